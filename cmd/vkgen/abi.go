@@ -0,0 +1,286 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strings"
+)
+
+// The struct layout test is generated along with an output that sets abi.
+// The C half is a package of its own, test files cannot use cgo.
+const (
+	abiPackage = "internal/abi/layouts.go"
+	abiTest    = "vulkan-core-abi_test.go"
+	abiImport  = "github.com/toy80/vk/internal/abi"
+)
+
+// layout is a C struct or union the output declares in Go. The members of
+// a union all start at 0, they are left out.
+type layout struct {
+	cname   string
+	name    string
+	members []string
+}
+
+func (r *renderer) record(t *typeDef, members []cDecl) {
+	if !r.out.abi {
+		return
+	}
+	l := layout{cname: t.name, name: r.goName(t.name)}
+	if t.category != "union" {
+		for _, d := range members {
+			if d.bits == 0 { // offsetof takes no bit fields
+				l.members = append(l.members, d.name)
+			}
+		}
+	}
+	r.layouts = append(r.layouts, l)
+}
+
+// target is the C data model of the targets a layout is computed for.
+type target struct {
+	ptr     int // pointers, size_t, unsigned long and the dispatchable handles
+	align64 int // alignment of the 64-bit scalars and non-dispatchable handles
+}
+
+var (
+	target64  = target{ptr: 8, align64: 8}
+	target386 = target{ptr: 4, align64: 4}
+
+	// targetARM is linux/arm, where the EABI aligns the 64-bit scalars to 8
+	// bytes and Go to 4.
+	targetARM = target{ptr: 4, align64: 8}
+)
+
+// sizeof models the size and alignment of the C declaration d on a 64-bit
+// target. It is only used to pick the largest and the most aligned union
+// members, the layout test checks the result on the real targets.
+func (r *renderer) sizeof(d cDecl) (size, align int) {
+	return r.sizeofOn(target64, d)
+}
+
+// sizeofOn models the size and alignment of the C declaration d on tg.
+func (r *renderer) sizeofOn(tg target, d cDecl) (size, align int) {
+	if d.ptr > 0 {
+		size, align = tg.ptr, tg.ptr
+	} else {
+		size, align = r.sizeofType(tg, d.typ)
+	}
+	for _, n := range d.array {
+		if c := r.reg.constants[n]; c != nil {
+			n = c.attr("value")
+		}
+		var dim int
+		if _, err := fmt.Sscan(n, &dim); err != nil {
+			r.fail("bad array size %s", n)
+		}
+		size *= dim
+	}
+	return
+}
+
+var scalarSizes = map[string]int{
+	"char": 1, "uint8_t": 1, "int8_t": 1, "uint16_t": 2, "int16_t": 2,
+	"int": 4, "float": 4, "uint32_t": 4, "int32_t": 4,
+	"double": 8, "uint64_t": 8, "int64_t": 8,
+}
+
+// externalSizes are the window system types the structs take by value, 0
+// for the XIDs, an unsigned long.
+var externalSizes = map[string]int{
+	"Window": 0, "VisualID": 0, "RROutput": 0,
+	"xcb_window_t": 4, "xcb_visualid_t": 4,
+}
+
+func (r *renderer) sizeofType(tg target, ctype string) (size, align int) {
+	switch n, ok := scalarSizes[ctype]; {
+	case ctype == "size_t":
+		return tg.ptr, tg.ptr
+	case n == 8:
+		return 8, tg.align64
+	case ok:
+		return n, n
+	}
+	t := r.reg.types[ctype]
+	if t == nil {
+		r.fail("%s: unknown size", ctype)
+		return 8, 8
+	}
+	if t.alias != "" {
+		return r.sizeofType(tg, t.alias)
+	}
+	switch t.category {
+	case "":
+		if n, ok := externalSizes[ctype]; ok {
+			if n == 0 {
+				n = tg.ptr
+			}
+			return n, n
+		}
+	case "handle":
+		if r.nonDispatchable(cDecl{typ: ctype}) {
+			return 8, tg.align64
+		}
+		return tg.ptr, tg.ptr
+	case "funcpointer":
+		return tg.ptr, tg.ptr
+	case "enum":
+		return 4, 4
+	case "bitmask":
+		if r.flags64(ctype) {
+			return 8, tg.align64
+		}
+		return 4, 4
+	case "basetype":
+		return r.sizeofType(tg, t.elem.childText("type"))
+	case "struct", "union":
+		used := 0 // bits taken in the last bit field unit
+		for _, m := range t.elem.elems("member") {
+			d := parseDecl(m.text())
+			n, a := r.sizeofOn(tg, d)
+			if a > align {
+				align = a
+			}
+			if t.category == "union" {
+				if n > size {
+					size = n
+				}
+				continue
+			}
+			if d.bits > 0 && used > 0 && used+d.bits <= n*8 {
+				used += d.bits
+				continue
+			}
+			size = roundUp(size, a) + n
+			used = d.bits
+		}
+		return roundUp(size, align), align
+	}
+	r.fail("%s: unknown size", ctype)
+	return 8, 8
+}
+
+func roundUp(n, align int) int { return (n + align - 1) / align * align }
+
+// goAlign is the alignment Go gives to a C type aligned to align on
+// linux/arm, it has nothing above 4.
+func goAlign(align int) int {
+	if align > 4 {
+		return 4
+	}
+	return align
+}
+
+// armPads returns the bytes of padding to put ahead of each field, and
+// after the last, for a Go struct to have the C layout on linux/arm. The
+// fields are the C declarations of the Go fields, a bit field unit is a
+// uint32_t. On the other targets C and Go agree and nothing is padded.
+func (r *renderer) armPads(fields []cDecl) (pads []int, tail int) {
+	end, align := 0, 1
+	for _, d := range fields {
+		size, a := r.sizeofOn(targetARM, d)
+		off := roundUp(end, a)
+		pads = append(pads, off-roundUp(end, goAlign(a)))
+		end = off + size
+		if a > align {
+			align = a
+		}
+	}
+	return pads, roundUp(end, align) - roundUp(end, goAlign(align))
+}
+
+// renderABI returns the C and the Go half of the layout test.
+func renderABI(out *output, layouts []layout) (pkg, test []byte, err error) {
+	var buf bytes.Buffer
+	buf.WriteString("// Package abi reports how C lays out the Vulkan structs and passes the\n")
+	buf.WriteString("// arguments, the tests of package vk compare it with the Go side.\n")
+	buf.WriteString("//\n// This file is generated by vkgen.\npackage abi\n\n")
+	buf.WriteString("// #cgo CFLAGS: -I${SRCDIR}/../..\n//\n// #include <stddef.h>\n")
+	for _, l := range out.preamble {
+		if strings.HasPrefix(l, `#include "./vulkan/`) {
+			fmt.Fprintf(&buf, "// #include \"%s\n", strings.TrimPrefix(l, `#include "./`))
+		}
+	}
+	buf.WriteString("//\n")
+	for _, l := range layouts {
+		vals := []string{"sizeof(" + l.cname + ")", "_Alignof(" + l.cname + ")"}
+		for _, m := range l.members {
+			vals = append(vals, fmt.Sprintf("offsetof(%s, %s)", l.cname, m))
+		}
+		fmt.Fprintf(&buf, "// const size_t layout_%s[] = {%s};\n", l.cname, strings.Join(vals, ", "))
+	}
+	buf.WriteString("import \"C\"\n\n")
+	buf.WriteString("// Layouts maps the C type names to sizeof, _Alignof and the offsetof of\n")
+	buf.WriteString("// every member, in declaration order.\nvar Layouts = map[string][]uintptr{\n")
+	for _, l := range layouts {
+		fmt.Fprintf(&buf, "\t%q: values(C.layout_%s[:]),\n", l.cname, l.cname)
+	}
+	buf.WriteString("}\n\nfunc values(a []C.size_t) []uintptr {\n")
+	buf.WriteString("\ts := make([]uintptr, len(a))\n\tfor i, v := range a {\n\t\ts[i] = uintptr(v)\n\t}\n\treturn s\n}\n")
+	if pkg, err = format.Source(buf.Bytes()); err != nil {
+		return nil, nil, fmt.Errorf("%s: %v", abiPackage, err)
+	}
+
+	buf.Reset()
+	fmt.Fprintf(&buf, "// +build cgo\n\n// This file is generated by vkgen.\n\npackage vk\n\n")
+	fmt.Fprintf(&buf, "import (\n\t\"runtime\"\n\t\"testing\"\n\t\"unsafe\"\n\n\t%q\n)\n\n", abiImport)
+	buf.WriteString(abiTestFunc)
+	buf.WriteString("\nvar structLayouts = []structLayout{\n")
+	for _, l := range layouts {
+		zero := fmt.Sprintf("*(*%s)(nil)", l.name)
+		fmt.Fprintf(&buf, "\t{%q, unsafe.Sizeof(%s), unsafe.Alignof(%s), ", l.cname, zero, zero)
+		if l.members == nil {
+			buf.WriteString("nil},\n")
+			continue
+		}
+		buf.WriteString("[]memberOffset{\n")
+		for _, m := range l.members {
+			fmt.Fprintf(&buf, "\t\t{%q, unsafe.Offsetof((*%s)(nil).%s)},\n", m, l.name, exported(m))
+		}
+		buf.WriteString("\t}},\n")
+	}
+	buf.WriteString("}\n")
+	if test, err = format.Source(buf.Bytes()); err != nil {
+		return nil, nil, fmt.Errorf("%s: %v", abiTest, err)
+	}
+	return pkg, test, nil
+}
+
+const abiTestFunc = `type structLayout struct {
+	name    string
+	size    uintptr
+	align   uintptr
+	members []memberOffset // nil for the unions, all at 0
+}
+
+type memberOffset struct {
+	name   string
+	offset uintptr
+}
+
+// TestStructLayouts compares every generated struct with the C declaration
+// it mirrors. A mismatch means the driver reads garbage.
+func TestStructLayouts(t *testing.T) {
+	for _, s := range structLayouts {
+		c, ok := abi.Layouts[s.name]
+		if !ok || len(c) != 2+len(s.members) {
+			t.Errorf("%s: C layout has %d values, want %d", s.name, len(c), 2+len(s.members))
+			continue
+		}
+		if s.size != c[0] {
+			t.Errorf("%s: sizeof is %d, C has %d", s.name, s.size, c[0])
+		}
+		// Go aligns nothing to 8 on arm, the padding of the structs keeps
+		// their members and their size where C has them
+		if s.align != c[1] && !(runtime.GOARCH == "arm" && s.align == 4 && c[1] == 8) {
+			t.Errorf("%s: alignof is %d, C has %d", s.name, s.align, c[1])
+		}
+		for i, m := range s.members {
+			if m.offset != c[2+i] {
+				t.Errorf("%s.%s: offsetof is %d, C has %d", s.name, m.name, m.offset, c[2+i])
+			}
+		}
+	}
+}
+`
@@ -0,0 +1,90 @@
+package main
+
+import "sort"
+
+// legacyNames maps the enum values the 1.2.177 bindings had under a K_
+// prefix, the provisional video and portability ones, to their current
+// names. They are kept as deprecated aliases. The decode session create
+// infos and the H.264 MVC struct were dropped from the extensions, their
+// values are taken by other structs and have no alias.
+var legacyNames = map[string]string{
+	"K_BUFFER_USAGE_VIDEO_DECODE_DST_BIT_KHR":                               "BUFFER_USAGE_VIDEO_DECODE_DST_BIT_KHR",
+	"K_BUFFER_USAGE_VIDEO_DECODE_SRC_BIT_KHR":                               "BUFFER_USAGE_VIDEO_DECODE_SRC_BIT_KHR",
+	"K_BUFFER_USAGE_VIDEO_ENCODE_DST_BIT_KHR":                               "BUFFER_USAGE_VIDEO_ENCODE_DST_BIT_KHR",
+	"K_BUFFER_USAGE_VIDEO_ENCODE_SRC_BIT_KHR":                               "BUFFER_USAGE_VIDEO_ENCODE_SRC_BIT_KHR",
+	"K_FORMAT_FEATURE_VIDEO_DECODE_DPB_BIT_KHR":                             "FORMAT_FEATURE_VIDEO_DECODE_DPB_BIT_KHR",
+	"K_FORMAT_FEATURE_VIDEO_DECODE_OUTPUT_BIT_KHR":                          "FORMAT_FEATURE_VIDEO_DECODE_OUTPUT_BIT_KHR",
+	"K_FORMAT_FEATURE_VIDEO_ENCODE_DPB_BIT_KHR":                             "FORMAT_FEATURE_VIDEO_ENCODE_DPB_BIT_KHR",
+	"K_FORMAT_FEATURE_VIDEO_ENCODE_INPUT_BIT_KHR":                           "FORMAT_FEATURE_VIDEO_ENCODE_INPUT_BIT_KHR",
+	"K_IMAGE_LAYOUT_VIDEO_DECODE_DPB_KHR":                                   "IMAGE_LAYOUT_VIDEO_DECODE_DPB_KHR",
+	"K_IMAGE_LAYOUT_VIDEO_DECODE_DST_KHR":                                   "IMAGE_LAYOUT_VIDEO_DECODE_DST_KHR",
+	"K_IMAGE_LAYOUT_VIDEO_DECODE_SRC_KHR":                                   "IMAGE_LAYOUT_VIDEO_DECODE_SRC_KHR",
+	"K_IMAGE_LAYOUT_VIDEO_ENCODE_DPB_KHR":                                   "IMAGE_LAYOUT_VIDEO_ENCODE_DPB_KHR",
+	"K_IMAGE_LAYOUT_VIDEO_ENCODE_DST_KHR":                                   "IMAGE_LAYOUT_VIDEO_ENCODE_DST_KHR",
+	"K_IMAGE_LAYOUT_VIDEO_ENCODE_SRC_KHR":                                   "IMAGE_LAYOUT_VIDEO_ENCODE_SRC_KHR",
+	"K_IMAGE_USAGE_VIDEO_DECODE_DPB_BIT_KHR":                                "IMAGE_USAGE_VIDEO_DECODE_DPB_BIT_KHR",
+	"K_IMAGE_USAGE_VIDEO_DECODE_DST_BIT_KHR":                                "IMAGE_USAGE_VIDEO_DECODE_DST_BIT_KHR",
+	"K_IMAGE_USAGE_VIDEO_DECODE_SRC_BIT_KHR":                                "IMAGE_USAGE_VIDEO_DECODE_SRC_BIT_KHR",
+	"K_IMAGE_USAGE_VIDEO_ENCODE_DPB_BIT_KHR":                                "IMAGE_USAGE_VIDEO_ENCODE_DPB_BIT_KHR",
+	"K_IMAGE_USAGE_VIDEO_ENCODE_DST_BIT_KHR":                                "IMAGE_USAGE_VIDEO_ENCODE_DST_BIT_KHR",
+	"K_IMAGE_USAGE_VIDEO_ENCODE_SRC_BIT_KHR":                                "IMAGE_USAGE_VIDEO_ENCODE_SRC_BIT_KHR",
+	"K_OBJECT_TYPE_VIDEO_SESSION_KHR":                                       "OBJECT_TYPE_VIDEO_SESSION_KHR",
+	"K_OBJECT_TYPE_VIDEO_SESSION_PARAMETERS_KHR":                            "OBJECT_TYPE_VIDEO_SESSION_PARAMETERS_KHR",
+	"K_QUERY_RESULT_WITH_STATUS_BIT_KHR":                                    "QUERY_RESULT_WITH_STATUS_BIT_KHR",
+	"K_QUERY_TYPE_RESULT_STATUS_ONLY_KHR":                                   "QUERY_TYPE_RESULT_STATUS_ONLY_KHR",
+	"K_QUERY_TYPE_VIDEO_ENCODE_BITSTREAM_BUFFER_RANGE_KHR":                  "QUERY_TYPE_VIDEO_ENCODE_BITSTREAM_BUFFER_RANGE_KHR",
+	"K_QUEUE_VIDEO_DECODE_BIT_KHR":                                          "QUEUE_VIDEO_DECODE_BIT_KHR",
+	"K_QUEUE_VIDEO_ENCODE_BIT_KHR":                                          "QUEUE_VIDEO_ENCODE_BIT_KHR",
+	"K_STRUCTURE_TYPE_PHYSICAL_DEVICE_PORTABILITY_SUBSET_FEATURES_KHR":      "STRUCTURE_TYPE_PHYSICAL_DEVICE_PORTABILITY_SUBSET_FEATURES_KHR",
+	"K_STRUCTURE_TYPE_PHYSICAL_DEVICE_PORTABILITY_SUBSET_PROPERTIES_KHR":    "STRUCTURE_TYPE_PHYSICAL_DEVICE_PORTABILITY_SUBSET_PROPERTIES_KHR",
+	"K_STRUCTURE_TYPE_PHYSICAL_DEVICE_VIDEO_FORMAT_INFO_KHR":                "STRUCTURE_TYPE_PHYSICAL_DEVICE_VIDEO_FORMAT_INFO_KHR",
+	"K_STRUCTURE_TYPE_VIDEO_BEGIN_CODING_INFO_KHR":                          "STRUCTURE_TYPE_VIDEO_BEGIN_CODING_INFO_KHR",
+	"K_STRUCTURE_TYPE_VIDEO_CAPABILITIES_KHR":                               "STRUCTURE_TYPE_VIDEO_CAPABILITIES_KHR",
+	"K_STRUCTURE_TYPE_VIDEO_CODING_CONTROL_INFO_KHR":                        "STRUCTURE_TYPE_VIDEO_CODING_CONTROL_INFO_KHR",
+	"K_STRUCTURE_TYPE_VIDEO_DECODE_INFO_KHR":                                "STRUCTURE_TYPE_VIDEO_DECODE_INFO_KHR",
+	"K_STRUCTURE_TYPE_VIDEO_ENCODE_H264_CAPABILITIES_EXT":                   "STRUCTURE_TYPE_VIDEO_ENCODE_H264_CAPABILITIES_EXT",
+	"K_STRUCTURE_TYPE_VIDEO_ENCODE_H264_DPB_SLOT_INFO_EXT":                  "STRUCTURE_TYPE_VIDEO_ENCODE_H264_DPB_SLOT_INFO_EXT",
+	"K_STRUCTURE_TYPE_VIDEO_ENCODE_H264_SESSION_PARAMETERS_ADD_INFO_EXT":    "STRUCTURE_TYPE_VIDEO_ENCODE_H264_SESSION_PARAMETERS_ADD_INFO_EXT",
+	"K_STRUCTURE_TYPE_VIDEO_ENCODE_H264_SESSION_PARAMETERS_CREATE_INFO_EXT": "STRUCTURE_TYPE_VIDEO_ENCODE_H264_SESSION_PARAMETERS_CREATE_INFO_EXT",
+	"K_STRUCTURE_TYPE_VIDEO_ENCODE_H264_VCL_FRAME_INFO_EXT":                 "STRUCTURE_TYPE_VIDEO_ENCODE_H264_VCL_FRAME_INFO_EXT",
+	"K_STRUCTURE_TYPE_VIDEO_ENCODE_INFO_KHR":                                "STRUCTURE_TYPE_VIDEO_ENCODE_INFO_KHR",
+	"K_STRUCTURE_TYPE_VIDEO_ENCODE_RATE_CONTROL_INFO_KHR":                   "STRUCTURE_TYPE_VIDEO_ENCODE_RATE_CONTROL_INFO_KHR",
+	"K_STRUCTURE_TYPE_VIDEO_END_CODING_INFO_KHR":                            "STRUCTURE_TYPE_VIDEO_END_CODING_INFO_KHR",
+	"K_STRUCTURE_TYPE_VIDEO_FORMAT_PROPERTIES_KHR":                          "STRUCTURE_TYPE_VIDEO_FORMAT_PROPERTIES_KHR",
+	"K_STRUCTURE_TYPE_VIDEO_SESSION_CREATE_INFO_KHR":                        "STRUCTURE_TYPE_VIDEO_SESSION_CREATE_INFO_KHR",
+	"K_STRUCTURE_TYPE_VIDEO_SESSION_PARAMETERS_CREATE_INFO_KHR":             "STRUCTURE_TYPE_VIDEO_SESSION_PARAMETERS_CREATE_INFO_KHR",
+	"K_STRUCTURE_TYPE_VIDEO_SESSION_PARAMETERS_UPDATE_INFO_KHR":             "STRUCTURE_TYPE_VIDEO_SESSION_PARAMETERS_UPDATE_INFO_KHR",
+	"K_STRUCTURE_TYPE_VIDEO_BIND_MEMORY_KHR":                                "STRUCTURE_TYPE_BIND_VIDEO_SESSION_MEMORY_INFO_KHR",
+	"K_STRUCTURE_TYPE_VIDEO_DECODE_H264_CAPABILITIES_EXT":                   "STRUCTURE_TYPE_VIDEO_DECODE_H264_CAPABILITIES_KHR",
+	"K_STRUCTURE_TYPE_VIDEO_DECODE_H264_DPB_SLOT_INFO_EXT":                  "STRUCTURE_TYPE_VIDEO_DECODE_H264_DPB_SLOT_INFO_KHR",
+	"K_STRUCTURE_TYPE_VIDEO_DECODE_H264_PICTURE_INFO_EXT":                   "STRUCTURE_TYPE_VIDEO_DECODE_H264_PICTURE_INFO_KHR",
+	"K_STRUCTURE_TYPE_VIDEO_DECODE_H264_PROFILE_EXT":                        "STRUCTURE_TYPE_VIDEO_DECODE_H264_PROFILE_INFO_KHR",
+	"K_STRUCTURE_TYPE_VIDEO_DECODE_H264_SESSION_PARAMETERS_ADD_INFO_EXT":    "STRUCTURE_TYPE_VIDEO_DECODE_H264_SESSION_PARAMETERS_ADD_INFO_KHR",
+	"K_STRUCTURE_TYPE_VIDEO_DECODE_H264_SESSION_PARAMETERS_CREATE_INFO_EXT": "STRUCTURE_TYPE_VIDEO_DECODE_H264_SESSION_PARAMETERS_CREATE_INFO_KHR",
+	"K_STRUCTURE_TYPE_VIDEO_DECODE_H265_CAPABILITIES_EXT":                   "STRUCTURE_TYPE_VIDEO_DECODE_H265_CAPABILITIES_KHR",
+	"K_STRUCTURE_TYPE_VIDEO_DECODE_H265_DPB_SLOT_INFO_EXT":                  "STRUCTURE_TYPE_VIDEO_DECODE_H265_DPB_SLOT_INFO_KHR",
+	"K_STRUCTURE_TYPE_VIDEO_DECODE_H265_PICTURE_INFO_EXT":                   "STRUCTURE_TYPE_VIDEO_DECODE_H265_PICTURE_INFO_KHR",
+	"K_STRUCTURE_TYPE_VIDEO_DECODE_H265_PROFILE_EXT":                        "STRUCTURE_TYPE_VIDEO_DECODE_H265_PROFILE_INFO_KHR",
+	"K_STRUCTURE_TYPE_VIDEO_DECODE_H265_SESSION_PARAMETERS_ADD_INFO_EXT":    "STRUCTURE_TYPE_VIDEO_DECODE_H265_SESSION_PARAMETERS_ADD_INFO_KHR",
+	"K_STRUCTURE_TYPE_VIDEO_DECODE_H265_SESSION_PARAMETERS_CREATE_INFO_EXT": "STRUCTURE_TYPE_VIDEO_DECODE_H265_SESSION_PARAMETERS_CREATE_INFO_KHR",
+	"K_STRUCTURE_TYPE_VIDEO_ENCODE_H264_EMIT_PICTURE_PARAMETERS_EXT":        "STRUCTURE_TYPE_VIDEO_ENCODE_H264_EMIT_PICTURE_PARAMETERS_INFO_EXT",
+	"K_STRUCTURE_TYPE_VIDEO_ENCODE_H264_NALU_SLICE_EXT":                     "STRUCTURE_TYPE_VIDEO_ENCODE_H264_NALU_SLICE_INFO_EXT",
+	"K_STRUCTURE_TYPE_VIDEO_ENCODE_H264_PROFILE_EXT":                        "STRUCTURE_TYPE_VIDEO_ENCODE_H264_PROFILE_INFO_EXT",
+	"K_STRUCTURE_TYPE_VIDEO_GET_MEMORY_PROPERTIES_KHR":                      "STRUCTURE_TYPE_VIDEO_SESSION_MEMORY_REQUIREMENTS_KHR",
+	"K_STRUCTURE_TYPE_VIDEO_PICTURE_RESOURCE_KHR":                           "STRUCTURE_TYPE_VIDEO_PICTURE_RESOURCE_INFO_KHR",
+	"K_STRUCTURE_TYPE_VIDEO_PROFILES_KHR":                                   "STRUCTURE_TYPE_VIDEO_PROFILE_LIST_INFO_KHR",
+	"K_STRUCTURE_TYPE_VIDEO_PROFILE_KHR":                                    "STRUCTURE_TYPE_VIDEO_PROFILE_INFO_KHR",
+	"K_STRUCTURE_TYPE_VIDEO_QUEUE_FAMILY_PROPERTIES_2_KHR":                  "STRUCTURE_TYPE_QUEUE_FAMILY_VIDEO_PROPERTIES_KHR",
+	"K_STRUCTURE_TYPE_VIDEO_REFERENCE_SLOT_KHR":                             "STRUCTURE_TYPE_VIDEO_REFERENCE_SLOT_INFO_KHR",
+}
+
+// legacyAliases returns the legacy names of the value name, sorted.
+func legacyAliases(name string) (ret []string) {
+	for old, cur := range legacyNames {
+		if cur == name {
+			ret = append(ret, old)
+		}
+	}
+	sort.Strings(ret)
+	return
+}
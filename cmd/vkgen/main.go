@@ -0,0 +1,57 @@
+// Command vkgen generates the Vulkan bindings of package vk from the Khronos
+// registry, vk.xml.
+//
+// Usage:
+//
+//	go run ./cmd/vkgen [-registry vulkan/registry/vk.xml] [-out .]
+//
+// The registry must come from the same release as the headers in the vulkan
+// directory, the cgo bridges are compiled against them. The StdVideo types
+// are read from video.xml, next to vk.xml.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+func main() {
+	registryPath := flag.String("registry", "vulkan/registry/vk.xml", "path of the Vulkan registry")
+	outDir := flag.String("out", ".", "output directory")
+	flag.Parse()
+
+	if err := run(*registryPath, *outDir); err != nil {
+		fmt.Fprintln(os.Stderr, "vkgen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(registryPath, outDir string) error {
+	reg, err := loadRegistry(registryPath)
+	if err != nil {
+		return err
+	}
+	files, err := generate(reg)
+	if err != nil {
+		return err
+	}
+	var names []string
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		path := filepath.Join(outDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(path, files[name], 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,564 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+)
+
+// output describes one generated file, vulkan_core.h goes into a cgo and a
+// syscall flavour, the window system headers get a file each.
+type output struct {
+	file     string
+	platform string // vk.xml platform name, empty for the core API
+	build    string
+	cgo      bool
+	preamble []string // cgo preamble, the bridges are appended
+	prelude  string   // handwritten declarations ahead of the generated ones
+	abi      bool     // generate the struct layout test as well
+	vkx      bool     // generate the methods of package vkx as well
+	arm      bool     // pad the structs for linux/arm, see armPads
+
+	// external C types -> Go types
+	types map[string]string
+}
+
+var win32Types = map[string]string{
+	"HANDLE":              "HANDLE",
+	"HINSTANCE":           "HINSTANCE",
+	"HWND":                "HWND",
+	"HMONITOR":            "HMONITOR",
+	"DWORD":               "DWORD",
+	"LPCWSTR":             "LPCWSTR",
+	"SECURITY_ATTRIBUTES": "SECURITY_ATTRIBUTES",
+}
+
+var outputs = []*output{
+	{
+		file:  "vulkan-core-cgo.go",
+		build: "linux darwin forcecgo,windows",
+		cgo:   true,
+		arm:   true,
+		preamble: []string{
+			"#cgo windows LDFLAGS: -lvulkan-1",
+			"#cgo linux LDFLAGS: -lvulkan",
+			"#cgo darwin LDFLAGS: -lMoltenVK",
+			"",
+			"#ifdef _WIN32",
+			"# include <windows.h>",
+			"#endif",
+			"",
+			"#ifdef __apple__",
+			"# include <Availability.h>",
+			"#endif",
+			"",
+			"#include <stdint.h>",
+			"#include <stdlib.h>",
+			"#include <string.h>",
+			`#include "./vulkan/vulkan.h"`,
+			"",
+			"",
+		},
+		prelude: coreCgoPrelude,
+		abi:     true,
+		vkx:     true,
+	},
+	{
+		file:    "vulkan-core-syscall_windows.go",
+		build:   "!forcecgo",
+		prelude: win32Prelude + "\n\n" + coreSyscallPrelude,
+	},
+	{
+		file:     "vulkan-xlib_linux.go",
+		platform: "xlib",
+		build:    "xlib xlib_xrandr",
+		cgo:      true,
+		arm:      true,
+		preamble: []string{
+			"#cgo linux LDFLAGS: -lvulkan",
+			"#include <stdint.h>",
+			"#include <X11/Xlib.h>",
+			`#include "./vulkan/vulkan.h"`,
+			`#include "./vulkan/vulkan_xlib.h"`,
+			"",
+		},
+		prelude: xlibPrelude,
+		types:   map[string]string{"Display": "Display", "Window": "Window", "VisualID": "VisualID"},
+	},
+	{
+		file:     "vulkan-xcb_linux.go",
+		platform: "xcb",
+		build:    "!xlib,!xlib_xrandr",
+		cgo:      true,
+		arm:      true,
+		preamble: []string{
+			"#cgo linux LDFLAGS: -lvulkan",
+			"#include <stdint.h>",
+			"#include <xcb/xcb.h>",
+			`#include "./vulkan/vulkan.h"`,
+			`#include "./vulkan/vulkan_xcb.h"`,
+			"",
+		},
+		prelude: xcbPrelude,
+		types: map[string]string{
+			"xcb_connection_t": "XcbConnection",
+			"xcb_window_t":     "XcbWindow",
+			"xcb_visualid_t":   "XcbVisualID",
+		},
+	},
+	{
+		file:     "vulkan-xlib_xrandr_linux.go",
+		platform: "xlib_xrandr",
+		build:    "xlib_xrandr",
+		cgo:      true,
+		arm:      true,
+		preamble: []string{
+			"#cgo linux LDFLAGS: -lvulkan",
+			"#include <stdint.h>",
+			"#include <X11/Xlib.h>",
+			"typedef XID RROutput;",
+			`#include "./vulkan/vulkan.h"`,
+			`#include "./vulkan/vulkan_xlib_xrandr.h"`,
+			"",
+		},
+		prelude: xrandrPrelude,
+		types:   map[string]string{"Display": "Display", "RROutput": "RROutput"},
+	},
+	{
+		file:     "vulkan-wayland_linux.go",
+		platform: "wayland",
+		build:    "wayland",
+		cgo:      true,
+		arm:      true,
+		preamble: []string{
+			"#cgo linux LDFLAGS: -lvulkan",
+			"#include <stdint.h>",
+			"typedef struct wl_display wl_display;",
+			"typedef struct wl_surface wl_surface;",
+			`#include "./vulkan/vulkan.h"`,
+			`#include "./vulkan/vulkan_wayland.h"`,
+			"",
+		},
+		prelude: waylandPrelude,
+		types:   map[string]string{"wl_display": "WlDisplay", "wl_surface": "WlSurface"},
+	},
+	{
+		file:     "vulkan-directfb_linux.go",
+		platform: "directfb",
+		build:    "directfb",
+		cgo:      true,
+		arm:      true,
+		preamble: []string{
+			"#cgo linux LDFLAGS: -lvulkan",
+			"#include <stdint.h>",
+			"typedef struct _IDirectFB IDirectFB;",
+			"typedef struct _IDirectFBSurface IDirectFBSurface;",
+			`#include "./vulkan/vulkan.h"`,
+			`#include "./vulkan/vulkan_directfb.h"`,
+			"",
+		},
+		prelude: directfbPrelude,
+		types:   map[string]string{"IDirectFB": "IDirectFB", "IDirectFBSurface": "IDirectFBSurface"},
+	},
+	{
+		file:     "vulkan-beta-cgo.go",
+		platform: "provisional",
+		build:    "vkbeta,linux vkbeta,darwin vkbeta,forcecgo,windows",
+		cgo:      true,
+		arm:      true,
+		preamble: []string{
+			"#cgo windows LDFLAGS: -lvulkan-1",
+			"#cgo linux LDFLAGS: -lvulkan",
+			"#cgo darwin LDFLAGS: -lMoltenVK",
+			"#include <stdint.h>",
+			"#define VK_ENABLE_BETA_EXTENSIONS 1",
+			`#include "./vulkan/vulkan_core.h"`,
+			`#include "./vulkan/vulkan_beta.h"`,
+			"",
+		},
+	},
+	{
+		file:     "vulkan-beta-syscall_windows.go",
+		platform: "provisional",
+		build:    "vkbeta,!forcecgo",
+	},
+	{
+		file:     "vulkan-macos_darwin.go",
+		platform: "macos",
+		build:    "!ios",
+		cgo:      true,
+		preamble: []string{
+			"#cgo darwin LDFLAGS: -lMoltenVK",
+			"#include <Availability.h>",
+			"#include <stdint.h>",
+			`#include "./vulkan/vulkan.h"`,
+			`#include "./vulkan/vulkan_macos.h"`,
+			"",
+		},
+	},
+	{
+		file:     "vulkan-ios_darwin.go",
+		platform: "ios",
+		build:    "ios",
+		cgo:      true,
+		preamble: []string{
+			"#cgo darwin LDFLAGS: -lMoltenVK",
+			"#include <Availability.h>",
+			"#include <stdint.h>",
+			`#include "./vulkan/vulkan.h"`,
+			`#include "./vulkan/vulkan_ios.h"`,
+			"",
+		},
+	},
+	{
+		file:     "vulkan-win32-cgo_windows.go",
+		platform: "win32",
+		build:    "forcecgo",
+		cgo:      true,
+		preamble: []string{
+			"#cgo windows LDFLAGS: -lvulkan-1",
+			"#include <windows.h>",
+			"#include <stdint.h>",
+			`#include "./vulkan/vulkan.h"`,
+			`#include "./vulkan/vulkan_win32.h"`,
+			"",
+		},
+		prelude: win32Prelude,
+		types:   win32Types,
+	},
+	{
+		file:     "vulkan-win32-syscall_windows.go",
+		platform: "win32",
+		build:    "!forcecgo",
+		types:    win32Types,
+	},
+}
+
+// generate returns the source of every output, keyed by the file name.
+// Platforms missing from the registry produce nothing.
+func generate(reg *registry) (map[string][]byte, error) {
+	exts := append([]*element(nil), reg.extensions...)
+	// the headers list the KHR extensions first
+	sort.SliceStable(exts, func(i, j int) bool {
+		ki, kj := isKHR(exts[i]), isKHR(exts[j])
+		if ki != kj {
+			return ki
+		}
+		return extNumber(exts[i]) < extNumber(exts[j])
+	})
+	core := newPlanner(reg)
+	for _, f := range reg.features {
+		core.add(f)
+	}
+	for _, x := range exts {
+		if x.attr("platform") == "" {
+			core.add(x)
+		}
+	}
+	files := make(map[string][]byte)
+	for _, out := range outputs {
+		p := core
+		if out.platform != "" {
+			p = core.fork()
+			for _, x := range exts {
+				if x.attr("platform") == out.platform {
+					p.add(x)
+				}
+			}
+			if len(p.blocks) == 0 {
+				continue
+			}
+		}
+		src, r, err := out.render(reg, p.blocks)
+		if err != nil {
+			return nil, err
+		}
+		files[out.file] = src
+		if len(r.structs) > 0 {
+			other, arm := out.layoutFiles()
+			if files[other], err = out.renderLayouts(r.structs, false); err != nil {
+				return nil, err
+			}
+			if files[arm], err = out.renderLayouts(r.armStructs, true); err != nil {
+				return nil, err
+			}
+		}
+		if out.abi {
+			if files[abiPackage], files[abiTest], err = renderABI(out, r.layouts); err != nil {
+				return nil, err
+			}
+		}
+		if out.vkx {
+			if files[vkxCommands], err = renderVKX(r.wrappers); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return files, nil
+}
+
+func isKHR(x *element) bool { return strings.HasPrefix(x.attr("name"), "VK_KHR_") }
+
+func extNumber(x *element) (n int) {
+	fmt.Sscan(x.attr("number"), &n)
+	return
+}
+
+func (out *output) render(reg *registry, blocks []*block) ([]byte, *renderer, error) {
+	r := &renderer{reg: reg, out: out, vars: make(map[string]bool)}
+	for _, b := range blocks {
+		r.render(b)
+	}
+	if r.err != nil {
+		return nil, nil, fmt.Errorf("%s: %v", out.file, r.err)
+	}
+	body := r.body.String()
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// +build %s\n\npackage vk\n\n", out.build)
+	if out.cgo {
+		var lines []string
+		lines = append(lines, out.preamble...)
+		for _, b := range r.bridges {
+			lines = append(lines, strings.Split(b, "\n")...)
+		}
+		for _, l := range lines {
+			if l == "" {
+				buf.WriteString("//\n")
+			} else {
+				fmt.Fprintf(&buf, "// %s\n", l)
+			}
+		}
+		buf.WriteString("import \"C\"\n\n")
+	}
+	var imports []string
+	for _, pkg := range []string{"fmt", "strings", "syscall", "unsafe"} {
+		if strings.Contains(out.prelude, pkg+".") || strings.Contains(body, pkg+".") {
+			imports = append(imports, fmt.Sprintf("\t%q\n", pkg))
+		}
+	}
+	if len(imports) > 0 {
+		fmt.Fprintf(&buf, "import (\n%s)\n\n", strings.Join(imports, ""))
+	}
+	buf.WriteString(license)
+	if out.prelude != "" {
+		fmt.Fprintf(&buf, "\n%s\n", out.prelude)
+	}
+	buf.WriteString(body)
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %v", out.file, err)
+	}
+	return src, r, nil
+}
+
+// layoutFiles returns the names of the files of the structs padded on
+// linux/arm, for the other targets and for arm.
+func (out *output) layoutFiles() (other, arm string) {
+	base := strings.TrimSuffix(out.file, ".go")
+	suffix := ""
+	if strings.HasSuffix(base, "_linux") {
+		base, suffix = strings.TrimSuffix(base, "_linux"), "_linux"
+	}
+	base = strings.TrimSuffix(base, "-cgo") + "-layout" + suffix
+	return base + ".go", base + "_arm.go"
+}
+
+// renderLayouts returns the file of the structs padded on linux/arm, the
+// output declares them in one of the two files.
+func (out *output) renderLayouts(structs []string, arm bool) ([]byte, error) {
+	other, armFile := out.layoutFiles()
+	name := other
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// +build %s\n", out.build)
+	if arm {
+		name = armFile
+	} else {
+		buf.WriteString("// +build !arm\n")
+	}
+	buf.WriteString("\npackage vk\n\n")
+	body := strings.Join(structs, "\n")
+	if strings.Contains(body, "unsafe.") {
+		buf.WriteString("import \"unsafe\"\n\n")
+	}
+	buf.WriteString(license)
+	if arm {
+		fmt.Fprintf(&buf, "\n// The structs of %s that C lays out differently on linux/arm,\n", out.file)
+		buf.WriteString("// where the EABI aligns the 64-bit scalars to 8 bytes and Go to 4. The\n")
+		fmt.Fprintf(&buf, "// fields are padded to the C layout, %s has them unpadded.\n", other)
+	} else {
+		fmt.Fprintf(&buf, "\n// The structs of %s padded on linux/arm, see %s.\n", out.file, armFile)
+	}
+	buf.WriteString("\n" + body)
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", name, err)
+	}
+	return src, nil
+}
+
+const license = `/*
+ ** Copyright (c) 2015-2019 The Khronos Group Inc.
+ **
+ ** Licensed under the Apache License, Version 2.0 (the "License");
+ ** you may not use this file except in compliance with the License.
+ ** You may obtain a copy of the License at
+ **
+ **     http://www.apache.org/licenses/LICENSE-2.0
+ **
+ ** Unless required by applicable law or agreed to in writing, software
+ ** distributed under the License is distributed on an "AS IS" BASIS,
+ ** WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ ** See the License for the specific language governing permissions and
+ ** limitations under the License.
+ */
+
+/*
+ ** This file is generated from the Vulkan headers.
+ */
+`
+
+const coreCgoPrelude = `// MemAlloc allocate zeroed C memory block
+func MemAlloc(sz uintptr) (p unsafe.Pointer) {
+	// Address of a block of C memory is of course "unsafe pointer"
+	if sz == 0 {
+		sz = 1 // MemAlloc(0) should return a non nil pointer
+	}
+	q := C.malloc(C.size_t(sz))
+	C.memset(q, 0, C.size_t(sz))
+	debugMarkMemBlock(uintptr(q))
+	return q
+}
+
+// MemFree release C memory block that allocated with MemAlloc()
+func MemFree(p unsafe.Pointer) {
+	debugUnmarkMemBlock(uintptr(p))
+	C.free(p)
+}
+
+func GetInstanceProcAddr(instance Instance, name string) PfnVoidFunction {
+	c := []byte(name)
+	c = append(c, 0)
+	return PfnVoidFunction(unsafe.Pointer(C.vkGetInstanceProcAddr((C.VkInstance)(unsafe.Pointer(instance)), (*C.char)((unsafe.Pointer(&c[0]))))))
+}
+
+// GetDeviceProcAddr returns the command name of device, skipping the loader
+// trampolines. It is zero if device does not have it.
+func GetDeviceProcAddr(device Device, name string) PfnVoidFunction {
+	c := []byte(name)
+	c = append(c, 0)
+	return PfnVoidFunction(unsafe.Pointer(C.vkGetDeviceProcAddr((C.VkDevice)(unsafe.Pointer(device)), (*C.char)((unsafe.Pointer(&c[0]))))))
+}`
+
+const coreSyscallPrelude = `var (
+	kernel32dll    = syscall.NewLazyDLL("kernel32.dll")
+	procLocalAlloc = kernel32dll.NewProc("LocalAlloc") // HLOCAL LocalAlloc(UINT uFlags, SIZE_T uBytes);
+	procLocalFree  = kernel32dll.NewProc("LocalFree")  // HLOCAL LocalFree(HLOCAL hMem);
+
+	vkdll                   = syscall.NewLazyDLL("vulkan-1.dll")
+	procGetInstanceProcAddr = vkdll.NewProc("vkGetInstanceProcAddr")
+	procGetDeviceProcAddr   = vkdll.NewProc("vkGetDeviceProcAddr")
+)
+
+// MemAlloc allocate zeroed C memory block
+func MemAlloc(sz uintptr) (p unsafe.Pointer) {
+	// Address of a block of C memory is of course "unsafe pointer"
+	if sz == 0 {
+		sz = 1 // MemAlloc(0) should return a non nil pointer
+	}
+	*(*uintptr)(unsafe.Pointer(&p)), _, _ = procLocalAlloc.Call(0x0040, sz) // 0x0040 = LMEM_FIXED | LMEM_ZEROINIT.
+	debugMarkMemBlock(uintptr(p))
+	return
+}
+
+// MemFree release C memory block that allocated with MemAlloc()
+func MemFree(p unsafe.Pointer) {
+	debugUnmarkMemBlock(uintptr(p))
+	_, _, _ = procLocalFree.Call(uintptr(p))
+}
+
+func GetInstanceProcAddr(instance Instance, name string) PfnVoidFunction {
+	c := []byte(name)
+	c = append(c, 0)
+	ret, _, _ := procGetInstanceProcAddr.Call(uintptr(instance), uintptr(unsafe.Pointer(&c[0])))
+	debugCheckAndBreak()
+	return ret
+}
+
+// GetDeviceProcAddr returns the command name of device, skipping the loader
+// trampolines. It is zero if device does not have it.
+func GetDeviceProcAddr(device Device, name string) PfnVoidFunction {
+	c := []byte(name)
+	c = append(c, 0)
+	ret, _, _ := procGetDeviceProcAddr.Call(uintptr(device), uintptr(unsafe.Pointer(&c[0])))
+	debugCheckAndBreak()
+	return ret
+}
+
+// call makes the call with any number of words, windows/386 goes past the
+// 18 of syscall.Syscall18 when most of the arguments are 64-bit.
+func call(addr uintptr, a ...uintptr) (r1, r2 uintptr, lastErr error) {
+	return syscall.SyscallN(addr, a...)
+}
+
+// is32bit is set on windows/386. The 64-bit integers and non-dispatchable
+// handles are passed there as two words, the low one first, and returned
+// in r1 and r2.
+const is32bit = ^uintptr(0)>>32 == 0
+
+// ret64 joins a 64-bit result.
+func ret64(r1, r2 uintptr) uint64 {
+	if is32bit {
+		return uint64(r2)<<32 | uint64(r1)
+	}
+	return uint64(r1)
+}`
+
+const win32Prelude = `type (
+	HANDLE    = uintptr
+	HINSTANCE = uintptr
+	HWND      = uintptr
+	HMONITOR  = uintptr
+	DWORD     = uint32
+
+	LPCWSTR *uint16
+
+	SECURITY_ATTRIBUTES struct {
+		Length               DWORD
+		LpSecurityDescriptor unsafe.Pointer
+		InheritHandle        uint32
+	}
+)`
+
+const xlibPrelude = `type (
+	Window   = C.Window
+	Display  = C.Display
+	VisualID = C.VisualID
+)`
+
+const xcbPrelude = `type (
+	XcbConnection = C.xcb_connection_t
+	XcbWindow     = C.xcb_window_t
+	XcbVisualID   = C.xcb_visualid_t
+)`
+
+// Display comes from vulkan-xlib_linux.go, which the xlib_xrandr tag also
+// selects. RROutput is an XID, Xrandr.h need not be installed.
+const xrandrPrelude = `type RROutput = C.RROutput`
+
+// wl_display and wl_surface are only passed by pointer, the typedefs in the
+// preamble stand in for wayland-client.h, which need not be installed.
+const waylandPrelude = `type (
+	WlDisplay = C.wl_display
+	WlSurface = C.wl_surface
+)`
+
+// The DirectFB interfaces are opaque, the typedefs in the preamble match
+// directfb.h, which need not be installed.
+const directfbPrelude = `type (
+	IDirectFB        = C.IDirectFB
+	IDirectFBSurface = C.IDirectFBSurface
+)`
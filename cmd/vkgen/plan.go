@@ -0,0 +1,152 @@
+package main
+
+type section int
+
+const (
+	secDefine section = iota
+	secBasetype
+	secHandle
+	secEnum
+	secGroup
+	secFuncpointer
+	secStruct
+	secCommand
+	numSections
+)
+
+var sectionOf = map[string]section{
+	"define":      secDefine,
+	"basetype":    secBasetype,
+	"handle":      secHandle,
+	"enum":        secGroup,
+	"bitmask":     secGroup,
+	"funcpointer": secFuncpointer,
+	"struct":      secStruct,
+	"union":       secStruct,
+}
+
+type item struct {
+	name string
+	elem *element // set for the enum section
+}
+
+// block is the interface of a feature or an extension, split into sections
+// in the same order as the C header does.
+type block struct {
+	name     string
+	sections [numSections][]item
+}
+
+// planner walks the <require> tags like the Khronos genvk.py does, a type
+// is placed into the first block that requires it, after its dependencies.
+type planner struct {
+	reg    *registry
+	done   map[string]bool
+	cur    *block
+	blocks []*block
+}
+
+func newPlanner(reg *registry) *planner {
+	return &planner{reg: reg, done: make(map[string]bool)}
+}
+
+// fork returns a planner that skips everything already planned by p, which
+// is how the platform headers sit on top of vulkan_core.h.
+func (p *planner) fork() *planner {
+	q := newPlanner(p.reg)
+	for k, v := range p.done {
+		q.done[k] = v
+	}
+	return q
+}
+
+func (p *planner) add(e *element) {
+	p.cur = &block{name: e.attr("name")}
+	p.require(e)
+	p.blocks = append(p.blocks, p.cur)
+}
+
+// require plans the <require> tags of a feature, an extension or a vk_video
+// header into the current block.
+func (p *planner) require(e *element) {
+	for _, req := range e.elems("require") {
+		for _, t := range req.elems("type") {
+			p.requireType(t.attr("name"))
+		}
+		for _, c := range req.elems("enum") {
+			p.requireEnum(c)
+		}
+		for _, c := range req.elems("command") {
+			p.requireCommand(c.attr("name"))
+		}
+	}
+}
+
+func (p *planner) requireType(name string) {
+	t := p.reg.types[name]
+	if p.done[name] {
+		return
+	}
+	if h := p.reg.headers[name]; h != nil {
+		p.done[name] = true
+		p.require(h)
+		return
+	}
+	if t == nil {
+		return
+	}
+	if p.reg.headers[t.requires] != nil && !p.done[t.requires] {
+		// the header declares the type, in its own order
+		if p.requireType(t.requires); p.done[name] {
+			return
+		}
+	}
+	p.done[name] = true
+	for _, dep := range []string{t.requires, t.alias, t.elem.attr("bitvalues")} {
+		if dep != "" {
+			p.requireType(dep)
+		}
+	}
+	for _, c := range t.elem.descendants("type") {
+		p.requireType(c.text())
+	}
+	if sec, ok := sectionOf[t.category]; ok {
+		p.cur.sections[sec] = append(p.cur.sections[sec], item{name: name})
+	}
+}
+
+func (p *planner) requireEnum(e *element) {
+	name := e.attr("name")
+	if e.attr("extends") != "" || p.done[name] {
+		return
+	}
+	p.done[name] = true
+	if e.attr("value") == "" && e.attr("alias") == "" {
+		if c := p.reg.constants[name]; c != nil {
+			e = c
+		}
+	}
+	p.cur.sections[secEnum] = append(p.cur.sections[secEnum], item{name: name, elem: e})
+}
+
+func (p *planner) requireCommand(name string) {
+	c := p.reg.commands[name]
+	if c == nil || p.done[name] {
+		return
+	}
+	p.done[name] = true
+	if c.alias != "" {
+		c = p.reg.commands[c.alias]
+	}
+	if c != nil {
+		for _, t := range c.proto.descendants("type") {
+			p.requireType(t.text())
+		}
+		for _, param := range c.params {
+			for _, t := range param.descendants("type") {
+				p.requireType(t.text())
+			}
+		}
+	}
+	p.cur.sections[secCommand] = append(p.cur.sections[secCommand], item{name: name})
+}
@@ -0,0 +1,409 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// element is a minimal DOM node, vk.xml mixes text and tags (e.g.
+// "const <type>char</type>* <name>pName</name>") so the order matters.
+type element struct {
+	name     string
+	attrs    map[string]string
+	children []interface{} // *element or string
+}
+
+func (e *element) attr(name string) string { return e.attrs[name] }
+
+func (e *element) elems(name string) (ret []*element) {
+	for _, c := range e.children {
+		if c, ok := c.(*element); ok && (name == "" || c.name == name) {
+			ret = append(ret, c)
+		}
+	}
+	return
+}
+
+func (e *element) elem(name string) *element {
+	for _, c := range e.children {
+		if c, ok := c.(*element); ok && c.name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// descendants returns all the nested elements with the given name.
+func (e *element) descendants(name string) (ret []*element) {
+	for _, c := range e.elems("") {
+		if c.name == name {
+			ret = append(ret, c)
+		}
+		ret = append(ret, c.descendants(name)...)
+	}
+	return
+}
+
+// text returns the inner text, <comment> tags are skipped.
+func (e *element) text() string {
+	var sb strings.Builder
+	for _, c := range e.children {
+		switch c := c.(type) {
+		case string:
+			sb.WriteString(c)
+		case *element:
+			if c.name != "comment" {
+				sb.WriteString(c.text())
+			}
+		}
+	}
+	return sb.String()
+}
+
+func (e *element) childText(name string) string {
+	if c := e.elem(name); c != nil {
+		return c.text()
+	}
+	return ""
+}
+
+func decodeElement(d *xml.Decoder, start xml.StartElement) (*element, error) {
+	e := &element{name: start.Name.Local, attrs: make(map[string]string)}
+	for _, a := range start.Attr {
+		e.attrs[a.Name.Local] = a.Value
+	}
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			c, err := decodeElement(d, tok)
+			if err != nil {
+				return nil, err
+			}
+			e.children = append(e.children, c)
+		case xml.CharData:
+			e.children = append(e.children, string(tok))
+		case xml.EndElement:
+			return e, nil
+		}
+	}
+}
+
+func parseXML(r io.Reader) (*element, error) {
+	d := xml.NewDecoder(r)
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			return decodeElement(d, start)
+		}
+	}
+}
+
+type typeDef struct {
+	name     string
+	category string
+	alias    string
+	requires string
+	elem     *element
+}
+
+type enumValue struct {
+	name  string
+	alias string
+	value int64
+}
+
+type enumGroup struct {
+	name     string
+	bitmask  bool
+	bitwidth int
+	values   []*enumValue
+	byName   map[string]*enumValue
+}
+
+func (g *enumGroup) add(v *enumValue) {
+	if g.byName[v.name] != nil {
+		return
+	}
+	g.byName[v.name] = v
+	g.values = append(g.values, v)
+}
+
+type command struct {
+	name   string
+	alias  string
+	proto  *element
+	params []*element
+}
+
+type registry struct {
+	types      map[string]*typeDef
+	groups     map[string]*enumGroup
+	constants  map[string]*element
+	commands   map[string]*command
+	features   []*element
+	extensions []*element
+
+	// the vk_video headers of video.xml by include name, e.g.
+	// "vk_video/vulkan_video_codec_h264std.h"
+	headers map[string]*element
+
+	// bitmask FlagBits -> Flags, the generated Go code merges the two types
+	flagsOf map[string]string
+}
+
+func loadRegistry(path string) (*registry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	root, err := parseXML(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	reg, err := newRegistry(root)
+	if err != nil {
+		return nil, err
+	}
+	// video.xml sits next to vk.xml in the registry since 1.3.238
+	video := filepath.Join(filepath.Dir(path), "video.xml")
+	f, err = os.Open(video)
+	if os.IsNotExist(err) {
+		return reg, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	if root, err = parseXML(f); err != nil {
+		return nil, fmt.Errorf("%s: %v", video, err)
+	}
+	if err := reg.addVideo(root); err != nil {
+		return nil, fmt.Errorf("%s: %v", video, err)
+	}
+	return reg, nil
+}
+
+func newRegistry(root *element) (*registry, error) {
+	reg := &registry{
+		types:     make(map[string]*typeDef),
+		groups:    make(map[string]*enumGroup),
+		constants: make(map[string]*element),
+		commands:  make(map[string]*command),
+		headers:   make(map[string]*element),
+		flagsOf:   make(map[string]string),
+	}
+	for _, e := range root.elems("") {
+		switch e.name {
+		case "types":
+			for _, t := range e.elems("type") {
+				reg.addType(t)
+			}
+		case "enums":
+			if err := reg.addEnums(e); err != nil {
+				return nil, err
+			}
+		case "commands":
+			for _, c := range e.elems("command") {
+				reg.addCommand(c)
+			}
+		case "feature":
+			reg.features = append(reg.features, e)
+		case "extensions":
+			for _, x := range e.elems("extension") {
+				if x.attr("supported") != "disabled" {
+					reg.extensions = append(reg.extensions, x)
+				}
+			}
+		}
+	}
+	for _, t := range reg.types {
+		if t.category == "bitmask" && t.alias == "" {
+			if bits := t.requires; bits != "" {
+				reg.flagsOf[bits] = t.name
+			} else if bits := t.elem.attr("bitvalues"); bits != "" {
+				reg.flagsOf[bits] = t.name
+			}
+		}
+	}
+	for _, f := range reg.features {
+		if err := reg.extendEnums(f, 0); err != nil {
+			return nil, err
+		}
+	}
+	for _, x := range reg.extensions {
+		n, _ := strconv.Atoi(x.attr("number"))
+		if err := reg.extendEnums(x, n); err != nil {
+			return nil, err
+		}
+	}
+	return reg, nil
+}
+
+// addVideo merges video.xml, which defines the StdVideo types vk.xml only
+// names. A type keeps the header vk.xml says it requires, the planner pulls
+// in the whole header.
+func (reg *registry) addVideo(root *element) error {
+	for _, e := range root.elems("") {
+		switch e.name {
+		case "types":
+			for _, t := range e.elems("type") {
+				name := t.attr("name")
+				if name == "" {
+					name = t.childText("name")
+				}
+				old := reg.types[name]
+				if old != nil && !strings.HasPrefix(old.requires, "vk_video/") {
+					continue // stdint.h types
+				}
+				reg.addType(t)
+				if old != nil {
+					reg.types[name].requires = old.requires
+				}
+			}
+		case "enums":
+			if err := reg.addEnums(e); err != nil {
+				return err
+			}
+		case "extensions":
+			for _, x := range e.elems("extension") {
+				reg.headers["vk_video/"+x.attr("name")+".h"] = x
+				// the array sizes of the StdVideo structs
+				for _, c := range x.descendants("enum") {
+					if c.attr("value") != "" {
+						reg.constants[c.attr("name")] = c
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (reg *registry) addType(e *element) {
+	t := &typeDef{
+		name:     e.attr("name"),
+		category: e.attr("category"),
+		alias:    e.attr("alias"),
+		requires: e.attr("requires"),
+		elem:     e,
+	}
+	if t.name == "" {
+		t.name = e.childText("name")
+	}
+	reg.types[t.name] = t
+}
+
+func (reg *registry) addEnums(e *element) error {
+	name := e.attr("name")
+	if name == "API Constants" {
+		for _, c := range e.elems("enum") {
+			reg.constants[c.attr("name")] = c
+		}
+		return nil
+	}
+	g := &enumGroup{
+		name:    name,
+		bitmask: e.attr("type") == "bitmask",
+		byName:  make(map[string]*enumValue),
+	}
+	g.bitwidth, _ = strconv.Atoi(e.attr("bitwidth"))
+	for _, c := range e.elems("enum") {
+		v, err := parseEnumValue(c, 0)
+		if err != nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+		g.add(v)
+	}
+	reg.groups[name] = g
+	return nil
+}
+
+func (reg *registry) addCommand(e *element) {
+	c := &command{alias: e.attr("alias"), proto: e.elem("proto"), params: e.elems("param")}
+	if c.proto != nil {
+		c.name = c.proto.childText("name")
+	} else {
+		c.name = e.attr("name")
+	}
+	reg.commands[c.name] = c
+}
+
+// extendEnums adds the enum values introduced by a feature or an extension
+// to the groups they extend.
+func (reg *registry) extendEnums(e *element, extnumber int) error {
+	for _, req := range e.elems("require") {
+		for _, c := range req.elems("enum") {
+			extends := c.attr("extends")
+			if extends == "" {
+				continue
+			}
+			g := reg.groups[extends]
+			if g == nil {
+				return fmt.Errorf("%s: unknown enum group %s", c.attr("name"), extends)
+			}
+			v, err := parseEnumValue(c, extnumber)
+			if err != nil {
+				return fmt.Errorf("%s: %v", extends, err)
+			}
+			g.add(v)
+		}
+	}
+	return nil
+}
+
+func parseEnumValue(e *element, extnumber int) (v *enumValue, err error) {
+	v = &enumValue{name: e.attr("name"), alias: e.attr("alias")}
+	switch {
+	case v.alias != "":
+	case e.attr("bitpos") != "":
+		var pos uint64
+		pos, err = strconv.ParseUint(e.attr("bitpos"), 10, 6)
+		v.value = 1 << pos
+	case e.attr("offset") != "":
+		var off int64
+		off, err = strconv.ParseInt(e.attr("offset"), 10, 64)
+		if n := e.attr("extnumber"); n != "" {
+			extnumber, err = strconv.Atoi(n)
+		}
+		v.value = 1000000000 + int64(extnumber-1)*1000 + off
+		if e.attr("dir") == "-" {
+			v.value = -v.value
+		}
+	default:
+		v.value, err = strconv.ParseInt(e.attr("value"), 0, 64)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", v.name, err)
+	}
+	return v, nil
+}
+
+var (
+	reSnakeCase = regexp.MustCompile(`([0-9]+|[a-z_])([A-Z0-9])`)
+	reSuffix    = regexp.MustCompile(`[A-Z][A-Z]+$`)
+)
+
+// maxEnumName returns the name of the 0x7FFFFFFF sentinel the C header
+// appends to every enum, e.g. PRESENT_MODE_MAX_ENUM_KHR.
+func maxEnumName(group string) string {
+	name := strings.ToUpper(reSnakeCase.ReplaceAllString(group, "${1}_${2}"))
+	prefix, suffix := name, ""
+	if m := reSuffix.FindString(group); m != "" {
+		suffix = "_" + m
+		prefix = name[:strings.LastIndex(name, suffix)]
+	}
+	return strings.TrimPrefix(prefix, "VK_") + "_MAX_ENUM" + suffix
+}
@@ -0,0 +1,838 @@
+package main
+
+import (
+	"fmt"
+	"go/token"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const manURL = "https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/"
+
+var scalarTypes = map[string]string{
+	"char":     "int8",
+	"int":      "int32",
+	"float":    "float32",
+	"double":   "float64",
+	"size_t":   "uintptr",
+	"uint8_t":  "uint8",
+	"uint16_t": "uint16",
+	"uint32_t": "uint32",
+	"uint64_t": "uint64",
+	"int8_t":   "int8",
+	"int16_t":  "int16",
+	"int32_t":  "int32",
+	"int64_t":  "int64",
+}
+
+type chunkKind int
+
+const (
+	kindBlock chunkKind = iota
+	kindConst
+	kindType
+	kindReserved
+)
+
+// renderer turns the planned blocks into Go source. The chunks are joined
+// with the same blank lines the handwritten generator used to produce.
+type renderer struct {
+	reg     *registry
+	out     *output
+	body    strings.Builder
+	last    chunkKind
+	bridges []string
+	err     error
+	vars    map[string]bool // the string constants, Go has them as vars
+
+	layouts []layout
+	// the structs padded on linux/arm, as declared on the other targets
+	// and on arm
+	structs, armStructs []string
+	recorded            map[string]bool
+	wrappers            []wrapper
+
+	destroyable map[string]bool // handle types some command destroys
+}
+
+func (r *renderer) fail(format string, args ...interface{}) {
+	if r.err == nil {
+		r.err = fmt.Errorf(format, args...)
+	}
+}
+
+func (r *renderer) put(kind chunkKind, text string) {
+	single := kind != kindBlock && (r.last == kind || r.last == kindReserved && kind == kindType)
+	if r.body.Len() == 0 || !(r.last == kindReserved || single) {
+		r.body.WriteString("\n")
+	}
+	r.body.WriteString(text)
+	r.body.WriteString("\n")
+	r.last = kind
+}
+
+func (r *renderer) render(b *block) {
+	r.put(kindConst, fmt.Sprintf("const %s = 1", trimVK(b.name)))
+	for sec, items := range b.sections {
+		for _, it := range items {
+			switch section(sec) {
+			case secEnum:
+				r.constant(it.elem)
+			case secCommand:
+				r.command(it.name)
+			default:
+				r.typ(r.reg.types[it.name])
+			}
+		}
+	}
+}
+
+func (r *renderer) constant(e *element) {
+	name, v := trimVK(e.attr("name")), e.attr("value")
+	switch alias := trimVK(e.attr("alias")); {
+	case alias != "" && r.vars[alias]:
+		r.vars[name] = true
+		r.put(kindBlock, fmt.Sprintf("var %s = %s", name, alias))
+		return
+	case alias != "":
+		r.put(kindConst, fmt.Sprintf("const %s = %s", name, alias))
+		return
+	case strings.HasPrefix(v, `"`):
+		r.vars[name] = true
+		r.put(kindBlock, fmt.Sprintf("var %s = %s", name, v))
+		return
+	case r.reg.types[v] != nil:
+		// the vk_video spec versions, VK_MAKE_VIDEO_STD_VERSION(1, 0, 0)
+		var major, minor, patch int
+		text := r.reg.types[v].elem.text()
+		if _, err := fmt.Sscanf(text[strings.Index(text, "(")+1:], "%d, %d, %d)", &major, &minor, &patch); err != nil {
+			r.fail("%s: bad value %s", name, v)
+			return
+		}
+		v = fmt.Sprintf("%d<<22 | %d<<12 | %d", major, minor, patch)
+	case strings.HasPrefix(v, "(~"):
+		var n uint64
+		if _, err := fmt.Sscanf(v, "(~%dU", &n); err != nil {
+			r.fail("%s: bad value %s", name, v)
+			return
+		}
+		switch {
+		case n == 0:
+			// ~0U and ~0ULL are handwritten in vulkan-core-1.go
+			return
+		case strings.HasSuffix(v, "ULL)"):
+			v = fmt.Sprintf("uint64(0x%016X)", ^n)
+		default:
+			v = fmt.Sprintf("uint32(0x%08X)", ^uint32(n))
+		}
+	default:
+		v = strings.TrimRight(v, "fFLU")
+	}
+	r.put(kindConst, fmt.Sprintf("const %s = %s", name, v))
+}
+
+func (r *renderer) typ(t *typeDef) {
+	if t == nil {
+		return
+	}
+	if t.alias != "" {
+		if t.category == "enum" && strings.Contains(t.name, "FlagBits") {
+			return
+		}
+		if t.category == "bitmask" && r.reserved(r.reg.types[t.alias]) {
+			return
+		}
+		r.put(kindType, fmt.Sprintf("type %s = %s", r.goName(t.name), r.goName(t.alias)))
+		return
+	}
+	switch t.category {
+	case "define":
+		// only the plain numbers, the macros are handwritten
+		if t.name == "VK_HEADER_VERSION" {
+			v := strings.TrimSpace(t.elem.text())
+			v = v[strings.LastIndex(v, " ")+1:]
+			r.put(kindConst, fmt.Sprintf("const %s = %s", trimVK(t.name), v))
+		}
+		return
+	case "basetype":
+		d := parseDecl(strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(t.elem.text()), "typedef"), ";"))
+		switch s, ok := scalarTypes[d.typ]; {
+		case d.typ == "void" && d.ptr == 1:
+			r.put(kindType, fmt.Sprintf("type %s = unsafe.Pointer", r.goName(t.name)))
+		case ok && d.ptr == 0 && !strings.HasPrefix(t.name, "VkFlags"):
+			r.put(kindType, fmt.Sprintf("type %s = %s", r.goName(t.name), s))
+		}
+		return
+	}
+	name := r.goName(t.name)
+	switch t.category {
+	case "handle":
+		kind := "NonDispatchableHandle"
+		if t.elem.childText("type") == "VK_DEFINE_HANDLE" {
+			kind = "DispatchableHandle"
+		}
+		r.put(kindBlock, fmt.Sprintf("// %s -- %s%s.html\ntype %s %s\n\n"+
+			"func (x %s) ObjectType() ObjectType { return %s }\nfunc (x %s) Uint64() uint64 { return uint64(x) }",
+			name, manURL, t.name, name, kind, name, trimVK(t.elem.attr("objtypeenum")), name))
+	case "enum":
+		if g := r.reg.groups[t.name]; g != nil && g.bitwidth != 64 {
+			r.group(g)
+		}
+	case "bitmask":
+		switch {
+		case r.flags64(t.name):
+			r.put(kindType, fmt.Sprintf("type %s = Flags64", name))
+		case r.reserved(t):
+			r.put(kindReserved, fmt.Sprintf("type %s uint32 // reserved", name))
+		}
+	case "funcpointer":
+		if t.name != "PFN_vkVoidFunction" {
+			r.funcpointer(t)
+		}
+	case "struct", "union":
+		r.structure(t)
+	}
+}
+
+// reserved reports whether the bitmask t has no bits defined yet.
+func (r *renderer) reserved(t *typeDef) bool {
+	if t == nil {
+		return false
+	}
+	bits := t.requires
+	if bits == "" {
+		bits = t.elem.attr("bitvalues")
+	}
+	return r.reg.groups[bits] == nil
+}
+
+func (r *renderer) group(g *enumGroup) {
+	name, cname := r.goName(g.name), g.name
+	if g.bitmask {
+		cname = "Vk" + name
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "// %s -- %s%s.html\n", name, manURL, cname)
+	if g.bitmask {
+		fmt.Fprintf(&sb, "type %s uint32\n\nconst (\n", name)
+	} else {
+		fmt.Fprintf(&sb, "type %s int32\n\nconst (\n", name)
+	}
+	var cases []string
+	max, invalid := maxEnumName(g.name), false
+	for _, alias := range []bool{false, true} {
+		for _, v := range g.values {
+			if (v.alias != "") != alias {
+				continue
+			}
+			switch {
+			case alias:
+				fmt.Fprintf(&sb, "\t%s %s = %s\n", trimVK(v.name), name, trimVK(v.alias))
+			case !g.bitmask:
+				fmt.Fprintf(&sb, "\t%s %s = %d\n", trimVK(v.name), name, v.value)
+			case v.value == 0:
+				fmt.Fprintf(&sb, "\t%s %s = 0\n", trimVK(v.name), name)
+			default:
+				fmt.Fprintf(&sb, "\t%s %s = 0x%08X\n", trimVK(v.name), name, v.value)
+			}
+			if !alias {
+				cases = append(cases, trimVK(v.name))
+				invalid = invalid || v.value == 0x7FFFFFFF
+			}
+		}
+	}
+	for _, v := range g.values {
+		for _, old := range legacyAliases(trimVK(v.name)) {
+			fmt.Fprintf(&sb, "\t// Deprecated: Use %s instead.\n\t%s %s = %s\n", trimVK(v.name), old, name, trimVK(v.name))
+		}
+	}
+	fmt.Fprintf(&sb, "\t%s %s = 0x7FFFFFFF\n)\n\n", max, name)
+	if !invalid { // the StdVideo enums end with an INVALID of the same value
+		cases = append(cases, max)
+	}
+	fmt.Fprintf(&sb, "func (x %s) String() string {\n", name)
+	if g.bitmask {
+		fmt.Fprintf(&sb, "\tvar s string\n\tfor i := uint32(0); i < 32; i++ {\n\t\tif int32(x)&(1<<i) != 0 {\n\t\t\tswitch %s(1 << i) {\n", name)
+		for _, c := range cases {
+			fmt.Fprintf(&sb, "\t\t\tcase %s:\n\t\t\t\ts += \"%s|\"\n", c, c)
+		}
+		sb.WriteString("\t\t\t}\n\t\t}\n\t}\n\treturn strings.TrimSuffix(s, `|`)\n}")
+	} else {
+		sb.WriteString("\tswitch x {\n")
+		for _, c := range cases {
+			fmt.Fprintf(&sb, "\tcase %s:\n\t\treturn \"%s\"\n", c, c)
+		}
+		sb.WriteString("\tdefault:\n\t\treturn fmt.Sprint(int32(x))\n\t}\n}")
+	}
+	r.put(kindBlock, sb.String())
+}
+
+func (r *renderer) structure(t *typeDef) {
+	name := r.goName(t.name)
+	members := t.elem.elems("member")
+	var fields []string
+	var decls, fieldDecls []cDecl
+	var stype string
+	var bits []bitField
+	for _, m := range members {
+		d := parseDecl(m.text())
+		if d.bits > 0 {
+			follows := len(decls) > 0 && decls[len(decls)-1].bits > 0
+			if fields, bits = r.bitField(fields, bits, d, follows); len(fields) > len(fieldDecls) {
+				fieldDecls = append(fieldDecls, cDecl{typ: "uint32_t"})
+			}
+			decls = append(decls, d)
+			continue
+		}
+		decls = append(decls, d)
+		fieldDecls = append(fieldDecls, d)
+		typ := r.goType(d, false)
+		if d.typ == "uint32_t" && d.ptr == 0 && len(d.array) == 0 && strings.HasSuffix(d.name, "Version") {
+			typ = "Version"
+		}
+		if d.name == "sType" && m.attr("values") != "" {
+			stype = trimVK(m.attr("values"))
+		}
+		fields = append(fields, fmt.Sprintf("\t%s %s\n", exported(d.name), typ))
+	}
+	var sb strings.Builder
+	decl := func(fields []string) string {
+		return fmt.Sprintf("// %s -- %s%s.html\ntype %s struct {\n%s}\n", name, manURL, t.name, name, strings.Join(fields, ""))
+	}
+	var padded []string
+	if t.category == "union" {
+		fields = r.unionFields(decls)
+	} else if r.out.arm {
+		padded = r.padFields(fields, fieldDecls)
+	}
+	if padded != nil {
+		r.structs = append(r.structs, decl(fields))
+		r.armStructs = append(r.armStructs, decl(padded))
+	} else {
+		sb.WriteString(decl(fields) + "\n")
+	}
+	alloc := fmt.Sprintf("(*%s)(MemAlloc(unsafe.Sizeof(*(*%s)(nil))))", name, name)
+	switch short := fmt.Sprintf("func New%s() *%s { return %s }", name, name, alloc); {
+	case stype != "":
+		fmt.Fprintf(&sb, "func New%s() *%s {\n\tp := %s\n\tp.SType = %s\n\treturn p\n}\n", name, name, alloc, stype)
+	case len(short) <= 103:
+		sb.WriteString(short + "\n")
+	default:
+		fmt.Fprintf(&sb, "func New%s() *%s {\n\treturn %s\n}\n", name, name, alloc)
+	}
+	fmt.Fprintf(&sb, "func (p *%s) Free() { MemFree(unsafe.Pointer(p)) }", name)
+	if t.category == "union" {
+		r.unionAccessors(&sb, name, decls)
+	}
+	r.bitAccessors(&sb, name, bits)
+	r.put(kindBlock, sb.String())
+	r.record(t, decls)
+}
+
+// padFields returns the fields with the padding of armPads, nil if the
+// struct needs none.
+func (r *renderer) padFields(fields []string, decls []cDecl) []string {
+	pads, tail := r.armPads(decls)
+	var padded []string
+	for i, f := range fields {
+		if pads[i] > 0 {
+			padded = append(padded, fmt.Sprintf("\t_ [%d]byte\n", pads[i]))
+		}
+		padded = append(padded, f)
+	}
+	if tail > 0 {
+		padded = append(padded, fmt.Sprintf("\t_ [%d]byte\n", tail))
+	}
+	if len(padded) == len(fields) {
+		return nil
+	}
+	return padded
+}
+
+// bitField is a C bit field, stored in the uint32 unit field at shift.
+type bitField struct {
+	cDecl
+	unit  string
+	shift int
+}
+
+// bitField adds the bit field d to the struct fields. A bit field that
+// follows another shares its uint32 unit if it fits, the units fill from
+// the low bits up as the C compilers of the Vulkan targets do.
+func (r *renderer) bitField(fields []string, bits []bitField, d cDecl, follows bool) ([]string, []bitField) {
+	if size, _ := r.sizeof(cDecl{typ: d.typ}); size != 4 || d.bits > 32 {
+		r.fail("%s: bit field of %s", d.name, d.typ)
+		return fields, bits
+	}
+	f := bitField{cDecl: d}
+	if n := len(bits); follows {
+		if f.shift = bits[n-1].shift + bits[n-1].bits; f.shift+d.bits <= 32 {
+			f.unit = bits[n-1].unit
+			last := len(fields) - 1
+			fields[last] = fmt.Sprintf("%s %s:%d\n", strings.TrimSuffix(fields[last], "\n"), d.name, d.bits)
+			return fields, append(bits, f)
+		}
+	}
+	units := 0
+	for i := range bits {
+		if i == 0 || bits[i].unit != bits[i-1].unit {
+			units++
+		}
+	}
+	f.unit, f.shift = fmt.Sprintf("bitfield%d", units), 0
+	fields = append(fields, fmt.Sprintf("\t%s uint32 // %s:%d\n", f.unit, d.name, d.bits))
+	return fields, append(bits, f)
+}
+
+// bitAccessors writes a getter and a setter per bit field, the setter
+// leaves the other bits of the unit alone. The getters take the struct by
+// value and the 8-bit fields are uint8, as the handwritten accessors of
+// AccelerationStructureInstanceKHR were.
+func (r *renderer) bitAccessors(sb *strings.Builder, name string, bits []bitField) {
+	for _, f := range bits {
+		method := exported(f.name)
+		typ := r.goType(f.cDecl, false)
+		if f.bits == 8 {
+			typ = "uint8"
+		}
+		mask := uint64(1)<<uint(f.bits) - 1
+		get := fmt.Sprintf("p.%s & 0x%X", f.unit, mask)
+		set := fmt.Sprintf("x & 0x%X", mask)
+		if f.shift > 0 {
+			get = fmt.Sprintf("p.%s >> %d & 0x%X", f.unit, f.shift, mask)
+			set += fmt.Sprintf(" << %d", f.shift)
+		}
+		if typ != "uint32" {
+			get = fmt.Sprintf("%s(%s)", typ, get)
+			set = "uint32(x)" + strings.TrimPrefix(set, "x")
+		}
+		line := fmt.Sprintf("func (p %s) %s() %s { return %s }", name, method, typ, get)
+		if len(line) > 103 {
+			line = fmt.Sprintf("func (p %s) %s() %s {\n\treturn %s\n}", name, method, typ, get)
+		}
+		fmt.Fprintf(sb, "\n\n%s\n", line)
+		fmt.Fprintf(sb, "func (p *%s) Set%s(x %s) {\n\tp.%s = p.%s&^0x%X | %s\n}",
+			name, method, typ, f.unit, f.unit, mask<<uint(f.shift), set)
+	}
+}
+
+// unionFields lays a union out as bytes, as many as its largest member
+// and aligned as its most aligned one. The members are read and written
+// through the accessors.
+func (r *renderer) unionFields(members []cDecl) []string {
+	var large, aligned cDecl
+	var maxSize, maxSize32, maxAlign int
+	for _, d := range members {
+		size, align := r.sizeof(d)
+		// of the members as large, the one that is on 32-bit targets as well
+		size32, _ := r.sizeofOn(target386, d)
+		if size > maxSize || size == maxSize && size32 > maxSize32 {
+			large, maxSize, maxSize32 = d, size, size32
+		}
+		if align > maxAlign {
+			aligned, maxAlign = d, align
+		}
+	}
+	elem := aligned
+	elem.array = nil
+	size, armSize := fmt.Sprint(maxSize), maxSize
+	switch {
+	case large.ptr > 0:
+		size = "unsafe.Sizeof(uintptr(0))"
+		armSize, _ = r.sizeofOn(targetARM, large)
+	case len(large.array) == 0 && (r.category(large.typ) == "struct" || r.category(large.typ) == "union"):
+		size = fmt.Sprintf("unsafe.Sizeof(%s{})", r.goName(large.typ))
+		armSize, _ = r.sizeofOn(targetARM, large)
+	}
+	if r.out.arm {
+		// unions are not padded, the bytes must come out right as they are
+		cSize, cAlign := 0, 1
+		for _, d := range members {
+			n, a := r.sizeofOn(targetARM, d)
+			if n > cSize {
+				cSize = n
+			}
+			if a > cAlign {
+				cAlign = a
+			}
+		}
+		if roundUp(armSize, goAlign(cAlign)) != roundUp(cSize, cAlign) {
+			r.fail("%s: union of %d bytes on linux/arm, C has %d", large.name, armSize, cSize)
+		}
+	}
+	return []string{
+		fmt.Sprintf("\t_    [0]%s\n", r.goType(elem, false)),
+		fmt.Sprintf("\tdata [%s]byte\n", size),
+	}
+}
+
+// unionAccessors writes a getter and a setter per union member, the setter
+// clears the bytes the member does not cover.
+func (r *renderer) unionAccessors(sb *strings.Builder, name string, members []cDecl) {
+	for _, d := range members {
+		method := exported(d.name)
+		typ := r.goType(d, false)
+		get := fmt.Sprintf("func (p *%s) %s() %s { return *(*%s)(unsafe.Pointer(p)) }", name, method, typ, typ)
+		if len(get) > 103 {
+			get = fmt.Sprintf("func (p *%s) %s() %s {\n\treturn *(*%s)(unsafe.Pointer(p))\n}", name, method, typ, typ)
+		}
+		fmt.Fprintf(sb, "\n\n%s\n", get)
+		if d.ptr > 0 {
+			fmt.Fprintf(sb, "// Set%s stores the address only, the memory must be kept alive.\n", method)
+		}
+		fmt.Fprintf(sb, "func (p *%s) Set%s(x %s) {\n\t*p = %s{}\n\t*(*%s)(unsafe.Pointer(p)) = x\n}", name, method, typ, name, typ)
+	}
+}
+
+var reFuncpointer = regexp.MustCompile(`(?s)^\s*typedef\s+(.*?)\s*\(VKAPI_PTR\s*\*\s*(\w+)\)\s*\((.*)\)\s*;\s*$`)
+
+func (r *renderer) funcpointer(t *typeDef) {
+	m := reFuncpointer.FindStringSubmatch(t.elem.text())
+	if m == nil {
+		r.fail("%s: bad funcpointer", t.name)
+		return
+	}
+	var params []cDecl
+	if p := strings.TrimSpace(m[3]); p != "void" {
+		for _, s := range strings.Split(p, ",") {
+			params = append(params, parseDecl(s))
+		}
+	}
+	r.pfn(strings.TrimPrefix(t.name, "PFN_"), parseDecl(m[1]+" _"), params, "")
+}
+
+func (r *renderer) command(name string) {
+	c := r.reg.commands[name]
+	if c.alias != "" {
+		c = r.reg.commands[c.alias]
+		if c == nil {
+			r.fail("%s: unknown alias", name)
+			return
+		}
+	}
+	var params []cDecl
+	for _, p := range c.params {
+		params = append(params, parseDecl(p.text()))
+	}
+	ret, ps := parseDecl(c.proto.text()), r.params(c)
+	r.pfn(name, ret, params, tracking(name, ret, ps, r.trackedType))
+	r.wrap(name, ret, ps)
+}
+
+// pfn writes the function pointer type of the C function cname, commands
+// and funcpointers are called the same way. track follows the call.
+func (r *renderer) pfn(cname string, ret cDecl, params []cDecl, track string) {
+	name := "Pfn" + strings.TrimPrefix(cname, "vk")
+	retType := r.goType(ret, false)
+
+	var sig []string
+	var args, args32 []string
+	var cparams, cargs []string
+	for i, p := range params {
+		pname := p.name
+		if token.Lookup(pname).IsKeyword() {
+			pname += "_"
+		}
+		typ := r.goType(p, true)
+		if i > 0 && typ == r.goType(params[i-1], true) {
+			sig[len(sig)-1] = strings.TrimSuffix(sig[len(sig)-1], " "+typ) + ", " + pname + " " + typ
+		} else {
+			sig = append(sig, pname+" "+typ)
+		}
+		if r.out.cgo {
+			args = append(args, r.cgoArg(p, pname))
+		} else {
+			args = append(args, r.syscallArg(p, pname))
+			args32 = append(args32, r.syscallArg(p, pname))
+			if r.wide(p) {
+				args32 = append(args32, fmt.Sprintf("uintptr(%s >> 32)", pname))
+			}
+		}
+		if r.nonDispatchable(p) {
+			// a pointer on 64-bit targets, the bridge takes it as an integer
+			// so that no bits are lost on the 32-bit ones
+			cparams = append(cparams, "uint64_t "+p.name)
+			cargs = append(cargs, "("+p.typ+")"+p.name)
+			continue
+		}
+		cparams = append(cparams, p.text)
+		cargs = append(cargs, p.name)
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "// %s -- %s%s.html\ntype %s uintptr\n\n", name, manURL, cname, name)
+	fmt.Fprintf(&sb, "func (fn %s) Call(%s) %s{\n", name, strings.Join(sig, ", "), spaced(retType))
+	if r.out.cgo {
+		call := fmt.Sprintf("C.bridge_%s(%s)", cname, strings.Join(append([]string{"C.uintptr_t(fn)"}, args...), ", "))
+		if retType == "" {
+			fmt.Fprintf(&sb, "\t%s\n\tdebugCheckAndBreak()\n%s\treturn\n}\n", call, track)
+		} else {
+			fmt.Fprintf(&sb, "\tret := %s\n\tdebugCheckAndBreak()\n%s\treturn %s\n}\n", call, track, r.cgoRet(ret, retType))
+		}
+		r.bridges = append(r.bridges, fmt.Sprintf("%s bridge_%s(%s){\n  return ((PFN_%s)fp)(%s);\n}",
+			ret.ctype(), cname, strings.Join(append([]string{"uintptr_t fp"}, cparams...), ","), cname, strings.Join(cargs, ",")))
+	} else {
+		call := fmt.Sprintf("call(%s)", strings.Join(append([]string{"uintptr(fn)"}, args...), ", "))
+		results, vars := "_, _, _", ""
+		switch {
+		case retType == "":
+		case r.wide(ret):
+			results, vars = "ret, ret2, _", "ret, ret2"
+		default:
+			results, vars = "ret, _, _", "ret"
+		}
+		switch {
+		case len(args32) != len(args):
+			// 64-bit integers take two words on windows/386
+			call32 := fmt.Sprintf("call(%s)", strings.Join(append([]string{"uintptr(fn)"}, args32...), ", "))
+			if vars != "" {
+				fmt.Fprintf(&sb, "\tvar %s uintptr\n", vars)
+			}
+			fmt.Fprintf(&sb, "\tif is32bit {\n\t\t%s = %s\n\t} else {\n\t\t%s = %s\n\t}\n", results, call32, results, call)
+		case vars == "":
+			fmt.Fprintf(&sb, "\t%s = %s\n", results, call)
+		default:
+			fmt.Fprintf(&sb, "\t%s := %s\n", results, call)
+		}
+		sb.WriteString("\tdebugCheckAndBreak()\n" + track)
+		if retType != "" {
+			fmt.Fprintf(&sb, "\treturn %s\n", r.syscallRet(ret, retType))
+		}
+		sb.WriteString("}\n")
+	}
+	str := fmt.Sprintf("func (fn %s) String() string { return \"%s\" }", name, cname)
+	if len(str) > 103 {
+		str = fmt.Sprintf("func (fn %s) String() string {\n\treturn \"%s\"\n}", name, cname)
+	}
+	sb.WriteString(str)
+	r.put(kindBlock, sb.String())
+}
+
+func spaced(s string) string {
+	if s == "" {
+		return ""
+	}
+	return s + " "
+}
+
+func (r *renderer) cgoRet(ret cDecl, typ string) string {
+	switch {
+	case ret.typ == "PFN_vkVoidFunction":
+		return "PfnVoidFunction(unsafe.Pointer(ret))"
+	case ret.typ == "void":
+		return "unsafe.Pointer(ret)"
+	}
+	return typ + "(ret)"
+}
+
+func (r *renderer) syscallRet(ret cDecl, typ string) string {
+	switch {
+	case ret.typ == "PFN_vkVoidFunction":
+		return "PfnVoidFunction(ret)"
+	case ret.typ == "void":
+		return "unsafe.Pointer(ret)"
+	case r.wide(ret) && typ == "uint64":
+		return "ret64(ret, ret2)"
+	case r.wide(ret):
+		return typ + "(ret64(ret, ret2))"
+	}
+	return typ + "(ret)"
+}
+
+func (r *renderer) cgoArg(d cDecl, name string) string {
+	ctype := d.typ
+	switch {
+	case len(d.array) > 0:
+		return fmt.Sprintf("(*C.%s)(unsafe.Pointer(%s))", ctype, name)
+	case d.typ == "void" && d.ptr == 1:
+		return fmt.Sprintf("(unsafe.Pointer)(%s)", name)
+	case d.typ == "void":
+		return fmt.Sprintf("(%sunsafe.Pointer)(unsafe.Pointer(%s))", strings.Repeat("*", d.ptr-1), name)
+	case d.ptr > 0:
+		return fmt.Sprintf("(%sC.%s)(unsafe.Pointer(%s))", strings.Repeat("*", d.ptr), ctype, name)
+	}
+	switch r.category(d.typ) {
+	case "handle":
+		if r.nonDispatchable(d) {
+			return fmt.Sprintf("C.uint64_t(%s)", name)
+		}
+		return fmt.Sprintf("(C.%s)(unsafe.Pointer(uintptr(%s)))", ctype, name)
+	case "bitmask":
+		if !r.flags64(d.typ) {
+			return fmt.Sprintf("(C.%s)(uint32(%s))", ctype, name)
+		}
+	}
+	return fmt.Sprintf("(C.%s)(%s)", ctype, name)
+}
+
+func (r *renderer) syscallArg(d cDecl, name string) string {
+	if d.ptr > 0 && d.typ != "void" || d.ptr > 1 || len(d.array) > 0 {
+		return fmt.Sprintf("uintptr(unsafe.Pointer(%s))", name)
+	}
+	return fmt.Sprintf("uintptr(%s)", name)
+}
+
+// nonDispatchable reports whether d is a non-dispatchable handle passed by
+// value. Those are pointers on 64-bit targets and uint64_t on 32-bit ones.
+func (r *renderer) nonDispatchable(d cDecl) bool {
+	if d.ptr > 0 || len(d.array) > 0 {
+		return false
+	}
+	t := r.reg.types[d.typ]
+	for t != nil && t.alias != "" {
+		t = r.reg.types[t.alias]
+	}
+	return t != nil && t.category == "handle" && t.elem.childText("type") == "VK_DEFINE_NON_DISPATCHABLE_HANDLE"
+}
+
+// wide reports whether d is a 64-bit integer passed by value on every
+// target, it does not fit a uintptr on the 32-bit ones.
+func (r *renderer) wide(d cDecl) bool {
+	if d.ptr > 0 || len(d.array) > 0 {
+		return false
+	}
+	for ctype := d.typ; ; {
+		if ctype == "uint64_t" || ctype == "int64_t" {
+			return true
+		}
+		t := r.reg.types[ctype]
+		switch {
+		case t == nil:
+			return false
+		case t.alias != "":
+			ctype = t.alias
+		case t.category == "basetype":
+			ctype = t.elem.childText("type")
+		case t.category == "bitmask":
+			return r.flags64(ctype)
+		case t.category == "handle":
+			return r.nonDispatchable(cDecl{typ: ctype})
+		default:
+			return false
+		}
+	}
+}
+
+// flags64 reports whether the bitmask ctype is a VkFlags64, those must not
+// go through uint32.
+func (r *renderer) flags64(ctype string) bool {
+	t := r.reg.types[ctype]
+	for t != nil && t.alias != "" {
+		t = r.reg.types[t.alias]
+	}
+	return t != nil && t.elem.childText("type") == "VkFlags64"
+}
+
+func (r *renderer) category(ctype string) string {
+	if t := r.reg.types[ctype]; t != nil {
+		return t.category
+	}
+	return ""
+}
+
+// goName maps a C type name to the Go one, FlagBits are merged into Flags.
+func (r *renderer) goName(cname string) string {
+	if s, ok := scalarTypes[cname]; ok {
+		return s
+	}
+	if s, ok := r.out.types[cname]; ok {
+		return s
+	}
+	if g := r.reg.groups[cname]; g != nil && g.bitmask {
+		if flags, ok := r.reg.flagsOf[cname]; ok {
+			cname = flags
+		} else {
+			cname = strings.Replace(cname, "FlagBits", "Flags", 1)
+		}
+	}
+	switch {
+	case strings.HasPrefix(cname, "PFN_vk"):
+		return "Pfn" + cname[len("PFN_vk"):]
+	case strings.HasPrefix(cname, "Vk"):
+		return cname[len("Vk"):]
+	case strings.HasPrefix(cname, "VK_"):
+		return cname[len("VK_"):]
+	case strings.HasPrefix(cname, "StdVideo"):
+		return cname
+	}
+	r.fail("%s: unknown type", cname)
+	return cname
+}
+
+func (r *renderer) goType(d cDecl, param bool) string {
+	var s string
+	switch {
+	case d.typ == "void" && d.ptr == 0:
+		return ""
+	case d.typ == "void":
+		s = strings.Repeat("*", d.ptr-1) + "unsafe.Pointer"
+	default:
+		s = strings.Repeat("*", d.ptr) + r.goName(d.typ)
+	}
+	if len(d.array) > 0 {
+		var dims string
+		for _, n := range d.array {
+			dims += "[" + trimVK(n) + "]"
+		}
+		s = dims + s
+		if param {
+			s = "*" + s
+		}
+	}
+	return s
+}
+
+func trimVK(s string) string { return strings.TrimPrefix(s, "VK_") }
+
+// exported returns the Go name of a struct member, the StdVideo ones are
+// snake case, e.g. pic_order_cnt_type is PicOrderCntType.
+func exported(cname string) string {
+	var sb strings.Builder
+	for _, w := range strings.Split(cname, "_") {
+		if w != "" {
+			sb.WriteString(strings.ToUpper(w[:1]) + w[1:])
+		}
+	}
+	return sb.String()
+}
+
+// cDecl is a parsed C declaration, e.g. "const char* const* ppNames".
+type cDecl struct {
+	text  string
+	typ   string
+	name  string
+	ptr   int
+	array []string
+	bits  int // bit field width, 0 if not a bit field
+}
+
+func (d cDecl) ctype() string {
+	return strings.TrimSpace(strings.TrimSuffix(d.text, d.name))
+}
+
+var (
+	reDecl  = regexp.MustCompile(`^(.*?)(\w+)\s*((?:\[[^\]]*\]\s*)*)(?::\s*(\d+))?$`)
+	reIdent = regexp.MustCompile(`\w+`)
+	reDim   = regexp.MustCompile(`\[\s*([^\]]*?)\s*\]`)
+)
+
+func parseDecl(s string) cDecl {
+	d := cDecl{text: strings.Join(strings.Fields(s), " ")}
+	m := reDecl.FindStringSubmatch(d.text)
+	if m == nil {
+		return d
+	}
+	d.name = m[2]
+	d.ptr = strings.Count(m[1], "*")
+	for _, w := range reIdent.FindAllString(m[1], -1) {
+		if w != "const" && w != "struct" {
+			d.typ = w
+		}
+	}
+	for _, dim := range reDim.FindAllStringSubmatch(m[3], -1) {
+		d.array = append(d.array, dim[1])
+	}
+	d.bits, _ = strconv.Atoi(m[4])
+	return d
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The Call methods of the commands creating or destroying objects tell the
+// object tracking of package vk about them when it is on, see track.go
+// there. The commands are told apart by their names, the Acquire and
+// Release ones only when followed by the handle type, as in
+// vkReleasePerformanceConfigurationINTEL.
+var (
+	createVerbs  = []string{"Create", "Allocate", "Register", "Acquire"}
+	destroyVerbs = []string{"Destroy", "Free", "Release"}
+
+	// the ObjectType of the name info parameter of the commands naming
+	// objects, and the member holding the handle
+	namingCommands = map[string][2]string{
+		"vkSetDebugUtilsObjectNameEXT":  {"%s.ObjectType", "ObjectHandle"},
+		"vkDebugMarkerSetObjectNameEXT": {"objectTypeOf(%s.ObjectType)", "Object"},
+	}
+)
+
+func hasVerb(cname string, verbs []string, p wrapperParam) bool {
+	for _, v := range verbs {
+		switch {
+		case !strings.HasPrefix(cname, "vk"+v):
+		case v == "Acquire" || v == "Release":
+			return cname == "vk"+v+strings.TrimPrefix(p.decl.typ, "Vk")
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// destroyed returns the index of the parameter holding the objects the
+// command cname destroys, -1 if it destroys none. That is the array of
+// handles, or the last handle.
+func destroyed(cname string, ps []wrapperParam) int {
+	k := -1
+	for i, p := range ps {
+		switch {
+		case !p.handle || len(p.decl.array) > 0:
+		case p.decl.ptr == 0, p.decl.ptr == 1 && isConst(p) && p.len != "":
+			k = i
+		}
+	}
+	if k < 0 || !hasVerb(cname, destroyVerbs, ps[k]) {
+		return -1
+	}
+	return k
+}
+
+// tracking returns the statements recording the objects created, destroyed
+// or named by the command cname, empty if it does none of that.
+// trackedType returns the ObjectType constant of the handle types whose
+// objects are tracked, and is empty for the others. The dispatchable
+// handles other commands return are recorded too, but not reported, they
+// are the parents of the objects created from them.
+func tracking(cname string, ret cDecl, ps []wrapperParam, trackedType func(ctype string) string) string {
+	res := "SUCCESS"
+	if ret.typ == "VkResult" {
+		res = "Result(ret)"
+	}
+	// the objects are tracked by the handle they are created from, the
+	// instance or the device destroying them
+	parent := "0"
+	if len(ps) > 0 && receivers[ps[0].typ].name != "" {
+		parent = fmt.Sprintf("uint64(%s)", ps[0].name)
+	}
+	var stmt string
+	switch i := destroyed(cname, ps); {
+	case i >= 0:
+		p := ps[i]
+		t := trackedType(p.decl.typ)
+		if t == "" {
+			return ""
+		}
+		if i == 0 {
+			parent = "0" // a dispatchable handle destroying itself
+		}
+		if p.decl.ptr == 0 {
+			stmt = fmt.Sprintf("trackDestroyed(%s, %s, unsafe.Pointer(&%s), 1, unsafe.Sizeof(%s))", t, parent, p.name, p.name)
+			break
+		}
+		n := trackCount(p, ps)
+		if n == "" {
+			return ""
+		}
+		stmt = fmt.Sprintf("trackDestroyed(%s, %s, unsafe.Pointer(%s), %s, unsafe.Sizeof(*%s))", t, parent, p.name, n, p.name)
+	case namingCommands[cname] != [2]string{} && len(ps) == 2:
+		info := ps[1].name
+		typ := fmt.Sprintf(namingCommands[cname][0], info)
+		stmt = fmt.Sprintf("trackNamed(%s, %s, %s, %s.%s, %s.PObjectName)", res, parent, typ, info, namingCommands[cname][1], info)
+	case len(ps) > 0:
+		p := ps[len(ps)-1]
+		if !p.handle || !isOutput(p) || p.decl.ptr != 1 {
+			return ""
+		}
+		t := trackedType(p.decl.typ)
+		owned := hasVerb(cname, createVerbs, p)
+		if t == "" || !owned && receivers[strings.TrimPrefix(p.typ, "*")].name == "" {
+			return ""
+		}
+		n := trackCount(p, ps)
+		if n == "" {
+			return ""
+		}
+		stmt = fmt.Sprintf("trackCreated(%s, %s, %s, unsafe.Pointer(%s), %s, unsafe.Sizeof(*%s), %t)",
+			res, t, parent, p.name, n, p.name, owned)
+	default:
+		return ""
+	}
+	return fmt.Sprintf("\tif trackObjects {\n\t\t%s\n\t}\n", stmt)
+}
+
+// trackCount returns the number of handles p points to, empty if its len
+// is not understood.
+func trackCount(p wrapperParam, ps []wrapperParam) string {
+	switch {
+	case p.len == "":
+		return "1"
+	case strings.Contains(p.len, "->"):
+		s := strings.SplitN(p.len, "->", 2)
+		for _, q := range ps {
+			if q.decl.name == s[0] {
+				return fmt.Sprintf("int(%s.%s)", q.name, strings.ToUpper(s[1][:1])+s[1][1:])
+			}
+		}
+	}
+	for _, q := range ps {
+		switch {
+		case q.decl.name != p.len:
+		case q.decl.ptr == 0:
+			return fmt.Sprintf("int(%s)", q.name)
+		case isCount(q):
+			return fmt.Sprintf("int(*%s)", q.name)
+		}
+	}
+	return ""
+}
+
+// trackedType returns the ObjectType constant of the handle type ctype if
+// its objects are tracked: the dispatchable ones, and the ones a command
+// destroys and not freed with their pool.
+func (r *renderer) trackedType(ctype string) string {
+	t := r.reg.types[ctype]
+	for t != nil && t.alias != "" {
+		t = r.reg.types[t.alias]
+	}
+	if t == nil || t.category != "handle" || strings.HasSuffix(t.elem.attr("parent"), "Pool") {
+		return ""
+	}
+	if r.destroyable == nil {
+		r.destroyable = map[string]bool{}
+		for name, c := range r.reg.commands {
+			if c.alias != "" {
+				continue
+			}
+			// only what destroyed looks at, the types may not be in this output
+			var ps []wrapperParam
+			for _, e := range c.params {
+				d := parseDecl(e.text())
+				ps = append(ps, wrapperParam{decl: d, len: e.attr("len"), handle: r.category(d.typ) == "handle"})
+			}
+			if i := destroyed(name, ps); i >= 0 {
+				r.destroyable[ps[i].decl.typ] = true
+			}
+		}
+	}
+	if t.elem.childText("type") == "VK_DEFINE_HANDLE" || r.destroyable[t.name] {
+		return trimVK(t.elem.attr("objtypeenum"))
+	}
+	return ""
+}
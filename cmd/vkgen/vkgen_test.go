@@ -0,0 +1,298 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"go/ast"
+	"go/build"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+// TestGenerate runs vkgen on the registry in the vulkan directory into a
+// temporary directory, every file written must match the checked-in one
+// byte for byte.
+func TestGenerate(t *testing.T) {
+	registry := filepath.Join("..", "..", "vulkan", "registry", "vk.xml")
+	root := filepath.Join("..", "..")
+	if *update {
+		if err := run(registry, root); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	dir, err := ioutil.TempDir("", "vkgen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := run(registry, dir); err != nil {
+		t.Fatal(err)
+	}
+
+	names := []string{abiPackage, abiTest, vkxCommands}
+	for _, out := range outputs {
+		names = append(names, out.file)
+	}
+	for _, name := range names {
+		if _, err := os.Stat(filepath.Join(dir, filepath.FromSlash(name))); err != nil {
+			t.Errorf("%s: not generated", name)
+		}
+	}
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		got, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		want, err := ioutil.ReadFile(filepath.Join(root, rel))
+		if err != nil {
+			t.Errorf("%s: %v", rel, err)
+			return nil
+		}
+		if line := diffLine(got, want); line != 0 {
+			t.Errorf("%s: line %d differs from the generated output, run vkgen or go test -update", rel, line)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// diffLine returns the first line where a and b differ, 0 if they are equal.
+func diffLine(a, b []byte) int {
+	if bytes.Equal(a, b) {
+		return 0
+	}
+	al, bl := bytes.Split(a, []byte("\n")), bytes.Split(b, []byte("\n"))
+	for i := range al {
+		if i >= len(bl) || !bytes.Equal(al[i], bl[i]) {
+			return i + 1
+		}
+	}
+	return len(al) + 1
+}
+
+// TestTargetLayouts type-checks package vk for linux/amd64, 386 and arm and
+// compares every struct with the C layout vkgen models for the target, the
+// layout test of package vk checks the model against C on the host. On arm
+// Go aligns the structs to 4 where C has 8, the padding must make up for it.
+func TestTargetLayouts(t *testing.T) {
+	reg, err := loadRegistry(filepath.Join("..", "..", "vulkan", "registry", "vk.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &renderer{reg: reg, out: &output{}, vars: make(map[string]bool)}
+	targets := []struct {
+		arch string
+		tg   target
+	}{
+		{"amd64", target64},
+		{"386", target386},
+		{"arm", targetARM},
+	}
+	for _, tt := range targets {
+		for _, tags := range [][]string{nil, {"vkbeta", "xlib_xrandr", "wayland", "directfb"}} {
+			pkg := checkTarget(t, tt.arch, tags)
+			if pkg == nil {
+				continue
+			}
+			sizes := types.SizesFor("gc", tt.arch)
+			for _, td := range reg.types {
+				if td.alias != "" || td.category != "struct" && td.category != "union" {
+					continue
+				}
+				obj := pkg.Scope().Lookup(r.goName(td.name))
+				if obj == nil {
+					continue // not in the build
+				}
+				size, align := r.sizeofType(tt.tg, td.name)
+				if tt.arch == "arm" {
+					align = goAlign(align)
+				}
+				if got := sizes.Sizeof(obj.Type()); got != int64(size) {
+					t.Errorf("%s: %s: sizeof is %d, C has %d", tt.arch, td.name, got, size)
+				}
+				if got := sizes.Alignof(obj.Type()); got != int64(align) {
+					t.Errorf("%s: %s: alignof is %d, want %d", tt.arch, td.name, got, align)
+				}
+				st := obj.Type().Underlying().(*types.Struct)
+				var fields []*types.Var
+				for i := 0; i < st.NumFields(); i++ {
+					fields = append(fields, st.Field(i))
+				}
+				want := memberOffsets(r, tt.tg, td)
+				for i, off := range sizes.Offsetsof(fields) {
+					if c, ok := want[fields[i].Name()]; ok && off != int64(c) {
+						t.Errorf("%s: %s.%s: offsetof is %d, C has %d", tt.arch, td.name, fields[i].Name(), off, c)
+					}
+				}
+			}
+		}
+	}
+}
+
+// checkTarget type-checks package vk for linux/arch, the cgo half of it
+// left out. Any error but the missing import of C fails the test.
+func checkTarget(t *testing.T, arch string, tags []string) *types.Package {
+	ctx := build.Default
+	ctx.GOOS, ctx.GOARCH, ctx.CgoEnabled, ctx.BuildTags = "linux", arch, true, tags
+	bp, err := ctx.ImportDir(filepath.Join("..", ".."), 0)
+	if err != nil {
+		t.Error(err)
+		return nil
+	}
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, name := range append(bp.GoFiles, bp.CgoFiles...) {
+		f, err := parser.ParseFile(fset, filepath.Join(bp.Dir, name), nil, 0)
+		if err != nil {
+			t.Error(err)
+			return nil
+		}
+		files = append(files, f)
+	}
+	conf := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Sizes:    types.SizesFor("gc", arch),
+		Error: func(err error) {
+			if !strings.Contains(err.Error(), "could not import C ") {
+				t.Errorf("linux/%s %v: %v", arch, tags, err)
+			}
+		},
+	}
+	pkg, _ := conf.Check("github.com/toy80/vk", fset, files, nil)
+	return pkg
+}
+
+// memberOffsets models the offsetof of the members of a C struct on tg by
+// their Go names, the bit fields are left out.
+func memberOffsets(r *renderer, tg target, td *typeDef) map[string]int {
+	offsets := make(map[string]int)
+	if td.category == "union" {
+		return offsets
+	}
+	end, used := 0, 0
+	for _, m := range td.elem.elems("member") {
+		d := parseDecl(m.text())
+		n, a := r.sizeofOn(tg, d)
+		if d.bits > 0 && used > 0 && used+d.bits <= n*8 {
+			used += d.bits
+			continue
+		}
+		off := roundUp(end, a)
+		end, used = off+n, d.bits
+		if d.bits == 0 {
+			offsets[exported(d.name)] = off
+		}
+	}
+	return offsets
+}
+
+// TestLegacyNames checks that every legacy alias still has a target, the
+// alias is silently left out otherwise.
+func TestLegacyNames(t *testing.T) {
+	reg, err := loadRegistry(filepath.Join("..", "..", "vulkan", "registry", "vk.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	values := make(map[string]bool)
+	for _, g := range reg.groups {
+		for _, v := range g.values {
+			values[trimVK(v.name)] = true
+		}
+	}
+	for old, cur := range legacyNames {
+		if !values[cur] {
+			t.Errorf("%s: %s is not in the registry", old, cur)
+		}
+	}
+}
+
+func TestParseDecl(t *testing.T) {
+	tests := []struct {
+		in   string
+		want cDecl
+	}{
+		{"uint32_t width", cDecl{text: "uint32_t width", typ: "uint32_t", name: "width"}},
+		{"const char* const* ppEnabledLayerNames", cDecl{text: "const char* const* ppEnabledLayerNames", typ: "char", name: "ppEnabledLayerNames", ptr: 2}},
+		{"const  float blendConstants[4]", cDecl{text: "const float blendConstants[4]", typ: "float", name: "blendConstants", array: []string{"4"}}},
+		{"char extensionName[VK_MAX_EXTENSION_NAME_SIZE]", cDecl{text: "char extensionName[VK_MAX_EXTENSION_NAME_SIZE]", typ: "char", name: "extensionName", array: []string{"VK_MAX_EXTENSION_NAME_SIZE"}}},
+		{"uint32_t mask:8", cDecl{text: "uint32_t mask:8", typ: "uint32_t", name: "mask", bits: 8}},
+		{"struct Display* dpy", cDecl{text: "struct Display* dpy", typ: "Display", name: "dpy", ptr: 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			if got := parseDecl(tt.in); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseDecl(%q) = %+v, want %+v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMaxEnumName(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"VkFormat", "FORMAT_MAX_ENUM"},
+		{"VkPresentModeKHR", "PRESENT_MODE_MAX_ENUM_KHR"},
+		{"VkFullScreenExclusiveEXT", "FULL_SCREEN_EXCLUSIVE_MAX_ENUM_EXT"},
+		{"VkImageType", "IMAGE_TYPE_MAX_ENUM"},
+		{"StdVideoH264ChromaFormatIdc", "STD_VIDEO_H264_CHROMA_FORMAT_IDC_MAX_ENUM"},
+		{"StdVideoDecodeH264FieldOrderCount", "STD_VIDEO_DECODE_H264_FIELD_ORDER_COUNT_MAX_ENUM"},
+	}
+	for _, tt := range tests {
+		if got := maxEnumName(tt.in); got != tt.want {
+			t.Errorf("maxEnumName(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestExported(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"sType", "SType"},
+		{"pic_order_cnt_type", "PicOrderCntType"},
+		{"PicOrderCntVal", "PicOrderCntVal"},
+		{"reserved1", "Reserved1"},
+	}
+	for _, tt := range tests {
+		if got := exported(tt.in); got != tt.want {
+			t.Errorf("exported(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestLocalName(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"pCreateInfos", "createInfos"},
+		{"ppData", "data"},
+		{"pipelineCache", "pipelineCache"},
+		{"pType", "type_"},
+	}
+	for _, tt := range tests {
+		if got := localName(tt.in); got != tt.want {
+			t.Errorf("localName(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,467 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// The methods of package vkx are generated along with an output that sets
+// vkx, for the commands taking a dispatchable handle first.
+const vkxCommands = "vkx/commands.go"
+
+// wrapper is a command resolved against the registry as far as its vkx
+// method needs, renderVKX does not look at the registry.
+type wrapper struct {
+	name   string // Go name, e.g. CreateBuffer
+	ret    string // Go result type, empty for void
+	params []wrapperParam
+}
+
+type wrapperParam struct {
+	decl     cDecl
+	name     string // Go name, as in the Call method
+	typ      string // Go type, as in the Call method
+	len      string // vk.xml len attribute
+	optional bool   // the pointer itself may be null
+	chained  bool   // points to a struct with an sType
+	stype    string // the sType value of that struct, if fixed
+	handle   bool   // a handle, or points to some
+}
+
+func (r *renderer) wrap(name string, ret cDecl, params []wrapperParam) {
+	if !r.out.vkx {
+		return
+	}
+	r.wrappers = append(r.wrappers, wrapper{name: strings.TrimPrefix(name, "vk"), ret: r.goType(ret, false), params: params})
+}
+
+// params resolves the parameters of c against the registry.
+func (r *renderer) params(c *command) []wrapperParam {
+	var ps []wrapperParam
+	for _, e := range c.params {
+		d := parseDecl(e.text())
+		p := wrapperParam{
+			decl:     d,
+			name:     d.name,
+			typ:      r.goType(d, true),
+			len:      e.attr("len"),
+			optional: strings.HasPrefix(e.attr("optional"), "true"),
+		}
+		if token.Lookup(p.name).IsKeyword() {
+			p.name += "_"
+		}
+		t := r.reg.types[d.typ]
+		for t != nil && t.alias != "" {
+			t = r.reg.types[t.alias]
+		}
+		switch {
+		case t == nil:
+		case t.category == "handle":
+			p.handle = true
+		case t.category == "struct":
+			for _, m := range t.elem.elems("member") {
+				if parseDecl(m.text()).name == "sType" {
+					p.chained, p.stype = true, trimVK(m.attr("values"))
+				}
+			}
+		}
+		ps = append(ps, p)
+	}
+	return ps
+}
+
+// receiver is the vkx type of a dispatchable handle.
+type receiver struct {
+	name  string // receiver name of the methods
+	table string
+}
+
+var receivers = map[string]receiver{
+	"Instance":       {"i", "InstanceTable"},
+	"PhysicalDevice": {"p", "InstanceTable"},
+	"Device":         {"d", "DeviceTable"},
+	"Queue":          {"q", "DeviceTable"},
+	"CommandBuffer":  {"c", "DeviceTable"},
+}
+
+// table returns the dispatch table of w, empty if w has no method.
+func (w *wrapper) table() string {
+	switch {
+	case w.name == "GetInstanceProcAddr":
+		return "" // the tables are loaded with it
+	case w.name == "GetDeviceProcAddr":
+		return "InstanceTable" // the device tables are loaded with it
+	case len(w.params) == 0:
+		return ""
+	}
+	return receivers[w.params[0].typ].table
+}
+
+// renderVKX returns the dispatch tables and the methods of package vkx.
+func renderVKX(wrappers []wrapper) ([]byte, error) {
+	var body bytes.Buffer
+	for _, table := range []string{"InstanceTable", "DeviceTable"} {
+		var fields, loads strings.Builder
+		for _, w := range wrappers {
+			if w.table() == table {
+				fmt.Fprintf(&fields, "\t%s vk.Pfn%s\n", w.name, w.name)
+				fmt.Fprintf(&loads, "\tt.%s = vk.Pfn%s(proc(t.%s.String()))\n", w.name, w.name, w.name)
+			}
+		}
+		fmt.Fprintf(&body, "\n%s\ntype %s struct {\n", tableDocs[table], table)
+		body.WriteString("\t// Allocator is passed to the commands taking a pAllocator.\n")
+		fmt.Fprintf(&body, "\tAllocator *vk.AllocationCallbacks\n\n%s}\n\n", fields.String())
+		fmt.Fprintf(&body, "func (t *%s) load(proc func(name string) vk.PfnVoidFunction) {\n%s}\n", table, loads.String())
+	}
+	for _, w := range wrappers {
+		if w.table() == "" || w.name == "GetDeviceProcAddr" {
+			continue
+		}
+		m, err := vkxMethod(w)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s: %v", vkxCommands, w.name, err)
+		}
+		fmt.Fprintf(&body, "\n%s", m)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// This file is generated by vkgen.\n\npackage vkx\n\n")
+	if bytes.Contains(body.Bytes(), []byte("unsafe.")) {
+		buf.WriteString("import (\n\t\"unsafe\"\n\n\t\"github.com/toy80/vk\"\n)\n")
+	} else {
+		buf.WriteString("import \"github.com/toy80/vk\"\n")
+	}
+	buf.Write(body.Bytes())
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", vkxCommands, err)
+	}
+	return src, nil
+}
+
+var tableDocs = map[string]string{
+	"InstanceTable": "// InstanceTable holds the commands of an instance and its physical devices,\n" +
+		"// from vkGetInstanceProcAddr. Those the instance lacks are zero.",
+	"DeviceTable": "// DeviceTable holds the commands of a device, its queues and command buffers,\n" +
+		"// from vkGetDeviceProcAddr. Those the device lacks are zero.",
+}
+
+var reTypeName = regexp.MustCompile(`(^|[^.\w])([A-Z]\w*)`)
+
+// qualify prefixes the names package vk declares in the Go type typ.
+func qualify(typ string) string {
+	return reTypeName.ReplaceAllString(typ, "${1}vk.${2}")
+}
+
+// localName turns a C parameter name into the name of a Go variable,
+// pCreateInfos becomes createInfos.
+func localName(name string) string {
+	if i := len(name) - len(strings.TrimLeft(name, "p")); i < len(name) && unicode.IsUpper(rune(name[i])) {
+		name = name[i:]
+	}
+	name = strings.ToLower(name[:1]) + name[1:]
+	if token.Lookup(name).IsKeyword() {
+		name += "_"
+	}
+	return name
+}
+
+func isConst(p wrapperParam) bool { return strings.HasPrefix(p.decl.text, "const ") }
+
+// isOutput reports whether p points to memory the command writes.
+func isOutput(p wrapperParam) bool {
+	return p.decl.ptr > 0 && !isConst(p) && len(p.decl.array) == 0
+}
+
+// isCount reports whether p is the count of an enumeration, written by
+// the first call and read by the second one.
+func isCount(p wrapperParam) bool {
+	return p.decl.ptr == 1 && !isConst(p) && (p.typ == "*uint32" || p.typ == "*uintptr")
+}
+
+// elemType returns the Go type p points to, in package vkx.
+func elemType(p wrapperParam) string {
+	typ := strings.TrimPrefix(p.typ, "*")
+	switch {
+	case p.decl.typ == "void" && p.decl.ptr == 1:
+		return "byte"
+	case receivers[typ].table != "":
+		return typ
+	}
+	return qualify(typ)
+}
+
+// wrapHandle returns the vkx value of the dispatchable handle h of type
+// typ, created through the receiver rv.
+func wrapHandle(typ, h string, rv receiver) string {
+	if typ == "Device" {
+		return fmt.Sprintf("newDevice(%s, %s.InstanceTable)", h, rv.name)
+	}
+	return fmt.Sprintf("%s{%s, %s.%s}", typ, h, rv.name, rv.table)
+}
+
+func vkxMethod(w wrapper) (string, error) {
+	ps := w.params
+	rv := receivers[ps[0].typ]
+	n := len(ps)
+	last := ps[n-1]
+
+	// the results, from the last parameters
+	var out string
+	switch {
+	case w.ret != "" && w.ret != "Result" || n < 2 || !isOutput(last):
+	case n > 2 && isCount(ps[n-2]) && last.len == ps[n-2].decl.name:
+		out = "enumerate"
+	case last.len != "" && last.decl.typ != "void" && (strings.Contains(last.len, "->") || isCountOf(ps, last.len)):
+		out = "array"
+	case last.len == "" && !last.chained && (last.decl.typ != "void" || last.decl.ptr > 1):
+		out = "value"
+	}
+
+	// the parameters, counted arrays become slices
+	args := make([]string, n)
+	args[0] = rv.name + "." + ps[0].typ
+	counted := make(map[string]int) // count name -> number of input arrays
+	for _, p := range ps {
+		if isConst(p) {
+			counted[p.len]++
+		}
+	}
+	slices := make(map[int]bool)
+	counts := make(map[string]bool)
+	for i, p := range ps {
+		if i > 0 && p.decl.ptr == 1 && isConst(p) && len(p.decl.array) == 0 && counted[p.len] == 1 && isCountOf(ps[:i], p.len) {
+			slices[i], counts[p.len] = true, true
+		}
+	}
+	sliceOf := make(map[string]string) // count name -> slice name
+	var names, types, pre []string
+	param := func(name, typ string) {
+		names, types = append(names, name), append(types, typ)
+	}
+	locals := make(map[string]bool)
+	for _, s := range []string{"ret", "n", "k", "h", "x", "free", "handles", "wrapped"} {
+		locals[s] = true // declared by the generated code
+	}
+	local := func(name string) (string, error) {
+		name = localName(name)
+		if locals[name] {
+			return "", fmt.Errorf("%s is used twice", name)
+		}
+		locals[name] = true
+		return name, nil
+	}
+	for i := 1; i < n; i++ {
+		p := ps[i]
+		switch {
+		case out == "enumerate" && i >= n-2 || out != "" && i == n-1:
+			continue
+		case p.decl.ptr == 0 && counts[p.decl.name]:
+			continue // len of the slice
+		case p.typ == "*AllocationCallbacks" && p.decl.name == "pAllocator":
+			args[i] = rv.name + ".Allocator"
+		case p.decl.typ == "char" && p.decl.ptr == 1 && isConst(p) && strings.HasSuffix(p.len, "null-terminated"):
+			s, err := local(p.name)
+			if err != nil {
+				return "", err
+			}
+			param(s, "string")
+			cstr := "CStr"
+			if p.optional {
+				cstr = "CStrOrNil"
+			}
+			pre = append(pre, fmt.Sprintf("%s, free := vk.%s(%s)\ndefer free()", p.name, cstr, s))
+			args[i] = p.name
+		case slices[i]:
+			s, err := local(p.name)
+			if err != nil {
+				return "", err
+			}
+			elem := elemType(p)
+			param(s, "[]"+elem)
+			sliceOf[p.len] = s
+			switch {
+			case elem == "byte":
+				pre = append(pre, fmt.Sprintf("var %s unsafe.Pointer\nif len(%s) > 0 {\n%s = unsafe.Pointer(&%s[0])\n}", p.name, s, p.name, s))
+			case receivers[elem].table != "":
+				pre = append(pre, fmt.Sprintf("var %s *vk.%s\nif len(%s) > 0 {\nhandles := make([]vk.%s, len(%s))\nfor k, x := range %s {\nhandles[k] = x.%s\n}\n%s = &handles[0]\n}",
+					p.name, elem, s, elem, s, s, elem, p.name))
+			default:
+				pre = append(pre, fmt.Sprintf("var %s *%s\nif len(%s) > 0 {\n%s = &%s[0]\n}", p.name, elem, s, p.name, s))
+			}
+			args[i] = p.name
+		default:
+			if locals[p.name] {
+				return "", fmt.Errorf("%s is used twice", p.name)
+			}
+			locals[p.name] = true
+			param(p.name, qualify(p.typ))
+			args[i] = p.name
+		}
+	}
+	for i, p := range ps {
+		if s, ok := sliceOf[p.decl.name]; ok && p.decl.ptr == 0 {
+			args[i] = fmt.Sprintf("%s(len(%s))", qualify(p.typ), s)
+		}
+	}
+	// a, b T as in the Call methods
+	var sig []string
+	for i := range names {
+		if i > 0 && types[i] == types[i-1] {
+			sig[len(sig)-1] = strings.TrimSuffix(sig[len(sig)-1], " "+types[i]) + ", " + names[i] + " " + types[i]
+		} else {
+			sig = append(sig, names[i]+" "+types[i])
+		}
+	}
+
+	var sb strings.Builder
+	call := fmt.Sprintf("%s.%s.%s.Call", rv.name, rv.table, w.name)
+	joined := func(extra ...string) string {
+		return strings.Join(append(args[:n-len(extra):n-len(extra)], extra...), ", ")
+	}
+	head := func(results string) {
+		if strings.Contains(results, ",") {
+			results = "(" + results + ")"
+		}
+		fmt.Fprintf(&sb, "// %s calls vk%s.\n", w.name, w.name)
+		fmt.Fprintf(&sb, "func (%s %s) %s(%s) %s{\n", rv.name, ps[0].typ, w.name, strings.Join(sig, ", "), spaced(results))
+		for _, s := range pre {
+			sb.WriteString(s + "\n")
+		}
+	}
+	isResult := w.ret == "Result"
+	switch out {
+	case "":
+		switch {
+		case isResult:
+			head("error")
+			fmt.Fprintf(&sb, "return %s(%s).Err()\n", call, joined())
+		case w.ret != "":
+			head(qualify(w.ret))
+			fmt.Fprintf(&sb, "return %s(%s)\n", call, joined())
+		default:
+			head("")
+			fmt.Fprintf(&sb, "%s(%s)\n", call, joined())
+		}
+
+	case "value":
+		v, err := local(last.name)
+		if err != nil {
+			return "", err
+		}
+		elem := elemType(last)
+		result := v
+		if receivers[elem].table != "" {
+			result = wrapHandle(elem, v, rv)
+		}
+		if isResult {
+			head(elem + ", error")
+		} else {
+			head(elem)
+		}
+		if receivers[elem].table != "" {
+			fmt.Fprintf(&sb, "var %s vk.%s\n", v, elem)
+		} else {
+			fmt.Fprintf(&sb, "var %s %s\n", v, elem)
+		}
+		if isResult {
+			fmt.Fprintf(&sb, "ret := %s(%s)\nreturn %s, ret.Err()\n", call, joined("&"+v), result)
+		} else {
+			fmt.Fprintf(&sb, "%s(%s)\nreturn %s\n", call, joined("&"+v), result)
+		}
+
+	case "array":
+		v, err := local(last.name)
+		if err != nil {
+			return "", err
+		}
+		size := last.len
+		if i := strings.Index(size, "->"); i >= 0 {
+			size = size[:i] + "." + strings.ToUpper(size[i+2:i+3]) + size[i+3:]
+		} else if s, ok := sliceOf[size]; ok {
+			size = "len(" + s + ")"
+		}
+		elem := elemType(last)
+		wrapped := receivers[elem].table != ""
+		if isResult {
+			head("[]" + elem + ", error")
+		} else {
+			head("[]" + elem)
+		}
+		vkElem := elem
+		if wrapped {
+			vkElem = "vk." + elem
+		}
+		fmt.Fprintf(&sb, "%s := make([]%s, %s)\nvar %s *%s\nif len(%s) > 0 {\n%s = &%s[0]\n}\n", v, vkElem, size, last.name, vkElem, v, last.name, v)
+		ret := ""
+		if isResult {
+			ret = "ret := "
+		}
+		fmt.Fprintf(&sb, "%s%s(%s)\n", ret, call, joined(last.name))
+		if wrapped {
+			fmt.Fprintf(&sb, "wrapped := make([]%s, len(%s))\nfor k, h := range %s {\nwrapped[k] = %s\n}\n", elem, v, v, wrapHandle(elem, "h", rv))
+			v = "wrapped"
+		}
+		if isResult {
+			fmt.Fprintf(&sb, "return %s, ret.Err()\n", v)
+		} else {
+			fmt.Fprintf(&sb, "return %s\n", v)
+		}
+
+	case "enumerate":
+		v, err := local(last.name)
+		if err != nil {
+			return "", err
+		}
+		elem := elemType(last)
+		wrapped := receivers[elem].table != ""
+		vkElem, ptr := elem, "&"+v+"[0]"
+		switch {
+		case wrapped:
+			vkElem = "vk." + elem
+		case elem == "byte":
+			ptr = "unsafe.Pointer(" + ptr + ")"
+		}
+		count := strings.TrimPrefix(ps[n-2].typ, "*")
+		var fill, result strings.Builder
+		fmt.Fprintf(&fill, "%s := make([]%s, n)\n", v, vkElem)
+		if last.stype != "" {
+			fmt.Fprintf(&fill, "for k := range %s {\n%s[k].SType = vk.%s\n}\n", v, v, last.stype)
+		}
+		if wrapped {
+			fmt.Fprintf(&result, "wrapped := make([]%s, n)\nfor k, h := range %s[:n] {\nwrapped[k] = %s\n}\n", elem, v, wrapHandle(elem, "h", rv))
+			fmt.Fprintf(&result, "return wrapped")
+		} else {
+			fmt.Fprintf(&result, "return %s[:n]", v)
+		}
+		if !isResult {
+			head("[]" + elem)
+			fmt.Fprintf(&sb, "var n %s\n%s(%s)\nif n == 0 {\nreturn nil\n}\n", count, call, joined("&n", "nil"))
+			fmt.Fprintf(&sb, "%s%s(%s)\n%s\n", fill.String(), call, joined("&n", ptr), result.String())
+			break
+		}
+		head("[]" + elem + ", error")
+		fmt.Fprintf(&sb, "for {\nvar n %s\nif ret := %s(%s); ret != vk.SUCCESS {\nreturn nil, ret.Err()\n}\n", count, call, joined("&n", "nil"))
+		fmt.Fprintf(&sb, "if n == 0 {\nreturn nil, nil\n}\n%sret := %s(%s)\n", fill.String(), call, joined("&n", ptr))
+		sb.WriteString("if ret == vk.INCOMPLETE {\ncontinue // the list grew between the two calls\n}\n")
+		fmt.Fprintf(&sb, "if ret != vk.SUCCESS {\nreturn nil, ret.Err()\n}\n%s, nil\n}\n", result.String())
+	}
+	sb.WriteString("}\n")
+	return sb.String(), nil
+}
+
+// isCountOf reports whether one of ps is the count of the array len, an
+// integer passed by value.
+func isCountOf(ps []wrapperParam, len string) bool {
+	for _, p := range ps {
+		if p.decl.name == len && p.decl.ptr == 0 && len != "" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,107 @@
+package vk
+
+import "sync"
+
+// The global commands are resolved with a null instance, once, and cached
+// for the life of the process. A zero Pfn means the loader does not export
+// the command, vkEnumerateInstanceVersion is missing from 1.0 loaders.
+var (
+	globalOnce sync.Once
+
+	globalCreateInstance                       PfnCreateInstance
+	globalEnumerateInstanceVersion             PfnEnumerateInstanceVersion
+	globalEnumerateInstanceExtensionProperties PfnEnumerateInstanceExtensionProperties
+	globalEnumerateInstanceLayerProperties     PfnEnumerateInstanceLayerProperties
+)
+
+func loadGlobalCommands() {
+	globalOnce.Do(func() {
+		globalCreateInstance = PfnCreateInstance(GetInstanceProcAddr(0, globalCreateInstance.String()))
+		globalEnumerateInstanceVersion = PfnEnumerateInstanceVersion(GetInstanceProcAddr(0, globalEnumerateInstanceVersion.String()))
+		globalEnumerateInstanceExtensionProperties = PfnEnumerateInstanceExtensionProperties(GetInstanceProcAddr(0, globalEnumerateInstanceExtensionProperties.String()))
+		globalEnumerateInstanceLayerProperties = PfnEnumerateInstanceLayerProperties(GetInstanceProcAddr(0, globalEnumerateInstanceLayerProperties.String()))
+	})
+}
+
+func CreateInstance(pCreateInfo *InstanceCreateInfo, pAllocator *AllocationCallbacks, pInstance *Instance) Result {
+	loadGlobalCommands()
+	if globalCreateInstance == 0 {
+		return ERROR_UNKNOWN
+	}
+	return globalCreateInstance.Call(pCreateInfo, pAllocator, pInstance)
+}
+
+// EnumerateInstanceVersion reports API_VERSION_1_0 when the loader predates
+// vkEnumerateInstanceVersion, as the specification asks.
+func EnumerateInstanceVersion(pApiVersion *Version) Result {
+	loadGlobalCommands()
+	if globalEnumerateInstanceVersion == 0 {
+		*pApiVersion = API_VERSION_1_0
+		return SUCCESS
+	}
+	return globalEnumerateInstanceVersion.Call((*uint32)(pApiVersion))
+}
+
+// InstanceVersion is EnumerateInstanceVersion returning a Go value.
+func InstanceVersion() (Version, error) {
+	var ver Version
+	if ret := EnumerateInstanceVersion(&ver); ret != SUCCESS {
+		return 0, ret.Err()
+	}
+	return ver, nil
+}
+
+// EnumerateInstanceExtensionProperties returns the instance extensions of the
+// implementation and the implicit layers, or of layerName if not empty.
+func EnumerateInstanceExtensionProperties(layerName string) ([]ExtensionProperties, error) {
+	loadGlobalCommands()
+	if globalEnumerateInstanceExtensionProperties == 0 {
+		return nil, ERROR_UNKNOWN.Err()
+	}
+	pLayerName, free := CStrOrNil(layerName)
+	defer free()
+	for {
+		var n uint32
+		if ret := globalEnumerateInstanceExtensionProperties.Call(pLayerName, &n, nil); ret != SUCCESS {
+			return nil, ret.Err()
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		props := make([]ExtensionProperties, n)
+		ret := globalEnumerateInstanceExtensionProperties.Call(pLayerName, &n, &props[0])
+		if ret == INCOMPLETE {
+			continue // the list grew between the two calls
+		}
+		if ret != SUCCESS {
+			return nil, ret.Err()
+		}
+		return props[:n], nil
+	}
+}
+
+// EnumerateInstanceLayerProperties returns the layers available to instances.
+func EnumerateInstanceLayerProperties() ([]LayerProperties, error) {
+	loadGlobalCommands()
+	if globalEnumerateInstanceLayerProperties == 0 {
+		return nil, ERROR_UNKNOWN.Err()
+	}
+	for {
+		var n uint32
+		if ret := globalEnumerateInstanceLayerProperties.Call(&n, nil); ret != SUCCESS {
+			return nil, ret.Err()
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		props := make([]LayerProperties, n)
+		ret := globalEnumerateInstanceLayerProperties.Call(&n, &props[0])
+		if ret == INCOMPLETE {
+			continue
+		}
+		if ret != SUCCESS {
+			return nil, ret.Err()
+		}
+		return props[:n], nil
+	}
+}
@@ -0,0 +1,62 @@
+// +build cgo
+
+package vk
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/toy80/vk/internal/abi"
+)
+
+func TestGlobalCommands(t *testing.T) {
+	loadGlobalCommands()
+	defer func(a PfnEnumerateInstanceVersion, b PfnEnumerateInstanceExtensionProperties, c PfnEnumerateInstanceLayerProperties) {
+		globalEnumerateInstanceVersion = a
+		globalEnumerateInstanceExtensionProperties = b
+		globalEnumerateInstanceLayerProperties = c
+	}(globalEnumerateInstanceVersion, globalEnumerateInstanceExtensionProperties, globalEnumerateInstanceLayerProperties)
+
+	globalEnumerateInstanceVersion = 0
+	globalEnumerateInstanceExtensionProperties = PfnEnumerateInstanceExtensionProperties(abi.EnumerateInstanceExtensionProperties)
+	globalEnumerateInstanceLayerProperties = 0
+	loadGlobalCommands() // cached, the commands are not resolved again
+	if globalEnumerateInstanceExtensionProperties != PfnEnumerateInstanceExtensionProperties(abi.EnumerateInstanceExtensionProperties) {
+		t.Fatalf("global commands resolved twice")
+	}
+
+	if ver, err := InstanceVersion(); err != nil || ver != API_VERSION_1_0 {
+		t.Errorf("InstanceVersion() of a 1.0 loader = %v, %v", ver, err)
+	}
+	if _, err := EnumerateInstanceLayerProperties(); AsResult(err) != ERROR_UNKNOWN {
+		t.Errorf("EnumerateInstanceLayerProperties() without the command: %v", err)
+	}
+
+	abi.Enumerations()
+	props, err := EnumerateInstanceExtensionProperties("VK_LAYER_0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, p := range props {
+		names = append(names, GoStr(&p.ExtensionName))
+	}
+	if !reflect.DeepEqual(names, []string{"VK_EXT_0", "VK_EXT_1"}) || props[1].SpecVersion != 2 {
+		t.Errorf("extensions = %v, %+v", names, props)
+	}
+	if n := abi.Enumerations(); n != 4 {
+		t.Errorf("%d calls, want 4 with the retry after INCOMPLETE", n)
+	}
+	if got := abi.Args(); !reflect.DeepEqual(got, []uint64{1}) {
+		t.Errorf("layer name given: %v", got)
+	}
+
+	globalEnumerateInstanceLayerProperties = PfnEnumerateInstanceLayerProperties(abi.EnumerateInstanceLayerProperties)
+	layers, err := EnumerateInstanceLayerProperties()
+	if err != nil || len(layers) != 2 || GoStr(&layers[1].LayerName) != "VK_LAYER_1" {
+		t.Errorf("EnumerateInstanceLayerProperties() = %+v, %v", layers, err)
+	}
+	if n := abi.Enumerations(); n != 4 {
+		t.Errorf("%d calls of the layers, want 4", n)
+	}
+}
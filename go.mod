@@ -1,3 +1,3 @@
 module github.com/toy80/vk
 
-go 1.12
+go 1.18
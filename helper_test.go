@@ -70,3 +70,110 @@ func TestMemCopy(t *testing.T) {
 		t.Fatalf("src!=dst: src=%v, dst=%v", src, dst)
 	}
 }
+
+func TestVersion(t *testing.T) {
+	tests := []struct {
+		ver                          Version
+		variant, major, minor, patch uint32
+		want                         string
+	}{
+		{API_VERSION_1_3, 0, 1, 3, 0, "1.3.0"},
+		{MakeVersion(1, 2, 177), 0, 1, 2, 177, "1.2.177"},
+		{HEADER_VERSION_COMPLETE, 0, 1, 3, HEADER_VERSION, fmt.Sprintf("1.3.%d", HEADER_VERSION)},
+		{MakeApiVersion(7, 127, 1023, 4095), 7, 127, 1023, 4095, "7:127.1023.4095"},
+	}
+	for _, tt := range tests {
+		if tt.ver.Variant() != tt.variant || tt.ver.Major() != tt.major || tt.ver.Minor() != tt.minor || tt.ver.Patch() != tt.patch {
+			t.Errorf("%#x: got %d %d.%d.%d", uint32(tt.ver), tt.ver.Variant(), tt.ver.Major(), tt.ver.Minor(), tt.ver.Patch())
+		}
+		if got := tt.ver.String(); got != tt.want {
+			t.Errorf("%#x: String() = %q, want %q", uint32(tt.ver), got, tt.want)
+		}
+	}
+}
+
+func TestUnionAccessors(t *testing.T) {
+	var cv ClearValue
+	cv.SetUint32Color([4]uint32{1, 2, 3, 4})
+	if got := cv.Uint32Color(); got != [4]uint32{1, 2, 3, 4} {
+		t.Errorf("Uint32Color() = %v", got)
+	}
+	cv.SetDepth(0.5)
+	cv.SetStencil(7)
+	if cv.Depth() != 0.5 || cv.Stencil() != 7 {
+		t.Errorf("depth/stencil = %v/%v, want 0.5/7", cv.Depth(), cv.Stencil())
+	}
+	if got := cv.Uint32Color(); got[2] != 0 || got[3] != 0 {
+		t.Errorf("SetDepthStencil left %v behind", got[2:])
+	}
+
+	var cc ClearColorValue
+	cc.SetBytes([16]byte{0: 1, 4: 2, 15: 0xFF})
+	cv.SetColor(cc)
+	if got := cv.Int32Color(); got != [4]int32{1, 2, 0, -1 << 24} {
+		t.Errorf("Int32Color() after SetBytes = %v", got)
+	}
+	if got := cv.Bytes(); got != cc.Bytes() {
+		t.Errorf("Bytes() = %v, want %v", got, cc.Bytes())
+	}
+
+	var v PerformanceValueDataINTEL
+	v.SetValue64(^uint64(0))
+	v.SetValue32(42)
+	if got := v.Value64(); got != 42 {
+		t.Errorf("Value64() after SetValue32 = %#x, want 42", got)
+	}
+
+	var addr DeviceOrHostAddressConstKHR
+	addr.SetDeviceAddress(0x1000)
+	if addr.DeviceAddress() != 0x1000 {
+		t.Errorf("DeviceAddress() = %#x", addr.DeviceAddress())
+	}
+	buf := MemAlloc(8)
+	defer MemFree(buf)
+	addr.SetHostAddress(buf)
+	if addr.HostAddress() != buf {
+		t.Errorf("HostAddress() = %p, want %p", addr.HostAddress(), buf)
+	}
+
+	var geom AccelerationStructureGeometryDataKHR
+	geom.SetAabbs(AccelerationStructureGeometryAabbsDataKHR{SType: STRUCTURE_TYPE_ACCELERATION_STRUCTURE_GEOMETRY_AABBS_DATA_KHR, Stride: 24})
+	if got := geom.Aabbs(); got.Stride != 24 {
+		t.Errorf("Aabbs().Stride = %d, want 24", got.Stride)
+	}
+	if unsafe.Sizeof(geom) != unsafe.Sizeof(AccelerationStructureGeometryTrianglesDataKHR{}) {
+		t.Errorf("sizeof = %d, want the triangles size", unsafe.Sizeof(geom))
+	}
+}
+
+func TestBitFieldAccessors(t *testing.T) {
+	var inst AccelerationStructureInstanceKHR
+	inst.AccelerationStructureReference = ^uint64(0)
+	inst.SetInstanceCustomIndex(0xFFFFFF)
+	inst.SetMask(0xFF)
+	inst.SetInstanceShaderBindingTableRecordOffset(0xFFFFFF)
+	inst.SetFlags(0xFF)
+
+	// each setter writes its own bits and keeps the neighbours
+	inst.SetInstanceCustomIndex(0x123456)
+	inst.SetFlags(uint8(GEOMETRY_INSTANCE_FORCE_OPAQUE_BIT_KHR))
+	want := [4]uint32{0x123456, 0xFF, 0xFFFFFF, uint32(GEOMETRY_INSTANCE_FORCE_OPAQUE_BIT_KHR)}
+	got := [4]uint32{inst.InstanceCustomIndex(), uint32(inst.Mask()), inst.InstanceShaderBindingTableRecordOffset(), uint32(inst.Flags())}
+	if got != want {
+		t.Errorf("bit fields = %#x, want %#x", got, want)
+	}
+
+	// out of range values are truncated to the field width
+	inst.SetMask(0xAB)
+	inst.SetInstanceShaderBindingTableRecordOffset(0x1000001)
+	if inst.Mask() != 0xAB || inst.InstanceCustomIndex() != 0x123456 {
+		t.Errorf("SetMask(0xAB): mask %#x, index %#x", inst.Mask(), inst.InstanceCustomIndex())
+	}
+	if inst.InstanceShaderBindingTableRecordOffset() != 1 || inst.Flags() != uint8(GEOMETRY_INSTANCE_FORCE_OPAQUE_BIT_KHR) {
+		t.Errorf("SetInstanceShaderBindingTableRecordOffset(0x1000001): offset %#x, flags %#x",
+			inst.InstanceShaderBindingTableRecordOffset(), inst.Flags())
+	}
+	if inst.AccelerationStructureReference != ^uint64(0) {
+		t.Errorf("AccelerationStructureReference = %#x, clobbered", inst.AccelerationStructureReference)
+	}
+}
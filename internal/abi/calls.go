@@ -0,0 +1,270 @@
+package abi
+
+// #include <stdint.h>
+// #include <stdio.h>
+// #include "vulkan/vulkan.h"
+//
+// // The record functions have the signature of the Vulkan command they are
+// // named after and keep the arguments as 64-bit integers.
+// uint64_t abi_args[8];
+// int abi_nargs;
+//
+// void VKAPI_CALL record_vkDestroyBuffer(VkDevice device, VkBuffer buffer, const VkAllocationCallbacks* pAllocator) {
+//   abi_args[0] = (uintptr_t)device;
+//   abi_args[1] = (uint64_t)buffer;
+//   abi_args[2] = (uintptr_t)pAllocator;
+//   abi_nargs = 3;
+// }
+//
+// VkResult VKAPI_CALL record_vkMapMemory(VkDevice device, VkDeviceMemory memory, VkDeviceSize offset, VkDeviceSize size, VkMemoryMapFlags flags, void** ppData) {
+//   abi_args[0] = (uintptr_t)device;
+//   abi_args[1] = (uint64_t)memory;
+//   abi_args[2] = offset;
+//   abi_args[3] = size;
+//   abi_args[4] = flags;
+//   abi_args[5] = (uintptr_t)ppData;
+//   abi_nargs = 6;
+//   return VK_ERROR_MEMORY_MAP_FAILED;
+// }
+//
+// void VKAPI_CALL record_vkCmdFillBuffer(VkCommandBuffer commandBuffer, VkBuffer dstBuffer, VkDeviceSize dstOffset, VkDeviceSize size, uint32_t data) {
+//   abi_args[0] = (uintptr_t)commandBuffer;
+//   abi_args[1] = (uint64_t)dstBuffer;
+//   abi_args[2] = dstOffset;
+//   abi_args[3] = size;
+//   abi_args[4] = data;
+//   abi_nargs = 5;
+// }
+//
+// uint64_t VKAPI_CALL record_vkGetBufferOpaqueCaptureAddress(VkDevice device, const VkBufferDeviceAddressInfo* pInfo) {
+//   abi_args[0] = (uintptr_t)device;
+//   abi_args[1] = (uint64_t)pInfo->buffer;
+//   abi_nargs = 2;
+//   return ~(uint64_t)pInfo->buffer;
+// }
+//
+// // AllocateDescriptorSets makes the sets of the pool, numbered from 1.
+// VkResult VKAPI_CALL record_vkAllocateDescriptorSets(VkDevice device, const VkDescriptorSetAllocateInfo* pAllocateInfo, VkDescriptorSet* pDescriptorSets) {
+//   abi_args[0] = (uintptr_t)device;
+//   abi_args[1] = (uint64_t)pAllocateInfo->descriptorPool;
+//   abi_args[2] = pAllocateInfo->descriptorSetCount;
+//   abi_nargs = 3;
+//   for (uint32_t i = 0; i < pAllocateInfo->descriptorSetCount; i++) {
+//     pDescriptorSets[i] = (VkDescriptorSet)((uint64_t)pAllocateInfo->descriptorPool + i + 1);
+//   }
+//   return VK_SUCCESS;
+// }
+//
+// // QueueSubmit records the command buffers of the last submit, as if the
+// // device was lost.
+// VkResult VKAPI_CALL record_vkQueueSubmit(VkQueue queue, uint32_t submitCount, const VkSubmitInfo* pSubmits, VkFence fence) {
+//   abi_args[0] = (uintptr_t)queue;
+//   abi_args[1] = submitCount;
+//   abi_args[2] = (uint64_t)fence;
+//   abi_args[3] = submitCount == 0 ? 0 : pSubmits[submitCount - 1].commandBufferCount;
+//   abi_nargs = 4;
+//   return VK_ERROR_DEVICE_LOST;
+// }
+//
+// // The create functions make the handles of their arguments.
+// VkResult VKAPI_CALL record_vkCreateDevice(VkPhysicalDevice physicalDevice, const VkDeviceCreateInfo* pCreateInfo, const VkAllocationCallbacks* pAllocator, VkDevice* pDevice) {
+//   *pDevice = (VkDevice)((uintptr_t)physicalDevice + 1);
+//   return VK_SUCCESS;
+// }
+//
+// void VKAPI_CALL record_vkDestroyDevice(VkDevice device, const VkAllocationCallbacks* pAllocator) {
+//   abi_args[0] = (uintptr_t)device;
+//   abi_args[1] = (uintptr_t)pAllocator;
+//   abi_nargs = 2;
+// }
+//
+// VkResult VKAPI_CALL record_vkCreateBuffer(VkDevice device, const VkBufferCreateInfo* pCreateInfo, const VkAllocationCallbacks* pAllocator, VkBuffer* pBuffer) {
+//   *pBuffer = (VkBuffer)pCreateInfo->size;
+//   return VK_SUCCESS;
+// }
+//
+// VkResult VKAPI_CALL record_vkSetDebugUtilsObjectNameEXT(VkDevice device, const VkDebugUtilsObjectNameInfoEXT* pNameInfo) {
+//   abi_args[0] = (uintptr_t)device;
+//   abi_args[1] = pNameInfo->objectType;
+//   abi_args[2] = pNameInfo->objectHandle;
+//   abi_nargs = 3;
+//   return VK_SUCCESS;
+// }
+//
+// VkResult VKAPI_CALL record_vkDebugMarkerSetObjectNameEXT(VkDevice device, const VkDebugMarkerObjectNameInfoEXT* pNameInfo) {
+//   abi_args[0] = (uintptr_t)device;
+//   abi_args[1] = pNameInfo->objectType;
+//   abi_args[2] = pNameInfo->object;
+//   abi_nargs = 3;
+//   return VK_SUCCESS;
+// }
+//
+// // The memory functions count the DeviceMemory objects allocated and
+// // mapped, all are mapped at abi_memory. The allocations larger than
+// // abi_memory_limit fail, if it is not 0.
+// char abi_memory[1 << 20];
+// int abi_allocated, abi_mapped;
+// uint64_t abi_next_memory, abi_memory_limit;
+//
+// VkResult VKAPI_CALL memory_vkAllocateMemory(VkDevice device, const VkMemoryAllocateInfo* pAllocateInfo, const VkAllocationCallbacks* pAllocator, VkDeviceMemory* pMemory) {
+//   abi_args[0] = pAllocateInfo->allocationSize;
+//   abi_args[1] = pAllocateInfo->memoryTypeIndex;
+//   abi_nargs = 2;
+//   if (abi_memory_limit != 0 && pAllocateInfo->allocationSize > abi_memory_limit) {
+//     return VK_ERROR_OUT_OF_DEVICE_MEMORY;
+//   }
+//   *pMemory = (VkDeviceMemory)++abi_next_memory;
+//   abi_allocated++;
+//   return VK_SUCCESS;
+// }
+//
+// void VKAPI_CALL memory_vkFreeMemory(VkDevice device, VkDeviceMemory memory, const VkAllocationCallbacks* pAllocator) {
+//   abi_allocated--;
+// }
+//
+// VkResult VKAPI_CALL memory_vkMapMemory(VkDevice device, VkDeviceMemory memory, VkDeviceSize offset, VkDeviceSize size, VkMemoryMapFlags flags, void** ppData) {
+//   *ppData = abi_memory;
+//   abi_mapped++;
+//   return VK_SUCCESS;
+// }
+//
+// void VKAPI_CALL memory_vkUnmapMemory(VkDevice device, VkDeviceMemory memory) {
+//   abi_mapped--;
+// }
+//
+// // The memory properties are of two heaps of 1 GiB and 256 MiB, with half
+// // of them for the budget and 1 and 2 bytes in use.
+// void VKAPI_CALL memory_vkGetPhysicalDeviceMemoryProperties(VkPhysicalDevice physicalDevice, VkPhysicalDeviceMemoryProperties* pMemoryProperties) {
+//   pMemoryProperties->memoryHeapCount = 2;
+//   pMemoryProperties->memoryHeaps[0].size = 1 << 30;
+//   pMemoryProperties->memoryHeaps[1].size = 1 << 28;
+// }
+//
+// void VKAPI_CALL memory_vkGetPhysicalDeviceMemoryProperties2(VkPhysicalDevice physicalDevice, VkPhysicalDeviceMemoryProperties2* pMemoryProperties) {
+//   memory_vkGetPhysicalDeviceMemoryProperties(physicalDevice, &pMemoryProperties->memoryProperties);
+//   VkPhysicalDeviceMemoryBudgetPropertiesEXT* budget = pMemoryProperties->pNext;
+//   if (budget != NULL && budget->sType == VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MEMORY_BUDGET_PROPERTIES_EXT) {
+//     for (int i = 0; i < 2; i++) {
+//       budget->heapBudget[i] = pMemoryProperties->memoryProperties.memoryHeaps[i].size / 2;
+//       budget->heapUsage[i] = i + 1;
+//     }
+//   }
+// }
+//
+// // The enumerate functions list 1 item on their first call and 2 after, as
+// // if an item was added between the calls of an enumeration.
+// int abi_enumerations;
+//
+// static VkResult enumerate(uint32_t* pCount, int fill) {
+//   uint32_t n = abi_enumerations++ == 0 ? 1 : 2;
+//   if (!fill) {
+//     *pCount = n;
+//     return VK_SUCCESS;
+//   }
+//   VkResult ret = *pCount < n ? VK_INCOMPLETE : VK_SUCCESS;
+//   if (*pCount > n) {
+//     *pCount = n;
+//   }
+//   return ret;
+// }
+//
+// VkResult VKAPI_CALL enumerate_vkEnumerateInstanceExtensionProperties(const char* pLayerName, uint32_t* pPropertyCount, VkExtensionProperties* pProperties) {
+//   abi_args[0] = pLayerName != NULL;
+//   abi_nargs = 1;
+//   VkResult ret = enumerate(pPropertyCount, pProperties != NULL);
+//   for (uint32_t i = 0; pProperties != NULL && i < *pPropertyCount; i++) {
+//     snprintf(pProperties[i].extensionName, VK_MAX_EXTENSION_NAME_SIZE, "VK_EXT_%u", i);
+//     pProperties[i].specVersion = i + 1;
+//   }
+//   return ret;
+// }
+//
+// VkResult VKAPI_CALL enumerate_vkEnumerateInstanceLayerProperties(uint32_t* pPropertyCount, VkLayerProperties* pProperties) {
+//   VkResult ret = enumerate(pPropertyCount, pProperties != NULL);
+//   for (uint32_t i = 0; pProperties != NULL && i < *pPropertyCount; i++) {
+//     snprintf(pProperties[i].layerName, VK_MAX_EXTENSION_NAME_SIZE, "VK_LAYER_%u", i);
+//   }
+//   return ret;
+// }
+//
+// VkResult VKAPI_CALL enumerate_vkEnumerateDeviceExtensionProperties(VkPhysicalDevice physicalDevice, const char* pLayerName, uint32_t* pPropertyCount, VkExtensionProperties* pProperties) {
+//   VkResult ret = enumerate_vkEnumerateInstanceExtensionProperties(pLayerName, pPropertyCount, pProperties);
+//   abi_args[1] = abi_args[0];
+//   abi_args[0] = (uintptr_t)physicalDevice;
+//   abi_nargs = 2;
+//   return ret;
+// }
+import "C"
+
+import "unsafe"
+
+// The addresses of the record functions, for the Pfn types of package vk.
+// GetBufferOpaqueCaptureAddress returns the complement of the buffer.
+// CreateDevice returns the physical device plus one, CreateBuffer the size
+// of the buffer. AllocateDescriptorSets returns the pool plus 1, 2 and so
+// on, QueueSubmit records the fence before the command buffer count of the
+// last submit and returns ERROR_DEVICE_LOST.
+var (
+	DestroyBuffer                 = uintptr(unsafe.Pointer(C.record_vkDestroyBuffer))
+	MapMemory                     = uintptr(unsafe.Pointer(C.record_vkMapMemory))
+	CmdFillBuffer                 = uintptr(unsafe.Pointer(C.record_vkCmdFillBuffer))
+	GetBufferOpaqueCaptureAddress = uintptr(unsafe.Pointer(C.record_vkGetBufferOpaqueCaptureAddress))
+	CreateDevice                  = uintptr(unsafe.Pointer(C.record_vkCreateDevice))
+	DestroyDevice                 = uintptr(unsafe.Pointer(C.record_vkDestroyDevice))
+	CreateBuffer                  = uintptr(unsafe.Pointer(C.record_vkCreateBuffer))
+	SetDebugUtilsObjectNameEXT    = uintptr(unsafe.Pointer(C.record_vkSetDebugUtilsObjectNameEXT))
+	DebugMarkerSetObjectNameEXT   = uintptr(unsafe.Pointer(C.record_vkDebugMarkerSetObjectNameEXT))
+	AllocateDescriptorSets        = uintptr(unsafe.Pointer(C.record_vkAllocateDescriptorSets))
+	QueueSubmit                   = uintptr(unsafe.Pointer(C.record_vkQueueSubmit))
+)
+
+// The addresses of the memory functions, for the Pfn types of package vk.
+// AllocateDeviceMemory records the size and the memory type.
+var (
+	AllocateDeviceMemory = uintptr(unsafe.Pointer(C.memory_vkAllocateMemory))
+	FreeDeviceMemory     = uintptr(unsafe.Pointer(C.memory_vkFreeMemory))
+	MapDeviceMemory      = uintptr(unsafe.Pointer(C.memory_vkMapMemory))
+	UnmapDeviceMemory    = uintptr(unsafe.Pointer(C.memory_vkUnmapMemory))
+
+	GetPhysicalDeviceMemoryProperties  = uintptr(unsafe.Pointer(C.memory_vkGetPhysicalDeviceMemoryProperties))
+	GetPhysicalDeviceMemoryProperties2 = uintptr(unsafe.Pointer(C.memory_vkGetPhysicalDeviceMemoryProperties2))
+)
+
+// Memory returns the number of DeviceMemory objects allocated and mapped by
+// the memory functions.
+func Memory() (allocated, mapped int) {
+	return int(C.abi_allocated), int(C.abi_mapped)
+}
+
+// SetMemoryLimit makes the memory functions fail the allocations of more
+// than n bytes, 0 lifts the limit.
+func SetMemoryLimit(n uint64) {
+	C.abi_memory_limit = C.uint64_t(n)
+}
+
+// The addresses of the enumerate functions, for the Pfn types of package
+// vk. The extensions are named VK_EXT_0 and VK_EXT_1, of spec version 1 and
+// 2, the layers VK_LAYER_0 and VK_LAYER_1. The extension functions record
+// whether they are given a layer name, after the physical device.
+var (
+	EnumerateInstanceExtensionProperties = uintptr(unsafe.Pointer(C.enumerate_vkEnumerateInstanceExtensionProperties))
+	EnumerateInstanceLayerProperties     = uintptr(unsafe.Pointer(C.enumerate_vkEnumerateInstanceLayerProperties))
+	EnumerateDeviceExtensionProperties   = uintptr(unsafe.Pointer(C.enumerate_vkEnumerateDeviceExtensionProperties))
+)
+
+// Enumerations returns the number of calls to the enumerate functions since
+// the last call to Enumerations, the next enumeration starts with 1 item.
+func Enumerations() int {
+	n := int(C.abi_enumerations)
+	C.abi_enumerations = 0
+	return n
+}
+
+// Args returns the arguments of the last call to a record function.
+func Args() []uint64 {
+	a := make([]uint64, C.abi_nargs)
+	for i := range a {
+		a[i] = uint64(C.abi_args[i])
+	}
+	return a
+}
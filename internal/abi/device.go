@@ -0,0 +1,556 @@
+package abi
+
+// #include <pthread.h>
+// #include <stdlib.h>
+// #include <string.h>
+// #include "vulkan/vulkan.h"
+//
+// // The device functions log their calls, the arguments as 64-bit integers
+// // and the handles they make last. They return VK_SUCCESS, or the results
+// // queued for them by SetResults, one a call.
+// typedef struct {
+//   const char* name;
+//   uint64_t args[8];
+//   int nargs;
+// } device_call;
+//
+// typedef struct {
+//   char name[64];
+//   VkResult result;
+// } device_result;
+//
+// device_call device_calls[4096];
+// int device_ncalls;
+// device_result device_results[64];
+// int device_nresults;
+// pthread_mutex_t device_mu = PTHREAD_MUTEX_INITIALIZER;
+//
+// static VkResult device_log(const char* name, const uint64_t* args, int nargs) {
+//   VkResult ret = VK_SUCCESS;
+//   pthread_mutex_lock(&device_mu);
+//   if (device_ncalls < 4096) {
+//     device_call* c = &device_calls[device_ncalls++];
+//     c->name = name;
+//     memcpy(c->args, args, nargs * sizeof(uint64_t));
+//     c->nargs = nargs;
+//   }
+//   for (int i = 0; i < device_nresults; i++) {
+//     if (strcmp(device_results[i].name, name) == 0) {
+//       ret = device_results[i].result;
+//       memmove(&device_results[i], &device_results[i + 1], (device_nresults - i - 1) * sizeof(device_result));
+//       device_nresults--;
+//       break;
+//     }
+//   }
+//   pthread_mutex_unlock(&device_mu);
+//   return ret;
+// }
+//
+// #define LOG(name, ...) device_log(name, (uint64_t[]){__VA_ARGS__}, sizeof((uint64_t[]){__VA_ARGS__}) / sizeof(uint64_t))
+// #define H(h) ((uint64_t)(h))
+// #define P(p) ((uint64_t)(uintptr_t)(p))
+//
+// void device_set_result(const char* name, VkResult result) {
+//   pthread_mutex_lock(&device_mu);
+//   if (device_nresults < 64) {
+//     strncpy(device_results[device_nresults].name, name, 63);
+//     device_results[device_nresults++].result = result;
+//   }
+//   pthread_mutex_unlock(&device_mu);
+// }
+//
+// // The handles are numbered from 0x10000, the sizes of the buffers and images
+// // are kept for their memory requirements.
+// uint64_t device_handles = 0xFFFF;
+// VkDeviceSize device_sizes[4096];
+//
+// static uint64_t device_handle(VkDeviceSize size) {
+//   pthread_mutex_lock(&device_mu);
+//   uint64_t h = ++device_handles;
+//   device_sizes[h % 4096] = size;
+//   pthread_mutex_unlock(&device_mu);
+//   return h;
+// }
+//
+// // The memory types are all of one heap of 16 MiB, the memory is mapped at
+// // device_memory. The surface is of device_extent, the swapchains of 3
+// // images acquired in turn.
+// char device_memory[4 << 20];
+// VkMemoryPropertyFlags device_memory_types[VK_MAX_MEMORY_TYPES];
+// uint32_t device_memory_type_count;
+// uint32_t device_extent[2];
+// uint32_t device_image;
+//
+// void device_reset(void) {
+//   pthread_mutex_lock(&device_mu);
+//   device_ncalls = 0;
+//   device_nresults = 0;
+//   device_memory_types[0] = VK_MEMORY_PROPERTY_DEVICE_LOCAL_BIT;
+//   device_memory_types[1] = VK_MEMORY_PROPERTY_HOST_VISIBLE_BIT | VK_MEMORY_PROPERTY_HOST_COHERENT_BIT;
+//   device_memory_type_count = 2;
+//   device_extent[0] = 800;
+//   device_extent[1] = 600;
+//   pthread_mutex_unlock(&device_mu);
+// }
+//
+// void VKAPI_CALL device_vkGetPhysicalDeviceProperties(VkPhysicalDevice physicalDevice, VkPhysicalDeviceProperties* pProperties) {
+//   memset(pProperties, 0, sizeof(*pProperties));
+//   pProperties->limits.bufferImageGranularity = 1;
+//   pProperties->limits.nonCoherentAtomSize = 64;
+//   pProperties->limits.maxMemoryAllocationCount = 4096;
+// }
+//
+// void VKAPI_CALL device_vkGetPhysicalDeviceMemoryProperties(VkPhysicalDevice physicalDevice, VkPhysicalDeviceMemoryProperties* pMemoryProperties) {
+//   memset(pMemoryProperties, 0, sizeof(*pMemoryProperties));
+//   pMemoryProperties->memoryTypeCount = device_memory_type_count;
+//   for (uint32_t i = 0; i < device_memory_type_count; i++) {
+//     pMemoryProperties->memoryTypes[i].propertyFlags = device_memory_types[i];
+//   }
+//   pMemoryProperties->memoryHeapCount = 1;
+//   pMemoryProperties->memoryHeaps[0].size = 16 << 20;
+//   pMemoryProperties->memoryHeaps[0].flags = VK_MEMORY_HEAP_DEVICE_LOCAL_BIT;
+// }
+//
+// VkResult VKAPI_CALL device_vkGetPhysicalDeviceSurfaceCapabilitiesKHR(VkPhysicalDevice physicalDevice, VkSurfaceKHR surface, VkSurfaceCapabilitiesKHR* pSurfaceCapabilities) {
+//   memset(pSurfaceCapabilities, 0, sizeof(*pSurfaceCapabilities));
+//   pSurfaceCapabilities->minImageCount = 2;
+//   pSurfaceCapabilities->maxImageCount = 3;
+//   pSurfaceCapabilities->currentExtent.width = device_extent[0];
+//   pSurfaceCapabilities->currentExtent.height = device_extent[1];
+//   pSurfaceCapabilities->maxImageExtent.width = 4096;
+//   pSurfaceCapabilities->maxImageExtent.height = 4096;
+//   pSurfaceCapabilities->maxImageArrayLayers = 1;
+//   pSurfaceCapabilities->supportedTransforms = VK_SURFACE_TRANSFORM_IDENTITY_BIT_KHR;
+//   pSurfaceCapabilities->currentTransform = VK_SURFACE_TRANSFORM_IDENTITY_BIT_KHR;
+//   pSurfaceCapabilities->supportedCompositeAlpha = VK_COMPOSITE_ALPHA_OPAQUE_BIT_KHR;
+//   return LOG("vkGetPhysicalDeviceSurfaceCapabilitiesKHR", H(surface), device_extent[0], device_extent[1]);
+// }
+//
+// VkResult VKAPI_CALL device_vkGetPhysicalDeviceSurfaceFormatsKHR(VkPhysicalDevice physicalDevice, VkSurfaceKHR surface, uint32_t* pSurfaceFormatCount, VkSurfaceFormatKHR* pSurfaceFormats) {
+//   *pSurfaceFormatCount = 1;
+//   if (pSurfaceFormats != NULL) {
+//     pSurfaceFormats[0].format = VK_FORMAT_B8G8R8A8_SRGB;
+//     pSurfaceFormats[0].colorSpace = VK_COLOR_SPACE_SRGB_NONLINEAR_KHR;
+//   }
+//   return VK_SUCCESS;
+// }
+//
+// VkResult VKAPI_CALL device_vkGetPhysicalDeviceSurfacePresentModesKHR(VkPhysicalDevice physicalDevice, VkSurfaceKHR surface, uint32_t* pPresentModeCount, VkPresentModeKHR* pPresentModes) {
+//   *pPresentModeCount = 1;
+//   if (pPresentModes != NULL) {
+//     pPresentModes[0] = VK_PRESENT_MODE_FIFO_KHR;
+//   }
+//   return VK_SUCCESS;
+// }
+//
+// VkResult VKAPI_CALL device_vkDeviceWaitIdle(VkDevice device) {
+//   return LOG("vkDeviceWaitIdle", P(device));
+// }
+//
+// VkResult VKAPI_CALL device_vkQueueSubmit(VkQueue queue, uint32_t submitCount, const VkSubmitInfo* pSubmits, VkFence fence) {
+//   if (submitCount == 0) {
+//     return LOG("vkQueueSubmit", P(queue), H(fence), 0);
+//   }
+//   return LOG("vkQueueSubmit", P(queue), H(fence), submitCount, pSubmits[0].commandBufferCount, pSubmits[0].commandBufferCount == 0 ? 0 : P(pSubmits[0].pCommandBuffers[0]), pSubmits[0].waitSemaphoreCount, pSubmits[0].signalSemaphoreCount);
+// }
+//
+// VkResult VKAPI_CALL device_vkQueuePresentKHR(VkQueue queue, const VkPresentInfoKHR* pPresentInfo) {
+//   return LOG("vkQueuePresentKHR", P(queue), H(pPresentInfo->pSwapchains[0]), pPresentInfo->pImageIndices[0], H(pPresentInfo->pWaitSemaphores[0]));
+// }
+//
+// VkResult VKAPI_CALL device_vkAllocateMemory(VkDevice device, const VkMemoryAllocateInfo* pAllocateInfo, const VkAllocationCallbacks* pAllocator, VkDeviceMemory* pMemory) {
+//   uint64_t h = device_handle(0);
+//   VkResult ret = LOG("vkAllocateMemory", pAllocateInfo->allocationSize, pAllocateInfo->memoryTypeIndex, h);
+//   if (ret == VK_SUCCESS) {
+//     *pMemory = (VkDeviceMemory)h;
+//   }
+//   return ret;
+// }
+//
+// void VKAPI_CALL device_vkFreeMemory(VkDevice device, VkDeviceMemory memory, const VkAllocationCallbacks* pAllocator) {
+//   LOG("vkFreeMemory", H(memory));
+// }
+//
+// VkResult VKAPI_CALL device_vkMapMemory(VkDevice device, VkDeviceMemory memory, VkDeviceSize offset, VkDeviceSize size, VkMemoryMapFlags flags, void** ppData) {
+//   *ppData = device_memory;
+//   return LOG("vkMapMemory", H(memory));
+// }
+//
+// void VKAPI_CALL device_vkUnmapMemory(VkDevice device, VkDeviceMemory memory) {
+//   LOG("vkUnmapMemory", H(memory));
+// }
+//
+// VkResult VKAPI_CALL device_vkFlushMappedMemoryRanges(VkDevice device, uint32_t memoryRangeCount, const VkMappedMemoryRange* pMemoryRanges) {
+//   return LOG("vkFlushMappedMemoryRanges", memoryRangeCount, H(pMemoryRanges[0].memory), pMemoryRanges[0].offset, pMemoryRanges[0].size);
+// }
+//
+// VkResult VKAPI_CALL device_vkCreateBuffer(VkDevice device, const VkBufferCreateInfo* pCreateInfo, const VkAllocationCallbacks* pAllocator, VkBuffer* pBuffer) {
+//   uint64_t h = device_handle(pCreateInfo->size);
+//   VkResult ret = LOG("vkCreateBuffer", pCreateInfo->size, pCreateInfo->usage, h);
+//   if (ret == VK_SUCCESS) {
+//     *pBuffer = (VkBuffer)h;
+//   }
+//   return ret;
+// }
+//
+// void VKAPI_CALL device_vkDestroyBuffer(VkDevice device, VkBuffer buffer, const VkAllocationCallbacks* pAllocator) {
+//   LOG("vkDestroyBuffer", H(buffer));
+// }
+//
+// void VKAPI_CALL device_vkGetBufferMemoryRequirements(VkDevice device, VkBuffer buffer, VkMemoryRequirements* pMemoryRequirements) {
+//   pMemoryRequirements->size = device_sizes[H(buffer) % 4096];
+//   pMemoryRequirements->alignment = 256;
+//   pMemoryRequirements->memoryTypeBits = (1u << device_memory_type_count) - 1;
+// }
+//
+// VkResult VKAPI_CALL device_vkBindBufferMemory(VkDevice device, VkBuffer buffer, VkDeviceMemory memory, VkDeviceSize memoryOffset) {
+//   return LOG("vkBindBufferMemory", H(buffer), H(memory), memoryOffset);
+// }
+//
+// // The images take 4 bytes a texel.
+// VkResult VKAPI_CALL device_vkCreateImage(VkDevice device, const VkImageCreateInfo* pCreateInfo, const VkAllocationCallbacks* pAllocator, VkImage* pImage) {
+//   const VkExtent3D* e = &pCreateInfo->extent;
+//   uint64_t h = device_handle((VkDeviceSize)e->width * e->height * e->depth * pCreateInfo->arrayLayers * 4);
+//   VkResult ret = LOG("vkCreateImage", pCreateInfo->format, e->width, e->height, pCreateInfo->usage, h);
+//   if (ret == VK_SUCCESS) {
+//     *pImage = (VkImage)h;
+//   }
+//   return ret;
+// }
+//
+// void VKAPI_CALL device_vkDestroyImage(VkDevice device, VkImage image, const VkAllocationCallbacks* pAllocator) {
+//   LOG("vkDestroyImage", H(image));
+// }
+//
+// void VKAPI_CALL device_vkGetImageMemoryRequirements(VkDevice device, VkImage image, VkMemoryRequirements* pMemoryRequirements) {
+//   pMemoryRequirements->size = device_sizes[H(image) % 4096];
+//   pMemoryRequirements->alignment = 1024;
+//   pMemoryRequirements->memoryTypeBits = (1u << device_memory_type_count) - 1;
+// }
+//
+// VkResult VKAPI_CALL device_vkBindImageMemory(VkDevice device, VkImage image, VkDeviceMemory memory, VkDeviceSize memoryOffset) {
+//   return LOG("vkBindImageMemory", H(image), H(memory), memoryOffset);
+// }
+//
+// VkResult VKAPI_CALL device_vkCreateImageView(VkDevice device, const VkImageViewCreateInfo* pCreateInfo, const VkAllocationCallbacks* pAllocator, VkImageView* pView) {
+//   uint64_t h = device_handle(0);
+//   VkResult ret = LOG("vkCreateImageView", H(pCreateInfo->image), pCreateInfo->viewType, h);
+//   if (ret == VK_SUCCESS) {
+//     *pView = (VkImageView)h;
+//   }
+//   return ret;
+// }
+//
+// void VKAPI_CALL device_vkDestroyImageView(VkDevice device, VkImageView imageView, const VkAllocationCallbacks* pAllocator) {
+//   LOG("vkDestroyImageView", H(imageView));
+// }
+//
+// VkResult VKAPI_CALL device_vkCreateFence(VkDevice device, const VkFenceCreateInfo* pCreateInfo, const VkAllocationCallbacks* pAllocator, VkFence* pFence) {
+//   uint64_t h = device_handle(0);
+//   VkResult ret = LOG("vkCreateFence", pCreateInfo->flags, h);
+//   if (ret == VK_SUCCESS) {
+//     *pFence = (VkFence)h;
+//   }
+//   return ret;
+// }
+//
+// void VKAPI_CALL device_vkDestroyFence(VkDevice device, VkFence fence, const VkAllocationCallbacks* pAllocator) {
+//   LOG("vkDestroyFence", H(fence));
+// }
+//
+// VkResult VKAPI_CALL device_vkWaitForFences(VkDevice device, uint32_t fenceCount, const VkFence* pFences, VkBool32 waitAll, uint64_t timeout) {
+//   return LOG("vkWaitForFences", fenceCount, H(pFences[0]), timeout);
+// }
+//
+// VkResult VKAPI_CALL device_vkResetFences(VkDevice device, uint32_t fenceCount, const VkFence* pFences) {
+//   return LOG("vkResetFences", fenceCount, H(pFences[0]));
+// }
+//
+// VkResult VKAPI_CALL device_vkGetFenceStatus(VkDevice device, VkFence fence) {
+//   return LOG("vkGetFenceStatus", H(fence));
+// }
+//
+// VkResult VKAPI_CALL device_vkCreateSemaphore(VkDevice device, const VkSemaphoreCreateInfo* pCreateInfo, const VkAllocationCallbacks* pAllocator, VkSemaphore* pSemaphore) {
+//   uint64_t h = device_handle(0);
+//   VkResult ret = LOG("vkCreateSemaphore", h);
+//   if (ret == VK_SUCCESS) {
+//     *pSemaphore = (VkSemaphore)h;
+//   }
+//   return ret;
+// }
+//
+// void VKAPI_CALL device_vkDestroySemaphore(VkDevice device, VkSemaphore semaphore, const VkAllocationCallbacks* pAllocator) {
+//   LOG("vkDestroySemaphore", H(semaphore));
+// }
+//
+// VkResult VKAPI_CALL device_vkCreateCommandPool(VkDevice device, const VkCommandPoolCreateInfo* pCreateInfo, const VkAllocationCallbacks* pAllocator, VkCommandPool* pCommandPool) {
+//   uint64_t h = device_handle(0);
+//   VkResult ret = LOG("vkCreateCommandPool", pCreateInfo->flags, pCreateInfo->queueFamilyIndex, h);
+//   if (ret == VK_SUCCESS) {
+//     *pCommandPool = (VkCommandPool)h;
+//   }
+//   return ret;
+// }
+//
+// void VKAPI_CALL device_vkDestroyCommandPool(VkDevice device, VkCommandPool commandPool, const VkAllocationCallbacks* pAllocator) {
+//   LOG("vkDestroyCommandPool", H(commandPool));
+// }
+//
+// VkResult VKAPI_CALL device_vkAllocateCommandBuffers(VkDevice device, const VkCommandBufferAllocateInfo* pAllocateInfo, VkCommandBuffer* pCommandBuffers) {
+//   uint64_t h = device_handle(0);
+//   VkResult ret = LOG("vkAllocateCommandBuffers", H(pAllocateInfo->commandPool), pAllocateInfo->commandBufferCount, h);
+//   for (uint32_t i = 0; ret == VK_SUCCESS && i < pAllocateInfo->commandBufferCount; i++) {
+//     pCommandBuffers[i] = (VkCommandBuffer)(uintptr_t)(i == 0 ? h : device_handle(0));
+//   }
+//   return ret;
+// }
+//
+// void VKAPI_CALL device_vkFreeCommandBuffers(VkDevice device, VkCommandPool commandPool, uint32_t commandBufferCount, const VkCommandBuffer* pCommandBuffers) {
+//   LOG("vkFreeCommandBuffers", H(commandPool), commandBufferCount, P(pCommandBuffers[0]));
+// }
+//
+// VkResult VKAPI_CALL device_vkBeginCommandBuffer(VkCommandBuffer commandBuffer, const VkCommandBufferBeginInfo* pBeginInfo) {
+//   return LOG("vkBeginCommandBuffer", P(commandBuffer), pBeginInfo->flags);
+// }
+//
+// VkResult VKAPI_CALL device_vkEndCommandBuffer(VkCommandBuffer commandBuffer) {
+//   return LOG("vkEndCommandBuffer", P(commandBuffer));
+// }
+//
+// void VKAPI_CALL device_vkCmdCopyBuffer(VkCommandBuffer commandBuffer, VkBuffer srcBuffer, VkBuffer dstBuffer, uint32_t regionCount, const VkBufferCopy* pRegions) {
+//   LOG("vkCmdCopyBuffer", P(commandBuffer), H(srcBuffer), H(dstBuffer), regionCount, pRegions[0].srcOffset, pRegions[0].dstOffset, pRegions[0].size);
+// }
+//
+// void VKAPI_CALL device_vkCmdCopyBufferToImage(VkCommandBuffer commandBuffer, VkBuffer srcBuffer, VkImage dstImage, VkImageLayout dstImageLayout, uint32_t regionCount, const VkBufferImageCopy* pRegions) {
+//   LOG("vkCmdCopyBufferToImage", P(commandBuffer), H(srcBuffer), H(dstImage), dstImageLayout, regionCount, pRegions[0].bufferOffset);
+// }
+//
+// // The barriers are logged after vkCmdPipelineBarrier, named after their
+// // structure.
+// void VKAPI_CALL device_vkCmdPipelineBarrier(VkCommandBuffer commandBuffer, VkPipelineStageFlags srcStageMask, VkPipelineStageFlags dstStageMask, VkDependencyFlags dependencyFlags, uint32_t memoryBarrierCount, const VkMemoryBarrier* pMemoryBarriers, uint32_t bufferMemoryBarrierCount, const VkBufferMemoryBarrier* pBufferMemoryBarriers, uint32_t imageMemoryBarrierCount, const VkImageMemoryBarrier* pImageMemoryBarriers) {
+//   LOG("vkCmdPipelineBarrier", P(commandBuffer), srcStageMask, dstStageMask, memoryBarrierCount, bufferMemoryBarrierCount, imageMemoryBarrierCount);
+//   for (uint32_t i = 0; i < bufferMemoryBarrierCount; i++) {
+//     const VkBufferMemoryBarrier* b = &pBufferMemoryBarriers[i];
+//     LOG("VkBufferMemoryBarrier", H(b->buffer), b->srcAccessMask, b->dstAccessMask, b->srcQueueFamilyIndex, b->dstQueueFamilyIndex, b->offset, b->size);
+//   }
+//   for (uint32_t i = 0; i < imageMemoryBarrierCount; i++) {
+//     const VkImageMemoryBarrier* b = &pImageMemoryBarriers[i];
+//     LOG("VkImageMemoryBarrier", H(b->image), b->srcAccessMask, b->dstAccessMask, b->oldLayout, b->newLayout, b->srcQueueFamilyIndex, b->dstQueueFamilyIndex);
+//   }
+// }
+//
+// VkResult VKAPI_CALL device_vkCreateSwapchainKHR(VkDevice device, const VkSwapchainCreateInfoKHR* pCreateInfo, const VkAllocationCallbacks* pAllocator, VkSwapchainKHR* pSwapchain) {
+//   uint64_t h = device_handle(0);
+//   VkResult ret = LOG("vkCreateSwapchainKHR", pCreateInfo->minImageCount, pCreateInfo->imageExtent.width, pCreateInfo->imageExtent.height, H(pCreateInfo->oldSwapchain), h);
+//   if (ret == VK_SUCCESS) {
+//     *pSwapchain = (VkSwapchainKHR)h;
+//     device_image = 0;
+//   }
+//   return ret;
+// }
+//
+// void VKAPI_CALL device_vkDestroySwapchainKHR(VkDevice device, VkSwapchainKHR swapchain, const VkAllocationCallbacks* pAllocator) {
+//   LOG("vkDestroySwapchainKHR", H(swapchain));
+// }
+//
+// VkResult VKAPI_CALL device_vkGetSwapchainImagesKHR(VkDevice device, VkSwapchainKHR swapchain, uint32_t* pSwapchainImageCount, VkImage* pSwapchainImages) {
+//   if (pSwapchainImages == NULL) {
+//     *pSwapchainImageCount = 3;
+//     return VK_SUCCESS;
+//   }
+//   for (uint32_t i = 0; i < *pSwapchainImageCount && i < 3; i++) {
+//     pSwapchainImages[i] = (VkImage)device_handle(0);
+//   }
+//   return *pSwapchainImageCount < 3 ? VK_INCOMPLETE : VK_SUCCESS;
+// }
+//
+// VkResult VKAPI_CALL device_vkAcquireNextImageKHR(VkDevice device, VkSwapchainKHR swapchain, uint64_t timeout, VkSemaphore semaphore, VkFence fence, uint32_t* pImageIndex) {
+//   uint32_t index = device_image % 3;
+//   VkResult ret = LOG("vkAcquireNextImageKHR", H(swapchain), H(semaphore), index);
+//   if (ret == VK_SUCCESS || ret == VK_SUBOPTIMAL_KHR) {
+//     *pImageIndex = index;
+//     device_image++;
+//   }
+//   return ret;
+// }
+//
+// VkResult VKAPI_CALL device_vkCreateDescriptorPool(VkDevice device, const VkDescriptorPoolCreateInfo* pCreateInfo, const VkAllocationCallbacks* pAllocator, VkDescriptorPool* pDescriptorPool) {
+//   uint64_t h = device_handle(0);
+//   VkResult ret = LOG("vkCreateDescriptorPool", pCreateInfo->flags, pCreateInfo->maxSets, pCreateInfo->poolSizeCount, h);
+//   if (ret == VK_SUCCESS) {
+//     *pDescriptorPool = (VkDescriptorPool)h;
+//   }
+//   return ret;
+// }
+//
+// void VKAPI_CALL device_vkDestroyDescriptorPool(VkDevice device, VkDescriptorPool descriptorPool, const VkAllocationCallbacks* pAllocator) {
+//   LOG("vkDestroyDescriptorPool", H(descriptorPool));
+// }
+//
+// VkResult VKAPI_CALL device_vkResetDescriptorPool(VkDevice device, VkDescriptorPool descriptorPool, VkDescriptorPoolResetFlags flags) {
+//   return LOG("vkResetDescriptorPool", H(descriptorPool));
+// }
+//
+// VkResult VKAPI_CALL device_vkAllocateDescriptorSets(VkDevice device, const VkDescriptorSetAllocateInfo* pAllocateInfo, VkDescriptorSet* pDescriptorSets) {
+//   uint64_t h = device_handle(0);
+//   VkResult ret = LOG("vkAllocateDescriptorSets", H(pAllocateInfo->descriptorPool), pAllocateInfo->descriptorSetCount, h);
+//   for (uint32_t i = 0; ret == VK_SUCCESS && i < pAllocateInfo->descriptorSetCount; i++) {
+//     pDescriptorSets[i] = (VkDescriptorSet)(i == 0 ? h : device_handle(0));
+//   }
+//   return ret;
+// }
+//
+// typedef struct {
+//   const char* name;
+//   PFN_vkVoidFunction fn;
+// } device_proc;
+//
+// #define PROC(name) {#name, (PFN_vkVoidFunction)device_##name}
+//
+// const device_proc device_procs[] = {
+//   PROC(vkGetPhysicalDeviceProperties),
+//   PROC(vkGetPhysicalDeviceMemoryProperties),
+//   PROC(vkGetPhysicalDeviceSurfaceCapabilitiesKHR),
+//   PROC(vkGetPhysicalDeviceSurfaceFormatsKHR),
+//   PROC(vkGetPhysicalDeviceSurfacePresentModesKHR),
+//   PROC(vkDeviceWaitIdle),
+//   PROC(vkQueueSubmit),
+//   PROC(vkQueuePresentKHR),
+//   PROC(vkAllocateMemory),
+//   PROC(vkFreeMemory),
+//   PROC(vkMapMemory),
+//   PROC(vkUnmapMemory),
+//   PROC(vkFlushMappedMemoryRanges),
+//   PROC(vkCreateBuffer),
+//   PROC(vkDestroyBuffer),
+//   PROC(vkGetBufferMemoryRequirements),
+//   PROC(vkBindBufferMemory),
+//   PROC(vkCreateImage),
+//   PROC(vkDestroyImage),
+//   PROC(vkGetImageMemoryRequirements),
+//   PROC(vkBindImageMemory),
+//   PROC(vkCreateImageView),
+//   PROC(vkDestroyImageView),
+//   PROC(vkCreateFence),
+//   PROC(vkDestroyFence),
+//   PROC(vkWaitForFences),
+//   PROC(vkResetFences),
+//   PROC(vkGetFenceStatus),
+//   PROC(vkCreateSemaphore),
+//   PROC(vkDestroySemaphore),
+//   PROC(vkCreateCommandPool),
+//   PROC(vkDestroyCommandPool),
+//   PROC(vkAllocateCommandBuffers),
+//   PROC(vkFreeCommandBuffers),
+//   PROC(vkBeginCommandBuffer),
+//   PROC(vkEndCommandBuffer),
+//   PROC(vkCmdCopyBuffer),
+//   PROC(vkCmdCopyBufferToImage),
+//   PROC(vkCmdPipelineBarrier),
+//   PROC(vkCreateSwapchainKHR),
+//   PROC(vkDestroySwapchainKHR),
+//   PROC(vkGetSwapchainImagesKHR),
+//   PROC(vkAcquireNextImageKHR),
+//   PROC(vkCreateDescriptorPool),
+//   PROC(vkDestroyDescriptorPool),
+//   PROC(vkResetDescriptorPool),
+//   PROC(vkAllocateDescriptorSets),
+// };
+//
+// PFN_vkVoidFunction device_proc_addr(const char* pName) {
+//   for (size_t i = 0; i < sizeof(device_procs) / sizeof(device_procs[0]); i++) {
+//     if (strcmp(device_procs[i].name, pName) == 0) {
+//       return device_procs[i].fn;
+//     }
+//   }
+//   return NULL;
+// }
+//
+// PFN_vkVoidFunction VKAPI_CALL device_vkGetDeviceProcAddr(VkDevice device, const char* pName) {
+//   return device_proc_addr(pName);
+// }
+//
+// void device_lock(void) { pthread_mutex_lock(&device_mu); }
+// void device_unlock(void) { pthread_mutex_unlock(&device_mu); }
+import "C"
+
+import "unsafe"
+
+// The device functions fake a physical device and a device of one heap
+// and of the memory types set by SetMemoryTypes, for the tables of package
+// vkx: GetDeviceProcAddr returns the device functions to
+// PhysicalDevice.NewDevice, Proc those of the instance table. Their calls
+// are logged and read by Calls. The dispatchable handles given to them must
+// be 0x1000 or more, lower ones are bad pointers to the checks of -race.
+var GetDeviceProcAddr = uintptr(unsafe.Pointer(C.device_vkGetDeviceProcAddr))
+
+// Proc returns the address of the device function name, e.g.
+// "vkGetPhysicalDeviceMemoryProperties", or 0.
+func Proc(name string) uintptr {
+	pName := C.CString(name)
+	defer C.free(unsafe.Pointer(pName))
+	return uintptr(unsafe.Pointer(C.device_proc_addr(pName)))
+}
+
+func init() {
+	C.device_reset()
+}
+
+// Call is a call of a device function. The arguments are those it was
+// given, most handles and the fields of the structures, the handles it
+// made last. The barriers of vkCmdPipelineBarrier follow it as calls named
+// VkBufferMemoryBarrier and VkImageMemoryBarrier.
+type Call struct {
+	Name string
+	Args []uint64
+}
+
+// Calls returns the calls of the device functions since the last call to
+// Calls or Reset, 4096 at most.
+func Calls() []Call {
+	C.device_lock()
+	defer C.device_unlock()
+	var calls []Call
+	for _, c := range C.device_calls[:C.device_ncalls] {
+		call := Call{Name: C.GoString(c.name)}
+		for _, a := range c.args[:c.nargs] {
+			call.Args = append(call.Args, uint64(a))
+		}
+		calls = append(calls, call)
+	}
+	C.device_ncalls = 0
+	return calls
+}
+
+// SetResults makes the next calls of the device function name return
+// results, one a call, before VK_SUCCESS again.
+func SetResults(name string, results ...int32) {
+	pName := C.CString(name)
+	defer C.free(unsafe.Pointer(pName))
+	for _, r := range results {
+		C.device_set_result(pName, C.VkResult(r))
+	}
+}
+
+// SetMemoryTypes sets the property flags of the memory types, by default
+// DEVICE_LOCAL and HOST_VISIBLE | HOST_COHERENT.
+func SetMemoryTypes(flags ...uint32) {
+	C.device_lock()
+	defer C.device_unlock()
+	for i, f := range flags {
+		C.device_memory_types[i] = C.VkMemoryPropertyFlags(f)
+	}
+	C.device_memory_type_count = C.uint32_t(len(flags))
+}
+
+// SetSurfaceExtent sets the current extent of the surface, 800 by 600 by
+// default.
+func SetSurfaceExtent(width, height uint32) {
+	C.device_extent[0], C.device_extent[1] = C.uint32_t(width), C.uint32_t(height)
+}
+
+// Reset clears the calls and the results of the device functions, and
+// restores the memory types and the surface extent.
+func Reset() {
+	C.device_reset()
+}
@@ -0,0 +1,1675 @@
+// Package abi reports how C lays out the Vulkan structs and passes the
+// arguments, the tests of package vk compare it with the Go side.
+//
+// This file is generated by vkgen.
+package abi
+
+// #cgo CFLAGS: -I${SRCDIR}/../..
+//
+// #include <stddef.h>
+// #include "vulkan/vulkan.h"
+//
+// const size_t layout_VkExtent2D[] = {sizeof(VkExtent2D), _Alignof(VkExtent2D), offsetof(VkExtent2D, width), offsetof(VkExtent2D, height)};
+// const size_t layout_VkExtent3D[] = {sizeof(VkExtent3D), _Alignof(VkExtent3D), offsetof(VkExtent3D, width), offsetof(VkExtent3D, height), offsetof(VkExtent3D, depth)};
+// const size_t layout_VkOffset2D[] = {sizeof(VkOffset2D), _Alignof(VkOffset2D), offsetof(VkOffset2D, x), offsetof(VkOffset2D, y)};
+// const size_t layout_VkOffset3D[] = {sizeof(VkOffset3D), _Alignof(VkOffset3D), offsetof(VkOffset3D, x), offsetof(VkOffset3D, y), offsetof(VkOffset3D, z)};
+// const size_t layout_VkRect2D[] = {sizeof(VkRect2D), _Alignof(VkRect2D), offsetof(VkRect2D, offset), offsetof(VkRect2D, extent)};
+// const size_t layout_VkBaseInStructure[] = {sizeof(VkBaseInStructure), _Alignof(VkBaseInStructure), offsetof(VkBaseInStructure, sType), offsetof(VkBaseInStructure, pNext)};
+// const size_t layout_VkBaseOutStructure[] = {sizeof(VkBaseOutStructure), _Alignof(VkBaseOutStructure), offsetof(VkBaseOutStructure, sType), offsetof(VkBaseOutStructure, pNext)};
+// const size_t layout_VkBufferMemoryBarrier[] = {sizeof(VkBufferMemoryBarrier), _Alignof(VkBufferMemoryBarrier), offsetof(VkBufferMemoryBarrier, sType), offsetof(VkBufferMemoryBarrier, pNext), offsetof(VkBufferMemoryBarrier, srcAccessMask), offsetof(VkBufferMemoryBarrier, dstAccessMask), offsetof(VkBufferMemoryBarrier, srcQueueFamilyIndex), offsetof(VkBufferMemoryBarrier, dstQueueFamilyIndex), offsetof(VkBufferMemoryBarrier, buffer), offsetof(VkBufferMemoryBarrier, offset), offsetof(VkBufferMemoryBarrier, size)};
+// const size_t layout_VkDispatchIndirectCommand[] = {sizeof(VkDispatchIndirectCommand), _Alignof(VkDispatchIndirectCommand), offsetof(VkDispatchIndirectCommand, x), offsetof(VkDispatchIndirectCommand, y), offsetof(VkDispatchIndirectCommand, z)};
+// const size_t layout_VkDrawIndexedIndirectCommand[] = {sizeof(VkDrawIndexedIndirectCommand), _Alignof(VkDrawIndexedIndirectCommand), offsetof(VkDrawIndexedIndirectCommand, indexCount), offsetof(VkDrawIndexedIndirectCommand, instanceCount), offsetof(VkDrawIndexedIndirectCommand, firstIndex), offsetof(VkDrawIndexedIndirectCommand, vertexOffset), offsetof(VkDrawIndexedIndirectCommand, firstInstance)};
+// const size_t layout_VkDrawIndirectCommand[] = {sizeof(VkDrawIndirectCommand), _Alignof(VkDrawIndirectCommand), offsetof(VkDrawIndirectCommand, vertexCount), offsetof(VkDrawIndirectCommand, instanceCount), offsetof(VkDrawIndirectCommand, firstVertex), offsetof(VkDrawIndirectCommand, firstInstance)};
+// const size_t layout_VkImageSubresourceRange[] = {sizeof(VkImageSubresourceRange), _Alignof(VkImageSubresourceRange), offsetof(VkImageSubresourceRange, aspectMask), offsetof(VkImageSubresourceRange, baseMipLevel), offsetof(VkImageSubresourceRange, levelCount), offsetof(VkImageSubresourceRange, baseArrayLayer), offsetof(VkImageSubresourceRange, layerCount)};
+// const size_t layout_VkImageMemoryBarrier[] = {sizeof(VkImageMemoryBarrier), _Alignof(VkImageMemoryBarrier), offsetof(VkImageMemoryBarrier, sType), offsetof(VkImageMemoryBarrier, pNext), offsetof(VkImageMemoryBarrier, srcAccessMask), offsetof(VkImageMemoryBarrier, dstAccessMask), offsetof(VkImageMemoryBarrier, oldLayout), offsetof(VkImageMemoryBarrier, newLayout), offsetof(VkImageMemoryBarrier, srcQueueFamilyIndex), offsetof(VkImageMemoryBarrier, dstQueueFamilyIndex), offsetof(VkImageMemoryBarrier, image), offsetof(VkImageMemoryBarrier, subresourceRange)};
+// const size_t layout_VkMemoryBarrier[] = {sizeof(VkMemoryBarrier), _Alignof(VkMemoryBarrier), offsetof(VkMemoryBarrier, sType), offsetof(VkMemoryBarrier, pNext), offsetof(VkMemoryBarrier, srcAccessMask), offsetof(VkMemoryBarrier, dstAccessMask)};
+// const size_t layout_VkPipelineCacheHeaderVersionOne[] = {sizeof(VkPipelineCacheHeaderVersionOne), _Alignof(VkPipelineCacheHeaderVersionOne), offsetof(VkPipelineCacheHeaderVersionOne, headerSize), offsetof(VkPipelineCacheHeaderVersionOne, headerVersion), offsetof(VkPipelineCacheHeaderVersionOne, vendorID), offsetof(VkPipelineCacheHeaderVersionOne, deviceID), offsetof(VkPipelineCacheHeaderVersionOne, pipelineCacheUUID)};
+// const size_t layout_VkAllocationCallbacks[] = {sizeof(VkAllocationCallbacks), _Alignof(VkAllocationCallbacks), offsetof(VkAllocationCallbacks, pUserData), offsetof(VkAllocationCallbacks, pfnAllocation), offsetof(VkAllocationCallbacks, pfnReallocation), offsetof(VkAllocationCallbacks, pfnFree), offsetof(VkAllocationCallbacks, pfnInternalAllocation), offsetof(VkAllocationCallbacks, pfnInternalFree)};
+// const size_t layout_VkApplicationInfo[] = {sizeof(VkApplicationInfo), _Alignof(VkApplicationInfo), offsetof(VkApplicationInfo, sType), offsetof(VkApplicationInfo, pNext), offsetof(VkApplicationInfo, pApplicationName), offsetof(VkApplicationInfo, applicationVersion), offsetof(VkApplicationInfo, pEngineName), offsetof(VkApplicationInfo, engineVersion), offsetof(VkApplicationInfo, apiVersion)};
+// const size_t layout_VkFormatProperties[] = {sizeof(VkFormatProperties), _Alignof(VkFormatProperties), offsetof(VkFormatProperties, linearTilingFeatures), offsetof(VkFormatProperties, optimalTilingFeatures), offsetof(VkFormatProperties, bufferFeatures)};
+// const size_t layout_VkImageFormatProperties[] = {sizeof(VkImageFormatProperties), _Alignof(VkImageFormatProperties), offsetof(VkImageFormatProperties, maxExtent), offsetof(VkImageFormatProperties, maxMipLevels), offsetof(VkImageFormatProperties, maxArrayLayers), offsetof(VkImageFormatProperties, sampleCounts), offsetof(VkImageFormatProperties, maxResourceSize)};
+// const size_t layout_VkInstanceCreateInfo[] = {sizeof(VkInstanceCreateInfo), _Alignof(VkInstanceCreateInfo), offsetof(VkInstanceCreateInfo, sType), offsetof(VkInstanceCreateInfo, pNext), offsetof(VkInstanceCreateInfo, flags), offsetof(VkInstanceCreateInfo, pApplicationInfo), offsetof(VkInstanceCreateInfo, enabledLayerCount), offsetof(VkInstanceCreateInfo, ppEnabledLayerNames), offsetof(VkInstanceCreateInfo, enabledExtensionCount), offsetof(VkInstanceCreateInfo, ppEnabledExtensionNames)};
+// const size_t layout_VkMemoryHeap[] = {sizeof(VkMemoryHeap), _Alignof(VkMemoryHeap), offsetof(VkMemoryHeap, size), offsetof(VkMemoryHeap, flags)};
+// const size_t layout_VkMemoryType[] = {sizeof(VkMemoryType), _Alignof(VkMemoryType), offsetof(VkMemoryType, propertyFlags), offsetof(VkMemoryType, heapIndex)};
+// const size_t layout_VkPhysicalDeviceFeatures[] = {sizeof(VkPhysicalDeviceFeatures), _Alignof(VkPhysicalDeviceFeatures), offsetof(VkPhysicalDeviceFeatures, robustBufferAccess), offsetof(VkPhysicalDeviceFeatures, fullDrawIndexUint32), offsetof(VkPhysicalDeviceFeatures, imageCubeArray), offsetof(VkPhysicalDeviceFeatures, independentBlend), offsetof(VkPhysicalDeviceFeatures, geometryShader), offsetof(VkPhysicalDeviceFeatures, tessellationShader), offsetof(VkPhysicalDeviceFeatures, sampleRateShading), offsetof(VkPhysicalDeviceFeatures, dualSrcBlend), offsetof(VkPhysicalDeviceFeatures, logicOp), offsetof(VkPhysicalDeviceFeatures, multiDrawIndirect), offsetof(VkPhysicalDeviceFeatures, drawIndirectFirstInstance), offsetof(VkPhysicalDeviceFeatures, depthClamp), offsetof(VkPhysicalDeviceFeatures, depthBiasClamp), offsetof(VkPhysicalDeviceFeatures, fillModeNonSolid), offsetof(VkPhysicalDeviceFeatures, depthBounds), offsetof(VkPhysicalDeviceFeatures, wideLines), offsetof(VkPhysicalDeviceFeatures, largePoints), offsetof(VkPhysicalDeviceFeatures, alphaToOne), offsetof(VkPhysicalDeviceFeatures, multiViewport), offsetof(VkPhysicalDeviceFeatures, samplerAnisotropy), offsetof(VkPhysicalDeviceFeatures, textureCompressionETC2), offsetof(VkPhysicalDeviceFeatures, textureCompressionASTC_LDR), offsetof(VkPhysicalDeviceFeatures, textureCompressionBC), offsetof(VkPhysicalDeviceFeatures, occlusionQueryPrecise), offsetof(VkPhysicalDeviceFeatures, pipelineStatisticsQuery), offsetof(VkPhysicalDeviceFeatures, vertexPipelineStoresAndAtomics), offsetof(VkPhysicalDeviceFeatures, fragmentStoresAndAtomics), offsetof(VkPhysicalDeviceFeatures, shaderTessellationAndGeometryPointSize), offsetof(VkPhysicalDeviceFeatures, shaderImageGatherExtended), offsetof(VkPhysicalDeviceFeatures, shaderStorageImageExtendedFormats), offsetof(VkPhysicalDeviceFeatures, shaderStorageImageMultisample), offsetof(VkPhysicalDeviceFeatures, shaderStorageImageReadWithoutFormat), offsetof(VkPhysicalDeviceFeatures, shaderStorageImageWriteWithoutFormat), offsetof(VkPhysicalDeviceFeatures, shaderUniformBufferArrayDynamicIndexing), offsetof(VkPhysicalDeviceFeatures, shaderSampledImageArrayDynamicIndexing), offsetof(VkPhysicalDeviceFeatures, shaderStorageBufferArrayDynamicIndexing), offsetof(VkPhysicalDeviceFeatures, shaderStorageImageArrayDynamicIndexing), offsetof(VkPhysicalDeviceFeatures, shaderClipDistance), offsetof(VkPhysicalDeviceFeatures, shaderCullDistance), offsetof(VkPhysicalDeviceFeatures, shaderFloat64), offsetof(VkPhysicalDeviceFeatures, shaderInt64), offsetof(VkPhysicalDeviceFeatures, shaderInt16), offsetof(VkPhysicalDeviceFeatures, shaderResourceResidency), offsetof(VkPhysicalDeviceFeatures, shaderResourceMinLod), offsetof(VkPhysicalDeviceFeatures, sparseBinding), offsetof(VkPhysicalDeviceFeatures, sparseResidencyBuffer), offsetof(VkPhysicalDeviceFeatures, sparseResidencyImage2D), offsetof(VkPhysicalDeviceFeatures, sparseResidencyImage3D), offsetof(VkPhysicalDeviceFeatures, sparseResidency2Samples), offsetof(VkPhysicalDeviceFeatures, sparseResidency4Samples), offsetof(VkPhysicalDeviceFeatures, sparseResidency8Samples), offsetof(VkPhysicalDeviceFeatures, sparseResidency16Samples), offsetof(VkPhysicalDeviceFeatures, sparseResidencyAliased), offsetof(VkPhysicalDeviceFeatures, variableMultisampleRate), offsetof(VkPhysicalDeviceFeatures, inheritedQueries)};
+// const size_t layout_VkPhysicalDeviceLimits[] = {sizeof(VkPhysicalDeviceLimits), _Alignof(VkPhysicalDeviceLimits), offsetof(VkPhysicalDeviceLimits, maxImageDimension1D), offsetof(VkPhysicalDeviceLimits, maxImageDimension2D), offsetof(VkPhysicalDeviceLimits, maxImageDimension3D), offsetof(VkPhysicalDeviceLimits, maxImageDimensionCube), offsetof(VkPhysicalDeviceLimits, maxImageArrayLayers), offsetof(VkPhysicalDeviceLimits, maxTexelBufferElements), offsetof(VkPhysicalDeviceLimits, maxUniformBufferRange), offsetof(VkPhysicalDeviceLimits, maxStorageBufferRange), offsetof(VkPhysicalDeviceLimits, maxPushConstantsSize), offsetof(VkPhysicalDeviceLimits, maxMemoryAllocationCount), offsetof(VkPhysicalDeviceLimits, maxSamplerAllocationCount), offsetof(VkPhysicalDeviceLimits, bufferImageGranularity), offsetof(VkPhysicalDeviceLimits, sparseAddressSpaceSize), offsetof(VkPhysicalDeviceLimits, maxBoundDescriptorSets), offsetof(VkPhysicalDeviceLimits, maxPerStageDescriptorSamplers), offsetof(VkPhysicalDeviceLimits, maxPerStageDescriptorUniformBuffers), offsetof(VkPhysicalDeviceLimits, maxPerStageDescriptorStorageBuffers), offsetof(VkPhysicalDeviceLimits, maxPerStageDescriptorSampledImages), offsetof(VkPhysicalDeviceLimits, maxPerStageDescriptorStorageImages), offsetof(VkPhysicalDeviceLimits, maxPerStageDescriptorInputAttachments), offsetof(VkPhysicalDeviceLimits, maxPerStageResources), offsetof(VkPhysicalDeviceLimits, maxDescriptorSetSamplers), offsetof(VkPhysicalDeviceLimits, maxDescriptorSetUniformBuffers), offsetof(VkPhysicalDeviceLimits, maxDescriptorSetUniformBuffersDynamic), offsetof(VkPhysicalDeviceLimits, maxDescriptorSetStorageBuffers), offsetof(VkPhysicalDeviceLimits, maxDescriptorSetStorageBuffersDynamic), offsetof(VkPhysicalDeviceLimits, maxDescriptorSetSampledImages), offsetof(VkPhysicalDeviceLimits, maxDescriptorSetStorageImages), offsetof(VkPhysicalDeviceLimits, maxDescriptorSetInputAttachments), offsetof(VkPhysicalDeviceLimits, maxVertexInputAttributes), offsetof(VkPhysicalDeviceLimits, maxVertexInputBindings), offsetof(VkPhysicalDeviceLimits, maxVertexInputAttributeOffset), offsetof(VkPhysicalDeviceLimits, maxVertexInputBindingStride), offsetof(VkPhysicalDeviceLimits, maxVertexOutputComponents), offsetof(VkPhysicalDeviceLimits, maxTessellationGenerationLevel), offsetof(VkPhysicalDeviceLimits, maxTessellationPatchSize), offsetof(VkPhysicalDeviceLimits, maxTessellationControlPerVertexInputComponents), offsetof(VkPhysicalDeviceLimits, maxTessellationControlPerVertexOutputComponents), offsetof(VkPhysicalDeviceLimits, maxTessellationControlPerPatchOutputComponents), offsetof(VkPhysicalDeviceLimits, maxTessellationControlTotalOutputComponents), offsetof(VkPhysicalDeviceLimits, maxTessellationEvaluationInputComponents), offsetof(VkPhysicalDeviceLimits, maxTessellationEvaluationOutputComponents), offsetof(VkPhysicalDeviceLimits, maxGeometryShaderInvocations), offsetof(VkPhysicalDeviceLimits, maxGeometryInputComponents), offsetof(VkPhysicalDeviceLimits, maxGeometryOutputComponents), offsetof(VkPhysicalDeviceLimits, maxGeometryOutputVertices), offsetof(VkPhysicalDeviceLimits, maxGeometryTotalOutputComponents), offsetof(VkPhysicalDeviceLimits, maxFragmentInputComponents), offsetof(VkPhysicalDeviceLimits, maxFragmentOutputAttachments), offsetof(VkPhysicalDeviceLimits, maxFragmentDualSrcAttachments), offsetof(VkPhysicalDeviceLimits, maxFragmentCombinedOutputResources), offsetof(VkPhysicalDeviceLimits, maxComputeSharedMemorySize), offsetof(VkPhysicalDeviceLimits, maxComputeWorkGroupCount), offsetof(VkPhysicalDeviceLimits, maxComputeWorkGroupInvocations), offsetof(VkPhysicalDeviceLimits, maxComputeWorkGroupSize), offsetof(VkPhysicalDeviceLimits, subPixelPrecisionBits), offsetof(VkPhysicalDeviceLimits, subTexelPrecisionBits), offsetof(VkPhysicalDeviceLimits, mipmapPrecisionBits), offsetof(VkPhysicalDeviceLimits, maxDrawIndexedIndexValue), offsetof(VkPhysicalDeviceLimits, maxDrawIndirectCount), offsetof(VkPhysicalDeviceLimits, maxSamplerLodBias), offsetof(VkPhysicalDeviceLimits, maxSamplerAnisotropy), offsetof(VkPhysicalDeviceLimits, maxViewports), offsetof(VkPhysicalDeviceLimits, maxViewportDimensions), offsetof(VkPhysicalDeviceLimits, viewportBoundsRange), offsetof(VkPhysicalDeviceLimits, viewportSubPixelBits), offsetof(VkPhysicalDeviceLimits, minMemoryMapAlignment), offsetof(VkPhysicalDeviceLimits, minTexelBufferOffsetAlignment), offsetof(VkPhysicalDeviceLimits, minUniformBufferOffsetAlignment), offsetof(VkPhysicalDeviceLimits, minStorageBufferOffsetAlignment), offsetof(VkPhysicalDeviceLimits, minTexelOffset), offsetof(VkPhysicalDeviceLimits, maxTexelOffset), offsetof(VkPhysicalDeviceLimits, minTexelGatherOffset), offsetof(VkPhysicalDeviceLimits, maxTexelGatherOffset), offsetof(VkPhysicalDeviceLimits, minInterpolationOffset), offsetof(VkPhysicalDeviceLimits, maxInterpolationOffset), offsetof(VkPhysicalDeviceLimits, subPixelInterpolationOffsetBits), offsetof(VkPhysicalDeviceLimits, maxFramebufferWidth), offsetof(VkPhysicalDeviceLimits, maxFramebufferHeight), offsetof(VkPhysicalDeviceLimits, maxFramebufferLayers), offsetof(VkPhysicalDeviceLimits, framebufferColorSampleCounts), offsetof(VkPhysicalDeviceLimits, framebufferDepthSampleCounts), offsetof(VkPhysicalDeviceLimits, framebufferStencilSampleCounts), offsetof(VkPhysicalDeviceLimits, framebufferNoAttachmentsSampleCounts), offsetof(VkPhysicalDeviceLimits, maxColorAttachments), offsetof(VkPhysicalDeviceLimits, sampledImageColorSampleCounts), offsetof(VkPhysicalDeviceLimits, sampledImageIntegerSampleCounts), offsetof(VkPhysicalDeviceLimits, sampledImageDepthSampleCounts), offsetof(VkPhysicalDeviceLimits, sampledImageStencilSampleCounts), offsetof(VkPhysicalDeviceLimits, storageImageSampleCounts), offsetof(VkPhysicalDeviceLimits, maxSampleMaskWords), offsetof(VkPhysicalDeviceLimits, timestampComputeAndGraphics), offsetof(VkPhysicalDeviceLimits, timestampPeriod), offsetof(VkPhysicalDeviceLimits, maxClipDistances), offsetof(VkPhysicalDeviceLimits, maxCullDistances), offsetof(VkPhysicalDeviceLimits, maxCombinedClipAndCullDistances), offsetof(VkPhysicalDeviceLimits, discreteQueuePriorities), offsetof(VkPhysicalDeviceLimits, pointSizeRange), offsetof(VkPhysicalDeviceLimits, lineWidthRange), offsetof(VkPhysicalDeviceLimits, pointSizeGranularity), offsetof(VkPhysicalDeviceLimits, lineWidthGranularity), offsetof(VkPhysicalDeviceLimits, strictLines), offsetof(VkPhysicalDeviceLimits, standardSampleLocations), offsetof(VkPhysicalDeviceLimits, optimalBufferCopyOffsetAlignment), offsetof(VkPhysicalDeviceLimits, optimalBufferCopyRowPitchAlignment), offsetof(VkPhysicalDeviceLimits, nonCoherentAtomSize)};
+// const size_t layout_VkPhysicalDeviceMemoryProperties[] = {sizeof(VkPhysicalDeviceMemoryProperties), _Alignof(VkPhysicalDeviceMemoryProperties), offsetof(VkPhysicalDeviceMemoryProperties, memoryTypeCount), offsetof(VkPhysicalDeviceMemoryProperties, memoryTypes), offsetof(VkPhysicalDeviceMemoryProperties, memoryHeapCount), offsetof(VkPhysicalDeviceMemoryProperties, memoryHeaps)};
+// const size_t layout_VkPhysicalDeviceSparseProperties[] = {sizeof(VkPhysicalDeviceSparseProperties), _Alignof(VkPhysicalDeviceSparseProperties), offsetof(VkPhysicalDeviceSparseProperties, residencyStandard2DBlockShape), offsetof(VkPhysicalDeviceSparseProperties, residencyStandard2DMultisampleBlockShape), offsetof(VkPhysicalDeviceSparseProperties, residencyStandard3DBlockShape), offsetof(VkPhysicalDeviceSparseProperties, residencyAlignedMipSize), offsetof(VkPhysicalDeviceSparseProperties, residencyNonResidentStrict)};
+// const size_t layout_VkPhysicalDeviceProperties[] = {sizeof(VkPhysicalDeviceProperties), _Alignof(VkPhysicalDeviceProperties), offsetof(VkPhysicalDeviceProperties, apiVersion), offsetof(VkPhysicalDeviceProperties, driverVersion), offsetof(VkPhysicalDeviceProperties, vendorID), offsetof(VkPhysicalDeviceProperties, deviceID), offsetof(VkPhysicalDeviceProperties, deviceType), offsetof(VkPhysicalDeviceProperties, deviceName), offsetof(VkPhysicalDeviceProperties, pipelineCacheUUID), offsetof(VkPhysicalDeviceProperties, limits), offsetof(VkPhysicalDeviceProperties, sparseProperties)};
+// const size_t layout_VkQueueFamilyProperties[] = {sizeof(VkQueueFamilyProperties), _Alignof(VkQueueFamilyProperties), offsetof(VkQueueFamilyProperties, queueFlags), offsetof(VkQueueFamilyProperties, queueCount), offsetof(VkQueueFamilyProperties, timestampValidBits), offsetof(VkQueueFamilyProperties, minImageTransferGranularity)};
+// const size_t layout_VkDeviceQueueCreateInfo[] = {sizeof(VkDeviceQueueCreateInfo), _Alignof(VkDeviceQueueCreateInfo), offsetof(VkDeviceQueueCreateInfo, sType), offsetof(VkDeviceQueueCreateInfo, pNext), offsetof(VkDeviceQueueCreateInfo, flags), offsetof(VkDeviceQueueCreateInfo, queueFamilyIndex), offsetof(VkDeviceQueueCreateInfo, queueCount), offsetof(VkDeviceQueueCreateInfo, pQueuePriorities)};
+// const size_t layout_VkDeviceCreateInfo[] = {sizeof(VkDeviceCreateInfo), _Alignof(VkDeviceCreateInfo), offsetof(VkDeviceCreateInfo, sType), offsetof(VkDeviceCreateInfo, pNext), offsetof(VkDeviceCreateInfo, flags), offsetof(VkDeviceCreateInfo, queueCreateInfoCount), offsetof(VkDeviceCreateInfo, pQueueCreateInfos), offsetof(VkDeviceCreateInfo, enabledLayerCount), offsetof(VkDeviceCreateInfo, ppEnabledLayerNames), offsetof(VkDeviceCreateInfo, enabledExtensionCount), offsetof(VkDeviceCreateInfo, ppEnabledExtensionNames), offsetof(VkDeviceCreateInfo, pEnabledFeatures)};
+// const size_t layout_VkExtensionProperties[] = {sizeof(VkExtensionProperties), _Alignof(VkExtensionProperties), offsetof(VkExtensionProperties, extensionName), offsetof(VkExtensionProperties, specVersion)};
+// const size_t layout_VkLayerProperties[] = {sizeof(VkLayerProperties), _Alignof(VkLayerProperties), offsetof(VkLayerProperties, layerName), offsetof(VkLayerProperties, specVersion), offsetof(VkLayerProperties, implementationVersion), offsetof(VkLayerProperties, description)};
+// const size_t layout_VkSubmitInfo[] = {sizeof(VkSubmitInfo), _Alignof(VkSubmitInfo), offsetof(VkSubmitInfo, sType), offsetof(VkSubmitInfo, pNext), offsetof(VkSubmitInfo, waitSemaphoreCount), offsetof(VkSubmitInfo, pWaitSemaphores), offsetof(VkSubmitInfo, pWaitDstStageMask), offsetof(VkSubmitInfo, commandBufferCount), offsetof(VkSubmitInfo, pCommandBuffers), offsetof(VkSubmitInfo, signalSemaphoreCount), offsetof(VkSubmitInfo, pSignalSemaphores)};
+// const size_t layout_VkMappedMemoryRange[] = {sizeof(VkMappedMemoryRange), _Alignof(VkMappedMemoryRange), offsetof(VkMappedMemoryRange, sType), offsetof(VkMappedMemoryRange, pNext), offsetof(VkMappedMemoryRange, memory), offsetof(VkMappedMemoryRange, offset), offsetof(VkMappedMemoryRange, size)};
+// const size_t layout_VkMemoryAllocateInfo[] = {sizeof(VkMemoryAllocateInfo), _Alignof(VkMemoryAllocateInfo), offsetof(VkMemoryAllocateInfo, sType), offsetof(VkMemoryAllocateInfo, pNext), offsetof(VkMemoryAllocateInfo, allocationSize), offsetof(VkMemoryAllocateInfo, memoryTypeIndex)};
+// const size_t layout_VkMemoryRequirements[] = {sizeof(VkMemoryRequirements), _Alignof(VkMemoryRequirements), offsetof(VkMemoryRequirements, size), offsetof(VkMemoryRequirements, alignment), offsetof(VkMemoryRequirements, memoryTypeBits)};
+// const size_t layout_VkSparseMemoryBind[] = {sizeof(VkSparseMemoryBind), _Alignof(VkSparseMemoryBind), offsetof(VkSparseMemoryBind, resourceOffset), offsetof(VkSparseMemoryBind, size), offsetof(VkSparseMemoryBind, memory), offsetof(VkSparseMemoryBind, memoryOffset), offsetof(VkSparseMemoryBind, flags)};
+// const size_t layout_VkSparseBufferMemoryBindInfo[] = {sizeof(VkSparseBufferMemoryBindInfo), _Alignof(VkSparseBufferMemoryBindInfo), offsetof(VkSparseBufferMemoryBindInfo, buffer), offsetof(VkSparseBufferMemoryBindInfo, bindCount), offsetof(VkSparseBufferMemoryBindInfo, pBinds)};
+// const size_t layout_VkSparseImageOpaqueMemoryBindInfo[] = {sizeof(VkSparseImageOpaqueMemoryBindInfo), _Alignof(VkSparseImageOpaqueMemoryBindInfo), offsetof(VkSparseImageOpaqueMemoryBindInfo, image), offsetof(VkSparseImageOpaqueMemoryBindInfo, bindCount), offsetof(VkSparseImageOpaqueMemoryBindInfo, pBinds)};
+// const size_t layout_VkImageSubresource[] = {sizeof(VkImageSubresource), _Alignof(VkImageSubresource), offsetof(VkImageSubresource, aspectMask), offsetof(VkImageSubresource, mipLevel), offsetof(VkImageSubresource, arrayLayer)};
+// const size_t layout_VkSparseImageMemoryBind[] = {sizeof(VkSparseImageMemoryBind), _Alignof(VkSparseImageMemoryBind), offsetof(VkSparseImageMemoryBind, subresource), offsetof(VkSparseImageMemoryBind, offset), offsetof(VkSparseImageMemoryBind, extent), offsetof(VkSparseImageMemoryBind, memory), offsetof(VkSparseImageMemoryBind, memoryOffset), offsetof(VkSparseImageMemoryBind, flags)};
+// const size_t layout_VkSparseImageMemoryBindInfo[] = {sizeof(VkSparseImageMemoryBindInfo), _Alignof(VkSparseImageMemoryBindInfo), offsetof(VkSparseImageMemoryBindInfo, image), offsetof(VkSparseImageMemoryBindInfo, bindCount), offsetof(VkSparseImageMemoryBindInfo, pBinds)};
+// const size_t layout_VkBindSparseInfo[] = {sizeof(VkBindSparseInfo), _Alignof(VkBindSparseInfo), offsetof(VkBindSparseInfo, sType), offsetof(VkBindSparseInfo, pNext), offsetof(VkBindSparseInfo, waitSemaphoreCount), offsetof(VkBindSparseInfo, pWaitSemaphores), offsetof(VkBindSparseInfo, bufferBindCount), offsetof(VkBindSparseInfo, pBufferBinds), offsetof(VkBindSparseInfo, imageOpaqueBindCount), offsetof(VkBindSparseInfo, pImageOpaqueBinds), offsetof(VkBindSparseInfo, imageBindCount), offsetof(VkBindSparseInfo, pImageBinds), offsetof(VkBindSparseInfo, signalSemaphoreCount), offsetof(VkBindSparseInfo, pSignalSemaphores)};
+// const size_t layout_VkSparseImageFormatProperties[] = {sizeof(VkSparseImageFormatProperties), _Alignof(VkSparseImageFormatProperties), offsetof(VkSparseImageFormatProperties, aspectMask), offsetof(VkSparseImageFormatProperties, imageGranularity), offsetof(VkSparseImageFormatProperties, flags)};
+// const size_t layout_VkSparseImageMemoryRequirements[] = {sizeof(VkSparseImageMemoryRequirements), _Alignof(VkSparseImageMemoryRequirements), offsetof(VkSparseImageMemoryRequirements, formatProperties), offsetof(VkSparseImageMemoryRequirements, imageMipTailFirstLod), offsetof(VkSparseImageMemoryRequirements, imageMipTailSize), offsetof(VkSparseImageMemoryRequirements, imageMipTailOffset), offsetof(VkSparseImageMemoryRequirements, imageMipTailStride)};
+// const size_t layout_VkFenceCreateInfo[] = {sizeof(VkFenceCreateInfo), _Alignof(VkFenceCreateInfo), offsetof(VkFenceCreateInfo, sType), offsetof(VkFenceCreateInfo, pNext), offsetof(VkFenceCreateInfo, flags)};
+// const size_t layout_VkSemaphoreCreateInfo[] = {sizeof(VkSemaphoreCreateInfo), _Alignof(VkSemaphoreCreateInfo), offsetof(VkSemaphoreCreateInfo, sType), offsetof(VkSemaphoreCreateInfo, pNext), offsetof(VkSemaphoreCreateInfo, flags)};
+// const size_t layout_VkEventCreateInfo[] = {sizeof(VkEventCreateInfo), _Alignof(VkEventCreateInfo), offsetof(VkEventCreateInfo, sType), offsetof(VkEventCreateInfo, pNext), offsetof(VkEventCreateInfo, flags)};
+// const size_t layout_VkQueryPoolCreateInfo[] = {sizeof(VkQueryPoolCreateInfo), _Alignof(VkQueryPoolCreateInfo), offsetof(VkQueryPoolCreateInfo, sType), offsetof(VkQueryPoolCreateInfo, pNext), offsetof(VkQueryPoolCreateInfo, flags), offsetof(VkQueryPoolCreateInfo, queryType), offsetof(VkQueryPoolCreateInfo, queryCount), offsetof(VkQueryPoolCreateInfo, pipelineStatistics)};
+// const size_t layout_VkBufferCreateInfo[] = {sizeof(VkBufferCreateInfo), _Alignof(VkBufferCreateInfo), offsetof(VkBufferCreateInfo, sType), offsetof(VkBufferCreateInfo, pNext), offsetof(VkBufferCreateInfo, flags), offsetof(VkBufferCreateInfo, size), offsetof(VkBufferCreateInfo, usage), offsetof(VkBufferCreateInfo, sharingMode), offsetof(VkBufferCreateInfo, queueFamilyIndexCount), offsetof(VkBufferCreateInfo, pQueueFamilyIndices)};
+// const size_t layout_VkBufferViewCreateInfo[] = {sizeof(VkBufferViewCreateInfo), _Alignof(VkBufferViewCreateInfo), offsetof(VkBufferViewCreateInfo, sType), offsetof(VkBufferViewCreateInfo, pNext), offsetof(VkBufferViewCreateInfo, flags), offsetof(VkBufferViewCreateInfo, buffer), offsetof(VkBufferViewCreateInfo, format), offsetof(VkBufferViewCreateInfo, offset), offsetof(VkBufferViewCreateInfo, range)};
+// const size_t layout_VkImageCreateInfo[] = {sizeof(VkImageCreateInfo), _Alignof(VkImageCreateInfo), offsetof(VkImageCreateInfo, sType), offsetof(VkImageCreateInfo, pNext), offsetof(VkImageCreateInfo, flags), offsetof(VkImageCreateInfo, imageType), offsetof(VkImageCreateInfo, format), offsetof(VkImageCreateInfo, extent), offsetof(VkImageCreateInfo, mipLevels), offsetof(VkImageCreateInfo, arrayLayers), offsetof(VkImageCreateInfo, samples), offsetof(VkImageCreateInfo, tiling), offsetof(VkImageCreateInfo, usage), offsetof(VkImageCreateInfo, sharingMode), offsetof(VkImageCreateInfo, queueFamilyIndexCount), offsetof(VkImageCreateInfo, pQueueFamilyIndices), offsetof(VkImageCreateInfo, initialLayout)};
+// const size_t layout_VkSubresourceLayout[] = {sizeof(VkSubresourceLayout), _Alignof(VkSubresourceLayout), offsetof(VkSubresourceLayout, offset), offsetof(VkSubresourceLayout, size), offsetof(VkSubresourceLayout, rowPitch), offsetof(VkSubresourceLayout, arrayPitch), offsetof(VkSubresourceLayout, depthPitch)};
+// const size_t layout_VkComponentMapping[] = {sizeof(VkComponentMapping), _Alignof(VkComponentMapping), offsetof(VkComponentMapping, r), offsetof(VkComponentMapping, g), offsetof(VkComponentMapping, b), offsetof(VkComponentMapping, a)};
+// const size_t layout_VkImageViewCreateInfo[] = {sizeof(VkImageViewCreateInfo), _Alignof(VkImageViewCreateInfo), offsetof(VkImageViewCreateInfo, sType), offsetof(VkImageViewCreateInfo, pNext), offsetof(VkImageViewCreateInfo, flags), offsetof(VkImageViewCreateInfo, image), offsetof(VkImageViewCreateInfo, viewType), offsetof(VkImageViewCreateInfo, format), offsetof(VkImageViewCreateInfo, components), offsetof(VkImageViewCreateInfo, subresourceRange)};
+// const size_t layout_VkShaderModuleCreateInfo[] = {sizeof(VkShaderModuleCreateInfo), _Alignof(VkShaderModuleCreateInfo), offsetof(VkShaderModuleCreateInfo, sType), offsetof(VkShaderModuleCreateInfo, pNext), offsetof(VkShaderModuleCreateInfo, flags), offsetof(VkShaderModuleCreateInfo, codeSize), offsetof(VkShaderModuleCreateInfo, pCode)};
+// const size_t layout_VkPipelineCacheCreateInfo[] = {sizeof(VkPipelineCacheCreateInfo), _Alignof(VkPipelineCacheCreateInfo), offsetof(VkPipelineCacheCreateInfo, sType), offsetof(VkPipelineCacheCreateInfo, pNext), offsetof(VkPipelineCacheCreateInfo, flags), offsetof(VkPipelineCacheCreateInfo, initialDataSize), offsetof(VkPipelineCacheCreateInfo, pInitialData)};
+// const size_t layout_VkSpecializationMapEntry[] = {sizeof(VkSpecializationMapEntry), _Alignof(VkSpecializationMapEntry), offsetof(VkSpecializationMapEntry, constantID), offsetof(VkSpecializationMapEntry, offset), offsetof(VkSpecializationMapEntry, size)};
+// const size_t layout_VkSpecializationInfo[] = {sizeof(VkSpecializationInfo), _Alignof(VkSpecializationInfo), offsetof(VkSpecializationInfo, mapEntryCount), offsetof(VkSpecializationInfo, pMapEntries), offsetof(VkSpecializationInfo, dataSize), offsetof(VkSpecializationInfo, pData)};
+// const size_t layout_VkPipelineShaderStageCreateInfo[] = {sizeof(VkPipelineShaderStageCreateInfo), _Alignof(VkPipelineShaderStageCreateInfo), offsetof(VkPipelineShaderStageCreateInfo, sType), offsetof(VkPipelineShaderStageCreateInfo, pNext), offsetof(VkPipelineShaderStageCreateInfo, flags), offsetof(VkPipelineShaderStageCreateInfo, stage), offsetof(VkPipelineShaderStageCreateInfo, module), offsetof(VkPipelineShaderStageCreateInfo, pName), offsetof(VkPipelineShaderStageCreateInfo, pSpecializationInfo)};
+// const size_t layout_VkComputePipelineCreateInfo[] = {sizeof(VkComputePipelineCreateInfo), _Alignof(VkComputePipelineCreateInfo), offsetof(VkComputePipelineCreateInfo, sType), offsetof(VkComputePipelineCreateInfo, pNext), offsetof(VkComputePipelineCreateInfo, flags), offsetof(VkComputePipelineCreateInfo, stage), offsetof(VkComputePipelineCreateInfo, layout), offsetof(VkComputePipelineCreateInfo, basePipelineHandle), offsetof(VkComputePipelineCreateInfo, basePipelineIndex)};
+// const size_t layout_VkVertexInputBindingDescription[] = {sizeof(VkVertexInputBindingDescription), _Alignof(VkVertexInputBindingDescription), offsetof(VkVertexInputBindingDescription, binding), offsetof(VkVertexInputBindingDescription, stride), offsetof(VkVertexInputBindingDescription, inputRate)};
+// const size_t layout_VkVertexInputAttributeDescription[] = {sizeof(VkVertexInputAttributeDescription), _Alignof(VkVertexInputAttributeDescription), offsetof(VkVertexInputAttributeDescription, location), offsetof(VkVertexInputAttributeDescription, binding), offsetof(VkVertexInputAttributeDescription, format), offsetof(VkVertexInputAttributeDescription, offset)};
+// const size_t layout_VkPipelineVertexInputStateCreateInfo[] = {sizeof(VkPipelineVertexInputStateCreateInfo), _Alignof(VkPipelineVertexInputStateCreateInfo), offsetof(VkPipelineVertexInputStateCreateInfo, sType), offsetof(VkPipelineVertexInputStateCreateInfo, pNext), offsetof(VkPipelineVertexInputStateCreateInfo, flags), offsetof(VkPipelineVertexInputStateCreateInfo, vertexBindingDescriptionCount), offsetof(VkPipelineVertexInputStateCreateInfo, pVertexBindingDescriptions), offsetof(VkPipelineVertexInputStateCreateInfo, vertexAttributeDescriptionCount), offsetof(VkPipelineVertexInputStateCreateInfo, pVertexAttributeDescriptions)};
+// const size_t layout_VkPipelineInputAssemblyStateCreateInfo[] = {sizeof(VkPipelineInputAssemblyStateCreateInfo), _Alignof(VkPipelineInputAssemblyStateCreateInfo), offsetof(VkPipelineInputAssemblyStateCreateInfo, sType), offsetof(VkPipelineInputAssemblyStateCreateInfo, pNext), offsetof(VkPipelineInputAssemblyStateCreateInfo, flags), offsetof(VkPipelineInputAssemblyStateCreateInfo, topology), offsetof(VkPipelineInputAssemblyStateCreateInfo, primitiveRestartEnable)};
+// const size_t layout_VkPipelineTessellationStateCreateInfo[] = {sizeof(VkPipelineTessellationStateCreateInfo), _Alignof(VkPipelineTessellationStateCreateInfo), offsetof(VkPipelineTessellationStateCreateInfo, sType), offsetof(VkPipelineTessellationStateCreateInfo, pNext), offsetof(VkPipelineTessellationStateCreateInfo, flags), offsetof(VkPipelineTessellationStateCreateInfo, patchControlPoints)};
+// const size_t layout_VkViewport[] = {sizeof(VkViewport), _Alignof(VkViewport), offsetof(VkViewport, x), offsetof(VkViewport, y), offsetof(VkViewport, width), offsetof(VkViewport, height), offsetof(VkViewport, minDepth), offsetof(VkViewport, maxDepth)};
+// const size_t layout_VkPipelineViewportStateCreateInfo[] = {sizeof(VkPipelineViewportStateCreateInfo), _Alignof(VkPipelineViewportStateCreateInfo), offsetof(VkPipelineViewportStateCreateInfo, sType), offsetof(VkPipelineViewportStateCreateInfo, pNext), offsetof(VkPipelineViewportStateCreateInfo, flags), offsetof(VkPipelineViewportStateCreateInfo, viewportCount), offsetof(VkPipelineViewportStateCreateInfo, pViewports), offsetof(VkPipelineViewportStateCreateInfo, scissorCount), offsetof(VkPipelineViewportStateCreateInfo, pScissors)};
+// const size_t layout_VkPipelineRasterizationStateCreateInfo[] = {sizeof(VkPipelineRasterizationStateCreateInfo), _Alignof(VkPipelineRasterizationStateCreateInfo), offsetof(VkPipelineRasterizationStateCreateInfo, sType), offsetof(VkPipelineRasterizationStateCreateInfo, pNext), offsetof(VkPipelineRasterizationStateCreateInfo, flags), offsetof(VkPipelineRasterizationStateCreateInfo, depthClampEnable), offsetof(VkPipelineRasterizationStateCreateInfo, rasterizerDiscardEnable), offsetof(VkPipelineRasterizationStateCreateInfo, polygonMode), offsetof(VkPipelineRasterizationStateCreateInfo, cullMode), offsetof(VkPipelineRasterizationStateCreateInfo, frontFace), offsetof(VkPipelineRasterizationStateCreateInfo, depthBiasEnable), offsetof(VkPipelineRasterizationStateCreateInfo, depthBiasConstantFactor), offsetof(VkPipelineRasterizationStateCreateInfo, depthBiasClamp), offsetof(VkPipelineRasterizationStateCreateInfo, depthBiasSlopeFactor), offsetof(VkPipelineRasterizationStateCreateInfo, lineWidth)};
+// const size_t layout_VkPipelineMultisampleStateCreateInfo[] = {sizeof(VkPipelineMultisampleStateCreateInfo), _Alignof(VkPipelineMultisampleStateCreateInfo), offsetof(VkPipelineMultisampleStateCreateInfo, sType), offsetof(VkPipelineMultisampleStateCreateInfo, pNext), offsetof(VkPipelineMultisampleStateCreateInfo, flags), offsetof(VkPipelineMultisampleStateCreateInfo, rasterizationSamples), offsetof(VkPipelineMultisampleStateCreateInfo, sampleShadingEnable), offsetof(VkPipelineMultisampleStateCreateInfo, minSampleShading), offsetof(VkPipelineMultisampleStateCreateInfo, pSampleMask), offsetof(VkPipelineMultisampleStateCreateInfo, alphaToCoverageEnable), offsetof(VkPipelineMultisampleStateCreateInfo, alphaToOneEnable)};
+// const size_t layout_VkStencilOpState[] = {sizeof(VkStencilOpState), _Alignof(VkStencilOpState), offsetof(VkStencilOpState, failOp), offsetof(VkStencilOpState, passOp), offsetof(VkStencilOpState, depthFailOp), offsetof(VkStencilOpState, compareOp), offsetof(VkStencilOpState, compareMask), offsetof(VkStencilOpState, writeMask), offsetof(VkStencilOpState, reference)};
+// const size_t layout_VkPipelineDepthStencilStateCreateInfo[] = {sizeof(VkPipelineDepthStencilStateCreateInfo), _Alignof(VkPipelineDepthStencilStateCreateInfo), offsetof(VkPipelineDepthStencilStateCreateInfo, sType), offsetof(VkPipelineDepthStencilStateCreateInfo, pNext), offsetof(VkPipelineDepthStencilStateCreateInfo, flags), offsetof(VkPipelineDepthStencilStateCreateInfo, depthTestEnable), offsetof(VkPipelineDepthStencilStateCreateInfo, depthWriteEnable), offsetof(VkPipelineDepthStencilStateCreateInfo, depthCompareOp), offsetof(VkPipelineDepthStencilStateCreateInfo, depthBoundsTestEnable), offsetof(VkPipelineDepthStencilStateCreateInfo, stencilTestEnable), offsetof(VkPipelineDepthStencilStateCreateInfo, front), offsetof(VkPipelineDepthStencilStateCreateInfo, back), offsetof(VkPipelineDepthStencilStateCreateInfo, minDepthBounds), offsetof(VkPipelineDepthStencilStateCreateInfo, maxDepthBounds)};
+// const size_t layout_VkPipelineColorBlendAttachmentState[] = {sizeof(VkPipelineColorBlendAttachmentState), _Alignof(VkPipelineColorBlendAttachmentState), offsetof(VkPipelineColorBlendAttachmentState, blendEnable), offsetof(VkPipelineColorBlendAttachmentState, srcColorBlendFactor), offsetof(VkPipelineColorBlendAttachmentState, dstColorBlendFactor), offsetof(VkPipelineColorBlendAttachmentState, colorBlendOp), offsetof(VkPipelineColorBlendAttachmentState, srcAlphaBlendFactor), offsetof(VkPipelineColorBlendAttachmentState, dstAlphaBlendFactor), offsetof(VkPipelineColorBlendAttachmentState, alphaBlendOp), offsetof(VkPipelineColorBlendAttachmentState, colorWriteMask)};
+// const size_t layout_VkPipelineColorBlendStateCreateInfo[] = {sizeof(VkPipelineColorBlendStateCreateInfo), _Alignof(VkPipelineColorBlendStateCreateInfo), offsetof(VkPipelineColorBlendStateCreateInfo, sType), offsetof(VkPipelineColorBlendStateCreateInfo, pNext), offsetof(VkPipelineColorBlendStateCreateInfo, flags), offsetof(VkPipelineColorBlendStateCreateInfo, logicOpEnable), offsetof(VkPipelineColorBlendStateCreateInfo, logicOp), offsetof(VkPipelineColorBlendStateCreateInfo, attachmentCount), offsetof(VkPipelineColorBlendStateCreateInfo, pAttachments), offsetof(VkPipelineColorBlendStateCreateInfo, blendConstants)};
+// const size_t layout_VkPipelineDynamicStateCreateInfo[] = {sizeof(VkPipelineDynamicStateCreateInfo), _Alignof(VkPipelineDynamicStateCreateInfo), offsetof(VkPipelineDynamicStateCreateInfo, sType), offsetof(VkPipelineDynamicStateCreateInfo, pNext), offsetof(VkPipelineDynamicStateCreateInfo, flags), offsetof(VkPipelineDynamicStateCreateInfo, dynamicStateCount), offsetof(VkPipelineDynamicStateCreateInfo, pDynamicStates)};
+// const size_t layout_VkGraphicsPipelineCreateInfo[] = {sizeof(VkGraphicsPipelineCreateInfo), _Alignof(VkGraphicsPipelineCreateInfo), offsetof(VkGraphicsPipelineCreateInfo, sType), offsetof(VkGraphicsPipelineCreateInfo, pNext), offsetof(VkGraphicsPipelineCreateInfo, flags), offsetof(VkGraphicsPipelineCreateInfo, stageCount), offsetof(VkGraphicsPipelineCreateInfo, pStages), offsetof(VkGraphicsPipelineCreateInfo, pVertexInputState), offsetof(VkGraphicsPipelineCreateInfo, pInputAssemblyState), offsetof(VkGraphicsPipelineCreateInfo, pTessellationState), offsetof(VkGraphicsPipelineCreateInfo, pViewportState), offsetof(VkGraphicsPipelineCreateInfo, pRasterizationState), offsetof(VkGraphicsPipelineCreateInfo, pMultisampleState), offsetof(VkGraphicsPipelineCreateInfo, pDepthStencilState), offsetof(VkGraphicsPipelineCreateInfo, pColorBlendState), offsetof(VkGraphicsPipelineCreateInfo, pDynamicState), offsetof(VkGraphicsPipelineCreateInfo, layout), offsetof(VkGraphicsPipelineCreateInfo, renderPass), offsetof(VkGraphicsPipelineCreateInfo, subpass), offsetof(VkGraphicsPipelineCreateInfo, basePipelineHandle), offsetof(VkGraphicsPipelineCreateInfo, basePipelineIndex)};
+// const size_t layout_VkPushConstantRange[] = {sizeof(VkPushConstantRange), _Alignof(VkPushConstantRange), offsetof(VkPushConstantRange, stageFlags), offsetof(VkPushConstantRange, offset), offsetof(VkPushConstantRange, size)};
+// const size_t layout_VkPipelineLayoutCreateInfo[] = {sizeof(VkPipelineLayoutCreateInfo), _Alignof(VkPipelineLayoutCreateInfo), offsetof(VkPipelineLayoutCreateInfo, sType), offsetof(VkPipelineLayoutCreateInfo, pNext), offsetof(VkPipelineLayoutCreateInfo, flags), offsetof(VkPipelineLayoutCreateInfo, setLayoutCount), offsetof(VkPipelineLayoutCreateInfo, pSetLayouts), offsetof(VkPipelineLayoutCreateInfo, pushConstantRangeCount), offsetof(VkPipelineLayoutCreateInfo, pPushConstantRanges)};
+// const size_t layout_VkSamplerCreateInfo[] = {sizeof(VkSamplerCreateInfo), _Alignof(VkSamplerCreateInfo), offsetof(VkSamplerCreateInfo, sType), offsetof(VkSamplerCreateInfo, pNext), offsetof(VkSamplerCreateInfo, flags), offsetof(VkSamplerCreateInfo, magFilter), offsetof(VkSamplerCreateInfo, minFilter), offsetof(VkSamplerCreateInfo, mipmapMode), offsetof(VkSamplerCreateInfo, addressModeU), offsetof(VkSamplerCreateInfo, addressModeV), offsetof(VkSamplerCreateInfo, addressModeW), offsetof(VkSamplerCreateInfo, mipLodBias), offsetof(VkSamplerCreateInfo, anisotropyEnable), offsetof(VkSamplerCreateInfo, maxAnisotropy), offsetof(VkSamplerCreateInfo, compareEnable), offsetof(VkSamplerCreateInfo, compareOp), offsetof(VkSamplerCreateInfo, minLod), offsetof(VkSamplerCreateInfo, maxLod), offsetof(VkSamplerCreateInfo, borderColor), offsetof(VkSamplerCreateInfo, unnormalizedCoordinates)};
+// const size_t layout_VkCopyDescriptorSet[] = {sizeof(VkCopyDescriptorSet), _Alignof(VkCopyDescriptorSet), offsetof(VkCopyDescriptorSet, sType), offsetof(VkCopyDescriptorSet, pNext), offsetof(VkCopyDescriptorSet, srcSet), offsetof(VkCopyDescriptorSet, srcBinding), offsetof(VkCopyDescriptorSet, srcArrayElement), offsetof(VkCopyDescriptorSet, dstSet), offsetof(VkCopyDescriptorSet, dstBinding), offsetof(VkCopyDescriptorSet, dstArrayElement), offsetof(VkCopyDescriptorSet, descriptorCount)};
+// const size_t layout_VkDescriptorBufferInfo[] = {sizeof(VkDescriptorBufferInfo), _Alignof(VkDescriptorBufferInfo), offsetof(VkDescriptorBufferInfo, buffer), offsetof(VkDescriptorBufferInfo, offset), offsetof(VkDescriptorBufferInfo, range)};
+// const size_t layout_VkDescriptorImageInfo[] = {sizeof(VkDescriptorImageInfo), _Alignof(VkDescriptorImageInfo), offsetof(VkDescriptorImageInfo, sampler), offsetof(VkDescriptorImageInfo, imageView), offsetof(VkDescriptorImageInfo, imageLayout)};
+// const size_t layout_VkDescriptorPoolSize[] = {sizeof(VkDescriptorPoolSize), _Alignof(VkDescriptorPoolSize), offsetof(VkDescriptorPoolSize, type), offsetof(VkDescriptorPoolSize, descriptorCount)};
+// const size_t layout_VkDescriptorPoolCreateInfo[] = {sizeof(VkDescriptorPoolCreateInfo), _Alignof(VkDescriptorPoolCreateInfo), offsetof(VkDescriptorPoolCreateInfo, sType), offsetof(VkDescriptorPoolCreateInfo, pNext), offsetof(VkDescriptorPoolCreateInfo, flags), offsetof(VkDescriptorPoolCreateInfo, maxSets), offsetof(VkDescriptorPoolCreateInfo, poolSizeCount), offsetof(VkDescriptorPoolCreateInfo, pPoolSizes)};
+// const size_t layout_VkDescriptorSetAllocateInfo[] = {sizeof(VkDescriptorSetAllocateInfo), _Alignof(VkDescriptorSetAllocateInfo), offsetof(VkDescriptorSetAllocateInfo, sType), offsetof(VkDescriptorSetAllocateInfo, pNext), offsetof(VkDescriptorSetAllocateInfo, descriptorPool), offsetof(VkDescriptorSetAllocateInfo, descriptorSetCount), offsetof(VkDescriptorSetAllocateInfo, pSetLayouts)};
+// const size_t layout_VkDescriptorSetLayoutBinding[] = {sizeof(VkDescriptorSetLayoutBinding), _Alignof(VkDescriptorSetLayoutBinding), offsetof(VkDescriptorSetLayoutBinding, binding), offsetof(VkDescriptorSetLayoutBinding, descriptorType), offsetof(VkDescriptorSetLayoutBinding, descriptorCount), offsetof(VkDescriptorSetLayoutBinding, stageFlags), offsetof(VkDescriptorSetLayoutBinding, pImmutableSamplers)};
+// const size_t layout_VkDescriptorSetLayoutCreateInfo[] = {sizeof(VkDescriptorSetLayoutCreateInfo), _Alignof(VkDescriptorSetLayoutCreateInfo), offsetof(VkDescriptorSetLayoutCreateInfo, sType), offsetof(VkDescriptorSetLayoutCreateInfo, pNext), offsetof(VkDescriptorSetLayoutCreateInfo, flags), offsetof(VkDescriptorSetLayoutCreateInfo, bindingCount), offsetof(VkDescriptorSetLayoutCreateInfo, pBindings)};
+// const size_t layout_VkWriteDescriptorSet[] = {sizeof(VkWriteDescriptorSet), _Alignof(VkWriteDescriptorSet), offsetof(VkWriteDescriptorSet, sType), offsetof(VkWriteDescriptorSet, pNext), offsetof(VkWriteDescriptorSet, dstSet), offsetof(VkWriteDescriptorSet, dstBinding), offsetof(VkWriteDescriptorSet, dstArrayElement), offsetof(VkWriteDescriptorSet, descriptorCount), offsetof(VkWriteDescriptorSet, descriptorType), offsetof(VkWriteDescriptorSet, pImageInfo), offsetof(VkWriteDescriptorSet, pBufferInfo), offsetof(VkWriteDescriptorSet, pTexelBufferView)};
+// const size_t layout_VkAttachmentDescription[] = {sizeof(VkAttachmentDescription), _Alignof(VkAttachmentDescription), offsetof(VkAttachmentDescription, flags), offsetof(VkAttachmentDescription, format), offsetof(VkAttachmentDescription, samples), offsetof(VkAttachmentDescription, loadOp), offsetof(VkAttachmentDescription, storeOp), offsetof(VkAttachmentDescription, stencilLoadOp), offsetof(VkAttachmentDescription, stencilStoreOp), offsetof(VkAttachmentDescription, initialLayout), offsetof(VkAttachmentDescription, finalLayout)};
+// const size_t layout_VkAttachmentReference[] = {sizeof(VkAttachmentReference), _Alignof(VkAttachmentReference), offsetof(VkAttachmentReference, attachment), offsetof(VkAttachmentReference, layout)};
+// const size_t layout_VkFramebufferCreateInfo[] = {sizeof(VkFramebufferCreateInfo), _Alignof(VkFramebufferCreateInfo), offsetof(VkFramebufferCreateInfo, sType), offsetof(VkFramebufferCreateInfo, pNext), offsetof(VkFramebufferCreateInfo, flags), offsetof(VkFramebufferCreateInfo, renderPass), offsetof(VkFramebufferCreateInfo, attachmentCount), offsetof(VkFramebufferCreateInfo, pAttachments), offsetof(VkFramebufferCreateInfo, width), offsetof(VkFramebufferCreateInfo, height), offsetof(VkFramebufferCreateInfo, layers)};
+// const size_t layout_VkSubpassDescription[] = {sizeof(VkSubpassDescription), _Alignof(VkSubpassDescription), offsetof(VkSubpassDescription, flags), offsetof(VkSubpassDescription, pipelineBindPoint), offsetof(VkSubpassDescription, inputAttachmentCount), offsetof(VkSubpassDescription, pInputAttachments), offsetof(VkSubpassDescription, colorAttachmentCount), offsetof(VkSubpassDescription, pColorAttachments), offsetof(VkSubpassDescription, pResolveAttachments), offsetof(VkSubpassDescription, pDepthStencilAttachment), offsetof(VkSubpassDescription, preserveAttachmentCount), offsetof(VkSubpassDescription, pPreserveAttachments)};
+// const size_t layout_VkSubpassDependency[] = {sizeof(VkSubpassDependency), _Alignof(VkSubpassDependency), offsetof(VkSubpassDependency, srcSubpass), offsetof(VkSubpassDependency, dstSubpass), offsetof(VkSubpassDependency, srcStageMask), offsetof(VkSubpassDependency, dstStageMask), offsetof(VkSubpassDependency, srcAccessMask), offsetof(VkSubpassDependency, dstAccessMask), offsetof(VkSubpassDependency, dependencyFlags)};
+// const size_t layout_VkRenderPassCreateInfo[] = {sizeof(VkRenderPassCreateInfo), _Alignof(VkRenderPassCreateInfo), offsetof(VkRenderPassCreateInfo, sType), offsetof(VkRenderPassCreateInfo, pNext), offsetof(VkRenderPassCreateInfo, flags), offsetof(VkRenderPassCreateInfo, attachmentCount), offsetof(VkRenderPassCreateInfo, pAttachments), offsetof(VkRenderPassCreateInfo, subpassCount), offsetof(VkRenderPassCreateInfo, pSubpasses), offsetof(VkRenderPassCreateInfo, dependencyCount), offsetof(VkRenderPassCreateInfo, pDependencies)};
+// const size_t layout_VkCommandPoolCreateInfo[] = {sizeof(VkCommandPoolCreateInfo), _Alignof(VkCommandPoolCreateInfo), offsetof(VkCommandPoolCreateInfo, sType), offsetof(VkCommandPoolCreateInfo, pNext), offsetof(VkCommandPoolCreateInfo, flags), offsetof(VkCommandPoolCreateInfo, queueFamilyIndex)};
+// const size_t layout_VkCommandBufferAllocateInfo[] = {sizeof(VkCommandBufferAllocateInfo), _Alignof(VkCommandBufferAllocateInfo), offsetof(VkCommandBufferAllocateInfo, sType), offsetof(VkCommandBufferAllocateInfo, pNext), offsetof(VkCommandBufferAllocateInfo, commandPool), offsetof(VkCommandBufferAllocateInfo, level), offsetof(VkCommandBufferAllocateInfo, commandBufferCount)};
+// const size_t layout_VkCommandBufferInheritanceInfo[] = {sizeof(VkCommandBufferInheritanceInfo), _Alignof(VkCommandBufferInheritanceInfo), offsetof(VkCommandBufferInheritanceInfo, sType), offsetof(VkCommandBufferInheritanceInfo, pNext), offsetof(VkCommandBufferInheritanceInfo, renderPass), offsetof(VkCommandBufferInheritanceInfo, subpass), offsetof(VkCommandBufferInheritanceInfo, framebuffer), offsetof(VkCommandBufferInheritanceInfo, occlusionQueryEnable), offsetof(VkCommandBufferInheritanceInfo, queryFlags), offsetof(VkCommandBufferInheritanceInfo, pipelineStatistics)};
+// const size_t layout_VkCommandBufferBeginInfo[] = {sizeof(VkCommandBufferBeginInfo), _Alignof(VkCommandBufferBeginInfo), offsetof(VkCommandBufferBeginInfo, sType), offsetof(VkCommandBufferBeginInfo, pNext), offsetof(VkCommandBufferBeginInfo, flags), offsetof(VkCommandBufferBeginInfo, pInheritanceInfo)};
+// const size_t layout_VkBufferCopy[] = {sizeof(VkBufferCopy), _Alignof(VkBufferCopy), offsetof(VkBufferCopy, srcOffset), offsetof(VkBufferCopy, dstOffset), offsetof(VkBufferCopy, size)};
+// const size_t layout_VkImageSubresourceLayers[] = {sizeof(VkImageSubresourceLayers), _Alignof(VkImageSubresourceLayers), offsetof(VkImageSubresourceLayers, aspectMask), offsetof(VkImageSubresourceLayers, mipLevel), offsetof(VkImageSubresourceLayers, baseArrayLayer), offsetof(VkImageSubresourceLayers, layerCount)};
+// const size_t layout_VkBufferImageCopy[] = {sizeof(VkBufferImageCopy), _Alignof(VkBufferImageCopy), offsetof(VkBufferImageCopy, bufferOffset), offsetof(VkBufferImageCopy, bufferRowLength), offsetof(VkBufferImageCopy, bufferImageHeight), offsetof(VkBufferImageCopy, imageSubresource), offsetof(VkBufferImageCopy, imageOffset), offsetof(VkBufferImageCopy, imageExtent)};
+// const size_t layout_VkClearColorValue[] = {sizeof(VkClearColorValue), _Alignof(VkClearColorValue)};
+// const size_t layout_VkClearDepthStencilValue[] = {sizeof(VkClearDepthStencilValue), _Alignof(VkClearDepthStencilValue), offsetof(VkClearDepthStencilValue, depth), offsetof(VkClearDepthStencilValue, stencil)};
+// const size_t layout_VkClearValue[] = {sizeof(VkClearValue), _Alignof(VkClearValue)};
+// const size_t layout_VkClearAttachment[] = {sizeof(VkClearAttachment), _Alignof(VkClearAttachment), offsetof(VkClearAttachment, aspectMask), offsetof(VkClearAttachment, colorAttachment), offsetof(VkClearAttachment, clearValue)};
+// const size_t layout_VkClearRect[] = {sizeof(VkClearRect), _Alignof(VkClearRect), offsetof(VkClearRect, rect), offsetof(VkClearRect, baseArrayLayer), offsetof(VkClearRect, layerCount)};
+// const size_t layout_VkImageBlit[] = {sizeof(VkImageBlit), _Alignof(VkImageBlit), offsetof(VkImageBlit, srcSubresource), offsetof(VkImageBlit, srcOffsets), offsetof(VkImageBlit, dstSubresource), offsetof(VkImageBlit, dstOffsets)};
+// const size_t layout_VkImageCopy[] = {sizeof(VkImageCopy), _Alignof(VkImageCopy), offsetof(VkImageCopy, srcSubresource), offsetof(VkImageCopy, srcOffset), offsetof(VkImageCopy, dstSubresource), offsetof(VkImageCopy, dstOffset), offsetof(VkImageCopy, extent)};
+// const size_t layout_VkImageResolve[] = {sizeof(VkImageResolve), _Alignof(VkImageResolve), offsetof(VkImageResolve, srcSubresource), offsetof(VkImageResolve, srcOffset), offsetof(VkImageResolve, dstSubresource), offsetof(VkImageResolve, dstOffset), offsetof(VkImageResolve, extent)};
+// const size_t layout_VkRenderPassBeginInfo[] = {sizeof(VkRenderPassBeginInfo), _Alignof(VkRenderPassBeginInfo), offsetof(VkRenderPassBeginInfo, sType), offsetof(VkRenderPassBeginInfo, pNext), offsetof(VkRenderPassBeginInfo, renderPass), offsetof(VkRenderPassBeginInfo, framebuffer), offsetof(VkRenderPassBeginInfo, renderArea), offsetof(VkRenderPassBeginInfo, clearValueCount), offsetof(VkRenderPassBeginInfo, pClearValues)};
+// const size_t layout_VkPhysicalDeviceSubgroupProperties[] = {sizeof(VkPhysicalDeviceSubgroupProperties), _Alignof(VkPhysicalDeviceSubgroupProperties), offsetof(VkPhysicalDeviceSubgroupProperties, sType), offsetof(VkPhysicalDeviceSubgroupProperties, pNext), offsetof(VkPhysicalDeviceSubgroupProperties, subgroupSize), offsetof(VkPhysicalDeviceSubgroupProperties, supportedStages), offsetof(VkPhysicalDeviceSubgroupProperties, supportedOperations), offsetof(VkPhysicalDeviceSubgroupProperties, quadOperationsInAllStages)};
+// const size_t layout_VkBindBufferMemoryInfo[] = {sizeof(VkBindBufferMemoryInfo), _Alignof(VkBindBufferMemoryInfo), offsetof(VkBindBufferMemoryInfo, sType), offsetof(VkBindBufferMemoryInfo, pNext), offsetof(VkBindBufferMemoryInfo, buffer), offsetof(VkBindBufferMemoryInfo, memory), offsetof(VkBindBufferMemoryInfo, memoryOffset)};
+// const size_t layout_VkBindImageMemoryInfo[] = {sizeof(VkBindImageMemoryInfo), _Alignof(VkBindImageMemoryInfo), offsetof(VkBindImageMemoryInfo, sType), offsetof(VkBindImageMemoryInfo, pNext), offsetof(VkBindImageMemoryInfo, image), offsetof(VkBindImageMemoryInfo, memory), offsetof(VkBindImageMemoryInfo, memoryOffset)};
+// const size_t layout_VkPhysicalDevice16BitStorageFeatures[] = {sizeof(VkPhysicalDevice16BitStorageFeatures), _Alignof(VkPhysicalDevice16BitStorageFeatures), offsetof(VkPhysicalDevice16BitStorageFeatures, sType), offsetof(VkPhysicalDevice16BitStorageFeatures, pNext), offsetof(VkPhysicalDevice16BitStorageFeatures, storageBuffer16BitAccess), offsetof(VkPhysicalDevice16BitStorageFeatures, uniformAndStorageBuffer16BitAccess), offsetof(VkPhysicalDevice16BitStorageFeatures, storagePushConstant16), offsetof(VkPhysicalDevice16BitStorageFeatures, storageInputOutput16)};
+// const size_t layout_VkMemoryDedicatedRequirements[] = {sizeof(VkMemoryDedicatedRequirements), _Alignof(VkMemoryDedicatedRequirements), offsetof(VkMemoryDedicatedRequirements, sType), offsetof(VkMemoryDedicatedRequirements, pNext), offsetof(VkMemoryDedicatedRequirements, prefersDedicatedAllocation), offsetof(VkMemoryDedicatedRequirements, requiresDedicatedAllocation)};
+// const size_t layout_VkMemoryDedicatedAllocateInfo[] = {sizeof(VkMemoryDedicatedAllocateInfo), _Alignof(VkMemoryDedicatedAllocateInfo), offsetof(VkMemoryDedicatedAllocateInfo, sType), offsetof(VkMemoryDedicatedAllocateInfo, pNext), offsetof(VkMemoryDedicatedAllocateInfo, image), offsetof(VkMemoryDedicatedAllocateInfo, buffer)};
+// const size_t layout_VkMemoryAllocateFlagsInfo[] = {sizeof(VkMemoryAllocateFlagsInfo), _Alignof(VkMemoryAllocateFlagsInfo), offsetof(VkMemoryAllocateFlagsInfo, sType), offsetof(VkMemoryAllocateFlagsInfo, pNext), offsetof(VkMemoryAllocateFlagsInfo, flags), offsetof(VkMemoryAllocateFlagsInfo, deviceMask)};
+// const size_t layout_VkDeviceGroupRenderPassBeginInfo[] = {sizeof(VkDeviceGroupRenderPassBeginInfo), _Alignof(VkDeviceGroupRenderPassBeginInfo), offsetof(VkDeviceGroupRenderPassBeginInfo, sType), offsetof(VkDeviceGroupRenderPassBeginInfo, pNext), offsetof(VkDeviceGroupRenderPassBeginInfo, deviceMask), offsetof(VkDeviceGroupRenderPassBeginInfo, deviceRenderAreaCount), offsetof(VkDeviceGroupRenderPassBeginInfo, pDeviceRenderAreas)};
+// const size_t layout_VkDeviceGroupCommandBufferBeginInfo[] = {sizeof(VkDeviceGroupCommandBufferBeginInfo), _Alignof(VkDeviceGroupCommandBufferBeginInfo), offsetof(VkDeviceGroupCommandBufferBeginInfo, sType), offsetof(VkDeviceGroupCommandBufferBeginInfo, pNext), offsetof(VkDeviceGroupCommandBufferBeginInfo, deviceMask)};
+// const size_t layout_VkDeviceGroupSubmitInfo[] = {sizeof(VkDeviceGroupSubmitInfo), _Alignof(VkDeviceGroupSubmitInfo), offsetof(VkDeviceGroupSubmitInfo, sType), offsetof(VkDeviceGroupSubmitInfo, pNext), offsetof(VkDeviceGroupSubmitInfo, waitSemaphoreCount), offsetof(VkDeviceGroupSubmitInfo, pWaitSemaphoreDeviceIndices), offsetof(VkDeviceGroupSubmitInfo, commandBufferCount), offsetof(VkDeviceGroupSubmitInfo, pCommandBufferDeviceMasks), offsetof(VkDeviceGroupSubmitInfo, signalSemaphoreCount), offsetof(VkDeviceGroupSubmitInfo, pSignalSemaphoreDeviceIndices)};
+// const size_t layout_VkDeviceGroupBindSparseInfo[] = {sizeof(VkDeviceGroupBindSparseInfo), _Alignof(VkDeviceGroupBindSparseInfo), offsetof(VkDeviceGroupBindSparseInfo, sType), offsetof(VkDeviceGroupBindSparseInfo, pNext), offsetof(VkDeviceGroupBindSparseInfo, resourceDeviceIndex), offsetof(VkDeviceGroupBindSparseInfo, memoryDeviceIndex)};
+// const size_t layout_VkBindBufferMemoryDeviceGroupInfo[] = {sizeof(VkBindBufferMemoryDeviceGroupInfo), _Alignof(VkBindBufferMemoryDeviceGroupInfo), offsetof(VkBindBufferMemoryDeviceGroupInfo, sType), offsetof(VkBindBufferMemoryDeviceGroupInfo, pNext), offsetof(VkBindBufferMemoryDeviceGroupInfo, deviceIndexCount), offsetof(VkBindBufferMemoryDeviceGroupInfo, pDeviceIndices)};
+// const size_t layout_VkBindImageMemoryDeviceGroupInfo[] = {sizeof(VkBindImageMemoryDeviceGroupInfo), _Alignof(VkBindImageMemoryDeviceGroupInfo), offsetof(VkBindImageMemoryDeviceGroupInfo, sType), offsetof(VkBindImageMemoryDeviceGroupInfo, pNext), offsetof(VkBindImageMemoryDeviceGroupInfo, deviceIndexCount), offsetof(VkBindImageMemoryDeviceGroupInfo, pDeviceIndices), offsetof(VkBindImageMemoryDeviceGroupInfo, splitInstanceBindRegionCount), offsetof(VkBindImageMemoryDeviceGroupInfo, pSplitInstanceBindRegions)};
+// const size_t layout_VkPhysicalDeviceGroupProperties[] = {sizeof(VkPhysicalDeviceGroupProperties), _Alignof(VkPhysicalDeviceGroupProperties), offsetof(VkPhysicalDeviceGroupProperties, sType), offsetof(VkPhysicalDeviceGroupProperties, pNext), offsetof(VkPhysicalDeviceGroupProperties, physicalDeviceCount), offsetof(VkPhysicalDeviceGroupProperties, physicalDevices), offsetof(VkPhysicalDeviceGroupProperties, subsetAllocation)};
+// const size_t layout_VkDeviceGroupDeviceCreateInfo[] = {sizeof(VkDeviceGroupDeviceCreateInfo), _Alignof(VkDeviceGroupDeviceCreateInfo), offsetof(VkDeviceGroupDeviceCreateInfo, sType), offsetof(VkDeviceGroupDeviceCreateInfo, pNext), offsetof(VkDeviceGroupDeviceCreateInfo, physicalDeviceCount), offsetof(VkDeviceGroupDeviceCreateInfo, pPhysicalDevices)};
+// const size_t layout_VkBufferMemoryRequirementsInfo2[] = {sizeof(VkBufferMemoryRequirementsInfo2), _Alignof(VkBufferMemoryRequirementsInfo2), offsetof(VkBufferMemoryRequirementsInfo2, sType), offsetof(VkBufferMemoryRequirementsInfo2, pNext), offsetof(VkBufferMemoryRequirementsInfo2, buffer)};
+// const size_t layout_VkImageMemoryRequirementsInfo2[] = {sizeof(VkImageMemoryRequirementsInfo2), _Alignof(VkImageMemoryRequirementsInfo2), offsetof(VkImageMemoryRequirementsInfo2, sType), offsetof(VkImageMemoryRequirementsInfo2, pNext), offsetof(VkImageMemoryRequirementsInfo2, image)};
+// const size_t layout_VkImageSparseMemoryRequirementsInfo2[] = {sizeof(VkImageSparseMemoryRequirementsInfo2), _Alignof(VkImageSparseMemoryRequirementsInfo2), offsetof(VkImageSparseMemoryRequirementsInfo2, sType), offsetof(VkImageSparseMemoryRequirementsInfo2, pNext), offsetof(VkImageSparseMemoryRequirementsInfo2, image)};
+// const size_t layout_VkMemoryRequirements2[] = {sizeof(VkMemoryRequirements2), _Alignof(VkMemoryRequirements2), offsetof(VkMemoryRequirements2, sType), offsetof(VkMemoryRequirements2, pNext), offsetof(VkMemoryRequirements2, memoryRequirements)};
+// const size_t layout_VkSparseImageMemoryRequirements2[] = {sizeof(VkSparseImageMemoryRequirements2), _Alignof(VkSparseImageMemoryRequirements2), offsetof(VkSparseImageMemoryRequirements2, sType), offsetof(VkSparseImageMemoryRequirements2, pNext), offsetof(VkSparseImageMemoryRequirements2, memoryRequirements)};
+// const size_t layout_VkPhysicalDeviceFeatures2[] = {sizeof(VkPhysicalDeviceFeatures2), _Alignof(VkPhysicalDeviceFeatures2), offsetof(VkPhysicalDeviceFeatures2, sType), offsetof(VkPhysicalDeviceFeatures2, pNext), offsetof(VkPhysicalDeviceFeatures2, features)};
+// const size_t layout_VkPhysicalDeviceProperties2[] = {sizeof(VkPhysicalDeviceProperties2), _Alignof(VkPhysicalDeviceProperties2), offsetof(VkPhysicalDeviceProperties2, sType), offsetof(VkPhysicalDeviceProperties2, pNext), offsetof(VkPhysicalDeviceProperties2, properties)};
+// const size_t layout_VkFormatProperties2[] = {sizeof(VkFormatProperties2), _Alignof(VkFormatProperties2), offsetof(VkFormatProperties2, sType), offsetof(VkFormatProperties2, pNext), offsetof(VkFormatProperties2, formatProperties)};
+// const size_t layout_VkImageFormatProperties2[] = {sizeof(VkImageFormatProperties2), _Alignof(VkImageFormatProperties2), offsetof(VkImageFormatProperties2, sType), offsetof(VkImageFormatProperties2, pNext), offsetof(VkImageFormatProperties2, imageFormatProperties)};
+// const size_t layout_VkPhysicalDeviceImageFormatInfo2[] = {sizeof(VkPhysicalDeviceImageFormatInfo2), _Alignof(VkPhysicalDeviceImageFormatInfo2), offsetof(VkPhysicalDeviceImageFormatInfo2, sType), offsetof(VkPhysicalDeviceImageFormatInfo2, pNext), offsetof(VkPhysicalDeviceImageFormatInfo2, format), offsetof(VkPhysicalDeviceImageFormatInfo2, type), offsetof(VkPhysicalDeviceImageFormatInfo2, tiling), offsetof(VkPhysicalDeviceImageFormatInfo2, usage), offsetof(VkPhysicalDeviceImageFormatInfo2, flags)};
+// const size_t layout_VkQueueFamilyProperties2[] = {sizeof(VkQueueFamilyProperties2), _Alignof(VkQueueFamilyProperties2), offsetof(VkQueueFamilyProperties2, sType), offsetof(VkQueueFamilyProperties2, pNext), offsetof(VkQueueFamilyProperties2, queueFamilyProperties)};
+// const size_t layout_VkPhysicalDeviceMemoryProperties2[] = {sizeof(VkPhysicalDeviceMemoryProperties2), _Alignof(VkPhysicalDeviceMemoryProperties2), offsetof(VkPhysicalDeviceMemoryProperties2, sType), offsetof(VkPhysicalDeviceMemoryProperties2, pNext), offsetof(VkPhysicalDeviceMemoryProperties2, memoryProperties)};
+// const size_t layout_VkSparseImageFormatProperties2[] = {sizeof(VkSparseImageFormatProperties2), _Alignof(VkSparseImageFormatProperties2), offsetof(VkSparseImageFormatProperties2, sType), offsetof(VkSparseImageFormatProperties2, pNext), offsetof(VkSparseImageFormatProperties2, properties)};
+// const size_t layout_VkPhysicalDeviceSparseImageFormatInfo2[] = {sizeof(VkPhysicalDeviceSparseImageFormatInfo2), _Alignof(VkPhysicalDeviceSparseImageFormatInfo2), offsetof(VkPhysicalDeviceSparseImageFormatInfo2, sType), offsetof(VkPhysicalDeviceSparseImageFormatInfo2, pNext), offsetof(VkPhysicalDeviceSparseImageFormatInfo2, format), offsetof(VkPhysicalDeviceSparseImageFormatInfo2, type), offsetof(VkPhysicalDeviceSparseImageFormatInfo2, samples), offsetof(VkPhysicalDeviceSparseImageFormatInfo2, usage), offsetof(VkPhysicalDeviceSparseImageFormatInfo2, tiling)};
+// const size_t layout_VkPhysicalDevicePointClippingProperties[] = {sizeof(VkPhysicalDevicePointClippingProperties), _Alignof(VkPhysicalDevicePointClippingProperties), offsetof(VkPhysicalDevicePointClippingProperties, sType), offsetof(VkPhysicalDevicePointClippingProperties, pNext), offsetof(VkPhysicalDevicePointClippingProperties, pointClippingBehavior)};
+// const size_t layout_VkInputAttachmentAspectReference[] = {sizeof(VkInputAttachmentAspectReference), _Alignof(VkInputAttachmentAspectReference), offsetof(VkInputAttachmentAspectReference, subpass), offsetof(VkInputAttachmentAspectReference, inputAttachmentIndex), offsetof(VkInputAttachmentAspectReference, aspectMask)};
+// const size_t layout_VkRenderPassInputAttachmentAspectCreateInfo[] = {sizeof(VkRenderPassInputAttachmentAspectCreateInfo), _Alignof(VkRenderPassInputAttachmentAspectCreateInfo), offsetof(VkRenderPassInputAttachmentAspectCreateInfo, sType), offsetof(VkRenderPassInputAttachmentAspectCreateInfo, pNext), offsetof(VkRenderPassInputAttachmentAspectCreateInfo, aspectReferenceCount), offsetof(VkRenderPassInputAttachmentAspectCreateInfo, pAspectReferences)};
+// const size_t layout_VkImageViewUsageCreateInfo[] = {sizeof(VkImageViewUsageCreateInfo), _Alignof(VkImageViewUsageCreateInfo), offsetof(VkImageViewUsageCreateInfo, sType), offsetof(VkImageViewUsageCreateInfo, pNext), offsetof(VkImageViewUsageCreateInfo, usage)};
+// const size_t layout_VkPipelineTessellationDomainOriginStateCreateInfo[] = {sizeof(VkPipelineTessellationDomainOriginStateCreateInfo), _Alignof(VkPipelineTessellationDomainOriginStateCreateInfo), offsetof(VkPipelineTessellationDomainOriginStateCreateInfo, sType), offsetof(VkPipelineTessellationDomainOriginStateCreateInfo, pNext), offsetof(VkPipelineTessellationDomainOriginStateCreateInfo, domainOrigin)};
+// const size_t layout_VkRenderPassMultiviewCreateInfo[] = {sizeof(VkRenderPassMultiviewCreateInfo), _Alignof(VkRenderPassMultiviewCreateInfo), offsetof(VkRenderPassMultiviewCreateInfo, sType), offsetof(VkRenderPassMultiviewCreateInfo, pNext), offsetof(VkRenderPassMultiviewCreateInfo, subpassCount), offsetof(VkRenderPassMultiviewCreateInfo, pViewMasks), offsetof(VkRenderPassMultiviewCreateInfo, dependencyCount), offsetof(VkRenderPassMultiviewCreateInfo, pViewOffsets), offsetof(VkRenderPassMultiviewCreateInfo, correlationMaskCount), offsetof(VkRenderPassMultiviewCreateInfo, pCorrelationMasks)};
+// const size_t layout_VkPhysicalDeviceMultiviewFeatures[] = {sizeof(VkPhysicalDeviceMultiviewFeatures), _Alignof(VkPhysicalDeviceMultiviewFeatures), offsetof(VkPhysicalDeviceMultiviewFeatures, sType), offsetof(VkPhysicalDeviceMultiviewFeatures, pNext), offsetof(VkPhysicalDeviceMultiviewFeatures, multiview), offsetof(VkPhysicalDeviceMultiviewFeatures, multiviewGeometryShader), offsetof(VkPhysicalDeviceMultiviewFeatures, multiviewTessellationShader)};
+// const size_t layout_VkPhysicalDeviceMultiviewProperties[] = {sizeof(VkPhysicalDeviceMultiviewProperties), _Alignof(VkPhysicalDeviceMultiviewProperties), offsetof(VkPhysicalDeviceMultiviewProperties, sType), offsetof(VkPhysicalDeviceMultiviewProperties, pNext), offsetof(VkPhysicalDeviceMultiviewProperties, maxMultiviewViewCount), offsetof(VkPhysicalDeviceMultiviewProperties, maxMultiviewInstanceIndex)};
+// const size_t layout_VkPhysicalDeviceVariablePointersFeatures[] = {sizeof(VkPhysicalDeviceVariablePointersFeatures), _Alignof(VkPhysicalDeviceVariablePointersFeatures), offsetof(VkPhysicalDeviceVariablePointersFeatures, sType), offsetof(VkPhysicalDeviceVariablePointersFeatures, pNext), offsetof(VkPhysicalDeviceVariablePointersFeatures, variablePointersStorageBuffer), offsetof(VkPhysicalDeviceVariablePointersFeatures, variablePointers)};
+// const size_t layout_VkPhysicalDeviceProtectedMemoryFeatures[] = {sizeof(VkPhysicalDeviceProtectedMemoryFeatures), _Alignof(VkPhysicalDeviceProtectedMemoryFeatures), offsetof(VkPhysicalDeviceProtectedMemoryFeatures, sType), offsetof(VkPhysicalDeviceProtectedMemoryFeatures, pNext), offsetof(VkPhysicalDeviceProtectedMemoryFeatures, protectedMemory)};
+// const size_t layout_VkPhysicalDeviceProtectedMemoryProperties[] = {sizeof(VkPhysicalDeviceProtectedMemoryProperties), _Alignof(VkPhysicalDeviceProtectedMemoryProperties), offsetof(VkPhysicalDeviceProtectedMemoryProperties, sType), offsetof(VkPhysicalDeviceProtectedMemoryProperties, pNext), offsetof(VkPhysicalDeviceProtectedMemoryProperties, protectedNoFault)};
+// const size_t layout_VkDeviceQueueInfo2[] = {sizeof(VkDeviceQueueInfo2), _Alignof(VkDeviceQueueInfo2), offsetof(VkDeviceQueueInfo2, sType), offsetof(VkDeviceQueueInfo2, pNext), offsetof(VkDeviceQueueInfo2, flags), offsetof(VkDeviceQueueInfo2, queueFamilyIndex), offsetof(VkDeviceQueueInfo2, queueIndex)};
+// const size_t layout_VkProtectedSubmitInfo[] = {sizeof(VkProtectedSubmitInfo), _Alignof(VkProtectedSubmitInfo), offsetof(VkProtectedSubmitInfo, sType), offsetof(VkProtectedSubmitInfo, pNext), offsetof(VkProtectedSubmitInfo, protectedSubmit)};
+// const size_t layout_VkSamplerYcbcrConversionCreateInfo[] = {sizeof(VkSamplerYcbcrConversionCreateInfo), _Alignof(VkSamplerYcbcrConversionCreateInfo), offsetof(VkSamplerYcbcrConversionCreateInfo, sType), offsetof(VkSamplerYcbcrConversionCreateInfo, pNext), offsetof(VkSamplerYcbcrConversionCreateInfo, format), offsetof(VkSamplerYcbcrConversionCreateInfo, ycbcrModel), offsetof(VkSamplerYcbcrConversionCreateInfo, ycbcrRange), offsetof(VkSamplerYcbcrConversionCreateInfo, components), offsetof(VkSamplerYcbcrConversionCreateInfo, xChromaOffset), offsetof(VkSamplerYcbcrConversionCreateInfo, yChromaOffset), offsetof(VkSamplerYcbcrConversionCreateInfo, chromaFilter), offsetof(VkSamplerYcbcrConversionCreateInfo, forceExplicitReconstruction)};
+// const size_t layout_VkSamplerYcbcrConversionInfo[] = {sizeof(VkSamplerYcbcrConversionInfo), _Alignof(VkSamplerYcbcrConversionInfo), offsetof(VkSamplerYcbcrConversionInfo, sType), offsetof(VkSamplerYcbcrConversionInfo, pNext), offsetof(VkSamplerYcbcrConversionInfo, conversion)};
+// const size_t layout_VkBindImagePlaneMemoryInfo[] = {sizeof(VkBindImagePlaneMemoryInfo), _Alignof(VkBindImagePlaneMemoryInfo), offsetof(VkBindImagePlaneMemoryInfo, sType), offsetof(VkBindImagePlaneMemoryInfo, pNext), offsetof(VkBindImagePlaneMemoryInfo, planeAspect)};
+// const size_t layout_VkImagePlaneMemoryRequirementsInfo[] = {sizeof(VkImagePlaneMemoryRequirementsInfo), _Alignof(VkImagePlaneMemoryRequirementsInfo), offsetof(VkImagePlaneMemoryRequirementsInfo, sType), offsetof(VkImagePlaneMemoryRequirementsInfo, pNext), offsetof(VkImagePlaneMemoryRequirementsInfo, planeAspect)};
+// const size_t layout_VkPhysicalDeviceSamplerYcbcrConversionFeatures[] = {sizeof(VkPhysicalDeviceSamplerYcbcrConversionFeatures), _Alignof(VkPhysicalDeviceSamplerYcbcrConversionFeatures), offsetof(VkPhysicalDeviceSamplerYcbcrConversionFeatures, sType), offsetof(VkPhysicalDeviceSamplerYcbcrConversionFeatures, pNext), offsetof(VkPhysicalDeviceSamplerYcbcrConversionFeatures, samplerYcbcrConversion)};
+// const size_t layout_VkSamplerYcbcrConversionImageFormatProperties[] = {sizeof(VkSamplerYcbcrConversionImageFormatProperties), _Alignof(VkSamplerYcbcrConversionImageFormatProperties), offsetof(VkSamplerYcbcrConversionImageFormatProperties, sType), offsetof(VkSamplerYcbcrConversionImageFormatProperties, pNext), offsetof(VkSamplerYcbcrConversionImageFormatProperties, combinedImageSamplerDescriptorCount)};
+// const size_t layout_VkDescriptorUpdateTemplateEntry[] = {sizeof(VkDescriptorUpdateTemplateEntry), _Alignof(VkDescriptorUpdateTemplateEntry), offsetof(VkDescriptorUpdateTemplateEntry, dstBinding), offsetof(VkDescriptorUpdateTemplateEntry, dstArrayElement), offsetof(VkDescriptorUpdateTemplateEntry, descriptorCount), offsetof(VkDescriptorUpdateTemplateEntry, descriptorType), offsetof(VkDescriptorUpdateTemplateEntry, offset), offsetof(VkDescriptorUpdateTemplateEntry, stride)};
+// const size_t layout_VkDescriptorUpdateTemplateCreateInfo[] = {sizeof(VkDescriptorUpdateTemplateCreateInfo), _Alignof(VkDescriptorUpdateTemplateCreateInfo), offsetof(VkDescriptorUpdateTemplateCreateInfo, sType), offsetof(VkDescriptorUpdateTemplateCreateInfo, pNext), offsetof(VkDescriptorUpdateTemplateCreateInfo, flags), offsetof(VkDescriptorUpdateTemplateCreateInfo, descriptorUpdateEntryCount), offsetof(VkDescriptorUpdateTemplateCreateInfo, pDescriptorUpdateEntries), offsetof(VkDescriptorUpdateTemplateCreateInfo, templateType), offsetof(VkDescriptorUpdateTemplateCreateInfo, descriptorSetLayout), offsetof(VkDescriptorUpdateTemplateCreateInfo, pipelineBindPoint), offsetof(VkDescriptorUpdateTemplateCreateInfo, pipelineLayout), offsetof(VkDescriptorUpdateTemplateCreateInfo, set)};
+// const size_t layout_VkExternalMemoryProperties[] = {sizeof(VkExternalMemoryProperties), _Alignof(VkExternalMemoryProperties), offsetof(VkExternalMemoryProperties, externalMemoryFeatures), offsetof(VkExternalMemoryProperties, exportFromImportedHandleTypes), offsetof(VkExternalMemoryProperties, compatibleHandleTypes)};
+// const size_t layout_VkPhysicalDeviceExternalImageFormatInfo[] = {sizeof(VkPhysicalDeviceExternalImageFormatInfo), _Alignof(VkPhysicalDeviceExternalImageFormatInfo), offsetof(VkPhysicalDeviceExternalImageFormatInfo, sType), offsetof(VkPhysicalDeviceExternalImageFormatInfo, pNext), offsetof(VkPhysicalDeviceExternalImageFormatInfo, handleType)};
+// const size_t layout_VkExternalImageFormatProperties[] = {sizeof(VkExternalImageFormatProperties), _Alignof(VkExternalImageFormatProperties), offsetof(VkExternalImageFormatProperties, sType), offsetof(VkExternalImageFormatProperties, pNext), offsetof(VkExternalImageFormatProperties, externalMemoryProperties)};
+// const size_t layout_VkPhysicalDeviceExternalBufferInfo[] = {sizeof(VkPhysicalDeviceExternalBufferInfo), _Alignof(VkPhysicalDeviceExternalBufferInfo), offsetof(VkPhysicalDeviceExternalBufferInfo, sType), offsetof(VkPhysicalDeviceExternalBufferInfo, pNext), offsetof(VkPhysicalDeviceExternalBufferInfo, flags), offsetof(VkPhysicalDeviceExternalBufferInfo, usage), offsetof(VkPhysicalDeviceExternalBufferInfo, handleType)};
+// const size_t layout_VkExternalBufferProperties[] = {sizeof(VkExternalBufferProperties), _Alignof(VkExternalBufferProperties), offsetof(VkExternalBufferProperties, sType), offsetof(VkExternalBufferProperties, pNext), offsetof(VkExternalBufferProperties, externalMemoryProperties)};
+// const size_t layout_VkPhysicalDeviceIDProperties[] = {sizeof(VkPhysicalDeviceIDProperties), _Alignof(VkPhysicalDeviceIDProperties), offsetof(VkPhysicalDeviceIDProperties, sType), offsetof(VkPhysicalDeviceIDProperties, pNext), offsetof(VkPhysicalDeviceIDProperties, deviceUUID), offsetof(VkPhysicalDeviceIDProperties, driverUUID), offsetof(VkPhysicalDeviceIDProperties, deviceLUID), offsetof(VkPhysicalDeviceIDProperties, deviceNodeMask), offsetof(VkPhysicalDeviceIDProperties, deviceLUIDValid)};
+// const size_t layout_VkExternalMemoryImageCreateInfo[] = {sizeof(VkExternalMemoryImageCreateInfo), _Alignof(VkExternalMemoryImageCreateInfo), offsetof(VkExternalMemoryImageCreateInfo, sType), offsetof(VkExternalMemoryImageCreateInfo, pNext), offsetof(VkExternalMemoryImageCreateInfo, handleTypes)};
+// const size_t layout_VkExternalMemoryBufferCreateInfo[] = {sizeof(VkExternalMemoryBufferCreateInfo), _Alignof(VkExternalMemoryBufferCreateInfo), offsetof(VkExternalMemoryBufferCreateInfo, sType), offsetof(VkExternalMemoryBufferCreateInfo, pNext), offsetof(VkExternalMemoryBufferCreateInfo, handleTypes)};
+// const size_t layout_VkExportMemoryAllocateInfo[] = {sizeof(VkExportMemoryAllocateInfo), _Alignof(VkExportMemoryAllocateInfo), offsetof(VkExportMemoryAllocateInfo, sType), offsetof(VkExportMemoryAllocateInfo, pNext), offsetof(VkExportMemoryAllocateInfo, handleTypes)};
+// const size_t layout_VkPhysicalDeviceExternalFenceInfo[] = {sizeof(VkPhysicalDeviceExternalFenceInfo), _Alignof(VkPhysicalDeviceExternalFenceInfo), offsetof(VkPhysicalDeviceExternalFenceInfo, sType), offsetof(VkPhysicalDeviceExternalFenceInfo, pNext), offsetof(VkPhysicalDeviceExternalFenceInfo, handleType)};
+// const size_t layout_VkExternalFenceProperties[] = {sizeof(VkExternalFenceProperties), _Alignof(VkExternalFenceProperties), offsetof(VkExternalFenceProperties, sType), offsetof(VkExternalFenceProperties, pNext), offsetof(VkExternalFenceProperties, exportFromImportedHandleTypes), offsetof(VkExternalFenceProperties, compatibleHandleTypes), offsetof(VkExternalFenceProperties, externalFenceFeatures)};
+// const size_t layout_VkExportFenceCreateInfo[] = {sizeof(VkExportFenceCreateInfo), _Alignof(VkExportFenceCreateInfo), offsetof(VkExportFenceCreateInfo, sType), offsetof(VkExportFenceCreateInfo, pNext), offsetof(VkExportFenceCreateInfo, handleTypes)};
+// const size_t layout_VkExportSemaphoreCreateInfo[] = {sizeof(VkExportSemaphoreCreateInfo), _Alignof(VkExportSemaphoreCreateInfo), offsetof(VkExportSemaphoreCreateInfo, sType), offsetof(VkExportSemaphoreCreateInfo, pNext), offsetof(VkExportSemaphoreCreateInfo, handleTypes)};
+// const size_t layout_VkPhysicalDeviceExternalSemaphoreInfo[] = {sizeof(VkPhysicalDeviceExternalSemaphoreInfo), _Alignof(VkPhysicalDeviceExternalSemaphoreInfo), offsetof(VkPhysicalDeviceExternalSemaphoreInfo, sType), offsetof(VkPhysicalDeviceExternalSemaphoreInfo, pNext), offsetof(VkPhysicalDeviceExternalSemaphoreInfo, handleType)};
+// const size_t layout_VkExternalSemaphoreProperties[] = {sizeof(VkExternalSemaphoreProperties), _Alignof(VkExternalSemaphoreProperties), offsetof(VkExternalSemaphoreProperties, sType), offsetof(VkExternalSemaphoreProperties, pNext), offsetof(VkExternalSemaphoreProperties, exportFromImportedHandleTypes), offsetof(VkExternalSemaphoreProperties, compatibleHandleTypes), offsetof(VkExternalSemaphoreProperties, externalSemaphoreFeatures)};
+// const size_t layout_VkPhysicalDeviceMaintenance3Properties[] = {sizeof(VkPhysicalDeviceMaintenance3Properties), _Alignof(VkPhysicalDeviceMaintenance3Properties), offsetof(VkPhysicalDeviceMaintenance3Properties, sType), offsetof(VkPhysicalDeviceMaintenance3Properties, pNext), offsetof(VkPhysicalDeviceMaintenance3Properties, maxPerSetDescriptors), offsetof(VkPhysicalDeviceMaintenance3Properties, maxMemoryAllocationSize)};
+// const size_t layout_VkDescriptorSetLayoutSupport[] = {sizeof(VkDescriptorSetLayoutSupport), _Alignof(VkDescriptorSetLayoutSupport), offsetof(VkDescriptorSetLayoutSupport, sType), offsetof(VkDescriptorSetLayoutSupport, pNext), offsetof(VkDescriptorSetLayoutSupport, supported)};
+// const size_t layout_VkPhysicalDeviceShaderDrawParametersFeatures[] = {sizeof(VkPhysicalDeviceShaderDrawParametersFeatures), _Alignof(VkPhysicalDeviceShaderDrawParametersFeatures), offsetof(VkPhysicalDeviceShaderDrawParametersFeatures, sType), offsetof(VkPhysicalDeviceShaderDrawParametersFeatures, pNext), offsetof(VkPhysicalDeviceShaderDrawParametersFeatures, shaderDrawParameters)};
+// const size_t layout_VkPhysicalDeviceVulkan11Features[] = {sizeof(VkPhysicalDeviceVulkan11Features), _Alignof(VkPhysicalDeviceVulkan11Features), offsetof(VkPhysicalDeviceVulkan11Features, sType), offsetof(VkPhysicalDeviceVulkan11Features, pNext), offsetof(VkPhysicalDeviceVulkan11Features, storageBuffer16BitAccess), offsetof(VkPhysicalDeviceVulkan11Features, uniformAndStorageBuffer16BitAccess), offsetof(VkPhysicalDeviceVulkan11Features, storagePushConstant16), offsetof(VkPhysicalDeviceVulkan11Features, storageInputOutput16), offsetof(VkPhysicalDeviceVulkan11Features, multiview), offsetof(VkPhysicalDeviceVulkan11Features, multiviewGeometryShader), offsetof(VkPhysicalDeviceVulkan11Features, multiviewTessellationShader), offsetof(VkPhysicalDeviceVulkan11Features, variablePointersStorageBuffer), offsetof(VkPhysicalDeviceVulkan11Features, variablePointers), offsetof(VkPhysicalDeviceVulkan11Features, protectedMemory), offsetof(VkPhysicalDeviceVulkan11Features, samplerYcbcrConversion), offsetof(VkPhysicalDeviceVulkan11Features, shaderDrawParameters)};
+// const size_t layout_VkPhysicalDeviceVulkan11Properties[] = {sizeof(VkPhysicalDeviceVulkan11Properties), _Alignof(VkPhysicalDeviceVulkan11Properties), offsetof(VkPhysicalDeviceVulkan11Properties, sType), offsetof(VkPhysicalDeviceVulkan11Properties, pNext), offsetof(VkPhysicalDeviceVulkan11Properties, deviceUUID), offsetof(VkPhysicalDeviceVulkan11Properties, driverUUID), offsetof(VkPhysicalDeviceVulkan11Properties, deviceLUID), offsetof(VkPhysicalDeviceVulkan11Properties, deviceNodeMask), offsetof(VkPhysicalDeviceVulkan11Properties, deviceLUIDValid), offsetof(VkPhysicalDeviceVulkan11Properties, subgroupSize), offsetof(VkPhysicalDeviceVulkan11Properties, subgroupSupportedStages), offsetof(VkPhysicalDeviceVulkan11Properties, subgroupSupportedOperations), offsetof(VkPhysicalDeviceVulkan11Properties, subgroupQuadOperationsInAllStages), offsetof(VkPhysicalDeviceVulkan11Properties, pointClippingBehavior), offsetof(VkPhysicalDeviceVulkan11Properties, maxMultiviewViewCount), offsetof(VkPhysicalDeviceVulkan11Properties, maxMultiviewInstanceIndex), offsetof(VkPhysicalDeviceVulkan11Properties, protectedNoFault), offsetof(VkPhysicalDeviceVulkan11Properties, maxPerSetDescriptors), offsetof(VkPhysicalDeviceVulkan11Properties, maxMemoryAllocationSize)};
+// const size_t layout_VkPhysicalDeviceVulkan12Features[] = {sizeof(VkPhysicalDeviceVulkan12Features), _Alignof(VkPhysicalDeviceVulkan12Features), offsetof(VkPhysicalDeviceVulkan12Features, sType), offsetof(VkPhysicalDeviceVulkan12Features, pNext), offsetof(VkPhysicalDeviceVulkan12Features, samplerMirrorClampToEdge), offsetof(VkPhysicalDeviceVulkan12Features, drawIndirectCount), offsetof(VkPhysicalDeviceVulkan12Features, storageBuffer8BitAccess), offsetof(VkPhysicalDeviceVulkan12Features, uniformAndStorageBuffer8BitAccess), offsetof(VkPhysicalDeviceVulkan12Features, storagePushConstant8), offsetof(VkPhysicalDeviceVulkan12Features, shaderBufferInt64Atomics), offsetof(VkPhysicalDeviceVulkan12Features, shaderSharedInt64Atomics), offsetof(VkPhysicalDeviceVulkan12Features, shaderFloat16), offsetof(VkPhysicalDeviceVulkan12Features, shaderInt8), offsetof(VkPhysicalDeviceVulkan12Features, descriptorIndexing), offsetof(VkPhysicalDeviceVulkan12Features, shaderInputAttachmentArrayDynamicIndexing), offsetof(VkPhysicalDeviceVulkan12Features, shaderUniformTexelBufferArrayDynamicIndexing), offsetof(VkPhysicalDeviceVulkan12Features, shaderStorageTexelBufferArrayDynamicIndexing), offsetof(VkPhysicalDeviceVulkan12Features, shaderUniformBufferArrayNonUniformIndexing), offsetof(VkPhysicalDeviceVulkan12Features, shaderSampledImageArrayNonUniformIndexing), offsetof(VkPhysicalDeviceVulkan12Features, shaderStorageBufferArrayNonUniformIndexing), offsetof(VkPhysicalDeviceVulkan12Features, shaderStorageImageArrayNonUniformIndexing), offsetof(VkPhysicalDeviceVulkan12Features, shaderInputAttachmentArrayNonUniformIndexing), offsetof(VkPhysicalDeviceVulkan12Features, shaderUniformTexelBufferArrayNonUniformIndexing), offsetof(VkPhysicalDeviceVulkan12Features, shaderStorageTexelBufferArrayNonUniformIndexing), offsetof(VkPhysicalDeviceVulkan12Features, descriptorBindingUniformBufferUpdateAfterBind), offsetof(VkPhysicalDeviceVulkan12Features, descriptorBindingSampledImageUpdateAfterBind), offsetof(VkPhysicalDeviceVulkan12Features, descriptorBindingStorageImageUpdateAfterBind), offsetof(VkPhysicalDeviceVulkan12Features, descriptorBindingStorageBufferUpdateAfterBind), offsetof(VkPhysicalDeviceVulkan12Features, descriptorBindingUniformTexelBufferUpdateAfterBind), offsetof(VkPhysicalDeviceVulkan12Features, descriptorBindingStorageTexelBufferUpdateAfterBind), offsetof(VkPhysicalDeviceVulkan12Features, descriptorBindingUpdateUnusedWhilePending), offsetof(VkPhysicalDeviceVulkan12Features, descriptorBindingPartiallyBound), offsetof(VkPhysicalDeviceVulkan12Features, descriptorBindingVariableDescriptorCount), offsetof(VkPhysicalDeviceVulkan12Features, runtimeDescriptorArray), offsetof(VkPhysicalDeviceVulkan12Features, samplerFilterMinmax), offsetof(VkPhysicalDeviceVulkan12Features, scalarBlockLayout), offsetof(VkPhysicalDeviceVulkan12Features, imagelessFramebuffer), offsetof(VkPhysicalDeviceVulkan12Features, uniformBufferStandardLayout), offsetof(VkPhysicalDeviceVulkan12Features, shaderSubgroupExtendedTypes), offsetof(VkPhysicalDeviceVulkan12Features, separateDepthStencilLayouts), offsetof(VkPhysicalDeviceVulkan12Features, hostQueryReset), offsetof(VkPhysicalDeviceVulkan12Features, timelineSemaphore), offsetof(VkPhysicalDeviceVulkan12Features, bufferDeviceAddress), offsetof(VkPhysicalDeviceVulkan12Features, bufferDeviceAddressCaptureReplay), offsetof(VkPhysicalDeviceVulkan12Features, bufferDeviceAddressMultiDevice), offsetof(VkPhysicalDeviceVulkan12Features, vulkanMemoryModel), offsetof(VkPhysicalDeviceVulkan12Features, vulkanMemoryModelDeviceScope), offsetof(VkPhysicalDeviceVulkan12Features, vulkanMemoryModelAvailabilityVisibilityChains), offsetof(VkPhysicalDeviceVulkan12Features, shaderOutputViewportIndex), offsetof(VkPhysicalDeviceVulkan12Features, shaderOutputLayer), offsetof(VkPhysicalDeviceVulkan12Features, subgroupBroadcastDynamicId)};
+// const size_t layout_VkConformanceVersion[] = {sizeof(VkConformanceVersion), _Alignof(VkConformanceVersion), offsetof(VkConformanceVersion, major), offsetof(VkConformanceVersion, minor), offsetof(VkConformanceVersion, subminor), offsetof(VkConformanceVersion, patch)};
+// const size_t layout_VkPhysicalDeviceVulkan12Properties[] = {sizeof(VkPhysicalDeviceVulkan12Properties), _Alignof(VkPhysicalDeviceVulkan12Properties), offsetof(VkPhysicalDeviceVulkan12Properties, sType), offsetof(VkPhysicalDeviceVulkan12Properties, pNext), offsetof(VkPhysicalDeviceVulkan12Properties, driverID), offsetof(VkPhysicalDeviceVulkan12Properties, driverName), offsetof(VkPhysicalDeviceVulkan12Properties, driverInfo), offsetof(VkPhysicalDeviceVulkan12Properties, conformanceVersion), offsetof(VkPhysicalDeviceVulkan12Properties, denormBehaviorIndependence), offsetof(VkPhysicalDeviceVulkan12Properties, roundingModeIndependence), offsetof(VkPhysicalDeviceVulkan12Properties, shaderSignedZeroInfNanPreserveFloat16), offsetof(VkPhysicalDeviceVulkan12Properties, shaderSignedZeroInfNanPreserveFloat32), offsetof(VkPhysicalDeviceVulkan12Properties, shaderSignedZeroInfNanPreserveFloat64), offsetof(VkPhysicalDeviceVulkan12Properties, shaderDenormPreserveFloat16), offsetof(VkPhysicalDeviceVulkan12Properties, shaderDenormPreserveFloat32), offsetof(VkPhysicalDeviceVulkan12Properties, shaderDenormPreserveFloat64), offsetof(VkPhysicalDeviceVulkan12Properties, shaderDenormFlushToZeroFloat16), offsetof(VkPhysicalDeviceVulkan12Properties, shaderDenormFlushToZeroFloat32), offsetof(VkPhysicalDeviceVulkan12Properties, shaderDenormFlushToZeroFloat64), offsetof(VkPhysicalDeviceVulkan12Properties, shaderRoundingModeRTEFloat16), offsetof(VkPhysicalDeviceVulkan12Properties, shaderRoundingModeRTEFloat32), offsetof(VkPhysicalDeviceVulkan12Properties, shaderRoundingModeRTEFloat64), offsetof(VkPhysicalDeviceVulkan12Properties, shaderRoundingModeRTZFloat16), offsetof(VkPhysicalDeviceVulkan12Properties, shaderRoundingModeRTZFloat32), offsetof(VkPhysicalDeviceVulkan12Properties, shaderRoundingModeRTZFloat64), offsetof(VkPhysicalDeviceVulkan12Properties, maxUpdateAfterBindDescriptorsInAllPools), offsetof(VkPhysicalDeviceVulkan12Properties, shaderUniformBufferArrayNonUniformIndexingNative), offsetof(VkPhysicalDeviceVulkan12Properties, shaderSampledImageArrayNonUniformIndexingNative), offsetof(VkPhysicalDeviceVulkan12Properties, shaderStorageBufferArrayNonUniformIndexingNative), offsetof(VkPhysicalDeviceVulkan12Properties, shaderStorageImageArrayNonUniformIndexingNative), offsetof(VkPhysicalDeviceVulkan12Properties, shaderInputAttachmentArrayNonUniformIndexingNative), offsetof(VkPhysicalDeviceVulkan12Properties, robustBufferAccessUpdateAfterBind), offsetof(VkPhysicalDeviceVulkan12Properties, quadDivergentImplicitLod), offsetof(VkPhysicalDeviceVulkan12Properties, maxPerStageDescriptorUpdateAfterBindSamplers), offsetof(VkPhysicalDeviceVulkan12Properties, maxPerStageDescriptorUpdateAfterBindUniformBuffers), offsetof(VkPhysicalDeviceVulkan12Properties, maxPerStageDescriptorUpdateAfterBindStorageBuffers), offsetof(VkPhysicalDeviceVulkan12Properties, maxPerStageDescriptorUpdateAfterBindSampledImages), offsetof(VkPhysicalDeviceVulkan12Properties, maxPerStageDescriptorUpdateAfterBindStorageImages), offsetof(VkPhysicalDeviceVulkan12Properties, maxPerStageDescriptorUpdateAfterBindInputAttachments), offsetof(VkPhysicalDeviceVulkan12Properties, maxPerStageUpdateAfterBindResources), offsetof(VkPhysicalDeviceVulkan12Properties, maxDescriptorSetUpdateAfterBindSamplers), offsetof(VkPhysicalDeviceVulkan12Properties, maxDescriptorSetUpdateAfterBindUniformBuffers), offsetof(VkPhysicalDeviceVulkan12Properties, maxDescriptorSetUpdateAfterBindUniformBuffersDynamic), offsetof(VkPhysicalDeviceVulkan12Properties, maxDescriptorSetUpdateAfterBindStorageBuffers), offsetof(VkPhysicalDeviceVulkan12Properties, maxDescriptorSetUpdateAfterBindStorageBuffersDynamic), offsetof(VkPhysicalDeviceVulkan12Properties, maxDescriptorSetUpdateAfterBindSampledImages), offsetof(VkPhysicalDeviceVulkan12Properties, maxDescriptorSetUpdateAfterBindStorageImages), offsetof(VkPhysicalDeviceVulkan12Properties, maxDescriptorSetUpdateAfterBindInputAttachments), offsetof(VkPhysicalDeviceVulkan12Properties, supportedDepthResolveModes), offsetof(VkPhysicalDeviceVulkan12Properties, supportedStencilResolveModes), offsetof(VkPhysicalDeviceVulkan12Properties, independentResolveNone), offsetof(VkPhysicalDeviceVulkan12Properties, independentResolve), offsetof(VkPhysicalDeviceVulkan12Properties, filterMinmaxSingleComponentFormats), offsetof(VkPhysicalDeviceVulkan12Properties, filterMinmaxImageComponentMapping), offsetof(VkPhysicalDeviceVulkan12Properties, maxTimelineSemaphoreValueDifference), offsetof(VkPhysicalDeviceVulkan12Properties, framebufferIntegerColorSampleCounts)};
+// const size_t layout_VkImageFormatListCreateInfo[] = {sizeof(VkImageFormatListCreateInfo), _Alignof(VkImageFormatListCreateInfo), offsetof(VkImageFormatListCreateInfo, sType), offsetof(VkImageFormatListCreateInfo, pNext), offsetof(VkImageFormatListCreateInfo, viewFormatCount), offsetof(VkImageFormatListCreateInfo, pViewFormats)};
+// const size_t layout_VkAttachmentDescription2[] = {sizeof(VkAttachmentDescription2), _Alignof(VkAttachmentDescription2), offsetof(VkAttachmentDescription2, sType), offsetof(VkAttachmentDescription2, pNext), offsetof(VkAttachmentDescription2, flags), offsetof(VkAttachmentDescription2, format), offsetof(VkAttachmentDescription2, samples), offsetof(VkAttachmentDescription2, loadOp), offsetof(VkAttachmentDescription2, storeOp), offsetof(VkAttachmentDescription2, stencilLoadOp), offsetof(VkAttachmentDescription2, stencilStoreOp), offsetof(VkAttachmentDescription2, initialLayout), offsetof(VkAttachmentDescription2, finalLayout)};
+// const size_t layout_VkAttachmentReference2[] = {sizeof(VkAttachmentReference2), _Alignof(VkAttachmentReference2), offsetof(VkAttachmentReference2, sType), offsetof(VkAttachmentReference2, pNext), offsetof(VkAttachmentReference2, attachment), offsetof(VkAttachmentReference2, layout), offsetof(VkAttachmentReference2, aspectMask)};
+// const size_t layout_VkSubpassDescription2[] = {sizeof(VkSubpassDescription2), _Alignof(VkSubpassDescription2), offsetof(VkSubpassDescription2, sType), offsetof(VkSubpassDescription2, pNext), offsetof(VkSubpassDescription2, flags), offsetof(VkSubpassDescription2, pipelineBindPoint), offsetof(VkSubpassDescription2, viewMask), offsetof(VkSubpassDescription2, inputAttachmentCount), offsetof(VkSubpassDescription2, pInputAttachments), offsetof(VkSubpassDescription2, colorAttachmentCount), offsetof(VkSubpassDescription2, pColorAttachments), offsetof(VkSubpassDescription2, pResolveAttachments), offsetof(VkSubpassDescription2, pDepthStencilAttachment), offsetof(VkSubpassDescription2, preserveAttachmentCount), offsetof(VkSubpassDescription2, pPreserveAttachments)};
+// const size_t layout_VkSubpassDependency2[] = {sizeof(VkSubpassDependency2), _Alignof(VkSubpassDependency2), offsetof(VkSubpassDependency2, sType), offsetof(VkSubpassDependency2, pNext), offsetof(VkSubpassDependency2, srcSubpass), offsetof(VkSubpassDependency2, dstSubpass), offsetof(VkSubpassDependency2, srcStageMask), offsetof(VkSubpassDependency2, dstStageMask), offsetof(VkSubpassDependency2, srcAccessMask), offsetof(VkSubpassDependency2, dstAccessMask), offsetof(VkSubpassDependency2, dependencyFlags), offsetof(VkSubpassDependency2, viewOffset)};
+// const size_t layout_VkRenderPassCreateInfo2[] = {sizeof(VkRenderPassCreateInfo2), _Alignof(VkRenderPassCreateInfo2), offsetof(VkRenderPassCreateInfo2, sType), offsetof(VkRenderPassCreateInfo2, pNext), offsetof(VkRenderPassCreateInfo2, flags), offsetof(VkRenderPassCreateInfo2, attachmentCount), offsetof(VkRenderPassCreateInfo2, pAttachments), offsetof(VkRenderPassCreateInfo2, subpassCount), offsetof(VkRenderPassCreateInfo2, pSubpasses), offsetof(VkRenderPassCreateInfo2, dependencyCount), offsetof(VkRenderPassCreateInfo2, pDependencies), offsetof(VkRenderPassCreateInfo2, correlatedViewMaskCount), offsetof(VkRenderPassCreateInfo2, pCorrelatedViewMasks)};
+// const size_t layout_VkSubpassBeginInfo[] = {sizeof(VkSubpassBeginInfo), _Alignof(VkSubpassBeginInfo), offsetof(VkSubpassBeginInfo, sType), offsetof(VkSubpassBeginInfo, pNext), offsetof(VkSubpassBeginInfo, contents)};
+// const size_t layout_VkSubpassEndInfo[] = {sizeof(VkSubpassEndInfo), _Alignof(VkSubpassEndInfo), offsetof(VkSubpassEndInfo, sType), offsetof(VkSubpassEndInfo, pNext)};
+// const size_t layout_VkPhysicalDevice8BitStorageFeatures[] = {sizeof(VkPhysicalDevice8BitStorageFeatures), _Alignof(VkPhysicalDevice8BitStorageFeatures), offsetof(VkPhysicalDevice8BitStorageFeatures, sType), offsetof(VkPhysicalDevice8BitStorageFeatures, pNext), offsetof(VkPhysicalDevice8BitStorageFeatures, storageBuffer8BitAccess), offsetof(VkPhysicalDevice8BitStorageFeatures, uniformAndStorageBuffer8BitAccess), offsetof(VkPhysicalDevice8BitStorageFeatures, storagePushConstant8)};
+// const size_t layout_VkPhysicalDeviceDriverProperties[] = {sizeof(VkPhysicalDeviceDriverProperties), _Alignof(VkPhysicalDeviceDriverProperties), offsetof(VkPhysicalDeviceDriverProperties, sType), offsetof(VkPhysicalDeviceDriverProperties, pNext), offsetof(VkPhysicalDeviceDriverProperties, driverID), offsetof(VkPhysicalDeviceDriverProperties, driverName), offsetof(VkPhysicalDeviceDriverProperties, driverInfo), offsetof(VkPhysicalDeviceDriverProperties, conformanceVersion)};
+// const size_t layout_VkPhysicalDeviceShaderAtomicInt64Features[] = {sizeof(VkPhysicalDeviceShaderAtomicInt64Features), _Alignof(VkPhysicalDeviceShaderAtomicInt64Features), offsetof(VkPhysicalDeviceShaderAtomicInt64Features, sType), offsetof(VkPhysicalDeviceShaderAtomicInt64Features, pNext), offsetof(VkPhysicalDeviceShaderAtomicInt64Features, shaderBufferInt64Atomics), offsetof(VkPhysicalDeviceShaderAtomicInt64Features, shaderSharedInt64Atomics)};
+// const size_t layout_VkPhysicalDeviceShaderFloat16Int8Features[] = {sizeof(VkPhysicalDeviceShaderFloat16Int8Features), _Alignof(VkPhysicalDeviceShaderFloat16Int8Features), offsetof(VkPhysicalDeviceShaderFloat16Int8Features, sType), offsetof(VkPhysicalDeviceShaderFloat16Int8Features, pNext), offsetof(VkPhysicalDeviceShaderFloat16Int8Features, shaderFloat16), offsetof(VkPhysicalDeviceShaderFloat16Int8Features, shaderInt8)};
+// const size_t layout_VkPhysicalDeviceFloatControlsProperties[] = {sizeof(VkPhysicalDeviceFloatControlsProperties), _Alignof(VkPhysicalDeviceFloatControlsProperties), offsetof(VkPhysicalDeviceFloatControlsProperties, sType), offsetof(VkPhysicalDeviceFloatControlsProperties, pNext), offsetof(VkPhysicalDeviceFloatControlsProperties, denormBehaviorIndependence), offsetof(VkPhysicalDeviceFloatControlsProperties, roundingModeIndependence), offsetof(VkPhysicalDeviceFloatControlsProperties, shaderSignedZeroInfNanPreserveFloat16), offsetof(VkPhysicalDeviceFloatControlsProperties, shaderSignedZeroInfNanPreserveFloat32), offsetof(VkPhysicalDeviceFloatControlsProperties, shaderSignedZeroInfNanPreserveFloat64), offsetof(VkPhysicalDeviceFloatControlsProperties, shaderDenormPreserveFloat16), offsetof(VkPhysicalDeviceFloatControlsProperties, shaderDenormPreserveFloat32), offsetof(VkPhysicalDeviceFloatControlsProperties, shaderDenormPreserveFloat64), offsetof(VkPhysicalDeviceFloatControlsProperties, shaderDenormFlushToZeroFloat16), offsetof(VkPhysicalDeviceFloatControlsProperties, shaderDenormFlushToZeroFloat32), offsetof(VkPhysicalDeviceFloatControlsProperties, shaderDenormFlushToZeroFloat64), offsetof(VkPhysicalDeviceFloatControlsProperties, shaderRoundingModeRTEFloat16), offsetof(VkPhysicalDeviceFloatControlsProperties, shaderRoundingModeRTEFloat32), offsetof(VkPhysicalDeviceFloatControlsProperties, shaderRoundingModeRTEFloat64), offsetof(VkPhysicalDeviceFloatControlsProperties, shaderRoundingModeRTZFloat16), offsetof(VkPhysicalDeviceFloatControlsProperties, shaderRoundingModeRTZFloat32), offsetof(VkPhysicalDeviceFloatControlsProperties, shaderRoundingModeRTZFloat64)};
+// const size_t layout_VkDescriptorSetLayoutBindingFlagsCreateInfo[] = {sizeof(VkDescriptorSetLayoutBindingFlagsCreateInfo), _Alignof(VkDescriptorSetLayoutBindingFlagsCreateInfo), offsetof(VkDescriptorSetLayoutBindingFlagsCreateInfo, sType), offsetof(VkDescriptorSetLayoutBindingFlagsCreateInfo, pNext), offsetof(VkDescriptorSetLayoutBindingFlagsCreateInfo, bindingCount), offsetof(VkDescriptorSetLayoutBindingFlagsCreateInfo, pBindingFlags)};
+// const size_t layout_VkPhysicalDeviceDescriptorIndexingFeatures[] = {sizeof(VkPhysicalDeviceDescriptorIndexingFeatures), _Alignof(VkPhysicalDeviceDescriptorIndexingFeatures), offsetof(VkPhysicalDeviceDescriptorIndexingFeatures, sType), offsetof(VkPhysicalDeviceDescriptorIndexingFeatures, pNext), offsetof(VkPhysicalDeviceDescriptorIndexingFeatures, shaderInputAttachmentArrayDynamicIndexing), offsetof(VkPhysicalDeviceDescriptorIndexingFeatures, shaderUniformTexelBufferArrayDynamicIndexing), offsetof(VkPhysicalDeviceDescriptorIndexingFeatures, shaderStorageTexelBufferArrayDynamicIndexing), offsetof(VkPhysicalDeviceDescriptorIndexingFeatures, shaderUniformBufferArrayNonUniformIndexing), offsetof(VkPhysicalDeviceDescriptorIndexingFeatures, shaderSampledImageArrayNonUniformIndexing), offsetof(VkPhysicalDeviceDescriptorIndexingFeatures, shaderStorageBufferArrayNonUniformIndexing), offsetof(VkPhysicalDeviceDescriptorIndexingFeatures, shaderStorageImageArrayNonUniformIndexing), offsetof(VkPhysicalDeviceDescriptorIndexingFeatures, shaderInputAttachmentArrayNonUniformIndexing), offsetof(VkPhysicalDeviceDescriptorIndexingFeatures, shaderUniformTexelBufferArrayNonUniformIndexing), offsetof(VkPhysicalDeviceDescriptorIndexingFeatures, shaderStorageTexelBufferArrayNonUniformIndexing), offsetof(VkPhysicalDeviceDescriptorIndexingFeatures, descriptorBindingUniformBufferUpdateAfterBind), offsetof(VkPhysicalDeviceDescriptorIndexingFeatures, descriptorBindingSampledImageUpdateAfterBind), offsetof(VkPhysicalDeviceDescriptorIndexingFeatures, descriptorBindingStorageImageUpdateAfterBind), offsetof(VkPhysicalDeviceDescriptorIndexingFeatures, descriptorBindingStorageBufferUpdateAfterBind), offsetof(VkPhysicalDeviceDescriptorIndexingFeatures, descriptorBindingUniformTexelBufferUpdateAfterBind), offsetof(VkPhysicalDeviceDescriptorIndexingFeatures, descriptorBindingStorageTexelBufferUpdateAfterBind), offsetof(VkPhysicalDeviceDescriptorIndexingFeatures, descriptorBindingUpdateUnusedWhilePending), offsetof(VkPhysicalDeviceDescriptorIndexingFeatures, descriptorBindingPartiallyBound), offsetof(VkPhysicalDeviceDescriptorIndexingFeatures, descriptorBindingVariableDescriptorCount), offsetof(VkPhysicalDeviceDescriptorIndexingFeatures, runtimeDescriptorArray)};
+// const size_t layout_VkPhysicalDeviceDescriptorIndexingProperties[] = {sizeof(VkPhysicalDeviceDescriptorIndexingProperties), _Alignof(VkPhysicalDeviceDescriptorIndexingProperties), offsetof(VkPhysicalDeviceDescriptorIndexingProperties, sType), offsetof(VkPhysicalDeviceDescriptorIndexingProperties, pNext), offsetof(VkPhysicalDeviceDescriptorIndexingProperties, maxUpdateAfterBindDescriptorsInAllPools), offsetof(VkPhysicalDeviceDescriptorIndexingProperties, shaderUniformBufferArrayNonUniformIndexingNative), offsetof(VkPhysicalDeviceDescriptorIndexingProperties, shaderSampledImageArrayNonUniformIndexingNative), offsetof(VkPhysicalDeviceDescriptorIndexingProperties, shaderStorageBufferArrayNonUniformIndexingNative), offsetof(VkPhysicalDeviceDescriptorIndexingProperties, shaderStorageImageArrayNonUniformIndexingNative), offsetof(VkPhysicalDeviceDescriptorIndexingProperties, shaderInputAttachmentArrayNonUniformIndexingNative), offsetof(VkPhysicalDeviceDescriptorIndexingProperties, robustBufferAccessUpdateAfterBind), offsetof(VkPhysicalDeviceDescriptorIndexingProperties, quadDivergentImplicitLod), offsetof(VkPhysicalDeviceDescriptorIndexingProperties, maxPerStageDescriptorUpdateAfterBindSamplers), offsetof(VkPhysicalDeviceDescriptorIndexingProperties, maxPerStageDescriptorUpdateAfterBindUniformBuffers), offsetof(VkPhysicalDeviceDescriptorIndexingProperties, maxPerStageDescriptorUpdateAfterBindStorageBuffers), offsetof(VkPhysicalDeviceDescriptorIndexingProperties, maxPerStageDescriptorUpdateAfterBindSampledImages), offsetof(VkPhysicalDeviceDescriptorIndexingProperties, maxPerStageDescriptorUpdateAfterBindStorageImages), offsetof(VkPhysicalDeviceDescriptorIndexingProperties, maxPerStageDescriptorUpdateAfterBindInputAttachments), offsetof(VkPhysicalDeviceDescriptorIndexingProperties, maxPerStageUpdateAfterBindResources), offsetof(VkPhysicalDeviceDescriptorIndexingProperties, maxDescriptorSetUpdateAfterBindSamplers), offsetof(VkPhysicalDeviceDescriptorIndexingProperties, maxDescriptorSetUpdateAfterBindUniformBuffers), offsetof(VkPhysicalDeviceDescriptorIndexingProperties, maxDescriptorSetUpdateAfterBindUniformBuffersDynamic), offsetof(VkPhysicalDeviceDescriptorIndexingProperties, maxDescriptorSetUpdateAfterBindStorageBuffers), offsetof(VkPhysicalDeviceDescriptorIndexingProperties, maxDescriptorSetUpdateAfterBindStorageBuffersDynamic), offsetof(VkPhysicalDeviceDescriptorIndexingProperties, maxDescriptorSetUpdateAfterBindSampledImages), offsetof(VkPhysicalDeviceDescriptorIndexingProperties, maxDescriptorSetUpdateAfterBindStorageImages), offsetof(VkPhysicalDeviceDescriptorIndexingProperties, maxDescriptorSetUpdateAfterBindInputAttachments)};
+// const size_t layout_VkDescriptorSetVariableDescriptorCountAllocateInfo[] = {sizeof(VkDescriptorSetVariableDescriptorCountAllocateInfo), _Alignof(VkDescriptorSetVariableDescriptorCountAllocateInfo), offsetof(VkDescriptorSetVariableDescriptorCountAllocateInfo, sType), offsetof(VkDescriptorSetVariableDescriptorCountAllocateInfo, pNext), offsetof(VkDescriptorSetVariableDescriptorCountAllocateInfo, descriptorSetCount), offsetof(VkDescriptorSetVariableDescriptorCountAllocateInfo, pDescriptorCounts)};
+// const size_t layout_VkDescriptorSetVariableDescriptorCountLayoutSupport[] = {sizeof(VkDescriptorSetVariableDescriptorCountLayoutSupport), _Alignof(VkDescriptorSetVariableDescriptorCountLayoutSupport), offsetof(VkDescriptorSetVariableDescriptorCountLayoutSupport, sType), offsetof(VkDescriptorSetVariableDescriptorCountLayoutSupport, pNext), offsetof(VkDescriptorSetVariableDescriptorCountLayoutSupport, maxVariableDescriptorCount)};
+// const size_t layout_VkSubpassDescriptionDepthStencilResolve[] = {sizeof(VkSubpassDescriptionDepthStencilResolve), _Alignof(VkSubpassDescriptionDepthStencilResolve), offsetof(VkSubpassDescriptionDepthStencilResolve, sType), offsetof(VkSubpassDescriptionDepthStencilResolve, pNext), offsetof(VkSubpassDescriptionDepthStencilResolve, depthResolveMode), offsetof(VkSubpassDescriptionDepthStencilResolve, stencilResolveMode), offsetof(VkSubpassDescriptionDepthStencilResolve, pDepthStencilResolveAttachment)};
+// const size_t layout_VkPhysicalDeviceDepthStencilResolveProperties[] = {sizeof(VkPhysicalDeviceDepthStencilResolveProperties), _Alignof(VkPhysicalDeviceDepthStencilResolveProperties), offsetof(VkPhysicalDeviceDepthStencilResolveProperties, sType), offsetof(VkPhysicalDeviceDepthStencilResolveProperties, pNext), offsetof(VkPhysicalDeviceDepthStencilResolveProperties, supportedDepthResolveModes), offsetof(VkPhysicalDeviceDepthStencilResolveProperties, supportedStencilResolveModes), offsetof(VkPhysicalDeviceDepthStencilResolveProperties, independentResolveNone), offsetof(VkPhysicalDeviceDepthStencilResolveProperties, independentResolve)};
+// const size_t layout_VkPhysicalDeviceScalarBlockLayoutFeatures[] = {sizeof(VkPhysicalDeviceScalarBlockLayoutFeatures), _Alignof(VkPhysicalDeviceScalarBlockLayoutFeatures), offsetof(VkPhysicalDeviceScalarBlockLayoutFeatures, sType), offsetof(VkPhysicalDeviceScalarBlockLayoutFeatures, pNext), offsetof(VkPhysicalDeviceScalarBlockLayoutFeatures, scalarBlockLayout)};
+// const size_t layout_VkImageStencilUsageCreateInfo[] = {sizeof(VkImageStencilUsageCreateInfo), _Alignof(VkImageStencilUsageCreateInfo), offsetof(VkImageStencilUsageCreateInfo, sType), offsetof(VkImageStencilUsageCreateInfo, pNext), offsetof(VkImageStencilUsageCreateInfo, stencilUsage)};
+// const size_t layout_VkSamplerReductionModeCreateInfo[] = {sizeof(VkSamplerReductionModeCreateInfo), _Alignof(VkSamplerReductionModeCreateInfo), offsetof(VkSamplerReductionModeCreateInfo, sType), offsetof(VkSamplerReductionModeCreateInfo, pNext), offsetof(VkSamplerReductionModeCreateInfo, reductionMode)};
+// const size_t layout_VkPhysicalDeviceSamplerFilterMinmaxProperties[] = {sizeof(VkPhysicalDeviceSamplerFilterMinmaxProperties), _Alignof(VkPhysicalDeviceSamplerFilterMinmaxProperties), offsetof(VkPhysicalDeviceSamplerFilterMinmaxProperties, sType), offsetof(VkPhysicalDeviceSamplerFilterMinmaxProperties, pNext), offsetof(VkPhysicalDeviceSamplerFilterMinmaxProperties, filterMinmaxSingleComponentFormats), offsetof(VkPhysicalDeviceSamplerFilterMinmaxProperties, filterMinmaxImageComponentMapping)};
+// const size_t layout_VkPhysicalDeviceVulkanMemoryModelFeatures[] = {sizeof(VkPhysicalDeviceVulkanMemoryModelFeatures), _Alignof(VkPhysicalDeviceVulkanMemoryModelFeatures), offsetof(VkPhysicalDeviceVulkanMemoryModelFeatures, sType), offsetof(VkPhysicalDeviceVulkanMemoryModelFeatures, pNext), offsetof(VkPhysicalDeviceVulkanMemoryModelFeatures, vulkanMemoryModel), offsetof(VkPhysicalDeviceVulkanMemoryModelFeatures, vulkanMemoryModelDeviceScope), offsetof(VkPhysicalDeviceVulkanMemoryModelFeatures, vulkanMemoryModelAvailabilityVisibilityChains)};
+// const size_t layout_VkPhysicalDeviceImagelessFramebufferFeatures[] = {sizeof(VkPhysicalDeviceImagelessFramebufferFeatures), _Alignof(VkPhysicalDeviceImagelessFramebufferFeatures), offsetof(VkPhysicalDeviceImagelessFramebufferFeatures, sType), offsetof(VkPhysicalDeviceImagelessFramebufferFeatures, pNext), offsetof(VkPhysicalDeviceImagelessFramebufferFeatures, imagelessFramebuffer)};
+// const size_t layout_VkFramebufferAttachmentImageInfo[] = {sizeof(VkFramebufferAttachmentImageInfo), _Alignof(VkFramebufferAttachmentImageInfo), offsetof(VkFramebufferAttachmentImageInfo, sType), offsetof(VkFramebufferAttachmentImageInfo, pNext), offsetof(VkFramebufferAttachmentImageInfo, flags), offsetof(VkFramebufferAttachmentImageInfo, usage), offsetof(VkFramebufferAttachmentImageInfo, width), offsetof(VkFramebufferAttachmentImageInfo, height), offsetof(VkFramebufferAttachmentImageInfo, layerCount), offsetof(VkFramebufferAttachmentImageInfo, viewFormatCount), offsetof(VkFramebufferAttachmentImageInfo, pViewFormats)};
+// const size_t layout_VkFramebufferAttachmentsCreateInfo[] = {sizeof(VkFramebufferAttachmentsCreateInfo), _Alignof(VkFramebufferAttachmentsCreateInfo), offsetof(VkFramebufferAttachmentsCreateInfo, sType), offsetof(VkFramebufferAttachmentsCreateInfo, pNext), offsetof(VkFramebufferAttachmentsCreateInfo, attachmentImageInfoCount), offsetof(VkFramebufferAttachmentsCreateInfo, pAttachmentImageInfos)};
+// const size_t layout_VkRenderPassAttachmentBeginInfo[] = {sizeof(VkRenderPassAttachmentBeginInfo), _Alignof(VkRenderPassAttachmentBeginInfo), offsetof(VkRenderPassAttachmentBeginInfo, sType), offsetof(VkRenderPassAttachmentBeginInfo, pNext), offsetof(VkRenderPassAttachmentBeginInfo, attachmentCount), offsetof(VkRenderPassAttachmentBeginInfo, pAttachments)};
+// const size_t layout_VkPhysicalDeviceUniformBufferStandardLayoutFeatures[] = {sizeof(VkPhysicalDeviceUniformBufferStandardLayoutFeatures), _Alignof(VkPhysicalDeviceUniformBufferStandardLayoutFeatures), offsetof(VkPhysicalDeviceUniformBufferStandardLayoutFeatures, sType), offsetof(VkPhysicalDeviceUniformBufferStandardLayoutFeatures, pNext), offsetof(VkPhysicalDeviceUniformBufferStandardLayoutFeatures, uniformBufferStandardLayout)};
+// const size_t layout_VkPhysicalDeviceShaderSubgroupExtendedTypesFeatures[] = {sizeof(VkPhysicalDeviceShaderSubgroupExtendedTypesFeatures), _Alignof(VkPhysicalDeviceShaderSubgroupExtendedTypesFeatures), offsetof(VkPhysicalDeviceShaderSubgroupExtendedTypesFeatures, sType), offsetof(VkPhysicalDeviceShaderSubgroupExtendedTypesFeatures, pNext), offsetof(VkPhysicalDeviceShaderSubgroupExtendedTypesFeatures, shaderSubgroupExtendedTypes)};
+// const size_t layout_VkPhysicalDeviceSeparateDepthStencilLayoutsFeatures[] = {sizeof(VkPhysicalDeviceSeparateDepthStencilLayoutsFeatures), _Alignof(VkPhysicalDeviceSeparateDepthStencilLayoutsFeatures), offsetof(VkPhysicalDeviceSeparateDepthStencilLayoutsFeatures, sType), offsetof(VkPhysicalDeviceSeparateDepthStencilLayoutsFeatures, pNext), offsetof(VkPhysicalDeviceSeparateDepthStencilLayoutsFeatures, separateDepthStencilLayouts)};
+// const size_t layout_VkAttachmentReferenceStencilLayout[] = {sizeof(VkAttachmentReferenceStencilLayout), _Alignof(VkAttachmentReferenceStencilLayout), offsetof(VkAttachmentReferenceStencilLayout, sType), offsetof(VkAttachmentReferenceStencilLayout, pNext), offsetof(VkAttachmentReferenceStencilLayout, stencilLayout)};
+// const size_t layout_VkAttachmentDescriptionStencilLayout[] = {sizeof(VkAttachmentDescriptionStencilLayout), _Alignof(VkAttachmentDescriptionStencilLayout), offsetof(VkAttachmentDescriptionStencilLayout, sType), offsetof(VkAttachmentDescriptionStencilLayout, pNext), offsetof(VkAttachmentDescriptionStencilLayout, stencilInitialLayout), offsetof(VkAttachmentDescriptionStencilLayout, stencilFinalLayout)};
+// const size_t layout_VkPhysicalDeviceHostQueryResetFeatures[] = {sizeof(VkPhysicalDeviceHostQueryResetFeatures), _Alignof(VkPhysicalDeviceHostQueryResetFeatures), offsetof(VkPhysicalDeviceHostQueryResetFeatures, sType), offsetof(VkPhysicalDeviceHostQueryResetFeatures, pNext), offsetof(VkPhysicalDeviceHostQueryResetFeatures, hostQueryReset)};
+// const size_t layout_VkPhysicalDeviceTimelineSemaphoreFeatures[] = {sizeof(VkPhysicalDeviceTimelineSemaphoreFeatures), _Alignof(VkPhysicalDeviceTimelineSemaphoreFeatures), offsetof(VkPhysicalDeviceTimelineSemaphoreFeatures, sType), offsetof(VkPhysicalDeviceTimelineSemaphoreFeatures, pNext), offsetof(VkPhysicalDeviceTimelineSemaphoreFeatures, timelineSemaphore)};
+// const size_t layout_VkPhysicalDeviceTimelineSemaphoreProperties[] = {sizeof(VkPhysicalDeviceTimelineSemaphoreProperties), _Alignof(VkPhysicalDeviceTimelineSemaphoreProperties), offsetof(VkPhysicalDeviceTimelineSemaphoreProperties, sType), offsetof(VkPhysicalDeviceTimelineSemaphoreProperties, pNext), offsetof(VkPhysicalDeviceTimelineSemaphoreProperties, maxTimelineSemaphoreValueDifference)};
+// const size_t layout_VkSemaphoreTypeCreateInfo[] = {sizeof(VkSemaphoreTypeCreateInfo), _Alignof(VkSemaphoreTypeCreateInfo), offsetof(VkSemaphoreTypeCreateInfo, sType), offsetof(VkSemaphoreTypeCreateInfo, pNext), offsetof(VkSemaphoreTypeCreateInfo, semaphoreType), offsetof(VkSemaphoreTypeCreateInfo, initialValue)};
+// const size_t layout_VkTimelineSemaphoreSubmitInfo[] = {sizeof(VkTimelineSemaphoreSubmitInfo), _Alignof(VkTimelineSemaphoreSubmitInfo), offsetof(VkTimelineSemaphoreSubmitInfo, sType), offsetof(VkTimelineSemaphoreSubmitInfo, pNext), offsetof(VkTimelineSemaphoreSubmitInfo, waitSemaphoreValueCount), offsetof(VkTimelineSemaphoreSubmitInfo, pWaitSemaphoreValues), offsetof(VkTimelineSemaphoreSubmitInfo, signalSemaphoreValueCount), offsetof(VkTimelineSemaphoreSubmitInfo, pSignalSemaphoreValues)};
+// const size_t layout_VkSemaphoreWaitInfo[] = {sizeof(VkSemaphoreWaitInfo), _Alignof(VkSemaphoreWaitInfo), offsetof(VkSemaphoreWaitInfo, sType), offsetof(VkSemaphoreWaitInfo, pNext), offsetof(VkSemaphoreWaitInfo, flags), offsetof(VkSemaphoreWaitInfo, semaphoreCount), offsetof(VkSemaphoreWaitInfo, pSemaphores), offsetof(VkSemaphoreWaitInfo, pValues)};
+// const size_t layout_VkSemaphoreSignalInfo[] = {sizeof(VkSemaphoreSignalInfo), _Alignof(VkSemaphoreSignalInfo), offsetof(VkSemaphoreSignalInfo, sType), offsetof(VkSemaphoreSignalInfo, pNext), offsetof(VkSemaphoreSignalInfo, semaphore), offsetof(VkSemaphoreSignalInfo, value)};
+// const size_t layout_VkPhysicalDeviceBufferDeviceAddressFeatures[] = {sizeof(VkPhysicalDeviceBufferDeviceAddressFeatures), _Alignof(VkPhysicalDeviceBufferDeviceAddressFeatures), offsetof(VkPhysicalDeviceBufferDeviceAddressFeatures, sType), offsetof(VkPhysicalDeviceBufferDeviceAddressFeatures, pNext), offsetof(VkPhysicalDeviceBufferDeviceAddressFeatures, bufferDeviceAddress), offsetof(VkPhysicalDeviceBufferDeviceAddressFeatures, bufferDeviceAddressCaptureReplay), offsetof(VkPhysicalDeviceBufferDeviceAddressFeatures, bufferDeviceAddressMultiDevice)};
+// const size_t layout_VkBufferDeviceAddressInfo[] = {sizeof(VkBufferDeviceAddressInfo), _Alignof(VkBufferDeviceAddressInfo), offsetof(VkBufferDeviceAddressInfo, sType), offsetof(VkBufferDeviceAddressInfo, pNext), offsetof(VkBufferDeviceAddressInfo, buffer)};
+// const size_t layout_VkBufferOpaqueCaptureAddressCreateInfo[] = {sizeof(VkBufferOpaqueCaptureAddressCreateInfo), _Alignof(VkBufferOpaqueCaptureAddressCreateInfo), offsetof(VkBufferOpaqueCaptureAddressCreateInfo, sType), offsetof(VkBufferOpaqueCaptureAddressCreateInfo, pNext), offsetof(VkBufferOpaqueCaptureAddressCreateInfo, opaqueCaptureAddress)};
+// const size_t layout_VkMemoryOpaqueCaptureAddressAllocateInfo[] = {sizeof(VkMemoryOpaqueCaptureAddressAllocateInfo), _Alignof(VkMemoryOpaqueCaptureAddressAllocateInfo), offsetof(VkMemoryOpaqueCaptureAddressAllocateInfo, sType), offsetof(VkMemoryOpaqueCaptureAddressAllocateInfo, pNext), offsetof(VkMemoryOpaqueCaptureAddressAllocateInfo, opaqueCaptureAddress)};
+// const size_t layout_VkDeviceMemoryOpaqueCaptureAddressInfo[] = {sizeof(VkDeviceMemoryOpaqueCaptureAddressInfo), _Alignof(VkDeviceMemoryOpaqueCaptureAddressInfo), offsetof(VkDeviceMemoryOpaqueCaptureAddressInfo, sType), offsetof(VkDeviceMemoryOpaqueCaptureAddressInfo, pNext), offsetof(VkDeviceMemoryOpaqueCaptureAddressInfo, memory)};
+// const size_t layout_VkPhysicalDeviceVulkan13Features[] = {sizeof(VkPhysicalDeviceVulkan13Features), _Alignof(VkPhysicalDeviceVulkan13Features), offsetof(VkPhysicalDeviceVulkan13Features, sType), offsetof(VkPhysicalDeviceVulkan13Features, pNext), offsetof(VkPhysicalDeviceVulkan13Features, robustImageAccess), offsetof(VkPhysicalDeviceVulkan13Features, inlineUniformBlock), offsetof(VkPhysicalDeviceVulkan13Features, descriptorBindingInlineUniformBlockUpdateAfterBind), offsetof(VkPhysicalDeviceVulkan13Features, pipelineCreationCacheControl), offsetof(VkPhysicalDeviceVulkan13Features, privateData), offsetof(VkPhysicalDeviceVulkan13Features, shaderDemoteToHelperInvocation), offsetof(VkPhysicalDeviceVulkan13Features, shaderTerminateInvocation), offsetof(VkPhysicalDeviceVulkan13Features, subgroupSizeControl), offsetof(VkPhysicalDeviceVulkan13Features, computeFullSubgroups), offsetof(VkPhysicalDeviceVulkan13Features, synchronization2), offsetof(VkPhysicalDeviceVulkan13Features, textureCompressionASTC_HDR), offsetof(VkPhysicalDeviceVulkan13Features, shaderZeroInitializeWorkgroupMemory), offsetof(VkPhysicalDeviceVulkan13Features, dynamicRendering), offsetof(VkPhysicalDeviceVulkan13Features, shaderIntegerDotProduct), offsetof(VkPhysicalDeviceVulkan13Features, maintenance4)};
+// const size_t layout_VkPhysicalDeviceVulkan13Properties[] = {sizeof(VkPhysicalDeviceVulkan13Properties), _Alignof(VkPhysicalDeviceVulkan13Properties), offsetof(VkPhysicalDeviceVulkan13Properties, sType), offsetof(VkPhysicalDeviceVulkan13Properties, pNext), offsetof(VkPhysicalDeviceVulkan13Properties, minSubgroupSize), offsetof(VkPhysicalDeviceVulkan13Properties, maxSubgroupSize), offsetof(VkPhysicalDeviceVulkan13Properties, maxComputeWorkgroupSubgroups), offsetof(VkPhysicalDeviceVulkan13Properties, requiredSubgroupSizeStages), offsetof(VkPhysicalDeviceVulkan13Properties, maxInlineUniformBlockSize), offsetof(VkPhysicalDeviceVulkan13Properties, maxPerStageDescriptorInlineUniformBlocks), offsetof(VkPhysicalDeviceVulkan13Properties, maxPerStageDescriptorUpdateAfterBindInlineUniformBlocks), offsetof(VkPhysicalDeviceVulkan13Properties, maxDescriptorSetInlineUniformBlocks), offsetof(VkPhysicalDeviceVulkan13Properties, maxDescriptorSetUpdateAfterBindInlineUniformBlocks), offsetof(VkPhysicalDeviceVulkan13Properties, maxInlineUniformTotalSize), offsetof(VkPhysicalDeviceVulkan13Properties, integerDotProduct8BitUnsignedAccelerated), offsetof(VkPhysicalDeviceVulkan13Properties, integerDotProduct8BitSignedAccelerated), offsetof(VkPhysicalDeviceVulkan13Properties, integerDotProduct8BitMixedSignednessAccelerated), offsetof(VkPhysicalDeviceVulkan13Properties, integerDotProduct4x8BitPackedUnsignedAccelerated), offsetof(VkPhysicalDeviceVulkan13Properties, integerDotProduct4x8BitPackedSignedAccelerated), offsetof(VkPhysicalDeviceVulkan13Properties, integerDotProduct4x8BitPackedMixedSignednessAccelerated), offsetof(VkPhysicalDeviceVulkan13Properties, integerDotProduct16BitUnsignedAccelerated), offsetof(VkPhysicalDeviceVulkan13Properties, integerDotProduct16BitSignedAccelerated), offsetof(VkPhysicalDeviceVulkan13Properties, integerDotProduct16BitMixedSignednessAccelerated), offsetof(VkPhysicalDeviceVulkan13Properties, integerDotProduct32BitUnsignedAccelerated), offsetof(VkPhysicalDeviceVulkan13Properties, integerDotProduct32BitSignedAccelerated), offsetof(VkPhysicalDeviceVulkan13Properties, integerDotProduct32BitMixedSignednessAccelerated), offsetof(VkPhysicalDeviceVulkan13Properties, integerDotProduct64BitUnsignedAccelerated), offsetof(VkPhysicalDeviceVulkan13Properties, integerDotProduct64BitSignedAccelerated), offsetof(VkPhysicalDeviceVulkan13Properties, integerDotProduct64BitMixedSignednessAccelerated), offsetof(VkPhysicalDeviceVulkan13Properties, integerDotProductAccumulatingSaturating8BitUnsignedAccelerated), offsetof(VkPhysicalDeviceVulkan13Properties, integerDotProductAccumulatingSaturating8BitSignedAccelerated), offsetof(VkPhysicalDeviceVulkan13Properties, integerDotProductAccumulatingSaturating8BitMixedSignednessAccelerated), offsetof(VkPhysicalDeviceVulkan13Properties, integerDotProductAccumulatingSaturating4x8BitPackedUnsignedAccelerated), offsetof(VkPhysicalDeviceVulkan13Properties, integerDotProductAccumulatingSaturating4x8BitPackedSignedAccelerated), offsetof(VkPhysicalDeviceVulkan13Properties, integerDotProductAccumulatingSaturating4x8BitPackedMixedSignednessAccelerated), offsetof(VkPhysicalDeviceVulkan13Properties, integerDotProductAccumulatingSaturating16BitUnsignedAccelerated), offsetof(VkPhysicalDeviceVulkan13Properties, integerDotProductAccumulatingSaturating16BitSignedAccelerated), offsetof(VkPhysicalDeviceVulkan13Properties, integerDotProductAccumulatingSaturating16BitMixedSignednessAccelerated), offsetof(VkPhysicalDeviceVulkan13Properties, integerDotProductAccumulatingSaturating32BitUnsignedAccelerated), offsetof(VkPhysicalDeviceVulkan13Properties, integerDotProductAccumulatingSaturating32BitSignedAccelerated), offsetof(VkPhysicalDeviceVulkan13Properties, integerDotProductAccumulatingSaturating32BitMixedSignednessAccelerated), offsetof(VkPhysicalDeviceVulkan13Properties, integerDotProductAccumulatingSaturating64BitUnsignedAccelerated), offsetof(VkPhysicalDeviceVulkan13Properties, integerDotProductAccumulatingSaturating64BitSignedAccelerated), offsetof(VkPhysicalDeviceVulkan13Properties, integerDotProductAccumulatingSaturating64BitMixedSignednessAccelerated), offsetof(VkPhysicalDeviceVulkan13Properties, storageTexelBufferOffsetAlignmentBytes), offsetof(VkPhysicalDeviceVulkan13Properties, storageTexelBufferOffsetSingleTexelAlignment), offsetof(VkPhysicalDeviceVulkan13Properties, uniformTexelBufferOffsetAlignmentBytes), offsetof(VkPhysicalDeviceVulkan13Properties, uniformTexelBufferOffsetSingleTexelAlignment), offsetof(VkPhysicalDeviceVulkan13Properties, maxBufferSize)};
+// const size_t layout_VkPipelineCreationFeedback[] = {sizeof(VkPipelineCreationFeedback), _Alignof(VkPipelineCreationFeedback), offsetof(VkPipelineCreationFeedback, flags), offsetof(VkPipelineCreationFeedback, duration)};
+// const size_t layout_VkPipelineCreationFeedbackCreateInfo[] = {sizeof(VkPipelineCreationFeedbackCreateInfo), _Alignof(VkPipelineCreationFeedbackCreateInfo), offsetof(VkPipelineCreationFeedbackCreateInfo, sType), offsetof(VkPipelineCreationFeedbackCreateInfo, pNext), offsetof(VkPipelineCreationFeedbackCreateInfo, pPipelineCreationFeedback), offsetof(VkPipelineCreationFeedbackCreateInfo, pipelineStageCreationFeedbackCount), offsetof(VkPipelineCreationFeedbackCreateInfo, pPipelineStageCreationFeedbacks)};
+// const size_t layout_VkPhysicalDeviceShaderTerminateInvocationFeatures[] = {sizeof(VkPhysicalDeviceShaderTerminateInvocationFeatures), _Alignof(VkPhysicalDeviceShaderTerminateInvocationFeatures), offsetof(VkPhysicalDeviceShaderTerminateInvocationFeatures, sType), offsetof(VkPhysicalDeviceShaderTerminateInvocationFeatures, pNext), offsetof(VkPhysicalDeviceShaderTerminateInvocationFeatures, shaderTerminateInvocation)};
+// const size_t layout_VkPhysicalDeviceToolProperties[] = {sizeof(VkPhysicalDeviceToolProperties), _Alignof(VkPhysicalDeviceToolProperties), offsetof(VkPhysicalDeviceToolProperties, sType), offsetof(VkPhysicalDeviceToolProperties, pNext), offsetof(VkPhysicalDeviceToolProperties, name), offsetof(VkPhysicalDeviceToolProperties, version), offsetof(VkPhysicalDeviceToolProperties, purposes), offsetof(VkPhysicalDeviceToolProperties, description), offsetof(VkPhysicalDeviceToolProperties, layer)};
+// const size_t layout_VkPhysicalDeviceShaderDemoteToHelperInvocationFeatures[] = {sizeof(VkPhysicalDeviceShaderDemoteToHelperInvocationFeatures), _Alignof(VkPhysicalDeviceShaderDemoteToHelperInvocationFeatures), offsetof(VkPhysicalDeviceShaderDemoteToHelperInvocationFeatures, sType), offsetof(VkPhysicalDeviceShaderDemoteToHelperInvocationFeatures, pNext), offsetof(VkPhysicalDeviceShaderDemoteToHelperInvocationFeatures, shaderDemoteToHelperInvocation)};
+// const size_t layout_VkPhysicalDevicePrivateDataFeatures[] = {sizeof(VkPhysicalDevicePrivateDataFeatures), _Alignof(VkPhysicalDevicePrivateDataFeatures), offsetof(VkPhysicalDevicePrivateDataFeatures, sType), offsetof(VkPhysicalDevicePrivateDataFeatures, pNext), offsetof(VkPhysicalDevicePrivateDataFeatures, privateData)};
+// const size_t layout_VkDevicePrivateDataCreateInfo[] = {sizeof(VkDevicePrivateDataCreateInfo), _Alignof(VkDevicePrivateDataCreateInfo), offsetof(VkDevicePrivateDataCreateInfo, sType), offsetof(VkDevicePrivateDataCreateInfo, pNext), offsetof(VkDevicePrivateDataCreateInfo, privateDataSlotRequestCount)};
+// const size_t layout_VkPrivateDataSlotCreateInfo[] = {sizeof(VkPrivateDataSlotCreateInfo), _Alignof(VkPrivateDataSlotCreateInfo), offsetof(VkPrivateDataSlotCreateInfo, sType), offsetof(VkPrivateDataSlotCreateInfo, pNext), offsetof(VkPrivateDataSlotCreateInfo, flags)};
+// const size_t layout_VkPhysicalDevicePipelineCreationCacheControlFeatures[] = {sizeof(VkPhysicalDevicePipelineCreationCacheControlFeatures), _Alignof(VkPhysicalDevicePipelineCreationCacheControlFeatures), offsetof(VkPhysicalDevicePipelineCreationCacheControlFeatures, sType), offsetof(VkPhysicalDevicePipelineCreationCacheControlFeatures, pNext), offsetof(VkPhysicalDevicePipelineCreationCacheControlFeatures, pipelineCreationCacheControl)};
+// const size_t layout_VkMemoryBarrier2[] = {sizeof(VkMemoryBarrier2), _Alignof(VkMemoryBarrier2), offsetof(VkMemoryBarrier2, sType), offsetof(VkMemoryBarrier2, pNext), offsetof(VkMemoryBarrier2, srcStageMask), offsetof(VkMemoryBarrier2, srcAccessMask), offsetof(VkMemoryBarrier2, dstStageMask), offsetof(VkMemoryBarrier2, dstAccessMask)};
+// const size_t layout_VkBufferMemoryBarrier2[] = {sizeof(VkBufferMemoryBarrier2), _Alignof(VkBufferMemoryBarrier2), offsetof(VkBufferMemoryBarrier2, sType), offsetof(VkBufferMemoryBarrier2, pNext), offsetof(VkBufferMemoryBarrier2, srcStageMask), offsetof(VkBufferMemoryBarrier2, srcAccessMask), offsetof(VkBufferMemoryBarrier2, dstStageMask), offsetof(VkBufferMemoryBarrier2, dstAccessMask), offsetof(VkBufferMemoryBarrier2, srcQueueFamilyIndex), offsetof(VkBufferMemoryBarrier2, dstQueueFamilyIndex), offsetof(VkBufferMemoryBarrier2, buffer), offsetof(VkBufferMemoryBarrier2, offset), offsetof(VkBufferMemoryBarrier2, size)};
+// const size_t layout_VkImageMemoryBarrier2[] = {sizeof(VkImageMemoryBarrier2), _Alignof(VkImageMemoryBarrier2), offsetof(VkImageMemoryBarrier2, sType), offsetof(VkImageMemoryBarrier2, pNext), offsetof(VkImageMemoryBarrier2, srcStageMask), offsetof(VkImageMemoryBarrier2, srcAccessMask), offsetof(VkImageMemoryBarrier2, dstStageMask), offsetof(VkImageMemoryBarrier2, dstAccessMask), offsetof(VkImageMemoryBarrier2, oldLayout), offsetof(VkImageMemoryBarrier2, newLayout), offsetof(VkImageMemoryBarrier2, srcQueueFamilyIndex), offsetof(VkImageMemoryBarrier2, dstQueueFamilyIndex), offsetof(VkImageMemoryBarrier2, image), offsetof(VkImageMemoryBarrier2, subresourceRange)};
+// const size_t layout_VkDependencyInfo[] = {sizeof(VkDependencyInfo), _Alignof(VkDependencyInfo), offsetof(VkDependencyInfo, sType), offsetof(VkDependencyInfo, pNext), offsetof(VkDependencyInfo, dependencyFlags), offsetof(VkDependencyInfo, memoryBarrierCount), offsetof(VkDependencyInfo, pMemoryBarriers), offsetof(VkDependencyInfo, bufferMemoryBarrierCount), offsetof(VkDependencyInfo, pBufferMemoryBarriers), offsetof(VkDependencyInfo, imageMemoryBarrierCount), offsetof(VkDependencyInfo, pImageMemoryBarriers)};
+// const size_t layout_VkSemaphoreSubmitInfo[] = {sizeof(VkSemaphoreSubmitInfo), _Alignof(VkSemaphoreSubmitInfo), offsetof(VkSemaphoreSubmitInfo, sType), offsetof(VkSemaphoreSubmitInfo, pNext), offsetof(VkSemaphoreSubmitInfo, semaphore), offsetof(VkSemaphoreSubmitInfo, value), offsetof(VkSemaphoreSubmitInfo, stageMask), offsetof(VkSemaphoreSubmitInfo, deviceIndex)};
+// const size_t layout_VkCommandBufferSubmitInfo[] = {sizeof(VkCommandBufferSubmitInfo), _Alignof(VkCommandBufferSubmitInfo), offsetof(VkCommandBufferSubmitInfo, sType), offsetof(VkCommandBufferSubmitInfo, pNext), offsetof(VkCommandBufferSubmitInfo, commandBuffer), offsetof(VkCommandBufferSubmitInfo, deviceMask)};
+// const size_t layout_VkSubmitInfo2[] = {sizeof(VkSubmitInfo2), _Alignof(VkSubmitInfo2), offsetof(VkSubmitInfo2, sType), offsetof(VkSubmitInfo2, pNext), offsetof(VkSubmitInfo2, flags), offsetof(VkSubmitInfo2, waitSemaphoreInfoCount), offsetof(VkSubmitInfo2, pWaitSemaphoreInfos), offsetof(VkSubmitInfo2, commandBufferInfoCount), offsetof(VkSubmitInfo2, pCommandBufferInfos), offsetof(VkSubmitInfo2, signalSemaphoreInfoCount), offsetof(VkSubmitInfo2, pSignalSemaphoreInfos)};
+// const size_t layout_VkPhysicalDeviceSynchronization2Features[] = {sizeof(VkPhysicalDeviceSynchronization2Features), _Alignof(VkPhysicalDeviceSynchronization2Features), offsetof(VkPhysicalDeviceSynchronization2Features, sType), offsetof(VkPhysicalDeviceSynchronization2Features, pNext), offsetof(VkPhysicalDeviceSynchronization2Features, synchronization2)};
+// const size_t layout_VkPhysicalDeviceZeroInitializeWorkgroupMemoryFeatures[] = {sizeof(VkPhysicalDeviceZeroInitializeWorkgroupMemoryFeatures), _Alignof(VkPhysicalDeviceZeroInitializeWorkgroupMemoryFeatures), offsetof(VkPhysicalDeviceZeroInitializeWorkgroupMemoryFeatures, sType), offsetof(VkPhysicalDeviceZeroInitializeWorkgroupMemoryFeatures, pNext), offsetof(VkPhysicalDeviceZeroInitializeWorkgroupMemoryFeatures, shaderZeroInitializeWorkgroupMemory)};
+// const size_t layout_VkPhysicalDeviceImageRobustnessFeatures[] = {sizeof(VkPhysicalDeviceImageRobustnessFeatures), _Alignof(VkPhysicalDeviceImageRobustnessFeatures), offsetof(VkPhysicalDeviceImageRobustnessFeatures, sType), offsetof(VkPhysicalDeviceImageRobustnessFeatures, pNext), offsetof(VkPhysicalDeviceImageRobustnessFeatures, robustImageAccess)};
+// const size_t layout_VkBufferCopy2[] = {sizeof(VkBufferCopy2), _Alignof(VkBufferCopy2), offsetof(VkBufferCopy2, sType), offsetof(VkBufferCopy2, pNext), offsetof(VkBufferCopy2, srcOffset), offsetof(VkBufferCopy2, dstOffset), offsetof(VkBufferCopy2, size)};
+// const size_t layout_VkCopyBufferInfo2[] = {sizeof(VkCopyBufferInfo2), _Alignof(VkCopyBufferInfo2), offsetof(VkCopyBufferInfo2, sType), offsetof(VkCopyBufferInfo2, pNext), offsetof(VkCopyBufferInfo2, srcBuffer), offsetof(VkCopyBufferInfo2, dstBuffer), offsetof(VkCopyBufferInfo2, regionCount), offsetof(VkCopyBufferInfo2, pRegions)};
+// const size_t layout_VkImageCopy2[] = {sizeof(VkImageCopy2), _Alignof(VkImageCopy2), offsetof(VkImageCopy2, sType), offsetof(VkImageCopy2, pNext), offsetof(VkImageCopy2, srcSubresource), offsetof(VkImageCopy2, srcOffset), offsetof(VkImageCopy2, dstSubresource), offsetof(VkImageCopy2, dstOffset), offsetof(VkImageCopy2, extent)};
+// const size_t layout_VkCopyImageInfo2[] = {sizeof(VkCopyImageInfo2), _Alignof(VkCopyImageInfo2), offsetof(VkCopyImageInfo2, sType), offsetof(VkCopyImageInfo2, pNext), offsetof(VkCopyImageInfo2, srcImage), offsetof(VkCopyImageInfo2, srcImageLayout), offsetof(VkCopyImageInfo2, dstImage), offsetof(VkCopyImageInfo2, dstImageLayout), offsetof(VkCopyImageInfo2, regionCount), offsetof(VkCopyImageInfo2, pRegions)};
+// const size_t layout_VkBufferImageCopy2[] = {sizeof(VkBufferImageCopy2), _Alignof(VkBufferImageCopy2), offsetof(VkBufferImageCopy2, sType), offsetof(VkBufferImageCopy2, pNext), offsetof(VkBufferImageCopy2, bufferOffset), offsetof(VkBufferImageCopy2, bufferRowLength), offsetof(VkBufferImageCopy2, bufferImageHeight), offsetof(VkBufferImageCopy2, imageSubresource), offsetof(VkBufferImageCopy2, imageOffset), offsetof(VkBufferImageCopy2, imageExtent)};
+// const size_t layout_VkCopyBufferToImageInfo2[] = {sizeof(VkCopyBufferToImageInfo2), _Alignof(VkCopyBufferToImageInfo2), offsetof(VkCopyBufferToImageInfo2, sType), offsetof(VkCopyBufferToImageInfo2, pNext), offsetof(VkCopyBufferToImageInfo2, srcBuffer), offsetof(VkCopyBufferToImageInfo2, dstImage), offsetof(VkCopyBufferToImageInfo2, dstImageLayout), offsetof(VkCopyBufferToImageInfo2, regionCount), offsetof(VkCopyBufferToImageInfo2, pRegions)};
+// const size_t layout_VkCopyImageToBufferInfo2[] = {sizeof(VkCopyImageToBufferInfo2), _Alignof(VkCopyImageToBufferInfo2), offsetof(VkCopyImageToBufferInfo2, sType), offsetof(VkCopyImageToBufferInfo2, pNext), offsetof(VkCopyImageToBufferInfo2, srcImage), offsetof(VkCopyImageToBufferInfo2, srcImageLayout), offsetof(VkCopyImageToBufferInfo2, dstBuffer), offsetof(VkCopyImageToBufferInfo2, regionCount), offsetof(VkCopyImageToBufferInfo2, pRegions)};
+// const size_t layout_VkImageBlit2[] = {sizeof(VkImageBlit2), _Alignof(VkImageBlit2), offsetof(VkImageBlit2, sType), offsetof(VkImageBlit2, pNext), offsetof(VkImageBlit2, srcSubresource), offsetof(VkImageBlit2, srcOffsets), offsetof(VkImageBlit2, dstSubresource), offsetof(VkImageBlit2, dstOffsets)};
+// const size_t layout_VkBlitImageInfo2[] = {sizeof(VkBlitImageInfo2), _Alignof(VkBlitImageInfo2), offsetof(VkBlitImageInfo2, sType), offsetof(VkBlitImageInfo2, pNext), offsetof(VkBlitImageInfo2, srcImage), offsetof(VkBlitImageInfo2, srcImageLayout), offsetof(VkBlitImageInfo2, dstImage), offsetof(VkBlitImageInfo2, dstImageLayout), offsetof(VkBlitImageInfo2, regionCount), offsetof(VkBlitImageInfo2, pRegions), offsetof(VkBlitImageInfo2, filter)};
+// const size_t layout_VkImageResolve2[] = {sizeof(VkImageResolve2), _Alignof(VkImageResolve2), offsetof(VkImageResolve2, sType), offsetof(VkImageResolve2, pNext), offsetof(VkImageResolve2, srcSubresource), offsetof(VkImageResolve2, srcOffset), offsetof(VkImageResolve2, dstSubresource), offsetof(VkImageResolve2, dstOffset), offsetof(VkImageResolve2, extent)};
+// const size_t layout_VkResolveImageInfo2[] = {sizeof(VkResolveImageInfo2), _Alignof(VkResolveImageInfo2), offsetof(VkResolveImageInfo2, sType), offsetof(VkResolveImageInfo2, pNext), offsetof(VkResolveImageInfo2, srcImage), offsetof(VkResolveImageInfo2, srcImageLayout), offsetof(VkResolveImageInfo2, dstImage), offsetof(VkResolveImageInfo2, dstImageLayout), offsetof(VkResolveImageInfo2, regionCount), offsetof(VkResolveImageInfo2, pRegions)};
+// const size_t layout_VkPhysicalDeviceSubgroupSizeControlFeatures[] = {sizeof(VkPhysicalDeviceSubgroupSizeControlFeatures), _Alignof(VkPhysicalDeviceSubgroupSizeControlFeatures), offsetof(VkPhysicalDeviceSubgroupSizeControlFeatures, sType), offsetof(VkPhysicalDeviceSubgroupSizeControlFeatures, pNext), offsetof(VkPhysicalDeviceSubgroupSizeControlFeatures, subgroupSizeControl), offsetof(VkPhysicalDeviceSubgroupSizeControlFeatures, computeFullSubgroups)};
+// const size_t layout_VkPhysicalDeviceSubgroupSizeControlProperties[] = {sizeof(VkPhysicalDeviceSubgroupSizeControlProperties), _Alignof(VkPhysicalDeviceSubgroupSizeControlProperties), offsetof(VkPhysicalDeviceSubgroupSizeControlProperties, sType), offsetof(VkPhysicalDeviceSubgroupSizeControlProperties, pNext), offsetof(VkPhysicalDeviceSubgroupSizeControlProperties, minSubgroupSize), offsetof(VkPhysicalDeviceSubgroupSizeControlProperties, maxSubgroupSize), offsetof(VkPhysicalDeviceSubgroupSizeControlProperties, maxComputeWorkgroupSubgroups), offsetof(VkPhysicalDeviceSubgroupSizeControlProperties, requiredSubgroupSizeStages)};
+// const size_t layout_VkPipelineShaderStageRequiredSubgroupSizeCreateInfo[] = {sizeof(VkPipelineShaderStageRequiredSubgroupSizeCreateInfo), _Alignof(VkPipelineShaderStageRequiredSubgroupSizeCreateInfo), offsetof(VkPipelineShaderStageRequiredSubgroupSizeCreateInfo, sType), offsetof(VkPipelineShaderStageRequiredSubgroupSizeCreateInfo, pNext), offsetof(VkPipelineShaderStageRequiredSubgroupSizeCreateInfo, requiredSubgroupSize)};
+// const size_t layout_VkPhysicalDeviceInlineUniformBlockFeatures[] = {sizeof(VkPhysicalDeviceInlineUniformBlockFeatures), _Alignof(VkPhysicalDeviceInlineUniformBlockFeatures), offsetof(VkPhysicalDeviceInlineUniformBlockFeatures, sType), offsetof(VkPhysicalDeviceInlineUniformBlockFeatures, pNext), offsetof(VkPhysicalDeviceInlineUniformBlockFeatures, inlineUniformBlock), offsetof(VkPhysicalDeviceInlineUniformBlockFeatures, descriptorBindingInlineUniformBlockUpdateAfterBind)};
+// const size_t layout_VkPhysicalDeviceInlineUniformBlockProperties[] = {sizeof(VkPhysicalDeviceInlineUniformBlockProperties), _Alignof(VkPhysicalDeviceInlineUniformBlockProperties), offsetof(VkPhysicalDeviceInlineUniformBlockProperties, sType), offsetof(VkPhysicalDeviceInlineUniformBlockProperties, pNext), offsetof(VkPhysicalDeviceInlineUniformBlockProperties, maxInlineUniformBlockSize), offsetof(VkPhysicalDeviceInlineUniformBlockProperties, maxPerStageDescriptorInlineUniformBlocks), offsetof(VkPhysicalDeviceInlineUniformBlockProperties, maxPerStageDescriptorUpdateAfterBindInlineUniformBlocks), offsetof(VkPhysicalDeviceInlineUniformBlockProperties, maxDescriptorSetInlineUniformBlocks), offsetof(VkPhysicalDeviceInlineUniformBlockProperties, maxDescriptorSetUpdateAfterBindInlineUniformBlocks)};
+// const size_t layout_VkWriteDescriptorSetInlineUniformBlock[] = {sizeof(VkWriteDescriptorSetInlineUniformBlock), _Alignof(VkWriteDescriptorSetInlineUniformBlock), offsetof(VkWriteDescriptorSetInlineUniformBlock, sType), offsetof(VkWriteDescriptorSetInlineUniformBlock, pNext), offsetof(VkWriteDescriptorSetInlineUniformBlock, dataSize), offsetof(VkWriteDescriptorSetInlineUniformBlock, pData)};
+// const size_t layout_VkDescriptorPoolInlineUniformBlockCreateInfo[] = {sizeof(VkDescriptorPoolInlineUniformBlockCreateInfo), _Alignof(VkDescriptorPoolInlineUniformBlockCreateInfo), offsetof(VkDescriptorPoolInlineUniformBlockCreateInfo, sType), offsetof(VkDescriptorPoolInlineUniformBlockCreateInfo, pNext), offsetof(VkDescriptorPoolInlineUniformBlockCreateInfo, maxInlineUniformBlockBindings)};
+// const size_t layout_VkPhysicalDeviceTextureCompressionASTCHDRFeatures[] = {sizeof(VkPhysicalDeviceTextureCompressionASTCHDRFeatures), _Alignof(VkPhysicalDeviceTextureCompressionASTCHDRFeatures), offsetof(VkPhysicalDeviceTextureCompressionASTCHDRFeatures, sType), offsetof(VkPhysicalDeviceTextureCompressionASTCHDRFeatures, pNext), offsetof(VkPhysicalDeviceTextureCompressionASTCHDRFeatures, textureCompressionASTC_HDR)};
+// const size_t layout_VkRenderingAttachmentInfo[] = {sizeof(VkRenderingAttachmentInfo), _Alignof(VkRenderingAttachmentInfo), offsetof(VkRenderingAttachmentInfo, sType), offsetof(VkRenderingAttachmentInfo, pNext), offsetof(VkRenderingAttachmentInfo, imageView), offsetof(VkRenderingAttachmentInfo, imageLayout), offsetof(VkRenderingAttachmentInfo, resolveMode), offsetof(VkRenderingAttachmentInfo, resolveImageView), offsetof(VkRenderingAttachmentInfo, resolveImageLayout), offsetof(VkRenderingAttachmentInfo, loadOp), offsetof(VkRenderingAttachmentInfo, storeOp), offsetof(VkRenderingAttachmentInfo, clearValue)};
+// const size_t layout_VkRenderingInfo[] = {sizeof(VkRenderingInfo), _Alignof(VkRenderingInfo), offsetof(VkRenderingInfo, sType), offsetof(VkRenderingInfo, pNext), offsetof(VkRenderingInfo, flags), offsetof(VkRenderingInfo, renderArea), offsetof(VkRenderingInfo, layerCount), offsetof(VkRenderingInfo, viewMask), offsetof(VkRenderingInfo, colorAttachmentCount), offsetof(VkRenderingInfo, pColorAttachments), offsetof(VkRenderingInfo, pDepthAttachment), offsetof(VkRenderingInfo, pStencilAttachment)};
+// const size_t layout_VkPipelineRenderingCreateInfo[] = {sizeof(VkPipelineRenderingCreateInfo), _Alignof(VkPipelineRenderingCreateInfo), offsetof(VkPipelineRenderingCreateInfo, sType), offsetof(VkPipelineRenderingCreateInfo, pNext), offsetof(VkPipelineRenderingCreateInfo, viewMask), offsetof(VkPipelineRenderingCreateInfo, colorAttachmentCount), offsetof(VkPipelineRenderingCreateInfo, pColorAttachmentFormats), offsetof(VkPipelineRenderingCreateInfo, depthAttachmentFormat), offsetof(VkPipelineRenderingCreateInfo, stencilAttachmentFormat)};
+// const size_t layout_VkPhysicalDeviceDynamicRenderingFeatures[] = {sizeof(VkPhysicalDeviceDynamicRenderingFeatures), _Alignof(VkPhysicalDeviceDynamicRenderingFeatures), offsetof(VkPhysicalDeviceDynamicRenderingFeatures, sType), offsetof(VkPhysicalDeviceDynamicRenderingFeatures, pNext), offsetof(VkPhysicalDeviceDynamicRenderingFeatures, dynamicRendering)};
+// const size_t layout_VkCommandBufferInheritanceRenderingInfo[] = {sizeof(VkCommandBufferInheritanceRenderingInfo), _Alignof(VkCommandBufferInheritanceRenderingInfo), offsetof(VkCommandBufferInheritanceRenderingInfo, sType), offsetof(VkCommandBufferInheritanceRenderingInfo, pNext), offsetof(VkCommandBufferInheritanceRenderingInfo, flags), offsetof(VkCommandBufferInheritanceRenderingInfo, viewMask), offsetof(VkCommandBufferInheritanceRenderingInfo, colorAttachmentCount), offsetof(VkCommandBufferInheritanceRenderingInfo, pColorAttachmentFormats), offsetof(VkCommandBufferInheritanceRenderingInfo, depthAttachmentFormat), offsetof(VkCommandBufferInheritanceRenderingInfo, stencilAttachmentFormat), offsetof(VkCommandBufferInheritanceRenderingInfo, rasterizationSamples)};
+// const size_t layout_VkPhysicalDeviceShaderIntegerDotProductFeatures[] = {sizeof(VkPhysicalDeviceShaderIntegerDotProductFeatures), _Alignof(VkPhysicalDeviceShaderIntegerDotProductFeatures), offsetof(VkPhysicalDeviceShaderIntegerDotProductFeatures, sType), offsetof(VkPhysicalDeviceShaderIntegerDotProductFeatures, pNext), offsetof(VkPhysicalDeviceShaderIntegerDotProductFeatures, shaderIntegerDotProduct)};
+// const size_t layout_VkPhysicalDeviceShaderIntegerDotProductProperties[] = {sizeof(VkPhysicalDeviceShaderIntegerDotProductProperties), _Alignof(VkPhysicalDeviceShaderIntegerDotProductProperties), offsetof(VkPhysicalDeviceShaderIntegerDotProductProperties, sType), offsetof(VkPhysicalDeviceShaderIntegerDotProductProperties, pNext), offsetof(VkPhysicalDeviceShaderIntegerDotProductProperties, integerDotProduct8BitUnsignedAccelerated), offsetof(VkPhysicalDeviceShaderIntegerDotProductProperties, integerDotProduct8BitSignedAccelerated), offsetof(VkPhysicalDeviceShaderIntegerDotProductProperties, integerDotProduct8BitMixedSignednessAccelerated), offsetof(VkPhysicalDeviceShaderIntegerDotProductProperties, integerDotProduct4x8BitPackedUnsignedAccelerated), offsetof(VkPhysicalDeviceShaderIntegerDotProductProperties, integerDotProduct4x8BitPackedSignedAccelerated), offsetof(VkPhysicalDeviceShaderIntegerDotProductProperties, integerDotProduct4x8BitPackedMixedSignednessAccelerated), offsetof(VkPhysicalDeviceShaderIntegerDotProductProperties, integerDotProduct16BitUnsignedAccelerated), offsetof(VkPhysicalDeviceShaderIntegerDotProductProperties, integerDotProduct16BitSignedAccelerated), offsetof(VkPhysicalDeviceShaderIntegerDotProductProperties, integerDotProduct16BitMixedSignednessAccelerated), offsetof(VkPhysicalDeviceShaderIntegerDotProductProperties, integerDotProduct32BitUnsignedAccelerated), offsetof(VkPhysicalDeviceShaderIntegerDotProductProperties, integerDotProduct32BitSignedAccelerated), offsetof(VkPhysicalDeviceShaderIntegerDotProductProperties, integerDotProduct32BitMixedSignednessAccelerated), offsetof(VkPhysicalDeviceShaderIntegerDotProductProperties, integerDotProduct64BitUnsignedAccelerated), offsetof(VkPhysicalDeviceShaderIntegerDotProductProperties, integerDotProduct64BitSignedAccelerated), offsetof(VkPhysicalDeviceShaderIntegerDotProductProperties, integerDotProduct64BitMixedSignednessAccelerated), offsetof(VkPhysicalDeviceShaderIntegerDotProductProperties, integerDotProductAccumulatingSaturating8BitUnsignedAccelerated), offsetof(VkPhysicalDeviceShaderIntegerDotProductProperties, integerDotProductAccumulatingSaturating8BitSignedAccelerated), offsetof(VkPhysicalDeviceShaderIntegerDotProductProperties, integerDotProductAccumulatingSaturating8BitMixedSignednessAccelerated), offsetof(VkPhysicalDeviceShaderIntegerDotProductProperties, integerDotProductAccumulatingSaturating4x8BitPackedUnsignedAccelerated), offsetof(VkPhysicalDeviceShaderIntegerDotProductProperties, integerDotProductAccumulatingSaturating4x8BitPackedSignedAccelerated), offsetof(VkPhysicalDeviceShaderIntegerDotProductProperties, integerDotProductAccumulatingSaturating4x8BitPackedMixedSignednessAccelerated), offsetof(VkPhysicalDeviceShaderIntegerDotProductProperties, integerDotProductAccumulatingSaturating16BitUnsignedAccelerated), offsetof(VkPhysicalDeviceShaderIntegerDotProductProperties, integerDotProductAccumulatingSaturating16BitSignedAccelerated), offsetof(VkPhysicalDeviceShaderIntegerDotProductProperties, integerDotProductAccumulatingSaturating16BitMixedSignednessAccelerated), offsetof(VkPhysicalDeviceShaderIntegerDotProductProperties, integerDotProductAccumulatingSaturating32BitUnsignedAccelerated), offsetof(VkPhysicalDeviceShaderIntegerDotProductProperties, integerDotProductAccumulatingSaturating32BitSignedAccelerated), offsetof(VkPhysicalDeviceShaderIntegerDotProductProperties, integerDotProductAccumulatingSaturating32BitMixedSignednessAccelerated), offsetof(VkPhysicalDeviceShaderIntegerDotProductProperties, integerDotProductAccumulatingSaturating64BitUnsignedAccelerated), offsetof(VkPhysicalDeviceShaderIntegerDotProductProperties, integerDotProductAccumulatingSaturating64BitSignedAccelerated), offsetof(VkPhysicalDeviceShaderIntegerDotProductProperties, integerDotProductAccumulatingSaturating64BitMixedSignednessAccelerated)};
+// const size_t layout_VkPhysicalDeviceTexelBufferAlignmentProperties[] = {sizeof(VkPhysicalDeviceTexelBufferAlignmentProperties), _Alignof(VkPhysicalDeviceTexelBufferAlignmentProperties), offsetof(VkPhysicalDeviceTexelBufferAlignmentProperties, sType), offsetof(VkPhysicalDeviceTexelBufferAlignmentProperties, pNext), offsetof(VkPhysicalDeviceTexelBufferAlignmentProperties, storageTexelBufferOffsetAlignmentBytes), offsetof(VkPhysicalDeviceTexelBufferAlignmentProperties, storageTexelBufferOffsetSingleTexelAlignment), offsetof(VkPhysicalDeviceTexelBufferAlignmentProperties, uniformTexelBufferOffsetAlignmentBytes), offsetof(VkPhysicalDeviceTexelBufferAlignmentProperties, uniformTexelBufferOffsetSingleTexelAlignment)};
+// const size_t layout_VkFormatProperties3[] = {sizeof(VkFormatProperties3), _Alignof(VkFormatProperties3), offsetof(VkFormatProperties3, sType), offsetof(VkFormatProperties3, pNext), offsetof(VkFormatProperties3, linearTilingFeatures), offsetof(VkFormatProperties3, optimalTilingFeatures), offsetof(VkFormatProperties3, bufferFeatures)};
+// const size_t layout_VkPhysicalDeviceMaintenance4Features[] = {sizeof(VkPhysicalDeviceMaintenance4Features), _Alignof(VkPhysicalDeviceMaintenance4Features), offsetof(VkPhysicalDeviceMaintenance4Features, sType), offsetof(VkPhysicalDeviceMaintenance4Features, pNext), offsetof(VkPhysicalDeviceMaintenance4Features, maintenance4)};
+// const size_t layout_VkPhysicalDeviceMaintenance4Properties[] = {sizeof(VkPhysicalDeviceMaintenance4Properties), _Alignof(VkPhysicalDeviceMaintenance4Properties), offsetof(VkPhysicalDeviceMaintenance4Properties, sType), offsetof(VkPhysicalDeviceMaintenance4Properties, pNext), offsetof(VkPhysicalDeviceMaintenance4Properties, maxBufferSize)};
+// const size_t layout_VkDeviceBufferMemoryRequirements[] = {sizeof(VkDeviceBufferMemoryRequirements), _Alignof(VkDeviceBufferMemoryRequirements), offsetof(VkDeviceBufferMemoryRequirements, sType), offsetof(VkDeviceBufferMemoryRequirements, pNext), offsetof(VkDeviceBufferMemoryRequirements, pCreateInfo)};
+// const size_t layout_VkDeviceImageMemoryRequirements[] = {sizeof(VkDeviceImageMemoryRequirements), _Alignof(VkDeviceImageMemoryRequirements), offsetof(VkDeviceImageMemoryRequirements, sType), offsetof(VkDeviceImageMemoryRequirements, pNext), offsetof(VkDeviceImageMemoryRequirements, pCreateInfo), offsetof(VkDeviceImageMemoryRequirements, planeAspect)};
+// const size_t layout_VkSurfaceCapabilitiesKHR[] = {sizeof(VkSurfaceCapabilitiesKHR), _Alignof(VkSurfaceCapabilitiesKHR), offsetof(VkSurfaceCapabilitiesKHR, minImageCount), offsetof(VkSurfaceCapabilitiesKHR, maxImageCount), offsetof(VkSurfaceCapabilitiesKHR, currentExtent), offsetof(VkSurfaceCapabilitiesKHR, minImageExtent), offsetof(VkSurfaceCapabilitiesKHR, maxImageExtent), offsetof(VkSurfaceCapabilitiesKHR, maxImageArrayLayers), offsetof(VkSurfaceCapabilitiesKHR, supportedTransforms), offsetof(VkSurfaceCapabilitiesKHR, currentTransform), offsetof(VkSurfaceCapabilitiesKHR, supportedCompositeAlpha), offsetof(VkSurfaceCapabilitiesKHR, supportedUsageFlags)};
+// const size_t layout_VkSurfaceFormatKHR[] = {sizeof(VkSurfaceFormatKHR), _Alignof(VkSurfaceFormatKHR), offsetof(VkSurfaceFormatKHR, format), offsetof(VkSurfaceFormatKHR, colorSpace)};
+// const size_t layout_VkSwapchainCreateInfoKHR[] = {sizeof(VkSwapchainCreateInfoKHR), _Alignof(VkSwapchainCreateInfoKHR), offsetof(VkSwapchainCreateInfoKHR, sType), offsetof(VkSwapchainCreateInfoKHR, pNext), offsetof(VkSwapchainCreateInfoKHR, flags), offsetof(VkSwapchainCreateInfoKHR, surface), offsetof(VkSwapchainCreateInfoKHR, minImageCount), offsetof(VkSwapchainCreateInfoKHR, imageFormat), offsetof(VkSwapchainCreateInfoKHR, imageColorSpace), offsetof(VkSwapchainCreateInfoKHR, imageExtent), offsetof(VkSwapchainCreateInfoKHR, imageArrayLayers), offsetof(VkSwapchainCreateInfoKHR, imageUsage), offsetof(VkSwapchainCreateInfoKHR, imageSharingMode), offsetof(VkSwapchainCreateInfoKHR, queueFamilyIndexCount), offsetof(VkSwapchainCreateInfoKHR, pQueueFamilyIndices), offsetof(VkSwapchainCreateInfoKHR, preTransform), offsetof(VkSwapchainCreateInfoKHR, compositeAlpha), offsetof(VkSwapchainCreateInfoKHR, presentMode), offsetof(VkSwapchainCreateInfoKHR, clipped), offsetof(VkSwapchainCreateInfoKHR, oldSwapchain)};
+// const size_t layout_VkPresentInfoKHR[] = {sizeof(VkPresentInfoKHR), _Alignof(VkPresentInfoKHR), offsetof(VkPresentInfoKHR, sType), offsetof(VkPresentInfoKHR, pNext), offsetof(VkPresentInfoKHR, waitSemaphoreCount), offsetof(VkPresentInfoKHR, pWaitSemaphores), offsetof(VkPresentInfoKHR, swapchainCount), offsetof(VkPresentInfoKHR, pSwapchains), offsetof(VkPresentInfoKHR, pImageIndices), offsetof(VkPresentInfoKHR, pResults)};
+// const size_t layout_VkImageSwapchainCreateInfoKHR[] = {sizeof(VkImageSwapchainCreateInfoKHR), _Alignof(VkImageSwapchainCreateInfoKHR), offsetof(VkImageSwapchainCreateInfoKHR, sType), offsetof(VkImageSwapchainCreateInfoKHR, pNext), offsetof(VkImageSwapchainCreateInfoKHR, swapchain)};
+// const size_t layout_VkBindImageMemorySwapchainInfoKHR[] = {sizeof(VkBindImageMemorySwapchainInfoKHR), _Alignof(VkBindImageMemorySwapchainInfoKHR), offsetof(VkBindImageMemorySwapchainInfoKHR, sType), offsetof(VkBindImageMemorySwapchainInfoKHR, pNext), offsetof(VkBindImageMemorySwapchainInfoKHR, swapchain), offsetof(VkBindImageMemorySwapchainInfoKHR, imageIndex)};
+// const size_t layout_VkAcquireNextImageInfoKHR[] = {sizeof(VkAcquireNextImageInfoKHR), _Alignof(VkAcquireNextImageInfoKHR), offsetof(VkAcquireNextImageInfoKHR, sType), offsetof(VkAcquireNextImageInfoKHR, pNext), offsetof(VkAcquireNextImageInfoKHR, swapchain), offsetof(VkAcquireNextImageInfoKHR, timeout), offsetof(VkAcquireNextImageInfoKHR, semaphore), offsetof(VkAcquireNextImageInfoKHR, fence), offsetof(VkAcquireNextImageInfoKHR, deviceMask)};
+// const size_t layout_VkDeviceGroupPresentCapabilitiesKHR[] = {sizeof(VkDeviceGroupPresentCapabilitiesKHR), _Alignof(VkDeviceGroupPresentCapabilitiesKHR), offsetof(VkDeviceGroupPresentCapabilitiesKHR, sType), offsetof(VkDeviceGroupPresentCapabilitiesKHR, pNext), offsetof(VkDeviceGroupPresentCapabilitiesKHR, presentMask), offsetof(VkDeviceGroupPresentCapabilitiesKHR, modes)};
+// const size_t layout_VkDeviceGroupPresentInfoKHR[] = {sizeof(VkDeviceGroupPresentInfoKHR), _Alignof(VkDeviceGroupPresentInfoKHR), offsetof(VkDeviceGroupPresentInfoKHR, sType), offsetof(VkDeviceGroupPresentInfoKHR, pNext), offsetof(VkDeviceGroupPresentInfoKHR, swapchainCount), offsetof(VkDeviceGroupPresentInfoKHR, pDeviceMasks), offsetof(VkDeviceGroupPresentInfoKHR, mode)};
+// const size_t layout_VkDeviceGroupSwapchainCreateInfoKHR[] = {sizeof(VkDeviceGroupSwapchainCreateInfoKHR), _Alignof(VkDeviceGroupSwapchainCreateInfoKHR), offsetof(VkDeviceGroupSwapchainCreateInfoKHR, sType), offsetof(VkDeviceGroupSwapchainCreateInfoKHR, pNext), offsetof(VkDeviceGroupSwapchainCreateInfoKHR, modes)};
+// const size_t layout_VkDisplayModeParametersKHR[] = {sizeof(VkDisplayModeParametersKHR), _Alignof(VkDisplayModeParametersKHR), offsetof(VkDisplayModeParametersKHR, visibleRegion), offsetof(VkDisplayModeParametersKHR, refreshRate)};
+// const size_t layout_VkDisplayModeCreateInfoKHR[] = {sizeof(VkDisplayModeCreateInfoKHR), _Alignof(VkDisplayModeCreateInfoKHR), offsetof(VkDisplayModeCreateInfoKHR, sType), offsetof(VkDisplayModeCreateInfoKHR, pNext), offsetof(VkDisplayModeCreateInfoKHR, flags), offsetof(VkDisplayModeCreateInfoKHR, parameters)};
+// const size_t layout_VkDisplayModePropertiesKHR[] = {sizeof(VkDisplayModePropertiesKHR), _Alignof(VkDisplayModePropertiesKHR), offsetof(VkDisplayModePropertiesKHR, displayMode), offsetof(VkDisplayModePropertiesKHR, parameters)};
+// const size_t layout_VkDisplayPlaneCapabilitiesKHR[] = {sizeof(VkDisplayPlaneCapabilitiesKHR), _Alignof(VkDisplayPlaneCapabilitiesKHR), offsetof(VkDisplayPlaneCapabilitiesKHR, supportedAlpha), offsetof(VkDisplayPlaneCapabilitiesKHR, minSrcPosition), offsetof(VkDisplayPlaneCapabilitiesKHR, maxSrcPosition), offsetof(VkDisplayPlaneCapabilitiesKHR, minSrcExtent), offsetof(VkDisplayPlaneCapabilitiesKHR, maxSrcExtent), offsetof(VkDisplayPlaneCapabilitiesKHR, minDstPosition), offsetof(VkDisplayPlaneCapabilitiesKHR, maxDstPosition), offsetof(VkDisplayPlaneCapabilitiesKHR, minDstExtent), offsetof(VkDisplayPlaneCapabilitiesKHR, maxDstExtent)};
+// const size_t layout_VkDisplayPlanePropertiesKHR[] = {sizeof(VkDisplayPlanePropertiesKHR), _Alignof(VkDisplayPlanePropertiesKHR), offsetof(VkDisplayPlanePropertiesKHR, currentDisplay), offsetof(VkDisplayPlanePropertiesKHR, currentStackIndex)};
+// const size_t layout_VkDisplayPropertiesKHR[] = {sizeof(VkDisplayPropertiesKHR), _Alignof(VkDisplayPropertiesKHR), offsetof(VkDisplayPropertiesKHR, display), offsetof(VkDisplayPropertiesKHR, displayName), offsetof(VkDisplayPropertiesKHR, physicalDimensions), offsetof(VkDisplayPropertiesKHR, physicalResolution), offsetof(VkDisplayPropertiesKHR, supportedTransforms), offsetof(VkDisplayPropertiesKHR, planeReorderPossible), offsetof(VkDisplayPropertiesKHR, persistentContent)};
+// const size_t layout_VkDisplaySurfaceCreateInfoKHR[] = {sizeof(VkDisplaySurfaceCreateInfoKHR), _Alignof(VkDisplaySurfaceCreateInfoKHR), offsetof(VkDisplaySurfaceCreateInfoKHR, sType), offsetof(VkDisplaySurfaceCreateInfoKHR, pNext), offsetof(VkDisplaySurfaceCreateInfoKHR, flags), offsetof(VkDisplaySurfaceCreateInfoKHR, displayMode), offsetof(VkDisplaySurfaceCreateInfoKHR, planeIndex), offsetof(VkDisplaySurfaceCreateInfoKHR, planeStackIndex), offsetof(VkDisplaySurfaceCreateInfoKHR, transform), offsetof(VkDisplaySurfaceCreateInfoKHR, globalAlpha), offsetof(VkDisplaySurfaceCreateInfoKHR, alphaMode), offsetof(VkDisplaySurfaceCreateInfoKHR, imageExtent)};
+// const size_t layout_VkDisplayPresentInfoKHR[] = {sizeof(VkDisplayPresentInfoKHR), _Alignof(VkDisplayPresentInfoKHR), offsetof(VkDisplayPresentInfoKHR, sType), offsetof(VkDisplayPresentInfoKHR, pNext), offsetof(VkDisplayPresentInfoKHR, srcRect), offsetof(VkDisplayPresentInfoKHR, dstRect), offsetof(VkDisplayPresentInfoKHR, persistent)};
+// const size_t layout_VkQueueFamilyQueryResultStatusPropertiesKHR[] = {sizeof(VkQueueFamilyQueryResultStatusPropertiesKHR), _Alignof(VkQueueFamilyQueryResultStatusPropertiesKHR), offsetof(VkQueueFamilyQueryResultStatusPropertiesKHR, sType), offsetof(VkQueueFamilyQueryResultStatusPropertiesKHR, pNext), offsetof(VkQueueFamilyQueryResultStatusPropertiesKHR, queryResultStatusSupport)};
+// const size_t layout_VkQueueFamilyVideoPropertiesKHR[] = {sizeof(VkQueueFamilyVideoPropertiesKHR), _Alignof(VkQueueFamilyVideoPropertiesKHR), offsetof(VkQueueFamilyVideoPropertiesKHR, sType), offsetof(VkQueueFamilyVideoPropertiesKHR, pNext), offsetof(VkQueueFamilyVideoPropertiesKHR, videoCodecOperations)};
+// const size_t layout_VkVideoProfileInfoKHR[] = {sizeof(VkVideoProfileInfoKHR), _Alignof(VkVideoProfileInfoKHR), offsetof(VkVideoProfileInfoKHR, sType), offsetof(VkVideoProfileInfoKHR, pNext), offsetof(VkVideoProfileInfoKHR, videoCodecOperation), offsetof(VkVideoProfileInfoKHR, chromaSubsampling), offsetof(VkVideoProfileInfoKHR, lumaBitDepth), offsetof(VkVideoProfileInfoKHR, chromaBitDepth)};
+// const size_t layout_VkVideoProfileListInfoKHR[] = {sizeof(VkVideoProfileListInfoKHR), _Alignof(VkVideoProfileListInfoKHR), offsetof(VkVideoProfileListInfoKHR, sType), offsetof(VkVideoProfileListInfoKHR, pNext), offsetof(VkVideoProfileListInfoKHR, profileCount), offsetof(VkVideoProfileListInfoKHR, pProfiles)};
+// const size_t layout_VkVideoCapabilitiesKHR[] = {sizeof(VkVideoCapabilitiesKHR), _Alignof(VkVideoCapabilitiesKHR), offsetof(VkVideoCapabilitiesKHR, sType), offsetof(VkVideoCapabilitiesKHR, pNext), offsetof(VkVideoCapabilitiesKHR, flags), offsetof(VkVideoCapabilitiesKHR, minBitstreamBufferOffsetAlignment), offsetof(VkVideoCapabilitiesKHR, minBitstreamBufferSizeAlignment), offsetof(VkVideoCapabilitiesKHR, pictureAccessGranularity), offsetof(VkVideoCapabilitiesKHR, minCodedExtent), offsetof(VkVideoCapabilitiesKHR, maxCodedExtent), offsetof(VkVideoCapabilitiesKHR, maxDpbSlots), offsetof(VkVideoCapabilitiesKHR, maxActiveReferencePictures), offsetof(VkVideoCapabilitiesKHR, stdHeaderVersion)};
+// const size_t layout_VkPhysicalDeviceVideoFormatInfoKHR[] = {sizeof(VkPhysicalDeviceVideoFormatInfoKHR), _Alignof(VkPhysicalDeviceVideoFormatInfoKHR), offsetof(VkPhysicalDeviceVideoFormatInfoKHR, sType), offsetof(VkPhysicalDeviceVideoFormatInfoKHR, pNext), offsetof(VkPhysicalDeviceVideoFormatInfoKHR, imageUsage)};
+// const size_t layout_VkVideoFormatPropertiesKHR[] = {sizeof(VkVideoFormatPropertiesKHR), _Alignof(VkVideoFormatPropertiesKHR), offsetof(VkVideoFormatPropertiesKHR, sType), offsetof(VkVideoFormatPropertiesKHR, pNext), offsetof(VkVideoFormatPropertiesKHR, format), offsetof(VkVideoFormatPropertiesKHR, componentMapping), offsetof(VkVideoFormatPropertiesKHR, imageCreateFlags), offsetof(VkVideoFormatPropertiesKHR, imageType), offsetof(VkVideoFormatPropertiesKHR, imageTiling), offsetof(VkVideoFormatPropertiesKHR, imageUsageFlags)};
+// const size_t layout_VkVideoPictureResourceInfoKHR[] = {sizeof(VkVideoPictureResourceInfoKHR), _Alignof(VkVideoPictureResourceInfoKHR), offsetof(VkVideoPictureResourceInfoKHR, sType), offsetof(VkVideoPictureResourceInfoKHR, pNext), offsetof(VkVideoPictureResourceInfoKHR, codedOffset), offsetof(VkVideoPictureResourceInfoKHR, codedExtent), offsetof(VkVideoPictureResourceInfoKHR, baseArrayLayer), offsetof(VkVideoPictureResourceInfoKHR, imageViewBinding)};
+// const size_t layout_VkVideoReferenceSlotInfoKHR[] = {sizeof(VkVideoReferenceSlotInfoKHR), _Alignof(VkVideoReferenceSlotInfoKHR), offsetof(VkVideoReferenceSlotInfoKHR, sType), offsetof(VkVideoReferenceSlotInfoKHR, pNext), offsetof(VkVideoReferenceSlotInfoKHR, slotIndex), offsetof(VkVideoReferenceSlotInfoKHR, pPictureResource)};
+// const size_t layout_VkVideoSessionMemoryRequirementsKHR[] = {sizeof(VkVideoSessionMemoryRequirementsKHR), _Alignof(VkVideoSessionMemoryRequirementsKHR), offsetof(VkVideoSessionMemoryRequirementsKHR, sType), offsetof(VkVideoSessionMemoryRequirementsKHR, pNext), offsetof(VkVideoSessionMemoryRequirementsKHR, memoryBindIndex), offsetof(VkVideoSessionMemoryRequirementsKHR, memoryRequirements)};
+// const size_t layout_VkBindVideoSessionMemoryInfoKHR[] = {sizeof(VkBindVideoSessionMemoryInfoKHR), _Alignof(VkBindVideoSessionMemoryInfoKHR), offsetof(VkBindVideoSessionMemoryInfoKHR, sType), offsetof(VkBindVideoSessionMemoryInfoKHR, pNext), offsetof(VkBindVideoSessionMemoryInfoKHR, memoryBindIndex), offsetof(VkBindVideoSessionMemoryInfoKHR, memory), offsetof(VkBindVideoSessionMemoryInfoKHR, memoryOffset), offsetof(VkBindVideoSessionMemoryInfoKHR, memorySize)};
+// const size_t layout_VkVideoSessionCreateInfoKHR[] = {sizeof(VkVideoSessionCreateInfoKHR), _Alignof(VkVideoSessionCreateInfoKHR), offsetof(VkVideoSessionCreateInfoKHR, sType), offsetof(VkVideoSessionCreateInfoKHR, pNext), offsetof(VkVideoSessionCreateInfoKHR, queueFamilyIndex), offsetof(VkVideoSessionCreateInfoKHR, flags), offsetof(VkVideoSessionCreateInfoKHR, pVideoProfile), offsetof(VkVideoSessionCreateInfoKHR, pictureFormat), offsetof(VkVideoSessionCreateInfoKHR, maxCodedExtent), offsetof(VkVideoSessionCreateInfoKHR, referencePictureFormat), offsetof(VkVideoSessionCreateInfoKHR, maxDpbSlots), offsetof(VkVideoSessionCreateInfoKHR, maxActiveReferencePictures), offsetof(VkVideoSessionCreateInfoKHR, pStdHeaderVersion)};
+// const size_t layout_VkVideoSessionParametersCreateInfoKHR[] = {sizeof(VkVideoSessionParametersCreateInfoKHR), _Alignof(VkVideoSessionParametersCreateInfoKHR), offsetof(VkVideoSessionParametersCreateInfoKHR, sType), offsetof(VkVideoSessionParametersCreateInfoKHR, pNext), offsetof(VkVideoSessionParametersCreateInfoKHR, flags), offsetof(VkVideoSessionParametersCreateInfoKHR, videoSessionParametersTemplate), offsetof(VkVideoSessionParametersCreateInfoKHR, videoSession)};
+// const size_t layout_VkVideoSessionParametersUpdateInfoKHR[] = {sizeof(VkVideoSessionParametersUpdateInfoKHR), _Alignof(VkVideoSessionParametersUpdateInfoKHR), offsetof(VkVideoSessionParametersUpdateInfoKHR, sType), offsetof(VkVideoSessionParametersUpdateInfoKHR, pNext), offsetof(VkVideoSessionParametersUpdateInfoKHR, updateSequenceCount)};
+// const size_t layout_VkVideoBeginCodingInfoKHR[] = {sizeof(VkVideoBeginCodingInfoKHR), _Alignof(VkVideoBeginCodingInfoKHR), offsetof(VkVideoBeginCodingInfoKHR, sType), offsetof(VkVideoBeginCodingInfoKHR, pNext), offsetof(VkVideoBeginCodingInfoKHR, flags), offsetof(VkVideoBeginCodingInfoKHR, videoSession), offsetof(VkVideoBeginCodingInfoKHR, videoSessionParameters), offsetof(VkVideoBeginCodingInfoKHR, referenceSlotCount), offsetof(VkVideoBeginCodingInfoKHR, pReferenceSlots)};
+// const size_t layout_VkVideoEndCodingInfoKHR[] = {sizeof(VkVideoEndCodingInfoKHR), _Alignof(VkVideoEndCodingInfoKHR), offsetof(VkVideoEndCodingInfoKHR, sType), offsetof(VkVideoEndCodingInfoKHR, pNext), offsetof(VkVideoEndCodingInfoKHR, flags)};
+// const size_t layout_VkVideoCodingControlInfoKHR[] = {sizeof(VkVideoCodingControlInfoKHR), _Alignof(VkVideoCodingControlInfoKHR), offsetof(VkVideoCodingControlInfoKHR, sType), offsetof(VkVideoCodingControlInfoKHR, pNext), offsetof(VkVideoCodingControlInfoKHR, flags)};
+// const size_t layout_VkVideoDecodeCapabilitiesKHR[] = {sizeof(VkVideoDecodeCapabilitiesKHR), _Alignof(VkVideoDecodeCapabilitiesKHR), offsetof(VkVideoDecodeCapabilitiesKHR, sType), offsetof(VkVideoDecodeCapabilitiesKHR, pNext), offsetof(VkVideoDecodeCapabilitiesKHR, flags)};
+// const size_t layout_VkVideoDecodeUsageInfoKHR[] = {sizeof(VkVideoDecodeUsageInfoKHR), _Alignof(VkVideoDecodeUsageInfoKHR), offsetof(VkVideoDecodeUsageInfoKHR, sType), offsetof(VkVideoDecodeUsageInfoKHR, pNext), offsetof(VkVideoDecodeUsageInfoKHR, videoUsageHints)};
+// const size_t layout_VkVideoDecodeInfoKHR[] = {sizeof(VkVideoDecodeInfoKHR), _Alignof(VkVideoDecodeInfoKHR), offsetof(VkVideoDecodeInfoKHR, sType), offsetof(VkVideoDecodeInfoKHR, pNext), offsetof(VkVideoDecodeInfoKHR, flags), offsetof(VkVideoDecodeInfoKHR, srcBuffer), offsetof(VkVideoDecodeInfoKHR, srcBufferOffset), offsetof(VkVideoDecodeInfoKHR, srcBufferRange), offsetof(VkVideoDecodeInfoKHR, dstPictureResource), offsetof(VkVideoDecodeInfoKHR, pSetupReferenceSlot), offsetof(VkVideoDecodeInfoKHR, referenceSlotCount), offsetof(VkVideoDecodeInfoKHR, pReferenceSlots)};
+// const size_t layout_StdVideoH264SpsVuiFlags[] = {sizeof(StdVideoH264SpsVuiFlags), _Alignof(StdVideoH264SpsVuiFlags)};
+// const size_t layout_StdVideoH264HrdParameters[] = {sizeof(StdVideoH264HrdParameters), _Alignof(StdVideoH264HrdParameters), offsetof(StdVideoH264HrdParameters, cpb_cnt_minus1), offsetof(StdVideoH264HrdParameters, bit_rate_scale), offsetof(StdVideoH264HrdParameters, cpb_size_scale), offsetof(StdVideoH264HrdParameters, reserved1), offsetof(StdVideoH264HrdParameters, bit_rate_value_minus1), offsetof(StdVideoH264HrdParameters, cpb_size_value_minus1), offsetof(StdVideoH264HrdParameters, cbr_flag), offsetof(StdVideoH264HrdParameters, initial_cpb_removal_delay_length_minus1), offsetof(StdVideoH264HrdParameters, cpb_removal_delay_length_minus1), offsetof(StdVideoH264HrdParameters, dpb_output_delay_length_minus1), offsetof(StdVideoH264HrdParameters, time_offset_length)};
+// const size_t layout_StdVideoH264SequenceParameterSetVui[] = {sizeof(StdVideoH264SequenceParameterSetVui), _Alignof(StdVideoH264SequenceParameterSetVui), offsetof(StdVideoH264SequenceParameterSetVui, flags), offsetof(StdVideoH264SequenceParameterSetVui, aspect_ratio_idc), offsetof(StdVideoH264SequenceParameterSetVui, sar_width), offsetof(StdVideoH264SequenceParameterSetVui, sar_height), offsetof(StdVideoH264SequenceParameterSetVui, video_format), offsetof(StdVideoH264SequenceParameterSetVui, colour_primaries), offsetof(StdVideoH264SequenceParameterSetVui, transfer_characteristics), offsetof(StdVideoH264SequenceParameterSetVui, matrix_coefficients), offsetof(StdVideoH264SequenceParameterSetVui, num_units_in_tick), offsetof(StdVideoH264SequenceParameterSetVui, time_scale), offsetof(StdVideoH264SequenceParameterSetVui, max_num_reorder_frames), offsetof(StdVideoH264SequenceParameterSetVui, max_dec_frame_buffering), offsetof(StdVideoH264SequenceParameterSetVui, chroma_sample_loc_type_top_field), offsetof(StdVideoH264SequenceParameterSetVui, chroma_sample_loc_type_bottom_field), offsetof(StdVideoH264SequenceParameterSetVui, reserved1), offsetof(StdVideoH264SequenceParameterSetVui, pHrdParameters)};
+// const size_t layout_StdVideoH264SpsFlags[] = {sizeof(StdVideoH264SpsFlags), _Alignof(StdVideoH264SpsFlags)};
+// const size_t layout_StdVideoH264ScalingLists[] = {sizeof(StdVideoH264ScalingLists), _Alignof(StdVideoH264ScalingLists), offsetof(StdVideoH264ScalingLists, scaling_list_present_mask), offsetof(StdVideoH264ScalingLists, use_default_scaling_matrix_mask), offsetof(StdVideoH264ScalingLists, ScalingList4x4), offsetof(StdVideoH264ScalingLists, ScalingList8x8)};
+// const size_t layout_StdVideoH264SequenceParameterSet[] = {sizeof(StdVideoH264SequenceParameterSet), _Alignof(StdVideoH264SequenceParameterSet), offsetof(StdVideoH264SequenceParameterSet, flags), offsetof(StdVideoH264SequenceParameterSet, profile_idc), offsetof(StdVideoH264SequenceParameterSet, level_idc), offsetof(StdVideoH264SequenceParameterSet, chroma_format_idc), offsetof(StdVideoH264SequenceParameterSet, seq_parameter_set_id), offsetof(StdVideoH264SequenceParameterSet, bit_depth_luma_minus8), offsetof(StdVideoH264SequenceParameterSet, bit_depth_chroma_minus8), offsetof(StdVideoH264SequenceParameterSet, log2_max_frame_num_minus4), offsetof(StdVideoH264SequenceParameterSet, pic_order_cnt_type), offsetof(StdVideoH264SequenceParameterSet, offset_for_non_ref_pic), offsetof(StdVideoH264SequenceParameterSet, offset_for_top_to_bottom_field), offsetof(StdVideoH264SequenceParameterSet, log2_max_pic_order_cnt_lsb_minus4), offsetof(StdVideoH264SequenceParameterSet, num_ref_frames_in_pic_order_cnt_cycle), offsetof(StdVideoH264SequenceParameterSet, max_num_ref_frames), offsetof(StdVideoH264SequenceParameterSet, reserved1), offsetof(StdVideoH264SequenceParameterSet, pic_width_in_mbs_minus1), offsetof(StdVideoH264SequenceParameterSet, pic_height_in_map_units_minus1), offsetof(StdVideoH264SequenceParameterSet, frame_crop_left_offset), offsetof(StdVideoH264SequenceParameterSet, frame_crop_right_offset), offsetof(StdVideoH264SequenceParameterSet, frame_crop_top_offset), offsetof(StdVideoH264SequenceParameterSet, frame_crop_bottom_offset), offsetof(StdVideoH264SequenceParameterSet, reserved2), offsetof(StdVideoH264SequenceParameterSet, pOffsetForRefFrame), offsetof(StdVideoH264SequenceParameterSet, pScalingLists), offsetof(StdVideoH264SequenceParameterSet, pSequenceParameterSetVui)};
+// const size_t layout_StdVideoH264PpsFlags[] = {sizeof(StdVideoH264PpsFlags), _Alignof(StdVideoH264PpsFlags)};
+// const size_t layout_StdVideoH264PictureParameterSet[] = {sizeof(StdVideoH264PictureParameterSet), _Alignof(StdVideoH264PictureParameterSet), offsetof(StdVideoH264PictureParameterSet, flags), offsetof(StdVideoH264PictureParameterSet, seq_parameter_set_id), offsetof(StdVideoH264PictureParameterSet, pic_parameter_set_id), offsetof(StdVideoH264PictureParameterSet, num_ref_idx_l0_default_active_minus1), offsetof(StdVideoH264PictureParameterSet, num_ref_idx_l1_default_active_minus1), offsetof(StdVideoH264PictureParameterSet, weighted_bipred_idc), offsetof(StdVideoH264PictureParameterSet, pic_init_qp_minus26), offsetof(StdVideoH264PictureParameterSet, pic_init_qs_minus26), offsetof(StdVideoH264PictureParameterSet, chroma_qp_index_offset), offsetof(StdVideoH264PictureParameterSet, second_chroma_qp_index_offset), offsetof(StdVideoH264PictureParameterSet, pScalingLists)};
+// const size_t layout_VkVideoDecodeH264ProfileInfoKHR[] = {sizeof(VkVideoDecodeH264ProfileInfoKHR), _Alignof(VkVideoDecodeH264ProfileInfoKHR), offsetof(VkVideoDecodeH264ProfileInfoKHR, sType), offsetof(VkVideoDecodeH264ProfileInfoKHR, pNext), offsetof(VkVideoDecodeH264ProfileInfoKHR, stdProfileIdc), offsetof(VkVideoDecodeH264ProfileInfoKHR, pictureLayout)};
+// const size_t layout_VkVideoDecodeH264CapabilitiesKHR[] = {sizeof(VkVideoDecodeH264CapabilitiesKHR), _Alignof(VkVideoDecodeH264CapabilitiesKHR), offsetof(VkVideoDecodeH264CapabilitiesKHR, sType), offsetof(VkVideoDecodeH264CapabilitiesKHR, pNext), offsetof(VkVideoDecodeH264CapabilitiesKHR, maxLevelIdc), offsetof(VkVideoDecodeH264CapabilitiesKHR, fieldOffsetGranularity)};
+// const size_t layout_VkVideoDecodeH264SessionParametersAddInfoKHR[] = {sizeof(VkVideoDecodeH264SessionParametersAddInfoKHR), _Alignof(VkVideoDecodeH264SessionParametersAddInfoKHR), offsetof(VkVideoDecodeH264SessionParametersAddInfoKHR, sType), offsetof(VkVideoDecodeH264SessionParametersAddInfoKHR, pNext), offsetof(VkVideoDecodeH264SessionParametersAddInfoKHR, stdSPSCount), offsetof(VkVideoDecodeH264SessionParametersAddInfoKHR, pStdSPSs), offsetof(VkVideoDecodeH264SessionParametersAddInfoKHR, stdPPSCount), offsetof(VkVideoDecodeH264SessionParametersAddInfoKHR, pStdPPSs)};
+// const size_t layout_VkVideoDecodeH264SessionParametersCreateInfoKHR[] = {sizeof(VkVideoDecodeH264SessionParametersCreateInfoKHR), _Alignof(VkVideoDecodeH264SessionParametersCreateInfoKHR), offsetof(VkVideoDecodeH264SessionParametersCreateInfoKHR, sType), offsetof(VkVideoDecodeH264SessionParametersCreateInfoKHR, pNext), offsetof(VkVideoDecodeH264SessionParametersCreateInfoKHR, maxStdSPSCount), offsetof(VkVideoDecodeH264SessionParametersCreateInfoKHR, maxStdPPSCount), offsetof(VkVideoDecodeH264SessionParametersCreateInfoKHR, pParametersAddInfo)};
+// const size_t layout_StdVideoDecodeH264PictureInfoFlags[] = {sizeof(StdVideoDecodeH264PictureInfoFlags), _Alignof(StdVideoDecodeH264PictureInfoFlags)};
+// const size_t layout_StdVideoDecodeH264PictureInfo[] = {sizeof(StdVideoDecodeH264PictureInfo), _Alignof(StdVideoDecodeH264PictureInfo), offsetof(StdVideoDecodeH264PictureInfo, flags), offsetof(StdVideoDecodeH264PictureInfo, seq_parameter_set_id), offsetof(StdVideoDecodeH264PictureInfo, pic_parameter_set_id), offsetof(StdVideoDecodeH264PictureInfo, reserved1), offsetof(StdVideoDecodeH264PictureInfo, reserved2), offsetof(StdVideoDecodeH264PictureInfo, frame_num), offsetof(StdVideoDecodeH264PictureInfo, idr_pic_id), offsetof(StdVideoDecodeH264PictureInfo, PicOrderCnt)};
+// const size_t layout_StdVideoDecodeH264ReferenceInfoFlags[] = {sizeof(StdVideoDecodeH264ReferenceInfoFlags), _Alignof(StdVideoDecodeH264ReferenceInfoFlags)};
+// const size_t layout_StdVideoDecodeH264ReferenceInfo[] = {sizeof(StdVideoDecodeH264ReferenceInfo), _Alignof(StdVideoDecodeH264ReferenceInfo), offsetof(StdVideoDecodeH264ReferenceInfo, flags), offsetof(StdVideoDecodeH264ReferenceInfo, FrameNum), offsetof(StdVideoDecodeH264ReferenceInfo, reserved), offsetof(StdVideoDecodeH264ReferenceInfo, PicOrderCnt)};
+// const size_t layout_VkVideoDecodeH264PictureInfoKHR[] = {sizeof(VkVideoDecodeH264PictureInfoKHR), _Alignof(VkVideoDecodeH264PictureInfoKHR), offsetof(VkVideoDecodeH264PictureInfoKHR, sType), offsetof(VkVideoDecodeH264PictureInfoKHR, pNext), offsetof(VkVideoDecodeH264PictureInfoKHR, pStdPictureInfo), offsetof(VkVideoDecodeH264PictureInfoKHR, sliceCount), offsetof(VkVideoDecodeH264PictureInfoKHR, pSliceOffsets)};
+// const size_t layout_VkVideoDecodeH264DpbSlotInfoKHR[] = {sizeof(VkVideoDecodeH264DpbSlotInfoKHR), _Alignof(VkVideoDecodeH264DpbSlotInfoKHR), offsetof(VkVideoDecodeH264DpbSlotInfoKHR, sType), offsetof(VkVideoDecodeH264DpbSlotInfoKHR, pNext), offsetof(VkVideoDecodeH264DpbSlotInfoKHR, pStdReferenceInfo)};
+// const size_t layout_VkRenderingFragmentShadingRateAttachmentInfoKHR[] = {sizeof(VkRenderingFragmentShadingRateAttachmentInfoKHR), _Alignof(VkRenderingFragmentShadingRateAttachmentInfoKHR), offsetof(VkRenderingFragmentShadingRateAttachmentInfoKHR, sType), offsetof(VkRenderingFragmentShadingRateAttachmentInfoKHR, pNext), offsetof(VkRenderingFragmentShadingRateAttachmentInfoKHR, imageView), offsetof(VkRenderingFragmentShadingRateAttachmentInfoKHR, imageLayout), offsetof(VkRenderingFragmentShadingRateAttachmentInfoKHR, shadingRateAttachmentTexelSize)};
+// const size_t layout_VkRenderingFragmentDensityMapAttachmentInfoEXT[] = {sizeof(VkRenderingFragmentDensityMapAttachmentInfoEXT), _Alignof(VkRenderingFragmentDensityMapAttachmentInfoEXT), offsetof(VkRenderingFragmentDensityMapAttachmentInfoEXT, sType), offsetof(VkRenderingFragmentDensityMapAttachmentInfoEXT, pNext), offsetof(VkRenderingFragmentDensityMapAttachmentInfoEXT, imageView), offsetof(VkRenderingFragmentDensityMapAttachmentInfoEXT, imageLayout)};
+// const size_t layout_VkAttachmentSampleCountInfoAMD[] = {sizeof(VkAttachmentSampleCountInfoAMD), _Alignof(VkAttachmentSampleCountInfoAMD), offsetof(VkAttachmentSampleCountInfoAMD, sType), offsetof(VkAttachmentSampleCountInfoAMD, pNext), offsetof(VkAttachmentSampleCountInfoAMD, colorAttachmentCount), offsetof(VkAttachmentSampleCountInfoAMD, pColorAttachmentSamples), offsetof(VkAttachmentSampleCountInfoAMD, depthStencilAttachmentSamples)};
+// const size_t layout_VkMultiviewPerViewAttributesInfoNVX[] = {sizeof(VkMultiviewPerViewAttributesInfoNVX), _Alignof(VkMultiviewPerViewAttributesInfoNVX), offsetof(VkMultiviewPerViewAttributesInfoNVX, sType), offsetof(VkMultiviewPerViewAttributesInfoNVX, pNext), offsetof(VkMultiviewPerViewAttributesInfoNVX, perViewAttributes), offsetof(VkMultiviewPerViewAttributesInfoNVX, perViewAttributesPositionXOnly)};
+// const size_t layout_VkImportMemoryFdInfoKHR[] = {sizeof(VkImportMemoryFdInfoKHR), _Alignof(VkImportMemoryFdInfoKHR), offsetof(VkImportMemoryFdInfoKHR, sType), offsetof(VkImportMemoryFdInfoKHR, pNext), offsetof(VkImportMemoryFdInfoKHR, handleType), offsetof(VkImportMemoryFdInfoKHR, fd)};
+// const size_t layout_VkMemoryFdPropertiesKHR[] = {sizeof(VkMemoryFdPropertiesKHR), _Alignof(VkMemoryFdPropertiesKHR), offsetof(VkMemoryFdPropertiesKHR, sType), offsetof(VkMemoryFdPropertiesKHR, pNext), offsetof(VkMemoryFdPropertiesKHR, memoryTypeBits)};
+// const size_t layout_VkMemoryGetFdInfoKHR[] = {sizeof(VkMemoryGetFdInfoKHR), _Alignof(VkMemoryGetFdInfoKHR), offsetof(VkMemoryGetFdInfoKHR, sType), offsetof(VkMemoryGetFdInfoKHR, pNext), offsetof(VkMemoryGetFdInfoKHR, memory), offsetof(VkMemoryGetFdInfoKHR, handleType)};
+// const size_t layout_VkImportSemaphoreFdInfoKHR[] = {sizeof(VkImportSemaphoreFdInfoKHR), _Alignof(VkImportSemaphoreFdInfoKHR), offsetof(VkImportSemaphoreFdInfoKHR, sType), offsetof(VkImportSemaphoreFdInfoKHR, pNext), offsetof(VkImportSemaphoreFdInfoKHR, semaphore), offsetof(VkImportSemaphoreFdInfoKHR, flags), offsetof(VkImportSemaphoreFdInfoKHR, handleType), offsetof(VkImportSemaphoreFdInfoKHR, fd)};
+// const size_t layout_VkSemaphoreGetFdInfoKHR[] = {sizeof(VkSemaphoreGetFdInfoKHR), _Alignof(VkSemaphoreGetFdInfoKHR), offsetof(VkSemaphoreGetFdInfoKHR, sType), offsetof(VkSemaphoreGetFdInfoKHR, pNext), offsetof(VkSemaphoreGetFdInfoKHR, semaphore), offsetof(VkSemaphoreGetFdInfoKHR, handleType)};
+// const size_t layout_VkPhysicalDevicePushDescriptorPropertiesKHR[] = {sizeof(VkPhysicalDevicePushDescriptorPropertiesKHR), _Alignof(VkPhysicalDevicePushDescriptorPropertiesKHR), offsetof(VkPhysicalDevicePushDescriptorPropertiesKHR, sType), offsetof(VkPhysicalDevicePushDescriptorPropertiesKHR, pNext), offsetof(VkPhysicalDevicePushDescriptorPropertiesKHR, maxPushDescriptors)};
+// const size_t layout_VkRectLayerKHR[] = {sizeof(VkRectLayerKHR), _Alignof(VkRectLayerKHR), offsetof(VkRectLayerKHR, offset), offsetof(VkRectLayerKHR, extent), offsetof(VkRectLayerKHR, layer)};
+// const size_t layout_VkPresentRegionKHR[] = {sizeof(VkPresentRegionKHR), _Alignof(VkPresentRegionKHR), offsetof(VkPresentRegionKHR, rectangleCount), offsetof(VkPresentRegionKHR, pRectangles)};
+// const size_t layout_VkPresentRegionsKHR[] = {sizeof(VkPresentRegionsKHR), _Alignof(VkPresentRegionsKHR), offsetof(VkPresentRegionsKHR, sType), offsetof(VkPresentRegionsKHR, pNext), offsetof(VkPresentRegionsKHR, swapchainCount), offsetof(VkPresentRegionsKHR, pRegions)};
+// const size_t layout_VkSharedPresentSurfaceCapabilitiesKHR[] = {sizeof(VkSharedPresentSurfaceCapabilitiesKHR), _Alignof(VkSharedPresentSurfaceCapabilitiesKHR), offsetof(VkSharedPresentSurfaceCapabilitiesKHR, sType), offsetof(VkSharedPresentSurfaceCapabilitiesKHR, pNext), offsetof(VkSharedPresentSurfaceCapabilitiesKHR, sharedPresentSupportedUsageFlags)};
+// const size_t layout_VkImportFenceFdInfoKHR[] = {sizeof(VkImportFenceFdInfoKHR), _Alignof(VkImportFenceFdInfoKHR), offsetof(VkImportFenceFdInfoKHR, sType), offsetof(VkImportFenceFdInfoKHR, pNext), offsetof(VkImportFenceFdInfoKHR, fence), offsetof(VkImportFenceFdInfoKHR, flags), offsetof(VkImportFenceFdInfoKHR, handleType), offsetof(VkImportFenceFdInfoKHR, fd)};
+// const size_t layout_VkFenceGetFdInfoKHR[] = {sizeof(VkFenceGetFdInfoKHR), _Alignof(VkFenceGetFdInfoKHR), offsetof(VkFenceGetFdInfoKHR, sType), offsetof(VkFenceGetFdInfoKHR, pNext), offsetof(VkFenceGetFdInfoKHR, fence), offsetof(VkFenceGetFdInfoKHR, handleType)};
+// const size_t layout_VkPhysicalDevicePerformanceQueryFeaturesKHR[] = {sizeof(VkPhysicalDevicePerformanceQueryFeaturesKHR), _Alignof(VkPhysicalDevicePerformanceQueryFeaturesKHR), offsetof(VkPhysicalDevicePerformanceQueryFeaturesKHR, sType), offsetof(VkPhysicalDevicePerformanceQueryFeaturesKHR, pNext), offsetof(VkPhysicalDevicePerformanceQueryFeaturesKHR, performanceCounterQueryPools), offsetof(VkPhysicalDevicePerformanceQueryFeaturesKHR, performanceCounterMultipleQueryPools)};
+// const size_t layout_VkPhysicalDevicePerformanceQueryPropertiesKHR[] = {sizeof(VkPhysicalDevicePerformanceQueryPropertiesKHR), _Alignof(VkPhysicalDevicePerformanceQueryPropertiesKHR), offsetof(VkPhysicalDevicePerformanceQueryPropertiesKHR, sType), offsetof(VkPhysicalDevicePerformanceQueryPropertiesKHR, pNext), offsetof(VkPhysicalDevicePerformanceQueryPropertiesKHR, allowCommandBufferQueryCopies)};
+// const size_t layout_VkPerformanceCounterKHR[] = {sizeof(VkPerformanceCounterKHR), _Alignof(VkPerformanceCounterKHR), offsetof(VkPerformanceCounterKHR, sType), offsetof(VkPerformanceCounterKHR, pNext), offsetof(VkPerformanceCounterKHR, unit), offsetof(VkPerformanceCounterKHR, scope), offsetof(VkPerformanceCounterKHR, storage), offsetof(VkPerformanceCounterKHR, uuid)};
+// const size_t layout_VkPerformanceCounterDescriptionKHR[] = {sizeof(VkPerformanceCounterDescriptionKHR), _Alignof(VkPerformanceCounterDescriptionKHR), offsetof(VkPerformanceCounterDescriptionKHR, sType), offsetof(VkPerformanceCounterDescriptionKHR, pNext), offsetof(VkPerformanceCounterDescriptionKHR, flags), offsetof(VkPerformanceCounterDescriptionKHR, name), offsetof(VkPerformanceCounterDescriptionKHR, category), offsetof(VkPerformanceCounterDescriptionKHR, description)};
+// const size_t layout_VkQueryPoolPerformanceCreateInfoKHR[] = {sizeof(VkQueryPoolPerformanceCreateInfoKHR), _Alignof(VkQueryPoolPerformanceCreateInfoKHR), offsetof(VkQueryPoolPerformanceCreateInfoKHR, sType), offsetof(VkQueryPoolPerformanceCreateInfoKHR, pNext), offsetof(VkQueryPoolPerformanceCreateInfoKHR, queueFamilyIndex), offsetof(VkQueryPoolPerformanceCreateInfoKHR, counterIndexCount), offsetof(VkQueryPoolPerformanceCreateInfoKHR, pCounterIndices)};
+// const size_t layout_VkPerformanceCounterResultKHR[] = {sizeof(VkPerformanceCounterResultKHR), _Alignof(VkPerformanceCounterResultKHR)};
+// const size_t layout_VkAcquireProfilingLockInfoKHR[] = {sizeof(VkAcquireProfilingLockInfoKHR), _Alignof(VkAcquireProfilingLockInfoKHR), offsetof(VkAcquireProfilingLockInfoKHR, sType), offsetof(VkAcquireProfilingLockInfoKHR, pNext), offsetof(VkAcquireProfilingLockInfoKHR, flags), offsetof(VkAcquireProfilingLockInfoKHR, timeout)};
+// const size_t layout_VkPerformanceQuerySubmitInfoKHR[] = {sizeof(VkPerformanceQuerySubmitInfoKHR), _Alignof(VkPerformanceQuerySubmitInfoKHR), offsetof(VkPerformanceQuerySubmitInfoKHR, sType), offsetof(VkPerformanceQuerySubmitInfoKHR, pNext), offsetof(VkPerformanceQuerySubmitInfoKHR, counterPassIndex)};
+// const size_t layout_VkPhysicalDeviceSurfaceInfo2KHR[] = {sizeof(VkPhysicalDeviceSurfaceInfo2KHR), _Alignof(VkPhysicalDeviceSurfaceInfo2KHR), offsetof(VkPhysicalDeviceSurfaceInfo2KHR, sType), offsetof(VkPhysicalDeviceSurfaceInfo2KHR, pNext), offsetof(VkPhysicalDeviceSurfaceInfo2KHR, surface)};
+// const size_t layout_VkSurfaceCapabilities2KHR[] = {sizeof(VkSurfaceCapabilities2KHR), _Alignof(VkSurfaceCapabilities2KHR), offsetof(VkSurfaceCapabilities2KHR, sType), offsetof(VkSurfaceCapabilities2KHR, pNext), offsetof(VkSurfaceCapabilities2KHR, surfaceCapabilities)};
+// const size_t layout_VkSurfaceFormat2KHR[] = {sizeof(VkSurfaceFormat2KHR), _Alignof(VkSurfaceFormat2KHR), offsetof(VkSurfaceFormat2KHR, sType), offsetof(VkSurfaceFormat2KHR, pNext), offsetof(VkSurfaceFormat2KHR, surfaceFormat)};
+// const size_t layout_VkDisplayProperties2KHR[] = {sizeof(VkDisplayProperties2KHR), _Alignof(VkDisplayProperties2KHR), offsetof(VkDisplayProperties2KHR, sType), offsetof(VkDisplayProperties2KHR, pNext), offsetof(VkDisplayProperties2KHR, displayProperties)};
+// const size_t layout_VkDisplayPlaneProperties2KHR[] = {sizeof(VkDisplayPlaneProperties2KHR), _Alignof(VkDisplayPlaneProperties2KHR), offsetof(VkDisplayPlaneProperties2KHR, sType), offsetof(VkDisplayPlaneProperties2KHR, pNext), offsetof(VkDisplayPlaneProperties2KHR, displayPlaneProperties)};
+// const size_t layout_VkDisplayModeProperties2KHR[] = {sizeof(VkDisplayModeProperties2KHR), _Alignof(VkDisplayModeProperties2KHR), offsetof(VkDisplayModeProperties2KHR, sType), offsetof(VkDisplayModeProperties2KHR, pNext), offsetof(VkDisplayModeProperties2KHR, displayModeProperties)};
+// const size_t layout_VkDisplayPlaneInfo2KHR[] = {sizeof(VkDisplayPlaneInfo2KHR), _Alignof(VkDisplayPlaneInfo2KHR), offsetof(VkDisplayPlaneInfo2KHR, sType), offsetof(VkDisplayPlaneInfo2KHR, pNext), offsetof(VkDisplayPlaneInfo2KHR, mode), offsetof(VkDisplayPlaneInfo2KHR, planeIndex)};
+// const size_t layout_VkDisplayPlaneCapabilities2KHR[] = {sizeof(VkDisplayPlaneCapabilities2KHR), _Alignof(VkDisplayPlaneCapabilities2KHR), offsetof(VkDisplayPlaneCapabilities2KHR, sType), offsetof(VkDisplayPlaneCapabilities2KHR, pNext), offsetof(VkDisplayPlaneCapabilities2KHR, capabilities)};
+// const size_t layout_VkDeviceOrHostAddressKHR[] = {sizeof(VkDeviceOrHostAddressKHR), _Alignof(VkDeviceOrHostAddressKHR)};
+// const size_t layout_VkDeviceOrHostAddressConstKHR[] = {sizeof(VkDeviceOrHostAddressConstKHR), _Alignof(VkDeviceOrHostAddressConstKHR)};
+// const size_t layout_VkAccelerationStructureBuildRangeInfoKHR[] = {sizeof(VkAccelerationStructureBuildRangeInfoKHR), _Alignof(VkAccelerationStructureBuildRangeInfoKHR), offsetof(VkAccelerationStructureBuildRangeInfoKHR, primitiveCount), offsetof(VkAccelerationStructureBuildRangeInfoKHR, primitiveOffset), offsetof(VkAccelerationStructureBuildRangeInfoKHR, firstVertex), offsetof(VkAccelerationStructureBuildRangeInfoKHR, transformOffset)};
+// const size_t layout_VkAabbPositionsKHR[] = {sizeof(VkAabbPositionsKHR), _Alignof(VkAabbPositionsKHR), offsetof(VkAabbPositionsKHR, minX), offsetof(VkAabbPositionsKHR, minY), offsetof(VkAabbPositionsKHR, minZ), offsetof(VkAabbPositionsKHR, maxX), offsetof(VkAabbPositionsKHR, maxY), offsetof(VkAabbPositionsKHR, maxZ)};
+// const size_t layout_VkAccelerationStructureGeometryTrianglesDataKHR[] = {sizeof(VkAccelerationStructureGeometryTrianglesDataKHR), _Alignof(VkAccelerationStructureGeometryTrianglesDataKHR), offsetof(VkAccelerationStructureGeometryTrianglesDataKHR, sType), offsetof(VkAccelerationStructureGeometryTrianglesDataKHR, pNext), offsetof(VkAccelerationStructureGeometryTrianglesDataKHR, vertexFormat), offsetof(VkAccelerationStructureGeometryTrianglesDataKHR, vertexData), offsetof(VkAccelerationStructureGeometryTrianglesDataKHR, vertexStride), offsetof(VkAccelerationStructureGeometryTrianglesDataKHR, maxVertex), offsetof(VkAccelerationStructureGeometryTrianglesDataKHR, indexType), offsetof(VkAccelerationStructureGeometryTrianglesDataKHR, indexData), offsetof(VkAccelerationStructureGeometryTrianglesDataKHR, transformData)};
+// const size_t layout_VkTransformMatrixKHR[] = {sizeof(VkTransformMatrixKHR), _Alignof(VkTransformMatrixKHR), offsetof(VkTransformMatrixKHR, matrix)};
+// const size_t layout_VkAccelerationStructureGeometryAabbsDataKHR[] = {sizeof(VkAccelerationStructureGeometryAabbsDataKHR), _Alignof(VkAccelerationStructureGeometryAabbsDataKHR), offsetof(VkAccelerationStructureGeometryAabbsDataKHR, sType), offsetof(VkAccelerationStructureGeometryAabbsDataKHR, pNext), offsetof(VkAccelerationStructureGeometryAabbsDataKHR, data), offsetof(VkAccelerationStructureGeometryAabbsDataKHR, stride)};
+// const size_t layout_VkAccelerationStructureGeometryInstancesDataKHR[] = {sizeof(VkAccelerationStructureGeometryInstancesDataKHR), _Alignof(VkAccelerationStructureGeometryInstancesDataKHR), offsetof(VkAccelerationStructureGeometryInstancesDataKHR, sType), offsetof(VkAccelerationStructureGeometryInstancesDataKHR, pNext), offsetof(VkAccelerationStructureGeometryInstancesDataKHR, arrayOfPointers), offsetof(VkAccelerationStructureGeometryInstancesDataKHR, data)};
+// const size_t layout_VkAccelerationStructureGeometryDataKHR[] = {sizeof(VkAccelerationStructureGeometryDataKHR), _Alignof(VkAccelerationStructureGeometryDataKHR)};
+// const size_t layout_VkAccelerationStructureGeometryKHR[] = {sizeof(VkAccelerationStructureGeometryKHR), _Alignof(VkAccelerationStructureGeometryKHR), offsetof(VkAccelerationStructureGeometryKHR, sType), offsetof(VkAccelerationStructureGeometryKHR, pNext), offsetof(VkAccelerationStructureGeometryKHR, geometryType), offsetof(VkAccelerationStructureGeometryKHR, geometry), offsetof(VkAccelerationStructureGeometryKHR, flags)};
+// const size_t layout_VkAccelerationStructureBuildGeometryInfoKHR[] = {sizeof(VkAccelerationStructureBuildGeometryInfoKHR), _Alignof(VkAccelerationStructureBuildGeometryInfoKHR), offsetof(VkAccelerationStructureBuildGeometryInfoKHR, sType), offsetof(VkAccelerationStructureBuildGeometryInfoKHR, pNext), offsetof(VkAccelerationStructureBuildGeometryInfoKHR, type), offsetof(VkAccelerationStructureBuildGeometryInfoKHR, flags), offsetof(VkAccelerationStructureBuildGeometryInfoKHR, mode), offsetof(VkAccelerationStructureBuildGeometryInfoKHR, srcAccelerationStructure), offsetof(VkAccelerationStructureBuildGeometryInfoKHR, dstAccelerationStructure), offsetof(VkAccelerationStructureBuildGeometryInfoKHR, geometryCount), offsetof(VkAccelerationStructureBuildGeometryInfoKHR, pGeometries), offsetof(VkAccelerationStructureBuildGeometryInfoKHR, ppGeometries), offsetof(VkAccelerationStructureBuildGeometryInfoKHR, scratchData)};
+// const size_t layout_VkAccelerationStructureInstanceKHR[] = {sizeof(VkAccelerationStructureInstanceKHR), _Alignof(VkAccelerationStructureInstanceKHR), offsetof(VkAccelerationStructureInstanceKHR, transform), offsetof(VkAccelerationStructureInstanceKHR, accelerationStructureReference)};
+// const size_t layout_VkAccelerationStructureCreateInfoKHR[] = {sizeof(VkAccelerationStructureCreateInfoKHR), _Alignof(VkAccelerationStructureCreateInfoKHR), offsetof(VkAccelerationStructureCreateInfoKHR, sType), offsetof(VkAccelerationStructureCreateInfoKHR, pNext), offsetof(VkAccelerationStructureCreateInfoKHR, createFlags), offsetof(VkAccelerationStructureCreateInfoKHR, buffer), offsetof(VkAccelerationStructureCreateInfoKHR, offset), offsetof(VkAccelerationStructureCreateInfoKHR, size), offsetof(VkAccelerationStructureCreateInfoKHR, type), offsetof(VkAccelerationStructureCreateInfoKHR, deviceAddress)};
+// const size_t layout_VkWriteDescriptorSetAccelerationStructureKHR[] = {sizeof(VkWriteDescriptorSetAccelerationStructureKHR), _Alignof(VkWriteDescriptorSetAccelerationStructureKHR), offsetof(VkWriteDescriptorSetAccelerationStructureKHR, sType), offsetof(VkWriteDescriptorSetAccelerationStructureKHR, pNext), offsetof(VkWriteDescriptorSetAccelerationStructureKHR, accelerationStructureCount), offsetof(VkWriteDescriptorSetAccelerationStructureKHR, pAccelerationStructures)};
+// const size_t layout_VkPhysicalDeviceAccelerationStructureFeaturesKHR[] = {sizeof(VkPhysicalDeviceAccelerationStructureFeaturesKHR), _Alignof(VkPhysicalDeviceAccelerationStructureFeaturesKHR), offsetof(VkPhysicalDeviceAccelerationStructureFeaturesKHR, sType), offsetof(VkPhysicalDeviceAccelerationStructureFeaturesKHR, pNext), offsetof(VkPhysicalDeviceAccelerationStructureFeaturesKHR, accelerationStructure), offsetof(VkPhysicalDeviceAccelerationStructureFeaturesKHR, accelerationStructureCaptureReplay), offsetof(VkPhysicalDeviceAccelerationStructureFeaturesKHR, accelerationStructureIndirectBuild), offsetof(VkPhysicalDeviceAccelerationStructureFeaturesKHR, accelerationStructureHostCommands), offsetof(VkPhysicalDeviceAccelerationStructureFeaturesKHR, descriptorBindingAccelerationStructureUpdateAfterBind)};
+// const size_t layout_VkPhysicalDeviceAccelerationStructurePropertiesKHR[] = {sizeof(VkPhysicalDeviceAccelerationStructurePropertiesKHR), _Alignof(VkPhysicalDeviceAccelerationStructurePropertiesKHR), offsetof(VkPhysicalDeviceAccelerationStructurePropertiesKHR, sType), offsetof(VkPhysicalDeviceAccelerationStructurePropertiesKHR, pNext), offsetof(VkPhysicalDeviceAccelerationStructurePropertiesKHR, maxGeometryCount), offsetof(VkPhysicalDeviceAccelerationStructurePropertiesKHR, maxInstanceCount), offsetof(VkPhysicalDeviceAccelerationStructurePropertiesKHR, maxPrimitiveCount), offsetof(VkPhysicalDeviceAccelerationStructurePropertiesKHR, maxPerStageDescriptorAccelerationStructures), offsetof(VkPhysicalDeviceAccelerationStructurePropertiesKHR, maxPerStageDescriptorUpdateAfterBindAccelerationStructures), offsetof(VkPhysicalDeviceAccelerationStructurePropertiesKHR, maxDescriptorSetAccelerationStructures), offsetof(VkPhysicalDeviceAccelerationStructurePropertiesKHR, maxDescriptorSetUpdateAfterBindAccelerationStructures), offsetof(VkPhysicalDeviceAccelerationStructurePropertiesKHR, minAccelerationStructureScratchOffsetAlignment)};
+// const size_t layout_VkAccelerationStructureDeviceAddressInfoKHR[] = {sizeof(VkAccelerationStructureDeviceAddressInfoKHR), _Alignof(VkAccelerationStructureDeviceAddressInfoKHR), offsetof(VkAccelerationStructureDeviceAddressInfoKHR, sType), offsetof(VkAccelerationStructureDeviceAddressInfoKHR, pNext), offsetof(VkAccelerationStructureDeviceAddressInfoKHR, accelerationStructure)};
+// const size_t layout_VkAccelerationStructureVersionInfoKHR[] = {sizeof(VkAccelerationStructureVersionInfoKHR), _Alignof(VkAccelerationStructureVersionInfoKHR), offsetof(VkAccelerationStructureVersionInfoKHR, sType), offsetof(VkAccelerationStructureVersionInfoKHR, pNext), offsetof(VkAccelerationStructureVersionInfoKHR, pVersionData)};
+// const size_t layout_VkCopyAccelerationStructureToMemoryInfoKHR[] = {sizeof(VkCopyAccelerationStructureToMemoryInfoKHR), _Alignof(VkCopyAccelerationStructureToMemoryInfoKHR), offsetof(VkCopyAccelerationStructureToMemoryInfoKHR, sType), offsetof(VkCopyAccelerationStructureToMemoryInfoKHR, pNext), offsetof(VkCopyAccelerationStructureToMemoryInfoKHR, src), offsetof(VkCopyAccelerationStructureToMemoryInfoKHR, dst), offsetof(VkCopyAccelerationStructureToMemoryInfoKHR, mode)};
+// const size_t layout_VkCopyMemoryToAccelerationStructureInfoKHR[] = {sizeof(VkCopyMemoryToAccelerationStructureInfoKHR), _Alignof(VkCopyMemoryToAccelerationStructureInfoKHR), offsetof(VkCopyMemoryToAccelerationStructureInfoKHR, sType), offsetof(VkCopyMemoryToAccelerationStructureInfoKHR, pNext), offsetof(VkCopyMemoryToAccelerationStructureInfoKHR, src), offsetof(VkCopyMemoryToAccelerationStructureInfoKHR, dst), offsetof(VkCopyMemoryToAccelerationStructureInfoKHR, mode)};
+// const size_t layout_VkCopyAccelerationStructureInfoKHR[] = {sizeof(VkCopyAccelerationStructureInfoKHR), _Alignof(VkCopyAccelerationStructureInfoKHR), offsetof(VkCopyAccelerationStructureInfoKHR, sType), offsetof(VkCopyAccelerationStructureInfoKHR, pNext), offsetof(VkCopyAccelerationStructureInfoKHR, src), offsetof(VkCopyAccelerationStructureInfoKHR, dst), offsetof(VkCopyAccelerationStructureInfoKHR, mode)};
+// const size_t layout_VkAccelerationStructureBuildSizesInfoKHR[] = {sizeof(VkAccelerationStructureBuildSizesInfoKHR), _Alignof(VkAccelerationStructureBuildSizesInfoKHR), offsetof(VkAccelerationStructureBuildSizesInfoKHR, sType), offsetof(VkAccelerationStructureBuildSizesInfoKHR, pNext), offsetof(VkAccelerationStructureBuildSizesInfoKHR, accelerationStructureSize), offsetof(VkAccelerationStructureBuildSizesInfoKHR, updateScratchSize), offsetof(VkAccelerationStructureBuildSizesInfoKHR, buildScratchSize)};
+// const size_t layout_VkPhysicalDeviceShaderClockFeaturesKHR[] = {sizeof(VkPhysicalDeviceShaderClockFeaturesKHR), _Alignof(VkPhysicalDeviceShaderClockFeaturesKHR), offsetof(VkPhysicalDeviceShaderClockFeaturesKHR, sType), offsetof(VkPhysicalDeviceShaderClockFeaturesKHR, pNext), offsetof(VkPhysicalDeviceShaderClockFeaturesKHR, shaderSubgroupClock), offsetof(VkPhysicalDeviceShaderClockFeaturesKHR, shaderDeviceClock)};
+// const size_t layout_StdVideoH265DecPicBufMgr[] = {sizeof(StdVideoH265DecPicBufMgr), _Alignof(StdVideoH265DecPicBufMgr), offsetof(StdVideoH265DecPicBufMgr, max_latency_increase_plus1), offsetof(StdVideoH265DecPicBufMgr, max_dec_pic_buffering_minus1), offsetof(StdVideoH265DecPicBufMgr, max_num_reorder_pics)};
+// const size_t layout_StdVideoH265SubLayerHrdParameters[] = {sizeof(StdVideoH265SubLayerHrdParameters), _Alignof(StdVideoH265SubLayerHrdParameters), offsetof(StdVideoH265SubLayerHrdParameters, bit_rate_value_minus1), offsetof(StdVideoH265SubLayerHrdParameters, cpb_size_value_minus1), offsetof(StdVideoH265SubLayerHrdParameters, cpb_size_du_value_minus1), offsetof(StdVideoH265SubLayerHrdParameters, bit_rate_du_value_minus1), offsetof(StdVideoH265SubLayerHrdParameters, cbr_flag)};
+// const size_t layout_StdVideoH265HrdFlags[] = {sizeof(StdVideoH265HrdFlags), _Alignof(StdVideoH265HrdFlags)};
+// const size_t layout_StdVideoH265HrdParameters[] = {sizeof(StdVideoH265HrdParameters), _Alignof(StdVideoH265HrdParameters), offsetof(StdVideoH265HrdParameters, flags), offsetof(StdVideoH265HrdParameters, tick_divisor_minus2), offsetof(StdVideoH265HrdParameters, du_cpb_removal_delay_increment_length_minus1), offsetof(StdVideoH265HrdParameters, dpb_output_delay_du_length_minus1), offsetof(StdVideoH265HrdParameters, bit_rate_scale), offsetof(StdVideoH265HrdParameters, cpb_size_scale), offsetof(StdVideoH265HrdParameters, cpb_size_du_scale), offsetof(StdVideoH265HrdParameters, initial_cpb_removal_delay_length_minus1), offsetof(StdVideoH265HrdParameters, au_cpb_removal_delay_length_minus1), offsetof(StdVideoH265HrdParameters, dpb_output_delay_length_minus1), offsetof(StdVideoH265HrdParameters, cpb_cnt_minus1), offsetof(StdVideoH265HrdParameters, elemental_duration_in_tc_minus1), offsetof(StdVideoH265HrdParameters, reserved), offsetof(StdVideoH265HrdParameters, pSubLayerHrdParametersNal), offsetof(StdVideoH265HrdParameters, pSubLayerHrdParametersVcl)};
+// const size_t layout_StdVideoH265VpsFlags[] = {sizeof(StdVideoH265VpsFlags), _Alignof(StdVideoH265VpsFlags)};
+// const size_t layout_StdVideoH265ProfileTierLevelFlags[] = {sizeof(StdVideoH265ProfileTierLevelFlags), _Alignof(StdVideoH265ProfileTierLevelFlags)};
+// const size_t layout_StdVideoH265ProfileTierLevel[] = {sizeof(StdVideoH265ProfileTierLevel), _Alignof(StdVideoH265ProfileTierLevel), offsetof(StdVideoH265ProfileTierLevel, flags), offsetof(StdVideoH265ProfileTierLevel, general_profile_idc), offsetof(StdVideoH265ProfileTierLevel, general_level_idc)};
+// const size_t layout_StdVideoH265VideoParameterSet[] = {sizeof(StdVideoH265VideoParameterSet), _Alignof(StdVideoH265VideoParameterSet), offsetof(StdVideoH265VideoParameterSet, flags), offsetof(StdVideoH265VideoParameterSet, vps_video_parameter_set_id), offsetof(StdVideoH265VideoParameterSet, vps_max_sub_layers_minus1), offsetof(StdVideoH265VideoParameterSet, reserved1), offsetof(StdVideoH265VideoParameterSet, reserved2), offsetof(StdVideoH265VideoParameterSet, vps_num_units_in_tick), offsetof(StdVideoH265VideoParameterSet, vps_time_scale), offsetof(StdVideoH265VideoParameterSet, vps_num_ticks_poc_diff_one_minus1), offsetof(StdVideoH265VideoParameterSet, reserved3), offsetof(StdVideoH265VideoParameterSet, pDecPicBufMgr), offsetof(StdVideoH265VideoParameterSet, pHrdParameters), offsetof(StdVideoH265VideoParameterSet, pProfileTierLevel)};
+// const size_t layout_StdVideoH265ScalingLists[] = {sizeof(StdVideoH265ScalingLists), _Alignof(StdVideoH265ScalingLists), offsetof(StdVideoH265ScalingLists, ScalingList4x4), offsetof(StdVideoH265ScalingLists, ScalingList8x8), offsetof(StdVideoH265ScalingLists, ScalingList16x16), offsetof(StdVideoH265ScalingLists, ScalingList32x32), offsetof(StdVideoH265ScalingLists, ScalingListDCCoef16x16), offsetof(StdVideoH265ScalingLists, ScalingListDCCoef32x32)};
+// const size_t layout_StdVideoH265SpsVuiFlags[] = {sizeof(StdVideoH265SpsVuiFlags), _Alignof(StdVideoH265SpsVuiFlags)};
+// const size_t layout_StdVideoH265SequenceParameterSetVui[] = {sizeof(StdVideoH265SequenceParameterSetVui), _Alignof(StdVideoH265SequenceParameterSetVui), offsetof(StdVideoH265SequenceParameterSetVui, flags), offsetof(StdVideoH265SequenceParameterSetVui, aspect_ratio_idc), offsetof(StdVideoH265SequenceParameterSetVui, sar_width), offsetof(StdVideoH265SequenceParameterSetVui, sar_height), offsetof(StdVideoH265SequenceParameterSetVui, video_format), offsetof(StdVideoH265SequenceParameterSetVui, colour_primaries), offsetof(StdVideoH265SequenceParameterSetVui, transfer_characteristics), offsetof(StdVideoH265SequenceParameterSetVui, matrix_coeffs), offsetof(StdVideoH265SequenceParameterSetVui, chroma_sample_loc_type_top_field), offsetof(StdVideoH265SequenceParameterSetVui, chroma_sample_loc_type_bottom_field), offsetof(StdVideoH265SequenceParameterSetVui, reserved1), offsetof(StdVideoH265SequenceParameterSetVui, reserved2), offsetof(StdVideoH265SequenceParameterSetVui, def_disp_win_left_offset), offsetof(StdVideoH265SequenceParameterSetVui, def_disp_win_right_offset), offsetof(StdVideoH265SequenceParameterSetVui, def_disp_win_top_offset), offsetof(StdVideoH265SequenceParameterSetVui, def_disp_win_bottom_offset), offsetof(StdVideoH265SequenceParameterSetVui, vui_num_units_in_tick), offsetof(StdVideoH265SequenceParameterSetVui, vui_time_scale), offsetof(StdVideoH265SequenceParameterSetVui, vui_num_ticks_poc_diff_one_minus1), offsetof(StdVideoH265SequenceParameterSetVui, min_spatial_segmentation_idc), offsetof(StdVideoH265SequenceParameterSetVui, reserved3), offsetof(StdVideoH265SequenceParameterSetVui, max_bytes_per_pic_denom), offsetof(StdVideoH265SequenceParameterSetVui, max_bits_per_min_cu_denom), offsetof(StdVideoH265SequenceParameterSetVui, log2_max_mv_length_horizontal), offsetof(StdVideoH265SequenceParameterSetVui, log2_max_mv_length_vertical), offsetof(StdVideoH265SequenceParameterSetVui, pHrdParameters)};
+// const size_t layout_StdVideoH265PredictorPaletteEntries[] = {sizeof(StdVideoH265PredictorPaletteEntries), _Alignof(StdVideoH265PredictorPaletteEntries), offsetof(StdVideoH265PredictorPaletteEntries, PredictorPaletteEntries)};
+// const size_t layout_StdVideoH265SpsFlags[] = {sizeof(StdVideoH265SpsFlags), _Alignof(StdVideoH265SpsFlags)};
+// const size_t layout_StdVideoH265ShortTermRefPicSetFlags[] = {sizeof(StdVideoH265ShortTermRefPicSetFlags), _Alignof(StdVideoH265ShortTermRefPicSetFlags)};
+// const size_t layout_StdVideoH265ShortTermRefPicSet[] = {sizeof(StdVideoH265ShortTermRefPicSet), _Alignof(StdVideoH265ShortTermRefPicSet), offsetof(StdVideoH265ShortTermRefPicSet, flags), offsetof(StdVideoH265ShortTermRefPicSet, delta_idx_minus1), offsetof(StdVideoH265ShortTermRefPicSet, use_delta_flag), offsetof(StdVideoH265ShortTermRefPicSet, abs_delta_rps_minus1), offsetof(StdVideoH265ShortTermRefPicSet, used_by_curr_pic_flag), offsetof(StdVideoH265ShortTermRefPicSet, used_by_curr_pic_s0_flag), offsetof(StdVideoH265ShortTermRefPicSet, used_by_curr_pic_s1_flag), offsetof(StdVideoH265ShortTermRefPicSet, reserved1), offsetof(StdVideoH265ShortTermRefPicSet, reserved2), offsetof(StdVideoH265ShortTermRefPicSet, reserved3), offsetof(StdVideoH265ShortTermRefPicSet, num_negative_pics), offsetof(StdVideoH265ShortTermRefPicSet, num_positive_pics), offsetof(StdVideoH265ShortTermRefPicSet, delta_poc_s0_minus1), offsetof(StdVideoH265ShortTermRefPicSet, delta_poc_s1_minus1)};
+// const size_t layout_StdVideoH265LongTermRefPicsSps[] = {sizeof(StdVideoH265LongTermRefPicsSps), _Alignof(StdVideoH265LongTermRefPicsSps), offsetof(StdVideoH265LongTermRefPicsSps, used_by_curr_pic_lt_sps_flag), offsetof(StdVideoH265LongTermRefPicsSps, lt_ref_pic_poc_lsb_sps)};
+// const size_t layout_StdVideoH265SequenceParameterSet[] = {sizeof(StdVideoH265SequenceParameterSet), _Alignof(StdVideoH265SequenceParameterSet), offsetof(StdVideoH265SequenceParameterSet, flags), offsetof(StdVideoH265SequenceParameterSet, chroma_format_idc), offsetof(StdVideoH265SequenceParameterSet, pic_width_in_luma_samples), offsetof(StdVideoH265SequenceParameterSet, pic_height_in_luma_samples), offsetof(StdVideoH265SequenceParameterSet, sps_video_parameter_set_id), offsetof(StdVideoH265SequenceParameterSet, sps_max_sub_layers_minus1), offsetof(StdVideoH265SequenceParameterSet, sps_seq_parameter_set_id), offsetof(StdVideoH265SequenceParameterSet, bit_depth_luma_minus8), offsetof(StdVideoH265SequenceParameterSet, bit_depth_chroma_minus8), offsetof(StdVideoH265SequenceParameterSet, log2_max_pic_order_cnt_lsb_minus4), offsetof(StdVideoH265SequenceParameterSet, log2_min_luma_coding_block_size_minus3), offsetof(StdVideoH265SequenceParameterSet, log2_diff_max_min_luma_coding_block_size), offsetof(StdVideoH265SequenceParameterSet, log2_min_luma_transform_block_size_minus2), offsetof(StdVideoH265SequenceParameterSet, log2_diff_max_min_luma_transform_block_size), offsetof(StdVideoH265SequenceParameterSet, max_transform_hierarchy_depth_inter), offsetof(StdVideoH265SequenceParameterSet, max_transform_hierarchy_depth_intra), offsetof(StdVideoH265SequenceParameterSet, num_short_term_ref_pic_sets), offsetof(StdVideoH265SequenceParameterSet, num_long_term_ref_pics_sps), offsetof(StdVideoH265SequenceParameterSet, pcm_sample_bit_depth_luma_minus1), offsetof(StdVideoH265SequenceParameterSet, pcm_sample_bit_depth_chroma_minus1), offsetof(StdVideoH265SequenceParameterSet, log2_min_pcm_luma_coding_block_size_minus3), offsetof(StdVideoH265SequenceParameterSet, log2_diff_max_min_pcm_luma_coding_block_size), offsetof(StdVideoH265SequenceParameterSet, reserved1), offsetof(StdVideoH265SequenceParameterSet, reserved2), offsetof(StdVideoH265SequenceParameterSet, palette_max_size), offsetof(StdVideoH265SequenceParameterSet, delta_palette_max_predictor_size), offsetof(StdVideoH265SequenceParameterSet, motion_vector_resolution_control_idc), offsetof(StdVideoH265SequenceParameterSet, sps_num_palette_predictor_initializers_minus1), offsetof(StdVideoH265SequenceParameterSet, conf_win_left_offset), offsetof(StdVideoH265SequenceParameterSet, conf_win_right_offset), offsetof(StdVideoH265SequenceParameterSet, conf_win_top_offset), offsetof(StdVideoH265SequenceParameterSet, conf_win_bottom_offset), offsetof(StdVideoH265SequenceParameterSet, pProfileTierLevel), offsetof(StdVideoH265SequenceParameterSet, pDecPicBufMgr), offsetof(StdVideoH265SequenceParameterSet, pScalingLists), offsetof(StdVideoH265SequenceParameterSet, pShortTermRefPicSet), offsetof(StdVideoH265SequenceParameterSet, pLongTermRefPicsSps), offsetof(StdVideoH265SequenceParameterSet, pSequenceParameterSetVui), offsetof(StdVideoH265SequenceParameterSet, pPredictorPaletteEntries)};
+// const size_t layout_StdVideoH265PpsFlags[] = {sizeof(StdVideoH265PpsFlags), _Alignof(StdVideoH265PpsFlags)};
+// const size_t layout_StdVideoH265PictureParameterSet[] = {sizeof(StdVideoH265PictureParameterSet), _Alignof(StdVideoH265PictureParameterSet), offsetof(StdVideoH265PictureParameterSet, flags), offsetof(StdVideoH265PictureParameterSet, pps_pic_parameter_set_id), offsetof(StdVideoH265PictureParameterSet, pps_seq_parameter_set_id), offsetof(StdVideoH265PictureParameterSet, sps_video_parameter_set_id), offsetof(StdVideoH265PictureParameterSet, num_extra_slice_header_bits), offsetof(StdVideoH265PictureParameterSet, num_ref_idx_l0_default_active_minus1), offsetof(StdVideoH265PictureParameterSet, num_ref_idx_l1_default_active_minus1), offsetof(StdVideoH265PictureParameterSet, init_qp_minus26), offsetof(StdVideoH265PictureParameterSet, diff_cu_qp_delta_depth), offsetof(StdVideoH265PictureParameterSet, pps_cb_qp_offset), offsetof(StdVideoH265PictureParameterSet, pps_cr_qp_offset), offsetof(StdVideoH265PictureParameterSet, pps_beta_offset_div2), offsetof(StdVideoH265PictureParameterSet, pps_tc_offset_div2), offsetof(StdVideoH265PictureParameterSet, log2_parallel_merge_level_minus2), offsetof(StdVideoH265PictureParameterSet, log2_max_transform_skip_block_size_minus2), offsetof(StdVideoH265PictureParameterSet, diff_cu_chroma_qp_offset_depth), offsetof(StdVideoH265PictureParameterSet, chroma_qp_offset_list_len_minus1), offsetof(StdVideoH265PictureParameterSet, cb_qp_offset_list), offsetof(StdVideoH265PictureParameterSet, cr_qp_offset_list), offsetof(StdVideoH265PictureParameterSet, log2_sao_offset_scale_luma), offsetof(StdVideoH265PictureParameterSet, log2_sao_offset_scale_chroma), offsetof(StdVideoH265PictureParameterSet, pps_act_y_qp_offset_plus5), offsetof(StdVideoH265PictureParameterSet, pps_act_cb_qp_offset_plus5), offsetof(StdVideoH265PictureParameterSet, pps_act_cr_qp_offset_plus3), offsetof(StdVideoH265PictureParameterSet, pps_num_palette_predictor_initializers), offsetof(StdVideoH265PictureParameterSet, luma_bit_depth_entry_minus8), offsetof(StdVideoH265PictureParameterSet, chroma_bit_depth_entry_minus8), offsetof(StdVideoH265PictureParameterSet, num_tile_columns_minus1), offsetof(StdVideoH265PictureParameterSet, num_tile_rows_minus1), offsetof(StdVideoH265PictureParameterSet, reserved1), offsetof(StdVideoH265PictureParameterSet, reserved2), offsetof(StdVideoH265PictureParameterSet, column_width_minus1), offsetof(StdVideoH265PictureParameterSet, row_height_minus1), offsetof(StdVideoH265PictureParameterSet, reserved3), offsetof(StdVideoH265PictureParameterSet, pScalingLists), offsetof(StdVideoH265PictureParameterSet, pPredictorPaletteEntries)};
+// const size_t layout_VkVideoDecodeH265ProfileInfoKHR[] = {sizeof(VkVideoDecodeH265ProfileInfoKHR), _Alignof(VkVideoDecodeH265ProfileInfoKHR), offsetof(VkVideoDecodeH265ProfileInfoKHR, sType), offsetof(VkVideoDecodeH265ProfileInfoKHR, pNext), offsetof(VkVideoDecodeH265ProfileInfoKHR, stdProfileIdc)};
+// const size_t layout_VkVideoDecodeH265CapabilitiesKHR[] = {sizeof(VkVideoDecodeH265CapabilitiesKHR), _Alignof(VkVideoDecodeH265CapabilitiesKHR), offsetof(VkVideoDecodeH265CapabilitiesKHR, sType), offsetof(VkVideoDecodeH265CapabilitiesKHR, pNext), offsetof(VkVideoDecodeH265CapabilitiesKHR, maxLevelIdc)};
+// const size_t layout_VkVideoDecodeH265SessionParametersAddInfoKHR[] = {sizeof(VkVideoDecodeH265SessionParametersAddInfoKHR), _Alignof(VkVideoDecodeH265SessionParametersAddInfoKHR), offsetof(VkVideoDecodeH265SessionParametersAddInfoKHR, sType), offsetof(VkVideoDecodeH265SessionParametersAddInfoKHR, pNext), offsetof(VkVideoDecodeH265SessionParametersAddInfoKHR, stdVPSCount), offsetof(VkVideoDecodeH265SessionParametersAddInfoKHR, pStdVPSs), offsetof(VkVideoDecodeH265SessionParametersAddInfoKHR, stdSPSCount), offsetof(VkVideoDecodeH265SessionParametersAddInfoKHR, pStdSPSs), offsetof(VkVideoDecodeH265SessionParametersAddInfoKHR, stdPPSCount), offsetof(VkVideoDecodeH265SessionParametersAddInfoKHR, pStdPPSs)};
+// const size_t layout_VkVideoDecodeH265SessionParametersCreateInfoKHR[] = {sizeof(VkVideoDecodeH265SessionParametersCreateInfoKHR), _Alignof(VkVideoDecodeH265SessionParametersCreateInfoKHR), offsetof(VkVideoDecodeH265SessionParametersCreateInfoKHR, sType), offsetof(VkVideoDecodeH265SessionParametersCreateInfoKHR, pNext), offsetof(VkVideoDecodeH265SessionParametersCreateInfoKHR, maxStdVPSCount), offsetof(VkVideoDecodeH265SessionParametersCreateInfoKHR, maxStdSPSCount), offsetof(VkVideoDecodeH265SessionParametersCreateInfoKHR, maxStdPPSCount), offsetof(VkVideoDecodeH265SessionParametersCreateInfoKHR, pParametersAddInfo)};
+// const size_t layout_StdVideoDecodeH265PictureInfoFlags[] = {sizeof(StdVideoDecodeH265PictureInfoFlags), _Alignof(StdVideoDecodeH265PictureInfoFlags)};
+// const size_t layout_StdVideoDecodeH265PictureInfo[] = {sizeof(StdVideoDecodeH265PictureInfo), _Alignof(StdVideoDecodeH265PictureInfo), offsetof(StdVideoDecodeH265PictureInfo, flags), offsetof(StdVideoDecodeH265PictureInfo, sps_video_parameter_set_id), offsetof(StdVideoDecodeH265PictureInfo, pps_seq_parameter_set_id), offsetof(StdVideoDecodeH265PictureInfo, pps_pic_parameter_set_id), offsetof(StdVideoDecodeH265PictureInfo, NumDeltaPocsOfRefRpsIdx), offsetof(StdVideoDecodeH265PictureInfo, PicOrderCntVal), offsetof(StdVideoDecodeH265PictureInfo, NumBitsForSTRefPicSetInSlice), offsetof(StdVideoDecodeH265PictureInfo, reserved), offsetof(StdVideoDecodeH265PictureInfo, RefPicSetStCurrBefore), offsetof(StdVideoDecodeH265PictureInfo, RefPicSetStCurrAfter), offsetof(StdVideoDecodeH265PictureInfo, RefPicSetLtCurr)};
+// const size_t layout_StdVideoDecodeH265ReferenceInfoFlags[] = {sizeof(StdVideoDecodeH265ReferenceInfoFlags), _Alignof(StdVideoDecodeH265ReferenceInfoFlags)};
+// const size_t layout_StdVideoDecodeH265ReferenceInfo[] = {sizeof(StdVideoDecodeH265ReferenceInfo), _Alignof(StdVideoDecodeH265ReferenceInfo), offsetof(StdVideoDecodeH265ReferenceInfo, flags), offsetof(StdVideoDecodeH265ReferenceInfo, PicOrderCntVal)};
+// const size_t layout_VkVideoDecodeH265PictureInfoKHR[] = {sizeof(VkVideoDecodeH265PictureInfoKHR), _Alignof(VkVideoDecodeH265PictureInfoKHR), offsetof(VkVideoDecodeH265PictureInfoKHR, sType), offsetof(VkVideoDecodeH265PictureInfoKHR, pNext), offsetof(VkVideoDecodeH265PictureInfoKHR, pStdPictureInfo), offsetof(VkVideoDecodeH265PictureInfoKHR, sliceSegmentCount), offsetof(VkVideoDecodeH265PictureInfoKHR, pSliceSegmentOffsets)};
+// const size_t layout_VkVideoDecodeH265DpbSlotInfoKHR[] = {sizeof(VkVideoDecodeH265DpbSlotInfoKHR), _Alignof(VkVideoDecodeH265DpbSlotInfoKHR), offsetof(VkVideoDecodeH265DpbSlotInfoKHR, sType), offsetof(VkVideoDecodeH265DpbSlotInfoKHR, pNext), offsetof(VkVideoDecodeH265DpbSlotInfoKHR, pStdReferenceInfo)};
+// const size_t layout_VkDeviceQueueGlobalPriorityCreateInfoKHR[] = {sizeof(VkDeviceQueueGlobalPriorityCreateInfoKHR), _Alignof(VkDeviceQueueGlobalPriorityCreateInfoKHR), offsetof(VkDeviceQueueGlobalPriorityCreateInfoKHR, sType), offsetof(VkDeviceQueueGlobalPriorityCreateInfoKHR, pNext), offsetof(VkDeviceQueueGlobalPriorityCreateInfoKHR, globalPriority)};
+// const size_t layout_VkPhysicalDeviceGlobalPriorityQueryFeaturesKHR[] = {sizeof(VkPhysicalDeviceGlobalPriorityQueryFeaturesKHR), _Alignof(VkPhysicalDeviceGlobalPriorityQueryFeaturesKHR), offsetof(VkPhysicalDeviceGlobalPriorityQueryFeaturesKHR, sType), offsetof(VkPhysicalDeviceGlobalPriorityQueryFeaturesKHR, pNext), offsetof(VkPhysicalDeviceGlobalPriorityQueryFeaturesKHR, globalPriorityQuery)};
+// const size_t layout_VkQueueFamilyGlobalPriorityPropertiesKHR[] = {sizeof(VkQueueFamilyGlobalPriorityPropertiesKHR), _Alignof(VkQueueFamilyGlobalPriorityPropertiesKHR), offsetof(VkQueueFamilyGlobalPriorityPropertiesKHR, sType), offsetof(VkQueueFamilyGlobalPriorityPropertiesKHR, pNext), offsetof(VkQueueFamilyGlobalPriorityPropertiesKHR, priorityCount), offsetof(VkQueueFamilyGlobalPriorityPropertiesKHR, priorities)};
+// const size_t layout_VkFragmentShadingRateAttachmentInfoKHR[] = {sizeof(VkFragmentShadingRateAttachmentInfoKHR), _Alignof(VkFragmentShadingRateAttachmentInfoKHR), offsetof(VkFragmentShadingRateAttachmentInfoKHR, sType), offsetof(VkFragmentShadingRateAttachmentInfoKHR, pNext), offsetof(VkFragmentShadingRateAttachmentInfoKHR, pFragmentShadingRateAttachment), offsetof(VkFragmentShadingRateAttachmentInfoKHR, shadingRateAttachmentTexelSize)};
+// const size_t layout_VkPipelineFragmentShadingRateStateCreateInfoKHR[] = {sizeof(VkPipelineFragmentShadingRateStateCreateInfoKHR), _Alignof(VkPipelineFragmentShadingRateStateCreateInfoKHR), offsetof(VkPipelineFragmentShadingRateStateCreateInfoKHR, sType), offsetof(VkPipelineFragmentShadingRateStateCreateInfoKHR, pNext), offsetof(VkPipelineFragmentShadingRateStateCreateInfoKHR, fragmentSize), offsetof(VkPipelineFragmentShadingRateStateCreateInfoKHR, combinerOps)};
+// const size_t layout_VkPhysicalDeviceFragmentShadingRateFeaturesKHR[] = {sizeof(VkPhysicalDeviceFragmentShadingRateFeaturesKHR), _Alignof(VkPhysicalDeviceFragmentShadingRateFeaturesKHR), offsetof(VkPhysicalDeviceFragmentShadingRateFeaturesKHR, sType), offsetof(VkPhysicalDeviceFragmentShadingRateFeaturesKHR, pNext), offsetof(VkPhysicalDeviceFragmentShadingRateFeaturesKHR, pipelineFragmentShadingRate), offsetof(VkPhysicalDeviceFragmentShadingRateFeaturesKHR, primitiveFragmentShadingRate), offsetof(VkPhysicalDeviceFragmentShadingRateFeaturesKHR, attachmentFragmentShadingRate)};
+// const size_t layout_VkPhysicalDeviceFragmentShadingRatePropertiesKHR[] = {sizeof(VkPhysicalDeviceFragmentShadingRatePropertiesKHR), _Alignof(VkPhysicalDeviceFragmentShadingRatePropertiesKHR), offsetof(VkPhysicalDeviceFragmentShadingRatePropertiesKHR, sType), offsetof(VkPhysicalDeviceFragmentShadingRatePropertiesKHR, pNext), offsetof(VkPhysicalDeviceFragmentShadingRatePropertiesKHR, minFragmentShadingRateAttachmentTexelSize), offsetof(VkPhysicalDeviceFragmentShadingRatePropertiesKHR, maxFragmentShadingRateAttachmentTexelSize), offsetof(VkPhysicalDeviceFragmentShadingRatePropertiesKHR, maxFragmentShadingRateAttachmentTexelSizeAspectRatio), offsetof(VkPhysicalDeviceFragmentShadingRatePropertiesKHR, primitiveFragmentShadingRateWithMultipleViewports), offsetof(VkPhysicalDeviceFragmentShadingRatePropertiesKHR, layeredShadingRateAttachments), offsetof(VkPhysicalDeviceFragmentShadingRatePropertiesKHR, fragmentShadingRateNonTrivialCombinerOps), offsetof(VkPhysicalDeviceFragmentShadingRatePropertiesKHR, maxFragmentSize), offsetof(VkPhysicalDeviceFragmentShadingRatePropertiesKHR, maxFragmentSizeAspectRatio), offsetof(VkPhysicalDeviceFragmentShadingRatePropertiesKHR, maxFragmentShadingRateCoverageSamples), offsetof(VkPhysicalDeviceFragmentShadingRatePropertiesKHR, maxFragmentShadingRateRasterizationSamples), offsetof(VkPhysicalDeviceFragmentShadingRatePropertiesKHR, fragmentShadingRateWithShaderDepthStencilWrites), offsetof(VkPhysicalDeviceFragmentShadingRatePropertiesKHR, fragmentShadingRateWithSampleMask), offsetof(VkPhysicalDeviceFragmentShadingRatePropertiesKHR, fragmentShadingRateWithShaderSampleMask), offsetof(VkPhysicalDeviceFragmentShadingRatePropertiesKHR, fragmentShadingRateWithConservativeRasterization), offsetof(VkPhysicalDeviceFragmentShadingRatePropertiesKHR, fragmentShadingRateWithFragmentShaderInterlock), offsetof(VkPhysicalDeviceFragmentShadingRatePropertiesKHR, fragmentShadingRateWithCustomSampleLocations), offsetof(VkPhysicalDeviceFragmentShadingRatePropertiesKHR, fragmentShadingRateStrictMultiplyCombiner)};
+// const size_t layout_VkPhysicalDeviceFragmentShadingRateKHR[] = {sizeof(VkPhysicalDeviceFragmentShadingRateKHR), _Alignof(VkPhysicalDeviceFragmentShadingRateKHR), offsetof(VkPhysicalDeviceFragmentShadingRateKHR, sType), offsetof(VkPhysicalDeviceFragmentShadingRateKHR, pNext), offsetof(VkPhysicalDeviceFragmentShadingRateKHR, sampleCounts), offsetof(VkPhysicalDeviceFragmentShadingRateKHR, fragmentSize)};
+// const size_t layout_VkSurfaceProtectedCapabilitiesKHR[] = {sizeof(VkSurfaceProtectedCapabilitiesKHR), _Alignof(VkSurfaceProtectedCapabilitiesKHR), offsetof(VkSurfaceProtectedCapabilitiesKHR, sType), offsetof(VkSurfaceProtectedCapabilitiesKHR, pNext), offsetof(VkSurfaceProtectedCapabilitiesKHR, supportsProtected)};
+// const size_t layout_VkPhysicalDevicePresentWaitFeaturesKHR[] = {sizeof(VkPhysicalDevicePresentWaitFeaturesKHR), _Alignof(VkPhysicalDevicePresentWaitFeaturesKHR), offsetof(VkPhysicalDevicePresentWaitFeaturesKHR, sType), offsetof(VkPhysicalDevicePresentWaitFeaturesKHR, pNext), offsetof(VkPhysicalDevicePresentWaitFeaturesKHR, presentWait)};
+// const size_t layout_VkPhysicalDevicePipelineExecutablePropertiesFeaturesKHR[] = {sizeof(VkPhysicalDevicePipelineExecutablePropertiesFeaturesKHR), _Alignof(VkPhysicalDevicePipelineExecutablePropertiesFeaturesKHR), offsetof(VkPhysicalDevicePipelineExecutablePropertiesFeaturesKHR, sType), offsetof(VkPhysicalDevicePipelineExecutablePropertiesFeaturesKHR, pNext), offsetof(VkPhysicalDevicePipelineExecutablePropertiesFeaturesKHR, pipelineExecutableInfo)};
+// const size_t layout_VkPipelineInfoKHR[] = {sizeof(VkPipelineInfoKHR), _Alignof(VkPipelineInfoKHR), offsetof(VkPipelineInfoKHR, sType), offsetof(VkPipelineInfoKHR, pNext), offsetof(VkPipelineInfoKHR, pipeline)};
+// const size_t layout_VkPipelineExecutablePropertiesKHR[] = {sizeof(VkPipelineExecutablePropertiesKHR), _Alignof(VkPipelineExecutablePropertiesKHR), offsetof(VkPipelineExecutablePropertiesKHR, sType), offsetof(VkPipelineExecutablePropertiesKHR, pNext), offsetof(VkPipelineExecutablePropertiesKHR, stages), offsetof(VkPipelineExecutablePropertiesKHR, name), offsetof(VkPipelineExecutablePropertiesKHR, description), offsetof(VkPipelineExecutablePropertiesKHR, subgroupSize)};
+// const size_t layout_VkPipelineExecutableInfoKHR[] = {sizeof(VkPipelineExecutableInfoKHR), _Alignof(VkPipelineExecutableInfoKHR), offsetof(VkPipelineExecutableInfoKHR, sType), offsetof(VkPipelineExecutableInfoKHR, pNext), offsetof(VkPipelineExecutableInfoKHR, pipeline), offsetof(VkPipelineExecutableInfoKHR, executableIndex)};
+// const size_t layout_VkPipelineExecutableStatisticValueKHR[] = {sizeof(VkPipelineExecutableStatisticValueKHR), _Alignof(VkPipelineExecutableStatisticValueKHR)};
+// const size_t layout_VkPipelineExecutableStatisticKHR[] = {sizeof(VkPipelineExecutableStatisticKHR), _Alignof(VkPipelineExecutableStatisticKHR), offsetof(VkPipelineExecutableStatisticKHR, sType), offsetof(VkPipelineExecutableStatisticKHR, pNext), offsetof(VkPipelineExecutableStatisticKHR, name), offsetof(VkPipelineExecutableStatisticKHR, description), offsetof(VkPipelineExecutableStatisticKHR, format), offsetof(VkPipelineExecutableStatisticKHR, value)};
+// const size_t layout_VkPipelineExecutableInternalRepresentationKHR[] = {sizeof(VkPipelineExecutableInternalRepresentationKHR), _Alignof(VkPipelineExecutableInternalRepresentationKHR), offsetof(VkPipelineExecutableInternalRepresentationKHR, sType), offsetof(VkPipelineExecutableInternalRepresentationKHR, pNext), offsetof(VkPipelineExecutableInternalRepresentationKHR, name), offsetof(VkPipelineExecutableInternalRepresentationKHR, description), offsetof(VkPipelineExecutableInternalRepresentationKHR, isText), offsetof(VkPipelineExecutableInternalRepresentationKHR, dataSize), offsetof(VkPipelineExecutableInternalRepresentationKHR, pData)};
+// const size_t layout_VkPipelineLibraryCreateInfoKHR[] = {sizeof(VkPipelineLibraryCreateInfoKHR), _Alignof(VkPipelineLibraryCreateInfoKHR), offsetof(VkPipelineLibraryCreateInfoKHR, sType), offsetof(VkPipelineLibraryCreateInfoKHR, pNext), offsetof(VkPipelineLibraryCreateInfoKHR, libraryCount), offsetof(VkPipelineLibraryCreateInfoKHR, pLibraries)};
+// const size_t layout_VkPresentIdKHR[] = {sizeof(VkPresentIdKHR), _Alignof(VkPresentIdKHR), offsetof(VkPresentIdKHR, sType), offsetof(VkPresentIdKHR, pNext), offsetof(VkPresentIdKHR, swapchainCount), offsetof(VkPresentIdKHR, pPresentIds)};
+// const size_t layout_VkPhysicalDevicePresentIdFeaturesKHR[] = {sizeof(VkPhysicalDevicePresentIdFeaturesKHR), _Alignof(VkPhysicalDevicePresentIdFeaturesKHR), offsetof(VkPhysicalDevicePresentIdFeaturesKHR, sType), offsetof(VkPhysicalDevicePresentIdFeaturesKHR, pNext), offsetof(VkPhysicalDevicePresentIdFeaturesKHR, presentId)};
+// const size_t layout_VkQueueFamilyCheckpointProperties2NV[] = {sizeof(VkQueueFamilyCheckpointProperties2NV), _Alignof(VkQueueFamilyCheckpointProperties2NV), offsetof(VkQueueFamilyCheckpointProperties2NV, sType), offsetof(VkQueueFamilyCheckpointProperties2NV, pNext), offsetof(VkQueueFamilyCheckpointProperties2NV, checkpointExecutionStageMask)};
+// const size_t layout_VkCheckpointData2NV[] = {sizeof(VkCheckpointData2NV), _Alignof(VkCheckpointData2NV), offsetof(VkCheckpointData2NV, sType), offsetof(VkCheckpointData2NV, pNext), offsetof(VkCheckpointData2NV, stage), offsetof(VkCheckpointData2NV, pCheckpointMarker)};
+// const size_t layout_VkPhysicalDeviceFragmentShaderBarycentricFeaturesKHR[] = {sizeof(VkPhysicalDeviceFragmentShaderBarycentricFeaturesKHR), _Alignof(VkPhysicalDeviceFragmentShaderBarycentricFeaturesKHR), offsetof(VkPhysicalDeviceFragmentShaderBarycentricFeaturesKHR, sType), offsetof(VkPhysicalDeviceFragmentShaderBarycentricFeaturesKHR, pNext), offsetof(VkPhysicalDeviceFragmentShaderBarycentricFeaturesKHR, fragmentShaderBarycentric)};
+// const size_t layout_VkPhysicalDeviceFragmentShaderBarycentricPropertiesKHR[] = {sizeof(VkPhysicalDeviceFragmentShaderBarycentricPropertiesKHR), _Alignof(VkPhysicalDeviceFragmentShaderBarycentricPropertiesKHR), offsetof(VkPhysicalDeviceFragmentShaderBarycentricPropertiesKHR, sType), offsetof(VkPhysicalDeviceFragmentShaderBarycentricPropertiesKHR, pNext), offsetof(VkPhysicalDeviceFragmentShaderBarycentricPropertiesKHR, triStripVertexOrderIndependentOfProvokingVertex)};
+// const size_t layout_VkPhysicalDeviceShaderSubgroupUniformControlFlowFeaturesKHR[] = {sizeof(VkPhysicalDeviceShaderSubgroupUniformControlFlowFeaturesKHR), _Alignof(VkPhysicalDeviceShaderSubgroupUniformControlFlowFeaturesKHR), offsetof(VkPhysicalDeviceShaderSubgroupUniformControlFlowFeaturesKHR, sType), offsetof(VkPhysicalDeviceShaderSubgroupUniformControlFlowFeaturesKHR, pNext), offsetof(VkPhysicalDeviceShaderSubgroupUniformControlFlowFeaturesKHR, shaderSubgroupUniformControlFlow)};
+// const size_t layout_VkPhysicalDeviceWorkgroupMemoryExplicitLayoutFeaturesKHR[] = {sizeof(VkPhysicalDeviceWorkgroupMemoryExplicitLayoutFeaturesKHR), _Alignof(VkPhysicalDeviceWorkgroupMemoryExplicitLayoutFeaturesKHR), offsetof(VkPhysicalDeviceWorkgroupMemoryExplicitLayoutFeaturesKHR, sType), offsetof(VkPhysicalDeviceWorkgroupMemoryExplicitLayoutFeaturesKHR, pNext), offsetof(VkPhysicalDeviceWorkgroupMemoryExplicitLayoutFeaturesKHR, workgroupMemoryExplicitLayout), offsetof(VkPhysicalDeviceWorkgroupMemoryExplicitLayoutFeaturesKHR, workgroupMemoryExplicitLayoutScalarBlockLayout), offsetof(VkPhysicalDeviceWorkgroupMemoryExplicitLayoutFeaturesKHR, workgroupMemoryExplicitLayout8BitAccess), offsetof(VkPhysicalDeviceWorkgroupMemoryExplicitLayoutFeaturesKHR, workgroupMemoryExplicitLayout16BitAccess)};
+// const size_t layout_VkRayTracingShaderGroupCreateInfoKHR[] = {sizeof(VkRayTracingShaderGroupCreateInfoKHR), _Alignof(VkRayTracingShaderGroupCreateInfoKHR), offsetof(VkRayTracingShaderGroupCreateInfoKHR, sType), offsetof(VkRayTracingShaderGroupCreateInfoKHR, pNext), offsetof(VkRayTracingShaderGroupCreateInfoKHR, type), offsetof(VkRayTracingShaderGroupCreateInfoKHR, generalShader), offsetof(VkRayTracingShaderGroupCreateInfoKHR, closestHitShader), offsetof(VkRayTracingShaderGroupCreateInfoKHR, anyHitShader), offsetof(VkRayTracingShaderGroupCreateInfoKHR, intersectionShader), offsetof(VkRayTracingShaderGroupCreateInfoKHR, pShaderGroupCaptureReplayHandle)};
+// const size_t layout_VkRayTracingPipelineInterfaceCreateInfoKHR[] = {sizeof(VkRayTracingPipelineInterfaceCreateInfoKHR), _Alignof(VkRayTracingPipelineInterfaceCreateInfoKHR), offsetof(VkRayTracingPipelineInterfaceCreateInfoKHR, sType), offsetof(VkRayTracingPipelineInterfaceCreateInfoKHR, pNext), offsetof(VkRayTracingPipelineInterfaceCreateInfoKHR, maxPipelineRayPayloadSize), offsetof(VkRayTracingPipelineInterfaceCreateInfoKHR, maxPipelineRayHitAttributeSize)};
+// const size_t layout_VkRayTracingPipelineCreateInfoKHR[] = {sizeof(VkRayTracingPipelineCreateInfoKHR), _Alignof(VkRayTracingPipelineCreateInfoKHR), offsetof(VkRayTracingPipelineCreateInfoKHR, sType), offsetof(VkRayTracingPipelineCreateInfoKHR, pNext), offsetof(VkRayTracingPipelineCreateInfoKHR, flags), offsetof(VkRayTracingPipelineCreateInfoKHR, stageCount), offsetof(VkRayTracingPipelineCreateInfoKHR, pStages), offsetof(VkRayTracingPipelineCreateInfoKHR, groupCount), offsetof(VkRayTracingPipelineCreateInfoKHR, pGroups), offsetof(VkRayTracingPipelineCreateInfoKHR, maxPipelineRayRecursionDepth), offsetof(VkRayTracingPipelineCreateInfoKHR, pLibraryInfo), offsetof(VkRayTracingPipelineCreateInfoKHR, pLibraryInterface), offsetof(VkRayTracingPipelineCreateInfoKHR, pDynamicState), offsetof(VkRayTracingPipelineCreateInfoKHR, layout), offsetof(VkRayTracingPipelineCreateInfoKHR, basePipelineHandle), offsetof(VkRayTracingPipelineCreateInfoKHR, basePipelineIndex)};
+// const size_t layout_VkPhysicalDeviceRayTracingPipelineFeaturesKHR[] = {sizeof(VkPhysicalDeviceRayTracingPipelineFeaturesKHR), _Alignof(VkPhysicalDeviceRayTracingPipelineFeaturesKHR), offsetof(VkPhysicalDeviceRayTracingPipelineFeaturesKHR, sType), offsetof(VkPhysicalDeviceRayTracingPipelineFeaturesKHR, pNext), offsetof(VkPhysicalDeviceRayTracingPipelineFeaturesKHR, rayTracingPipeline), offsetof(VkPhysicalDeviceRayTracingPipelineFeaturesKHR, rayTracingPipelineShaderGroupHandleCaptureReplay), offsetof(VkPhysicalDeviceRayTracingPipelineFeaturesKHR, rayTracingPipelineShaderGroupHandleCaptureReplayMixed), offsetof(VkPhysicalDeviceRayTracingPipelineFeaturesKHR, rayTracingPipelineTraceRaysIndirect), offsetof(VkPhysicalDeviceRayTracingPipelineFeaturesKHR, rayTraversalPrimitiveCulling)};
+// const size_t layout_VkPhysicalDeviceRayTracingPipelinePropertiesKHR[] = {sizeof(VkPhysicalDeviceRayTracingPipelinePropertiesKHR), _Alignof(VkPhysicalDeviceRayTracingPipelinePropertiesKHR), offsetof(VkPhysicalDeviceRayTracingPipelinePropertiesKHR, sType), offsetof(VkPhysicalDeviceRayTracingPipelinePropertiesKHR, pNext), offsetof(VkPhysicalDeviceRayTracingPipelinePropertiesKHR, shaderGroupHandleSize), offsetof(VkPhysicalDeviceRayTracingPipelinePropertiesKHR, maxRayRecursionDepth), offsetof(VkPhysicalDeviceRayTracingPipelinePropertiesKHR, maxShaderGroupStride), offsetof(VkPhysicalDeviceRayTracingPipelinePropertiesKHR, shaderGroupBaseAlignment), offsetof(VkPhysicalDeviceRayTracingPipelinePropertiesKHR, shaderGroupHandleCaptureReplaySize), offsetof(VkPhysicalDeviceRayTracingPipelinePropertiesKHR, maxRayDispatchInvocationCount), offsetof(VkPhysicalDeviceRayTracingPipelinePropertiesKHR, shaderGroupHandleAlignment), offsetof(VkPhysicalDeviceRayTracingPipelinePropertiesKHR, maxRayHitAttributeSize)};
+// const size_t layout_VkStridedDeviceAddressRegionKHR[] = {sizeof(VkStridedDeviceAddressRegionKHR), _Alignof(VkStridedDeviceAddressRegionKHR), offsetof(VkStridedDeviceAddressRegionKHR, deviceAddress), offsetof(VkStridedDeviceAddressRegionKHR, stride), offsetof(VkStridedDeviceAddressRegionKHR, size)};
+// const size_t layout_VkTraceRaysIndirectCommandKHR[] = {sizeof(VkTraceRaysIndirectCommandKHR), _Alignof(VkTraceRaysIndirectCommandKHR), offsetof(VkTraceRaysIndirectCommandKHR, width), offsetof(VkTraceRaysIndirectCommandKHR, height), offsetof(VkTraceRaysIndirectCommandKHR, depth)};
+// const size_t layout_VkPhysicalDeviceRayQueryFeaturesKHR[] = {sizeof(VkPhysicalDeviceRayQueryFeaturesKHR), _Alignof(VkPhysicalDeviceRayQueryFeaturesKHR), offsetof(VkPhysicalDeviceRayQueryFeaturesKHR, sType), offsetof(VkPhysicalDeviceRayQueryFeaturesKHR, pNext), offsetof(VkPhysicalDeviceRayQueryFeaturesKHR, rayQuery)};
+// const size_t layout_VkPhysicalDeviceRayTracingMaintenance1FeaturesKHR[] = {sizeof(VkPhysicalDeviceRayTracingMaintenance1FeaturesKHR), _Alignof(VkPhysicalDeviceRayTracingMaintenance1FeaturesKHR), offsetof(VkPhysicalDeviceRayTracingMaintenance1FeaturesKHR, sType), offsetof(VkPhysicalDeviceRayTracingMaintenance1FeaturesKHR, pNext), offsetof(VkPhysicalDeviceRayTracingMaintenance1FeaturesKHR, rayTracingMaintenance1), offsetof(VkPhysicalDeviceRayTracingMaintenance1FeaturesKHR, rayTracingPipelineTraceRaysIndirect2)};
+// const size_t layout_VkTraceRaysIndirectCommand2KHR[] = {sizeof(VkTraceRaysIndirectCommand2KHR), _Alignof(VkTraceRaysIndirectCommand2KHR), offsetof(VkTraceRaysIndirectCommand2KHR, raygenShaderRecordAddress), offsetof(VkTraceRaysIndirectCommand2KHR, raygenShaderRecordSize), offsetof(VkTraceRaysIndirectCommand2KHR, missShaderBindingTableAddress), offsetof(VkTraceRaysIndirectCommand2KHR, missShaderBindingTableSize), offsetof(VkTraceRaysIndirectCommand2KHR, missShaderBindingTableStride), offsetof(VkTraceRaysIndirectCommand2KHR, hitShaderBindingTableAddress), offsetof(VkTraceRaysIndirectCommand2KHR, hitShaderBindingTableSize), offsetof(VkTraceRaysIndirectCommand2KHR, hitShaderBindingTableStride), offsetof(VkTraceRaysIndirectCommand2KHR, callableShaderBindingTableAddress), offsetof(VkTraceRaysIndirectCommand2KHR, callableShaderBindingTableSize), offsetof(VkTraceRaysIndirectCommand2KHR, callableShaderBindingTableStride), offsetof(VkTraceRaysIndirectCommand2KHR, width), offsetof(VkTraceRaysIndirectCommand2KHR, height), offsetof(VkTraceRaysIndirectCommand2KHR, depth)};
+// const size_t layout_VkDebugReportCallbackCreateInfoEXT[] = {sizeof(VkDebugReportCallbackCreateInfoEXT), _Alignof(VkDebugReportCallbackCreateInfoEXT), offsetof(VkDebugReportCallbackCreateInfoEXT, sType), offsetof(VkDebugReportCallbackCreateInfoEXT, pNext), offsetof(VkDebugReportCallbackCreateInfoEXT, flags), offsetof(VkDebugReportCallbackCreateInfoEXT, pfnCallback), offsetof(VkDebugReportCallbackCreateInfoEXT, pUserData)};
+// const size_t layout_VkPipelineRasterizationStateRasterizationOrderAMD[] = {sizeof(VkPipelineRasterizationStateRasterizationOrderAMD), _Alignof(VkPipelineRasterizationStateRasterizationOrderAMD), offsetof(VkPipelineRasterizationStateRasterizationOrderAMD, sType), offsetof(VkPipelineRasterizationStateRasterizationOrderAMD, pNext), offsetof(VkPipelineRasterizationStateRasterizationOrderAMD, rasterizationOrder)};
+// const size_t layout_VkDebugMarkerObjectNameInfoEXT[] = {sizeof(VkDebugMarkerObjectNameInfoEXT), _Alignof(VkDebugMarkerObjectNameInfoEXT), offsetof(VkDebugMarkerObjectNameInfoEXT, sType), offsetof(VkDebugMarkerObjectNameInfoEXT, pNext), offsetof(VkDebugMarkerObjectNameInfoEXT, objectType), offsetof(VkDebugMarkerObjectNameInfoEXT, object), offsetof(VkDebugMarkerObjectNameInfoEXT, pObjectName)};
+// const size_t layout_VkDebugMarkerObjectTagInfoEXT[] = {sizeof(VkDebugMarkerObjectTagInfoEXT), _Alignof(VkDebugMarkerObjectTagInfoEXT), offsetof(VkDebugMarkerObjectTagInfoEXT, sType), offsetof(VkDebugMarkerObjectTagInfoEXT, pNext), offsetof(VkDebugMarkerObjectTagInfoEXT, objectType), offsetof(VkDebugMarkerObjectTagInfoEXT, object), offsetof(VkDebugMarkerObjectTagInfoEXT, tagName), offsetof(VkDebugMarkerObjectTagInfoEXT, tagSize), offsetof(VkDebugMarkerObjectTagInfoEXT, pTag)};
+// const size_t layout_VkDebugMarkerMarkerInfoEXT[] = {sizeof(VkDebugMarkerMarkerInfoEXT), _Alignof(VkDebugMarkerMarkerInfoEXT), offsetof(VkDebugMarkerMarkerInfoEXT, sType), offsetof(VkDebugMarkerMarkerInfoEXT, pNext), offsetof(VkDebugMarkerMarkerInfoEXT, pMarkerName), offsetof(VkDebugMarkerMarkerInfoEXT, color)};
+// const size_t layout_VkDedicatedAllocationImageCreateInfoNV[] = {sizeof(VkDedicatedAllocationImageCreateInfoNV), _Alignof(VkDedicatedAllocationImageCreateInfoNV), offsetof(VkDedicatedAllocationImageCreateInfoNV, sType), offsetof(VkDedicatedAllocationImageCreateInfoNV, pNext), offsetof(VkDedicatedAllocationImageCreateInfoNV, dedicatedAllocation)};
+// const size_t layout_VkDedicatedAllocationBufferCreateInfoNV[] = {sizeof(VkDedicatedAllocationBufferCreateInfoNV), _Alignof(VkDedicatedAllocationBufferCreateInfoNV), offsetof(VkDedicatedAllocationBufferCreateInfoNV, sType), offsetof(VkDedicatedAllocationBufferCreateInfoNV, pNext), offsetof(VkDedicatedAllocationBufferCreateInfoNV, dedicatedAllocation)};
+// const size_t layout_VkDedicatedAllocationMemoryAllocateInfoNV[] = {sizeof(VkDedicatedAllocationMemoryAllocateInfoNV), _Alignof(VkDedicatedAllocationMemoryAllocateInfoNV), offsetof(VkDedicatedAllocationMemoryAllocateInfoNV, sType), offsetof(VkDedicatedAllocationMemoryAllocateInfoNV, pNext), offsetof(VkDedicatedAllocationMemoryAllocateInfoNV, image), offsetof(VkDedicatedAllocationMemoryAllocateInfoNV, buffer)};
+// const size_t layout_VkPhysicalDeviceTransformFeedbackFeaturesEXT[] = {sizeof(VkPhysicalDeviceTransformFeedbackFeaturesEXT), _Alignof(VkPhysicalDeviceTransformFeedbackFeaturesEXT), offsetof(VkPhysicalDeviceTransformFeedbackFeaturesEXT, sType), offsetof(VkPhysicalDeviceTransformFeedbackFeaturesEXT, pNext), offsetof(VkPhysicalDeviceTransformFeedbackFeaturesEXT, transformFeedback), offsetof(VkPhysicalDeviceTransformFeedbackFeaturesEXT, geometryStreams)};
+// const size_t layout_VkPhysicalDeviceTransformFeedbackPropertiesEXT[] = {sizeof(VkPhysicalDeviceTransformFeedbackPropertiesEXT), _Alignof(VkPhysicalDeviceTransformFeedbackPropertiesEXT), offsetof(VkPhysicalDeviceTransformFeedbackPropertiesEXT, sType), offsetof(VkPhysicalDeviceTransformFeedbackPropertiesEXT, pNext), offsetof(VkPhysicalDeviceTransformFeedbackPropertiesEXT, maxTransformFeedbackStreams), offsetof(VkPhysicalDeviceTransformFeedbackPropertiesEXT, maxTransformFeedbackBuffers), offsetof(VkPhysicalDeviceTransformFeedbackPropertiesEXT, maxTransformFeedbackBufferSize), offsetof(VkPhysicalDeviceTransformFeedbackPropertiesEXT, maxTransformFeedbackStreamDataSize), offsetof(VkPhysicalDeviceTransformFeedbackPropertiesEXT, maxTransformFeedbackBufferDataSize), offsetof(VkPhysicalDeviceTransformFeedbackPropertiesEXT, maxTransformFeedbackBufferDataStride), offsetof(VkPhysicalDeviceTransformFeedbackPropertiesEXT, transformFeedbackQueries), offsetof(VkPhysicalDeviceTransformFeedbackPropertiesEXT, transformFeedbackStreamsLinesTriangles), offsetof(VkPhysicalDeviceTransformFeedbackPropertiesEXT, transformFeedbackRasterizationStreamSelect), offsetof(VkPhysicalDeviceTransformFeedbackPropertiesEXT, transformFeedbackDraw)};
+// const size_t layout_VkPipelineRasterizationStateStreamCreateInfoEXT[] = {sizeof(VkPipelineRasterizationStateStreamCreateInfoEXT), _Alignof(VkPipelineRasterizationStateStreamCreateInfoEXT), offsetof(VkPipelineRasterizationStateStreamCreateInfoEXT, sType), offsetof(VkPipelineRasterizationStateStreamCreateInfoEXT, pNext), offsetof(VkPipelineRasterizationStateStreamCreateInfoEXT, flags), offsetof(VkPipelineRasterizationStateStreamCreateInfoEXT, rasterizationStream)};
+// const size_t layout_VkCuModuleCreateInfoNVX[] = {sizeof(VkCuModuleCreateInfoNVX), _Alignof(VkCuModuleCreateInfoNVX), offsetof(VkCuModuleCreateInfoNVX, sType), offsetof(VkCuModuleCreateInfoNVX, pNext), offsetof(VkCuModuleCreateInfoNVX, dataSize), offsetof(VkCuModuleCreateInfoNVX, pData)};
+// const size_t layout_VkCuFunctionCreateInfoNVX[] = {sizeof(VkCuFunctionCreateInfoNVX), _Alignof(VkCuFunctionCreateInfoNVX), offsetof(VkCuFunctionCreateInfoNVX, sType), offsetof(VkCuFunctionCreateInfoNVX, pNext), offsetof(VkCuFunctionCreateInfoNVX, module), offsetof(VkCuFunctionCreateInfoNVX, pName)};
+// const size_t layout_VkCuLaunchInfoNVX[] = {sizeof(VkCuLaunchInfoNVX), _Alignof(VkCuLaunchInfoNVX), offsetof(VkCuLaunchInfoNVX, sType), offsetof(VkCuLaunchInfoNVX, pNext), offsetof(VkCuLaunchInfoNVX, function), offsetof(VkCuLaunchInfoNVX, gridDimX), offsetof(VkCuLaunchInfoNVX, gridDimY), offsetof(VkCuLaunchInfoNVX, gridDimZ), offsetof(VkCuLaunchInfoNVX, blockDimX), offsetof(VkCuLaunchInfoNVX, blockDimY), offsetof(VkCuLaunchInfoNVX, blockDimZ), offsetof(VkCuLaunchInfoNVX, sharedMemBytes), offsetof(VkCuLaunchInfoNVX, paramCount), offsetof(VkCuLaunchInfoNVX, pParams), offsetof(VkCuLaunchInfoNVX, extraCount), offsetof(VkCuLaunchInfoNVX, pExtras)};
+// const size_t layout_VkImageViewHandleInfoNVX[] = {sizeof(VkImageViewHandleInfoNVX), _Alignof(VkImageViewHandleInfoNVX), offsetof(VkImageViewHandleInfoNVX, sType), offsetof(VkImageViewHandleInfoNVX, pNext), offsetof(VkImageViewHandleInfoNVX, imageView), offsetof(VkImageViewHandleInfoNVX, descriptorType), offsetof(VkImageViewHandleInfoNVX, sampler)};
+// const size_t layout_VkImageViewAddressPropertiesNVX[] = {sizeof(VkImageViewAddressPropertiesNVX), _Alignof(VkImageViewAddressPropertiesNVX), offsetof(VkImageViewAddressPropertiesNVX, sType), offsetof(VkImageViewAddressPropertiesNVX, pNext), offsetof(VkImageViewAddressPropertiesNVX, deviceAddress), offsetof(VkImageViewAddressPropertiesNVX, size)};
+// const size_t layout_VkTextureLODGatherFormatPropertiesAMD[] = {sizeof(VkTextureLODGatherFormatPropertiesAMD), _Alignof(VkTextureLODGatherFormatPropertiesAMD), offsetof(VkTextureLODGatherFormatPropertiesAMD, sType), offsetof(VkTextureLODGatherFormatPropertiesAMD, pNext), offsetof(VkTextureLODGatherFormatPropertiesAMD, supportsTextureGatherLODBiasAMD)};
+// const size_t layout_VkShaderResourceUsageAMD[] = {sizeof(VkShaderResourceUsageAMD), _Alignof(VkShaderResourceUsageAMD), offsetof(VkShaderResourceUsageAMD, numUsedVgprs), offsetof(VkShaderResourceUsageAMD, numUsedSgprs), offsetof(VkShaderResourceUsageAMD, ldsSizePerLocalWorkGroup), offsetof(VkShaderResourceUsageAMD, ldsUsageSizeInBytes), offsetof(VkShaderResourceUsageAMD, scratchMemUsageInBytes)};
+// const size_t layout_VkShaderStatisticsInfoAMD[] = {sizeof(VkShaderStatisticsInfoAMD), _Alignof(VkShaderStatisticsInfoAMD), offsetof(VkShaderStatisticsInfoAMD, shaderStageMask), offsetof(VkShaderStatisticsInfoAMD, resourceUsage), offsetof(VkShaderStatisticsInfoAMD, numPhysicalVgprs), offsetof(VkShaderStatisticsInfoAMD, numPhysicalSgprs), offsetof(VkShaderStatisticsInfoAMD, numAvailableVgprs), offsetof(VkShaderStatisticsInfoAMD, numAvailableSgprs), offsetof(VkShaderStatisticsInfoAMD, computeWorkGroupSize)};
+// const size_t layout_VkPhysicalDeviceCornerSampledImageFeaturesNV[] = {sizeof(VkPhysicalDeviceCornerSampledImageFeaturesNV), _Alignof(VkPhysicalDeviceCornerSampledImageFeaturesNV), offsetof(VkPhysicalDeviceCornerSampledImageFeaturesNV, sType), offsetof(VkPhysicalDeviceCornerSampledImageFeaturesNV, pNext), offsetof(VkPhysicalDeviceCornerSampledImageFeaturesNV, cornerSampledImage)};
+// const size_t layout_VkExternalImageFormatPropertiesNV[] = {sizeof(VkExternalImageFormatPropertiesNV), _Alignof(VkExternalImageFormatPropertiesNV), offsetof(VkExternalImageFormatPropertiesNV, imageFormatProperties), offsetof(VkExternalImageFormatPropertiesNV, externalMemoryFeatures), offsetof(VkExternalImageFormatPropertiesNV, exportFromImportedHandleTypes), offsetof(VkExternalImageFormatPropertiesNV, compatibleHandleTypes)};
+// const size_t layout_VkExternalMemoryImageCreateInfoNV[] = {sizeof(VkExternalMemoryImageCreateInfoNV), _Alignof(VkExternalMemoryImageCreateInfoNV), offsetof(VkExternalMemoryImageCreateInfoNV, sType), offsetof(VkExternalMemoryImageCreateInfoNV, pNext), offsetof(VkExternalMemoryImageCreateInfoNV, handleTypes)};
+// const size_t layout_VkExportMemoryAllocateInfoNV[] = {sizeof(VkExportMemoryAllocateInfoNV), _Alignof(VkExportMemoryAllocateInfoNV), offsetof(VkExportMemoryAllocateInfoNV, sType), offsetof(VkExportMemoryAllocateInfoNV, pNext), offsetof(VkExportMemoryAllocateInfoNV, handleTypes)};
+// const size_t layout_VkValidationFlagsEXT[] = {sizeof(VkValidationFlagsEXT), _Alignof(VkValidationFlagsEXT), offsetof(VkValidationFlagsEXT, sType), offsetof(VkValidationFlagsEXT, pNext), offsetof(VkValidationFlagsEXT, disabledValidationCheckCount), offsetof(VkValidationFlagsEXT, pDisabledValidationChecks)};
+// const size_t layout_VkImageViewASTCDecodeModeEXT[] = {sizeof(VkImageViewASTCDecodeModeEXT), _Alignof(VkImageViewASTCDecodeModeEXT), offsetof(VkImageViewASTCDecodeModeEXT, sType), offsetof(VkImageViewASTCDecodeModeEXT, pNext), offsetof(VkImageViewASTCDecodeModeEXT, decodeMode)};
+// const size_t layout_VkPhysicalDeviceASTCDecodeFeaturesEXT[] = {sizeof(VkPhysicalDeviceASTCDecodeFeaturesEXT), _Alignof(VkPhysicalDeviceASTCDecodeFeaturesEXT), offsetof(VkPhysicalDeviceASTCDecodeFeaturesEXT, sType), offsetof(VkPhysicalDeviceASTCDecodeFeaturesEXT, pNext), offsetof(VkPhysicalDeviceASTCDecodeFeaturesEXT, decodeModeSharedExponent)};
+// const size_t layout_VkPhysicalDevicePipelineRobustnessFeaturesEXT[] = {sizeof(VkPhysicalDevicePipelineRobustnessFeaturesEXT), _Alignof(VkPhysicalDevicePipelineRobustnessFeaturesEXT), offsetof(VkPhysicalDevicePipelineRobustnessFeaturesEXT, sType), offsetof(VkPhysicalDevicePipelineRobustnessFeaturesEXT, pNext), offsetof(VkPhysicalDevicePipelineRobustnessFeaturesEXT, pipelineRobustness)};
+// const size_t layout_VkPhysicalDevicePipelineRobustnessPropertiesEXT[] = {sizeof(VkPhysicalDevicePipelineRobustnessPropertiesEXT), _Alignof(VkPhysicalDevicePipelineRobustnessPropertiesEXT), offsetof(VkPhysicalDevicePipelineRobustnessPropertiesEXT, sType), offsetof(VkPhysicalDevicePipelineRobustnessPropertiesEXT, pNext), offsetof(VkPhysicalDevicePipelineRobustnessPropertiesEXT, defaultRobustnessStorageBuffers), offsetof(VkPhysicalDevicePipelineRobustnessPropertiesEXT, defaultRobustnessUniformBuffers), offsetof(VkPhysicalDevicePipelineRobustnessPropertiesEXT, defaultRobustnessVertexInputs), offsetof(VkPhysicalDevicePipelineRobustnessPropertiesEXT, defaultRobustnessImages)};
+// const size_t layout_VkPipelineRobustnessCreateInfoEXT[] = {sizeof(VkPipelineRobustnessCreateInfoEXT), _Alignof(VkPipelineRobustnessCreateInfoEXT), offsetof(VkPipelineRobustnessCreateInfoEXT, sType), offsetof(VkPipelineRobustnessCreateInfoEXT, pNext), offsetof(VkPipelineRobustnessCreateInfoEXT, storageBuffers), offsetof(VkPipelineRobustnessCreateInfoEXT, uniformBuffers), offsetof(VkPipelineRobustnessCreateInfoEXT, vertexInputs), offsetof(VkPipelineRobustnessCreateInfoEXT, images)};
+// const size_t layout_VkConditionalRenderingBeginInfoEXT[] = {sizeof(VkConditionalRenderingBeginInfoEXT), _Alignof(VkConditionalRenderingBeginInfoEXT), offsetof(VkConditionalRenderingBeginInfoEXT, sType), offsetof(VkConditionalRenderingBeginInfoEXT, pNext), offsetof(VkConditionalRenderingBeginInfoEXT, buffer), offsetof(VkConditionalRenderingBeginInfoEXT, offset), offsetof(VkConditionalRenderingBeginInfoEXT, flags)};
+// const size_t layout_VkPhysicalDeviceConditionalRenderingFeaturesEXT[] = {sizeof(VkPhysicalDeviceConditionalRenderingFeaturesEXT), _Alignof(VkPhysicalDeviceConditionalRenderingFeaturesEXT), offsetof(VkPhysicalDeviceConditionalRenderingFeaturesEXT, sType), offsetof(VkPhysicalDeviceConditionalRenderingFeaturesEXT, pNext), offsetof(VkPhysicalDeviceConditionalRenderingFeaturesEXT, conditionalRendering), offsetof(VkPhysicalDeviceConditionalRenderingFeaturesEXT, inheritedConditionalRendering)};
+// const size_t layout_VkCommandBufferInheritanceConditionalRenderingInfoEXT[] = {sizeof(VkCommandBufferInheritanceConditionalRenderingInfoEXT), _Alignof(VkCommandBufferInheritanceConditionalRenderingInfoEXT), offsetof(VkCommandBufferInheritanceConditionalRenderingInfoEXT, sType), offsetof(VkCommandBufferInheritanceConditionalRenderingInfoEXT, pNext), offsetof(VkCommandBufferInheritanceConditionalRenderingInfoEXT, conditionalRenderingEnable)};
+// const size_t layout_VkViewportWScalingNV[] = {sizeof(VkViewportWScalingNV), _Alignof(VkViewportWScalingNV), offsetof(VkViewportWScalingNV, xcoeff), offsetof(VkViewportWScalingNV, ycoeff)};
+// const size_t layout_VkPipelineViewportWScalingStateCreateInfoNV[] = {sizeof(VkPipelineViewportWScalingStateCreateInfoNV), _Alignof(VkPipelineViewportWScalingStateCreateInfoNV), offsetof(VkPipelineViewportWScalingStateCreateInfoNV, sType), offsetof(VkPipelineViewportWScalingStateCreateInfoNV, pNext), offsetof(VkPipelineViewportWScalingStateCreateInfoNV, viewportWScalingEnable), offsetof(VkPipelineViewportWScalingStateCreateInfoNV, viewportCount), offsetof(VkPipelineViewportWScalingStateCreateInfoNV, pViewportWScalings)};
+// const size_t layout_VkSurfaceCapabilities2EXT[] = {sizeof(VkSurfaceCapabilities2EXT), _Alignof(VkSurfaceCapabilities2EXT), offsetof(VkSurfaceCapabilities2EXT, sType), offsetof(VkSurfaceCapabilities2EXT, pNext), offsetof(VkSurfaceCapabilities2EXT, minImageCount), offsetof(VkSurfaceCapabilities2EXT, maxImageCount), offsetof(VkSurfaceCapabilities2EXT, currentExtent), offsetof(VkSurfaceCapabilities2EXT, minImageExtent), offsetof(VkSurfaceCapabilities2EXT, maxImageExtent), offsetof(VkSurfaceCapabilities2EXT, maxImageArrayLayers), offsetof(VkSurfaceCapabilities2EXT, supportedTransforms), offsetof(VkSurfaceCapabilities2EXT, currentTransform), offsetof(VkSurfaceCapabilities2EXT, supportedCompositeAlpha), offsetof(VkSurfaceCapabilities2EXT, supportedUsageFlags), offsetof(VkSurfaceCapabilities2EXT, supportedSurfaceCounters)};
+// const size_t layout_VkDisplayPowerInfoEXT[] = {sizeof(VkDisplayPowerInfoEXT), _Alignof(VkDisplayPowerInfoEXT), offsetof(VkDisplayPowerInfoEXT, sType), offsetof(VkDisplayPowerInfoEXT, pNext), offsetof(VkDisplayPowerInfoEXT, powerState)};
+// const size_t layout_VkDeviceEventInfoEXT[] = {sizeof(VkDeviceEventInfoEXT), _Alignof(VkDeviceEventInfoEXT), offsetof(VkDeviceEventInfoEXT, sType), offsetof(VkDeviceEventInfoEXT, pNext), offsetof(VkDeviceEventInfoEXT, deviceEvent)};
+// const size_t layout_VkDisplayEventInfoEXT[] = {sizeof(VkDisplayEventInfoEXT), _Alignof(VkDisplayEventInfoEXT), offsetof(VkDisplayEventInfoEXT, sType), offsetof(VkDisplayEventInfoEXT, pNext), offsetof(VkDisplayEventInfoEXT, displayEvent)};
+// const size_t layout_VkSwapchainCounterCreateInfoEXT[] = {sizeof(VkSwapchainCounterCreateInfoEXT), _Alignof(VkSwapchainCounterCreateInfoEXT), offsetof(VkSwapchainCounterCreateInfoEXT, sType), offsetof(VkSwapchainCounterCreateInfoEXT, pNext), offsetof(VkSwapchainCounterCreateInfoEXT, surfaceCounters)};
+// const size_t layout_VkRefreshCycleDurationGOOGLE[] = {sizeof(VkRefreshCycleDurationGOOGLE), _Alignof(VkRefreshCycleDurationGOOGLE), offsetof(VkRefreshCycleDurationGOOGLE, refreshDuration)};
+// const size_t layout_VkPastPresentationTimingGOOGLE[] = {sizeof(VkPastPresentationTimingGOOGLE), _Alignof(VkPastPresentationTimingGOOGLE), offsetof(VkPastPresentationTimingGOOGLE, presentID), offsetof(VkPastPresentationTimingGOOGLE, desiredPresentTime), offsetof(VkPastPresentationTimingGOOGLE, actualPresentTime), offsetof(VkPastPresentationTimingGOOGLE, earliestPresentTime), offsetof(VkPastPresentationTimingGOOGLE, presentMargin)};
+// const size_t layout_VkPresentTimeGOOGLE[] = {sizeof(VkPresentTimeGOOGLE), _Alignof(VkPresentTimeGOOGLE), offsetof(VkPresentTimeGOOGLE, presentID), offsetof(VkPresentTimeGOOGLE, desiredPresentTime)};
+// const size_t layout_VkPresentTimesInfoGOOGLE[] = {sizeof(VkPresentTimesInfoGOOGLE), _Alignof(VkPresentTimesInfoGOOGLE), offsetof(VkPresentTimesInfoGOOGLE, sType), offsetof(VkPresentTimesInfoGOOGLE, pNext), offsetof(VkPresentTimesInfoGOOGLE, swapchainCount), offsetof(VkPresentTimesInfoGOOGLE, pTimes)};
+// const size_t layout_VkPhysicalDeviceMultiviewPerViewAttributesPropertiesNVX[] = {sizeof(VkPhysicalDeviceMultiviewPerViewAttributesPropertiesNVX), _Alignof(VkPhysicalDeviceMultiviewPerViewAttributesPropertiesNVX), offsetof(VkPhysicalDeviceMultiviewPerViewAttributesPropertiesNVX, sType), offsetof(VkPhysicalDeviceMultiviewPerViewAttributesPropertiesNVX, pNext), offsetof(VkPhysicalDeviceMultiviewPerViewAttributesPropertiesNVX, perViewPositionAllComponents)};
+// const size_t layout_VkViewportSwizzleNV[] = {sizeof(VkViewportSwizzleNV), _Alignof(VkViewportSwizzleNV), offsetof(VkViewportSwizzleNV, x), offsetof(VkViewportSwizzleNV, y), offsetof(VkViewportSwizzleNV, z), offsetof(VkViewportSwizzleNV, w)};
+// const size_t layout_VkPipelineViewportSwizzleStateCreateInfoNV[] = {sizeof(VkPipelineViewportSwizzleStateCreateInfoNV), _Alignof(VkPipelineViewportSwizzleStateCreateInfoNV), offsetof(VkPipelineViewportSwizzleStateCreateInfoNV, sType), offsetof(VkPipelineViewportSwizzleStateCreateInfoNV, pNext), offsetof(VkPipelineViewportSwizzleStateCreateInfoNV, flags), offsetof(VkPipelineViewportSwizzleStateCreateInfoNV, viewportCount), offsetof(VkPipelineViewportSwizzleStateCreateInfoNV, pViewportSwizzles)};
+// const size_t layout_VkPhysicalDeviceDiscardRectanglePropertiesEXT[] = {sizeof(VkPhysicalDeviceDiscardRectanglePropertiesEXT), _Alignof(VkPhysicalDeviceDiscardRectanglePropertiesEXT), offsetof(VkPhysicalDeviceDiscardRectanglePropertiesEXT, sType), offsetof(VkPhysicalDeviceDiscardRectanglePropertiesEXT, pNext), offsetof(VkPhysicalDeviceDiscardRectanglePropertiesEXT, maxDiscardRectangles)};
+// const size_t layout_VkPipelineDiscardRectangleStateCreateInfoEXT[] = {sizeof(VkPipelineDiscardRectangleStateCreateInfoEXT), _Alignof(VkPipelineDiscardRectangleStateCreateInfoEXT), offsetof(VkPipelineDiscardRectangleStateCreateInfoEXT, sType), offsetof(VkPipelineDiscardRectangleStateCreateInfoEXT, pNext), offsetof(VkPipelineDiscardRectangleStateCreateInfoEXT, flags), offsetof(VkPipelineDiscardRectangleStateCreateInfoEXT, discardRectangleMode), offsetof(VkPipelineDiscardRectangleStateCreateInfoEXT, discardRectangleCount), offsetof(VkPipelineDiscardRectangleStateCreateInfoEXT, pDiscardRectangles)};
+// const size_t layout_VkPhysicalDeviceConservativeRasterizationPropertiesEXT[] = {sizeof(VkPhysicalDeviceConservativeRasterizationPropertiesEXT), _Alignof(VkPhysicalDeviceConservativeRasterizationPropertiesEXT), offsetof(VkPhysicalDeviceConservativeRasterizationPropertiesEXT, sType), offsetof(VkPhysicalDeviceConservativeRasterizationPropertiesEXT, pNext), offsetof(VkPhysicalDeviceConservativeRasterizationPropertiesEXT, primitiveOverestimationSize), offsetof(VkPhysicalDeviceConservativeRasterizationPropertiesEXT, maxExtraPrimitiveOverestimationSize), offsetof(VkPhysicalDeviceConservativeRasterizationPropertiesEXT, extraPrimitiveOverestimationSizeGranularity), offsetof(VkPhysicalDeviceConservativeRasterizationPropertiesEXT, primitiveUnderestimation), offsetof(VkPhysicalDeviceConservativeRasterizationPropertiesEXT, conservativePointAndLineRasterization), offsetof(VkPhysicalDeviceConservativeRasterizationPropertiesEXT, degenerateTrianglesRasterized), offsetof(VkPhysicalDeviceConservativeRasterizationPropertiesEXT, degenerateLinesRasterized), offsetof(VkPhysicalDeviceConservativeRasterizationPropertiesEXT, fullyCoveredFragmentShaderInputVariable), offsetof(VkPhysicalDeviceConservativeRasterizationPropertiesEXT, conservativeRasterizationPostDepthCoverage)};
+// const size_t layout_VkPipelineRasterizationConservativeStateCreateInfoEXT[] = {sizeof(VkPipelineRasterizationConservativeStateCreateInfoEXT), _Alignof(VkPipelineRasterizationConservativeStateCreateInfoEXT), offsetof(VkPipelineRasterizationConservativeStateCreateInfoEXT, sType), offsetof(VkPipelineRasterizationConservativeStateCreateInfoEXT, pNext), offsetof(VkPipelineRasterizationConservativeStateCreateInfoEXT, flags), offsetof(VkPipelineRasterizationConservativeStateCreateInfoEXT, conservativeRasterizationMode), offsetof(VkPipelineRasterizationConservativeStateCreateInfoEXT, extraPrimitiveOverestimationSize)};
+// const size_t layout_VkPhysicalDeviceDepthClipEnableFeaturesEXT[] = {sizeof(VkPhysicalDeviceDepthClipEnableFeaturesEXT), _Alignof(VkPhysicalDeviceDepthClipEnableFeaturesEXT), offsetof(VkPhysicalDeviceDepthClipEnableFeaturesEXT, sType), offsetof(VkPhysicalDeviceDepthClipEnableFeaturesEXT, pNext), offsetof(VkPhysicalDeviceDepthClipEnableFeaturesEXT, depthClipEnable)};
+// const size_t layout_VkPipelineRasterizationDepthClipStateCreateInfoEXT[] = {sizeof(VkPipelineRasterizationDepthClipStateCreateInfoEXT), _Alignof(VkPipelineRasterizationDepthClipStateCreateInfoEXT), offsetof(VkPipelineRasterizationDepthClipStateCreateInfoEXT, sType), offsetof(VkPipelineRasterizationDepthClipStateCreateInfoEXT, pNext), offsetof(VkPipelineRasterizationDepthClipStateCreateInfoEXT, flags), offsetof(VkPipelineRasterizationDepthClipStateCreateInfoEXT, depthClipEnable)};
+// const size_t layout_VkXYColorEXT[] = {sizeof(VkXYColorEXT), _Alignof(VkXYColorEXT), offsetof(VkXYColorEXT, x), offsetof(VkXYColorEXT, y)};
+// const size_t layout_VkHdrMetadataEXT[] = {sizeof(VkHdrMetadataEXT), _Alignof(VkHdrMetadataEXT), offsetof(VkHdrMetadataEXT, sType), offsetof(VkHdrMetadataEXT, pNext), offsetof(VkHdrMetadataEXT, displayPrimaryRed), offsetof(VkHdrMetadataEXT, displayPrimaryGreen), offsetof(VkHdrMetadataEXT, displayPrimaryBlue), offsetof(VkHdrMetadataEXT, whitePoint), offsetof(VkHdrMetadataEXT, maxLuminance), offsetof(VkHdrMetadataEXT, minLuminance), offsetof(VkHdrMetadataEXT, maxContentLightLevel), offsetof(VkHdrMetadataEXT, maxFrameAverageLightLevel)};
+// const size_t layout_VkDebugUtilsLabelEXT[] = {sizeof(VkDebugUtilsLabelEXT), _Alignof(VkDebugUtilsLabelEXT), offsetof(VkDebugUtilsLabelEXT, sType), offsetof(VkDebugUtilsLabelEXT, pNext), offsetof(VkDebugUtilsLabelEXT, pLabelName), offsetof(VkDebugUtilsLabelEXT, color)};
+// const size_t layout_VkDebugUtilsObjectNameInfoEXT[] = {sizeof(VkDebugUtilsObjectNameInfoEXT), _Alignof(VkDebugUtilsObjectNameInfoEXT), offsetof(VkDebugUtilsObjectNameInfoEXT, sType), offsetof(VkDebugUtilsObjectNameInfoEXT, pNext), offsetof(VkDebugUtilsObjectNameInfoEXT, objectType), offsetof(VkDebugUtilsObjectNameInfoEXT, objectHandle), offsetof(VkDebugUtilsObjectNameInfoEXT, pObjectName)};
+// const size_t layout_VkDebugUtilsMessengerCallbackDataEXT[] = {sizeof(VkDebugUtilsMessengerCallbackDataEXT), _Alignof(VkDebugUtilsMessengerCallbackDataEXT), offsetof(VkDebugUtilsMessengerCallbackDataEXT, sType), offsetof(VkDebugUtilsMessengerCallbackDataEXT, pNext), offsetof(VkDebugUtilsMessengerCallbackDataEXT, flags), offsetof(VkDebugUtilsMessengerCallbackDataEXT, pMessageIdName), offsetof(VkDebugUtilsMessengerCallbackDataEXT, messageIdNumber), offsetof(VkDebugUtilsMessengerCallbackDataEXT, pMessage), offsetof(VkDebugUtilsMessengerCallbackDataEXT, queueLabelCount), offsetof(VkDebugUtilsMessengerCallbackDataEXT, pQueueLabels), offsetof(VkDebugUtilsMessengerCallbackDataEXT, cmdBufLabelCount), offsetof(VkDebugUtilsMessengerCallbackDataEXT, pCmdBufLabels), offsetof(VkDebugUtilsMessengerCallbackDataEXT, objectCount), offsetof(VkDebugUtilsMessengerCallbackDataEXT, pObjects)};
+// const size_t layout_VkDebugUtilsMessengerCreateInfoEXT[] = {sizeof(VkDebugUtilsMessengerCreateInfoEXT), _Alignof(VkDebugUtilsMessengerCreateInfoEXT), offsetof(VkDebugUtilsMessengerCreateInfoEXT, sType), offsetof(VkDebugUtilsMessengerCreateInfoEXT, pNext), offsetof(VkDebugUtilsMessengerCreateInfoEXT, flags), offsetof(VkDebugUtilsMessengerCreateInfoEXT, messageSeverity), offsetof(VkDebugUtilsMessengerCreateInfoEXT, messageType), offsetof(VkDebugUtilsMessengerCreateInfoEXT, pfnUserCallback), offsetof(VkDebugUtilsMessengerCreateInfoEXT, pUserData)};
+// const size_t layout_VkDebugUtilsObjectTagInfoEXT[] = {sizeof(VkDebugUtilsObjectTagInfoEXT), _Alignof(VkDebugUtilsObjectTagInfoEXT), offsetof(VkDebugUtilsObjectTagInfoEXT, sType), offsetof(VkDebugUtilsObjectTagInfoEXT, pNext), offsetof(VkDebugUtilsObjectTagInfoEXT, objectType), offsetof(VkDebugUtilsObjectTagInfoEXT, objectHandle), offsetof(VkDebugUtilsObjectTagInfoEXT, tagName), offsetof(VkDebugUtilsObjectTagInfoEXT, tagSize), offsetof(VkDebugUtilsObjectTagInfoEXT, pTag)};
+// const size_t layout_VkSampleLocationEXT[] = {sizeof(VkSampleLocationEXT), _Alignof(VkSampleLocationEXT), offsetof(VkSampleLocationEXT, x), offsetof(VkSampleLocationEXT, y)};
+// const size_t layout_VkSampleLocationsInfoEXT[] = {sizeof(VkSampleLocationsInfoEXT), _Alignof(VkSampleLocationsInfoEXT), offsetof(VkSampleLocationsInfoEXT, sType), offsetof(VkSampleLocationsInfoEXT, pNext), offsetof(VkSampleLocationsInfoEXT, sampleLocationsPerPixel), offsetof(VkSampleLocationsInfoEXT, sampleLocationGridSize), offsetof(VkSampleLocationsInfoEXT, sampleLocationsCount), offsetof(VkSampleLocationsInfoEXT, pSampleLocations)};
+// const size_t layout_VkAttachmentSampleLocationsEXT[] = {sizeof(VkAttachmentSampleLocationsEXT), _Alignof(VkAttachmentSampleLocationsEXT), offsetof(VkAttachmentSampleLocationsEXT, attachmentIndex), offsetof(VkAttachmentSampleLocationsEXT, sampleLocationsInfo)};
+// const size_t layout_VkSubpassSampleLocationsEXT[] = {sizeof(VkSubpassSampleLocationsEXT), _Alignof(VkSubpassSampleLocationsEXT), offsetof(VkSubpassSampleLocationsEXT, subpassIndex), offsetof(VkSubpassSampleLocationsEXT, sampleLocationsInfo)};
+// const size_t layout_VkRenderPassSampleLocationsBeginInfoEXT[] = {sizeof(VkRenderPassSampleLocationsBeginInfoEXT), _Alignof(VkRenderPassSampleLocationsBeginInfoEXT), offsetof(VkRenderPassSampleLocationsBeginInfoEXT, sType), offsetof(VkRenderPassSampleLocationsBeginInfoEXT, pNext), offsetof(VkRenderPassSampleLocationsBeginInfoEXT, attachmentInitialSampleLocationsCount), offsetof(VkRenderPassSampleLocationsBeginInfoEXT, pAttachmentInitialSampleLocations), offsetof(VkRenderPassSampleLocationsBeginInfoEXT, postSubpassSampleLocationsCount), offsetof(VkRenderPassSampleLocationsBeginInfoEXT, pPostSubpassSampleLocations)};
+// const size_t layout_VkPipelineSampleLocationsStateCreateInfoEXT[] = {sizeof(VkPipelineSampleLocationsStateCreateInfoEXT), _Alignof(VkPipelineSampleLocationsStateCreateInfoEXT), offsetof(VkPipelineSampleLocationsStateCreateInfoEXT, sType), offsetof(VkPipelineSampleLocationsStateCreateInfoEXT, pNext), offsetof(VkPipelineSampleLocationsStateCreateInfoEXT, sampleLocationsEnable), offsetof(VkPipelineSampleLocationsStateCreateInfoEXT, sampleLocationsInfo)};
+// const size_t layout_VkPhysicalDeviceSampleLocationsPropertiesEXT[] = {sizeof(VkPhysicalDeviceSampleLocationsPropertiesEXT), _Alignof(VkPhysicalDeviceSampleLocationsPropertiesEXT), offsetof(VkPhysicalDeviceSampleLocationsPropertiesEXT, sType), offsetof(VkPhysicalDeviceSampleLocationsPropertiesEXT, pNext), offsetof(VkPhysicalDeviceSampleLocationsPropertiesEXT, sampleLocationSampleCounts), offsetof(VkPhysicalDeviceSampleLocationsPropertiesEXT, maxSampleLocationGridSize), offsetof(VkPhysicalDeviceSampleLocationsPropertiesEXT, sampleLocationCoordinateRange), offsetof(VkPhysicalDeviceSampleLocationsPropertiesEXT, sampleLocationSubPixelBits), offsetof(VkPhysicalDeviceSampleLocationsPropertiesEXT, variableSampleLocations)};
+// const size_t layout_VkMultisamplePropertiesEXT[] = {sizeof(VkMultisamplePropertiesEXT), _Alignof(VkMultisamplePropertiesEXT), offsetof(VkMultisamplePropertiesEXT, sType), offsetof(VkMultisamplePropertiesEXT, pNext), offsetof(VkMultisamplePropertiesEXT, maxSampleLocationGridSize)};
+// const size_t layout_VkPhysicalDeviceBlendOperationAdvancedFeaturesEXT[] = {sizeof(VkPhysicalDeviceBlendOperationAdvancedFeaturesEXT), _Alignof(VkPhysicalDeviceBlendOperationAdvancedFeaturesEXT), offsetof(VkPhysicalDeviceBlendOperationAdvancedFeaturesEXT, sType), offsetof(VkPhysicalDeviceBlendOperationAdvancedFeaturesEXT, pNext), offsetof(VkPhysicalDeviceBlendOperationAdvancedFeaturesEXT, advancedBlendCoherentOperations)};
+// const size_t layout_VkPhysicalDeviceBlendOperationAdvancedPropertiesEXT[] = {sizeof(VkPhysicalDeviceBlendOperationAdvancedPropertiesEXT), _Alignof(VkPhysicalDeviceBlendOperationAdvancedPropertiesEXT), offsetof(VkPhysicalDeviceBlendOperationAdvancedPropertiesEXT, sType), offsetof(VkPhysicalDeviceBlendOperationAdvancedPropertiesEXT, pNext), offsetof(VkPhysicalDeviceBlendOperationAdvancedPropertiesEXT, advancedBlendMaxColorAttachments), offsetof(VkPhysicalDeviceBlendOperationAdvancedPropertiesEXT, advancedBlendIndependentBlend), offsetof(VkPhysicalDeviceBlendOperationAdvancedPropertiesEXT, advancedBlendNonPremultipliedSrcColor), offsetof(VkPhysicalDeviceBlendOperationAdvancedPropertiesEXT, advancedBlendNonPremultipliedDstColor), offsetof(VkPhysicalDeviceBlendOperationAdvancedPropertiesEXT, advancedBlendCorrelatedOverlap), offsetof(VkPhysicalDeviceBlendOperationAdvancedPropertiesEXT, advancedBlendAllOperations)};
+// const size_t layout_VkPipelineColorBlendAdvancedStateCreateInfoEXT[] = {sizeof(VkPipelineColorBlendAdvancedStateCreateInfoEXT), _Alignof(VkPipelineColorBlendAdvancedStateCreateInfoEXT), offsetof(VkPipelineColorBlendAdvancedStateCreateInfoEXT, sType), offsetof(VkPipelineColorBlendAdvancedStateCreateInfoEXT, pNext), offsetof(VkPipelineColorBlendAdvancedStateCreateInfoEXT, srcPremultiplied), offsetof(VkPipelineColorBlendAdvancedStateCreateInfoEXT, dstPremultiplied), offsetof(VkPipelineColorBlendAdvancedStateCreateInfoEXT, blendOverlap)};
+// const size_t layout_VkPipelineCoverageToColorStateCreateInfoNV[] = {sizeof(VkPipelineCoverageToColorStateCreateInfoNV), _Alignof(VkPipelineCoverageToColorStateCreateInfoNV), offsetof(VkPipelineCoverageToColorStateCreateInfoNV, sType), offsetof(VkPipelineCoverageToColorStateCreateInfoNV, pNext), offsetof(VkPipelineCoverageToColorStateCreateInfoNV, flags), offsetof(VkPipelineCoverageToColorStateCreateInfoNV, coverageToColorEnable), offsetof(VkPipelineCoverageToColorStateCreateInfoNV, coverageToColorLocation)};
+// const size_t layout_VkPipelineCoverageModulationStateCreateInfoNV[] = {sizeof(VkPipelineCoverageModulationStateCreateInfoNV), _Alignof(VkPipelineCoverageModulationStateCreateInfoNV), offsetof(VkPipelineCoverageModulationStateCreateInfoNV, sType), offsetof(VkPipelineCoverageModulationStateCreateInfoNV, pNext), offsetof(VkPipelineCoverageModulationStateCreateInfoNV, flags), offsetof(VkPipelineCoverageModulationStateCreateInfoNV, coverageModulationMode), offsetof(VkPipelineCoverageModulationStateCreateInfoNV, coverageModulationTableEnable), offsetof(VkPipelineCoverageModulationStateCreateInfoNV, coverageModulationTableCount), offsetof(VkPipelineCoverageModulationStateCreateInfoNV, pCoverageModulationTable)};
+// const size_t layout_VkPhysicalDeviceShaderSMBuiltinsPropertiesNV[] = {sizeof(VkPhysicalDeviceShaderSMBuiltinsPropertiesNV), _Alignof(VkPhysicalDeviceShaderSMBuiltinsPropertiesNV), offsetof(VkPhysicalDeviceShaderSMBuiltinsPropertiesNV, sType), offsetof(VkPhysicalDeviceShaderSMBuiltinsPropertiesNV, pNext), offsetof(VkPhysicalDeviceShaderSMBuiltinsPropertiesNV, shaderSMCount), offsetof(VkPhysicalDeviceShaderSMBuiltinsPropertiesNV, shaderWarpsPerSM)};
+// const size_t layout_VkPhysicalDeviceShaderSMBuiltinsFeaturesNV[] = {sizeof(VkPhysicalDeviceShaderSMBuiltinsFeaturesNV), _Alignof(VkPhysicalDeviceShaderSMBuiltinsFeaturesNV), offsetof(VkPhysicalDeviceShaderSMBuiltinsFeaturesNV, sType), offsetof(VkPhysicalDeviceShaderSMBuiltinsFeaturesNV, pNext), offsetof(VkPhysicalDeviceShaderSMBuiltinsFeaturesNV, shaderSMBuiltins)};
+// const size_t layout_VkDrmFormatModifierPropertiesEXT[] = {sizeof(VkDrmFormatModifierPropertiesEXT), _Alignof(VkDrmFormatModifierPropertiesEXT), offsetof(VkDrmFormatModifierPropertiesEXT, drmFormatModifier), offsetof(VkDrmFormatModifierPropertiesEXT, drmFormatModifierPlaneCount), offsetof(VkDrmFormatModifierPropertiesEXT, drmFormatModifierTilingFeatures)};
+// const size_t layout_VkDrmFormatModifierPropertiesListEXT[] = {sizeof(VkDrmFormatModifierPropertiesListEXT), _Alignof(VkDrmFormatModifierPropertiesListEXT), offsetof(VkDrmFormatModifierPropertiesListEXT, sType), offsetof(VkDrmFormatModifierPropertiesListEXT, pNext), offsetof(VkDrmFormatModifierPropertiesListEXT, drmFormatModifierCount), offsetof(VkDrmFormatModifierPropertiesListEXT, pDrmFormatModifierProperties)};
+// const size_t layout_VkPhysicalDeviceImageDrmFormatModifierInfoEXT[] = {sizeof(VkPhysicalDeviceImageDrmFormatModifierInfoEXT), _Alignof(VkPhysicalDeviceImageDrmFormatModifierInfoEXT), offsetof(VkPhysicalDeviceImageDrmFormatModifierInfoEXT, sType), offsetof(VkPhysicalDeviceImageDrmFormatModifierInfoEXT, pNext), offsetof(VkPhysicalDeviceImageDrmFormatModifierInfoEXT, drmFormatModifier), offsetof(VkPhysicalDeviceImageDrmFormatModifierInfoEXT, sharingMode), offsetof(VkPhysicalDeviceImageDrmFormatModifierInfoEXT, queueFamilyIndexCount), offsetof(VkPhysicalDeviceImageDrmFormatModifierInfoEXT, pQueueFamilyIndices)};
+// const size_t layout_VkImageDrmFormatModifierListCreateInfoEXT[] = {sizeof(VkImageDrmFormatModifierListCreateInfoEXT), _Alignof(VkImageDrmFormatModifierListCreateInfoEXT), offsetof(VkImageDrmFormatModifierListCreateInfoEXT, sType), offsetof(VkImageDrmFormatModifierListCreateInfoEXT, pNext), offsetof(VkImageDrmFormatModifierListCreateInfoEXT, drmFormatModifierCount), offsetof(VkImageDrmFormatModifierListCreateInfoEXT, pDrmFormatModifiers)};
+// const size_t layout_VkImageDrmFormatModifierExplicitCreateInfoEXT[] = {sizeof(VkImageDrmFormatModifierExplicitCreateInfoEXT), _Alignof(VkImageDrmFormatModifierExplicitCreateInfoEXT), offsetof(VkImageDrmFormatModifierExplicitCreateInfoEXT, sType), offsetof(VkImageDrmFormatModifierExplicitCreateInfoEXT, pNext), offsetof(VkImageDrmFormatModifierExplicitCreateInfoEXT, drmFormatModifier), offsetof(VkImageDrmFormatModifierExplicitCreateInfoEXT, drmFormatModifierPlaneCount), offsetof(VkImageDrmFormatModifierExplicitCreateInfoEXT, pPlaneLayouts)};
+// const size_t layout_VkImageDrmFormatModifierPropertiesEXT[] = {sizeof(VkImageDrmFormatModifierPropertiesEXT), _Alignof(VkImageDrmFormatModifierPropertiesEXT), offsetof(VkImageDrmFormatModifierPropertiesEXT, sType), offsetof(VkImageDrmFormatModifierPropertiesEXT, pNext), offsetof(VkImageDrmFormatModifierPropertiesEXT, drmFormatModifier)};
+// const size_t layout_VkDrmFormatModifierProperties2EXT[] = {sizeof(VkDrmFormatModifierProperties2EXT), _Alignof(VkDrmFormatModifierProperties2EXT), offsetof(VkDrmFormatModifierProperties2EXT, drmFormatModifier), offsetof(VkDrmFormatModifierProperties2EXT, drmFormatModifierPlaneCount), offsetof(VkDrmFormatModifierProperties2EXT, drmFormatModifierTilingFeatures)};
+// const size_t layout_VkDrmFormatModifierPropertiesList2EXT[] = {sizeof(VkDrmFormatModifierPropertiesList2EXT), _Alignof(VkDrmFormatModifierPropertiesList2EXT), offsetof(VkDrmFormatModifierPropertiesList2EXT, sType), offsetof(VkDrmFormatModifierPropertiesList2EXT, pNext), offsetof(VkDrmFormatModifierPropertiesList2EXT, drmFormatModifierCount), offsetof(VkDrmFormatModifierPropertiesList2EXT, pDrmFormatModifierProperties)};
+// const size_t layout_VkValidationCacheCreateInfoEXT[] = {sizeof(VkValidationCacheCreateInfoEXT), _Alignof(VkValidationCacheCreateInfoEXT), offsetof(VkValidationCacheCreateInfoEXT, sType), offsetof(VkValidationCacheCreateInfoEXT, pNext), offsetof(VkValidationCacheCreateInfoEXT, flags), offsetof(VkValidationCacheCreateInfoEXT, initialDataSize), offsetof(VkValidationCacheCreateInfoEXT, pInitialData)};
+// const size_t layout_VkShaderModuleValidationCacheCreateInfoEXT[] = {sizeof(VkShaderModuleValidationCacheCreateInfoEXT), _Alignof(VkShaderModuleValidationCacheCreateInfoEXT), offsetof(VkShaderModuleValidationCacheCreateInfoEXT, sType), offsetof(VkShaderModuleValidationCacheCreateInfoEXT, pNext), offsetof(VkShaderModuleValidationCacheCreateInfoEXT, validationCache)};
+// const size_t layout_VkShadingRatePaletteNV[] = {sizeof(VkShadingRatePaletteNV), _Alignof(VkShadingRatePaletteNV), offsetof(VkShadingRatePaletteNV, shadingRatePaletteEntryCount), offsetof(VkShadingRatePaletteNV, pShadingRatePaletteEntries)};
+// const size_t layout_VkPipelineViewportShadingRateImageStateCreateInfoNV[] = {sizeof(VkPipelineViewportShadingRateImageStateCreateInfoNV), _Alignof(VkPipelineViewportShadingRateImageStateCreateInfoNV), offsetof(VkPipelineViewportShadingRateImageStateCreateInfoNV, sType), offsetof(VkPipelineViewportShadingRateImageStateCreateInfoNV, pNext), offsetof(VkPipelineViewportShadingRateImageStateCreateInfoNV, shadingRateImageEnable), offsetof(VkPipelineViewportShadingRateImageStateCreateInfoNV, viewportCount), offsetof(VkPipelineViewportShadingRateImageStateCreateInfoNV, pShadingRatePalettes)};
+// const size_t layout_VkPhysicalDeviceShadingRateImageFeaturesNV[] = {sizeof(VkPhysicalDeviceShadingRateImageFeaturesNV), _Alignof(VkPhysicalDeviceShadingRateImageFeaturesNV), offsetof(VkPhysicalDeviceShadingRateImageFeaturesNV, sType), offsetof(VkPhysicalDeviceShadingRateImageFeaturesNV, pNext), offsetof(VkPhysicalDeviceShadingRateImageFeaturesNV, shadingRateImage), offsetof(VkPhysicalDeviceShadingRateImageFeaturesNV, shadingRateCoarseSampleOrder)};
+// const size_t layout_VkPhysicalDeviceShadingRateImagePropertiesNV[] = {sizeof(VkPhysicalDeviceShadingRateImagePropertiesNV), _Alignof(VkPhysicalDeviceShadingRateImagePropertiesNV), offsetof(VkPhysicalDeviceShadingRateImagePropertiesNV, sType), offsetof(VkPhysicalDeviceShadingRateImagePropertiesNV, pNext), offsetof(VkPhysicalDeviceShadingRateImagePropertiesNV, shadingRateTexelSize), offsetof(VkPhysicalDeviceShadingRateImagePropertiesNV, shadingRatePaletteSize), offsetof(VkPhysicalDeviceShadingRateImagePropertiesNV, shadingRateMaxCoarseSamples)};
+// const size_t layout_VkCoarseSampleLocationNV[] = {sizeof(VkCoarseSampleLocationNV), _Alignof(VkCoarseSampleLocationNV), offsetof(VkCoarseSampleLocationNV, pixelX), offsetof(VkCoarseSampleLocationNV, pixelY), offsetof(VkCoarseSampleLocationNV, sample)};
+// const size_t layout_VkCoarseSampleOrderCustomNV[] = {sizeof(VkCoarseSampleOrderCustomNV), _Alignof(VkCoarseSampleOrderCustomNV), offsetof(VkCoarseSampleOrderCustomNV, shadingRate), offsetof(VkCoarseSampleOrderCustomNV, sampleCount), offsetof(VkCoarseSampleOrderCustomNV, sampleLocationCount), offsetof(VkCoarseSampleOrderCustomNV, pSampleLocations)};
+// const size_t layout_VkPipelineViewportCoarseSampleOrderStateCreateInfoNV[] = {sizeof(VkPipelineViewportCoarseSampleOrderStateCreateInfoNV), _Alignof(VkPipelineViewportCoarseSampleOrderStateCreateInfoNV), offsetof(VkPipelineViewportCoarseSampleOrderStateCreateInfoNV, sType), offsetof(VkPipelineViewportCoarseSampleOrderStateCreateInfoNV, pNext), offsetof(VkPipelineViewportCoarseSampleOrderStateCreateInfoNV, sampleOrderType), offsetof(VkPipelineViewportCoarseSampleOrderStateCreateInfoNV, customSampleOrderCount), offsetof(VkPipelineViewportCoarseSampleOrderStateCreateInfoNV, pCustomSampleOrders)};
+// const size_t layout_VkRayTracingShaderGroupCreateInfoNV[] = {sizeof(VkRayTracingShaderGroupCreateInfoNV), _Alignof(VkRayTracingShaderGroupCreateInfoNV), offsetof(VkRayTracingShaderGroupCreateInfoNV, sType), offsetof(VkRayTracingShaderGroupCreateInfoNV, pNext), offsetof(VkRayTracingShaderGroupCreateInfoNV, type), offsetof(VkRayTracingShaderGroupCreateInfoNV, generalShader), offsetof(VkRayTracingShaderGroupCreateInfoNV, closestHitShader), offsetof(VkRayTracingShaderGroupCreateInfoNV, anyHitShader), offsetof(VkRayTracingShaderGroupCreateInfoNV, intersectionShader)};
+// const size_t layout_VkRayTracingPipelineCreateInfoNV[] = {sizeof(VkRayTracingPipelineCreateInfoNV), _Alignof(VkRayTracingPipelineCreateInfoNV), offsetof(VkRayTracingPipelineCreateInfoNV, sType), offsetof(VkRayTracingPipelineCreateInfoNV, pNext), offsetof(VkRayTracingPipelineCreateInfoNV, flags), offsetof(VkRayTracingPipelineCreateInfoNV, stageCount), offsetof(VkRayTracingPipelineCreateInfoNV, pStages), offsetof(VkRayTracingPipelineCreateInfoNV, groupCount), offsetof(VkRayTracingPipelineCreateInfoNV, pGroups), offsetof(VkRayTracingPipelineCreateInfoNV, maxRecursionDepth), offsetof(VkRayTracingPipelineCreateInfoNV, layout), offsetof(VkRayTracingPipelineCreateInfoNV, basePipelineHandle), offsetof(VkRayTracingPipelineCreateInfoNV, basePipelineIndex)};
+// const size_t layout_VkGeometryTrianglesNV[] = {sizeof(VkGeometryTrianglesNV), _Alignof(VkGeometryTrianglesNV), offsetof(VkGeometryTrianglesNV, sType), offsetof(VkGeometryTrianglesNV, pNext), offsetof(VkGeometryTrianglesNV, vertexData), offsetof(VkGeometryTrianglesNV, vertexOffset), offsetof(VkGeometryTrianglesNV, vertexCount), offsetof(VkGeometryTrianglesNV, vertexStride), offsetof(VkGeometryTrianglesNV, vertexFormat), offsetof(VkGeometryTrianglesNV, indexData), offsetof(VkGeometryTrianglesNV, indexOffset), offsetof(VkGeometryTrianglesNV, indexCount), offsetof(VkGeometryTrianglesNV, indexType), offsetof(VkGeometryTrianglesNV, transformData), offsetof(VkGeometryTrianglesNV, transformOffset)};
+// const size_t layout_VkGeometryAABBNV[] = {sizeof(VkGeometryAABBNV), _Alignof(VkGeometryAABBNV), offsetof(VkGeometryAABBNV, sType), offsetof(VkGeometryAABBNV, pNext), offsetof(VkGeometryAABBNV, aabbData), offsetof(VkGeometryAABBNV, numAABBs), offsetof(VkGeometryAABBNV, stride), offsetof(VkGeometryAABBNV, offset)};
+// const size_t layout_VkGeometryDataNV[] = {sizeof(VkGeometryDataNV), _Alignof(VkGeometryDataNV), offsetof(VkGeometryDataNV, triangles), offsetof(VkGeometryDataNV, aabbs)};
+// const size_t layout_VkGeometryNV[] = {sizeof(VkGeometryNV), _Alignof(VkGeometryNV), offsetof(VkGeometryNV, sType), offsetof(VkGeometryNV, pNext), offsetof(VkGeometryNV, geometryType), offsetof(VkGeometryNV, geometry), offsetof(VkGeometryNV, flags)};
+// const size_t layout_VkAccelerationStructureInfoNV[] = {sizeof(VkAccelerationStructureInfoNV), _Alignof(VkAccelerationStructureInfoNV), offsetof(VkAccelerationStructureInfoNV, sType), offsetof(VkAccelerationStructureInfoNV, pNext), offsetof(VkAccelerationStructureInfoNV, type), offsetof(VkAccelerationStructureInfoNV, flags), offsetof(VkAccelerationStructureInfoNV, instanceCount), offsetof(VkAccelerationStructureInfoNV, geometryCount), offsetof(VkAccelerationStructureInfoNV, pGeometries)};
+// const size_t layout_VkAccelerationStructureCreateInfoNV[] = {sizeof(VkAccelerationStructureCreateInfoNV), _Alignof(VkAccelerationStructureCreateInfoNV), offsetof(VkAccelerationStructureCreateInfoNV, sType), offsetof(VkAccelerationStructureCreateInfoNV, pNext), offsetof(VkAccelerationStructureCreateInfoNV, compactedSize), offsetof(VkAccelerationStructureCreateInfoNV, info)};
+// const size_t layout_VkBindAccelerationStructureMemoryInfoNV[] = {sizeof(VkBindAccelerationStructureMemoryInfoNV), _Alignof(VkBindAccelerationStructureMemoryInfoNV), offsetof(VkBindAccelerationStructureMemoryInfoNV, sType), offsetof(VkBindAccelerationStructureMemoryInfoNV, pNext), offsetof(VkBindAccelerationStructureMemoryInfoNV, accelerationStructure), offsetof(VkBindAccelerationStructureMemoryInfoNV, memory), offsetof(VkBindAccelerationStructureMemoryInfoNV, memoryOffset), offsetof(VkBindAccelerationStructureMemoryInfoNV, deviceIndexCount), offsetof(VkBindAccelerationStructureMemoryInfoNV, pDeviceIndices)};
+// const size_t layout_VkWriteDescriptorSetAccelerationStructureNV[] = {sizeof(VkWriteDescriptorSetAccelerationStructureNV), _Alignof(VkWriteDescriptorSetAccelerationStructureNV), offsetof(VkWriteDescriptorSetAccelerationStructureNV, sType), offsetof(VkWriteDescriptorSetAccelerationStructureNV, pNext), offsetof(VkWriteDescriptorSetAccelerationStructureNV, accelerationStructureCount), offsetof(VkWriteDescriptorSetAccelerationStructureNV, pAccelerationStructures)};
+// const size_t layout_VkAccelerationStructureMemoryRequirementsInfoNV[] = {sizeof(VkAccelerationStructureMemoryRequirementsInfoNV), _Alignof(VkAccelerationStructureMemoryRequirementsInfoNV), offsetof(VkAccelerationStructureMemoryRequirementsInfoNV, sType), offsetof(VkAccelerationStructureMemoryRequirementsInfoNV, pNext), offsetof(VkAccelerationStructureMemoryRequirementsInfoNV, type), offsetof(VkAccelerationStructureMemoryRequirementsInfoNV, accelerationStructure)};
+// const size_t layout_VkPhysicalDeviceRayTracingPropertiesNV[] = {sizeof(VkPhysicalDeviceRayTracingPropertiesNV), _Alignof(VkPhysicalDeviceRayTracingPropertiesNV), offsetof(VkPhysicalDeviceRayTracingPropertiesNV, sType), offsetof(VkPhysicalDeviceRayTracingPropertiesNV, pNext), offsetof(VkPhysicalDeviceRayTracingPropertiesNV, shaderGroupHandleSize), offsetof(VkPhysicalDeviceRayTracingPropertiesNV, maxRecursionDepth), offsetof(VkPhysicalDeviceRayTracingPropertiesNV, maxShaderGroupStride), offsetof(VkPhysicalDeviceRayTracingPropertiesNV, shaderGroupBaseAlignment), offsetof(VkPhysicalDeviceRayTracingPropertiesNV, maxGeometryCount), offsetof(VkPhysicalDeviceRayTracingPropertiesNV, maxInstanceCount), offsetof(VkPhysicalDeviceRayTracingPropertiesNV, maxTriangleCount), offsetof(VkPhysicalDeviceRayTracingPropertiesNV, maxDescriptorSetAccelerationStructures)};
+// const size_t layout_VkPhysicalDeviceRepresentativeFragmentTestFeaturesNV[] = {sizeof(VkPhysicalDeviceRepresentativeFragmentTestFeaturesNV), _Alignof(VkPhysicalDeviceRepresentativeFragmentTestFeaturesNV), offsetof(VkPhysicalDeviceRepresentativeFragmentTestFeaturesNV, sType), offsetof(VkPhysicalDeviceRepresentativeFragmentTestFeaturesNV, pNext), offsetof(VkPhysicalDeviceRepresentativeFragmentTestFeaturesNV, representativeFragmentTest)};
+// const size_t layout_VkPipelineRepresentativeFragmentTestStateCreateInfoNV[] = {sizeof(VkPipelineRepresentativeFragmentTestStateCreateInfoNV), _Alignof(VkPipelineRepresentativeFragmentTestStateCreateInfoNV), offsetof(VkPipelineRepresentativeFragmentTestStateCreateInfoNV, sType), offsetof(VkPipelineRepresentativeFragmentTestStateCreateInfoNV, pNext), offsetof(VkPipelineRepresentativeFragmentTestStateCreateInfoNV, representativeFragmentTestEnable)};
+// const size_t layout_VkPhysicalDeviceImageViewImageFormatInfoEXT[] = {sizeof(VkPhysicalDeviceImageViewImageFormatInfoEXT), _Alignof(VkPhysicalDeviceImageViewImageFormatInfoEXT), offsetof(VkPhysicalDeviceImageViewImageFormatInfoEXT, sType), offsetof(VkPhysicalDeviceImageViewImageFormatInfoEXT, pNext), offsetof(VkPhysicalDeviceImageViewImageFormatInfoEXT, imageViewType)};
+// const size_t layout_VkFilterCubicImageViewImageFormatPropertiesEXT[] = {sizeof(VkFilterCubicImageViewImageFormatPropertiesEXT), _Alignof(VkFilterCubicImageViewImageFormatPropertiesEXT), offsetof(VkFilterCubicImageViewImageFormatPropertiesEXT, sType), offsetof(VkFilterCubicImageViewImageFormatPropertiesEXT, pNext), offsetof(VkFilterCubicImageViewImageFormatPropertiesEXT, filterCubic), offsetof(VkFilterCubicImageViewImageFormatPropertiesEXT, filterCubicMinmax)};
+// const size_t layout_VkImportMemoryHostPointerInfoEXT[] = {sizeof(VkImportMemoryHostPointerInfoEXT), _Alignof(VkImportMemoryHostPointerInfoEXT), offsetof(VkImportMemoryHostPointerInfoEXT, sType), offsetof(VkImportMemoryHostPointerInfoEXT, pNext), offsetof(VkImportMemoryHostPointerInfoEXT, handleType), offsetof(VkImportMemoryHostPointerInfoEXT, pHostPointer)};
+// const size_t layout_VkMemoryHostPointerPropertiesEXT[] = {sizeof(VkMemoryHostPointerPropertiesEXT), _Alignof(VkMemoryHostPointerPropertiesEXT), offsetof(VkMemoryHostPointerPropertiesEXT, sType), offsetof(VkMemoryHostPointerPropertiesEXT, pNext), offsetof(VkMemoryHostPointerPropertiesEXT, memoryTypeBits)};
+// const size_t layout_VkPhysicalDeviceExternalMemoryHostPropertiesEXT[] = {sizeof(VkPhysicalDeviceExternalMemoryHostPropertiesEXT), _Alignof(VkPhysicalDeviceExternalMemoryHostPropertiesEXT), offsetof(VkPhysicalDeviceExternalMemoryHostPropertiesEXT, sType), offsetof(VkPhysicalDeviceExternalMemoryHostPropertiesEXT, pNext), offsetof(VkPhysicalDeviceExternalMemoryHostPropertiesEXT, minImportedHostPointerAlignment)};
+// const size_t layout_VkPipelineCompilerControlCreateInfoAMD[] = {sizeof(VkPipelineCompilerControlCreateInfoAMD), _Alignof(VkPipelineCompilerControlCreateInfoAMD), offsetof(VkPipelineCompilerControlCreateInfoAMD, sType), offsetof(VkPipelineCompilerControlCreateInfoAMD, pNext), offsetof(VkPipelineCompilerControlCreateInfoAMD, compilerControlFlags)};
+// const size_t layout_VkCalibratedTimestampInfoEXT[] = {sizeof(VkCalibratedTimestampInfoEXT), _Alignof(VkCalibratedTimestampInfoEXT), offsetof(VkCalibratedTimestampInfoEXT, sType), offsetof(VkCalibratedTimestampInfoEXT, pNext), offsetof(VkCalibratedTimestampInfoEXT, timeDomain)};
+// const size_t layout_VkPhysicalDeviceShaderCorePropertiesAMD[] = {sizeof(VkPhysicalDeviceShaderCorePropertiesAMD), _Alignof(VkPhysicalDeviceShaderCorePropertiesAMD), offsetof(VkPhysicalDeviceShaderCorePropertiesAMD, sType), offsetof(VkPhysicalDeviceShaderCorePropertiesAMD, pNext), offsetof(VkPhysicalDeviceShaderCorePropertiesAMD, shaderEngineCount), offsetof(VkPhysicalDeviceShaderCorePropertiesAMD, shaderArraysPerEngineCount), offsetof(VkPhysicalDeviceShaderCorePropertiesAMD, computeUnitsPerShaderArray), offsetof(VkPhysicalDeviceShaderCorePropertiesAMD, simdPerComputeUnit), offsetof(VkPhysicalDeviceShaderCorePropertiesAMD, wavefrontsPerSimd), offsetof(VkPhysicalDeviceShaderCorePropertiesAMD, wavefrontSize), offsetof(VkPhysicalDeviceShaderCorePropertiesAMD, sgprsPerSimd), offsetof(VkPhysicalDeviceShaderCorePropertiesAMD, minSgprAllocation), offsetof(VkPhysicalDeviceShaderCorePropertiesAMD, maxSgprAllocation), offsetof(VkPhysicalDeviceShaderCorePropertiesAMD, sgprAllocationGranularity), offsetof(VkPhysicalDeviceShaderCorePropertiesAMD, vgprsPerSimd), offsetof(VkPhysicalDeviceShaderCorePropertiesAMD, minVgprAllocation), offsetof(VkPhysicalDeviceShaderCorePropertiesAMD, maxVgprAllocation), offsetof(VkPhysicalDeviceShaderCorePropertiesAMD, vgprAllocationGranularity)};
+// const size_t layout_VkDeviceMemoryOverallocationCreateInfoAMD[] = {sizeof(VkDeviceMemoryOverallocationCreateInfoAMD), _Alignof(VkDeviceMemoryOverallocationCreateInfoAMD), offsetof(VkDeviceMemoryOverallocationCreateInfoAMD, sType), offsetof(VkDeviceMemoryOverallocationCreateInfoAMD, pNext), offsetof(VkDeviceMemoryOverallocationCreateInfoAMD, overallocationBehavior)};
+// const size_t layout_VkPhysicalDeviceVertexAttributeDivisorPropertiesEXT[] = {sizeof(VkPhysicalDeviceVertexAttributeDivisorPropertiesEXT), _Alignof(VkPhysicalDeviceVertexAttributeDivisorPropertiesEXT), offsetof(VkPhysicalDeviceVertexAttributeDivisorPropertiesEXT, sType), offsetof(VkPhysicalDeviceVertexAttributeDivisorPropertiesEXT, pNext), offsetof(VkPhysicalDeviceVertexAttributeDivisorPropertiesEXT, maxVertexAttribDivisor)};
+// const size_t layout_VkVertexInputBindingDivisorDescriptionEXT[] = {sizeof(VkVertexInputBindingDivisorDescriptionEXT), _Alignof(VkVertexInputBindingDivisorDescriptionEXT), offsetof(VkVertexInputBindingDivisorDescriptionEXT, binding), offsetof(VkVertexInputBindingDivisorDescriptionEXT, divisor)};
+// const size_t layout_VkPipelineVertexInputDivisorStateCreateInfoEXT[] = {sizeof(VkPipelineVertexInputDivisorStateCreateInfoEXT), _Alignof(VkPipelineVertexInputDivisorStateCreateInfoEXT), offsetof(VkPipelineVertexInputDivisorStateCreateInfoEXT, sType), offsetof(VkPipelineVertexInputDivisorStateCreateInfoEXT, pNext), offsetof(VkPipelineVertexInputDivisorStateCreateInfoEXT, vertexBindingDivisorCount), offsetof(VkPipelineVertexInputDivisorStateCreateInfoEXT, pVertexBindingDivisors)};
+// const size_t layout_VkPhysicalDeviceVertexAttributeDivisorFeaturesEXT[] = {sizeof(VkPhysicalDeviceVertexAttributeDivisorFeaturesEXT), _Alignof(VkPhysicalDeviceVertexAttributeDivisorFeaturesEXT), offsetof(VkPhysicalDeviceVertexAttributeDivisorFeaturesEXT, sType), offsetof(VkPhysicalDeviceVertexAttributeDivisorFeaturesEXT, pNext), offsetof(VkPhysicalDeviceVertexAttributeDivisorFeaturesEXT, vertexAttributeInstanceRateDivisor), offsetof(VkPhysicalDeviceVertexAttributeDivisorFeaturesEXT, vertexAttributeInstanceRateZeroDivisor)};
+// const size_t layout_VkPhysicalDeviceComputeShaderDerivativesFeaturesNV[] = {sizeof(VkPhysicalDeviceComputeShaderDerivativesFeaturesNV), _Alignof(VkPhysicalDeviceComputeShaderDerivativesFeaturesNV), offsetof(VkPhysicalDeviceComputeShaderDerivativesFeaturesNV, sType), offsetof(VkPhysicalDeviceComputeShaderDerivativesFeaturesNV, pNext), offsetof(VkPhysicalDeviceComputeShaderDerivativesFeaturesNV, computeDerivativeGroupQuads), offsetof(VkPhysicalDeviceComputeShaderDerivativesFeaturesNV, computeDerivativeGroupLinear)};
+// const size_t layout_VkPhysicalDeviceMeshShaderFeaturesNV[] = {sizeof(VkPhysicalDeviceMeshShaderFeaturesNV), _Alignof(VkPhysicalDeviceMeshShaderFeaturesNV), offsetof(VkPhysicalDeviceMeshShaderFeaturesNV, sType), offsetof(VkPhysicalDeviceMeshShaderFeaturesNV, pNext), offsetof(VkPhysicalDeviceMeshShaderFeaturesNV, taskShader), offsetof(VkPhysicalDeviceMeshShaderFeaturesNV, meshShader)};
+// const size_t layout_VkPhysicalDeviceMeshShaderPropertiesNV[] = {sizeof(VkPhysicalDeviceMeshShaderPropertiesNV), _Alignof(VkPhysicalDeviceMeshShaderPropertiesNV), offsetof(VkPhysicalDeviceMeshShaderPropertiesNV, sType), offsetof(VkPhysicalDeviceMeshShaderPropertiesNV, pNext), offsetof(VkPhysicalDeviceMeshShaderPropertiesNV, maxDrawMeshTasksCount), offsetof(VkPhysicalDeviceMeshShaderPropertiesNV, maxTaskWorkGroupInvocations), offsetof(VkPhysicalDeviceMeshShaderPropertiesNV, maxTaskWorkGroupSize), offsetof(VkPhysicalDeviceMeshShaderPropertiesNV, maxTaskTotalMemorySize), offsetof(VkPhysicalDeviceMeshShaderPropertiesNV, maxTaskOutputCount), offsetof(VkPhysicalDeviceMeshShaderPropertiesNV, maxMeshWorkGroupInvocations), offsetof(VkPhysicalDeviceMeshShaderPropertiesNV, maxMeshWorkGroupSize), offsetof(VkPhysicalDeviceMeshShaderPropertiesNV, maxMeshTotalMemorySize), offsetof(VkPhysicalDeviceMeshShaderPropertiesNV, maxMeshOutputVertices), offsetof(VkPhysicalDeviceMeshShaderPropertiesNV, maxMeshOutputPrimitives), offsetof(VkPhysicalDeviceMeshShaderPropertiesNV, maxMeshMultiviewViewCount), offsetof(VkPhysicalDeviceMeshShaderPropertiesNV, meshOutputPerVertexGranularity), offsetof(VkPhysicalDeviceMeshShaderPropertiesNV, meshOutputPerPrimitiveGranularity)};
+// const size_t layout_VkDrawMeshTasksIndirectCommandNV[] = {sizeof(VkDrawMeshTasksIndirectCommandNV), _Alignof(VkDrawMeshTasksIndirectCommandNV), offsetof(VkDrawMeshTasksIndirectCommandNV, taskCount), offsetof(VkDrawMeshTasksIndirectCommandNV, firstTask)};
+// const size_t layout_VkPhysicalDeviceShaderImageFootprintFeaturesNV[] = {sizeof(VkPhysicalDeviceShaderImageFootprintFeaturesNV), _Alignof(VkPhysicalDeviceShaderImageFootprintFeaturesNV), offsetof(VkPhysicalDeviceShaderImageFootprintFeaturesNV, sType), offsetof(VkPhysicalDeviceShaderImageFootprintFeaturesNV, pNext), offsetof(VkPhysicalDeviceShaderImageFootprintFeaturesNV, imageFootprint)};
+// const size_t layout_VkPipelineViewportExclusiveScissorStateCreateInfoNV[] = {sizeof(VkPipelineViewportExclusiveScissorStateCreateInfoNV), _Alignof(VkPipelineViewportExclusiveScissorStateCreateInfoNV), offsetof(VkPipelineViewportExclusiveScissorStateCreateInfoNV, sType), offsetof(VkPipelineViewportExclusiveScissorStateCreateInfoNV, pNext), offsetof(VkPipelineViewportExclusiveScissorStateCreateInfoNV, exclusiveScissorCount), offsetof(VkPipelineViewportExclusiveScissorStateCreateInfoNV, pExclusiveScissors)};
+// const size_t layout_VkPhysicalDeviceExclusiveScissorFeaturesNV[] = {sizeof(VkPhysicalDeviceExclusiveScissorFeaturesNV), _Alignof(VkPhysicalDeviceExclusiveScissorFeaturesNV), offsetof(VkPhysicalDeviceExclusiveScissorFeaturesNV, sType), offsetof(VkPhysicalDeviceExclusiveScissorFeaturesNV, pNext), offsetof(VkPhysicalDeviceExclusiveScissorFeaturesNV, exclusiveScissor)};
+// const size_t layout_VkQueueFamilyCheckpointPropertiesNV[] = {sizeof(VkQueueFamilyCheckpointPropertiesNV), _Alignof(VkQueueFamilyCheckpointPropertiesNV), offsetof(VkQueueFamilyCheckpointPropertiesNV, sType), offsetof(VkQueueFamilyCheckpointPropertiesNV, pNext), offsetof(VkQueueFamilyCheckpointPropertiesNV, checkpointExecutionStageMask)};
+// const size_t layout_VkCheckpointDataNV[] = {sizeof(VkCheckpointDataNV), _Alignof(VkCheckpointDataNV), offsetof(VkCheckpointDataNV, sType), offsetof(VkCheckpointDataNV, pNext), offsetof(VkCheckpointDataNV, stage), offsetof(VkCheckpointDataNV, pCheckpointMarker)};
+// const size_t layout_VkPhysicalDeviceShaderIntegerFunctions2FeaturesINTEL[] = {sizeof(VkPhysicalDeviceShaderIntegerFunctions2FeaturesINTEL), _Alignof(VkPhysicalDeviceShaderIntegerFunctions2FeaturesINTEL), offsetof(VkPhysicalDeviceShaderIntegerFunctions2FeaturesINTEL, sType), offsetof(VkPhysicalDeviceShaderIntegerFunctions2FeaturesINTEL, pNext), offsetof(VkPhysicalDeviceShaderIntegerFunctions2FeaturesINTEL, shaderIntegerFunctions2)};
+// const size_t layout_VkPerformanceValueDataINTEL[] = {sizeof(VkPerformanceValueDataINTEL), _Alignof(VkPerformanceValueDataINTEL)};
+// const size_t layout_VkPerformanceValueINTEL[] = {sizeof(VkPerformanceValueINTEL), _Alignof(VkPerformanceValueINTEL), offsetof(VkPerformanceValueINTEL, type), offsetof(VkPerformanceValueINTEL, data)};
+// const size_t layout_VkInitializePerformanceApiInfoINTEL[] = {sizeof(VkInitializePerformanceApiInfoINTEL), _Alignof(VkInitializePerformanceApiInfoINTEL), offsetof(VkInitializePerformanceApiInfoINTEL, sType), offsetof(VkInitializePerformanceApiInfoINTEL, pNext), offsetof(VkInitializePerformanceApiInfoINTEL, pUserData)};
+// const size_t layout_VkQueryPoolPerformanceQueryCreateInfoINTEL[] = {sizeof(VkQueryPoolPerformanceQueryCreateInfoINTEL), _Alignof(VkQueryPoolPerformanceQueryCreateInfoINTEL), offsetof(VkQueryPoolPerformanceQueryCreateInfoINTEL, sType), offsetof(VkQueryPoolPerformanceQueryCreateInfoINTEL, pNext), offsetof(VkQueryPoolPerformanceQueryCreateInfoINTEL, performanceCountersSampling)};
+// const size_t layout_VkPerformanceMarkerInfoINTEL[] = {sizeof(VkPerformanceMarkerInfoINTEL), _Alignof(VkPerformanceMarkerInfoINTEL), offsetof(VkPerformanceMarkerInfoINTEL, sType), offsetof(VkPerformanceMarkerInfoINTEL, pNext), offsetof(VkPerformanceMarkerInfoINTEL, marker)};
+// const size_t layout_VkPerformanceStreamMarkerInfoINTEL[] = {sizeof(VkPerformanceStreamMarkerInfoINTEL), _Alignof(VkPerformanceStreamMarkerInfoINTEL), offsetof(VkPerformanceStreamMarkerInfoINTEL, sType), offsetof(VkPerformanceStreamMarkerInfoINTEL, pNext), offsetof(VkPerformanceStreamMarkerInfoINTEL, marker)};
+// const size_t layout_VkPerformanceOverrideInfoINTEL[] = {sizeof(VkPerformanceOverrideInfoINTEL), _Alignof(VkPerformanceOverrideInfoINTEL), offsetof(VkPerformanceOverrideInfoINTEL, sType), offsetof(VkPerformanceOverrideInfoINTEL, pNext), offsetof(VkPerformanceOverrideInfoINTEL, type), offsetof(VkPerformanceOverrideInfoINTEL, enable), offsetof(VkPerformanceOverrideInfoINTEL, parameter)};
+// const size_t layout_VkPerformanceConfigurationAcquireInfoINTEL[] = {sizeof(VkPerformanceConfigurationAcquireInfoINTEL), _Alignof(VkPerformanceConfigurationAcquireInfoINTEL), offsetof(VkPerformanceConfigurationAcquireInfoINTEL, sType), offsetof(VkPerformanceConfigurationAcquireInfoINTEL, pNext), offsetof(VkPerformanceConfigurationAcquireInfoINTEL, type)};
+// const size_t layout_VkPhysicalDevicePCIBusInfoPropertiesEXT[] = {sizeof(VkPhysicalDevicePCIBusInfoPropertiesEXT), _Alignof(VkPhysicalDevicePCIBusInfoPropertiesEXT), offsetof(VkPhysicalDevicePCIBusInfoPropertiesEXT, sType), offsetof(VkPhysicalDevicePCIBusInfoPropertiesEXT, pNext), offsetof(VkPhysicalDevicePCIBusInfoPropertiesEXT, pciDomain), offsetof(VkPhysicalDevicePCIBusInfoPropertiesEXT, pciBus), offsetof(VkPhysicalDevicePCIBusInfoPropertiesEXT, pciDevice), offsetof(VkPhysicalDevicePCIBusInfoPropertiesEXT, pciFunction)};
+// const size_t layout_VkDisplayNativeHdrSurfaceCapabilitiesAMD[] = {sizeof(VkDisplayNativeHdrSurfaceCapabilitiesAMD), _Alignof(VkDisplayNativeHdrSurfaceCapabilitiesAMD), offsetof(VkDisplayNativeHdrSurfaceCapabilitiesAMD, sType), offsetof(VkDisplayNativeHdrSurfaceCapabilitiesAMD, pNext), offsetof(VkDisplayNativeHdrSurfaceCapabilitiesAMD, localDimmingSupport)};
+// const size_t layout_VkSwapchainDisplayNativeHdrCreateInfoAMD[] = {sizeof(VkSwapchainDisplayNativeHdrCreateInfoAMD), _Alignof(VkSwapchainDisplayNativeHdrCreateInfoAMD), offsetof(VkSwapchainDisplayNativeHdrCreateInfoAMD, sType), offsetof(VkSwapchainDisplayNativeHdrCreateInfoAMD, pNext), offsetof(VkSwapchainDisplayNativeHdrCreateInfoAMD, localDimmingEnable)};
+// const size_t layout_VkPhysicalDeviceFragmentDensityMapFeaturesEXT[] = {sizeof(VkPhysicalDeviceFragmentDensityMapFeaturesEXT), _Alignof(VkPhysicalDeviceFragmentDensityMapFeaturesEXT), offsetof(VkPhysicalDeviceFragmentDensityMapFeaturesEXT, sType), offsetof(VkPhysicalDeviceFragmentDensityMapFeaturesEXT, pNext), offsetof(VkPhysicalDeviceFragmentDensityMapFeaturesEXT, fragmentDensityMap), offsetof(VkPhysicalDeviceFragmentDensityMapFeaturesEXT, fragmentDensityMapDynamic), offsetof(VkPhysicalDeviceFragmentDensityMapFeaturesEXT, fragmentDensityMapNonSubsampledImages)};
+// const size_t layout_VkPhysicalDeviceFragmentDensityMapPropertiesEXT[] = {sizeof(VkPhysicalDeviceFragmentDensityMapPropertiesEXT), _Alignof(VkPhysicalDeviceFragmentDensityMapPropertiesEXT), offsetof(VkPhysicalDeviceFragmentDensityMapPropertiesEXT, sType), offsetof(VkPhysicalDeviceFragmentDensityMapPropertiesEXT, pNext), offsetof(VkPhysicalDeviceFragmentDensityMapPropertiesEXT, minFragmentDensityTexelSize), offsetof(VkPhysicalDeviceFragmentDensityMapPropertiesEXT, maxFragmentDensityTexelSize), offsetof(VkPhysicalDeviceFragmentDensityMapPropertiesEXT, fragmentDensityInvocations)};
+// const size_t layout_VkRenderPassFragmentDensityMapCreateInfoEXT[] = {sizeof(VkRenderPassFragmentDensityMapCreateInfoEXT), _Alignof(VkRenderPassFragmentDensityMapCreateInfoEXT), offsetof(VkRenderPassFragmentDensityMapCreateInfoEXT, sType), offsetof(VkRenderPassFragmentDensityMapCreateInfoEXT, pNext), offsetof(VkRenderPassFragmentDensityMapCreateInfoEXT, fragmentDensityMapAttachment)};
+// const size_t layout_VkPhysicalDeviceShaderCoreProperties2AMD[] = {sizeof(VkPhysicalDeviceShaderCoreProperties2AMD), _Alignof(VkPhysicalDeviceShaderCoreProperties2AMD), offsetof(VkPhysicalDeviceShaderCoreProperties2AMD, sType), offsetof(VkPhysicalDeviceShaderCoreProperties2AMD, pNext), offsetof(VkPhysicalDeviceShaderCoreProperties2AMD, shaderCoreFeatures), offsetof(VkPhysicalDeviceShaderCoreProperties2AMD, activeComputeUnitCount)};
+// const size_t layout_VkPhysicalDeviceCoherentMemoryFeaturesAMD[] = {sizeof(VkPhysicalDeviceCoherentMemoryFeaturesAMD), _Alignof(VkPhysicalDeviceCoherentMemoryFeaturesAMD), offsetof(VkPhysicalDeviceCoherentMemoryFeaturesAMD, sType), offsetof(VkPhysicalDeviceCoherentMemoryFeaturesAMD, pNext), offsetof(VkPhysicalDeviceCoherentMemoryFeaturesAMD, deviceCoherentMemory)};
+// const size_t layout_VkPhysicalDeviceShaderImageAtomicInt64FeaturesEXT[] = {sizeof(VkPhysicalDeviceShaderImageAtomicInt64FeaturesEXT), _Alignof(VkPhysicalDeviceShaderImageAtomicInt64FeaturesEXT), offsetof(VkPhysicalDeviceShaderImageAtomicInt64FeaturesEXT, sType), offsetof(VkPhysicalDeviceShaderImageAtomicInt64FeaturesEXT, pNext), offsetof(VkPhysicalDeviceShaderImageAtomicInt64FeaturesEXT, shaderImageInt64Atomics), offsetof(VkPhysicalDeviceShaderImageAtomicInt64FeaturesEXT, sparseImageInt64Atomics)};
+// const size_t layout_VkPhysicalDeviceMemoryBudgetPropertiesEXT[] = {sizeof(VkPhysicalDeviceMemoryBudgetPropertiesEXT), _Alignof(VkPhysicalDeviceMemoryBudgetPropertiesEXT), offsetof(VkPhysicalDeviceMemoryBudgetPropertiesEXT, sType), offsetof(VkPhysicalDeviceMemoryBudgetPropertiesEXT, pNext), offsetof(VkPhysicalDeviceMemoryBudgetPropertiesEXT, heapBudget), offsetof(VkPhysicalDeviceMemoryBudgetPropertiesEXT, heapUsage)};
+// const size_t layout_VkPhysicalDeviceMemoryPriorityFeaturesEXT[] = {sizeof(VkPhysicalDeviceMemoryPriorityFeaturesEXT), _Alignof(VkPhysicalDeviceMemoryPriorityFeaturesEXT), offsetof(VkPhysicalDeviceMemoryPriorityFeaturesEXT, sType), offsetof(VkPhysicalDeviceMemoryPriorityFeaturesEXT, pNext), offsetof(VkPhysicalDeviceMemoryPriorityFeaturesEXT, memoryPriority)};
+// const size_t layout_VkMemoryPriorityAllocateInfoEXT[] = {sizeof(VkMemoryPriorityAllocateInfoEXT), _Alignof(VkMemoryPriorityAllocateInfoEXT), offsetof(VkMemoryPriorityAllocateInfoEXT, sType), offsetof(VkMemoryPriorityAllocateInfoEXT, pNext), offsetof(VkMemoryPriorityAllocateInfoEXT, priority)};
+// const size_t layout_VkPhysicalDeviceDedicatedAllocationImageAliasingFeaturesNV[] = {sizeof(VkPhysicalDeviceDedicatedAllocationImageAliasingFeaturesNV), _Alignof(VkPhysicalDeviceDedicatedAllocationImageAliasingFeaturesNV), offsetof(VkPhysicalDeviceDedicatedAllocationImageAliasingFeaturesNV, sType), offsetof(VkPhysicalDeviceDedicatedAllocationImageAliasingFeaturesNV, pNext), offsetof(VkPhysicalDeviceDedicatedAllocationImageAliasingFeaturesNV, dedicatedAllocationImageAliasing)};
+// const size_t layout_VkPhysicalDeviceBufferDeviceAddressFeaturesEXT[] = {sizeof(VkPhysicalDeviceBufferDeviceAddressFeaturesEXT), _Alignof(VkPhysicalDeviceBufferDeviceAddressFeaturesEXT), offsetof(VkPhysicalDeviceBufferDeviceAddressFeaturesEXT, sType), offsetof(VkPhysicalDeviceBufferDeviceAddressFeaturesEXT, pNext), offsetof(VkPhysicalDeviceBufferDeviceAddressFeaturesEXT, bufferDeviceAddress), offsetof(VkPhysicalDeviceBufferDeviceAddressFeaturesEXT, bufferDeviceAddressCaptureReplay), offsetof(VkPhysicalDeviceBufferDeviceAddressFeaturesEXT, bufferDeviceAddressMultiDevice)};
+// const size_t layout_VkBufferDeviceAddressCreateInfoEXT[] = {sizeof(VkBufferDeviceAddressCreateInfoEXT), _Alignof(VkBufferDeviceAddressCreateInfoEXT), offsetof(VkBufferDeviceAddressCreateInfoEXT, sType), offsetof(VkBufferDeviceAddressCreateInfoEXT, pNext), offsetof(VkBufferDeviceAddressCreateInfoEXT, deviceAddress)};
+// const size_t layout_VkValidationFeaturesEXT[] = {sizeof(VkValidationFeaturesEXT), _Alignof(VkValidationFeaturesEXT), offsetof(VkValidationFeaturesEXT, sType), offsetof(VkValidationFeaturesEXT, pNext), offsetof(VkValidationFeaturesEXT, enabledValidationFeatureCount), offsetof(VkValidationFeaturesEXT, pEnabledValidationFeatures), offsetof(VkValidationFeaturesEXT, disabledValidationFeatureCount), offsetof(VkValidationFeaturesEXT, pDisabledValidationFeatures)};
+// const size_t layout_VkCooperativeMatrixPropertiesNV[] = {sizeof(VkCooperativeMatrixPropertiesNV), _Alignof(VkCooperativeMatrixPropertiesNV), offsetof(VkCooperativeMatrixPropertiesNV, sType), offsetof(VkCooperativeMatrixPropertiesNV, pNext), offsetof(VkCooperativeMatrixPropertiesNV, MSize), offsetof(VkCooperativeMatrixPropertiesNV, NSize), offsetof(VkCooperativeMatrixPropertiesNV, KSize), offsetof(VkCooperativeMatrixPropertiesNV, AType), offsetof(VkCooperativeMatrixPropertiesNV, BType), offsetof(VkCooperativeMatrixPropertiesNV, CType), offsetof(VkCooperativeMatrixPropertiesNV, DType), offsetof(VkCooperativeMatrixPropertiesNV, scope)};
+// const size_t layout_VkPhysicalDeviceCooperativeMatrixFeaturesNV[] = {sizeof(VkPhysicalDeviceCooperativeMatrixFeaturesNV), _Alignof(VkPhysicalDeviceCooperativeMatrixFeaturesNV), offsetof(VkPhysicalDeviceCooperativeMatrixFeaturesNV, sType), offsetof(VkPhysicalDeviceCooperativeMatrixFeaturesNV, pNext), offsetof(VkPhysicalDeviceCooperativeMatrixFeaturesNV, cooperativeMatrix), offsetof(VkPhysicalDeviceCooperativeMatrixFeaturesNV, cooperativeMatrixRobustBufferAccess)};
+// const size_t layout_VkPhysicalDeviceCooperativeMatrixPropertiesNV[] = {sizeof(VkPhysicalDeviceCooperativeMatrixPropertiesNV), _Alignof(VkPhysicalDeviceCooperativeMatrixPropertiesNV), offsetof(VkPhysicalDeviceCooperativeMatrixPropertiesNV, sType), offsetof(VkPhysicalDeviceCooperativeMatrixPropertiesNV, pNext), offsetof(VkPhysicalDeviceCooperativeMatrixPropertiesNV, cooperativeMatrixSupportedStages)};
+// const size_t layout_VkPhysicalDeviceCoverageReductionModeFeaturesNV[] = {sizeof(VkPhysicalDeviceCoverageReductionModeFeaturesNV), _Alignof(VkPhysicalDeviceCoverageReductionModeFeaturesNV), offsetof(VkPhysicalDeviceCoverageReductionModeFeaturesNV, sType), offsetof(VkPhysicalDeviceCoverageReductionModeFeaturesNV, pNext), offsetof(VkPhysicalDeviceCoverageReductionModeFeaturesNV, coverageReductionMode)};
+// const size_t layout_VkPipelineCoverageReductionStateCreateInfoNV[] = {sizeof(VkPipelineCoverageReductionStateCreateInfoNV), _Alignof(VkPipelineCoverageReductionStateCreateInfoNV), offsetof(VkPipelineCoverageReductionStateCreateInfoNV, sType), offsetof(VkPipelineCoverageReductionStateCreateInfoNV, pNext), offsetof(VkPipelineCoverageReductionStateCreateInfoNV, flags), offsetof(VkPipelineCoverageReductionStateCreateInfoNV, coverageReductionMode)};
+// const size_t layout_VkFramebufferMixedSamplesCombinationNV[] = {sizeof(VkFramebufferMixedSamplesCombinationNV), _Alignof(VkFramebufferMixedSamplesCombinationNV), offsetof(VkFramebufferMixedSamplesCombinationNV, sType), offsetof(VkFramebufferMixedSamplesCombinationNV, pNext), offsetof(VkFramebufferMixedSamplesCombinationNV, coverageReductionMode), offsetof(VkFramebufferMixedSamplesCombinationNV, rasterizationSamples), offsetof(VkFramebufferMixedSamplesCombinationNV, depthStencilSamples), offsetof(VkFramebufferMixedSamplesCombinationNV, colorSamples)};
+// const size_t layout_VkPhysicalDeviceFragmentShaderInterlockFeaturesEXT[] = {sizeof(VkPhysicalDeviceFragmentShaderInterlockFeaturesEXT), _Alignof(VkPhysicalDeviceFragmentShaderInterlockFeaturesEXT), offsetof(VkPhysicalDeviceFragmentShaderInterlockFeaturesEXT, sType), offsetof(VkPhysicalDeviceFragmentShaderInterlockFeaturesEXT, pNext), offsetof(VkPhysicalDeviceFragmentShaderInterlockFeaturesEXT, fragmentShaderSampleInterlock), offsetof(VkPhysicalDeviceFragmentShaderInterlockFeaturesEXT, fragmentShaderPixelInterlock), offsetof(VkPhysicalDeviceFragmentShaderInterlockFeaturesEXT, fragmentShaderShadingRateInterlock)};
+// const size_t layout_VkPhysicalDeviceYcbcrImageArraysFeaturesEXT[] = {sizeof(VkPhysicalDeviceYcbcrImageArraysFeaturesEXT), _Alignof(VkPhysicalDeviceYcbcrImageArraysFeaturesEXT), offsetof(VkPhysicalDeviceYcbcrImageArraysFeaturesEXT, sType), offsetof(VkPhysicalDeviceYcbcrImageArraysFeaturesEXT, pNext), offsetof(VkPhysicalDeviceYcbcrImageArraysFeaturesEXT, ycbcrImageArrays)};
+// const size_t layout_VkPhysicalDeviceProvokingVertexFeaturesEXT[] = {sizeof(VkPhysicalDeviceProvokingVertexFeaturesEXT), _Alignof(VkPhysicalDeviceProvokingVertexFeaturesEXT), offsetof(VkPhysicalDeviceProvokingVertexFeaturesEXT, sType), offsetof(VkPhysicalDeviceProvokingVertexFeaturesEXT, pNext), offsetof(VkPhysicalDeviceProvokingVertexFeaturesEXT, provokingVertexLast), offsetof(VkPhysicalDeviceProvokingVertexFeaturesEXT, transformFeedbackPreservesProvokingVertex)};
+// const size_t layout_VkPhysicalDeviceProvokingVertexPropertiesEXT[] = {sizeof(VkPhysicalDeviceProvokingVertexPropertiesEXT), _Alignof(VkPhysicalDeviceProvokingVertexPropertiesEXT), offsetof(VkPhysicalDeviceProvokingVertexPropertiesEXT, sType), offsetof(VkPhysicalDeviceProvokingVertexPropertiesEXT, pNext), offsetof(VkPhysicalDeviceProvokingVertexPropertiesEXT, provokingVertexModePerPipeline), offsetof(VkPhysicalDeviceProvokingVertexPropertiesEXT, transformFeedbackPreservesTriangleFanProvokingVertex)};
+// const size_t layout_VkPipelineRasterizationProvokingVertexStateCreateInfoEXT[] = {sizeof(VkPipelineRasterizationProvokingVertexStateCreateInfoEXT), _Alignof(VkPipelineRasterizationProvokingVertexStateCreateInfoEXT), offsetof(VkPipelineRasterizationProvokingVertexStateCreateInfoEXT, sType), offsetof(VkPipelineRasterizationProvokingVertexStateCreateInfoEXT, pNext), offsetof(VkPipelineRasterizationProvokingVertexStateCreateInfoEXT, provokingVertexMode)};
+// const size_t layout_VkHeadlessSurfaceCreateInfoEXT[] = {sizeof(VkHeadlessSurfaceCreateInfoEXT), _Alignof(VkHeadlessSurfaceCreateInfoEXT), offsetof(VkHeadlessSurfaceCreateInfoEXT, sType), offsetof(VkHeadlessSurfaceCreateInfoEXT, pNext), offsetof(VkHeadlessSurfaceCreateInfoEXT, flags)};
+// const size_t layout_VkPhysicalDeviceLineRasterizationFeaturesEXT[] = {sizeof(VkPhysicalDeviceLineRasterizationFeaturesEXT), _Alignof(VkPhysicalDeviceLineRasterizationFeaturesEXT), offsetof(VkPhysicalDeviceLineRasterizationFeaturesEXT, sType), offsetof(VkPhysicalDeviceLineRasterizationFeaturesEXT, pNext), offsetof(VkPhysicalDeviceLineRasterizationFeaturesEXT, rectangularLines), offsetof(VkPhysicalDeviceLineRasterizationFeaturesEXT, bresenhamLines), offsetof(VkPhysicalDeviceLineRasterizationFeaturesEXT, smoothLines), offsetof(VkPhysicalDeviceLineRasterizationFeaturesEXT, stippledRectangularLines), offsetof(VkPhysicalDeviceLineRasterizationFeaturesEXT, stippledBresenhamLines), offsetof(VkPhysicalDeviceLineRasterizationFeaturesEXT, stippledSmoothLines)};
+// const size_t layout_VkPhysicalDeviceLineRasterizationPropertiesEXT[] = {sizeof(VkPhysicalDeviceLineRasterizationPropertiesEXT), _Alignof(VkPhysicalDeviceLineRasterizationPropertiesEXT), offsetof(VkPhysicalDeviceLineRasterizationPropertiesEXT, sType), offsetof(VkPhysicalDeviceLineRasterizationPropertiesEXT, pNext), offsetof(VkPhysicalDeviceLineRasterizationPropertiesEXT, lineSubPixelPrecisionBits)};
+// const size_t layout_VkPipelineRasterizationLineStateCreateInfoEXT[] = {sizeof(VkPipelineRasterizationLineStateCreateInfoEXT), _Alignof(VkPipelineRasterizationLineStateCreateInfoEXT), offsetof(VkPipelineRasterizationLineStateCreateInfoEXT, sType), offsetof(VkPipelineRasterizationLineStateCreateInfoEXT, pNext), offsetof(VkPipelineRasterizationLineStateCreateInfoEXT, lineRasterizationMode), offsetof(VkPipelineRasterizationLineStateCreateInfoEXT, stippledLineEnable), offsetof(VkPipelineRasterizationLineStateCreateInfoEXT, lineStippleFactor), offsetof(VkPipelineRasterizationLineStateCreateInfoEXT, lineStipplePattern)};
+// const size_t layout_VkPhysicalDeviceShaderAtomicFloatFeaturesEXT[] = {sizeof(VkPhysicalDeviceShaderAtomicFloatFeaturesEXT), _Alignof(VkPhysicalDeviceShaderAtomicFloatFeaturesEXT), offsetof(VkPhysicalDeviceShaderAtomicFloatFeaturesEXT, sType), offsetof(VkPhysicalDeviceShaderAtomicFloatFeaturesEXT, pNext), offsetof(VkPhysicalDeviceShaderAtomicFloatFeaturesEXT, shaderBufferFloat32Atomics), offsetof(VkPhysicalDeviceShaderAtomicFloatFeaturesEXT, shaderBufferFloat32AtomicAdd), offsetof(VkPhysicalDeviceShaderAtomicFloatFeaturesEXT, shaderBufferFloat64Atomics), offsetof(VkPhysicalDeviceShaderAtomicFloatFeaturesEXT, shaderBufferFloat64AtomicAdd), offsetof(VkPhysicalDeviceShaderAtomicFloatFeaturesEXT, shaderSharedFloat32Atomics), offsetof(VkPhysicalDeviceShaderAtomicFloatFeaturesEXT, shaderSharedFloat32AtomicAdd), offsetof(VkPhysicalDeviceShaderAtomicFloatFeaturesEXT, shaderSharedFloat64Atomics), offsetof(VkPhysicalDeviceShaderAtomicFloatFeaturesEXT, shaderSharedFloat64AtomicAdd), offsetof(VkPhysicalDeviceShaderAtomicFloatFeaturesEXT, shaderImageFloat32Atomics), offsetof(VkPhysicalDeviceShaderAtomicFloatFeaturesEXT, shaderImageFloat32AtomicAdd), offsetof(VkPhysicalDeviceShaderAtomicFloatFeaturesEXT, sparseImageFloat32Atomics), offsetof(VkPhysicalDeviceShaderAtomicFloatFeaturesEXT, sparseImageFloat32AtomicAdd)};
+// const size_t layout_VkPhysicalDeviceIndexTypeUint8FeaturesEXT[] = {sizeof(VkPhysicalDeviceIndexTypeUint8FeaturesEXT), _Alignof(VkPhysicalDeviceIndexTypeUint8FeaturesEXT), offsetof(VkPhysicalDeviceIndexTypeUint8FeaturesEXT, sType), offsetof(VkPhysicalDeviceIndexTypeUint8FeaturesEXT, pNext), offsetof(VkPhysicalDeviceIndexTypeUint8FeaturesEXT, indexTypeUint8)};
+// const size_t layout_VkPhysicalDeviceExtendedDynamicStateFeaturesEXT[] = {sizeof(VkPhysicalDeviceExtendedDynamicStateFeaturesEXT), _Alignof(VkPhysicalDeviceExtendedDynamicStateFeaturesEXT), offsetof(VkPhysicalDeviceExtendedDynamicStateFeaturesEXT, sType), offsetof(VkPhysicalDeviceExtendedDynamicStateFeaturesEXT, pNext), offsetof(VkPhysicalDeviceExtendedDynamicStateFeaturesEXT, extendedDynamicState)};
+// const size_t layout_VkPhysicalDeviceShaderAtomicFloat2FeaturesEXT[] = {sizeof(VkPhysicalDeviceShaderAtomicFloat2FeaturesEXT), _Alignof(VkPhysicalDeviceShaderAtomicFloat2FeaturesEXT), offsetof(VkPhysicalDeviceShaderAtomicFloat2FeaturesEXT, sType), offsetof(VkPhysicalDeviceShaderAtomicFloat2FeaturesEXT, pNext), offsetof(VkPhysicalDeviceShaderAtomicFloat2FeaturesEXT, shaderBufferFloat16Atomics), offsetof(VkPhysicalDeviceShaderAtomicFloat2FeaturesEXT, shaderBufferFloat16AtomicAdd), offsetof(VkPhysicalDeviceShaderAtomicFloat2FeaturesEXT, shaderBufferFloat16AtomicMinMax), offsetof(VkPhysicalDeviceShaderAtomicFloat2FeaturesEXT, shaderBufferFloat32AtomicMinMax), offsetof(VkPhysicalDeviceShaderAtomicFloat2FeaturesEXT, shaderBufferFloat64AtomicMinMax), offsetof(VkPhysicalDeviceShaderAtomicFloat2FeaturesEXT, shaderSharedFloat16Atomics), offsetof(VkPhysicalDeviceShaderAtomicFloat2FeaturesEXT, shaderSharedFloat16AtomicAdd), offsetof(VkPhysicalDeviceShaderAtomicFloat2FeaturesEXT, shaderSharedFloat16AtomicMinMax), offsetof(VkPhysicalDeviceShaderAtomicFloat2FeaturesEXT, shaderSharedFloat32AtomicMinMax), offsetof(VkPhysicalDeviceShaderAtomicFloat2FeaturesEXT, shaderSharedFloat64AtomicMinMax), offsetof(VkPhysicalDeviceShaderAtomicFloat2FeaturesEXT, shaderImageFloat32AtomicMinMax), offsetof(VkPhysicalDeviceShaderAtomicFloat2FeaturesEXT, sparseImageFloat32AtomicMinMax)};
+// const size_t layout_VkSurfacePresentModeEXT[] = {sizeof(VkSurfacePresentModeEXT), _Alignof(VkSurfacePresentModeEXT), offsetof(VkSurfacePresentModeEXT, sType), offsetof(VkSurfacePresentModeEXT, pNext), offsetof(VkSurfacePresentModeEXT, presentMode)};
+// const size_t layout_VkSurfacePresentScalingCapabilitiesEXT[] = {sizeof(VkSurfacePresentScalingCapabilitiesEXT), _Alignof(VkSurfacePresentScalingCapabilitiesEXT), offsetof(VkSurfacePresentScalingCapabilitiesEXT, sType), offsetof(VkSurfacePresentScalingCapabilitiesEXT, pNext), offsetof(VkSurfacePresentScalingCapabilitiesEXT, supportedPresentScaling), offsetof(VkSurfacePresentScalingCapabilitiesEXT, supportedPresentGravityX), offsetof(VkSurfacePresentScalingCapabilitiesEXT, supportedPresentGravityY), offsetof(VkSurfacePresentScalingCapabilitiesEXT, minScaledImageExtent), offsetof(VkSurfacePresentScalingCapabilitiesEXT, maxScaledImageExtent)};
+// const size_t layout_VkSurfacePresentModeCompatibilityEXT[] = {sizeof(VkSurfacePresentModeCompatibilityEXT), _Alignof(VkSurfacePresentModeCompatibilityEXT), offsetof(VkSurfacePresentModeCompatibilityEXT, sType), offsetof(VkSurfacePresentModeCompatibilityEXT, pNext), offsetof(VkSurfacePresentModeCompatibilityEXT, presentModeCount), offsetof(VkSurfacePresentModeCompatibilityEXT, pPresentModes)};
+// const size_t layout_VkPhysicalDeviceSwapchainMaintenance1FeaturesEXT[] = {sizeof(VkPhysicalDeviceSwapchainMaintenance1FeaturesEXT), _Alignof(VkPhysicalDeviceSwapchainMaintenance1FeaturesEXT), offsetof(VkPhysicalDeviceSwapchainMaintenance1FeaturesEXT, sType), offsetof(VkPhysicalDeviceSwapchainMaintenance1FeaturesEXT, pNext), offsetof(VkPhysicalDeviceSwapchainMaintenance1FeaturesEXT, swapchainMaintenance1)};
+// const size_t layout_VkSwapchainPresentFenceInfoEXT[] = {sizeof(VkSwapchainPresentFenceInfoEXT), _Alignof(VkSwapchainPresentFenceInfoEXT), offsetof(VkSwapchainPresentFenceInfoEXT, sType), offsetof(VkSwapchainPresentFenceInfoEXT, pNext), offsetof(VkSwapchainPresentFenceInfoEXT, swapchainCount), offsetof(VkSwapchainPresentFenceInfoEXT, pFences)};
+// const size_t layout_VkSwapchainPresentModesCreateInfoEXT[] = {sizeof(VkSwapchainPresentModesCreateInfoEXT), _Alignof(VkSwapchainPresentModesCreateInfoEXT), offsetof(VkSwapchainPresentModesCreateInfoEXT, sType), offsetof(VkSwapchainPresentModesCreateInfoEXT, pNext), offsetof(VkSwapchainPresentModesCreateInfoEXT, presentModeCount), offsetof(VkSwapchainPresentModesCreateInfoEXT, pPresentModes)};
+// const size_t layout_VkSwapchainPresentModeInfoEXT[] = {sizeof(VkSwapchainPresentModeInfoEXT), _Alignof(VkSwapchainPresentModeInfoEXT), offsetof(VkSwapchainPresentModeInfoEXT, sType), offsetof(VkSwapchainPresentModeInfoEXT, pNext), offsetof(VkSwapchainPresentModeInfoEXT, swapchainCount), offsetof(VkSwapchainPresentModeInfoEXT, pPresentModes)};
+// const size_t layout_VkSwapchainPresentScalingCreateInfoEXT[] = {sizeof(VkSwapchainPresentScalingCreateInfoEXT), _Alignof(VkSwapchainPresentScalingCreateInfoEXT), offsetof(VkSwapchainPresentScalingCreateInfoEXT, sType), offsetof(VkSwapchainPresentScalingCreateInfoEXT, pNext), offsetof(VkSwapchainPresentScalingCreateInfoEXT, scalingBehavior), offsetof(VkSwapchainPresentScalingCreateInfoEXT, presentGravityX), offsetof(VkSwapchainPresentScalingCreateInfoEXT, presentGravityY)};
+// const size_t layout_VkReleaseSwapchainImagesInfoEXT[] = {sizeof(VkReleaseSwapchainImagesInfoEXT), _Alignof(VkReleaseSwapchainImagesInfoEXT), offsetof(VkReleaseSwapchainImagesInfoEXT, sType), offsetof(VkReleaseSwapchainImagesInfoEXT, pNext), offsetof(VkReleaseSwapchainImagesInfoEXT, swapchain), offsetof(VkReleaseSwapchainImagesInfoEXT, imageIndexCount), offsetof(VkReleaseSwapchainImagesInfoEXT, pImageIndices)};
+// const size_t layout_VkPhysicalDeviceDeviceGeneratedCommandsPropertiesNV[] = {sizeof(VkPhysicalDeviceDeviceGeneratedCommandsPropertiesNV), _Alignof(VkPhysicalDeviceDeviceGeneratedCommandsPropertiesNV), offsetof(VkPhysicalDeviceDeviceGeneratedCommandsPropertiesNV, sType), offsetof(VkPhysicalDeviceDeviceGeneratedCommandsPropertiesNV, pNext), offsetof(VkPhysicalDeviceDeviceGeneratedCommandsPropertiesNV, maxGraphicsShaderGroupCount), offsetof(VkPhysicalDeviceDeviceGeneratedCommandsPropertiesNV, maxIndirectSequenceCount), offsetof(VkPhysicalDeviceDeviceGeneratedCommandsPropertiesNV, maxIndirectCommandsTokenCount), offsetof(VkPhysicalDeviceDeviceGeneratedCommandsPropertiesNV, maxIndirectCommandsStreamCount), offsetof(VkPhysicalDeviceDeviceGeneratedCommandsPropertiesNV, maxIndirectCommandsTokenOffset), offsetof(VkPhysicalDeviceDeviceGeneratedCommandsPropertiesNV, maxIndirectCommandsStreamStride), offsetof(VkPhysicalDeviceDeviceGeneratedCommandsPropertiesNV, minSequencesCountBufferOffsetAlignment), offsetof(VkPhysicalDeviceDeviceGeneratedCommandsPropertiesNV, minSequencesIndexBufferOffsetAlignment), offsetof(VkPhysicalDeviceDeviceGeneratedCommandsPropertiesNV, minIndirectCommandsBufferOffsetAlignment)};
+// const size_t layout_VkPhysicalDeviceDeviceGeneratedCommandsFeaturesNV[] = {sizeof(VkPhysicalDeviceDeviceGeneratedCommandsFeaturesNV), _Alignof(VkPhysicalDeviceDeviceGeneratedCommandsFeaturesNV), offsetof(VkPhysicalDeviceDeviceGeneratedCommandsFeaturesNV, sType), offsetof(VkPhysicalDeviceDeviceGeneratedCommandsFeaturesNV, pNext), offsetof(VkPhysicalDeviceDeviceGeneratedCommandsFeaturesNV, deviceGeneratedCommands)};
+// const size_t layout_VkGraphicsShaderGroupCreateInfoNV[] = {sizeof(VkGraphicsShaderGroupCreateInfoNV), _Alignof(VkGraphicsShaderGroupCreateInfoNV), offsetof(VkGraphicsShaderGroupCreateInfoNV, sType), offsetof(VkGraphicsShaderGroupCreateInfoNV, pNext), offsetof(VkGraphicsShaderGroupCreateInfoNV, stageCount), offsetof(VkGraphicsShaderGroupCreateInfoNV, pStages), offsetof(VkGraphicsShaderGroupCreateInfoNV, pVertexInputState), offsetof(VkGraphicsShaderGroupCreateInfoNV, pTessellationState)};
+// const size_t layout_VkGraphicsPipelineShaderGroupsCreateInfoNV[] = {sizeof(VkGraphicsPipelineShaderGroupsCreateInfoNV), _Alignof(VkGraphicsPipelineShaderGroupsCreateInfoNV), offsetof(VkGraphicsPipelineShaderGroupsCreateInfoNV, sType), offsetof(VkGraphicsPipelineShaderGroupsCreateInfoNV, pNext), offsetof(VkGraphicsPipelineShaderGroupsCreateInfoNV, groupCount), offsetof(VkGraphicsPipelineShaderGroupsCreateInfoNV, pGroups), offsetof(VkGraphicsPipelineShaderGroupsCreateInfoNV, pipelineCount), offsetof(VkGraphicsPipelineShaderGroupsCreateInfoNV, pPipelines)};
+// const size_t layout_VkBindShaderGroupIndirectCommandNV[] = {sizeof(VkBindShaderGroupIndirectCommandNV), _Alignof(VkBindShaderGroupIndirectCommandNV), offsetof(VkBindShaderGroupIndirectCommandNV, groupIndex)};
+// const size_t layout_VkBindIndexBufferIndirectCommandNV[] = {sizeof(VkBindIndexBufferIndirectCommandNV), _Alignof(VkBindIndexBufferIndirectCommandNV), offsetof(VkBindIndexBufferIndirectCommandNV, bufferAddress), offsetof(VkBindIndexBufferIndirectCommandNV, size), offsetof(VkBindIndexBufferIndirectCommandNV, indexType)};
+// const size_t layout_VkBindVertexBufferIndirectCommandNV[] = {sizeof(VkBindVertexBufferIndirectCommandNV), _Alignof(VkBindVertexBufferIndirectCommandNV), offsetof(VkBindVertexBufferIndirectCommandNV, bufferAddress), offsetof(VkBindVertexBufferIndirectCommandNV, size), offsetof(VkBindVertexBufferIndirectCommandNV, stride)};
+// const size_t layout_VkSetStateFlagsIndirectCommandNV[] = {sizeof(VkSetStateFlagsIndirectCommandNV), _Alignof(VkSetStateFlagsIndirectCommandNV), offsetof(VkSetStateFlagsIndirectCommandNV, data)};
+// const size_t layout_VkIndirectCommandsStreamNV[] = {sizeof(VkIndirectCommandsStreamNV), _Alignof(VkIndirectCommandsStreamNV), offsetof(VkIndirectCommandsStreamNV, buffer), offsetof(VkIndirectCommandsStreamNV, offset)};
+// const size_t layout_VkIndirectCommandsLayoutTokenNV[] = {sizeof(VkIndirectCommandsLayoutTokenNV), _Alignof(VkIndirectCommandsLayoutTokenNV), offsetof(VkIndirectCommandsLayoutTokenNV, sType), offsetof(VkIndirectCommandsLayoutTokenNV, pNext), offsetof(VkIndirectCommandsLayoutTokenNV, tokenType), offsetof(VkIndirectCommandsLayoutTokenNV, stream), offsetof(VkIndirectCommandsLayoutTokenNV, offset), offsetof(VkIndirectCommandsLayoutTokenNV, vertexBindingUnit), offsetof(VkIndirectCommandsLayoutTokenNV, vertexDynamicStride), offsetof(VkIndirectCommandsLayoutTokenNV, pushconstantPipelineLayout), offsetof(VkIndirectCommandsLayoutTokenNV, pushconstantShaderStageFlags), offsetof(VkIndirectCommandsLayoutTokenNV, pushconstantOffset), offsetof(VkIndirectCommandsLayoutTokenNV, pushconstantSize), offsetof(VkIndirectCommandsLayoutTokenNV, indirectStateFlags), offsetof(VkIndirectCommandsLayoutTokenNV, indexTypeCount), offsetof(VkIndirectCommandsLayoutTokenNV, pIndexTypes), offsetof(VkIndirectCommandsLayoutTokenNV, pIndexTypeValues)};
+// const size_t layout_VkIndirectCommandsLayoutCreateInfoNV[] = {sizeof(VkIndirectCommandsLayoutCreateInfoNV), _Alignof(VkIndirectCommandsLayoutCreateInfoNV), offsetof(VkIndirectCommandsLayoutCreateInfoNV, sType), offsetof(VkIndirectCommandsLayoutCreateInfoNV, pNext), offsetof(VkIndirectCommandsLayoutCreateInfoNV, flags), offsetof(VkIndirectCommandsLayoutCreateInfoNV, pipelineBindPoint), offsetof(VkIndirectCommandsLayoutCreateInfoNV, tokenCount), offsetof(VkIndirectCommandsLayoutCreateInfoNV, pTokens), offsetof(VkIndirectCommandsLayoutCreateInfoNV, streamCount), offsetof(VkIndirectCommandsLayoutCreateInfoNV, pStreamStrides)};
+// const size_t layout_VkGeneratedCommandsInfoNV[] = {sizeof(VkGeneratedCommandsInfoNV), _Alignof(VkGeneratedCommandsInfoNV), offsetof(VkGeneratedCommandsInfoNV, sType), offsetof(VkGeneratedCommandsInfoNV, pNext), offsetof(VkGeneratedCommandsInfoNV, pipelineBindPoint), offsetof(VkGeneratedCommandsInfoNV, pipeline), offsetof(VkGeneratedCommandsInfoNV, indirectCommandsLayout), offsetof(VkGeneratedCommandsInfoNV, streamCount), offsetof(VkGeneratedCommandsInfoNV, pStreams), offsetof(VkGeneratedCommandsInfoNV, sequencesCount), offsetof(VkGeneratedCommandsInfoNV, preprocessBuffer), offsetof(VkGeneratedCommandsInfoNV, preprocessOffset), offsetof(VkGeneratedCommandsInfoNV, preprocessSize), offsetof(VkGeneratedCommandsInfoNV, sequencesCountBuffer), offsetof(VkGeneratedCommandsInfoNV, sequencesCountOffset), offsetof(VkGeneratedCommandsInfoNV, sequencesIndexBuffer), offsetof(VkGeneratedCommandsInfoNV, sequencesIndexOffset)};
+// const size_t layout_VkGeneratedCommandsMemoryRequirementsInfoNV[] = {sizeof(VkGeneratedCommandsMemoryRequirementsInfoNV), _Alignof(VkGeneratedCommandsMemoryRequirementsInfoNV), offsetof(VkGeneratedCommandsMemoryRequirementsInfoNV, sType), offsetof(VkGeneratedCommandsMemoryRequirementsInfoNV, pNext), offsetof(VkGeneratedCommandsMemoryRequirementsInfoNV, pipelineBindPoint), offsetof(VkGeneratedCommandsMemoryRequirementsInfoNV, pipeline), offsetof(VkGeneratedCommandsMemoryRequirementsInfoNV, indirectCommandsLayout), offsetof(VkGeneratedCommandsMemoryRequirementsInfoNV, maxSequencesCount)};
+// const size_t layout_VkPhysicalDeviceInheritedViewportScissorFeaturesNV[] = {sizeof(VkPhysicalDeviceInheritedViewportScissorFeaturesNV), _Alignof(VkPhysicalDeviceInheritedViewportScissorFeaturesNV), offsetof(VkPhysicalDeviceInheritedViewportScissorFeaturesNV, sType), offsetof(VkPhysicalDeviceInheritedViewportScissorFeaturesNV, pNext), offsetof(VkPhysicalDeviceInheritedViewportScissorFeaturesNV, inheritedViewportScissor2D)};
+// const size_t layout_VkCommandBufferInheritanceViewportScissorInfoNV[] = {sizeof(VkCommandBufferInheritanceViewportScissorInfoNV), _Alignof(VkCommandBufferInheritanceViewportScissorInfoNV), offsetof(VkCommandBufferInheritanceViewportScissorInfoNV, sType), offsetof(VkCommandBufferInheritanceViewportScissorInfoNV, pNext), offsetof(VkCommandBufferInheritanceViewportScissorInfoNV, viewportScissor2D), offsetof(VkCommandBufferInheritanceViewportScissorInfoNV, viewportDepthCount), offsetof(VkCommandBufferInheritanceViewportScissorInfoNV, pViewportDepths)};
+// const size_t layout_VkPhysicalDeviceTexelBufferAlignmentFeaturesEXT[] = {sizeof(VkPhysicalDeviceTexelBufferAlignmentFeaturesEXT), _Alignof(VkPhysicalDeviceTexelBufferAlignmentFeaturesEXT), offsetof(VkPhysicalDeviceTexelBufferAlignmentFeaturesEXT, sType), offsetof(VkPhysicalDeviceTexelBufferAlignmentFeaturesEXT, pNext), offsetof(VkPhysicalDeviceTexelBufferAlignmentFeaturesEXT, texelBufferAlignment)};
+// const size_t layout_VkRenderPassTransformBeginInfoQCOM[] = {sizeof(VkRenderPassTransformBeginInfoQCOM), _Alignof(VkRenderPassTransformBeginInfoQCOM), offsetof(VkRenderPassTransformBeginInfoQCOM, sType), offsetof(VkRenderPassTransformBeginInfoQCOM, pNext), offsetof(VkRenderPassTransformBeginInfoQCOM, transform)};
+// const size_t layout_VkCommandBufferInheritanceRenderPassTransformInfoQCOM[] = {sizeof(VkCommandBufferInheritanceRenderPassTransformInfoQCOM), _Alignof(VkCommandBufferInheritanceRenderPassTransformInfoQCOM), offsetof(VkCommandBufferInheritanceRenderPassTransformInfoQCOM, sType), offsetof(VkCommandBufferInheritanceRenderPassTransformInfoQCOM, pNext), offsetof(VkCommandBufferInheritanceRenderPassTransformInfoQCOM, transform), offsetof(VkCommandBufferInheritanceRenderPassTransformInfoQCOM, renderArea)};
+// const size_t layout_VkPhysicalDeviceDeviceMemoryReportFeaturesEXT[] = {sizeof(VkPhysicalDeviceDeviceMemoryReportFeaturesEXT), _Alignof(VkPhysicalDeviceDeviceMemoryReportFeaturesEXT), offsetof(VkPhysicalDeviceDeviceMemoryReportFeaturesEXT, sType), offsetof(VkPhysicalDeviceDeviceMemoryReportFeaturesEXT, pNext), offsetof(VkPhysicalDeviceDeviceMemoryReportFeaturesEXT, deviceMemoryReport)};
+// const size_t layout_VkDeviceMemoryReportCallbackDataEXT[] = {sizeof(VkDeviceMemoryReportCallbackDataEXT), _Alignof(VkDeviceMemoryReportCallbackDataEXT), offsetof(VkDeviceMemoryReportCallbackDataEXT, sType), offsetof(VkDeviceMemoryReportCallbackDataEXT, pNext), offsetof(VkDeviceMemoryReportCallbackDataEXT, flags), offsetof(VkDeviceMemoryReportCallbackDataEXT, type), offsetof(VkDeviceMemoryReportCallbackDataEXT, memoryObjectId), offsetof(VkDeviceMemoryReportCallbackDataEXT, size), offsetof(VkDeviceMemoryReportCallbackDataEXT, objectType), offsetof(VkDeviceMemoryReportCallbackDataEXT, objectHandle), offsetof(VkDeviceMemoryReportCallbackDataEXT, heapIndex)};
+// const size_t layout_VkDeviceDeviceMemoryReportCreateInfoEXT[] = {sizeof(VkDeviceDeviceMemoryReportCreateInfoEXT), _Alignof(VkDeviceDeviceMemoryReportCreateInfoEXT), offsetof(VkDeviceDeviceMemoryReportCreateInfoEXT, sType), offsetof(VkDeviceDeviceMemoryReportCreateInfoEXT, pNext), offsetof(VkDeviceDeviceMemoryReportCreateInfoEXT, flags), offsetof(VkDeviceDeviceMemoryReportCreateInfoEXT, pfnUserCallback), offsetof(VkDeviceDeviceMemoryReportCreateInfoEXT, pUserData)};
+// const size_t layout_VkPhysicalDeviceRobustness2FeaturesEXT[] = {sizeof(VkPhysicalDeviceRobustness2FeaturesEXT), _Alignof(VkPhysicalDeviceRobustness2FeaturesEXT), offsetof(VkPhysicalDeviceRobustness2FeaturesEXT, sType), offsetof(VkPhysicalDeviceRobustness2FeaturesEXT, pNext), offsetof(VkPhysicalDeviceRobustness2FeaturesEXT, robustBufferAccess2), offsetof(VkPhysicalDeviceRobustness2FeaturesEXT, robustImageAccess2), offsetof(VkPhysicalDeviceRobustness2FeaturesEXT, nullDescriptor)};
+// const size_t layout_VkPhysicalDeviceRobustness2PropertiesEXT[] = {sizeof(VkPhysicalDeviceRobustness2PropertiesEXT), _Alignof(VkPhysicalDeviceRobustness2PropertiesEXT), offsetof(VkPhysicalDeviceRobustness2PropertiesEXT, sType), offsetof(VkPhysicalDeviceRobustness2PropertiesEXT, pNext), offsetof(VkPhysicalDeviceRobustness2PropertiesEXT, robustStorageBufferAccessSizeAlignment), offsetof(VkPhysicalDeviceRobustness2PropertiesEXT, robustUniformBufferAccessSizeAlignment)};
+// const size_t layout_VkSamplerCustomBorderColorCreateInfoEXT[] = {sizeof(VkSamplerCustomBorderColorCreateInfoEXT), _Alignof(VkSamplerCustomBorderColorCreateInfoEXT), offsetof(VkSamplerCustomBorderColorCreateInfoEXT, sType), offsetof(VkSamplerCustomBorderColorCreateInfoEXT, pNext), offsetof(VkSamplerCustomBorderColorCreateInfoEXT, customBorderColor), offsetof(VkSamplerCustomBorderColorCreateInfoEXT, format)};
+// const size_t layout_VkPhysicalDeviceCustomBorderColorPropertiesEXT[] = {sizeof(VkPhysicalDeviceCustomBorderColorPropertiesEXT), _Alignof(VkPhysicalDeviceCustomBorderColorPropertiesEXT), offsetof(VkPhysicalDeviceCustomBorderColorPropertiesEXT, sType), offsetof(VkPhysicalDeviceCustomBorderColorPropertiesEXT, pNext), offsetof(VkPhysicalDeviceCustomBorderColorPropertiesEXT, maxCustomBorderColorSamplers)};
+// const size_t layout_VkPhysicalDeviceCustomBorderColorFeaturesEXT[] = {sizeof(VkPhysicalDeviceCustomBorderColorFeaturesEXT), _Alignof(VkPhysicalDeviceCustomBorderColorFeaturesEXT), offsetof(VkPhysicalDeviceCustomBorderColorFeaturesEXT, sType), offsetof(VkPhysicalDeviceCustomBorderColorFeaturesEXT, pNext), offsetof(VkPhysicalDeviceCustomBorderColorFeaturesEXT, customBorderColors), offsetof(VkPhysicalDeviceCustomBorderColorFeaturesEXT, customBorderColorWithoutFormat)};
+// const size_t layout_VkPhysicalDevicePresentBarrierFeaturesNV[] = {sizeof(VkPhysicalDevicePresentBarrierFeaturesNV), _Alignof(VkPhysicalDevicePresentBarrierFeaturesNV), offsetof(VkPhysicalDevicePresentBarrierFeaturesNV, sType), offsetof(VkPhysicalDevicePresentBarrierFeaturesNV, pNext), offsetof(VkPhysicalDevicePresentBarrierFeaturesNV, presentBarrier)};
+// const size_t layout_VkSurfaceCapabilitiesPresentBarrierNV[] = {sizeof(VkSurfaceCapabilitiesPresentBarrierNV), _Alignof(VkSurfaceCapabilitiesPresentBarrierNV), offsetof(VkSurfaceCapabilitiesPresentBarrierNV, sType), offsetof(VkSurfaceCapabilitiesPresentBarrierNV, pNext), offsetof(VkSurfaceCapabilitiesPresentBarrierNV, presentBarrierSupported)};
+// const size_t layout_VkSwapchainPresentBarrierCreateInfoNV[] = {sizeof(VkSwapchainPresentBarrierCreateInfoNV), _Alignof(VkSwapchainPresentBarrierCreateInfoNV), offsetof(VkSwapchainPresentBarrierCreateInfoNV, sType), offsetof(VkSwapchainPresentBarrierCreateInfoNV, pNext), offsetof(VkSwapchainPresentBarrierCreateInfoNV, presentBarrierEnable)};
+// const size_t layout_VkPhysicalDeviceDiagnosticsConfigFeaturesNV[] = {sizeof(VkPhysicalDeviceDiagnosticsConfigFeaturesNV), _Alignof(VkPhysicalDeviceDiagnosticsConfigFeaturesNV), offsetof(VkPhysicalDeviceDiagnosticsConfigFeaturesNV, sType), offsetof(VkPhysicalDeviceDiagnosticsConfigFeaturesNV, pNext), offsetof(VkPhysicalDeviceDiagnosticsConfigFeaturesNV, diagnosticsConfig)};
+// const size_t layout_VkDeviceDiagnosticsConfigCreateInfoNV[] = {sizeof(VkDeviceDiagnosticsConfigCreateInfoNV), _Alignof(VkDeviceDiagnosticsConfigCreateInfoNV), offsetof(VkDeviceDiagnosticsConfigCreateInfoNV, sType), offsetof(VkDeviceDiagnosticsConfigCreateInfoNV, pNext), offsetof(VkDeviceDiagnosticsConfigCreateInfoNV, flags)};
+// const size_t layout_VkPhysicalDeviceDescriptorBufferPropertiesEXT[] = {sizeof(VkPhysicalDeviceDescriptorBufferPropertiesEXT), _Alignof(VkPhysicalDeviceDescriptorBufferPropertiesEXT), offsetof(VkPhysicalDeviceDescriptorBufferPropertiesEXT, sType), offsetof(VkPhysicalDeviceDescriptorBufferPropertiesEXT, pNext), offsetof(VkPhysicalDeviceDescriptorBufferPropertiesEXT, combinedImageSamplerDescriptorSingleArray), offsetof(VkPhysicalDeviceDescriptorBufferPropertiesEXT, bufferlessPushDescriptors), offsetof(VkPhysicalDeviceDescriptorBufferPropertiesEXT, allowSamplerImageViewPostSubmitCreation), offsetof(VkPhysicalDeviceDescriptorBufferPropertiesEXT, descriptorBufferOffsetAlignment), offsetof(VkPhysicalDeviceDescriptorBufferPropertiesEXT, maxDescriptorBufferBindings), offsetof(VkPhysicalDeviceDescriptorBufferPropertiesEXT, maxResourceDescriptorBufferBindings), offsetof(VkPhysicalDeviceDescriptorBufferPropertiesEXT, maxSamplerDescriptorBufferBindings), offsetof(VkPhysicalDeviceDescriptorBufferPropertiesEXT, maxEmbeddedImmutableSamplerBindings), offsetof(VkPhysicalDeviceDescriptorBufferPropertiesEXT, maxEmbeddedImmutableSamplers), offsetof(VkPhysicalDeviceDescriptorBufferPropertiesEXT, bufferCaptureReplayDescriptorDataSize), offsetof(VkPhysicalDeviceDescriptorBufferPropertiesEXT, imageCaptureReplayDescriptorDataSize), offsetof(VkPhysicalDeviceDescriptorBufferPropertiesEXT, imageViewCaptureReplayDescriptorDataSize), offsetof(VkPhysicalDeviceDescriptorBufferPropertiesEXT, samplerCaptureReplayDescriptorDataSize), offsetof(VkPhysicalDeviceDescriptorBufferPropertiesEXT, accelerationStructureCaptureReplayDescriptorDataSize), offsetof(VkPhysicalDeviceDescriptorBufferPropertiesEXT, samplerDescriptorSize), offsetof(VkPhysicalDeviceDescriptorBufferPropertiesEXT, combinedImageSamplerDescriptorSize), offsetof(VkPhysicalDeviceDescriptorBufferPropertiesEXT, sampledImageDescriptorSize), offsetof(VkPhysicalDeviceDescriptorBufferPropertiesEXT, storageImageDescriptorSize), offsetof(VkPhysicalDeviceDescriptorBufferPropertiesEXT, uniformTexelBufferDescriptorSize), offsetof(VkPhysicalDeviceDescriptorBufferPropertiesEXT, robustUniformTexelBufferDescriptorSize), offsetof(VkPhysicalDeviceDescriptorBufferPropertiesEXT, storageTexelBufferDescriptorSize), offsetof(VkPhysicalDeviceDescriptorBufferPropertiesEXT, robustStorageTexelBufferDescriptorSize), offsetof(VkPhysicalDeviceDescriptorBufferPropertiesEXT, uniformBufferDescriptorSize), offsetof(VkPhysicalDeviceDescriptorBufferPropertiesEXT, robustUniformBufferDescriptorSize), offsetof(VkPhysicalDeviceDescriptorBufferPropertiesEXT, storageBufferDescriptorSize), offsetof(VkPhysicalDeviceDescriptorBufferPropertiesEXT, robustStorageBufferDescriptorSize), offsetof(VkPhysicalDeviceDescriptorBufferPropertiesEXT, inputAttachmentDescriptorSize), offsetof(VkPhysicalDeviceDescriptorBufferPropertiesEXT, accelerationStructureDescriptorSize), offsetof(VkPhysicalDeviceDescriptorBufferPropertiesEXT, maxSamplerDescriptorBufferRange), offsetof(VkPhysicalDeviceDescriptorBufferPropertiesEXT, maxResourceDescriptorBufferRange), offsetof(VkPhysicalDeviceDescriptorBufferPropertiesEXT, samplerDescriptorBufferAddressSpaceSize), offsetof(VkPhysicalDeviceDescriptorBufferPropertiesEXT, resourceDescriptorBufferAddressSpaceSize), offsetof(VkPhysicalDeviceDescriptorBufferPropertiesEXT, descriptorBufferAddressSpaceSize)};
+// const size_t layout_VkPhysicalDeviceDescriptorBufferDensityMapPropertiesEXT[] = {sizeof(VkPhysicalDeviceDescriptorBufferDensityMapPropertiesEXT), _Alignof(VkPhysicalDeviceDescriptorBufferDensityMapPropertiesEXT), offsetof(VkPhysicalDeviceDescriptorBufferDensityMapPropertiesEXT, sType), offsetof(VkPhysicalDeviceDescriptorBufferDensityMapPropertiesEXT, pNext), offsetof(VkPhysicalDeviceDescriptorBufferDensityMapPropertiesEXT, combinedImageSamplerDensityMapDescriptorSize)};
+// const size_t layout_VkPhysicalDeviceDescriptorBufferFeaturesEXT[] = {sizeof(VkPhysicalDeviceDescriptorBufferFeaturesEXT), _Alignof(VkPhysicalDeviceDescriptorBufferFeaturesEXT), offsetof(VkPhysicalDeviceDescriptorBufferFeaturesEXT, sType), offsetof(VkPhysicalDeviceDescriptorBufferFeaturesEXT, pNext), offsetof(VkPhysicalDeviceDescriptorBufferFeaturesEXT, descriptorBuffer), offsetof(VkPhysicalDeviceDescriptorBufferFeaturesEXT, descriptorBufferCaptureReplay), offsetof(VkPhysicalDeviceDescriptorBufferFeaturesEXT, descriptorBufferImageLayoutIgnored), offsetof(VkPhysicalDeviceDescriptorBufferFeaturesEXT, descriptorBufferPushDescriptors)};
+// const size_t layout_VkDescriptorAddressInfoEXT[] = {sizeof(VkDescriptorAddressInfoEXT), _Alignof(VkDescriptorAddressInfoEXT), offsetof(VkDescriptorAddressInfoEXT, sType), offsetof(VkDescriptorAddressInfoEXT, pNext), offsetof(VkDescriptorAddressInfoEXT, address), offsetof(VkDescriptorAddressInfoEXT, range), offsetof(VkDescriptorAddressInfoEXT, format)};
+// const size_t layout_VkDescriptorBufferBindingInfoEXT[] = {sizeof(VkDescriptorBufferBindingInfoEXT), _Alignof(VkDescriptorBufferBindingInfoEXT), offsetof(VkDescriptorBufferBindingInfoEXT, sType), offsetof(VkDescriptorBufferBindingInfoEXT, pNext), offsetof(VkDescriptorBufferBindingInfoEXT, address), offsetof(VkDescriptorBufferBindingInfoEXT, usage)};
+// const size_t layout_VkDescriptorBufferBindingPushDescriptorBufferHandleEXT[] = {sizeof(VkDescriptorBufferBindingPushDescriptorBufferHandleEXT), _Alignof(VkDescriptorBufferBindingPushDescriptorBufferHandleEXT), offsetof(VkDescriptorBufferBindingPushDescriptorBufferHandleEXT, sType), offsetof(VkDescriptorBufferBindingPushDescriptorBufferHandleEXT, pNext), offsetof(VkDescriptorBufferBindingPushDescriptorBufferHandleEXT, buffer)};
+// const size_t layout_VkDescriptorDataEXT[] = {sizeof(VkDescriptorDataEXT), _Alignof(VkDescriptorDataEXT)};
+// const size_t layout_VkDescriptorGetInfoEXT[] = {sizeof(VkDescriptorGetInfoEXT), _Alignof(VkDescriptorGetInfoEXT), offsetof(VkDescriptorGetInfoEXT, sType), offsetof(VkDescriptorGetInfoEXT, pNext), offsetof(VkDescriptorGetInfoEXT, type), offsetof(VkDescriptorGetInfoEXT, data)};
+// const size_t layout_VkBufferCaptureDescriptorDataInfoEXT[] = {sizeof(VkBufferCaptureDescriptorDataInfoEXT), _Alignof(VkBufferCaptureDescriptorDataInfoEXT), offsetof(VkBufferCaptureDescriptorDataInfoEXT, sType), offsetof(VkBufferCaptureDescriptorDataInfoEXT, pNext), offsetof(VkBufferCaptureDescriptorDataInfoEXT, buffer)};
+// const size_t layout_VkImageCaptureDescriptorDataInfoEXT[] = {sizeof(VkImageCaptureDescriptorDataInfoEXT), _Alignof(VkImageCaptureDescriptorDataInfoEXT), offsetof(VkImageCaptureDescriptorDataInfoEXT, sType), offsetof(VkImageCaptureDescriptorDataInfoEXT, pNext), offsetof(VkImageCaptureDescriptorDataInfoEXT, image)};
+// const size_t layout_VkImageViewCaptureDescriptorDataInfoEXT[] = {sizeof(VkImageViewCaptureDescriptorDataInfoEXT), _Alignof(VkImageViewCaptureDescriptorDataInfoEXT), offsetof(VkImageViewCaptureDescriptorDataInfoEXT, sType), offsetof(VkImageViewCaptureDescriptorDataInfoEXT, pNext), offsetof(VkImageViewCaptureDescriptorDataInfoEXT, imageView)};
+// const size_t layout_VkSamplerCaptureDescriptorDataInfoEXT[] = {sizeof(VkSamplerCaptureDescriptorDataInfoEXT), _Alignof(VkSamplerCaptureDescriptorDataInfoEXT), offsetof(VkSamplerCaptureDescriptorDataInfoEXT, sType), offsetof(VkSamplerCaptureDescriptorDataInfoEXT, pNext), offsetof(VkSamplerCaptureDescriptorDataInfoEXT, sampler)};
+// const size_t layout_VkOpaqueCaptureDescriptorDataCreateInfoEXT[] = {sizeof(VkOpaqueCaptureDescriptorDataCreateInfoEXT), _Alignof(VkOpaqueCaptureDescriptorDataCreateInfoEXT), offsetof(VkOpaqueCaptureDescriptorDataCreateInfoEXT, sType), offsetof(VkOpaqueCaptureDescriptorDataCreateInfoEXT, pNext), offsetof(VkOpaqueCaptureDescriptorDataCreateInfoEXT, opaqueCaptureDescriptorData)};
+// const size_t layout_VkAccelerationStructureCaptureDescriptorDataInfoEXT[] = {sizeof(VkAccelerationStructureCaptureDescriptorDataInfoEXT), _Alignof(VkAccelerationStructureCaptureDescriptorDataInfoEXT), offsetof(VkAccelerationStructureCaptureDescriptorDataInfoEXT, sType), offsetof(VkAccelerationStructureCaptureDescriptorDataInfoEXT, pNext), offsetof(VkAccelerationStructureCaptureDescriptorDataInfoEXT, accelerationStructure), offsetof(VkAccelerationStructureCaptureDescriptorDataInfoEXT, accelerationStructureNV)};
+// const size_t layout_VkPhysicalDeviceGraphicsPipelineLibraryFeaturesEXT[] = {sizeof(VkPhysicalDeviceGraphicsPipelineLibraryFeaturesEXT), _Alignof(VkPhysicalDeviceGraphicsPipelineLibraryFeaturesEXT), offsetof(VkPhysicalDeviceGraphicsPipelineLibraryFeaturesEXT, sType), offsetof(VkPhysicalDeviceGraphicsPipelineLibraryFeaturesEXT, pNext), offsetof(VkPhysicalDeviceGraphicsPipelineLibraryFeaturesEXT, graphicsPipelineLibrary)};
+// const size_t layout_VkPhysicalDeviceGraphicsPipelineLibraryPropertiesEXT[] = {sizeof(VkPhysicalDeviceGraphicsPipelineLibraryPropertiesEXT), _Alignof(VkPhysicalDeviceGraphicsPipelineLibraryPropertiesEXT), offsetof(VkPhysicalDeviceGraphicsPipelineLibraryPropertiesEXT, sType), offsetof(VkPhysicalDeviceGraphicsPipelineLibraryPropertiesEXT, pNext), offsetof(VkPhysicalDeviceGraphicsPipelineLibraryPropertiesEXT, graphicsPipelineLibraryFastLinking), offsetof(VkPhysicalDeviceGraphicsPipelineLibraryPropertiesEXT, graphicsPipelineLibraryIndependentInterpolationDecoration)};
+// const size_t layout_VkGraphicsPipelineLibraryCreateInfoEXT[] = {sizeof(VkGraphicsPipelineLibraryCreateInfoEXT), _Alignof(VkGraphicsPipelineLibraryCreateInfoEXT), offsetof(VkGraphicsPipelineLibraryCreateInfoEXT, sType), offsetof(VkGraphicsPipelineLibraryCreateInfoEXT, pNext), offsetof(VkGraphicsPipelineLibraryCreateInfoEXT, flags)};
+// const size_t layout_VkPhysicalDeviceShaderEarlyAndLateFragmentTestsFeaturesAMD[] = {sizeof(VkPhysicalDeviceShaderEarlyAndLateFragmentTestsFeaturesAMD), _Alignof(VkPhysicalDeviceShaderEarlyAndLateFragmentTestsFeaturesAMD), offsetof(VkPhysicalDeviceShaderEarlyAndLateFragmentTestsFeaturesAMD, sType), offsetof(VkPhysicalDeviceShaderEarlyAndLateFragmentTestsFeaturesAMD, pNext), offsetof(VkPhysicalDeviceShaderEarlyAndLateFragmentTestsFeaturesAMD, shaderEarlyAndLateFragmentTests)};
+// const size_t layout_VkPhysicalDeviceFragmentShadingRateEnumsFeaturesNV[] = {sizeof(VkPhysicalDeviceFragmentShadingRateEnumsFeaturesNV), _Alignof(VkPhysicalDeviceFragmentShadingRateEnumsFeaturesNV), offsetof(VkPhysicalDeviceFragmentShadingRateEnumsFeaturesNV, sType), offsetof(VkPhysicalDeviceFragmentShadingRateEnumsFeaturesNV, pNext), offsetof(VkPhysicalDeviceFragmentShadingRateEnumsFeaturesNV, fragmentShadingRateEnums), offsetof(VkPhysicalDeviceFragmentShadingRateEnumsFeaturesNV, supersampleFragmentShadingRates), offsetof(VkPhysicalDeviceFragmentShadingRateEnumsFeaturesNV, noInvocationFragmentShadingRates)};
+// const size_t layout_VkPhysicalDeviceFragmentShadingRateEnumsPropertiesNV[] = {sizeof(VkPhysicalDeviceFragmentShadingRateEnumsPropertiesNV), _Alignof(VkPhysicalDeviceFragmentShadingRateEnumsPropertiesNV), offsetof(VkPhysicalDeviceFragmentShadingRateEnumsPropertiesNV, sType), offsetof(VkPhysicalDeviceFragmentShadingRateEnumsPropertiesNV, pNext), offsetof(VkPhysicalDeviceFragmentShadingRateEnumsPropertiesNV, maxFragmentShadingRateInvocationCount)};
+// const size_t layout_VkPipelineFragmentShadingRateEnumStateCreateInfoNV[] = {sizeof(VkPipelineFragmentShadingRateEnumStateCreateInfoNV), _Alignof(VkPipelineFragmentShadingRateEnumStateCreateInfoNV), offsetof(VkPipelineFragmentShadingRateEnumStateCreateInfoNV, sType), offsetof(VkPipelineFragmentShadingRateEnumStateCreateInfoNV, pNext), offsetof(VkPipelineFragmentShadingRateEnumStateCreateInfoNV, shadingRateType), offsetof(VkPipelineFragmentShadingRateEnumStateCreateInfoNV, shadingRate), offsetof(VkPipelineFragmentShadingRateEnumStateCreateInfoNV, combinerOps)};
+// const size_t layout_VkAccelerationStructureGeometryMotionTrianglesDataNV[] = {sizeof(VkAccelerationStructureGeometryMotionTrianglesDataNV), _Alignof(VkAccelerationStructureGeometryMotionTrianglesDataNV), offsetof(VkAccelerationStructureGeometryMotionTrianglesDataNV, sType), offsetof(VkAccelerationStructureGeometryMotionTrianglesDataNV, pNext), offsetof(VkAccelerationStructureGeometryMotionTrianglesDataNV, vertexData)};
+// const size_t layout_VkAccelerationStructureMotionInfoNV[] = {sizeof(VkAccelerationStructureMotionInfoNV), _Alignof(VkAccelerationStructureMotionInfoNV), offsetof(VkAccelerationStructureMotionInfoNV, sType), offsetof(VkAccelerationStructureMotionInfoNV, pNext), offsetof(VkAccelerationStructureMotionInfoNV, maxInstances), offsetof(VkAccelerationStructureMotionInfoNV, flags)};
+// const size_t layout_VkAccelerationStructureMatrixMotionInstanceNV[] = {sizeof(VkAccelerationStructureMatrixMotionInstanceNV), _Alignof(VkAccelerationStructureMatrixMotionInstanceNV), offsetof(VkAccelerationStructureMatrixMotionInstanceNV, transformT0), offsetof(VkAccelerationStructureMatrixMotionInstanceNV, transformT1), offsetof(VkAccelerationStructureMatrixMotionInstanceNV, accelerationStructureReference)};
+// const size_t layout_VkSRTDataNV[] = {sizeof(VkSRTDataNV), _Alignof(VkSRTDataNV), offsetof(VkSRTDataNV, sx), offsetof(VkSRTDataNV, a), offsetof(VkSRTDataNV, b), offsetof(VkSRTDataNV, pvx), offsetof(VkSRTDataNV, sy), offsetof(VkSRTDataNV, c), offsetof(VkSRTDataNV, pvy), offsetof(VkSRTDataNV, sz), offsetof(VkSRTDataNV, pvz), offsetof(VkSRTDataNV, qx), offsetof(VkSRTDataNV, qy), offsetof(VkSRTDataNV, qz), offsetof(VkSRTDataNV, qw), offsetof(VkSRTDataNV, tx), offsetof(VkSRTDataNV, ty), offsetof(VkSRTDataNV, tz)};
+// const size_t layout_VkAccelerationStructureSRTMotionInstanceNV[] = {sizeof(VkAccelerationStructureSRTMotionInstanceNV), _Alignof(VkAccelerationStructureSRTMotionInstanceNV), offsetof(VkAccelerationStructureSRTMotionInstanceNV, transformT0), offsetof(VkAccelerationStructureSRTMotionInstanceNV, transformT1), offsetof(VkAccelerationStructureSRTMotionInstanceNV, accelerationStructureReference)};
+// const size_t layout_VkAccelerationStructureMotionInstanceDataNV[] = {sizeof(VkAccelerationStructureMotionInstanceDataNV), _Alignof(VkAccelerationStructureMotionInstanceDataNV)};
+// const size_t layout_VkAccelerationStructureMotionInstanceNV[] = {sizeof(VkAccelerationStructureMotionInstanceNV), _Alignof(VkAccelerationStructureMotionInstanceNV), offsetof(VkAccelerationStructureMotionInstanceNV, type), offsetof(VkAccelerationStructureMotionInstanceNV, flags), offsetof(VkAccelerationStructureMotionInstanceNV, data)};
+// const size_t layout_VkPhysicalDeviceRayTracingMotionBlurFeaturesNV[] = {sizeof(VkPhysicalDeviceRayTracingMotionBlurFeaturesNV), _Alignof(VkPhysicalDeviceRayTracingMotionBlurFeaturesNV), offsetof(VkPhysicalDeviceRayTracingMotionBlurFeaturesNV, sType), offsetof(VkPhysicalDeviceRayTracingMotionBlurFeaturesNV, pNext), offsetof(VkPhysicalDeviceRayTracingMotionBlurFeaturesNV, rayTracingMotionBlur), offsetof(VkPhysicalDeviceRayTracingMotionBlurFeaturesNV, rayTracingMotionBlurPipelineTraceRaysIndirect)};
+// const size_t layout_VkPhysicalDeviceMeshShaderFeaturesEXT[] = {sizeof(VkPhysicalDeviceMeshShaderFeaturesEXT), _Alignof(VkPhysicalDeviceMeshShaderFeaturesEXT), offsetof(VkPhysicalDeviceMeshShaderFeaturesEXT, sType), offsetof(VkPhysicalDeviceMeshShaderFeaturesEXT, pNext), offsetof(VkPhysicalDeviceMeshShaderFeaturesEXT, taskShader), offsetof(VkPhysicalDeviceMeshShaderFeaturesEXT, meshShader), offsetof(VkPhysicalDeviceMeshShaderFeaturesEXT, multiviewMeshShader), offsetof(VkPhysicalDeviceMeshShaderFeaturesEXT, primitiveFragmentShadingRateMeshShader), offsetof(VkPhysicalDeviceMeshShaderFeaturesEXT, meshShaderQueries)};
+// const size_t layout_VkPhysicalDeviceMeshShaderPropertiesEXT[] = {sizeof(VkPhysicalDeviceMeshShaderPropertiesEXT), _Alignof(VkPhysicalDeviceMeshShaderPropertiesEXT), offsetof(VkPhysicalDeviceMeshShaderPropertiesEXT, sType), offsetof(VkPhysicalDeviceMeshShaderPropertiesEXT, pNext), offsetof(VkPhysicalDeviceMeshShaderPropertiesEXT, maxTaskWorkGroupTotalCount), offsetof(VkPhysicalDeviceMeshShaderPropertiesEXT, maxTaskWorkGroupCount), offsetof(VkPhysicalDeviceMeshShaderPropertiesEXT, maxTaskWorkGroupInvocations), offsetof(VkPhysicalDeviceMeshShaderPropertiesEXT, maxTaskWorkGroupSize), offsetof(VkPhysicalDeviceMeshShaderPropertiesEXT, maxTaskPayloadSize), offsetof(VkPhysicalDeviceMeshShaderPropertiesEXT, maxTaskSharedMemorySize), offsetof(VkPhysicalDeviceMeshShaderPropertiesEXT, maxTaskPayloadAndSharedMemorySize), offsetof(VkPhysicalDeviceMeshShaderPropertiesEXT, maxMeshWorkGroupTotalCount), offsetof(VkPhysicalDeviceMeshShaderPropertiesEXT, maxMeshWorkGroupCount), offsetof(VkPhysicalDeviceMeshShaderPropertiesEXT, maxMeshWorkGroupInvocations), offsetof(VkPhysicalDeviceMeshShaderPropertiesEXT, maxMeshWorkGroupSize), offsetof(VkPhysicalDeviceMeshShaderPropertiesEXT, maxMeshSharedMemorySize), offsetof(VkPhysicalDeviceMeshShaderPropertiesEXT, maxMeshPayloadAndSharedMemorySize), offsetof(VkPhysicalDeviceMeshShaderPropertiesEXT, maxMeshOutputMemorySize), offsetof(VkPhysicalDeviceMeshShaderPropertiesEXT, maxMeshPayloadAndOutputMemorySize), offsetof(VkPhysicalDeviceMeshShaderPropertiesEXT, maxMeshOutputComponents), offsetof(VkPhysicalDeviceMeshShaderPropertiesEXT, maxMeshOutputVertices), offsetof(VkPhysicalDeviceMeshShaderPropertiesEXT, maxMeshOutputPrimitives), offsetof(VkPhysicalDeviceMeshShaderPropertiesEXT, maxMeshOutputLayers), offsetof(VkPhysicalDeviceMeshShaderPropertiesEXT, maxMeshMultiviewViewCount), offsetof(VkPhysicalDeviceMeshShaderPropertiesEXT, meshOutputPerVertexGranularity), offsetof(VkPhysicalDeviceMeshShaderPropertiesEXT, meshOutputPerPrimitiveGranularity), offsetof(VkPhysicalDeviceMeshShaderPropertiesEXT, maxPreferredTaskWorkGroupInvocations), offsetof(VkPhysicalDeviceMeshShaderPropertiesEXT, maxPreferredMeshWorkGroupInvocations), offsetof(VkPhysicalDeviceMeshShaderPropertiesEXT, prefersLocalInvocationVertexOutput), offsetof(VkPhysicalDeviceMeshShaderPropertiesEXT, prefersLocalInvocationPrimitiveOutput), offsetof(VkPhysicalDeviceMeshShaderPropertiesEXT, prefersCompactVertexOutput), offsetof(VkPhysicalDeviceMeshShaderPropertiesEXT, prefersCompactPrimitiveOutput)};
+// const size_t layout_VkDrawMeshTasksIndirectCommandEXT[] = {sizeof(VkDrawMeshTasksIndirectCommandEXT), _Alignof(VkDrawMeshTasksIndirectCommandEXT), offsetof(VkDrawMeshTasksIndirectCommandEXT, groupCountX), offsetof(VkDrawMeshTasksIndirectCommandEXT, groupCountY), offsetof(VkDrawMeshTasksIndirectCommandEXT, groupCountZ)};
+// const size_t layout_VkPhysicalDeviceYcbcr2Plane444FormatsFeaturesEXT[] = {sizeof(VkPhysicalDeviceYcbcr2Plane444FormatsFeaturesEXT), _Alignof(VkPhysicalDeviceYcbcr2Plane444FormatsFeaturesEXT), offsetof(VkPhysicalDeviceYcbcr2Plane444FormatsFeaturesEXT, sType), offsetof(VkPhysicalDeviceYcbcr2Plane444FormatsFeaturesEXT, pNext), offsetof(VkPhysicalDeviceYcbcr2Plane444FormatsFeaturesEXT, ycbcr2plane444Formats)};
+// const size_t layout_VkPhysicalDeviceFragmentDensityMap2FeaturesEXT[] = {sizeof(VkPhysicalDeviceFragmentDensityMap2FeaturesEXT), _Alignof(VkPhysicalDeviceFragmentDensityMap2FeaturesEXT), offsetof(VkPhysicalDeviceFragmentDensityMap2FeaturesEXT, sType), offsetof(VkPhysicalDeviceFragmentDensityMap2FeaturesEXT, pNext), offsetof(VkPhysicalDeviceFragmentDensityMap2FeaturesEXT, fragmentDensityMapDeferred)};
+// const size_t layout_VkPhysicalDeviceFragmentDensityMap2PropertiesEXT[] = {sizeof(VkPhysicalDeviceFragmentDensityMap2PropertiesEXT), _Alignof(VkPhysicalDeviceFragmentDensityMap2PropertiesEXT), offsetof(VkPhysicalDeviceFragmentDensityMap2PropertiesEXT, sType), offsetof(VkPhysicalDeviceFragmentDensityMap2PropertiesEXT, pNext), offsetof(VkPhysicalDeviceFragmentDensityMap2PropertiesEXT, subsampledLoads), offsetof(VkPhysicalDeviceFragmentDensityMap2PropertiesEXT, subsampledCoarseReconstructionEarlyAccess), offsetof(VkPhysicalDeviceFragmentDensityMap2PropertiesEXT, maxSubsampledArrayLayers), offsetof(VkPhysicalDeviceFragmentDensityMap2PropertiesEXT, maxDescriptorSetSubsampledSamplers)};
+// const size_t layout_VkCopyCommandTransformInfoQCOM[] = {sizeof(VkCopyCommandTransformInfoQCOM), _Alignof(VkCopyCommandTransformInfoQCOM), offsetof(VkCopyCommandTransformInfoQCOM, sType), offsetof(VkCopyCommandTransformInfoQCOM, pNext), offsetof(VkCopyCommandTransformInfoQCOM, transform)};
+// const size_t layout_VkPhysicalDeviceImageCompressionControlFeaturesEXT[] = {sizeof(VkPhysicalDeviceImageCompressionControlFeaturesEXT), _Alignof(VkPhysicalDeviceImageCompressionControlFeaturesEXT), offsetof(VkPhysicalDeviceImageCompressionControlFeaturesEXT, sType), offsetof(VkPhysicalDeviceImageCompressionControlFeaturesEXT, pNext), offsetof(VkPhysicalDeviceImageCompressionControlFeaturesEXT, imageCompressionControl)};
+// const size_t layout_VkImageCompressionControlEXT[] = {sizeof(VkImageCompressionControlEXT), _Alignof(VkImageCompressionControlEXT), offsetof(VkImageCompressionControlEXT, sType), offsetof(VkImageCompressionControlEXT, pNext), offsetof(VkImageCompressionControlEXT, flags), offsetof(VkImageCompressionControlEXT, compressionControlPlaneCount), offsetof(VkImageCompressionControlEXT, pFixedRateFlags)};
+// const size_t layout_VkSubresourceLayout2EXT[] = {sizeof(VkSubresourceLayout2EXT), _Alignof(VkSubresourceLayout2EXT), offsetof(VkSubresourceLayout2EXT, sType), offsetof(VkSubresourceLayout2EXT, pNext), offsetof(VkSubresourceLayout2EXT, subresourceLayout)};
+// const size_t layout_VkImageSubresource2EXT[] = {sizeof(VkImageSubresource2EXT), _Alignof(VkImageSubresource2EXT), offsetof(VkImageSubresource2EXT, sType), offsetof(VkImageSubresource2EXT, pNext), offsetof(VkImageSubresource2EXT, imageSubresource)};
+// const size_t layout_VkImageCompressionPropertiesEXT[] = {sizeof(VkImageCompressionPropertiesEXT), _Alignof(VkImageCompressionPropertiesEXT), offsetof(VkImageCompressionPropertiesEXT, sType), offsetof(VkImageCompressionPropertiesEXT, pNext), offsetof(VkImageCompressionPropertiesEXT, imageCompressionFlags), offsetof(VkImageCompressionPropertiesEXT, imageCompressionFixedRateFlags)};
+// const size_t layout_VkPhysicalDeviceAttachmentFeedbackLoopLayoutFeaturesEXT[] = {sizeof(VkPhysicalDeviceAttachmentFeedbackLoopLayoutFeaturesEXT), _Alignof(VkPhysicalDeviceAttachmentFeedbackLoopLayoutFeaturesEXT), offsetof(VkPhysicalDeviceAttachmentFeedbackLoopLayoutFeaturesEXT, sType), offsetof(VkPhysicalDeviceAttachmentFeedbackLoopLayoutFeaturesEXT, pNext), offsetof(VkPhysicalDeviceAttachmentFeedbackLoopLayoutFeaturesEXT, attachmentFeedbackLoopLayout)};
+// const size_t layout_VkPhysicalDevice4444FormatsFeaturesEXT[] = {sizeof(VkPhysicalDevice4444FormatsFeaturesEXT), _Alignof(VkPhysicalDevice4444FormatsFeaturesEXT), offsetof(VkPhysicalDevice4444FormatsFeaturesEXT, sType), offsetof(VkPhysicalDevice4444FormatsFeaturesEXT, pNext), offsetof(VkPhysicalDevice4444FormatsFeaturesEXT, formatA4R4G4B4), offsetof(VkPhysicalDevice4444FormatsFeaturesEXT, formatA4B4G4R4)};
+// const size_t layout_VkPhysicalDeviceFaultFeaturesEXT[] = {sizeof(VkPhysicalDeviceFaultFeaturesEXT), _Alignof(VkPhysicalDeviceFaultFeaturesEXT), offsetof(VkPhysicalDeviceFaultFeaturesEXT, sType), offsetof(VkPhysicalDeviceFaultFeaturesEXT, pNext), offsetof(VkPhysicalDeviceFaultFeaturesEXT, deviceFault), offsetof(VkPhysicalDeviceFaultFeaturesEXT, deviceFaultVendorBinary)};
+// const size_t layout_VkDeviceFaultCountsEXT[] = {sizeof(VkDeviceFaultCountsEXT), _Alignof(VkDeviceFaultCountsEXT), offsetof(VkDeviceFaultCountsEXT, sType), offsetof(VkDeviceFaultCountsEXT, pNext), offsetof(VkDeviceFaultCountsEXT, addressInfoCount), offsetof(VkDeviceFaultCountsEXT, vendorInfoCount), offsetof(VkDeviceFaultCountsEXT, vendorBinarySize)};
+// const size_t layout_VkDeviceFaultAddressInfoEXT[] = {sizeof(VkDeviceFaultAddressInfoEXT), _Alignof(VkDeviceFaultAddressInfoEXT), offsetof(VkDeviceFaultAddressInfoEXT, addressType), offsetof(VkDeviceFaultAddressInfoEXT, reportedAddress), offsetof(VkDeviceFaultAddressInfoEXT, addressPrecision)};
+// const size_t layout_VkDeviceFaultVendorInfoEXT[] = {sizeof(VkDeviceFaultVendorInfoEXT), _Alignof(VkDeviceFaultVendorInfoEXT), offsetof(VkDeviceFaultVendorInfoEXT, description), offsetof(VkDeviceFaultVendorInfoEXT, vendorFaultCode), offsetof(VkDeviceFaultVendorInfoEXT, vendorFaultData)};
+// const size_t layout_VkDeviceFaultInfoEXT[] = {sizeof(VkDeviceFaultInfoEXT), _Alignof(VkDeviceFaultInfoEXT), offsetof(VkDeviceFaultInfoEXT, sType), offsetof(VkDeviceFaultInfoEXT, pNext), offsetof(VkDeviceFaultInfoEXT, description), offsetof(VkDeviceFaultInfoEXT, pAddressInfos), offsetof(VkDeviceFaultInfoEXT, pVendorInfos), offsetof(VkDeviceFaultInfoEXT, pVendorBinaryData)};
+// const size_t layout_VkDeviceFaultVendorBinaryHeaderVersionOneEXT[] = {sizeof(VkDeviceFaultVendorBinaryHeaderVersionOneEXT), _Alignof(VkDeviceFaultVendorBinaryHeaderVersionOneEXT), offsetof(VkDeviceFaultVendorBinaryHeaderVersionOneEXT, headerSize), offsetof(VkDeviceFaultVendorBinaryHeaderVersionOneEXT, headerVersion), offsetof(VkDeviceFaultVendorBinaryHeaderVersionOneEXT, vendorID), offsetof(VkDeviceFaultVendorBinaryHeaderVersionOneEXT, deviceID), offsetof(VkDeviceFaultVendorBinaryHeaderVersionOneEXT, driverVersion), offsetof(VkDeviceFaultVendorBinaryHeaderVersionOneEXT, pipelineCacheUUID), offsetof(VkDeviceFaultVendorBinaryHeaderVersionOneEXT, applicationNameOffset), offsetof(VkDeviceFaultVendorBinaryHeaderVersionOneEXT, applicationVersion), offsetof(VkDeviceFaultVendorBinaryHeaderVersionOneEXT, engineNameOffset)};
+// const size_t layout_VkPhysicalDeviceRasterizationOrderAttachmentAccessFeaturesEXT[] = {sizeof(VkPhysicalDeviceRasterizationOrderAttachmentAccessFeaturesEXT), _Alignof(VkPhysicalDeviceRasterizationOrderAttachmentAccessFeaturesEXT), offsetof(VkPhysicalDeviceRasterizationOrderAttachmentAccessFeaturesEXT, sType), offsetof(VkPhysicalDeviceRasterizationOrderAttachmentAccessFeaturesEXT, pNext), offsetof(VkPhysicalDeviceRasterizationOrderAttachmentAccessFeaturesEXT, rasterizationOrderColorAttachmentAccess), offsetof(VkPhysicalDeviceRasterizationOrderAttachmentAccessFeaturesEXT, rasterizationOrderDepthAttachmentAccess), offsetof(VkPhysicalDeviceRasterizationOrderAttachmentAccessFeaturesEXT, rasterizationOrderStencilAttachmentAccess)};
+// const size_t layout_VkPhysicalDeviceRGBA10X6FormatsFeaturesEXT[] = {sizeof(VkPhysicalDeviceRGBA10X6FormatsFeaturesEXT), _Alignof(VkPhysicalDeviceRGBA10X6FormatsFeaturesEXT), offsetof(VkPhysicalDeviceRGBA10X6FormatsFeaturesEXT, sType), offsetof(VkPhysicalDeviceRGBA10X6FormatsFeaturesEXT, pNext), offsetof(VkPhysicalDeviceRGBA10X6FormatsFeaturesEXT, formatRgba10x6WithoutYCbCrSampler)};
+// const size_t layout_VkPhysicalDeviceMutableDescriptorTypeFeaturesEXT[] = {sizeof(VkPhysicalDeviceMutableDescriptorTypeFeaturesEXT), _Alignof(VkPhysicalDeviceMutableDescriptorTypeFeaturesEXT), offsetof(VkPhysicalDeviceMutableDescriptorTypeFeaturesEXT, sType), offsetof(VkPhysicalDeviceMutableDescriptorTypeFeaturesEXT, pNext), offsetof(VkPhysicalDeviceMutableDescriptorTypeFeaturesEXT, mutableDescriptorType)};
+// const size_t layout_VkMutableDescriptorTypeListEXT[] = {sizeof(VkMutableDescriptorTypeListEXT), _Alignof(VkMutableDescriptorTypeListEXT), offsetof(VkMutableDescriptorTypeListEXT, descriptorTypeCount), offsetof(VkMutableDescriptorTypeListEXT, pDescriptorTypes)};
+// const size_t layout_VkMutableDescriptorTypeCreateInfoEXT[] = {sizeof(VkMutableDescriptorTypeCreateInfoEXT), _Alignof(VkMutableDescriptorTypeCreateInfoEXT), offsetof(VkMutableDescriptorTypeCreateInfoEXT, sType), offsetof(VkMutableDescriptorTypeCreateInfoEXT, pNext), offsetof(VkMutableDescriptorTypeCreateInfoEXT, mutableDescriptorTypeListCount), offsetof(VkMutableDescriptorTypeCreateInfoEXT, pMutableDescriptorTypeLists)};
+// const size_t layout_VkPhysicalDeviceVertexInputDynamicStateFeaturesEXT[] = {sizeof(VkPhysicalDeviceVertexInputDynamicStateFeaturesEXT), _Alignof(VkPhysicalDeviceVertexInputDynamicStateFeaturesEXT), offsetof(VkPhysicalDeviceVertexInputDynamicStateFeaturesEXT, sType), offsetof(VkPhysicalDeviceVertexInputDynamicStateFeaturesEXT, pNext), offsetof(VkPhysicalDeviceVertexInputDynamicStateFeaturesEXT, vertexInputDynamicState)};
+// const size_t layout_VkVertexInputBindingDescription2EXT[] = {sizeof(VkVertexInputBindingDescription2EXT), _Alignof(VkVertexInputBindingDescription2EXT), offsetof(VkVertexInputBindingDescription2EXT, sType), offsetof(VkVertexInputBindingDescription2EXT, pNext), offsetof(VkVertexInputBindingDescription2EXT, binding), offsetof(VkVertexInputBindingDescription2EXT, stride), offsetof(VkVertexInputBindingDescription2EXT, inputRate), offsetof(VkVertexInputBindingDescription2EXT, divisor)};
+// const size_t layout_VkVertexInputAttributeDescription2EXT[] = {sizeof(VkVertexInputAttributeDescription2EXT), _Alignof(VkVertexInputAttributeDescription2EXT), offsetof(VkVertexInputAttributeDescription2EXT, sType), offsetof(VkVertexInputAttributeDescription2EXT, pNext), offsetof(VkVertexInputAttributeDescription2EXT, location), offsetof(VkVertexInputAttributeDescription2EXT, binding), offsetof(VkVertexInputAttributeDescription2EXT, format), offsetof(VkVertexInputAttributeDescription2EXT, offset)};
+// const size_t layout_VkPhysicalDeviceDrmPropertiesEXT[] = {sizeof(VkPhysicalDeviceDrmPropertiesEXT), _Alignof(VkPhysicalDeviceDrmPropertiesEXT), offsetof(VkPhysicalDeviceDrmPropertiesEXT, sType), offsetof(VkPhysicalDeviceDrmPropertiesEXT, pNext), offsetof(VkPhysicalDeviceDrmPropertiesEXT, hasPrimary), offsetof(VkPhysicalDeviceDrmPropertiesEXT, hasRender), offsetof(VkPhysicalDeviceDrmPropertiesEXT, primaryMajor), offsetof(VkPhysicalDeviceDrmPropertiesEXT, primaryMinor), offsetof(VkPhysicalDeviceDrmPropertiesEXT, renderMajor), offsetof(VkPhysicalDeviceDrmPropertiesEXT, renderMinor)};
+// const size_t layout_VkPhysicalDeviceAddressBindingReportFeaturesEXT[] = {sizeof(VkPhysicalDeviceAddressBindingReportFeaturesEXT), _Alignof(VkPhysicalDeviceAddressBindingReportFeaturesEXT), offsetof(VkPhysicalDeviceAddressBindingReportFeaturesEXT, sType), offsetof(VkPhysicalDeviceAddressBindingReportFeaturesEXT, pNext), offsetof(VkPhysicalDeviceAddressBindingReportFeaturesEXT, reportAddressBinding)};
+// const size_t layout_VkDeviceAddressBindingCallbackDataEXT[] = {sizeof(VkDeviceAddressBindingCallbackDataEXT), _Alignof(VkDeviceAddressBindingCallbackDataEXT), offsetof(VkDeviceAddressBindingCallbackDataEXT, sType), offsetof(VkDeviceAddressBindingCallbackDataEXT, pNext), offsetof(VkDeviceAddressBindingCallbackDataEXT, flags), offsetof(VkDeviceAddressBindingCallbackDataEXT, baseAddress), offsetof(VkDeviceAddressBindingCallbackDataEXT, size), offsetof(VkDeviceAddressBindingCallbackDataEXT, bindingType)};
+// const size_t layout_VkPhysicalDeviceDepthClipControlFeaturesEXT[] = {sizeof(VkPhysicalDeviceDepthClipControlFeaturesEXT), _Alignof(VkPhysicalDeviceDepthClipControlFeaturesEXT), offsetof(VkPhysicalDeviceDepthClipControlFeaturesEXT, sType), offsetof(VkPhysicalDeviceDepthClipControlFeaturesEXT, pNext), offsetof(VkPhysicalDeviceDepthClipControlFeaturesEXT, depthClipControl)};
+// const size_t layout_VkPipelineViewportDepthClipControlCreateInfoEXT[] = {sizeof(VkPipelineViewportDepthClipControlCreateInfoEXT), _Alignof(VkPipelineViewportDepthClipControlCreateInfoEXT), offsetof(VkPipelineViewportDepthClipControlCreateInfoEXT, sType), offsetof(VkPipelineViewportDepthClipControlCreateInfoEXT, pNext), offsetof(VkPipelineViewportDepthClipControlCreateInfoEXT, negativeOneToOne)};
+// const size_t layout_VkPhysicalDevicePrimitiveTopologyListRestartFeaturesEXT[] = {sizeof(VkPhysicalDevicePrimitiveTopologyListRestartFeaturesEXT), _Alignof(VkPhysicalDevicePrimitiveTopologyListRestartFeaturesEXT), offsetof(VkPhysicalDevicePrimitiveTopologyListRestartFeaturesEXT, sType), offsetof(VkPhysicalDevicePrimitiveTopologyListRestartFeaturesEXT, pNext), offsetof(VkPhysicalDevicePrimitiveTopologyListRestartFeaturesEXT, primitiveTopologyListRestart), offsetof(VkPhysicalDevicePrimitiveTopologyListRestartFeaturesEXT, primitiveTopologyPatchListRestart)};
+// const size_t layout_VkSubpassShadingPipelineCreateInfoHUAWEI[] = {sizeof(VkSubpassShadingPipelineCreateInfoHUAWEI), _Alignof(VkSubpassShadingPipelineCreateInfoHUAWEI), offsetof(VkSubpassShadingPipelineCreateInfoHUAWEI, sType), offsetof(VkSubpassShadingPipelineCreateInfoHUAWEI, pNext), offsetof(VkSubpassShadingPipelineCreateInfoHUAWEI, renderPass), offsetof(VkSubpassShadingPipelineCreateInfoHUAWEI, subpass)};
+// const size_t layout_VkPhysicalDeviceSubpassShadingFeaturesHUAWEI[] = {sizeof(VkPhysicalDeviceSubpassShadingFeaturesHUAWEI), _Alignof(VkPhysicalDeviceSubpassShadingFeaturesHUAWEI), offsetof(VkPhysicalDeviceSubpassShadingFeaturesHUAWEI, sType), offsetof(VkPhysicalDeviceSubpassShadingFeaturesHUAWEI, pNext), offsetof(VkPhysicalDeviceSubpassShadingFeaturesHUAWEI, subpassShading)};
+// const size_t layout_VkPhysicalDeviceSubpassShadingPropertiesHUAWEI[] = {sizeof(VkPhysicalDeviceSubpassShadingPropertiesHUAWEI), _Alignof(VkPhysicalDeviceSubpassShadingPropertiesHUAWEI), offsetof(VkPhysicalDeviceSubpassShadingPropertiesHUAWEI, sType), offsetof(VkPhysicalDeviceSubpassShadingPropertiesHUAWEI, pNext), offsetof(VkPhysicalDeviceSubpassShadingPropertiesHUAWEI, maxSubpassShadingWorkgroupSizeAspectRatio)};
+// const size_t layout_VkPhysicalDeviceInvocationMaskFeaturesHUAWEI[] = {sizeof(VkPhysicalDeviceInvocationMaskFeaturesHUAWEI), _Alignof(VkPhysicalDeviceInvocationMaskFeaturesHUAWEI), offsetof(VkPhysicalDeviceInvocationMaskFeaturesHUAWEI, sType), offsetof(VkPhysicalDeviceInvocationMaskFeaturesHUAWEI, pNext), offsetof(VkPhysicalDeviceInvocationMaskFeaturesHUAWEI, invocationMask)};
+// const size_t layout_VkMemoryGetRemoteAddressInfoNV[] = {sizeof(VkMemoryGetRemoteAddressInfoNV), _Alignof(VkMemoryGetRemoteAddressInfoNV), offsetof(VkMemoryGetRemoteAddressInfoNV, sType), offsetof(VkMemoryGetRemoteAddressInfoNV, pNext), offsetof(VkMemoryGetRemoteAddressInfoNV, memory), offsetof(VkMemoryGetRemoteAddressInfoNV, handleType)};
+// const size_t layout_VkPhysicalDeviceExternalMemoryRDMAFeaturesNV[] = {sizeof(VkPhysicalDeviceExternalMemoryRDMAFeaturesNV), _Alignof(VkPhysicalDeviceExternalMemoryRDMAFeaturesNV), offsetof(VkPhysicalDeviceExternalMemoryRDMAFeaturesNV, sType), offsetof(VkPhysicalDeviceExternalMemoryRDMAFeaturesNV, pNext), offsetof(VkPhysicalDeviceExternalMemoryRDMAFeaturesNV, externalMemoryRDMA)};
+// const size_t layout_VkPipelinePropertiesIdentifierEXT[] = {sizeof(VkPipelinePropertiesIdentifierEXT), _Alignof(VkPipelinePropertiesIdentifierEXT), offsetof(VkPipelinePropertiesIdentifierEXT, sType), offsetof(VkPipelinePropertiesIdentifierEXT, pNext), offsetof(VkPipelinePropertiesIdentifierEXT, pipelineIdentifier)};
+// const size_t layout_VkPhysicalDevicePipelinePropertiesFeaturesEXT[] = {sizeof(VkPhysicalDevicePipelinePropertiesFeaturesEXT), _Alignof(VkPhysicalDevicePipelinePropertiesFeaturesEXT), offsetof(VkPhysicalDevicePipelinePropertiesFeaturesEXT, sType), offsetof(VkPhysicalDevicePipelinePropertiesFeaturesEXT, pNext), offsetof(VkPhysicalDevicePipelinePropertiesFeaturesEXT, pipelinePropertiesIdentifier)};
+// const size_t layout_VkPhysicalDeviceMultisampledRenderToSingleSampledFeaturesEXT[] = {sizeof(VkPhysicalDeviceMultisampledRenderToSingleSampledFeaturesEXT), _Alignof(VkPhysicalDeviceMultisampledRenderToSingleSampledFeaturesEXT), offsetof(VkPhysicalDeviceMultisampledRenderToSingleSampledFeaturesEXT, sType), offsetof(VkPhysicalDeviceMultisampledRenderToSingleSampledFeaturesEXT, pNext), offsetof(VkPhysicalDeviceMultisampledRenderToSingleSampledFeaturesEXT, multisampledRenderToSingleSampled)};
+// const size_t layout_VkSubpassResolvePerformanceQueryEXT[] = {sizeof(VkSubpassResolvePerformanceQueryEXT), _Alignof(VkSubpassResolvePerformanceQueryEXT), offsetof(VkSubpassResolvePerformanceQueryEXT, sType), offsetof(VkSubpassResolvePerformanceQueryEXT, pNext), offsetof(VkSubpassResolvePerformanceQueryEXT, optimal)};
+// const size_t layout_VkMultisampledRenderToSingleSampledInfoEXT[] = {sizeof(VkMultisampledRenderToSingleSampledInfoEXT), _Alignof(VkMultisampledRenderToSingleSampledInfoEXT), offsetof(VkMultisampledRenderToSingleSampledInfoEXT, sType), offsetof(VkMultisampledRenderToSingleSampledInfoEXT, pNext), offsetof(VkMultisampledRenderToSingleSampledInfoEXT, multisampledRenderToSingleSampledEnable), offsetof(VkMultisampledRenderToSingleSampledInfoEXT, rasterizationSamples)};
+// const size_t layout_VkPhysicalDeviceExtendedDynamicState2FeaturesEXT[] = {sizeof(VkPhysicalDeviceExtendedDynamicState2FeaturesEXT), _Alignof(VkPhysicalDeviceExtendedDynamicState2FeaturesEXT), offsetof(VkPhysicalDeviceExtendedDynamicState2FeaturesEXT, sType), offsetof(VkPhysicalDeviceExtendedDynamicState2FeaturesEXT, pNext), offsetof(VkPhysicalDeviceExtendedDynamicState2FeaturesEXT, extendedDynamicState2), offsetof(VkPhysicalDeviceExtendedDynamicState2FeaturesEXT, extendedDynamicState2LogicOp), offsetof(VkPhysicalDeviceExtendedDynamicState2FeaturesEXT, extendedDynamicState2PatchControlPoints)};
+// const size_t layout_VkPhysicalDeviceColorWriteEnableFeaturesEXT[] = {sizeof(VkPhysicalDeviceColorWriteEnableFeaturesEXT), _Alignof(VkPhysicalDeviceColorWriteEnableFeaturesEXT), offsetof(VkPhysicalDeviceColorWriteEnableFeaturesEXT, sType), offsetof(VkPhysicalDeviceColorWriteEnableFeaturesEXT, pNext), offsetof(VkPhysicalDeviceColorWriteEnableFeaturesEXT, colorWriteEnable)};
+// const size_t layout_VkPipelineColorWriteCreateInfoEXT[] = {sizeof(VkPipelineColorWriteCreateInfoEXT), _Alignof(VkPipelineColorWriteCreateInfoEXT), offsetof(VkPipelineColorWriteCreateInfoEXT, sType), offsetof(VkPipelineColorWriteCreateInfoEXT, pNext), offsetof(VkPipelineColorWriteCreateInfoEXT, attachmentCount), offsetof(VkPipelineColorWriteCreateInfoEXT, pColorWriteEnables)};
+// const size_t layout_VkPhysicalDevicePrimitivesGeneratedQueryFeaturesEXT[] = {sizeof(VkPhysicalDevicePrimitivesGeneratedQueryFeaturesEXT), _Alignof(VkPhysicalDevicePrimitivesGeneratedQueryFeaturesEXT), offsetof(VkPhysicalDevicePrimitivesGeneratedQueryFeaturesEXT, sType), offsetof(VkPhysicalDevicePrimitivesGeneratedQueryFeaturesEXT, pNext), offsetof(VkPhysicalDevicePrimitivesGeneratedQueryFeaturesEXT, primitivesGeneratedQuery), offsetof(VkPhysicalDevicePrimitivesGeneratedQueryFeaturesEXT, primitivesGeneratedQueryWithRasterizerDiscard), offsetof(VkPhysicalDevicePrimitivesGeneratedQueryFeaturesEXT, primitivesGeneratedQueryWithNonZeroStreams)};
+// const size_t layout_VkPhysicalDeviceImageViewMinLodFeaturesEXT[] = {sizeof(VkPhysicalDeviceImageViewMinLodFeaturesEXT), _Alignof(VkPhysicalDeviceImageViewMinLodFeaturesEXT), offsetof(VkPhysicalDeviceImageViewMinLodFeaturesEXT, sType), offsetof(VkPhysicalDeviceImageViewMinLodFeaturesEXT, pNext), offsetof(VkPhysicalDeviceImageViewMinLodFeaturesEXT, minLod)};
+// const size_t layout_VkImageViewMinLodCreateInfoEXT[] = {sizeof(VkImageViewMinLodCreateInfoEXT), _Alignof(VkImageViewMinLodCreateInfoEXT), offsetof(VkImageViewMinLodCreateInfoEXT, sType), offsetof(VkImageViewMinLodCreateInfoEXT, pNext), offsetof(VkImageViewMinLodCreateInfoEXT, minLod)};
+// const size_t layout_VkPhysicalDeviceMultiDrawFeaturesEXT[] = {sizeof(VkPhysicalDeviceMultiDrawFeaturesEXT), _Alignof(VkPhysicalDeviceMultiDrawFeaturesEXT), offsetof(VkPhysicalDeviceMultiDrawFeaturesEXT, sType), offsetof(VkPhysicalDeviceMultiDrawFeaturesEXT, pNext), offsetof(VkPhysicalDeviceMultiDrawFeaturesEXT, multiDraw)};
+// const size_t layout_VkPhysicalDeviceMultiDrawPropertiesEXT[] = {sizeof(VkPhysicalDeviceMultiDrawPropertiesEXT), _Alignof(VkPhysicalDeviceMultiDrawPropertiesEXT), offsetof(VkPhysicalDeviceMultiDrawPropertiesEXT, sType), offsetof(VkPhysicalDeviceMultiDrawPropertiesEXT, pNext), offsetof(VkPhysicalDeviceMultiDrawPropertiesEXT, maxMultiDrawCount)};
+// const size_t layout_VkMultiDrawInfoEXT[] = {sizeof(VkMultiDrawInfoEXT), _Alignof(VkMultiDrawInfoEXT), offsetof(VkMultiDrawInfoEXT, firstVertex), offsetof(VkMultiDrawInfoEXT, vertexCount)};
+// const size_t layout_VkMultiDrawIndexedInfoEXT[] = {sizeof(VkMultiDrawIndexedInfoEXT), _Alignof(VkMultiDrawIndexedInfoEXT), offsetof(VkMultiDrawIndexedInfoEXT, firstIndex), offsetof(VkMultiDrawIndexedInfoEXT, indexCount), offsetof(VkMultiDrawIndexedInfoEXT, vertexOffset)};
+// const size_t layout_VkPhysicalDeviceImage2DViewOf3DFeaturesEXT[] = {sizeof(VkPhysicalDeviceImage2DViewOf3DFeaturesEXT), _Alignof(VkPhysicalDeviceImage2DViewOf3DFeaturesEXT), offsetof(VkPhysicalDeviceImage2DViewOf3DFeaturesEXT, sType), offsetof(VkPhysicalDeviceImage2DViewOf3DFeaturesEXT, pNext), offsetof(VkPhysicalDeviceImage2DViewOf3DFeaturesEXT, image2DViewOf3D), offsetof(VkPhysicalDeviceImage2DViewOf3DFeaturesEXT, sampler2DViewOf3D)};
+// const size_t layout_VkMicromapUsageEXT[] = {sizeof(VkMicromapUsageEXT), _Alignof(VkMicromapUsageEXT), offsetof(VkMicromapUsageEXT, count), offsetof(VkMicromapUsageEXT, subdivisionLevel), offsetof(VkMicromapUsageEXT, format)};
+// const size_t layout_VkMicromapBuildInfoEXT[] = {sizeof(VkMicromapBuildInfoEXT), _Alignof(VkMicromapBuildInfoEXT), offsetof(VkMicromapBuildInfoEXT, sType), offsetof(VkMicromapBuildInfoEXT, pNext), offsetof(VkMicromapBuildInfoEXT, type), offsetof(VkMicromapBuildInfoEXT, flags), offsetof(VkMicromapBuildInfoEXT, mode), offsetof(VkMicromapBuildInfoEXT, dstMicromap), offsetof(VkMicromapBuildInfoEXT, usageCountsCount), offsetof(VkMicromapBuildInfoEXT, pUsageCounts), offsetof(VkMicromapBuildInfoEXT, ppUsageCounts), offsetof(VkMicromapBuildInfoEXT, data), offsetof(VkMicromapBuildInfoEXT, scratchData), offsetof(VkMicromapBuildInfoEXT, triangleArray), offsetof(VkMicromapBuildInfoEXT, triangleArrayStride)};
+// const size_t layout_VkMicromapCreateInfoEXT[] = {sizeof(VkMicromapCreateInfoEXT), _Alignof(VkMicromapCreateInfoEXT), offsetof(VkMicromapCreateInfoEXT, sType), offsetof(VkMicromapCreateInfoEXT, pNext), offsetof(VkMicromapCreateInfoEXT, createFlags), offsetof(VkMicromapCreateInfoEXT, buffer), offsetof(VkMicromapCreateInfoEXT, offset), offsetof(VkMicromapCreateInfoEXT, size), offsetof(VkMicromapCreateInfoEXT, type), offsetof(VkMicromapCreateInfoEXT, deviceAddress)};
+// const size_t layout_VkPhysicalDeviceOpacityMicromapFeaturesEXT[] = {sizeof(VkPhysicalDeviceOpacityMicromapFeaturesEXT), _Alignof(VkPhysicalDeviceOpacityMicromapFeaturesEXT), offsetof(VkPhysicalDeviceOpacityMicromapFeaturesEXT, sType), offsetof(VkPhysicalDeviceOpacityMicromapFeaturesEXT, pNext), offsetof(VkPhysicalDeviceOpacityMicromapFeaturesEXT, micromap), offsetof(VkPhysicalDeviceOpacityMicromapFeaturesEXT, micromapCaptureReplay), offsetof(VkPhysicalDeviceOpacityMicromapFeaturesEXT, micromapHostCommands)};
+// const size_t layout_VkPhysicalDeviceOpacityMicromapPropertiesEXT[] = {sizeof(VkPhysicalDeviceOpacityMicromapPropertiesEXT), _Alignof(VkPhysicalDeviceOpacityMicromapPropertiesEXT), offsetof(VkPhysicalDeviceOpacityMicromapPropertiesEXT, sType), offsetof(VkPhysicalDeviceOpacityMicromapPropertiesEXT, pNext), offsetof(VkPhysicalDeviceOpacityMicromapPropertiesEXT, maxOpacity2StateSubdivisionLevel), offsetof(VkPhysicalDeviceOpacityMicromapPropertiesEXT, maxOpacity4StateSubdivisionLevel)};
+// const size_t layout_VkMicromapVersionInfoEXT[] = {sizeof(VkMicromapVersionInfoEXT), _Alignof(VkMicromapVersionInfoEXT), offsetof(VkMicromapVersionInfoEXT, sType), offsetof(VkMicromapVersionInfoEXT, pNext), offsetof(VkMicromapVersionInfoEXT, pVersionData)};
+// const size_t layout_VkCopyMicromapToMemoryInfoEXT[] = {sizeof(VkCopyMicromapToMemoryInfoEXT), _Alignof(VkCopyMicromapToMemoryInfoEXT), offsetof(VkCopyMicromapToMemoryInfoEXT, sType), offsetof(VkCopyMicromapToMemoryInfoEXT, pNext), offsetof(VkCopyMicromapToMemoryInfoEXT, src), offsetof(VkCopyMicromapToMemoryInfoEXT, dst), offsetof(VkCopyMicromapToMemoryInfoEXT, mode)};
+// const size_t layout_VkCopyMemoryToMicromapInfoEXT[] = {sizeof(VkCopyMemoryToMicromapInfoEXT), _Alignof(VkCopyMemoryToMicromapInfoEXT), offsetof(VkCopyMemoryToMicromapInfoEXT, sType), offsetof(VkCopyMemoryToMicromapInfoEXT, pNext), offsetof(VkCopyMemoryToMicromapInfoEXT, src), offsetof(VkCopyMemoryToMicromapInfoEXT, dst), offsetof(VkCopyMemoryToMicromapInfoEXT, mode)};
+// const size_t layout_VkCopyMicromapInfoEXT[] = {sizeof(VkCopyMicromapInfoEXT), _Alignof(VkCopyMicromapInfoEXT), offsetof(VkCopyMicromapInfoEXT, sType), offsetof(VkCopyMicromapInfoEXT, pNext), offsetof(VkCopyMicromapInfoEXT, src), offsetof(VkCopyMicromapInfoEXT, dst), offsetof(VkCopyMicromapInfoEXT, mode)};
+// const size_t layout_VkMicromapBuildSizesInfoEXT[] = {sizeof(VkMicromapBuildSizesInfoEXT), _Alignof(VkMicromapBuildSizesInfoEXT), offsetof(VkMicromapBuildSizesInfoEXT, sType), offsetof(VkMicromapBuildSizesInfoEXT, pNext), offsetof(VkMicromapBuildSizesInfoEXT, micromapSize), offsetof(VkMicromapBuildSizesInfoEXT, buildScratchSize), offsetof(VkMicromapBuildSizesInfoEXT, discardable)};
+// const size_t layout_VkAccelerationStructureTrianglesOpacityMicromapEXT[] = {sizeof(VkAccelerationStructureTrianglesOpacityMicromapEXT), _Alignof(VkAccelerationStructureTrianglesOpacityMicromapEXT), offsetof(VkAccelerationStructureTrianglesOpacityMicromapEXT, sType), offsetof(VkAccelerationStructureTrianglesOpacityMicromapEXT, pNext), offsetof(VkAccelerationStructureTrianglesOpacityMicromapEXT, indexType), offsetof(VkAccelerationStructureTrianglesOpacityMicromapEXT, indexBuffer), offsetof(VkAccelerationStructureTrianglesOpacityMicromapEXT, indexStride), offsetof(VkAccelerationStructureTrianglesOpacityMicromapEXT, baseTriangle), offsetof(VkAccelerationStructureTrianglesOpacityMicromapEXT, usageCountsCount), offsetof(VkAccelerationStructureTrianglesOpacityMicromapEXT, pUsageCounts), offsetof(VkAccelerationStructureTrianglesOpacityMicromapEXT, ppUsageCounts), offsetof(VkAccelerationStructureTrianglesOpacityMicromapEXT, micromap)};
+// const size_t layout_VkMicromapTriangleEXT[] = {sizeof(VkMicromapTriangleEXT), _Alignof(VkMicromapTriangleEXT), offsetof(VkMicromapTriangleEXT, dataOffset), offsetof(VkMicromapTriangleEXT, subdivisionLevel), offsetof(VkMicromapTriangleEXT, format)};
+// const size_t layout_VkPhysicalDeviceClusterCullingShaderFeaturesHUAWEI[] = {sizeof(VkPhysicalDeviceClusterCullingShaderFeaturesHUAWEI), _Alignof(VkPhysicalDeviceClusterCullingShaderFeaturesHUAWEI), offsetof(VkPhysicalDeviceClusterCullingShaderFeaturesHUAWEI, sType), offsetof(VkPhysicalDeviceClusterCullingShaderFeaturesHUAWEI, pNext), offsetof(VkPhysicalDeviceClusterCullingShaderFeaturesHUAWEI, clustercullingShader), offsetof(VkPhysicalDeviceClusterCullingShaderFeaturesHUAWEI, multiviewClusterCullingShader)};
+// const size_t layout_VkPhysicalDeviceClusterCullingShaderPropertiesHUAWEI[] = {sizeof(VkPhysicalDeviceClusterCullingShaderPropertiesHUAWEI), _Alignof(VkPhysicalDeviceClusterCullingShaderPropertiesHUAWEI), offsetof(VkPhysicalDeviceClusterCullingShaderPropertiesHUAWEI, sType), offsetof(VkPhysicalDeviceClusterCullingShaderPropertiesHUAWEI, pNext), offsetof(VkPhysicalDeviceClusterCullingShaderPropertiesHUAWEI, maxWorkGroupCount), offsetof(VkPhysicalDeviceClusterCullingShaderPropertiesHUAWEI, maxWorkGroupSize), offsetof(VkPhysicalDeviceClusterCullingShaderPropertiesHUAWEI, maxOutputClusterCount)};
+// const size_t layout_VkPhysicalDeviceBorderColorSwizzleFeaturesEXT[] = {sizeof(VkPhysicalDeviceBorderColorSwizzleFeaturesEXT), _Alignof(VkPhysicalDeviceBorderColorSwizzleFeaturesEXT), offsetof(VkPhysicalDeviceBorderColorSwizzleFeaturesEXT, sType), offsetof(VkPhysicalDeviceBorderColorSwizzleFeaturesEXT, pNext), offsetof(VkPhysicalDeviceBorderColorSwizzleFeaturesEXT, borderColorSwizzle), offsetof(VkPhysicalDeviceBorderColorSwizzleFeaturesEXT, borderColorSwizzleFromImage)};
+// const size_t layout_VkSamplerBorderColorComponentMappingCreateInfoEXT[] = {sizeof(VkSamplerBorderColorComponentMappingCreateInfoEXT), _Alignof(VkSamplerBorderColorComponentMappingCreateInfoEXT), offsetof(VkSamplerBorderColorComponentMappingCreateInfoEXT, sType), offsetof(VkSamplerBorderColorComponentMappingCreateInfoEXT, pNext), offsetof(VkSamplerBorderColorComponentMappingCreateInfoEXT, components), offsetof(VkSamplerBorderColorComponentMappingCreateInfoEXT, srgb)};
+// const size_t layout_VkPhysicalDevicePageableDeviceLocalMemoryFeaturesEXT[] = {sizeof(VkPhysicalDevicePageableDeviceLocalMemoryFeaturesEXT), _Alignof(VkPhysicalDevicePageableDeviceLocalMemoryFeaturesEXT), offsetof(VkPhysicalDevicePageableDeviceLocalMemoryFeaturesEXT, sType), offsetof(VkPhysicalDevicePageableDeviceLocalMemoryFeaturesEXT, pNext), offsetof(VkPhysicalDevicePageableDeviceLocalMemoryFeaturesEXT, pageableDeviceLocalMemory)};
+// const size_t layout_VkPhysicalDeviceDescriptorSetHostMappingFeaturesVALVE[] = {sizeof(VkPhysicalDeviceDescriptorSetHostMappingFeaturesVALVE), _Alignof(VkPhysicalDeviceDescriptorSetHostMappingFeaturesVALVE), offsetof(VkPhysicalDeviceDescriptorSetHostMappingFeaturesVALVE, sType), offsetof(VkPhysicalDeviceDescriptorSetHostMappingFeaturesVALVE, pNext), offsetof(VkPhysicalDeviceDescriptorSetHostMappingFeaturesVALVE, descriptorSetHostMapping)};
+// const size_t layout_VkDescriptorSetBindingReferenceVALVE[] = {sizeof(VkDescriptorSetBindingReferenceVALVE), _Alignof(VkDescriptorSetBindingReferenceVALVE), offsetof(VkDescriptorSetBindingReferenceVALVE, sType), offsetof(VkDescriptorSetBindingReferenceVALVE, pNext), offsetof(VkDescriptorSetBindingReferenceVALVE, descriptorSetLayout), offsetof(VkDescriptorSetBindingReferenceVALVE, binding)};
+// const size_t layout_VkDescriptorSetLayoutHostMappingInfoVALVE[] = {sizeof(VkDescriptorSetLayoutHostMappingInfoVALVE), _Alignof(VkDescriptorSetLayoutHostMappingInfoVALVE), offsetof(VkDescriptorSetLayoutHostMappingInfoVALVE, sType), offsetof(VkDescriptorSetLayoutHostMappingInfoVALVE, pNext), offsetof(VkDescriptorSetLayoutHostMappingInfoVALVE, descriptorOffset), offsetof(VkDescriptorSetLayoutHostMappingInfoVALVE, descriptorSize)};
+// const size_t layout_VkPhysicalDeviceDepthClampZeroOneFeaturesEXT[] = {sizeof(VkPhysicalDeviceDepthClampZeroOneFeaturesEXT), _Alignof(VkPhysicalDeviceDepthClampZeroOneFeaturesEXT), offsetof(VkPhysicalDeviceDepthClampZeroOneFeaturesEXT, sType), offsetof(VkPhysicalDeviceDepthClampZeroOneFeaturesEXT, pNext), offsetof(VkPhysicalDeviceDepthClampZeroOneFeaturesEXT, depthClampZeroOne)};
+// const size_t layout_VkPhysicalDeviceNonSeamlessCubeMapFeaturesEXT[] = {sizeof(VkPhysicalDeviceNonSeamlessCubeMapFeaturesEXT), _Alignof(VkPhysicalDeviceNonSeamlessCubeMapFeaturesEXT), offsetof(VkPhysicalDeviceNonSeamlessCubeMapFeaturesEXT, sType), offsetof(VkPhysicalDeviceNonSeamlessCubeMapFeaturesEXT, pNext), offsetof(VkPhysicalDeviceNonSeamlessCubeMapFeaturesEXT, nonSeamlessCubeMap)};
+// const size_t layout_VkPhysicalDeviceFragmentDensityMapOffsetFeaturesQCOM[] = {sizeof(VkPhysicalDeviceFragmentDensityMapOffsetFeaturesQCOM), _Alignof(VkPhysicalDeviceFragmentDensityMapOffsetFeaturesQCOM), offsetof(VkPhysicalDeviceFragmentDensityMapOffsetFeaturesQCOM, sType), offsetof(VkPhysicalDeviceFragmentDensityMapOffsetFeaturesQCOM, pNext), offsetof(VkPhysicalDeviceFragmentDensityMapOffsetFeaturesQCOM, fragmentDensityMapOffset)};
+// const size_t layout_VkPhysicalDeviceFragmentDensityMapOffsetPropertiesQCOM[] = {sizeof(VkPhysicalDeviceFragmentDensityMapOffsetPropertiesQCOM), _Alignof(VkPhysicalDeviceFragmentDensityMapOffsetPropertiesQCOM), offsetof(VkPhysicalDeviceFragmentDensityMapOffsetPropertiesQCOM, sType), offsetof(VkPhysicalDeviceFragmentDensityMapOffsetPropertiesQCOM, pNext), offsetof(VkPhysicalDeviceFragmentDensityMapOffsetPropertiesQCOM, fragmentDensityOffsetGranularity)};
+// const size_t layout_VkSubpassFragmentDensityMapOffsetEndInfoQCOM[] = {sizeof(VkSubpassFragmentDensityMapOffsetEndInfoQCOM), _Alignof(VkSubpassFragmentDensityMapOffsetEndInfoQCOM), offsetof(VkSubpassFragmentDensityMapOffsetEndInfoQCOM, sType), offsetof(VkSubpassFragmentDensityMapOffsetEndInfoQCOM, pNext), offsetof(VkSubpassFragmentDensityMapOffsetEndInfoQCOM, fragmentDensityOffsetCount), offsetof(VkSubpassFragmentDensityMapOffsetEndInfoQCOM, pFragmentDensityOffsets)};
+// const size_t layout_VkCopyMemoryIndirectCommandNV[] = {sizeof(VkCopyMemoryIndirectCommandNV), _Alignof(VkCopyMemoryIndirectCommandNV), offsetof(VkCopyMemoryIndirectCommandNV, srcAddress), offsetof(VkCopyMemoryIndirectCommandNV, dstAddress), offsetof(VkCopyMemoryIndirectCommandNV, size)};
+// const size_t layout_VkCopyMemoryToImageIndirectCommandNV[] = {sizeof(VkCopyMemoryToImageIndirectCommandNV), _Alignof(VkCopyMemoryToImageIndirectCommandNV), offsetof(VkCopyMemoryToImageIndirectCommandNV, srcAddress), offsetof(VkCopyMemoryToImageIndirectCommandNV, bufferRowLength), offsetof(VkCopyMemoryToImageIndirectCommandNV, bufferImageHeight), offsetof(VkCopyMemoryToImageIndirectCommandNV, imageSubresource), offsetof(VkCopyMemoryToImageIndirectCommandNV, imageOffset), offsetof(VkCopyMemoryToImageIndirectCommandNV, imageExtent)};
+// const size_t layout_VkPhysicalDeviceCopyMemoryIndirectFeaturesNV[] = {sizeof(VkPhysicalDeviceCopyMemoryIndirectFeaturesNV), _Alignof(VkPhysicalDeviceCopyMemoryIndirectFeaturesNV), offsetof(VkPhysicalDeviceCopyMemoryIndirectFeaturesNV, sType), offsetof(VkPhysicalDeviceCopyMemoryIndirectFeaturesNV, pNext), offsetof(VkPhysicalDeviceCopyMemoryIndirectFeaturesNV, indirectCopy)};
+// const size_t layout_VkPhysicalDeviceCopyMemoryIndirectPropertiesNV[] = {sizeof(VkPhysicalDeviceCopyMemoryIndirectPropertiesNV), _Alignof(VkPhysicalDeviceCopyMemoryIndirectPropertiesNV), offsetof(VkPhysicalDeviceCopyMemoryIndirectPropertiesNV, sType), offsetof(VkPhysicalDeviceCopyMemoryIndirectPropertiesNV, pNext), offsetof(VkPhysicalDeviceCopyMemoryIndirectPropertiesNV, supportedQueues)};
+// const size_t layout_VkDecompressMemoryRegionNV[] = {sizeof(VkDecompressMemoryRegionNV), _Alignof(VkDecompressMemoryRegionNV), offsetof(VkDecompressMemoryRegionNV, srcAddress), offsetof(VkDecompressMemoryRegionNV, dstAddress), offsetof(VkDecompressMemoryRegionNV, compressedSize), offsetof(VkDecompressMemoryRegionNV, decompressedSize), offsetof(VkDecompressMemoryRegionNV, decompressionMethod)};
+// const size_t layout_VkPhysicalDeviceMemoryDecompressionFeaturesNV[] = {sizeof(VkPhysicalDeviceMemoryDecompressionFeaturesNV), _Alignof(VkPhysicalDeviceMemoryDecompressionFeaturesNV), offsetof(VkPhysicalDeviceMemoryDecompressionFeaturesNV, sType), offsetof(VkPhysicalDeviceMemoryDecompressionFeaturesNV, pNext), offsetof(VkPhysicalDeviceMemoryDecompressionFeaturesNV, memoryDecompression)};
+// const size_t layout_VkPhysicalDeviceMemoryDecompressionPropertiesNV[] = {sizeof(VkPhysicalDeviceMemoryDecompressionPropertiesNV), _Alignof(VkPhysicalDeviceMemoryDecompressionPropertiesNV), offsetof(VkPhysicalDeviceMemoryDecompressionPropertiesNV, sType), offsetof(VkPhysicalDeviceMemoryDecompressionPropertiesNV, pNext), offsetof(VkPhysicalDeviceMemoryDecompressionPropertiesNV, decompressionMethods), offsetof(VkPhysicalDeviceMemoryDecompressionPropertiesNV, maxDecompressionIndirectCount)};
+// const size_t layout_VkPhysicalDeviceLinearColorAttachmentFeaturesNV[] = {sizeof(VkPhysicalDeviceLinearColorAttachmentFeaturesNV), _Alignof(VkPhysicalDeviceLinearColorAttachmentFeaturesNV), offsetof(VkPhysicalDeviceLinearColorAttachmentFeaturesNV, sType), offsetof(VkPhysicalDeviceLinearColorAttachmentFeaturesNV, pNext), offsetof(VkPhysicalDeviceLinearColorAttachmentFeaturesNV, linearColorAttachment)};
+// const size_t layout_VkPhysicalDeviceImageCompressionControlSwapchainFeaturesEXT[] = {sizeof(VkPhysicalDeviceImageCompressionControlSwapchainFeaturesEXT), _Alignof(VkPhysicalDeviceImageCompressionControlSwapchainFeaturesEXT), offsetof(VkPhysicalDeviceImageCompressionControlSwapchainFeaturesEXT, sType), offsetof(VkPhysicalDeviceImageCompressionControlSwapchainFeaturesEXT, pNext), offsetof(VkPhysicalDeviceImageCompressionControlSwapchainFeaturesEXT, imageCompressionControlSwapchain)};
+// const size_t layout_VkImageViewSampleWeightCreateInfoQCOM[] = {sizeof(VkImageViewSampleWeightCreateInfoQCOM), _Alignof(VkImageViewSampleWeightCreateInfoQCOM), offsetof(VkImageViewSampleWeightCreateInfoQCOM, sType), offsetof(VkImageViewSampleWeightCreateInfoQCOM, pNext), offsetof(VkImageViewSampleWeightCreateInfoQCOM, filterCenter), offsetof(VkImageViewSampleWeightCreateInfoQCOM, filterSize), offsetof(VkImageViewSampleWeightCreateInfoQCOM, numPhases)};
+// const size_t layout_VkPhysicalDeviceImageProcessingFeaturesQCOM[] = {sizeof(VkPhysicalDeviceImageProcessingFeaturesQCOM), _Alignof(VkPhysicalDeviceImageProcessingFeaturesQCOM), offsetof(VkPhysicalDeviceImageProcessingFeaturesQCOM, sType), offsetof(VkPhysicalDeviceImageProcessingFeaturesQCOM, pNext), offsetof(VkPhysicalDeviceImageProcessingFeaturesQCOM, textureSampleWeighted), offsetof(VkPhysicalDeviceImageProcessingFeaturesQCOM, textureBoxFilter), offsetof(VkPhysicalDeviceImageProcessingFeaturesQCOM, textureBlockMatch)};
+// const size_t layout_VkPhysicalDeviceImageProcessingPropertiesQCOM[] = {sizeof(VkPhysicalDeviceImageProcessingPropertiesQCOM), _Alignof(VkPhysicalDeviceImageProcessingPropertiesQCOM), offsetof(VkPhysicalDeviceImageProcessingPropertiesQCOM, sType), offsetof(VkPhysicalDeviceImageProcessingPropertiesQCOM, pNext), offsetof(VkPhysicalDeviceImageProcessingPropertiesQCOM, maxWeightFilterPhases), offsetof(VkPhysicalDeviceImageProcessingPropertiesQCOM, maxWeightFilterDimension), offsetof(VkPhysicalDeviceImageProcessingPropertiesQCOM, maxBlockMatchRegion), offsetof(VkPhysicalDeviceImageProcessingPropertiesQCOM, maxBoxFilterBlockSize)};
+// const size_t layout_VkPhysicalDeviceExtendedDynamicState3FeaturesEXT[] = {sizeof(VkPhysicalDeviceExtendedDynamicState3FeaturesEXT), _Alignof(VkPhysicalDeviceExtendedDynamicState3FeaturesEXT), offsetof(VkPhysicalDeviceExtendedDynamicState3FeaturesEXT, sType), offsetof(VkPhysicalDeviceExtendedDynamicState3FeaturesEXT, pNext), offsetof(VkPhysicalDeviceExtendedDynamicState3FeaturesEXT, extendedDynamicState3TessellationDomainOrigin), offsetof(VkPhysicalDeviceExtendedDynamicState3FeaturesEXT, extendedDynamicState3DepthClampEnable), offsetof(VkPhysicalDeviceExtendedDynamicState3FeaturesEXT, extendedDynamicState3PolygonMode), offsetof(VkPhysicalDeviceExtendedDynamicState3FeaturesEXT, extendedDynamicState3RasterizationSamples), offsetof(VkPhysicalDeviceExtendedDynamicState3FeaturesEXT, extendedDynamicState3SampleMask), offsetof(VkPhysicalDeviceExtendedDynamicState3FeaturesEXT, extendedDynamicState3AlphaToCoverageEnable), offsetof(VkPhysicalDeviceExtendedDynamicState3FeaturesEXT, extendedDynamicState3AlphaToOneEnable), offsetof(VkPhysicalDeviceExtendedDynamicState3FeaturesEXT, extendedDynamicState3LogicOpEnable), offsetof(VkPhysicalDeviceExtendedDynamicState3FeaturesEXT, extendedDynamicState3ColorBlendEnable), offsetof(VkPhysicalDeviceExtendedDynamicState3FeaturesEXT, extendedDynamicState3ColorBlendEquation), offsetof(VkPhysicalDeviceExtendedDynamicState3FeaturesEXT, extendedDynamicState3ColorWriteMask), offsetof(VkPhysicalDeviceExtendedDynamicState3FeaturesEXT, extendedDynamicState3RasterizationStream), offsetof(VkPhysicalDeviceExtendedDynamicState3FeaturesEXT, extendedDynamicState3ConservativeRasterizationMode), offsetof(VkPhysicalDeviceExtendedDynamicState3FeaturesEXT, extendedDynamicState3ExtraPrimitiveOverestimationSize), offsetof(VkPhysicalDeviceExtendedDynamicState3FeaturesEXT, extendedDynamicState3DepthClipEnable), offsetof(VkPhysicalDeviceExtendedDynamicState3FeaturesEXT, extendedDynamicState3SampleLocationsEnable), offsetof(VkPhysicalDeviceExtendedDynamicState3FeaturesEXT, extendedDynamicState3ColorBlendAdvanced), offsetof(VkPhysicalDeviceExtendedDynamicState3FeaturesEXT, extendedDynamicState3ProvokingVertexMode), offsetof(VkPhysicalDeviceExtendedDynamicState3FeaturesEXT, extendedDynamicState3LineRasterizationMode), offsetof(VkPhysicalDeviceExtendedDynamicState3FeaturesEXT, extendedDynamicState3LineStippleEnable), offsetof(VkPhysicalDeviceExtendedDynamicState3FeaturesEXT, extendedDynamicState3DepthClipNegativeOneToOne), offsetof(VkPhysicalDeviceExtendedDynamicState3FeaturesEXT, extendedDynamicState3ViewportWScalingEnable), offsetof(VkPhysicalDeviceExtendedDynamicState3FeaturesEXT, extendedDynamicState3ViewportSwizzle), offsetof(VkPhysicalDeviceExtendedDynamicState3FeaturesEXT, extendedDynamicState3CoverageToColorEnable), offsetof(VkPhysicalDeviceExtendedDynamicState3FeaturesEXT, extendedDynamicState3CoverageToColorLocation), offsetof(VkPhysicalDeviceExtendedDynamicState3FeaturesEXT, extendedDynamicState3CoverageModulationMode), offsetof(VkPhysicalDeviceExtendedDynamicState3FeaturesEXT, extendedDynamicState3CoverageModulationTableEnable), offsetof(VkPhysicalDeviceExtendedDynamicState3FeaturesEXT, extendedDynamicState3CoverageModulationTable), offsetof(VkPhysicalDeviceExtendedDynamicState3FeaturesEXT, extendedDynamicState3CoverageReductionMode), offsetof(VkPhysicalDeviceExtendedDynamicState3FeaturesEXT, extendedDynamicState3RepresentativeFragmentTestEnable), offsetof(VkPhysicalDeviceExtendedDynamicState3FeaturesEXT, extendedDynamicState3ShadingRateImageEnable)};
+// const size_t layout_VkPhysicalDeviceExtendedDynamicState3PropertiesEXT[] = {sizeof(VkPhysicalDeviceExtendedDynamicState3PropertiesEXT), _Alignof(VkPhysicalDeviceExtendedDynamicState3PropertiesEXT), offsetof(VkPhysicalDeviceExtendedDynamicState3PropertiesEXT, sType), offsetof(VkPhysicalDeviceExtendedDynamicState3PropertiesEXT, pNext), offsetof(VkPhysicalDeviceExtendedDynamicState3PropertiesEXT, dynamicPrimitiveTopologyUnrestricted)};
+// const size_t layout_VkColorBlendEquationEXT[] = {sizeof(VkColorBlendEquationEXT), _Alignof(VkColorBlendEquationEXT), offsetof(VkColorBlendEquationEXT, srcColorBlendFactor), offsetof(VkColorBlendEquationEXT, dstColorBlendFactor), offsetof(VkColorBlendEquationEXT, colorBlendOp), offsetof(VkColorBlendEquationEXT, srcAlphaBlendFactor), offsetof(VkColorBlendEquationEXT, dstAlphaBlendFactor), offsetof(VkColorBlendEquationEXT, alphaBlendOp)};
+// const size_t layout_VkColorBlendAdvancedEXT[] = {sizeof(VkColorBlendAdvancedEXT), _Alignof(VkColorBlendAdvancedEXT), offsetof(VkColorBlendAdvancedEXT, advancedBlendOp), offsetof(VkColorBlendAdvancedEXT, srcPremultiplied), offsetof(VkColorBlendAdvancedEXT, dstPremultiplied), offsetof(VkColorBlendAdvancedEXT, blendOverlap), offsetof(VkColorBlendAdvancedEXT, clampResults)};
+// const size_t layout_VkPhysicalDeviceSubpassMergeFeedbackFeaturesEXT[] = {sizeof(VkPhysicalDeviceSubpassMergeFeedbackFeaturesEXT), _Alignof(VkPhysicalDeviceSubpassMergeFeedbackFeaturesEXT), offsetof(VkPhysicalDeviceSubpassMergeFeedbackFeaturesEXT, sType), offsetof(VkPhysicalDeviceSubpassMergeFeedbackFeaturesEXT, pNext), offsetof(VkPhysicalDeviceSubpassMergeFeedbackFeaturesEXT, subpassMergeFeedback)};
+// const size_t layout_VkRenderPassCreationControlEXT[] = {sizeof(VkRenderPassCreationControlEXT), _Alignof(VkRenderPassCreationControlEXT), offsetof(VkRenderPassCreationControlEXT, sType), offsetof(VkRenderPassCreationControlEXT, pNext), offsetof(VkRenderPassCreationControlEXT, disallowMerging)};
+// const size_t layout_VkRenderPassCreationFeedbackInfoEXT[] = {sizeof(VkRenderPassCreationFeedbackInfoEXT), _Alignof(VkRenderPassCreationFeedbackInfoEXT), offsetof(VkRenderPassCreationFeedbackInfoEXT, postMergeSubpassCount)};
+// const size_t layout_VkRenderPassCreationFeedbackCreateInfoEXT[] = {sizeof(VkRenderPassCreationFeedbackCreateInfoEXT), _Alignof(VkRenderPassCreationFeedbackCreateInfoEXT), offsetof(VkRenderPassCreationFeedbackCreateInfoEXT, sType), offsetof(VkRenderPassCreationFeedbackCreateInfoEXT, pNext), offsetof(VkRenderPassCreationFeedbackCreateInfoEXT, pRenderPassFeedback)};
+// const size_t layout_VkRenderPassSubpassFeedbackInfoEXT[] = {sizeof(VkRenderPassSubpassFeedbackInfoEXT), _Alignof(VkRenderPassSubpassFeedbackInfoEXT), offsetof(VkRenderPassSubpassFeedbackInfoEXT, subpassMergeStatus), offsetof(VkRenderPassSubpassFeedbackInfoEXT, description), offsetof(VkRenderPassSubpassFeedbackInfoEXT, postMergeIndex)};
+// const size_t layout_VkRenderPassSubpassFeedbackCreateInfoEXT[] = {sizeof(VkRenderPassSubpassFeedbackCreateInfoEXT), _Alignof(VkRenderPassSubpassFeedbackCreateInfoEXT), offsetof(VkRenderPassSubpassFeedbackCreateInfoEXT, sType), offsetof(VkRenderPassSubpassFeedbackCreateInfoEXT, pNext), offsetof(VkRenderPassSubpassFeedbackCreateInfoEXT, pSubpassFeedback)};
+// const size_t layout_VkDirectDriverLoadingInfoLUNARG[] = {sizeof(VkDirectDriverLoadingInfoLUNARG), _Alignof(VkDirectDriverLoadingInfoLUNARG), offsetof(VkDirectDriverLoadingInfoLUNARG, sType), offsetof(VkDirectDriverLoadingInfoLUNARG, pNext), offsetof(VkDirectDriverLoadingInfoLUNARG, flags), offsetof(VkDirectDriverLoadingInfoLUNARG, pfnGetInstanceProcAddr)};
+// const size_t layout_VkDirectDriverLoadingListLUNARG[] = {sizeof(VkDirectDriverLoadingListLUNARG), _Alignof(VkDirectDriverLoadingListLUNARG), offsetof(VkDirectDriverLoadingListLUNARG, sType), offsetof(VkDirectDriverLoadingListLUNARG, pNext), offsetof(VkDirectDriverLoadingListLUNARG, mode), offsetof(VkDirectDriverLoadingListLUNARG, driverCount), offsetof(VkDirectDriverLoadingListLUNARG, pDrivers)};
+// const size_t layout_VkPhysicalDeviceShaderModuleIdentifierFeaturesEXT[] = {sizeof(VkPhysicalDeviceShaderModuleIdentifierFeaturesEXT), _Alignof(VkPhysicalDeviceShaderModuleIdentifierFeaturesEXT), offsetof(VkPhysicalDeviceShaderModuleIdentifierFeaturesEXT, sType), offsetof(VkPhysicalDeviceShaderModuleIdentifierFeaturesEXT, pNext), offsetof(VkPhysicalDeviceShaderModuleIdentifierFeaturesEXT, shaderModuleIdentifier)};
+// const size_t layout_VkPhysicalDeviceShaderModuleIdentifierPropertiesEXT[] = {sizeof(VkPhysicalDeviceShaderModuleIdentifierPropertiesEXT), _Alignof(VkPhysicalDeviceShaderModuleIdentifierPropertiesEXT), offsetof(VkPhysicalDeviceShaderModuleIdentifierPropertiesEXT, sType), offsetof(VkPhysicalDeviceShaderModuleIdentifierPropertiesEXT, pNext), offsetof(VkPhysicalDeviceShaderModuleIdentifierPropertiesEXT, shaderModuleIdentifierAlgorithmUUID)};
+// const size_t layout_VkPipelineShaderStageModuleIdentifierCreateInfoEXT[] = {sizeof(VkPipelineShaderStageModuleIdentifierCreateInfoEXT), _Alignof(VkPipelineShaderStageModuleIdentifierCreateInfoEXT), offsetof(VkPipelineShaderStageModuleIdentifierCreateInfoEXT, sType), offsetof(VkPipelineShaderStageModuleIdentifierCreateInfoEXT, pNext), offsetof(VkPipelineShaderStageModuleIdentifierCreateInfoEXT, identifierSize), offsetof(VkPipelineShaderStageModuleIdentifierCreateInfoEXT, pIdentifier)};
+// const size_t layout_VkShaderModuleIdentifierEXT[] = {sizeof(VkShaderModuleIdentifierEXT), _Alignof(VkShaderModuleIdentifierEXT), offsetof(VkShaderModuleIdentifierEXT, sType), offsetof(VkShaderModuleIdentifierEXT, pNext), offsetof(VkShaderModuleIdentifierEXT, identifierSize), offsetof(VkShaderModuleIdentifierEXT, identifier)};
+// const size_t layout_VkPhysicalDeviceOpticalFlowFeaturesNV[] = {sizeof(VkPhysicalDeviceOpticalFlowFeaturesNV), _Alignof(VkPhysicalDeviceOpticalFlowFeaturesNV), offsetof(VkPhysicalDeviceOpticalFlowFeaturesNV, sType), offsetof(VkPhysicalDeviceOpticalFlowFeaturesNV, pNext), offsetof(VkPhysicalDeviceOpticalFlowFeaturesNV, opticalFlow)};
+// const size_t layout_VkPhysicalDeviceOpticalFlowPropertiesNV[] = {sizeof(VkPhysicalDeviceOpticalFlowPropertiesNV), _Alignof(VkPhysicalDeviceOpticalFlowPropertiesNV), offsetof(VkPhysicalDeviceOpticalFlowPropertiesNV, sType), offsetof(VkPhysicalDeviceOpticalFlowPropertiesNV, pNext), offsetof(VkPhysicalDeviceOpticalFlowPropertiesNV, supportedOutputGridSizes), offsetof(VkPhysicalDeviceOpticalFlowPropertiesNV, supportedHintGridSizes), offsetof(VkPhysicalDeviceOpticalFlowPropertiesNV, hintSupported), offsetof(VkPhysicalDeviceOpticalFlowPropertiesNV, costSupported), offsetof(VkPhysicalDeviceOpticalFlowPropertiesNV, bidirectionalFlowSupported), offsetof(VkPhysicalDeviceOpticalFlowPropertiesNV, globalFlowSupported), offsetof(VkPhysicalDeviceOpticalFlowPropertiesNV, minWidth), offsetof(VkPhysicalDeviceOpticalFlowPropertiesNV, minHeight), offsetof(VkPhysicalDeviceOpticalFlowPropertiesNV, maxWidth), offsetof(VkPhysicalDeviceOpticalFlowPropertiesNV, maxHeight), offsetof(VkPhysicalDeviceOpticalFlowPropertiesNV, maxNumRegionsOfInterest)};
+// const size_t layout_VkOpticalFlowImageFormatInfoNV[] = {sizeof(VkOpticalFlowImageFormatInfoNV), _Alignof(VkOpticalFlowImageFormatInfoNV), offsetof(VkOpticalFlowImageFormatInfoNV, sType), offsetof(VkOpticalFlowImageFormatInfoNV, pNext), offsetof(VkOpticalFlowImageFormatInfoNV, usage)};
+// const size_t layout_VkOpticalFlowImageFormatPropertiesNV[] = {sizeof(VkOpticalFlowImageFormatPropertiesNV), _Alignof(VkOpticalFlowImageFormatPropertiesNV), offsetof(VkOpticalFlowImageFormatPropertiesNV, sType), offsetof(VkOpticalFlowImageFormatPropertiesNV, pNext), offsetof(VkOpticalFlowImageFormatPropertiesNV, format)};
+// const size_t layout_VkOpticalFlowSessionCreateInfoNV[] = {sizeof(VkOpticalFlowSessionCreateInfoNV), _Alignof(VkOpticalFlowSessionCreateInfoNV), offsetof(VkOpticalFlowSessionCreateInfoNV, sType), offsetof(VkOpticalFlowSessionCreateInfoNV, pNext), offsetof(VkOpticalFlowSessionCreateInfoNV, width), offsetof(VkOpticalFlowSessionCreateInfoNV, height), offsetof(VkOpticalFlowSessionCreateInfoNV, imageFormat), offsetof(VkOpticalFlowSessionCreateInfoNV, flowVectorFormat), offsetof(VkOpticalFlowSessionCreateInfoNV, costFormat), offsetof(VkOpticalFlowSessionCreateInfoNV, outputGridSize), offsetof(VkOpticalFlowSessionCreateInfoNV, hintGridSize), offsetof(VkOpticalFlowSessionCreateInfoNV, performanceLevel), offsetof(VkOpticalFlowSessionCreateInfoNV, flags)};
+// const size_t layout_VkOpticalFlowSessionCreatePrivateDataInfoNV[] = {sizeof(VkOpticalFlowSessionCreatePrivateDataInfoNV), _Alignof(VkOpticalFlowSessionCreatePrivateDataInfoNV), offsetof(VkOpticalFlowSessionCreatePrivateDataInfoNV, sType), offsetof(VkOpticalFlowSessionCreatePrivateDataInfoNV, pNext), offsetof(VkOpticalFlowSessionCreatePrivateDataInfoNV, id), offsetof(VkOpticalFlowSessionCreatePrivateDataInfoNV, size), offsetof(VkOpticalFlowSessionCreatePrivateDataInfoNV, pPrivateData)};
+// const size_t layout_VkOpticalFlowExecuteInfoNV[] = {sizeof(VkOpticalFlowExecuteInfoNV), _Alignof(VkOpticalFlowExecuteInfoNV), offsetof(VkOpticalFlowExecuteInfoNV, sType), offsetof(VkOpticalFlowExecuteInfoNV, pNext), offsetof(VkOpticalFlowExecuteInfoNV, flags), offsetof(VkOpticalFlowExecuteInfoNV, regionCount), offsetof(VkOpticalFlowExecuteInfoNV, pRegions)};
+// const size_t layout_VkPhysicalDeviceLegacyDitheringFeaturesEXT[] = {sizeof(VkPhysicalDeviceLegacyDitheringFeaturesEXT), _Alignof(VkPhysicalDeviceLegacyDitheringFeaturesEXT), offsetof(VkPhysicalDeviceLegacyDitheringFeaturesEXT, sType), offsetof(VkPhysicalDeviceLegacyDitheringFeaturesEXT, pNext), offsetof(VkPhysicalDeviceLegacyDitheringFeaturesEXT, legacyDithering)};
+// const size_t layout_VkPhysicalDevicePipelineProtectedAccessFeaturesEXT[] = {sizeof(VkPhysicalDevicePipelineProtectedAccessFeaturesEXT), _Alignof(VkPhysicalDevicePipelineProtectedAccessFeaturesEXT), offsetof(VkPhysicalDevicePipelineProtectedAccessFeaturesEXT, sType), offsetof(VkPhysicalDevicePipelineProtectedAccessFeaturesEXT, pNext), offsetof(VkPhysicalDevicePipelineProtectedAccessFeaturesEXT, pipelineProtectedAccess)};
+// const size_t layout_VkPhysicalDeviceTilePropertiesFeaturesQCOM[] = {sizeof(VkPhysicalDeviceTilePropertiesFeaturesQCOM), _Alignof(VkPhysicalDeviceTilePropertiesFeaturesQCOM), offsetof(VkPhysicalDeviceTilePropertiesFeaturesQCOM, sType), offsetof(VkPhysicalDeviceTilePropertiesFeaturesQCOM, pNext), offsetof(VkPhysicalDeviceTilePropertiesFeaturesQCOM, tileProperties)};
+// const size_t layout_VkTilePropertiesQCOM[] = {sizeof(VkTilePropertiesQCOM), _Alignof(VkTilePropertiesQCOM), offsetof(VkTilePropertiesQCOM, sType), offsetof(VkTilePropertiesQCOM, pNext), offsetof(VkTilePropertiesQCOM, tileSize), offsetof(VkTilePropertiesQCOM, apronSize), offsetof(VkTilePropertiesQCOM, origin)};
+// const size_t layout_VkPhysicalDeviceAmigoProfilingFeaturesSEC[] = {sizeof(VkPhysicalDeviceAmigoProfilingFeaturesSEC), _Alignof(VkPhysicalDeviceAmigoProfilingFeaturesSEC), offsetof(VkPhysicalDeviceAmigoProfilingFeaturesSEC, sType), offsetof(VkPhysicalDeviceAmigoProfilingFeaturesSEC, pNext), offsetof(VkPhysicalDeviceAmigoProfilingFeaturesSEC, amigoProfiling)};
+// const size_t layout_VkAmigoProfilingSubmitInfoSEC[] = {sizeof(VkAmigoProfilingSubmitInfoSEC), _Alignof(VkAmigoProfilingSubmitInfoSEC), offsetof(VkAmigoProfilingSubmitInfoSEC, sType), offsetof(VkAmigoProfilingSubmitInfoSEC, pNext), offsetof(VkAmigoProfilingSubmitInfoSEC, firstDrawTimestamp), offsetof(VkAmigoProfilingSubmitInfoSEC, swapBufferTimestamp)};
+// const size_t layout_VkPhysicalDeviceMultiviewPerViewViewportsFeaturesQCOM[] = {sizeof(VkPhysicalDeviceMultiviewPerViewViewportsFeaturesQCOM), _Alignof(VkPhysicalDeviceMultiviewPerViewViewportsFeaturesQCOM), offsetof(VkPhysicalDeviceMultiviewPerViewViewportsFeaturesQCOM, sType), offsetof(VkPhysicalDeviceMultiviewPerViewViewportsFeaturesQCOM, pNext), offsetof(VkPhysicalDeviceMultiviewPerViewViewportsFeaturesQCOM, multiviewPerViewViewports)};
+// const size_t layout_VkPhysicalDeviceRayTracingInvocationReorderPropertiesNV[] = {sizeof(VkPhysicalDeviceRayTracingInvocationReorderPropertiesNV), _Alignof(VkPhysicalDeviceRayTracingInvocationReorderPropertiesNV), offsetof(VkPhysicalDeviceRayTracingInvocationReorderPropertiesNV, sType), offsetof(VkPhysicalDeviceRayTracingInvocationReorderPropertiesNV, pNext), offsetof(VkPhysicalDeviceRayTracingInvocationReorderPropertiesNV, rayTracingInvocationReorderReorderingHint)};
+// const size_t layout_VkPhysicalDeviceRayTracingInvocationReorderFeaturesNV[] = {sizeof(VkPhysicalDeviceRayTracingInvocationReorderFeaturesNV), _Alignof(VkPhysicalDeviceRayTracingInvocationReorderFeaturesNV), offsetof(VkPhysicalDeviceRayTracingInvocationReorderFeaturesNV, sType), offsetof(VkPhysicalDeviceRayTracingInvocationReorderFeaturesNV, pNext), offsetof(VkPhysicalDeviceRayTracingInvocationReorderFeaturesNV, rayTracingInvocationReorder)};
+// const size_t layout_VkPhysicalDeviceShaderCoreBuiltinsFeaturesARM[] = {sizeof(VkPhysicalDeviceShaderCoreBuiltinsFeaturesARM), _Alignof(VkPhysicalDeviceShaderCoreBuiltinsFeaturesARM), offsetof(VkPhysicalDeviceShaderCoreBuiltinsFeaturesARM, sType), offsetof(VkPhysicalDeviceShaderCoreBuiltinsFeaturesARM, pNext), offsetof(VkPhysicalDeviceShaderCoreBuiltinsFeaturesARM, shaderCoreBuiltins)};
+// const size_t layout_VkPhysicalDeviceShaderCoreBuiltinsPropertiesARM[] = {sizeof(VkPhysicalDeviceShaderCoreBuiltinsPropertiesARM), _Alignof(VkPhysicalDeviceShaderCoreBuiltinsPropertiesARM), offsetof(VkPhysicalDeviceShaderCoreBuiltinsPropertiesARM, sType), offsetof(VkPhysicalDeviceShaderCoreBuiltinsPropertiesARM, pNext), offsetof(VkPhysicalDeviceShaderCoreBuiltinsPropertiesARM, shaderCoreMask), offsetof(VkPhysicalDeviceShaderCoreBuiltinsPropertiesARM, shaderCoreCount), offsetof(VkPhysicalDeviceShaderCoreBuiltinsPropertiesARM, shaderWarpsPerCore)};
+import "C"
+
+// Layouts maps the C type names to sizeof, _Alignof and the offsetof of
+// every member, in declaration order.
+var Layouts = map[string][]uintptr{
+	"VkExtent2D":                                                    values(C.layout_VkExtent2D[:]),
+	"VkExtent3D":                                                    values(C.layout_VkExtent3D[:]),
+	"VkOffset2D":                                                    values(C.layout_VkOffset2D[:]),
+	"VkOffset3D":                                                    values(C.layout_VkOffset3D[:]),
+	"VkRect2D":                                                      values(C.layout_VkRect2D[:]),
+	"VkBaseInStructure":                                             values(C.layout_VkBaseInStructure[:]),
+	"VkBaseOutStructure":                                            values(C.layout_VkBaseOutStructure[:]),
+	"VkBufferMemoryBarrier":                                         values(C.layout_VkBufferMemoryBarrier[:]),
+	"VkDispatchIndirectCommand":                                     values(C.layout_VkDispatchIndirectCommand[:]),
+	"VkDrawIndexedIndirectCommand":                                  values(C.layout_VkDrawIndexedIndirectCommand[:]),
+	"VkDrawIndirectCommand":                                         values(C.layout_VkDrawIndirectCommand[:]),
+	"VkImageSubresourceRange":                                       values(C.layout_VkImageSubresourceRange[:]),
+	"VkImageMemoryBarrier":                                          values(C.layout_VkImageMemoryBarrier[:]),
+	"VkMemoryBarrier":                                               values(C.layout_VkMemoryBarrier[:]),
+	"VkPipelineCacheHeaderVersionOne":                               values(C.layout_VkPipelineCacheHeaderVersionOne[:]),
+	"VkAllocationCallbacks":                                         values(C.layout_VkAllocationCallbacks[:]),
+	"VkApplicationInfo":                                             values(C.layout_VkApplicationInfo[:]),
+	"VkFormatProperties":                                            values(C.layout_VkFormatProperties[:]),
+	"VkImageFormatProperties":                                       values(C.layout_VkImageFormatProperties[:]),
+	"VkInstanceCreateInfo":                                          values(C.layout_VkInstanceCreateInfo[:]),
+	"VkMemoryHeap":                                                  values(C.layout_VkMemoryHeap[:]),
+	"VkMemoryType":                                                  values(C.layout_VkMemoryType[:]),
+	"VkPhysicalDeviceFeatures":                                      values(C.layout_VkPhysicalDeviceFeatures[:]),
+	"VkPhysicalDeviceLimits":                                        values(C.layout_VkPhysicalDeviceLimits[:]),
+	"VkPhysicalDeviceMemoryProperties":                              values(C.layout_VkPhysicalDeviceMemoryProperties[:]),
+	"VkPhysicalDeviceSparseProperties":                              values(C.layout_VkPhysicalDeviceSparseProperties[:]),
+	"VkPhysicalDeviceProperties":                                    values(C.layout_VkPhysicalDeviceProperties[:]),
+	"VkQueueFamilyProperties":                                       values(C.layout_VkQueueFamilyProperties[:]),
+	"VkDeviceQueueCreateInfo":                                       values(C.layout_VkDeviceQueueCreateInfo[:]),
+	"VkDeviceCreateInfo":                                            values(C.layout_VkDeviceCreateInfo[:]),
+	"VkExtensionProperties":                                         values(C.layout_VkExtensionProperties[:]),
+	"VkLayerProperties":                                             values(C.layout_VkLayerProperties[:]),
+	"VkSubmitInfo":                                                  values(C.layout_VkSubmitInfo[:]),
+	"VkMappedMemoryRange":                                           values(C.layout_VkMappedMemoryRange[:]),
+	"VkMemoryAllocateInfo":                                          values(C.layout_VkMemoryAllocateInfo[:]),
+	"VkMemoryRequirements":                                          values(C.layout_VkMemoryRequirements[:]),
+	"VkSparseMemoryBind":                                            values(C.layout_VkSparseMemoryBind[:]),
+	"VkSparseBufferMemoryBindInfo":                                  values(C.layout_VkSparseBufferMemoryBindInfo[:]),
+	"VkSparseImageOpaqueMemoryBindInfo":                             values(C.layout_VkSparseImageOpaqueMemoryBindInfo[:]),
+	"VkImageSubresource":                                            values(C.layout_VkImageSubresource[:]),
+	"VkSparseImageMemoryBind":                                       values(C.layout_VkSparseImageMemoryBind[:]),
+	"VkSparseImageMemoryBindInfo":                                   values(C.layout_VkSparseImageMemoryBindInfo[:]),
+	"VkBindSparseInfo":                                              values(C.layout_VkBindSparseInfo[:]),
+	"VkSparseImageFormatProperties":                                 values(C.layout_VkSparseImageFormatProperties[:]),
+	"VkSparseImageMemoryRequirements":                               values(C.layout_VkSparseImageMemoryRequirements[:]),
+	"VkFenceCreateInfo":                                             values(C.layout_VkFenceCreateInfo[:]),
+	"VkSemaphoreCreateInfo":                                         values(C.layout_VkSemaphoreCreateInfo[:]),
+	"VkEventCreateInfo":                                             values(C.layout_VkEventCreateInfo[:]),
+	"VkQueryPoolCreateInfo":                                         values(C.layout_VkQueryPoolCreateInfo[:]),
+	"VkBufferCreateInfo":                                            values(C.layout_VkBufferCreateInfo[:]),
+	"VkBufferViewCreateInfo":                                        values(C.layout_VkBufferViewCreateInfo[:]),
+	"VkImageCreateInfo":                                             values(C.layout_VkImageCreateInfo[:]),
+	"VkSubresourceLayout":                                           values(C.layout_VkSubresourceLayout[:]),
+	"VkComponentMapping":                                            values(C.layout_VkComponentMapping[:]),
+	"VkImageViewCreateInfo":                                         values(C.layout_VkImageViewCreateInfo[:]),
+	"VkShaderModuleCreateInfo":                                      values(C.layout_VkShaderModuleCreateInfo[:]),
+	"VkPipelineCacheCreateInfo":                                     values(C.layout_VkPipelineCacheCreateInfo[:]),
+	"VkSpecializationMapEntry":                                      values(C.layout_VkSpecializationMapEntry[:]),
+	"VkSpecializationInfo":                                          values(C.layout_VkSpecializationInfo[:]),
+	"VkPipelineShaderStageCreateInfo":                               values(C.layout_VkPipelineShaderStageCreateInfo[:]),
+	"VkComputePipelineCreateInfo":                                   values(C.layout_VkComputePipelineCreateInfo[:]),
+	"VkVertexInputBindingDescription":                               values(C.layout_VkVertexInputBindingDescription[:]),
+	"VkVertexInputAttributeDescription":                             values(C.layout_VkVertexInputAttributeDescription[:]),
+	"VkPipelineVertexInputStateCreateInfo":                          values(C.layout_VkPipelineVertexInputStateCreateInfo[:]),
+	"VkPipelineInputAssemblyStateCreateInfo":                        values(C.layout_VkPipelineInputAssemblyStateCreateInfo[:]),
+	"VkPipelineTessellationStateCreateInfo":                         values(C.layout_VkPipelineTessellationStateCreateInfo[:]),
+	"VkViewport":                                                    values(C.layout_VkViewport[:]),
+	"VkPipelineViewportStateCreateInfo":                             values(C.layout_VkPipelineViewportStateCreateInfo[:]),
+	"VkPipelineRasterizationStateCreateInfo":                        values(C.layout_VkPipelineRasterizationStateCreateInfo[:]),
+	"VkPipelineMultisampleStateCreateInfo":                          values(C.layout_VkPipelineMultisampleStateCreateInfo[:]),
+	"VkStencilOpState":                                              values(C.layout_VkStencilOpState[:]),
+	"VkPipelineDepthStencilStateCreateInfo":                         values(C.layout_VkPipelineDepthStencilStateCreateInfo[:]),
+	"VkPipelineColorBlendAttachmentState":                           values(C.layout_VkPipelineColorBlendAttachmentState[:]),
+	"VkPipelineColorBlendStateCreateInfo":                           values(C.layout_VkPipelineColorBlendStateCreateInfo[:]),
+	"VkPipelineDynamicStateCreateInfo":                              values(C.layout_VkPipelineDynamicStateCreateInfo[:]),
+	"VkGraphicsPipelineCreateInfo":                                  values(C.layout_VkGraphicsPipelineCreateInfo[:]),
+	"VkPushConstantRange":                                           values(C.layout_VkPushConstantRange[:]),
+	"VkPipelineLayoutCreateInfo":                                    values(C.layout_VkPipelineLayoutCreateInfo[:]),
+	"VkSamplerCreateInfo":                                           values(C.layout_VkSamplerCreateInfo[:]),
+	"VkCopyDescriptorSet":                                           values(C.layout_VkCopyDescriptorSet[:]),
+	"VkDescriptorBufferInfo":                                        values(C.layout_VkDescriptorBufferInfo[:]),
+	"VkDescriptorImageInfo":                                         values(C.layout_VkDescriptorImageInfo[:]),
+	"VkDescriptorPoolSize":                                          values(C.layout_VkDescriptorPoolSize[:]),
+	"VkDescriptorPoolCreateInfo":                                    values(C.layout_VkDescriptorPoolCreateInfo[:]),
+	"VkDescriptorSetAllocateInfo":                                   values(C.layout_VkDescriptorSetAllocateInfo[:]),
+	"VkDescriptorSetLayoutBinding":                                  values(C.layout_VkDescriptorSetLayoutBinding[:]),
+	"VkDescriptorSetLayoutCreateInfo":                               values(C.layout_VkDescriptorSetLayoutCreateInfo[:]),
+	"VkWriteDescriptorSet":                                          values(C.layout_VkWriteDescriptorSet[:]),
+	"VkAttachmentDescription":                                       values(C.layout_VkAttachmentDescription[:]),
+	"VkAttachmentReference":                                         values(C.layout_VkAttachmentReference[:]),
+	"VkFramebufferCreateInfo":                                       values(C.layout_VkFramebufferCreateInfo[:]),
+	"VkSubpassDescription":                                          values(C.layout_VkSubpassDescription[:]),
+	"VkSubpassDependency":                                           values(C.layout_VkSubpassDependency[:]),
+	"VkRenderPassCreateInfo":                                        values(C.layout_VkRenderPassCreateInfo[:]),
+	"VkCommandPoolCreateInfo":                                       values(C.layout_VkCommandPoolCreateInfo[:]),
+	"VkCommandBufferAllocateInfo":                                   values(C.layout_VkCommandBufferAllocateInfo[:]),
+	"VkCommandBufferInheritanceInfo":                                values(C.layout_VkCommandBufferInheritanceInfo[:]),
+	"VkCommandBufferBeginInfo":                                      values(C.layout_VkCommandBufferBeginInfo[:]),
+	"VkBufferCopy":                                                  values(C.layout_VkBufferCopy[:]),
+	"VkImageSubresourceLayers":                                      values(C.layout_VkImageSubresourceLayers[:]),
+	"VkBufferImageCopy":                                             values(C.layout_VkBufferImageCopy[:]),
+	"VkClearColorValue":                                             values(C.layout_VkClearColorValue[:]),
+	"VkClearDepthStencilValue":                                      values(C.layout_VkClearDepthStencilValue[:]),
+	"VkClearValue":                                                  values(C.layout_VkClearValue[:]),
+	"VkClearAttachment":                                             values(C.layout_VkClearAttachment[:]),
+	"VkClearRect":                                                   values(C.layout_VkClearRect[:]),
+	"VkImageBlit":                                                   values(C.layout_VkImageBlit[:]),
+	"VkImageCopy":                                                   values(C.layout_VkImageCopy[:]),
+	"VkImageResolve":                                                values(C.layout_VkImageResolve[:]),
+	"VkRenderPassBeginInfo":                                         values(C.layout_VkRenderPassBeginInfo[:]),
+	"VkPhysicalDeviceSubgroupProperties":                            values(C.layout_VkPhysicalDeviceSubgroupProperties[:]),
+	"VkBindBufferMemoryInfo":                                        values(C.layout_VkBindBufferMemoryInfo[:]),
+	"VkBindImageMemoryInfo":                                         values(C.layout_VkBindImageMemoryInfo[:]),
+	"VkPhysicalDevice16BitStorageFeatures":                          values(C.layout_VkPhysicalDevice16BitStorageFeatures[:]),
+	"VkMemoryDedicatedRequirements":                                 values(C.layout_VkMemoryDedicatedRequirements[:]),
+	"VkMemoryDedicatedAllocateInfo":                                 values(C.layout_VkMemoryDedicatedAllocateInfo[:]),
+	"VkMemoryAllocateFlagsInfo":                                     values(C.layout_VkMemoryAllocateFlagsInfo[:]),
+	"VkDeviceGroupRenderPassBeginInfo":                              values(C.layout_VkDeviceGroupRenderPassBeginInfo[:]),
+	"VkDeviceGroupCommandBufferBeginInfo":                           values(C.layout_VkDeviceGroupCommandBufferBeginInfo[:]),
+	"VkDeviceGroupSubmitInfo":                                       values(C.layout_VkDeviceGroupSubmitInfo[:]),
+	"VkDeviceGroupBindSparseInfo":                                   values(C.layout_VkDeviceGroupBindSparseInfo[:]),
+	"VkBindBufferMemoryDeviceGroupInfo":                             values(C.layout_VkBindBufferMemoryDeviceGroupInfo[:]),
+	"VkBindImageMemoryDeviceGroupInfo":                              values(C.layout_VkBindImageMemoryDeviceGroupInfo[:]),
+	"VkPhysicalDeviceGroupProperties":                               values(C.layout_VkPhysicalDeviceGroupProperties[:]),
+	"VkDeviceGroupDeviceCreateInfo":                                 values(C.layout_VkDeviceGroupDeviceCreateInfo[:]),
+	"VkBufferMemoryRequirementsInfo2":                               values(C.layout_VkBufferMemoryRequirementsInfo2[:]),
+	"VkImageMemoryRequirementsInfo2":                                values(C.layout_VkImageMemoryRequirementsInfo2[:]),
+	"VkImageSparseMemoryRequirementsInfo2":                          values(C.layout_VkImageSparseMemoryRequirementsInfo2[:]),
+	"VkMemoryRequirements2":                                         values(C.layout_VkMemoryRequirements2[:]),
+	"VkSparseImageMemoryRequirements2":                              values(C.layout_VkSparseImageMemoryRequirements2[:]),
+	"VkPhysicalDeviceFeatures2":                                     values(C.layout_VkPhysicalDeviceFeatures2[:]),
+	"VkPhysicalDeviceProperties2":                                   values(C.layout_VkPhysicalDeviceProperties2[:]),
+	"VkFormatProperties2":                                           values(C.layout_VkFormatProperties2[:]),
+	"VkImageFormatProperties2":                                      values(C.layout_VkImageFormatProperties2[:]),
+	"VkPhysicalDeviceImageFormatInfo2":                              values(C.layout_VkPhysicalDeviceImageFormatInfo2[:]),
+	"VkQueueFamilyProperties2":                                      values(C.layout_VkQueueFamilyProperties2[:]),
+	"VkPhysicalDeviceMemoryProperties2":                             values(C.layout_VkPhysicalDeviceMemoryProperties2[:]),
+	"VkSparseImageFormatProperties2":                                values(C.layout_VkSparseImageFormatProperties2[:]),
+	"VkPhysicalDeviceSparseImageFormatInfo2":                        values(C.layout_VkPhysicalDeviceSparseImageFormatInfo2[:]),
+	"VkPhysicalDevicePointClippingProperties":                       values(C.layout_VkPhysicalDevicePointClippingProperties[:]),
+	"VkInputAttachmentAspectReference":                              values(C.layout_VkInputAttachmentAspectReference[:]),
+	"VkRenderPassInputAttachmentAspectCreateInfo":                   values(C.layout_VkRenderPassInputAttachmentAspectCreateInfo[:]),
+	"VkImageViewUsageCreateInfo":                                    values(C.layout_VkImageViewUsageCreateInfo[:]),
+	"VkPipelineTessellationDomainOriginStateCreateInfo":             values(C.layout_VkPipelineTessellationDomainOriginStateCreateInfo[:]),
+	"VkRenderPassMultiviewCreateInfo":                               values(C.layout_VkRenderPassMultiviewCreateInfo[:]),
+	"VkPhysicalDeviceMultiviewFeatures":                             values(C.layout_VkPhysicalDeviceMultiviewFeatures[:]),
+	"VkPhysicalDeviceMultiviewProperties":                           values(C.layout_VkPhysicalDeviceMultiviewProperties[:]),
+	"VkPhysicalDeviceVariablePointersFeatures":                      values(C.layout_VkPhysicalDeviceVariablePointersFeatures[:]),
+	"VkPhysicalDeviceProtectedMemoryFeatures":                       values(C.layout_VkPhysicalDeviceProtectedMemoryFeatures[:]),
+	"VkPhysicalDeviceProtectedMemoryProperties":                     values(C.layout_VkPhysicalDeviceProtectedMemoryProperties[:]),
+	"VkDeviceQueueInfo2":                                            values(C.layout_VkDeviceQueueInfo2[:]),
+	"VkProtectedSubmitInfo":                                         values(C.layout_VkProtectedSubmitInfo[:]),
+	"VkSamplerYcbcrConversionCreateInfo":                            values(C.layout_VkSamplerYcbcrConversionCreateInfo[:]),
+	"VkSamplerYcbcrConversionInfo":                                  values(C.layout_VkSamplerYcbcrConversionInfo[:]),
+	"VkBindImagePlaneMemoryInfo":                                    values(C.layout_VkBindImagePlaneMemoryInfo[:]),
+	"VkImagePlaneMemoryRequirementsInfo":                            values(C.layout_VkImagePlaneMemoryRequirementsInfo[:]),
+	"VkPhysicalDeviceSamplerYcbcrConversionFeatures":                values(C.layout_VkPhysicalDeviceSamplerYcbcrConversionFeatures[:]),
+	"VkSamplerYcbcrConversionImageFormatProperties":                 values(C.layout_VkSamplerYcbcrConversionImageFormatProperties[:]),
+	"VkDescriptorUpdateTemplateEntry":                               values(C.layout_VkDescriptorUpdateTemplateEntry[:]),
+	"VkDescriptorUpdateTemplateCreateInfo":                          values(C.layout_VkDescriptorUpdateTemplateCreateInfo[:]),
+	"VkExternalMemoryProperties":                                    values(C.layout_VkExternalMemoryProperties[:]),
+	"VkPhysicalDeviceExternalImageFormatInfo":                       values(C.layout_VkPhysicalDeviceExternalImageFormatInfo[:]),
+	"VkExternalImageFormatProperties":                               values(C.layout_VkExternalImageFormatProperties[:]),
+	"VkPhysicalDeviceExternalBufferInfo":                            values(C.layout_VkPhysicalDeviceExternalBufferInfo[:]),
+	"VkExternalBufferProperties":                                    values(C.layout_VkExternalBufferProperties[:]),
+	"VkPhysicalDeviceIDProperties":                                  values(C.layout_VkPhysicalDeviceIDProperties[:]),
+	"VkExternalMemoryImageCreateInfo":                               values(C.layout_VkExternalMemoryImageCreateInfo[:]),
+	"VkExternalMemoryBufferCreateInfo":                              values(C.layout_VkExternalMemoryBufferCreateInfo[:]),
+	"VkExportMemoryAllocateInfo":                                    values(C.layout_VkExportMemoryAllocateInfo[:]),
+	"VkPhysicalDeviceExternalFenceInfo":                             values(C.layout_VkPhysicalDeviceExternalFenceInfo[:]),
+	"VkExternalFenceProperties":                                     values(C.layout_VkExternalFenceProperties[:]),
+	"VkExportFenceCreateInfo":                                       values(C.layout_VkExportFenceCreateInfo[:]),
+	"VkExportSemaphoreCreateInfo":                                   values(C.layout_VkExportSemaphoreCreateInfo[:]),
+	"VkPhysicalDeviceExternalSemaphoreInfo":                         values(C.layout_VkPhysicalDeviceExternalSemaphoreInfo[:]),
+	"VkExternalSemaphoreProperties":                                 values(C.layout_VkExternalSemaphoreProperties[:]),
+	"VkPhysicalDeviceMaintenance3Properties":                        values(C.layout_VkPhysicalDeviceMaintenance3Properties[:]),
+	"VkDescriptorSetLayoutSupport":                                  values(C.layout_VkDescriptorSetLayoutSupport[:]),
+	"VkPhysicalDeviceShaderDrawParametersFeatures":                  values(C.layout_VkPhysicalDeviceShaderDrawParametersFeatures[:]),
+	"VkPhysicalDeviceVulkan11Features":                              values(C.layout_VkPhysicalDeviceVulkan11Features[:]),
+	"VkPhysicalDeviceVulkan11Properties":                            values(C.layout_VkPhysicalDeviceVulkan11Properties[:]),
+	"VkPhysicalDeviceVulkan12Features":                              values(C.layout_VkPhysicalDeviceVulkan12Features[:]),
+	"VkConformanceVersion":                                          values(C.layout_VkConformanceVersion[:]),
+	"VkPhysicalDeviceVulkan12Properties":                            values(C.layout_VkPhysicalDeviceVulkan12Properties[:]),
+	"VkImageFormatListCreateInfo":                                   values(C.layout_VkImageFormatListCreateInfo[:]),
+	"VkAttachmentDescription2":                                      values(C.layout_VkAttachmentDescription2[:]),
+	"VkAttachmentReference2":                                        values(C.layout_VkAttachmentReference2[:]),
+	"VkSubpassDescription2":                                         values(C.layout_VkSubpassDescription2[:]),
+	"VkSubpassDependency2":                                          values(C.layout_VkSubpassDependency2[:]),
+	"VkRenderPassCreateInfo2":                                       values(C.layout_VkRenderPassCreateInfo2[:]),
+	"VkSubpassBeginInfo":                                            values(C.layout_VkSubpassBeginInfo[:]),
+	"VkSubpassEndInfo":                                              values(C.layout_VkSubpassEndInfo[:]),
+	"VkPhysicalDevice8BitStorageFeatures":                           values(C.layout_VkPhysicalDevice8BitStorageFeatures[:]),
+	"VkPhysicalDeviceDriverProperties":                              values(C.layout_VkPhysicalDeviceDriverProperties[:]),
+	"VkPhysicalDeviceShaderAtomicInt64Features":                     values(C.layout_VkPhysicalDeviceShaderAtomicInt64Features[:]),
+	"VkPhysicalDeviceShaderFloat16Int8Features":                     values(C.layout_VkPhysicalDeviceShaderFloat16Int8Features[:]),
+	"VkPhysicalDeviceFloatControlsProperties":                       values(C.layout_VkPhysicalDeviceFloatControlsProperties[:]),
+	"VkDescriptorSetLayoutBindingFlagsCreateInfo":                   values(C.layout_VkDescriptorSetLayoutBindingFlagsCreateInfo[:]),
+	"VkPhysicalDeviceDescriptorIndexingFeatures":                    values(C.layout_VkPhysicalDeviceDescriptorIndexingFeatures[:]),
+	"VkPhysicalDeviceDescriptorIndexingProperties":                  values(C.layout_VkPhysicalDeviceDescriptorIndexingProperties[:]),
+	"VkDescriptorSetVariableDescriptorCountAllocateInfo":            values(C.layout_VkDescriptorSetVariableDescriptorCountAllocateInfo[:]),
+	"VkDescriptorSetVariableDescriptorCountLayoutSupport":           values(C.layout_VkDescriptorSetVariableDescriptorCountLayoutSupport[:]),
+	"VkSubpassDescriptionDepthStencilResolve":                       values(C.layout_VkSubpassDescriptionDepthStencilResolve[:]),
+	"VkPhysicalDeviceDepthStencilResolveProperties":                 values(C.layout_VkPhysicalDeviceDepthStencilResolveProperties[:]),
+	"VkPhysicalDeviceScalarBlockLayoutFeatures":                     values(C.layout_VkPhysicalDeviceScalarBlockLayoutFeatures[:]),
+	"VkImageStencilUsageCreateInfo":                                 values(C.layout_VkImageStencilUsageCreateInfo[:]),
+	"VkSamplerReductionModeCreateInfo":                              values(C.layout_VkSamplerReductionModeCreateInfo[:]),
+	"VkPhysicalDeviceSamplerFilterMinmaxProperties":                 values(C.layout_VkPhysicalDeviceSamplerFilterMinmaxProperties[:]),
+	"VkPhysicalDeviceVulkanMemoryModelFeatures":                     values(C.layout_VkPhysicalDeviceVulkanMemoryModelFeatures[:]),
+	"VkPhysicalDeviceImagelessFramebufferFeatures":                  values(C.layout_VkPhysicalDeviceImagelessFramebufferFeatures[:]),
+	"VkFramebufferAttachmentImageInfo":                              values(C.layout_VkFramebufferAttachmentImageInfo[:]),
+	"VkFramebufferAttachmentsCreateInfo":                            values(C.layout_VkFramebufferAttachmentsCreateInfo[:]),
+	"VkRenderPassAttachmentBeginInfo":                               values(C.layout_VkRenderPassAttachmentBeginInfo[:]),
+	"VkPhysicalDeviceUniformBufferStandardLayoutFeatures":           values(C.layout_VkPhysicalDeviceUniformBufferStandardLayoutFeatures[:]),
+	"VkPhysicalDeviceShaderSubgroupExtendedTypesFeatures":           values(C.layout_VkPhysicalDeviceShaderSubgroupExtendedTypesFeatures[:]),
+	"VkPhysicalDeviceSeparateDepthStencilLayoutsFeatures":           values(C.layout_VkPhysicalDeviceSeparateDepthStencilLayoutsFeatures[:]),
+	"VkAttachmentReferenceStencilLayout":                            values(C.layout_VkAttachmentReferenceStencilLayout[:]),
+	"VkAttachmentDescriptionStencilLayout":                          values(C.layout_VkAttachmentDescriptionStencilLayout[:]),
+	"VkPhysicalDeviceHostQueryResetFeatures":                        values(C.layout_VkPhysicalDeviceHostQueryResetFeatures[:]),
+	"VkPhysicalDeviceTimelineSemaphoreFeatures":                     values(C.layout_VkPhysicalDeviceTimelineSemaphoreFeatures[:]),
+	"VkPhysicalDeviceTimelineSemaphoreProperties":                   values(C.layout_VkPhysicalDeviceTimelineSemaphoreProperties[:]),
+	"VkSemaphoreTypeCreateInfo":                                     values(C.layout_VkSemaphoreTypeCreateInfo[:]),
+	"VkTimelineSemaphoreSubmitInfo":                                 values(C.layout_VkTimelineSemaphoreSubmitInfo[:]),
+	"VkSemaphoreWaitInfo":                                           values(C.layout_VkSemaphoreWaitInfo[:]),
+	"VkSemaphoreSignalInfo":                                         values(C.layout_VkSemaphoreSignalInfo[:]),
+	"VkPhysicalDeviceBufferDeviceAddressFeatures":                   values(C.layout_VkPhysicalDeviceBufferDeviceAddressFeatures[:]),
+	"VkBufferDeviceAddressInfo":                                     values(C.layout_VkBufferDeviceAddressInfo[:]),
+	"VkBufferOpaqueCaptureAddressCreateInfo":                        values(C.layout_VkBufferOpaqueCaptureAddressCreateInfo[:]),
+	"VkMemoryOpaqueCaptureAddressAllocateInfo":                      values(C.layout_VkMemoryOpaqueCaptureAddressAllocateInfo[:]),
+	"VkDeviceMemoryOpaqueCaptureAddressInfo":                        values(C.layout_VkDeviceMemoryOpaqueCaptureAddressInfo[:]),
+	"VkPhysicalDeviceVulkan13Features":                              values(C.layout_VkPhysicalDeviceVulkan13Features[:]),
+	"VkPhysicalDeviceVulkan13Properties":                            values(C.layout_VkPhysicalDeviceVulkan13Properties[:]),
+	"VkPipelineCreationFeedback":                                    values(C.layout_VkPipelineCreationFeedback[:]),
+	"VkPipelineCreationFeedbackCreateInfo":                          values(C.layout_VkPipelineCreationFeedbackCreateInfo[:]),
+	"VkPhysicalDeviceShaderTerminateInvocationFeatures":             values(C.layout_VkPhysicalDeviceShaderTerminateInvocationFeatures[:]),
+	"VkPhysicalDeviceToolProperties":                                values(C.layout_VkPhysicalDeviceToolProperties[:]),
+	"VkPhysicalDeviceShaderDemoteToHelperInvocationFeatures":        values(C.layout_VkPhysicalDeviceShaderDemoteToHelperInvocationFeatures[:]),
+	"VkPhysicalDevicePrivateDataFeatures":                           values(C.layout_VkPhysicalDevicePrivateDataFeatures[:]),
+	"VkDevicePrivateDataCreateInfo":                                 values(C.layout_VkDevicePrivateDataCreateInfo[:]),
+	"VkPrivateDataSlotCreateInfo":                                   values(C.layout_VkPrivateDataSlotCreateInfo[:]),
+	"VkPhysicalDevicePipelineCreationCacheControlFeatures":          values(C.layout_VkPhysicalDevicePipelineCreationCacheControlFeatures[:]),
+	"VkMemoryBarrier2":                                              values(C.layout_VkMemoryBarrier2[:]),
+	"VkBufferMemoryBarrier2":                                        values(C.layout_VkBufferMemoryBarrier2[:]),
+	"VkImageMemoryBarrier2":                                         values(C.layout_VkImageMemoryBarrier2[:]),
+	"VkDependencyInfo":                                              values(C.layout_VkDependencyInfo[:]),
+	"VkSemaphoreSubmitInfo":                                         values(C.layout_VkSemaphoreSubmitInfo[:]),
+	"VkCommandBufferSubmitInfo":                                     values(C.layout_VkCommandBufferSubmitInfo[:]),
+	"VkSubmitInfo2":                                                 values(C.layout_VkSubmitInfo2[:]),
+	"VkPhysicalDeviceSynchronization2Features":                      values(C.layout_VkPhysicalDeviceSynchronization2Features[:]),
+	"VkPhysicalDeviceZeroInitializeWorkgroupMemoryFeatures":         values(C.layout_VkPhysicalDeviceZeroInitializeWorkgroupMemoryFeatures[:]),
+	"VkPhysicalDeviceImageRobustnessFeatures":                       values(C.layout_VkPhysicalDeviceImageRobustnessFeatures[:]),
+	"VkBufferCopy2":                                                 values(C.layout_VkBufferCopy2[:]),
+	"VkCopyBufferInfo2":                                             values(C.layout_VkCopyBufferInfo2[:]),
+	"VkImageCopy2":                                                  values(C.layout_VkImageCopy2[:]),
+	"VkCopyImageInfo2":                                              values(C.layout_VkCopyImageInfo2[:]),
+	"VkBufferImageCopy2":                                            values(C.layout_VkBufferImageCopy2[:]),
+	"VkCopyBufferToImageInfo2":                                      values(C.layout_VkCopyBufferToImageInfo2[:]),
+	"VkCopyImageToBufferInfo2":                                      values(C.layout_VkCopyImageToBufferInfo2[:]),
+	"VkImageBlit2":                                                  values(C.layout_VkImageBlit2[:]),
+	"VkBlitImageInfo2":                                              values(C.layout_VkBlitImageInfo2[:]),
+	"VkImageResolve2":                                               values(C.layout_VkImageResolve2[:]),
+	"VkResolveImageInfo2":                                           values(C.layout_VkResolveImageInfo2[:]),
+	"VkPhysicalDeviceSubgroupSizeControlFeatures":                   values(C.layout_VkPhysicalDeviceSubgroupSizeControlFeatures[:]),
+	"VkPhysicalDeviceSubgroupSizeControlProperties":                 values(C.layout_VkPhysicalDeviceSubgroupSizeControlProperties[:]),
+	"VkPipelineShaderStageRequiredSubgroupSizeCreateInfo":           values(C.layout_VkPipelineShaderStageRequiredSubgroupSizeCreateInfo[:]),
+	"VkPhysicalDeviceInlineUniformBlockFeatures":                    values(C.layout_VkPhysicalDeviceInlineUniformBlockFeatures[:]),
+	"VkPhysicalDeviceInlineUniformBlockProperties":                  values(C.layout_VkPhysicalDeviceInlineUniformBlockProperties[:]),
+	"VkWriteDescriptorSetInlineUniformBlock":                        values(C.layout_VkWriteDescriptorSetInlineUniformBlock[:]),
+	"VkDescriptorPoolInlineUniformBlockCreateInfo":                  values(C.layout_VkDescriptorPoolInlineUniformBlockCreateInfo[:]),
+	"VkPhysicalDeviceTextureCompressionASTCHDRFeatures":             values(C.layout_VkPhysicalDeviceTextureCompressionASTCHDRFeatures[:]),
+	"VkRenderingAttachmentInfo":                                     values(C.layout_VkRenderingAttachmentInfo[:]),
+	"VkRenderingInfo":                                               values(C.layout_VkRenderingInfo[:]),
+	"VkPipelineRenderingCreateInfo":                                 values(C.layout_VkPipelineRenderingCreateInfo[:]),
+	"VkPhysicalDeviceDynamicRenderingFeatures":                      values(C.layout_VkPhysicalDeviceDynamicRenderingFeatures[:]),
+	"VkCommandBufferInheritanceRenderingInfo":                       values(C.layout_VkCommandBufferInheritanceRenderingInfo[:]),
+	"VkPhysicalDeviceShaderIntegerDotProductFeatures":               values(C.layout_VkPhysicalDeviceShaderIntegerDotProductFeatures[:]),
+	"VkPhysicalDeviceShaderIntegerDotProductProperties":             values(C.layout_VkPhysicalDeviceShaderIntegerDotProductProperties[:]),
+	"VkPhysicalDeviceTexelBufferAlignmentProperties":                values(C.layout_VkPhysicalDeviceTexelBufferAlignmentProperties[:]),
+	"VkFormatProperties3":                                           values(C.layout_VkFormatProperties3[:]),
+	"VkPhysicalDeviceMaintenance4Features":                          values(C.layout_VkPhysicalDeviceMaintenance4Features[:]),
+	"VkPhysicalDeviceMaintenance4Properties":                        values(C.layout_VkPhysicalDeviceMaintenance4Properties[:]),
+	"VkDeviceBufferMemoryRequirements":                              values(C.layout_VkDeviceBufferMemoryRequirements[:]),
+	"VkDeviceImageMemoryRequirements":                               values(C.layout_VkDeviceImageMemoryRequirements[:]),
+	"VkSurfaceCapabilitiesKHR":                                      values(C.layout_VkSurfaceCapabilitiesKHR[:]),
+	"VkSurfaceFormatKHR":                                            values(C.layout_VkSurfaceFormatKHR[:]),
+	"VkSwapchainCreateInfoKHR":                                      values(C.layout_VkSwapchainCreateInfoKHR[:]),
+	"VkPresentInfoKHR":                                              values(C.layout_VkPresentInfoKHR[:]),
+	"VkImageSwapchainCreateInfoKHR":                                 values(C.layout_VkImageSwapchainCreateInfoKHR[:]),
+	"VkBindImageMemorySwapchainInfoKHR":                             values(C.layout_VkBindImageMemorySwapchainInfoKHR[:]),
+	"VkAcquireNextImageInfoKHR":                                     values(C.layout_VkAcquireNextImageInfoKHR[:]),
+	"VkDeviceGroupPresentCapabilitiesKHR":                           values(C.layout_VkDeviceGroupPresentCapabilitiesKHR[:]),
+	"VkDeviceGroupPresentInfoKHR":                                   values(C.layout_VkDeviceGroupPresentInfoKHR[:]),
+	"VkDeviceGroupSwapchainCreateInfoKHR":                           values(C.layout_VkDeviceGroupSwapchainCreateInfoKHR[:]),
+	"VkDisplayModeParametersKHR":                                    values(C.layout_VkDisplayModeParametersKHR[:]),
+	"VkDisplayModeCreateInfoKHR":                                    values(C.layout_VkDisplayModeCreateInfoKHR[:]),
+	"VkDisplayModePropertiesKHR":                                    values(C.layout_VkDisplayModePropertiesKHR[:]),
+	"VkDisplayPlaneCapabilitiesKHR":                                 values(C.layout_VkDisplayPlaneCapabilitiesKHR[:]),
+	"VkDisplayPlanePropertiesKHR":                                   values(C.layout_VkDisplayPlanePropertiesKHR[:]),
+	"VkDisplayPropertiesKHR":                                        values(C.layout_VkDisplayPropertiesKHR[:]),
+	"VkDisplaySurfaceCreateInfoKHR":                                 values(C.layout_VkDisplaySurfaceCreateInfoKHR[:]),
+	"VkDisplayPresentInfoKHR":                                       values(C.layout_VkDisplayPresentInfoKHR[:]),
+	"VkQueueFamilyQueryResultStatusPropertiesKHR":                   values(C.layout_VkQueueFamilyQueryResultStatusPropertiesKHR[:]),
+	"VkQueueFamilyVideoPropertiesKHR":                               values(C.layout_VkQueueFamilyVideoPropertiesKHR[:]),
+	"VkVideoProfileInfoKHR":                                         values(C.layout_VkVideoProfileInfoKHR[:]),
+	"VkVideoProfileListInfoKHR":                                     values(C.layout_VkVideoProfileListInfoKHR[:]),
+	"VkVideoCapabilitiesKHR":                                        values(C.layout_VkVideoCapabilitiesKHR[:]),
+	"VkPhysicalDeviceVideoFormatInfoKHR":                            values(C.layout_VkPhysicalDeviceVideoFormatInfoKHR[:]),
+	"VkVideoFormatPropertiesKHR":                                    values(C.layout_VkVideoFormatPropertiesKHR[:]),
+	"VkVideoPictureResourceInfoKHR":                                 values(C.layout_VkVideoPictureResourceInfoKHR[:]),
+	"VkVideoReferenceSlotInfoKHR":                                   values(C.layout_VkVideoReferenceSlotInfoKHR[:]),
+	"VkVideoSessionMemoryRequirementsKHR":                           values(C.layout_VkVideoSessionMemoryRequirementsKHR[:]),
+	"VkBindVideoSessionMemoryInfoKHR":                               values(C.layout_VkBindVideoSessionMemoryInfoKHR[:]),
+	"VkVideoSessionCreateInfoKHR":                                   values(C.layout_VkVideoSessionCreateInfoKHR[:]),
+	"VkVideoSessionParametersCreateInfoKHR":                         values(C.layout_VkVideoSessionParametersCreateInfoKHR[:]),
+	"VkVideoSessionParametersUpdateInfoKHR":                         values(C.layout_VkVideoSessionParametersUpdateInfoKHR[:]),
+	"VkVideoBeginCodingInfoKHR":                                     values(C.layout_VkVideoBeginCodingInfoKHR[:]),
+	"VkVideoEndCodingInfoKHR":                                       values(C.layout_VkVideoEndCodingInfoKHR[:]),
+	"VkVideoCodingControlInfoKHR":                                   values(C.layout_VkVideoCodingControlInfoKHR[:]),
+	"VkVideoDecodeCapabilitiesKHR":                                  values(C.layout_VkVideoDecodeCapabilitiesKHR[:]),
+	"VkVideoDecodeUsageInfoKHR":                                     values(C.layout_VkVideoDecodeUsageInfoKHR[:]),
+	"VkVideoDecodeInfoKHR":                                          values(C.layout_VkVideoDecodeInfoKHR[:]),
+	"StdVideoH264SpsVuiFlags":                                       values(C.layout_StdVideoH264SpsVuiFlags[:]),
+	"StdVideoH264HrdParameters":                                     values(C.layout_StdVideoH264HrdParameters[:]),
+	"StdVideoH264SequenceParameterSetVui":                           values(C.layout_StdVideoH264SequenceParameterSetVui[:]),
+	"StdVideoH264SpsFlags":                                          values(C.layout_StdVideoH264SpsFlags[:]),
+	"StdVideoH264ScalingLists":                                      values(C.layout_StdVideoH264ScalingLists[:]),
+	"StdVideoH264SequenceParameterSet":                              values(C.layout_StdVideoH264SequenceParameterSet[:]),
+	"StdVideoH264PpsFlags":                                          values(C.layout_StdVideoH264PpsFlags[:]),
+	"StdVideoH264PictureParameterSet":                               values(C.layout_StdVideoH264PictureParameterSet[:]),
+	"VkVideoDecodeH264ProfileInfoKHR":                               values(C.layout_VkVideoDecodeH264ProfileInfoKHR[:]),
+	"VkVideoDecodeH264CapabilitiesKHR":                              values(C.layout_VkVideoDecodeH264CapabilitiesKHR[:]),
+	"VkVideoDecodeH264SessionParametersAddInfoKHR":                  values(C.layout_VkVideoDecodeH264SessionParametersAddInfoKHR[:]),
+	"VkVideoDecodeH264SessionParametersCreateInfoKHR":               values(C.layout_VkVideoDecodeH264SessionParametersCreateInfoKHR[:]),
+	"StdVideoDecodeH264PictureInfoFlags":                            values(C.layout_StdVideoDecodeH264PictureInfoFlags[:]),
+	"StdVideoDecodeH264PictureInfo":                                 values(C.layout_StdVideoDecodeH264PictureInfo[:]),
+	"StdVideoDecodeH264ReferenceInfoFlags":                          values(C.layout_StdVideoDecodeH264ReferenceInfoFlags[:]),
+	"StdVideoDecodeH264ReferenceInfo":                               values(C.layout_StdVideoDecodeH264ReferenceInfo[:]),
+	"VkVideoDecodeH264PictureInfoKHR":                               values(C.layout_VkVideoDecodeH264PictureInfoKHR[:]),
+	"VkVideoDecodeH264DpbSlotInfoKHR":                               values(C.layout_VkVideoDecodeH264DpbSlotInfoKHR[:]),
+	"VkRenderingFragmentShadingRateAttachmentInfoKHR":               values(C.layout_VkRenderingFragmentShadingRateAttachmentInfoKHR[:]),
+	"VkRenderingFragmentDensityMapAttachmentInfoEXT":                values(C.layout_VkRenderingFragmentDensityMapAttachmentInfoEXT[:]),
+	"VkAttachmentSampleCountInfoAMD":                                values(C.layout_VkAttachmentSampleCountInfoAMD[:]),
+	"VkMultiviewPerViewAttributesInfoNVX":                           values(C.layout_VkMultiviewPerViewAttributesInfoNVX[:]),
+	"VkImportMemoryFdInfoKHR":                                       values(C.layout_VkImportMemoryFdInfoKHR[:]),
+	"VkMemoryFdPropertiesKHR":                                       values(C.layout_VkMemoryFdPropertiesKHR[:]),
+	"VkMemoryGetFdInfoKHR":                                          values(C.layout_VkMemoryGetFdInfoKHR[:]),
+	"VkImportSemaphoreFdInfoKHR":                                    values(C.layout_VkImportSemaphoreFdInfoKHR[:]),
+	"VkSemaphoreGetFdInfoKHR":                                       values(C.layout_VkSemaphoreGetFdInfoKHR[:]),
+	"VkPhysicalDevicePushDescriptorPropertiesKHR":                   values(C.layout_VkPhysicalDevicePushDescriptorPropertiesKHR[:]),
+	"VkRectLayerKHR":                                                values(C.layout_VkRectLayerKHR[:]),
+	"VkPresentRegionKHR":                                            values(C.layout_VkPresentRegionKHR[:]),
+	"VkPresentRegionsKHR":                                           values(C.layout_VkPresentRegionsKHR[:]),
+	"VkSharedPresentSurfaceCapabilitiesKHR":                         values(C.layout_VkSharedPresentSurfaceCapabilitiesKHR[:]),
+	"VkImportFenceFdInfoKHR":                                        values(C.layout_VkImportFenceFdInfoKHR[:]),
+	"VkFenceGetFdInfoKHR":                                           values(C.layout_VkFenceGetFdInfoKHR[:]),
+	"VkPhysicalDevicePerformanceQueryFeaturesKHR":                   values(C.layout_VkPhysicalDevicePerformanceQueryFeaturesKHR[:]),
+	"VkPhysicalDevicePerformanceQueryPropertiesKHR":                 values(C.layout_VkPhysicalDevicePerformanceQueryPropertiesKHR[:]),
+	"VkPerformanceCounterKHR":                                       values(C.layout_VkPerformanceCounterKHR[:]),
+	"VkPerformanceCounterDescriptionKHR":                            values(C.layout_VkPerformanceCounterDescriptionKHR[:]),
+	"VkQueryPoolPerformanceCreateInfoKHR":                           values(C.layout_VkQueryPoolPerformanceCreateInfoKHR[:]),
+	"VkPerformanceCounterResultKHR":                                 values(C.layout_VkPerformanceCounterResultKHR[:]),
+	"VkAcquireProfilingLockInfoKHR":                                 values(C.layout_VkAcquireProfilingLockInfoKHR[:]),
+	"VkPerformanceQuerySubmitInfoKHR":                               values(C.layout_VkPerformanceQuerySubmitInfoKHR[:]),
+	"VkPhysicalDeviceSurfaceInfo2KHR":                               values(C.layout_VkPhysicalDeviceSurfaceInfo2KHR[:]),
+	"VkSurfaceCapabilities2KHR":                                     values(C.layout_VkSurfaceCapabilities2KHR[:]),
+	"VkSurfaceFormat2KHR":                                           values(C.layout_VkSurfaceFormat2KHR[:]),
+	"VkDisplayProperties2KHR":                                       values(C.layout_VkDisplayProperties2KHR[:]),
+	"VkDisplayPlaneProperties2KHR":                                  values(C.layout_VkDisplayPlaneProperties2KHR[:]),
+	"VkDisplayModeProperties2KHR":                                   values(C.layout_VkDisplayModeProperties2KHR[:]),
+	"VkDisplayPlaneInfo2KHR":                                        values(C.layout_VkDisplayPlaneInfo2KHR[:]),
+	"VkDisplayPlaneCapabilities2KHR":                                values(C.layout_VkDisplayPlaneCapabilities2KHR[:]),
+	"VkDeviceOrHostAddressKHR":                                      values(C.layout_VkDeviceOrHostAddressKHR[:]),
+	"VkDeviceOrHostAddressConstKHR":                                 values(C.layout_VkDeviceOrHostAddressConstKHR[:]),
+	"VkAccelerationStructureBuildRangeInfoKHR":                      values(C.layout_VkAccelerationStructureBuildRangeInfoKHR[:]),
+	"VkAabbPositionsKHR":                                            values(C.layout_VkAabbPositionsKHR[:]),
+	"VkAccelerationStructureGeometryTrianglesDataKHR":               values(C.layout_VkAccelerationStructureGeometryTrianglesDataKHR[:]),
+	"VkTransformMatrixKHR":                                          values(C.layout_VkTransformMatrixKHR[:]),
+	"VkAccelerationStructureGeometryAabbsDataKHR":                   values(C.layout_VkAccelerationStructureGeometryAabbsDataKHR[:]),
+	"VkAccelerationStructureGeometryInstancesDataKHR":               values(C.layout_VkAccelerationStructureGeometryInstancesDataKHR[:]),
+	"VkAccelerationStructureGeometryDataKHR":                        values(C.layout_VkAccelerationStructureGeometryDataKHR[:]),
+	"VkAccelerationStructureGeometryKHR":                            values(C.layout_VkAccelerationStructureGeometryKHR[:]),
+	"VkAccelerationStructureBuildGeometryInfoKHR":                   values(C.layout_VkAccelerationStructureBuildGeometryInfoKHR[:]),
+	"VkAccelerationStructureInstanceKHR":                            values(C.layout_VkAccelerationStructureInstanceKHR[:]),
+	"VkAccelerationStructureCreateInfoKHR":                          values(C.layout_VkAccelerationStructureCreateInfoKHR[:]),
+	"VkWriteDescriptorSetAccelerationStructureKHR":                  values(C.layout_VkWriteDescriptorSetAccelerationStructureKHR[:]),
+	"VkPhysicalDeviceAccelerationStructureFeaturesKHR":              values(C.layout_VkPhysicalDeviceAccelerationStructureFeaturesKHR[:]),
+	"VkPhysicalDeviceAccelerationStructurePropertiesKHR":            values(C.layout_VkPhysicalDeviceAccelerationStructurePropertiesKHR[:]),
+	"VkAccelerationStructureDeviceAddressInfoKHR":                   values(C.layout_VkAccelerationStructureDeviceAddressInfoKHR[:]),
+	"VkAccelerationStructureVersionInfoKHR":                         values(C.layout_VkAccelerationStructureVersionInfoKHR[:]),
+	"VkCopyAccelerationStructureToMemoryInfoKHR":                    values(C.layout_VkCopyAccelerationStructureToMemoryInfoKHR[:]),
+	"VkCopyMemoryToAccelerationStructureInfoKHR":                    values(C.layout_VkCopyMemoryToAccelerationStructureInfoKHR[:]),
+	"VkCopyAccelerationStructureInfoKHR":                            values(C.layout_VkCopyAccelerationStructureInfoKHR[:]),
+	"VkAccelerationStructureBuildSizesInfoKHR":                      values(C.layout_VkAccelerationStructureBuildSizesInfoKHR[:]),
+	"VkPhysicalDeviceShaderClockFeaturesKHR":                        values(C.layout_VkPhysicalDeviceShaderClockFeaturesKHR[:]),
+	"StdVideoH265DecPicBufMgr":                                      values(C.layout_StdVideoH265DecPicBufMgr[:]),
+	"StdVideoH265SubLayerHrdParameters":                             values(C.layout_StdVideoH265SubLayerHrdParameters[:]),
+	"StdVideoH265HrdFlags":                                          values(C.layout_StdVideoH265HrdFlags[:]),
+	"StdVideoH265HrdParameters":                                     values(C.layout_StdVideoH265HrdParameters[:]),
+	"StdVideoH265VpsFlags":                                          values(C.layout_StdVideoH265VpsFlags[:]),
+	"StdVideoH265ProfileTierLevelFlags":                             values(C.layout_StdVideoH265ProfileTierLevelFlags[:]),
+	"StdVideoH265ProfileTierLevel":                                  values(C.layout_StdVideoH265ProfileTierLevel[:]),
+	"StdVideoH265VideoParameterSet":                                 values(C.layout_StdVideoH265VideoParameterSet[:]),
+	"StdVideoH265ScalingLists":                                      values(C.layout_StdVideoH265ScalingLists[:]),
+	"StdVideoH265SpsVuiFlags":                                       values(C.layout_StdVideoH265SpsVuiFlags[:]),
+	"StdVideoH265SequenceParameterSetVui":                           values(C.layout_StdVideoH265SequenceParameterSetVui[:]),
+	"StdVideoH265PredictorPaletteEntries":                           values(C.layout_StdVideoH265PredictorPaletteEntries[:]),
+	"StdVideoH265SpsFlags":                                          values(C.layout_StdVideoH265SpsFlags[:]),
+	"StdVideoH265ShortTermRefPicSetFlags":                           values(C.layout_StdVideoH265ShortTermRefPicSetFlags[:]),
+	"StdVideoH265ShortTermRefPicSet":                                values(C.layout_StdVideoH265ShortTermRefPicSet[:]),
+	"StdVideoH265LongTermRefPicsSps":                                values(C.layout_StdVideoH265LongTermRefPicsSps[:]),
+	"StdVideoH265SequenceParameterSet":                              values(C.layout_StdVideoH265SequenceParameterSet[:]),
+	"StdVideoH265PpsFlags":                                          values(C.layout_StdVideoH265PpsFlags[:]),
+	"StdVideoH265PictureParameterSet":                               values(C.layout_StdVideoH265PictureParameterSet[:]),
+	"VkVideoDecodeH265ProfileInfoKHR":                               values(C.layout_VkVideoDecodeH265ProfileInfoKHR[:]),
+	"VkVideoDecodeH265CapabilitiesKHR":                              values(C.layout_VkVideoDecodeH265CapabilitiesKHR[:]),
+	"VkVideoDecodeH265SessionParametersAddInfoKHR":                  values(C.layout_VkVideoDecodeH265SessionParametersAddInfoKHR[:]),
+	"VkVideoDecodeH265SessionParametersCreateInfoKHR":               values(C.layout_VkVideoDecodeH265SessionParametersCreateInfoKHR[:]),
+	"StdVideoDecodeH265PictureInfoFlags":                            values(C.layout_StdVideoDecodeH265PictureInfoFlags[:]),
+	"StdVideoDecodeH265PictureInfo":                                 values(C.layout_StdVideoDecodeH265PictureInfo[:]),
+	"StdVideoDecodeH265ReferenceInfoFlags":                          values(C.layout_StdVideoDecodeH265ReferenceInfoFlags[:]),
+	"StdVideoDecodeH265ReferenceInfo":                               values(C.layout_StdVideoDecodeH265ReferenceInfo[:]),
+	"VkVideoDecodeH265PictureInfoKHR":                               values(C.layout_VkVideoDecodeH265PictureInfoKHR[:]),
+	"VkVideoDecodeH265DpbSlotInfoKHR":                               values(C.layout_VkVideoDecodeH265DpbSlotInfoKHR[:]),
+	"VkDeviceQueueGlobalPriorityCreateInfoKHR":                      values(C.layout_VkDeviceQueueGlobalPriorityCreateInfoKHR[:]),
+	"VkPhysicalDeviceGlobalPriorityQueryFeaturesKHR":                values(C.layout_VkPhysicalDeviceGlobalPriorityQueryFeaturesKHR[:]),
+	"VkQueueFamilyGlobalPriorityPropertiesKHR":                      values(C.layout_VkQueueFamilyGlobalPriorityPropertiesKHR[:]),
+	"VkFragmentShadingRateAttachmentInfoKHR":                        values(C.layout_VkFragmentShadingRateAttachmentInfoKHR[:]),
+	"VkPipelineFragmentShadingRateStateCreateInfoKHR":               values(C.layout_VkPipelineFragmentShadingRateStateCreateInfoKHR[:]),
+	"VkPhysicalDeviceFragmentShadingRateFeaturesKHR":                values(C.layout_VkPhysicalDeviceFragmentShadingRateFeaturesKHR[:]),
+	"VkPhysicalDeviceFragmentShadingRatePropertiesKHR":              values(C.layout_VkPhysicalDeviceFragmentShadingRatePropertiesKHR[:]),
+	"VkPhysicalDeviceFragmentShadingRateKHR":                        values(C.layout_VkPhysicalDeviceFragmentShadingRateKHR[:]),
+	"VkSurfaceProtectedCapabilitiesKHR":                             values(C.layout_VkSurfaceProtectedCapabilitiesKHR[:]),
+	"VkPhysicalDevicePresentWaitFeaturesKHR":                        values(C.layout_VkPhysicalDevicePresentWaitFeaturesKHR[:]),
+	"VkPhysicalDevicePipelineExecutablePropertiesFeaturesKHR":       values(C.layout_VkPhysicalDevicePipelineExecutablePropertiesFeaturesKHR[:]),
+	"VkPipelineInfoKHR":                                             values(C.layout_VkPipelineInfoKHR[:]),
+	"VkPipelineExecutablePropertiesKHR":                             values(C.layout_VkPipelineExecutablePropertiesKHR[:]),
+	"VkPipelineExecutableInfoKHR":                                   values(C.layout_VkPipelineExecutableInfoKHR[:]),
+	"VkPipelineExecutableStatisticValueKHR":                         values(C.layout_VkPipelineExecutableStatisticValueKHR[:]),
+	"VkPipelineExecutableStatisticKHR":                              values(C.layout_VkPipelineExecutableStatisticKHR[:]),
+	"VkPipelineExecutableInternalRepresentationKHR":                 values(C.layout_VkPipelineExecutableInternalRepresentationKHR[:]),
+	"VkPipelineLibraryCreateInfoKHR":                                values(C.layout_VkPipelineLibraryCreateInfoKHR[:]),
+	"VkPresentIdKHR":                                                values(C.layout_VkPresentIdKHR[:]),
+	"VkPhysicalDevicePresentIdFeaturesKHR":                          values(C.layout_VkPhysicalDevicePresentIdFeaturesKHR[:]),
+	"VkQueueFamilyCheckpointProperties2NV":                          values(C.layout_VkQueueFamilyCheckpointProperties2NV[:]),
+	"VkCheckpointData2NV":                                           values(C.layout_VkCheckpointData2NV[:]),
+	"VkPhysicalDeviceFragmentShaderBarycentricFeaturesKHR":          values(C.layout_VkPhysicalDeviceFragmentShaderBarycentricFeaturesKHR[:]),
+	"VkPhysicalDeviceFragmentShaderBarycentricPropertiesKHR":        values(C.layout_VkPhysicalDeviceFragmentShaderBarycentricPropertiesKHR[:]),
+	"VkPhysicalDeviceShaderSubgroupUniformControlFlowFeaturesKHR":   values(C.layout_VkPhysicalDeviceShaderSubgroupUniformControlFlowFeaturesKHR[:]),
+	"VkPhysicalDeviceWorkgroupMemoryExplicitLayoutFeaturesKHR":      values(C.layout_VkPhysicalDeviceWorkgroupMemoryExplicitLayoutFeaturesKHR[:]),
+	"VkRayTracingShaderGroupCreateInfoKHR":                          values(C.layout_VkRayTracingShaderGroupCreateInfoKHR[:]),
+	"VkRayTracingPipelineInterfaceCreateInfoKHR":                    values(C.layout_VkRayTracingPipelineInterfaceCreateInfoKHR[:]),
+	"VkRayTracingPipelineCreateInfoKHR":                             values(C.layout_VkRayTracingPipelineCreateInfoKHR[:]),
+	"VkPhysicalDeviceRayTracingPipelineFeaturesKHR":                 values(C.layout_VkPhysicalDeviceRayTracingPipelineFeaturesKHR[:]),
+	"VkPhysicalDeviceRayTracingPipelinePropertiesKHR":               values(C.layout_VkPhysicalDeviceRayTracingPipelinePropertiesKHR[:]),
+	"VkStridedDeviceAddressRegionKHR":                               values(C.layout_VkStridedDeviceAddressRegionKHR[:]),
+	"VkTraceRaysIndirectCommandKHR":                                 values(C.layout_VkTraceRaysIndirectCommandKHR[:]),
+	"VkPhysicalDeviceRayQueryFeaturesKHR":                           values(C.layout_VkPhysicalDeviceRayQueryFeaturesKHR[:]),
+	"VkPhysicalDeviceRayTracingMaintenance1FeaturesKHR":             values(C.layout_VkPhysicalDeviceRayTracingMaintenance1FeaturesKHR[:]),
+	"VkTraceRaysIndirectCommand2KHR":                                values(C.layout_VkTraceRaysIndirectCommand2KHR[:]),
+	"VkDebugReportCallbackCreateInfoEXT":                            values(C.layout_VkDebugReportCallbackCreateInfoEXT[:]),
+	"VkPipelineRasterizationStateRasterizationOrderAMD":             values(C.layout_VkPipelineRasterizationStateRasterizationOrderAMD[:]),
+	"VkDebugMarkerObjectNameInfoEXT":                                values(C.layout_VkDebugMarkerObjectNameInfoEXT[:]),
+	"VkDebugMarkerObjectTagInfoEXT":                                 values(C.layout_VkDebugMarkerObjectTagInfoEXT[:]),
+	"VkDebugMarkerMarkerInfoEXT":                                    values(C.layout_VkDebugMarkerMarkerInfoEXT[:]),
+	"VkDedicatedAllocationImageCreateInfoNV":                        values(C.layout_VkDedicatedAllocationImageCreateInfoNV[:]),
+	"VkDedicatedAllocationBufferCreateInfoNV":                       values(C.layout_VkDedicatedAllocationBufferCreateInfoNV[:]),
+	"VkDedicatedAllocationMemoryAllocateInfoNV":                     values(C.layout_VkDedicatedAllocationMemoryAllocateInfoNV[:]),
+	"VkPhysicalDeviceTransformFeedbackFeaturesEXT":                  values(C.layout_VkPhysicalDeviceTransformFeedbackFeaturesEXT[:]),
+	"VkPhysicalDeviceTransformFeedbackPropertiesEXT":                values(C.layout_VkPhysicalDeviceTransformFeedbackPropertiesEXT[:]),
+	"VkPipelineRasterizationStateStreamCreateInfoEXT":               values(C.layout_VkPipelineRasterizationStateStreamCreateInfoEXT[:]),
+	"VkCuModuleCreateInfoNVX":                                       values(C.layout_VkCuModuleCreateInfoNVX[:]),
+	"VkCuFunctionCreateInfoNVX":                                     values(C.layout_VkCuFunctionCreateInfoNVX[:]),
+	"VkCuLaunchInfoNVX":                                             values(C.layout_VkCuLaunchInfoNVX[:]),
+	"VkImageViewHandleInfoNVX":                                      values(C.layout_VkImageViewHandleInfoNVX[:]),
+	"VkImageViewAddressPropertiesNVX":                               values(C.layout_VkImageViewAddressPropertiesNVX[:]),
+	"VkTextureLODGatherFormatPropertiesAMD":                         values(C.layout_VkTextureLODGatherFormatPropertiesAMD[:]),
+	"VkShaderResourceUsageAMD":                                      values(C.layout_VkShaderResourceUsageAMD[:]),
+	"VkShaderStatisticsInfoAMD":                                     values(C.layout_VkShaderStatisticsInfoAMD[:]),
+	"VkPhysicalDeviceCornerSampledImageFeaturesNV":                  values(C.layout_VkPhysicalDeviceCornerSampledImageFeaturesNV[:]),
+	"VkExternalImageFormatPropertiesNV":                             values(C.layout_VkExternalImageFormatPropertiesNV[:]),
+	"VkExternalMemoryImageCreateInfoNV":                             values(C.layout_VkExternalMemoryImageCreateInfoNV[:]),
+	"VkExportMemoryAllocateInfoNV":                                  values(C.layout_VkExportMemoryAllocateInfoNV[:]),
+	"VkValidationFlagsEXT":                                          values(C.layout_VkValidationFlagsEXT[:]),
+	"VkImageViewASTCDecodeModeEXT":                                  values(C.layout_VkImageViewASTCDecodeModeEXT[:]),
+	"VkPhysicalDeviceASTCDecodeFeaturesEXT":                         values(C.layout_VkPhysicalDeviceASTCDecodeFeaturesEXT[:]),
+	"VkPhysicalDevicePipelineRobustnessFeaturesEXT":                 values(C.layout_VkPhysicalDevicePipelineRobustnessFeaturesEXT[:]),
+	"VkPhysicalDevicePipelineRobustnessPropertiesEXT":               values(C.layout_VkPhysicalDevicePipelineRobustnessPropertiesEXT[:]),
+	"VkPipelineRobustnessCreateInfoEXT":                             values(C.layout_VkPipelineRobustnessCreateInfoEXT[:]),
+	"VkConditionalRenderingBeginInfoEXT":                            values(C.layout_VkConditionalRenderingBeginInfoEXT[:]),
+	"VkPhysicalDeviceConditionalRenderingFeaturesEXT":               values(C.layout_VkPhysicalDeviceConditionalRenderingFeaturesEXT[:]),
+	"VkCommandBufferInheritanceConditionalRenderingInfoEXT":         values(C.layout_VkCommandBufferInheritanceConditionalRenderingInfoEXT[:]),
+	"VkViewportWScalingNV":                                          values(C.layout_VkViewportWScalingNV[:]),
+	"VkPipelineViewportWScalingStateCreateInfoNV":                   values(C.layout_VkPipelineViewportWScalingStateCreateInfoNV[:]),
+	"VkSurfaceCapabilities2EXT":                                     values(C.layout_VkSurfaceCapabilities2EXT[:]),
+	"VkDisplayPowerInfoEXT":                                         values(C.layout_VkDisplayPowerInfoEXT[:]),
+	"VkDeviceEventInfoEXT":                                          values(C.layout_VkDeviceEventInfoEXT[:]),
+	"VkDisplayEventInfoEXT":                                         values(C.layout_VkDisplayEventInfoEXT[:]),
+	"VkSwapchainCounterCreateInfoEXT":                               values(C.layout_VkSwapchainCounterCreateInfoEXT[:]),
+	"VkRefreshCycleDurationGOOGLE":                                  values(C.layout_VkRefreshCycleDurationGOOGLE[:]),
+	"VkPastPresentationTimingGOOGLE":                                values(C.layout_VkPastPresentationTimingGOOGLE[:]),
+	"VkPresentTimeGOOGLE":                                           values(C.layout_VkPresentTimeGOOGLE[:]),
+	"VkPresentTimesInfoGOOGLE":                                      values(C.layout_VkPresentTimesInfoGOOGLE[:]),
+	"VkPhysicalDeviceMultiviewPerViewAttributesPropertiesNVX":       values(C.layout_VkPhysicalDeviceMultiviewPerViewAttributesPropertiesNVX[:]),
+	"VkViewportSwizzleNV":                                           values(C.layout_VkViewportSwizzleNV[:]),
+	"VkPipelineViewportSwizzleStateCreateInfoNV":                    values(C.layout_VkPipelineViewportSwizzleStateCreateInfoNV[:]),
+	"VkPhysicalDeviceDiscardRectanglePropertiesEXT":                 values(C.layout_VkPhysicalDeviceDiscardRectanglePropertiesEXT[:]),
+	"VkPipelineDiscardRectangleStateCreateInfoEXT":                  values(C.layout_VkPipelineDiscardRectangleStateCreateInfoEXT[:]),
+	"VkPhysicalDeviceConservativeRasterizationPropertiesEXT":        values(C.layout_VkPhysicalDeviceConservativeRasterizationPropertiesEXT[:]),
+	"VkPipelineRasterizationConservativeStateCreateInfoEXT":         values(C.layout_VkPipelineRasterizationConservativeStateCreateInfoEXT[:]),
+	"VkPhysicalDeviceDepthClipEnableFeaturesEXT":                    values(C.layout_VkPhysicalDeviceDepthClipEnableFeaturesEXT[:]),
+	"VkPipelineRasterizationDepthClipStateCreateInfoEXT":            values(C.layout_VkPipelineRasterizationDepthClipStateCreateInfoEXT[:]),
+	"VkXYColorEXT":                                                  values(C.layout_VkXYColorEXT[:]),
+	"VkHdrMetadataEXT":                                              values(C.layout_VkHdrMetadataEXT[:]),
+	"VkDebugUtilsLabelEXT":                                          values(C.layout_VkDebugUtilsLabelEXT[:]),
+	"VkDebugUtilsObjectNameInfoEXT":                                 values(C.layout_VkDebugUtilsObjectNameInfoEXT[:]),
+	"VkDebugUtilsMessengerCallbackDataEXT":                          values(C.layout_VkDebugUtilsMessengerCallbackDataEXT[:]),
+	"VkDebugUtilsMessengerCreateInfoEXT":                            values(C.layout_VkDebugUtilsMessengerCreateInfoEXT[:]),
+	"VkDebugUtilsObjectTagInfoEXT":                                  values(C.layout_VkDebugUtilsObjectTagInfoEXT[:]),
+	"VkSampleLocationEXT":                                           values(C.layout_VkSampleLocationEXT[:]),
+	"VkSampleLocationsInfoEXT":                                      values(C.layout_VkSampleLocationsInfoEXT[:]),
+	"VkAttachmentSampleLocationsEXT":                                values(C.layout_VkAttachmentSampleLocationsEXT[:]),
+	"VkSubpassSampleLocationsEXT":                                   values(C.layout_VkSubpassSampleLocationsEXT[:]),
+	"VkRenderPassSampleLocationsBeginInfoEXT":                       values(C.layout_VkRenderPassSampleLocationsBeginInfoEXT[:]),
+	"VkPipelineSampleLocationsStateCreateInfoEXT":                   values(C.layout_VkPipelineSampleLocationsStateCreateInfoEXT[:]),
+	"VkPhysicalDeviceSampleLocationsPropertiesEXT":                  values(C.layout_VkPhysicalDeviceSampleLocationsPropertiesEXT[:]),
+	"VkMultisamplePropertiesEXT":                                    values(C.layout_VkMultisamplePropertiesEXT[:]),
+	"VkPhysicalDeviceBlendOperationAdvancedFeaturesEXT":             values(C.layout_VkPhysicalDeviceBlendOperationAdvancedFeaturesEXT[:]),
+	"VkPhysicalDeviceBlendOperationAdvancedPropertiesEXT":           values(C.layout_VkPhysicalDeviceBlendOperationAdvancedPropertiesEXT[:]),
+	"VkPipelineColorBlendAdvancedStateCreateInfoEXT":                values(C.layout_VkPipelineColorBlendAdvancedStateCreateInfoEXT[:]),
+	"VkPipelineCoverageToColorStateCreateInfoNV":                    values(C.layout_VkPipelineCoverageToColorStateCreateInfoNV[:]),
+	"VkPipelineCoverageModulationStateCreateInfoNV":                 values(C.layout_VkPipelineCoverageModulationStateCreateInfoNV[:]),
+	"VkPhysicalDeviceShaderSMBuiltinsPropertiesNV":                  values(C.layout_VkPhysicalDeviceShaderSMBuiltinsPropertiesNV[:]),
+	"VkPhysicalDeviceShaderSMBuiltinsFeaturesNV":                    values(C.layout_VkPhysicalDeviceShaderSMBuiltinsFeaturesNV[:]),
+	"VkDrmFormatModifierPropertiesEXT":                              values(C.layout_VkDrmFormatModifierPropertiesEXT[:]),
+	"VkDrmFormatModifierPropertiesListEXT":                          values(C.layout_VkDrmFormatModifierPropertiesListEXT[:]),
+	"VkPhysicalDeviceImageDrmFormatModifierInfoEXT":                 values(C.layout_VkPhysicalDeviceImageDrmFormatModifierInfoEXT[:]),
+	"VkImageDrmFormatModifierListCreateInfoEXT":                     values(C.layout_VkImageDrmFormatModifierListCreateInfoEXT[:]),
+	"VkImageDrmFormatModifierExplicitCreateInfoEXT":                 values(C.layout_VkImageDrmFormatModifierExplicitCreateInfoEXT[:]),
+	"VkImageDrmFormatModifierPropertiesEXT":                         values(C.layout_VkImageDrmFormatModifierPropertiesEXT[:]),
+	"VkDrmFormatModifierProperties2EXT":                             values(C.layout_VkDrmFormatModifierProperties2EXT[:]),
+	"VkDrmFormatModifierPropertiesList2EXT":                         values(C.layout_VkDrmFormatModifierPropertiesList2EXT[:]),
+	"VkValidationCacheCreateInfoEXT":                                values(C.layout_VkValidationCacheCreateInfoEXT[:]),
+	"VkShaderModuleValidationCacheCreateInfoEXT":                    values(C.layout_VkShaderModuleValidationCacheCreateInfoEXT[:]),
+	"VkShadingRatePaletteNV":                                        values(C.layout_VkShadingRatePaletteNV[:]),
+	"VkPipelineViewportShadingRateImageStateCreateInfoNV":           values(C.layout_VkPipelineViewportShadingRateImageStateCreateInfoNV[:]),
+	"VkPhysicalDeviceShadingRateImageFeaturesNV":                    values(C.layout_VkPhysicalDeviceShadingRateImageFeaturesNV[:]),
+	"VkPhysicalDeviceShadingRateImagePropertiesNV":                  values(C.layout_VkPhysicalDeviceShadingRateImagePropertiesNV[:]),
+	"VkCoarseSampleLocationNV":                                      values(C.layout_VkCoarseSampleLocationNV[:]),
+	"VkCoarseSampleOrderCustomNV":                                   values(C.layout_VkCoarseSampleOrderCustomNV[:]),
+	"VkPipelineViewportCoarseSampleOrderStateCreateInfoNV":          values(C.layout_VkPipelineViewportCoarseSampleOrderStateCreateInfoNV[:]),
+	"VkRayTracingShaderGroupCreateInfoNV":                           values(C.layout_VkRayTracingShaderGroupCreateInfoNV[:]),
+	"VkRayTracingPipelineCreateInfoNV":                              values(C.layout_VkRayTracingPipelineCreateInfoNV[:]),
+	"VkGeometryTrianglesNV":                                         values(C.layout_VkGeometryTrianglesNV[:]),
+	"VkGeometryAABBNV":                                              values(C.layout_VkGeometryAABBNV[:]),
+	"VkGeometryDataNV":                                              values(C.layout_VkGeometryDataNV[:]),
+	"VkGeometryNV":                                                  values(C.layout_VkGeometryNV[:]),
+	"VkAccelerationStructureInfoNV":                                 values(C.layout_VkAccelerationStructureInfoNV[:]),
+	"VkAccelerationStructureCreateInfoNV":                           values(C.layout_VkAccelerationStructureCreateInfoNV[:]),
+	"VkBindAccelerationStructureMemoryInfoNV":                       values(C.layout_VkBindAccelerationStructureMemoryInfoNV[:]),
+	"VkWriteDescriptorSetAccelerationStructureNV":                   values(C.layout_VkWriteDescriptorSetAccelerationStructureNV[:]),
+	"VkAccelerationStructureMemoryRequirementsInfoNV":               values(C.layout_VkAccelerationStructureMemoryRequirementsInfoNV[:]),
+	"VkPhysicalDeviceRayTracingPropertiesNV":                        values(C.layout_VkPhysicalDeviceRayTracingPropertiesNV[:]),
+	"VkPhysicalDeviceRepresentativeFragmentTestFeaturesNV":          values(C.layout_VkPhysicalDeviceRepresentativeFragmentTestFeaturesNV[:]),
+	"VkPipelineRepresentativeFragmentTestStateCreateInfoNV":         values(C.layout_VkPipelineRepresentativeFragmentTestStateCreateInfoNV[:]),
+	"VkPhysicalDeviceImageViewImageFormatInfoEXT":                   values(C.layout_VkPhysicalDeviceImageViewImageFormatInfoEXT[:]),
+	"VkFilterCubicImageViewImageFormatPropertiesEXT":                values(C.layout_VkFilterCubicImageViewImageFormatPropertiesEXT[:]),
+	"VkImportMemoryHostPointerInfoEXT":                              values(C.layout_VkImportMemoryHostPointerInfoEXT[:]),
+	"VkMemoryHostPointerPropertiesEXT":                              values(C.layout_VkMemoryHostPointerPropertiesEXT[:]),
+	"VkPhysicalDeviceExternalMemoryHostPropertiesEXT":               values(C.layout_VkPhysicalDeviceExternalMemoryHostPropertiesEXT[:]),
+	"VkPipelineCompilerControlCreateInfoAMD":                        values(C.layout_VkPipelineCompilerControlCreateInfoAMD[:]),
+	"VkCalibratedTimestampInfoEXT":                                  values(C.layout_VkCalibratedTimestampInfoEXT[:]),
+	"VkPhysicalDeviceShaderCorePropertiesAMD":                       values(C.layout_VkPhysicalDeviceShaderCorePropertiesAMD[:]),
+	"VkDeviceMemoryOverallocationCreateInfoAMD":                     values(C.layout_VkDeviceMemoryOverallocationCreateInfoAMD[:]),
+	"VkPhysicalDeviceVertexAttributeDivisorPropertiesEXT":           values(C.layout_VkPhysicalDeviceVertexAttributeDivisorPropertiesEXT[:]),
+	"VkVertexInputBindingDivisorDescriptionEXT":                     values(C.layout_VkVertexInputBindingDivisorDescriptionEXT[:]),
+	"VkPipelineVertexInputDivisorStateCreateInfoEXT":                values(C.layout_VkPipelineVertexInputDivisorStateCreateInfoEXT[:]),
+	"VkPhysicalDeviceVertexAttributeDivisorFeaturesEXT":             values(C.layout_VkPhysicalDeviceVertexAttributeDivisorFeaturesEXT[:]),
+	"VkPhysicalDeviceComputeShaderDerivativesFeaturesNV":            values(C.layout_VkPhysicalDeviceComputeShaderDerivativesFeaturesNV[:]),
+	"VkPhysicalDeviceMeshShaderFeaturesNV":                          values(C.layout_VkPhysicalDeviceMeshShaderFeaturesNV[:]),
+	"VkPhysicalDeviceMeshShaderPropertiesNV":                        values(C.layout_VkPhysicalDeviceMeshShaderPropertiesNV[:]),
+	"VkDrawMeshTasksIndirectCommandNV":                              values(C.layout_VkDrawMeshTasksIndirectCommandNV[:]),
+	"VkPhysicalDeviceShaderImageFootprintFeaturesNV":                values(C.layout_VkPhysicalDeviceShaderImageFootprintFeaturesNV[:]),
+	"VkPipelineViewportExclusiveScissorStateCreateInfoNV":           values(C.layout_VkPipelineViewportExclusiveScissorStateCreateInfoNV[:]),
+	"VkPhysicalDeviceExclusiveScissorFeaturesNV":                    values(C.layout_VkPhysicalDeviceExclusiveScissorFeaturesNV[:]),
+	"VkQueueFamilyCheckpointPropertiesNV":                           values(C.layout_VkQueueFamilyCheckpointPropertiesNV[:]),
+	"VkCheckpointDataNV":                                            values(C.layout_VkCheckpointDataNV[:]),
+	"VkPhysicalDeviceShaderIntegerFunctions2FeaturesINTEL":          values(C.layout_VkPhysicalDeviceShaderIntegerFunctions2FeaturesINTEL[:]),
+	"VkPerformanceValueDataINTEL":                                   values(C.layout_VkPerformanceValueDataINTEL[:]),
+	"VkPerformanceValueINTEL":                                       values(C.layout_VkPerformanceValueINTEL[:]),
+	"VkInitializePerformanceApiInfoINTEL":                           values(C.layout_VkInitializePerformanceApiInfoINTEL[:]),
+	"VkQueryPoolPerformanceQueryCreateInfoINTEL":                    values(C.layout_VkQueryPoolPerformanceQueryCreateInfoINTEL[:]),
+	"VkPerformanceMarkerInfoINTEL":                                  values(C.layout_VkPerformanceMarkerInfoINTEL[:]),
+	"VkPerformanceStreamMarkerInfoINTEL":                            values(C.layout_VkPerformanceStreamMarkerInfoINTEL[:]),
+	"VkPerformanceOverrideInfoINTEL":                                values(C.layout_VkPerformanceOverrideInfoINTEL[:]),
+	"VkPerformanceConfigurationAcquireInfoINTEL":                    values(C.layout_VkPerformanceConfigurationAcquireInfoINTEL[:]),
+	"VkPhysicalDevicePCIBusInfoPropertiesEXT":                       values(C.layout_VkPhysicalDevicePCIBusInfoPropertiesEXT[:]),
+	"VkDisplayNativeHdrSurfaceCapabilitiesAMD":                      values(C.layout_VkDisplayNativeHdrSurfaceCapabilitiesAMD[:]),
+	"VkSwapchainDisplayNativeHdrCreateInfoAMD":                      values(C.layout_VkSwapchainDisplayNativeHdrCreateInfoAMD[:]),
+	"VkPhysicalDeviceFragmentDensityMapFeaturesEXT":                 values(C.layout_VkPhysicalDeviceFragmentDensityMapFeaturesEXT[:]),
+	"VkPhysicalDeviceFragmentDensityMapPropertiesEXT":               values(C.layout_VkPhysicalDeviceFragmentDensityMapPropertiesEXT[:]),
+	"VkRenderPassFragmentDensityMapCreateInfoEXT":                   values(C.layout_VkRenderPassFragmentDensityMapCreateInfoEXT[:]),
+	"VkPhysicalDeviceShaderCoreProperties2AMD":                      values(C.layout_VkPhysicalDeviceShaderCoreProperties2AMD[:]),
+	"VkPhysicalDeviceCoherentMemoryFeaturesAMD":                     values(C.layout_VkPhysicalDeviceCoherentMemoryFeaturesAMD[:]),
+	"VkPhysicalDeviceShaderImageAtomicInt64FeaturesEXT":             values(C.layout_VkPhysicalDeviceShaderImageAtomicInt64FeaturesEXT[:]),
+	"VkPhysicalDeviceMemoryBudgetPropertiesEXT":                     values(C.layout_VkPhysicalDeviceMemoryBudgetPropertiesEXT[:]),
+	"VkPhysicalDeviceMemoryPriorityFeaturesEXT":                     values(C.layout_VkPhysicalDeviceMemoryPriorityFeaturesEXT[:]),
+	"VkMemoryPriorityAllocateInfoEXT":                               values(C.layout_VkMemoryPriorityAllocateInfoEXT[:]),
+	"VkPhysicalDeviceDedicatedAllocationImageAliasingFeaturesNV":    values(C.layout_VkPhysicalDeviceDedicatedAllocationImageAliasingFeaturesNV[:]),
+	"VkPhysicalDeviceBufferDeviceAddressFeaturesEXT":                values(C.layout_VkPhysicalDeviceBufferDeviceAddressFeaturesEXT[:]),
+	"VkBufferDeviceAddressCreateInfoEXT":                            values(C.layout_VkBufferDeviceAddressCreateInfoEXT[:]),
+	"VkValidationFeaturesEXT":                                       values(C.layout_VkValidationFeaturesEXT[:]),
+	"VkCooperativeMatrixPropertiesNV":                               values(C.layout_VkCooperativeMatrixPropertiesNV[:]),
+	"VkPhysicalDeviceCooperativeMatrixFeaturesNV":                   values(C.layout_VkPhysicalDeviceCooperativeMatrixFeaturesNV[:]),
+	"VkPhysicalDeviceCooperativeMatrixPropertiesNV":                 values(C.layout_VkPhysicalDeviceCooperativeMatrixPropertiesNV[:]),
+	"VkPhysicalDeviceCoverageReductionModeFeaturesNV":               values(C.layout_VkPhysicalDeviceCoverageReductionModeFeaturesNV[:]),
+	"VkPipelineCoverageReductionStateCreateInfoNV":                  values(C.layout_VkPipelineCoverageReductionStateCreateInfoNV[:]),
+	"VkFramebufferMixedSamplesCombinationNV":                        values(C.layout_VkFramebufferMixedSamplesCombinationNV[:]),
+	"VkPhysicalDeviceFragmentShaderInterlockFeaturesEXT":            values(C.layout_VkPhysicalDeviceFragmentShaderInterlockFeaturesEXT[:]),
+	"VkPhysicalDeviceYcbcrImageArraysFeaturesEXT":                   values(C.layout_VkPhysicalDeviceYcbcrImageArraysFeaturesEXT[:]),
+	"VkPhysicalDeviceProvokingVertexFeaturesEXT":                    values(C.layout_VkPhysicalDeviceProvokingVertexFeaturesEXT[:]),
+	"VkPhysicalDeviceProvokingVertexPropertiesEXT":                  values(C.layout_VkPhysicalDeviceProvokingVertexPropertiesEXT[:]),
+	"VkPipelineRasterizationProvokingVertexStateCreateInfoEXT":      values(C.layout_VkPipelineRasterizationProvokingVertexStateCreateInfoEXT[:]),
+	"VkHeadlessSurfaceCreateInfoEXT":                                values(C.layout_VkHeadlessSurfaceCreateInfoEXT[:]),
+	"VkPhysicalDeviceLineRasterizationFeaturesEXT":                  values(C.layout_VkPhysicalDeviceLineRasterizationFeaturesEXT[:]),
+	"VkPhysicalDeviceLineRasterizationPropertiesEXT":                values(C.layout_VkPhysicalDeviceLineRasterizationPropertiesEXT[:]),
+	"VkPipelineRasterizationLineStateCreateInfoEXT":                 values(C.layout_VkPipelineRasterizationLineStateCreateInfoEXT[:]),
+	"VkPhysicalDeviceShaderAtomicFloatFeaturesEXT":                  values(C.layout_VkPhysicalDeviceShaderAtomicFloatFeaturesEXT[:]),
+	"VkPhysicalDeviceIndexTypeUint8FeaturesEXT":                     values(C.layout_VkPhysicalDeviceIndexTypeUint8FeaturesEXT[:]),
+	"VkPhysicalDeviceExtendedDynamicStateFeaturesEXT":               values(C.layout_VkPhysicalDeviceExtendedDynamicStateFeaturesEXT[:]),
+	"VkPhysicalDeviceShaderAtomicFloat2FeaturesEXT":                 values(C.layout_VkPhysicalDeviceShaderAtomicFloat2FeaturesEXT[:]),
+	"VkSurfacePresentModeEXT":                                       values(C.layout_VkSurfacePresentModeEXT[:]),
+	"VkSurfacePresentScalingCapabilitiesEXT":                        values(C.layout_VkSurfacePresentScalingCapabilitiesEXT[:]),
+	"VkSurfacePresentModeCompatibilityEXT":                          values(C.layout_VkSurfacePresentModeCompatibilityEXT[:]),
+	"VkPhysicalDeviceSwapchainMaintenance1FeaturesEXT":              values(C.layout_VkPhysicalDeviceSwapchainMaintenance1FeaturesEXT[:]),
+	"VkSwapchainPresentFenceInfoEXT":                                values(C.layout_VkSwapchainPresentFenceInfoEXT[:]),
+	"VkSwapchainPresentModesCreateInfoEXT":                          values(C.layout_VkSwapchainPresentModesCreateInfoEXT[:]),
+	"VkSwapchainPresentModeInfoEXT":                                 values(C.layout_VkSwapchainPresentModeInfoEXT[:]),
+	"VkSwapchainPresentScalingCreateInfoEXT":                        values(C.layout_VkSwapchainPresentScalingCreateInfoEXT[:]),
+	"VkReleaseSwapchainImagesInfoEXT":                               values(C.layout_VkReleaseSwapchainImagesInfoEXT[:]),
+	"VkPhysicalDeviceDeviceGeneratedCommandsPropertiesNV":           values(C.layout_VkPhysicalDeviceDeviceGeneratedCommandsPropertiesNV[:]),
+	"VkPhysicalDeviceDeviceGeneratedCommandsFeaturesNV":             values(C.layout_VkPhysicalDeviceDeviceGeneratedCommandsFeaturesNV[:]),
+	"VkGraphicsShaderGroupCreateInfoNV":                             values(C.layout_VkGraphicsShaderGroupCreateInfoNV[:]),
+	"VkGraphicsPipelineShaderGroupsCreateInfoNV":                    values(C.layout_VkGraphicsPipelineShaderGroupsCreateInfoNV[:]),
+	"VkBindShaderGroupIndirectCommandNV":                            values(C.layout_VkBindShaderGroupIndirectCommandNV[:]),
+	"VkBindIndexBufferIndirectCommandNV":                            values(C.layout_VkBindIndexBufferIndirectCommandNV[:]),
+	"VkBindVertexBufferIndirectCommandNV":                           values(C.layout_VkBindVertexBufferIndirectCommandNV[:]),
+	"VkSetStateFlagsIndirectCommandNV":                              values(C.layout_VkSetStateFlagsIndirectCommandNV[:]),
+	"VkIndirectCommandsStreamNV":                                    values(C.layout_VkIndirectCommandsStreamNV[:]),
+	"VkIndirectCommandsLayoutTokenNV":                               values(C.layout_VkIndirectCommandsLayoutTokenNV[:]),
+	"VkIndirectCommandsLayoutCreateInfoNV":                          values(C.layout_VkIndirectCommandsLayoutCreateInfoNV[:]),
+	"VkGeneratedCommandsInfoNV":                                     values(C.layout_VkGeneratedCommandsInfoNV[:]),
+	"VkGeneratedCommandsMemoryRequirementsInfoNV":                   values(C.layout_VkGeneratedCommandsMemoryRequirementsInfoNV[:]),
+	"VkPhysicalDeviceInheritedViewportScissorFeaturesNV":            values(C.layout_VkPhysicalDeviceInheritedViewportScissorFeaturesNV[:]),
+	"VkCommandBufferInheritanceViewportScissorInfoNV":               values(C.layout_VkCommandBufferInheritanceViewportScissorInfoNV[:]),
+	"VkPhysicalDeviceTexelBufferAlignmentFeaturesEXT":               values(C.layout_VkPhysicalDeviceTexelBufferAlignmentFeaturesEXT[:]),
+	"VkRenderPassTransformBeginInfoQCOM":                            values(C.layout_VkRenderPassTransformBeginInfoQCOM[:]),
+	"VkCommandBufferInheritanceRenderPassTransformInfoQCOM":         values(C.layout_VkCommandBufferInheritanceRenderPassTransformInfoQCOM[:]),
+	"VkPhysicalDeviceDeviceMemoryReportFeaturesEXT":                 values(C.layout_VkPhysicalDeviceDeviceMemoryReportFeaturesEXT[:]),
+	"VkDeviceMemoryReportCallbackDataEXT":                           values(C.layout_VkDeviceMemoryReportCallbackDataEXT[:]),
+	"VkDeviceDeviceMemoryReportCreateInfoEXT":                       values(C.layout_VkDeviceDeviceMemoryReportCreateInfoEXT[:]),
+	"VkPhysicalDeviceRobustness2FeaturesEXT":                        values(C.layout_VkPhysicalDeviceRobustness2FeaturesEXT[:]),
+	"VkPhysicalDeviceRobustness2PropertiesEXT":                      values(C.layout_VkPhysicalDeviceRobustness2PropertiesEXT[:]),
+	"VkSamplerCustomBorderColorCreateInfoEXT":                       values(C.layout_VkSamplerCustomBorderColorCreateInfoEXT[:]),
+	"VkPhysicalDeviceCustomBorderColorPropertiesEXT":                values(C.layout_VkPhysicalDeviceCustomBorderColorPropertiesEXT[:]),
+	"VkPhysicalDeviceCustomBorderColorFeaturesEXT":                  values(C.layout_VkPhysicalDeviceCustomBorderColorFeaturesEXT[:]),
+	"VkPhysicalDevicePresentBarrierFeaturesNV":                      values(C.layout_VkPhysicalDevicePresentBarrierFeaturesNV[:]),
+	"VkSurfaceCapabilitiesPresentBarrierNV":                         values(C.layout_VkSurfaceCapabilitiesPresentBarrierNV[:]),
+	"VkSwapchainPresentBarrierCreateInfoNV":                         values(C.layout_VkSwapchainPresentBarrierCreateInfoNV[:]),
+	"VkPhysicalDeviceDiagnosticsConfigFeaturesNV":                   values(C.layout_VkPhysicalDeviceDiagnosticsConfigFeaturesNV[:]),
+	"VkDeviceDiagnosticsConfigCreateInfoNV":                         values(C.layout_VkDeviceDiagnosticsConfigCreateInfoNV[:]),
+	"VkPhysicalDeviceDescriptorBufferPropertiesEXT":                 values(C.layout_VkPhysicalDeviceDescriptorBufferPropertiesEXT[:]),
+	"VkPhysicalDeviceDescriptorBufferDensityMapPropertiesEXT":       values(C.layout_VkPhysicalDeviceDescriptorBufferDensityMapPropertiesEXT[:]),
+	"VkPhysicalDeviceDescriptorBufferFeaturesEXT":                   values(C.layout_VkPhysicalDeviceDescriptorBufferFeaturesEXT[:]),
+	"VkDescriptorAddressInfoEXT":                                    values(C.layout_VkDescriptorAddressInfoEXT[:]),
+	"VkDescriptorBufferBindingInfoEXT":                              values(C.layout_VkDescriptorBufferBindingInfoEXT[:]),
+	"VkDescriptorBufferBindingPushDescriptorBufferHandleEXT":        values(C.layout_VkDescriptorBufferBindingPushDescriptorBufferHandleEXT[:]),
+	"VkDescriptorDataEXT":                                           values(C.layout_VkDescriptorDataEXT[:]),
+	"VkDescriptorGetInfoEXT":                                        values(C.layout_VkDescriptorGetInfoEXT[:]),
+	"VkBufferCaptureDescriptorDataInfoEXT":                          values(C.layout_VkBufferCaptureDescriptorDataInfoEXT[:]),
+	"VkImageCaptureDescriptorDataInfoEXT":                           values(C.layout_VkImageCaptureDescriptorDataInfoEXT[:]),
+	"VkImageViewCaptureDescriptorDataInfoEXT":                       values(C.layout_VkImageViewCaptureDescriptorDataInfoEXT[:]),
+	"VkSamplerCaptureDescriptorDataInfoEXT":                         values(C.layout_VkSamplerCaptureDescriptorDataInfoEXT[:]),
+	"VkOpaqueCaptureDescriptorDataCreateInfoEXT":                    values(C.layout_VkOpaqueCaptureDescriptorDataCreateInfoEXT[:]),
+	"VkAccelerationStructureCaptureDescriptorDataInfoEXT":           values(C.layout_VkAccelerationStructureCaptureDescriptorDataInfoEXT[:]),
+	"VkPhysicalDeviceGraphicsPipelineLibraryFeaturesEXT":            values(C.layout_VkPhysicalDeviceGraphicsPipelineLibraryFeaturesEXT[:]),
+	"VkPhysicalDeviceGraphicsPipelineLibraryPropertiesEXT":          values(C.layout_VkPhysicalDeviceGraphicsPipelineLibraryPropertiesEXT[:]),
+	"VkGraphicsPipelineLibraryCreateInfoEXT":                        values(C.layout_VkGraphicsPipelineLibraryCreateInfoEXT[:]),
+	"VkPhysicalDeviceShaderEarlyAndLateFragmentTestsFeaturesAMD":    values(C.layout_VkPhysicalDeviceShaderEarlyAndLateFragmentTestsFeaturesAMD[:]),
+	"VkPhysicalDeviceFragmentShadingRateEnumsFeaturesNV":            values(C.layout_VkPhysicalDeviceFragmentShadingRateEnumsFeaturesNV[:]),
+	"VkPhysicalDeviceFragmentShadingRateEnumsPropertiesNV":          values(C.layout_VkPhysicalDeviceFragmentShadingRateEnumsPropertiesNV[:]),
+	"VkPipelineFragmentShadingRateEnumStateCreateInfoNV":            values(C.layout_VkPipelineFragmentShadingRateEnumStateCreateInfoNV[:]),
+	"VkAccelerationStructureGeometryMotionTrianglesDataNV":          values(C.layout_VkAccelerationStructureGeometryMotionTrianglesDataNV[:]),
+	"VkAccelerationStructureMotionInfoNV":                           values(C.layout_VkAccelerationStructureMotionInfoNV[:]),
+	"VkAccelerationStructureMatrixMotionInstanceNV":                 values(C.layout_VkAccelerationStructureMatrixMotionInstanceNV[:]),
+	"VkSRTDataNV":                                                   values(C.layout_VkSRTDataNV[:]),
+	"VkAccelerationStructureSRTMotionInstanceNV":                    values(C.layout_VkAccelerationStructureSRTMotionInstanceNV[:]),
+	"VkAccelerationStructureMotionInstanceDataNV":                   values(C.layout_VkAccelerationStructureMotionInstanceDataNV[:]),
+	"VkAccelerationStructureMotionInstanceNV":                       values(C.layout_VkAccelerationStructureMotionInstanceNV[:]),
+	"VkPhysicalDeviceRayTracingMotionBlurFeaturesNV":                values(C.layout_VkPhysicalDeviceRayTracingMotionBlurFeaturesNV[:]),
+	"VkPhysicalDeviceMeshShaderFeaturesEXT":                         values(C.layout_VkPhysicalDeviceMeshShaderFeaturesEXT[:]),
+	"VkPhysicalDeviceMeshShaderPropertiesEXT":                       values(C.layout_VkPhysicalDeviceMeshShaderPropertiesEXT[:]),
+	"VkDrawMeshTasksIndirectCommandEXT":                             values(C.layout_VkDrawMeshTasksIndirectCommandEXT[:]),
+	"VkPhysicalDeviceYcbcr2Plane444FormatsFeaturesEXT":              values(C.layout_VkPhysicalDeviceYcbcr2Plane444FormatsFeaturesEXT[:]),
+	"VkPhysicalDeviceFragmentDensityMap2FeaturesEXT":                values(C.layout_VkPhysicalDeviceFragmentDensityMap2FeaturesEXT[:]),
+	"VkPhysicalDeviceFragmentDensityMap2PropertiesEXT":              values(C.layout_VkPhysicalDeviceFragmentDensityMap2PropertiesEXT[:]),
+	"VkCopyCommandTransformInfoQCOM":                                values(C.layout_VkCopyCommandTransformInfoQCOM[:]),
+	"VkPhysicalDeviceImageCompressionControlFeaturesEXT":            values(C.layout_VkPhysicalDeviceImageCompressionControlFeaturesEXT[:]),
+	"VkImageCompressionControlEXT":                                  values(C.layout_VkImageCompressionControlEXT[:]),
+	"VkSubresourceLayout2EXT":                                       values(C.layout_VkSubresourceLayout2EXT[:]),
+	"VkImageSubresource2EXT":                                        values(C.layout_VkImageSubresource2EXT[:]),
+	"VkImageCompressionPropertiesEXT":                               values(C.layout_VkImageCompressionPropertiesEXT[:]),
+	"VkPhysicalDeviceAttachmentFeedbackLoopLayoutFeaturesEXT":       values(C.layout_VkPhysicalDeviceAttachmentFeedbackLoopLayoutFeaturesEXT[:]),
+	"VkPhysicalDevice4444FormatsFeaturesEXT":                        values(C.layout_VkPhysicalDevice4444FormatsFeaturesEXT[:]),
+	"VkPhysicalDeviceFaultFeaturesEXT":                              values(C.layout_VkPhysicalDeviceFaultFeaturesEXT[:]),
+	"VkDeviceFaultCountsEXT":                                        values(C.layout_VkDeviceFaultCountsEXT[:]),
+	"VkDeviceFaultAddressInfoEXT":                                   values(C.layout_VkDeviceFaultAddressInfoEXT[:]),
+	"VkDeviceFaultVendorInfoEXT":                                    values(C.layout_VkDeviceFaultVendorInfoEXT[:]),
+	"VkDeviceFaultInfoEXT":                                          values(C.layout_VkDeviceFaultInfoEXT[:]),
+	"VkDeviceFaultVendorBinaryHeaderVersionOneEXT":                  values(C.layout_VkDeviceFaultVendorBinaryHeaderVersionOneEXT[:]),
+	"VkPhysicalDeviceRasterizationOrderAttachmentAccessFeaturesEXT": values(C.layout_VkPhysicalDeviceRasterizationOrderAttachmentAccessFeaturesEXT[:]),
+	"VkPhysicalDeviceRGBA10X6FormatsFeaturesEXT":                    values(C.layout_VkPhysicalDeviceRGBA10X6FormatsFeaturesEXT[:]),
+	"VkPhysicalDeviceMutableDescriptorTypeFeaturesEXT":              values(C.layout_VkPhysicalDeviceMutableDescriptorTypeFeaturesEXT[:]),
+	"VkMutableDescriptorTypeListEXT":                                values(C.layout_VkMutableDescriptorTypeListEXT[:]),
+	"VkMutableDescriptorTypeCreateInfoEXT":                          values(C.layout_VkMutableDescriptorTypeCreateInfoEXT[:]),
+	"VkPhysicalDeviceVertexInputDynamicStateFeaturesEXT":            values(C.layout_VkPhysicalDeviceVertexInputDynamicStateFeaturesEXT[:]),
+	"VkVertexInputBindingDescription2EXT":                           values(C.layout_VkVertexInputBindingDescription2EXT[:]),
+	"VkVertexInputAttributeDescription2EXT":                         values(C.layout_VkVertexInputAttributeDescription2EXT[:]),
+	"VkPhysicalDeviceDrmPropertiesEXT":                              values(C.layout_VkPhysicalDeviceDrmPropertiesEXT[:]),
+	"VkPhysicalDeviceAddressBindingReportFeaturesEXT":               values(C.layout_VkPhysicalDeviceAddressBindingReportFeaturesEXT[:]),
+	"VkDeviceAddressBindingCallbackDataEXT":                         values(C.layout_VkDeviceAddressBindingCallbackDataEXT[:]),
+	"VkPhysicalDeviceDepthClipControlFeaturesEXT":                   values(C.layout_VkPhysicalDeviceDepthClipControlFeaturesEXT[:]),
+	"VkPipelineViewportDepthClipControlCreateInfoEXT":               values(C.layout_VkPipelineViewportDepthClipControlCreateInfoEXT[:]),
+	"VkPhysicalDevicePrimitiveTopologyListRestartFeaturesEXT":       values(C.layout_VkPhysicalDevicePrimitiveTopologyListRestartFeaturesEXT[:]),
+	"VkSubpassShadingPipelineCreateInfoHUAWEI":                      values(C.layout_VkSubpassShadingPipelineCreateInfoHUAWEI[:]),
+	"VkPhysicalDeviceSubpassShadingFeaturesHUAWEI":                  values(C.layout_VkPhysicalDeviceSubpassShadingFeaturesHUAWEI[:]),
+	"VkPhysicalDeviceSubpassShadingPropertiesHUAWEI":                values(C.layout_VkPhysicalDeviceSubpassShadingPropertiesHUAWEI[:]),
+	"VkPhysicalDeviceInvocationMaskFeaturesHUAWEI":                  values(C.layout_VkPhysicalDeviceInvocationMaskFeaturesHUAWEI[:]),
+	"VkMemoryGetRemoteAddressInfoNV":                                values(C.layout_VkMemoryGetRemoteAddressInfoNV[:]),
+	"VkPhysicalDeviceExternalMemoryRDMAFeaturesNV":                  values(C.layout_VkPhysicalDeviceExternalMemoryRDMAFeaturesNV[:]),
+	"VkPipelinePropertiesIdentifierEXT":                             values(C.layout_VkPipelinePropertiesIdentifierEXT[:]),
+	"VkPhysicalDevicePipelinePropertiesFeaturesEXT":                 values(C.layout_VkPhysicalDevicePipelinePropertiesFeaturesEXT[:]),
+	"VkPhysicalDeviceMultisampledRenderToSingleSampledFeaturesEXT":  values(C.layout_VkPhysicalDeviceMultisampledRenderToSingleSampledFeaturesEXT[:]),
+	"VkSubpassResolvePerformanceQueryEXT":                           values(C.layout_VkSubpassResolvePerformanceQueryEXT[:]),
+	"VkMultisampledRenderToSingleSampledInfoEXT":                    values(C.layout_VkMultisampledRenderToSingleSampledInfoEXT[:]),
+	"VkPhysicalDeviceExtendedDynamicState2FeaturesEXT":              values(C.layout_VkPhysicalDeviceExtendedDynamicState2FeaturesEXT[:]),
+	"VkPhysicalDeviceColorWriteEnableFeaturesEXT":                   values(C.layout_VkPhysicalDeviceColorWriteEnableFeaturesEXT[:]),
+	"VkPipelineColorWriteCreateInfoEXT":                             values(C.layout_VkPipelineColorWriteCreateInfoEXT[:]),
+	"VkPhysicalDevicePrimitivesGeneratedQueryFeaturesEXT":           values(C.layout_VkPhysicalDevicePrimitivesGeneratedQueryFeaturesEXT[:]),
+	"VkPhysicalDeviceImageViewMinLodFeaturesEXT":                    values(C.layout_VkPhysicalDeviceImageViewMinLodFeaturesEXT[:]),
+	"VkImageViewMinLodCreateInfoEXT":                                values(C.layout_VkImageViewMinLodCreateInfoEXT[:]),
+	"VkPhysicalDeviceMultiDrawFeaturesEXT":                          values(C.layout_VkPhysicalDeviceMultiDrawFeaturesEXT[:]),
+	"VkPhysicalDeviceMultiDrawPropertiesEXT":                        values(C.layout_VkPhysicalDeviceMultiDrawPropertiesEXT[:]),
+	"VkMultiDrawInfoEXT":                                            values(C.layout_VkMultiDrawInfoEXT[:]),
+	"VkMultiDrawIndexedInfoEXT":                                     values(C.layout_VkMultiDrawIndexedInfoEXT[:]),
+	"VkPhysicalDeviceImage2DViewOf3DFeaturesEXT":                    values(C.layout_VkPhysicalDeviceImage2DViewOf3DFeaturesEXT[:]),
+	"VkMicromapUsageEXT":                                            values(C.layout_VkMicromapUsageEXT[:]),
+	"VkMicromapBuildInfoEXT":                                        values(C.layout_VkMicromapBuildInfoEXT[:]),
+	"VkMicromapCreateInfoEXT":                                       values(C.layout_VkMicromapCreateInfoEXT[:]),
+	"VkPhysicalDeviceOpacityMicromapFeaturesEXT":                    values(C.layout_VkPhysicalDeviceOpacityMicromapFeaturesEXT[:]),
+	"VkPhysicalDeviceOpacityMicromapPropertiesEXT":                  values(C.layout_VkPhysicalDeviceOpacityMicromapPropertiesEXT[:]),
+	"VkMicromapVersionInfoEXT":                                      values(C.layout_VkMicromapVersionInfoEXT[:]),
+	"VkCopyMicromapToMemoryInfoEXT":                                 values(C.layout_VkCopyMicromapToMemoryInfoEXT[:]),
+	"VkCopyMemoryToMicromapInfoEXT":                                 values(C.layout_VkCopyMemoryToMicromapInfoEXT[:]),
+	"VkCopyMicromapInfoEXT":                                         values(C.layout_VkCopyMicromapInfoEXT[:]),
+	"VkMicromapBuildSizesInfoEXT":                                   values(C.layout_VkMicromapBuildSizesInfoEXT[:]),
+	"VkAccelerationStructureTrianglesOpacityMicromapEXT":            values(C.layout_VkAccelerationStructureTrianglesOpacityMicromapEXT[:]),
+	"VkMicromapTriangleEXT":                                         values(C.layout_VkMicromapTriangleEXT[:]),
+	"VkPhysicalDeviceClusterCullingShaderFeaturesHUAWEI":            values(C.layout_VkPhysicalDeviceClusterCullingShaderFeaturesHUAWEI[:]),
+	"VkPhysicalDeviceClusterCullingShaderPropertiesHUAWEI":          values(C.layout_VkPhysicalDeviceClusterCullingShaderPropertiesHUAWEI[:]),
+	"VkPhysicalDeviceBorderColorSwizzleFeaturesEXT":                 values(C.layout_VkPhysicalDeviceBorderColorSwizzleFeaturesEXT[:]),
+	"VkSamplerBorderColorComponentMappingCreateInfoEXT":             values(C.layout_VkSamplerBorderColorComponentMappingCreateInfoEXT[:]),
+	"VkPhysicalDevicePageableDeviceLocalMemoryFeaturesEXT":          values(C.layout_VkPhysicalDevicePageableDeviceLocalMemoryFeaturesEXT[:]),
+	"VkPhysicalDeviceDescriptorSetHostMappingFeaturesVALVE":         values(C.layout_VkPhysicalDeviceDescriptorSetHostMappingFeaturesVALVE[:]),
+	"VkDescriptorSetBindingReferenceVALVE":                          values(C.layout_VkDescriptorSetBindingReferenceVALVE[:]),
+	"VkDescriptorSetLayoutHostMappingInfoVALVE":                     values(C.layout_VkDescriptorSetLayoutHostMappingInfoVALVE[:]),
+	"VkPhysicalDeviceDepthClampZeroOneFeaturesEXT":                  values(C.layout_VkPhysicalDeviceDepthClampZeroOneFeaturesEXT[:]),
+	"VkPhysicalDeviceNonSeamlessCubeMapFeaturesEXT":                 values(C.layout_VkPhysicalDeviceNonSeamlessCubeMapFeaturesEXT[:]),
+	"VkPhysicalDeviceFragmentDensityMapOffsetFeaturesQCOM":          values(C.layout_VkPhysicalDeviceFragmentDensityMapOffsetFeaturesQCOM[:]),
+	"VkPhysicalDeviceFragmentDensityMapOffsetPropertiesQCOM":        values(C.layout_VkPhysicalDeviceFragmentDensityMapOffsetPropertiesQCOM[:]),
+	"VkSubpassFragmentDensityMapOffsetEndInfoQCOM":                  values(C.layout_VkSubpassFragmentDensityMapOffsetEndInfoQCOM[:]),
+	"VkCopyMemoryIndirectCommandNV":                                 values(C.layout_VkCopyMemoryIndirectCommandNV[:]),
+	"VkCopyMemoryToImageIndirectCommandNV":                          values(C.layout_VkCopyMemoryToImageIndirectCommandNV[:]),
+	"VkPhysicalDeviceCopyMemoryIndirectFeaturesNV":                  values(C.layout_VkPhysicalDeviceCopyMemoryIndirectFeaturesNV[:]),
+	"VkPhysicalDeviceCopyMemoryIndirectPropertiesNV":                values(C.layout_VkPhysicalDeviceCopyMemoryIndirectPropertiesNV[:]),
+	"VkDecompressMemoryRegionNV":                                    values(C.layout_VkDecompressMemoryRegionNV[:]),
+	"VkPhysicalDeviceMemoryDecompressionFeaturesNV":                 values(C.layout_VkPhysicalDeviceMemoryDecompressionFeaturesNV[:]),
+	"VkPhysicalDeviceMemoryDecompressionPropertiesNV":               values(C.layout_VkPhysicalDeviceMemoryDecompressionPropertiesNV[:]),
+	"VkPhysicalDeviceLinearColorAttachmentFeaturesNV":               values(C.layout_VkPhysicalDeviceLinearColorAttachmentFeaturesNV[:]),
+	"VkPhysicalDeviceImageCompressionControlSwapchainFeaturesEXT":   values(C.layout_VkPhysicalDeviceImageCompressionControlSwapchainFeaturesEXT[:]),
+	"VkImageViewSampleWeightCreateInfoQCOM":                         values(C.layout_VkImageViewSampleWeightCreateInfoQCOM[:]),
+	"VkPhysicalDeviceImageProcessingFeaturesQCOM":                   values(C.layout_VkPhysicalDeviceImageProcessingFeaturesQCOM[:]),
+	"VkPhysicalDeviceImageProcessingPropertiesQCOM":                 values(C.layout_VkPhysicalDeviceImageProcessingPropertiesQCOM[:]),
+	"VkPhysicalDeviceExtendedDynamicState3FeaturesEXT":              values(C.layout_VkPhysicalDeviceExtendedDynamicState3FeaturesEXT[:]),
+	"VkPhysicalDeviceExtendedDynamicState3PropertiesEXT":            values(C.layout_VkPhysicalDeviceExtendedDynamicState3PropertiesEXT[:]),
+	"VkColorBlendEquationEXT":                                       values(C.layout_VkColorBlendEquationEXT[:]),
+	"VkColorBlendAdvancedEXT":                                       values(C.layout_VkColorBlendAdvancedEXT[:]),
+	"VkPhysicalDeviceSubpassMergeFeedbackFeaturesEXT":               values(C.layout_VkPhysicalDeviceSubpassMergeFeedbackFeaturesEXT[:]),
+	"VkRenderPassCreationControlEXT":                                values(C.layout_VkRenderPassCreationControlEXT[:]),
+	"VkRenderPassCreationFeedbackInfoEXT":                           values(C.layout_VkRenderPassCreationFeedbackInfoEXT[:]),
+	"VkRenderPassCreationFeedbackCreateInfoEXT":                     values(C.layout_VkRenderPassCreationFeedbackCreateInfoEXT[:]),
+	"VkRenderPassSubpassFeedbackInfoEXT":                            values(C.layout_VkRenderPassSubpassFeedbackInfoEXT[:]),
+	"VkRenderPassSubpassFeedbackCreateInfoEXT":                      values(C.layout_VkRenderPassSubpassFeedbackCreateInfoEXT[:]),
+	"VkDirectDriverLoadingInfoLUNARG":                               values(C.layout_VkDirectDriverLoadingInfoLUNARG[:]),
+	"VkDirectDriverLoadingListLUNARG":                               values(C.layout_VkDirectDriverLoadingListLUNARG[:]),
+	"VkPhysicalDeviceShaderModuleIdentifierFeaturesEXT":             values(C.layout_VkPhysicalDeviceShaderModuleIdentifierFeaturesEXT[:]),
+	"VkPhysicalDeviceShaderModuleIdentifierPropertiesEXT":           values(C.layout_VkPhysicalDeviceShaderModuleIdentifierPropertiesEXT[:]),
+	"VkPipelineShaderStageModuleIdentifierCreateInfoEXT":            values(C.layout_VkPipelineShaderStageModuleIdentifierCreateInfoEXT[:]),
+	"VkShaderModuleIdentifierEXT":                                   values(C.layout_VkShaderModuleIdentifierEXT[:]),
+	"VkPhysicalDeviceOpticalFlowFeaturesNV":                         values(C.layout_VkPhysicalDeviceOpticalFlowFeaturesNV[:]),
+	"VkPhysicalDeviceOpticalFlowPropertiesNV":                       values(C.layout_VkPhysicalDeviceOpticalFlowPropertiesNV[:]),
+	"VkOpticalFlowImageFormatInfoNV":                                values(C.layout_VkOpticalFlowImageFormatInfoNV[:]),
+	"VkOpticalFlowImageFormatPropertiesNV":                          values(C.layout_VkOpticalFlowImageFormatPropertiesNV[:]),
+	"VkOpticalFlowSessionCreateInfoNV":                              values(C.layout_VkOpticalFlowSessionCreateInfoNV[:]),
+	"VkOpticalFlowSessionCreatePrivateDataInfoNV":                   values(C.layout_VkOpticalFlowSessionCreatePrivateDataInfoNV[:]),
+	"VkOpticalFlowExecuteInfoNV":                                    values(C.layout_VkOpticalFlowExecuteInfoNV[:]),
+	"VkPhysicalDeviceLegacyDitheringFeaturesEXT":                    values(C.layout_VkPhysicalDeviceLegacyDitheringFeaturesEXT[:]),
+	"VkPhysicalDevicePipelineProtectedAccessFeaturesEXT":            values(C.layout_VkPhysicalDevicePipelineProtectedAccessFeaturesEXT[:]),
+	"VkPhysicalDeviceTilePropertiesFeaturesQCOM":                    values(C.layout_VkPhysicalDeviceTilePropertiesFeaturesQCOM[:]),
+	"VkTilePropertiesQCOM":                                          values(C.layout_VkTilePropertiesQCOM[:]),
+	"VkPhysicalDeviceAmigoProfilingFeaturesSEC":                     values(C.layout_VkPhysicalDeviceAmigoProfilingFeaturesSEC[:]),
+	"VkAmigoProfilingSubmitInfoSEC":                                 values(C.layout_VkAmigoProfilingSubmitInfoSEC[:]),
+	"VkPhysicalDeviceMultiviewPerViewViewportsFeaturesQCOM":         values(C.layout_VkPhysicalDeviceMultiviewPerViewViewportsFeaturesQCOM[:]),
+	"VkPhysicalDeviceRayTracingInvocationReorderPropertiesNV":       values(C.layout_VkPhysicalDeviceRayTracingInvocationReorderPropertiesNV[:]),
+	"VkPhysicalDeviceRayTracingInvocationReorderFeaturesNV":         values(C.layout_VkPhysicalDeviceRayTracingInvocationReorderFeaturesNV[:]),
+	"VkPhysicalDeviceShaderCoreBuiltinsFeaturesARM":                 values(C.layout_VkPhysicalDeviceShaderCoreBuiltinsFeaturesARM[:]),
+	"VkPhysicalDeviceShaderCoreBuiltinsPropertiesARM":               values(C.layout_VkPhysicalDeviceShaderCoreBuiltinsPropertiesARM[:]),
+}
+
+func values(a []C.size_t) []uintptr {
+	s := make([]uintptr, len(a))
+	for i, v := range a {
+		s[i] = uintptr(v)
+	}
+	return s
+}
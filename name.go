@@ -0,0 +1,66 @@
+package vk
+
+// Handle is implemented by the handle types, for the commands taking any
+// object as an ObjectType and a uint64.
+type Handle interface {
+	ObjectType() ObjectType
+	Uint64() uint64
+}
+
+// debugReportTypes has the object types of VK_EXT_debug_marker past the
+// core 1.0 ones, those have the values of ObjectType.
+var debugReportTypes = map[ObjectType]DebugReportObjectTypeEXT{
+	OBJECT_TYPE_SURFACE_KHR:                DEBUG_REPORT_OBJECT_TYPE_SURFACE_KHR_EXT,
+	OBJECT_TYPE_SWAPCHAIN_KHR:              DEBUG_REPORT_OBJECT_TYPE_SWAPCHAIN_KHR_EXT,
+	OBJECT_TYPE_DEBUG_REPORT_CALLBACK_EXT:  DEBUG_REPORT_OBJECT_TYPE_DEBUG_REPORT_CALLBACK_EXT_EXT,
+	OBJECT_TYPE_DISPLAY_KHR:                DEBUG_REPORT_OBJECT_TYPE_DISPLAY_KHR_EXT,
+	OBJECT_TYPE_DISPLAY_MODE_KHR:           DEBUG_REPORT_OBJECT_TYPE_DISPLAY_MODE_KHR_EXT,
+	OBJECT_TYPE_VALIDATION_CACHE_EXT:       DEBUG_REPORT_OBJECT_TYPE_VALIDATION_CACHE_EXT_EXT,
+	OBJECT_TYPE_SAMPLER_YCBCR_CONVERSION:   DEBUG_REPORT_OBJECT_TYPE_SAMPLER_YCBCR_CONVERSION_EXT,
+	OBJECT_TYPE_DESCRIPTOR_UPDATE_TEMPLATE: DEBUG_REPORT_OBJECT_TYPE_DESCRIPTOR_UPDATE_TEMPLATE_EXT,
+	OBJECT_TYPE_ACCELERATION_STRUCTURE_KHR: DEBUG_REPORT_OBJECT_TYPE_ACCELERATION_STRUCTURE_KHR_EXT,
+	OBJECT_TYPE_ACCELERATION_STRUCTURE_NV:  DEBUG_REPORT_OBJECT_TYPE_ACCELERATION_STRUCTURE_NV_EXT,
+}
+
+// SetName names the object h of device for the debuggers and the validation
+// layers, with VK_EXT_debug_utils or else VK_EXT_debug_marker. It returns
+// ERROR_EXTENSION_NOT_PRESENT when device has neither. The commands are
+// looked up on every call, SetNameWith takes them resolved once.
+func SetName(device Device, h Handle, name string) Result {
+	utils := PfnSetDebugUtilsObjectNameEXT(GetDeviceProcAddr(device, "vkSetDebugUtilsObjectNameEXT"))
+	marker := PfnDebugMarkerSetObjectNameEXT(GetDeviceProcAddr(device, "vkDebugMarkerSetObjectNameEXT"))
+	return SetNameWith(device, h, name, utils, marker)
+}
+
+// SetNameWith is SetName with the commands of device, e.g. from a dispatch
+// table, zero for those it does not have.
+func SetNameWith(device Device, h Handle, name string, utils PfnSetDebugUtilsObjectNameEXT, marker PfnDebugMarkerSetObjectNameEXT) Result {
+	pName, free := CStr(name)
+	defer free()
+	if utils != 0 {
+		info := DebugUtilsObjectNameInfoEXT{
+			SType:        STRUCTURE_TYPE_DEBUG_UTILS_OBJECT_NAME_INFO_EXT,
+			ObjectType:   h.ObjectType(),
+			ObjectHandle: h.Uint64(),
+			PObjectName:  pName,
+		}
+		return utils.Call(device, &info)
+	}
+	if marker != 0 {
+		typ, ok := debugReportTypes[h.ObjectType()]
+		if !ok && h.ObjectType() <= OBJECT_TYPE_COMMAND_POOL {
+			typ, ok = DebugReportObjectTypeEXT(h.ObjectType()), true
+		}
+		if !ok {
+			return ERROR_FEATURE_NOT_PRESENT // not an object of the extension
+		}
+		info := DebugMarkerObjectNameInfoEXT{
+			SType:       STRUCTURE_TYPE_DEBUG_MARKER_OBJECT_NAME_INFO_EXT,
+			ObjectType:  typ,
+			Object:      h.Uint64(),
+			PObjectName: pName,
+		}
+		return marker.Call(device, &info)
+	}
+	return ERROR_EXTENSION_NOT_PRESENT
+}
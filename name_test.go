@@ -0,0 +1,41 @@
+// +build cgo
+
+package vk
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/toy80/vk/internal/abi"
+)
+
+func TestSetName(t *testing.T) {
+	const device = Device(0x1234)
+	utils := PfnSetDebugUtilsObjectNameEXT(abi.SetDebugUtilsObjectNameEXT)
+	marker := PfnDebugMarkerSetObjectNameEXT(abi.DebugMarkerSetObjectNameEXT)
+	tests := []struct {
+		h      Handle
+		utils  PfnSetDebugUtilsObjectNameEXT
+		marker PfnDebugMarkerSetObjectNameEXT
+		ret    Result
+		want   []uint64
+	}{
+		{Buffer(0x8877665544332211), utils, marker, SUCCESS, []uint64{uint64(device), uint64(OBJECT_TYPE_BUFFER), 0x8877665544332211}},
+		{Buffer(0x10), 0, marker, SUCCESS, []uint64{uint64(device), uint64(DEBUG_REPORT_OBJECT_TYPE_BUFFER_EXT), 0x10}},
+		{SwapchainKHR(0x20), 0, marker, SUCCESS, []uint64{uint64(device), uint64(DEBUG_REPORT_OBJECT_TYPE_SWAPCHAIN_KHR_EXT), 0x20}},
+		{Queue(0x30), utils, 0, SUCCESS, []uint64{uint64(device), uint64(OBJECT_TYPE_QUEUE), 0x30}},
+		{PrivateDataSlot(0x40), 0, marker, ERROR_FEATURE_NOT_PRESENT, nil},
+		{Image(0x50), 0, 0, ERROR_EXTENSION_NOT_PRESENT, nil},
+	}
+	for _, tt := range tests {
+		if tt.want == nil {
+			tt.want = abi.Args() // untouched
+		}
+		if ret := SetNameWith(device, tt.h, "name", tt.utils, tt.marker); ret != tt.ret {
+			t.Errorf("%T: returned %v, want %v", tt.h, ret, tt.ret)
+		}
+		if got := abi.Args(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%T: C got %#x, want %#x", tt.h, got, tt.want)
+		}
+	}
+}
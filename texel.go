@@ -0,0 +1,569 @@
+package vk
+
+import (
+	"encoding/binary"
+	"image/color"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// The texel codec converts between the raw bytes of an uncompressed format and
+// RGBA values. The memory layout is derived from the format name, following the
+// rules of the "Identification of Formats" section in the specification:
+//
+//  - non-packed formats store each component in its own bytes, in name order;
+//  - _PACKn formats store all components in one n-bit word, the first named
+//    component in the most significant bits;
+//  - _mPACK16 formats store m 16-bit words with one component each.
+//
+// Multi-byte words are little-endian, which is what every Vulkan host uses.
+//
+// Decoded values are what a shader would see: normalized formats map to [0, 1]
+// or [-1, 1], sRGB formats are linearized, integer and scaled formats keep
+// their integral values. Missing color components decode as 0, missing alpha as
+// 1. Depth goes into component 0 and stencil into component 1.
+
+type numericFormat uint8
+
+const (
+	numericUNORM numericFormat = iota
+	numericSNORM
+	numericUSCALED
+	numericSSCALED
+	numericUINT
+	numericSINT
+	numericUFLOAT
+	numericSFLOAT
+	numericSRGB
+)
+
+var numericFormatNames = map[string]numericFormat{
+	"UNORM":   numericUNORM,
+	"SNORM":   numericSNORM,
+	"USCALED": numericUSCALED,
+	"SSCALED": numericSSCALED,
+	"UINT":    numericUINT,
+	"SINT":    numericSINT,
+	"UFLOAT":  numericUFLOAT,
+	"SFLOAT":  numericSFLOAT,
+	"SRGB":    numericSRGB,
+}
+
+type texelChannel struct {
+	index  int // 0..3 for R, G, B, A; depth is 0 and stencil is 1
+	kind   numericFormat
+	offset int // byte offset of the word holding the channel
+	word   int // size of that word in bytes
+	shift  uint
+	bits   uint
+}
+
+type texelLayout struct {
+	size     int
+	channels []texelChannel
+	shared   bool // E5B9G9R9_UFLOAT_PACK32
+}
+
+var texelLayouts sync.Map // Format -> *texelLayout, nil if unsupported
+
+func texelLayoutOf(format Format) *texelLayout {
+	if v, ok := texelLayouts.Load(format); ok {
+		return v.(*texelLayout)
+	}
+	l := parseTexelLayout(format)
+	texelLayouts.Store(format, l)
+	return l
+}
+
+type texelComponent struct {
+	name byte
+	bits uint
+	kind numericFormat
+}
+
+func parseTexelLayout(format Format) *texelLayout {
+	name := format.String()
+	if !strings.HasPrefix(name, "FORMAT_") || strings.Contains(name, "BLOCK") ||
+		strings.Contains(name, "PLANE") || strings.Contains(name, "_422_") {
+		return nil
+	}
+	parts := strings.Split(strings.TrimPrefix(name, "FORMAT_"), "_")
+
+	var comps []texelComponent
+	pack, words := 0, 1
+	pending := 0 // components without a numeric format yet
+	for _, part := range parts {
+		if kind, ok := numericFormatNames[part]; ok {
+			for i := len(comps) - pending; i < len(comps); i++ {
+				comps[i].kind = kind
+				if kind == numericSRGB && comps[i].name == 'A' {
+					comps[i].kind = numericUNORM // alpha is never sRGB encoded
+				}
+			}
+			pending = 0
+			continue
+		}
+		if i := strings.Index(part, "PACK"); i >= 0 {
+			if i > 0 {
+				words, _ = strconv.Atoi(part[:i])
+			}
+			pack, _ = strconv.Atoi(part[i+4:])
+			continue
+		}
+		if part == "EXT" || part == "KHR" || part == "IMG" {
+			continue
+		}
+		for len(part) > 0 {
+			c := part[0]
+			if !strings.ContainsRune("RGBADSXE", rune(c)) {
+				return nil
+			}
+			j := 1
+			for j < len(part) && part[j] >= '0' && part[j] <= '9' {
+				j++
+			}
+			bits, err := strconv.Atoi(part[1:j])
+			if err != nil || bits == 0 {
+				return nil
+			}
+			comps = append(comps, texelComponent{name: c, bits: uint(bits)})
+			pending++
+			part = part[j:]
+		}
+	}
+	if len(comps) == 0 || pending != 0 {
+		return nil
+	}
+
+	l := &texelLayout{shared: comps[0].name == 'E'}
+	switch {
+	case format == FORMAT_D24_UNORM_S8_UINT:
+		// depth in the low 24 bits, stencil in the high 8 bits
+		l.size = 4
+		l.channels = []texelChannel{
+			{index: 0, kind: numericUNORM, word: 4, shift: 0, bits: 24},
+			{index: 1, kind: numericUINT, word: 4, shift: 24, bits: 8},
+		}
+	case pack != 0:
+		wordBytes := pack / 8
+		l.size = wordBytes * words
+		perWord := len(comps) / words
+		for w := 0; w < words; w++ {
+			shift := uint(pack)
+			for _, c := range comps[w*perWord : (w+1)*perWord] {
+				shift -= c.bits
+				if i := texelChannelIndex(c.name); i >= 0 {
+					l.channels = append(l.channels, texelChannel{
+						index: i, kind: c.kind, offset: w * wordBytes, word: wordBytes, shift: shift, bits: c.bits,
+					})
+				}
+			}
+		}
+	default:
+		for _, c := range comps {
+			if c.bits%8 != 0 {
+				return nil
+			}
+			if i := texelChannelIndex(c.name); i >= 0 {
+				l.channels = append(l.channels, texelChannel{
+					index: i, kind: c.kind, offset: l.size, word: int(c.bits / 8), bits: c.bits,
+				})
+			}
+			l.size += int(c.bits / 8)
+		}
+	}
+	return l
+}
+
+func texelChannelIndex(name byte) int {
+	switch name {
+	case 'R', 'D':
+		return 0
+	case 'G', 'S':
+		return 1
+	case 'B':
+		return 2
+	case 'A':
+		return 3
+	}
+	return -1 // X padding, E shared exponent
+}
+
+// TexelSize returns the size in bytes of one texel of an uncompressed format, or
+// 0 if the texel codec does not support the format.
+func (f Format) TexelSize() int {
+	if l := texelLayoutOf(f); l != nil {
+		return l.size
+	}
+	return 0
+}
+
+// EncodeTexel packs v into the first f.TexelSize() bytes of dst.
+func EncodeTexel(format Format, dst []byte, v [4]float32) error {
+	l := texelLayoutOf(format)
+	if l == nil {
+		return ERROR_FORMAT_NOT_SUPPORTED.Err()
+	}
+	if len(dst) < l.size {
+		return io.ErrShortBuffer
+	}
+	l.encode(dst, v, false)
+	return nil
+}
+
+// DecodeTexel unpacks the first f.TexelSize() bytes of src.
+func DecodeTexel(format Format, src []byte) (v [4]float32, err error) {
+	l := texelLayoutOf(format)
+	if l == nil {
+		return v, ERROR_FORMAT_NOT_SUPPORTED.Err()
+	}
+	if len(src) < l.size {
+		return v, io.ErrShortBuffer
+	}
+	return l.decode(src, false), nil
+}
+
+// EncodeTexelColor packs c into dst. The components of c are taken to be
+// already in the transfer function of the format, so an sRGB image.Image is
+// stored byte for byte into an _SRGB format.
+func EncodeTexelColor(format Format, dst []byte, c color.Color) error {
+	l := texelLayoutOf(format)
+	if l == nil {
+		return ERROR_FORMAT_NOT_SUPPORTED.Err()
+	}
+	if len(dst) < l.size {
+		return io.ErrShortBuffer
+	}
+	n := color.NRGBA64Model.Convert(c).(color.NRGBA64)
+	l.encode(dst, [4]float32{
+		float32(n.R) / 0xFFFF,
+		float32(n.G) / 0xFFFF,
+		float32(n.B) / 0xFFFF,
+		float32(n.A) / 0xFFFF,
+	}, true)
+	return nil
+}
+
+// DecodeTexelColor unpacks src into a color.NRGBA64, clamping each component to
+// [0, 1]. See EncodeTexelColor for the handling of sRGB formats.
+func DecodeTexelColor(format Format, src []byte) (color.Color, error) {
+	l := texelLayoutOf(format)
+	if l == nil {
+		return nil, ERROR_FORMAT_NOT_SUPPORTED.Err()
+	}
+	if len(src) < l.size {
+		return nil, io.ErrShortBuffer
+	}
+	v := l.decode(src, true)
+	return color.NRGBA64{
+		R: unitToUint16(v[0]),
+		G: unitToUint16(v[1]),
+		B: unitToUint16(v[2]),
+		A: unitToUint16(v[3]),
+	}, nil
+}
+
+// EncodeTexelRow packs len(src) texels into dst.
+func EncodeTexelRow(format Format, dst []byte, src [][4]float32) error {
+	l := texelLayoutOf(format)
+	if l == nil {
+		return ERROR_FORMAT_NOT_SUPPORTED.Err()
+	}
+	if len(dst) < l.size*len(src) {
+		return io.ErrShortBuffer
+	}
+	for i, v := range src {
+		l.encode(dst[i*l.size:], v, false)
+	}
+	return nil
+}
+
+// DecodeTexelRow unpacks len(dst) texels from src.
+func DecodeTexelRow(format Format, dst [][4]float32, src []byte) error {
+	l := texelLayoutOf(format)
+	if l == nil {
+		return ERROR_FORMAT_NOT_SUPPORTED.Err()
+	}
+	if len(src) < l.size*len(dst) {
+		return io.ErrShortBuffer
+	}
+	for i := range dst {
+		dst[i] = l.decode(src[i*l.size:], false)
+	}
+	return nil
+}
+
+func (l *texelLayout) encode(dst []byte, v [4]float32, raw bool) {
+	for i := 0; i < l.size; i++ {
+		dst[i] = 0
+	}
+	if l.shared {
+		putTexelWord(dst, 4, encodeE5B9G9R9(v))
+		return
+	}
+	for _, c := range l.channels {
+		w := texelWord(dst[c.offset:], c.word)
+		w |= encodeTexelChannel(v[c.index], c.kind, c.bits, raw) << c.shift
+		putTexelWord(dst[c.offset:], c.word, w)
+	}
+}
+
+func (l *texelLayout) decode(src []byte, raw bool) [4]float32 {
+	if l.shared {
+		return decodeE5B9G9R9(uint32(texelWord(src, 4)))
+	}
+	v := [4]float32{0, 0, 0, 1}
+	for _, c := range l.channels {
+		w := texelWord(src[c.offset:], c.word) >> c.shift
+		if c.bits < 64 {
+			w &= 1<<c.bits - 1
+		}
+		v[c.index] = decodeTexelChannel(w, c.kind, c.bits, raw)
+	}
+	return v
+}
+
+func texelWord(b []byte, size int) uint64 {
+	switch size {
+	case 1:
+		return uint64(b[0])
+	case 2:
+		return uint64(binary.LittleEndian.Uint16(b))
+	case 4:
+		return uint64(binary.LittleEndian.Uint32(b))
+	default:
+		return binary.LittleEndian.Uint64(b)
+	}
+}
+
+func putTexelWord(b []byte, size int, w uint64) {
+	switch size {
+	case 1:
+		b[0] = byte(w)
+	case 2:
+		binary.LittleEndian.PutUint16(b, uint16(w))
+	case 4:
+		binary.LittleEndian.PutUint32(b, uint32(w))
+	default:
+		binary.LittleEndian.PutUint64(b, w)
+	}
+}
+
+func encodeTexelChannel(x float32, kind numericFormat, bits uint, raw bool) uint64 {
+	f := float64(x)
+	mask := uint64(1)<<bits - 1
+	if bits == 64 {
+		mask = math.MaxUint64
+	}
+	switch kind {
+	case numericSRGB:
+		if !raw {
+			f = linearToSRGB(f)
+		}
+		fallthrough
+	case numericUNORM:
+		f = math.Max(0, math.Min(1, f))
+		return uint64(math.Floor(f*float64(mask) + 0.5))
+	case numericSNORM:
+		max := float64(uint64(1)<<(bits-1) - 1)
+		f = math.Max(-1, math.Min(1, f))
+		return uint64(int64(math.Floor(f*max+0.5))) & mask
+	case numericUSCALED, numericUINT:
+		if f <= 0 || math.IsNaN(f) {
+			return 0
+		}
+		if f >= float64(mask) {
+			return mask
+		}
+		return uint64(math.Floor(f + 0.5))
+	case numericSSCALED, numericSINT:
+		min, max := -math.Ldexp(1, int(bits-1)), math.Ldexp(1, int(bits-1))-1
+		if math.IsNaN(f) {
+			return 0
+		}
+		if f <= min {
+			return uint64(1) << (bits - 1)
+		}
+		if f >= max {
+			return mask >> 1
+		}
+		return uint64(int64(math.Floor(f+0.5))) & mask
+	case numericSFLOAT:
+		switch bits {
+		case 32:
+			return uint64(math.Float32bits(x))
+		case 64:
+			return math.Float64bits(f)
+		}
+		return floatToMinifloat(f, 5, bits-6, true)
+	case numericUFLOAT:
+		return floatToMinifloat(f, 5, bits-5, false)
+	}
+	return 0
+}
+
+func decodeTexelChannel(w uint64, kind numericFormat, bits uint, raw bool) float32 {
+	mask := uint64(1)<<bits - 1
+	if bits == 64 {
+		mask = math.MaxUint64
+	}
+	switch kind {
+	case numericUNORM:
+		return float32(float64(w) / float64(mask))
+	case numericSRGB:
+		f := float64(w) / float64(mask)
+		if !raw {
+			f = srgbToLinear(f)
+		}
+		return float32(f)
+	case numericSNORM:
+		max := float64(uint64(1)<<(bits-1) - 1)
+		return float32(math.Max(-1, float64(signExtend(w, bits))/max))
+	case numericUSCALED, numericUINT:
+		return float32(w)
+	case numericSSCALED, numericSINT:
+		return float32(signExtend(w, bits))
+	case numericSFLOAT:
+		switch bits {
+		case 32:
+			return math.Float32frombits(uint32(w))
+		case 64:
+			return float32(math.Float64frombits(w))
+		}
+		return float32(minifloatToFloat(w, 5, bits-6, true))
+	case numericUFLOAT:
+		return float32(minifloatToFloat(w, 5, bits-5, false))
+	}
+	return 0
+}
+
+func signExtend(w uint64, bits uint) int64 {
+	return int64(w<<(64-bits)) >> (64 - bits)
+}
+
+func unitToUint16(x float32) uint16 {
+	f := math.Max(0, math.Min(1, float64(x)))
+	return uint16(math.Floor(f*0xFFFF + 0.5))
+}
+
+func srgbToLinear(f float64) float64 {
+	if f <= 0.04045 {
+		return f / 12.92
+	}
+	return math.Pow((f+0.055)/1.055, 2.4)
+}
+
+func linearToSRGB(f float64) float64 {
+	if f <= 0.0031308 {
+		return f * 12.92
+	}
+	return 1.055*math.Pow(f, 1/2.4) - 0.055
+}
+
+// floatToMinifloat converts f to a small IEEE-like float with the given number
+// of exponent and mantissa bits, rounding to nearest. Unsigned formats clamp
+// negative values to zero.
+func floatToMinifloat(f float64, expBits, mantBits uint, signed bool) uint64 {
+	var sign uint64
+	expMax := uint64(1)<<expBits - 1
+	if math.IsNaN(f) {
+		return expMax<<mantBits | 1<<(mantBits-1)
+	}
+	if f < 0 || (f == 0 && math.Signbit(f)) {
+		if !signed {
+			return 0
+		}
+		sign = 1 << (expBits + mantBits)
+		f = -f
+	}
+	if math.IsInf(f, 0) {
+		return sign | expMax<<mantBits
+	}
+	if f == 0 {
+		return sign
+	}
+	bias := int(expMax >> 1)
+	frac, exp := math.Frexp(f) // f = frac * 2^exp, frac in [0.5, 1)
+	e := exp - 1 + bias
+	if e <= 0 {
+		// denormal
+		m := uint64(math.Floor(math.Ldexp(f, bias-1+int(mantBits)) + 0.5))
+		return sign | m // m == 1<<mantBits rolls over into the smallest normal
+	}
+	m := uint64(math.Floor(math.Ldexp(frac*2-1, int(mantBits)) + 0.5))
+	if m == 1<<mantBits {
+		m = 0
+		e++
+	}
+	if uint64(e) >= expMax {
+		return sign | expMax<<mantBits
+	}
+	return sign | uint64(e)<<mantBits | m
+}
+
+func minifloatToFloat(w uint64, expBits, mantBits uint, signed bool) float64 {
+	expMax := uint64(1)<<expBits - 1
+	bias := int(expMax >> 1)
+	s := 1.0
+	if signed && w>>(expBits+mantBits)&1 != 0 {
+		s = -1
+	}
+	e := w >> mantBits & expMax
+	m := w & (1<<mantBits - 1)
+	switch e {
+	case 0:
+		return s * math.Ldexp(float64(m), 1-bias-int(mantBits))
+	case expMax:
+		if m != 0 {
+			return math.NaN()
+		}
+		return math.Inf(int(s))
+	}
+	return s * math.Ldexp(float64(m|1<<mantBits), int(e)-bias-int(mantBits))
+}
+
+// encodeE5B9G9R9 follows the "Shared Exponent" conversion in the specification.
+func encodeE5B9G9R9(v [4]float32) uint64 {
+	const n, b, emax = 9, 15, 31
+	sharedMax := float64(1<<n-1) / (1 << n) * math.Ldexp(1, emax-b)
+	var c [3]float64
+	maxc := 0.0
+	for i := range c {
+		f := float64(v[i])
+		if math.IsNaN(f) || f < 0 {
+			f = 0
+		}
+		c[i] = math.Min(f, sharedMax)
+		maxc = math.Max(maxc, c[i])
+	}
+	expP := -b - 1
+	if maxc > 0 {
+		expP = int(math.Max(-b-1, math.Floor(math.Log2(maxc))))
+	}
+	expP += 1 + b
+	maxS := math.Floor(maxc/math.Ldexp(1, expP-b-n) + 0.5)
+	expS := expP
+	if maxS >= 1<<n {
+		expS++
+	}
+	w := uint64(expS) << 27
+	for i := range c {
+		m := uint64(math.Floor(c[i]/math.Ldexp(1, expS-b-n) + 0.5))
+		w |= m << (9 * uint(i))
+	}
+	return w
+}
+
+func decodeE5B9G9R9(w uint32) [4]float32 {
+	const n, b = 9, 15
+	e := int(w>>27) - b - n
+	return [4]float32{
+		float32(math.Ldexp(float64(w&0x1FF), e)),
+		float32(math.Ldexp(float64(w>>9&0x1FF), e)),
+		float32(math.Ldexp(float64(w>>18&0x1FF), e)),
+		1,
+	}
+}
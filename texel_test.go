@@ -0,0 +1,182 @@
+package vk
+
+import (
+	"encoding/binary"
+	"image/color"
+	"math"
+	"testing"
+)
+
+func TestTexelSize(t *testing.T) {
+	tests := []struct {
+		format Format
+		want   int
+	}{
+		{FORMAT_R4G4_UNORM_PACK8, 1},
+		{FORMAT_R8G8B8_SRGB, 3},
+		{FORMAT_B8G8R8A8_UNORM, 4},
+		{FORMAT_A2B10G10R10_UNORM_PACK32, 4},
+		{FORMAT_R16G16B16A16_SFLOAT, 8},
+		{FORMAT_R64G64B64A64_SFLOAT, 32},
+		{FORMAT_D16_UNORM_S8_UINT, 3},
+		{FORMAT_D32_SFLOAT_S8_UINT, 5},
+		{FORMAT_R10X6G10X6B10X6A10X6_UNORM_4PACK16, 8},
+		{FORMAT_A4R4G4B4_UNORM_PACK16_EXT, 2},
+		{FORMAT_BC1_RGB_UNORM_BLOCK, 0},
+		{FORMAT_G8_B8_R8_3PLANE_420_UNORM, 0},
+		{FORMAT_G8B8G8R8_422_UNORM, 0},
+		{FORMAT_UNDEFINED, 0},
+	}
+	for _, tt := range tests {
+		if got := tt.format.TexelSize(); got != tt.want {
+			t.Errorf("%v.TexelSize() = %d, want %d", tt.format, got, tt.want)
+		}
+	}
+}
+
+func TestEncodeTexel(t *testing.T) {
+	tests := []struct {
+		format Format
+		v      [4]float32
+		want   []byte
+	}{
+		{FORMAT_R8G8B8A8_UNORM, [4]float32{1, 0, 0.5, 1}, []byte{0xFF, 0x00, 0x80, 0xFF}},
+		{FORMAT_B8G8R8A8_UNORM, [4]float32{1, 0, 0.5, 1}, []byte{0x80, 0x00, 0xFF, 0xFF}},
+		{FORMAT_R8G8B8A8_SRGB, [4]float32{0.5, 0, 1, 0.5}, []byte{0xBC, 0x00, 0xFF, 0x80}},
+		{FORMAT_R8_SNORM, [4]float32{-1}, []byte{0x81}},
+		{FORMAT_R16_SINT, [4]float32{-2}, []byte{0xFE, 0xFF}},
+		{FORMAT_R16_SFLOAT, [4]float32{1}, []byte{0x00, 0x3C}},
+		{FORMAT_R16_SFLOAT, [4]float32{-2}, []byte{0x00, 0xC0}},
+		{FORMAT_R5G6B5_UNORM_PACK16, [4]float32{1, 0, 0}, []byte{0x00, 0xF8}},
+		{FORMAT_A1R5G5B5_UNORM_PACK16, [4]float32{0, 0, 1, 1}, []byte{0x1F, 0x80}},
+		{FORMAT_A2B10G10R10_UNORM_PACK32, [4]float32{1, 0, 0, 1}, le32(0xC00003FF)},
+		{FORMAT_B10G11R11_UFLOAT_PACK32, [4]float32{1, 1, 1}, le32(0x3C0 | 0x3C0<<11 | 0x1E0<<22)},
+		{FORMAT_E5B9G9R9_UFLOAT_PACK32, [4]float32{1, 1, 1}, le32(256 | 256<<9 | 256<<18 | 16<<27)},
+		{FORMAT_R10X6_UNORM_PACK16, [4]float32{1}, []byte{0xC0, 0xFF}},
+		{FORMAT_X8_D24_UNORM_PACK32, [4]float32{1}, le32(0x00FFFFFF)},
+		{FORMAT_D24_UNORM_S8_UINT, [4]float32{1, 3}, le32(0x03FFFFFF)},
+		{FORMAT_D32_SFLOAT_S8_UINT, [4]float32{1, 7}, []byte{0x00, 0x00, 0x80, 0x3F, 0x07}},
+		{FORMAT_S8_UINT, [4]float32{0, 300}, []byte{0xFF}},
+	}
+	for _, tt := range tests {
+		got := make([]byte, tt.format.TexelSize())
+		if err := EncodeTexel(tt.format, got, tt.v); err != nil {
+			t.Errorf("EncodeTexel(%v) error: %v", tt.format, err)
+			continue
+		}
+		if string(got) != string(tt.want) {
+			t.Errorf("EncodeTexel(%v, %v) = % X, want % X", tt.format, tt.v, got, tt.want)
+		}
+	}
+}
+
+func TestDecodeTexel(t *testing.T) {
+	tests := []struct {
+		format Format
+		src    []byte
+		want   [4]float32
+	}{
+		{FORMAT_R8_UNORM, []byte{0xFF}, [4]float32{1, 0, 0, 1}},
+		{FORMAT_R8G8_UINT, []byte{7, 9}, [4]float32{7, 9, 0, 1}},
+		{FORMAT_R8_SNORM, []byte{0x80}, [4]float32{-1, 0, 0, 1}},
+		{FORMAT_R32_SINT, le32(0xFFFFFFFF), [4]float32{-1, 0, 0, 1}},
+		{FORMAT_R16G16_SFLOAT, []byte{0x00, 0x38, 0x00, 0x7C}, [4]float32{0.5, float32(math.Inf(1)), 0, 1}},
+		{FORMAT_A2R10G10B10_UINT_PACK32, le32(3<<30 | 5<<20 | 6<<10 | 7), [4]float32{5, 6, 7, 3}},
+		{FORMAT_B4G4R4A4_UNORM_PACK16, []byte{0x0F, 0x0F}, [4]float32{0, 1, 0, 1}},
+		{FORMAT_E5B9G9R9_UFLOAT_PACK32, le32(256 | 128<<9 | 16<<27), [4]float32{1, 0.5, 0, 1}},
+		{FORMAT_D16_UNORM_S8_UINT, []byte{0xFF, 0xFF, 0x2A}, [4]float32{1, 42, 0, 1}},
+	}
+	for _, tt := range tests {
+		got, err := DecodeTexel(tt.format, tt.src)
+		if err != nil {
+			t.Errorf("DecodeTexel(%v) error: %v", tt.format, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("DecodeTexel(%v, % X) = %v, want %v", tt.format, tt.src, got, tt.want)
+		}
+	}
+}
+
+func TestTexelRoundTrip(t *testing.T) {
+	v := [4]float32{0.25, 0.5, 0.75, 1}
+	var formats []Format
+	for f := FORMAT_R4G4_UNORM_PACK8; f <= FORMAT_D32_SFLOAT_S8_UINT; f++ {
+		formats = append(formats, f)
+	}
+	formats = append(formats,
+		FORMAT_R10X6_UNORM_PACK16, FORMAT_R10X6G10X6_UNORM_2PACK16, FORMAT_R10X6G10X6B10X6A10X6_UNORM_4PACK16,
+		FORMAT_R12X4_UNORM_PACK16, FORMAT_R12X4G12X4_UNORM_2PACK16, FORMAT_R12X4G12X4B12X4A12X4_UNORM_4PACK16,
+		FORMAT_A4R4G4B4_UNORM_PACK16_EXT, FORMAT_A4B4G4R4_UNORM_PACK16_EXT)
+
+	for _, f := range formats {
+		if f.TexelSize() == 0 {
+			t.Errorf("%v is not supported", f)
+			continue
+		}
+		buf := make([]byte, f.TexelSize())
+		if err := EncodeTexel(f, buf, v); err != nil {
+			t.Errorf("EncodeTexel(%v) error: %v", f, err)
+			continue
+		}
+		got, err := DecodeTexel(f, buf)
+		if err != nil {
+			t.Errorf("DecodeTexel(%v) error: %v", f, err)
+			continue
+		}
+		// integer formats round 0.25..0.75 to 0 or 1, 4-bit formats
+		// quantize to 1/15, every stored component must still be close
+		for _, c := range texelLayoutOf(f).channels {
+			if d := math.Abs(float64(got[c.index] - v[c.index])); d > 0.51 {
+				t.Errorf("%v: component %d = %v, want ~%v", f, c.index, got[c.index], v[c.index])
+			}
+		}
+	}
+}
+
+func TestTexelColor(t *testing.T) {
+	c := color.NRGBA{R: 0x12, G: 0x34, B: 0x56, A: 0x78}
+	buf := make([]byte, 4)
+	if err := EncodeTexelColor(FORMAT_B8G8R8A8_SRGB, buf, c); err != nil {
+		t.Fatal(err)
+	}
+	if want := []byte{0x56, 0x34, 0x12, 0x78}; string(buf) != string(want) {
+		t.Fatalf("EncodeTexelColor() = % X, want % X", buf, want)
+	}
+	got, err := DecodeTexelColor(FORMAT_B8G8R8A8_SRGB, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := color.NRGBAModel.Convert(got).(color.NRGBA); n != c {
+		t.Fatalf("DecodeTexelColor() = %v, want %v", n, c)
+	}
+}
+
+func TestTexelRow(t *testing.T) {
+	src := [][4]float32{{1, 0, 0, 1}, {0, 1, 0, 1}, {0, 0, 1, 1}}
+	buf := make([]byte, 3*FORMAT_R16G16B16A16_SFLOAT.TexelSize())
+	if err := EncodeTexelRow(FORMAT_R16G16B16A16_SFLOAT, buf, src); err != nil {
+		t.Fatal(err)
+	}
+	dst := make([][4]float32, len(src))
+	if err := DecodeTexelRow(FORMAT_R16G16B16A16_SFLOAT, dst, buf); err != nil {
+		t.Fatal(err)
+	}
+	for i := range src {
+		if dst[i] != src[i] {
+			t.Errorf("texel %d = %v, want %v", i, dst[i], src[i])
+		}
+	}
+	if err := EncodeTexelRow(FORMAT_R16G16B16A16_SFLOAT, buf[:10], src); err == nil {
+		t.Error("EncodeTexelRow() with a short buffer should fail")
+	}
+	if err := DecodeTexelRow(FORMAT_BC7_UNORM_BLOCK, dst, buf); AsResult(err) != ERROR_FORMAT_NOT_SUPPORTED {
+		t.Errorf("DecodeTexelRow() on a block format = %v, want %v", err, ERROR_FORMAT_NOT_SUPPORTED)
+	}
+}
+
+func le32(x uint32) []byte {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, x)
+	return b
+}
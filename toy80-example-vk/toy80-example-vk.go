@@ -9,8 +9,6 @@ import (
 
 type Example struct {
 	instance vk.Instance
-
-	enumerateInstanceExtensionProperties vk.PfnEnumerateInstanceExtensionProperties
 }
 
 func (ex *Example) LoadProc(ppfn interface{}) error {
@@ -18,6 +16,14 @@ func (ex *Example) LoadProc(ppfn interface{}) error {
 }
 
 func (ex *Example) Init() {
+	props, err := vk.EnumerateInstanceExtensionProperties("")
+	if err != nil {
+		log.Fatalln("vk.EnumerateInstanceExtensionProperties():", err)
+	}
+	for _, prop := range props {
+		fmt.Println(vk.GoStr(&prop.ExtensionName), ":", prop.SpecVersion)
+	}
+
 	// copy strings into C memory for obey "the rules"
 	appName, freeAppName := vk.CStr("Hello World!")
 	defer freeAppName()
@@ -39,18 +45,6 @@ func (ex *Example) Init() {
 	if ret := vk.CreateInstance(&createInfo, nil, &ex.instance); ret != vk.SUCCESS {
 		log.Fatalln("vk.CreateInstance():", ret)
 	}
-	if err := ex.LoadProc(&ex.enumerateInstanceExtensionProperties); err != nil {
-		log.Fatalln("Example.LoadProc():", err)
-	}
-	var numExts uint32
-	ex.enumerateInstanceExtensionProperties.Call(nil, &numExts, nil)
-	props := make([]vk.ExtensionProperties, numExts)
-	if numExts > 0 {
-		ex.enumerateInstanceExtensionProperties.Call(nil, &numExts, &props[0])
-	}
-	for _, prop := range props {
-		fmt.Println(vk.GoStr(&prop.ExtensionName), ":", prop.SpecVersion)
-	}
 }
 
 func main() {
@@ -0,0 +1,227 @@
+package vk
+
+import (
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"unsafe"
+)
+
+// The object tracking records the objects created through the Pfn types,
+// with the handle they were created from, the Go call stack of the creation
+// and the name given by vkSetDebugUtilsObjectNameEXT or
+// vkDebugMarkerSetObjectNameEXT. The objects left alive when their device or
+// instance is destroyed are passed to ObjectLeakHandler. It finds the leaks
+// where the validation layers are not available.
+//
+// The command buffers and the descriptor sets are freed with their pool and
+// are not tracked.
+//
+// The non-dispatchable handles are only unique to the objects of a type
+// created from a device or an instance at a time, if at all: two samplers
+// of a device may share one. The objects are kept by their parent, type and
+// handle, and the last of a handle is destroyed first.
+
+var (
+	trackObjects bool
+	trackMutex   sync.Mutex
+	tracked      = make(map[trackKey][]*trackedObject)
+)
+
+type trackKey struct {
+	parent uint64 // 0 for the dispatchable handles, they are unique
+	typ    ObjectType
+	handle uint64
+}
+
+func newTrackKey(typ ObjectType, parent, h uint64) trackKey {
+	switch typ {
+	case OBJECT_TYPE_INSTANCE, OBJECT_TYPE_PHYSICAL_DEVICE, OBJECT_TYPE_DEVICE, OBJECT_TYPE_QUEUE, OBJECT_TYPE_COMMAND_BUFFER:
+		parent = 0
+	}
+	return trackKey{parent, typ, h}
+}
+
+type trackedObject struct {
+	parent uint64
+	name   string
+	stack  []uintptr
+	owned  bool // false for the physical devices and the queues
+}
+
+// LeakedObject is an object left alive by the destruction of its device or
+// instance.
+type LeakedObject struct {
+	Type   ObjectType
+	Handle uint64
+	Parent uint64 // the device, instance or physical device it was created from
+	Name   string // empty if it was not named
+	Stack  string // the Go call stack of its creation
+}
+
+// ObjectLeakHandler is called by the destruction of the device or the
+// instance handle with the objects it leaves alive, sorted by type. The
+// default prints them grouped by type.
+var ObjectLeakHandler = PrintObjectLeaks
+
+// TrackObjects turns the object tracking on or off, it is off by default.
+// It must not be called concurrently with the commands, and the objects
+// created while it is off are unknown to it.
+func TrackObjects(on bool) {
+	trackMutex.Lock()
+	defer trackMutex.Unlock()
+	trackObjects = on
+	if !on {
+		tracked = make(map[trackKey][]*trackedObject)
+	}
+}
+
+// PrintObjectLeaks prints the leaks grouped by type.
+func PrintObjectLeaks(typ ObjectType, handle uint64, leaks []LeakedObject) {
+	fmt.Printf("------- OBJECT LEAKS DETECTED: %v 0x%X -------\n", typ, handle)
+	for i, o := range leaks {
+		if i == 0 || o.Type != leaks[i-1].Type {
+			n := 1
+			for n < len(leaks)-i && leaks[i+n].Type == o.Type {
+				n++
+			}
+			fmt.Printf("%v: %d\n", o.Type, n)
+		}
+		if o.Name != "" {
+			fmt.Printf("\t0x%X %q\n", o.Handle, o.Name)
+		} else {
+			fmt.Printf("\t0x%X\n", o.Handle)
+		}
+		fmt.Print(o.Stack)
+	}
+	fmt.Println("------- END DUMP OBJECT LEAKS -------")
+}
+
+// trackHandle returns the i-th handle of size bytes at p.
+func trackHandle(p unsafe.Pointer, i int, size uintptr) uint64 {
+	q := unsafe.Pointer(uintptr(p) + uintptr(i)*size)
+	if size == 8 {
+		return *(*uint64)(q)
+	}
+	return uint64(*(*uintptr)(q))
+}
+
+func trackCreated(res Result, typ ObjectType, parent uint64, p unsafe.Pointer, n int, size uintptr, owned bool) {
+	if res < 0 || p == nil {
+		return
+	}
+	var stack []uintptr
+	if owned {
+		pcs := make([]uintptr, 32)
+		stack = pcs[:runtime.Callers(3, pcs)] // from the caller of Call
+	}
+	trackMutex.Lock()
+	defer trackMutex.Unlock()
+	for i := 0; i < n; i++ {
+		h := trackHandle(p, i, size)
+		if h == 0 {
+			continue // vkCreate*Pipelines may fail some of them
+		}
+		k := newTrackKey(typ, parent, h)
+		if len(tracked[k]) > 0 && !owned {
+			continue // enumerated again
+		}
+		tracked[k] = append(tracked[k], &trackedObject{parent: parent, stack: stack, owned: owned})
+	}
+}
+
+func trackDestroyed(typ ObjectType, parent uint64, p unsafe.Pointer, n int, size uintptr) {
+	for i := 0; i < n; i++ {
+		h := trackHandle(p, i, size)
+		if leaks := trackRemove(typ, parent, h); len(leaks) > 0 && ObjectLeakHandler != nil {
+			ObjectLeakHandler(typ, h, leaks)
+		}
+	}
+}
+
+// trackRemove forgets the object h, and the objects created from it if it
+// is a device or an instance. It returns those the application did not
+// destroy.
+func trackRemove(typ ObjectType, parent, h uint64) (leaks []LeakedObject) {
+	trackMutex.Lock()
+	defer trackMutex.Unlock()
+	k := newTrackKey(typ, parent, h)
+	objs := tracked[k]
+	if len(objs) == 0 {
+		return nil
+	}
+	if objs = objs[:len(objs)-1]; len(objs) > 0 {
+		tracked[k] = objs
+	} else {
+		delete(tracked, k)
+	}
+	if typ != OBJECT_TYPE_DEVICE && typ != OBJECT_TYPE_INSTANCE {
+		return nil
+	}
+	// the parents are dispatchable, their handles are unique
+	gone := map[uint64]bool{h: true}
+	for more := true; more; {
+		more = false
+		for k, objs := range tracked {
+			if !gone[objs[0].parent] { // one parent to a key
+				continue
+			}
+			for _, o := range objs {
+				if o.owned {
+					leaks = append(leaks, LeakedObject{k.typ, k.handle, o.parent, o.name, trackStack(o.stack)})
+				}
+			}
+			delete(tracked, k)
+			if k.parent == 0 {
+				gone[k.handle], more = true, true
+			}
+		}
+	}
+	sort.Slice(leaks, func(i, j int) bool {
+		if leaks[i].Type != leaks[j].Type {
+			return leaks[i].Type < leaks[j].Type
+		}
+		return leaks[i].Handle < leaks[j].Handle
+	})
+	return leaks
+}
+
+// trackNamed names the objects of handle h, those sharing the handle share
+// the name in the driver as well.
+func trackNamed(res Result, device uint64, typ ObjectType, h uint64, name *int8) {
+	if res < 0 {
+		return
+	}
+	trackMutex.Lock()
+	defer trackMutex.Unlock()
+	for _, o := range tracked[newTrackKey(typ, device, h)] {
+		o.name = ptrInt8ToString(name)
+	}
+}
+
+// objectTypeOf returns the ObjectType of a VK_EXT_debug_marker object type.
+func objectTypeOf(typ DebugReportObjectTypeEXT) ObjectType {
+	for t, r := range debugReportTypes {
+		if r == typ {
+			return t
+		}
+	}
+	return ObjectType(typ)
+}
+
+func trackStack(pcs []uintptr) string {
+	if len(pcs) == 0 {
+		return ""
+	}
+	var sb strings.Builder
+	frames := runtime.CallersFrames(pcs)
+	for {
+		f, more := frames.Next()
+		fmt.Fprintf(&sb, "\t\t%s\n\t\t\t%s:%d\n", f.Function, f.File, f.Line)
+		if !more {
+			return sb.String()
+		}
+	}
+}
@@ -0,0 +1,109 @@
+// +build cgo
+
+package vk
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/toy80/vk/internal/abi"
+)
+
+func TestTrackObjects(t *testing.T) {
+	var leaks []LeakedObject
+	defer func(h func(ObjectType, uint64, []LeakedObject)) { ObjectLeakHandler = h }(ObjectLeakHandler)
+	ObjectLeakHandler = func(typ ObjectType, h uint64, l []LeakedObject) {
+		if typ != OBJECT_TYPE_DEVICE {
+			t.Errorf("leaks reported by %v 0x%X", typ, h)
+		}
+		leaks = append(leaks, l...)
+	}
+	TrackObjects(true)
+	defer TrackObjects(false)
+
+	var device Device
+	PfnCreateDevice(abi.CreateDevice).Call(PhysicalDevice(0x1000), &DeviceCreateInfo{}, nil, &device)
+	var buffers [3]Buffer
+	for i := range buffers {
+		info := BufferCreateInfo{SType: STRUCTURE_TYPE_BUFFER_CREATE_INFO, Size: DeviceSize(0x10 * (i + 1))}
+		PfnCreateBuffer(abi.CreateBuffer).Call(device, &info, nil, &buffers[i])
+	}
+	name, free := CStr("vertices")
+	defer free()
+	info := DebugUtilsObjectNameInfoEXT{
+		SType:        STRUCTURE_TYPE_DEBUG_UTILS_OBJECT_NAME_INFO_EXT,
+		ObjectType:   OBJECT_TYPE_BUFFER,
+		ObjectHandle: uint64(buffers[2]),
+		PObjectName:  name,
+	}
+	PfnSetDebugUtilsObjectNameEXT(abi.SetDebugUtilsObjectNameEXT).Call(device, &info)
+	PfnDestroyBuffer(abi.DestroyBuffer).Call(device, buffers[0], nil)
+	PfnDestroyDevice(abi.DestroyDevice).Call(device, nil)
+	PfnDestroyDevice(abi.DestroyDevice).Call(device, nil) // forgotten already
+
+	if len(leaks) != 2 {
+		t.Fatalf("got %d leaks, want 2", len(leaks))
+	}
+	for i, o := range leaks {
+		want := LeakedObject{OBJECT_TYPE_BUFFER, uint64(buffers[i+1]), uint64(device), "", o.Stack}
+		if i == 1 {
+			want.Name = "vertices"
+		}
+		if o != want {
+			t.Errorf("leak %d: got %+v, want %+v", i, o, want)
+		}
+		if !strings.Contains(o.Stack, "TestTrackObjects") {
+			t.Errorf("leak %d: stack does not have the test\n%s", i, o.Stack)
+		}
+	}
+	if n := len(tracked); n != 0 {
+		t.Errorf("%d objects still tracked", n)
+	}
+}
+
+// TestTrackSharedHandles creates buffers of the same handle on two devices,
+// and twice on one, the handles are not unique to the objects.
+func TestTrackSharedHandles(t *testing.T) {
+	leaks := make(map[Device][]LeakedObject)
+	defer func(h func(ObjectType, uint64, []LeakedObject)) { ObjectLeakHandler = h }(ObjectLeakHandler)
+	ObjectLeakHandler = func(typ ObjectType, h uint64, l []LeakedObject) {
+		leaks[Device(h)] = append(leaks[Device(h)], l...)
+	}
+	TrackObjects(true)
+	defer TrackObjects(false)
+
+	var a, b Device
+	PfnCreateDevice(abi.CreateDevice).Call(PhysicalDevice(0x1000), &DeviceCreateInfo{}, nil, &a)
+	PfnCreateDevice(abi.CreateDevice).Call(PhysicalDevice(0x2000), &DeviceCreateInfo{}, nil, &b)
+	info := BufferCreateInfo{SType: STRUCTURE_TYPE_BUFFER_CREATE_INFO, Size: 0x40}
+	var x, y, z Buffer
+	PfnCreateBuffer(abi.CreateBuffer).Call(a, &info, nil, &x)
+	PfnCreateBuffer(abi.CreateBuffer).Call(b, &info, nil, &y)
+	PfnCreateBuffer(abi.CreateBuffer).Call(b, &info, nil, &z)
+	if x != y || y != z {
+		t.Fatalf("buffers 0x%X, 0x%X and 0x%X, want one handle", x, y, z)
+	}
+	name, free := CStr("uniforms")
+	defer free()
+	nameInfo := DebugMarkerObjectNameInfoEXT{
+		SType:       STRUCTURE_TYPE_DEBUG_MARKER_OBJECT_NAME_INFO_EXT,
+		ObjectType:  DEBUG_REPORT_OBJECT_TYPE_BUFFER_EXT,
+		Object:      uint64(y),
+		PObjectName: name,
+	}
+	PfnDebugMarkerSetObjectNameEXT(abi.DebugMarkerSetObjectNameEXT).Call(b, &nameInfo)
+
+	PfnDestroyBuffer(abi.DestroyBuffer).Call(a, x, nil)
+	PfnDestroyBuffer(abi.DestroyBuffer).Call(b, y, nil)
+	PfnDestroyDevice(abi.DestroyDevice).Call(a, nil)
+	if len(leaks[a]) != 0 {
+		t.Errorf("leaks of the first device: %+v", leaks[a])
+	}
+	PfnDestroyDevice(abi.DestroyDevice).Call(b, nil)
+	if l := leaks[b]; len(l) != 1 || l[0].Handle != uint64(z) || l[0].Parent != uint64(b) || l[0].Name != "uniforms" {
+		t.Errorf("leaks of the second device: %+v", l)
+	}
+	if n := len(tracked); n != 0 {
+		t.Errorf("%d objects still tracked", n)
+	}
+}
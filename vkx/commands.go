@@ -0,0 +1,5356 @@
+// This file is generated by vkgen.
+
+package vkx
+
+import (
+	"unsafe"
+
+	"github.com/toy80/vk"
+)
+
+// InstanceTable holds the commands of an instance and its physical devices,
+// from vkGetInstanceProcAddr. Those the instance lacks are zero.
+type InstanceTable struct {
+	// Allocator is passed to the commands taking a pAllocator.
+	Allocator *vk.AllocationCallbacks
+
+	DestroyInstance                                                 vk.PfnDestroyInstance
+	EnumeratePhysicalDevices                                        vk.PfnEnumeratePhysicalDevices
+	GetPhysicalDeviceFeatures                                       vk.PfnGetPhysicalDeviceFeatures
+	GetPhysicalDeviceFormatProperties                               vk.PfnGetPhysicalDeviceFormatProperties
+	GetPhysicalDeviceImageFormatProperties                          vk.PfnGetPhysicalDeviceImageFormatProperties
+	GetPhysicalDeviceProperties                                     vk.PfnGetPhysicalDeviceProperties
+	GetPhysicalDeviceQueueFamilyProperties                          vk.PfnGetPhysicalDeviceQueueFamilyProperties
+	GetPhysicalDeviceMemoryProperties                               vk.PfnGetPhysicalDeviceMemoryProperties
+	GetDeviceProcAddr                                               vk.PfnGetDeviceProcAddr
+	CreateDevice                                                    vk.PfnCreateDevice
+	EnumerateDeviceExtensionProperties                              vk.PfnEnumerateDeviceExtensionProperties
+	EnumerateDeviceLayerProperties                                  vk.PfnEnumerateDeviceLayerProperties
+	GetPhysicalDeviceSparseImageFormatProperties                    vk.PfnGetPhysicalDeviceSparseImageFormatProperties
+	EnumeratePhysicalDeviceGroups                                   vk.PfnEnumeratePhysicalDeviceGroups
+	GetPhysicalDeviceFeatures2                                      vk.PfnGetPhysicalDeviceFeatures2
+	GetPhysicalDeviceProperties2                                    vk.PfnGetPhysicalDeviceProperties2
+	GetPhysicalDeviceFormatProperties2                              vk.PfnGetPhysicalDeviceFormatProperties2
+	GetPhysicalDeviceImageFormatProperties2                         vk.PfnGetPhysicalDeviceImageFormatProperties2
+	GetPhysicalDeviceQueueFamilyProperties2                         vk.PfnGetPhysicalDeviceQueueFamilyProperties2
+	GetPhysicalDeviceMemoryProperties2                              vk.PfnGetPhysicalDeviceMemoryProperties2
+	GetPhysicalDeviceSparseImageFormatProperties2                   vk.PfnGetPhysicalDeviceSparseImageFormatProperties2
+	GetPhysicalDeviceExternalBufferProperties                       vk.PfnGetPhysicalDeviceExternalBufferProperties
+	GetPhysicalDeviceExternalFenceProperties                        vk.PfnGetPhysicalDeviceExternalFenceProperties
+	GetPhysicalDeviceExternalSemaphoreProperties                    vk.PfnGetPhysicalDeviceExternalSemaphoreProperties
+	GetPhysicalDeviceToolProperties                                 vk.PfnGetPhysicalDeviceToolProperties
+	DestroySurfaceKHR                                               vk.PfnDestroySurfaceKHR
+	GetPhysicalDeviceSurfaceSupportKHR                              vk.PfnGetPhysicalDeviceSurfaceSupportKHR
+	GetPhysicalDeviceSurfaceCapabilitiesKHR                         vk.PfnGetPhysicalDeviceSurfaceCapabilitiesKHR
+	GetPhysicalDeviceSurfaceFormatsKHR                              vk.PfnGetPhysicalDeviceSurfaceFormatsKHR
+	GetPhysicalDeviceSurfacePresentModesKHR                         vk.PfnGetPhysicalDeviceSurfacePresentModesKHR
+	GetPhysicalDevicePresentRectanglesKHR                           vk.PfnGetPhysicalDevicePresentRectanglesKHR
+	GetPhysicalDeviceDisplayPropertiesKHR                           vk.PfnGetPhysicalDeviceDisplayPropertiesKHR
+	GetPhysicalDeviceDisplayPlanePropertiesKHR                      vk.PfnGetPhysicalDeviceDisplayPlanePropertiesKHR
+	GetDisplayPlaneSupportedDisplaysKHR                             vk.PfnGetDisplayPlaneSupportedDisplaysKHR
+	GetDisplayModePropertiesKHR                                     vk.PfnGetDisplayModePropertiesKHR
+	CreateDisplayModeKHR                                            vk.PfnCreateDisplayModeKHR
+	GetDisplayPlaneCapabilitiesKHR                                  vk.PfnGetDisplayPlaneCapabilitiesKHR
+	CreateDisplayPlaneSurfaceKHR                                    vk.PfnCreateDisplayPlaneSurfaceKHR
+	GetPhysicalDeviceVideoCapabilitiesKHR                           vk.PfnGetPhysicalDeviceVideoCapabilitiesKHR
+	GetPhysicalDeviceVideoFormatPropertiesKHR                       vk.PfnGetPhysicalDeviceVideoFormatPropertiesKHR
+	GetPhysicalDeviceFeatures2KHR                                   vk.PfnGetPhysicalDeviceFeatures2KHR
+	GetPhysicalDeviceProperties2KHR                                 vk.PfnGetPhysicalDeviceProperties2KHR
+	GetPhysicalDeviceFormatProperties2KHR                           vk.PfnGetPhysicalDeviceFormatProperties2KHR
+	GetPhysicalDeviceImageFormatProperties2KHR                      vk.PfnGetPhysicalDeviceImageFormatProperties2KHR
+	GetPhysicalDeviceQueueFamilyProperties2KHR                      vk.PfnGetPhysicalDeviceQueueFamilyProperties2KHR
+	GetPhysicalDeviceMemoryProperties2KHR                           vk.PfnGetPhysicalDeviceMemoryProperties2KHR
+	GetPhysicalDeviceSparseImageFormatProperties2KHR                vk.PfnGetPhysicalDeviceSparseImageFormatProperties2KHR
+	EnumeratePhysicalDeviceGroupsKHR                                vk.PfnEnumeratePhysicalDeviceGroupsKHR
+	GetPhysicalDeviceExternalBufferPropertiesKHR                    vk.PfnGetPhysicalDeviceExternalBufferPropertiesKHR
+	GetPhysicalDeviceExternalSemaphorePropertiesKHR                 vk.PfnGetPhysicalDeviceExternalSemaphorePropertiesKHR
+	GetPhysicalDeviceExternalFencePropertiesKHR                     vk.PfnGetPhysicalDeviceExternalFencePropertiesKHR
+	EnumeratePhysicalDeviceQueueFamilyPerformanceQueryCountersKHR   vk.PfnEnumeratePhysicalDeviceQueueFamilyPerformanceQueryCountersKHR
+	GetPhysicalDeviceQueueFamilyPerformanceQueryPassesKHR           vk.PfnGetPhysicalDeviceQueueFamilyPerformanceQueryPassesKHR
+	GetPhysicalDeviceSurfaceCapabilities2KHR                        vk.PfnGetPhysicalDeviceSurfaceCapabilities2KHR
+	GetPhysicalDeviceSurfaceFormats2KHR                             vk.PfnGetPhysicalDeviceSurfaceFormats2KHR
+	GetPhysicalDeviceDisplayProperties2KHR                          vk.PfnGetPhysicalDeviceDisplayProperties2KHR
+	GetPhysicalDeviceDisplayPlaneProperties2KHR                     vk.PfnGetPhysicalDeviceDisplayPlaneProperties2KHR
+	GetDisplayModeProperties2KHR                                    vk.PfnGetDisplayModeProperties2KHR
+	GetDisplayPlaneCapabilities2KHR                                 vk.PfnGetDisplayPlaneCapabilities2KHR
+	GetPhysicalDeviceFragmentShadingRatesKHR                        vk.PfnGetPhysicalDeviceFragmentShadingRatesKHR
+	CreateDebugReportCallbackEXT                                    vk.PfnCreateDebugReportCallbackEXT
+	DestroyDebugReportCallbackEXT                                   vk.PfnDestroyDebugReportCallbackEXT
+	DebugReportMessageEXT                                           vk.PfnDebugReportMessageEXT
+	GetPhysicalDeviceExternalImageFormatPropertiesNV                vk.PfnGetPhysicalDeviceExternalImageFormatPropertiesNV
+	ReleaseDisplayEXT                                               vk.PfnReleaseDisplayEXT
+	GetPhysicalDeviceSurfaceCapabilities2EXT                        vk.PfnGetPhysicalDeviceSurfaceCapabilities2EXT
+	CreateDebugUtilsMessengerEXT                                    vk.PfnCreateDebugUtilsMessengerEXT
+	DestroyDebugUtilsMessengerEXT                                   vk.PfnDestroyDebugUtilsMessengerEXT
+	SubmitDebugUtilsMessageEXT                                      vk.PfnSubmitDebugUtilsMessageEXT
+	GetPhysicalDeviceMultisamplePropertiesEXT                       vk.PfnGetPhysicalDeviceMultisamplePropertiesEXT
+	GetPhysicalDeviceCalibrateableTimeDomainsEXT                    vk.PfnGetPhysicalDeviceCalibrateableTimeDomainsEXT
+	GetPhysicalDeviceToolPropertiesEXT                              vk.PfnGetPhysicalDeviceToolPropertiesEXT
+	GetPhysicalDeviceCooperativeMatrixPropertiesNV                  vk.PfnGetPhysicalDeviceCooperativeMatrixPropertiesNV
+	GetPhysicalDeviceSupportedFramebufferMixedSamplesCombinationsNV vk.PfnGetPhysicalDeviceSupportedFramebufferMixedSamplesCombinationsNV
+	CreateHeadlessSurfaceEXT                                        vk.PfnCreateHeadlessSurfaceEXT
+	AcquireDrmDisplayEXT                                            vk.PfnAcquireDrmDisplayEXT
+	GetDrmDisplayEXT                                                vk.PfnGetDrmDisplayEXT
+	GetPhysicalDeviceOpticalFlowImageFormatsNV                      vk.PfnGetPhysicalDeviceOpticalFlowImageFormatsNV
+}
+
+func (t *InstanceTable) load(proc func(name string) vk.PfnVoidFunction) {
+	t.DestroyInstance = vk.PfnDestroyInstance(proc(t.DestroyInstance.String()))
+	t.EnumeratePhysicalDevices = vk.PfnEnumeratePhysicalDevices(proc(t.EnumeratePhysicalDevices.String()))
+	t.GetPhysicalDeviceFeatures = vk.PfnGetPhysicalDeviceFeatures(proc(t.GetPhysicalDeviceFeatures.String()))
+	t.GetPhysicalDeviceFormatProperties = vk.PfnGetPhysicalDeviceFormatProperties(proc(t.GetPhysicalDeviceFormatProperties.String()))
+	t.GetPhysicalDeviceImageFormatProperties = vk.PfnGetPhysicalDeviceImageFormatProperties(proc(t.GetPhysicalDeviceImageFormatProperties.String()))
+	t.GetPhysicalDeviceProperties = vk.PfnGetPhysicalDeviceProperties(proc(t.GetPhysicalDeviceProperties.String()))
+	t.GetPhysicalDeviceQueueFamilyProperties = vk.PfnGetPhysicalDeviceQueueFamilyProperties(proc(t.GetPhysicalDeviceQueueFamilyProperties.String()))
+	t.GetPhysicalDeviceMemoryProperties = vk.PfnGetPhysicalDeviceMemoryProperties(proc(t.GetPhysicalDeviceMemoryProperties.String()))
+	t.GetDeviceProcAddr = vk.PfnGetDeviceProcAddr(proc(t.GetDeviceProcAddr.String()))
+	t.CreateDevice = vk.PfnCreateDevice(proc(t.CreateDevice.String()))
+	t.EnumerateDeviceExtensionProperties = vk.PfnEnumerateDeviceExtensionProperties(proc(t.EnumerateDeviceExtensionProperties.String()))
+	t.EnumerateDeviceLayerProperties = vk.PfnEnumerateDeviceLayerProperties(proc(t.EnumerateDeviceLayerProperties.String()))
+	t.GetPhysicalDeviceSparseImageFormatProperties = vk.PfnGetPhysicalDeviceSparseImageFormatProperties(proc(t.GetPhysicalDeviceSparseImageFormatProperties.String()))
+	t.EnumeratePhysicalDeviceGroups = vk.PfnEnumeratePhysicalDeviceGroups(proc(t.EnumeratePhysicalDeviceGroups.String()))
+	t.GetPhysicalDeviceFeatures2 = vk.PfnGetPhysicalDeviceFeatures2(proc(t.GetPhysicalDeviceFeatures2.String()))
+	t.GetPhysicalDeviceProperties2 = vk.PfnGetPhysicalDeviceProperties2(proc(t.GetPhysicalDeviceProperties2.String()))
+	t.GetPhysicalDeviceFormatProperties2 = vk.PfnGetPhysicalDeviceFormatProperties2(proc(t.GetPhysicalDeviceFormatProperties2.String()))
+	t.GetPhysicalDeviceImageFormatProperties2 = vk.PfnGetPhysicalDeviceImageFormatProperties2(proc(t.GetPhysicalDeviceImageFormatProperties2.String()))
+	t.GetPhysicalDeviceQueueFamilyProperties2 = vk.PfnGetPhysicalDeviceQueueFamilyProperties2(proc(t.GetPhysicalDeviceQueueFamilyProperties2.String()))
+	t.GetPhysicalDeviceMemoryProperties2 = vk.PfnGetPhysicalDeviceMemoryProperties2(proc(t.GetPhysicalDeviceMemoryProperties2.String()))
+	t.GetPhysicalDeviceSparseImageFormatProperties2 = vk.PfnGetPhysicalDeviceSparseImageFormatProperties2(proc(t.GetPhysicalDeviceSparseImageFormatProperties2.String()))
+	t.GetPhysicalDeviceExternalBufferProperties = vk.PfnGetPhysicalDeviceExternalBufferProperties(proc(t.GetPhysicalDeviceExternalBufferProperties.String()))
+	t.GetPhysicalDeviceExternalFenceProperties = vk.PfnGetPhysicalDeviceExternalFenceProperties(proc(t.GetPhysicalDeviceExternalFenceProperties.String()))
+	t.GetPhysicalDeviceExternalSemaphoreProperties = vk.PfnGetPhysicalDeviceExternalSemaphoreProperties(proc(t.GetPhysicalDeviceExternalSemaphoreProperties.String()))
+	t.GetPhysicalDeviceToolProperties = vk.PfnGetPhysicalDeviceToolProperties(proc(t.GetPhysicalDeviceToolProperties.String()))
+	t.DestroySurfaceKHR = vk.PfnDestroySurfaceKHR(proc(t.DestroySurfaceKHR.String()))
+	t.GetPhysicalDeviceSurfaceSupportKHR = vk.PfnGetPhysicalDeviceSurfaceSupportKHR(proc(t.GetPhysicalDeviceSurfaceSupportKHR.String()))
+	t.GetPhysicalDeviceSurfaceCapabilitiesKHR = vk.PfnGetPhysicalDeviceSurfaceCapabilitiesKHR(proc(t.GetPhysicalDeviceSurfaceCapabilitiesKHR.String()))
+	t.GetPhysicalDeviceSurfaceFormatsKHR = vk.PfnGetPhysicalDeviceSurfaceFormatsKHR(proc(t.GetPhysicalDeviceSurfaceFormatsKHR.String()))
+	t.GetPhysicalDeviceSurfacePresentModesKHR = vk.PfnGetPhysicalDeviceSurfacePresentModesKHR(proc(t.GetPhysicalDeviceSurfacePresentModesKHR.String()))
+	t.GetPhysicalDevicePresentRectanglesKHR = vk.PfnGetPhysicalDevicePresentRectanglesKHR(proc(t.GetPhysicalDevicePresentRectanglesKHR.String()))
+	t.GetPhysicalDeviceDisplayPropertiesKHR = vk.PfnGetPhysicalDeviceDisplayPropertiesKHR(proc(t.GetPhysicalDeviceDisplayPropertiesKHR.String()))
+	t.GetPhysicalDeviceDisplayPlanePropertiesKHR = vk.PfnGetPhysicalDeviceDisplayPlanePropertiesKHR(proc(t.GetPhysicalDeviceDisplayPlanePropertiesKHR.String()))
+	t.GetDisplayPlaneSupportedDisplaysKHR = vk.PfnGetDisplayPlaneSupportedDisplaysKHR(proc(t.GetDisplayPlaneSupportedDisplaysKHR.String()))
+	t.GetDisplayModePropertiesKHR = vk.PfnGetDisplayModePropertiesKHR(proc(t.GetDisplayModePropertiesKHR.String()))
+	t.CreateDisplayModeKHR = vk.PfnCreateDisplayModeKHR(proc(t.CreateDisplayModeKHR.String()))
+	t.GetDisplayPlaneCapabilitiesKHR = vk.PfnGetDisplayPlaneCapabilitiesKHR(proc(t.GetDisplayPlaneCapabilitiesKHR.String()))
+	t.CreateDisplayPlaneSurfaceKHR = vk.PfnCreateDisplayPlaneSurfaceKHR(proc(t.CreateDisplayPlaneSurfaceKHR.String()))
+	t.GetPhysicalDeviceVideoCapabilitiesKHR = vk.PfnGetPhysicalDeviceVideoCapabilitiesKHR(proc(t.GetPhysicalDeviceVideoCapabilitiesKHR.String()))
+	t.GetPhysicalDeviceVideoFormatPropertiesKHR = vk.PfnGetPhysicalDeviceVideoFormatPropertiesKHR(proc(t.GetPhysicalDeviceVideoFormatPropertiesKHR.String()))
+	t.GetPhysicalDeviceFeatures2KHR = vk.PfnGetPhysicalDeviceFeatures2KHR(proc(t.GetPhysicalDeviceFeatures2KHR.String()))
+	t.GetPhysicalDeviceProperties2KHR = vk.PfnGetPhysicalDeviceProperties2KHR(proc(t.GetPhysicalDeviceProperties2KHR.String()))
+	t.GetPhysicalDeviceFormatProperties2KHR = vk.PfnGetPhysicalDeviceFormatProperties2KHR(proc(t.GetPhysicalDeviceFormatProperties2KHR.String()))
+	t.GetPhysicalDeviceImageFormatProperties2KHR = vk.PfnGetPhysicalDeviceImageFormatProperties2KHR(proc(t.GetPhysicalDeviceImageFormatProperties2KHR.String()))
+	t.GetPhysicalDeviceQueueFamilyProperties2KHR = vk.PfnGetPhysicalDeviceQueueFamilyProperties2KHR(proc(t.GetPhysicalDeviceQueueFamilyProperties2KHR.String()))
+	t.GetPhysicalDeviceMemoryProperties2KHR = vk.PfnGetPhysicalDeviceMemoryProperties2KHR(proc(t.GetPhysicalDeviceMemoryProperties2KHR.String()))
+	t.GetPhysicalDeviceSparseImageFormatProperties2KHR = vk.PfnGetPhysicalDeviceSparseImageFormatProperties2KHR(proc(t.GetPhysicalDeviceSparseImageFormatProperties2KHR.String()))
+	t.EnumeratePhysicalDeviceGroupsKHR = vk.PfnEnumeratePhysicalDeviceGroupsKHR(proc(t.EnumeratePhysicalDeviceGroupsKHR.String()))
+	t.GetPhysicalDeviceExternalBufferPropertiesKHR = vk.PfnGetPhysicalDeviceExternalBufferPropertiesKHR(proc(t.GetPhysicalDeviceExternalBufferPropertiesKHR.String()))
+	t.GetPhysicalDeviceExternalSemaphorePropertiesKHR = vk.PfnGetPhysicalDeviceExternalSemaphorePropertiesKHR(proc(t.GetPhysicalDeviceExternalSemaphorePropertiesKHR.String()))
+	t.GetPhysicalDeviceExternalFencePropertiesKHR = vk.PfnGetPhysicalDeviceExternalFencePropertiesKHR(proc(t.GetPhysicalDeviceExternalFencePropertiesKHR.String()))
+	t.EnumeratePhysicalDeviceQueueFamilyPerformanceQueryCountersKHR = vk.PfnEnumeratePhysicalDeviceQueueFamilyPerformanceQueryCountersKHR(proc(t.EnumeratePhysicalDeviceQueueFamilyPerformanceQueryCountersKHR.String()))
+	t.GetPhysicalDeviceQueueFamilyPerformanceQueryPassesKHR = vk.PfnGetPhysicalDeviceQueueFamilyPerformanceQueryPassesKHR(proc(t.GetPhysicalDeviceQueueFamilyPerformanceQueryPassesKHR.String()))
+	t.GetPhysicalDeviceSurfaceCapabilities2KHR = vk.PfnGetPhysicalDeviceSurfaceCapabilities2KHR(proc(t.GetPhysicalDeviceSurfaceCapabilities2KHR.String()))
+	t.GetPhysicalDeviceSurfaceFormats2KHR = vk.PfnGetPhysicalDeviceSurfaceFormats2KHR(proc(t.GetPhysicalDeviceSurfaceFormats2KHR.String()))
+	t.GetPhysicalDeviceDisplayProperties2KHR = vk.PfnGetPhysicalDeviceDisplayProperties2KHR(proc(t.GetPhysicalDeviceDisplayProperties2KHR.String()))
+	t.GetPhysicalDeviceDisplayPlaneProperties2KHR = vk.PfnGetPhysicalDeviceDisplayPlaneProperties2KHR(proc(t.GetPhysicalDeviceDisplayPlaneProperties2KHR.String()))
+	t.GetDisplayModeProperties2KHR = vk.PfnGetDisplayModeProperties2KHR(proc(t.GetDisplayModeProperties2KHR.String()))
+	t.GetDisplayPlaneCapabilities2KHR = vk.PfnGetDisplayPlaneCapabilities2KHR(proc(t.GetDisplayPlaneCapabilities2KHR.String()))
+	t.GetPhysicalDeviceFragmentShadingRatesKHR = vk.PfnGetPhysicalDeviceFragmentShadingRatesKHR(proc(t.GetPhysicalDeviceFragmentShadingRatesKHR.String()))
+	t.CreateDebugReportCallbackEXT = vk.PfnCreateDebugReportCallbackEXT(proc(t.CreateDebugReportCallbackEXT.String()))
+	t.DestroyDebugReportCallbackEXT = vk.PfnDestroyDebugReportCallbackEXT(proc(t.DestroyDebugReportCallbackEXT.String()))
+	t.DebugReportMessageEXT = vk.PfnDebugReportMessageEXT(proc(t.DebugReportMessageEXT.String()))
+	t.GetPhysicalDeviceExternalImageFormatPropertiesNV = vk.PfnGetPhysicalDeviceExternalImageFormatPropertiesNV(proc(t.GetPhysicalDeviceExternalImageFormatPropertiesNV.String()))
+	t.ReleaseDisplayEXT = vk.PfnReleaseDisplayEXT(proc(t.ReleaseDisplayEXT.String()))
+	t.GetPhysicalDeviceSurfaceCapabilities2EXT = vk.PfnGetPhysicalDeviceSurfaceCapabilities2EXT(proc(t.GetPhysicalDeviceSurfaceCapabilities2EXT.String()))
+	t.CreateDebugUtilsMessengerEXT = vk.PfnCreateDebugUtilsMessengerEXT(proc(t.CreateDebugUtilsMessengerEXT.String()))
+	t.DestroyDebugUtilsMessengerEXT = vk.PfnDestroyDebugUtilsMessengerEXT(proc(t.DestroyDebugUtilsMessengerEXT.String()))
+	t.SubmitDebugUtilsMessageEXT = vk.PfnSubmitDebugUtilsMessageEXT(proc(t.SubmitDebugUtilsMessageEXT.String()))
+	t.GetPhysicalDeviceMultisamplePropertiesEXT = vk.PfnGetPhysicalDeviceMultisamplePropertiesEXT(proc(t.GetPhysicalDeviceMultisamplePropertiesEXT.String()))
+	t.GetPhysicalDeviceCalibrateableTimeDomainsEXT = vk.PfnGetPhysicalDeviceCalibrateableTimeDomainsEXT(proc(t.GetPhysicalDeviceCalibrateableTimeDomainsEXT.String()))
+	t.GetPhysicalDeviceToolPropertiesEXT = vk.PfnGetPhysicalDeviceToolPropertiesEXT(proc(t.GetPhysicalDeviceToolPropertiesEXT.String()))
+	t.GetPhysicalDeviceCooperativeMatrixPropertiesNV = vk.PfnGetPhysicalDeviceCooperativeMatrixPropertiesNV(proc(t.GetPhysicalDeviceCooperativeMatrixPropertiesNV.String()))
+	t.GetPhysicalDeviceSupportedFramebufferMixedSamplesCombinationsNV = vk.PfnGetPhysicalDeviceSupportedFramebufferMixedSamplesCombinationsNV(proc(t.GetPhysicalDeviceSupportedFramebufferMixedSamplesCombinationsNV.String()))
+	t.CreateHeadlessSurfaceEXT = vk.PfnCreateHeadlessSurfaceEXT(proc(t.CreateHeadlessSurfaceEXT.String()))
+	t.AcquireDrmDisplayEXT = vk.PfnAcquireDrmDisplayEXT(proc(t.AcquireDrmDisplayEXT.String()))
+	t.GetDrmDisplayEXT = vk.PfnGetDrmDisplayEXT(proc(t.GetDrmDisplayEXT.String()))
+	t.GetPhysicalDeviceOpticalFlowImageFormatsNV = vk.PfnGetPhysicalDeviceOpticalFlowImageFormatsNV(proc(t.GetPhysicalDeviceOpticalFlowImageFormatsNV.String()))
+}
+
+// DeviceTable holds the commands of a device, its queues and command buffers,
+// from vkGetDeviceProcAddr. Those the device lacks are zero.
+type DeviceTable struct {
+	// Allocator is passed to the commands taking a pAllocator.
+	Allocator *vk.AllocationCallbacks
+
+	DestroyDevice                                          vk.PfnDestroyDevice
+	GetDeviceQueue                                         vk.PfnGetDeviceQueue
+	QueueSubmit                                            vk.PfnQueueSubmit
+	QueueWaitIdle                                          vk.PfnQueueWaitIdle
+	DeviceWaitIdle                                         vk.PfnDeviceWaitIdle
+	AllocateMemory                                         vk.PfnAllocateMemory
+	FreeMemory                                             vk.PfnFreeMemory
+	MapMemory                                              vk.PfnMapMemory
+	UnmapMemory                                            vk.PfnUnmapMemory
+	FlushMappedMemoryRanges                                vk.PfnFlushMappedMemoryRanges
+	InvalidateMappedMemoryRanges                           vk.PfnInvalidateMappedMemoryRanges
+	GetDeviceMemoryCommitment                              vk.PfnGetDeviceMemoryCommitment
+	BindBufferMemory                                       vk.PfnBindBufferMemory
+	BindImageMemory                                        vk.PfnBindImageMemory
+	GetBufferMemoryRequirements                            vk.PfnGetBufferMemoryRequirements
+	GetImageMemoryRequirements                             vk.PfnGetImageMemoryRequirements
+	GetImageSparseMemoryRequirements                       vk.PfnGetImageSparseMemoryRequirements
+	QueueBindSparse                                        vk.PfnQueueBindSparse
+	CreateFence                                            vk.PfnCreateFence
+	DestroyFence                                           vk.PfnDestroyFence
+	ResetFences                                            vk.PfnResetFences
+	GetFenceStatus                                         vk.PfnGetFenceStatus
+	WaitForFences                                          vk.PfnWaitForFences
+	CreateSemaphore                                        vk.PfnCreateSemaphore
+	DestroySemaphore                                       vk.PfnDestroySemaphore
+	CreateEvent                                            vk.PfnCreateEvent
+	DestroyEvent                                           vk.PfnDestroyEvent
+	GetEventStatus                                         vk.PfnGetEventStatus
+	SetEvent                                               vk.PfnSetEvent
+	ResetEvent                                             vk.PfnResetEvent
+	CreateQueryPool                                        vk.PfnCreateQueryPool
+	DestroyQueryPool                                       vk.PfnDestroyQueryPool
+	GetQueryPoolResults                                    vk.PfnGetQueryPoolResults
+	CreateBuffer                                           vk.PfnCreateBuffer
+	DestroyBuffer                                          vk.PfnDestroyBuffer
+	CreateBufferView                                       vk.PfnCreateBufferView
+	DestroyBufferView                                      vk.PfnDestroyBufferView
+	CreateImage                                            vk.PfnCreateImage
+	DestroyImage                                           vk.PfnDestroyImage
+	GetImageSubresourceLayout                              vk.PfnGetImageSubresourceLayout
+	CreateImageView                                        vk.PfnCreateImageView
+	DestroyImageView                                       vk.PfnDestroyImageView
+	CreateShaderModule                                     vk.PfnCreateShaderModule
+	DestroyShaderModule                                    vk.PfnDestroyShaderModule
+	CreatePipelineCache                                    vk.PfnCreatePipelineCache
+	DestroyPipelineCache                                   vk.PfnDestroyPipelineCache
+	GetPipelineCacheData                                   vk.PfnGetPipelineCacheData
+	MergePipelineCaches                                    vk.PfnMergePipelineCaches
+	CreateGraphicsPipelines                                vk.PfnCreateGraphicsPipelines
+	CreateComputePipelines                                 vk.PfnCreateComputePipelines
+	DestroyPipeline                                        vk.PfnDestroyPipeline
+	CreatePipelineLayout                                   vk.PfnCreatePipelineLayout
+	DestroyPipelineLayout                                  vk.PfnDestroyPipelineLayout
+	CreateSampler                                          vk.PfnCreateSampler
+	DestroySampler                                         vk.PfnDestroySampler
+	CreateDescriptorSetLayout                              vk.PfnCreateDescriptorSetLayout
+	DestroyDescriptorSetLayout                             vk.PfnDestroyDescriptorSetLayout
+	CreateDescriptorPool                                   vk.PfnCreateDescriptorPool
+	DestroyDescriptorPool                                  vk.PfnDestroyDescriptorPool
+	ResetDescriptorPool                                    vk.PfnResetDescriptorPool
+	AllocateDescriptorSets                                 vk.PfnAllocateDescriptorSets
+	FreeDescriptorSets                                     vk.PfnFreeDescriptorSets
+	UpdateDescriptorSets                                   vk.PfnUpdateDescriptorSets
+	CreateFramebuffer                                      vk.PfnCreateFramebuffer
+	DestroyFramebuffer                                     vk.PfnDestroyFramebuffer
+	CreateRenderPass                                       vk.PfnCreateRenderPass
+	DestroyRenderPass                                      vk.PfnDestroyRenderPass
+	GetRenderAreaGranularity                               vk.PfnGetRenderAreaGranularity
+	CreateCommandPool                                      vk.PfnCreateCommandPool
+	DestroyCommandPool                                     vk.PfnDestroyCommandPool
+	ResetCommandPool                                       vk.PfnResetCommandPool
+	AllocateCommandBuffers                                 vk.PfnAllocateCommandBuffers
+	FreeCommandBuffers                                     vk.PfnFreeCommandBuffers
+	BeginCommandBuffer                                     vk.PfnBeginCommandBuffer
+	EndCommandBuffer                                       vk.PfnEndCommandBuffer
+	ResetCommandBuffer                                     vk.PfnResetCommandBuffer
+	CmdBindPipeline                                        vk.PfnCmdBindPipeline
+	CmdSetViewport                                         vk.PfnCmdSetViewport
+	CmdSetScissor                                          vk.PfnCmdSetScissor
+	CmdSetLineWidth                                        vk.PfnCmdSetLineWidth
+	CmdSetDepthBias                                        vk.PfnCmdSetDepthBias
+	CmdSetBlendConstants                                   vk.PfnCmdSetBlendConstants
+	CmdSetDepthBounds                                      vk.PfnCmdSetDepthBounds
+	CmdSetStencilCompareMask                               vk.PfnCmdSetStencilCompareMask
+	CmdSetStencilWriteMask                                 vk.PfnCmdSetStencilWriteMask
+	CmdSetStencilReference                                 vk.PfnCmdSetStencilReference
+	CmdBindDescriptorSets                                  vk.PfnCmdBindDescriptorSets
+	CmdBindIndexBuffer                                     vk.PfnCmdBindIndexBuffer
+	CmdBindVertexBuffers                                   vk.PfnCmdBindVertexBuffers
+	CmdDraw                                                vk.PfnCmdDraw
+	CmdDrawIndexed                                         vk.PfnCmdDrawIndexed
+	CmdDrawIndirect                                        vk.PfnCmdDrawIndirect
+	CmdDrawIndexedIndirect                                 vk.PfnCmdDrawIndexedIndirect
+	CmdDispatch                                            vk.PfnCmdDispatch
+	CmdDispatchIndirect                                    vk.PfnCmdDispatchIndirect
+	CmdCopyBuffer                                          vk.PfnCmdCopyBuffer
+	CmdCopyImage                                           vk.PfnCmdCopyImage
+	CmdBlitImage                                           vk.PfnCmdBlitImage
+	CmdCopyBufferToImage                                   vk.PfnCmdCopyBufferToImage
+	CmdCopyImageToBuffer                                   vk.PfnCmdCopyImageToBuffer
+	CmdUpdateBuffer                                        vk.PfnCmdUpdateBuffer
+	CmdFillBuffer                                          vk.PfnCmdFillBuffer
+	CmdClearColorImage                                     vk.PfnCmdClearColorImage
+	CmdClearDepthStencilImage                              vk.PfnCmdClearDepthStencilImage
+	CmdClearAttachments                                    vk.PfnCmdClearAttachments
+	CmdResolveImage                                        vk.PfnCmdResolveImage
+	CmdSetEvent                                            vk.PfnCmdSetEvent
+	CmdResetEvent                                          vk.PfnCmdResetEvent
+	CmdWaitEvents                                          vk.PfnCmdWaitEvents
+	CmdPipelineBarrier                                     vk.PfnCmdPipelineBarrier
+	CmdBeginQuery                                          vk.PfnCmdBeginQuery
+	CmdEndQuery                                            vk.PfnCmdEndQuery
+	CmdResetQueryPool                                      vk.PfnCmdResetQueryPool
+	CmdWriteTimestamp                                      vk.PfnCmdWriteTimestamp
+	CmdCopyQueryPoolResults                                vk.PfnCmdCopyQueryPoolResults
+	CmdPushConstants                                       vk.PfnCmdPushConstants
+	CmdBeginRenderPass                                     vk.PfnCmdBeginRenderPass
+	CmdNextSubpass                                         vk.PfnCmdNextSubpass
+	CmdEndRenderPass                                       vk.PfnCmdEndRenderPass
+	CmdExecuteCommands                                     vk.PfnCmdExecuteCommands
+	BindBufferMemory2                                      vk.PfnBindBufferMemory2
+	BindImageMemory2                                       vk.PfnBindImageMemory2
+	GetDeviceGroupPeerMemoryFeatures                       vk.PfnGetDeviceGroupPeerMemoryFeatures
+	CmdSetDeviceMask                                       vk.PfnCmdSetDeviceMask
+	CmdDispatchBase                                        vk.PfnCmdDispatchBase
+	GetImageMemoryRequirements2                            vk.PfnGetImageMemoryRequirements2
+	GetBufferMemoryRequirements2                           vk.PfnGetBufferMemoryRequirements2
+	GetImageSparseMemoryRequirements2                      vk.PfnGetImageSparseMemoryRequirements2
+	TrimCommandPool                                        vk.PfnTrimCommandPool
+	GetDeviceQueue2                                        vk.PfnGetDeviceQueue2
+	CreateSamplerYcbcrConversion                           vk.PfnCreateSamplerYcbcrConversion
+	DestroySamplerYcbcrConversion                          vk.PfnDestroySamplerYcbcrConversion
+	CreateDescriptorUpdateTemplate                         vk.PfnCreateDescriptorUpdateTemplate
+	DestroyDescriptorUpdateTemplate                        vk.PfnDestroyDescriptorUpdateTemplate
+	UpdateDescriptorSetWithTemplate                        vk.PfnUpdateDescriptorSetWithTemplate
+	GetDescriptorSetLayoutSupport                          vk.PfnGetDescriptorSetLayoutSupport
+	CmdDrawIndirectCount                                   vk.PfnCmdDrawIndirectCount
+	CmdDrawIndexedIndirectCount                            vk.PfnCmdDrawIndexedIndirectCount
+	CreateRenderPass2                                      vk.PfnCreateRenderPass2
+	CmdBeginRenderPass2                                    vk.PfnCmdBeginRenderPass2
+	CmdNextSubpass2                                        vk.PfnCmdNextSubpass2
+	CmdEndRenderPass2                                      vk.PfnCmdEndRenderPass2
+	ResetQueryPool                                         vk.PfnResetQueryPool
+	GetSemaphoreCounterValue                               vk.PfnGetSemaphoreCounterValue
+	WaitSemaphores                                         vk.PfnWaitSemaphores
+	SignalSemaphore                                        vk.PfnSignalSemaphore
+	GetBufferDeviceAddress                                 vk.PfnGetBufferDeviceAddress
+	GetBufferOpaqueCaptureAddress                          vk.PfnGetBufferOpaqueCaptureAddress
+	GetDeviceMemoryOpaqueCaptureAddress                    vk.PfnGetDeviceMemoryOpaqueCaptureAddress
+	CreatePrivateDataSlot                                  vk.PfnCreatePrivateDataSlot
+	DestroyPrivateDataSlot                                 vk.PfnDestroyPrivateDataSlot
+	SetPrivateData                                         vk.PfnSetPrivateData
+	GetPrivateData                                         vk.PfnGetPrivateData
+	CmdSetEvent2                                           vk.PfnCmdSetEvent2
+	CmdResetEvent2                                         vk.PfnCmdResetEvent2
+	CmdWaitEvents2                                         vk.PfnCmdWaitEvents2
+	CmdPipelineBarrier2                                    vk.PfnCmdPipelineBarrier2
+	CmdWriteTimestamp2                                     vk.PfnCmdWriteTimestamp2
+	QueueSubmit2                                           vk.PfnQueueSubmit2
+	CmdCopyBuffer2                                         vk.PfnCmdCopyBuffer2
+	CmdCopyImage2                                          vk.PfnCmdCopyImage2
+	CmdCopyBufferToImage2                                  vk.PfnCmdCopyBufferToImage2
+	CmdCopyImageToBuffer2                                  vk.PfnCmdCopyImageToBuffer2
+	CmdBlitImage2                                          vk.PfnCmdBlitImage2
+	CmdResolveImage2                                       vk.PfnCmdResolveImage2
+	CmdBeginRendering                                      vk.PfnCmdBeginRendering
+	CmdEndRendering                                        vk.PfnCmdEndRendering
+	CmdSetCullMode                                         vk.PfnCmdSetCullMode
+	CmdSetFrontFace                                        vk.PfnCmdSetFrontFace
+	CmdSetPrimitiveTopology                                vk.PfnCmdSetPrimitiveTopology
+	CmdSetViewportWithCount                                vk.PfnCmdSetViewportWithCount
+	CmdSetScissorWithCount                                 vk.PfnCmdSetScissorWithCount
+	CmdBindVertexBuffers2                                  vk.PfnCmdBindVertexBuffers2
+	CmdSetDepthTestEnable                                  vk.PfnCmdSetDepthTestEnable
+	CmdSetDepthWriteEnable                                 vk.PfnCmdSetDepthWriteEnable
+	CmdSetDepthCompareOp                                   vk.PfnCmdSetDepthCompareOp
+	CmdSetDepthBoundsTestEnable                            vk.PfnCmdSetDepthBoundsTestEnable
+	CmdSetStencilTestEnable                                vk.PfnCmdSetStencilTestEnable
+	CmdSetStencilOp                                        vk.PfnCmdSetStencilOp
+	CmdSetRasterizerDiscardEnable                          vk.PfnCmdSetRasterizerDiscardEnable
+	CmdSetDepthBiasEnable                                  vk.PfnCmdSetDepthBiasEnable
+	CmdSetPrimitiveRestartEnable                           vk.PfnCmdSetPrimitiveRestartEnable
+	GetDeviceBufferMemoryRequirements                      vk.PfnGetDeviceBufferMemoryRequirements
+	GetDeviceImageMemoryRequirements                       vk.PfnGetDeviceImageMemoryRequirements
+	GetDeviceImageSparseMemoryRequirements                 vk.PfnGetDeviceImageSparseMemoryRequirements
+	CreateSwapchainKHR                                     vk.PfnCreateSwapchainKHR
+	DestroySwapchainKHR                                    vk.PfnDestroySwapchainKHR
+	GetSwapchainImagesKHR                                  vk.PfnGetSwapchainImagesKHR
+	AcquireNextImageKHR                                    vk.PfnAcquireNextImageKHR
+	QueuePresentKHR                                        vk.PfnQueuePresentKHR
+	GetDeviceGroupPresentCapabilitiesKHR                   vk.PfnGetDeviceGroupPresentCapabilitiesKHR
+	GetDeviceGroupSurfacePresentModesKHR                   vk.PfnGetDeviceGroupSurfacePresentModesKHR
+	AcquireNextImage2KHR                                   vk.PfnAcquireNextImage2KHR
+	CreateSharedSwapchainsKHR                              vk.PfnCreateSharedSwapchainsKHR
+	CreateVideoSessionKHR                                  vk.PfnCreateVideoSessionKHR
+	DestroyVideoSessionKHR                                 vk.PfnDestroyVideoSessionKHR
+	GetVideoSessionMemoryRequirementsKHR                   vk.PfnGetVideoSessionMemoryRequirementsKHR
+	BindVideoSessionMemoryKHR                              vk.PfnBindVideoSessionMemoryKHR
+	CreateVideoSessionParametersKHR                        vk.PfnCreateVideoSessionParametersKHR
+	UpdateVideoSessionParametersKHR                        vk.PfnUpdateVideoSessionParametersKHR
+	DestroyVideoSessionParametersKHR                       vk.PfnDestroyVideoSessionParametersKHR
+	CmdBeginVideoCodingKHR                                 vk.PfnCmdBeginVideoCodingKHR
+	CmdEndVideoCodingKHR                                   vk.PfnCmdEndVideoCodingKHR
+	CmdControlVideoCodingKHR                               vk.PfnCmdControlVideoCodingKHR
+	CmdDecodeVideoKHR                                      vk.PfnCmdDecodeVideoKHR
+	CmdBeginRenderingKHR                                   vk.PfnCmdBeginRenderingKHR
+	CmdEndRenderingKHR                                     vk.PfnCmdEndRenderingKHR
+	GetDeviceGroupPeerMemoryFeaturesKHR                    vk.PfnGetDeviceGroupPeerMemoryFeaturesKHR
+	CmdSetDeviceMaskKHR                                    vk.PfnCmdSetDeviceMaskKHR
+	CmdDispatchBaseKHR                                     vk.PfnCmdDispatchBaseKHR
+	TrimCommandPoolKHR                                     vk.PfnTrimCommandPoolKHR
+	GetMemoryFdKHR                                         vk.PfnGetMemoryFdKHR
+	GetMemoryFdPropertiesKHR                               vk.PfnGetMemoryFdPropertiesKHR
+	ImportSemaphoreFdKHR                                   vk.PfnImportSemaphoreFdKHR
+	GetSemaphoreFdKHR                                      vk.PfnGetSemaphoreFdKHR
+	CmdPushDescriptorSetKHR                                vk.PfnCmdPushDescriptorSetKHR
+	CmdPushDescriptorSetWithTemplateKHR                    vk.PfnCmdPushDescriptorSetWithTemplateKHR
+	CreateDescriptorUpdateTemplateKHR                      vk.PfnCreateDescriptorUpdateTemplateKHR
+	DestroyDescriptorUpdateTemplateKHR                     vk.PfnDestroyDescriptorUpdateTemplateKHR
+	UpdateDescriptorSetWithTemplateKHR                     vk.PfnUpdateDescriptorSetWithTemplateKHR
+	CreateRenderPass2KHR                                   vk.PfnCreateRenderPass2KHR
+	CmdBeginRenderPass2KHR                                 vk.PfnCmdBeginRenderPass2KHR
+	CmdNextSubpass2KHR                                     vk.PfnCmdNextSubpass2KHR
+	CmdEndRenderPass2KHR                                   vk.PfnCmdEndRenderPass2KHR
+	GetSwapchainStatusKHR                                  vk.PfnGetSwapchainStatusKHR
+	ImportFenceFdKHR                                       vk.PfnImportFenceFdKHR
+	GetFenceFdKHR                                          vk.PfnGetFenceFdKHR
+	AcquireProfilingLockKHR                                vk.PfnAcquireProfilingLockKHR
+	ReleaseProfilingLockKHR                                vk.PfnReleaseProfilingLockKHR
+	GetImageMemoryRequirements2KHR                         vk.PfnGetImageMemoryRequirements2KHR
+	GetBufferMemoryRequirements2KHR                        vk.PfnGetBufferMemoryRequirements2KHR
+	GetImageSparseMemoryRequirements2KHR                   vk.PfnGetImageSparseMemoryRequirements2KHR
+	CreateAccelerationStructureKHR                         vk.PfnCreateAccelerationStructureKHR
+	DestroyAccelerationStructureKHR                        vk.PfnDestroyAccelerationStructureKHR
+	CmdBuildAccelerationStructuresKHR                      vk.PfnCmdBuildAccelerationStructuresKHR
+	CmdBuildAccelerationStructuresIndirectKHR              vk.PfnCmdBuildAccelerationStructuresIndirectKHR
+	BuildAccelerationStructuresKHR                         vk.PfnBuildAccelerationStructuresKHR
+	CopyAccelerationStructureKHR                           vk.PfnCopyAccelerationStructureKHR
+	CopyAccelerationStructureToMemoryKHR                   vk.PfnCopyAccelerationStructureToMemoryKHR
+	CopyMemoryToAccelerationStructureKHR                   vk.PfnCopyMemoryToAccelerationStructureKHR
+	WriteAccelerationStructuresPropertiesKHR               vk.PfnWriteAccelerationStructuresPropertiesKHR
+	CmdCopyAccelerationStructureKHR                        vk.PfnCmdCopyAccelerationStructureKHR
+	CmdCopyAccelerationStructureToMemoryKHR                vk.PfnCmdCopyAccelerationStructureToMemoryKHR
+	CmdCopyMemoryToAccelerationStructureKHR                vk.PfnCmdCopyMemoryToAccelerationStructureKHR
+	GetAccelerationStructureDeviceAddressKHR               vk.PfnGetAccelerationStructureDeviceAddressKHR
+	CmdWriteAccelerationStructuresPropertiesKHR            vk.PfnCmdWriteAccelerationStructuresPropertiesKHR
+	GetDeviceAccelerationStructureCompatibilityKHR         vk.PfnGetDeviceAccelerationStructureCompatibilityKHR
+	GetAccelerationStructureBuildSizesKHR                  vk.PfnGetAccelerationStructureBuildSizesKHR
+	CreateSamplerYcbcrConversionKHR                        vk.PfnCreateSamplerYcbcrConversionKHR
+	DestroySamplerYcbcrConversionKHR                       vk.PfnDestroySamplerYcbcrConversionKHR
+	BindBufferMemory2KHR                                   vk.PfnBindBufferMemory2KHR
+	BindImageMemory2KHR                                    vk.PfnBindImageMemory2KHR
+	GetDescriptorSetLayoutSupportKHR                       vk.PfnGetDescriptorSetLayoutSupportKHR
+	CmdDrawIndirectCountKHR                                vk.PfnCmdDrawIndirectCountKHR
+	CmdDrawIndexedIndirectCountKHR                         vk.PfnCmdDrawIndexedIndirectCountKHR
+	GetSemaphoreCounterValueKHR                            vk.PfnGetSemaphoreCounterValueKHR
+	WaitSemaphoresKHR                                      vk.PfnWaitSemaphoresKHR
+	SignalSemaphoreKHR                                     vk.PfnSignalSemaphoreKHR
+	CmdSetFragmentShadingRateKHR                           vk.PfnCmdSetFragmentShadingRateKHR
+	WaitForPresentKHR                                      vk.PfnWaitForPresentKHR
+	GetBufferDeviceAddressKHR                              vk.PfnGetBufferDeviceAddressKHR
+	GetBufferOpaqueCaptureAddressKHR                       vk.PfnGetBufferOpaqueCaptureAddressKHR
+	GetDeviceMemoryOpaqueCaptureAddressKHR                 vk.PfnGetDeviceMemoryOpaqueCaptureAddressKHR
+	CreateDeferredOperationKHR                             vk.PfnCreateDeferredOperationKHR
+	DestroyDeferredOperationKHR                            vk.PfnDestroyDeferredOperationKHR
+	GetDeferredOperationMaxConcurrencyKHR                  vk.PfnGetDeferredOperationMaxConcurrencyKHR
+	GetDeferredOperationResultKHR                          vk.PfnGetDeferredOperationResultKHR
+	DeferredOperationJoinKHR                               vk.PfnDeferredOperationJoinKHR
+	GetPipelineExecutablePropertiesKHR                     vk.PfnGetPipelineExecutablePropertiesKHR
+	GetPipelineExecutableStatisticsKHR                     vk.PfnGetPipelineExecutableStatisticsKHR
+	GetPipelineExecutableInternalRepresentationsKHR        vk.PfnGetPipelineExecutableInternalRepresentationsKHR
+	CmdSetEvent2KHR                                        vk.PfnCmdSetEvent2KHR
+	CmdResetEvent2KHR                                      vk.PfnCmdResetEvent2KHR
+	CmdWaitEvents2KHR                                      vk.PfnCmdWaitEvents2KHR
+	CmdPipelineBarrier2KHR                                 vk.PfnCmdPipelineBarrier2KHR
+	CmdWriteTimestamp2KHR                                  vk.PfnCmdWriteTimestamp2KHR
+	QueueSubmit2KHR                                        vk.PfnQueueSubmit2KHR
+	CmdWriteBufferMarker2AMD                               vk.PfnCmdWriteBufferMarker2AMD
+	GetQueueCheckpointData2NV                              vk.PfnGetQueueCheckpointData2NV
+	CmdCopyBuffer2KHR                                      vk.PfnCmdCopyBuffer2KHR
+	CmdCopyImage2KHR                                       vk.PfnCmdCopyImage2KHR
+	CmdCopyBufferToImage2KHR                               vk.PfnCmdCopyBufferToImage2KHR
+	CmdCopyImageToBuffer2KHR                               vk.PfnCmdCopyImageToBuffer2KHR
+	CmdBlitImage2KHR                                       vk.PfnCmdBlitImage2KHR
+	CmdResolveImage2KHR                                    vk.PfnCmdResolveImage2KHR
+	CmdTraceRaysKHR                                        vk.PfnCmdTraceRaysKHR
+	CreateRayTracingPipelinesKHR                           vk.PfnCreateRayTracingPipelinesKHR
+	GetRayTracingShaderGroupHandlesKHR                     vk.PfnGetRayTracingShaderGroupHandlesKHR
+	GetRayTracingCaptureReplayShaderGroupHandlesKHR        vk.PfnGetRayTracingCaptureReplayShaderGroupHandlesKHR
+	CmdTraceRaysIndirectKHR                                vk.PfnCmdTraceRaysIndirectKHR
+	GetRayTracingShaderGroupStackSizeKHR                   vk.PfnGetRayTracingShaderGroupStackSizeKHR
+	CmdSetRayTracingPipelineStackSizeKHR                   vk.PfnCmdSetRayTracingPipelineStackSizeKHR
+	CmdTraceRaysIndirect2KHR                               vk.PfnCmdTraceRaysIndirect2KHR
+	GetDeviceBufferMemoryRequirementsKHR                   vk.PfnGetDeviceBufferMemoryRequirementsKHR
+	GetDeviceImageMemoryRequirementsKHR                    vk.PfnGetDeviceImageMemoryRequirementsKHR
+	GetDeviceImageSparseMemoryRequirementsKHR              vk.PfnGetDeviceImageSparseMemoryRequirementsKHR
+	DebugMarkerSetObjectTagEXT                             vk.PfnDebugMarkerSetObjectTagEXT
+	DebugMarkerSetObjectNameEXT                            vk.PfnDebugMarkerSetObjectNameEXT
+	CmdDebugMarkerBeginEXT                                 vk.PfnCmdDebugMarkerBeginEXT
+	CmdDebugMarkerEndEXT                                   vk.PfnCmdDebugMarkerEndEXT
+	CmdDebugMarkerInsertEXT                                vk.PfnCmdDebugMarkerInsertEXT
+	CmdBindTransformFeedbackBuffersEXT                     vk.PfnCmdBindTransformFeedbackBuffersEXT
+	CmdBeginTransformFeedbackEXT                           vk.PfnCmdBeginTransformFeedbackEXT
+	CmdEndTransformFeedbackEXT                             vk.PfnCmdEndTransformFeedbackEXT
+	CmdBeginQueryIndexedEXT                                vk.PfnCmdBeginQueryIndexedEXT
+	CmdEndQueryIndexedEXT                                  vk.PfnCmdEndQueryIndexedEXT
+	CmdDrawIndirectByteCountEXT                            vk.PfnCmdDrawIndirectByteCountEXT
+	CreateCuModuleNVX                                      vk.PfnCreateCuModuleNVX
+	CreateCuFunctionNVX                                    vk.PfnCreateCuFunctionNVX
+	DestroyCuModuleNVX                                     vk.PfnDestroyCuModuleNVX
+	DestroyCuFunctionNVX                                   vk.PfnDestroyCuFunctionNVX
+	CmdCuLaunchKernelNVX                                   vk.PfnCmdCuLaunchKernelNVX
+	GetImageViewHandleNVX                                  vk.PfnGetImageViewHandleNVX
+	GetImageViewAddressNVX                                 vk.PfnGetImageViewAddressNVX
+	CmdDrawIndirectCountAMD                                vk.PfnCmdDrawIndirectCountAMD
+	CmdDrawIndexedIndirectCountAMD                         vk.PfnCmdDrawIndexedIndirectCountAMD
+	GetShaderInfoAMD                                       vk.PfnGetShaderInfoAMD
+	CmdBeginConditionalRenderingEXT                        vk.PfnCmdBeginConditionalRenderingEXT
+	CmdEndConditionalRenderingEXT                          vk.PfnCmdEndConditionalRenderingEXT
+	CmdSetViewportWScalingNV                               vk.PfnCmdSetViewportWScalingNV
+	DisplayPowerControlEXT                                 vk.PfnDisplayPowerControlEXT
+	RegisterDeviceEventEXT                                 vk.PfnRegisterDeviceEventEXT
+	RegisterDisplayEventEXT                                vk.PfnRegisterDisplayEventEXT
+	GetSwapchainCounterEXT                                 vk.PfnGetSwapchainCounterEXT
+	GetRefreshCycleDurationGOOGLE                          vk.PfnGetRefreshCycleDurationGOOGLE
+	GetPastPresentationTimingGOOGLE                        vk.PfnGetPastPresentationTimingGOOGLE
+	CmdSetDiscardRectangleEXT                              vk.PfnCmdSetDiscardRectangleEXT
+	SetHdrMetadataEXT                                      vk.PfnSetHdrMetadataEXT
+	SetDebugUtilsObjectNameEXT                             vk.PfnSetDebugUtilsObjectNameEXT
+	SetDebugUtilsObjectTagEXT                              vk.PfnSetDebugUtilsObjectTagEXT
+	QueueBeginDebugUtilsLabelEXT                           vk.PfnQueueBeginDebugUtilsLabelEXT
+	QueueEndDebugUtilsLabelEXT                             vk.PfnQueueEndDebugUtilsLabelEXT
+	QueueInsertDebugUtilsLabelEXT                          vk.PfnQueueInsertDebugUtilsLabelEXT
+	CmdBeginDebugUtilsLabelEXT                             vk.PfnCmdBeginDebugUtilsLabelEXT
+	CmdEndDebugUtilsLabelEXT                               vk.PfnCmdEndDebugUtilsLabelEXT
+	CmdInsertDebugUtilsLabelEXT                            vk.PfnCmdInsertDebugUtilsLabelEXT
+	CmdSetSampleLocationsEXT                               vk.PfnCmdSetSampleLocationsEXT
+	GetImageDrmFormatModifierPropertiesEXT                 vk.PfnGetImageDrmFormatModifierPropertiesEXT
+	CreateValidationCacheEXT                               vk.PfnCreateValidationCacheEXT
+	DestroyValidationCacheEXT                              vk.PfnDestroyValidationCacheEXT
+	MergeValidationCachesEXT                               vk.PfnMergeValidationCachesEXT
+	GetValidationCacheDataEXT                              vk.PfnGetValidationCacheDataEXT
+	CmdBindShadingRateImageNV                              vk.PfnCmdBindShadingRateImageNV
+	CmdSetViewportShadingRatePaletteNV                     vk.PfnCmdSetViewportShadingRatePaletteNV
+	CmdSetCoarseSampleOrderNV                              vk.PfnCmdSetCoarseSampleOrderNV
+	CreateAccelerationStructureNV                          vk.PfnCreateAccelerationStructureNV
+	DestroyAccelerationStructureNV                         vk.PfnDestroyAccelerationStructureNV
+	GetAccelerationStructureMemoryRequirementsNV           vk.PfnGetAccelerationStructureMemoryRequirementsNV
+	BindAccelerationStructureMemoryNV                      vk.PfnBindAccelerationStructureMemoryNV
+	CmdBuildAccelerationStructureNV                        vk.PfnCmdBuildAccelerationStructureNV
+	CmdCopyAccelerationStructureNV                         vk.PfnCmdCopyAccelerationStructureNV
+	CmdTraceRaysNV                                         vk.PfnCmdTraceRaysNV
+	CreateRayTracingPipelinesNV                            vk.PfnCreateRayTracingPipelinesNV
+	GetRayTracingShaderGroupHandlesNV                      vk.PfnGetRayTracingShaderGroupHandlesNV
+	GetAccelerationStructureHandleNV                       vk.PfnGetAccelerationStructureHandleNV
+	CmdWriteAccelerationStructuresPropertiesNV             vk.PfnCmdWriteAccelerationStructuresPropertiesNV
+	CompileDeferredNV                                      vk.PfnCompileDeferredNV
+	GetMemoryHostPointerPropertiesEXT                      vk.PfnGetMemoryHostPointerPropertiesEXT
+	CmdWriteBufferMarkerAMD                                vk.PfnCmdWriteBufferMarkerAMD
+	GetCalibratedTimestampsEXT                             vk.PfnGetCalibratedTimestampsEXT
+	CmdDrawMeshTasksNV                                     vk.PfnCmdDrawMeshTasksNV
+	CmdDrawMeshTasksIndirectNV                             vk.PfnCmdDrawMeshTasksIndirectNV
+	CmdDrawMeshTasksIndirectCountNV                        vk.PfnCmdDrawMeshTasksIndirectCountNV
+	CmdSetExclusiveScissorNV                               vk.PfnCmdSetExclusiveScissorNV
+	CmdSetCheckpointNV                                     vk.PfnCmdSetCheckpointNV
+	GetQueueCheckpointDataNV                               vk.PfnGetQueueCheckpointDataNV
+	InitializePerformanceApiINTEL                          vk.PfnInitializePerformanceApiINTEL
+	UninitializePerformanceApiINTEL                        vk.PfnUninitializePerformanceApiINTEL
+	CmdSetPerformanceMarkerINTEL                           vk.PfnCmdSetPerformanceMarkerINTEL
+	CmdSetPerformanceStreamMarkerINTEL                     vk.PfnCmdSetPerformanceStreamMarkerINTEL
+	CmdSetPerformanceOverrideINTEL                         vk.PfnCmdSetPerformanceOverrideINTEL
+	AcquirePerformanceConfigurationINTEL                   vk.PfnAcquirePerformanceConfigurationINTEL
+	ReleasePerformanceConfigurationINTEL                   vk.PfnReleasePerformanceConfigurationINTEL
+	QueueSetPerformanceConfigurationINTEL                  vk.PfnQueueSetPerformanceConfigurationINTEL
+	GetPerformanceParameterINTEL                           vk.PfnGetPerformanceParameterINTEL
+	SetLocalDimmingAMD                                     vk.PfnSetLocalDimmingAMD
+	GetBufferDeviceAddressEXT                              vk.PfnGetBufferDeviceAddressEXT
+	CmdSetLineStippleEXT                                   vk.PfnCmdSetLineStippleEXT
+	ResetQueryPoolEXT                                      vk.PfnResetQueryPoolEXT
+	CmdSetCullModeEXT                                      vk.PfnCmdSetCullModeEXT
+	CmdSetFrontFaceEXT                                     vk.PfnCmdSetFrontFaceEXT
+	CmdSetPrimitiveTopologyEXT                             vk.PfnCmdSetPrimitiveTopologyEXT
+	CmdSetViewportWithCountEXT                             vk.PfnCmdSetViewportWithCountEXT
+	CmdSetScissorWithCountEXT                              vk.PfnCmdSetScissorWithCountEXT
+	CmdBindVertexBuffers2EXT                               vk.PfnCmdBindVertexBuffers2EXT
+	CmdSetDepthTestEnableEXT                               vk.PfnCmdSetDepthTestEnableEXT
+	CmdSetDepthWriteEnableEXT                              vk.PfnCmdSetDepthWriteEnableEXT
+	CmdSetDepthCompareOpEXT                                vk.PfnCmdSetDepthCompareOpEXT
+	CmdSetDepthBoundsTestEnableEXT                         vk.PfnCmdSetDepthBoundsTestEnableEXT
+	CmdSetStencilTestEnableEXT                             vk.PfnCmdSetStencilTestEnableEXT
+	CmdSetStencilOpEXT                                     vk.PfnCmdSetStencilOpEXT
+	ReleaseSwapchainImagesEXT                              vk.PfnReleaseSwapchainImagesEXT
+	GetGeneratedCommandsMemoryRequirementsNV               vk.PfnGetGeneratedCommandsMemoryRequirementsNV
+	CmdPreprocessGeneratedCommandsNV                       vk.PfnCmdPreprocessGeneratedCommandsNV
+	CmdExecuteGeneratedCommandsNV                          vk.PfnCmdExecuteGeneratedCommandsNV
+	CmdBindPipelineShaderGroupNV                           vk.PfnCmdBindPipelineShaderGroupNV
+	CreateIndirectCommandsLayoutNV                         vk.PfnCreateIndirectCommandsLayoutNV
+	DestroyIndirectCommandsLayoutNV                        vk.PfnDestroyIndirectCommandsLayoutNV
+	CreatePrivateDataSlotEXT                               vk.PfnCreatePrivateDataSlotEXT
+	DestroyPrivateDataSlotEXT                              vk.PfnDestroyPrivateDataSlotEXT
+	SetPrivateDataEXT                                      vk.PfnSetPrivateDataEXT
+	GetPrivateDataEXT                                      vk.PfnGetPrivateDataEXT
+	GetDescriptorSetLayoutSizeEXT                          vk.PfnGetDescriptorSetLayoutSizeEXT
+	GetDescriptorSetLayoutBindingOffsetEXT                 vk.PfnGetDescriptorSetLayoutBindingOffsetEXT
+	GetDescriptorEXT                                       vk.PfnGetDescriptorEXT
+	CmdBindDescriptorBuffersEXT                            vk.PfnCmdBindDescriptorBuffersEXT
+	CmdSetDescriptorBufferOffsetsEXT                       vk.PfnCmdSetDescriptorBufferOffsetsEXT
+	CmdBindDescriptorBufferEmbeddedSamplersEXT             vk.PfnCmdBindDescriptorBufferEmbeddedSamplersEXT
+	GetBufferOpaqueCaptureDescriptorDataEXT                vk.PfnGetBufferOpaqueCaptureDescriptorDataEXT
+	GetImageOpaqueCaptureDescriptorDataEXT                 vk.PfnGetImageOpaqueCaptureDescriptorDataEXT
+	GetImageViewOpaqueCaptureDescriptorDataEXT             vk.PfnGetImageViewOpaqueCaptureDescriptorDataEXT
+	GetSamplerOpaqueCaptureDescriptorDataEXT               vk.PfnGetSamplerOpaqueCaptureDescriptorDataEXT
+	GetAccelerationStructureOpaqueCaptureDescriptorDataEXT vk.PfnGetAccelerationStructureOpaqueCaptureDescriptorDataEXT
+	CmdSetFragmentShadingRateEnumNV                        vk.PfnCmdSetFragmentShadingRateEnumNV
+	CmdDrawMeshTasksEXT                                    vk.PfnCmdDrawMeshTasksEXT
+	CmdDrawMeshTasksIndirectEXT                            vk.PfnCmdDrawMeshTasksIndirectEXT
+	CmdDrawMeshTasksIndirectCountEXT                       vk.PfnCmdDrawMeshTasksIndirectCountEXT
+	GetImageSubresourceLayout2EXT                          vk.PfnGetImageSubresourceLayout2EXT
+	GetDeviceFaultInfoEXT                                  vk.PfnGetDeviceFaultInfoEXT
+	CmdSetVertexInputEXT                                   vk.PfnCmdSetVertexInputEXT
+	GetDeviceSubpassShadingMaxWorkgroupSizeHUAWEI          vk.PfnGetDeviceSubpassShadingMaxWorkgroupSizeHUAWEI
+	CmdSubpassShadingHUAWEI                                vk.PfnCmdSubpassShadingHUAWEI
+	CmdBindInvocationMaskHUAWEI                            vk.PfnCmdBindInvocationMaskHUAWEI
+	GetMemoryRemoteAddressNV                               vk.PfnGetMemoryRemoteAddressNV
+	GetPipelinePropertiesEXT                               vk.PfnGetPipelinePropertiesEXT
+	CmdSetPatchControlPointsEXT                            vk.PfnCmdSetPatchControlPointsEXT
+	CmdSetRasterizerDiscardEnableEXT                       vk.PfnCmdSetRasterizerDiscardEnableEXT
+	CmdSetDepthBiasEnableEXT                               vk.PfnCmdSetDepthBiasEnableEXT
+	CmdSetLogicOpEXT                                       vk.PfnCmdSetLogicOpEXT
+	CmdSetPrimitiveRestartEnableEXT                        vk.PfnCmdSetPrimitiveRestartEnableEXT
+	CmdSetColorWriteEnableEXT                              vk.PfnCmdSetColorWriteEnableEXT
+	CmdDrawMultiEXT                                        vk.PfnCmdDrawMultiEXT
+	CmdDrawMultiIndexedEXT                                 vk.PfnCmdDrawMultiIndexedEXT
+	CreateMicromapEXT                                      vk.PfnCreateMicromapEXT
+	DestroyMicromapEXT                                     vk.PfnDestroyMicromapEXT
+	CmdBuildMicromapsEXT                                   vk.PfnCmdBuildMicromapsEXT
+	BuildMicromapsEXT                                      vk.PfnBuildMicromapsEXT
+	CopyMicromapEXT                                        vk.PfnCopyMicromapEXT
+	CopyMicromapToMemoryEXT                                vk.PfnCopyMicromapToMemoryEXT
+	CopyMemoryToMicromapEXT                                vk.PfnCopyMemoryToMicromapEXT
+	WriteMicromapsPropertiesEXT                            vk.PfnWriteMicromapsPropertiesEXT
+	CmdCopyMicromapEXT                                     vk.PfnCmdCopyMicromapEXT
+	CmdCopyMicromapToMemoryEXT                             vk.PfnCmdCopyMicromapToMemoryEXT
+	CmdCopyMemoryToMicromapEXT                             vk.PfnCmdCopyMemoryToMicromapEXT
+	CmdWriteMicromapsPropertiesEXT                         vk.PfnCmdWriteMicromapsPropertiesEXT
+	GetDeviceMicromapCompatibilityEXT                      vk.PfnGetDeviceMicromapCompatibilityEXT
+	GetMicromapBuildSizesEXT                               vk.PfnGetMicromapBuildSizesEXT
+	CmdDrawClusterHUAWEI                                   vk.PfnCmdDrawClusterHUAWEI
+	CmdDrawClusterIndirectHUAWEI                           vk.PfnCmdDrawClusterIndirectHUAWEI
+	SetDeviceMemoryPriorityEXT                             vk.PfnSetDeviceMemoryPriorityEXT
+	GetDescriptorSetLayoutHostMappingInfoVALVE             vk.PfnGetDescriptorSetLayoutHostMappingInfoVALVE
+	GetDescriptorSetHostMappingVALVE                       vk.PfnGetDescriptorSetHostMappingVALVE
+	CmdCopyMemoryIndirectNV                                vk.PfnCmdCopyMemoryIndirectNV
+	CmdCopyMemoryToImageIndirectNV                         vk.PfnCmdCopyMemoryToImageIndirectNV
+	CmdDecompressMemoryNV                                  vk.PfnCmdDecompressMemoryNV
+	CmdDecompressMemoryIndirectCountNV                     vk.PfnCmdDecompressMemoryIndirectCountNV
+	CmdSetTessellationDomainOriginEXT                      vk.PfnCmdSetTessellationDomainOriginEXT
+	CmdSetDepthClampEnableEXT                              vk.PfnCmdSetDepthClampEnableEXT
+	CmdSetPolygonModeEXT                                   vk.PfnCmdSetPolygonModeEXT
+	CmdSetRasterizationSamplesEXT                          vk.PfnCmdSetRasterizationSamplesEXT
+	CmdSetSampleMaskEXT                                    vk.PfnCmdSetSampleMaskEXT
+	CmdSetAlphaToCoverageEnableEXT                         vk.PfnCmdSetAlphaToCoverageEnableEXT
+	CmdSetAlphaToOneEnableEXT                              vk.PfnCmdSetAlphaToOneEnableEXT
+	CmdSetLogicOpEnableEXT                                 vk.PfnCmdSetLogicOpEnableEXT
+	CmdSetColorBlendEnableEXT                              vk.PfnCmdSetColorBlendEnableEXT
+	CmdSetColorBlendEquationEXT                            vk.PfnCmdSetColorBlendEquationEXT
+	CmdSetColorWriteMaskEXT                                vk.PfnCmdSetColorWriteMaskEXT
+	CmdSetRasterizationStreamEXT                           vk.PfnCmdSetRasterizationStreamEXT
+	CmdSetConservativeRasterizationModeEXT                 vk.PfnCmdSetConservativeRasterizationModeEXT
+	CmdSetExtraPrimitiveOverestimationSizeEXT              vk.PfnCmdSetExtraPrimitiveOverestimationSizeEXT
+	CmdSetDepthClipEnableEXT                               vk.PfnCmdSetDepthClipEnableEXT
+	CmdSetSampleLocationsEnableEXT                         vk.PfnCmdSetSampleLocationsEnableEXT
+	CmdSetColorBlendAdvancedEXT                            vk.PfnCmdSetColorBlendAdvancedEXT
+	CmdSetProvokingVertexModeEXT                           vk.PfnCmdSetProvokingVertexModeEXT
+	CmdSetLineRasterizationModeEXT                         vk.PfnCmdSetLineRasterizationModeEXT
+	CmdSetLineStippleEnableEXT                             vk.PfnCmdSetLineStippleEnableEXT
+	CmdSetDepthClipNegativeOneToOneEXT                     vk.PfnCmdSetDepthClipNegativeOneToOneEXT
+	CmdSetViewportWScalingEnableNV                         vk.PfnCmdSetViewportWScalingEnableNV
+	CmdSetViewportSwizzleNV                                vk.PfnCmdSetViewportSwizzleNV
+	CmdSetCoverageToColorEnableNV                          vk.PfnCmdSetCoverageToColorEnableNV
+	CmdSetCoverageToColorLocationNV                        vk.PfnCmdSetCoverageToColorLocationNV
+	CmdSetCoverageModulationModeNV                         vk.PfnCmdSetCoverageModulationModeNV
+	CmdSetCoverageModulationTableEnableNV                  vk.PfnCmdSetCoverageModulationTableEnableNV
+	CmdSetCoverageModulationTableNV                        vk.PfnCmdSetCoverageModulationTableNV
+	CmdSetShadingRateImageEnableNV                         vk.PfnCmdSetShadingRateImageEnableNV
+	CmdSetRepresentativeFragmentTestEnableNV               vk.PfnCmdSetRepresentativeFragmentTestEnableNV
+	CmdSetCoverageReductionModeNV                          vk.PfnCmdSetCoverageReductionModeNV
+	GetShaderModuleIdentifierEXT                           vk.PfnGetShaderModuleIdentifierEXT
+	GetShaderModuleCreateInfoIdentifierEXT                 vk.PfnGetShaderModuleCreateInfoIdentifierEXT
+	CreateOpticalFlowSessionNV                             vk.PfnCreateOpticalFlowSessionNV
+	DestroyOpticalFlowSessionNV                            vk.PfnDestroyOpticalFlowSessionNV
+	BindOpticalFlowSessionImageNV                          vk.PfnBindOpticalFlowSessionImageNV
+	CmdOpticalFlowExecuteNV                                vk.PfnCmdOpticalFlowExecuteNV
+	GetFramebufferTilePropertiesQCOM                       vk.PfnGetFramebufferTilePropertiesQCOM
+	GetDynamicRenderingTilePropertiesQCOM                  vk.PfnGetDynamicRenderingTilePropertiesQCOM
+}
+
+func (t *DeviceTable) load(proc func(name string) vk.PfnVoidFunction) {
+	t.DestroyDevice = vk.PfnDestroyDevice(proc(t.DestroyDevice.String()))
+	t.GetDeviceQueue = vk.PfnGetDeviceQueue(proc(t.GetDeviceQueue.String()))
+	t.QueueSubmit = vk.PfnQueueSubmit(proc(t.QueueSubmit.String()))
+	t.QueueWaitIdle = vk.PfnQueueWaitIdle(proc(t.QueueWaitIdle.String()))
+	t.DeviceWaitIdle = vk.PfnDeviceWaitIdle(proc(t.DeviceWaitIdle.String()))
+	t.AllocateMemory = vk.PfnAllocateMemory(proc(t.AllocateMemory.String()))
+	t.FreeMemory = vk.PfnFreeMemory(proc(t.FreeMemory.String()))
+	t.MapMemory = vk.PfnMapMemory(proc(t.MapMemory.String()))
+	t.UnmapMemory = vk.PfnUnmapMemory(proc(t.UnmapMemory.String()))
+	t.FlushMappedMemoryRanges = vk.PfnFlushMappedMemoryRanges(proc(t.FlushMappedMemoryRanges.String()))
+	t.InvalidateMappedMemoryRanges = vk.PfnInvalidateMappedMemoryRanges(proc(t.InvalidateMappedMemoryRanges.String()))
+	t.GetDeviceMemoryCommitment = vk.PfnGetDeviceMemoryCommitment(proc(t.GetDeviceMemoryCommitment.String()))
+	t.BindBufferMemory = vk.PfnBindBufferMemory(proc(t.BindBufferMemory.String()))
+	t.BindImageMemory = vk.PfnBindImageMemory(proc(t.BindImageMemory.String()))
+	t.GetBufferMemoryRequirements = vk.PfnGetBufferMemoryRequirements(proc(t.GetBufferMemoryRequirements.String()))
+	t.GetImageMemoryRequirements = vk.PfnGetImageMemoryRequirements(proc(t.GetImageMemoryRequirements.String()))
+	t.GetImageSparseMemoryRequirements = vk.PfnGetImageSparseMemoryRequirements(proc(t.GetImageSparseMemoryRequirements.String()))
+	t.QueueBindSparse = vk.PfnQueueBindSparse(proc(t.QueueBindSparse.String()))
+	t.CreateFence = vk.PfnCreateFence(proc(t.CreateFence.String()))
+	t.DestroyFence = vk.PfnDestroyFence(proc(t.DestroyFence.String()))
+	t.ResetFences = vk.PfnResetFences(proc(t.ResetFences.String()))
+	t.GetFenceStatus = vk.PfnGetFenceStatus(proc(t.GetFenceStatus.String()))
+	t.WaitForFences = vk.PfnWaitForFences(proc(t.WaitForFences.String()))
+	t.CreateSemaphore = vk.PfnCreateSemaphore(proc(t.CreateSemaphore.String()))
+	t.DestroySemaphore = vk.PfnDestroySemaphore(proc(t.DestroySemaphore.String()))
+	t.CreateEvent = vk.PfnCreateEvent(proc(t.CreateEvent.String()))
+	t.DestroyEvent = vk.PfnDestroyEvent(proc(t.DestroyEvent.String()))
+	t.GetEventStatus = vk.PfnGetEventStatus(proc(t.GetEventStatus.String()))
+	t.SetEvent = vk.PfnSetEvent(proc(t.SetEvent.String()))
+	t.ResetEvent = vk.PfnResetEvent(proc(t.ResetEvent.String()))
+	t.CreateQueryPool = vk.PfnCreateQueryPool(proc(t.CreateQueryPool.String()))
+	t.DestroyQueryPool = vk.PfnDestroyQueryPool(proc(t.DestroyQueryPool.String()))
+	t.GetQueryPoolResults = vk.PfnGetQueryPoolResults(proc(t.GetQueryPoolResults.String()))
+	t.CreateBuffer = vk.PfnCreateBuffer(proc(t.CreateBuffer.String()))
+	t.DestroyBuffer = vk.PfnDestroyBuffer(proc(t.DestroyBuffer.String()))
+	t.CreateBufferView = vk.PfnCreateBufferView(proc(t.CreateBufferView.String()))
+	t.DestroyBufferView = vk.PfnDestroyBufferView(proc(t.DestroyBufferView.String()))
+	t.CreateImage = vk.PfnCreateImage(proc(t.CreateImage.String()))
+	t.DestroyImage = vk.PfnDestroyImage(proc(t.DestroyImage.String()))
+	t.GetImageSubresourceLayout = vk.PfnGetImageSubresourceLayout(proc(t.GetImageSubresourceLayout.String()))
+	t.CreateImageView = vk.PfnCreateImageView(proc(t.CreateImageView.String()))
+	t.DestroyImageView = vk.PfnDestroyImageView(proc(t.DestroyImageView.String()))
+	t.CreateShaderModule = vk.PfnCreateShaderModule(proc(t.CreateShaderModule.String()))
+	t.DestroyShaderModule = vk.PfnDestroyShaderModule(proc(t.DestroyShaderModule.String()))
+	t.CreatePipelineCache = vk.PfnCreatePipelineCache(proc(t.CreatePipelineCache.String()))
+	t.DestroyPipelineCache = vk.PfnDestroyPipelineCache(proc(t.DestroyPipelineCache.String()))
+	t.GetPipelineCacheData = vk.PfnGetPipelineCacheData(proc(t.GetPipelineCacheData.String()))
+	t.MergePipelineCaches = vk.PfnMergePipelineCaches(proc(t.MergePipelineCaches.String()))
+	t.CreateGraphicsPipelines = vk.PfnCreateGraphicsPipelines(proc(t.CreateGraphicsPipelines.String()))
+	t.CreateComputePipelines = vk.PfnCreateComputePipelines(proc(t.CreateComputePipelines.String()))
+	t.DestroyPipeline = vk.PfnDestroyPipeline(proc(t.DestroyPipeline.String()))
+	t.CreatePipelineLayout = vk.PfnCreatePipelineLayout(proc(t.CreatePipelineLayout.String()))
+	t.DestroyPipelineLayout = vk.PfnDestroyPipelineLayout(proc(t.DestroyPipelineLayout.String()))
+	t.CreateSampler = vk.PfnCreateSampler(proc(t.CreateSampler.String()))
+	t.DestroySampler = vk.PfnDestroySampler(proc(t.DestroySampler.String()))
+	t.CreateDescriptorSetLayout = vk.PfnCreateDescriptorSetLayout(proc(t.CreateDescriptorSetLayout.String()))
+	t.DestroyDescriptorSetLayout = vk.PfnDestroyDescriptorSetLayout(proc(t.DestroyDescriptorSetLayout.String()))
+	t.CreateDescriptorPool = vk.PfnCreateDescriptorPool(proc(t.CreateDescriptorPool.String()))
+	t.DestroyDescriptorPool = vk.PfnDestroyDescriptorPool(proc(t.DestroyDescriptorPool.String()))
+	t.ResetDescriptorPool = vk.PfnResetDescriptorPool(proc(t.ResetDescriptorPool.String()))
+	t.AllocateDescriptorSets = vk.PfnAllocateDescriptorSets(proc(t.AllocateDescriptorSets.String()))
+	t.FreeDescriptorSets = vk.PfnFreeDescriptorSets(proc(t.FreeDescriptorSets.String()))
+	t.UpdateDescriptorSets = vk.PfnUpdateDescriptorSets(proc(t.UpdateDescriptorSets.String()))
+	t.CreateFramebuffer = vk.PfnCreateFramebuffer(proc(t.CreateFramebuffer.String()))
+	t.DestroyFramebuffer = vk.PfnDestroyFramebuffer(proc(t.DestroyFramebuffer.String()))
+	t.CreateRenderPass = vk.PfnCreateRenderPass(proc(t.CreateRenderPass.String()))
+	t.DestroyRenderPass = vk.PfnDestroyRenderPass(proc(t.DestroyRenderPass.String()))
+	t.GetRenderAreaGranularity = vk.PfnGetRenderAreaGranularity(proc(t.GetRenderAreaGranularity.String()))
+	t.CreateCommandPool = vk.PfnCreateCommandPool(proc(t.CreateCommandPool.String()))
+	t.DestroyCommandPool = vk.PfnDestroyCommandPool(proc(t.DestroyCommandPool.String()))
+	t.ResetCommandPool = vk.PfnResetCommandPool(proc(t.ResetCommandPool.String()))
+	t.AllocateCommandBuffers = vk.PfnAllocateCommandBuffers(proc(t.AllocateCommandBuffers.String()))
+	t.FreeCommandBuffers = vk.PfnFreeCommandBuffers(proc(t.FreeCommandBuffers.String()))
+	t.BeginCommandBuffer = vk.PfnBeginCommandBuffer(proc(t.BeginCommandBuffer.String()))
+	t.EndCommandBuffer = vk.PfnEndCommandBuffer(proc(t.EndCommandBuffer.String()))
+	t.ResetCommandBuffer = vk.PfnResetCommandBuffer(proc(t.ResetCommandBuffer.String()))
+	t.CmdBindPipeline = vk.PfnCmdBindPipeline(proc(t.CmdBindPipeline.String()))
+	t.CmdSetViewport = vk.PfnCmdSetViewport(proc(t.CmdSetViewport.String()))
+	t.CmdSetScissor = vk.PfnCmdSetScissor(proc(t.CmdSetScissor.String()))
+	t.CmdSetLineWidth = vk.PfnCmdSetLineWidth(proc(t.CmdSetLineWidth.String()))
+	t.CmdSetDepthBias = vk.PfnCmdSetDepthBias(proc(t.CmdSetDepthBias.String()))
+	t.CmdSetBlendConstants = vk.PfnCmdSetBlendConstants(proc(t.CmdSetBlendConstants.String()))
+	t.CmdSetDepthBounds = vk.PfnCmdSetDepthBounds(proc(t.CmdSetDepthBounds.String()))
+	t.CmdSetStencilCompareMask = vk.PfnCmdSetStencilCompareMask(proc(t.CmdSetStencilCompareMask.String()))
+	t.CmdSetStencilWriteMask = vk.PfnCmdSetStencilWriteMask(proc(t.CmdSetStencilWriteMask.String()))
+	t.CmdSetStencilReference = vk.PfnCmdSetStencilReference(proc(t.CmdSetStencilReference.String()))
+	t.CmdBindDescriptorSets = vk.PfnCmdBindDescriptorSets(proc(t.CmdBindDescriptorSets.String()))
+	t.CmdBindIndexBuffer = vk.PfnCmdBindIndexBuffer(proc(t.CmdBindIndexBuffer.String()))
+	t.CmdBindVertexBuffers = vk.PfnCmdBindVertexBuffers(proc(t.CmdBindVertexBuffers.String()))
+	t.CmdDraw = vk.PfnCmdDraw(proc(t.CmdDraw.String()))
+	t.CmdDrawIndexed = vk.PfnCmdDrawIndexed(proc(t.CmdDrawIndexed.String()))
+	t.CmdDrawIndirect = vk.PfnCmdDrawIndirect(proc(t.CmdDrawIndirect.String()))
+	t.CmdDrawIndexedIndirect = vk.PfnCmdDrawIndexedIndirect(proc(t.CmdDrawIndexedIndirect.String()))
+	t.CmdDispatch = vk.PfnCmdDispatch(proc(t.CmdDispatch.String()))
+	t.CmdDispatchIndirect = vk.PfnCmdDispatchIndirect(proc(t.CmdDispatchIndirect.String()))
+	t.CmdCopyBuffer = vk.PfnCmdCopyBuffer(proc(t.CmdCopyBuffer.String()))
+	t.CmdCopyImage = vk.PfnCmdCopyImage(proc(t.CmdCopyImage.String()))
+	t.CmdBlitImage = vk.PfnCmdBlitImage(proc(t.CmdBlitImage.String()))
+	t.CmdCopyBufferToImage = vk.PfnCmdCopyBufferToImage(proc(t.CmdCopyBufferToImage.String()))
+	t.CmdCopyImageToBuffer = vk.PfnCmdCopyImageToBuffer(proc(t.CmdCopyImageToBuffer.String()))
+	t.CmdUpdateBuffer = vk.PfnCmdUpdateBuffer(proc(t.CmdUpdateBuffer.String()))
+	t.CmdFillBuffer = vk.PfnCmdFillBuffer(proc(t.CmdFillBuffer.String()))
+	t.CmdClearColorImage = vk.PfnCmdClearColorImage(proc(t.CmdClearColorImage.String()))
+	t.CmdClearDepthStencilImage = vk.PfnCmdClearDepthStencilImage(proc(t.CmdClearDepthStencilImage.String()))
+	t.CmdClearAttachments = vk.PfnCmdClearAttachments(proc(t.CmdClearAttachments.String()))
+	t.CmdResolveImage = vk.PfnCmdResolveImage(proc(t.CmdResolveImage.String()))
+	t.CmdSetEvent = vk.PfnCmdSetEvent(proc(t.CmdSetEvent.String()))
+	t.CmdResetEvent = vk.PfnCmdResetEvent(proc(t.CmdResetEvent.String()))
+	t.CmdWaitEvents = vk.PfnCmdWaitEvents(proc(t.CmdWaitEvents.String()))
+	t.CmdPipelineBarrier = vk.PfnCmdPipelineBarrier(proc(t.CmdPipelineBarrier.String()))
+	t.CmdBeginQuery = vk.PfnCmdBeginQuery(proc(t.CmdBeginQuery.String()))
+	t.CmdEndQuery = vk.PfnCmdEndQuery(proc(t.CmdEndQuery.String()))
+	t.CmdResetQueryPool = vk.PfnCmdResetQueryPool(proc(t.CmdResetQueryPool.String()))
+	t.CmdWriteTimestamp = vk.PfnCmdWriteTimestamp(proc(t.CmdWriteTimestamp.String()))
+	t.CmdCopyQueryPoolResults = vk.PfnCmdCopyQueryPoolResults(proc(t.CmdCopyQueryPoolResults.String()))
+	t.CmdPushConstants = vk.PfnCmdPushConstants(proc(t.CmdPushConstants.String()))
+	t.CmdBeginRenderPass = vk.PfnCmdBeginRenderPass(proc(t.CmdBeginRenderPass.String()))
+	t.CmdNextSubpass = vk.PfnCmdNextSubpass(proc(t.CmdNextSubpass.String()))
+	t.CmdEndRenderPass = vk.PfnCmdEndRenderPass(proc(t.CmdEndRenderPass.String()))
+	t.CmdExecuteCommands = vk.PfnCmdExecuteCommands(proc(t.CmdExecuteCommands.String()))
+	t.BindBufferMemory2 = vk.PfnBindBufferMemory2(proc(t.BindBufferMemory2.String()))
+	t.BindImageMemory2 = vk.PfnBindImageMemory2(proc(t.BindImageMemory2.String()))
+	t.GetDeviceGroupPeerMemoryFeatures = vk.PfnGetDeviceGroupPeerMemoryFeatures(proc(t.GetDeviceGroupPeerMemoryFeatures.String()))
+	t.CmdSetDeviceMask = vk.PfnCmdSetDeviceMask(proc(t.CmdSetDeviceMask.String()))
+	t.CmdDispatchBase = vk.PfnCmdDispatchBase(proc(t.CmdDispatchBase.String()))
+	t.GetImageMemoryRequirements2 = vk.PfnGetImageMemoryRequirements2(proc(t.GetImageMemoryRequirements2.String()))
+	t.GetBufferMemoryRequirements2 = vk.PfnGetBufferMemoryRequirements2(proc(t.GetBufferMemoryRequirements2.String()))
+	t.GetImageSparseMemoryRequirements2 = vk.PfnGetImageSparseMemoryRequirements2(proc(t.GetImageSparseMemoryRequirements2.String()))
+	t.TrimCommandPool = vk.PfnTrimCommandPool(proc(t.TrimCommandPool.String()))
+	t.GetDeviceQueue2 = vk.PfnGetDeviceQueue2(proc(t.GetDeviceQueue2.String()))
+	t.CreateSamplerYcbcrConversion = vk.PfnCreateSamplerYcbcrConversion(proc(t.CreateSamplerYcbcrConversion.String()))
+	t.DestroySamplerYcbcrConversion = vk.PfnDestroySamplerYcbcrConversion(proc(t.DestroySamplerYcbcrConversion.String()))
+	t.CreateDescriptorUpdateTemplate = vk.PfnCreateDescriptorUpdateTemplate(proc(t.CreateDescriptorUpdateTemplate.String()))
+	t.DestroyDescriptorUpdateTemplate = vk.PfnDestroyDescriptorUpdateTemplate(proc(t.DestroyDescriptorUpdateTemplate.String()))
+	t.UpdateDescriptorSetWithTemplate = vk.PfnUpdateDescriptorSetWithTemplate(proc(t.UpdateDescriptorSetWithTemplate.String()))
+	t.GetDescriptorSetLayoutSupport = vk.PfnGetDescriptorSetLayoutSupport(proc(t.GetDescriptorSetLayoutSupport.String()))
+	t.CmdDrawIndirectCount = vk.PfnCmdDrawIndirectCount(proc(t.CmdDrawIndirectCount.String()))
+	t.CmdDrawIndexedIndirectCount = vk.PfnCmdDrawIndexedIndirectCount(proc(t.CmdDrawIndexedIndirectCount.String()))
+	t.CreateRenderPass2 = vk.PfnCreateRenderPass2(proc(t.CreateRenderPass2.String()))
+	t.CmdBeginRenderPass2 = vk.PfnCmdBeginRenderPass2(proc(t.CmdBeginRenderPass2.String()))
+	t.CmdNextSubpass2 = vk.PfnCmdNextSubpass2(proc(t.CmdNextSubpass2.String()))
+	t.CmdEndRenderPass2 = vk.PfnCmdEndRenderPass2(proc(t.CmdEndRenderPass2.String()))
+	t.ResetQueryPool = vk.PfnResetQueryPool(proc(t.ResetQueryPool.String()))
+	t.GetSemaphoreCounterValue = vk.PfnGetSemaphoreCounterValue(proc(t.GetSemaphoreCounterValue.String()))
+	t.WaitSemaphores = vk.PfnWaitSemaphores(proc(t.WaitSemaphores.String()))
+	t.SignalSemaphore = vk.PfnSignalSemaphore(proc(t.SignalSemaphore.String()))
+	t.GetBufferDeviceAddress = vk.PfnGetBufferDeviceAddress(proc(t.GetBufferDeviceAddress.String()))
+	t.GetBufferOpaqueCaptureAddress = vk.PfnGetBufferOpaqueCaptureAddress(proc(t.GetBufferOpaqueCaptureAddress.String()))
+	t.GetDeviceMemoryOpaqueCaptureAddress = vk.PfnGetDeviceMemoryOpaqueCaptureAddress(proc(t.GetDeviceMemoryOpaqueCaptureAddress.String()))
+	t.CreatePrivateDataSlot = vk.PfnCreatePrivateDataSlot(proc(t.CreatePrivateDataSlot.String()))
+	t.DestroyPrivateDataSlot = vk.PfnDestroyPrivateDataSlot(proc(t.DestroyPrivateDataSlot.String()))
+	t.SetPrivateData = vk.PfnSetPrivateData(proc(t.SetPrivateData.String()))
+	t.GetPrivateData = vk.PfnGetPrivateData(proc(t.GetPrivateData.String()))
+	t.CmdSetEvent2 = vk.PfnCmdSetEvent2(proc(t.CmdSetEvent2.String()))
+	t.CmdResetEvent2 = vk.PfnCmdResetEvent2(proc(t.CmdResetEvent2.String()))
+	t.CmdWaitEvents2 = vk.PfnCmdWaitEvents2(proc(t.CmdWaitEvents2.String()))
+	t.CmdPipelineBarrier2 = vk.PfnCmdPipelineBarrier2(proc(t.CmdPipelineBarrier2.String()))
+	t.CmdWriteTimestamp2 = vk.PfnCmdWriteTimestamp2(proc(t.CmdWriteTimestamp2.String()))
+	t.QueueSubmit2 = vk.PfnQueueSubmit2(proc(t.QueueSubmit2.String()))
+	t.CmdCopyBuffer2 = vk.PfnCmdCopyBuffer2(proc(t.CmdCopyBuffer2.String()))
+	t.CmdCopyImage2 = vk.PfnCmdCopyImage2(proc(t.CmdCopyImage2.String()))
+	t.CmdCopyBufferToImage2 = vk.PfnCmdCopyBufferToImage2(proc(t.CmdCopyBufferToImage2.String()))
+	t.CmdCopyImageToBuffer2 = vk.PfnCmdCopyImageToBuffer2(proc(t.CmdCopyImageToBuffer2.String()))
+	t.CmdBlitImage2 = vk.PfnCmdBlitImage2(proc(t.CmdBlitImage2.String()))
+	t.CmdResolveImage2 = vk.PfnCmdResolveImage2(proc(t.CmdResolveImage2.String()))
+	t.CmdBeginRendering = vk.PfnCmdBeginRendering(proc(t.CmdBeginRendering.String()))
+	t.CmdEndRendering = vk.PfnCmdEndRendering(proc(t.CmdEndRendering.String()))
+	t.CmdSetCullMode = vk.PfnCmdSetCullMode(proc(t.CmdSetCullMode.String()))
+	t.CmdSetFrontFace = vk.PfnCmdSetFrontFace(proc(t.CmdSetFrontFace.String()))
+	t.CmdSetPrimitiveTopology = vk.PfnCmdSetPrimitiveTopology(proc(t.CmdSetPrimitiveTopology.String()))
+	t.CmdSetViewportWithCount = vk.PfnCmdSetViewportWithCount(proc(t.CmdSetViewportWithCount.String()))
+	t.CmdSetScissorWithCount = vk.PfnCmdSetScissorWithCount(proc(t.CmdSetScissorWithCount.String()))
+	t.CmdBindVertexBuffers2 = vk.PfnCmdBindVertexBuffers2(proc(t.CmdBindVertexBuffers2.String()))
+	t.CmdSetDepthTestEnable = vk.PfnCmdSetDepthTestEnable(proc(t.CmdSetDepthTestEnable.String()))
+	t.CmdSetDepthWriteEnable = vk.PfnCmdSetDepthWriteEnable(proc(t.CmdSetDepthWriteEnable.String()))
+	t.CmdSetDepthCompareOp = vk.PfnCmdSetDepthCompareOp(proc(t.CmdSetDepthCompareOp.String()))
+	t.CmdSetDepthBoundsTestEnable = vk.PfnCmdSetDepthBoundsTestEnable(proc(t.CmdSetDepthBoundsTestEnable.String()))
+	t.CmdSetStencilTestEnable = vk.PfnCmdSetStencilTestEnable(proc(t.CmdSetStencilTestEnable.String()))
+	t.CmdSetStencilOp = vk.PfnCmdSetStencilOp(proc(t.CmdSetStencilOp.String()))
+	t.CmdSetRasterizerDiscardEnable = vk.PfnCmdSetRasterizerDiscardEnable(proc(t.CmdSetRasterizerDiscardEnable.String()))
+	t.CmdSetDepthBiasEnable = vk.PfnCmdSetDepthBiasEnable(proc(t.CmdSetDepthBiasEnable.String()))
+	t.CmdSetPrimitiveRestartEnable = vk.PfnCmdSetPrimitiveRestartEnable(proc(t.CmdSetPrimitiveRestartEnable.String()))
+	t.GetDeviceBufferMemoryRequirements = vk.PfnGetDeviceBufferMemoryRequirements(proc(t.GetDeviceBufferMemoryRequirements.String()))
+	t.GetDeviceImageMemoryRequirements = vk.PfnGetDeviceImageMemoryRequirements(proc(t.GetDeviceImageMemoryRequirements.String()))
+	t.GetDeviceImageSparseMemoryRequirements = vk.PfnGetDeviceImageSparseMemoryRequirements(proc(t.GetDeviceImageSparseMemoryRequirements.String()))
+	t.CreateSwapchainKHR = vk.PfnCreateSwapchainKHR(proc(t.CreateSwapchainKHR.String()))
+	t.DestroySwapchainKHR = vk.PfnDestroySwapchainKHR(proc(t.DestroySwapchainKHR.String()))
+	t.GetSwapchainImagesKHR = vk.PfnGetSwapchainImagesKHR(proc(t.GetSwapchainImagesKHR.String()))
+	t.AcquireNextImageKHR = vk.PfnAcquireNextImageKHR(proc(t.AcquireNextImageKHR.String()))
+	t.QueuePresentKHR = vk.PfnQueuePresentKHR(proc(t.QueuePresentKHR.String()))
+	t.GetDeviceGroupPresentCapabilitiesKHR = vk.PfnGetDeviceGroupPresentCapabilitiesKHR(proc(t.GetDeviceGroupPresentCapabilitiesKHR.String()))
+	t.GetDeviceGroupSurfacePresentModesKHR = vk.PfnGetDeviceGroupSurfacePresentModesKHR(proc(t.GetDeviceGroupSurfacePresentModesKHR.String()))
+	t.AcquireNextImage2KHR = vk.PfnAcquireNextImage2KHR(proc(t.AcquireNextImage2KHR.String()))
+	t.CreateSharedSwapchainsKHR = vk.PfnCreateSharedSwapchainsKHR(proc(t.CreateSharedSwapchainsKHR.String()))
+	t.CreateVideoSessionKHR = vk.PfnCreateVideoSessionKHR(proc(t.CreateVideoSessionKHR.String()))
+	t.DestroyVideoSessionKHR = vk.PfnDestroyVideoSessionKHR(proc(t.DestroyVideoSessionKHR.String()))
+	t.GetVideoSessionMemoryRequirementsKHR = vk.PfnGetVideoSessionMemoryRequirementsKHR(proc(t.GetVideoSessionMemoryRequirementsKHR.String()))
+	t.BindVideoSessionMemoryKHR = vk.PfnBindVideoSessionMemoryKHR(proc(t.BindVideoSessionMemoryKHR.String()))
+	t.CreateVideoSessionParametersKHR = vk.PfnCreateVideoSessionParametersKHR(proc(t.CreateVideoSessionParametersKHR.String()))
+	t.UpdateVideoSessionParametersKHR = vk.PfnUpdateVideoSessionParametersKHR(proc(t.UpdateVideoSessionParametersKHR.String()))
+	t.DestroyVideoSessionParametersKHR = vk.PfnDestroyVideoSessionParametersKHR(proc(t.DestroyVideoSessionParametersKHR.String()))
+	t.CmdBeginVideoCodingKHR = vk.PfnCmdBeginVideoCodingKHR(proc(t.CmdBeginVideoCodingKHR.String()))
+	t.CmdEndVideoCodingKHR = vk.PfnCmdEndVideoCodingKHR(proc(t.CmdEndVideoCodingKHR.String()))
+	t.CmdControlVideoCodingKHR = vk.PfnCmdControlVideoCodingKHR(proc(t.CmdControlVideoCodingKHR.String()))
+	t.CmdDecodeVideoKHR = vk.PfnCmdDecodeVideoKHR(proc(t.CmdDecodeVideoKHR.String()))
+	t.CmdBeginRenderingKHR = vk.PfnCmdBeginRenderingKHR(proc(t.CmdBeginRenderingKHR.String()))
+	t.CmdEndRenderingKHR = vk.PfnCmdEndRenderingKHR(proc(t.CmdEndRenderingKHR.String()))
+	t.GetDeviceGroupPeerMemoryFeaturesKHR = vk.PfnGetDeviceGroupPeerMemoryFeaturesKHR(proc(t.GetDeviceGroupPeerMemoryFeaturesKHR.String()))
+	t.CmdSetDeviceMaskKHR = vk.PfnCmdSetDeviceMaskKHR(proc(t.CmdSetDeviceMaskKHR.String()))
+	t.CmdDispatchBaseKHR = vk.PfnCmdDispatchBaseKHR(proc(t.CmdDispatchBaseKHR.String()))
+	t.TrimCommandPoolKHR = vk.PfnTrimCommandPoolKHR(proc(t.TrimCommandPoolKHR.String()))
+	t.GetMemoryFdKHR = vk.PfnGetMemoryFdKHR(proc(t.GetMemoryFdKHR.String()))
+	t.GetMemoryFdPropertiesKHR = vk.PfnGetMemoryFdPropertiesKHR(proc(t.GetMemoryFdPropertiesKHR.String()))
+	t.ImportSemaphoreFdKHR = vk.PfnImportSemaphoreFdKHR(proc(t.ImportSemaphoreFdKHR.String()))
+	t.GetSemaphoreFdKHR = vk.PfnGetSemaphoreFdKHR(proc(t.GetSemaphoreFdKHR.String()))
+	t.CmdPushDescriptorSetKHR = vk.PfnCmdPushDescriptorSetKHR(proc(t.CmdPushDescriptorSetKHR.String()))
+	t.CmdPushDescriptorSetWithTemplateKHR = vk.PfnCmdPushDescriptorSetWithTemplateKHR(proc(t.CmdPushDescriptorSetWithTemplateKHR.String()))
+	t.CreateDescriptorUpdateTemplateKHR = vk.PfnCreateDescriptorUpdateTemplateKHR(proc(t.CreateDescriptorUpdateTemplateKHR.String()))
+	t.DestroyDescriptorUpdateTemplateKHR = vk.PfnDestroyDescriptorUpdateTemplateKHR(proc(t.DestroyDescriptorUpdateTemplateKHR.String()))
+	t.UpdateDescriptorSetWithTemplateKHR = vk.PfnUpdateDescriptorSetWithTemplateKHR(proc(t.UpdateDescriptorSetWithTemplateKHR.String()))
+	t.CreateRenderPass2KHR = vk.PfnCreateRenderPass2KHR(proc(t.CreateRenderPass2KHR.String()))
+	t.CmdBeginRenderPass2KHR = vk.PfnCmdBeginRenderPass2KHR(proc(t.CmdBeginRenderPass2KHR.String()))
+	t.CmdNextSubpass2KHR = vk.PfnCmdNextSubpass2KHR(proc(t.CmdNextSubpass2KHR.String()))
+	t.CmdEndRenderPass2KHR = vk.PfnCmdEndRenderPass2KHR(proc(t.CmdEndRenderPass2KHR.String()))
+	t.GetSwapchainStatusKHR = vk.PfnGetSwapchainStatusKHR(proc(t.GetSwapchainStatusKHR.String()))
+	t.ImportFenceFdKHR = vk.PfnImportFenceFdKHR(proc(t.ImportFenceFdKHR.String()))
+	t.GetFenceFdKHR = vk.PfnGetFenceFdKHR(proc(t.GetFenceFdKHR.String()))
+	t.AcquireProfilingLockKHR = vk.PfnAcquireProfilingLockKHR(proc(t.AcquireProfilingLockKHR.String()))
+	t.ReleaseProfilingLockKHR = vk.PfnReleaseProfilingLockKHR(proc(t.ReleaseProfilingLockKHR.String()))
+	t.GetImageMemoryRequirements2KHR = vk.PfnGetImageMemoryRequirements2KHR(proc(t.GetImageMemoryRequirements2KHR.String()))
+	t.GetBufferMemoryRequirements2KHR = vk.PfnGetBufferMemoryRequirements2KHR(proc(t.GetBufferMemoryRequirements2KHR.String()))
+	t.GetImageSparseMemoryRequirements2KHR = vk.PfnGetImageSparseMemoryRequirements2KHR(proc(t.GetImageSparseMemoryRequirements2KHR.String()))
+	t.CreateAccelerationStructureKHR = vk.PfnCreateAccelerationStructureKHR(proc(t.CreateAccelerationStructureKHR.String()))
+	t.DestroyAccelerationStructureKHR = vk.PfnDestroyAccelerationStructureKHR(proc(t.DestroyAccelerationStructureKHR.String()))
+	t.CmdBuildAccelerationStructuresKHR = vk.PfnCmdBuildAccelerationStructuresKHR(proc(t.CmdBuildAccelerationStructuresKHR.String()))
+	t.CmdBuildAccelerationStructuresIndirectKHR = vk.PfnCmdBuildAccelerationStructuresIndirectKHR(proc(t.CmdBuildAccelerationStructuresIndirectKHR.String()))
+	t.BuildAccelerationStructuresKHR = vk.PfnBuildAccelerationStructuresKHR(proc(t.BuildAccelerationStructuresKHR.String()))
+	t.CopyAccelerationStructureKHR = vk.PfnCopyAccelerationStructureKHR(proc(t.CopyAccelerationStructureKHR.String()))
+	t.CopyAccelerationStructureToMemoryKHR = vk.PfnCopyAccelerationStructureToMemoryKHR(proc(t.CopyAccelerationStructureToMemoryKHR.String()))
+	t.CopyMemoryToAccelerationStructureKHR = vk.PfnCopyMemoryToAccelerationStructureKHR(proc(t.CopyMemoryToAccelerationStructureKHR.String()))
+	t.WriteAccelerationStructuresPropertiesKHR = vk.PfnWriteAccelerationStructuresPropertiesKHR(proc(t.WriteAccelerationStructuresPropertiesKHR.String()))
+	t.CmdCopyAccelerationStructureKHR = vk.PfnCmdCopyAccelerationStructureKHR(proc(t.CmdCopyAccelerationStructureKHR.String()))
+	t.CmdCopyAccelerationStructureToMemoryKHR = vk.PfnCmdCopyAccelerationStructureToMemoryKHR(proc(t.CmdCopyAccelerationStructureToMemoryKHR.String()))
+	t.CmdCopyMemoryToAccelerationStructureKHR = vk.PfnCmdCopyMemoryToAccelerationStructureKHR(proc(t.CmdCopyMemoryToAccelerationStructureKHR.String()))
+	t.GetAccelerationStructureDeviceAddressKHR = vk.PfnGetAccelerationStructureDeviceAddressKHR(proc(t.GetAccelerationStructureDeviceAddressKHR.String()))
+	t.CmdWriteAccelerationStructuresPropertiesKHR = vk.PfnCmdWriteAccelerationStructuresPropertiesKHR(proc(t.CmdWriteAccelerationStructuresPropertiesKHR.String()))
+	t.GetDeviceAccelerationStructureCompatibilityKHR = vk.PfnGetDeviceAccelerationStructureCompatibilityKHR(proc(t.GetDeviceAccelerationStructureCompatibilityKHR.String()))
+	t.GetAccelerationStructureBuildSizesKHR = vk.PfnGetAccelerationStructureBuildSizesKHR(proc(t.GetAccelerationStructureBuildSizesKHR.String()))
+	t.CreateSamplerYcbcrConversionKHR = vk.PfnCreateSamplerYcbcrConversionKHR(proc(t.CreateSamplerYcbcrConversionKHR.String()))
+	t.DestroySamplerYcbcrConversionKHR = vk.PfnDestroySamplerYcbcrConversionKHR(proc(t.DestroySamplerYcbcrConversionKHR.String()))
+	t.BindBufferMemory2KHR = vk.PfnBindBufferMemory2KHR(proc(t.BindBufferMemory2KHR.String()))
+	t.BindImageMemory2KHR = vk.PfnBindImageMemory2KHR(proc(t.BindImageMemory2KHR.String()))
+	t.GetDescriptorSetLayoutSupportKHR = vk.PfnGetDescriptorSetLayoutSupportKHR(proc(t.GetDescriptorSetLayoutSupportKHR.String()))
+	t.CmdDrawIndirectCountKHR = vk.PfnCmdDrawIndirectCountKHR(proc(t.CmdDrawIndirectCountKHR.String()))
+	t.CmdDrawIndexedIndirectCountKHR = vk.PfnCmdDrawIndexedIndirectCountKHR(proc(t.CmdDrawIndexedIndirectCountKHR.String()))
+	t.GetSemaphoreCounterValueKHR = vk.PfnGetSemaphoreCounterValueKHR(proc(t.GetSemaphoreCounterValueKHR.String()))
+	t.WaitSemaphoresKHR = vk.PfnWaitSemaphoresKHR(proc(t.WaitSemaphoresKHR.String()))
+	t.SignalSemaphoreKHR = vk.PfnSignalSemaphoreKHR(proc(t.SignalSemaphoreKHR.String()))
+	t.CmdSetFragmentShadingRateKHR = vk.PfnCmdSetFragmentShadingRateKHR(proc(t.CmdSetFragmentShadingRateKHR.String()))
+	t.WaitForPresentKHR = vk.PfnWaitForPresentKHR(proc(t.WaitForPresentKHR.String()))
+	t.GetBufferDeviceAddressKHR = vk.PfnGetBufferDeviceAddressKHR(proc(t.GetBufferDeviceAddressKHR.String()))
+	t.GetBufferOpaqueCaptureAddressKHR = vk.PfnGetBufferOpaqueCaptureAddressKHR(proc(t.GetBufferOpaqueCaptureAddressKHR.String()))
+	t.GetDeviceMemoryOpaqueCaptureAddressKHR = vk.PfnGetDeviceMemoryOpaqueCaptureAddressKHR(proc(t.GetDeviceMemoryOpaqueCaptureAddressKHR.String()))
+	t.CreateDeferredOperationKHR = vk.PfnCreateDeferredOperationKHR(proc(t.CreateDeferredOperationKHR.String()))
+	t.DestroyDeferredOperationKHR = vk.PfnDestroyDeferredOperationKHR(proc(t.DestroyDeferredOperationKHR.String()))
+	t.GetDeferredOperationMaxConcurrencyKHR = vk.PfnGetDeferredOperationMaxConcurrencyKHR(proc(t.GetDeferredOperationMaxConcurrencyKHR.String()))
+	t.GetDeferredOperationResultKHR = vk.PfnGetDeferredOperationResultKHR(proc(t.GetDeferredOperationResultKHR.String()))
+	t.DeferredOperationJoinKHR = vk.PfnDeferredOperationJoinKHR(proc(t.DeferredOperationJoinKHR.String()))
+	t.GetPipelineExecutablePropertiesKHR = vk.PfnGetPipelineExecutablePropertiesKHR(proc(t.GetPipelineExecutablePropertiesKHR.String()))
+	t.GetPipelineExecutableStatisticsKHR = vk.PfnGetPipelineExecutableStatisticsKHR(proc(t.GetPipelineExecutableStatisticsKHR.String()))
+	t.GetPipelineExecutableInternalRepresentationsKHR = vk.PfnGetPipelineExecutableInternalRepresentationsKHR(proc(t.GetPipelineExecutableInternalRepresentationsKHR.String()))
+	t.CmdSetEvent2KHR = vk.PfnCmdSetEvent2KHR(proc(t.CmdSetEvent2KHR.String()))
+	t.CmdResetEvent2KHR = vk.PfnCmdResetEvent2KHR(proc(t.CmdResetEvent2KHR.String()))
+	t.CmdWaitEvents2KHR = vk.PfnCmdWaitEvents2KHR(proc(t.CmdWaitEvents2KHR.String()))
+	t.CmdPipelineBarrier2KHR = vk.PfnCmdPipelineBarrier2KHR(proc(t.CmdPipelineBarrier2KHR.String()))
+	t.CmdWriteTimestamp2KHR = vk.PfnCmdWriteTimestamp2KHR(proc(t.CmdWriteTimestamp2KHR.String()))
+	t.QueueSubmit2KHR = vk.PfnQueueSubmit2KHR(proc(t.QueueSubmit2KHR.String()))
+	t.CmdWriteBufferMarker2AMD = vk.PfnCmdWriteBufferMarker2AMD(proc(t.CmdWriteBufferMarker2AMD.String()))
+	t.GetQueueCheckpointData2NV = vk.PfnGetQueueCheckpointData2NV(proc(t.GetQueueCheckpointData2NV.String()))
+	t.CmdCopyBuffer2KHR = vk.PfnCmdCopyBuffer2KHR(proc(t.CmdCopyBuffer2KHR.String()))
+	t.CmdCopyImage2KHR = vk.PfnCmdCopyImage2KHR(proc(t.CmdCopyImage2KHR.String()))
+	t.CmdCopyBufferToImage2KHR = vk.PfnCmdCopyBufferToImage2KHR(proc(t.CmdCopyBufferToImage2KHR.String()))
+	t.CmdCopyImageToBuffer2KHR = vk.PfnCmdCopyImageToBuffer2KHR(proc(t.CmdCopyImageToBuffer2KHR.String()))
+	t.CmdBlitImage2KHR = vk.PfnCmdBlitImage2KHR(proc(t.CmdBlitImage2KHR.String()))
+	t.CmdResolveImage2KHR = vk.PfnCmdResolveImage2KHR(proc(t.CmdResolveImage2KHR.String()))
+	t.CmdTraceRaysKHR = vk.PfnCmdTraceRaysKHR(proc(t.CmdTraceRaysKHR.String()))
+	t.CreateRayTracingPipelinesKHR = vk.PfnCreateRayTracingPipelinesKHR(proc(t.CreateRayTracingPipelinesKHR.String()))
+	t.GetRayTracingShaderGroupHandlesKHR = vk.PfnGetRayTracingShaderGroupHandlesKHR(proc(t.GetRayTracingShaderGroupHandlesKHR.String()))
+	t.GetRayTracingCaptureReplayShaderGroupHandlesKHR = vk.PfnGetRayTracingCaptureReplayShaderGroupHandlesKHR(proc(t.GetRayTracingCaptureReplayShaderGroupHandlesKHR.String()))
+	t.CmdTraceRaysIndirectKHR = vk.PfnCmdTraceRaysIndirectKHR(proc(t.CmdTraceRaysIndirectKHR.String()))
+	t.GetRayTracingShaderGroupStackSizeKHR = vk.PfnGetRayTracingShaderGroupStackSizeKHR(proc(t.GetRayTracingShaderGroupStackSizeKHR.String()))
+	t.CmdSetRayTracingPipelineStackSizeKHR = vk.PfnCmdSetRayTracingPipelineStackSizeKHR(proc(t.CmdSetRayTracingPipelineStackSizeKHR.String()))
+	t.CmdTraceRaysIndirect2KHR = vk.PfnCmdTraceRaysIndirect2KHR(proc(t.CmdTraceRaysIndirect2KHR.String()))
+	t.GetDeviceBufferMemoryRequirementsKHR = vk.PfnGetDeviceBufferMemoryRequirementsKHR(proc(t.GetDeviceBufferMemoryRequirementsKHR.String()))
+	t.GetDeviceImageMemoryRequirementsKHR = vk.PfnGetDeviceImageMemoryRequirementsKHR(proc(t.GetDeviceImageMemoryRequirementsKHR.String()))
+	t.GetDeviceImageSparseMemoryRequirementsKHR = vk.PfnGetDeviceImageSparseMemoryRequirementsKHR(proc(t.GetDeviceImageSparseMemoryRequirementsKHR.String()))
+	t.DebugMarkerSetObjectTagEXT = vk.PfnDebugMarkerSetObjectTagEXT(proc(t.DebugMarkerSetObjectTagEXT.String()))
+	t.DebugMarkerSetObjectNameEXT = vk.PfnDebugMarkerSetObjectNameEXT(proc(t.DebugMarkerSetObjectNameEXT.String()))
+	t.CmdDebugMarkerBeginEXT = vk.PfnCmdDebugMarkerBeginEXT(proc(t.CmdDebugMarkerBeginEXT.String()))
+	t.CmdDebugMarkerEndEXT = vk.PfnCmdDebugMarkerEndEXT(proc(t.CmdDebugMarkerEndEXT.String()))
+	t.CmdDebugMarkerInsertEXT = vk.PfnCmdDebugMarkerInsertEXT(proc(t.CmdDebugMarkerInsertEXT.String()))
+	t.CmdBindTransformFeedbackBuffersEXT = vk.PfnCmdBindTransformFeedbackBuffersEXT(proc(t.CmdBindTransformFeedbackBuffersEXT.String()))
+	t.CmdBeginTransformFeedbackEXT = vk.PfnCmdBeginTransformFeedbackEXT(proc(t.CmdBeginTransformFeedbackEXT.String()))
+	t.CmdEndTransformFeedbackEXT = vk.PfnCmdEndTransformFeedbackEXT(proc(t.CmdEndTransformFeedbackEXT.String()))
+	t.CmdBeginQueryIndexedEXT = vk.PfnCmdBeginQueryIndexedEXT(proc(t.CmdBeginQueryIndexedEXT.String()))
+	t.CmdEndQueryIndexedEXT = vk.PfnCmdEndQueryIndexedEXT(proc(t.CmdEndQueryIndexedEXT.String()))
+	t.CmdDrawIndirectByteCountEXT = vk.PfnCmdDrawIndirectByteCountEXT(proc(t.CmdDrawIndirectByteCountEXT.String()))
+	t.CreateCuModuleNVX = vk.PfnCreateCuModuleNVX(proc(t.CreateCuModuleNVX.String()))
+	t.CreateCuFunctionNVX = vk.PfnCreateCuFunctionNVX(proc(t.CreateCuFunctionNVX.String()))
+	t.DestroyCuModuleNVX = vk.PfnDestroyCuModuleNVX(proc(t.DestroyCuModuleNVX.String()))
+	t.DestroyCuFunctionNVX = vk.PfnDestroyCuFunctionNVX(proc(t.DestroyCuFunctionNVX.String()))
+	t.CmdCuLaunchKernelNVX = vk.PfnCmdCuLaunchKernelNVX(proc(t.CmdCuLaunchKernelNVX.String()))
+	t.GetImageViewHandleNVX = vk.PfnGetImageViewHandleNVX(proc(t.GetImageViewHandleNVX.String()))
+	t.GetImageViewAddressNVX = vk.PfnGetImageViewAddressNVX(proc(t.GetImageViewAddressNVX.String()))
+	t.CmdDrawIndirectCountAMD = vk.PfnCmdDrawIndirectCountAMD(proc(t.CmdDrawIndirectCountAMD.String()))
+	t.CmdDrawIndexedIndirectCountAMD = vk.PfnCmdDrawIndexedIndirectCountAMD(proc(t.CmdDrawIndexedIndirectCountAMD.String()))
+	t.GetShaderInfoAMD = vk.PfnGetShaderInfoAMD(proc(t.GetShaderInfoAMD.String()))
+	t.CmdBeginConditionalRenderingEXT = vk.PfnCmdBeginConditionalRenderingEXT(proc(t.CmdBeginConditionalRenderingEXT.String()))
+	t.CmdEndConditionalRenderingEXT = vk.PfnCmdEndConditionalRenderingEXT(proc(t.CmdEndConditionalRenderingEXT.String()))
+	t.CmdSetViewportWScalingNV = vk.PfnCmdSetViewportWScalingNV(proc(t.CmdSetViewportWScalingNV.String()))
+	t.DisplayPowerControlEXT = vk.PfnDisplayPowerControlEXT(proc(t.DisplayPowerControlEXT.String()))
+	t.RegisterDeviceEventEXT = vk.PfnRegisterDeviceEventEXT(proc(t.RegisterDeviceEventEXT.String()))
+	t.RegisterDisplayEventEXT = vk.PfnRegisterDisplayEventEXT(proc(t.RegisterDisplayEventEXT.String()))
+	t.GetSwapchainCounterEXT = vk.PfnGetSwapchainCounterEXT(proc(t.GetSwapchainCounterEXT.String()))
+	t.GetRefreshCycleDurationGOOGLE = vk.PfnGetRefreshCycleDurationGOOGLE(proc(t.GetRefreshCycleDurationGOOGLE.String()))
+	t.GetPastPresentationTimingGOOGLE = vk.PfnGetPastPresentationTimingGOOGLE(proc(t.GetPastPresentationTimingGOOGLE.String()))
+	t.CmdSetDiscardRectangleEXT = vk.PfnCmdSetDiscardRectangleEXT(proc(t.CmdSetDiscardRectangleEXT.String()))
+	t.SetHdrMetadataEXT = vk.PfnSetHdrMetadataEXT(proc(t.SetHdrMetadataEXT.String()))
+	t.SetDebugUtilsObjectNameEXT = vk.PfnSetDebugUtilsObjectNameEXT(proc(t.SetDebugUtilsObjectNameEXT.String()))
+	t.SetDebugUtilsObjectTagEXT = vk.PfnSetDebugUtilsObjectTagEXT(proc(t.SetDebugUtilsObjectTagEXT.String()))
+	t.QueueBeginDebugUtilsLabelEXT = vk.PfnQueueBeginDebugUtilsLabelEXT(proc(t.QueueBeginDebugUtilsLabelEXT.String()))
+	t.QueueEndDebugUtilsLabelEXT = vk.PfnQueueEndDebugUtilsLabelEXT(proc(t.QueueEndDebugUtilsLabelEXT.String()))
+	t.QueueInsertDebugUtilsLabelEXT = vk.PfnQueueInsertDebugUtilsLabelEXT(proc(t.QueueInsertDebugUtilsLabelEXT.String()))
+	t.CmdBeginDebugUtilsLabelEXT = vk.PfnCmdBeginDebugUtilsLabelEXT(proc(t.CmdBeginDebugUtilsLabelEXT.String()))
+	t.CmdEndDebugUtilsLabelEXT = vk.PfnCmdEndDebugUtilsLabelEXT(proc(t.CmdEndDebugUtilsLabelEXT.String()))
+	t.CmdInsertDebugUtilsLabelEXT = vk.PfnCmdInsertDebugUtilsLabelEXT(proc(t.CmdInsertDebugUtilsLabelEXT.String()))
+	t.CmdSetSampleLocationsEXT = vk.PfnCmdSetSampleLocationsEXT(proc(t.CmdSetSampleLocationsEXT.String()))
+	t.GetImageDrmFormatModifierPropertiesEXT = vk.PfnGetImageDrmFormatModifierPropertiesEXT(proc(t.GetImageDrmFormatModifierPropertiesEXT.String()))
+	t.CreateValidationCacheEXT = vk.PfnCreateValidationCacheEXT(proc(t.CreateValidationCacheEXT.String()))
+	t.DestroyValidationCacheEXT = vk.PfnDestroyValidationCacheEXT(proc(t.DestroyValidationCacheEXT.String()))
+	t.MergeValidationCachesEXT = vk.PfnMergeValidationCachesEXT(proc(t.MergeValidationCachesEXT.String()))
+	t.GetValidationCacheDataEXT = vk.PfnGetValidationCacheDataEXT(proc(t.GetValidationCacheDataEXT.String()))
+	t.CmdBindShadingRateImageNV = vk.PfnCmdBindShadingRateImageNV(proc(t.CmdBindShadingRateImageNV.String()))
+	t.CmdSetViewportShadingRatePaletteNV = vk.PfnCmdSetViewportShadingRatePaletteNV(proc(t.CmdSetViewportShadingRatePaletteNV.String()))
+	t.CmdSetCoarseSampleOrderNV = vk.PfnCmdSetCoarseSampleOrderNV(proc(t.CmdSetCoarseSampleOrderNV.String()))
+	t.CreateAccelerationStructureNV = vk.PfnCreateAccelerationStructureNV(proc(t.CreateAccelerationStructureNV.String()))
+	t.DestroyAccelerationStructureNV = vk.PfnDestroyAccelerationStructureNV(proc(t.DestroyAccelerationStructureNV.String()))
+	t.GetAccelerationStructureMemoryRequirementsNV = vk.PfnGetAccelerationStructureMemoryRequirementsNV(proc(t.GetAccelerationStructureMemoryRequirementsNV.String()))
+	t.BindAccelerationStructureMemoryNV = vk.PfnBindAccelerationStructureMemoryNV(proc(t.BindAccelerationStructureMemoryNV.String()))
+	t.CmdBuildAccelerationStructureNV = vk.PfnCmdBuildAccelerationStructureNV(proc(t.CmdBuildAccelerationStructureNV.String()))
+	t.CmdCopyAccelerationStructureNV = vk.PfnCmdCopyAccelerationStructureNV(proc(t.CmdCopyAccelerationStructureNV.String()))
+	t.CmdTraceRaysNV = vk.PfnCmdTraceRaysNV(proc(t.CmdTraceRaysNV.String()))
+	t.CreateRayTracingPipelinesNV = vk.PfnCreateRayTracingPipelinesNV(proc(t.CreateRayTracingPipelinesNV.String()))
+	t.GetRayTracingShaderGroupHandlesNV = vk.PfnGetRayTracingShaderGroupHandlesNV(proc(t.GetRayTracingShaderGroupHandlesNV.String()))
+	t.GetAccelerationStructureHandleNV = vk.PfnGetAccelerationStructureHandleNV(proc(t.GetAccelerationStructureHandleNV.String()))
+	t.CmdWriteAccelerationStructuresPropertiesNV = vk.PfnCmdWriteAccelerationStructuresPropertiesNV(proc(t.CmdWriteAccelerationStructuresPropertiesNV.String()))
+	t.CompileDeferredNV = vk.PfnCompileDeferredNV(proc(t.CompileDeferredNV.String()))
+	t.GetMemoryHostPointerPropertiesEXT = vk.PfnGetMemoryHostPointerPropertiesEXT(proc(t.GetMemoryHostPointerPropertiesEXT.String()))
+	t.CmdWriteBufferMarkerAMD = vk.PfnCmdWriteBufferMarkerAMD(proc(t.CmdWriteBufferMarkerAMD.String()))
+	t.GetCalibratedTimestampsEXT = vk.PfnGetCalibratedTimestampsEXT(proc(t.GetCalibratedTimestampsEXT.String()))
+	t.CmdDrawMeshTasksNV = vk.PfnCmdDrawMeshTasksNV(proc(t.CmdDrawMeshTasksNV.String()))
+	t.CmdDrawMeshTasksIndirectNV = vk.PfnCmdDrawMeshTasksIndirectNV(proc(t.CmdDrawMeshTasksIndirectNV.String()))
+	t.CmdDrawMeshTasksIndirectCountNV = vk.PfnCmdDrawMeshTasksIndirectCountNV(proc(t.CmdDrawMeshTasksIndirectCountNV.String()))
+	t.CmdSetExclusiveScissorNV = vk.PfnCmdSetExclusiveScissorNV(proc(t.CmdSetExclusiveScissorNV.String()))
+	t.CmdSetCheckpointNV = vk.PfnCmdSetCheckpointNV(proc(t.CmdSetCheckpointNV.String()))
+	t.GetQueueCheckpointDataNV = vk.PfnGetQueueCheckpointDataNV(proc(t.GetQueueCheckpointDataNV.String()))
+	t.InitializePerformanceApiINTEL = vk.PfnInitializePerformanceApiINTEL(proc(t.InitializePerformanceApiINTEL.String()))
+	t.UninitializePerformanceApiINTEL = vk.PfnUninitializePerformanceApiINTEL(proc(t.UninitializePerformanceApiINTEL.String()))
+	t.CmdSetPerformanceMarkerINTEL = vk.PfnCmdSetPerformanceMarkerINTEL(proc(t.CmdSetPerformanceMarkerINTEL.String()))
+	t.CmdSetPerformanceStreamMarkerINTEL = vk.PfnCmdSetPerformanceStreamMarkerINTEL(proc(t.CmdSetPerformanceStreamMarkerINTEL.String()))
+	t.CmdSetPerformanceOverrideINTEL = vk.PfnCmdSetPerformanceOverrideINTEL(proc(t.CmdSetPerformanceOverrideINTEL.String()))
+	t.AcquirePerformanceConfigurationINTEL = vk.PfnAcquirePerformanceConfigurationINTEL(proc(t.AcquirePerformanceConfigurationINTEL.String()))
+	t.ReleasePerformanceConfigurationINTEL = vk.PfnReleasePerformanceConfigurationINTEL(proc(t.ReleasePerformanceConfigurationINTEL.String()))
+	t.QueueSetPerformanceConfigurationINTEL = vk.PfnQueueSetPerformanceConfigurationINTEL(proc(t.QueueSetPerformanceConfigurationINTEL.String()))
+	t.GetPerformanceParameterINTEL = vk.PfnGetPerformanceParameterINTEL(proc(t.GetPerformanceParameterINTEL.String()))
+	t.SetLocalDimmingAMD = vk.PfnSetLocalDimmingAMD(proc(t.SetLocalDimmingAMD.String()))
+	t.GetBufferDeviceAddressEXT = vk.PfnGetBufferDeviceAddressEXT(proc(t.GetBufferDeviceAddressEXT.String()))
+	t.CmdSetLineStippleEXT = vk.PfnCmdSetLineStippleEXT(proc(t.CmdSetLineStippleEXT.String()))
+	t.ResetQueryPoolEXT = vk.PfnResetQueryPoolEXT(proc(t.ResetQueryPoolEXT.String()))
+	t.CmdSetCullModeEXT = vk.PfnCmdSetCullModeEXT(proc(t.CmdSetCullModeEXT.String()))
+	t.CmdSetFrontFaceEXT = vk.PfnCmdSetFrontFaceEXT(proc(t.CmdSetFrontFaceEXT.String()))
+	t.CmdSetPrimitiveTopologyEXT = vk.PfnCmdSetPrimitiveTopologyEXT(proc(t.CmdSetPrimitiveTopologyEXT.String()))
+	t.CmdSetViewportWithCountEXT = vk.PfnCmdSetViewportWithCountEXT(proc(t.CmdSetViewportWithCountEXT.String()))
+	t.CmdSetScissorWithCountEXT = vk.PfnCmdSetScissorWithCountEXT(proc(t.CmdSetScissorWithCountEXT.String()))
+	t.CmdBindVertexBuffers2EXT = vk.PfnCmdBindVertexBuffers2EXT(proc(t.CmdBindVertexBuffers2EXT.String()))
+	t.CmdSetDepthTestEnableEXT = vk.PfnCmdSetDepthTestEnableEXT(proc(t.CmdSetDepthTestEnableEXT.String()))
+	t.CmdSetDepthWriteEnableEXT = vk.PfnCmdSetDepthWriteEnableEXT(proc(t.CmdSetDepthWriteEnableEXT.String()))
+	t.CmdSetDepthCompareOpEXT = vk.PfnCmdSetDepthCompareOpEXT(proc(t.CmdSetDepthCompareOpEXT.String()))
+	t.CmdSetDepthBoundsTestEnableEXT = vk.PfnCmdSetDepthBoundsTestEnableEXT(proc(t.CmdSetDepthBoundsTestEnableEXT.String()))
+	t.CmdSetStencilTestEnableEXT = vk.PfnCmdSetStencilTestEnableEXT(proc(t.CmdSetStencilTestEnableEXT.String()))
+	t.CmdSetStencilOpEXT = vk.PfnCmdSetStencilOpEXT(proc(t.CmdSetStencilOpEXT.String()))
+	t.ReleaseSwapchainImagesEXT = vk.PfnReleaseSwapchainImagesEXT(proc(t.ReleaseSwapchainImagesEXT.String()))
+	t.GetGeneratedCommandsMemoryRequirementsNV = vk.PfnGetGeneratedCommandsMemoryRequirementsNV(proc(t.GetGeneratedCommandsMemoryRequirementsNV.String()))
+	t.CmdPreprocessGeneratedCommandsNV = vk.PfnCmdPreprocessGeneratedCommandsNV(proc(t.CmdPreprocessGeneratedCommandsNV.String()))
+	t.CmdExecuteGeneratedCommandsNV = vk.PfnCmdExecuteGeneratedCommandsNV(proc(t.CmdExecuteGeneratedCommandsNV.String()))
+	t.CmdBindPipelineShaderGroupNV = vk.PfnCmdBindPipelineShaderGroupNV(proc(t.CmdBindPipelineShaderGroupNV.String()))
+	t.CreateIndirectCommandsLayoutNV = vk.PfnCreateIndirectCommandsLayoutNV(proc(t.CreateIndirectCommandsLayoutNV.String()))
+	t.DestroyIndirectCommandsLayoutNV = vk.PfnDestroyIndirectCommandsLayoutNV(proc(t.DestroyIndirectCommandsLayoutNV.String()))
+	t.CreatePrivateDataSlotEXT = vk.PfnCreatePrivateDataSlotEXT(proc(t.CreatePrivateDataSlotEXT.String()))
+	t.DestroyPrivateDataSlotEXT = vk.PfnDestroyPrivateDataSlotEXT(proc(t.DestroyPrivateDataSlotEXT.String()))
+	t.SetPrivateDataEXT = vk.PfnSetPrivateDataEXT(proc(t.SetPrivateDataEXT.String()))
+	t.GetPrivateDataEXT = vk.PfnGetPrivateDataEXT(proc(t.GetPrivateDataEXT.String()))
+	t.GetDescriptorSetLayoutSizeEXT = vk.PfnGetDescriptorSetLayoutSizeEXT(proc(t.GetDescriptorSetLayoutSizeEXT.String()))
+	t.GetDescriptorSetLayoutBindingOffsetEXT = vk.PfnGetDescriptorSetLayoutBindingOffsetEXT(proc(t.GetDescriptorSetLayoutBindingOffsetEXT.String()))
+	t.GetDescriptorEXT = vk.PfnGetDescriptorEXT(proc(t.GetDescriptorEXT.String()))
+	t.CmdBindDescriptorBuffersEXT = vk.PfnCmdBindDescriptorBuffersEXT(proc(t.CmdBindDescriptorBuffersEXT.String()))
+	t.CmdSetDescriptorBufferOffsetsEXT = vk.PfnCmdSetDescriptorBufferOffsetsEXT(proc(t.CmdSetDescriptorBufferOffsetsEXT.String()))
+	t.CmdBindDescriptorBufferEmbeddedSamplersEXT = vk.PfnCmdBindDescriptorBufferEmbeddedSamplersEXT(proc(t.CmdBindDescriptorBufferEmbeddedSamplersEXT.String()))
+	t.GetBufferOpaqueCaptureDescriptorDataEXT = vk.PfnGetBufferOpaqueCaptureDescriptorDataEXT(proc(t.GetBufferOpaqueCaptureDescriptorDataEXT.String()))
+	t.GetImageOpaqueCaptureDescriptorDataEXT = vk.PfnGetImageOpaqueCaptureDescriptorDataEXT(proc(t.GetImageOpaqueCaptureDescriptorDataEXT.String()))
+	t.GetImageViewOpaqueCaptureDescriptorDataEXT = vk.PfnGetImageViewOpaqueCaptureDescriptorDataEXT(proc(t.GetImageViewOpaqueCaptureDescriptorDataEXT.String()))
+	t.GetSamplerOpaqueCaptureDescriptorDataEXT = vk.PfnGetSamplerOpaqueCaptureDescriptorDataEXT(proc(t.GetSamplerOpaqueCaptureDescriptorDataEXT.String()))
+	t.GetAccelerationStructureOpaqueCaptureDescriptorDataEXT = vk.PfnGetAccelerationStructureOpaqueCaptureDescriptorDataEXT(proc(t.GetAccelerationStructureOpaqueCaptureDescriptorDataEXT.String()))
+	t.CmdSetFragmentShadingRateEnumNV = vk.PfnCmdSetFragmentShadingRateEnumNV(proc(t.CmdSetFragmentShadingRateEnumNV.String()))
+	t.CmdDrawMeshTasksEXT = vk.PfnCmdDrawMeshTasksEXT(proc(t.CmdDrawMeshTasksEXT.String()))
+	t.CmdDrawMeshTasksIndirectEXT = vk.PfnCmdDrawMeshTasksIndirectEXT(proc(t.CmdDrawMeshTasksIndirectEXT.String()))
+	t.CmdDrawMeshTasksIndirectCountEXT = vk.PfnCmdDrawMeshTasksIndirectCountEXT(proc(t.CmdDrawMeshTasksIndirectCountEXT.String()))
+	t.GetImageSubresourceLayout2EXT = vk.PfnGetImageSubresourceLayout2EXT(proc(t.GetImageSubresourceLayout2EXT.String()))
+	t.GetDeviceFaultInfoEXT = vk.PfnGetDeviceFaultInfoEXT(proc(t.GetDeviceFaultInfoEXT.String()))
+	t.CmdSetVertexInputEXT = vk.PfnCmdSetVertexInputEXT(proc(t.CmdSetVertexInputEXT.String()))
+	t.GetDeviceSubpassShadingMaxWorkgroupSizeHUAWEI = vk.PfnGetDeviceSubpassShadingMaxWorkgroupSizeHUAWEI(proc(t.GetDeviceSubpassShadingMaxWorkgroupSizeHUAWEI.String()))
+	t.CmdSubpassShadingHUAWEI = vk.PfnCmdSubpassShadingHUAWEI(proc(t.CmdSubpassShadingHUAWEI.String()))
+	t.CmdBindInvocationMaskHUAWEI = vk.PfnCmdBindInvocationMaskHUAWEI(proc(t.CmdBindInvocationMaskHUAWEI.String()))
+	t.GetMemoryRemoteAddressNV = vk.PfnGetMemoryRemoteAddressNV(proc(t.GetMemoryRemoteAddressNV.String()))
+	t.GetPipelinePropertiesEXT = vk.PfnGetPipelinePropertiesEXT(proc(t.GetPipelinePropertiesEXT.String()))
+	t.CmdSetPatchControlPointsEXT = vk.PfnCmdSetPatchControlPointsEXT(proc(t.CmdSetPatchControlPointsEXT.String()))
+	t.CmdSetRasterizerDiscardEnableEXT = vk.PfnCmdSetRasterizerDiscardEnableEXT(proc(t.CmdSetRasterizerDiscardEnableEXT.String()))
+	t.CmdSetDepthBiasEnableEXT = vk.PfnCmdSetDepthBiasEnableEXT(proc(t.CmdSetDepthBiasEnableEXT.String()))
+	t.CmdSetLogicOpEXT = vk.PfnCmdSetLogicOpEXT(proc(t.CmdSetLogicOpEXT.String()))
+	t.CmdSetPrimitiveRestartEnableEXT = vk.PfnCmdSetPrimitiveRestartEnableEXT(proc(t.CmdSetPrimitiveRestartEnableEXT.String()))
+	t.CmdSetColorWriteEnableEXT = vk.PfnCmdSetColorWriteEnableEXT(proc(t.CmdSetColorWriteEnableEXT.String()))
+	t.CmdDrawMultiEXT = vk.PfnCmdDrawMultiEXT(proc(t.CmdDrawMultiEXT.String()))
+	t.CmdDrawMultiIndexedEXT = vk.PfnCmdDrawMultiIndexedEXT(proc(t.CmdDrawMultiIndexedEXT.String()))
+	t.CreateMicromapEXT = vk.PfnCreateMicromapEXT(proc(t.CreateMicromapEXT.String()))
+	t.DestroyMicromapEXT = vk.PfnDestroyMicromapEXT(proc(t.DestroyMicromapEXT.String()))
+	t.CmdBuildMicromapsEXT = vk.PfnCmdBuildMicromapsEXT(proc(t.CmdBuildMicromapsEXT.String()))
+	t.BuildMicromapsEXT = vk.PfnBuildMicromapsEXT(proc(t.BuildMicromapsEXT.String()))
+	t.CopyMicromapEXT = vk.PfnCopyMicromapEXT(proc(t.CopyMicromapEXT.String()))
+	t.CopyMicromapToMemoryEXT = vk.PfnCopyMicromapToMemoryEXT(proc(t.CopyMicromapToMemoryEXT.String()))
+	t.CopyMemoryToMicromapEXT = vk.PfnCopyMemoryToMicromapEXT(proc(t.CopyMemoryToMicromapEXT.String()))
+	t.WriteMicromapsPropertiesEXT = vk.PfnWriteMicromapsPropertiesEXT(proc(t.WriteMicromapsPropertiesEXT.String()))
+	t.CmdCopyMicromapEXT = vk.PfnCmdCopyMicromapEXT(proc(t.CmdCopyMicromapEXT.String()))
+	t.CmdCopyMicromapToMemoryEXT = vk.PfnCmdCopyMicromapToMemoryEXT(proc(t.CmdCopyMicromapToMemoryEXT.String()))
+	t.CmdCopyMemoryToMicromapEXT = vk.PfnCmdCopyMemoryToMicromapEXT(proc(t.CmdCopyMemoryToMicromapEXT.String()))
+	t.CmdWriteMicromapsPropertiesEXT = vk.PfnCmdWriteMicromapsPropertiesEXT(proc(t.CmdWriteMicromapsPropertiesEXT.String()))
+	t.GetDeviceMicromapCompatibilityEXT = vk.PfnGetDeviceMicromapCompatibilityEXT(proc(t.GetDeviceMicromapCompatibilityEXT.String()))
+	t.GetMicromapBuildSizesEXT = vk.PfnGetMicromapBuildSizesEXT(proc(t.GetMicromapBuildSizesEXT.String()))
+	t.CmdDrawClusterHUAWEI = vk.PfnCmdDrawClusterHUAWEI(proc(t.CmdDrawClusterHUAWEI.String()))
+	t.CmdDrawClusterIndirectHUAWEI = vk.PfnCmdDrawClusterIndirectHUAWEI(proc(t.CmdDrawClusterIndirectHUAWEI.String()))
+	t.SetDeviceMemoryPriorityEXT = vk.PfnSetDeviceMemoryPriorityEXT(proc(t.SetDeviceMemoryPriorityEXT.String()))
+	t.GetDescriptorSetLayoutHostMappingInfoVALVE = vk.PfnGetDescriptorSetLayoutHostMappingInfoVALVE(proc(t.GetDescriptorSetLayoutHostMappingInfoVALVE.String()))
+	t.GetDescriptorSetHostMappingVALVE = vk.PfnGetDescriptorSetHostMappingVALVE(proc(t.GetDescriptorSetHostMappingVALVE.String()))
+	t.CmdCopyMemoryIndirectNV = vk.PfnCmdCopyMemoryIndirectNV(proc(t.CmdCopyMemoryIndirectNV.String()))
+	t.CmdCopyMemoryToImageIndirectNV = vk.PfnCmdCopyMemoryToImageIndirectNV(proc(t.CmdCopyMemoryToImageIndirectNV.String()))
+	t.CmdDecompressMemoryNV = vk.PfnCmdDecompressMemoryNV(proc(t.CmdDecompressMemoryNV.String()))
+	t.CmdDecompressMemoryIndirectCountNV = vk.PfnCmdDecompressMemoryIndirectCountNV(proc(t.CmdDecompressMemoryIndirectCountNV.String()))
+	t.CmdSetTessellationDomainOriginEXT = vk.PfnCmdSetTessellationDomainOriginEXT(proc(t.CmdSetTessellationDomainOriginEXT.String()))
+	t.CmdSetDepthClampEnableEXT = vk.PfnCmdSetDepthClampEnableEXT(proc(t.CmdSetDepthClampEnableEXT.String()))
+	t.CmdSetPolygonModeEXT = vk.PfnCmdSetPolygonModeEXT(proc(t.CmdSetPolygonModeEXT.String()))
+	t.CmdSetRasterizationSamplesEXT = vk.PfnCmdSetRasterizationSamplesEXT(proc(t.CmdSetRasterizationSamplesEXT.String()))
+	t.CmdSetSampleMaskEXT = vk.PfnCmdSetSampleMaskEXT(proc(t.CmdSetSampleMaskEXT.String()))
+	t.CmdSetAlphaToCoverageEnableEXT = vk.PfnCmdSetAlphaToCoverageEnableEXT(proc(t.CmdSetAlphaToCoverageEnableEXT.String()))
+	t.CmdSetAlphaToOneEnableEXT = vk.PfnCmdSetAlphaToOneEnableEXT(proc(t.CmdSetAlphaToOneEnableEXT.String()))
+	t.CmdSetLogicOpEnableEXT = vk.PfnCmdSetLogicOpEnableEXT(proc(t.CmdSetLogicOpEnableEXT.String()))
+	t.CmdSetColorBlendEnableEXT = vk.PfnCmdSetColorBlendEnableEXT(proc(t.CmdSetColorBlendEnableEXT.String()))
+	t.CmdSetColorBlendEquationEXT = vk.PfnCmdSetColorBlendEquationEXT(proc(t.CmdSetColorBlendEquationEXT.String()))
+	t.CmdSetColorWriteMaskEXT = vk.PfnCmdSetColorWriteMaskEXT(proc(t.CmdSetColorWriteMaskEXT.String()))
+	t.CmdSetRasterizationStreamEXT = vk.PfnCmdSetRasterizationStreamEXT(proc(t.CmdSetRasterizationStreamEXT.String()))
+	t.CmdSetConservativeRasterizationModeEXT = vk.PfnCmdSetConservativeRasterizationModeEXT(proc(t.CmdSetConservativeRasterizationModeEXT.String()))
+	t.CmdSetExtraPrimitiveOverestimationSizeEXT = vk.PfnCmdSetExtraPrimitiveOverestimationSizeEXT(proc(t.CmdSetExtraPrimitiveOverestimationSizeEXT.String()))
+	t.CmdSetDepthClipEnableEXT = vk.PfnCmdSetDepthClipEnableEXT(proc(t.CmdSetDepthClipEnableEXT.String()))
+	t.CmdSetSampleLocationsEnableEXT = vk.PfnCmdSetSampleLocationsEnableEXT(proc(t.CmdSetSampleLocationsEnableEXT.String()))
+	t.CmdSetColorBlendAdvancedEXT = vk.PfnCmdSetColorBlendAdvancedEXT(proc(t.CmdSetColorBlendAdvancedEXT.String()))
+	t.CmdSetProvokingVertexModeEXT = vk.PfnCmdSetProvokingVertexModeEXT(proc(t.CmdSetProvokingVertexModeEXT.String()))
+	t.CmdSetLineRasterizationModeEXT = vk.PfnCmdSetLineRasterizationModeEXT(proc(t.CmdSetLineRasterizationModeEXT.String()))
+	t.CmdSetLineStippleEnableEXT = vk.PfnCmdSetLineStippleEnableEXT(proc(t.CmdSetLineStippleEnableEXT.String()))
+	t.CmdSetDepthClipNegativeOneToOneEXT = vk.PfnCmdSetDepthClipNegativeOneToOneEXT(proc(t.CmdSetDepthClipNegativeOneToOneEXT.String()))
+	t.CmdSetViewportWScalingEnableNV = vk.PfnCmdSetViewportWScalingEnableNV(proc(t.CmdSetViewportWScalingEnableNV.String()))
+	t.CmdSetViewportSwizzleNV = vk.PfnCmdSetViewportSwizzleNV(proc(t.CmdSetViewportSwizzleNV.String()))
+	t.CmdSetCoverageToColorEnableNV = vk.PfnCmdSetCoverageToColorEnableNV(proc(t.CmdSetCoverageToColorEnableNV.String()))
+	t.CmdSetCoverageToColorLocationNV = vk.PfnCmdSetCoverageToColorLocationNV(proc(t.CmdSetCoverageToColorLocationNV.String()))
+	t.CmdSetCoverageModulationModeNV = vk.PfnCmdSetCoverageModulationModeNV(proc(t.CmdSetCoverageModulationModeNV.String()))
+	t.CmdSetCoverageModulationTableEnableNV = vk.PfnCmdSetCoverageModulationTableEnableNV(proc(t.CmdSetCoverageModulationTableEnableNV.String()))
+	t.CmdSetCoverageModulationTableNV = vk.PfnCmdSetCoverageModulationTableNV(proc(t.CmdSetCoverageModulationTableNV.String()))
+	t.CmdSetShadingRateImageEnableNV = vk.PfnCmdSetShadingRateImageEnableNV(proc(t.CmdSetShadingRateImageEnableNV.String()))
+	t.CmdSetRepresentativeFragmentTestEnableNV = vk.PfnCmdSetRepresentativeFragmentTestEnableNV(proc(t.CmdSetRepresentativeFragmentTestEnableNV.String()))
+	t.CmdSetCoverageReductionModeNV = vk.PfnCmdSetCoverageReductionModeNV(proc(t.CmdSetCoverageReductionModeNV.String()))
+	t.GetShaderModuleIdentifierEXT = vk.PfnGetShaderModuleIdentifierEXT(proc(t.GetShaderModuleIdentifierEXT.String()))
+	t.GetShaderModuleCreateInfoIdentifierEXT = vk.PfnGetShaderModuleCreateInfoIdentifierEXT(proc(t.GetShaderModuleCreateInfoIdentifierEXT.String()))
+	t.CreateOpticalFlowSessionNV = vk.PfnCreateOpticalFlowSessionNV(proc(t.CreateOpticalFlowSessionNV.String()))
+	t.DestroyOpticalFlowSessionNV = vk.PfnDestroyOpticalFlowSessionNV(proc(t.DestroyOpticalFlowSessionNV.String()))
+	t.BindOpticalFlowSessionImageNV = vk.PfnBindOpticalFlowSessionImageNV(proc(t.BindOpticalFlowSessionImageNV.String()))
+	t.CmdOpticalFlowExecuteNV = vk.PfnCmdOpticalFlowExecuteNV(proc(t.CmdOpticalFlowExecuteNV.String()))
+	t.GetFramebufferTilePropertiesQCOM = vk.PfnGetFramebufferTilePropertiesQCOM(proc(t.GetFramebufferTilePropertiesQCOM.String()))
+	t.GetDynamicRenderingTilePropertiesQCOM = vk.PfnGetDynamicRenderingTilePropertiesQCOM(proc(t.GetDynamicRenderingTilePropertiesQCOM.String()))
+}
+
+// DestroyInstance calls vkDestroyInstance.
+func (i Instance) DestroyInstance() {
+	i.InstanceTable.DestroyInstance.Call(i.Instance, i.Allocator)
+}
+
+// EnumeratePhysicalDevices calls vkEnumeratePhysicalDevices.
+func (i Instance) EnumeratePhysicalDevices() ([]PhysicalDevice, error) {
+	for {
+		var n uint32
+		if ret := i.InstanceTable.EnumeratePhysicalDevices.Call(i.Instance, &n, nil); ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		physicalDevices := make([]vk.PhysicalDevice, n)
+		ret := i.InstanceTable.EnumeratePhysicalDevices.Call(i.Instance, &n, &physicalDevices[0])
+		if ret == vk.INCOMPLETE {
+			continue // the list grew between the two calls
+		}
+		if ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		wrapped := make([]PhysicalDevice, n)
+		for k, h := range physicalDevices[:n] {
+			wrapped[k] = PhysicalDevice{h, i.InstanceTable}
+		}
+		return wrapped, nil
+	}
+}
+
+// GetPhysicalDeviceFeatures calls vkGetPhysicalDeviceFeatures.
+func (p PhysicalDevice) GetPhysicalDeviceFeatures() vk.PhysicalDeviceFeatures {
+	var features vk.PhysicalDeviceFeatures
+	p.InstanceTable.GetPhysicalDeviceFeatures.Call(p.PhysicalDevice, &features)
+	return features
+}
+
+// GetPhysicalDeviceFormatProperties calls vkGetPhysicalDeviceFormatProperties.
+func (p PhysicalDevice) GetPhysicalDeviceFormatProperties(format vk.Format) vk.FormatProperties {
+	var formatProperties vk.FormatProperties
+	p.InstanceTable.GetPhysicalDeviceFormatProperties.Call(p.PhysicalDevice, format, &formatProperties)
+	return formatProperties
+}
+
+// GetPhysicalDeviceImageFormatProperties calls vkGetPhysicalDeviceImageFormatProperties.
+func (p PhysicalDevice) GetPhysicalDeviceImageFormatProperties(format vk.Format, type_ vk.ImageType, tiling vk.ImageTiling, usage vk.ImageUsageFlags, flags vk.ImageCreateFlags) (vk.ImageFormatProperties, error) {
+	var imageFormatProperties vk.ImageFormatProperties
+	ret := p.InstanceTable.GetPhysicalDeviceImageFormatProperties.Call(p.PhysicalDevice, format, type_, tiling, usage, flags, &imageFormatProperties)
+	return imageFormatProperties, ret.Err()
+}
+
+// GetPhysicalDeviceProperties calls vkGetPhysicalDeviceProperties.
+func (p PhysicalDevice) GetPhysicalDeviceProperties() vk.PhysicalDeviceProperties {
+	var properties vk.PhysicalDeviceProperties
+	p.InstanceTable.GetPhysicalDeviceProperties.Call(p.PhysicalDevice, &properties)
+	return properties
+}
+
+// GetPhysicalDeviceQueueFamilyProperties calls vkGetPhysicalDeviceQueueFamilyProperties.
+func (p PhysicalDevice) GetPhysicalDeviceQueueFamilyProperties() []vk.QueueFamilyProperties {
+	var n uint32
+	p.InstanceTable.GetPhysicalDeviceQueueFamilyProperties.Call(p.PhysicalDevice, &n, nil)
+	if n == 0 {
+		return nil
+	}
+	queueFamilyProperties := make([]vk.QueueFamilyProperties, n)
+	p.InstanceTable.GetPhysicalDeviceQueueFamilyProperties.Call(p.PhysicalDevice, &n, &queueFamilyProperties[0])
+	return queueFamilyProperties[:n]
+}
+
+// GetPhysicalDeviceMemoryProperties calls vkGetPhysicalDeviceMemoryProperties.
+func (p PhysicalDevice) GetPhysicalDeviceMemoryProperties() vk.PhysicalDeviceMemoryProperties {
+	var memoryProperties vk.PhysicalDeviceMemoryProperties
+	p.InstanceTable.GetPhysicalDeviceMemoryProperties.Call(p.PhysicalDevice, &memoryProperties)
+	return memoryProperties
+}
+
+// CreateDevice calls vkCreateDevice.
+func (p PhysicalDevice) CreateDevice(pCreateInfo *vk.DeviceCreateInfo) (Device, error) {
+	var device vk.Device
+	ret := p.InstanceTable.CreateDevice.Call(p.PhysicalDevice, pCreateInfo, p.Allocator, &device)
+	return newDevice(device, p.InstanceTable), ret.Err()
+}
+
+// DestroyDevice calls vkDestroyDevice.
+func (d Device) DestroyDevice() {
+	d.DeviceTable.DestroyDevice.Call(d.Device, d.Allocator)
+}
+
+// EnumerateDeviceExtensionProperties calls vkEnumerateDeviceExtensionProperties.
+func (p PhysicalDevice) EnumerateDeviceExtensionProperties(layerName string) ([]vk.ExtensionProperties, error) {
+	pLayerName, free := vk.CStrOrNil(layerName)
+	defer free()
+	for {
+		var n uint32
+		if ret := p.InstanceTable.EnumerateDeviceExtensionProperties.Call(p.PhysicalDevice, pLayerName, &n, nil); ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		properties := make([]vk.ExtensionProperties, n)
+		ret := p.InstanceTable.EnumerateDeviceExtensionProperties.Call(p.PhysicalDevice, pLayerName, &n, &properties[0])
+		if ret == vk.INCOMPLETE {
+			continue // the list grew between the two calls
+		}
+		if ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		return properties[:n], nil
+	}
+}
+
+// EnumerateDeviceLayerProperties calls vkEnumerateDeviceLayerProperties.
+func (p PhysicalDevice) EnumerateDeviceLayerProperties() ([]vk.LayerProperties, error) {
+	for {
+		var n uint32
+		if ret := p.InstanceTable.EnumerateDeviceLayerProperties.Call(p.PhysicalDevice, &n, nil); ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		properties := make([]vk.LayerProperties, n)
+		ret := p.InstanceTable.EnumerateDeviceLayerProperties.Call(p.PhysicalDevice, &n, &properties[0])
+		if ret == vk.INCOMPLETE {
+			continue // the list grew between the two calls
+		}
+		if ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		return properties[:n], nil
+	}
+}
+
+// GetDeviceQueue calls vkGetDeviceQueue.
+func (d Device) GetDeviceQueue(queueFamilyIndex, queueIndex uint32) Queue {
+	var queue vk.Queue
+	d.DeviceTable.GetDeviceQueue.Call(d.Device, queueFamilyIndex, queueIndex, &queue)
+	return Queue{queue, d.DeviceTable}
+}
+
+// QueueSubmit calls vkQueueSubmit.
+func (q Queue) QueueSubmit(submits []vk.SubmitInfo, fence vk.Fence) error {
+	var pSubmits *vk.SubmitInfo
+	if len(submits) > 0 {
+		pSubmits = &submits[0]
+	}
+	return q.DeviceTable.QueueSubmit.Call(q.Queue, uint32(len(submits)), pSubmits, fence).Err()
+}
+
+// QueueWaitIdle calls vkQueueWaitIdle.
+func (q Queue) QueueWaitIdle() error {
+	return q.DeviceTable.QueueWaitIdle.Call(q.Queue).Err()
+}
+
+// DeviceWaitIdle calls vkDeviceWaitIdle.
+func (d Device) DeviceWaitIdle() error {
+	return d.DeviceTable.DeviceWaitIdle.Call(d.Device).Err()
+}
+
+// AllocateMemory calls vkAllocateMemory.
+func (d Device) AllocateMemory(pAllocateInfo *vk.MemoryAllocateInfo) (vk.DeviceMemory, error) {
+	var memory vk.DeviceMemory
+	ret := d.DeviceTable.AllocateMemory.Call(d.Device, pAllocateInfo, d.Allocator, &memory)
+	return memory, ret.Err()
+}
+
+// FreeMemory calls vkFreeMemory.
+func (d Device) FreeMemory(memory vk.DeviceMemory) {
+	d.DeviceTable.FreeMemory.Call(d.Device, memory, d.Allocator)
+}
+
+// MapMemory calls vkMapMemory.
+func (d Device) MapMemory(memory vk.DeviceMemory, offset, size vk.DeviceSize, flags vk.MemoryMapFlags) (unsafe.Pointer, error) {
+	var data unsafe.Pointer
+	ret := d.DeviceTable.MapMemory.Call(d.Device, memory, offset, size, flags, &data)
+	return data, ret.Err()
+}
+
+// UnmapMemory calls vkUnmapMemory.
+func (d Device) UnmapMemory(memory vk.DeviceMemory) {
+	d.DeviceTable.UnmapMemory.Call(d.Device, memory)
+}
+
+// FlushMappedMemoryRanges calls vkFlushMappedMemoryRanges.
+func (d Device) FlushMappedMemoryRanges(memoryRanges []vk.MappedMemoryRange) error {
+	var pMemoryRanges *vk.MappedMemoryRange
+	if len(memoryRanges) > 0 {
+		pMemoryRanges = &memoryRanges[0]
+	}
+	return d.DeviceTable.FlushMappedMemoryRanges.Call(d.Device, uint32(len(memoryRanges)), pMemoryRanges).Err()
+}
+
+// InvalidateMappedMemoryRanges calls vkInvalidateMappedMemoryRanges.
+func (d Device) InvalidateMappedMemoryRanges(memoryRanges []vk.MappedMemoryRange) error {
+	var pMemoryRanges *vk.MappedMemoryRange
+	if len(memoryRanges) > 0 {
+		pMemoryRanges = &memoryRanges[0]
+	}
+	return d.DeviceTable.InvalidateMappedMemoryRanges.Call(d.Device, uint32(len(memoryRanges)), pMemoryRanges).Err()
+}
+
+// GetDeviceMemoryCommitment calls vkGetDeviceMemoryCommitment.
+func (d Device) GetDeviceMemoryCommitment(memory vk.DeviceMemory) vk.DeviceSize {
+	var committedMemoryInBytes vk.DeviceSize
+	d.DeviceTable.GetDeviceMemoryCommitment.Call(d.Device, memory, &committedMemoryInBytes)
+	return committedMemoryInBytes
+}
+
+// BindBufferMemory calls vkBindBufferMemory.
+func (d Device) BindBufferMemory(buffer vk.Buffer, memory vk.DeviceMemory, memoryOffset vk.DeviceSize) error {
+	return d.DeviceTable.BindBufferMemory.Call(d.Device, buffer, memory, memoryOffset).Err()
+}
+
+// BindImageMemory calls vkBindImageMemory.
+func (d Device) BindImageMemory(image vk.Image, memory vk.DeviceMemory, memoryOffset vk.DeviceSize) error {
+	return d.DeviceTable.BindImageMemory.Call(d.Device, image, memory, memoryOffset).Err()
+}
+
+// GetBufferMemoryRequirements calls vkGetBufferMemoryRequirements.
+func (d Device) GetBufferMemoryRequirements(buffer vk.Buffer) vk.MemoryRequirements {
+	var memoryRequirements vk.MemoryRequirements
+	d.DeviceTable.GetBufferMemoryRequirements.Call(d.Device, buffer, &memoryRequirements)
+	return memoryRequirements
+}
+
+// GetImageMemoryRequirements calls vkGetImageMemoryRequirements.
+func (d Device) GetImageMemoryRequirements(image vk.Image) vk.MemoryRequirements {
+	var memoryRequirements vk.MemoryRequirements
+	d.DeviceTable.GetImageMemoryRequirements.Call(d.Device, image, &memoryRequirements)
+	return memoryRequirements
+}
+
+// GetImageSparseMemoryRequirements calls vkGetImageSparseMemoryRequirements.
+func (d Device) GetImageSparseMemoryRequirements(image vk.Image) []vk.SparseImageMemoryRequirements {
+	var n uint32
+	d.DeviceTable.GetImageSparseMemoryRequirements.Call(d.Device, image, &n, nil)
+	if n == 0 {
+		return nil
+	}
+	sparseMemoryRequirements := make([]vk.SparseImageMemoryRequirements, n)
+	d.DeviceTable.GetImageSparseMemoryRequirements.Call(d.Device, image, &n, &sparseMemoryRequirements[0])
+	return sparseMemoryRequirements[:n]
+}
+
+// GetPhysicalDeviceSparseImageFormatProperties calls vkGetPhysicalDeviceSparseImageFormatProperties.
+func (p PhysicalDevice) GetPhysicalDeviceSparseImageFormatProperties(format vk.Format, type_ vk.ImageType, samples vk.SampleCountFlags, usage vk.ImageUsageFlags, tiling vk.ImageTiling) []vk.SparseImageFormatProperties {
+	var n uint32
+	p.InstanceTable.GetPhysicalDeviceSparseImageFormatProperties.Call(p.PhysicalDevice, format, type_, samples, usage, tiling, &n, nil)
+	if n == 0 {
+		return nil
+	}
+	properties := make([]vk.SparseImageFormatProperties, n)
+	p.InstanceTable.GetPhysicalDeviceSparseImageFormatProperties.Call(p.PhysicalDevice, format, type_, samples, usage, tiling, &n, &properties[0])
+	return properties[:n]
+}
+
+// QueueBindSparse calls vkQueueBindSparse.
+func (q Queue) QueueBindSparse(bindInfo []vk.BindSparseInfo, fence vk.Fence) error {
+	var pBindInfo *vk.BindSparseInfo
+	if len(bindInfo) > 0 {
+		pBindInfo = &bindInfo[0]
+	}
+	return q.DeviceTable.QueueBindSparse.Call(q.Queue, uint32(len(bindInfo)), pBindInfo, fence).Err()
+}
+
+// CreateFence calls vkCreateFence.
+func (d Device) CreateFence(pCreateInfo *vk.FenceCreateInfo) (vk.Fence, error) {
+	var fence vk.Fence
+	ret := d.DeviceTable.CreateFence.Call(d.Device, pCreateInfo, d.Allocator, &fence)
+	return fence, ret.Err()
+}
+
+// DestroyFence calls vkDestroyFence.
+func (d Device) DestroyFence(fence vk.Fence) {
+	d.DeviceTable.DestroyFence.Call(d.Device, fence, d.Allocator)
+}
+
+// ResetFences calls vkResetFences.
+func (d Device) ResetFences(fences []vk.Fence) error {
+	var pFences *vk.Fence
+	if len(fences) > 0 {
+		pFences = &fences[0]
+	}
+	return d.DeviceTable.ResetFences.Call(d.Device, uint32(len(fences)), pFences).Err()
+}
+
+// GetFenceStatus calls vkGetFenceStatus.
+func (d Device) GetFenceStatus(fence vk.Fence) error {
+	return d.DeviceTable.GetFenceStatus.Call(d.Device, fence).Err()
+}
+
+// WaitForFences calls vkWaitForFences.
+func (d Device) WaitForFences(fences []vk.Fence, waitAll vk.Bool32, timeout uint64) error {
+	var pFences *vk.Fence
+	if len(fences) > 0 {
+		pFences = &fences[0]
+	}
+	return d.DeviceTable.WaitForFences.Call(d.Device, uint32(len(fences)), pFences, waitAll, timeout).Err()
+}
+
+// CreateSemaphore calls vkCreateSemaphore.
+func (d Device) CreateSemaphore(pCreateInfo *vk.SemaphoreCreateInfo) (vk.Semaphore, error) {
+	var semaphore vk.Semaphore
+	ret := d.DeviceTable.CreateSemaphore.Call(d.Device, pCreateInfo, d.Allocator, &semaphore)
+	return semaphore, ret.Err()
+}
+
+// DestroySemaphore calls vkDestroySemaphore.
+func (d Device) DestroySemaphore(semaphore vk.Semaphore) {
+	d.DeviceTable.DestroySemaphore.Call(d.Device, semaphore, d.Allocator)
+}
+
+// CreateEvent calls vkCreateEvent.
+func (d Device) CreateEvent(pCreateInfo *vk.EventCreateInfo) (vk.Event, error) {
+	var event vk.Event
+	ret := d.DeviceTable.CreateEvent.Call(d.Device, pCreateInfo, d.Allocator, &event)
+	return event, ret.Err()
+}
+
+// DestroyEvent calls vkDestroyEvent.
+func (d Device) DestroyEvent(event vk.Event) {
+	d.DeviceTable.DestroyEvent.Call(d.Device, event, d.Allocator)
+}
+
+// GetEventStatus calls vkGetEventStatus.
+func (d Device) GetEventStatus(event vk.Event) error {
+	return d.DeviceTable.GetEventStatus.Call(d.Device, event).Err()
+}
+
+// SetEvent calls vkSetEvent.
+func (d Device) SetEvent(event vk.Event) error {
+	return d.DeviceTable.SetEvent.Call(d.Device, event).Err()
+}
+
+// ResetEvent calls vkResetEvent.
+func (d Device) ResetEvent(event vk.Event) error {
+	return d.DeviceTable.ResetEvent.Call(d.Device, event).Err()
+}
+
+// CreateQueryPool calls vkCreateQueryPool.
+func (d Device) CreateQueryPool(pCreateInfo *vk.QueryPoolCreateInfo) (vk.QueryPool, error) {
+	var queryPool vk.QueryPool
+	ret := d.DeviceTable.CreateQueryPool.Call(d.Device, pCreateInfo, d.Allocator, &queryPool)
+	return queryPool, ret.Err()
+}
+
+// DestroyQueryPool calls vkDestroyQueryPool.
+func (d Device) DestroyQueryPool(queryPool vk.QueryPool) {
+	d.DeviceTable.DestroyQueryPool.Call(d.Device, queryPool, d.Allocator)
+}
+
+// GetQueryPoolResults calls vkGetQueryPoolResults.
+func (d Device) GetQueryPoolResults(queryPool vk.QueryPool, firstQuery, queryCount uint32, dataSize uintptr, pData unsafe.Pointer, stride vk.DeviceSize, flags vk.QueryResultFlags) error {
+	return d.DeviceTable.GetQueryPoolResults.Call(d.Device, queryPool, firstQuery, queryCount, dataSize, pData, stride, flags).Err()
+}
+
+// CreateBuffer calls vkCreateBuffer.
+func (d Device) CreateBuffer(pCreateInfo *vk.BufferCreateInfo) (vk.Buffer, error) {
+	var buffer vk.Buffer
+	ret := d.DeviceTable.CreateBuffer.Call(d.Device, pCreateInfo, d.Allocator, &buffer)
+	return buffer, ret.Err()
+}
+
+// DestroyBuffer calls vkDestroyBuffer.
+func (d Device) DestroyBuffer(buffer vk.Buffer) {
+	d.DeviceTable.DestroyBuffer.Call(d.Device, buffer, d.Allocator)
+}
+
+// CreateBufferView calls vkCreateBufferView.
+func (d Device) CreateBufferView(pCreateInfo *vk.BufferViewCreateInfo) (vk.BufferView, error) {
+	var view vk.BufferView
+	ret := d.DeviceTable.CreateBufferView.Call(d.Device, pCreateInfo, d.Allocator, &view)
+	return view, ret.Err()
+}
+
+// DestroyBufferView calls vkDestroyBufferView.
+func (d Device) DestroyBufferView(bufferView vk.BufferView) {
+	d.DeviceTable.DestroyBufferView.Call(d.Device, bufferView, d.Allocator)
+}
+
+// CreateImage calls vkCreateImage.
+func (d Device) CreateImage(pCreateInfo *vk.ImageCreateInfo) (vk.Image, error) {
+	var image vk.Image
+	ret := d.DeviceTable.CreateImage.Call(d.Device, pCreateInfo, d.Allocator, &image)
+	return image, ret.Err()
+}
+
+// DestroyImage calls vkDestroyImage.
+func (d Device) DestroyImage(image vk.Image) {
+	d.DeviceTable.DestroyImage.Call(d.Device, image, d.Allocator)
+}
+
+// GetImageSubresourceLayout calls vkGetImageSubresourceLayout.
+func (d Device) GetImageSubresourceLayout(image vk.Image, pSubresource *vk.ImageSubresource) vk.SubresourceLayout {
+	var layout vk.SubresourceLayout
+	d.DeviceTable.GetImageSubresourceLayout.Call(d.Device, image, pSubresource, &layout)
+	return layout
+}
+
+// CreateImageView calls vkCreateImageView.
+func (d Device) CreateImageView(pCreateInfo *vk.ImageViewCreateInfo) (vk.ImageView, error) {
+	var view vk.ImageView
+	ret := d.DeviceTable.CreateImageView.Call(d.Device, pCreateInfo, d.Allocator, &view)
+	return view, ret.Err()
+}
+
+// DestroyImageView calls vkDestroyImageView.
+func (d Device) DestroyImageView(imageView vk.ImageView) {
+	d.DeviceTable.DestroyImageView.Call(d.Device, imageView, d.Allocator)
+}
+
+// CreateShaderModule calls vkCreateShaderModule.
+func (d Device) CreateShaderModule(pCreateInfo *vk.ShaderModuleCreateInfo) (vk.ShaderModule, error) {
+	var shaderModule vk.ShaderModule
+	ret := d.DeviceTable.CreateShaderModule.Call(d.Device, pCreateInfo, d.Allocator, &shaderModule)
+	return shaderModule, ret.Err()
+}
+
+// DestroyShaderModule calls vkDestroyShaderModule.
+func (d Device) DestroyShaderModule(shaderModule vk.ShaderModule) {
+	d.DeviceTable.DestroyShaderModule.Call(d.Device, shaderModule, d.Allocator)
+}
+
+// CreatePipelineCache calls vkCreatePipelineCache.
+func (d Device) CreatePipelineCache(pCreateInfo *vk.PipelineCacheCreateInfo) (vk.PipelineCache, error) {
+	var pipelineCache vk.PipelineCache
+	ret := d.DeviceTable.CreatePipelineCache.Call(d.Device, pCreateInfo, d.Allocator, &pipelineCache)
+	return pipelineCache, ret.Err()
+}
+
+// DestroyPipelineCache calls vkDestroyPipelineCache.
+func (d Device) DestroyPipelineCache(pipelineCache vk.PipelineCache) {
+	d.DeviceTable.DestroyPipelineCache.Call(d.Device, pipelineCache, d.Allocator)
+}
+
+// GetPipelineCacheData calls vkGetPipelineCacheData.
+func (d Device) GetPipelineCacheData(pipelineCache vk.PipelineCache) ([]byte, error) {
+	for {
+		var n uintptr
+		if ret := d.DeviceTable.GetPipelineCacheData.Call(d.Device, pipelineCache, &n, nil); ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		data := make([]byte, n)
+		ret := d.DeviceTable.GetPipelineCacheData.Call(d.Device, pipelineCache, &n, unsafe.Pointer(&data[0]))
+		if ret == vk.INCOMPLETE {
+			continue // the list grew between the two calls
+		}
+		if ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		return data[:n], nil
+	}
+}
+
+// MergePipelineCaches calls vkMergePipelineCaches.
+func (d Device) MergePipelineCaches(dstCache vk.PipelineCache, srcCaches []vk.PipelineCache) error {
+	var pSrcCaches *vk.PipelineCache
+	if len(srcCaches) > 0 {
+		pSrcCaches = &srcCaches[0]
+	}
+	return d.DeviceTable.MergePipelineCaches.Call(d.Device, dstCache, uint32(len(srcCaches)), pSrcCaches).Err()
+}
+
+// CreateGraphicsPipelines calls vkCreateGraphicsPipelines.
+func (d Device) CreateGraphicsPipelines(pipelineCache vk.PipelineCache, createInfos []vk.GraphicsPipelineCreateInfo) ([]vk.Pipeline, error) {
+	var pCreateInfos *vk.GraphicsPipelineCreateInfo
+	if len(createInfos) > 0 {
+		pCreateInfos = &createInfos[0]
+	}
+	pipelines := make([]vk.Pipeline, len(createInfos))
+	var pPipelines *vk.Pipeline
+	if len(pipelines) > 0 {
+		pPipelines = &pipelines[0]
+	}
+	ret := d.DeviceTable.CreateGraphicsPipelines.Call(d.Device, pipelineCache, uint32(len(createInfos)), pCreateInfos, d.Allocator, pPipelines)
+	return pipelines, ret.Err()
+}
+
+// CreateComputePipelines calls vkCreateComputePipelines.
+func (d Device) CreateComputePipelines(pipelineCache vk.PipelineCache, createInfos []vk.ComputePipelineCreateInfo) ([]vk.Pipeline, error) {
+	var pCreateInfos *vk.ComputePipelineCreateInfo
+	if len(createInfos) > 0 {
+		pCreateInfos = &createInfos[0]
+	}
+	pipelines := make([]vk.Pipeline, len(createInfos))
+	var pPipelines *vk.Pipeline
+	if len(pipelines) > 0 {
+		pPipelines = &pipelines[0]
+	}
+	ret := d.DeviceTable.CreateComputePipelines.Call(d.Device, pipelineCache, uint32(len(createInfos)), pCreateInfos, d.Allocator, pPipelines)
+	return pipelines, ret.Err()
+}
+
+// DestroyPipeline calls vkDestroyPipeline.
+func (d Device) DestroyPipeline(pipeline vk.Pipeline) {
+	d.DeviceTable.DestroyPipeline.Call(d.Device, pipeline, d.Allocator)
+}
+
+// CreatePipelineLayout calls vkCreatePipelineLayout.
+func (d Device) CreatePipelineLayout(pCreateInfo *vk.PipelineLayoutCreateInfo) (vk.PipelineLayout, error) {
+	var pipelineLayout vk.PipelineLayout
+	ret := d.DeviceTable.CreatePipelineLayout.Call(d.Device, pCreateInfo, d.Allocator, &pipelineLayout)
+	return pipelineLayout, ret.Err()
+}
+
+// DestroyPipelineLayout calls vkDestroyPipelineLayout.
+func (d Device) DestroyPipelineLayout(pipelineLayout vk.PipelineLayout) {
+	d.DeviceTable.DestroyPipelineLayout.Call(d.Device, pipelineLayout, d.Allocator)
+}
+
+// CreateSampler calls vkCreateSampler.
+func (d Device) CreateSampler(pCreateInfo *vk.SamplerCreateInfo) (vk.Sampler, error) {
+	var sampler vk.Sampler
+	ret := d.DeviceTable.CreateSampler.Call(d.Device, pCreateInfo, d.Allocator, &sampler)
+	return sampler, ret.Err()
+}
+
+// DestroySampler calls vkDestroySampler.
+func (d Device) DestroySampler(sampler vk.Sampler) {
+	d.DeviceTable.DestroySampler.Call(d.Device, sampler, d.Allocator)
+}
+
+// CreateDescriptorSetLayout calls vkCreateDescriptorSetLayout.
+func (d Device) CreateDescriptorSetLayout(pCreateInfo *vk.DescriptorSetLayoutCreateInfo) (vk.DescriptorSetLayout, error) {
+	var setLayout vk.DescriptorSetLayout
+	ret := d.DeviceTable.CreateDescriptorSetLayout.Call(d.Device, pCreateInfo, d.Allocator, &setLayout)
+	return setLayout, ret.Err()
+}
+
+// DestroyDescriptorSetLayout calls vkDestroyDescriptorSetLayout.
+func (d Device) DestroyDescriptorSetLayout(descriptorSetLayout vk.DescriptorSetLayout) {
+	d.DeviceTable.DestroyDescriptorSetLayout.Call(d.Device, descriptorSetLayout, d.Allocator)
+}
+
+// CreateDescriptorPool calls vkCreateDescriptorPool.
+func (d Device) CreateDescriptorPool(pCreateInfo *vk.DescriptorPoolCreateInfo) (vk.DescriptorPool, error) {
+	var descriptorPool vk.DescriptorPool
+	ret := d.DeviceTable.CreateDescriptorPool.Call(d.Device, pCreateInfo, d.Allocator, &descriptorPool)
+	return descriptorPool, ret.Err()
+}
+
+// DestroyDescriptorPool calls vkDestroyDescriptorPool.
+func (d Device) DestroyDescriptorPool(descriptorPool vk.DescriptorPool) {
+	d.DeviceTable.DestroyDescriptorPool.Call(d.Device, descriptorPool, d.Allocator)
+}
+
+// ResetDescriptorPool calls vkResetDescriptorPool.
+func (d Device) ResetDescriptorPool(descriptorPool vk.DescriptorPool, flags vk.DescriptorPoolResetFlags) error {
+	return d.DeviceTable.ResetDescriptorPool.Call(d.Device, descriptorPool, flags).Err()
+}
+
+// AllocateDescriptorSets calls vkAllocateDescriptorSets.
+func (d Device) AllocateDescriptorSets(pAllocateInfo *vk.DescriptorSetAllocateInfo) ([]vk.DescriptorSet, error) {
+	descriptorSets := make([]vk.DescriptorSet, pAllocateInfo.DescriptorSetCount)
+	var pDescriptorSets *vk.DescriptorSet
+	if len(descriptorSets) > 0 {
+		pDescriptorSets = &descriptorSets[0]
+	}
+	ret := d.DeviceTable.AllocateDescriptorSets.Call(d.Device, pAllocateInfo, pDescriptorSets)
+	return descriptorSets, ret.Err()
+}
+
+// FreeDescriptorSets calls vkFreeDescriptorSets.
+func (d Device) FreeDescriptorSets(descriptorPool vk.DescriptorPool, descriptorSets []vk.DescriptorSet) error {
+	var pDescriptorSets *vk.DescriptorSet
+	if len(descriptorSets) > 0 {
+		pDescriptorSets = &descriptorSets[0]
+	}
+	return d.DeviceTable.FreeDescriptorSets.Call(d.Device, descriptorPool, uint32(len(descriptorSets)), pDescriptorSets).Err()
+}
+
+// UpdateDescriptorSets calls vkUpdateDescriptorSets.
+func (d Device) UpdateDescriptorSets(descriptorWrites []vk.WriteDescriptorSet, descriptorCopies []vk.CopyDescriptorSet) {
+	var pDescriptorWrites *vk.WriteDescriptorSet
+	if len(descriptorWrites) > 0 {
+		pDescriptorWrites = &descriptorWrites[0]
+	}
+	var pDescriptorCopies *vk.CopyDescriptorSet
+	if len(descriptorCopies) > 0 {
+		pDescriptorCopies = &descriptorCopies[0]
+	}
+	d.DeviceTable.UpdateDescriptorSets.Call(d.Device, uint32(len(descriptorWrites)), pDescriptorWrites, uint32(len(descriptorCopies)), pDescriptorCopies)
+}
+
+// CreateFramebuffer calls vkCreateFramebuffer.
+func (d Device) CreateFramebuffer(pCreateInfo *vk.FramebufferCreateInfo) (vk.Framebuffer, error) {
+	var framebuffer vk.Framebuffer
+	ret := d.DeviceTable.CreateFramebuffer.Call(d.Device, pCreateInfo, d.Allocator, &framebuffer)
+	return framebuffer, ret.Err()
+}
+
+// DestroyFramebuffer calls vkDestroyFramebuffer.
+func (d Device) DestroyFramebuffer(framebuffer vk.Framebuffer) {
+	d.DeviceTable.DestroyFramebuffer.Call(d.Device, framebuffer, d.Allocator)
+}
+
+// CreateRenderPass calls vkCreateRenderPass.
+func (d Device) CreateRenderPass(pCreateInfo *vk.RenderPassCreateInfo) (vk.RenderPass, error) {
+	var renderPass vk.RenderPass
+	ret := d.DeviceTable.CreateRenderPass.Call(d.Device, pCreateInfo, d.Allocator, &renderPass)
+	return renderPass, ret.Err()
+}
+
+// DestroyRenderPass calls vkDestroyRenderPass.
+func (d Device) DestroyRenderPass(renderPass vk.RenderPass) {
+	d.DeviceTable.DestroyRenderPass.Call(d.Device, renderPass, d.Allocator)
+}
+
+// GetRenderAreaGranularity calls vkGetRenderAreaGranularity.
+func (d Device) GetRenderAreaGranularity(renderPass vk.RenderPass) vk.Extent2D {
+	var granularity vk.Extent2D
+	d.DeviceTable.GetRenderAreaGranularity.Call(d.Device, renderPass, &granularity)
+	return granularity
+}
+
+// CreateCommandPool calls vkCreateCommandPool.
+func (d Device) CreateCommandPool(pCreateInfo *vk.CommandPoolCreateInfo) (vk.CommandPool, error) {
+	var commandPool vk.CommandPool
+	ret := d.DeviceTable.CreateCommandPool.Call(d.Device, pCreateInfo, d.Allocator, &commandPool)
+	return commandPool, ret.Err()
+}
+
+// DestroyCommandPool calls vkDestroyCommandPool.
+func (d Device) DestroyCommandPool(commandPool vk.CommandPool) {
+	d.DeviceTable.DestroyCommandPool.Call(d.Device, commandPool, d.Allocator)
+}
+
+// ResetCommandPool calls vkResetCommandPool.
+func (d Device) ResetCommandPool(commandPool vk.CommandPool, flags vk.CommandPoolResetFlags) error {
+	return d.DeviceTable.ResetCommandPool.Call(d.Device, commandPool, flags).Err()
+}
+
+// AllocateCommandBuffers calls vkAllocateCommandBuffers.
+func (d Device) AllocateCommandBuffers(pAllocateInfo *vk.CommandBufferAllocateInfo) ([]CommandBuffer, error) {
+	commandBuffers := make([]vk.CommandBuffer, pAllocateInfo.CommandBufferCount)
+	var pCommandBuffers *vk.CommandBuffer
+	if len(commandBuffers) > 0 {
+		pCommandBuffers = &commandBuffers[0]
+	}
+	ret := d.DeviceTable.AllocateCommandBuffers.Call(d.Device, pAllocateInfo, pCommandBuffers)
+	wrapped := make([]CommandBuffer, len(commandBuffers))
+	for k, h := range commandBuffers {
+		wrapped[k] = CommandBuffer{h, d.DeviceTable}
+	}
+	return wrapped, ret.Err()
+}
+
+// FreeCommandBuffers calls vkFreeCommandBuffers.
+func (d Device) FreeCommandBuffers(commandPool vk.CommandPool, commandBuffers []CommandBuffer) {
+	var pCommandBuffers *vk.CommandBuffer
+	if len(commandBuffers) > 0 {
+		handles := make([]vk.CommandBuffer, len(commandBuffers))
+		for k, x := range commandBuffers {
+			handles[k] = x.CommandBuffer
+		}
+		pCommandBuffers = &handles[0]
+	}
+	d.DeviceTable.FreeCommandBuffers.Call(d.Device, commandPool, uint32(len(commandBuffers)), pCommandBuffers)
+}
+
+// BeginCommandBuffer calls vkBeginCommandBuffer.
+func (c CommandBuffer) BeginCommandBuffer(pBeginInfo *vk.CommandBufferBeginInfo) error {
+	return c.DeviceTable.BeginCommandBuffer.Call(c.CommandBuffer, pBeginInfo).Err()
+}
+
+// EndCommandBuffer calls vkEndCommandBuffer.
+func (c CommandBuffer) EndCommandBuffer() error {
+	return c.DeviceTable.EndCommandBuffer.Call(c.CommandBuffer).Err()
+}
+
+// ResetCommandBuffer calls vkResetCommandBuffer.
+func (c CommandBuffer) ResetCommandBuffer(flags vk.CommandBufferResetFlags) error {
+	return c.DeviceTable.ResetCommandBuffer.Call(c.CommandBuffer, flags).Err()
+}
+
+// CmdBindPipeline calls vkCmdBindPipeline.
+func (c CommandBuffer) CmdBindPipeline(pipelineBindPoint vk.PipelineBindPoint, pipeline vk.Pipeline) {
+	c.DeviceTable.CmdBindPipeline.Call(c.CommandBuffer, pipelineBindPoint, pipeline)
+}
+
+// CmdSetViewport calls vkCmdSetViewport.
+func (c CommandBuffer) CmdSetViewport(firstViewport uint32, viewports []vk.Viewport) {
+	var pViewports *vk.Viewport
+	if len(viewports) > 0 {
+		pViewports = &viewports[0]
+	}
+	c.DeviceTable.CmdSetViewport.Call(c.CommandBuffer, firstViewport, uint32(len(viewports)), pViewports)
+}
+
+// CmdSetScissor calls vkCmdSetScissor.
+func (c CommandBuffer) CmdSetScissor(firstScissor uint32, scissors []vk.Rect2D) {
+	var pScissors *vk.Rect2D
+	if len(scissors) > 0 {
+		pScissors = &scissors[0]
+	}
+	c.DeviceTable.CmdSetScissor.Call(c.CommandBuffer, firstScissor, uint32(len(scissors)), pScissors)
+}
+
+// CmdSetLineWidth calls vkCmdSetLineWidth.
+func (c CommandBuffer) CmdSetLineWidth(lineWidth float32) {
+	c.DeviceTable.CmdSetLineWidth.Call(c.CommandBuffer, lineWidth)
+}
+
+// CmdSetDepthBias calls vkCmdSetDepthBias.
+func (c CommandBuffer) CmdSetDepthBias(depthBiasConstantFactor, depthBiasClamp, depthBiasSlopeFactor float32) {
+	c.DeviceTable.CmdSetDepthBias.Call(c.CommandBuffer, depthBiasConstantFactor, depthBiasClamp, depthBiasSlopeFactor)
+}
+
+// CmdSetBlendConstants calls vkCmdSetBlendConstants.
+func (c CommandBuffer) CmdSetBlendConstants(blendConstants *[4]float32) {
+	c.DeviceTable.CmdSetBlendConstants.Call(c.CommandBuffer, blendConstants)
+}
+
+// CmdSetDepthBounds calls vkCmdSetDepthBounds.
+func (c CommandBuffer) CmdSetDepthBounds(minDepthBounds, maxDepthBounds float32) {
+	c.DeviceTable.CmdSetDepthBounds.Call(c.CommandBuffer, minDepthBounds, maxDepthBounds)
+}
+
+// CmdSetStencilCompareMask calls vkCmdSetStencilCompareMask.
+func (c CommandBuffer) CmdSetStencilCompareMask(faceMask vk.StencilFaceFlags, compareMask uint32) {
+	c.DeviceTable.CmdSetStencilCompareMask.Call(c.CommandBuffer, faceMask, compareMask)
+}
+
+// CmdSetStencilWriteMask calls vkCmdSetStencilWriteMask.
+func (c CommandBuffer) CmdSetStencilWriteMask(faceMask vk.StencilFaceFlags, writeMask uint32) {
+	c.DeviceTable.CmdSetStencilWriteMask.Call(c.CommandBuffer, faceMask, writeMask)
+}
+
+// CmdSetStencilReference calls vkCmdSetStencilReference.
+func (c CommandBuffer) CmdSetStencilReference(faceMask vk.StencilFaceFlags, reference uint32) {
+	c.DeviceTable.CmdSetStencilReference.Call(c.CommandBuffer, faceMask, reference)
+}
+
+// CmdBindDescriptorSets calls vkCmdBindDescriptorSets.
+func (c CommandBuffer) CmdBindDescriptorSets(pipelineBindPoint vk.PipelineBindPoint, layout vk.PipelineLayout, firstSet uint32, descriptorSets []vk.DescriptorSet, dynamicOffsets []uint32) {
+	var pDescriptorSets *vk.DescriptorSet
+	if len(descriptorSets) > 0 {
+		pDescriptorSets = &descriptorSets[0]
+	}
+	var pDynamicOffsets *uint32
+	if len(dynamicOffsets) > 0 {
+		pDynamicOffsets = &dynamicOffsets[0]
+	}
+	c.DeviceTable.CmdBindDescriptorSets.Call(c.CommandBuffer, pipelineBindPoint, layout, firstSet, uint32(len(descriptorSets)), pDescriptorSets, uint32(len(dynamicOffsets)), pDynamicOffsets)
+}
+
+// CmdBindIndexBuffer calls vkCmdBindIndexBuffer.
+func (c CommandBuffer) CmdBindIndexBuffer(buffer vk.Buffer, offset vk.DeviceSize, indexType vk.IndexType) {
+	c.DeviceTable.CmdBindIndexBuffer.Call(c.CommandBuffer, buffer, offset, indexType)
+}
+
+// CmdBindVertexBuffers calls vkCmdBindVertexBuffers.
+func (c CommandBuffer) CmdBindVertexBuffers(firstBinding, bindingCount uint32, pBuffers *vk.Buffer, pOffsets *vk.DeviceSize) {
+	c.DeviceTable.CmdBindVertexBuffers.Call(c.CommandBuffer, firstBinding, bindingCount, pBuffers, pOffsets)
+}
+
+// CmdDraw calls vkCmdDraw.
+func (c CommandBuffer) CmdDraw(vertexCount, instanceCount, firstVertex, firstInstance uint32) {
+	c.DeviceTable.CmdDraw.Call(c.CommandBuffer, vertexCount, instanceCount, firstVertex, firstInstance)
+}
+
+// CmdDrawIndexed calls vkCmdDrawIndexed.
+func (c CommandBuffer) CmdDrawIndexed(indexCount, instanceCount, firstIndex uint32, vertexOffset int32, firstInstance uint32) {
+	c.DeviceTable.CmdDrawIndexed.Call(c.CommandBuffer, indexCount, instanceCount, firstIndex, vertexOffset, firstInstance)
+}
+
+// CmdDrawIndirect calls vkCmdDrawIndirect.
+func (c CommandBuffer) CmdDrawIndirect(buffer vk.Buffer, offset vk.DeviceSize, drawCount, stride uint32) {
+	c.DeviceTable.CmdDrawIndirect.Call(c.CommandBuffer, buffer, offset, drawCount, stride)
+}
+
+// CmdDrawIndexedIndirect calls vkCmdDrawIndexedIndirect.
+func (c CommandBuffer) CmdDrawIndexedIndirect(buffer vk.Buffer, offset vk.DeviceSize, drawCount, stride uint32) {
+	c.DeviceTable.CmdDrawIndexedIndirect.Call(c.CommandBuffer, buffer, offset, drawCount, stride)
+}
+
+// CmdDispatch calls vkCmdDispatch.
+func (c CommandBuffer) CmdDispatch(groupCountX, groupCountY, groupCountZ uint32) {
+	c.DeviceTable.CmdDispatch.Call(c.CommandBuffer, groupCountX, groupCountY, groupCountZ)
+}
+
+// CmdDispatchIndirect calls vkCmdDispatchIndirect.
+func (c CommandBuffer) CmdDispatchIndirect(buffer vk.Buffer, offset vk.DeviceSize) {
+	c.DeviceTable.CmdDispatchIndirect.Call(c.CommandBuffer, buffer, offset)
+}
+
+// CmdCopyBuffer calls vkCmdCopyBuffer.
+func (c CommandBuffer) CmdCopyBuffer(srcBuffer, dstBuffer vk.Buffer, regions []vk.BufferCopy) {
+	var pRegions *vk.BufferCopy
+	if len(regions) > 0 {
+		pRegions = &regions[0]
+	}
+	c.DeviceTable.CmdCopyBuffer.Call(c.CommandBuffer, srcBuffer, dstBuffer, uint32(len(regions)), pRegions)
+}
+
+// CmdCopyImage calls vkCmdCopyImage.
+func (c CommandBuffer) CmdCopyImage(srcImage vk.Image, srcImageLayout vk.ImageLayout, dstImage vk.Image, dstImageLayout vk.ImageLayout, regions []vk.ImageCopy) {
+	var pRegions *vk.ImageCopy
+	if len(regions) > 0 {
+		pRegions = &regions[0]
+	}
+	c.DeviceTable.CmdCopyImage.Call(c.CommandBuffer, srcImage, srcImageLayout, dstImage, dstImageLayout, uint32(len(regions)), pRegions)
+}
+
+// CmdBlitImage calls vkCmdBlitImage.
+func (c CommandBuffer) CmdBlitImage(srcImage vk.Image, srcImageLayout vk.ImageLayout, dstImage vk.Image, dstImageLayout vk.ImageLayout, regions []vk.ImageBlit, filter vk.Filter) {
+	var pRegions *vk.ImageBlit
+	if len(regions) > 0 {
+		pRegions = &regions[0]
+	}
+	c.DeviceTable.CmdBlitImage.Call(c.CommandBuffer, srcImage, srcImageLayout, dstImage, dstImageLayout, uint32(len(regions)), pRegions, filter)
+}
+
+// CmdCopyBufferToImage calls vkCmdCopyBufferToImage.
+func (c CommandBuffer) CmdCopyBufferToImage(srcBuffer vk.Buffer, dstImage vk.Image, dstImageLayout vk.ImageLayout, regions []vk.BufferImageCopy) {
+	var pRegions *vk.BufferImageCopy
+	if len(regions) > 0 {
+		pRegions = &regions[0]
+	}
+	c.DeviceTable.CmdCopyBufferToImage.Call(c.CommandBuffer, srcBuffer, dstImage, dstImageLayout, uint32(len(regions)), pRegions)
+}
+
+// CmdCopyImageToBuffer calls vkCmdCopyImageToBuffer.
+func (c CommandBuffer) CmdCopyImageToBuffer(srcImage vk.Image, srcImageLayout vk.ImageLayout, dstBuffer vk.Buffer, regions []vk.BufferImageCopy) {
+	var pRegions *vk.BufferImageCopy
+	if len(regions) > 0 {
+		pRegions = &regions[0]
+	}
+	c.DeviceTable.CmdCopyImageToBuffer.Call(c.CommandBuffer, srcImage, srcImageLayout, dstBuffer, uint32(len(regions)), pRegions)
+}
+
+// CmdUpdateBuffer calls vkCmdUpdateBuffer.
+func (c CommandBuffer) CmdUpdateBuffer(dstBuffer vk.Buffer, dstOffset vk.DeviceSize, data []byte) {
+	var pData unsafe.Pointer
+	if len(data) > 0 {
+		pData = unsafe.Pointer(&data[0])
+	}
+	c.DeviceTable.CmdUpdateBuffer.Call(c.CommandBuffer, dstBuffer, dstOffset, vk.DeviceSize(len(data)), pData)
+}
+
+// CmdFillBuffer calls vkCmdFillBuffer.
+func (c CommandBuffer) CmdFillBuffer(dstBuffer vk.Buffer, dstOffset, size vk.DeviceSize, data uint32) {
+	c.DeviceTable.CmdFillBuffer.Call(c.CommandBuffer, dstBuffer, dstOffset, size, data)
+}
+
+// CmdClearColorImage calls vkCmdClearColorImage.
+func (c CommandBuffer) CmdClearColorImage(image vk.Image, imageLayout vk.ImageLayout, pColor *vk.ClearColorValue, ranges []vk.ImageSubresourceRange) {
+	var pRanges *vk.ImageSubresourceRange
+	if len(ranges) > 0 {
+		pRanges = &ranges[0]
+	}
+	c.DeviceTable.CmdClearColorImage.Call(c.CommandBuffer, image, imageLayout, pColor, uint32(len(ranges)), pRanges)
+}
+
+// CmdClearDepthStencilImage calls vkCmdClearDepthStencilImage.
+func (c CommandBuffer) CmdClearDepthStencilImage(image vk.Image, imageLayout vk.ImageLayout, pDepthStencil *vk.ClearDepthStencilValue, ranges []vk.ImageSubresourceRange) {
+	var pRanges *vk.ImageSubresourceRange
+	if len(ranges) > 0 {
+		pRanges = &ranges[0]
+	}
+	c.DeviceTable.CmdClearDepthStencilImage.Call(c.CommandBuffer, image, imageLayout, pDepthStencil, uint32(len(ranges)), pRanges)
+}
+
+// CmdClearAttachments calls vkCmdClearAttachments.
+func (c CommandBuffer) CmdClearAttachments(attachments []vk.ClearAttachment, rects []vk.ClearRect) {
+	var pAttachments *vk.ClearAttachment
+	if len(attachments) > 0 {
+		pAttachments = &attachments[0]
+	}
+	var pRects *vk.ClearRect
+	if len(rects) > 0 {
+		pRects = &rects[0]
+	}
+	c.DeviceTable.CmdClearAttachments.Call(c.CommandBuffer, uint32(len(attachments)), pAttachments, uint32(len(rects)), pRects)
+}
+
+// CmdResolveImage calls vkCmdResolveImage.
+func (c CommandBuffer) CmdResolveImage(srcImage vk.Image, srcImageLayout vk.ImageLayout, dstImage vk.Image, dstImageLayout vk.ImageLayout, regions []vk.ImageResolve) {
+	var pRegions *vk.ImageResolve
+	if len(regions) > 0 {
+		pRegions = &regions[0]
+	}
+	c.DeviceTable.CmdResolveImage.Call(c.CommandBuffer, srcImage, srcImageLayout, dstImage, dstImageLayout, uint32(len(regions)), pRegions)
+}
+
+// CmdSetEvent calls vkCmdSetEvent.
+func (c CommandBuffer) CmdSetEvent(event vk.Event, stageMask vk.PipelineStageFlags) {
+	c.DeviceTable.CmdSetEvent.Call(c.CommandBuffer, event, stageMask)
+}
+
+// CmdResetEvent calls vkCmdResetEvent.
+func (c CommandBuffer) CmdResetEvent(event vk.Event, stageMask vk.PipelineStageFlags) {
+	c.DeviceTable.CmdResetEvent.Call(c.CommandBuffer, event, stageMask)
+}
+
+// CmdWaitEvents calls vkCmdWaitEvents.
+func (c CommandBuffer) CmdWaitEvents(events []vk.Event, srcStageMask, dstStageMask vk.PipelineStageFlags, memoryBarriers []vk.MemoryBarrier, bufferMemoryBarriers []vk.BufferMemoryBarrier, imageMemoryBarriers []vk.ImageMemoryBarrier) {
+	var pEvents *vk.Event
+	if len(events) > 0 {
+		pEvents = &events[0]
+	}
+	var pMemoryBarriers *vk.MemoryBarrier
+	if len(memoryBarriers) > 0 {
+		pMemoryBarriers = &memoryBarriers[0]
+	}
+	var pBufferMemoryBarriers *vk.BufferMemoryBarrier
+	if len(bufferMemoryBarriers) > 0 {
+		pBufferMemoryBarriers = &bufferMemoryBarriers[0]
+	}
+	var pImageMemoryBarriers *vk.ImageMemoryBarrier
+	if len(imageMemoryBarriers) > 0 {
+		pImageMemoryBarriers = &imageMemoryBarriers[0]
+	}
+	c.DeviceTable.CmdWaitEvents.Call(c.CommandBuffer, uint32(len(events)), pEvents, srcStageMask, dstStageMask, uint32(len(memoryBarriers)), pMemoryBarriers, uint32(len(bufferMemoryBarriers)), pBufferMemoryBarriers, uint32(len(imageMemoryBarriers)), pImageMemoryBarriers)
+}
+
+// CmdPipelineBarrier calls vkCmdPipelineBarrier.
+func (c CommandBuffer) CmdPipelineBarrier(srcStageMask, dstStageMask vk.PipelineStageFlags, dependencyFlags vk.DependencyFlags, memoryBarriers []vk.MemoryBarrier, bufferMemoryBarriers []vk.BufferMemoryBarrier, imageMemoryBarriers []vk.ImageMemoryBarrier) {
+	var pMemoryBarriers *vk.MemoryBarrier
+	if len(memoryBarriers) > 0 {
+		pMemoryBarriers = &memoryBarriers[0]
+	}
+	var pBufferMemoryBarriers *vk.BufferMemoryBarrier
+	if len(bufferMemoryBarriers) > 0 {
+		pBufferMemoryBarriers = &bufferMemoryBarriers[0]
+	}
+	var pImageMemoryBarriers *vk.ImageMemoryBarrier
+	if len(imageMemoryBarriers) > 0 {
+		pImageMemoryBarriers = &imageMemoryBarriers[0]
+	}
+	c.DeviceTable.CmdPipelineBarrier.Call(c.CommandBuffer, srcStageMask, dstStageMask, dependencyFlags, uint32(len(memoryBarriers)), pMemoryBarriers, uint32(len(bufferMemoryBarriers)), pBufferMemoryBarriers, uint32(len(imageMemoryBarriers)), pImageMemoryBarriers)
+}
+
+// CmdBeginQuery calls vkCmdBeginQuery.
+func (c CommandBuffer) CmdBeginQuery(queryPool vk.QueryPool, query uint32, flags vk.QueryControlFlags) {
+	c.DeviceTable.CmdBeginQuery.Call(c.CommandBuffer, queryPool, query, flags)
+}
+
+// CmdEndQuery calls vkCmdEndQuery.
+func (c CommandBuffer) CmdEndQuery(queryPool vk.QueryPool, query uint32) {
+	c.DeviceTable.CmdEndQuery.Call(c.CommandBuffer, queryPool, query)
+}
+
+// CmdResetQueryPool calls vkCmdResetQueryPool.
+func (c CommandBuffer) CmdResetQueryPool(queryPool vk.QueryPool, firstQuery, queryCount uint32) {
+	c.DeviceTable.CmdResetQueryPool.Call(c.CommandBuffer, queryPool, firstQuery, queryCount)
+}
+
+// CmdWriteTimestamp calls vkCmdWriteTimestamp.
+func (c CommandBuffer) CmdWriteTimestamp(pipelineStage vk.PipelineStageFlags, queryPool vk.QueryPool, query uint32) {
+	c.DeviceTable.CmdWriteTimestamp.Call(c.CommandBuffer, pipelineStage, queryPool, query)
+}
+
+// CmdCopyQueryPoolResults calls vkCmdCopyQueryPoolResults.
+func (c CommandBuffer) CmdCopyQueryPoolResults(queryPool vk.QueryPool, firstQuery, queryCount uint32, dstBuffer vk.Buffer, dstOffset, stride vk.DeviceSize, flags vk.QueryResultFlags) {
+	c.DeviceTable.CmdCopyQueryPoolResults.Call(c.CommandBuffer, queryPool, firstQuery, queryCount, dstBuffer, dstOffset, stride, flags)
+}
+
+// CmdPushConstants calls vkCmdPushConstants.
+func (c CommandBuffer) CmdPushConstants(layout vk.PipelineLayout, stageFlags vk.ShaderStageFlags, offset uint32, values []byte) {
+	var pValues unsafe.Pointer
+	if len(values) > 0 {
+		pValues = unsafe.Pointer(&values[0])
+	}
+	c.DeviceTable.CmdPushConstants.Call(c.CommandBuffer, layout, stageFlags, offset, uint32(len(values)), pValues)
+}
+
+// CmdBeginRenderPass calls vkCmdBeginRenderPass.
+func (c CommandBuffer) CmdBeginRenderPass(pRenderPassBegin *vk.RenderPassBeginInfo, contents vk.SubpassContents) {
+	c.DeviceTable.CmdBeginRenderPass.Call(c.CommandBuffer, pRenderPassBegin, contents)
+}
+
+// CmdNextSubpass calls vkCmdNextSubpass.
+func (c CommandBuffer) CmdNextSubpass(contents vk.SubpassContents) {
+	c.DeviceTable.CmdNextSubpass.Call(c.CommandBuffer, contents)
+}
+
+// CmdEndRenderPass calls vkCmdEndRenderPass.
+func (c CommandBuffer) CmdEndRenderPass() {
+	c.DeviceTable.CmdEndRenderPass.Call(c.CommandBuffer)
+}
+
+// CmdExecuteCommands calls vkCmdExecuteCommands.
+func (c CommandBuffer) CmdExecuteCommands(commandBuffers []CommandBuffer) {
+	var pCommandBuffers *vk.CommandBuffer
+	if len(commandBuffers) > 0 {
+		handles := make([]vk.CommandBuffer, len(commandBuffers))
+		for k, x := range commandBuffers {
+			handles[k] = x.CommandBuffer
+		}
+		pCommandBuffers = &handles[0]
+	}
+	c.DeviceTable.CmdExecuteCommands.Call(c.CommandBuffer, uint32(len(commandBuffers)), pCommandBuffers)
+}
+
+// BindBufferMemory2 calls vkBindBufferMemory2.
+func (d Device) BindBufferMemory2(bindInfos []vk.BindBufferMemoryInfo) error {
+	var pBindInfos *vk.BindBufferMemoryInfo
+	if len(bindInfos) > 0 {
+		pBindInfos = &bindInfos[0]
+	}
+	return d.DeviceTable.BindBufferMemory2.Call(d.Device, uint32(len(bindInfos)), pBindInfos).Err()
+}
+
+// BindImageMemory2 calls vkBindImageMemory2.
+func (d Device) BindImageMemory2(bindInfos []vk.BindImageMemoryInfo) error {
+	var pBindInfos *vk.BindImageMemoryInfo
+	if len(bindInfos) > 0 {
+		pBindInfos = &bindInfos[0]
+	}
+	return d.DeviceTable.BindImageMemory2.Call(d.Device, uint32(len(bindInfos)), pBindInfos).Err()
+}
+
+// GetDeviceGroupPeerMemoryFeatures calls vkGetDeviceGroupPeerMemoryFeatures.
+func (d Device) GetDeviceGroupPeerMemoryFeatures(heapIndex, localDeviceIndex, remoteDeviceIndex uint32) vk.PeerMemoryFeatureFlags {
+	var peerMemoryFeatures vk.PeerMemoryFeatureFlags
+	d.DeviceTable.GetDeviceGroupPeerMemoryFeatures.Call(d.Device, heapIndex, localDeviceIndex, remoteDeviceIndex, &peerMemoryFeatures)
+	return peerMemoryFeatures
+}
+
+// CmdSetDeviceMask calls vkCmdSetDeviceMask.
+func (c CommandBuffer) CmdSetDeviceMask(deviceMask uint32) {
+	c.DeviceTable.CmdSetDeviceMask.Call(c.CommandBuffer, deviceMask)
+}
+
+// CmdDispatchBase calls vkCmdDispatchBase.
+func (c CommandBuffer) CmdDispatchBase(baseGroupX, baseGroupY, baseGroupZ, groupCountX, groupCountY, groupCountZ uint32) {
+	c.DeviceTable.CmdDispatchBase.Call(c.CommandBuffer, baseGroupX, baseGroupY, baseGroupZ, groupCountX, groupCountY, groupCountZ)
+}
+
+// EnumeratePhysicalDeviceGroups calls vkEnumeratePhysicalDeviceGroups.
+func (i Instance) EnumeratePhysicalDeviceGroups() ([]vk.PhysicalDeviceGroupProperties, error) {
+	for {
+		var n uint32
+		if ret := i.InstanceTable.EnumeratePhysicalDeviceGroups.Call(i.Instance, &n, nil); ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		physicalDeviceGroupProperties := make([]vk.PhysicalDeviceGroupProperties, n)
+		for k := range physicalDeviceGroupProperties {
+			physicalDeviceGroupProperties[k].SType = vk.STRUCTURE_TYPE_PHYSICAL_DEVICE_GROUP_PROPERTIES
+		}
+		ret := i.InstanceTable.EnumeratePhysicalDeviceGroups.Call(i.Instance, &n, &physicalDeviceGroupProperties[0])
+		if ret == vk.INCOMPLETE {
+			continue // the list grew between the two calls
+		}
+		if ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		return physicalDeviceGroupProperties[:n], nil
+	}
+}
+
+// GetImageMemoryRequirements2 calls vkGetImageMemoryRequirements2.
+func (d Device) GetImageMemoryRequirements2(pInfo *vk.ImageMemoryRequirementsInfo2, pMemoryRequirements *vk.MemoryRequirements2) {
+	d.DeviceTable.GetImageMemoryRequirements2.Call(d.Device, pInfo, pMemoryRequirements)
+}
+
+// GetBufferMemoryRequirements2 calls vkGetBufferMemoryRequirements2.
+func (d Device) GetBufferMemoryRequirements2(pInfo *vk.BufferMemoryRequirementsInfo2, pMemoryRequirements *vk.MemoryRequirements2) {
+	d.DeviceTable.GetBufferMemoryRequirements2.Call(d.Device, pInfo, pMemoryRequirements)
+}
+
+// GetImageSparseMemoryRequirements2 calls vkGetImageSparseMemoryRequirements2.
+func (d Device) GetImageSparseMemoryRequirements2(pInfo *vk.ImageSparseMemoryRequirementsInfo2) []vk.SparseImageMemoryRequirements2 {
+	var n uint32
+	d.DeviceTable.GetImageSparseMemoryRequirements2.Call(d.Device, pInfo, &n, nil)
+	if n == 0 {
+		return nil
+	}
+	sparseMemoryRequirements := make([]vk.SparseImageMemoryRequirements2, n)
+	for k := range sparseMemoryRequirements {
+		sparseMemoryRequirements[k].SType = vk.STRUCTURE_TYPE_SPARSE_IMAGE_MEMORY_REQUIREMENTS_2
+	}
+	d.DeviceTable.GetImageSparseMemoryRequirements2.Call(d.Device, pInfo, &n, &sparseMemoryRequirements[0])
+	return sparseMemoryRequirements[:n]
+}
+
+// GetPhysicalDeviceFeatures2 calls vkGetPhysicalDeviceFeatures2.
+func (p PhysicalDevice) GetPhysicalDeviceFeatures2(pFeatures *vk.PhysicalDeviceFeatures2) {
+	p.InstanceTable.GetPhysicalDeviceFeatures2.Call(p.PhysicalDevice, pFeatures)
+}
+
+// GetPhysicalDeviceProperties2 calls vkGetPhysicalDeviceProperties2.
+func (p PhysicalDevice) GetPhysicalDeviceProperties2(pProperties *vk.PhysicalDeviceProperties2) {
+	p.InstanceTable.GetPhysicalDeviceProperties2.Call(p.PhysicalDevice, pProperties)
+}
+
+// GetPhysicalDeviceFormatProperties2 calls vkGetPhysicalDeviceFormatProperties2.
+func (p PhysicalDevice) GetPhysicalDeviceFormatProperties2(format vk.Format, pFormatProperties *vk.FormatProperties2) {
+	p.InstanceTable.GetPhysicalDeviceFormatProperties2.Call(p.PhysicalDevice, format, pFormatProperties)
+}
+
+// GetPhysicalDeviceImageFormatProperties2 calls vkGetPhysicalDeviceImageFormatProperties2.
+func (p PhysicalDevice) GetPhysicalDeviceImageFormatProperties2(pImageFormatInfo *vk.PhysicalDeviceImageFormatInfo2, pImageFormatProperties *vk.ImageFormatProperties2) error {
+	return p.InstanceTable.GetPhysicalDeviceImageFormatProperties2.Call(p.PhysicalDevice, pImageFormatInfo, pImageFormatProperties).Err()
+}
+
+// GetPhysicalDeviceQueueFamilyProperties2 calls vkGetPhysicalDeviceQueueFamilyProperties2.
+func (p PhysicalDevice) GetPhysicalDeviceQueueFamilyProperties2() []vk.QueueFamilyProperties2 {
+	var n uint32
+	p.InstanceTable.GetPhysicalDeviceQueueFamilyProperties2.Call(p.PhysicalDevice, &n, nil)
+	if n == 0 {
+		return nil
+	}
+	queueFamilyProperties := make([]vk.QueueFamilyProperties2, n)
+	for k := range queueFamilyProperties {
+		queueFamilyProperties[k].SType = vk.STRUCTURE_TYPE_QUEUE_FAMILY_PROPERTIES_2
+	}
+	p.InstanceTable.GetPhysicalDeviceQueueFamilyProperties2.Call(p.PhysicalDevice, &n, &queueFamilyProperties[0])
+	return queueFamilyProperties[:n]
+}
+
+// GetPhysicalDeviceMemoryProperties2 calls vkGetPhysicalDeviceMemoryProperties2.
+func (p PhysicalDevice) GetPhysicalDeviceMemoryProperties2(pMemoryProperties *vk.PhysicalDeviceMemoryProperties2) {
+	p.InstanceTable.GetPhysicalDeviceMemoryProperties2.Call(p.PhysicalDevice, pMemoryProperties)
+}
+
+// GetPhysicalDeviceSparseImageFormatProperties2 calls vkGetPhysicalDeviceSparseImageFormatProperties2.
+func (p PhysicalDevice) GetPhysicalDeviceSparseImageFormatProperties2(pFormatInfo *vk.PhysicalDeviceSparseImageFormatInfo2) []vk.SparseImageFormatProperties2 {
+	var n uint32
+	p.InstanceTable.GetPhysicalDeviceSparseImageFormatProperties2.Call(p.PhysicalDevice, pFormatInfo, &n, nil)
+	if n == 0 {
+		return nil
+	}
+	properties := make([]vk.SparseImageFormatProperties2, n)
+	for k := range properties {
+		properties[k].SType = vk.STRUCTURE_TYPE_SPARSE_IMAGE_FORMAT_PROPERTIES_2
+	}
+	p.InstanceTable.GetPhysicalDeviceSparseImageFormatProperties2.Call(p.PhysicalDevice, pFormatInfo, &n, &properties[0])
+	return properties[:n]
+}
+
+// TrimCommandPool calls vkTrimCommandPool.
+func (d Device) TrimCommandPool(commandPool vk.CommandPool, flags vk.CommandPoolTrimFlags) {
+	d.DeviceTable.TrimCommandPool.Call(d.Device, commandPool, flags)
+}
+
+// GetDeviceQueue2 calls vkGetDeviceQueue2.
+func (d Device) GetDeviceQueue2(pQueueInfo *vk.DeviceQueueInfo2) Queue {
+	var queue vk.Queue
+	d.DeviceTable.GetDeviceQueue2.Call(d.Device, pQueueInfo, &queue)
+	return Queue{queue, d.DeviceTable}
+}
+
+// CreateSamplerYcbcrConversion calls vkCreateSamplerYcbcrConversion.
+func (d Device) CreateSamplerYcbcrConversion(pCreateInfo *vk.SamplerYcbcrConversionCreateInfo) (vk.SamplerYcbcrConversion, error) {
+	var ycbcrConversion vk.SamplerYcbcrConversion
+	ret := d.DeviceTable.CreateSamplerYcbcrConversion.Call(d.Device, pCreateInfo, d.Allocator, &ycbcrConversion)
+	return ycbcrConversion, ret.Err()
+}
+
+// DestroySamplerYcbcrConversion calls vkDestroySamplerYcbcrConversion.
+func (d Device) DestroySamplerYcbcrConversion(ycbcrConversion vk.SamplerYcbcrConversion) {
+	d.DeviceTable.DestroySamplerYcbcrConversion.Call(d.Device, ycbcrConversion, d.Allocator)
+}
+
+// CreateDescriptorUpdateTemplate calls vkCreateDescriptorUpdateTemplate.
+func (d Device) CreateDescriptorUpdateTemplate(pCreateInfo *vk.DescriptorUpdateTemplateCreateInfo) (vk.DescriptorUpdateTemplate, error) {
+	var descriptorUpdateTemplate vk.DescriptorUpdateTemplate
+	ret := d.DeviceTable.CreateDescriptorUpdateTemplate.Call(d.Device, pCreateInfo, d.Allocator, &descriptorUpdateTemplate)
+	return descriptorUpdateTemplate, ret.Err()
+}
+
+// DestroyDescriptorUpdateTemplate calls vkDestroyDescriptorUpdateTemplate.
+func (d Device) DestroyDescriptorUpdateTemplate(descriptorUpdateTemplate vk.DescriptorUpdateTemplate) {
+	d.DeviceTable.DestroyDescriptorUpdateTemplate.Call(d.Device, descriptorUpdateTemplate, d.Allocator)
+}
+
+// UpdateDescriptorSetWithTemplate calls vkUpdateDescriptorSetWithTemplate.
+func (d Device) UpdateDescriptorSetWithTemplate(descriptorSet vk.DescriptorSet, descriptorUpdateTemplate vk.DescriptorUpdateTemplate, pData unsafe.Pointer) {
+	d.DeviceTable.UpdateDescriptorSetWithTemplate.Call(d.Device, descriptorSet, descriptorUpdateTemplate, pData)
+}
+
+// GetPhysicalDeviceExternalBufferProperties calls vkGetPhysicalDeviceExternalBufferProperties.
+func (p PhysicalDevice) GetPhysicalDeviceExternalBufferProperties(pExternalBufferInfo *vk.PhysicalDeviceExternalBufferInfo, pExternalBufferProperties *vk.ExternalBufferProperties) {
+	p.InstanceTable.GetPhysicalDeviceExternalBufferProperties.Call(p.PhysicalDevice, pExternalBufferInfo, pExternalBufferProperties)
+}
+
+// GetPhysicalDeviceExternalFenceProperties calls vkGetPhysicalDeviceExternalFenceProperties.
+func (p PhysicalDevice) GetPhysicalDeviceExternalFenceProperties(pExternalFenceInfo *vk.PhysicalDeviceExternalFenceInfo, pExternalFenceProperties *vk.ExternalFenceProperties) {
+	p.InstanceTable.GetPhysicalDeviceExternalFenceProperties.Call(p.PhysicalDevice, pExternalFenceInfo, pExternalFenceProperties)
+}
+
+// GetPhysicalDeviceExternalSemaphoreProperties calls vkGetPhysicalDeviceExternalSemaphoreProperties.
+func (p PhysicalDevice) GetPhysicalDeviceExternalSemaphoreProperties(pExternalSemaphoreInfo *vk.PhysicalDeviceExternalSemaphoreInfo, pExternalSemaphoreProperties *vk.ExternalSemaphoreProperties) {
+	p.InstanceTable.GetPhysicalDeviceExternalSemaphoreProperties.Call(p.PhysicalDevice, pExternalSemaphoreInfo, pExternalSemaphoreProperties)
+}
+
+// GetDescriptorSetLayoutSupport calls vkGetDescriptorSetLayoutSupport.
+func (d Device) GetDescriptorSetLayoutSupport(pCreateInfo *vk.DescriptorSetLayoutCreateInfo, pSupport *vk.DescriptorSetLayoutSupport) {
+	d.DeviceTable.GetDescriptorSetLayoutSupport.Call(d.Device, pCreateInfo, pSupport)
+}
+
+// CmdDrawIndirectCount calls vkCmdDrawIndirectCount.
+func (c CommandBuffer) CmdDrawIndirectCount(buffer vk.Buffer, offset vk.DeviceSize, countBuffer vk.Buffer, countBufferOffset vk.DeviceSize, maxDrawCount, stride uint32) {
+	c.DeviceTable.CmdDrawIndirectCount.Call(c.CommandBuffer, buffer, offset, countBuffer, countBufferOffset, maxDrawCount, stride)
+}
+
+// CmdDrawIndexedIndirectCount calls vkCmdDrawIndexedIndirectCount.
+func (c CommandBuffer) CmdDrawIndexedIndirectCount(buffer vk.Buffer, offset vk.DeviceSize, countBuffer vk.Buffer, countBufferOffset vk.DeviceSize, maxDrawCount, stride uint32) {
+	c.DeviceTable.CmdDrawIndexedIndirectCount.Call(c.CommandBuffer, buffer, offset, countBuffer, countBufferOffset, maxDrawCount, stride)
+}
+
+// CreateRenderPass2 calls vkCreateRenderPass2.
+func (d Device) CreateRenderPass2(pCreateInfo *vk.RenderPassCreateInfo2) (vk.RenderPass, error) {
+	var renderPass vk.RenderPass
+	ret := d.DeviceTable.CreateRenderPass2.Call(d.Device, pCreateInfo, d.Allocator, &renderPass)
+	return renderPass, ret.Err()
+}
+
+// CmdBeginRenderPass2 calls vkCmdBeginRenderPass2.
+func (c CommandBuffer) CmdBeginRenderPass2(pRenderPassBegin *vk.RenderPassBeginInfo, pSubpassBeginInfo *vk.SubpassBeginInfo) {
+	c.DeviceTable.CmdBeginRenderPass2.Call(c.CommandBuffer, pRenderPassBegin, pSubpassBeginInfo)
+}
+
+// CmdNextSubpass2 calls vkCmdNextSubpass2.
+func (c CommandBuffer) CmdNextSubpass2(pSubpassBeginInfo *vk.SubpassBeginInfo, pSubpassEndInfo *vk.SubpassEndInfo) {
+	c.DeviceTable.CmdNextSubpass2.Call(c.CommandBuffer, pSubpassBeginInfo, pSubpassEndInfo)
+}
+
+// CmdEndRenderPass2 calls vkCmdEndRenderPass2.
+func (c CommandBuffer) CmdEndRenderPass2(pSubpassEndInfo *vk.SubpassEndInfo) {
+	c.DeviceTable.CmdEndRenderPass2.Call(c.CommandBuffer, pSubpassEndInfo)
+}
+
+// ResetQueryPool calls vkResetQueryPool.
+func (d Device) ResetQueryPool(queryPool vk.QueryPool, firstQuery, queryCount uint32) {
+	d.DeviceTable.ResetQueryPool.Call(d.Device, queryPool, firstQuery, queryCount)
+}
+
+// GetSemaphoreCounterValue calls vkGetSemaphoreCounterValue.
+func (d Device) GetSemaphoreCounterValue(semaphore vk.Semaphore) (uint64, error) {
+	var value uint64
+	ret := d.DeviceTable.GetSemaphoreCounterValue.Call(d.Device, semaphore, &value)
+	return value, ret.Err()
+}
+
+// WaitSemaphores calls vkWaitSemaphores.
+func (d Device) WaitSemaphores(pWaitInfo *vk.SemaphoreWaitInfo, timeout uint64) error {
+	return d.DeviceTable.WaitSemaphores.Call(d.Device, pWaitInfo, timeout).Err()
+}
+
+// SignalSemaphore calls vkSignalSemaphore.
+func (d Device) SignalSemaphore(pSignalInfo *vk.SemaphoreSignalInfo) error {
+	return d.DeviceTable.SignalSemaphore.Call(d.Device, pSignalInfo).Err()
+}
+
+// GetBufferDeviceAddress calls vkGetBufferDeviceAddress.
+func (d Device) GetBufferDeviceAddress(pInfo *vk.BufferDeviceAddressInfo) vk.DeviceAddress {
+	return d.DeviceTable.GetBufferDeviceAddress.Call(d.Device, pInfo)
+}
+
+// GetBufferOpaqueCaptureAddress calls vkGetBufferOpaqueCaptureAddress.
+func (d Device) GetBufferOpaqueCaptureAddress(pInfo *vk.BufferDeviceAddressInfo) uint64 {
+	return d.DeviceTable.GetBufferOpaqueCaptureAddress.Call(d.Device, pInfo)
+}
+
+// GetDeviceMemoryOpaqueCaptureAddress calls vkGetDeviceMemoryOpaqueCaptureAddress.
+func (d Device) GetDeviceMemoryOpaqueCaptureAddress(pInfo *vk.DeviceMemoryOpaqueCaptureAddressInfo) uint64 {
+	return d.DeviceTable.GetDeviceMemoryOpaqueCaptureAddress.Call(d.Device, pInfo)
+}
+
+// GetPhysicalDeviceToolProperties calls vkGetPhysicalDeviceToolProperties.
+func (p PhysicalDevice) GetPhysicalDeviceToolProperties() ([]vk.PhysicalDeviceToolProperties, error) {
+	for {
+		var n uint32
+		if ret := p.InstanceTable.GetPhysicalDeviceToolProperties.Call(p.PhysicalDevice, &n, nil); ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		toolProperties := make([]vk.PhysicalDeviceToolProperties, n)
+		for k := range toolProperties {
+			toolProperties[k].SType = vk.STRUCTURE_TYPE_PHYSICAL_DEVICE_TOOL_PROPERTIES
+		}
+		ret := p.InstanceTable.GetPhysicalDeviceToolProperties.Call(p.PhysicalDevice, &n, &toolProperties[0])
+		if ret == vk.INCOMPLETE {
+			continue // the list grew between the two calls
+		}
+		if ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		return toolProperties[:n], nil
+	}
+}
+
+// CreatePrivateDataSlot calls vkCreatePrivateDataSlot.
+func (d Device) CreatePrivateDataSlot(pCreateInfo *vk.PrivateDataSlotCreateInfo) (vk.PrivateDataSlot, error) {
+	var privateDataSlot vk.PrivateDataSlot
+	ret := d.DeviceTable.CreatePrivateDataSlot.Call(d.Device, pCreateInfo, d.Allocator, &privateDataSlot)
+	return privateDataSlot, ret.Err()
+}
+
+// DestroyPrivateDataSlot calls vkDestroyPrivateDataSlot.
+func (d Device) DestroyPrivateDataSlot(privateDataSlot vk.PrivateDataSlot) {
+	d.DeviceTable.DestroyPrivateDataSlot.Call(d.Device, privateDataSlot, d.Allocator)
+}
+
+// SetPrivateData calls vkSetPrivateData.
+func (d Device) SetPrivateData(objectType vk.ObjectType, objectHandle uint64, privateDataSlot vk.PrivateDataSlot, data uint64) error {
+	return d.DeviceTable.SetPrivateData.Call(d.Device, objectType, objectHandle, privateDataSlot, data).Err()
+}
+
+// GetPrivateData calls vkGetPrivateData.
+func (d Device) GetPrivateData(objectType vk.ObjectType, objectHandle uint64, privateDataSlot vk.PrivateDataSlot) uint64 {
+	var data uint64
+	d.DeviceTable.GetPrivateData.Call(d.Device, objectType, objectHandle, privateDataSlot, &data)
+	return data
+}
+
+// CmdSetEvent2 calls vkCmdSetEvent2.
+func (c CommandBuffer) CmdSetEvent2(event vk.Event, pDependencyInfo *vk.DependencyInfo) {
+	c.DeviceTable.CmdSetEvent2.Call(c.CommandBuffer, event, pDependencyInfo)
+}
+
+// CmdResetEvent2 calls vkCmdResetEvent2.
+func (c CommandBuffer) CmdResetEvent2(event vk.Event, stageMask vk.PipelineStageFlags2) {
+	c.DeviceTable.CmdResetEvent2.Call(c.CommandBuffer, event, stageMask)
+}
+
+// CmdWaitEvents2 calls vkCmdWaitEvents2.
+func (c CommandBuffer) CmdWaitEvents2(eventCount uint32, pEvents *vk.Event, pDependencyInfos *vk.DependencyInfo) {
+	c.DeviceTable.CmdWaitEvents2.Call(c.CommandBuffer, eventCount, pEvents, pDependencyInfos)
+}
+
+// CmdPipelineBarrier2 calls vkCmdPipelineBarrier2.
+func (c CommandBuffer) CmdPipelineBarrier2(pDependencyInfo *vk.DependencyInfo) {
+	c.DeviceTable.CmdPipelineBarrier2.Call(c.CommandBuffer, pDependencyInfo)
+}
+
+// CmdWriteTimestamp2 calls vkCmdWriteTimestamp2.
+func (c CommandBuffer) CmdWriteTimestamp2(stage vk.PipelineStageFlags2, queryPool vk.QueryPool, query uint32) {
+	c.DeviceTable.CmdWriteTimestamp2.Call(c.CommandBuffer, stage, queryPool, query)
+}
+
+// QueueSubmit2 calls vkQueueSubmit2.
+func (q Queue) QueueSubmit2(submits []vk.SubmitInfo2, fence vk.Fence) error {
+	var pSubmits *vk.SubmitInfo2
+	if len(submits) > 0 {
+		pSubmits = &submits[0]
+	}
+	return q.DeviceTable.QueueSubmit2.Call(q.Queue, uint32(len(submits)), pSubmits, fence).Err()
+}
+
+// CmdCopyBuffer2 calls vkCmdCopyBuffer2.
+func (c CommandBuffer) CmdCopyBuffer2(pCopyBufferInfo *vk.CopyBufferInfo2) {
+	c.DeviceTable.CmdCopyBuffer2.Call(c.CommandBuffer, pCopyBufferInfo)
+}
+
+// CmdCopyImage2 calls vkCmdCopyImage2.
+func (c CommandBuffer) CmdCopyImage2(pCopyImageInfo *vk.CopyImageInfo2) {
+	c.DeviceTable.CmdCopyImage2.Call(c.CommandBuffer, pCopyImageInfo)
+}
+
+// CmdCopyBufferToImage2 calls vkCmdCopyBufferToImage2.
+func (c CommandBuffer) CmdCopyBufferToImage2(pCopyBufferToImageInfo *vk.CopyBufferToImageInfo2) {
+	c.DeviceTable.CmdCopyBufferToImage2.Call(c.CommandBuffer, pCopyBufferToImageInfo)
+}
+
+// CmdCopyImageToBuffer2 calls vkCmdCopyImageToBuffer2.
+func (c CommandBuffer) CmdCopyImageToBuffer2(pCopyImageToBufferInfo *vk.CopyImageToBufferInfo2) {
+	c.DeviceTable.CmdCopyImageToBuffer2.Call(c.CommandBuffer, pCopyImageToBufferInfo)
+}
+
+// CmdBlitImage2 calls vkCmdBlitImage2.
+func (c CommandBuffer) CmdBlitImage2(pBlitImageInfo *vk.BlitImageInfo2) {
+	c.DeviceTable.CmdBlitImage2.Call(c.CommandBuffer, pBlitImageInfo)
+}
+
+// CmdResolveImage2 calls vkCmdResolveImage2.
+func (c CommandBuffer) CmdResolveImage2(pResolveImageInfo *vk.ResolveImageInfo2) {
+	c.DeviceTable.CmdResolveImage2.Call(c.CommandBuffer, pResolveImageInfo)
+}
+
+// CmdBeginRendering calls vkCmdBeginRendering.
+func (c CommandBuffer) CmdBeginRendering(pRenderingInfo *vk.RenderingInfo) {
+	c.DeviceTable.CmdBeginRendering.Call(c.CommandBuffer, pRenderingInfo)
+}
+
+// CmdEndRendering calls vkCmdEndRendering.
+func (c CommandBuffer) CmdEndRendering() {
+	c.DeviceTable.CmdEndRendering.Call(c.CommandBuffer)
+}
+
+// CmdSetCullMode calls vkCmdSetCullMode.
+func (c CommandBuffer) CmdSetCullMode(cullMode vk.CullModeFlags) {
+	c.DeviceTable.CmdSetCullMode.Call(c.CommandBuffer, cullMode)
+}
+
+// CmdSetFrontFace calls vkCmdSetFrontFace.
+func (c CommandBuffer) CmdSetFrontFace(frontFace vk.FrontFace) {
+	c.DeviceTable.CmdSetFrontFace.Call(c.CommandBuffer, frontFace)
+}
+
+// CmdSetPrimitiveTopology calls vkCmdSetPrimitiveTopology.
+func (c CommandBuffer) CmdSetPrimitiveTopology(primitiveTopology vk.PrimitiveTopology) {
+	c.DeviceTable.CmdSetPrimitiveTopology.Call(c.CommandBuffer, primitiveTopology)
+}
+
+// CmdSetViewportWithCount calls vkCmdSetViewportWithCount.
+func (c CommandBuffer) CmdSetViewportWithCount(viewports []vk.Viewport) {
+	var pViewports *vk.Viewport
+	if len(viewports) > 0 {
+		pViewports = &viewports[0]
+	}
+	c.DeviceTable.CmdSetViewportWithCount.Call(c.CommandBuffer, uint32(len(viewports)), pViewports)
+}
+
+// CmdSetScissorWithCount calls vkCmdSetScissorWithCount.
+func (c CommandBuffer) CmdSetScissorWithCount(scissors []vk.Rect2D) {
+	var pScissors *vk.Rect2D
+	if len(scissors) > 0 {
+		pScissors = &scissors[0]
+	}
+	c.DeviceTable.CmdSetScissorWithCount.Call(c.CommandBuffer, uint32(len(scissors)), pScissors)
+}
+
+// CmdBindVertexBuffers2 calls vkCmdBindVertexBuffers2.
+func (c CommandBuffer) CmdBindVertexBuffers2(firstBinding, bindingCount uint32, pBuffers *vk.Buffer, pOffsets, pSizes, pStrides *vk.DeviceSize) {
+	c.DeviceTable.CmdBindVertexBuffers2.Call(c.CommandBuffer, firstBinding, bindingCount, pBuffers, pOffsets, pSizes, pStrides)
+}
+
+// CmdSetDepthTestEnable calls vkCmdSetDepthTestEnable.
+func (c CommandBuffer) CmdSetDepthTestEnable(depthTestEnable vk.Bool32) {
+	c.DeviceTable.CmdSetDepthTestEnable.Call(c.CommandBuffer, depthTestEnable)
+}
+
+// CmdSetDepthWriteEnable calls vkCmdSetDepthWriteEnable.
+func (c CommandBuffer) CmdSetDepthWriteEnable(depthWriteEnable vk.Bool32) {
+	c.DeviceTable.CmdSetDepthWriteEnable.Call(c.CommandBuffer, depthWriteEnable)
+}
+
+// CmdSetDepthCompareOp calls vkCmdSetDepthCompareOp.
+func (c CommandBuffer) CmdSetDepthCompareOp(depthCompareOp vk.CompareOp) {
+	c.DeviceTable.CmdSetDepthCompareOp.Call(c.CommandBuffer, depthCompareOp)
+}
+
+// CmdSetDepthBoundsTestEnable calls vkCmdSetDepthBoundsTestEnable.
+func (c CommandBuffer) CmdSetDepthBoundsTestEnable(depthBoundsTestEnable vk.Bool32) {
+	c.DeviceTable.CmdSetDepthBoundsTestEnable.Call(c.CommandBuffer, depthBoundsTestEnable)
+}
+
+// CmdSetStencilTestEnable calls vkCmdSetStencilTestEnable.
+func (c CommandBuffer) CmdSetStencilTestEnable(stencilTestEnable vk.Bool32) {
+	c.DeviceTable.CmdSetStencilTestEnable.Call(c.CommandBuffer, stencilTestEnable)
+}
+
+// CmdSetStencilOp calls vkCmdSetStencilOp.
+func (c CommandBuffer) CmdSetStencilOp(faceMask vk.StencilFaceFlags, failOp, passOp, depthFailOp vk.StencilOp, compareOp vk.CompareOp) {
+	c.DeviceTable.CmdSetStencilOp.Call(c.CommandBuffer, faceMask, failOp, passOp, depthFailOp, compareOp)
+}
+
+// CmdSetRasterizerDiscardEnable calls vkCmdSetRasterizerDiscardEnable.
+func (c CommandBuffer) CmdSetRasterizerDiscardEnable(rasterizerDiscardEnable vk.Bool32) {
+	c.DeviceTable.CmdSetRasterizerDiscardEnable.Call(c.CommandBuffer, rasterizerDiscardEnable)
+}
+
+// CmdSetDepthBiasEnable calls vkCmdSetDepthBiasEnable.
+func (c CommandBuffer) CmdSetDepthBiasEnable(depthBiasEnable vk.Bool32) {
+	c.DeviceTable.CmdSetDepthBiasEnable.Call(c.CommandBuffer, depthBiasEnable)
+}
+
+// CmdSetPrimitiveRestartEnable calls vkCmdSetPrimitiveRestartEnable.
+func (c CommandBuffer) CmdSetPrimitiveRestartEnable(primitiveRestartEnable vk.Bool32) {
+	c.DeviceTable.CmdSetPrimitiveRestartEnable.Call(c.CommandBuffer, primitiveRestartEnable)
+}
+
+// GetDeviceBufferMemoryRequirements calls vkGetDeviceBufferMemoryRequirements.
+func (d Device) GetDeviceBufferMemoryRequirements(pInfo *vk.DeviceBufferMemoryRequirements, pMemoryRequirements *vk.MemoryRequirements2) {
+	d.DeviceTable.GetDeviceBufferMemoryRequirements.Call(d.Device, pInfo, pMemoryRequirements)
+}
+
+// GetDeviceImageMemoryRequirements calls vkGetDeviceImageMemoryRequirements.
+func (d Device) GetDeviceImageMemoryRequirements(pInfo *vk.DeviceImageMemoryRequirements, pMemoryRequirements *vk.MemoryRequirements2) {
+	d.DeviceTable.GetDeviceImageMemoryRequirements.Call(d.Device, pInfo, pMemoryRequirements)
+}
+
+// GetDeviceImageSparseMemoryRequirements calls vkGetDeviceImageSparseMemoryRequirements.
+func (d Device) GetDeviceImageSparseMemoryRequirements(pInfo *vk.DeviceImageMemoryRequirements) []vk.SparseImageMemoryRequirements2 {
+	var n uint32
+	d.DeviceTable.GetDeviceImageSparseMemoryRequirements.Call(d.Device, pInfo, &n, nil)
+	if n == 0 {
+		return nil
+	}
+	sparseMemoryRequirements := make([]vk.SparseImageMemoryRequirements2, n)
+	for k := range sparseMemoryRequirements {
+		sparseMemoryRequirements[k].SType = vk.STRUCTURE_TYPE_SPARSE_IMAGE_MEMORY_REQUIREMENTS_2
+	}
+	d.DeviceTable.GetDeviceImageSparseMemoryRequirements.Call(d.Device, pInfo, &n, &sparseMemoryRequirements[0])
+	return sparseMemoryRequirements[:n]
+}
+
+// DestroySurfaceKHR calls vkDestroySurfaceKHR.
+func (i Instance) DestroySurfaceKHR(surface vk.SurfaceKHR) {
+	i.InstanceTable.DestroySurfaceKHR.Call(i.Instance, surface, i.Allocator)
+}
+
+// GetPhysicalDeviceSurfaceSupportKHR calls vkGetPhysicalDeviceSurfaceSupportKHR.
+func (p PhysicalDevice) GetPhysicalDeviceSurfaceSupportKHR(queueFamilyIndex uint32, surface vk.SurfaceKHR) (vk.Bool32, error) {
+	var supported vk.Bool32
+	ret := p.InstanceTable.GetPhysicalDeviceSurfaceSupportKHR.Call(p.PhysicalDevice, queueFamilyIndex, surface, &supported)
+	return supported, ret.Err()
+}
+
+// GetPhysicalDeviceSurfaceCapabilitiesKHR calls vkGetPhysicalDeviceSurfaceCapabilitiesKHR.
+func (p PhysicalDevice) GetPhysicalDeviceSurfaceCapabilitiesKHR(surface vk.SurfaceKHR) (vk.SurfaceCapabilitiesKHR, error) {
+	var surfaceCapabilities vk.SurfaceCapabilitiesKHR
+	ret := p.InstanceTable.GetPhysicalDeviceSurfaceCapabilitiesKHR.Call(p.PhysicalDevice, surface, &surfaceCapabilities)
+	return surfaceCapabilities, ret.Err()
+}
+
+// GetPhysicalDeviceSurfaceFormatsKHR calls vkGetPhysicalDeviceSurfaceFormatsKHR.
+func (p PhysicalDevice) GetPhysicalDeviceSurfaceFormatsKHR(surface vk.SurfaceKHR) ([]vk.SurfaceFormatKHR, error) {
+	for {
+		var n uint32
+		if ret := p.InstanceTable.GetPhysicalDeviceSurfaceFormatsKHR.Call(p.PhysicalDevice, surface, &n, nil); ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		surfaceFormats := make([]vk.SurfaceFormatKHR, n)
+		ret := p.InstanceTable.GetPhysicalDeviceSurfaceFormatsKHR.Call(p.PhysicalDevice, surface, &n, &surfaceFormats[0])
+		if ret == vk.INCOMPLETE {
+			continue // the list grew between the two calls
+		}
+		if ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		return surfaceFormats[:n], nil
+	}
+}
+
+// GetPhysicalDeviceSurfacePresentModesKHR calls vkGetPhysicalDeviceSurfacePresentModesKHR.
+func (p PhysicalDevice) GetPhysicalDeviceSurfacePresentModesKHR(surface vk.SurfaceKHR) ([]vk.PresentModeKHR, error) {
+	for {
+		var n uint32
+		if ret := p.InstanceTable.GetPhysicalDeviceSurfacePresentModesKHR.Call(p.PhysicalDevice, surface, &n, nil); ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		presentModes := make([]vk.PresentModeKHR, n)
+		ret := p.InstanceTable.GetPhysicalDeviceSurfacePresentModesKHR.Call(p.PhysicalDevice, surface, &n, &presentModes[0])
+		if ret == vk.INCOMPLETE {
+			continue // the list grew between the two calls
+		}
+		if ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		return presentModes[:n], nil
+	}
+}
+
+// CreateSwapchainKHR calls vkCreateSwapchainKHR.
+func (d Device) CreateSwapchainKHR(pCreateInfo *vk.SwapchainCreateInfoKHR) (vk.SwapchainKHR, error) {
+	var swapchain vk.SwapchainKHR
+	ret := d.DeviceTable.CreateSwapchainKHR.Call(d.Device, pCreateInfo, d.Allocator, &swapchain)
+	return swapchain, ret.Err()
+}
+
+// DestroySwapchainKHR calls vkDestroySwapchainKHR.
+func (d Device) DestroySwapchainKHR(swapchain vk.SwapchainKHR) {
+	d.DeviceTable.DestroySwapchainKHR.Call(d.Device, swapchain, d.Allocator)
+}
+
+// GetSwapchainImagesKHR calls vkGetSwapchainImagesKHR.
+func (d Device) GetSwapchainImagesKHR(swapchain vk.SwapchainKHR) ([]vk.Image, error) {
+	for {
+		var n uint32
+		if ret := d.DeviceTable.GetSwapchainImagesKHR.Call(d.Device, swapchain, &n, nil); ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		swapchainImages := make([]vk.Image, n)
+		ret := d.DeviceTable.GetSwapchainImagesKHR.Call(d.Device, swapchain, &n, &swapchainImages[0])
+		if ret == vk.INCOMPLETE {
+			continue // the list grew between the two calls
+		}
+		if ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		return swapchainImages[:n], nil
+	}
+}
+
+// AcquireNextImageKHR calls vkAcquireNextImageKHR.
+func (d Device) AcquireNextImageKHR(swapchain vk.SwapchainKHR, timeout uint64, semaphore vk.Semaphore, fence vk.Fence) (uint32, error) {
+	var imageIndex uint32
+	ret := d.DeviceTable.AcquireNextImageKHR.Call(d.Device, swapchain, timeout, semaphore, fence, &imageIndex)
+	return imageIndex, ret.Err()
+}
+
+// QueuePresentKHR calls vkQueuePresentKHR.
+func (q Queue) QueuePresentKHR(pPresentInfo *vk.PresentInfoKHR) error {
+	return q.DeviceTable.QueuePresentKHR.Call(q.Queue, pPresentInfo).Err()
+}
+
+// GetDeviceGroupPresentCapabilitiesKHR calls vkGetDeviceGroupPresentCapabilitiesKHR.
+func (d Device) GetDeviceGroupPresentCapabilitiesKHR(pDeviceGroupPresentCapabilities *vk.DeviceGroupPresentCapabilitiesKHR) error {
+	return d.DeviceTable.GetDeviceGroupPresentCapabilitiesKHR.Call(d.Device, pDeviceGroupPresentCapabilities).Err()
+}
+
+// GetDeviceGroupSurfacePresentModesKHR calls vkGetDeviceGroupSurfacePresentModesKHR.
+func (d Device) GetDeviceGroupSurfacePresentModesKHR(surface vk.SurfaceKHR) (vk.DeviceGroupPresentModeFlagsKHR, error) {
+	var modes vk.DeviceGroupPresentModeFlagsKHR
+	ret := d.DeviceTable.GetDeviceGroupSurfacePresentModesKHR.Call(d.Device, surface, &modes)
+	return modes, ret.Err()
+}
+
+// GetPhysicalDevicePresentRectanglesKHR calls vkGetPhysicalDevicePresentRectanglesKHR.
+func (p PhysicalDevice) GetPhysicalDevicePresentRectanglesKHR(surface vk.SurfaceKHR) ([]vk.Rect2D, error) {
+	for {
+		var n uint32
+		if ret := p.InstanceTable.GetPhysicalDevicePresentRectanglesKHR.Call(p.PhysicalDevice, surface, &n, nil); ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		rects := make([]vk.Rect2D, n)
+		ret := p.InstanceTable.GetPhysicalDevicePresentRectanglesKHR.Call(p.PhysicalDevice, surface, &n, &rects[0])
+		if ret == vk.INCOMPLETE {
+			continue // the list grew between the two calls
+		}
+		if ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		return rects[:n], nil
+	}
+}
+
+// AcquireNextImage2KHR calls vkAcquireNextImage2KHR.
+func (d Device) AcquireNextImage2KHR(pAcquireInfo *vk.AcquireNextImageInfoKHR) (uint32, error) {
+	var imageIndex uint32
+	ret := d.DeviceTable.AcquireNextImage2KHR.Call(d.Device, pAcquireInfo, &imageIndex)
+	return imageIndex, ret.Err()
+}
+
+// GetPhysicalDeviceDisplayPropertiesKHR calls vkGetPhysicalDeviceDisplayPropertiesKHR.
+func (p PhysicalDevice) GetPhysicalDeviceDisplayPropertiesKHR() ([]vk.DisplayPropertiesKHR, error) {
+	for {
+		var n uint32
+		if ret := p.InstanceTable.GetPhysicalDeviceDisplayPropertiesKHR.Call(p.PhysicalDevice, &n, nil); ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		properties := make([]vk.DisplayPropertiesKHR, n)
+		ret := p.InstanceTable.GetPhysicalDeviceDisplayPropertiesKHR.Call(p.PhysicalDevice, &n, &properties[0])
+		if ret == vk.INCOMPLETE {
+			continue // the list grew between the two calls
+		}
+		if ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		return properties[:n], nil
+	}
+}
+
+// GetPhysicalDeviceDisplayPlanePropertiesKHR calls vkGetPhysicalDeviceDisplayPlanePropertiesKHR.
+func (p PhysicalDevice) GetPhysicalDeviceDisplayPlanePropertiesKHR() ([]vk.DisplayPlanePropertiesKHR, error) {
+	for {
+		var n uint32
+		if ret := p.InstanceTable.GetPhysicalDeviceDisplayPlanePropertiesKHR.Call(p.PhysicalDevice, &n, nil); ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		properties := make([]vk.DisplayPlanePropertiesKHR, n)
+		ret := p.InstanceTable.GetPhysicalDeviceDisplayPlanePropertiesKHR.Call(p.PhysicalDevice, &n, &properties[0])
+		if ret == vk.INCOMPLETE {
+			continue // the list grew between the two calls
+		}
+		if ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		return properties[:n], nil
+	}
+}
+
+// GetDisplayPlaneSupportedDisplaysKHR calls vkGetDisplayPlaneSupportedDisplaysKHR.
+func (p PhysicalDevice) GetDisplayPlaneSupportedDisplaysKHR(planeIndex uint32) ([]vk.DisplayKHR, error) {
+	for {
+		var n uint32
+		if ret := p.InstanceTable.GetDisplayPlaneSupportedDisplaysKHR.Call(p.PhysicalDevice, planeIndex, &n, nil); ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		displays := make([]vk.DisplayKHR, n)
+		ret := p.InstanceTable.GetDisplayPlaneSupportedDisplaysKHR.Call(p.PhysicalDevice, planeIndex, &n, &displays[0])
+		if ret == vk.INCOMPLETE {
+			continue // the list grew between the two calls
+		}
+		if ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		return displays[:n], nil
+	}
+}
+
+// GetDisplayModePropertiesKHR calls vkGetDisplayModePropertiesKHR.
+func (p PhysicalDevice) GetDisplayModePropertiesKHR(display vk.DisplayKHR) ([]vk.DisplayModePropertiesKHR, error) {
+	for {
+		var n uint32
+		if ret := p.InstanceTable.GetDisplayModePropertiesKHR.Call(p.PhysicalDevice, display, &n, nil); ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		properties := make([]vk.DisplayModePropertiesKHR, n)
+		ret := p.InstanceTable.GetDisplayModePropertiesKHR.Call(p.PhysicalDevice, display, &n, &properties[0])
+		if ret == vk.INCOMPLETE {
+			continue // the list grew between the two calls
+		}
+		if ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		return properties[:n], nil
+	}
+}
+
+// CreateDisplayModeKHR calls vkCreateDisplayModeKHR.
+func (p PhysicalDevice) CreateDisplayModeKHR(display vk.DisplayKHR, pCreateInfo *vk.DisplayModeCreateInfoKHR) (vk.DisplayModeKHR, error) {
+	var mode vk.DisplayModeKHR
+	ret := p.InstanceTable.CreateDisplayModeKHR.Call(p.PhysicalDevice, display, pCreateInfo, p.Allocator, &mode)
+	return mode, ret.Err()
+}
+
+// GetDisplayPlaneCapabilitiesKHR calls vkGetDisplayPlaneCapabilitiesKHR.
+func (p PhysicalDevice) GetDisplayPlaneCapabilitiesKHR(mode vk.DisplayModeKHR, planeIndex uint32) (vk.DisplayPlaneCapabilitiesKHR, error) {
+	var capabilities vk.DisplayPlaneCapabilitiesKHR
+	ret := p.InstanceTable.GetDisplayPlaneCapabilitiesKHR.Call(p.PhysicalDevice, mode, planeIndex, &capabilities)
+	return capabilities, ret.Err()
+}
+
+// CreateDisplayPlaneSurfaceKHR calls vkCreateDisplayPlaneSurfaceKHR.
+func (i Instance) CreateDisplayPlaneSurfaceKHR(pCreateInfo *vk.DisplaySurfaceCreateInfoKHR) (vk.SurfaceKHR, error) {
+	var surface vk.SurfaceKHR
+	ret := i.InstanceTable.CreateDisplayPlaneSurfaceKHR.Call(i.Instance, pCreateInfo, i.Allocator, &surface)
+	return surface, ret.Err()
+}
+
+// CreateSharedSwapchainsKHR calls vkCreateSharedSwapchainsKHR.
+func (d Device) CreateSharedSwapchainsKHR(createInfos []vk.SwapchainCreateInfoKHR) ([]vk.SwapchainKHR, error) {
+	var pCreateInfos *vk.SwapchainCreateInfoKHR
+	if len(createInfos) > 0 {
+		pCreateInfos = &createInfos[0]
+	}
+	swapchains := make([]vk.SwapchainKHR, len(createInfos))
+	var pSwapchains *vk.SwapchainKHR
+	if len(swapchains) > 0 {
+		pSwapchains = &swapchains[0]
+	}
+	ret := d.DeviceTable.CreateSharedSwapchainsKHR.Call(d.Device, uint32(len(createInfos)), pCreateInfos, d.Allocator, pSwapchains)
+	return swapchains, ret.Err()
+}
+
+// GetPhysicalDeviceVideoCapabilitiesKHR calls vkGetPhysicalDeviceVideoCapabilitiesKHR.
+func (p PhysicalDevice) GetPhysicalDeviceVideoCapabilitiesKHR(pVideoProfile *vk.VideoProfileInfoKHR, pCapabilities *vk.VideoCapabilitiesKHR) error {
+	return p.InstanceTable.GetPhysicalDeviceVideoCapabilitiesKHR.Call(p.PhysicalDevice, pVideoProfile, pCapabilities).Err()
+}
+
+// GetPhysicalDeviceVideoFormatPropertiesKHR calls vkGetPhysicalDeviceVideoFormatPropertiesKHR.
+func (p PhysicalDevice) GetPhysicalDeviceVideoFormatPropertiesKHR(pVideoFormatInfo *vk.PhysicalDeviceVideoFormatInfoKHR) ([]vk.VideoFormatPropertiesKHR, error) {
+	for {
+		var n uint32
+		if ret := p.InstanceTable.GetPhysicalDeviceVideoFormatPropertiesKHR.Call(p.PhysicalDevice, pVideoFormatInfo, &n, nil); ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		videoFormatProperties := make([]vk.VideoFormatPropertiesKHR, n)
+		for k := range videoFormatProperties {
+			videoFormatProperties[k].SType = vk.STRUCTURE_TYPE_VIDEO_FORMAT_PROPERTIES_KHR
+		}
+		ret := p.InstanceTable.GetPhysicalDeviceVideoFormatPropertiesKHR.Call(p.PhysicalDevice, pVideoFormatInfo, &n, &videoFormatProperties[0])
+		if ret == vk.INCOMPLETE {
+			continue // the list grew between the two calls
+		}
+		if ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		return videoFormatProperties[:n], nil
+	}
+}
+
+// CreateVideoSessionKHR calls vkCreateVideoSessionKHR.
+func (d Device) CreateVideoSessionKHR(pCreateInfo *vk.VideoSessionCreateInfoKHR) (vk.VideoSessionKHR, error) {
+	var videoSession vk.VideoSessionKHR
+	ret := d.DeviceTable.CreateVideoSessionKHR.Call(d.Device, pCreateInfo, d.Allocator, &videoSession)
+	return videoSession, ret.Err()
+}
+
+// DestroyVideoSessionKHR calls vkDestroyVideoSessionKHR.
+func (d Device) DestroyVideoSessionKHR(videoSession vk.VideoSessionKHR) {
+	d.DeviceTable.DestroyVideoSessionKHR.Call(d.Device, videoSession, d.Allocator)
+}
+
+// GetVideoSessionMemoryRequirementsKHR calls vkGetVideoSessionMemoryRequirementsKHR.
+func (d Device) GetVideoSessionMemoryRequirementsKHR(videoSession vk.VideoSessionKHR) ([]vk.VideoSessionMemoryRequirementsKHR, error) {
+	for {
+		var n uint32
+		if ret := d.DeviceTable.GetVideoSessionMemoryRequirementsKHR.Call(d.Device, videoSession, &n, nil); ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		memoryRequirements := make([]vk.VideoSessionMemoryRequirementsKHR, n)
+		for k := range memoryRequirements {
+			memoryRequirements[k].SType = vk.STRUCTURE_TYPE_VIDEO_SESSION_MEMORY_REQUIREMENTS_KHR
+		}
+		ret := d.DeviceTable.GetVideoSessionMemoryRequirementsKHR.Call(d.Device, videoSession, &n, &memoryRequirements[0])
+		if ret == vk.INCOMPLETE {
+			continue // the list grew between the two calls
+		}
+		if ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		return memoryRequirements[:n], nil
+	}
+}
+
+// BindVideoSessionMemoryKHR calls vkBindVideoSessionMemoryKHR.
+func (d Device) BindVideoSessionMemoryKHR(videoSession vk.VideoSessionKHR, bindSessionMemoryInfos []vk.BindVideoSessionMemoryInfoKHR) error {
+	var pBindSessionMemoryInfos *vk.BindVideoSessionMemoryInfoKHR
+	if len(bindSessionMemoryInfos) > 0 {
+		pBindSessionMemoryInfos = &bindSessionMemoryInfos[0]
+	}
+	return d.DeviceTable.BindVideoSessionMemoryKHR.Call(d.Device, videoSession, uint32(len(bindSessionMemoryInfos)), pBindSessionMemoryInfos).Err()
+}
+
+// CreateVideoSessionParametersKHR calls vkCreateVideoSessionParametersKHR.
+func (d Device) CreateVideoSessionParametersKHR(pCreateInfo *vk.VideoSessionParametersCreateInfoKHR) (vk.VideoSessionParametersKHR, error) {
+	var videoSessionParameters vk.VideoSessionParametersKHR
+	ret := d.DeviceTable.CreateVideoSessionParametersKHR.Call(d.Device, pCreateInfo, d.Allocator, &videoSessionParameters)
+	return videoSessionParameters, ret.Err()
+}
+
+// UpdateVideoSessionParametersKHR calls vkUpdateVideoSessionParametersKHR.
+func (d Device) UpdateVideoSessionParametersKHR(videoSessionParameters vk.VideoSessionParametersKHR, pUpdateInfo *vk.VideoSessionParametersUpdateInfoKHR) error {
+	return d.DeviceTable.UpdateVideoSessionParametersKHR.Call(d.Device, videoSessionParameters, pUpdateInfo).Err()
+}
+
+// DestroyVideoSessionParametersKHR calls vkDestroyVideoSessionParametersKHR.
+func (d Device) DestroyVideoSessionParametersKHR(videoSessionParameters vk.VideoSessionParametersKHR) {
+	d.DeviceTable.DestroyVideoSessionParametersKHR.Call(d.Device, videoSessionParameters, d.Allocator)
+}
+
+// CmdBeginVideoCodingKHR calls vkCmdBeginVideoCodingKHR.
+func (c CommandBuffer) CmdBeginVideoCodingKHR(pBeginInfo *vk.VideoBeginCodingInfoKHR) {
+	c.DeviceTable.CmdBeginVideoCodingKHR.Call(c.CommandBuffer, pBeginInfo)
+}
+
+// CmdEndVideoCodingKHR calls vkCmdEndVideoCodingKHR.
+func (c CommandBuffer) CmdEndVideoCodingKHR(pEndCodingInfo *vk.VideoEndCodingInfoKHR) {
+	c.DeviceTable.CmdEndVideoCodingKHR.Call(c.CommandBuffer, pEndCodingInfo)
+}
+
+// CmdControlVideoCodingKHR calls vkCmdControlVideoCodingKHR.
+func (c CommandBuffer) CmdControlVideoCodingKHR(pCodingControlInfo *vk.VideoCodingControlInfoKHR) {
+	c.DeviceTable.CmdControlVideoCodingKHR.Call(c.CommandBuffer, pCodingControlInfo)
+}
+
+// CmdDecodeVideoKHR calls vkCmdDecodeVideoKHR.
+func (c CommandBuffer) CmdDecodeVideoKHR(pDecodeInfo *vk.VideoDecodeInfoKHR) {
+	c.DeviceTable.CmdDecodeVideoKHR.Call(c.CommandBuffer, pDecodeInfo)
+}
+
+// CmdBeginRenderingKHR calls vkCmdBeginRenderingKHR.
+func (c CommandBuffer) CmdBeginRenderingKHR(pRenderingInfo *vk.RenderingInfo) {
+	c.DeviceTable.CmdBeginRenderingKHR.Call(c.CommandBuffer, pRenderingInfo)
+}
+
+// CmdEndRenderingKHR calls vkCmdEndRenderingKHR.
+func (c CommandBuffer) CmdEndRenderingKHR() {
+	c.DeviceTable.CmdEndRenderingKHR.Call(c.CommandBuffer)
+}
+
+// GetPhysicalDeviceFeatures2KHR calls vkGetPhysicalDeviceFeatures2KHR.
+func (p PhysicalDevice) GetPhysicalDeviceFeatures2KHR(pFeatures *vk.PhysicalDeviceFeatures2) {
+	p.InstanceTable.GetPhysicalDeviceFeatures2KHR.Call(p.PhysicalDevice, pFeatures)
+}
+
+// GetPhysicalDeviceProperties2KHR calls vkGetPhysicalDeviceProperties2KHR.
+func (p PhysicalDevice) GetPhysicalDeviceProperties2KHR(pProperties *vk.PhysicalDeviceProperties2) {
+	p.InstanceTable.GetPhysicalDeviceProperties2KHR.Call(p.PhysicalDevice, pProperties)
+}
+
+// GetPhysicalDeviceFormatProperties2KHR calls vkGetPhysicalDeviceFormatProperties2KHR.
+func (p PhysicalDevice) GetPhysicalDeviceFormatProperties2KHR(format vk.Format, pFormatProperties *vk.FormatProperties2) {
+	p.InstanceTable.GetPhysicalDeviceFormatProperties2KHR.Call(p.PhysicalDevice, format, pFormatProperties)
+}
+
+// GetPhysicalDeviceImageFormatProperties2KHR calls vkGetPhysicalDeviceImageFormatProperties2KHR.
+func (p PhysicalDevice) GetPhysicalDeviceImageFormatProperties2KHR(pImageFormatInfo *vk.PhysicalDeviceImageFormatInfo2, pImageFormatProperties *vk.ImageFormatProperties2) error {
+	return p.InstanceTable.GetPhysicalDeviceImageFormatProperties2KHR.Call(p.PhysicalDevice, pImageFormatInfo, pImageFormatProperties).Err()
+}
+
+// GetPhysicalDeviceQueueFamilyProperties2KHR calls vkGetPhysicalDeviceQueueFamilyProperties2KHR.
+func (p PhysicalDevice) GetPhysicalDeviceQueueFamilyProperties2KHR() []vk.QueueFamilyProperties2 {
+	var n uint32
+	p.InstanceTable.GetPhysicalDeviceQueueFamilyProperties2KHR.Call(p.PhysicalDevice, &n, nil)
+	if n == 0 {
+		return nil
+	}
+	queueFamilyProperties := make([]vk.QueueFamilyProperties2, n)
+	for k := range queueFamilyProperties {
+		queueFamilyProperties[k].SType = vk.STRUCTURE_TYPE_QUEUE_FAMILY_PROPERTIES_2
+	}
+	p.InstanceTable.GetPhysicalDeviceQueueFamilyProperties2KHR.Call(p.PhysicalDevice, &n, &queueFamilyProperties[0])
+	return queueFamilyProperties[:n]
+}
+
+// GetPhysicalDeviceMemoryProperties2KHR calls vkGetPhysicalDeviceMemoryProperties2KHR.
+func (p PhysicalDevice) GetPhysicalDeviceMemoryProperties2KHR(pMemoryProperties *vk.PhysicalDeviceMemoryProperties2) {
+	p.InstanceTable.GetPhysicalDeviceMemoryProperties2KHR.Call(p.PhysicalDevice, pMemoryProperties)
+}
+
+// GetPhysicalDeviceSparseImageFormatProperties2KHR calls vkGetPhysicalDeviceSparseImageFormatProperties2KHR.
+func (p PhysicalDevice) GetPhysicalDeviceSparseImageFormatProperties2KHR(pFormatInfo *vk.PhysicalDeviceSparseImageFormatInfo2) []vk.SparseImageFormatProperties2 {
+	var n uint32
+	p.InstanceTable.GetPhysicalDeviceSparseImageFormatProperties2KHR.Call(p.PhysicalDevice, pFormatInfo, &n, nil)
+	if n == 0 {
+		return nil
+	}
+	properties := make([]vk.SparseImageFormatProperties2, n)
+	for k := range properties {
+		properties[k].SType = vk.STRUCTURE_TYPE_SPARSE_IMAGE_FORMAT_PROPERTIES_2
+	}
+	p.InstanceTable.GetPhysicalDeviceSparseImageFormatProperties2KHR.Call(p.PhysicalDevice, pFormatInfo, &n, &properties[0])
+	return properties[:n]
+}
+
+// GetDeviceGroupPeerMemoryFeaturesKHR calls vkGetDeviceGroupPeerMemoryFeaturesKHR.
+func (d Device) GetDeviceGroupPeerMemoryFeaturesKHR(heapIndex, localDeviceIndex, remoteDeviceIndex uint32) vk.PeerMemoryFeatureFlags {
+	var peerMemoryFeatures vk.PeerMemoryFeatureFlags
+	d.DeviceTable.GetDeviceGroupPeerMemoryFeaturesKHR.Call(d.Device, heapIndex, localDeviceIndex, remoteDeviceIndex, &peerMemoryFeatures)
+	return peerMemoryFeatures
+}
+
+// CmdSetDeviceMaskKHR calls vkCmdSetDeviceMaskKHR.
+func (c CommandBuffer) CmdSetDeviceMaskKHR(deviceMask uint32) {
+	c.DeviceTable.CmdSetDeviceMaskKHR.Call(c.CommandBuffer, deviceMask)
+}
+
+// CmdDispatchBaseKHR calls vkCmdDispatchBaseKHR.
+func (c CommandBuffer) CmdDispatchBaseKHR(baseGroupX, baseGroupY, baseGroupZ, groupCountX, groupCountY, groupCountZ uint32) {
+	c.DeviceTable.CmdDispatchBaseKHR.Call(c.CommandBuffer, baseGroupX, baseGroupY, baseGroupZ, groupCountX, groupCountY, groupCountZ)
+}
+
+// TrimCommandPoolKHR calls vkTrimCommandPoolKHR.
+func (d Device) TrimCommandPoolKHR(commandPool vk.CommandPool, flags vk.CommandPoolTrimFlags) {
+	d.DeviceTable.TrimCommandPoolKHR.Call(d.Device, commandPool, flags)
+}
+
+// EnumeratePhysicalDeviceGroupsKHR calls vkEnumeratePhysicalDeviceGroupsKHR.
+func (i Instance) EnumeratePhysicalDeviceGroupsKHR() ([]vk.PhysicalDeviceGroupProperties, error) {
+	for {
+		var n uint32
+		if ret := i.InstanceTable.EnumeratePhysicalDeviceGroupsKHR.Call(i.Instance, &n, nil); ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		physicalDeviceGroupProperties := make([]vk.PhysicalDeviceGroupProperties, n)
+		for k := range physicalDeviceGroupProperties {
+			physicalDeviceGroupProperties[k].SType = vk.STRUCTURE_TYPE_PHYSICAL_DEVICE_GROUP_PROPERTIES
+		}
+		ret := i.InstanceTable.EnumeratePhysicalDeviceGroupsKHR.Call(i.Instance, &n, &physicalDeviceGroupProperties[0])
+		if ret == vk.INCOMPLETE {
+			continue // the list grew between the two calls
+		}
+		if ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		return physicalDeviceGroupProperties[:n], nil
+	}
+}
+
+// GetPhysicalDeviceExternalBufferPropertiesKHR calls vkGetPhysicalDeviceExternalBufferPropertiesKHR.
+func (p PhysicalDevice) GetPhysicalDeviceExternalBufferPropertiesKHR(pExternalBufferInfo *vk.PhysicalDeviceExternalBufferInfo, pExternalBufferProperties *vk.ExternalBufferProperties) {
+	p.InstanceTable.GetPhysicalDeviceExternalBufferPropertiesKHR.Call(p.PhysicalDevice, pExternalBufferInfo, pExternalBufferProperties)
+}
+
+// GetMemoryFdKHR calls vkGetMemoryFdKHR.
+func (d Device) GetMemoryFdKHR(pGetFdInfo *vk.MemoryGetFdInfoKHR) (int32, error) {
+	var fd int32
+	ret := d.DeviceTable.GetMemoryFdKHR.Call(d.Device, pGetFdInfo, &fd)
+	return fd, ret.Err()
+}
+
+// GetMemoryFdPropertiesKHR calls vkGetMemoryFdPropertiesKHR.
+func (d Device) GetMemoryFdPropertiesKHR(handleType vk.ExternalMemoryHandleTypeFlags, fd int32, pMemoryFdProperties *vk.MemoryFdPropertiesKHR) error {
+	return d.DeviceTable.GetMemoryFdPropertiesKHR.Call(d.Device, handleType, fd, pMemoryFdProperties).Err()
+}
+
+// GetPhysicalDeviceExternalSemaphorePropertiesKHR calls vkGetPhysicalDeviceExternalSemaphorePropertiesKHR.
+func (p PhysicalDevice) GetPhysicalDeviceExternalSemaphorePropertiesKHR(pExternalSemaphoreInfo *vk.PhysicalDeviceExternalSemaphoreInfo, pExternalSemaphoreProperties *vk.ExternalSemaphoreProperties) {
+	p.InstanceTable.GetPhysicalDeviceExternalSemaphorePropertiesKHR.Call(p.PhysicalDevice, pExternalSemaphoreInfo, pExternalSemaphoreProperties)
+}
+
+// ImportSemaphoreFdKHR calls vkImportSemaphoreFdKHR.
+func (d Device) ImportSemaphoreFdKHR(pImportSemaphoreFdInfo *vk.ImportSemaphoreFdInfoKHR) error {
+	return d.DeviceTable.ImportSemaphoreFdKHR.Call(d.Device, pImportSemaphoreFdInfo).Err()
+}
+
+// GetSemaphoreFdKHR calls vkGetSemaphoreFdKHR.
+func (d Device) GetSemaphoreFdKHR(pGetFdInfo *vk.SemaphoreGetFdInfoKHR) (int32, error) {
+	var fd int32
+	ret := d.DeviceTable.GetSemaphoreFdKHR.Call(d.Device, pGetFdInfo, &fd)
+	return fd, ret.Err()
+}
+
+// CmdPushDescriptorSetKHR calls vkCmdPushDescriptorSetKHR.
+func (c CommandBuffer) CmdPushDescriptorSetKHR(pipelineBindPoint vk.PipelineBindPoint, layout vk.PipelineLayout, set uint32, descriptorWrites []vk.WriteDescriptorSet) {
+	var pDescriptorWrites *vk.WriteDescriptorSet
+	if len(descriptorWrites) > 0 {
+		pDescriptorWrites = &descriptorWrites[0]
+	}
+	c.DeviceTable.CmdPushDescriptorSetKHR.Call(c.CommandBuffer, pipelineBindPoint, layout, set, uint32(len(descriptorWrites)), pDescriptorWrites)
+}
+
+// CmdPushDescriptorSetWithTemplateKHR calls vkCmdPushDescriptorSetWithTemplateKHR.
+func (c CommandBuffer) CmdPushDescriptorSetWithTemplateKHR(descriptorUpdateTemplate vk.DescriptorUpdateTemplate, layout vk.PipelineLayout, set uint32, pData unsafe.Pointer) {
+	c.DeviceTable.CmdPushDescriptorSetWithTemplateKHR.Call(c.CommandBuffer, descriptorUpdateTemplate, layout, set, pData)
+}
+
+// CreateDescriptorUpdateTemplateKHR calls vkCreateDescriptorUpdateTemplateKHR.
+func (d Device) CreateDescriptorUpdateTemplateKHR(pCreateInfo *vk.DescriptorUpdateTemplateCreateInfo) (vk.DescriptorUpdateTemplate, error) {
+	var descriptorUpdateTemplate vk.DescriptorUpdateTemplate
+	ret := d.DeviceTable.CreateDescriptorUpdateTemplateKHR.Call(d.Device, pCreateInfo, d.Allocator, &descriptorUpdateTemplate)
+	return descriptorUpdateTemplate, ret.Err()
+}
+
+// DestroyDescriptorUpdateTemplateKHR calls vkDestroyDescriptorUpdateTemplateKHR.
+func (d Device) DestroyDescriptorUpdateTemplateKHR(descriptorUpdateTemplate vk.DescriptorUpdateTemplate) {
+	d.DeviceTable.DestroyDescriptorUpdateTemplateKHR.Call(d.Device, descriptorUpdateTemplate, d.Allocator)
+}
+
+// UpdateDescriptorSetWithTemplateKHR calls vkUpdateDescriptorSetWithTemplateKHR.
+func (d Device) UpdateDescriptorSetWithTemplateKHR(descriptorSet vk.DescriptorSet, descriptorUpdateTemplate vk.DescriptorUpdateTemplate, pData unsafe.Pointer) {
+	d.DeviceTable.UpdateDescriptorSetWithTemplateKHR.Call(d.Device, descriptorSet, descriptorUpdateTemplate, pData)
+}
+
+// CreateRenderPass2KHR calls vkCreateRenderPass2KHR.
+func (d Device) CreateRenderPass2KHR(pCreateInfo *vk.RenderPassCreateInfo2) (vk.RenderPass, error) {
+	var renderPass vk.RenderPass
+	ret := d.DeviceTable.CreateRenderPass2KHR.Call(d.Device, pCreateInfo, d.Allocator, &renderPass)
+	return renderPass, ret.Err()
+}
+
+// CmdBeginRenderPass2KHR calls vkCmdBeginRenderPass2KHR.
+func (c CommandBuffer) CmdBeginRenderPass2KHR(pRenderPassBegin *vk.RenderPassBeginInfo, pSubpassBeginInfo *vk.SubpassBeginInfo) {
+	c.DeviceTable.CmdBeginRenderPass2KHR.Call(c.CommandBuffer, pRenderPassBegin, pSubpassBeginInfo)
+}
+
+// CmdNextSubpass2KHR calls vkCmdNextSubpass2KHR.
+func (c CommandBuffer) CmdNextSubpass2KHR(pSubpassBeginInfo *vk.SubpassBeginInfo, pSubpassEndInfo *vk.SubpassEndInfo) {
+	c.DeviceTable.CmdNextSubpass2KHR.Call(c.CommandBuffer, pSubpassBeginInfo, pSubpassEndInfo)
+}
+
+// CmdEndRenderPass2KHR calls vkCmdEndRenderPass2KHR.
+func (c CommandBuffer) CmdEndRenderPass2KHR(pSubpassEndInfo *vk.SubpassEndInfo) {
+	c.DeviceTable.CmdEndRenderPass2KHR.Call(c.CommandBuffer, pSubpassEndInfo)
+}
+
+// GetSwapchainStatusKHR calls vkGetSwapchainStatusKHR.
+func (d Device) GetSwapchainStatusKHR(swapchain vk.SwapchainKHR) error {
+	return d.DeviceTable.GetSwapchainStatusKHR.Call(d.Device, swapchain).Err()
+}
+
+// GetPhysicalDeviceExternalFencePropertiesKHR calls vkGetPhysicalDeviceExternalFencePropertiesKHR.
+func (p PhysicalDevice) GetPhysicalDeviceExternalFencePropertiesKHR(pExternalFenceInfo *vk.PhysicalDeviceExternalFenceInfo, pExternalFenceProperties *vk.ExternalFenceProperties) {
+	p.InstanceTable.GetPhysicalDeviceExternalFencePropertiesKHR.Call(p.PhysicalDevice, pExternalFenceInfo, pExternalFenceProperties)
+}
+
+// ImportFenceFdKHR calls vkImportFenceFdKHR.
+func (d Device) ImportFenceFdKHR(pImportFenceFdInfo *vk.ImportFenceFdInfoKHR) error {
+	return d.DeviceTable.ImportFenceFdKHR.Call(d.Device, pImportFenceFdInfo).Err()
+}
+
+// GetFenceFdKHR calls vkGetFenceFdKHR.
+func (d Device) GetFenceFdKHR(pGetFdInfo *vk.FenceGetFdInfoKHR) (int32, error) {
+	var fd int32
+	ret := d.DeviceTable.GetFenceFdKHR.Call(d.Device, pGetFdInfo, &fd)
+	return fd, ret.Err()
+}
+
+// EnumeratePhysicalDeviceQueueFamilyPerformanceQueryCountersKHR calls vkEnumeratePhysicalDeviceQueueFamilyPerformanceQueryCountersKHR.
+func (p PhysicalDevice) EnumeratePhysicalDeviceQueueFamilyPerformanceQueryCountersKHR(queueFamilyIndex uint32, pCounterCount *uint32, pCounters *vk.PerformanceCounterKHR, pCounterDescriptions *vk.PerformanceCounterDescriptionKHR) error {
+	return p.InstanceTable.EnumeratePhysicalDeviceQueueFamilyPerformanceQueryCountersKHR.Call(p.PhysicalDevice, queueFamilyIndex, pCounterCount, pCounters, pCounterDescriptions).Err()
+}
+
+// GetPhysicalDeviceQueueFamilyPerformanceQueryPassesKHR calls vkGetPhysicalDeviceQueueFamilyPerformanceQueryPassesKHR.
+func (p PhysicalDevice) GetPhysicalDeviceQueueFamilyPerformanceQueryPassesKHR(pPerformanceQueryCreateInfo *vk.QueryPoolPerformanceCreateInfoKHR) uint32 {
+	var numPasses uint32
+	p.InstanceTable.GetPhysicalDeviceQueueFamilyPerformanceQueryPassesKHR.Call(p.PhysicalDevice, pPerformanceQueryCreateInfo, &numPasses)
+	return numPasses
+}
+
+// AcquireProfilingLockKHR calls vkAcquireProfilingLockKHR.
+func (d Device) AcquireProfilingLockKHR(pInfo *vk.AcquireProfilingLockInfoKHR) error {
+	return d.DeviceTable.AcquireProfilingLockKHR.Call(d.Device, pInfo).Err()
+}
+
+// ReleaseProfilingLockKHR calls vkReleaseProfilingLockKHR.
+func (d Device) ReleaseProfilingLockKHR() {
+	d.DeviceTable.ReleaseProfilingLockKHR.Call(d.Device)
+}
+
+// GetPhysicalDeviceSurfaceCapabilities2KHR calls vkGetPhysicalDeviceSurfaceCapabilities2KHR.
+func (p PhysicalDevice) GetPhysicalDeviceSurfaceCapabilities2KHR(pSurfaceInfo *vk.PhysicalDeviceSurfaceInfo2KHR, pSurfaceCapabilities *vk.SurfaceCapabilities2KHR) error {
+	return p.InstanceTable.GetPhysicalDeviceSurfaceCapabilities2KHR.Call(p.PhysicalDevice, pSurfaceInfo, pSurfaceCapabilities).Err()
+}
+
+// GetPhysicalDeviceSurfaceFormats2KHR calls vkGetPhysicalDeviceSurfaceFormats2KHR.
+func (p PhysicalDevice) GetPhysicalDeviceSurfaceFormats2KHR(pSurfaceInfo *vk.PhysicalDeviceSurfaceInfo2KHR) ([]vk.SurfaceFormat2KHR, error) {
+	for {
+		var n uint32
+		if ret := p.InstanceTable.GetPhysicalDeviceSurfaceFormats2KHR.Call(p.PhysicalDevice, pSurfaceInfo, &n, nil); ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		surfaceFormats := make([]vk.SurfaceFormat2KHR, n)
+		for k := range surfaceFormats {
+			surfaceFormats[k].SType = vk.STRUCTURE_TYPE_SURFACE_FORMAT_2_KHR
+		}
+		ret := p.InstanceTable.GetPhysicalDeviceSurfaceFormats2KHR.Call(p.PhysicalDevice, pSurfaceInfo, &n, &surfaceFormats[0])
+		if ret == vk.INCOMPLETE {
+			continue // the list grew between the two calls
+		}
+		if ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		return surfaceFormats[:n], nil
+	}
+}
+
+// GetPhysicalDeviceDisplayProperties2KHR calls vkGetPhysicalDeviceDisplayProperties2KHR.
+func (p PhysicalDevice) GetPhysicalDeviceDisplayProperties2KHR() ([]vk.DisplayProperties2KHR, error) {
+	for {
+		var n uint32
+		if ret := p.InstanceTable.GetPhysicalDeviceDisplayProperties2KHR.Call(p.PhysicalDevice, &n, nil); ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		properties := make([]vk.DisplayProperties2KHR, n)
+		for k := range properties {
+			properties[k].SType = vk.STRUCTURE_TYPE_DISPLAY_PROPERTIES_2_KHR
+		}
+		ret := p.InstanceTable.GetPhysicalDeviceDisplayProperties2KHR.Call(p.PhysicalDevice, &n, &properties[0])
+		if ret == vk.INCOMPLETE {
+			continue // the list grew between the two calls
+		}
+		if ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		return properties[:n], nil
+	}
+}
+
+// GetPhysicalDeviceDisplayPlaneProperties2KHR calls vkGetPhysicalDeviceDisplayPlaneProperties2KHR.
+func (p PhysicalDevice) GetPhysicalDeviceDisplayPlaneProperties2KHR() ([]vk.DisplayPlaneProperties2KHR, error) {
+	for {
+		var n uint32
+		if ret := p.InstanceTable.GetPhysicalDeviceDisplayPlaneProperties2KHR.Call(p.PhysicalDevice, &n, nil); ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		properties := make([]vk.DisplayPlaneProperties2KHR, n)
+		for k := range properties {
+			properties[k].SType = vk.STRUCTURE_TYPE_DISPLAY_PLANE_PROPERTIES_2_KHR
+		}
+		ret := p.InstanceTable.GetPhysicalDeviceDisplayPlaneProperties2KHR.Call(p.PhysicalDevice, &n, &properties[0])
+		if ret == vk.INCOMPLETE {
+			continue // the list grew between the two calls
+		}
+		if ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		return properties[:n], nil
+	}
+}
+
+// GetDisplayModeProperties2KHR calls vkGetDisplayModeProperties2KHR.
+func (p PhysicalDevice) GetDisplayModeProperties2KHR(display vk.DisplayKHR) ([]vk.DisplayModeProperties2KHR, error) {
+	for {
+		var n uint32
+		if ret := p.InstanceTable.GetDisplayModeProperties2KHR.Call(p.PhysicalDevice, display, &n, nil); ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		properties := make([]vk.DisplayModeProperties2KHR, n)
+		for k := range properties {
+			properties[k].SType = vk.STRUCTURE_TYPE_DISPLAY_MODE_PROPERTIES_2_KHR
+		}
+		ret := p.InstanceTable.GetDisplayModeProperties2KHR.Call(p.PhysicalDevice, display, &n, &properties[0])
+		if ret == vk.INCOMPLETE {
+			continue // the list grew between the two calls
+		}
+		if ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		return properties[:n], nil
+	}
+}
+
+// GetDisplayPlaneCapabilities2KHR calls vkGetDisplayPlaneCapabilities2KHR.
+func (p PhysicalDevice) GetDisplayPlaneCapabilities2KHR(pDisplayPlaneInfo *vk.DisplayPlaneInfo2KHR, pCapabilities *vk.DisplayPlaneCapabilities2KHR) error {
+	return p.InstanceTable.GetDisplayPlaneCapabilities2KHR.Call(p.PhysicalDevice, pDisplayPlaneInfo, pCapabilities).Err()
+}
+
+// GetImageMemoryRequirements2KHR calls vkGetImageMemoryRequirements2KHR.
+func (d Device) GetImageMemoryRequirements2KHR(pInfo *vk.ImageMemoryRequirementsInfo2, pMemoryRequirements *vk.MemoryRequirements2) {
+	d.DeviceTable.GetImageMemoryRequirements2KHR.Call(d.Device, pInfo, pMemoryRequirements)
+}
+
+// GetBufferMemoryRequirements2KHR calls vkGetBufferMemoryRequirements2KHR.
+func (d Device) GetBufferMemoryRequirements2KHR(pInfo *vk.BufferMemoryRequirementsInfo2, pMemoryRequirements *vk.MemoryRequirements2) {
+	d.DeviceTable.GetBufferMemoryRequirements2KHR.Call(d.Device, pInfo, pMemoryRequirements)
+}
+
+// GetImageSparseMemoryRequirements2KHR calls vkGetImageSparseMemoryRequirements2KHR.
+func (d Device) GetImageSparseMemoryRequirements2KHR(pInfo *vk.ImageSparseMemoryRequirementsInfo2) []vk.SparseImageMemoryRequirements2 {
+	var n uint32
+	d.DeviceTable.GetImageSparseMemoryRequirements2KHR.Call(d.Device, pInfo, &n, nil)
+	if n == 0 {
+		return nil
+	}
+	sparseMemoryRequirements := make([]vk.SparseImageMemoryRequirements2, n)
+	for k := range sparseMemoryRequirements {
+		sparseMemoryRequirements[k].SType = vk.STRUCTURE_TYPE_SPARSE_IMAGE_MEMORY_REQUIREMENTS_2
+	}
+	d.DeviceTable.GetImageSparseMemoryRequirements2KHR.Call(d.Device, pInfo, &n, &sparseMemoryRequirements[0])
+	return sparseMemoryRequirements[:n]
+}
+
+// CreateAccelerationStructureKHR calls vkCreateAccelerationStructureKHR.
+func (d Device) CreateAccelerationStructureKHR(pCreateInfo *vk.AccelerationStructureCreateInfoKHR) (vk.AccelerationStructureKHR, error) {
+	var accelerationStructure vk.AccelerationStructureKHR
+	ret := d.DeviceTable.CreateAccelerationStructureKHR.Call(d.Device, pCreateInfo, d.Allocator, &accelerationStructure)
+	return accelerationStructure, ret.Err()
+}
+
+// DestroyAccelerationStructureKHR calls vkDestroyAccelerationStructureKHR.
+func (d Device) DestroyAccelerationStructureKHR(accelerationStructure vk.AccelerationStructureKHR) {
+	d.DeviceTable.DestroyAccelerationStructureKHR.Call(d.Device, accelerationStructure, d.Allocator)
+}
+
+// CmdBuildAccelerationStructuresKHR calls vkCmdBuildAccelerationStructuresKHR.
+func (c CommandBuffer) CmdBuildAccelerationStructuresKHR(infoCount uint32, pInfos *vk.AccelerationStructureBuildGeometryInfoKHR, ppBuildRangeInfos **vk.AccelerationStructureBuildRangeInfoKHR) {
+	c.DeviceTable.CmdBuildAccelerationStructuresKHR.Call(c.CommandBuffer, infoCount, pInfos, ppBuildRangeInfos)
+}
+
+// CmdBuildAccelerationStructuresIndirectKHR calls vkCmdBuildAccelerationStructuresIndirectKHR.
+func (c CommandBuffer) CmdBuildAccelerationStructuresIndirectKHR(infoCount uint32, pInfos *vk.AccelerationStructureBuildGeometryInfoKHR, pIndirectDeviceAddresses *vk.DeviceAddress, pIndirectStrides *uint32, ppMaxPrimitiveCounts **uint32) {
+	c.DeviceTable.CmdBuildAccelerationStructuresIndirectKHR.Call(c.CommandBuffer, infoCount, pInfos, pIndirectDeviceAddresses, pIndirectStrides, ppMaxPrimitiveCounts)
+}
+
+// BuildAccelerationStructuresKHR calls vkBuildAccelerationStructuresKHR.
+func (d Device) BuildAccelerationStructuresKHR(deferredOperation vk.DeferredOperationKHR, infoCount uint32, pInfos *vk.AccelerationStructureBuildGeometryInfoKHR, ppBuildRangeInfos **vk.AccelerationStructureBuildRangeInfoKHR) error {
+	return d.DeviceTable.BuildAccelerationStructuresKHR.Call(d.Device, deferredOperation, infoCount, pInfos, ppBuildRangeInfos).Err()
+}
+
+// CopyAccelerationStructureKHR calls vkCopyAccelerationStructureKHR.
+func (d Device) CopyAccelerationStructureKHR(deferredOperation vk.DeferredOperationKHR, pInfo *vk.CopyAccelerationStructureInfoKHR) error {
+	return d.DeviceTable.CopyAccelerationStructureKHR.Call(d.Device, deferredOperation, pInfo).Err()
+}
+
+// CopyAccelerationStructureToMemoryKHR calls vkCopyAccelerationStructureToMemoryKHR.
+func (d Device) CopyAccelerationStructureToMemoryKHR(deferredOperation vk.DeferredOperationKHR, pInfo *vk.CopyAccelerationStructureToMemoryInfoKHR) error {
+	return d.DeviceTable.CopyAccelerationStructureToMemoryKHR.Call(d.Device, deferredOperation, pInfo).Err()
+}
+
+// CopyMemoryToAccelerationStructureKHR calls vkCopyMemoryToAccelerationStructureKHR.
+func (d Device) CopyMemoryToAccelerationStructureKHR(deferredOperation vk.DeferredOperationKHR, pInfo *vk.CopyMemoryToAccelerationStructureInfoKHR) error {
+	return d.DeviceTable.CopyMemoryToAccelerationStructureKHR.Call(d.Device, deferredOperation, pInfo).Err()
+}
+
+// WriteAccelerationStructuresPropertiesKHR calls vkWriteAccelerationStructuresPropertiesKHR.
+func (d Device) WriteAccelerationStructuresPropertiesKHR(accelerationStructures []vk.AccelerationStructureKHR, queryType vk.QueryType, dataSize uintptr, pData unsafe.Pointer, stride uintptr) error {
+	var pAccelerationStructures *vk.AccelerationStructureKHR
+	if len(accelerationStructures) > 0 {
+		pAccelerationStructures = &accelerationStructures[0]
+	}
+	return d.DeviceTable.WriteAccelerationStructuresPropertiesKHR.Call(d.Device, uint32(len(accelerationStructures)), pAccelerationStructures, queryType, dataSize, pData, stride).Err()
+}
+
+// CmdCopyAccelerationStructureKHR calls vkCmdCopyAccelerationStructureKHR.
+func (c CommandBuffer) CmdCopyAccelerationStructureKHR(pInfo *vk.CopyAccelerationStructureInfoKHR) {
+	c.DeviceTable.CmdCopyAccelerationStructureKHR.Call(c.CommandBuffer, pInfo)
+}
+
+// CmdCopyAccelerationStructureToMemoryKHR calls vkCmdCopyAccelerationStructureToMemoryKHR.
+func (c CommandBuffer) CmdCopyAccelerationStructureToMemoryKHR(pInfo *vk.CopyAccelerationStructureToMemoryInfoKHR) {
+	c.DeviceTable.CmdCopyAccelerationStructureToMemoryKHR.Call(c.CommandBuffer, pInfo)
+}
+
+// CmdCopyMemoryToAccelerationStructureKHR calls vkCmdCopyMemoryToAccelerationStructureKHR.
+func (c CommandBuffer) CmdCopyMemoryToAccelerationStructureKHR(pInfo *vk.CopyMemoryToAccelerationStructureInfoKHR) {
+	c.DeviceTable.CmdCopyMemoryToAccelerationStructureKHR.Call(c.CommandBuffer, pInfo)
+}
+
+// GetAccelerationStructureDeviceAddressKHR calls vkGetAccelerationStructureDeviceAddressKHR.
+func (d Device) GetAccelerationStructureDeviceAddressKHR(pInfo *vk.AccelerationStructureDeviceAddressInfoKHR) vk.DeviceAddress {
+	return d.DeviceTable.GetAccelerationStructureDeviceAddressKHR.Call(d.Device, pInfo)
+}
+
+// CmdWriteAccelerationStructuresPropertiesKHR calls vkCmdWriteAccelerationStructuresPropertiesKHR.
+func (c CommandBuffer) CmdWriteAccelerationStructuresPropertiesKHR(accelerationStructures []vk.AccelerationStructureKHR, queryType vk.QueryType, queryPool vk.QueryPool, firstQuery uint32) {
+	var pAccelerationStructures *vk.AccelerationStructureKHR
+	if len(accelerationStructures) > 0 {
+		pAccelerationStructures = &accelerationStructures[0]
+	}
+	c.DeviceTable.CmdWriteAccelerationStructuresPropertiesKHR.Call(c.CommandBuffer, uint32(len(accelerationStructures)), pAccelerationStructures, queryType, queryPool, firstQuery)
+}
+
+// GetDeviceAccelerationStructureCompatibilityKHR calls vkGetDeviceAccelerationStructureCompatibilityKHR.
+func (d Device) GetDeviceAccelerationStructureCompatibilityKHR(pVersionInfo *vk.AccelerationStructureVersionInfoKHR) vk.AccelerationStructureCompatibilityKHR {
+	var compatibility vk.AccelerationStructureCompatibilityKHR
+	d.DeviceTable.GetDeviceAccelerationStructureCompatibilityKHR.Call(d.Device, pVersionInfo, &compatibility)
+	return compatibility
+}
+
+// GetAccelerationStructureBuildSizesKHR calls vkGetAccelerationStructureBuildSizesKHR.
+func (d Device) GetAccelerationStructureBuildSizesKHR(buildType vk.AccelerationStructureBuildTypeKHR, pBuildInfo *vk.AccelerationStructureBuildGeometryInfoKHR, pMaxPrimitiveCounts *uint32, pSizeInfo *vk.AccelerationStructureBuildSizesInfoKHR) {
+	d.DeviceTable.GetAccelerationStructureBuildSizesKHR.Call(d.Device, buildType, pBuildInfo, pMaxPrimitiveCounts, pSizeInfo)
+}
+
+// CreateSamplerYcbcrConversionKHR calls vkCreateSamplerYcbcrConversionKHR.
+func (d Device) CreateSamplerYcbcrConversionKHR(pCreateInfo *vk.SamplerYcbcrConversionCreateInfo) (vk.SamplerYcbcrConversion, error) {
+	var ycbcrConversion vk.SamplerYcbcrConversion
+	ret := d.DeviceTable.CreateSamplerYcbcrConversionKHR.Call(d.Device, pCreateInfo, d.Allocator, &ycbcrConversion)
+	return ycbcrConversion, ret.Err()
+}
+
+// DestroySamplerYcbcrConversionKHR calls vkDestroySamplerYcbcrConversionKHR.
+func (d Device) DestroySamplerYcbcrConversionKHR(ycbcrConversion vk.SamplerYcbcrConversion) {
+	d.DeviceTable.DestroySamplerYcbcrConversionKHR.Call(d.Device, ycbcrConversion, d.Allocator)
+}
+
+// BindBufferMemory2KHR calls vkBindBufferMemory2KHR.
+func (d Device) BindBufferMemory2KHR(bindInfos []vk.BindBufferMemoryInfo) error {
+	var pBindInfos *vk.BindBufferMemoryInfo
+	if len(bindInfos) > 0 {
+		pBindInfos = &bindInfos[0]
+	}
+	return d.DeviceTable.BindBufferMemory2KHR.Call(d.Device, uint32(len(bindInfos)), pBindInfos).Err()
+}
+
+// BindImageMemory2KHR calls vkBindImageMemory2KHR.
+func (d Device) BindImageMemory2KHR(bindInfos []vk.BindImageMemoryInfo) error {
+	var pBindInfos *vk.BindImageMemoryInfo
+	if len(bindInfos) > 0 {
+		pBindInfos = &bindInfos[0]
+	}
+	return d.DeviceTable.BindImageMemory2KHR.Call(d.Device, uint32(len(bindInfos)), pBindInfos).Err()
+}
+
+// GetDescriptorSetLayoutSupportKHR calls vkGetDescriptorSetLayoutSupportKHR.
+func (d Device) GetDescriptorSetLayoutSupportKHR(pCreateInfo *vk.DescriptorSetLayoutCreateInfo, pSupport *vk.DescriptorSetLayoutSupport) {
+	d.DeviceTable.GetDescriptorSetLayoutSupportKHR.Call(d.Device, pCreateInfo, pSupport)
+}
+
+// CmdDrawIndirectCountKHR calls vkCmdDrawIndirectCountKHR.
+func (c CommandBuffer) CmdDrawIndirectCountKHR(buffer vk.Buffer, offset vk.DeviceSize, countBuffer vk.Buffer, countBufferOffset vk.DeviceSize, maxDrawCount, stride uint32) {
+	c.DeviceTable.CmdDrawIndirectCountKHR.Call(c.CommandBuffer, buffer, offset, countBuffer, countBufferOffset, maxDrawCount, stride)
+}
+
+// CmdDrawIndexedIndirectCountKHR calls vkCmdDrawIndexedIndirectCountKHR.
+func (c CommandBuffer) CmdDrawIndexedIndirectCountKHR(buffer vk.Buffer, offset vk.DeviceSize, countBuffer vk.Buffer, countBufferOffset vk.DeviceSize, maxDrawCount, stride uint32) {
+	c.DeviceTable.CmdDrawIndexedIndirectCountKHR.Call(c.CommandBuffer, buffer, offset, countBuffer, countBufferOffset, maxDrawCount, stride)
+}
+
+// GetSemaphoreCounterValueKHR calls vkGetSemaphoreCounterValueKHR.
+func (d Device) GetSemaphoreCounterValueKHR(semaphore vk.Semaphore) (uint64, error) {
+	var value uint64
+	ret := d.DeviceTable.GetSemaphoreCounterValueKHR.Call(d.Device, semaphore, &value)
+	return value, ret.Err()
+}
+
+// WaitSemaphoresKHR calls vkWaitSemaphoresKHR.
+func (d Device) WaitSemaphoresKHR(pWaitInfo *vk.SemaphoreWaitInfo, timeout uint64) error {
+	return d.DeviceTable.WaitSemaphoresKHR.Call(d.Device, pWaitInfo, timeout).Err()
+}
+
+// SignalSemaphoreKHR calls vkSignalSemaphoreKHR.
+func (d Device) SignalSemaphoreKHR(pSignalInfo *vk.SemaphoreSignalInfo) error {
+	return d.DeviceTable.SignalSemaphoreKHR.Call(d.Device, pSignalInfo).Err()
+}
+
+// GetPhysicalDeviceFragmentShadingRatesKHR calls vkGetPhysicalDeviceFragmentShadingRatesKHR.
+func (p PhysicalDevice) GetPhysicalDeviceFragmentShadingRatesKHR() ([]vk.PhysicalDeviceFragmentShadingRateKHR, error) {
+	for {
+		var n uint32
+		if ret := p.InstanceTable.GetPhysicalDeviceFragmentShadingRatesKHR.Call(p.PhysicalDevice, &n, nil); ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		fragmentShadingRates := make([]vk.PhysicalDeviceFragmentShadingRateKHR, n)
+		for k := range fragmentShadingRates {
+			fragmentShadingRates[k].SType = vk.STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_KHR
+		}
+		ret := p.InstanceTable.GetPhysicalDeviceFragmentShadingRatesKHR.Call(p.PhysicalDevice, &n, &fragmentShadingRates[0])
+		if ret == vk.INCOMPLETE {
+			continue // the list grew between the two calls
+		}
+		if ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		return fragmentShadingRates[:n], nil
+	}
+}
+
+// CmdSetFragmentShadingRateKHR calls vkCmdSetFragmentShadingRateKHR.
+func (c CommandBuffer) CmdSetFragmentShadingRateKHR(pFragmentSize *vk.Extent2D, combinerOps *[2]vk.FragmentShadingRateCombinerOpKHR) {
+	c.DeviceTable.CmdSetFragmentShadingRateKHR.Call(c.CommandBuffer, pFragmentSize, combinerOps)
+}
+
+// WaitForPresentKHR calls vkWaitForPresentKHR.
+func (d Device) WaitForPresentKHR(swapchain vk.SwapchainKHR, presentId, timeout uint64) error {
+	return d.DeviceTable.WaitForPresentKHR.Call(d.Device, swapchain, presentId, timeout).Err()
+}
+
+// GetBufferDeviceAddressKHR calls vkGetBufferDeviceAddressKHR.
+func (d Device) GetBufferDeviceAddressKHR(pInfo *vk.BufferDeviceAddressInfo) vk.DeviceAddress {
+	return d.DeviceTable.GetBufferDeviceAddressKHR.Call(d.Device, pInfo)
+}
+
+// GetBufferOpaqueCaptureAddressKHR calls vkGetBufferOpaqueCaptureAddressKHR.
+func (d Device) GetBufferOpaqueCaptureAddressKHR(pInfo *vk.BufferDeviceAddressInfo) uint64 {
+	return d.DeviceTable.GetBufferOpaqueCaptureAddressKHR.Call(d.Device, pInfo)
+}
+
+// GetDeviceMemoryOpaqueCaptureAddressKHR calls vkGetDeviceMemoryOpaqueCaptureAddressKHR.
+func (d Device) GetDeviceMemoryOpaqueCaptureAddressKHR(pInfo *vk.DeviceMemoryOpaqueCaptureAddressInfo) uint64 {
+	return d.DeviceTable.GetDeviceMemoryOpaqueCaptureAddressKHR.Call(d.Device, pInfo)
+}
+
+// CreateDeferredOperationKHR calls vkCreateDeferredOperationKHR.
+func (d Device) CreateDeferredOperationKHR() (vk.DeferredOperationKHR, error) {
+	var deferredOperation vk.DeferredOperationKHR
+	ret := d.DeviceTable.CreateDeferredOperationKHR.Call(d.Device, d.Allocator, &deferredOperation)
+	return deferredOperation, ret.Err()
+}
+
+// DestroyDeferredOperationKHR calls vkDestroyDeferredOperationKHR.
+func (d Device) DestroyDeferredOperationKHR(operation vk.DeferredOperationKHR) {
+	d.DeviceTable.DestroyDeferredOperationKHR.Call(d.Device, operation, d.Allocator)
+}
+
+// GetDeferredOperationMaxConcurrencyKHR calls vkGetDeferredOperationMaxConcurrencyKHR.
+func (d Device) GetDeferredOperationMaxConcurrencyKHR(operation vk.DeferredOperationKHR) uint32 {
+	return d.DeviceTable.GetDeferredOperationMaxConcurrencyKHR.Call(d.Device, operation)
+}
+
+// GetDeferredOperationResultKHR calls vkGetDeferredOperationResultKHR.
+func (d Device) GetDeferredOperationResultKHR(operation vk.DeferredOperationKHR) error {
+	return d.DeviceTable.GetDeferredOperationResultKHR.Call(d.Device, operation).Err()
+}
+
+// DeferredOperationJoinKHR calls vkDeferredOperationJoinKHR.
+func (d Device) DeferredOperationJoinKHR(operation vk.DeferredOperationKHR) error {
+	return d.DeviceTable.DeferredOperationJoinKHR.Call(d.Device, operation).Err()
+}
+
+// GetPipelineExecutablePropertiesKHR calls vkGetPipelineExecutablePropertiesKHR.
+func (d Device) GetPipelineExecutablePropertiesKHR(pPipelineInfo *vk.PipelineInfoKHR) ([]vk.PipelineExecutablePropertiesKHR, error) {
+	for {
+		var n uint32
+		if ret := d.DeviceTable.GetPipelineExecutablePropertiesKHR.Call(d.Device, pPipelineInfo, &n, nil); ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		properties := make([]vk.PipelineExecutablePropertiesKHR, n)
+		for k := range properties {
+			properties[k].SType = vk.STRUCTURE_TYPE_PIPELINE_EXECUTABLE_PROPERTIES_KHR
+		}
+		ret := d.DeviceTable.GetPipelineExecutablePropertiesKHR.Call(d.Device, pPipelineInfo, &n, &properties[0])
+		if ret == vk.INCOMPLETE {
+			continue // the list grew between the two calls
+		}
+		if ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		return properties[:n], nil
+	}
+}
+
+// GetPipelineExecutableStatisticsKHR calls vkGetPipelineExecutableStatisticsKHR.
+func (d Device) GetPipelineExecutableStatisticsKHR(pExecutableInfo *vk.PipelineExecutableInfoKHR) ([]vk.PipelineExecutableStatisticKHR, error) {
+	for {
+		var n uint32
+		if ret := d.DeviceTable.GetPipelineExecutableStatisticsKHR.Call(d.Device, pExecutableInfo, &n, nil); ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		statistics := make([]vk.PipelineExecutableStatisticKHR, n)
+		for k := range statistics {
+			statistics[k].SType = vk.STRUCTURE_TYPE_PIPELINE_EXECUTABLE_STATISTIC_KHR
+		}
+		ret := d.DeviceTable.GetPipelineExecutableStatisticsKHR.Call(d.Device, pExecutableInfo, &n, &statistics[0])
+		if ret == vk.INCOMPLETE {
+			continue // the list grew between the two calls
+		}
+		if ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		return statistics[:n], nil
+	}
+}
+
+// GetPipelineExecutableInternalRepresentationsKHR calls vkGetPipelineExecutableInternalRepresentationsKHR.
+func (d Device) GetPipelineExecutableInternalRepresentationsKHR(pExecutableInfo *vk.PipelineExecutableInfoKHR) ([]vk.PipelineExecutableInternalRepresentationKHR, error) {
+	for {
+		var n uint32
+		if ret := d.DeviceTable.GetPipelineExecutableInternalRepresentationsKHR.Call(d.Device, pExecutableInfo, &n, nil); ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		internalRepresentations := make([]vk.PipelineExecutableInternalRepresentationKHR, n)
+		for k := range internalRepresentations {
+			internalRepresentations[k].SType = vk.STRUCTURE_TYPE_PIPELINE_EXECUTABLE_INTERNAL_REPRESENTATION_KHR
+		}
+		ret := d.DeviceTable.GetPipelineExecutableInternalRepresentationsKHR.Call(d.Device, pExecutableInfo, &n, &internalRepresentations[0])
+		if ret == vk.INCOMPLETE {
+			continue // the list grew between the two calls
+		}
+		if ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		return internalRepresentations[:n], nil
+	}
+}
+
+// CmdSetEvent2KHR calls vkCmdSetEvent2KHR.
+func (c CommandBuffer) CmdSetEvent2KHR(event vk.Event, pDependencyInfo *vk.DependencyInfo) {
+	c.DeviceTable.CmdSetEvent2KHR.Call(c.CommandBuffer, event, pDependencyInfo)
+}
+
+// CmdResetEvent2KHR calls vkCmdResetEvent2KHR.
+func (c CommandBuffer) CmdResetEvent2KHR(event vk.Event, stageMask vk.PipelineStageFlags2) {
+	c.DeviceTable.CmdResetEvent2KHR.Call(c.CommandBuffer, event, stageMask)
+}
+
+// CmdWaitEvents2KHR calls vkCmdWaitEvents2KHR.
+func (c CommandBuffer) CmdWaitEvents2KHR(eventCount uint32, pEvents *vk.Event, pDependencyInfos *vk.DependencyInfo) {
+	c.DeviceTable.CmdWaitEvents2KHR.Call(c.CommandBuffer, eventCount, pEvents, pDependencyInfos)
+}
+
+// CmdPipelineBarrier2KHR calls vkCmdPipelineBarrier2KHR.
+func (c CommandBuffer) CmdPipelineBarrier2KHR(pDependencyInfo *vk.DependencyInfo) {
+	c.DeviceTable.CmdPipelineBarrier2KHR.Call(c.CommandBuffer, pDependencyInfo)
+}
+
+// CmdWriteTimestamp2KHR calls vkCmdWriteTimestamp2KHR.
+func (c CommandBuffer) CmdWriteTimestamp2KHR(stage vk.PipelineStageFlags2, queryPool vk.QueryPool, query uint32) {
+	c.DeviceTable.CmdWriteTimestamp2KHR.Call(c.CommandBuffer, stage, queryPool, query)
+}
+
+// QueueSubmit2KHR calls vkQueueSubmit2KHR.
+func (q Queue) QueueSubmit2KHR(submits []vk.SubmitInfo2, fence vk.Fence) error {
+	var pSubmits *vk.SubmitInfo2
+	if len(submits) > 0 {
+		pSubmits = &submits[0]
+	}
+	return q.DeviceTable.QueueSubmit2KHR.Call(q.Queue, uint32(len(submits)), pSubmits, fence).Err()
+}
+
+// CmdWriteBufferMarker2AMD calls vkCmdWriteBufferMarker2AMD.
+func (c CommandBuffer) CmdWriteBufferMarker2AMD(stage vk.PipelineStageFlags2, dstBuffer vk.Buffer, dstOffset vk.DeviceSize, marker uint32) {
+	c.DeviceTable.CmdWriteBufferMarker2AMD.Call(c.CommandBuffer, stage, dstBuffer, dstOffset, marker)
+}
+
+// GetQueueCheckpointData2NV calls vkGetQueueCheckpointData2NV.
+func (q Queue) GetQueueCheckpointData2NV() []vk.CheckpointData2NV {
+	var n uint32
+	q.DeviceTable.GetQueueCheckpointData2NV.Call(q.Queue, &n, nil)
+	if n == 0 {
+		return nil
+	}
+	checkpointData := make([]vk.CheckpointData2NV, n)
+	for k := range checkpointData {
+		checkpointData[k].SType = vk.STRUCTURE_TYPE_CHECKPOINT_DATA_2_NV
+	}
+	q.DeviceTable.GetQueueCheckpointData2NV.Call(q.Queue, &n, &checkpointData[0])
+	return checkpointData[:n]
+}
+
+// CmdCopyBuffer2KHR calls vkCmdCopyBuffer2KHR.
+func (c CommandBuffer) CmdCopyBuffer2KHR(pCopyBufferInfo *vk.CopyBufferInfo2) {
+	c.DeviceTable.CmdCopyBuffer2KHR.Call(c.CommandBuffer, pCopyBufferInfo)
+}
+
+// CmdCopyImage2KHR calls vkCmdCopyImage2KHR.
+func (c CommandBuffer) CmdCopyImage2KHR(pCopyImageInfo *vk.CopyImageInfo2) {
+	c.DeviceTable.CmdCopyImage2KHR.Call(c.CommandBuffer, pCopyImageInfo)
+}
+
+// CmdCopyBufferToImage2KHR calls vkCmdCopyBufferToImage2KHR.
+func (c CommandBuffer) CmdCopyBufferToImage2KHR(pCopyBufferToImageInfo *vk.CopyBufferToImageInfo2) {
+	c.DeviceTable.CmdCopyBufferToImage2KHR.Call(c.CommandBuffer, pCopyBufferToImageInfo)
+}
+
+// CmdCopyImageToBuffer2KHR calls vkCmdCopyImageToBuffer2KHR.
+func (c CommandBuffer) CmdCopyImageToBuffer2KHR(pCopyImageToBufferInfo *vk.CopyImageToBufferInfo2) {
+	c.DeviceTable.CmdCopyImageToBuffer2KHR.Call(c.CommandBuffer, pCopyImageToBufferInfo)
+}
+
+// CmdBlitImage2KHR calls vkCmdBlitImage2KHR.
+func (c CommandBuffer) CmdBlitImage2KHR(pBlitImageInfo *vk.BlitImageInfo2) {
+	c.DeviceTable.CmdBlitImage2KHR.Call(c.CommandBuffer, pBlitImageInfo)
+}
+
+// CmdResolveImage2KHR calls vkCmdResolveImage2KHR.
+func (c CommandBuffer) CmdResolveImage2KHR(pResolveImageInfo *vk.ResolveImageInfo2) {
+	c.DeviceTable.CmdResolveImage2KHR.Call(c.CommandBuffer, pResolveImageInfo)
+}
+
+// CmdTraceRaysKHR calls vkCmdTraceRaysKHR.
+func (c CommandBuffer) CmdTraceRaysKHR(pRaygenShaderBindingTable, pMissShaderBindingTable, pHitShaderBindingTable, pCallableShaderBindingTable *vk.StridedDeviceAddressRegionKHR, width, height, depth uint32) {
+	c.DeviceTable.CmdTraceRaysKHR.Call(c.CommandBuffer, pRaygenShaderBindingTable, pMissShaderBindingTable, pHitShaderBindingTable, pCallableShaderBindingTable, width, height, depth)
+}
+
+// CreateRayTracingPipelinesKHR calls vkCreateRayTracingPipelinesKHR.
+func (d Device) CreateRayTracingPipelinesKHR(deferredOperation vk.DeferredOperationKHR, pipelineCache vk.PipelineCache, createInfos []vk.RayTracingPipelineCreateInfoKHR) ([]vk.Pipeline, error) {
+	var pCreateInfos *vk.RayTracingPipelineCreateInfoKHR
+	if len(createInfos) > 0 {
+		pCreateInfos = &createInfos[0]
+	}
+	pipelines := make([]vk.Pipeline, len(createInfos))
+	var pPipelines *vk.Pipeline
+	if len(pipelines) > 0 {
+		pPipelines = &pipelines[0]
+	}
+	ret := d.DeviceTable.CreateRayTracingPipelinesKHR.Call(d.Device, deferredOperation, pipelineCache, uint32(len(createInfos)), pCreateInfos, d.Allocator, pPipelines)
+	return pipelines, ret.Err()
+}
+
+// GetRayTracingShaderGroupHandlesKHR calls vkGetRayTracingShaderGroupHandlesKHR.
+func (d Device) GetRayTracingShaderGroupHandlesKHR(pipeline vk.Pipeline, firstGroup, groupCount uint32, dataSize uintptr, pData unsafe.Pointer) error {
+	return d.DeviceTable.GetRayTracingShaderGroupHandlesKHR.Call(d.Device, pipeline, firstGroup, groupCount, dataSize, pData).Err()
+}
+
+// GetRayTracingCaptureReplayShaderGroupHandlesKHR calls vkGetRayTracingCaptureReplayShaderGroupHandlesKHR.
+func (d Device) GetRayTracingCaptureReplayShaderGroupHandlesKHR(pipeline vk.Pipeline, firstGroup, groupCount uint32, dataSize uintptr, pData unsafe.Pointer) error {
+	return d.DeviceTable.GetRayTracingCaptureReplayShaderGroupHandlesKHR.Call(d.Device, pipeline, firstGroup, groupCount, dataSize, pData).Err()
+}
+
+// CmdTraceRaysIndirectKHR calls vkCmdTraceRaysIndirectKHR.
+func (c CommandBuffer) CmdTraceRaysIndirectKHR(pRaygenShaderBindingTable, pMissShaderBindingTable, pHitShaderBindingTable, pCallableShaderBindingTable *vk.StridedDeviceAddressRegionKHR, indirectDeviceAddress vk.DeviceAddress) {
+	c.DeviceTable.CmdTraceRaysIndirectKHR.Call(c.CommandBuffer, pRaygenShaderBindingTable, pMissShaderBindingTable, pHitShaderBindingTable, pCallableShaderBindingTable, indirectDeviceAddress)
+}
+
+// GetRayTracingShaderGroupStackSizeKHR calls vkGetRayTracingShaderGroupStackSizeKHR.
+func (d Device) GetRayTracingShaderGroupStackSizeKHR(pipeline vk.Pipeline, group uint32, groupShader vk.ShaderGroupShaderKHR) vk.DeviceSize {
+	return d.DeviceTable.GetRayTracingShaderGroupStackSizeKHR.Call(d.Device, pipeline, group, groupShader)
+}
+
+// CmdSetRayTracingPipelineStackSizeKHR calls vkCmdSetRayTracingPipelineStackSizeKHR.
+func (c CommandBuffer) CmdSetRayTracingPipelineStackSizeKHR(pipelineStackSize uint32) {
+	c.DeviceTable.CmdSetRayTracingPipelineStackSizeKHR.Call(c.CommandBuffer, pipelineStackSize)
+}
+
+// CmdTraceRaysIndirect2KHR calls vkCmdTraceRaysIndirect2KHR.
+func (c CommandBuffer) CmdTraceRaysIndirect2KHR(indirectDeviceAddress vk.DeviceAddress) {
+	c.DeviceTable.CmdTraceRaysIndirect2KHR.Call(c.CommandBuffer, indirectDeviceAddress)
+}
+
+// GetDeviceBufferMemoryRequirementsKHR calls vkGetDeviceBufferMemoryRequirementsKHR.
+func (d Device) GetDeviceBufferMemoryRequirementsKHR(pInfo *vk.DeviceBufferMemoryRequirements, pMemoryRequirements *vk.MemoryRequirements2) {
+	d.DeviceTable.GetDeviceBufferMemoryRequirementsKHR.Call(d.Device, pInfo, pMemoryRequirements)
+}
+
+// GetDeviceImageMemoryRequirementsKHR calls vkGetDeviceImageMemoryRequirementsKHR.
+func (d Device) GetDeviceImageMemoryRequirementsKHR(pInfo *vk.DeviceImageMemoryRequirements, pMemoryRequirements *vk.MemoryRequirements2) {
+	d.DeviceTable.GetDeviceImageMemoryRequirementsKHR.Call(d.Device, pInfo, pMemoryRequirements)
+}
+
+// GetDeviceImageSparseMemoryRequirementsKHR calls vkGetDeviceImageSparseMemoryRequirementsKHR.
+func (d Device) GetDeviceImageSparseMemoryRequirementsKHR(pInfo *vk.DeviceImageMemoryRequirements) []vk.SparseImageMemoryRequirements2 {
+	var n uint32
+	d.DeviceTable.GetDeviceImageSparseMemoryRequirementsKHR.Call(d.Device, pInfo, &n, nil)
+	if n == 0 {
+		return nil
+	}
+	sparseMemoryRequirements := make([]vk.SparseImageMemoryRequirements2, n)
+	for k := range sparseMemoryRequirements {
+		sparseMemoryRequirements[k].SType = vk.STRUCTURE_TYPE_SPARSE_IMAGE_MEMORY_REQUIREMENTS_2
+	}
+	d.DeviceTable.GetDeviceImageSparseMemoryRequirementsKHR.Call(d.Device, pInfo, &n, &sparseMemoryRequirements[0])
+	return sparseMemoryRequirements[:n]
+}
+
+// CreateDebugReportCallbackEXT calls vkCreateDebugReportCallbackEXT.
+func (i Instance) CreateDebugReportCallbackEXT(pCreateInfo *vk.DebugReportCallbackCreateInfoEXT) (vk.DebugReportCallbackEXT, error) {
+	var callback vk.DebugReportCallbackEXT
+	ret := i.InstanceTable.CreateDebugReportCallbackEXT.Call(i.Instance, pCreateInfo, i.Allocator, &callback)
+	return callback, ret.Err()
+}
+
+// DestroyDebugReportCallbackEXT calls vkDestroyDebugReportCallbackEXT.
+func (i Instance) DestroyDebugReportCallbackEXT(callback vk.DebugReportCallbackEXT) {
+	i.InstanceTable.DestroyDebugReportCallbackEXT.Call(i.Instance, callback, i.Allocator)
+}
+
+// DebugReportMessageEXT calls vkDebugReportMessageEXT.
+func (i Instance) DebugReportMessageEXT(flags vk.DebugReportFlagsEXT, objectType vk.DebugReportObjectTypeEXT, object uint64, location uintptr, messageCode int32, layerPrefix, message string) {
+	pLayerPrefix, free := vk.CStr(layerPrefix)
+	defer free()
+	pMessage, free := vk.CStr(message)
+	defer free()
+	i.InstanceTable.DebugReportMessageEXT.Call(i.Instance, flags, objectType, object, location, messageCode, pLayerPrefix, pMessage)
+}
+
+// DebugMarkerSetObjectTagEXT calls vkDebugMarkerSetObjectTagEXT.
+func (d Device) DebugMarkerSetObjectTagEXT(pTagInfo *vk.DebugMarkerObjectTagInfoEXT) error {
+	return d.DeviceTable.DebugMarkerSetObjectTagEXT.Call(d.Device, pTagInfo).Err()
+}
+
+// DebugMarkerSetObjectNameEXT calls vkDebugMarkerSetObjectNameEXT.
+func (d Device) DebugMarkerSetObjectNameEXT(pNameInfo *vk.DebugMarkerObjectNameInfoEXT) error {
+	return d.DeviceTable.DebugMarkerSetObjectNameEXT.Call(d.Device, pNameInfo).Err()
+}
+
+// CmdDebugMarkerBeginEXT calls vkCmdDebugMarkerBeginEXT.
+func (c CommandBuffer) CmdDebugMarkerBeginEXT(pMarkerInfo *vk.DebugMarkerMarkerInfoEXT) {
+	c.DeviceTable.CmdDebugMarkerBeginEXT.Call(c.CommandBuffer, pMarkerInfo)
+}
+
+// CmdDebugMarkerEndEXT calls vkCmdDebugMarkerEndEXT.
+func (c CommandBuffer) CmdDebugMarkerEndEXT() {
+	c.DeviceTable.CmdDebugMarkerEndEXT.Call(c.CommandBuffer)
+}
+
+// CmdDebugMarkerInsertEXT calls vkCmdDebugMarkerInsertEXT.
+func (c CommandBuffer) CmdDebugMarkerInsertEXT(pMarkerInfo *vk.DebugMarkerMarkerInfoEXT) {
+	c.DeviceTable.CmdDebugMarkerInsertEXT.Call(c.CommandBuffer, pMarkerInfo)
+}
+
+// CmdBindTransformFeedbackBuffersEXT calls vkCmdBindTransformFeedbackBuffersEXT.
+func (c CommandBuffer) CmdBindTransformFeedbackBuffersEXT(firstBinding, bindingCount uint32, pBuffers *vk.Buffer, pOffsets, pSizes *vk.DeviceSize) {
+	c.DeviceTable.CmdBindTransformFeedbackBuffersEXT.Call(c.CommandBuffer, firstBinding, bindingCount, pBuffers, pOffsets, pSizes)
+}
+
+// CmdBeginTransformFeedbackEXT calls vkCmdBeginTransformFeedbackEXT.
+func (c CommandBuffer) CmdBeginTransformFeedbackEXT(firstCounterBuffer, counterBufferCount uint32, pCounterBuffers *vk.Buffer, pCounterBufferOffsets *vk.DeviceSize) {
+	c.DeviceTable.CmdBeginTransformFeedbackEXT.Call(c.CommandBuffer, firstCounterBuffer, counterBufferCount, pCounterBuffers, pCounterBufferOffsets)
+}
+
+// CmdEndTransformFeedbackEXT calls vkCmdEndTransformFeedbackEXT.
+func (c CommandBuffer) CmdEndTransformFeedbackEXT(firstCounterBuffer, counterBufferCount uint32, pCounterBuffers *vk.Buffer, pCounterBufferOffsets *vk.DeviceSize) {
+	c.DeviceTable.CmdEndTransformFeedbackEXT.Call(c.CommandBuffer, firstCounterBuffer, counterBufferCount, pCounterBuffers, pCounterBufferOffsets)
+}
+
+// CmdBeginQueryIndexedEXT calls vkCmdBeginQueryIndexedEXT.
+func (c CommandBuffer) CmdBeginQueryIndexedEXT(queryPool vk.QueryPool, query uint32, flags vk.QueryControlFlags, index uint32) {
+	c.DeviceTable.CmdBeginQueryIndexedEXT.Call(c.CommandBuffer, queryPool, query, flags, index)
+}
+
+// CmdEndQueryIndexedEXT calls vkCmdEndQueryIndexedEXT.
+func (c CommandBuffer) CmdEndQueryIndexedEXT(queryPool vk.QueryPool, query, index uint32) {
+	c.DeviceTable.CmdEndQueryIndexedEXT.Call(c.CommandBuffer, queryPool, query, index)
+}
+
+// CmdDrawIndirectByteCountEXT calls vkCmdDrawIndirectByteCountEXT.
+func (c CommandBuffer) CmdDrawIndirectByteCountEXT(instanceCount, firstInstance uint32, counterBuffer vk.Buffer, counterBufferOffset vk.DeviceSize, counterOffset, vertexStride uint32) {
+	c.DeviceTable.CmdDrawIndirectByteCountEXT.Call(c.CommandBuffer, instanceCount, firstInstance, counterBuffer, counterBufferOffset, counterOffset, vertexStride)
+}
+
+// CreateCuModuleNVX calls vkCreateCuModuleNVX.
+func (d Device) CreateCuModuleNVX(pCreateInfo *vk.CuModuleCreateInfoNVX) (vk.CuModuleNVX, error) {
+	var module vk.CuModuleNVX
+	ret := d.DeviceTable.CreateCuModuleNVX.Call(d.Device, pCreateInfo, d.Allocator, &module)
+	return module, ret.Err()
+}
+
+// CreateCuFunctionNVX calls vkCreateCuFunctionNVX.
+func (d Device) CreateCuFunctionNVX(pCreateInfo *vk.CuFunctionCreateInfoNVX) (vk.CuFunctionNVX, error) {
+	var function vk.CuFunctionNVX
+	ret := d.DeviceTable.CreateCuFunctionNVX.Call(d.Device, pCreateInfo, d.Allocator, &function)
+	return function, ret.Err()
+}
+
+// DestroyCuModuleNVX calls vkDestroyCuModuleNVX.
+func (d Device) DestroyCuModuleNVX(module vk.CuModuleNVX) {
+	d.DeviceTable.DestroyCuModuleNVX.Call(d.Device, module, d.Allocator)
+}
+
+// DestroyCuFunctionNVX calls vkDestroyCuFunctionNVX.
+func (d Device) DestroyCuFunctionNVX(function vk.CuFunctionNVX) {
+	d.DeviceTable.DestroyCuFunctionNVX.Call(d.Device, function, d.Allocator)
+}
+
+// CmdCuLaunchKernelNVX calls vkCmdCuLaunchKernelNVX.
+func (c CommandBuffer) CmdCuLaunchKernelNVX(pLaunchInfo *vk.CuLaunchInfoNVX) {
+	c.DeviceTable.CmdCuLaunchKernelNVX.Call(c.CommandBuffer, pLaunchInfo)
+}
+
+// GetImageViewHandleNVX calls vkGetImageViewHandleNVX.
+func (d Device) GetImageViewHandleNVX(pInfo *vk.ImageViewHandleInfoNVX) uint32 {
+	return d.DeviceTable.GetImageViewHandleNVX.Call(d.Device, pInfo)
+}
+
+// GetImageViewAddressNVX calls vkGetImageViewAddressNVX.
+func (d Device) GetImageViewAddressNVX(imageView vk.ImageView, pProperties *vk.ImageViewAddressPropertiesNVX) error {
+	return d.DeviceTable.GetImageViewAddressNVX.Call(d.Device, imageView, pProperties).Err()
+}
+
+// CmdDrawIndirectCountAMD calls vkCmdDrawIndirectCountAMD.
+func (c CommandBuffer) CmdDrawIndirectCountAMD(buffer vk.Buffer, offset vk.DeviceSize, countBuffer vk.Buffer, countBufferOffset vk.DeviceSize, maxDrawCount, stride uint32) {
+	c.DeviceTable.CmdDrawIndirectCountAMD.Call(c.CommandBuffer, buffer, offset, countBuffer, countBufferOffset, maxDrawCount, stride)
+}
+
+// CmdDrawIndexedIndirectCountAMD calls vkCmdDrawIndexedIndirectCountAMD.
+func (c CommandBuffer) CmdDrawIndexedIndirectCountAMD(buffer vk.Buffer, offset vk.DeviceSize, countBuffer vk.Buffer, countBufferOffset vk.DeviceSize, maxDrawCount, stride uint32) {
+	c.DeviceTable.CmdDrawIndexedIndirectCountAMD.Call(c.CommandBuffer, buffer, offset, countBuffer, countBufferOffset, maxDrawCount, stride)
+}
+
+// GetShaderInfoAMD calls vkGetShaderInfoAMD.
+func (d Device) GetShaderInfoAMD(pipeline vk.Pipeline, shaderStage vk.ShaderStageFlags, infoType vk.ShaderInfoTypeAMD) ([]byte, error) {
+	for {
+		var n uintptr
+		if ret := d.DeviceTable.GetShaderInfoAMD.Call(d.Device, pipeline, shaderStage, infoType, &n, nil); ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		info := make([]byte, n)
+		ret := d.DeviceTable.GetShaderInfoAMD.Call(d.Device, pipeline, shaderStage, infoType, &n, unsafe.Pointer(&info[0]))
+		if ret == vk.INCOMPLETE {
+			continue // the list grew between the two calls
+		}
+		if ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		return info[:n], nil
+	}
+}
+
+// GetPhysicalDeviceExternalImageFormatPropertiesNV calls vkGetPhysicalDeviceExternalImageFormatPropertiesNV.
+func (p PhysicalDevice) GetPhysicalDeviceExternalImageFormatPropertiesNV(format vk.Format, type_ vk.ImageType, tiling vk.ImageTiling, usage vk.ImageUsageFlags, flags vk.ImageCreateFlags, externalHandleType vk.ExternalMemoryHandleTypeFlagsNV) (vk.ExternalImageFormatPropertiesNV, error) {
+	var externalImageFormatProperties vk.ExternalImageFormatPropertiesNV
+	ret := p.InstanceTable.GetPhysicalDeviceExternalImageFormatPropertiesNV.Call(p.PhysicalDevice, format, type_, tiling, usage, flags, externalHandleType, &externalImageFormatProperties)
+	return externalImageFormatProperties, ret.Err()
+}
+
+// CmdBeginConditionalRenderingEXT calls vkCmdBeginConditionalRenderingEXT.
+func (c CommandBuffer) CmdBeginConditionalRenderingEXT(pConditionalRenderingBegin *vk.ConditionalRenderingBeginInfoEXT) {
+	c.DeviceTable.CmdBeginConditionalRenderingEXT.Call(c.CommandBuffer, pConditionalRenderingBegin)
+}
+
+// CmdEndConditionalRenderingEXT calls vkCmdEndConditionalRenderingEXT.
+func (c CommandBuffer) CmdEndConditionalRenderingEXT() {
+	c.DeviceTable.CmdEndConditionalRenderingEXT.Call(c.CommandBuffer)
+}
+
+// CmdSetViewportWScalingNV calls vkCmdSetViewportWScalingNV.
+func (c CommandBuffer) CmdSetViewportWScalingNV(firstViewport uint32, viewportWScalings []vk.ViewportWScalingNV) {
+	var pViewportWScalings *vk.ViewportWScalingNV
+	if len(viewportWScalings) > 0 {
+		pViewportWScalings = &viewportWScalings[0]
+	}
+	c.DeviceTable.CmdSetViewportWScalingNV.Call(c.CommandBuffer, firstViewport, uint32(len(viewportWScalings)), pViewportWScalings)
+}
+
+// ReleaseDisplayEXT calls vkReleaseDisplayEXT.
+func (p PhysicalDevice) ReleaseDisplayEXT(display vk.DisplayKHR) error {
+	return p.InstanceTable.ReleaseDisplayEXT.Call(p.PhysicalDevice, display).Err()
+}
+
+// GetPhysicalDeviceSurfaceCapabilities2EXT calls vkGetPhysicalDeviceSurfaceCapabilities2EXT.
+func (p PhysicalDevice) GetPhysicalDeviceSurfaceCapabilities2EXT(surface vk.SurfaceKHR, pSurfaceCapabilities *vk.SurfaceCapabilities2EXT) error {
+	return p.InstanceTable.GetPhysicalDeviceSurfaceCapabilities2EXT.Call(p.PhysicalDevice, surface, pSurfaceCapabilities).Err()
+}
+
+// DisplayPowerControlEXT calls vkDisplayPowerControlEXT.
+func (d Device) DisplayPowerControlEXT(display vk.DisplayKHR, pDisplayPowerInfo *vk.DisplayPowerInfoEXT) error {
+	return d.DeviceTable.DisplayPowerControlEXT.Call(d.Device, display, pDisplayPowerInfo).Err()
+}
+
+// RegisterDeviceEventEXT calls vkRegisterDeviceEventEXT.
+func (d Device) RegisterDeviceEventEXT(pDeviceEventInfo *vk.DeviceEventInfoEXT) (vk.Fence, error) {
+	var fence vk.Fence
+	ret := d.DeviceTable.RegisterDeviceEventEXT.Call(d.Device, pDeviceEventInfo, d.Allocator, &fence)
+	return fence, ret.Err()
+}
+
+// RegisterDisplayEventEXT calls vkRegisterDisplayEventEXT.
+func (d Device) RegisterDisplayEventEXT(display vk.DisplayKHR, pDisplayEventInfo *vk.DisplayEventInfoEXT) (vk.Fence, error) {
+	var fence vk.Fence
+	ret := d.DeviceTable.RegisterDisplayEventEXT.Call(d.Device, display, pDisplayEventInfo, d.Allocator, &fence)
+	return fence, ret.Err()
+}
+
+// GetSwapchainCounterEXT calls vkGetSwapchainCounterEXT.
+func (d Device) GetSwapchainCounterEXT(swapchain vk.SwapchainKHR, counter vk.SurfaceCounterFlagsEXT) (uint64, error) {
+	var counterValue uint64
+	ret := d.DeviceTable.GetSwapchainCounterEXT.Call(d.Device, swapchain, counter, &counterValue)
+	return counterValue, ret.Err()
+}
+
+// GetRefreshCycleDurationGOOGLE calls vkGetRefreshCycleDurationGOOGLE.
+func (d Device) GetRefreshCycleDurationGOOGLE(swapchain vk.SwapchainKHR) (vk.RefreshCycleDurationGOOGLE, error) {
+	var displayTimingProperties vk.RefreshCycleDurationGOOGLE
+	ret := d.DeviceTable.GetRefreshCycleDurationGOOGLE.Call(d.Device, swapchain, &displayTimingProperties)
+	return displayTimingProperties, ret.Err()
+}
+
+// GetPastPresentationTimingGOOGLE calls vkGetPastPresentationTimingGOOGLE.
+func (d Device) GetPastPresentationTimingGOOGLE(swapchain vk.SwapchainKHR) ([]vk.PastPresentationTimingGOOGLE, error) {
+	for {
+		var n uint32
+		if ret := d.DeviceTable.GetPastPresentationTimingGOOGLE.Call(d.Device, swapchain, &n, nil); ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		presentationTimings := make([]vk.PastPresentationTimingGOOGLE, n)
+		ret := d.DeviceTable.GetPastPresentationTimingGOOGLE.Call(d.Device, swapchain, &n, &presentationTimings[0])
+		if ret == vk.INCOMPLETE {
+			continue // the list grew between the two calls
+		}
+		if ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		return presentationTimings[:n], nil
+	}
+}
+
+// CmdSetDiscardRectangleEXT calls vkCmdSetDiscardRectangleEXT.
+func (c CommandBuffer) CmdSetDiscardRectangleEXT(firstDiscardRectangle uint32, discardRectangles []vk.Rect2D) {
+	var pDiscardRectangles *vk.Rect2D
+	if len(discardRectangles) > 0 {
+		pDiscardRectangles = &discardRectangles[0]
+	}
+	c.DeviceTable.CmdSetDiscardRectangleEXT.Call(c.CommandBuffer, firstDiscardRectangle, uint32(len(discardRectangles)), pDiscardRectangles)
+}
+
+// SetHdrMetadataEXT calls vkSetHdrMetadataEXT.
+func (d Device) SetHdrMetadataEXT(swapchainCount uint32, pSwapchains *vk.SwapchainKHR, pMetadata *vk.HdrMetadataEXT) {
+	d.DeviceTable.SetHdrMetadataEXT.Call(d.Device, swapchainCount, pSwapchains, pMetadata)
+}
+
+// SetDebugUtilsObjectNameEXT calls vkSetDebugUtilsObjectNameEXT.
+func (d Device) SetDebugUtilsObjectNameEXT(pNameInfo *vk.DebugUtilsObjectNameInfoEXT) error {
+	return d.DeviceTable.SetDebugUtilsObjectNameEXT.Call(d.Device, pNameInfo).Err()
+}
+
+// SetDebugUtilsObjectTagEXT calls vkSetDebugUtilsObjectTagEXT.
+func (d Device) SetDebugUtilsObjectTagEXT(pTagInfo *vk.DebugUtilsObjectTagInfoEXT) error {
+	return d.DeviceTable.SetDebugUtilsObjectTagEXT.Call(d.Device, pTagInfo).Err()
+}
+
+// QueueBeginDebugUtilsLabelEXT calls vkQueueBeginDebugUtilsLabelEXT.
+func (q Queue) QueueBeginDebugUtilsLabelEXT(pLabelInfo *vk.DebugUtilsLabelEXT) {
+	q.DeviceTable.QueueBeginDebugUtilsLabelEXT.Call(q.Queue, pLabelInfo)
+}
+
+// QueueEndDebugUtilsLabelEXT calls vkQueueEndDebugUtilsLabelEXT.
+func (q Queue) QueueEndDebugUtilsLabelEXT() {
+	q.DeviceTable.QueueEndDebugUtilsLabelEXT.Call(q.Queue)
+}
+
+// QueueInsertDebugUtilsLabelEXT calls vkQueueInsertDebugUtilsLabelEXT.
+func (q Queue) QueueInsertDebugUtilsLabelEXT(pLabelInfo *vk.DebugUtilsLabelEXT) {
+	q.DeviceTable.QueueInsertDebugUtilsLabelEXT.Call(q.Queue, pLabelInfo)
+}
+
+// CmdBeginDebugUtilsLabelEXT calls vkCmdBeginDebugUtilsLabelEXT.
+func (c CommandBuffer) CmdBeginDebugUtilsLabelEXT(pLabelInfo *vk.DebugUtilsLabelEXT) {
+	c.DeviceTable.CmdBeginDebugUtilsLabelEXT.Call(c.CommandBuffer, pLabelInfo)
+}
+
+// CmdEndDebugUtilsLabelEXT calls vkCmdEndDebugUtilsLabelEXT.
+func (c CommandBuffer) CmdEndDebugUtilsLabelEXT() {
+	c.DeviceTable.CmdEndDebugUtilsLabelEXT.Call(c.CommandBuffer)
+}
+
+// CmdInsertDebugUtilsLabelEXT calls vkCmdInsertDebugUtilsLabelEXT.
+func (c CommandBuffer) CmdInsertDebugUtilsLabelEXT(pLabelInfo *vk.DebugUtilsLabelEXT) {
+	c.DeviceTable.CmdInsertDebugUtilsLabelEXT.Call(c.CommandBuffer, pLabelInfo)
+}
+
+// CreateDebugUtilsMessengerEXT calls vkCreateDebugUtilsMessengerEXT.
+func (i Instance) CreateDebugUtilsMessengerEXT(pCreateInfo *vk.DebugUtilsMessengerCreateInfoEXT) (vk.DebugUtilsMessengerEXT, error) {
+	var messenger vk.DebugUtilsMessengerEXT
+	ret := i.InstanceTable.CreateDebugUtilsMessengerEXT.Call(i.Instance, pCreateInfo, i.Allocator, &messenger)
+	return messenger, ret.Err()
+}
+
+// DestroyDebugUtilsMessengerEXT calls vkDestroyDebugUtilsMessengerEXT.
+func (i Instance) DestroyDebugUtilsMessengerEXT(messenger vk.DebugUtilsMessengerEXT) {
+	i.InstanceTable.DestroyDebugUtilsMessengerEXT.Call(i.Instance, messenger, i.Allocator)
+}
+
+// SubmitDebugUtilsMessageEXT calls vkSubmitDebugUtilsMessageEXT.
+func (i Instance) SubmitDebugUtilsMessageEXT(messageSeverity vk.DebugUtilsMessageSeverityFlagsEXT, messageTypes vk.DebugUtilsMessageTypeFlagsEXT, pCallbackData *vk.DebugUtilsMessengerCallbackDataEXT) {
+	i.InstanceTable.SubmitDebugUtilsMessageEXT.Call(i.Instance, messageSeverity, messageTypes, pCallbackData)
+}
+
+// CmdSetSampleLocationsEXT calls vkCmdSetSampleLocationsEXT.
+func (c CommandBuffer) CmdSetSampleLocationsEXT(pSampleLocationsInfo *vk.SampleLocationsInfoEXT) {
+	c.DeviceTable.CmdSetSampleLocationsEXT.Call(c.CommandBuffer, pSampleLocationsInfo)
+}
+
+// GetPhysicalDeviceMultisamplePropertiesEXT calls vkGetPhysicalDeviceMultisamplePropertiesEXT.
+func (p PhysicalDevice) GetPhysicalDeviceMultisamplePropertiesEXT(samples vk.SampleCountFlags, pMultisampleProperties *vk.MultisamplePropertiesEXT) {
+	p.InstanceTable.GetPhysicalDeviceMultisamplePropertiesEXT.Call(p.PhysicalDevice, samples, pMultisampleProperties)
+}
+
+// GetImageDrmFormatModifierPropertiesEXT calls vkGetImageDrmFormatModifierPropertiesEXT.
+func (d Device) GetImageDrmFormatModifierPropertiesEXT(image vk.Image, pProperties *vk.ImageDrmFormatModifierPropertiesEXT) error {
+	return d.DeviceTable.GetImageDrmFormatModifierPropertiesEXT.Call(d.Device, image, pProperties).Err()
+}
+
+// CreateValidationCacheEXT calls vkCreateValidationCacheEXT.
+func (d Device) CreateValidationCacheEXT(pCreateInfo *vk.ValidationCacheCreateInfoEXT) (vk.ValidationCacheEXT, error) {
+	var validationCache vk.ValidationCacheEXT
+	ret := d.DeviceTable.CreateValidationCacheEXT.Call(d.Device, pCreateInfo, d.Allocator, &validationCache)
+	return validationCache, ret.Err()
+}
+
+// DestroyValidationCacheEXT calls vkDestroyValidationCacheEXT.
+func (d Device) DestroyValidationCacheEXT(validationCache vk.ValidationCacheEXT) {
+	d.DeviceTable.DestroyValidationCacheEXT.Call(d.Device, validationCache, d.Allocator)
+}
+
+// MergeValidationCachesEXT calls vkMergeValidationCachesEXT.
+func (d Device) MergeValidationCachesEXT(dstCache vk.ValidationCacheEXT, srcCaches []vk.ValidationCacheEXT) error {
+	var pSrcCaches *vk.ValidationCacheEXT
+	if len(srcCaches) > 0 {
+		pSrcCaches = &srcCaches[0]
+	}
+	return d.DeviceTable.MergeValidationCachesEXT.Call(d.Device, dstCache, uint32(len(srcCaches)), pSrcCaches).Err()
+}
+
+// GetValidationCacheDataEXT calls vkGetValidationCacheDataEXT.
+func (d Device) GetValidationCacheDataEXT(validationCache vk.ValidationCacheEXT) ([]byte, error) {
+	for {
+		var n uintptr
+		if ret := d.DeviceTable.GetValidationCacheDataEXT.Call(d.Device, validationCache, &n, nil); ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		data := make([]byte, n)
+		ret := d.DeviceTable.GetValidationCacheDataEXT.Call(d.Device, validationCache, &n, unsafe.Pointer(&data[0]))
+		if ret == vk.INCOMPLETE {
+			continue // the list grew between the two calls
+		}
+		if ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		return data[:n], nil
+	}
+}
+
+// CmdBindShadingRateImageNV calls vkCmdBindShadingRateImageNV.
+func (c CommandBuffer) CmdBindShadingRateImageNV(imageView vk.ImageView, imageLayout vk.ImageLayout) {
+	c.DeviceTable.CmdBindShadingRateImageNV.Call(c.CommandBuffer, imageView, imageLayout)
+}
+
+// CmdSetViewportShadingRatePaletteNV calls vkCmdSetViewportShadingRatePaletteNV.
+func (c CommandBuffer) CmdSetViewportShadingRatePaletteNV(firstViewport uint32, shadingRatePalettes []vk.ShadingRatePaletteNV) {
+	var pShadingRatePalettes *vk.ShadingRatePaletteNV
+	if len(shadingRatePalettes) > 0 {
+		pShadingRatePalettes = &shadingRatePalettes[0]
+	}
+	c.DeviceTable.CmdSetViewportShadingRatePaletteNV.Call(c.CommandBuffer, firstViewport, uint32(len(shadingRatePalettes)), pShadingRatePalettes)
+}
+
+// CmdSetCoarseSampleOrderNV calls vkCmdSetCoarseSampleOrderNV.
+func (c CommandBuffer) CmdSetCoarseSampleOrderNV(sampleOrderType vk.CoarseSampleOrderTypeNV, customSampleOrders []vk.CoarseSampleOrderCustomNV) {
+	var pCustomSampleOrders *vk.CoarseSampleOrderCustomNV
+	if len(customSampleOrders) > 0 {
+		pCustomSampleOrders = &customSampleOrders[0]
+	}
+	c.DeviceTable.CmdSetCoarseSampleOrderNV.Call(c.CommandBuffer, sampleOrderType, uint32(len(customSampleOrders)), pCustomSampleOrders)
+}
+
+// CreateAccelerationStructureNV calls vkCreateAccelerationStructureNV.
+func (d Device) CreateAccelerationStructureNV(pCreateInfo *vk.AccelerationStructureCreateInfoNV) (vk.AccelerationStructureNV, error) {
+	var accelerationStructure vk.AccelerationStructureNV
+	ret := d.DeviceTable.CreateAccelerationStructureNV.Call(d.Device, pCreateInfo, d.Allocator, &accelerationStructure)
+	return accelerationStructure, ret.Err()
+}
+
+// DestroyAccelerationStructureNV calls vkDestroyAccelerationStructureNV.
+func (d Device) DestroyAccelerationStructureNV(accelerationStructure vk.AccelerationStructureNV) {
+	d.DeviceTable.DestroyAccelerationStructureNV.Call(d.Device, accelerationStructure, d.Allocator)
+}
+
+// GetAccelerationStructureMemoryRequirementsNV calls vkGetAccelerationStructureMemoryRequirementsNV.
+func (d Device) GetAccelerationStructureMemoryRequirementsNV(pInfo *vk.AccelerationStructureMemoryRequirementsInfoNV, pMemoryRequirements *vk.MemoryRequirements2KHR) {
+	d.DeviceTable.GetAccelerationStructureMemoryRequirementsNV.Call(d.Device, pInfo, pMemoryRequirements)
+}
+
+// BindAccelerationStructureMemoryNV calls vkBindAccelerationStructureMemoryNV.
+func (d Device) BindAccelerationStructureMemoryNV(bindInfos []vk.BindAccelerationStructureMemoryInfoNV) error {
+	var pBindInfos *vk.BindAccelerationStructureMemoryInfoNV
+	if len(bindInfos) > 0 {
+		pBindInfos = &bindInfos[0]
+	}
+	return d.DeviceTable.BindAccelerationStructureMemoryNV.Call(d.Device, uint32(len(bindInfos)), pBindInfos).Err()
+}
+
+// CmdBuildAccelerationStructureNV calls vkCmdBuildAccelerationStructureNV.
+func (c CommandBuffer) CmdBuildAccelerationStructureNV(pInfo *vk.AccelerationStructureInfoNV, instanceData vk.Buffer, instanceOffset vk.DeviceSize, update vk.Bool32, dst, src vk.AccelerationStructureNV, scratch vk.Buffer, scratchOffset vk.DeviceSize) {
+	c.DeviceTable.CmdBuildAccelerationStructureNV.Call(c.CommandBuffer, pInfo, instanceData, instanceOffset, update, dst, src, scratch, scratchOffset)
+}
+
+// CmdCopyAccelerationStructureNV calls vkCmdCopyAccelerationStructureNV.
+func (c CommandBuffer) CmdCopyAccelerationStructureNV(dst, src vk.AccelerationStructureNV, mode vk.CopyAccelerationStructureModeKHR) {
+	c.DeviceTable.CmdCopyAccelerationStructureNV.Call(c.CommandBuffer, dst, src, mode)
+}
+
+// CmdTraceRaysNV calls vkCmdTraceRaysNV.
+func (c CommandBuffer) CmdTraceRaysNV(raygenShaderBindingTableBuffer vk.Buffer, raygenShaderBindingOffset vk.DeviceSize, missShaderBindingTableBuffer vk.Buffer, missShaderBindingOffset, missShaderBindingStride vk.DeviceSize, hitShaderBindingTableBuffer vk.Buffer, hitShaderBindingOffset, hitShaderBindingStride vk.DeviceSize, callableShaderBindingTableBuffer vk.Buffer, callableShaderBindingOffset, callableShaderBindingStride vk.DeviceSize, width, height, depth uint32) {
+	c.DeviceTable.CmdTraceRaysNV.Call(c.CommandBuffer, raygenShaderBindingTableBuffer, raygenShaderBindingOffset, missShaderBindingTableBuffer, missShaderBindingOffset, missShaderBindingStride, hitShaderBindingTableBuffer, hitShaderBindingOffset, hitShaderBindingStride, callableShaderBindingTableBuffer, callableShaderBindingOffset, callableShaderBindingStride, width, height, depth)
+}
+
+// CreateRayTracingPipelinesNV calls vkCreateRayTracingPipelinesNV.
+func (d Device) CreateRayTracingPipelinesNV(pipelineCache vk.PipelineCache, createInfos []vk.RayTracingPipelineCreateInfoNV) ([]vk.Pipeline, error) {
+	var pCreateInfos *vk.RayTracingPipelineCreateInfoNV
+	if len(createInfos) > 0 {
+		pCreateInfos = &createInfos[0]
+	}
+	pipelines := make([]vk.Pipeline, len(createInfos))
+	var pPipelines *vk.Pipeline
+	if len(pipelines) > 0 {
+		pPipelines = &pipelines[0]
+	}
+	ret := d.DeviceTable.CreateRayTracingPipelinesNV.Call(d.Device, pipelineCache, uint32(len(createInfos)), pCreateInfos, d.Allocator, pPipelines)
+	return pipelines, ret.Err()
+}
+
+// GetRayTracingShaderGroupHandlesNV calls vkGetRayTracingShaderGroupHandlesNV.
+func (d Device) GetRayTracingShaderGroupHandlesNV(pipeline vk.Pipeline, firstGroup, groupCount uint32, dataSize uintptr, pData unsafe.Pointer) error {
+	return d.DeviceTable.GetRayTracingShaderGroupHandlesNV.Call(d.Device, pipeline, firstGroup, groupCount, dataSize, pData).Err()
+}
+
+// GetAccelerationStructureHandleNV calls vkGetAccelerationStructureHandleNV.
+func (d Device) GetAccelerationStructureHandleNV(accelerationStructure vk.AccelerationStructureNV, dataSize uintptr, pData unsafe.Pointer) error {
+	return d.DeviceTable.GetAccelerationStructureHandleNV.Call(d.Device, accelerationStructure, dataSize, pData).Err()
+}
+
+// CmdWriteAccelerationStructuresPropertiesNV calls vkCmdWriteAccelerationStructuresPropertiesNV.
+func (c CommandBuffer) CmdWriteAccelerationStructuresPropertiesNV(accelerationStructures []vk.AccelerationStructureNV, queryType vk.QueryType, queryPool vk.QueryPool, firstQuery uint32) {
+	var pAccelerationStructures *vk.AccelerationStructureNV
+	if len(accelerationStructures) > 0 {
+		pAccelerationStructures = &accelerationStructures[0]
+	}
+	c.DeviceTable.CmdWriteAccelerationStructuresPropertiesNV.Call(c.CommandBuffer, uint32(len(accelerationStructures)), pAccelerationStructures, queryType, queryPool, firstQuery)
+}
+
+// CompileDeferredNV calls vkCompileDeferredNV.
+func (d Device) CompileDeferredNV(pipeline vk.Pipeline, shader uint32) error {
+	return d.DeviceTable.CompileDeferredNV.Call(d.Device, pipeline, shader).Err()
+}
+
+// GetMemoryHostPointerPropertiesEXT calls vkGetMemoryHostPointerPropertiesEXT.
+func (d Device) GetMemoryHostPointerPropertiesEXT(handleType vk.ExternalMemoryHandleTypeFlags, pHostPointer unsafe.Pointer, pMemoryHostPointerProperties *vk.MemoryHostPointerPropertiesEXT) error {
+	return d.DeviceTable.GetMemoryHostPointerPropertiesEXT.Call(d.Device, handleType, pHostPointer, pMemoryHostPointerProperties).Err()
+}
+
+// CmdWriteBufferMarkerAMD calls vkCmdWriteBufferMarkerAMD.
+func (c CommandBuffer) CmdWriteBufferMarkerAMD(pipelineStage vk.PipelineStageFlags, dstBuffer vk.Buffer, dstOffset vk.DeviceSize, marker uint32) {
+	c.DeviceTable.CmdWriteBufferMarkerAMD.Call(c.CommandBuffer, pipelineStage, dstBuffer, dstOffset, marker)
+}
+
+// GetPhysicalDeviceCalibrateableTimeDomainsEXT calls vkGetPhysicalDeviceCalibrateableTimeDomainsEXT.
+func (p PhysicalDevice) GetPhysicalDeviceCalibrateableTimeDomainsEXT() ([]vk.TimeDomainEXT, error) {
+	for {
+		var n uint32
+		if ret := p.InstanceTable.GetPhysicalDeviceCalibrateableTimeDomainsEXT.Call(p.PhysicalDevice, &n, nil); ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		timeDomains := make([]vk.TimeDomainEXT, n)
+		ret := p.InstanceTable.GetPhysicalDeviceCalibrateableTimeDomainsEXT.Call(p.PhysicalDevice, &n, &timeDomains[0])
+		if ret == vk.INCOMPLETE {
+			continue // the list grew between the two calls
+		}
+		if ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		return timeDomains[:n], nil
+	}
+}
+
+// GetCalibratedTimestampsEXT calls vkGetCalibratedTimestampsEXT.
+func (d Device) GetCalibratedTimestampsEXT(timestampInfos []vk.CalibratedTimestampInfoEXT, pTimestamps *uint64) (uint64, error) {
+	var pTimestampInfos *vk.CalibratedTimestampInfoEXT
+	if len(timestampInfos) > 0 {
+		pTimestampInfos = &timestampInfos[0]
+	}
+	var maxDeviation uint64
+	ret := d.DeviceTable.GetCalibratedTimestampsEXT.Call(d.Device, uint32(len(timestampInfos)), pTimestampInfos, pTimestamps, &maxDeviation)
+	return maxDeviation, ret.Err()
+}
+
+// CmdDrawMeshTasksNV calls vkCmdDrawMeshTasksNV.
+func (c CommandBuffer) CmdDrawMeshTasksNV(taskCount, firstTask uint32) {
+	c.DeviceTable.CmdDrawMeshTasksNV.Call(c.CommandBuffer, taskCount, firstTask)
+}
+
+// CmdDrawMeshTasksIndirectNV calls vkCmdDrawMeshTasksIndirectNV.
+func (c CommandBuffer) CmdDrawMeshTasksIndirectNV(buffer vk.Buffer, offset vk.DeviceSize, drawCount, stride uint32) {
+	c.DeviceTable.CmdDrawMeshTasksIndirectNV.Call(c.CommandBuffer, buffer, offset, drawCount, stride)
+}
+
+// CmdDrawMeshTasksIndirectCountNV calls vkCmdDrawMeshTasksIndirectCountNV.
+func (c CommandBuffer) CmdDrawMeshTasksIndirectCountNV(buffer vk.Buffer, offset vk.DeviceSize, countBuffer vk.Buffer, countBufferOffset vk.DeviceSize, maxDrawCount, stride uint32) {
+	c.DeviceTable.CmdDrawMeshTasksIndirectCountNV.Call(c.CommandBuffer, buffer, offset, countBuffer, countBufferOffset, maxDrawCount, stride)
+}
+
+// CmdSetExclusiveScissorNV calls vkCmdSetExclusiveScissorNV.
+func (c CommandBuffer) CmdSetExclusiveScissorNV(firstExclusiveScissor uint32, exclusiveScissors []vk.Rect2D) {
+	var pExclusiveScissors *vk.Rect2D
+	if len(exclusiveScissors) > 0 {
+		pExclusiveScissors = &exclusiveScissors[0]
+	}
+	c.DeviceTable.CmdSetExclusiveScissorNV.Call(c.CommandBuffer, firstExclusiveScissor, uint32(len(exclusiveScissors)), pExclusiveScissors)
+}
+
+// CmdSetCheckpointNV calls vkCmdSetCheckpointNV.
+func (c CommandBuffer) CmdSetCheckpointNV(pCheckpointMarker unsafe.Pointer) {
+	c.DeviceTable.CmdSetCheckpointNV.Call(c.CommandBuffer, pCheckpointMarker)
+}
+
+// GetQueueCheckpointDataNV calls vkGetQueueCheckpointDataNV.
+func (q Queue) GetQueueCheckpointDataNV() []vk.CheckpointDataNV {
+	var n uint32
+	q.DeviceTable.GetQueueCheckpointDataNV.Call(q.Queue, &n, nil)
+	if n == 0 {
+		return nil
+	}
+	checkpointData := make([]vk.CheckpointDataNV, n)
+	for k := range checkpointData {
+		checkpointData[k].SType = vk.STRUCTURE_TYPE_CHECKPOINT_DATA_NV
+	}
+	q.DeviceTable.GetQueueCheckpointDataNV.Call(q.Queue, &n, &checkpointData[0])
+	return checkpointData[:n]
+}
+
+// InitializePerformanceApiINTEL calls vkInitializePerformanceApiINTEL.
+func (d Device) InitializePerformanceApiINTEL(pInitializeInfo *vk.InitializePerformanceApiInfoINTEL) error {
+	return d.DeviceTable.InitializePerformanceApiINTEL.Call(d.Device, pInitializeInfo).Err()
+}
+
+// UninitializePerformanceApiINTEL calls vkUninitializePerformanceApiINTEL.
+func (d Device) UninitializePerformanceApiINTEL() {
+	d.DeviceTable.UninitializePerformanceApiINTEL.Call(d.Device)
+}
+
+// CmdSetPerformanceMarkerINTEL calls vkCmdSetPerformanceMarkerINTEL.
+func (c CommandBuffer) CmdSetPerformanceMarkerINTEL(pMarkerInfo *vk.PerformanceMarkerInfoINTEL) error {
+	return c.DeviceTable.CmdSetPerformanceMarkerINTEL.Call(c.CommandBuffer, pMarkerInfo).Err()
+}
+
+// CmdSetPerformanceStreamMarkerINTEL calls vkCmdSetPerformanceStreamMarkerINTEL.
+func (c CommandBuffer) CmdSetPerformanceStreamMarkerINTEL(pMarkerInfo *vk.PerformanceStreamMarkerInfoINTEL) error {
+	return c.DeviceTable.CmdSetPerformanceStreamMarkerINTEL.Call(c.CommandBuffer, pMarkerInfo).Err()
+}
+
+// CmdSetPerformanceOverrideINTEL calls vkCmdSetPerformanceOverrideINTEL.
+func (c CommandBuffer) CmdSetPerformanceOverrideINTEL(pOverrideInfo *vk.PerformanceOverrideInfoINTEL) error {
+	return c.DeviceTable.CmdSetPerformanceOverrideINTEL.Call(c.CommandBuffer, pOverrideInfo).Err()
+}
+
+// AcquirePerformanceConfigurationINTEL calls vkAcquirePerformanceConfigurationINTEL.
+func (d Device) AcquirePerformanceConfigurationINTEL(pAcquireInfo *vk.PerformanceConfigurationAcquireInfoINTEL) (vk.PerformanceConfigurationINTEL, error) {
+	var configuration vk.PerformanceConfigurationINTEL
+	ret := d.DeviceTable.AcquirePerformanceConfigurationINTEL.Call(d.Device, pAcquireInfo, &configuration)
+	return configuration, ret.Err()
+}
+
+// ReleasePerformanceConfigurationINTEL calls vkReleasePerformanceConfigurationINTEL.
+func (d Device) ReleasePerformanceConfigurationINTEL(configuration vk.PerformanceConfigurationINTEL) error {
+	return d.DeviceTable.ReleasePerformanceConfigurationINTEL.Call(d.Device, configuration).Err()
+}
+
+// QueueSetPerformanceConfigurationINTEL calls vkQueueSetPerformanceConfigurationINTEL.
+func (q Queue) QueueSetPerformanceConfigurationINTEL(configuration vk.PerformanceConfigurationINTEL) error {
+	return q.DeviceTable.QueueSetPerformanceConfigurationINTEL.Call(q.Queue, configuration).Err()
+}
+
+// GetPerformanceParameterINTEL calls vkGetPerformanceParameterINTEL.
+func (d Device) GetPerformanceParameterINTEL(parameter vk.PerformanceParameterTypeINTEL) (vk.PerformanceValueINTEL, error) {
+	var value vk.PerformanceValueINTEL
+	ret := d.DeviceTable.GetPerformanceParameterINTEL.Call(d.Device, parameter, &value)
+	return value, ret.Err()
+}
+
+// SetLocalDimmingAMD calls vkSetLocalDimmingAMD.
+func (d Device) SetLocalDimmingAMD(swapChain vk.SwapchainKHR, localDimmingEnable vk.Bool32) {
+	d.DeviceTable.SetLocalDimmingAMD.Call(d.Device, swapChain, localDimmingEnable)
+}
+
+// GetBufferDeviceAddressEXT calls vkGetBufferDeviceAddressEXT.
+func (d Device) GetBufferDeviceAddressEXT(pInfo *vk.BufferDeviceAddressInfo) vk.DeviceAddress {
+	return d.DeviceTable.GetBufferDeviceAddressEXT.Call(d.Device, pInfo)
+}
+
+// GetPhysicalDeviceToolPropertiesEXT calls vkGetPhysicalDeviceToolPropertiesEXT.
+func (p PhysicalDevice) GetPhysicalDeviceToolPropertiesEXT() ([]vk.PhysicalDeviceToolProperties, error) {
+	for {
+		var n uint32
+		if ret := p.InstanceTable.GetPhysicalDeviceToolPropertiesEXT.Call(p.PhysicalDevice, &n, nil); ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		toolProperties := make([]vk.PhysicalDeviceToolProperties, n)
+		for k := range toolProperties {
+			toolProperties[k].SType = vk.STRUCTURE_TYPE_PHYSICAL_DEVICE_TOOL_PROPERTIES
+		}
+		ret := p.InstanceTable.GetPhysicalDeviceToolPropertiesEXT.Call(p.PhysicalDevice, &n, &toolProperties[0])
+		if ret == vk.INCOMPLETE {
+			continue // the list grew between the two calls
+		}
+		if ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		return toolProperties[:n], nil
+	}
+}
+
+// GetPhysicalDeviceCooperativeMatrixPropertiesNV calls vkGetPhysicalDeviceCooperativeMatrixPropertiesNV.
+func (p PhysicalDevice) GetPhysicalDeviceCooperativeMatrixPropertiesNV() ([]vk.CooperativeMatrixPropertiesNV, error) {
+	for {
+		var n uint32
+		if ret := p.InstanceTable.GetPhysicalDeviceCooperativeMatrixPropertiesNV.Call(p.PhysicalDevice, &n, nil); ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		properties := make([]vk.CooperativeMatrixPropertiesNV, n)
+		for k := range properties {
+			properties[k].SType = vk.STRUCTURE_TYPE_COOPERATIVE_MATRIX_PROPERTIES_NV
+		}
+		ret := p.InstanceTable.GetPhysicalDeviceCooperativeMatrixPropertiesNV.Call(p.PhysicalDevice, &n, &properties[0])
+		if ret == vk.INCOMPLETE {
+			continue // the list grew between the two calls
+		}
+		if ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		return properties[:n], nil
+	}
+}
+
+// GetPhysicalDeviceSupportedFramebufferMixedSamplesCombinationsNV calls vkGetPhysicalDeviceSupportedFramebufferMixedSamplesCombinationsNV.
+func (p PhysicalDevice) GetPhysicalDeviceSupportedFramebufferMixedSamplesCombinationsNV() ([]vk.FramebufferMixedSamplesCombinationNV, error) {
+	for {
+		var n uint32
+		if ret := p.InstanceTable.GetPhysicalDeviceSupportedFramebufferMixedSamplesCombinationsNV.Call(p.PhysicalDevice, &n, nil); ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		combinations := make([]vk.FramebufferMixedSamplesCombinationNV, n)
+		for k := range combinations {
+			combinations[k].SType = vk.STRUCTURE_TYPE_FRAMEBUFFER_MIXED_SAMPLES_COMBINATION_NV
+		}
+		ret := p.InstanceTable.GetPhysicalDeviceSupportedFramebufferMixedSamplesCombinationsNV.Call(p.PhysicalDevice, &n, &combinations[0])
+		if ret == vk.INCOMPLETE {
+			continue // the list grew between the two calls
+		}
+		if ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		return combinations[:n], nil
+	}
+}
+
+// CreateHeadlessSurfaceEXT calls vkCreateHeadlessSurfaceEXT.
+func (i Instance) CreateHeadlessSurfaceEXT(pCreateInfo *vk.HeadlessSurfaceCreateInfoEXT) (vk.SurfaceKHR, error) {
+	var surface vk.SurfaceKHR
+	ret := i.InstanceTable.CreateHeadlessSurfaceEXT.Call(i.Instance, pCreateInfo, i.Allocator, &surface)
+	return surface, ret.Err()
+}
+
+// CmdSetLineStippleEXT calls vkCmdSetLineStippleEXT.
+func (c CommandBuffer) CmdSetLineStippleEXT(lineStippleFactor uint32, lineStipplePattern uint16) {
+	c.DeviceTable.CmdSetLineStippleEXT.Call(c.CommandBuffer, lineStippleFactor, lineStipplePattern)
+}
+
+// ResetQueryPoolEXT calls vkResetQueryPoolEXT.
+func (d Device) ResetQueryPoolEXT(queryPool vk.QueryPool, firstQuery, queryCount uint32) {
+	d.DeviceTable.ResetQueryPoolEXT.Call(d.Device, queryPool, firstQuery, queryCount)
+}
+
+// CmdSetCullModeEXT calls vkCmdSetCullModeEXT.
+func (c CommandBuffer) CmdSetCullModeEXT(cullMode vk.CullModeFlags) {
+	c.DeviceTable.CmdSetCullModeEXT.Call(c.CommandBuffer, cullMode)
+}
+
+// CmdSetFrontFaceEXT calls vkCmdSetFrontFaceEXT.
+func (c CommandBuffer) CmdSetFrontFaceEXT(frontFace vk.FrontFace) {
+	c.DeviceTable.CmdSetFrontFaceEXT.Call(c.CommandBuffer, frontFace)
+}
+
+// CmdSetPrimitiveTopologyEXT calls vkCmdSetPrimitiveTopologyEXT.
+func (c CommandBuffer) CmdSetPrimitiveTopologyEXT(primitiveTopology vk.PrimitiveTopology) {
+	c.DeviceTable.CmdSetPrimitiveTopologyEXT.Call(c.CommandBuffer, primitiveTopology)
+}
+
+// CmdSetViewportWithCountEXT calls vkCmdSetViewportWithCountEXT.
+func (c CommandBuffer) CmdSetViewportWithCountEXT(viewports []vk.Viewport) {
+	var pViewports *vk.Viewport
+	if len(viewports) > 0 {
+		pViewports = &viewports[0]
+	}
+	c.DeviceTable.CmdSetViewportWithCountEXT.Call(c.CommandBuffer, uint32(len(viewports)), pViewports)
+}
+
+// CmdSetScissorWithCountEXT calls vkCmdSetScissorWithCountEXT.
+func (c CommandBuffer) CmdSetScissorWithCountEXT(scissors []vk.Rect2D) {
+	var pScissors *vk.Rect2D
+	if len(scissors) > 0 {
+		pScissors = &scissors[0]
+	}
+	c.DeviceTable.CmdSetScissorWithCountEXT.Call(c.CommandBuffer, uint32(len(scissors)), pScissors)
+}
+
+// CmdBindVertexBuffers2EXT calls vkCmdBindVertexBuffers2EXT.
+func (c CommandBuffer) CmdBindVertexBuffers2EXT(firstBinding, bindingCount uint32, pBuffers *vk.Buffer, pOffsets, pSizes, pStrides *vk.DeviceSize) {
+	c.DeviceTable.CmdBindVertexBuffers2EXT.Call(c.CommandBuffer, firstBinding, bindingCount, pBuffers, pOffsets, pSizes, pStrides)
+}
+
+// CmdSetDepthTestEnableEXT calls vkCmdSetDepthTestEnableEXT.
+func (c CommandBuffer) CmdSetDepthTestEnableEXT(depthTestEnable vk.Bool32) {
+	c.DeviceTable.CmdSetDepthTestEnableEXT.Call(c.CommandBuffer, depthTestEnable)
+}
+
+// CmdSetDepthWriteEnableEXT calls vkCmdSetDepthWriteEnableEXT.
+func (c CommandBuffer) CmdSetDepthWriteEnableEXT(depthWriteEnable vk.Bool32) {
+	c.DeviceTable.CmdSetDepthWriteEnableEXT.Call(c.CommandBuffer, depthWriteEnable)
+}
+
+// CmdSetDepthCompareOpEXT calls vkCmdSetDepthCompareOpEXT.
+func (c CommandBuffer) CmdSetDepthCompareOpEXT(depthCompareOp vk.CompareOp) {
+	c.DeviceTable.CmdSetDepthCompareOpEXT.Call(c.CommandBuffer, depthCompareOp)
+}
+
+// CmdSetDepthBoundsTestEnableEXT calls vkCmdSetDepthBoundsTestEnableEXT.
+func (c CommandBuffer) CmdSetDepthBoundsTestEnableEXT(depthBoundsTestEnable vk.Bool32) {
+	c.DeviceTable.CmdSetDepthBoundsTestEnableEXT.Call(c.CommandBuffer, depthBoundsTestEnable)
+}
+
+// CmdSetStencilTestEnableEXT calls vkCmdSetStencilTestEnableEXT.
+func (c CommandBuffer) CmdSetStencilTestEnableEXT(stencilTestEnable vk.Bool32) {
+	c.DeviceTable.CmdSetStencilTestEnableEXT.Call(c.CommandBuffer, stencilTestEnable)
+}
+
+// CmdSetStencilOpEXT calls vkCmdSetStencilOpEXT.
+func (c CommandBuffer) CmdSetStencilOpEXT(faceMask vk.StencilFaceFlags, failOp, passOp, depthFailOp vk.StencilOp, compareOp vk.CompareOp) {
+	c.DeviceTable.CmdSetStencilOpEXT.Call(c.CommandBuffer, faceMask, failOp, passOp, depthFailOp, compareOp)
+}
+
+// ReleaseSwapchainImagesEXT calls vkReleaseSwapchainImagesEXT.
+func (d Device) ReleaseSwapchainImagesEXT(pReleaseInfo *vk.ReleaseSwapchainImagesInfoEXT) error {
+	return d.DeviceTable.ReleaseSwapchainImagesEXT.Call(d.Device, pReleaseInfo).Err()
+}
+
+// GetGeneratedCommandsMemoryRequirementsNV calls vkGetGeneratedCommandsMemoryRequirementsNV.
+func (d Device) GetGeneratedCommandsMemoryRequirementsNV(pInfo *vk.GeneratedCommandsMemoryRequirementsInfoNV, pMemoryRequirements *vk.MemoryRequirements2) {
+	d.DeviceTable.GetGeneratedCommandsMemoryRequirementsNV.Call(d.Device, pInfo, pMemoryRequirements)
+}
+
+// CmdPreprocessGeneratedCommandsNV calls vkCmdPreprocessGeneratedCommandsNV.
+func (c CommandBuffer) CmdPreprocessGeneratedCommandsNV(pGeneratedCommandsInfo *vk.GeneratedCommandsInfoNV) {
+	c.DeviceTable.CmdPreprocessGeneratedCommandsNV.Call(c.CommandBuffer, pGeneratedCommandsInfo)
+}
+
+// CmdExecuteGeneratedCommandsNV calls vkCmdExecuteGeneratedCommandsNV.
+func (c CommandBuffer) CmdExecuteGeneratedCommandsNV(isPreprocessed vk.Bool32, pGeneratedCommandsInfo *vk.GeneratedCommandsInfoNV) {
+	c.DeviceTable.CmdExecuteGeneratedCommandsNV.Call(c.CommandBuffer, isPreprocessed, pGeneratedCommandsInfo)
+}
+
+// CmdBindPipelineShaderGroupNV calls vkCmdBindPipelineShaderGroupNV.
+func (c CommandBuffer) CmdBindPipelineShaderGroupNV(pipelineBindPoint vk.PipelineBindPoint, pipeline vk.Pipeline, groupIndex uint32) {
+	c.DeviceTable.CmdBindPipelineShaderGroupNV.Call(c.CommandBuffer, pipelineBindPoint, pipeline, groupIndex)
+}
+
+// CreateIndirectCommandsLayoutNV calls vkCreateIndirectCommandsLayoutNV.
+func (d Device) CreateIndirectCommandsLayoutNV(pCreateInfo *vk.IndirectCommandsLayoutCreateInfoNV) (vk.IndirectCommandsLayoutNV, error) {
+	var indirectCommandsLayout vk.IndirectCommandsLayoutNV
+	ret := d.DeviceTable.CreateIndirectCommandsLayoutNV.Call(d.Device, pCreateInfo, d.Allocator, &indirectCommandsLayout)
+	return indirectCommandsLayout, ret.Err()
+}
+
+// DestroyIndirectCommandsLayoutNV calls vkDestroyIndirectCommandsLayoutNV.
+func (d Device) DestroyIndirectCommandsLayoutNV(indirectCommandsLayout vk.IndirectCommandsLayoutNV) {
+	d.DeviceTable.DestroyIndirectCommandsLayoutNV.Call(d.Device, indirectCommandsLayout, d.Allocator)
+}
+
+// AcquireDrmDisplayEXT calls vkAcquireDrmDisplayEXT.
+func (p PhysicalDevice) AcquireDrmDisplayEXT(drmFd int32, display vk.DisplayKHR) error {
+	return p.InstanceTable.AcquireDrmDisplayEXT.Call(p.PhysicalDevice, drmFd, display).Err()
+}
+
+// GetDrmDisplayEXT calls vkGetDrmDisplayEXT.
+func (p PhysicalDevice) GetDrmDisplayEXT(drmFd int32, connectorId uint32) (vk.DisplayKHR, error) {
+	var display vk.DisplayKHR
+	ret := p.InstanceTable.GetDrmDisplayEXT.Call(p.PhysicalDevice, drmFd, connectorId, &display)
+	return display, ret.Err()
+}
+
+// CreatePrivateDataSlotEXT calls vkCreatePrivateDataSlotEXT.
+func (d Device) CreatePrivateDataSlotEXT(pCreateInfo *vk.PrivateDataSlotCreateInfo) (vk.PrivateDataSlot, error) {
+	var privateDataSlot vk.PrivateDataSlot
+	ret := d.DeviceTable.CreatePrivateDataSlotEXT.Call(d.Device, pCreateInfo, d.Allocator, &privateDataSlot)
+	return privateDataSlot, ret.Err()
+}
+
+// DestroyPrivateDataSlotEXT calls vkDestroyPrivateDataSlotEXT.
+func (d Device) DestroyPrivateDataSlotEXT(privateDataSlot vk.PrivateDataSlot) {
+	d.DeviceTable.DestroyPrivateDataSlotEXT.Call(d.Device, privateDataSlot, d.Allocator)
+}
+
+// SetPrivateDataEXT calls vkSetPrivateDataEXT.
+func (d Device) SetPrivateDataEXT(objectType vk.ObjectType, objectHandle uint64, privateDataSlot vk.PrivateDataSlot, data uint64) error {
+	return d.DeviceTable.SetPrivateDataEXT.Call(d.Device, objectType, objectHandle, privateDataSlot, data).Err()
+}
+
+// GetPrivateDataEXT calls vkGetPrivateDataEXT.
+func (d Device) GetPrivateDataEXT(objectType vk.ObjectType, objectHandle uint64, privateDataSlot vk.PrivateDataSlot) uint64 {
+	var data uint64
+	d.DeviceTable.GetPrivateDataEXT.Call(d.Device, objectType, objectHandle, privateDataSlot, &data)
+	return data
+}
+
+// GetDescriptorSetLayoutSizeEXT calls vkGetDescriptorSetLayoutSizeEXT.
+func (d Device) GetDescriptorSetLayoutSizeEXT(layout vk.DescriptorSetLayout) vk.DeviceSize {
+	var layoutSizeInBytes vk.DeviceSize
+	d.DeviceTable.GetDescriptorSetLayoutSizeEXT.Call(d.Device, layout, &layoutSizeInBytes)
+	return layoutSizeInBytes
+}
+
+// GetDescriptorSetLayoutBindingOffsetEXT calls vkGetDescriptorSetLayoutBindingOffsetEXT.
+func (d Device) GetDescriptorSetLayoutBindingOffsetEXT(layout vk.DescriptorSetLayout, binding uint32) vk.DeviceSize {
+	var offset vk.DeviceSize
+	d.DeviceTable.GetDescriptorSetLayoutBindingOffsetEXT.Call(d.Device, layout, binding, &offset)
+	return offset
+}
+
+// GetDescriptorEXT calls vkGetDescriptorEXT.
+func (d Device) GetDescriptorEXT(pDescriptorInfo *vk.DescriptorGetInfoEXT, dataSize uintptr, pDescriptor unsafe.Pointer) {
+	d.DeviceTable.GetDescriptorEXT.Call(d.Device, pDescriptorInfo, dataSize, pDescriptor)
+}
+
+// CmdBindDescriptorBuffersEXT calls vkCmdBindDescriptorBuffersEXT.
+func (c CommandBuffer) CmdBindDescriptorBuffersEXT(bindingInfos []vk.DescriptorBufferBindingInfoEXT) {
+	var pBindingInfos *vk.DescriptorBufferBindingInfoEXT
+	if len(bindingInfos) > 0 {
+		pBindingInfos = &bindingInfos[0]
+	}
+	c.DeviceTable.CmdBindDescriptorBuffersEXT.Call(c.CommandBuffer, uint32(len(bindingInfos)), pBindingInfos)
+}
+
+// CmdSetDescriptorBufferOffsetsEXT calls vkCmdSetDescriptorBufferOffsetsEXT.
+func (c CommandBuffer) CmdSetDescriptorBufferOffsetsEXT(pipelineBindPoint vk.PipelineBindPoint, layout vk.PipelineLayout, firstSet, setCount uint32, pBufferIndices *uint32, pOffsets *vk.DeviceSize) {
+	c.DeviceTable.CmdSetDescriptorBufferOffsetsEXT.Call(c.CommandBuffer, pipelineBindPoint, layout, firstSet, setCount, pBufferIndices, pOffsets)
+}
+
+// CmdBindDescriptorBufferEmbeddedSamplersEXT calls vkCmdBindDescriptorBufferEmbeddedSamplersEXT.
+func (c CommandBuffer) CmdBindDescriptorBufferEmbeddedSamplersEXT(pipelineBindPoint vk.PipelineBindPoint, layout vk.PipelineLayout, set uint32) {
+	c.DeviceTable.CmdBindDescriptorBufferEmbeddedSamplersEXT.Call(c.CommandBuffer, pipelineBindPoint, layout, set)
+}
+
+// GetBufferOpaqueCaptureDescriptorDataEXT calls vkGetBufferOpaqueCaptureDescriptorDataEXT.
+func (d Device) GetBufferOpaqueCaptureDescriptorDataEXT(pInfo *vk.BufferCaptureDescriptorDataInfoEXT, pData unsafe.Pointer) error {
+	return d.DeviceTable.GetBufferOpaqueCaptureDescriptorDataEXT.Call(d.Device, pInfo, pData).Err()
+}
+
+// GetImageOpaqueCaptureDescriptorDataEXT calls vkGetImageOpaqueCaptureDescriptorDataEXT.
+func (d Device) GetImageOpaqueCaptureDescriptorDataEXT(pInfo *vk.ImageCaptureDescriptorDataInfoEXT, pData unsafe.Pointer) error {
+	return d.DeviceTable.GetImageOpaqueCaptureDescriptorDataEXT.Call(d.Device, pInfo, pData).Err()
+}
+
+// GetImageViewOpaqueCaptureDescriptorDataEXT calls vkGetImageViewOpaqueCaptureDescriptorDataEXT.
+func (d Device) GetImageViewOpaqueCaptureDescriptorDataEXT(pInfo *vk.ImageViewCaptureDescriptorDataInfoEXT, pData unsafe.Pointer) error {
+	return d.DeviceTable.GetImageViewOpaqueCaptureDescriptorDataEXT.Call(d.Device, pInfo, pData).Err()
+}
+
+// GetSamplerOpaqueCaptureDescriptorDataEXT calls vkGetSamplerOpaqueCaptureDescriptorDataEXT.
+func (d Device) GetSamplerOpaqueCaptureDescriptorDataEXT(pInfo *vk.SamplerCaptureDescriptorDataInfoEXT, pData unsafe.Pointer) error {
+	return d.DeviceTable.GetSamplerOpaqueCaptureDescriptorDataEXT.Call(d.Device, pInfo, pData).Err()
+}
+
+// GetAccelerationStructureOpaqueCaptureDescriptorDataEXT calls vkGetAccelerationStructureOpaqueCaptureDescriptorDataEXT.
+func (d Device) GetAccelerationStructureOpaqueCaptureDescriptorDataEXT(pInfo *vk.AccelerationStructureCaptureDescriptorDataInfoEXT, pData unsafe.Pointer) error {
+	return d.DeviceTable.GetAccelerationStructureOpaqueCaptureDescriptorDataEXT.Call(d.Device, pInfo, pData).Err()
+}
+
+// CmdSetFragmentShadingRateEnumNV calls vkCmdSetFragmentShadingRateEnumNV.
+func (c CommandBuffer) CmdSetFragmentShadingRateEnumNV(shadingRate vk.FragmentShadingRateNV, combinerOps *[2]vk.FragmentShadingRateCombinerOpKHR) {
+	c.DeviceTable.CmdSetFragmentShadingRateEnumNV.Call(c.CommandBuffer, shadingRate, combinerOps)
+}
+
+// CmdDrawMeshTasksEXT calls vkCmdDrawMeshTasksEXT.
+func (c CommandBuffer) CmdDrawMeshTasksEXT(groupCountX, groupCountY, groupCountZ uint32) {
+	c.DeviceTable.CmdDrawMeshTasksEXT.Call(c.CommandBuffer, groupCountX, groupCountY, groupCountZ)
+}
+
+// CmdDrawMeshTasksIndirectEXT calls vkCmdDrawMeshTasksIndirectEXT.
+func (c CommandBuffer) CmdDrawMeshTasksIndirectEXT(buffer vk.Buffer, offset vk.DeviceSize, drawCount, stride uint32) {
+	c.DeviceTable.CmdDrawMeshTasksIndirectEXT.Call(c.CommandBuffer, buffer, offset, drawCount, stride)
+}
+
+// CmdDrawMeshTasksIndirectCountEXT calls vkCmdDrawMeshTasksIndirectCountEXT.
+func (c CommandBuffer) CmdDrawMeshTasksIndirectCountEXT(buffer vk.Buffer, offset vk.DeviceSize, countBuffer vk.Buffer, countBufferOffset vk.DeviceSize, maxDrawCount, stride uint32) {
+	c.DeviceTable.CmdDrawMeshTasksIndirectCountEXT.Call(c.CommandBuffer, buffer, offset, countBuffer, countBufferOffset, maxDrawCount, stride)
+}
+
+// GetImageSubresourceLayout2EXT calls vkGetImageSubresourceLayout2EXT.
+func (d Device) GetImageSubresourceLayout2EXT(image vk.Image, pSubresource *vk.ImageSubresource2EXT, pLayout *vk.SubresourceLayout2EXT) {
+	d.DeviceTable.GetImageSubresourceLayout2EXT.Call(d.Device, image, pSubresource, pLayout)
+}
+
+// GetDeviceFaultInfoEXT calls vkGetDeviceFaultInfoEXT.
+func (d Device) GetDeviceFaultInfoEXT(pFaultCounts *vk.DeviceFaultCountsEXT, pFaultInfo *vk.DeviceFaultInfoEXT) error {
+	return d.DeviceTable.GetDeviceFaultInfoEXT.Call(d.Device, pFaultCounts, pFaultInfo).Err()
+}
+
+// CmdSetVertexInputEXT calls vkCmdSetVertexInputEXT.
+func (c CommandBuffer) CmdSetVertexInputEXT(vertexBindingDescriptions []vk.VertexInputBindingDescription2EXT, vertexAttributeDescriptions []vk.VertexInputAttributeDescription2EXT) {
+	var pVertexBindingDescriptions *vk.VertexInputBindingDescription2EXT
+	if len(vertexBindingDescriptions) > 0 {
+		pVertexBindingDescriptions = &vertexBindingDescriptions[0]
+	}
+	var pVertexAttributeDescriptions *vk.VertexInputAttributeDescription2EXT
+	if len(vertexAttributeDescriptions) > 0 {
+		pVertexAttributeDescriptions = &vertexAttributeDescriptions[0]
+	}
+	c.DeviceTable.CmdSetVertexInputEXT.Call(c.CommandBuffer, uint32(len(vertexBindingDescriptions)), pVertexBindingDescriptions, uint32(len(vertexAttributeDescriptions)), pVertexAttributeDescriptions)
+}
+
+// GetDeviceSubpassShadingMaxWorkgroupSizeHUAWEI calls vkGetDeviceSubpassShadingMaxWorkgroupSizeHUAWEI.
+func (d Device) GetDeviceSubpassShadingMaxWorkgroupSizeHUAWEI(renderpass vk.RenderPass) (vk.Extent2D, error) {
+	var maxWorkgroupSize vk.Extent2D
+	ret := d.DeviceTable.GetDeviceSubpassShadingMaxWorkgroupSizeHUAWEI.Call(d.Device, renderpass, &maxWorkgroupSize)
+	return maxWorkgroupSize, ret.Err()
+}
+
+// CmdSubpassShadingHUAWEI calls vkCmdSubpassShadingHUAWEI.
+func (c CommandBuffer) CmdSubpassShadingHUAWEI() {
+	c.DeviceTable.CmdSubpassShadingHUAWEI.Call(c.CommandBuffer)
+}
+
+// CmdBindInvocationMaskHUAWEI calls vkCmdBindInvocationMaskHUAWEI.
+func (c CommandBuffer) CmdBindInvocationMaskHUAWEI(imageView vk.ImageView, imageLayout vk.ImageLayout) {
+	c.DeviceTable.CmdBindInvocationMaskHUAWEI.Call(c.CommandBuffer, imageView, imageLayout)
+}
+
+// GetMemoryRemoteAddressNV calls vkGetMemoryRemoteAddressNV.
+func (d Device) GetMemoryRemoteAddressNV(pMemoryGetRemoteAddressInfo *vk.MemoryGetRemoteAddressInfoNV) (vk.RemoteAddressNV, error) {
+	var address vk.RemoteAddressNV
+	ret := d.DeviceTable.GetMemoryRemoteAddressNV.Call(d.Device, pMemoryGetRemoteAddressInfo, &address)
+	return address, ret.Err()
+}
+
+// GetPipelinePropertiesEXT calls vkGetPipelinePropertiesEXT.
+func (d Device) GetPipelinePropertiesEXT(pPipelineInfo *vk.PipelineInfoEXT, pPipelineProperties *vk.BaseOutStructure) error {
+	return d.DeviceTable.GetPipelinePropertiesEXT.Call(d.Device, pPipelineInfo, pPipelineProperties).Err()
+}
+
+// CmdSetPatchControlPointsEXT calls vkCmdSetPatchControlPointsEXT.
+func (c CommandBuffer) CmdSetPatchControlPointsEXT(patchControlPoints uint32) {
+	c.DeviceTable.CmdSetPatchControlPointsEXT.Call(c.CommandBuffer, patchControlPoints)
+}
+
+// CmdSetRasterizerDiscardEnableEXT calls vkCmdSetRasterizerDiscardEnableEXT.
+func (c CommandBuffer) CmdSetRasterizerDiscardEnableEXT(rasterizerDiscardEnable vk.Bool32) {
+	c.DeviceTable.CmdSetRasterizerDiscardEnableEXT.Call(c.CommandBuffer, rasterizerDiscardEnable)
+}
+
+// CmdSetDepthBiasEnableEXT calls vkCmdSetDepthBiasEnableEXT.
+func (c CommandBuffer) CmdSetDepthBiasEnableEXT(depthBiasEnable vk.Bool32) {
+	c.DeviceTable.CmdSetDepthBiasEnableEXT.Call(c.CommandBuffer, depthBiasEnable)
+}
+
+// CmdSetLogicOpEXT calls vkCmdSetLogicOpEXT.
+func (c CommandBuffer) CmdSetLogicOpEXT(logicOp vk.LogicOp) {
+	c.DeviceTable.CmdSetLogicOpEXT.Call(c.CommandBuffer, logicOp)
+}
+
+// CmdSetPrimitiveRestartEnableEXT calls vkCmdSetPrimitiveRestartEnableEXT.
+func (c CommandBuffer) CmdSetPrimitiveRestartEnableEXT(primitiveRestartEnable vk.Bool32) {
+	c.DeviceTable.CmdSetPrimitiveRestartEnableEXT.Call(c.CommandBuffer, primitiveRestartEnable)
+}
+
+// CmdSetColorWriteEnableEXT calls vkCmdSetColorWriteEnableEXT.
+func (c CommandBuffer) CmdSetColorWriteEnableEXT(colorWriteEnables []vk.Bool32) {
+	var pColorWriteEnables *vk.Bool32
+	if len(colorWriteEnables) > 0 {
+		pColorWriteEnables = &colorWriteEnables[0]
+	}
+	c.DeviceTable.CmdSetColorWriteEnableEXT.Call(c.CommandBuffer, uint32(len(colorWriteEnables)), pColorWriteEnables)
+}
+
+// CmdDrawMultiEXT calls vkCmdDrawMultiEXT.
+func (c CommandBuffer) CmdDrawMultiEXT(vertexInfo []vk.MultiDrawInfoEXT, instanceCount, firstInstance, stride uint32) {
+	var pVertexInfo *vk.MultiDrawInfoEXT
+	if len(vertexInfo) > 0 {
+		pVertexInfo = &vertexInfo[0]
+	}
+	c.DeviceTable.CmdDrawMultiEXT.Call(c.CommandBuffer, uint32(len(vertexInfo)), pVertexInfo, instanceCount, firstInstance, stride)
+}
+
+// CmdDrawMultiIndexedEXT calls vkCmdDrawMultiIndexedEXT.
+func (c CommandBuffer) CmdDrawMultiIndexedEXT(indexInfo []vk.MultiDrawIndexedInfoEXT, instanceCount, firstInstance, stride uint32, pVertexOffset *int32) {
+	var pIndexInfo *vk.MultiDrawIndexedInfoEXT
+	if len(indexInfo) > 0 {
+		pIndexInfo = &indexInfo[0]
+	}
+	c.DeviceTable.CmdDrawMultiIndexedEXT.Call(c.CommandBuffer, uint32(len(indexInfo)), pIndexInfo, instanceCount, firstInstance, stride, pVertexOffset)
+}
+
+// CreateMicromapEXT calls vkCreateMicromapEXT.
+func (d Device) CreateMicromapEXT(pCreateInfo *vk.MicromapCreateInfoEXT) (vk.MicromapEXT, error) {
+	var micromap vk.MicromapEXT
+	ret := d.DeviceTable.CreateMicromapEXT.Call(d.Device, pCreateInfo, d.Allocator, &micromap)
+	return micromap, ret.Err()
+}
+
+// DestroyMicromapEXT calls vkDestroyMicromapEXT.
+func (d Device) DestroyMicromapEXT(micromap vk.MicromapEXT) {
+	d.DeviceTable.DestroyMicromapEXT.Call(d.Device, micromap, d.Allocator)
+}
+
+// CmdBuildMicromapsEXT calls vkCmdBuildMicromapsEXT.
+func (c CommandBuffer) CmdBuildMicromapsEXT(infos []vk.MicromapBuildInfoEXT) {
+	var pInfos *vk.MicromapBuildInfoEXT
+	if len(infos) > 0 {
+		pInfos = &infos[0]
+	}
+	c.DeviceTable.CmdBuildMicromapsEXT.Call(c.CommandBuffer, uint32(len(infos)), pInfos)
+}
+
+// BuildMicromapsEXT calls vkBuildMicromapsEXT.
+func (d Device) BuildMicromapsEXT(deferredOperation vk.DeferredOperationKHR, infos []vk.MicromapBuildInfoEXT) error {
+	var pInfos *vk.MicromapBuildInfoEXT
+	if len(infos) > 0 {
+		pInfos = &infos[0]
+	}
+	return d.DeviceTable.BuildMicromapsEXT.Call(d.Device, deferredOperation, uint32(len(infos)), pInfos).Err()
+}
+
+// CopyMicromapEXT calls vkCopyMicromapEXT.
+func (d Device) CopyMicromapEXT(deferredOperation vk.DeferredOperationKHR, pInfo *vk.CopyMicromapInfoEXT) error {
+	return d.DeviceTable.CopyMicromapEXT.Call(d.Device, deferredOperation, pInfo).Err()
+}
+
+// CopyMicromapToMemoryEXT calls vkCopyMicromapToMemoryEXT.
+func (d Device) CopyMicromapToMemoryEXT(deferredOperation vk.DeferredOperationKHR, pInfo *vk.CopyMicromapToMemoryInfoEXT) error {
+	return d.DeviceTable.CopyMicromapToMemoryEXT.Call(d.Device, deferredOperation, pInfo).Err()
+}
+
+// CopyMemoryToMicromapEXT calls vkCopyMemoryToMicromapEXT.
+func (d Device) CopyMemoryToMicromapEXT(deferredOperation vk.DeferredOperationKHR, pInfo *vk.CopyMemoryToMicromapInfoEXT) error {
+	return d.DeviceTable.CopyMemoryToMicromapEXT.Call(d.Device, deferredOperation, pInfo).Err()
+}
+
+// WriteMicromapsPropertiesEXT calls vkWriteMicromapsPropertiesEXT.
+func (d Device) WriteMicromapsPropertiesEXT(micromaps []vk.MicromapEXT, queryType vk.QueryType, dataSize uintptr, pData unsafe.Pointer, stride uintptr) error {
+	var pMicromaps *vk.MicromapEXT
+	if len(micromaps) > 0 {
+		pMicromaps = &micromaps[0]
+	}
+	return d.DeviceTable.WriteMicromapsPropertiesEXT.Call(d.Device, uint32(len(micromaps)), pMicromaps, queryType, dataSize, pData, stride).Err()
+}
+
+// CmdCopyMicromapEXT calls vkCmdCopyMicromapEXT.
+func (c CommandBuffer) CmdCopyMicromapEXT(pInfo *vk.CopyMicromapInfoEXT) {
+	c.DeviceTable.CmdCopyMicromapEXT.Call(c.CommandBuffer, pInfo)
+}
+
+// CmdCopyMicromapToMemoryEXT calls vkCmdCopyMicromapToMemoryEXT.
+func (c CommandBuffer) CmdCopyMicromapToMemoryEXT(pInfo *vk.CopyMicromapToMemoryInfoEXT) {
+	c.DeviceTable.CmdCopyMicromapToMemoryEXT.Call(c.CommandBuffer, pInfo)
+}
+
+// CmdCopyMemoryToMicromapEXT calls vkCmdCopyMemoryToMicromapEXT.
+func (c CommandBuffer) CmdCopyMemoryToMicromapEXT(pInfo *vk.CopyMemoryToMicromapInfoEXT) {
+	c.DeviceTable.CmdCopyMemoryToMicromapEXT.Call(c.CommandBuffer, pInfo)
+}
+
+// CmdWriteMicromapsPropertiesEXT calls vkCmdWriteMicromapsPropertiesEXT.
+func (c CommandBuffer) CmdWriteMicromapsPropertiesEXT(micromaps []vk.MicromapEXT, queryType vk.QueryType, queryPool vk.QueryPool, firstQuery uint32) {
+	var pMicromaps *vk.MicromapEXT
+	if len(micromaps) > 0 {
+		pMicromaps = &micromaps[0]
+	}
+	c.DeviceTable.CmdWriteMicromapsPropertiesEXT.Call(c.CommandBuffer, uint32(len(micromaps)), pMicromaps, queryType, queryPool, firstQuery)
+}
+
+// GetDeviceMicromapCompatibilityEXT calls vkGetDeviceMicromapCompatibilityEXT.
+func (d Device) GetDeviceMicromapCompatibilityEXT(pVersionInfo *vk.MicromapVersionInfoEXT) vk.AccelerationStructureCompatibilityKHR {
+	var compatibility vk.AccelerationStructureCompatibilityKHR
+	d.DeviceTable.GetDeviceMicromapCompatibilityEXT.Call(d.Device, pVersionInfo, &compatibility)
+	return compatibility
+}
+
+// GetMicromapBuildSizesEXT calls vkGetMicromapBuildSizesEXT.
+func (d Device) GetMicromapBuildSizesEXT(buildType vk.AccelerationStructureBuildTypeKHR, pBuildInfo *vk.MicromapBuildInfoEXT, pSizeInfo *vk.MicromapBuildSizesInfoEXT) {
+	d.DeviceTable.GetMicromapBuildSizesEXT.Call(d.Device, buildType, pBuildInfo, pSizeInfo)
+}
+
+// CmdDrawClusterHUAWEI calls vkCmdDrawClusterHUAWEI.
+func (c CommandBuffer) CmdDrawClusterHUAWEI(groupCountX, groupCountY, groupCountZ uint32) {
+	c.DeviceTable.CmdDrawClusterHUAWEI.Call(c.CommandBuffer, groupCountX, groupCountY, groupCountZ)
+}
+
+// CmdDrawClusterIndirectHUAWEI calls vkCmdDrawClusterIndirectHUAWEI.
+func (c CommandBuffer) CmdDrawClusterIndirectHUAWEI(buffer vk.Buffer, offset vk.DeviceSize) {
+	c.DeviceTable.CmdDrawClusterIndirectHUAWEI.Call(c.CommandBuffer, buffer, offset)
+}
+
+// SetDeviceMemoryPriorityEXT calls vkSetDeviceMemoryPriorityEXT.
+func (d Device) SetDeviceMemoryPriorityEXT(memory vk.DeviceMemory, priority float32) {
+	d.DeviceTable.SetDeviceMemoryPriorityEXT.Call(d.Device, memory, priority)
+}
+
+// GetDescriptorSetLayoutHostMappingInfoVALVE calls vkGetDescriptorSetLayoutHostMappingInfoVALVE.
+func (d Device) GetDescriptorSetLayoutHostMappingInfoVALVE(pBindingReference *vk.DescriptorSetBindingReferenceVALVE, pHostMapping *vk.DescriptorSetLayoutHostMappingInfoVALVE) {
+	d.DeviceTable.GetDescriptorSetLayoutHostMappingInfoVALVE.Call(d.Device, pBindingReference, pHostMapping)
+}
+
+// GetDescriptorSetHostMappingVALVE calls vkGetDescriptorSetHostMappingVALVE.
+func (d Device) GetDescriptorSetHostMappingVALVE(descriptorSet vk.DescriptorSet) unsafe.Pointer {
+	var data unsafe.Pointer
+	d.DeviceTable.GetDescriptorSetHostMappingVALVE.Call(d.Device, descriptorSet, &data)
+	return data
+}
+
+// CmdCopyMemoryIndirectNV calls vkCmdCopyMemoryIndirectNV.
+func (c CommandBuffer) CmdCopyMemoryIndirectNV(copyBufferAddress vk.DeviceAddress, copyCount, stride uint32) {
+	c.DeviceTable.CmdCopyMemoryIndirectNV.Call(c.CommandBuffer, copyBufferAddress, copyCount, stride)
+}
+
+// CmdCopyMemoryToImageIndirectNV calls vkCmdCopyMemoryToImageIndirectNV.
+func (c CommandBuffer) CmdCopyMemoryToImageIndirectNV(copyBufferAddress vk.DeviceAddress, stride uint32, dstImage vk.Image, dstImageLayout vk.ImageLayout, imageSubresources []vk.ImageSubresourceLayers) {
+	var pImageSubresources *vk.ImageSubresourceLayers
+	if len(imageSubresources) > 0 {
+		pImageSubresources = &imageSubresources[0]
+	}
+	c.DeviceTable.CmdCopyMemoryToImageIndirectNV.Call(c.CommandBuffer, copyBufferAddress, uint32(len(imageSubresources)), stride, dstImage, dstImageLayout, pImageSubresources)
+}
+
+// CmdDecompressMemoryNV calls vkCmdDecompressMemoryNV.
+func (c CommandBuffer) CmdDecompressMemoryNV(decompressMemoryRegions []vk.DecompressMemoryRegionNV) {
+	var pDecompressMemoryRegions *vk.DecompressMemoryRegionNV
+	if len(decompressMemoryRegions) > 0 {
+		pDecompressMemoryRegions = &decompressMemoryRegions[0]
+	}
+	c.DeviceTable.CmdDecompressMemoryNV.Call(c.CommandBuffer, uint32(len(decompressMemoryRegions)), pDecompressMemoryRegions)
+}
+
+// CmdDecompressMemoryIndirectCountNV calls vkCmdDecompressMemoryIndirectCountNV.
+func (c CommandBuffer) CmdDecompressMemoryIndirectCountNV(indirectCommandsAddress, indirectCommandsCountAddress vk.DeviceAddress, stride uint32) {
+	c.DeviceTable.CmdDecompressMemoryIndirectCountNV.Call(c.CommandBuffer, indirectCommandsAddress, indirectCommandsCountAddress, stride)
+}
+
+// CmdSetTessellationDomainOriginEXT calls vkCmdSetTessellationDomainOriginEXT.
+func (c CommandBuffer) CmdSetTessellationDomainOriginEXT(domainOrigin vk.TessellationDomainOrigin) {
+	c.DeviceTable.CmdSetTessellationDomainOriginEXT.Call(c.CommandBuffer, domainOrigin)
+}
+
+// CmdSetDepthClampEnableEXT calls vkCmdSetDepthClampEnableEXT.
+func (c CommandBuffer) CmdSetDepthClampEnableEXT(depthClampEnable vk.Bool32) {
+	c.DeviceTable.CmdSetDepthClampEnableEXT.Call(c.CommandBuffer, depthClampEnable)
+}
+
+// CmdSetPolygonModeEXT calls vkCmdSetPolygonModeEXT.
+func (c CommandBuffer) CmdSetPolygonModeEXT(polygonMode vk.PolygonMode) {
+	c.DeviceTable.CmdSetPolygonModeEXT.Call(c.CommandBuffer, polygonMode)
+}
+
+// CmdSetRasterizationSamplesEXT calls vkCmdSetRasterizationSamplesEXT.
+func (c CommandBuffer) CmdSetRasterizationSamplesEXT(rasterizationSamples vk.SampleCountFlags) {
+	c.DeviceTable.CmdSetRasterizationSamplesEXT.Call(c.CommandBuffer, rasterizationSamples)
+}
+
+// CmdSetSampleMaskEXT calls vkCmdSetSampleMaskEXT.
+func (c CommandBuffer) CmdSetSampleMaskEXT(samples vk.SampleCountFlags, pSampleMask *vk.SampleMask) {
+	c.DeviceTable.CmdSetSampleMaskEXT.Call(c.CommandBuffer, samples, pSampleMask)
+}
+
+// CmdSetAlphaToCoverageEnableEXT calls vkCmdSetAlphaToCoverageEnableEXT.
+func (c CommandBuffer) CmdSetAlphaToCoverageEnableEXT(alphaToCoverageEnable vk.Bool32) {
+	c.DeviceTable.CmdSetAlphaToCoverageEnableEXT.Call(c.CommandBuffer, alphaToCoverageEnable)
+}
+
+// CmdSetAlphaToOneEnableEXT calls vkCmdSetAlphaToOneEnableEXT.
+func (c CommandBuffer) CmdSetAlphaToOneEnableEXT(alphaToOneEnable vk.Bool32) {
+	c.DeviceTable.CmdSetAlphaToOneEnableEXT.Call(c.CommandBuffer, alphaToOneEnable)
+}
+
+// CmdSetLogicOpEnableEXT calls vkCmdSetLogicOpEnableEXT.
+func (c CommandBuffer) CmdSetLogicOpEnableEXT(logicOpEnable vk.Bool32) {
+	c.DeviceTable.CmdSetLogicOpEnableEXT.Call(c.CommandBuffer, logicOpEnable)
+}
+
+// CmdSetColorBlendEnableEXT calls vkCmdSetColorBlendEnableEXT.
+func (c CommandBuffer) CmdSetColorBlendEnableEXT(firstAttachment uint32, colorBlendEnables []vk.Bool32) {
+	var pColorBlendEnables *vk.Bool32
+	if len(colorBlendEnables) > 0 {
+		pColorBlendEnables = &colorBlendEnables[0]
+	}
+	c.DeviceTable.CmdSetColorBlendEnableEXT.Call(c.CommandBuffer, firstAttachment, uint32(len(colorBlendEnables)), pColorBlendEnables)
+}
+
+// CmdSetColorBlendEquationEXT calls vkCmdSetColorBlendEquationEXT.
+func (c CommandBuffer) CmdSetColorBlendEquationEXT(firstAttachment uint32, colorBlendEquations []vk.ColorBlendEquationEXT) {
+	var pColorBlendEquations *vk.ColorBlendEquationEXT
+	if len(colorBlendEquations) > 0 {
+		pColorBlendEquations = &colorBlendEquations[0]
+	}
+	c.DeviceTable.CmdSetColorBlendEquationEXT.Call(c.CommandBuffer, firstAttachment, uint32(len(colorBlendEquations)), pColorBlendEquations)
+}
+
+// CmdSetColorWriteMaskEXT calls vkCmdSetColorWriteMaskEXT.
+func (c CommandBuffer) CmdSetColorWriteMaskEXT(firstAttachment uint32, colorWriteMasks []vk.ColorComponentFlags) {
+	var pColorWriteMasks *vk.ColorComponentFlags
+	if len(colorWriteMasks) > 0 {
+		pColorWriteMasks = &colorWriteMasks[0]
+	}
+	c.DeviceTable.CmdSetColorWriteMaskEXT.Call(c.CommandBuffer, firstAttachment, uint32(len(colorWriteMasks)), pColorWriteMasks)
+}
+
+// CmdSetRasterizationStreamEXT calls vkCmdSetRasterizationStreamEXT.
+func (c CommandBuffer) CmdSetRasterizationStreamEXT(rasterizationStream uint32) {
+	c.DeviceTable.CmdSetRasterizationStreamEXT.Call(c.CommandBuffer, rasterizationStream)
+}
+
+// CmdSetConservativeRasterizationModeEXT calls vkCmdSetConservativeRasterizationModeEXT.
+func (c CommandBuffer) CmdSetConservativeRasterizationModeEXT(conservativeRasterizationMode vk.ConservativeRasterizationModeEXT) {
+	c.DeviceTable.CmdSetConservativeRasterizationModeEXT.Call(c.CommandBuffer, conservativeRasterizationMode)
+}
+
+// CmdSetExtraPrimitiveOverestimationSizeEXT calls vkCmdSetExtraPrimitiveOverestimationSizeEXT.
+func (c CommandBuffer) CmdSetExtraPrimitiveOverestimationSizeEXT(extraPrimitiveOverestimationSize float32) {
+	c.DeviceTable.CmdSetExtraPrimitiveOverestimationSizeEXT.Call(c.CommandBuffer, extraPrimitiveOverestimationSize)
+}
+
+// CmdSetDepthClipEnableEXT calls vkCmdSetDepthClipEnableEXT.
+func (c CommandBuffer) CmdSetDepthClipEnableEXT(depthClipEnable vk.Bool32) {
+	c.DeviceTable.CmdSetDepthClipEnableEXT.Call(c.CommandBuffer, depthClipEnable)
+}
+
+// CmdSetSampleLocationsEnableEXT calls vkCmdSetSampleLocationsEnableEXT.
+func (c CommandBuffer) CmdSetSampleLocationsEnableEXT(sampleLocationsEnable vk.Bool32) {
+	c.DeviceTable.CmdSetSampleLocationsEnableEXT.Call(c.CommandBuffer, sampleLocationsEnable)
+}
+
+// CmdSetColorBlendAdvancedEXT calls vkCmdSetColorBlendAdvancedEXT.
+func (c CommandBuffer) CmdSetColorBlendAdvancedEXT(firstAttachment uint32, colorBlendAdvanced []vk.ColorBlendAdvancedEXT) {
+	var pColorBlendAdvanced *vk.ColorBlendAdvancedEXT
+	if len(colorBlendAdvanced) > 0 {
+		pColorBlendAdvanced = &colorBlendAdvanced[0]
+	}
+	c.DeviceTable.CmdSetColorBlendAdvancedEXT.Call(c.CommandBuffer, firstAttachment, uint32(len(colorBlendAdvanced)), pColorBlendAdvanced)
+}
+
+// CmdSetProvokingVertexModeEXT calls vkCmdSetProvokingVertexModeEXT.
+func (c CommandBuffer) CmdSetProvokingVertexModeEXT(provokingVertexMode vk.ProvokingVertexModeEXT) {
+	c.DeviceTable.CmdSetProvokingVertexModeEXT.Call(c.CommandBuffer, provokingVertexMode)
+}
+
+// CmdSetLineRasterizationModeEXT calls vkCmdSetLineRasterizationModeEXT.
+func (c CommandBuffer) CmdSetLineRasterizationModeEXT(lineRasterizationMode vk.LineRasterizationModeEXT) {
+	c.DeviceTable.CmdSetLineRasterizationModeEXT.Call(c.CommandBuffer, lineRasterizationMode)
+}
+
+// CmdSetLineStippleEnableEXT calls vkCmdSetLineStippleEnableEXT.
+func (c CommandBuffer) CmdSetLineStippleEnableEXT(stippledLineEnable vk.Bool32) {
+	c.DeviceTable.CmdSetLineStippleEnableEXT.Call(c.CommandBuffer, stippledLineEnable)
+}
+
+// CmdSetDepthClipNegativeOneToOneEXT calls vkCmdSetDepthClipNegativeOneToOneEXT.
+func (c CommandBuffer) CmdSetDepthClipNegativeOneToOneEXT(negativeOneToOne vk.Bool32) {
+	c.DeviceTable.CmdSetDepthClipNegativeOneToOneEXT.Call(c.CommandBuffer, negativeOneToOne)
+}
+
+// CmdSetViewportWScalingEnableNV calls vkCmdSetViewportWScalingEnableNV.
+func (c CommandBuffer) CmdSetViewportWScalingEnableNV(viewportWScalingEnable vk.Bool32) {
+	c.DeviceTable.CmdSetViewportWScalingEnableNV.Call(c.CommandBuffer, viewportWScalingEnable)
+}
+
+// CmdSetViewportSwizzleNV calls vkCmdSetViewportSwizzleNV.
+func (c CommandBuffer) CmdSetViewportSwizzleNV(firstViewport uint32, viewportSwizzles []vk.ViewportSwizzleNV) {
+	var pViewportSwizzles *vk.ViewportSwizzleNV
+	if len(viewportSwizzles) > 0 {
+		pViewportSwizzles = &viewportSwizzles[0]
+	}
+	c.DeviceTable.CmdSetViewportSwizzleNV.Call(c.CommandBuffer, firstViewport, uint32(len(viewportSwizzles)), pViewportSwizzles)
+}
+
+// CmdSetCoverageToColorEnableNV calls vkCmdSetCoverageToColorEnableNV.
+func (c CommandBuffer) CmdSetCoverageToColorEnableNV(coverageToColorEnable vk.Bool32) {
+	c.DeviceTable.CmdSetCoverageToColorEnableNV.Call(c.CommandBuffer, coverageToColorEnable)
+}
+
+// CmdSetCoverageToColorLocationNV calls vkCmdSetCoverageToColorLocationNV.
+func (c CommandBuffer) CmdSetCoverageToColorLocationNV(coverageToColorLocation uint32) {
+	c.DeviceTable.CmdSetCoverageToColorLocationNV.Call(c.CommandBuffer, coverageToColorLocation)
+}
+
+// CmdSetCoverageModulationModeNV calls vkCmdSetCoverageModulationModeNV.
+func (c CommandBuffer) CmdSetCoverageModulationModeNV(coverageModulationMode vk.CoverageModulationModeNV) {
+	c.DeviceTable.CmdSetCoverageModulationModeNV.Call(c.CommandBuffer, coverageModulationMode)
+}
+
+// CmdSetCoverageModulationTableEnableNV calls vkCmdSetCoverageModulationTableEnableNV.
+func (c CommandBuffer) CmdSetCoverageModulationTableEnableNV(coverageModulationTableEnable vk.Bool32) {
+	c.DeviceTable.CmdSetCoverageModulationTableEnableNV.Call(c.CommandBuffer, coverageModulationTableEnable)
+}
+
+// CmdSetCoverageModulationTableNV calls vkCmdSetCoverageModulationTableNV.
+func (c CommandBuffer) CmdSetCoverageModulationTableNV(coverageModulationTable []float32) {
+	var pCoverageModulationTable *float32
+	if len(coverageModulationTable) > 0 {
+		pCoverageModulationTable = &coverageModulationTable[0]
+	}
+	c.DeviceTable.CmdSetCoverageModulationTableNV.Call(c.CommandBuffer, uint32(len(coverageModulationTable)), pCoverageModulationTable)
+}
+
+// CmdSetShadingRateImageEnableNV calls vkCmdSetShadingRateImageEnableNV.
+func (c CommandBuffer) CmdSetShadingRateImageEnableNV(shadingRateImageEnable vk.Bool32) {
+	c.DeviceTable.CmdSetShadingRateImageEnableNV.Call(c.CommandBuffer, shadingRateImageEnable)
+}
+
+// CmdSetRepresentativeFragmentTestEnableNV calls vkCmdSetRepresentativeFragmentTestEnableNV.
+func (c CommandBuffer) CmdSetRepresentativeFragmentTestEnableNV(representativeFragmentTestEnable vk.Bool32) {
+	c.DeviceTable.CmdSetRepresentativeFragmentTestEnableNV.Call(c.CommandBuffer, representativeFragmentTestEnable)
+}
+
+// CmdSetCoverageReductionModeNV calls vkCmdSetCoverageReductionModeNV.
+func (c CommandBuffer) CmdSetCoverageReductionModeNV(coverageReductionMode vk.CoverageReductionModeNV) {
+	c.DeviceTable.CmdSetCoverageReductionModeNV.Call(c.CommandBuffer, coverageReductionMode)
+}
+
+// GetShaderModuleIdentifierEXT calls vkGetShaderModuleIdentifierEXT.
+func (d Device) GetShaderModuleIdentifierEXT(shaderModule vk.ShaderModule, pIdentifier *vk.ShaderModuleIdentifierEXT) {
+	d.DeviceTable.GetShaderModuleIdentifierEXT.Call(d.Device, shaderModule, pIdentifier)
+}
+
+// GetShaderModuleCreateInfoIdentifierEXT calls vkGetShaderModuleCreateInfoIdentifierEXT.
+func (d Device) GetShaderModuleCreateInfoIdentifierEXT(pCreateInfo *vk.ShaderModuleCreateInfo, pIdentifier *vk.ShaderModuleIdentifierEXT) {
+	d.DeviceTable.GetShaderModuleCreateInfoIdentifierEXT.Call(d.Device, pCreateInfo, pIdentifier)
+}
+
+// GetPhysicalDeviceOpticalFlowImageFormatsNV calls vkGetPhysicalDeviceOpticalFlowImageFormatsNV.
+func (p PhysicalDevice) GetPhysicalDeviceOpticalFlowImageFormatsNV(pOpticalFlowImageFormatInfo *vk.OpticalFlowImageFormatInfoNV) ([]vk.OpticalFlowImageFormatPropertiesNV, error) {
+	for {
+		var n uint32
+		if ret := p.InstanceTable.GetPhysicalDeviceOpticalFlowImageFormatsNV.Call(p.PhysicalDevice, pOpticalFlowImageFormatInfo, &n, nil); ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		imageFormatProperties := make([]vk.OpticalFlowImageFormatPropertiesNV, n)
+		for k := range imageFormatProperties {
+			imageFormatProperties[k].SType = vk.STRUCTURE_TYPE_OPTICAL_FLOW_IMAGE_FORMAT_PROPERTIES_NV
+		}
+		ret := p.InstanceTable.GetPhysicalDeviceOpticalFlowImageFormatsNV.Call(p.PhysicalDevice, pOpticalFlowImageFormatInfo, &n, &imageFormatProperties[0])
+		if ret == vk.INCOMPLETE {
+			continue // the list grew between the two calls
+		}
+		if ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		return imageFormatProperties[:n], nil
+	}
+}
+
+// CreateOpticalFlowSessionNV calls vkCreateOpticalFlowSessionNV.
+func (d Device) CreateOpticalFlowSessionNV(pCreateInfo *vk.OpticalFlowSessionCreateInfoNV) (vk.OpticalFlowSessionNV, error) {
+	var session vk.OpticalFlowSessionNV
+	ret := d.DeviceTable.CreateOpticalFlowSessionNV.Call(d.Device, pCreateInfo, d.Allocator, &session)
+	return session, ret.Err()
+}
+
+// DestroyOpticalFlowSessionNV calls vkDestroyOpticalFlowSessionNV.
+func (d Device) DestroyOpticalFlowSessionNV(session vk.OpticalFlowSessionNV) {
+	d.DeviceTable.DestroyOpticalFlowSessionNV.Call(d.Device, session, d.Allocator)
+}
+
+// BindOpticalFlowSessionImageNV calls vkBindOpticalFlowSessionImageNV.
+func (d Device) BindOpticalFlowSessionImageNV(session vk.OpticalFlowSessionNV, bindingPoint vk.OpticalFlowSessionBindingPointNV, view vk.ImageView, layout vk.ImageLayout) error {
+	return d.DeviceTable.BindOpticalFlowSessionImageNV.Call(d.Device, session, bindingPoint, view, layout).Err()
+}
+
+// CmdOpticalFlowExecuteNV calls vkCmdOpticalFlowExecuteNV.
+func (c CommandBuffer) CmdOpticalFlowExecuteNV(session vk.OpticalFlowSessionNV, pExecuteInfo *vk.OpticalFlowExecuteInfoNV) {
+	c.DeviceTable.CmdOpticalFlowExecuteNV.Call(c.CommandBuffer, session, pExecuteInfo)
+}
+
+// GetFramebufferTilePropertiesQCOM calls vkGetFramebufferTilePropertiesQCOM.
+func (d Device) GetFramebufferTilePropertiesQCOM(framebuffer vk.Framebuffer) ([]vk.TilePropertiesQCOM, error) {
+	for {
+		var n uint32
+		if ret := d.DeviceTable.GetFramebufferTilePropertiesQCOM.Call(d.Device, framebuffer, &n, nil); ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		properties := make([]vk.TilePropertiesQCOM, n)
+		for k := range properties {
+			properties[k].SType = vk.STRUCTURE_TYPE_TILE_PROPERTIES_QCOM
+		}
+		ret := d.DeviceTable.GetFramebufferTilePropertiesQCOM.Call(d.Device, framebuffer, &n, &properties[0])
+		if ret == vk.INCOMPLETE {
+			continue // the list grew between the two calls
+		}
+		if ret != vk.SUCCESS {
+			return nil, ret.Err()
+		}
+		return properties[:n], nil
+	}
+}
+
+// GetDynamicRenderingTilePropertiesQCOM calls vkGetDynamicRenderingTilePropertiesQCOM.
+func (d Device) GetDynamicRenderingTilePropertiesQCOM(pRenderingInfo *vk.RenderingInfo, pProperties *vk.TilePropertiesQCOM) error {
+	return d.DeviceTable.GetDynamicRenderingTilePropertiesQCOM.Call(d.Device, pRenderingInfo, pProperties).Err()
+}
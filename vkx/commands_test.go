@@ -0,0 +1,80 @@
+// +build cgo
+
+package vkx
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/toy80/vk"
+	"github.com/toy80/vk/internal/abi"
+)
+
+func TestEnumerateDeviceExtensionProperties(t *testing.T) {
+	p := PhysicalDevice{PhysicalDevice: vk.PhysicalDevice(0x1234), InstanceTable: &InstanceTable{
+		EnumerateDeviceExtensionProperties: vk.PfnEnumerateDeviceExtensionProperties(abi.EnumerateDeviceExtensionProperties),
+	}}
+	abi.Enumerations()
+	props, err := p.EnumerateDeviceExtensionProperties("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, prop := range props {
+		names = append(names, vk.GoStr(&prop.ExtensionName))
+	}
+	if !reflect.DeepEqual(names, []string{"VK_EXT_0", "VK_EXT_1"}) || props[1].SpecVersion != 2 {
+		t.Errorf("extensions = %v, %+v", names, props)
+	}
+	if n := abi.Enumerations(); n != 4 {
+		t.Errorf("%d calls, want 4 with the retry after INCOMPLETE", n)
+	}
+	if got := abi.Args(); !reflect.DeepEqual(got, []uint64{0x1234, 0}) {
+		t.Errorf("C got %#x, want the physical device and no layer name", got)
+	}
+}
+
+func TestAllocateDescriptorSets(t *testing.T) {
+	d := Device{Device: vk.Device(0x1234), DeviceTable: &DeviceTable{
+		AllocateDescriptorSets: vk.PfnAllocateDescriptorSets(abi.AllocateDescriptorSets),
+	}}
+	const pool = vk.DescriptorPool(0x8877665544332210)
+	info := vk.DescriptorSetAllocateInfo{
+		SType:              vk.STRUCTURE_TYPE_DESCRIPTOR_SET_ALLOCATE_INFO,
+		DescriptorPool:     pool,
+		DescriptorSetCount: 3,
+	}
+	sets, err := d.AllocateDescriptorSets(&info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []vk.DescriptorSet{vk.DescriptorSet(pool + 1), vk.DescriptorSet(pool + 2), vk.DescriptorSet(pool + 3)}; !reflect.DeepEqual(sets, want) {
+		t.Errorf("AllocateDescriptorSets() = %#x, want %#x", sets, want)
+	}
+	if got := abi.Args(); !reflect.DeepEqual(got, []uint64{0x1234, uint64(pool), 3}) {
+		t.Errorf("C got %#x", got)
+	}
+}
+
+func TestQueueSubmit(t *testing.T) {
+	q := Queue{Queue: vk.Queue(0x1234), DeviceTable: &DeviceTable{
+		QueueSubmit: vk.PfnQueueSubmit(abi.QueueSubmit),
+	}}
+	const fence = vk.Fence(0xF0E0D0C0B0A09080)
+	submits := []vk.SubmitInfo{
+		{SType: vk.STRUCTURE_TYPE_SUBMIT_INFO, CommandBufferCount: 1},
+		{SType: vk.STRUCTURE_TYPE_SUBMIT_INFO, CommandBufferCount: 2},
+	}
+	if err := q.QueueSubmit(submits, fence); vk.AsResult(err) != vk.ERROR_DEVICE_LOST {
+		t.Errorf("QueueSubmit() = %v, want ERROR_DEVICE_LOST", err)
+	}
+	if got := abi.Args(); !reflect.DeepEqual(got, []uint64{0x1234, 2, uint64(fence), 2}) {
+		t.Errorf("C got %#x", got)
+	}
+	if err := q.QueueSubmit(nil, 0); vk.AsResult(err) != vk.ERROR_DEVICE_LOST {
+		t.Errorf("QueueSubmit(nil) = %v", err)
+	}
+	if got := abi.Args(); !reflect.DeepEqual(got, []uint64{0x1234, 0, 0, 0}) {
+		t.Errorf("C got %#x for no submits", got)
+	}
+}
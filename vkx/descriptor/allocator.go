@@ -0,0 +1,199 @@
+// Package descriptor builds the layouts of descriptor sets, allocates
+// the sets and writes them, the structures given to the driver are put in
+// C memory:
+//
+//   - a LayoutBuilder adds the bindings one call at a time
+//   - an Allocator creates the descriptor pools on demand, sized by ratios
+//     of descriptors per set, and moves on to a new pool when one is full
+//   - Reset frees every set of an Allocator at once, e.g. at the start of
+//     a frame, with one Allocator per frame in flight
+//   - a Writer batches the writes of buffers, images and texel buffer
+//     views into one vkUpdateDescriptorSets
+//
+// An Allocator is safe for concurrent use, a Writer is not.
+package descriptor
+
+import (
+	"math"
+	"sync"
+	"unsafe"
+
+	"github.com/toy80/vk"
+	"github.com/toy80/vk/vkx"
+	"github.com/toy80/vk/vkx/internal/cmem"
+)
+
+// Ratio is the number of descriptors of a type in a pool, per set.
+type Ratio struct {
+	Type   vk.DescriptorType
+	PerSet float32
+}
+
+// DefaultRatios are the ratios of an Allocator when AllocatorInfo has none.
+var DefaultRatios = []Ratio{
+	{vk.DESCRIPTOR_TYPE_SAMPLER, 0.5},
+	{vk.DESCRIPTOR_TYPE_COMBINED_IMAGE_SAMPLER, 4},
+	{vk.DESCRIPTOR_TYPE_SAMPLED_IMAGE, 4},
+	{vk.DESCRIPTOR_TYPE_STORAGE_IMAGE, 1},
+	{vk.DESCRIPTOR_TYPE_UNIFORM_TEXEL_BUFFER, 1},
+	{vk.DESCRIPTOR_TYPE_STORAGE_TEXEL_BUFFER, 1},
+	{vk.DESCRIPTOR_TYPE_UNIFORM_BUFFER, 2},
+	{vk.DESCRIPTOR_TYPE_STORAGE_BUFFER, 2},
+	{vk.DESCRIPTOR_TYPE_UNIFORM_BUFFER_DYNAMIC, 1},
+	{vk.DESCRIPTOR_TYPE_STORAGE_BUFFER_DYNAMIC, 1},
+	{vk.DESCRIPTOR_TYPE_INPUT_ATTACHMENT, 0.5},
+}
+
+const (
+	// DefaultSetsPerPool is the size of the first pool when AllocatorInfo
+	// has none.
+	DefaultSetsPerPool = 64
+	// MaxSetsPerPool is the size of the pools past which they stop growing.
+	MaxSetsPerPool = 4096
+)
+
+// AllocatorInfo describes an Allocator.
+type AllocatorInfo struct {
+	Ratios      []Ratio                      // DefaultRatios if nil
+	SetsPerPool uint32                       // of the first pool, the next ones are twice larger
+	Flags       vk.DescriptorPoolCreateFlags // of the pools, e.g. UPDATE_AFTER_BIND
+}
+
+// Allocator allocates the descriptor sets of a device from pools it
+// creates.
+type Allocator struct {
+	device vkx.Device
+	ratios []Ratio
+	flags  vk.DescriptorPoolCreateFlags
+
+	mu      sync.Mutex
+	sets    uint32 // of the next pool created
+	current vk.DescriptorPool
+	full    []vk.DescriptorPool
+	ready   []vk.DescriptorPool // reset, empty
+}
+
+// NewAllocator returns an Allocator of device, it has no pool yet.
+func NewAllocator(device vkx.Device, info *AllocatorInfo) *Allocator {
+	a := &Allocator{device: device, ratios: info.Ratios, flags: info.Flags, sets: info.SetsPerPool}
+	if a.ratios == nil {
+		a.ratios = DefaultRatios
+	}
+	if a.sets == 0 {
+		a.sets = DefaultSetsPerPool
+	}
+	return a
+}
+
+// poolSizes returns the descriptors of a pool of sets.
+func poolSizes(ratios []Ratio, sets uint32) []vk.DescriptorPoolSize {
+	sizes := make([]vk.DescriptorPoolSize, 0, len(ratios))
+	for _, r := range ratios {
+		if n := uint32(math.Ceil(float64(r.PerSet * float32(sets)))); n > 0 {
+			sizes = append(sizes, vk.DescriptorPoolSize{Type: r.Type, DescriptorCount: n})
+		}
+	}
+	return sizes
+}
+
+// Allocate allocates a set of each layout. The sets are valid until Reset.
+func (a *Allocator) Allocate(layouts ...vk.DescriptorSetLayout) ([]vk.DescriptorSet, error) {
+	if len(layouts) == 0 {
+		return nil, nil
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for {
+		fresh := a.current == 0
+		if fresh {
+			var err error
+			if a.current, err = a.pool(); err != nil {
+				return nil, err
+			}
+		}
+		sets, err := a.allocate(a.current, layouts)
+		switch vk.AsResult(err) {
+		case vk.ERROR_OUT_OF_POOL_MEMORY, vk.ERROR_FRAGMENTED_POOL:
+			if fresh {
+				return nil, err // too large for an empty pool
+			}
+			a.full = append(a.full, a.current)
+			a.current = 0
+			continue
+		}
+		return sets, err
+	}
+}
+
+// pool returns an empty pool, reset or new.
+func (a *Allocator) pool() (vk.DescriptorPool, error) {
+	if n := len(a.ready); n > 0 {
+		p := a.ready[n-1]
+		a.ready = a.ready[:n-1]
+		return p, nil
+	}
+	sizes := poolSizes(a.ratios, a.sets)
+	var m cmem.Arena
+	defer m.Free()
+	info := (*vk.DescriptorPoolCreateInfo)(m.Alloc(1, unsafe.Sizeof(vk.DescriptorPoolCreateInfo{})))
+	info.SType = vk.STRUCTURE_TYPE_DESCRIPTOR_POOL_CREATE_INFO
+	info.Flags, info.MaxSets = a.flags, a.sets
+	if n := len(sizes); n > 0 {
+		p := m.Alloc(n, unsafe.Sizeof(sizes[0]))
+		copy((*[1 << 16]vk.DescriptorPoolSize)(p)[:n:n], sizes)
+		info.PoolSizeCount, info.PPoolSizes = uint32(n), (*vk.DescriptorPoolSize)(p)
+	}
+	pool, err := a.device.CreateDescriptorPool(info)
+	if err == nil && a.sets < MaxSetsPerPool {
+		a.sets *= 2
+	}
+	return pool, err
+}
+
+// allocate allocates the sets from pool.
+func (a *Allocator) allocate(pool vk.DescriptorPool, layouts []vk.DescriptorSetLayout) ([]vk.DescriptorSet, error) {
+	var m cmem.Arena
+	defer m.Free()
+	info := (*vk.DescriptorSetAllocateInfo)(m.Alloc(1, unsafe.Sizeof(vk.DescriptorSetAllocateInfo{})))
+	info.SType = vk.STRUCTURE_TYPE_DESCRIPTOR_SET_ALLOCATE_INFO
+	n := len(layouts)
+	p := m.Alloc(n, unsafe.Sizeof(layouts[0]))
+	copy((*[1 << 16]vk.DescriptorSetLayout)(p)[:n:n], layouts)
+	info.DescriptorPool = pool
+	info.DescriptorSetCount, info.PSetLayouts = uint32(n), (*vk.DescriptorSetLayout)(p)
+	return a.device.AllocateDescriptorSets(info)
+}
+
+// Reset frees every set allocated, the pools are kept for the next ones.
+// The command buffers using the sets must have completed.
+func (a *Allocator) Reset() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.current != 0 {
+		a.full = append(a.full, a.current)
+		a.current = 0
+	}
+	for len(a.full) > 0 {
+		p := a.full[len(a.full)-1]
+		if err := a.device.ResetDescriptorPool(p, 0); err != nil {
+			return err
+		}
+		a.full = a.full[:len(a.full)-1]
+		a.ready = append(a.ready, p)
+	}
+	return nil
+}
+
+// Destroy destroys the pools, and so frees the sets.
+func (a *Allocator) Destroy() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.current != 0 {
+		a.full = append(a.full, a.current)
+		a.current = 0
+	}
+	for _, p := range append(a.full, a.ready...) {
+		a.device.DestroyDescriptorPool(p)
+	}
+	a.full, a.ready = nil, nil
+}
@@ -0,0 +1,183 @@
+// +build cgo
+
+package descriptor
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/toy80/vk"
+	"github.com/toy80/vk/internal/abi"
+	"github.com/toy80/vk/vkx"
+)
+
+// newTestAllocator returns an Allocator of pools of 4 sets on the fake
+// device of package abi.
+func newTestAllocator() *Allocator {
+	abi.Reset()
+	physical := vkx.PhysicalDevice{PhysicalDevice: vk.PhysicalDevice(0x1000), InstanceTable: &vkx.InstanceTable{
+		GetDeviceProcAddr: vk.PfnGetDeviceProcAddr(abi.GetDeviceProcAddr),
+	}}
+	return NewAllocator(physical.NewDevice(vk.Device(0x2000)), &AllocatorInfo{SetsPerPool: 4})
+}
+
+// pools returns the pools created by calls and their sets.
+func pools(calls []abi.Call) (pools []uint64, sets []uint64) {
+	for _, c := range calls {
+		if c.Name == "vkCreateDescriptorPool" {
+			pools = append(pools, c.Args[3])
+			sets = append(sets, c.Args[1])
+		}
+	}
+	return pools, sets
+}
+
+// allocations returns the pools of the calls to vkAllocateDescriptorSets.
+func allocations(calls []abi.Call) []uint64 {
+	var pools []uint64
+	for _, c := range calls {
+		if c.Name == "vkAllocateDescriptorSets" {
+			pools = append(pools, c.Args[0])
+		}
+	}
+	return pools
+}
+
+func TestAllocateRetry(t *testing.T) {
+	a := newTestAllocator()
+	defer a.Destroy()
+	sets, err := a.Allocate(1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	calls := abi.Calls()
+	first, maxSets := pools(calls)
+	if len(first) != 1 || !reflect.DeepEqual(maxSets, []uint64{4}) || len(sets) != 2 {
+		t.Fatalf("first Allocate() = %v, calls %v", sets, calls)
+	}
+	if c := calls[len(calls)-1]; c.Name != "vkAllocateDescriptorSets" || c.Args[0] != first[0] || c.Args[1] != 2 {
+		t.Errorf("allocation %v, want 2 sets of pool %#x", c, first[0])
+	}
+
+	// a full pool is left for a new one, twice larger
+	for _, result := range []vk.Result{vk.ERROR_OUT_OF_POOL_MEMORY, vk.ERROR_FRAGMENTED_POOL} {
+		abi.SetResults("vkAllocateDescriptorSets", int32(result))
+		if _, err := a.Allocate(1); err != nil {
+			t.Fatalf("Allocate() after %v = %v", result, err)
+		}
+		calls = abi.Calls()
+		next, maxSets := pools(calls)
+		if len(next) != 1 || !reflect.DeepEqual(allocations(calls), []uint64{first[0], next[0]}) {
+			t.Errorf("calls after %v: %v, want an allocation from %#x then from a new pool", result, calls, first[0])
+		}
+		if want := uint64(8); result == vk.ERROR_OUT_OF_POOL_MEMORY && maxSets[0] != want {
+			t.Errorf("new pool of %d sets, want %d", maxSets[0], want)
+		}
+		first = next
+	}
+
+	// too large for an empty pool
+	abi.SetResults("vkAllocateDescriptorSets", int32(vk.ERROR_OUT_OF_POOL_MEMORY), int32(vk.ERROR_OUT_OF_POOL_MEMORY))
+	if _, err := a.Allocate(1); vk.AsResult(err) != vk.ERROR_OUT_OF_POOL_MEMORY {
+		t.Errorf("Allocate() failing from a new pool = %v, want ERROR_OUT_OF_POOL_MEMORY", err)
+	}
+	if n := len(allocations(abi.Calls())); n != 2 {
+		t.Errorf("%d allocations, want 2 and no more pools", n)
+	}
+	if sets, err := a.Allocate(); sets != nil || err != nil {
+		t.Errorf("Allocate() of no layout = %v, %v", sets, err)
+	}
+}
+
+func TestReset(t *testing.T) {
+	a := newTestAllocator()
+	// a set from a pool, then one from the next as the first is full
+	allocate := func() {
+		t.Helper()
+		if _, err := a.Allocate(1); err != nil {
+			t.Fatal(err)
+		}
+		abi.SetResults("vkAllocateDescriptorSets", int32(vk.ERROR_OUT_OF_POOL_MEMORY))
+		if _, err := a.Allocate(1); err != nil {
+			t.Fatal(err)
+		}
+	}
+	allocate()
+	created, _ := pools(abi.Calls())
+	if len(created) != 2 {
+		t.Fatalf("%d pools, want 2", len(created))
+	}
+
+	// every pool is reset and reused by the next frame
+	if err := a.Reset(); err != nil {
+		t.Fatal(err)
+	}
+	var reset []uint64
+	for _, c := range abi.Calls() {
+		if c.Name == "vkResetDescriptorPool" {
+			reset = append(reset, c.Args[0])
+		}
+	}
+	if len(reset) != 2 || reset[0] == reset[1] {
+		t.Errorf("reset pools %#x, want %#x", reset, created)
+	}
+	allocate()
+	calls := abi.Calls()
+	if again, _ := pools(calls); len(again) != 0 {
+		t.Errorf("pools %#x created after Reset", again)
+	}
+	if used := allocations(calls); !reflect.DeepEqual(used, []uint64{reset[1], reset[1], reset[0]}) {
+		t.Errorf("allocations from %#x, want the reset pools %#x in turn", used, reset)
+	}
+
+	a.Destroy()
+	var destroyed []uint64
+	for _, c := range abi.Calls() {
+		if c.Name == "vkDestroyDescriptorPool" {
+			destroyed = append(destroyed, c.Args[0])
+		}
+	}
+	if len(destroyed) != 2 {
+		t.Errorf("destroyed pools %#x, want %#x", destroyed, created)
+	}
+}
+
+func TestAllocateConcurrent(t *testing.T) {
+	a := newTestAllocator()
+	defer a.Destroy()
+	const goroutines, each = 8, 50
+	sets := make([][]vk.DescriptorSet, goroutines)
+	var wg sync.WaitGroup
+	for g := range sets {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < each; i++ {
+				if i == each/2 && g == 0 {
+					abi.SetResults("vkAllocateDescriptorSets", int32(vk.ERROR_FRAGMENTED_POOL))
+				}
+				s, err := a.Allocate(vk.DescriptorSetLayout(g + 1))
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				sets[g] = append(sets[g], s...)
+			}
+		}(g)
+	}
+	wg.Wait()
+	seen := map[vk.DescriptorSet]bool{}
+	for _, s := range sets {
+		for _, set := range s {
+			if seen[set] {
+				t.Fatalf("set %#x allocated twice", set)
+			}
+			seen[set] = true
+		}
+	}
+	calls := abi.Calls()
+	if created, _ := pools(calls); len(seen) != goroutines*each || len(created) != 2 {
+		t.Errorf("%d sets from %d pools, want %d from 2", len(seen), len(created), goroutines*each)
+	}
+}
@@ -0,0 +1,83 @@
+package descriptor
+
+import (
+	"reflect"
+	"testing"
+	"unsafe"
+
+	"github.com/toy80/vk"
+	"github.com/toy80/vk/vkx"
+	"github.com/toy80/vk/vkx/internal/cmem"
+)
+
+func TestPoolSizes(t *testing.T) {
+	ratios := []Ratio{
+		{vk.DESCRIPTOR_TYPE_SAMPLER, 0.5},
+		{vk.DESCRIPTOR_TYPE_UNIFORM_BUFFER, 2},
+		{vk.DESCRIPTOR_TYPE_INPUT_ATTACHMENT, 0},
+		{vk.DESCRIPTOR_TYPE_STORAGE_BUFFER, 0.3},
+	}
+	want := []vk.DescriptorPoolSize{
+		{Type: vk.DESCRIPTOR_TYPE_SAMPLER, DescriptorCount: 5},
+		{Type: vk.DESCRIPTOR_TYPE_UNIFORM_BUFFER, DescriptorCount: 20},
+		{Type: vk.DESCRIPTOR_TYPE_STORAGE_BUFFER, DescriptorCount: 3},
+	}
+	if got := poolSizes(ratios, 10); !reflect.DeepEqual(got, want) {
+		t.Errorf("poolSizes() = %v, want %v", got, want)
+	}
+}
+
+func TestLayoutBuilder(t *testing.T) {
+	l := NewLayoutBuilder().
+		Binding(0, vk.DESCRIPTOR_TYPE_UNIFORM_BUFFER, vk.SHADER_STAGE_VERTEX_BIT).
+		Samplers(1, vk.DESCRIPTOR_TYPE_COMBINED_IMAGE_SAMPLER, vk.SHADER_STAGE_FRAGMENT_BIT, 7, 8).
+		Array(2, vk.DESCRIPTOR_TYPE_UNIFORM_BUFFER, 3, vk.SHADER_STAGE_VERTEX_BIT).
+		BindingFlags(vk.DESCRIPTOR_BINDING_PARTIALLY_BOUND_BIT)
+	want := []Ratio{{vk.DESCRIPTOR_TYPE_UNIFORM_BUFFER, 4}, {vk.DESCRIPTOR_TYPE_COMBINED_IMAGE_SAMPLER, 2}}
+	if got := l.Ratios(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Ratios() = %v, want %v", got, want)
+	}
+
+	var m cmem.Arena
+	defer m.Free()
+	info := l.createInfo(&m)
+	bindings := (*[3]vk.DescriptorSetLayoutBinding)(unsafe.Pointer(info.PBindings))
+	if info.BindingCount != 3 || bindings[1].Binding != 1 || bindings[1].DescriptorCount != 2 || bindings[2].DescriptorCount != 3 {
+		t.Fatalf("bindings = %+v", bindings)
+	}
+	if s := (*[2]vk.Sampler)(unsafe.Pointer(bindings[1].PImmutableSamplers)); *s != [2]vk.Sampler{7, 8} || bindings[0].PImmutableSamplers != nil {
+		t.Errorf("immutable samplers = %v", s)
+	}
+	flags := (*vk.DescriptorSetLayoutBindingFlagsCreateInfo)(info.PNext)
+	if f := (*[3]vk.DescriptorBindingFlags)(unsafe.Pointer(flags.PBindingFlags)); flags.BindingCount != 3 || *f != [3]vk.DescriptorBindingFlags{0, 0, vk.DESCRIPTOR_BINDING_PARTIALLY_BOUND_BIT} {
+		t.Errorf("binding flags = %v", f)
+	}
+}
+
+func TestWriter(t *testing.T) {
+	w := NewWriter(vkx.Device{}).
+		Buffer(1, 0, vk.DESCRIPTOR_TYPE_UNIFORM_BUFFER, 10, 0, 64).
+		Image(1, 1, vk.DESCRIPTOR_TYPE_COMBINED_IMAGE_SAMPLER, 20, vk.IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL, 30).
+		Buffers(2, 0, 1, vk.DESCRIPTOR_TYPE_STORAGE_BUFFER, vk.DescriptorBufferInfo{Buffer: 11}, vk.DescriptorBufferInfo{Buffer: 12}).
+		TexelViews(2, 3, 0, vk.DESCRIPTOR_TYPE_UNIFORM_TEXEL_BUFFER, 40).
+		Images(2, 4, 0, vk.DESCRIPTOR_TYPE_SAMPLED_IMAGE)
+
+	var m cmem.Arena
+	defer m.Free()
+	writes := w.fill(&m)
+	if len(writes) != 4 {
+		t.Fatalf("got %d writes, want 4", len(writes))
+	}
+	if x := writes[0]; x.DstSet != 1 || x.PBufferInfo.Buffer != 10 || x.PBufferInfo.Range != 64 || x.PImageInfo != nil {
+		t.Errorf("write 0 = %+v", x)
+	}
+	if x := writes[1]; x.DstBinding != 1 || x.PImageInfo.ImageView != 20 || x.PImageInfo.Sampler != 30 {
+		t.Errorf("write 1 = %+v", x)
+	}
+	if x := writes[2]; x.DstArrayElement != 1 || x.DescriptorCount != 2 || (*[2]vk.DescriptorBufferInfo)(unsafe.Pointer(x.PBufferInfo))[1].Buffer != 12 {
+		t.Errorf("write 2 = %+v", x)
+	}
+	if x := writes[3]; *x.PTexelBufferView != 40 || x.PBufferInfo != nil {
+		t.Errorf("write 3 = %+v", x)
+	}
+}
@@ -0,0 +1,120 @@
+package descriptor
+
+import (
+	"unsafe"
+
+	"github.com/toy80/vk"
+	"github.com/toy80/vk/vkx"
+	"github.com/toy80/vk/vkx/internal/cmem"
+)
+
+// LayoutBuilder collects the bindings of a descriptor set layout:
+//
+//	layout, err := descriptor.NewLayoutBuilder().
+//		Binding(0, vk.DESCRIPTOR_TYPE_UNIFORM_BUFFER, vk.SHADER_STAGE_VERTEX_BIT).
+//		Binding(1, vk.DESCRIPTOR_TYPE_COMBINED_IMAGE_SAMPLER, vk.SHADER_STAGE_FRAGMENT_BIT).
+//		Build(device)
+type LayoutBuilder struct {
+	flags        vk.DescriptorSetLayoutCreateFlags
+	bindings     []vk.DescriptorSetLayoutBinding // without the samplers
+	samplers     [][]vk.Sampler
+	bindingFlags []vk.DescriptorBindingFlags
+}
+
+// NewLayoutBuilder returns a LayoutBuilder without bindings.
+func NewLayoutBuilder() *LayoutBuilder { return new(LayoutBuilder) }
+
+// Flags sets the flags of the layout.
+func (l *LayoutBuilder) Flags(flags vk.DescriptorSetLayoutCreateFlags) *LayoutBuilder {
+	l.flags = flags
+	return l
+}
+
+// Binding adds a binding of one descriptor.
+func (l *LayoutBuilder) Binding(binding uint32, typ vk.DescriptorType, stages vk.ShaderStageFlags) *LayoutBuilder {
+	return l.Array(binding, typ, 1, stages)
+}
+
+// Array adds a binding of count descriptors.
+func (l *LayoutBuilder) Array(binding uint32, typ vk.DescriptorType, count uint32, stages vk.ShaderStageFlags) *LayoutBuilder {
+	l.bindings = append(l.bindings, vk.DescriptorSetLayoutBinding{
+		Binding:         binding,
+		DescriptorType:  typ,
+		DescriptorCount: count,
+		StageFlags:      stages,
+	})
+	l.samplers = append(l.samplers, nil)
+	l.bindingFlags = append(l.bindingFlags, 0)
+	return l
+}
+
+// Samplers adds a binding of SAMPLER or COMBINED_IMAGE_SAMPLER descriptors
+// with immutable samplers, one per descriptor.
+func (l *LayoutBuilder) Samplers(binding uint32, typ vk.DescriptorType, stages vk.ShaderStageFlags, samplers ...vk.Sampler) *LayoutBuilder {
+	l.Array(binding, typ, uint32(len(samplers)), stages)
+	l.samplers[len(l.samplers)-1] = append([]vk.Sampler(nil), samplers...)
+	return l
+}
+
+// BindingFlags sets the flags of the last binding added, e.g.
+// PARTIALLY_BOUND. They need VK_EXT_descriptor_indexing or Vulkan 1.2.
+func (l *LayoutBuilder) BindingFlags(flags vk.DescriptorBindingFlags) *LayoutBuilder {
+	l.bindingFlags[len(l.bindingFlags)-1] = flags
+	return l
+}
+
+// Ratios returns the descriptors of a set of the layout, for the
+// AllocatorInfo of the sets having only this layout.
+func (l *LayoutBuilder) Ratios() []Ratio {
+	var ratios []Ratio
+next:
+	for _, b := range l.bindings {
+		for i := range ratios {
+			if ratios[i].Type == b.DescriptorType {
+				ratios[i].PerSet += float32(b.DescriptorCount)
+				continue next
+			}
+		}
+		ratios = append(ratios, Ratio{b.DescriptorType, float32(b.DescriptorCount)})
+	}
+	return ratios
+}
+
+// Build creates the layout.
+func (l *LayoutBuilder) Build(device vkx.Device) (vk.DescriptorSetLayout, error) {
+	var m cmem.Arena
+	defer m.Free()
+	return device.CreateDescriptorSetLayout(l.createInfo(&m))
+}
+
+func (l *LayoutBuilder) createInfo(m *cmem.Arena) *vk.DescriptorSetLayoutCreateInfo {
+	info := (*vk.DescriptorSetLayoutCreateInfo)(m.Alloc(1, unsafe.Sizeof(vk.DescriptorSetLayoutCreateInfo{})))
+	info.SType = vk.STRUCTURE_TYPE_DESCRIPTOR_SET_LAYOUT_CREATE_INFO
+	info.Flags = l.flags
+	n := len(l.bindings)
+	if n == 0 {
+		return info
+	}
+	bindings := (*[1 << 16]vk.DescriptorSetLayoutBinding)(m.Alloc(n, unsafe.Sizeof(l.bindings[0])))[:n:n]
+	copy(bindings, l.bindings)
+	for i, s := range l.samplers {
+		if len(s) > 0 {
+			p := m.Alloc(len(s), unsafe.Sizeof(s[0]))
+			copy((*[1 << 16]vk.Sampler)(p)[:len(s):len(s)], s)
+			bindings[i].PImmutableSamplers = (*vk.Sampler)(p)
+		}
+	}
+	info.BindingCount, info.PBindings = uint32(n), &bindings[0]
+	for _, f := range l.bindingFlags {
+		if f != 0 {
+			flags := (*vk.DescriptorSetLayoutBindingFlagsCreateInfo)(m.Alloc(1, unsafe.Sizeof(vk.DescriptorSetLayoutBindingFlagsCreateInfo{})))
+			flags.SType = vk.STRUCTURE_TYPE_DESCRIPTOR_SET_LAYOUT_BINDING_FLAGS_CREATE_INFO
+			p := m.Alloc(n, unsafe.Sizeof(f))
+			copy((*[1 << 16]vk.DescriptorBindingFlags)(p)[:n:n], l.bindingFlags)
+			flags.BindingCount, flags.PBindingFlags = uint32(n), (*vk.DescriptorBindingFlags)(p)
+			info.PNext = unsafe.Pointer(flags)
+			break
+		}
+	}
+	return info
+}
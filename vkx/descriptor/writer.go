@@ -0,0 +1,139 @@
+package descriptor
+
+import (
+	"unsafe"
+
+	"github.com/toy80/vk"
+	"github.com/toy80/vk/vkx"
+	"github.com/toy80/vk/vkx/internal/cmem"
+)
+
+// Writer accumulates descriptor writes, Flush makes them in one call to
+// vkUpdateDescriptorSets. A Writer is not safe for concurrent use.
+type Writer struct {
+	device  vkx.Device
+	writes  []write
+	buffers []vk.DescriptorBufferInfo
+	images  []vk.DescriptorImageInfo
+	views   []vk.BufferView
+}
+
+// write is a WriteDescriptorSet, its descriptors are from first in the
+// slice of the Writer for their kind.
+type write struct {
+	set              vk.DescriptorSet
+	binding, element uint32
+	typ              vk.DescriptorType
+	count            uint32
+	kind             kind
+	first            int
+}
+
+type kind int
+
+const (
+	bufferInfo kind = iota
+	imageInfo
+	texelView
+)
+
+// NewWriter returns a Writer of the sets of device.
+func NewWriter(device vkx.Device) *Writer { return &Writer{device: device} }
+
+// Buffers writes infos to binding of set, from the array element.
+func (w *Writer) Buffers(set vk.DescriptorSet, binding, element uint32, typ vk.DescriptorType, infos ...vk.DescriptorBufferInfo) *Writer {
+	if len(infos) == 0 {
+		return w
+	}
+	w.writes = append(w.writes, write{set, binding, element, typ, uint32(len(infos)), bufferInfo, len(w.buffers)})
+	w.buffers = append(w.buffers, infos...)
+	return w
+}
+
+// Buffer writes size bytes of buffer from offset to binding of set.
+func (w *Writer) Buffer(set vk.DescriptorSet, binding uint32, typ vk.DescriptorType, buffer vk.Buffer, offset, size vk.DeviceSize) *Writer {
+	return w.Buffers(set, binding, 0, typ, vk.DescriptorBufferInfo{Buffer: buffer, Offset: offset, Range: size})
+}
+
+// Images writes infos to binding of set, from the array element.
+func (w *Writer) Images(set vk.DescriptorSet, binding, element uint32, typ vk.DescriptorType, infos ...vk.DescriptorImageInfo) *Writer {
+	if len(infos) == 0 {
+		return w
+	}
+	w.writes = append(w.writes, write{set, binding, element, typ, uint32(len(infos)), imageInfo, len(w.images)})
+	w.images = append(w.images, infos...)
+	return w
+}
+
+// Image writes view in layout, with sampler if typ has one, to binding of
+// set.
+func (w *Writer) Image(set vk.DescriptorSet, binding uint32, typ vk.DescriptorType, view vk.ImageView, layout vk.ImageLayout, sampler vk.Sampler) *Writer {
+	return w.Images(set, binding, 0, typ, vk.DescriptorImageInfo{Sampler: sampler, ImageView: view, ImageLayout: layout})
+}
+
+// TexelViews writes views to binding of set, from the array element.
+func (w *Writer) TexelViews(set vk.DescriptorSet, binding, element uint32, typ vk.DescriptorType, views ...vk.BufferView) *Writer {
+	if len(views) == 0 {
+		return w
+	}
+	w.writes = append(w.writes, write{set, binding, element, typ, uint32(len(views)), texelView, len(w.views)})
+	w.views = append(w.views, views...)
+	return w
+}
+
+// Flush makes the writes and forgets them.
+func (w *Writer) Flush() {
+	if len(w.writes) == 0 {
+		return
+	}
+	var m cmem.Arena
+	defer m.Free()
+	w.device.UpdateDescriptorSets(w.fill(&m), nil)
+	w.Reset()
+}
+
+// Reset forgets the writes.
+func (w *Writer) Reset() {
+	w.writes, w.buffers, w.images, w.views = w.writes[:0], w.buffers[:0], w.images[:0], w.views[:0]
+}
+
+// fill returns the writes, in C memory with their descriptors.
+func (w *Writer) fill(m *cmem.Arena) []vk.WriteDescriptorSet {
+	var buffers *[1 << 20]vk.DescriptorBufferInfo
+	if n := len(w.buffers); n > 0 {
+		buffers = (*[1 << 20]vk.DescriptorBufferInfo)(m.Alloc(n, unsafe.Sizeof(w.buffers[0])))
+		copy(buffers[:n:n], w.buffers)
+	}
+	var images *[1 << 20]vk.DescriptorImageInfo
+	if n := len(w.images); n > 0 {
+		images = (*[1 << 20]vk.DescriptorImageInfo)(m.Alloc(n, unsafe.Sizeof(w.images[0])))
+		copy(images[:n:n], w.images)
+	}
+	var views *[1 << 20]vk.BufferView
+	if n := len(w.views); n > 0 {
+		views = (*[1 << 20]vk.BufferView)(m.Alloc(n, unsafe.Sizeof(w.views[0])))
+		copy(views[:n:n], w.views)
+	}
+
+	n := len(w.writes)
+	writes := (*[1 << 16]vk.WriteDescriptorSet)(m.Alloc(n, unsafe.Sizeof(vk.WriteDescriptorSet{})))[:n:n]
+	for i, x := range w.writes {
+		writes[i] = vk.WriteDescriptorSet{
+			SType:           vk.STRUCTURE_TYPE_WRITE_DESCRIPTOR_SET,
+			DstSet:          x.set,
+			DstBinding:      x.binding,
+			DstArrayElement: x.element,
+			DescriptorCount: x.count,
+			DescriptorType:  x.typ,
+		}
+		switch x.kind {
+		case bufferInfo:
+			writes[i].PBufferInfo = &buffers[x.first]
+		case imageInfo:
+			writes[i].PImageInfo = &images[x.first]
+		case texelView:
+			writes[i].PTexelBufferView = &views[x.first]
+		}
+	}
+	return writes
+}
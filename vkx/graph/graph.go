@@ -0,0 +1,374 @@
+// Package graph records the passes of a frame with the barriers between
+// them. The passes say which images and buffers they read and write, and
+// how; the graph then:
+//
+//   - culls the passes whose output nothing uses
+//   - orders the others, moving the independent passes between the
+//     dependent ones so that the barriers stall less
+//   - puts the fewest barriers and layout transitions before each pass,
+//     with vkCmdPipelineBarrier2 if Sync2 is set
+//   - creates the images of CreateImage through Transients, in memory
+//     shared by the images not used at the same time, LAZILY_ALLOCATED
+//     for the attachments if the device has such memory
+//
+// A Graph is built, compiled and recorded by one goroutine, usually anew
+// every frame.
+package graph
+
+import (
+	"fmt"
+
+	"github.com/toy80/vk"
+	"github.com/toy80/vk/vkx"
+	"github.com/toy80/vk/vkx/internal/hazard"
+)
+
+// Usage is how a pass uses a resource.
+type Usage struct {
+	Stage  vk.PipelineStageFlags
+	Access vk.AccessFlags
+	Layout vk.ImageLayout // of an image
+}
+
+// The usual usages.
+var (
+	ColorAttachment = Usage{vk.PIPELINE_STAGE_COLOR_ATTACHMENT_OUTPUT_BIT, vk.ACCESS_COLOR_ATTACHMENT_READ_BIT | vk.ACCESS_COLOR_ATTACHMENT_WRITE_BIT, vk.IMAGE_LAYOUT_COLOR_ATTACHMENT_OPTIMAL}
+	DepthAttachment = Usage{fragmentTests, vk.ACCESS_DEPTH_STENCIL_ATTACHMENT_READ_BIT | vk.ACCESS_DEPTH_STENCIL_ATTACHMENT_WRITE_BIT, vk.IMAGE_LAYOUT_DEPTH_STENCIL_ATTACHMENT_OPTIMAL}
+	DepthRead       = Usage{fragmentTests, vk.ACCESS_DEPTH_STENCIL_ATTACHMENT_READ_BIT, vk.IMAGE_LAYOUT_DEPTH_STENCIL_READ_ONLY_OPTIMAL}
+	InputAttachment = Usage{vk.PIPELINE_STAGE_FRAGMENT_SHADER_BIT, vk.ACCESS_INPUT_ATTACHMENT_READ_BIT, vk.IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL}
+	FragmentSampled = Usage{vk.PIPELINE_STAGE_FRAGMENT_SHADER_BIT, vk.ACCESS_SHADER_READ_BIT, vk.IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL}
+	ComputeSampled  = Usage{vk.PIPELINE_STAGE_COMPUTE_SHADER_BIT, vk.ACCESS_SHADER_READ_BIT, vk.IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL}
+	ComputeRead     = Usage{vk.PIPELINE_STAGE_COMPUTE_SHADER_BIT, vk.ACCESS_SHADER_READ_BIT, vk.IMAGE_LAYOUT_GENERAL}
+	ComputeWrite    = Usage{vk.PIPELINE_STAGE_COMPUTE_SHADER_BIT, vk.ACCESS_SHADER_WRITE_BIT, vk.IMAGE_LAYOUT_GENERAL}
+	TransferSrc     = Usage{vk.PIPELINE_STAGE_TRANSFER_BIT, vk.ACCESS_TRANSFER_READ_BIT, vk.IMAGE_LAYOUT_TRANSFER_SRC_OPTIMAL}
+	TransferDst     = Usage{vk.PIPELINE_STAGE_TRANSFER_BIT, vk.ACCESS_TRANSFER_WRITE_BIT, vk.IMAGE_LAYOUT_TRANSFER_DST_OPTIMAL}
+	Present         = Usage{0, 0, vk.IMAGE_LAYOUT_PRESENT_SRC_KHR}
+	VertexBuffer    = Usage{vk.PIPELINE_STAGE_VERTEX_INPUT_BIT, vk.ACCESS_VERTEX_ATTRIBUTE_READ_BIT, 0}
+	IndexBuffer     = Usage{vk.PIPELINE_STAGE_VERTEX_INPUT_BIT, vk.ACCESS_INDEX_READ_BIT, 0}
+	IndirectBuffer  = Usage{vk.PIPELINE_STAGE_DRAW_INDIRECT_BIT, vk.ACCESS_INDIRECT_COMMAND_READ_BIT, 0}
+	UniformBuffer   = Usage{vk.PIPELINE_STAGE_VERTEX_SHADER_BIT | vk.PIPELINE_STAGE_FRAGMENT_SHADER_BIT | vk.PIPELINE_STAGE_COMPUTE_SHADER_BIT, vk.ACCESS_UNIFORM_READ_BIT, 0}
+)
+
+const fragmentTests = vk.PIPELINE_STAGE_EARLY_FRAGMENT_TESTS_BIT | vk.PIPELINE_STAGE_LATE_FRAGMENT_TESTS_BIT
+
+// Image is an image of a Graph.
+type Image int
+
+// Buffer is a buffer of a Graph.
+type Buffer int
+
+// resource is an image or a buffer of a Graph.
+type resource struct {
+	name        string
+	isImage     bool
+	image       vk.Image
+	subresource vk.ImageSubresourceRange
+	buffer      vk.Buffer
+	create      *ImageInfo // of an image created by the graph
+	view        vk.ImageView
+	initial     Usage
+	final       Usage
+	exported    bool
+}
+
+// use is the use of a resource by a pass.
+type use struct {
+	res   int // in Graph.resources
+	usage Usage
+	write bool
+}
+
+// Pass is a pass of a Graph.
+type Pass struct {
+	g      *Graph
+	name   string
+	uses   []use
+	record func(cmd vkx.CommandBuffer)
+	keep   bool
+}
+
+// Graph is the passes of a frame and the resources they use.
+type Graph struct {
+	// Sync2 makes the barriers go through vkCmdPipelineBarrier2, the
+	// device must have enabled the synchronization2 feature.
+	Sync2 bool
+
+	// Transients creates the images of CreateImage.
+	Transients *Transients
+
+	resources []resource
+	passes    []*Pass
+
+	compiled bool
+	steps    []step
+	final    []barrier // after the passes, to the final usages
+}
+
+// step is a pass to record and the barriers before it.
+type step struct {
+	pass     *Pass
+	barriers []barrier
+}
+
+// New returns an empty Graph.
+func New() *Graph { return new(Graph) }
+
+// ImportImage adds an image used as initial before the graph. The passes
+// use subresource of it.
+func (g *Graph) ImportImage(name string, image vk.Image, subresource vk.ImageSubresourceRange, initial Usage) Image {
+	g.resources = append(g.resources, resource{name: name, isImage: true, image: image, subresource: subresource, initial: initial})
+	return Image(len(g.resources) - 1)
+}
+
+// ImportBuffer adds a buffer used as initial before the graph.
+func (g *Graph) ImportBuffer(name string, buffer vk.Buffer, initial Usage) Buffer {
+	g.resources = append(g.resources, resource{name: name, buffer: buffer, initial: initial})
+	return Buffer(len(g.resources) - 1)
+}
+
+// ExportImage says image is used as final after the graph, the passes
+// writing it are kept.
+func (g *Graph) ExportImage(image Image, final Usage) {
+	r := &g.resources[image]
+	r.final, r.exported = final, true
+}
+
+// ExportBuffer says buffer is used as final after the graph, the passes
+// writing it are kept.
+func (g *Graph) ExportBuffer(buffer Buffer, final Usage) {
+	r := &g.resources[buffer]
+	r.final, r.exported = final, true
+}
+
+// AddPass adds a pass recorded by record. The passes reading a resource
+// read what the passes added before wrote.
+func (g *Graph) AddPass(name string, record func(cmd vkx.CommandBuffer)) *Pass {
+	p := &Pass{g: g, name: name, record: record}
+	g.passes = append(g.passes, p)
+	return p
+}
+
+// Name returns the name of the pass.
+func (p *Pass) Name() string { return p.name }
+
+// Read says the pass reads image as u.
+func (p *Pass) Read(image Image, u Usage) *Pass { return p.use(int(image), u, false) }
+
+// Write says the pass writes image as u, and may read it.
+func (p *Pass) Write(image Image, u Usage) *Pass { return p.use(int(image), u, true) }
+
+// ReadBuffer says the pass reads buffer as u.
+func (p *Pass) ReadBuffer(buffer Buffer, u Usage) *Pass { return p.use(int(buffer), u, false) }
+
+// WriteBuffer says the pass writes buffer as u, and may read it.
+func (p *Pass) WriteBuffer(buffer Buffer, u Usage) *Pass { return p.use(int(buffer), u, true) }
+
+// KeepAlive keeps the pass even if nothing uses its output, e.g. when it
+// writes to the host.
+func (p *Pass) KeepAlive() *Pass {
+	p.keep = true
+	return p
+}
+
+func (p *Pass) use(res int, u Usage, write bool) *Pass {
+	p.uses = append(p.uses, use{res, u, write})
+	return p
+}
+
+// merge returns the uses of p, one per resource.
+func (p *Pass) merge() ([]use, error) {
+	var uses []use
+next:
+	for _, u := range p.uses {
+		for i := range uses {
+			if m := &uses[i]; m.res == u.res {
+				if m.usage.Layout != u.usage.Layout {
+					return nil, fmt.Errorf("graph: pass %s uses %s in layouts %v and %v", p.name, p.g.resources[u.res].name, m.usage.Layout, u.usage.Layout)
+				}
+				m.usage.Stage |= u.usage.Stage
+				m.usage.Access |= u.usage.Access
+				m.write = m.write || u.write
+				continue next
+			}
+		}
+		uses = append(uses, u)
+	}
+	return uses, nil
+}
+
+// Compile culls, orders the passes and computes the barriers. Record
+// compiles the Graph if it has not been.
+func (g *Graph) Compile() error {
+	n := len(g.passes)
+	uses := make([][]use, n)
+	deps := make([][]int, n) // the passes to run before
+	data := make([][]int, n) // the passes whose output is used
+	lastWriter := make([]int, len(g.resources))
+	readers := make([][]int, len(g.resources))
+	for i := range lastWriter {
+		lastWriter[i] = -1
+	}
+	for i, p := range g.passes {
+		var err error
+		if uses[i], err = p.merge(); err != nil {
+			return err
+		}
+		for _, u := range uses[i] {
+			if w := lastWriter[u.res]; w >= 0 {
+				deps[i] = append(deps[i], w)
+				data[i] = append(data[i], w) // a write may keep a part of the content
+			}
+			if u.write {
+				deps[i] = append(deps[i], readers[u.res]...)
+				lastWriter[u.res], readers[u.res] = i, nil
+			} else {
+				readers[u.res] = append(readers[u.res], i)
+			}
+		}
+	}
+
+	// keep the passes the kept ones, and the exported resources, need
+	needed := make([]bool, n)
+	var stack []int
+	for i, p := range g.passes {
+		if p.keep {
+			stack = append(stack, i)
+		}
+	}
+	for r, res := range g.resources {
+		if res.exported && lastWriter[r] >= 0 {
+			stack = append(stack, lastWriter[r])
+		}
+	}
+	for len(stack) > 0 {
+		i := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if !needed[i] {
+			needed[i] = true
+			stack = append(stack, data[i]...)
+		}
+	}
+
+	order := schedule(deps, needed)
+	lives, err := g.lifetimes(order, uses)
+	if err != nil {
+		return err
+	}
+	starts := make([][]alias, len(order))
+	if g.Transients != nil {
+		aliases, err := g.Transients.place(g, lives)
+		if err != nil {
+			return err
+		}
+		for i, l := range lives {
+			starts[l.first] = append(starts[l.first], aliases[i])
+		}
+	}
+
+	g.steps = g.steps[:0]
+	states := make([]hazard.State, len(g.resources))
+	for r, res := range g.resources {
+		states[r] = hazard.Initial(res.initial.Stage, res.initial.Access, res.initial.Layout)
+	}
+	for k, i := range order {
+		for _, a := range starts[k] {
+			states[a.res] = a.initial(states)
+		}
+		s := step{pass: g.passes[i]}
+		for _, u := range uses[i] {
+			if b, ok := states[u.res].Use(u.usage.Stage, u.usage.Access, u.usage.Layout, u.write, g.resources[u.res].isImage); ok {
+				s.barriers = append(s.barriers, barrier{u.res, b})
+			}
+		}
+		g.steps = append(g.steps, s)
+	}
+	g.final = g.final[:0]
+	for r, res := range g.resources {
+		if res.exported {
+			if b, ok := states[r].Use(res.final.Stage, res.final.Access, res.final.Layout, res.final.Access&hazard.Write != 0, res.isImage); ok {
+				g.final = append(g.final, barrier{r, b})
+			}
+		}
+	}
+	if g.Transients != nil {
+		g.Transients.finish(states)
+	}
+	g.compiled = true
+	return nil
+}
+
+// schedule returns the needed passes in an order where each comes after
+// its deps. Of the passes ready to run, the first added not depending on
+// the last one scheduled runs next, so that its barrier waits less.
+func schedule(deps [][]int, needed []bool) []int {
+	n := len(deps)
+	waiting := make([]int, n)
+	next := make([][]int, n)
+	for i := range deps {
+		if !needed[i] {
+			continue
+		}
+		seen := map[int]bool{}
+		for _, d := range deps[i] {
+			if needed[d] && !seen[d] {
+				seen[d] = true
+				waiting[i]++
+				next[d] = append(next[d], i)
+			}
+		}
+	}
+	var ready, order []int
+	for i := 0; i < n; i++ {
+		if needed[i] && waiting[i] == 0 {
+			ready = append(ready, i)
+		}
+	}
+	last := -1
+	before := func(i, j int) bool {
+		if di, dj := dependsOn(deps[i], last), dependsOn(deps[j], last); di != dj {
+			return dj
+		}
+		return i < j
+	}
+	for len(ready) > 0 {
+		pick := 0
+		for k, i := range ready {
+			if before(i, ready[pick]) {
+				pick = k
+			}
+		}
+		last = ready[pick]
+		ready = append(ready[:pick], ready[pick+1:]...)
+		order = append(order, last)
+		for _, i := range next[last] {
+			if waiting[i]--; waiting[i] == 0 {
+				ready = append(ready, i)
+			}
+		}
+	}
+	return order
+}
+
+func dependsOn(deps []int, pass int) bool {
+	for _, d := range deps {
+		if d == pass {
+			return true
+		}
+	}
+	return false
+}
+
+// Passes returns the names of the passes recorded, in order.
+func (g *Graph) Passes() []string {
+	names := make([]string, len(g.steps))
+	for i, s := range g.steps {
+		names[i] = s.pass.name
+	}
+	return names
+}
+
+// barrier is a barrier before a use of a resource.
+type barrier struct {
+	res int
+	hazard.Barrier
+}
@@ -0,0 +1,186 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/toy80/vk"
+	"github.com/toy80/vk/vkx/internal/hazard"
+)
+
+func TestCompile(t *testing.T) {
+	color := vk.ImageSubresourceRange{AspectMask: vk.ImageAspectFlags(vk.IMAGE_ASPECT_COLOR_BIT), LevelCount: 1, LayerCount: 1}
+	depth := vk.ImageSubresourceRange{AspectMask: vk.ImageAspectFlags(vk.IMAGE_ASPECT_DEPTH_BIT), LevelCount: 1, LayerCount: 1}
+	g := New()
+	albedo := g.ImportImage("albedo", 1, color, Usage{})
+	z := g.ImportImage("depth", 2, depth, Usage{})
+	lit := g.ImportImage("lit", 3, color, Usage{})
+	unused := g.ImportImage("unused", 4, color, Usage{})
+	swapchain := g.ImportImage("swapchain", 5, color, Usage{})
+	particles := g.ImportBuffer("particles", 6, Usage{})
+	g.ExportImage(swapchain, Present)
+
+	g.AddPass("gbuffer", nil).Write(albedo, ColorAttachment).Write(z, DepthAttachment)
+	g.AddPass("unused", nil).Read(albedo, FragmentSampled).Write(unused, ColorAttachment)
+	g.AddPass("lighting", nil).Read(albedo, FragmentSampled).Write(lit, ColorAttachment)
+	g.AddPass("particles", nil).WriteBuffer(particles, Usage{vk.PIPELINE_STAGE_COMPUTE_SHADER_BIT, vk.ACCESS_SHADER_WRITE_BIT, 0})
+	g.AddPass("post", nil).Read(lit, FragmentSampled).ReadBuffer(particles, VertexBuffer).Write(swapchain, ColorAttachment)
+	if err := g.Compile(); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := g.Passes(), []string{"gbuffer", "particles", "lighting", "post"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("passes = %v, want %v", got, want)
+	}
+	steps := [][]barrier{
+		{
+			{res: int(albedo), Barrier: hazard.Barrier{DstStage: ColorAttachment.Stage, DstAccess: ColorAttachment.Access, NewLayout: vk.IMAGE_LAYOUT_COLOR_ATTACHMENT_OPTIMAL}},
+			{res: int(z), Barrier: hazard.Barrier{DstStage: DepthAttachment.Stage, DstAccess: DepthAttachment.Access, NewLayout: vk.IMAGE_LAYOUT_DEPTH_STENCIL_ATTACHMENT_OPTIMAL}},
+		},
+		nil,
+		{
+			{res: int(albedo), Barrier: hazard.Barrier{SrcStage: ColorAttachment.Stage, DstStage: FragmentSampled.Stage, SrcAccess: vk.ACCESS_COLOR_ATTACHMENT_WRITE_BIT, DstAccess: FragmentSampled.Access, OldLayout: vk.IMAGE_LAYOUT_COLOR_ATTACHMENT_OPTIMAL, NewLayout: vk.IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL}},
+			{res: int(lit), Barrier: hazard.Barrier{DstStage: ColorAttachment.Stage, DstAccess: ColorAttachment.Access, NewLayout: vk.IMAGE_LAYOUT_COLOR_ATTACHMENT_OPTIMAL}},
+		},
+		{
+			{res: int(lit), Barrier: hazard.Barrier{SrcStage: ColorAttachment.Stage, DstStage: FragmentSampled.Stage, SrcAccess: vk.ACCESS_COLOR_ATTACHMENT_WRITE_BIT, DstAccess: FragmentSampled.Access, OldLayout: vk.IMAGE_LAYOUT_COLOR_ATTACHMENT_OPTIMAL, NewLayout: vk.IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL}},
+			{res: int(particles), Barrier: hazard.Barrier{SrcStage: vk.PIPELINE_STAGE_COMPUTE_SHADER_BIT, DstStage: VertexBuffer.Stage, SrcAccess: vk.ACCESS_SHADER_WRITE_BIT, DstAccess: VertexBuffer.Access}},
+			{res: int(swapchain), Barrier: hazard.Barrier{DstStage: ColorAttachment.Stage, DstAccess: ColorAttachment.Access, NewLayout: vk.IMAGE_LAYOUT_COLOR_ATTACHMENT_OPTIMAL}},
+		},
+	}
+	for i, s := range g.steps {
+		if !reflect.DeepEqual(s.barriers, steps[i]) {
+			t.Errorf("barriers before %s = %+v, want %+v", s.pass.name, s.barriers, steps[i])
+		}
+	}
+	final := []barrier{{res: int(swapchain), Barrier: hazard.Barrier{SrcStage: ColorAttachment.Stage, SrcAccess: vk.ACCESS_COLOR_ATTACHMENT_WRITE_BIT, OldLayout: vk.IMAGE_LAYOUT_COLOR_ATTACHMENT_OPTIMAL, NewLayout: vk.IMAGE_LAYOUT_PRESENT_SRC_KHR}}}
+	if !reflect.DeepEqual(g.final, final) {
+		t.Errorf("final barriers = %+v, want %+v", g.final, final)
+	}
+}
+
+func TestMergeConflict(t *testing.T) {
+	g := New()
+	img := g.ImportImage("img", 1, vk.ImageSubresourceRange{}, Usage{})
+	g.AddPass("p", nil).Read(img, FragmentSampled).Write(img, ColorAttachment).KeepAlive()
+	if err := g.Compile(); err == nil {
+		t.Errorf("no error for two layouts of an image")
+	}
+}
+
+func TestPack(t *testing.T) {
+	// gbuffer 0-1, bloom chain 2, 3, 4, each level half the size
+	spans := []span{
+		{first: 0, last: 1, size: 400},
+		{first: 0, last: 2, size: 400},
+		{first: 2, last: 3, size: 200},
+		{first: 3, last: 4, size: 100},
+		{first: 4, last: 4, size: 50},
+	}
+	aligns := []vk.DeviceSize{256, 256, 256, 256, 16}
+	if size := pack(spans, aligns); size != 912 {
+		t.Errorf("size = %d, want 912", size)
+	}
+	offsets := []vk.DeviceSize{0, 512, 0, 256, 0}
+	for i, s := range spans {
+		if s.offset != offsets[i] {
+			t.Errorf("offset of %d = %d, want %d", i, s.offset, offsets[i])
+		}
+		for j := range spans {
+			if i != j && s.overlaps(spans[j]) {
+				t.Errorf("%d overlaps %d", i, j)
+			}
+		}
+	}
+
+	group := []int{0, 0, 0, 0, 0}
+	after, covered := predecessors(spans, group, 2)
+	if !reflect.DeepEqual(after, []int{0}) || !covered {
+		t.Errorf("predecessors of 2 = %v, %v", after, covered)
+	}
+	after, covered = predecessors(spans, group, 3)
+	if !reflect.DeepEqual(after, []int{0}) || !covered {
+		t.Errorf("predecessors of 3 = %v, %v", after, covered)
+	}
+	after, covered = predecessors(spans, group, 4)
+	if !reflect.DeepEqual(after, []int{0, 2}) || !covered {
+		t.Errorf("predecessors of 4 = %v, %v", after, covered)
+	}
+	group[0], group[2] = 1, 1
+	if after, covered = predecessors(spans, group, 4); after != nil || covered {
+		t.Errorf("predecessors of 4 in another group = %v, %v", after, covered)
+	}
+}
+
+func TestLifetimes(t *testing.T) {
+	g := New()
+	albedo := g.CreateImage("albedo", ImageInfo{Format: vk.FORMAT_R8G8B8A8_UNORM, Width: 4, Height: 4})
+	z := g.CreateImage("depth", ImageInfo{Format: vk.FORMAT_D32_SFLOAT, Width: 4, Height: 4})
+	out := g.ImportImage("out", 1, vk.ImageSubresourceRange{}, Usage{})
+	g.ExportImage(out, Present)
+	g.AddPass("gbuffer", nil).Write(albedo, ColorAttachment).Write(z, DepthAttachment)
+	g.AddPass("lighting", nil).Read(albedo, InputAttachment).Read(z, FragmentSampled).Write(out, ColorAttachment)
+	if err := g.Compile(); err == nil {
+		t.Fatalf("no error without Transients")
+	}
+
+	if g.resources[z].subresource.AspectMask != vk.ImageAspectFlags(vk.IMAGE_ASPECT_DEPTH_BIT) {
+		t.Errorf("aspect of depth = %v", g.resources[z].subresource.AspectMask)
+	}
+	uses := [][]use{g.passes[0].uses, g.passes[1].uses}
+	lives, err := (&Graph{resources: g.resources, Transients: &Transients{}}).lifetimes([]int{0, 1}, uses)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []lifetime{
+		{res: int(albedo), first: 0, last: 1, usage: vk.IMAGE_USAGE_COLOR_ATTACHMENT_BIT | vk.IMAGE_USAGE_INPUT_ATTACHMENT_BIT},
+		{res: int(z), first: 0, last: 1, usage: vk.IMAGE_USAGE_DEPTH_STENCIL_ATTACHMENT_BIT | vk.IMAGE_USAGE_SAMPLED_BIT},
+	}
+	if !reflect.DeepEqual(lives, want) {
+		t.Errorf("lifetimes = %+v, want %+v", lives, want)
+	}
+}
+
+func TestAliasBarrier(t *testing.T) {
+	var states [3]hazard.State
+	access(&states[0], ColorAttachment, true)
+	access(&states[1], ComputeWrite, true)
+	s := alias{res: 2, after: []int{0, 1}}.initial(states[:])
+	b, ok := access(&s, ColorAttachment, true)
+	want := hazard.Barrier{
+		SrcStage:  ColorAttachment.Stage | ComputeWrite.Stage,
+		DstStage:  ColorAttachment.Stage,
+		SrcAccess: vk.ACCESS_COLOR_ATTACHMENT_WRITE_BIT | vk.ACCESS_SHADER_WRITE_BIT,
+		DstAccess: ColorAttachment.Access,
+		NewLayout: vk.IMAGE_LAYOUT_COLOR_ATTACHMENT_OPTIMAL,
+	}
+	if !ok || b != want {
+		t.Errorf("aliasing barrier = %+v, %v, want %+v", b, ok, want)
+	}
+}
+
+func TestFinish(t *testing.T) {
+	p := &plan{group: []int{0, 0}, res: []int{0, 1}, states: make([]hazard.State, 1), used: make([]bool, 1)}
+	tr := &Transients{plan: p}
+	states := make([]hazard.State, 2)
+	access(&states[0], ColorAttachment, true)
+	access(&states[1], ComputeWrite, true)
+	tr.finish(states)
+
+	// the states of the next frame replace those of the last
+	states = make([]hazard.State, 2)
+	access(&states[0], ColorAttachment, true)
+	access(&states[1], FragmentSampled, false)
+	tr.finish(states)
+	var want hazard.State
+	want.Merge(states[0])
+	want.Merge(states[1])
+	if p.states[0] != want || !p.used[0] {
+		t.Errorf("state of the group = %+v, %v, want %+v", p.states[0], p.used[0], want)
+	}
+}
+
+// access makes an access to the image of s as u.
+func access(s *hazard.State, u Usage, write bool) (hazard.Barrier, bool) {
+	return s.Use(u.Stage, u.Access, u.Layout, write, true)
+}
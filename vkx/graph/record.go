@@ -0,0 +1,133 @@
+package graph
+
+import (
+	"unsafe"
+
+	"github.com/toy80/vk"
+	"github.com/toy80/vk/vkx"
+	"github.com/toy80/vk/vkx/internal/cmem"
+)
+
+// Record records the passes into cmd, each after its barriers, then the
+// barriers to the final usages.
+func (g *Graph) Record(cmd vkx.CommandBuffer) error {
+	if !g.compiled {
+		if err := g.Compile(); err != nil {
+			return err
+		}
+	}
+	for _, s := range g.steps {
+		g.barriers(cmd, s.barriers)
+		if s.pass.record != nil {
+			s.pass.record(cmd)
+		}
+	}
+	g.barriers(cmd, g.final)
+	return nil
+}
+
+// barriers records bs in one call.
+func (g *Graph) barriers(cmd vkx.CommandBuffer, bs []barrier) {
+	if len(bs) == 0 {
+		return
+	}
+	if g.Sync2 {
+		g.barriers2(cmd, bs)
+		return
+	}
+	var srcStage, dstStage vk.PipelineStageFlags
+	var images []vk.ImageMemoryBarrier
+	var buffers []vk.BufferMemoryBarrier
+	for _, b := range bs {
+		srcStage |= b.SrcStage
+		dstStage |= b.DstStage
+		r := &g.resources[b.res]
+		switch {
+		case r.isImage:
+			images = append(images, vk.ImageMemoryBarrier{
+				SType:               vk.STRUCTURE_TYPE_IMAGE_MEMORY_BARRIER,
+				SrcAccessMask:       b.SrcAccess,
+				DstAccessMask:       b.DstAccess,
+				OldLayout:           b.OldLayout,
+				NewLayout:           b.NewLayout,
+				SrcQueueFamilyIndex: vk.QUEUE_FAMILY_IGNORED,
+				DstQueueFamilyIndex: vk.QUEUE_FAMILY_IGNORED,
+				Image:               r.image,
+				SubresourceRange:    r.subresource,
+			})
+		case b.SrcAccess != 0:
+			buffers = append(buffers, vk.BufferMemoryBarrier{
+				SType:               vk.STRUCTURE_TYPE_BUFFER_MEMORY_BARRIER,
+				SrcAccessMask:       b.SrcAccess,
+				DstAccessMask:       b.DstAccess,
+				SrcQueueFamilyIndex: vk.QUEUE_FAMILY_IGNORED,
+				DstQueueFamilyIndex: vk.QUEUE_FAMILY_IGNORED,
+				Buffer:              r.buffer,
+				Size:                vk.WHOLE_SIZE,
+			})
+		}
+	}
+	// the stages must not be zero without synchronization2
+	if srcStage == 0 {
+		srcStage = vk.PIPELINE_STAGE_TOP_OF_PIPE_BIT
+	}
+	if dstStage == 0 {
+		dstStage = vk.PIPELINE_STAGE_BOTTOM_OF_PIPE_BIT
+	}
+	cmd.CmdPipelineBarrier(srcStage, dstStage, 0, nil, buffers, images)
+}
+
+// barriers2 records bs with vkCmdPipelineBarrier2, whose flags are the
+// legacy ones widened.
+func (g *Graph) barriers2(cmd vkx.CommandBuffer, bs []barrier) {
+	var m cmem.Arena
+	defer m.Free()
+	info := (*vk.DependencyInfo)(m.Alloc(1, unsafe.Sizeof(vk.DependencyInfo{})))
+	info.SType = vk.STRUCTURE_TYPE_DEPENDENCY_INFO
+	images := (*[1 << 16]vk.ImageMemoryBarrier2)(m.Alloc(len(bs), unsafe.Sizeof(vk.ImageMemoryBarrier2{})))
+	buffers := (*[1 << 16]vk.BufferMemoryBarrier2)(m.Alloc(len(bs), unsafe.Sizeof(vk.BufferMemoryBarrier2{})))
+	var ni, nb int
+	for _, b := range bs {
+		r := &g.resources[b.res]
+		if r.isImage {
+			images[ni] = vk.ImageMemoryBarrier2{
+				SType:               vk.STRUCTURE_TYPE_IMAGE_MEMORY_BARRIER_2,
+				SrcStageMask:        vk.PipelineStageFlags2(b.SrcStage),
+				SrcAccessMask:       vk.AccessFlags2(b.SrcAccess),
+				DstStageMask:        vk.PipelineStageFlags2(b.DstStage),
+				DstAccessMask:       vk.AccessFlags2(b.DstAccess),
+				OldLayout:           b.OldLayout,
+				NewLayout:           b.NewLayout,
+				SrcQueueFamilyIndex: vk.QUEUE_FAMILY_IGNORED,
+				DstQueueFamilyIndex: vk.QUEUE_FAMILY_IGNORED,
+				Image:               r.image,
+				SubresourceRange:    r.subresource,
+			}
+			ni++
+		} else {
+			buffers[nb] = vk.BufferMemoryBarrier2{
+				SType:               vk.STRUCTURE_TYPE_BUFFER_MEMORY_BARRIER_2,
+				SrcStageMask:        vk.PipelineStageFlags2(b.SrcStage),
+				SrcAccessMask:       vk.AccessFlags2(b.SrcAccess),
+				DstStageMask:        vk.PipelineStageFlags2(b.DstStage),
+				DstAccessMask:       vk.AccessFlags2(b.DstAccess),
+				SrcQueueFamilyIndex: vk.QUEUE_FAMILY_IGNORED,
+				DstQueueFamilyIndex: vk.QUEUE_FAMILY_IGNORED,
+				Buffer:              r.buffer,
+				Size:                vk.WHOLE_SIZE,
+			}
+			nb++
+		}
+	}
+	if ni > 0 {
+		info.ImageMemoryBarrierCount, info.PImageMemoryBarriers = uint32(ni), &images[0]
+	}
+	if nb > 0 {
+		info.BufferMemoryBarrierCount, info.PBufferMemoryBarriers = uint32(nb), &buffers[0]
+	}
+	if cmd.DeviceTable.CmdPipelineBarrier2 != 0 {
+		cmd.CmdPipelineBarrier2(info)
+	} else {
+		cmd.CmdPipelineBarrier2KHR(info)
+	}
+}
@@ -0,0 +1,506 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/toy80/vk"
+	"github.com/toy80/vk/vkx"
+	"github.com/toy80/vk/vkx/internal/hazard"
+	"github.com/toy80/vk/vkx/mem"
+)
+
+// ImageInfo describes an image created by a Graph.
+type ImageInfo struct {
+	Format        vk.Format
+	Width, Height uint32
+	Levels        uint32              // of mipmaps, 0 is 1
+	Layers        uint32              // 0 is 1
+	Samples       vk.SampleCountFlags // 0 is 1
+	Usage         vk.ImageUsageFlags  // besides the uses of the passes
+}
+
+// attachmentUsage are the usages an image with TRANSIENT_ATTACHMENT may have.
+const attachmentUsage = vk.IMAGE_USAGE_COLOR_ATTACHMENT_BIT | vk.IMAGE_USAGE_DEPTH_STENCIL_ATTACHMENT_BIT | vk.IMAGE_USAGE_INPUT_ATTACHMENT_BIT
+
+// CreateImage adds an image created by g.Transients, with the usages of the
+// passes. Its content is undefined before the first pass using it, and its
+// memory is shared with the created images no pass uses at the same time.
+// It cannot be exported.
+func (g *Graph) CreateImage(name string, info ImageInfo) Image {
+	if info.Levels == 0 {
+		info.Levels = 1
+	}
+	if info.Layers == 0 {
+		info.Layers = 1
+	}
+	if info.Samples == 0 {
+		info.Samples = vk.SAMPLE_COUNT_1_BIT
+	}
+	g.resources = append(g.resources, resource{
+		name:    name,
+		isImage: true,
+		create:  &info,
+		subresource: vk.ImageSubresourceRange{
+			AspectMask: aspectOf(info.Format),
+			LevelCount: info.Levels,
+			LayerCount: info.Layers,
+		},
+	})
+	return Image(len(g.resources) - 1)
+}
+
+// Image returns the handle of image, of a created one once g is compiled.
+// It is zero if no pass uses the created image.
+func (g *Graph) Image(image Image) vk.Image { return g.resources[image].image }
+
+// View returns the view of the whole of a created image once g is compiled.
+func (g *Graph) View(image Image) vk.ImageView { return g.resources[image].view }
+
+// aspectOf returns the aspects of format.
+func aspectOf(format vk.Format) vk.ImageAspectFlags {
+	switch format {
+	case vk.FORMAT_D16_UNORM, vk.FORMAT_X8_D24_UNORM_PACK32, vk.FORMAT_D32_SFLOAT:
+		return vk.ImageAspectFlags(vk.IMAGE_ASPECT_DEPTH_BIT)
+	case vk.FORMAT_S8_UINT:
+		return vk.ImageAspectFlags(vk.IMAGE_ASPECT_STENCIL_BIT)
+	case vk.FORMAT_D16_UNORM_S8_UINT, vk.FORMAT_D24_UNORM_S8_UINT, vk.FORMAT_D32_SFLOAT_S8_UINT:
+		return vk.ImageAspectFlags(vk.IMAGE_ASPECT_DEPTH_BIT | vk.IMAGE_ASPECT_STENCIL_BIT)
+	}
+	return vk.ImageAspectFlags(vk.IMAGE_ASPECT_COLOR_BIT)
+}
+
+// usageOf returns the image usage needed for u.
+func usageOf(u Usage) vk.ImageUsageFlags {
+	var usage vk.ImageUsageFlags
+	if u.Access&(vk.ACCESS_COLOR_ATTACHMENT_READ_BIT|vk.ACCESS_COLOR_ATTACHMENT_WRITE_BIT) != 0 {
+		usage |= vk.IMAGE_USAGE_COLOR_ATTACHMENT_BIT
+	}
+	if u.Access&(vk.ACCESS_DEPTH_STENCIL_ATTACHMENT_READ_BIT|vk.ACCESS_DEPTH_STENCIL_ATTACHMENT_WRITE_BIT) != 0 {
+		usage |= vk.IMAGE_USAGE_DEPTH_STENCIL_ATTACHMENT_BIT
+	}
+	if u.Access&vk.ACCESS_INPUT_ATTACHMENT_READ_BIT != 0 {
+		usage |= vk.IMAGE_USAGE_INPUT_ATTACHMENT_BIT
+	}
+	if u.Access&(vk.ACCESS_SHADER_READ_BIT|vk.ACCESS_SHADER_WRITE_BIT) != 0 {
+		if u.Layout == vk.IMAGE_LAYOUT_GENERAL {
+			usage |= vk.IMAGE_USAGE_STORAGE_BIT
+		} else {
+			usage |= vk.IMAGE_USAGE_SAMPLED_BIT
+		}
+	}
+	if u.Access&vk.ACCESS_TRANSFER_READ_BIT != 0 {
+		usage |= vk.IMAGE_USAGE_TRANSFER_SRC_BIT
+	}
+	if u.Access&vk.ACCESS_TRANSFER_WRITE_BIT != 0 {
+		usage |= vk.IMAGE_USAGE_TRANSFER_DST_BIT
+	}
+	return usage
+}
+
+// span is the steps using a created image and its range of memory.
+type span struct {
+	first, last  int
+	offset, size vk.DeviceSize
+}
+
+func (s span) overlaps(t span) bool {
+	return s.first <= t.last && t.first <= s.last && s.offset < t.offset+t.size && t.offset < s.offset+s.size
+}
+
+func alignUp(x, align vk.DeviceSize) vk.DeviceSize {
+	if align == 0 {
+		return x
+	}
+	return (x + align - 1) / align * align
+}
+
+// pack sets the offsets of spans in one memory, the largest first at the
+// lowest offset apart from those used at the same time. It returns the size
+// of the memory.
+func pack(spans []span, aligns []vk.DeviceSize) vk.DeviceSize {
+	order := make([]int, len(spans))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return spans[order[i]].size > spans[order[j]].size })
+	var placed []int
+	var size vk.DeviceSize
+	for _, i := range order {
+		s := &spans[i]
+		offsets := []vk.DeviceSize{0}
+		for _, j := range placed {
+			if t := spans[j]; s.first <= t.last && t.first <= s.last {
+				offsets = append(offsets, alignUp(t.offset+t.size, aligns[i]))
+			}
+		}
+		sort.Slice(offsets, func(a, b int) bool { return offsets[a] < offsets[b] })
+	next:
+		for _, o := range offsets {
+			s.offset = o
+			for _, j := range placed {
+				if s.overlaps(spans[j]) {
+					continue next
+				}
+			}
+			break
+		}
+		placed = append(placed, i)
+		if end := s.offset + s.size; end > size {
+			size = end
+		}
+	}
+	return size
+}
+
+// predecessors returns the spans of group[i] ended before spans[i] and
+// sharing its memory, and whether they cover it.
+func predecessors(spans []span, group []int, i int) ([]int, bool) {
+	s := spans[i]
+	var after []int
+	for j, t := range spans {
+		if group[j] == group[i] && t.last < s.first && s.offset < t.offset+t.size && t.offset < s.offset+s.size {
+			after = append(after, j)
+		}
+	}
+	sorted := append([]int(nil), after...)
+	sort.Slice(sorted, func(a, b int) bool { return spans[sorted[a]].offset < spans[sorted[b]].offset })
+	pos := s.offset
+	for _, j := range sorted {
+		if t := spans[j]; t.offset <= pos && t.offset+t.size > pos {
+			pos = t.offset + t.size
+		}
+	}
+	return after, pos >= s.offset+s.size
+}
+
+// lifetime is a created image used by the steps from first to last.
+type lifetime struct {
+	res         int
+	first, last int
+	usage       vk.ImageUsageFlags
+}
+
+// lifetimes returns the lifetimes of the created images used in order.
+func (g *Graph) lifetimes(order []int, uses [][]use) ([]lifetime, error) {
+	index := make([]int, len(g.resources))
+	var lives []lifetime
+	for r, res := range g.resources {
+		index[r] = -1
+		if res.create == nil {
+			continue
+		}
+		if res.exported {
+			return nil, fmt.Errorf("graph: image %s is created by the graph and exported", res.name)
+		}
+		g.resources[r].image, g.resources[r].view = 0, 0
+	}
+	for k, i := range order {
+		for _, u := range uses[i] {
+			if g.resources[u.res].create == nil {
+				continue
+			}
+			if index[u.res] < 0 {
+				index[u.res] = len(lives)
+				lives = append(lives, lifetime{res: u.res, first: k, usage: g.resources[u.res].create.Usage})
+			}
+			l := &lives[index[u.res]]
+			l.last = k
+			l.usage |= usageOf(u.usage)
+		}
+	}
+	sort.Slice(lives, func(i, j int) bool { return lives[i].res < lives[j].res })
+	if len(lives) > 0 && g.Transients == nil {
+		return nil, fmt.Errorf("graph: image %s is created by a graph without Transients", g.resources[lives[0].res].name)
+	}
+	return lives, nil
+}
+
+// alias is the first use of a created image, after those sharing its memory.
+type alias struct {
+	res      int
+	after    []int // the resources ended before
+	previous *hazard.State
+}
+
+// initial returns the state of the resource before its first use.
+func (a alias) initial(states []hazard.State) hazard.State {
+	var s hazard.State
+	for _, r := range a.after {
+		s.Merge(states[r])
+	}
+	if a.previous != nil {
+		s.Merge(*a.previous)
+	}
+	return s
+}
+
+// Transients creates the images of Graphs and their memory. The memory is
+// reused by the next Graph creating the same images used by the same steps,
+// so the Graphs must be recorded in command buffers submitted in order to
+// one queue, and compiled once a frame.
+type Transients struct {
+	device vkx.Device
+	a      *mem.Allocator
+	frames int
+	frame  int
+	lazy   bool // a memory type is LAZILY_ALLOCATED
+
+	plan    *plan
+	retired []*plan
+}
+
+// NewTransients returns the Transients of device, whose memory comes from
+// a. The images and memory replaced are destroyed after framesInFlight
+// frames.
+func NewTransients(device vkx.Device, a *mem.Allocator, framesInFlight int) *Transients {
+	t := &Transients{device: device, a: a, frames: framesInFlight}
+	props := a.MemoryProperties()
+	for i := uint32(0); i < props.MemoryTypeCount; i++ {
+		if props.MemoryTypes[i].PropertyFlags&vk.MEMORY_PROPERTY_LAZILY_ALLOCATED_BIT != 0 {
+			t.lazy = true
+		}
+	}
+	return t
+}
+
+// key is what a plan creates for a created image.
+type key struct {
+	info        ImageInfo
+	usage       vk.ImageUsageFlags
+	first, last int
+}
+
+// plan is the images of a Graph and their memory.
+type plan struct {
+	keys    []key
+	images  []vk.Image
+	views   []vk.ImageView
+	spans   []span
+	group   []int   // of the images
+	after   [][]int // the images whose memory each reuses
+	covered []bool  // whether they cover it
+	allocs  []*mem.Allocation
+	states  []hazard.State // of the groups at the end of the last frame
+	used    []bool         // whether the groups were used by a frame
+	res     []int          // the resources of the images in the last Graph
+	frame   int            // when replaced
+}
+
+// place creates or reuses the images of lives, and returns their aliases.
+func (t *Transients) place(g *Graph, lives []lifetime) ([]alias, error) {
+	t.frame++
+	keys := make([]key, len(lives))
+	for i, l := range lives {
+		keys[i] = key{*g.resources[l.res].create, l.usage, l.first, l.last}
+		if t.lazy && l.usage&^attachmentUsage == 0 {
+			keys[i].usage |= vk.IMAGE_USAGE_TRANSIENT_ATTACHMENT_BIT
+		}
+	}
+	if t.plan == nil || !sameKeys(t.plan.keys, keys) {
+		p, err := t.newPlan(keys)
+		if err != nil {
+			return nil, err
+		}
+		if t.plan != nil {
+			t.plan.frame = t.frame
+			t.retired = append(t.retired, t.plan)
+		}
+		t.plan = p
+	}
+	retired := t.retired[:0]
+	for _, p := range t.retired {
+		if t.frame-p.frame >= t.frames {
+			t.destroy(p)
+		} else {
+			retired = append(retired, p)
+		}
+	}
+	t.retired = retired
+
+	p := t.plan
+	p.res = p.res[:0]
+	aliases := make([]alias, len(lives))
+	for i, l := range lives {
+		r := &g.resources[l.res]
+		r.image, r.view = p.images[i], p.views[i]
+		p.res = append(p.res, l.res)
+	}
+	for i, l := range lives {
+		a := alias{res: l.res}
+		for _, j := range p.after[i] {
+			a.after = append(a.after, lives[j].res)
+		}
+		if k := p.group[i]; !p.covered[i] && p.used[k] {
+			s := p.states[k]
+			a.previous = &s
+		}
+		aliases[i] = a
+	}
+	return aliases, nil
+}
+
+// finish keeps the states of the memory at the end of the frame, those of
+// the images of a group replace the state the group had before.
+func (t *Transients) finish(states []hazard.State) {
+	p := t.plan
+	if p == nil {
+		return
+	}
+	for i := range p.res {
+		p.states[p.group[i]] = hazard.State{}
+	}
+	for i, r := range p.res {
+		p.states[p.group[i]].Merge(states[r])
+		p.used[p.group[i]] = true
+	}
+}
+
+func sameKeys(a, b []key) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// newPlan creates the images of keys and binds them to the memory they
+// share, the TRANSIENT_ATTACHMENT ones have a LAZILY_ALLOCATED memory of
+// their own.
+func (t *Transients) newPlan(keys []key) (p *plan, err error) {
+	p = &plan{keys: keys, spans: make([]span, len(keys)), group: make([]int, len(keys))}
+	defer func() {
+		if err != nil {
+			t.destroy(p)
+		}
+	}()
+	info := vk.NewImageCreateInfo()
+	defer info.Free()
+	reqs := make([]vk.MemoryRequirements, len(keys))
+	for i, k := range keys {
+		info.ImageType = vk.IMAGE_TYPE_2D
+		info.Format = k.info.Format
+		info.Extent = vk.Extent3D{Width: k.info.Width, Height: k.info.Height, Depth: 1}
+		info.MipLevels, info.ArrayLayers = k.info.Levels, k.info.Layers
+		info.Samples = k.info.Samples
+		info.Tiling = vk.IMAGE_TILING_OPTIMAL
+		info.Usage = k.usage
+		info.SharingMode = vk.SHARING_MODE_EXCLUSIVE
+		info.InitialLayout = vk.IMAGE_LAYOUT_UNDEFINED
+		image, err := t.device.CreateImage(info)
+		if err != nil {
+			return p, err
+		}
+		p.images = append(p.images, image)
+		reqs[i] = t.device.GetImageMemoryRequirements(image)
+		p.spans[i] = span{first: k.first, last: k.last, size: reqs[i].Size}
+	}
+
+	// a group of memory per LAZILY_ALLOCATED image and per memory type
+	props := t.a.MemoryProperties()
+	types := map[uint32]int{}
+	for i, k := range keys {
+		if k.usage&vk.IMAGE_USAGE_TRANSIENT_ATTACHMENT_BIT != 0 {
+			if lazy := mem.MemoryTypes(props, reqs[i].MemoryTypeBits, vk.MEMORY_PROPERTY_LAZILY_ALLOCATED_BIT, 0); len(lazy) > 0 {
+				alloc, err := t.a.Allocate(reqs[i], &mem.AllocationInfo{Required: vk.MEMORY_PROPERTY_LAZILY_ALLOCATED_BIT, Dedicated: true}, false)
+				if err != nil {
+					return p, err
+				}
+				p.group[i] = len(p.allocs)
+				p.allocs = append(p.allocs, alloc)
+				continue
+			}
+		}
+		ts := mem.MemoryTypes(props, reqs[i].MemoryTypeBits, 0, vk.MEMORY_PROPERTY_DEVICE_LOCAL_BIT)
+		if len(ts) == 0 {
+			return p, mem.ErrNoMemoryType
+		}
+		g, ok := types[ts[0]]
+		if !ok {
+			g = len(p.allocs)
+			types[ts[0]] = g
+			p.allocs = append(p.allocs, nil)
+		}
+		p.group[i] = g
+	}
+	for memoryType, g := range types {
+		var spans []span
+		var aligns []vk.DeviceSize
+		var index []int
+		var align vk.DeviceSize = 1
+		for i := range keys {
+			if p.group[i] == g {
+				spans = append(spans, p.spans[i])
+				aligns = append(aligns, reqs[i].Alignment)
+				index = append(index, i)
+				if reqs[i].Alignment > align {
+					align = reqs[i].Alignment
+				}
+			}
+		}
+		size := pack(spans, aligns)
+		for k, i := range index {
+			p.spans[i] = spans[k]
+		}
+		req := vk.MemoryRequirements{Size: size, Alignment: align, MemoryTypeBits: 1 << memoryType}
+		if p.allocs[g], err = t.a.Allocate(req, &mem.AllocationInfo{Dedicated: true}, false); err != nil {
+			return p, err
+		}
+	}
+	p.states, p.used = make([]hazard.State, len(p.allocs)), make([]bool, len(p.allocs))
+	p.after, p.covered = make([][]int, len(keys)), make([]bool, len(keys))
+	for i := range keys {
+		p.after[i], p.covered[i] = predecessors(p.spans, p.group, i)
+	}
+
+	view := vk.NewImageViewCreateInfo()
+	defer view.Free()
+	for i, image := range p.images {
+		alloc := p.allocs[p.group[i]]
+		if err = t.device.BindImageMemory(image, alloc.Memory, alloc.Offset+p.spans[i].offset); err != nil {
+			return p, err
+		}
+		k := keys[i]
+		view.Image, view.Format = image, k.info.Format
+		view.ViewType = vk.IMAGE_VIEW_TYPE_2D
+		if k.info.Layers > 1 {
+			view.ViewType = vk.IMAGE_VIEW_TYPE_2D_ARRAY
+		}
+		view.SubresourceRange = vk.ImageSubresourceRange{AspectMask: aspectOf(k.info.Format), LevelCount: k.info.Levels, LayerCount: k.info.Layers}
+		v, err := t.device.CreateImageView(view)
+		if err != nil {
+			return p, err
+		}
+		p.views = append(p.views, v)
+	}
+	return p, nil
+}
+
+// destroy destroys the views, images and memory of p.
+func (t *Transients) destroy(p *plan) {
+	for _, v := range p.views {
+		t.device.DestroyImageView(v)
+	}
+	for _, image := range p.images {
+		t.device.DestroyImage(image)
+	}
+	for _, alloc := range p.allocs {
+		t.a.Free(alloc)
+	}
+}
+
+// Destroy destroys the images and memory, the device must not use them.
+func (t *Transients) Destroy() {
+	for _, p := range t.retired {
+		t.destroy(p)
+	}
+	t.retired = nil
+	if t.plan != nil {
+		t.destroy(t.plan)
+		t.plan = nil
+	}
+}
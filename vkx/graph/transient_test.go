@@ -0,0 +1,214 @@
+// +build cgo
+
+package graph
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/toy80/vk"
+	"github.com/toy80/vk/internal/abi"
+	"github.com/toy80/vk/vkx"
+	"github.com/toy80/vk/vkx/mem"
+)
+
+// lazyTypes are memory types with a LAZILY_ALLOCATED one.
+var lazyTypes = []uint32{
+	uint32(vk.MEMORY_PROPERTY_DEVICE_LOCAL_BIT),
+	uint32(vk.MEMORY_PROPERTY_HOST_VISIBLE_BIT | vk.MEMORY_PROPERTY_HOST_COHERENT_BIT),
+	uint32(vk.MEMORY_PROPERTY_DEVICE_LOCAL_BIT | vk.MEMORY_PROPERTY_LAZILY_ALLOCATED_BIT),
+}
+
+// newTestTransients returns the Transients of the fake device of package
+// abi, of memoryTypes if any.
+func newTestTransients(framesInFlight int, memoryTypes ...uint32) *Transients {
+	abi.Reset()
+	if memoryTypes != nil {
+		abi.SetMemoryTypes(memoryTypes...)
+	}
+	physical := vkx.PhysicalDevice{PhysicalDevice: vk.PhysicalDevice(0x1000), InstanceTable: &vkx.InstanceTable{
+		GetDeviceProcAddr:                 vk.PfnGetDeviceProcAddr(abi.GetDeviceProcAddr),
+		GetPhysicalDeviceProperties:       vk.PfnGetPhysicalDeviceProperties(abi.Proc("vkGetPhysicalDeviceProperties")),
+		GetPhysicalDeviceMemoryProperties: vk.PfnGetPhysicalDeviceMemoryProperties(abi.Proc("vkGetPhysicalDeviceMemoryProperties")),
+	}}
+	device := physical.NewDevice(vk.Device(0x2000))
+	return NewTransients(device, mem.New(physical, device), framesInFlight)
+}
+
+// deferred returns a Graph of t whose gbuffer pass writes albedo, width
+// texels wide, read as an input attachment by the lighting pass writing
+// hdr, sampled by the post pass.
+func deferred(t *Transients, width uint32) (g *Graph, albedo, hdr Image) {
+	g = New()
+	g.Transients = t
+	albedo = g.CreateImage("albedo", ImageInfo{Format: vk.FORMAT_R8G8B8A8_UNORM, Width: width, Height: width})
+	hdr = g.CreateImage("hdr", ImageInfo{Format: vk.FORMAT_R16G16B16A16_SFLOAT, Width: width, Height: width})
+	color := vk.ImageSubresourceRange{AspectMask: vk.ImageAspectFlags(vk.IMAGE_ASPECT_COLOR_BIT), LevelCount: 1, LayerCount: 1}
+	out := g.ImportImage("out", 0x6000, color, Usage{})
+	g.ExportImage(out, Present)
+	g.AddPass("gbuffer", nil).Write(albedo, ColorAttachment)
+	g.AddPass("lighting", nil).Read(albedo, InputAttachment).Write(hdr, ColorAttachment)
+	g.AddPass("post", nil).Read(hdr, FragmentSampled).Write(out, ColorAttachment)
+	return g, albedo, hdr
+}
+
+// named returns the calls named name.
+func named(calls []abi.Call, name string) []abi.Call {
+	var found []abi.Call
+	for _, c := range calls {
+		if c.Name == name {
+			found = append(found, c)
+		}
+	}
+	return found
+}
+
+// handles returns the argument i of the calls named name.
+func handles(calls []abi.Call, name string, i int) []uint64 {
+	var hs []uint64
+	for _, c := range named(calls, name) {
+		hs = append(hs, c.Args[i])
+	}
+	return hs
+}
+
+func TestTransientsLazy(t *testing.T) {
+	tr := newTestTransients(2, lazyTypes...)
+	defer tr.Destroy()
+	g, albedo, hdr := deferred(tr, 4)
+	if err := g.Compile(); err != nil {
+		t.Fatal(err)
+	}
+	calls := abi.Calls()
+	images := named(calls, "vkCreateImage")
+	if len(images) != 2 || uint64(g.Image(albedo)) != images[0].Args[4] || uint64(g.Image(hdr)) != images[1].Args[4] {
+		t.Fatalf("images %v, want albedo then hdr", images)
+	}
+	// only the image used as attachments is TRANSIENT_ATTACHMENT
+	transient := vk.IMAGE_USAGE_COLOR_ATTACHMENT_BIT | vk.IMAGE_USAGE_INPUT_ATTACHMENT_BIT | vk.IMAGE_USAGE_TRANSIENT_ATTACHMENT_BIT
+	if usage := vk.ImageUsageFlags(images[0].Args[3]); usage != transient {
+		t.Errorf("usage of albedo = %#x, want %#x", usage, transient)
+	}
+	if usage := vk.ImageUsageFlags(images[1].Args[3]); usage != vk.IMAGE_USAGE_COLOR_ATTACHMENT_BIT|vk.IMAGE_USAGE_SAMPLED_BIT {
+		t.Errorf("usage of hdr = %#x", usage)
+	}
+
+	// albedo has a LAZILY_ALLOCATED memory of its own, hdr a DEVICE_LOCAL one
+	allocs := named(calls, "vkAllocateMemory")
+	if len(allocs) != 2 || allocs[0].Args[0] != 64 || allocs[0].Args[1] != 2 || allocs[1].Args[1] != 0 {
+		t.Fatalf("allocations %v, want 64 bytes of type 2 then type 0", allocs)
+	}
+	binds := named(calls, "vkBindImageMemory")
+	want := []abi.Call{
+		{Name: "vkBindImageMemory", Args: []uint64{images[0].Args[4], allocs[0].Args[2], 0}},
+		{Name: "vkBindImageMemory", Args: []uint64{images[1].Args[4], allocs[1].Args[2], 0}},
+	}
+	if !reflect.DeepEqual(binds, want) {
+		t.Errorf("binds %v, want %v", binds, want)
+	}
+	if views := handles(calls, "vkCreateImageView", 2); len(views) != 2 || uint64(g.View(albedo)) != views[0] || uint64(g.View(hdr)) != views[1] {
+		t.Errorf("views %#x, want those of albedo and hdr", views)
+	}
+
+	// without LAZILY_ALLOCATED memory, both share one allocation
+	tr2 := newTestTransients(2)
+	defer tr2.Destroy()
+	if g, _, _ = deferred(tr2, 4); g.Compile() != nil {
+		t.Fatal("Compile() failed")
+	}
+	calls = abi.Calls()
+	if usage := vk.ImageUsageFlags(named(calls, "vkCreateImage")[0].Args[3]); usage&vk.IMAGE_USAGE_TRANSIENT_ATTACHMENT_BIT != 0 {
+		t.Errorf("usage of albedo = %#x without LAZILY_ALLOCATED memory", usage)
+	}
+	memory := handles(calls, "vkAllocateMemory", 2)
+	if bound := handles(calls, "vkBindImageMemory", 1); len(memory) != 1 || len(bound) != 2 || bound[0] != memory[0] || bound[1] != memory[0] {
+		t.Errorf("images bound to %#x, want both to the allocation %#x", bound, memory)
+	}
+}
+
+func TestTransientsReuse(t *testing.T) {
+	tr := newTestTransients(2)
+	compile := func(width uint32) (*Graph, Image, []abi.Call) {
+		t.Helper()
+		g, albedo, _ := deferred(tr, width)
+		if err := g.Compile(); err != nil {
+			t.Fatal(err)
+		}
+		return g, albedo, abi.Calls()
+	}
+	g, albedo, calls := compile(4)
+	first, image := handles(calls, "vkCreateImage", 4), g.Image(albedo)
+	memory := handles(calls, "vkAllocateMemory", 2)
+
+	// the same images are reused by the next frame
+	if g, albedo, calls = compile(4); len(calls) != 0 || g.Image(albedo) != image {
+		t.Fatalf("calls of the same graph %v, image %#x, want none and %#x", calls, g.Image(albedo), image)
+	}
+
+	// replaced, they are destroyed once the frames in flight are done
+	var second []uint64
+	for frame := 0; frame < 2; frame++ {
+		g, albedo, calls = compile(8)
+		if destroyed := named(calls, "vkDestroyImage"); len(destroyed) != 0 {
+			t.Fatalf("images destroyed %d frames after their replacement: %v", frame, destroyed)
+		}
+		if frame == 0 {
+			second = handles(calls, "vkCreateImage", 4)
+		}
+		if created := named(calls, "vkCreateImage"); frame == 0 && len(created) != 2 || frame > 0 && len(created) != 0 {
+			t.Fatalf("images created %d frames after the replacement: %v", frame, created)
+		}
+	}
+	_, _, calls = compile(8)
+	if destroyed := handles(calls, "vkDestroyImage", 0); !reflect.DeepEqual(destroyed, first) {
+		t.Errorf("images destroyed %#x, want %#x", destroyed, first)
+	}
+	if freed := handles(calls, "vkFreeMemory", 0); !reflect.DeepEqual(freed, memory) {
+		t.Errorf("memory freed %#x, want %#x", freed, memory)
+	}
+	if n := len(named(calls, "vkDestroyImageView")); n != 2 {
+		t.Errorf("%d views destroyed, want 2", n)
+	}
+
+	tr.Destroy()
+	if destroyed := handles(abi.Calls(), "vkDestroyImage", 0); !reflect.DeepEqual(destroyed, second) {
+		t.Errorf("Destroy() destroyed %#x, want %#x", destroyed, second)
+	}
+}
+
+func TestTransientsError(t *testing.T) {
+	pairs := [][2]string{
+		{"vkCreateImage", "vkDestroyImage"},
+		{"vkCreateImageView", "vkDestroyImageView"},
+		{"vkAllocateMemory", "vkFreeMemory"},
+	}
+	for _, failing := range []string{"vkCreateImage", "vkAllocateMemory", "vkBindImageMemory", "vkCreateImageView"} {
+		tr := newTestTransients(2, lazyTypes...)
+		// the second call fails
+		abi.SetResults(failing, int32(vk.SUCCESS), int32(vk.ERROR_OUT_OF_DEVICE_MEMORY))
+		g, _, _ := deferred(tr, 4)
+		if err := g.Compile(); vk.AsResult(err) != vk.ERROR_OUT_OF_DEVICE_MEMORY {
+			t.Errorf("Compile() with %s failing = %v", failing, err)
+		}
+		calls := abi.Calls()
+		for _, p := range pairs {
+			n := len(named(calls, p[0]))
+			if p[0] == failing {
+				n--
+			}
+			if m := len(named(calls, p[1])); m != n {
+				t.Errorf("with %s failing, %d calls of %s for %d of %s", failing, m, p[1], n, p[0])
+			}
+		}
+
+		// nothing is left to destroy, and the next frame creates the images
+		tr.Destroy()
+		if calls := abi.Calls(); calls != nil {
+			t.Errorf("Destroy() after %s failing calls %v", failing, calls)
+		}
+		if g, _, _ = deferred(tr, 4); g.Compile() != nil || len(named(abi.Calls(), "vkCreateImage")) != 2 {
+			t.Errorf("next frame after %s failing does not create the images", failing)
+		}
+		tr.Destroy()
+	}
+}
@@ -0,0 +1,47 @@
+// Package cmem allocates the C memory of the structures given to the
+// driver. cgo does not let C memory hold Go pointers, nor Go memory passed
+// to C hold pointers to Go memory, so a structure with pointers goes into C
+// memory with what it points to.
+package cmem
+
+import (
+	"unsafe"
+
+	"github.com/toy80/vk"
+)
+
+// Arena is the C memory of the structures given to a call, freed together
+// after it.
+type Arena []unsafe.Pointer
+
+// Alloc returns n zeroed elements of size bytes.
+func (a *Arena) Alloc(n int, size uintptr) unsafe.Pointer {
+	p := vk.MemAlloc(uintptr(n) * size)
+	*a = append(*a, p)
+	return p
+}
+
+// Copy returns a copy of the size bytes at p, which must not hold Go
+// pointers.
+func (a *Arena) Copy(p unsafe.Pointer, size uintptr) unsafe.Pointer {
+	q := a.Alloc(1, size)
+	if size > 0 {
+		copy((*[1 << 30]byte)(q)[:size:size], (*[1 << 30]byte)(p)[:size:size])
+	}
+	return q
+}
+
+// String returns s null-terminated.
+func (a *Arena) String(s string) *int8 {
+	p := a.Alloc(len(s)+1, 1)
+	copy((*[1 << 30]byte)(p)[:len(s):len(s)], s)
+	return (*int8)(p)
+}
+
+// Free frees the memory of a.
+func (a *Arena) Free() {
+	for _, p := range *a {
+		vk.MemFree(p)
+	}
+	*a = nil
+}
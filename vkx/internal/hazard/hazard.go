@@ -0,0 +1,82 @@
+// Package hazard tracks how a resource was last accessed, and finds the
+// barrier its next access needs: after a write, before a write after
+// reads, or for a layout transition. Packages graph and layout keep a
+// State per resource and per subresource.
+package hazard
+
+import "github.com/toy80/vk"
+
+// Write are the accesses writing memory.
+const Write = vk.ACCESS_SHADER_WRITE_BIT | vk.ACCESS_COLOR_ATTACHMENT_WRITE_BIT |
+	vk.ACCESS_DEPTH_STENCIL_ATTACHMENT_WRITE_BIT | vk.ACCESS_TRANSFER_WRITE_BIT | vk.ACCESS_HOST_WRITE_BIT |
+	vk.ACCESS_MEMORY_WRITE_BIT | vk.ACCESS_TRANSFORM_FEEDBACK_WRITE_BIT_EXT |
+	vk.ACCESS_TRANSFORM_FEEDBACK_COUNTER_WRITE_BIT_EXT | vk.ACCESS_ACCELERATION_STRUCTURE_WRITE_BIT_KHR |
+	vk.ACCESS_COMMAND_PREPROCESS_WRITE_BIT_NV
+
+// State is how a resource was last accessed.
+type State struct {
+	Layout        vk.ImageLayout
+	WriteStage    vk.PipelineStageFlags // of the last write or layout transition
+	WriteAccess   vk.AccessFlags        // of the last write
+	ReadStage     vk.PipelineStageFlags // of the reads since
+	VisibleStage  vk.PipelineStageFlags // the last write is visible to
+	VisibleAccess vk.AccessFlags
+}
+
+// Initial returns the state of a resource accessed by access at stage, in
+// layout if an image, e.g. before the commands tracked.
+func Initial(stage vk.PipelineStageFlags, access vk.AccessFlags, layout vk.ImageLayout) State {
+	s := State{Layout: layout}
+	if access&Write != 0 {
+		s.WriteStage, s.WriteAccess = stage, access&Write
+	} else {
+		s.ReadStage = stage
+	}
+	return s
+}
+
+// Merge adds the accesses of t to s, as if they had been made since the
+// last write.
+func (s *State) Merge(t State) {
+	s.WriteStage |= t.WriteStage
+	s.WriteAccess |= t.WriteAccess
+	s.ReadStage |= t.ReadStage
+}
+
+// Barrier is a barrier before an access to a resource.
+type Barrier struct {
+	SrcStage, DstStage   vk.PipelineStageFlags
+	SrcAccess, DstAccess vk.AccessFlags
+	OldLayout, NewLayout vk.ImageLayout
+}
+
+// Use returns the barrier needed before the access to the resource by
+// access at stage, writing it if write, and in layout if an image, if one
+// is. It updates s to after the access. Reads need none once the last
+// write is visible to their stage and access.
+func (s *State) Use(stage vk.PipelineStageFlags, access vk.AccessFlags, layout vk.ImageLayout, write, image bool) (Barrier, bool) {
+	b := Barrier{DstStage: stage, DstAccess: access, OldLayout: s.Layout, NewLayout: s.Layout}
+	transition := image && layout != s.Layout
+	if transition {
+		b.NewLayout = layout
+	}
+	if write || transition {
+		b.SrcStage, b.SrcAccess = s.WriteStage|s.ReadStage, s.WriteAccess
+		needed := transition || b.SrcStage != 0
+		*s = State{Layout: b.NewLayout, WriteStage: stage}
+		if write {
+			s.WriteAccess = access & Write
+		} else {
+			s.ReadStage, s.VisibleStage, s.VisibleAccess = stage, stage, access
+		}
+		return b, needed
+	}
+	s.ReadStage |= stage
+	if s.WriteStage == 0 || stage&^s.VisibleStage == 0 && access&^s.VisibleAccess == 0 {
+		return b, false
+	}
+	b.SrcStage, b.SrcAccess = s.WriteStage, s.WriteAccess
+	s.VisibleStage |= stage
+	s.VisibleAccess |= access
+	return b, true
+}
@@ -0,0 +1,67 @@
+package hazard
+
+import (
+	"testing"
+
+	"github.com/toy80/vk"
+)
+
+func TestUse(t *testing.T) {
+	const (
+		vertex  = vk.PIPELINE_STAGE_VERTEX_INPUT_BIT
+		shaders = vk.PIPELINE_STAGE_VERTEX_SHADER_BIT | vk.PIPELINE_STAGE_FRAGMENT_SHADER_BIT
+	)
+	s := Initial(vk.PIPELINE_STAGE_TRANSFER_BIT, vk.ACCESS_TRANSFER_WRITE_BIT, 0)
+	if b, ok := s.Use(vertex, vk.ACCESS_VERTEX_ATTRIBUTE_READ_BIT, 0, false, false); !ok || b.SrcStage != vk.PIPELINE_STAGE_TRANSFER_BIT || b.DstAccess != vk.ACCESS_VERTEX_ATTRIBUTE_READ_BIT {
+		t.Errorf("first read = %+v, %v", b, ok)
+	}
+	if _, ok := s.Use(vertex, vk.ACCESS_VERTEX_ATTRIBUTE_READ_BIT, 0, false, false); ok {
+		t.Errorf("second read needs a barrier")
+	}
+	if b, ok := s.Use(shaders, vk.ACCESS_UNIFORM_READ_BIT, 0, false, false); !ok || b.DstStage != shaders {
+		t.Errorf("read by other stages = %+v, %v", b, ok)
+	}
+	b, ok := s.Use(vk.PIPELINE_STAGE_TRANSFER_BIT, vk.ACCESS_TRANSFER_WRITE_BIT, 0, true, false)
+	if want := vertex | shaders | vk.PIPELINE_STAGE_TRANSFER_BIT; !ok || b.SrcStage != want || b.SrcAccess != vk.ACCESS_TRANSFER_WRITE_BIT {
+		t.Errorf("write after read = %+v, %v", b, ok)
+	}
+
+	// a first write in the same layout waits for nothing, a transition does
+	s = State{Layout: vk.IMAGE_LAYOUT_GENERAL}
+	if b, ok := s.Use(vk.PIPELINE_STAGE_COMPUTE_SHADER_BIT, vk.ACCESS_SHADER_WRITE_BIT, vk.IMAGE_LAYOUT_GENERAL, true, true); ok {
+		t.Errorf("first write = %+v, want no barrier", b)
+	}
+	b, ok = s.Use(vk.PIPELINE_STAGE_FRAGMENT_SHADER_BIT, vk.ACCESS_SHADER_READ_BIT, vk.IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL, false, true)
+	if !ok || b.OldLayout != vk.IMAGE_LAYOUT_GENERAL || b.NewLayout != vk.IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL || b.SrcAccess != vk.ACCESS_SHADER_WRITE_BIT {
+		t.Errorf("transition = %+v, %v", b, ok)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	// the writes of the extensions are made visible too
+	for _, access := range []vk.AccessFlags{
+		vk.ACCESS_TRANSFORM_FEEDBACK_WRITE_BIT_EXT,
+		vk.ACCESS_TRANSFORM_FEEDBACK_COUNTER_WRITE_BIT_EXT,
+		vk.ACCESS_ACCELERATION_STRUCTURE_WRITE_BIT_KHR,
+		vk.ACCESS_COMMAND_PREPROCESS_WRITE_BIT_NV,
+	} {
+		s := Initial(vk.PIPELINE_STAGE_ALL_COMMANDS_BIT, access, 0)
+		if b, ok := s.Use(vk.PIPELINE_STAGE_COMPUTE_SHADER_BIT, vk.ACCESS_SHADER_READ_BIT, 0, false, false); !ok || b.SrcAccess != access {
+			t.Errorf("read after %#x = %+v, %v", access, b, ok)
+		}
+	}
+}
+
+func TestMerge(t *testing.T) {
+	s := Initial(vk.PIPELINE_STAGE_COMPUTE_SHADER_BIT, vk.ACCESS_SHADER_WRITE_BIT, vk.IMAGE_LAYOUT_GENERAL)
+	s.Merge(Initial(vk.PIPELINE_STAGE_TRANSFER_BIT, vk.ACCESS_TRANSFER_READ_BIT, vk.IMAGE_LAYOUT_TRANSFER_SRC_OPTIMAL))
+	want := State{
+		Layout:      vk.IMAGE_LAYOUT_GENERAL,
+		WriteStage:  vk.PIPELINE_STAGE_COMPUTE_SHADER_BIT,
+		WriteAccess: vk.ACCESS_SHADER_WRITE_BIT,
+		ReadStage:   vk.PIPELINE_STAGE_TRANSFER_BIT,
+	}
+	if s != want {
+		t.Errorf("merged state = %+v, want %+v", s, want)
+	}
+}
@@ -0,0 +1,267 @@
+// Package layout tracks the layouts of images, and how they were last
+// accessed, per mip level and array layer, so that a transition only
+// needs the new layout:
+//
+//   - Access returns the stages and accesses of the usual use of a layout
+//   - the barriers of the transitions queued are recorded together by
+//     Flush, a range of subresources in one state takes one barrier
+//
+// A Tracker is used by one goroutine, with the command buffers submitted in
+// the order they are recorded in.
+package layout
+
+import (
+	"fmt"
+
+	"github.com/toy80/vk"
+	"github.com/toy80/vk/vkx"
+	"github.com/toy80/vk/vkx/internal/hazard"
+)
+
+const (
+	fragmentTests = vk.PIPELINE_STAGE_EARLY_FRAGMENT_TESTS_BIT | vk.PIPELINE_STAGE_LATE_FRAGMENT_TESTS_BIT
+	shaders       = vk.PIPELINE_STAGE_VERTEX_SHADER_BIT | vk.PIPELINE_STAGE_FRAGMENT_SHADER_BIT | vk.PIPELINE_STAGE_COMPUTE_SHADER_BIT
+)
+
+// Access returns the stages and accesses of the usual use of an image in
+// layout. The images in PRESENT_SRC_KHR are acquired and presented with
+// semaphores waited at COLOR_ATTACHMENT_OUTPUT. GENERAL and the unknown
+// layouts take every stage and memory access.
+func Access(layout vk.ImageLayout) (vk.PipelineStageFlags, vk.AccessFlags) {
+	switch layout {
+	case vk.IMAGE_LAYOUT_UNDEFINED:
+		return 0, 0
+	case vk.IMAGE_LAYOUT_PREINITIALIZED:
+		return vk.PIPELINE_STAGE_HOST_BIT, vk.ACCESS_HOST_WRITE_BIT
+	case vk.IMAGE_LAYOUT_COLOR_ATTACHMENT_OPTIMAL:
+		return vk.PIPELINE_STAGE_COLOR_ATTACHMENT_OUTPUT_BIT, vk.ACCESS_COLOR_ATTACHMENT_READ_BIT | vk.ACCESS_COLOR_ATTACHMENT_WRITE_BIT
+	case vk.IMAGE_LAYOUT_DEPTH_STENCIL_ATTACHMENT_OPTIMAL, vk.IMAGE_LAYOUT_DEPTH_ATTACHMENT_OPTIMAL,
+		vk.IMAGE_LAYOUT_STENCIL_ATTACHMENT_OPTIMAL, vk.IMAGE_LAYOUT_DEPTH_READ_ONLY_STENCIL_ATTACHMENT_OPTIMAL,
+		vk.IMAGE_LAYOUT_DEPTH_ATTACHMENT_STENCIL_READ_ONLY_OPTIMAL:
+		return fragmentTests, vk.ACCESS_DEPTH_STENCIL_ATTACHMENT_READ_BIT | vk.ACCESS_DEPTH_STENCIL_ATTACHMENT_WRITE_BIT
+	case vk.IMAGE_LAYOUT_ATTACHMENT_OPTIMAL:
+		return vk.PIPELINE_STAGE_COLOR_ATTACHMENT_OUTPUT_BIT | fragmentTests, vk.ACCESS_COLOR_ATTACHMENT_READ_BIT | vk.ACCESS_COLOR_ATTACHMENT_WRITE_BIT |
+			vk.ACCESS_DEPTH_STENCIL_ATTACHMENT_READ_BIT | vk.ACCESS_DEPTH_STENCIL_ATTACHMENT_WRITE_BIT
+	case vk.IMAGE_LAYOUT_DEPTH_STENCIL_READ_ONLY_OPTIMAL, vk.IMAGE_LAYOUT_DEPTH_READ_ONLY_OPTIMAL,
+		vk.IMAGE_LAYOUT_STENCIL_READ_ONLY_OPTIMAL:
+		return fragmentTests | shaders, vk.ACCESS_DEPTH_STENCIL_ATTACHMENT_READ_BIT | vk.ACCESS_SHADER_READ_BIT
+	case vk.IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL, vk.IMAGE_LAYOUT_READ_ONLY_OPTIMAL:
+		return shaders, vk.ACCESS_SHADER_READ_BIT | vk.ACCESS_INPUT_ATTACHMENT_READ_BIT
+	case vk.IMAGE_LAYOUT_TRANSFER_SRC_OPTIMAL:
+		return vk.PIPELINE_STAGE_TRANSFER_BIT, vk.ACCESS_TRANSFER_READ_BIT
+	case vk.IMAGE_LAYOUT_TRANSFER_DST_OPTIMAL:
+		return vk.PIPELINE_STAGE_TRANSFER_BIT, vk.ACCESS_TRANSFER_WRITE_BIT
+	case vk.IMAGE_LAYOUT_PRESENT_SRC_KHR:
+		return vk.PIPELINE_STAGE_COLOR_ATTACHMENT_OUTPUT_BIT, 0
+	case vk.IMAGE_LAYOUT_FRAGMENT_SHADING_RATE_ATTACHMENT_OPTIMAL_KHR:
+		return vk.PIPELINE_STAGE_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR, vk.ACCESS_FRAGMENT_SHADING_RATE_ATTACHMENT_READ_BIT_KHR
+	case vk.IMAGE_LAYOUT_FRAGMENT_DENSITY_MAP_OPTIMAL_EXT:
+		return vk.PIPELINE_STAGE_FRAGMENT_DENSITY_PROCESS_BIT_EXT, vk.ACCESS_FRAGMENT_DENSITY_MAP_READ_BIT_EXT
+	}
+	return vk.PIPELINE_STAGE_ALL_COMMANDS_BIT, vk.ACCESS_MEMORY_READ_BIT | vk.ACCESS_MEMORY_WRITE_BIT
+}
+
+// state is how a subresource was last accessed.
+type state struct {
+	hazard.State
+	batch   uint64 // of the barrier queued
+	barrier int    // in Tracker.barriers plus one, if queued in the batch
+}
+
+// image is the state of the subresources of an image.
+type image struct {
+	aspect vk.ImageAspectFlags
+	levels uint32
+	layers uint32
+	states []state // by level, then layer
+}
+
+// Tracker tracks the layouts of images.
+type Tracker struct {
+	images   map[vk.Image]*image
+	srcStage vk.PipelineStageFlags
+	dstStage vk.PipelineStageFlags
+	barriers []vk.ImageMemoryBarrier
+	batch    uint64 // of the barriers, incremented by Flush
+}
+
+// New returns a Tracker tracking no image.
+func New() *Tracker { return &Tracker{images: make(map[vk.Image]*image)} }
+
+// Add tracks image, of levels mip levels and layers array layers in layout,
+// e.g. UNDEFINED when it has just been created.
+func (t *Tracker) Add(img vk.Image, aspect vk.ImageAspectFlags, levels, layers uint32, layout vk.ImageLayout) {
+	stage, access := Access(layout)
+	s := state{State: hazard.Initial(stage, access, layout)}
+	im := &image{aspect: aspect, levels: levels, layers: layers, states: make([]state, levels*layers)}
+	for i := range im.states {
+		im.states[i] = s
+	}
+	t.images[img] = im
+}
+
+// Remove stops tracking img.
+func (t *Tracker) Remove(img vk.Image) { delete(t.images, img) }
+
+// Layout returns the layout of a subresource of img, after the transitions
+// queued.
+func (t *Tracker) Layout(img vk.Image, level, layer uint32) (vk.ImageLayout, error) {
+	im, ok := t.images[img]
+	if !ok || level >= im.levels || layer >= im.layers {
+		return 0, fmt.Errorf("layout: subresource %d/%d of image %#x is not tracked", level, layer, img)
+	}
+	return im.states[level*im.layers+layer].Layout, nil
+}
+
+// TransitionImage records the transition of the whole of img to layout into
+// cmd, with the transitions queued before.
+func (t *Tracker) TransitionImage(cmd vkx.CommandBuffer, img vk.Image, layout vk.ImageLayout) error {
+	if err := t.Transition(img, vk.ImageSubresourceRange{LevelCount: vk.REMAINING_MIP_LEVELS, LayerCount: vk.REMAINING_ARRAY_LAYERS}, layout); err != nil {
+		return err
+	}
+	t.Flush(cmd)
+	return nil
+}
+
+// Transition queues the transition of the range r of img to layout, for the
+// usual use of the layout. The AspectMask of r is the one given to Add if
+// it is zero.
+func (t *Tracker) Transition(img vk.Image, r vk.ImageSubresourceRange, layout vk.ImageLayout) error {
+	stage, access := Access(layout)
+	return t.Use(img, r, layout, stage, access)
+}
+
+// Use queues the barrier needed before the range r of img is accessed by
+// access at stage in layout. Reads in the same layout need none, once the
+// last write is visible to their stage and access, nor does an access in
+// the same layout with no earlier access to wait for.
+func (t *Tracker) Use(img vk.Image, r vk.ImageSubresourceRange, layout vk.ImageLayout, stage vk.PipelineStageFlags, access vk.AccessFlags) error {
+	im, ok := t.images[img]
+	if !ok {
+		return fmt.Errorf("layout: image %#x is not tracked", img)
+	}
+	if r.LevelCount == vk.REMAINING_MIP_LEVELS {
+		r.LevelCount = im.levels - r.BaseMipLevel
+	}
+	if r.LayerCount == vk.REMAINING_ARRAY_LAYERS {
+		r.LayerCount = im.layers - r.BaseArrayLayer
+	}
+	if r.BaseMipLevel+r.LevelCount > im.levels || r.BaseArrayLayer+r.LayerCount > im.layers || r.BaseMipLevel >= im.levels || r.BaseArrayLayer >= im.layers {
+		return fmt.Errorf("layout: levels %d+%d, layers %d+%d out of image %#x", r.BaseMipLevel, r.LevelCount, r.BaseArrayLayer, r.LayerCount, img)
+	}
+	if r.AspectMask == 0 {
+		r.AspectMask = im.aspect
+	}
+	for i := range im.states {
+		if s := &im.states[i]; s.batch != t.batch {
+			s.batch, s.barrier = t.batch, 0
+		}
+	}
+	for level := r.BaseMipLevel; level < r.BaseMipLevel+r.LevelCount; level++ {
+		for layer := r.BaseArrayLayer; layer < r.BaseArrayLayer+r.LayerCount; layer++ {
+			if s := im.states[level*im.layers+layer]; s.barrier != 0 && s.Layout != layout {
+				return fmt.Errorf("layout: image %#x is transitioned to %v and %v before a Flush", img, s.Layout, layout)
+			}
+		}
+	}
+	first := len(t.barriers)
+	for level := r.BaseMipLevel; level < r.BaseMipLevel+r.LevelCount; level++ {
+		states := im.states[level*im.layers : (level+1)*im.layers]
+		for layer := r.BaseArrayLayer; layer < r.BaseArrayLayer+r.LayerCount; {
+			old := states[layer]
+			end := layer + 1
+			for end < r.BaseArrayLayer+r.LayerCount && states[end] == old {
+				end++
+			}
+			write := access&hazard.Write != 0
+			if old.barrier != 0 {
+				// the barriers of a batch are not ordered, the queued one
+				// makes the subresources ready for both uses
+				t.barriers[old.barrier-1].DstAccessMask |= access
+				t.dstStage |= stage
+				for i := layer; i < end; i++ {
+					s := &states[i]
+					if write {
+						s.WriteStage |= stage
+						s.WriteAccess |= access & hazard.Write
+						s.VisibleStage, s.VisibleAccess = 0, 0
+					} else {
+						s.ReadStage |= stage
+						s.VisibleStage |= stage
+						s.VisibleAccess |= access
+					}
+				}
+				layer = end
+				continue
+			}
+			next := old
+			if b, ok := next.Use(stage, access, layout, write, true); ok {
+				next.barrier = t.barrier(first, vk.ImageMemoryBarrier{
+					SType:               vk.STRUCTURE_TYPE_IMAGE_MEMORY_BARRIER,
+					SrcAccessMask:       b.SrcAccess,
+					DstAccessMask:       b.DstAccess,
+					OldLayout:           b.OldLayout,
+					NewLayout:           b.NewLayout,
+					SrcQueueFamilyIndex: vk.QUEUE_FAMILY_IGNORED,
+					DstQueueFamilyIndex: vk.QUEUE_FAMILY_IGNORED,
+					Image:               img,
+					SubresourceRange: vk.ImageSubresourceRange{
+						AspectMask:     r.AspectMask,
+						BaseMipLevel:   level,
+						LevelCount:     1,
+						BaseArrayLayer: layer,
+						LayerCount:     end - layer,
+					},
+				})
+				t.srcStage |= b.SrcStage
+				t.dstStage |= stage
+			}
+			for i := layer; i < end; i++ {
+				states[i] = next
+			}
+			layer = end
+		}
+	}
+	return nil
+}
+
+// barrier queues b, or extends by one level a barrier of the same layers
+// and access queued since first. It returns the index of the barrier plus
+// one.
+func (t *Tracker) barrier(first int, b vk.ImageMemoryBarrier) int {
+	for i := first; i < len(t.barriers); i++ {
+		p := &t.barriers[i]
+		pr, br := &p.SubresourceRange, &b.SubresourceRange
+		if p.SrcAccessMask == b.SrcAccessMask && p.OldLayout == b.OldLayout &&
+			pr.BaseArrayLayer == br.BaseArrayLayer && pr.LayerCount == br.LayerCount &&
+			pr.BaseMipLevel+pr.LevelCount == br.BaseMipLevel {
+			pr.LevelCount++
+			return i + 1
+		}
+	}
+	t.barriers = append(t.barriers, b)
+	return len(t.barriers)
+}
+
+// Flush records the barriers of the transitions queued into cmd, in one
+// vkCmdPipelineBarrier.
+func (t *Tracker) Flush(cmd vkx.CommandBuffer) {
+	if len(t.barriers) == 0 {
+		return
+	}
+	src, dst := t.srcStage, t.dstStage
+	if src == 0 {
+		src = vk.PIPELINE_STAGE_TOP_OF_PIPE_BIT
+	}
+	if dst == 0 {
+		dst = vk.PIPELINE_STAGE_BOTTOM_OF_PIPE_BIT
+	}
+	cmd.CmdPipelineBarrier(src, dst, 0, nil, nil, t.barriers)
+	t.reset()
+}
+
+// reset starts a batch of barriers.
+func (t *Tracker) reset() {
+	t.barriers, t.srcStage, t.dstStage = t.barriers[:0], 0, 0
+	t.batch++
+}
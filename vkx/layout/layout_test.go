@@ -0,0 +1,113 @@
+package layout
+
+import (
+	"testing"
+
+	"github.com/toy80/vk"
+)
+
+func TestTracker(t *testing.T) {
+	const img = vk.Image(7)
+	color := vk.ImageAspectFlags(vk.IMAGE_ASPECT_COLOR_BIT)
+	tr := New()
+	tr.Add(img, color, 4, 2, vk.IMAGE_LAYOUT_UNDEFINED)
+
+	// upload, then generate the mip chain
+	if err := tr.Transition(img, vk.ImageSubresourceRange{LevelCount: vk.REMAINING_MIP_LEVELS, LayerCount: vk.REMAINING_ARRAY_LAYERS}, vk.IMAGE_LAYOUT_TRANSFER_DST_OPTIMAL); err != nil {
+		t.Fatal(err)
+	}
+	if len(tr.barriers) != 1 || tr.barriers[0].SubresourceRange != (vk.ImageSubresourceRange{AspectMask: color, LevelCount: 4, LayerCount: 2}) || tr.srcStage != 0 || tr.dstStage != vk.PIPELINE_STAGE_TRANSFER_BIT {
+		t.Fatalf("barriers to TRANSFER_DST = %+v", tr.barriers)
+	}
+	tr.reset()
+	if err := tr.Transition(img, vk.ImageSubresourceRange{LevelCount: 1, LayerCount: 2}, vk.IMAGE_LAYOUT_TRANSFER_SRC_OPTIMAL); err != nil {
+		t.Fatal(err)
+	}
+	if b := tr.barriers[0]; len(tr.barriers) != 1 || b.SrcAccessMask != vk.ACCESS_TRANSFER_WRITE_BIT || b.DstAccessMask != vk.ACCESS_TRANSFER_READ_BIT || b.SubresourceRange.LevelCount != 1 {
+		t.Fatalf("barriers to TRANSFER_SRC = %+v", tr.barriers)
+	}
+	tr.reset()
+	if err := tr.Transition(img, vk.ImageSubresourceRange{LevelCount: vk.REMAINING_MIP_LEVELS, LayerCount: vk.REMAINING_ARRAY_LAYERS}, vk.IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL); err != nil {
+		t.Fatal(err)
+	}
+	if len(tr.barriers) != 2 {
+		t.Fatalf("barriers to SHADER_READ_ONLY = %+v", tr.barriers)
+	}
+	if b := tr.barriers[0]; b.OldLayout != vk.IMAGE_LAYOUT_TRANSFER_SRC_OPTIMAL || b.SrcAccessMask != 0 || b.SubresourceRange.LevelCount != 1 {
+		t.Errorf("barrier of level 0 = %+v", b)
+	}
+	if b := tr.barriers[1]; b.OldLayout != vk.IMAGE_LAYOUT_TRANSFER_DST_OPTIMAL || b.SubresourceRange.BaseMipLevel != 1 || b.SubresourceRange.LevelCount != 3 || b.SubresourceRange.LayerCount != 2 {
+		t.Errorf("barrier of levels 1-3 = %+v", b)
+	}
+	if l, err := tr.Layout(img, 3, 1); err != nil || l != vk.IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL {
+		t.Errorf("layout = %v, %v", l, err)
+	}
+
+	// reads after reads, then twice the same layout in a batch
+	tr.reset()
+	if err := tr.Transition(img, vk.ImageSubresourceRange{LevelCount: vk.REMAINING_MIP_LEVELS, LayerCount: vk.REMAINING_ARRAY_LAYERS}, vk.IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL); err != nil || len(tr.barriers) != 0 {
+		t.Errorf("barriers of a read after a read = %+v, %v", tr.barriers, err)
+	}
+	r := vk.ImageSubresourceRange{BaseMipLevel: 1, LevelCount: 1, LayerCount: 1}
+	tr.Use(img, r, vk.IMAGE_LAYOUT_GENERAL, vk.PIPELINE_STAGE_COMPUTE_SHADER_BIT, vk.ACCESS_SHADER_READ_BIT)
+	tr.Use(img, r, vk.IMAGE_LAYOUT_GENERAL, vk.PIPELINE_STAGE_TRANSFER_BIT, vk.ACCESS_TRANSFER_WRITE_BIT)
+	if len(tr.barriers) != 1 || tr.barriers[0].DstAccessMask != vk.ACCESS_SHADER_READ_BIT|vk.ACCESS_TRANSFER_WRITE_BIT || tr.dstStage != vk.PIPELINE_STAGE_COMPUTE_SHADER_BIT|vk.PIPELINE_STAGE_TRANSFER_BIT {
+		t.Errorf("barriers of two uses = %+v", tr.barriers)
+	}
+	if err := tr.Transition(img, r, vk.IMAGE_LAYOUT_TRANSFER_SRC_OPTIMAL); err == nil {
+		t.Errorf("no error for two layouts in a batch")
+	}
+	if err := tr.Transition(9, r, vk.IMAGE_LAYOUT_GENERAL); err == nil {
+		t.Errorf("no error for an image not tracked")
+	}
+}
+
+func TestReadsOfWrite(t *testing.T) {
+	const img = vk.Image(7)
+	r := vk.ImageSubresourceRange{LevelCount: 1, LayerCount: 1}
+	tr := New()
+	tr.Add(img, vk.ImageAspectFlags(vk.IMAGE_ASPECT_COLOR_BIT), 1, 1, vk.IMAGE_LAYOUT_TRANSFER_DST_OPTIMAL)
+
+	tr.Use(img, r, vk.IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL, vk.PIPELINE_STAGE_FRAGMENT_SHADER_BIT, vk.ACCESS_SHADER_READ_BIT)
+	if b := tr.barriers[0]; len(tr.barriers) != 1 || b.SrcAccessMask != vk.ACCESS_TRANSFER_WRITE_BIT || tr.srcStage != vk.PIPELINE_STAGE_TRANSFER_BIT {
+		t.Fatalf("barriers of the first read = %+v", tr.barriers)
+	}
+	tr.reset()
+	tr.Use(img, r, vk.IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL, vk.PIPELINE_STAGE_COMPUTE_SHADER_BIT, vk.ACCESS_SHADER_READ_BIT)
+	if b := tr.barriers; len(b) != 1 || b[0].OldLayout != b[0].NewLayout || tr.srcStage != vk.PIPELINE_STAGE_FRAGMENT_SHADER_BIT || tr.dstStage != vk.PIPELINE_STAGE_COMPUTE_SHADER_BIT {
+		t.Fatalf("barriers of a read by another stage = %+v", b)
+	}
+	tr.reset()
+	tr.Use(img, r, vk.IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL, vk.PIPELINE_STAGE_FRAGMENT_SHADER_BIT, vk.ACCESS_SHADER_READ_BIT)
+	tr.Use(img, r, vk.IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL, vk.PIPELINE_STAGE_COMPUTE_SHADER_BIT, vk.ACCESS_SHADER_READ_BIT)
+	if len(tr.barriers) != 0 {
+		t.Errorf("barriers of reads the write is visible to = %+v", tr.barriers)
+	}
+
+	// a write in the layout, then a read
+	tr.Use(img, r, vk.IMAGE_LAYOUT_GENERAL, vk.PIPELINE_STAGE_COMPUTE_SHADER_BIT, vk.ACCESS_SHADER_WRITE_BIT)
+	tr.reset()
+	tr.Use(img, r, vk.IMAGE_LAYOUT_GENERAL, vk.PIPELINE_STAGE_FRAGMENT_SHADER_BIT, vk.ACCESS_SHADER_READ_BIT)
+	if b := tr.barriers; len(b) != 1 || b[0].SrcAccessMask != vk.ACCESS_SHADER_WRITE_BIT || tr.srcStage != vk.PIPELINE_STAGE_COMPUTE_SHADER_BIT {
+		t.Errorf("barriers of a read after a write = %+v", b)
+	}
+}
+
+func TestWriteAccess(t *testing.T) {
+	const img = vk.Image(7)
+	r := vk.ImageSubresourceRange{LevelCount: 1, LayerCount: 1}
+	tr := New()
+	tr.Add(img, vk.ImageAspectFlags(vk.IMAGE_ASPECT_COLOR_BIT), 1, 1, vk.IMAGE_LAYOUT_UNDEFINED)
+
+	// a first write in the layout waits for nothing
+	tr.Use(img, r, vk.IMAGE_LAYOUT_UNDEFINED, vk.PIPELINE_STAGE_TRANSFORM_FEEDBACK_BIT_EXT, vk.ACCESS_TRANSFORM_FEEDBACK_WRITE_BIT_EXT)
+	if len(tr.barriers) != 0 {
+		t.Errorf("barriers of a first write = %+v", tr.barriers)
+	}
+
+	// the writes of the extensions are made visible to the reads
+	tr.Use(img, r, vk.IMAGE_LAYOUT_UNDEFINED, vk.PIPELINE_STAGE_DRAW_INDIRECT_BIT, vk.ACCESS_INDIRECT_COMMAND_READ_BIT)
+	if b := tr.barriers; len(b) != 1 || b[0].SrcAccessMask != vk.ACCESS_TRANSFORM_FEEDBACK_WRITE_BIT_EXT || tr.srcStage != vk.PIPELINE_STAGE_TRANSFORM_FEEDBACK_BIT_EXT {
+		t.Errorf("barriers of a read after a transform feedback write = %+v", b)
+	}
+}
@@ -0,0 +1,210 @@
+// +build cgo
+
+package mem
+
+import (
+	"testing"
+
+	"github.com/toy80/vk"
+	"github.com/toy80/vk/internal/abi"
+	"github.com/toy80/vk/vkx"
+)
+
+const (
+	local   = vk.MEMORY_PROPERTY_DEVICE_LOCAL_BIT
+	visible = vk.MEMORY_PROPERTY_HOST_VISIBLE_BIT | vk.MEMORY_PROPERTY_HOST_COHERENT_BIT
+)
+
+// newTestAllocator returns an Allocator of a heap of 1 MiB, blocks of 128
+// KiB, with a local and a host visible memory type.
+func newTestAllocator(t *testing.T) *Allocator {
+	device := vkx.Device{Device: vk.Device(1), DeviceTable: &vkx.DeviceTable{
+		AllocateMemory: vk.PfnAllocateMemory(abi.AllocateDeviceMemory),
+		FreeMemory:     vk.PfnFreeMemory(abi.FreeDeviceMemory),
+		MapMemory:      vk.PfnMapMemory(abi.MapDeviceMemory),
+		UnmapMemory:    vk.PfnUnmapMemory(abi.UnmapDeviceMemory),
+	}}
+	props := vk.PhysicalDeviceMemoryProperties{MemoryTypeCount: 2, MemoryHeapCount: 1}
+	props.MemoryTypes[0].PropertyFlags = local
+	props.MemoryTypes[1].PropertyFlags = visible
+	props.MemoryHeaps[0].Size = 1 << 20
+	a := newAllocator(device, props, &vk.PhysicalDeviceLimits{BufferImageGranularity: 1024, NonCoherentAtomSize: 64})
+	if allocated, mapped := abi.Memory(); allocated != 0 || mapped != 0 {
+		t.Fatalf("%d DeviceMemory allocated and %d mapped before the test", allocated, mapped)
+	}
+	return a
+}
+
+func checkMemory(t *testing.T, allocated, mapped int) {
+	t.Helper()
+	if a, m := abi.Memory(); a != allocated || m != mapped {
+		t.Errorf("%d DeviceMemory allocated and %d mapped, want %d and %d", a, m, allocated, mapped)
+	}
+}
+
+func TestAllocate(t *testing.T) {
+	a := newTestAllocator(t)
+	small := vk.MemoryRequirements{Size: 1000, Alignment: 256, MemoryTypeBits: 3}
+	x, err := a.Allocate(small, &AllocationInfo{Required: local}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	y, err := a.Allocate(small, &AllocationInfo{Required: local}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x.Dedicated() || y.Memory != x.Memory || y.Offset != 1024 || x.MemoryType != 0 {
+		t.Errorf("allocations of a block = %+v, %+v", x, y)
+	}
+	large, err := a.Allocate(vk.MemoryRequirements{Size: 100 << 10, MemoryTypeBits: 3}, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	own, err := a.Allocate(small, &AllocationInfo{Dedicated: true}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !large.Dedicated() || !own.Dedicated() {
+		t.Errorf("allocations of a DeviceMemory of their own = %+v, %+v", large, own)
+	}
+	checkMemory(t, 3, 0)
+
+	s := a.Stats()
+	want := Stats{Blocks: 3, Allocations: 4, BlockBytes: 128<<10 + 100<<10 + 1000, AllocatedBytes: 3000 + 100<<10}
+	if s.Total != want || s.MemoryType[0] != want || s.MemoryHeap[0] != want {
+		t.Errorf("Stats() = %+v, want %+v", s.Total, want)
+	}
+	if _, err := a.Allocate(small, &AllocationInfo{Required: vk.MEMORY_PROPERTY_HOST_CACHED_BIT}, true); err != ErrNoMemoryType {
+		t.Errorf("error of a missing memory type = %v", err)
+	}
+
+	for _, alloc := range []*Allocation{x, y, large, own} {
+		a.Free(alloc)
+	}
+	checkMemory(t, 1, 0) // the empty block is kept
+	if s := a.Stats(); s.Total != (Stats{Blocks: 1, BlockBytes: 128 << 10}) {
+		t.Errorf("Stats() after Free = %+v", s.Total)
+	}
+	a.Destroy()
+	checkMemory(t, 0, 0)
+}
+
+func TestMap(t *testing.T) {
+	a := newTestAllocator(t)
+	defer a.Destroy()
+	req := vk.MemoryRequirements{Size: 100, Alignment: 16, MemoryTypeBits: 3}
+	x, err := a.Allocate(req, &AllocationInfo{Required: visible}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	y, err := a.Allocate(req, &AllocationInfo{Mapped: true}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if y.Mapped == nil || y.Memory != x.Memory {
+		t.Fatalf("persistently mapped allocation = %+v", y)
+	}
+	checkMemory(t, 1, 1)
+
+	p, err := a.Map(x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uintptr(y.Mapped)-uintptr(p) != uintptr(y.Offset-x.Offset) {
+		t.Errorf("addresses of the allocations of a block %p and %p", p, y.Mapped)
+	}
+	checkMemory(t, 1, 1) // the block once
+	a.Unmap(x)
+	a.Unmap(y)
+	if x.Mapped != nil || y.Mapped == nil {
+		t.Errorf("mapped after Unmap: %p, %p", x.Mapped, y.Mapped)
+	}
+	checkMemory(t, 1, 1)
+
+	own, err := a.Allocate(req, &AllocationInfo{Required: visible, Dedicated: true}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := a.Map(own); err != nil {
+		t.Fatal(err)
+	}
+	checkMemory(t, 2, 2)
+	a.Unmap(own)
+	checkMemory(t, 2, 1)
+	a.Free(own)
+	a.Free(y)
+	a.Free(x)
+	checkMemory(t, 1, 0)
+}
+
+func TestPoolFree(t *testing.T) {
+	a := newTestAllocator(t)
+	defer a.Destroy()
+	p, err := a.NewPool(PoolInfo{MemoryType: 0, BlockSize: 4096, MaxBlocks: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := vk.MemoryRequirements{Size: 4096, Alignment: 256, MemoryTypeBits: 1}
+	var allocs []*Allocation
+	for i := 0; i < 3; i++ {
+		alloc, err := a.Allocate(req, &AllocationInfo{Pool: p}, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		allocs = append(allocs, alloc)
+	}
+	if _, err := a.Allocate(req, &AllocationInfo{Pool: p}, true); vk.AsResult(err) != vk.ERROR_OUT_OF_DEVICE_MEMORY {
+		t.Errorf("error past MaxBlocks = %v", err)
+	}
+	if s := p.Stats(); s != (Stats{Blocks: 3, Allocations: 3, BlockBytes: 3 * 4096, AllocatedBytes: 3 * 4096}) {
+		t.Errorf("Stats() = %+v", s)
+	}
+	for _, alloc := range allocs {
+		a.Free(alloc)
+	}
+	if s := p.Stats(); s != (Stats{Blocks: 1, BlockBytes: 4096}) {
+		t.Errorf("Stats() after Free = %+v", s)
+	}
+	checkMemory(t, 1, 0)
+	p.Destroy()
+	checkMemory(t, 0, 0)
+}
+
+func TestAllocateFailure(t *testing.T) {
+	a := newTestAllocator(t)
+	defer a.Destroy()
+	if _, err := a.Allocate(vk.MemoryRequirements{MemoryTypeBits: 3}, nil, true); err != ErrZeroSize {
+		t.Errorf("error of zero bytes = %v", err)
+	}
+	p, err := a.NewPool(PoolInfo{MemoryType: 0, BlockSize: 8 << 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Destroy()
+	defer abi.SetMemoryLimit(0)
+	req := vk.MemoryRequirements{Size: 1000, Alignment: 256, MemoryTypeBits: 1}
+
+	abi.SetMemoryLimit(2 << 20)
+	x, err := a.Allocate(req, &AllocationInfo{Pool: p}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := abi.Args()[0]; got != 2<<20 {
+		t.Errorf("block of %d bytes, want the halved 2 MiB", got)
+	}
+	a.Free(x)
+
+	abi.SetMemoryLimit(minBlockSize / 2)
+	q, err := a.NewPool(PoolInfo{MemoryType: 0, BlockSize: 8 << 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer q.Destroy()
+	if _, err := a.Allocate(req, &AllocationInfo{Pool: q}, true); vk.AsResult(err) != vk.ERROR_OUT_OF_DEVICE_MEMORY {
+		t.Errorf("error below the smallest block = %v", err)
+	}
+	if got := abi.Args()[0]; got != uint64(minBlockSize) {
+		t.Errorf("last block tried of %d bytes, want %d", got, minBlockSize)
+	}
+	checkMemory(t, 1, 0) // the empty block of p
+}
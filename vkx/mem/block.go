@@ -0,0 +1,123 @@
+package mem
+
+import (
+	"unsafe"
+
+	"github.com/toy80/vk"
+)
+
+// region is a range of a block, free or holding an allocation.
+type region struct {
+	offset, size vk.DeviceSize
+	free         bool
+	linear       bool // holds a buffer or a linear image
+}
+
+func (r *region) end() vk.DeviceSize { return r.offset + r.size }
+
+// block is a DeviceMemory suballocated by its pool. The regions cover it
+// in order, two free regions are never adjacent.
+type block struct {
+	memory  vk.DeviceMemory
+	size    vk.DeviceSize
+	regions []region
+	used    vk.DeviceSize
+	count   int // allocations
+
+	mapped   unsafe.Pointer
+	mapCount int
+}
+
+func newBlock(memory vk.DeviceMemory, size vk.DeviceSize) *block {
+	return &block{memory: memory, size: size, regions: []region{{offset: 0, size: size, free: true}}}
+}
+
+func alignUp(x, align vk.DeviceSize) vk.DeviceSize {
+	if align <= 1 {
+		return x
+	}
+	return (x + align - 1) / align * align
+}
+
+// samePage reports whether the bytes a and b fall in the same page of
+// bufferImageGranularity.
+func samePage(a, b, granularity vk.DeviceSize) bool {
+	return granularity > 1 && a/granularity == b/granularity
+}
+
+// place returns where an allocation fits in the free region i, the linear
+// and the optimal resources are kept granularity apart.
+func (b *block) place(i int, size, align, granularity vk.DeviceSize, linear bool) (vk.DeviceSize, bool) {
+	r := &b.regions[i]
+	offset := alignUp(r.offset, align)
+	if i > 0 {
+		if prev := &b.regions[i-1]; prev.linear != linear && samePage(prev.end()-1, offset, granularity) {
+			offset = alignUp(offset, granularity)
+		}
+	}
+	if offset+size > r.end() {
+		return 0, false
+	}
+	if i+1 < len(b.regions) {
+		if next := &b.regions[i+1]; next.linear != linear && samePage(offset+size-1, next.offset, granularity) {
+			return 0, false
+		}
+	}
+	return offset, true
+}
+
+// alloc takes size bytes from the smallest free region they fit in and
+// returns their offset.
+func (b *block) alloc(size, align, granularity vk.DeviceSize, linear bool) (vk.DeviceSize, bool) {
+	if size == 0 || b.size-b.used < size {
+		return 0, false
+	}
+	best, bestOffset := -1, vk.DeviceSize(0)
+	for i := range b.regions {
+		r := &b.regions[i]
+		if !r.free || r.size < size || best >= 0 && r.size >= b.regions[best].size {
+			continue
+		}
+		if offset, ok := b.place(i, size, align, granularity, linear); ok {
+			best, bestOffset = i, offset
+		}
+	}
+	if best < 0 {
+		return 0, false
+	}
+	r := b.regions[best]
+	split := make([]region, 0, 3)
+	if bestOffset > r.offset {
+		split = append(split, region{offset: r.offset, size: bestOffset - r.offset, free: true})
+	}
+	split = append(split, region{offset: bestOffset, size: size, linear: linear})
+	if end := bestOffset + size; end < r.end() {
+		split = append(split, region{offset: end, size: r.end() - end, free: true})
+	}
+	b.regions = append(b.regions[:best], append(split, b.regions[best+1:]...)...)
+	b.used += size
+	b.count++
+	return bestOffset, true
+}
+
+// free returns the allocation at offset to the block.
+func (b *block) free(offset vk.DeviceSize) {
+	i := 0
+	for i < len(b.regions) && (b.regions[i].offset != offset || b.regions[i].free) {
+		i++
+	}
+	if i == len(b.regions) {
+		panic("mem: free of an unknown allocation")
+	}
+	b.used -= b.regions[i].size
+	b.count--
+	b.regions[i].free, b.regions[i].linear = true, false
+	if i+1 < len(b.regions) && b.regions[i+1].free {
+		b.regions[i].size += b.regions[i+1].size
+		b.regions = append(b.regions[:i+1], b.regions[i+2:]...)
+	}
+	if i > 0 && b.regions[i-1].free {
+		b.regions[i-1].size += b.regions[i].size
+		b.regions = append(b.regions[:i], b.regions[i+1:]...)
+	}
+}
@@ -0,0 +1,155 @@
+package mem
+
+import (
+	"expvar"
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/toy80/vk"
+	"github.com/toy80/vk/vkx"
+)
+
+// HeapBudget is what a process may use of a memory heap, and uses.
+type HeapBudget struct {
+	Budget vk.DeviceSize
+	Usage  vk.DeviceSize
+}
+
+// Fraction returns the part of the budget in use.
+func (h HeapBudget) Fraction() float64 {
+	if h.Budget == 0 {
+		return 0
+	}
+	return float64(h.Usage) / float64(h.Budget)
+}
+
+// threshold is a callback of a BudgetMonitor.
+type threshold struct {
+	fraction float64
+	fn       func(heap int, b HeapBudget, above bool)
+}
+
+// BudgetMonitor samples the budget and the usage of the memory heaps with
+// VK_EXT_memory_budget, which the device must have enabled. Without it the
+// budget is the size of the heap and the usage is zero.
+type BudgetMonitor struct {
+	sample func(heaps []HeapBudget)
+	stop   chan struct{}
+	done   chan struct{}
+
+	mu         sync.Mutex
+	heaps      []HeapBudget
+	thresholds []threshold
+}
+
+// NewBudgetMonitor returns the monitor of the heaps of physical, sampling
+// every interval until Stop. It only samples on Sample if interval is zero.
+// The memory properties are queried with vkGetPhysicalDeviceMemoryProperties2
+// or its KHR alias, without either the heaps keep their size and no usage.
+func NewBudgetMonitor(physical vkx.PhysicalDevice, interval time.Duration) *BudgetMonitor {
+	props := physical.GetPhysicalDeviceMemoryProperties()
+	get := physical.GetPhysicalDeviceMemoryProperties2
+	if physical.InstanceTable.GetPhysicalDeviceMemoryProperties2 == 0 {
+		get = physical.GetPhysicalDeviceMemoryProperties2KHR
+		if physical.InstanceTable.GetPhysicalDeviceMemoryProperties2KHR == 0 {
+			get = nil
+		}
+	}
+	return newBudgetMonitor(int(props.MemoryHeapCount), interval, func(heaps []HeapBudget) {
+		if get == nil {
+			for i := range heaps {
+				heaps[i] = HeapBudget{Budget: props.MemoryHeaps[i].Size}
+			}
+			return
+		}
+		props2, budget := vk.NewPhysicalDeviceMemoryProperties2(), vk.NewPhysicalDeviceMemoryBudgetPropertiesEXT()
+		defer props2.Free()
+		defer budget.Free()
+		props2.PNext = unsafe.Pointer(budget)
+		get(props2)
+		for i := range heaps {
+			heaps[i] = HeapBudget{budget.HeapBudget[i], budget.HeapUsage[i]}
+			if heaps[i].Budget == 0 {
+				heaps[i].Budget = props.MemoryHeaps[i].Size
+			}
+		}
+	})
+}
+
+func newBudgetMonitor(heapCount int, interval time.Duration, sample func([]HeapBudget)) *BudgetMonitor {
+	m := &BudgetMonitor{sample: sample, heaps: make([]HeapBudget, heapCount)}
+	if interval > 0 {
+		m.stop, m.done = make(chan struct{}), make(chan struct{})
+		go m.run(interval)
+	}
+	return m
+}
+
+func (m *BudgetMonitor) run(interval time.Duration) {
+	defer close(m.done)
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		m.Sample()
+		select {
+		case <-t.C:
+		case <-m.stop:
+			return
+		}
+	}
+}
+
+// Stop stops the sampling, the callbacks are not called after it returns.
+func (m *BudgetMonitor) Stop() {
+	if m.stop != nil {
+		close(m.stop)
+		<-m.done
+		m.stop = nil
+	}
+}
+
+// OnThreshold calls fn when the usage of a heap goes above fraction of its
+// budget, and again with above false when it goes back below. The calls
+// are made by the goroutine sampling.
+func (m *BudgetMonitor) OnThreshold(fraction float64, fn func(heap int, b HeapBudget, above bool)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.thresholds = append(m.thresholds, threshold{fraction, fn})
+}
+
+// Sample samples the heaps now, calls the callbacks of the thresholds they
+// crossed and returns them.
+func (m *BudgetMonitor) Sample() []HeapBudget {
+	heaps := make([]HeapBudget, len(m.heaps))
+	m.sample(heaps)
+	m.mu.Lock()
+	prev := m.heaps
+	m.heaps = heaps
+	thresholds := m.thresholds
+	m.mu.Unlock()
+
+	for i, h := range heaps {
+		was, now := prev[i].Fraction(), h.Fraction()
+		for _, t := range thresholds {
+			if above := now > t.fraction; above != (was > t.fraction) {
+				t.fn(i, h, above)
+			}
+		}
+	}
+	return append([]HeapBudget(nil), heaps...)
+}
+
+// Heaps returns the last sample.
+func (m *BudgetMonitor) Heaps() []HeapBudget {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]HeapBudget(nil), m.heaps...)
+}
+
+// Publish exports the last sample as the expvar name, a list of objects
+// with the Budget and the Usage of each heap. Like expvar.Publish, it
+// panics if name is taken.
+func (m *BudgetMonitor) Publish(name string) {
+	expvar.Publish(name, expvar.Func(func() interface{} { return m.Heaps() }))
+}
@@ -0,0 +1,33 @@
+// +build cgo
+
+package mem
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/toy80/vk"
+	"github.com/toy80/vk/internal/abi"
+	"github.com/toy80/vk/vkx"
+)
+
+func TestNewBudgetMonitor(t *testing.T) {
+	sizes := []HeapBudget{{Budget: 1 << 30}, {Budget: 1 << 28}}
+	budgets := []HeapBudget{{1 << 29, 1}, {1 << 27, 2}}
+	tests := []struct {
+		name  string
+		table vkx.InstanceTable
+		want  []HeapBudget
+	}{
+		{"1.0", vkx.InstanceTable{}, sizes},
+		{"1.1", vkx.InstanceTable{GetPhysicalDeviceMemoryProperties2: vk.PfnGetPhysicalDeviceMemoryProperties2(abi.GetPhysicalDeviceMemoryProperties2)}, budgets},
+		{"KHR", vkx.InstanceTable{GetPhysicalDeviceMemoryProperties2KHR: vk.PfnGetPhysicalDeviceMemoryProperties2KHR(abi.GetPhysicalDeviceMemoryProperties2)}, budgets},
+	}
+	for _, tt := range tests {
+		tt.table.GetPhysicalDeviceMemoryProperties = vk.PfnGetPhysicalDeviceMemoryProperties(abi.GetPhysicalDeviceMemoryProperties)
+		m := NewBudgetMonitor(vkx.PhysicalDevice{PhysicalDevice: 1, InstanceTable: &tt.table}, 0)
+		if got := m.Sample(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: Sample() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,456 @@
+// Package mem allocates the device memory of buffers and images. The
+// memory types are selected from required and preferred property flags,
+// and the resources are suballocated from large blocks, so that a program
+// stays far below maxMemoryAllocationCount:
+//
+//   - alignment and bufferImageGranularity are honoured in the blocks
+//   - the large resources and those the driver asks for get a dedicated
+//     DeviceMemory
+//   - a Pool has blocks of its own, of one memory type
+//   - the allocations can stay mapped for their whole life
+//   - a BudgetMonitor watches the budget of the heaps
+//
+// An Allocator is safe for concurrent use.
+package mem
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"sort"
+	"sync"
+	"unsafe"
+
+	"github.com/toy80/vk"
+	"github.com/toy80/vk/vkx"
+)
+
+// DefaultBlockSize is the size of the blocks of the default pools, the
+// heaps of 1 GiB or less get blocks of an eighth of their size.
+const DefaultBlockSize vk.DeviceSize = 256 << 20
+
+// ErrNoMemoryType is returned when no memory type has the required flags.
+var ErrNoMemoryType = errors.New("mem: no memory type has the required properties")
+
+// ErrZeroSize is returned for the requirements of zero bytes.
+var ErrZeroSize = errors.New("mem: allocation of zero bytes")
+
+// AllocationInfo says where an allocation goes.
+type AllocationInfo struct {
+	Required  vk.MemoryPropertyFlags // the memory type must have all of them
+	Preferred vk.MemoryPropertyFlags // the memory type with most of them wins
+	Dedicated bool                   // a DeviceMemory of its own
+	Mapped    bool                   // mapped for its whole life, Required gets HOST_VISIBLE
+	Pool      *Pool                  // nil for the default pool of the memory type
+}
+
+// Allocation is a range of a DeviceMemory bound to a resource.
+type Allocation struct {
+	Memory     vk.DeviceMemory
+	Offset     vk.DeviceSize
+	Size       vk.DeviceSize
+	MemoryType uint32
+	Mapped     unsafe.Pointer // the range, while mapped
+
+	pool  *Pool
+	block *block // nil if dedicated
+	keep  bool   // persistently mapped
+}
+
+// Dedicated reports whether a has a DeviceMemory of its own.
+func (a *Allocation) Dedicated() bool { return a.block == nil }
+
+// Allocator allocates the memory of one device.
+type Allocator struct {
+	device      vkx.Device
+	props       vk.PhysicalDeviceMemoryProperties
+	granularity vk.DeviceSize
+	atomSize    vk.DeviceSize
+	maxCount    uint32
+
+	mu       sync.Mutex
+	count    uint32 // DeviceMemory objects
+	defaults [vk.MAX_MEMORY_TYPES]*Pool
+	pools    map[*Pool]bool
+}
+
+// New returns the allocator of device, created from physical.
+func New(physical vkx.PhysicalDevice, device vkx.Device) *Allocator {
+	props := physical.GetPhysicalDeviceProperties()
+	return newAllocator(device, physical.GetPhysicalDeviceMemoryProperties(), &props.Limits)
+}
+
+func newAllocator(device vkx.Device, props vk.PhysicalDeviceMemoryProperties, limits *vk.PhysicalDeviceLimits) *Allocator {
+	a := &Allocator{
+		device:      device,
+		props:       props,
+		granularity: limits.BufferImageGranularity,
+		atomSize:    limits.NonCoherentAtomSize,
+		maxCount:    limits.MaxMemoryAllocationCount,
+		pools:       make(map[*Pool]bool),
+	}
+	for i := uint32(0); i < a.props.MemoryTypeCount; i++ {
+		size := DefaultBlockSize
+		if heap := a.props.MemoryHeaps[a.props.MemoryTypes[i].HeapIndex].Size; heap <= 1<<30 {
+			size = alignUp(heap/8, 32)
+		}
+		a.defaults[i] = &Pool{a: a, memoryType: i, blockSize: size}
+	}
+	return a
+}
+
+// MemoryProperties returns the memory properties of the physical device.
+func (a *Allocator) MemoryProperties() *vk.PhysicalDeviceMemoryProperties { return &a.props }
+
+// MemoryTypes returns the memory types of typeBits having the required
+// flags, the best first: the most preferred flags, then the fewest others.
+func MemoryTypes(props *vk.PhysicalDeviceMemoryProperties, typeBits uint32, required, preferred vk.MemoryPropertyFlags) []uint32 {
+	var types []uint32
+	for i := uint32(0); i < props.MemoryTypeCount; i++ {
+		if typeBits&(1<<i) != 0 && props.MemoryTypes[i].PropertyFlags&required == required {
+			types = append(types, i)
+		}
+	}
+	count := func(f vk.MemoryPropertyFlags) int { return bits.OnesCount32(uint32(f)) }
+	sort.SliceStable(types, func(i, j int) bool {
+		fi, fj := props.MemoryTypes[types[i]].PropertyFlags, props.MemoryTypes[types[j]].PropertyFlags
+		if pi, pj := count(fi&preferred), count(fj&preferred); pi != pj {
+			return pi > pj
+		}
+		return count(fi&^(required|preferred)) < count(fj&^(required|preferred))
+	})
+	return types
+}
+
+// CreateBuffer creates a buffer and binds it to a new allocation.
+func (a *Allocator) CreateBuffer(pCreateInfo *vk.BufferCreateInfo, info *AllocationInfo) (vk.Buffer, *Allocation, error) {
+	buffer, err := a.device.CreateBuffer(pCreateInfo)
+	if err != nil {
+		return 0, nil, err
+	}
+	req, dedicated := a.bufferRequirements(buffer)
+	alloc, err := a.allocate(req, info, dedicated, true, buffer, 0)
+	if err == nil {
+		err = a.device.BindBufferMemory(buffer, alloc.Memory, alloc.Offset)
+	}
+	if err != nil {
+		if alloc != nil {
+			a.Free(alloc)
+		}
+		a.device.DestroyBuffer(buffer)
+		return 0, nil, err
+	}
+	return buffer, alloc, nil
+}
+
+// CreateImage creates an image and binds it to a new allocation.
+func (a *Allocator) CreateImage(pCreateInfo *vk.ImageCreateInfo, info *AllocationInfo) (vk.Image, *Allocation, error) {
+	image, err := a.device.CreateImage(pCreateInfo)
+	if err != nil {
+		return 0, nil, err
+	}
+	req, dedicated := a.imageRequirements(image)
+	linear := pCreateInfo.Tiling == vk.IMAGE_TILING_LINEAR
+	alloc, err := a.allocate(req, info, dedicated, linear, 0, image)
+	if err == nil {
+		err = a.device.BindImageMemory(image, alloc.Memory, alloc.Offset)
+	}
+	if err != nil {
+		if alloc != nil {
+			a.Free(alloc)
+		}
+		a.device.DestroyImage(image)
+		return 0, nil, err
+	}
+	return image, alloc, nil
+}
+
+// DestroyBuffer destroys a buffer created by CreateBuffer and frees its
+// allocation.
+func (a *Allocator) DestroyBuffer(buffer vk.Buffer, alloc *Allocation) {
+	a.device.DestroyBuffer(buffer)
+	a.Free(alloc)
+}
+
+// DestroyImage destroys an image created by CreateImage and frees its
+// allocation.
+func (a *Allocator) DestroyImage(image vk.Image, alloc *Allocation) {
+	a.device.DestroyImage(image)
+	a.Free(alloc)
+}
+
+// Allocate allocates memory for req, linear tells a buffer or a linear
+// image from an optimal image.
+func (a *Allocator) Allocate(req vk.MemoryRequirements, info *AllocationInfo, linear bool) (*Allocation, error) {
+	if req.Size == 0 {
+		return nil, ErrZeroSize
+	}
+	return a.allocate(req, info, false, linear, 0, 0)
+}
+
+// bufferRequirements returns the requirements of buffer, and whether the
+// driver asks for a dedicated allocation.
+func (a *Allocator) bufferRequirements(buffer vk.Buffer) (vk.MemoryRequirements, bool) {
+	if a.device.DeviceTable.GetBufferMemoryRequirements2 == 0 {
+		return a.device.GetBufferMemoryRequirements(buffer), false
+	}
+	info := vk.NewBufferMemoryRequirementsInfo2()
+	defer info.Free()
+	info.Buffer = buffer
+	req, ded := vk.NewMemoryRequirements2(), vk.NewMemoryDedicatedRequirements()
+	defer req.Free()
+	defer ded.Free()
+	req.PNext = unsafe.Pointer(ded)
+	a.device.GetBufferMemoryRequirements2(info, req)
+	return req.MemoryRequirements, ded.PrefersDedicatedAllocation != 0 || ded.RequiresDedicatedAllocation != 0
+}
+
+// imageRequirements is bufferRequirements for an image.
+func (a *Allocator) imageRequirements(image vk.Image) (vk.MemoryRequirements, bool) {
+	if a.device.DeviceTable.GetImageMemoryRequirements2 == 0 {
+		return a.device.GetImageMemoryRequirements(image), false
+	}
+	info := vk.NewImageMemoryRequirementsInfo2()
+	defer info.Free()
+	info.Image = image
+	req, ded := vk.NewMemoryRequirements2(), vk.NewMemoryDedicatedRequirements()
+	defer req.Free()
+	defer ded.Free()
+	req.PNext = unsafe.Pointer(ded)
+	a.device.GetImageMemoryRequirements2(info, req)
+	return req.MemoryRequirements, ded.PrefersDedicatedAllocation != 0 || ded.RequiresDedicatedAllocation != 0
+}
+
+func (a *Allocator) allocate(req vk.MemoryRequirements, info *AllocationInfo, dedicated, linear bool, buffer vk.Buffer, image vk.Image) (*Allocation, error) {
+	if info == nil {
+		info = &AllocationInfo{}
+	}
+	required := info.Required
+	if info.Mapped {
+		required |= vk.MEMORY_PROPERTY_HOST_VISIBLE_BIT
+	}
+	dedicated = dedicated && info.Pool == nil || info.Dedicated
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	var types []uint32
+	if p := info.Pool; p != nil {
+		if req.MemoryTypeBits&(1<<p.memoryType) == 0 || a.props.MemoryTypes[p.memoryType].PropertyFlags&required != required {
+			return nil, ErrNoMemoryType
+		}
+		types = []uint32{p.memoryType}
+	} else {
+		types = MemoryTypes(&a.props, req.MemoryTypeBits, required, info.Preferred)
+	}
+	if len(types) == 0 {
+		return nil, ErrNoMemoryType
+	}
+	err := error(ErrNoMemoryType)
+	for _, t := range types {
+		p := info.Pool
+		if p == nil {
+			p = a.defaults[t]
+		}
+		var alloc *Allocation
+		if dedicated || info.Pool == nil && req.Size > p.blockSize/2 {
+			alloc, err = a.allocateDedicated(p, req.Size, buffer, image)
+		} else {
+			alloc, err = p.allocate(req, linear)
+		}
+		if err != nil {
+			continue // another memory type may have room
+		}
+		if info.Mapped {
+			if err = a.mapLocked(alloc); err != nil {
+				a.freeLocked(alloc)
+				return nil, err
+			}
+			alloc.keep = true
+		}
+		return alloc, nil
+	}
+	return nil, err
+}
+
+// allocateMemory allocates a DeviceMemory, pNext is chained to the info.
+func (a *Allocator) allocateMemory(size vk.DeviceSize, memoryType uint32, pNext unsafe.Pointer) (vk.DeviceMemory, error) {
+	if a.maxCount != 0 && a.count >= a.maxCount {
+		return 0, vk.ErrorResult(vk.ERROR_TOO_MANY_OBJECTS)
+	}
+	info := vk.NewMemoryAllocateInfo()
+	defer info.Free()
+	info.PNext, info.AllocationSize, info.MemoryTypeIndex = pNext, size, memoryType
+	memory, err := a.device.AllocateMemory(info)
+	if err == nil {
+		a.count++
+	}
+	return memory, err
+}
+
+func (a *Allocator) allocateDedicated(p *Pool, size vk.DeviceSize, buffer vk.Buffer, image vk.Image) (*Allocation, error) {
+	var pNext unsafe.Pointer
+	if (buffer != 0 || image != 0) && a.device.DeviceTable.GetBufferMemoryRequirements2 != 0 {
+		ded := vk.NewMemoryDedicatedAllocateInfo()
+		defer ded.Free()
+		ded.Buffer, ded.Image = buffer, image
+		pNext = unsafe.Pointer(ded)
+	}
+	memory, err := a.allocateMemory(size, p.memoryType, pNext)
+	if err != nil {
+		return nil, err
+	}
+	p.dedicated++
+	p.dedicatedBytes += size
+	return &Allocation{Memory: memory, Size: size, MemoryType: p.memoryType, pool: p}, nil
+}
+
+// Free frees an allocation, unmapping it.
+func (a *Allocator) Free(alloc *Allocation) {
+	if alloc == nil {
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.freeLocked(alloc)
+}
+
+func (a *Allocator) freeLocked(alloc *Allocation) {
+	p := alloc.pool
+	if alloc.block == nil {
+		a.device.FreeMemory(alloc.Memory) // unmaps it as well
+		a.count--
+		p.dedicated--
+		p.dedicatedBytes -= alloc.Size
+	} else {
+		if alloc.Mapped != nil {
+			a.unmapBlock(alloc.block)
+		}
+		p.free(alloc)
+	}
+	*alloc = Allocation{}
+}
+
+// Map maps the allocation and returns its address, Mapped if it is mapped
+// already. The blocks are mapped once for all their allocations.
+func (a *Allocator) Map(alloc *Allocation) (unsafe.Pointer, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if alloc.Mapped != nil {
+		return alloc.Mapped, nil
+	}
+	return alloc.Mapped, a.mapLocked(alloc)
+}
+
+// Unmap unmaps an allocation mapped by Map, the persistently mapped ones
+// stay mapped.
+func (a *Allocator) Unmap(alloc *Allocation) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if alloc.Mapped == nil || alloc.keep {
+		return
+	}
+	if alloc.block == nil {
+		a.device.UnmapMemory(alloc.Memory)
+	} else {
+		a.unmapBlock(alloc.block)
+	}
+	alloc.Mapped = nil
+}
+
+func (a *Allocator) mapLocked(alloc *Allocation) error {
+	if alloc.block == nil {
+		p, err := a.device.MapMemory(alloc.Memory, 0, vk.WHOLE_SIZE, 0)
+		alloc.Mapped = p
+		return err
+	}
+	b := alloc.block
+	if b.mapCount == 0 {
+		p, err := a.device.MapMemory(b.memory, 0, vk.WHOLE_SIZE, 0)
+		if err != nil {
+			return err
+		}
+		b.mapped = p
+	}
+	b.mapCount++
+	alloc.Mapped = unsafe.Pointer(uintptr(b.mapped) + uintptr(alloc.Offset))
+	return nil
+}
+
+func (a *Allocator) unmapBlock(b *block) {
+	if b.mapCount--; b.mapCount == 0 {
+		a.device.UnmapMemory(b.memory)
+		b.mapped = nil
+	}
+}
+
+// Flush flushes size bytes of alloc from offset, written by the host, to
+// the device. It does nothing for the HOST_COHERENT memory, offset must be
+// within alloc.
+func (a *Allocator) Flush(alloc *Allocation, offset, size vk.DeviceSize) error {
+	ranges, err := a.mappedRange(alloc, offset, size)
+	if err != nil || ranges == nil {
+		return err
+	}
+	return a.device.FlushMappedMemoryRanges(ranges)
+}
+
+// Invalidate makes size bytes of alloc from offset, written by the device,
+// visible to the host. It does nothing for the HOST_COHERENT memory.
+func (a *Allocator) Invalidate(alloc *Allocation, offset, size vk.DeviceSize) error {
+	ranges, err := a.mappedRange(alloc, offset, size)
+	if err != nil || ranges == nil {
+		return err
+	}
+	return a.device.InvalidateMappedMemoryRanges(ranges)
+}
+
+// mappedRange returns the range of the DeviceMemory to flush, aligned to
+// nonCoherentAtomSize and clamped to the allocation, or none for the
+// HOST_COHERENT memory.
+func (a *Allocator) mappedRange(alloc *Allocation, offset, size vk.DeviceSize) ([]vk.MappedMemoryRange, error) {
+	if offset > alloc.Size {
+		return nil, fmt.Errorf("mem: offset %d out of an allocation of %d bytes", offset, alloc.Size)
+	}
+	if a.props.MemoryTypes[alloc.MemoryType].PropertyFlags&vk.MEMORY_PROPERTY_HOST_COHERENT_BIT != 0 {
+		return nil, nil
+	}
+	if size == vk.WHOLE_SIZE || offset+size > alloc.Size {
+		size = alloc.Size - offset
+	}
+	begin := alloc.Offset + offset
+	if a.atomSize > 1 {
+		begin -= begin % a.atomSize
+	}
+	end := alignUp(alloc.Offset+offset+size, a.atomSize)
+	if memEnd := a.memorySize(alloc); end > memEnd {
+		end = memEnd
+	}
+	return []vk.MappedMemoryRange{{
+		SType:  vk.STRUCTURE_TYPE_MAPPED_MEMORY_RANGE,
+		Memory: alloc.Memory,
+		Offset: begin,
+		Size:   end - begin,
+	}}, nil
+}
+
+func (a *Allocator) memorySize(alloc *Allocation) vk.DeviceSize {
+	if alloc.block == nil {
+		return alloc.Size
+	}
+	return alloc.block.size
+}
+
+// Destroy frees the memory of every pool, the allocations must have been
+// freed.
+func (a *Allocator) Destroy() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for _, p := range a.defaults {
+		if p != nil {
+			p.release()
+		}
+	}
+	for p := range a.pools {
+		p.release()
+	}
+}
@@ -0,0 +1,105 @@
+package mem
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/toy80/vk"
+)
+
+func TestMemoryTypes(t *testing.T) {
+	const (
+		local    = vk.MEMORY_PROPERTY_DEVICE_LOCAL_BIT
+		visible  = vk.MEMORY_PROPERTY_HOST_VISIBLE_BIT
+		coherent = vk.MEMORY_PROPERTY_HOST_COHERENT_BIT
+		cached   = vk.MEMORY_PROPERTY_HOST_CACHED_BIT
+	)
+	props := vk.PhysicalDeviceMemoryProperties{MemoryTypeCount: 4}
+	for i, f := range []vk.MemoryPropertyFlags{local, visible | coherent, visible | coherent | cached, local | visible | coherent} {
+		props.MemoryTypes[i].PropertyFlags = f
+	}
+	tests := []struct {
+		typeBits            uint32
+		required, preferred vk.MemoryPropertyFlags
+		want                []uint32
+	}{
+		{0xF, local, 0, []uint32{0, 3}},
+		{0xF, visible, 0, []uint32{1, 2, 3}},
+		{0xF, visible, cached, []uint32{2, 1, 3}},
+		{0xF, visible | coherent, local, []uint32{3, 1, 2}},
+		{0x6, visible, local, []uint32{1, 2}},
+		{0x1, visible, 0, nil},
+	}
+	for _, tt := range tests {
+		if got := MemoryTypes(&props, tt.typeBits, tt.required, tt.preferred); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("MemoryTypes(%#x, %v, %v) = %v, want %v", tt.typeBits, tt.required, tt.preferred, got, tt.want)
+		}
+	}
+}
+
+func TestBlock(t *testing.T) {
+	const granularity = 1024
+	b := newBlock(1, 8192)
+	alloc := func(size, align vk.DeviceSize, linear bool, want vk.DeviceSize) {
+		t.Helper()
+		offset, ok := b.alloc(size, align, granularity, linear)
+		if !ok || offset != want {
+			t.Fatalf("alloc(%d, %d, %v) = %d, %v, want %d", size, align, linear, offset, ok, want)
+		}
+	}
+	alloc(100, 16, true, 0)
+	alloc(100, 64, true, 128)   // aligned
+	alloc(100, 16, false, 1024) // next page, an image after buffers
+	alloc(1000, 16, false, 1136)
+	if _, ok := b.alloc(8192, 1, granularity, true); ok {
+		t.Fatal("alloc past the free bytes")
+	}
+	b.free(128)
+	alloc(60, 16, true, 112) // best fit, in the hole
+	b.free(0)
+	b.free(112)
+	b.free(1024)
+	b.free(1136)
+	if len(b.regions) != 1 || !b.regions[0].free || b.used != 0 || b.count != 0 {
+		t.Fatalf("regions not merged back: %+v", b.regions)
+	}
+	alloc(8192, 4096, false, 0)
+}
+
+func TestBudgetMonitor(t *testing.T) {
+	usage := []vk.DeviceSize{10, 50}
+	m := newBudgetMonitor(2, 0, func(heaps []HeapBudget) {
+		for i := range heaps {
+			heaps[i] = HeapBudget{100, usage[i]}
+		}
+	})
+	type call struct {
+		heap  int
+		usage vk.DeviceSize
+		above bool
+	}
+	var calls []call
+	m.OnThreshold(0.8, func(heap int, b HeapBudget, above bool) {
+		calls = append(calls, call{heap, b.Usage, above})
+	})
+	m.Sample()
+	usage[1] = 90
+	m.Sample()
+	m.Sample() // still above
+	usage[0], usage[1] = 85, 20
+	m.Sample()
+	want := []call{{1, 90, true}, {0, 85, true}, {1, 20, false}}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("got calls %v, want %v", calls, want)
+	}
+	if got := m.Heaps(); !reflect.DeepEqual(got, []HeapBudget{{100, 85}, {100, 20}}) {
+		t.Errorf("Heaps() = %v", got)
+	}
+}
+
+func TestFlushOutOfRange(t *testing.T) {
+	var a Allocator
+	if err := a.Flush(&Allocation{Size: 16}, 32, vk.WHOLE_SIZE); err == nil {
+		t.Errorf("no error for an offset past the allocation")
+	}
+}
@@ -0,0 +1,196 @@
+package mem
+
+import (
+	"errors"
+
+	"github.com/toy80/vk"
+)
+
+// PoolInfo describes a custom pool.
+type PoolInfo struct {
+	MemoryType uint32        // see MemoryTypes
+	BlockSize  vk.DeviceSize // DefaultBlockSize if zero
+	MaxBlocks  int           // unlimited if zero
+}
+
+// minBlockSize is the size below which a pool stops halving its blocks
+// when the heap runs short.
+const minBlockSize vk.DeviceSize = 1 << 20
+
+// Pool is the blocks of one memory type. Every memory type has a default
+// pool, NewPool makes others, e.g. for the resources of a level.
+type Pool struct {
+	a          *Allocator
+	memoryType uint32
+	blockSize  vk.DeviceSize
+	maxBlocks  int
+	blocks     []*block
+
+	dedicated      int
+	dedicatedBytes vk.DeviceSize
+}
+
+// NewPool creates a custom pool, its blocks are allocated on demand.
+func (a *Allocator) NewPool(info PoolInfo) (*Pool, error) {
+	if info.MemoryType >= a.props.MemoryTypeCount {
+		return nil, errors.New("mem: no such memory type")
+	}
+	if info.BlockSize == 0 {
+		info.BlockSize = DefaultBlockSize
+	}
+	p := &Pool{a: a, memoryType: info.MemoryType, blockSize: info.BlockSize, maxBlocks: info.MaxBlocks}
+	a.mu.Lock()
+	a.pools[p] = true
+	a.mu.Unlock()
+	return p, nil
+}
+
+// Destroy frees the blocks of a custom pool, its allocations must have
+// been freed.
+func (p *Pool) Destroy() {
+	p.a.mu.Lock()
+	defer p.a.mu.Unlock()
+	p.release()
+	delete(p.a.pools, p)
+}
+
+func (p *Pool) release() {
+	for _, b := range p.blocks {
+		p.a.device.FreeMemory(b.memory)
+		p.a.count--
+	}
+	p.blocks = nil
+}
+
+// allocate suballocates req from the blocks of p, allocating a new one if
+// none has room.
+func (p *Pool) allocate(req vk.MemoryRequirements, linear bool) (*Allocation, error) {
+	if req.Size > p.blockSize {
+		return nil, vk.ErrorResult(vk.ERROR_OUT_OF_DEVICE_MEMORY)
+	}
+	for _, b := range p.blocks {
+		if offset, ok := b.alloc(req.Size, req.Alignment, p.a.granularity, linear); ok {
+			return p.allocation(b, offset, req.Size), nil
+		}
+	}
+	if p.maxBlocks > 0 && len(p.blocks) >= p.maxBlocks {
+		return nil, vk.ErrorResult(vk.ERROR_OUT_OF_DEVICE_MEMORY)
+	}
+	// smaller blocks when the heap runs short, as long as req fits and they
+	// are not below minBlockSize
+	var err error
+	for size := p.blockSize; ; size /= 2 {
+		var memory vk.DeviceMemory
+		memory, err = p.a.allocateMemory(size, p.memoryType, nil)
+		if err == nil {
+			b := newBlock(memory, size)
+			offset, ok := b.alloc(req.Size, req.Alignment, p.a.granularity, linear)
+			if !ok {
+				p.a.device.FreeMemory(memory)
+				p.a.count--
+				return nil, vk.ErrorResult(vk.ERROR_OUT_OF_DEVICE_MEMORY)
+			}
+			p.blocks = append(p.blocks, b)
+			return p.allocation(b, offset, req.Size), nil
+		}
+		if vk.AsResult(err) == vk.ERROR_TOO_MANY_OBJECTS || size/2 < req.Size || size/2 < minBlockSize {
+			return nil, err
+		}
+	}
+}
+
+func (p *Pool) allocation(b *block, offset, size vk.DeviceSize) *Allocation {
+	return &Allocation{Memory: b.memory, Offset: offset, Size: size, MemoryType: p.memoryType, pool: p, block: b}
+}
+
+// free returns alloc to its block, the empty blocks are freed but one.
+func (p *Pool) free(alloc *Allocation) {
+	b := alloc.block
+	b.free(alloc.Offset)
+	if b.count > 0 {
+		return
+	}
+	empty := 0
+	for _, c := range p.blocks {
+		if c.count == 0 {
+			empty++
+		}
+	}
+	if empty < 2 {
+		return // kept for the next allocations
+	}
+	for i, c := range p.blocks {
+		if c == b {
+			p.blocks = append(p.blocks[:i], p.blocks[i+1:]...)
+			break
+		}
+	}
+	if b.mapCount > 0 {
+		p.a.device.UnmapMemory(b.memory)
+	}
+	p.a.device.FreeMemory(b.memory)
+	p.a.count--
+}
+
+// Stats are the numbers of a set of allocations.
+type Stats struct {
+	Blocks         int           // DeviceMemory objects, the dedicated ones too
+	Allocations    int           // suballocated and dedicated
+	BlockBytes     vk.DeviceSize // size of the DeviceMemory objects
+	AllocatedBytes vk.DeviceSize // size of the allocations
+}
+
+// Unused returns the bytes of the blocks no allocation has.
+func (s Stats) Unused() vk.DeviceSize { return s.BlockBytes - s.AllocatedBytes }
+
+func (s *Stats) add(t Stats) {
+	s.Blocks += t.Blocks
+	s.Allocations += t.Allocations
+	s.BlockBytes += t.BlockBytes
+	s.AllocatedBytes += t.AllocatedBytes
+}
+
+func (p *Pool) stats() (s Stats) {
+	for _, b := range p.blocks {
+		s.add(Stats{1, b.count, b.size, b.used})
+	}
+	s.add(Stats{p.dedicated, p.dedicated, p.dedicatedBytes, p.dedicatedBytes})
+	return s
+}
+
+// Stats returns the numbers of the pool.
+func (p *Pool) Stats() Stats {
+	p.a.mu.Lock()
+	defer p.a.mu.Unlock()
+	return p.stats()
+}
+
+// AllocatorStats are the numbers of an Allocator, in total, by memory type
+// and by heap.
+type AllocatorStats struct {
+	Total      Stats
+	MemoryType [vk.MAX_MEMORY_TYPES]Stats
+	MemoryHeap [vk.MAX_MEMORY_HEAPS]Stats
+}
+
+// Stats returns the numbers of the default and the custom pools.
+func (a *Allocator) Stats() *AllocatorStats {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	s := new(AllocatorStats)
+	add := func(p *Pool) {
+		t := p.stats()
+		s.Total.add(t)
+		s.MemoryType[p.memoryType].add(t)
+		s.MemoryHeap[a.props.MemoryTypes[p.memoryType].HeapIndex].add(t)
+	}
+	for _, p := range a.defaults {
+		if p != nil {
+			add(p)
+		}
+	}
+	for p := range a.pools {
+		add(p)
+	}
+	return s
+}
@@ -0,0 +1,182 @@
+package pipeline
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"unsafe"
+
+	"github.com/toy80/vk"
+	"github.com/toy80/vk/vkx"
+	"github.com/toy80/vk/vkx/internal/cmem"
+)
+
+// headerSize is the size of the header of version one.
+const headerSize = 16 + vk.UUID_SIZE
+
+// ErrHeader is returned for pipeline cache data too short for a header.
+var ErrHeader = errors.New("pipeline: pipeline cache data has no header")
+
+// Header is the header of pipeline cache data.
+type Header struct {
+	Version  vk.PipelineCacheHeaderVersion
+	VendorID uint32
+	DeviceID uint32
+	UUID     [vk.UUID_SIZE]uint8
+}
+
+// ParseHeader returns the header of pipeline cache data.
+func ParseHeader(data []byte) (Header, error) {
+	if len(data) < headerSize {
+		return Header{}, ErrHeader
+	}
+	le := binary.LittleEndian
+	if n := le.Uint32(data); n < headerSize || int(n) > len(data) {
+		return Header{}, fmt.Errorf("pipeline: pipeline cache header of %d bytes", n)
+	}
+	h := Header{
+		Version:  vk.PipelineCacheHeaderVersion(le.Uint32(data[4:])),
+		VendorID: le.Uint32(data[8:]),
+		DeviceID: le.Uint32(data[12:]),
+	}
+	copy(h.UUID[:], data[16:])
+	return h, nil
+}
+
+// Check returns why pipeline cache data with header h cannot be used by
+// the device of props, or nil.
+func (h Header) Check(props *vk.PhysicalDeviceProperties) error {
+	switch {
+	case h.Version != vk.PIPELINE_CACHE_HEADER_VERSION_ONE:
+		return fmt.Errorf("pipeline: pipeline cache header version %d", h.Version)
+	case h.VendorID != props.VendorID || h.DeviceID != props.DeviceID:
+		return fmt.Errorf("pipeline: pipeline cache of device %#x:%#x, not %#x:%#x", h.VendorID, h.DeviceID, props.VendorID, props.DeviceID)
+	case h.UUID != props.PipelineCacheUUID:
+		return errors.New("pipeline: pipeline cache of another driver")
+	}
+	return nil
+}
+
+// Cache is a pipeline cache loaded from a file and saved back to it. The
+// goroutines creating pipelines at once may use their own caches, from
+// NewThreadCache, which are merged into it when it is saved.
+type Cache struct {
+	device    vkx.Device
+	path      string
+	cache     vk.PipelineCache
+	discarded error
+
+	mu      sync.Mutex
+	threads []vk.PipelineCache
+}
+
+// OpenCache creates the pipeline cache of device with the data of the file
+// at path. The data is discarded if the file is not of the device of
+// physical, its driver or this version of it. A missing file is an empty
+// cache.
+func OpenCache(physical vkx.PhysicalDevice, device vkx.Device, path string) (*Cache, error) {
+	c := &Cache{device: device, path: path}
+	data, err := ioutil.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if len(data) > 0 {
+		props := physical.GetPhysicalDeviceProperties()
+		h, err := ParseHeader(data)
+		if err == nil {
+			err = h.Check(&props)
+		}
+		if err != nil {
+			c.discarded, data = err, nil
+		}
+	}
+	if c.cache, err = c.create(data); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// create creates a pipeline cache with the initial data.
+func (c *Cache) create(data []byte) (vk.PipelineCache, error) {
+	var m cmem.Arena
+	defer m.Free()
+	info := (*vk.PipelineCacheCreateInfo)(m.Alloc(1, unsafe.Sizeof(vk.PipelineCacheCreateInfo{})))
+	info.SType = vk.STRUCTURE_TYPE_PIPELINE_CACHE_CREATE_INFO
+	if len(data) > 0 {
+		info.InitialDataSize, info.PInitialData = uintptr(len(data)), m.Copy(unsafe.Pointer(&data[0]), uintptr(len(data)))
+	}
+	return c.device.CreatePipelineCache(info)
+}
+
+// PipelineCache returns the pipeline cache.
+func (c *Cache) PipelineCache() vk.PipelineCache { return c.cache }
+
+// Discarded returns why the data of the file was discarded, or nil.
+func (c *Cache) Discarded() error { return c.discarded }
+
+// NewThreadCache creates an empty pipeline cache, merged into c by Save.
+// It must not be used by two goroutines at once.
+func (c *Cache) NewThreadCache() (vk.PipelineCache, error) {
+	cache, err := c.create(nil)
+	if err != nil {
+		return 0, err
+	}
+	c.mu.Lock()
+	c.threads = append(c.threads, cache)
+	c.mu.Unlock()
+	return cache, nil
+}
+
+// Save merges the thread caches and writes the data of the cache to its
+// file. The file is replaced at once, a crash leaves the old one.
+func (c *Cache) Save() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.threads) > 0 {
+		if err := c.device.MergePipelineCaches(c.cache, c.threads); err != nil {
+			return err
+		}
+	}
+	data, err := c.device.GetPipelineCacheData(c.cache)
+	if err != nil {
+		return err
+	}
+	return writeFile(c.path, data)
+}
+
+// writeFile writes data to a temporary file next to path, then renames it.
+func writeFile(path string, data []byte) error {
+	f, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), path)
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+	return err
+}
+
+// Destroy destroys the cache and the thread caches, without saving them.
+func (c *Cache) Destroy() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, cache := range c.threads {
+		c.device.DestroyPipelineCache(cache)
+	}
+	c.threads = nil
+	c.device.DestroyPipelineCache(c.cache)
+}
@@ -0,0 +1,457 @@
+// Package pipeline builds the graphics pipelines and keeps the pipeline
+// caches in files.
+//
+//   - a GraphicsBuilder starts from the usual state and changes only what
+//     a pipeline needs, then puts the state into C memory for the driver
+//   - CreateGraphics creates many pipelines in one call, derivatives of
+//     each other or of a pipeline created before
+//   - a Cache is loaded only by the device and the driver that saved it,
+//     and merges the caches of the goroutines when it is saved
+package pipeline
+
+import (
+	"errors"
+	"unsafe"
+
+	"github.com/toy80/vk"
+	"github.com/toy80/vk/vkx"
+	"github.com/toy80/vk/vkx/internal/cmem"
+)
+
+// ErrViewport is returned by CreateGraphics for a pipeline without
+// viewports or scissors, neither static nor dynamic.
+var ErrViewport = errors.New("pipeline: no viewport or scissor, static or dynamic")
+
+// ErrColorAttachments is returned by CreateGraphics for a pipeline of
+// dynamic rendering whose color attachments are not one per color format.
+var ErrColorAttachments = errors.New("pipeline: color attachments do not match the color formats")
+
+// shader is a stage of a pipeline.
+type shader struct {
+	stage  vk.ShaderStageFlags
+	module vk.ShaderModule
+	entry  string
+}
+
+// GraphicsBuilder is the state of a graphics pipeline. NewGraphics starts
+// it with:
+//
+//   - triangle lists, filled, the back faces culled, counter-clockwise front
+//   - one sample, depth test and write with COMPARE_OP_LESS, no stencil
+//   - one color attachment, written without blending
+//   - dynamic viewport and scissor
+type GraphicsBuilder struct {
+	flags      vk.PipelineCreateFlags
+	shaders    []shader
+	bindings   []vk.VertexInputBindingDescription
+	attributes []vk.VertexInputAttributeDescription
+	assembly   vk.PipelineInputAssemblyStateCreateInfo
+	patch      uint32 // tessellation control points, none if zero
+	viewports  []vk.Viewport
+	scissors   []vk.Rect2D
+	raster     vk.PipelineRasterizationStateCreateInfo
+	multi      vk.PipelineMultisampleStateCreateInfo
+	depth      vk.PipelineDepthStencilStateCreateInfo
+	blend      vk.PipelineColorBlendStateCreateInfo
+	attach     []vk.PipelineColorBlendAttachmentState
+	dynamic    []vk.DynamicState
+	layout     vk.PipelineLayout
+	renderPass vk.RenderPass
+	subpass    uint32
+	base       vk.Pipeline
+	baseIndex  int32
+
+	rendering      bool // dynamic rendering, without a render pass
+	colorFormats   []vk.Format
+	depthFormat    vk.Format
+	stencilFormat  vk.Format
+	renderViewMask uint32
+}
+
+// NoBlend is the blend state of a color attachment written as is.
+var NoBlend = vk.PipelineColorBlendAttachmentState{
+	ColorWriteMask: vk.COLOR_COMPONENT_R_BIT | vk.COLOR_COMPONENT_G_BIT | vk.COLOR_COMPONENT_B_BIT | vk.COLOR_COMPONENT_A_BIT,
+}
+
+// AlphaBlend is the blend state of a color attachment blended by the
+// alpha of the fragments.
+var AlphaBlend = vk.PipelineColorBlendAttachmentState{
+	BlendEnable:         vk.TRUE,
+	SrcColorBlendFactor: vk.BLEND_FACTOR_SRC_ALPHA,
+	DstColorBlendFactor: vk.BLEND_FACTOR_ONE_MINUS_SRC_ALPHA,
+	ColorBlendOp:        vk.BLEND_OP_ADD,
+	SrcAlphaBlendFactor: vk.BLEND_FACTOR_ONE,
+	DstAlphaBlendFactor: vk.BLEND_FACTOR_ONE_MINUS_SRC_ALPHA,
+	AlphaBlendOp:        vk.BLEND_OP_ADD,
+	ColorWriteMask:      NoBlend.ColorWriteMask,
+}
+
+// NewGraphics returns the default state of a pipeline of layout, used in
+// subpass of renderPass.
+func NewGraphics(layout vk.PipelineLayout, renderPass vk.RenderPass, subpass uint32) *GraphicsBuilder {
+	return &GraphicsBuilder{
+		assembly: vk.PipelineInputAssemblyStateCreateInfo{
+			SType:    vk.STRUCTURE_TYPE_PIPELINE_INPUT_ASSEMBLY_STATE_CREATE_INFO,
+			Topology: vk.PRIMITIVE_TOPOLOGY_TRIANGLE_LIST,
+		},
+		raster: vk.PipelineRasterizationStateCreateInfo{
+			SType:       vk.STRUCTURE_TYPE_PIPELINE_RASTERIZATION_STATE_CREATE_INFO,
+			PolygonMode: vk.POLYGON_MODE_FILL,
+			CullMode:    vk.CullModeFlags(vk.CULL_MODE_BACK_BIT),
+			FrontFace:   vk.FRONT_FACE_COUNTER_CLOCKWISE,
+			LineWidth:   1,
+		},
+		multi: vk.PipelineMultisampleStateCreateInfo{
+			SType:                vk.STRUCTURE_TYPE_PIPELINE_MULTISAMPLE_STATE_CREATE_INFO,
+			RasterizationSamples: vk.SampleCountFlags(vk.SAMPLE_COUNT_1_BIT),
+		},
+		depth: vk.PipelineDepthStencilStateCreateInfo{
+			SType:            vk.STRUCTURE_TYPE_PIPELINE_DEPTH_STENCIL_STATE_CREATE_INFO,
+			DepthTestEnable:  vk.TRUE,
+			DepthWriteEnable: vk.TRUE,
+			DepthCompareOp:   vk.COMPARE_OP_LESS,
+			MaxDepthBounds:   1,
+		},
+		blend: vk.PipelineColorBlendStateCreateInfo{
+			SType: vk.STRUCTURE_TYPE_PIPELINE_COLOR_BLEND_STATE_CREATE_INFO,
+		},
+		attach:     []vk.PipelineColorBlendAttachmentState{NoBlend},
+		dynamic:    []vk.DynamicState{vk.DYNAMIC_STATE_VIEWPORT, vk.DYNAMIC_STATE_SCISSOR},
+		layout:     layout,
+		renderPass: renderPass,
+		subpass:    subpass,
+		baseIndex:  -1,
+	}
+}
+
+// Flags sets the flags of the pipeline.
+func (b *GraphicsBuilder) Flags(flags vk.PipelineCreateFlags) *GraphicsBuilder {
+	b.flags = flags
+	return b
+}
+
+// Shader adds the stage of the function entry of module.
+func (b *GraphicsBuilder) Shader(stage vk.ShaderStageFlags, module vk.ShaderModule, entry string) *GraphicsBuilder {
+	b.shaders = append(b.shaders, shader{stage, module, entry})
+	return b
+}
+
+// VertexBinding adds a vertex buffer binding.
+func (b *GraphicsBuilder) VertexBinding(binding, stride uint32, rate vk.VertexInputRate) *GraphicsBuilder {
+	b.bindings = append(b.bindings, vk.VertexInputBindingDescription{Binding: binding, Stride: stride, InputRate: rate})
+	return b
+}
+
+// VertexAttribute adds a vertex attribute read from binding.
+func (b *GraphicsBuilder) VertexAttribute(location, binding uint32, format vk.Format, offset uint32) *GraphicsBuilder {
+	b.attributes = append(b.attributes, vk.VertexInputAttributeDescription{Location: location, Binding: binding, Format: format, Offset: offset})
+	return b
+}
+
+// Topology sets the primitives and whether the strips and fans restart at
+// the special index.
+func (b *GraphicsBuilder) Topology(topology vk.PrimitiveTopology, restart bool) *GraphicsBuilder {
+	b.assembly.Topology, b.assembly.PrimitiveRestartEnable = topology, bool32(restart)
+	return b
+}
+
+// Tessellation sets the control points of the patches, the topology
+// becomes PATCH_LIST.
+func (b *GraphicsBuilder) Tessellation(controlPoints uint32) *GraphicsBuilder {
+	b.patch = controlPoints
+	b.assembly.Topology = vk.PRIMITIVE_TOPOLOGY_PATCH_LIST
+	return b
+}
+
+// Viewports sets static viewports and scissors, removing them from the
+// dynamic state.
+func (b *GraphicsBuilder) Viewports(viewports []vk.Viewport, scissors []vk.Rect2D) *GraphicsBuilder {
+	b.viewports = append([]vk.Viewport(nil), viewports...)
+	b.scissors = append([]vk.Rect2D(nil), scissors...)
+	dynamic := b.dynamic[:0:0]
+	for _, s := range b.dynamic {
+		if s != vk.DYNAMIC_STATE_VIEWPORT && s != vk.DYNAMIC_STATE_SCISSOR {
+			dynamic = append(dynamic, s)
+		}
+	}
+	b.dynamic = dynamic
+	return b
+}
+
+// Polygon sets how the polygons are rasterized.
+func (b *GraphicsBuilder) Polygon(mode vk.PolygonMode) *GraphicsBuilder {
+	b.raster.PolygonMode = mode
+	return b
+}
+
+// Cull sets the faces culled and which faces are the front ones.
+func (b *GraphicsBuilder) Cull(mode vk.CullModeFlags, front vk.FrontFace) *GraphicsBuilder {
+	b.raster.CullMode, b.raster.FrontFace = mode, front
+	return b
+}
+
+// LineWidth sets the width of the lines.
+func (b *GraphicsBuilder) LineWidth(width float32) *GraphicsBuilder {
+	b.raster.LineWidth = width
+	return b
+}
+
+// DepthBias enables the depth bias.
+func (b *GraphicsBuilder) DepthBias(constant, clamp, slope float32) *GraphicsBuilder {
+	b.raster.DepthBiasEnable = vk.TRUE
+	b.raster.DepthBiasConstantFactor, b.raster.DepthBiasClamp, b.raster.DepthBiasSlopeFactor = constant, clamp, slope
+	return b
+}
+
+// DepthClamp sets whether the depth is clamped rather than clipped.
+func (b *GraphicsBuilder) DepthClamp(on bool) *GraphicsBuilder {
+	b.raster.DepthClampEnable = bool32(on)
+	return b
+}
+
+// Samples sets the samples of the attachments, and the fraction of them
+// shaded if minShading is not zero.
+func (b *GraphicsBuilder) Samples(samples vk.SampleCountFlags, minShading float32) *GraphicsBuilder {
+	b.multi.RasterizationSamples = samples
+	b.multi.SampleShadingEnable, b.multi.MinSampleShading = bool32(minShading > 0), minShading
+	return b
+}
+
+// AlphaToCoverage sets whether the alpha of the fragments makes their
+// coverage.
+func (b *GraphicsBuilder) AlphaToCoverage(on bool) *GraphicsBuilder {
+	b.multi.AlphaToCoverageEnable = bool32(on)
+	return b
+}
+
+// Depth sets the depth test and write, a test of COMPARE_OP_ALWAYS
+// without write disables it.
+func (b *GraphicsBuilder) Depth(test, write bool, op vk.CompareOp) *GraphicsBuilder {
+	b.depth.DepthTestEnable, b.depth.DepthWriteEnable, b.depth.DepthCompareOp = bool32(test), bool32(write), op
+	return b
+}
+
+// Stencil enables the stencil test.
+func (b *GraphicsBuilder) Stencil(front, back vk.StencilOpState) *GraphicsBuilder {
+	b.depth.StencilTestEnable, b.depth.Front, b.depth.Back = vk.TRUE, front, back
+	return b
+}
+
+// ColorAttachments sets the blend state of each color attachment of the
+// subpass.
+func (b *GraphicsBuilder) ColorAttachments(states ...vk.PipelineColorBlendAttachmentState) *GraphicsBuilder {
+	b.attach = append([]vk.PipelineColorBlendAttachmentState(nil), states...)
+	return b
+}
+
+// BlendConstants sets the color of the CONSTANT blend factors.
+func (b *GraphicsBuilder) BlendConstants(r, g, bl, a float32) *GraphicsBuilder {
+	b.blend.BlendConstants = [4]float32{r, g, bl, a}
+	return b
+}
+
+// LogicOp enables the logic op in place of blending.
+func (b *GraphicsBuilder) LogicOp(op vk.LogicOp) *GraphicsBuilder {
+	b.blend.LogicOpEnable, b.blend.LogicOp = vk.TRUE, op
+	return b
+}
+
+// Dynamic sets the states given when drawing, they replace the dynamic
+// viewport and scissor. Without them the viewports and scissors must be
+// static, or of the dynamic states WITH_COUNT.
+func (b *GraphicsBuilder) Dynamic(states ...vk.DynamicState) *GraphicsBuilder {
+	b.dynamic = append([]vk.DynamicState(nil), states...)
+	return b
+}
+
+// Rendering makes a pipeline for dynamic rendering with the formats of
+// the attachments, the render pass is ignored. The color attachments are
+// as many as colorFormats, those added are written without blending.
+func (b *GraphicsBuilder) Rendering(viewMask uint32, colorFormats []vk.Format, depthFormat, stencilFormat vk.Format) *GraphicsBuilder {
+	b.rendering, b.renderViewMask = true, viewMask
+	b.colorFormats = append([]vk.Format(nil), colorFormats...)
+	attach := make([]vk.PipelineColorBlendAttachmentState, len(colorFormats))
+	for i := range attach {
+		attach[i] = NoBlend
+	}
+	copy(attach, b.attach)
+	b.attach = attach
+	b.depthFormat, b.stencilFormat = depthFormat, stencilFormat
+	b.renderPass, b.subpass = 0, 0
+	return b
+}
+
+// AllowDerivatives lets the pipeline be the base of others.
+func (b *GraphicsBuilder) AllowDerivatives() *GraphicsBuilder {
+	b.flags |= vk.PIPELINE_CREATE_ALLOW_DERIVATIVES_BIT
+	return b
+}
+
+// Derive makes the pipeline a derivative of base, which allowed it.
+func (b *GraphicsBuilder) Derive(base vk.Pipeline) *GraphicsBuilder {
+	b.flags |= vk.PIPELINE_CREATE_DERIVATIVE_BIT
+	b.base, b.baseIndex = base, -1
+	return b
+}
+
+// DeriveIndex makes the pipeline a derivative of the one of the builder
+// index of the same CreateGraphics, which comes before.
+func (b *GraphicsBuilder) DeriveIndex(index int) *GraphicsBuilder {
+	b.flags |= vk.PIPELINE_CREATE_DERIVATIVE_BIT
+	b.base, b.baseIndex = 0, int32(index)
+	return b
+}
+
+// Build creates the pipeline.
+func (b *GraphicsBuilder) Build(device vkx.Device, cache vk.PipelineCache) (vk.Pipeline, error) {
+	pipelines, err := CreateGraphics(device, cache, b)
+	if err != nil {
+		return 0, err
+	}
+	return pipelines[0], nil
+}
+
+// CreateGraphics creates the pipeline of each builder in one call.
+func CreateGraphics(device vkx.Device, cache vk.PipelineCache, builders ...*GraphicsBuilder) ([]vk.Pipeline, error) {
+	if len(builders) == 0 {
+		return nil, nil
+	}
+	var m cmem.Arena
+	defer m.Free()
+	n := len(builders)
+	infos := (*[1 << 16]vk.GraphicsPipelineCreateInfo)(m.Alloc(n, unsafe.Sizeof(vk.GraphicsPipelineCreateInfo{})))[:n:n]
+	for i, b := range builders {
+		if err := b.fill(&m, &infos[i]); err != nil {
+			return nil, err
+		}
+	}
+	return device.CreateGraphicsPipelines(cache, infos)
+}
+
+// copyOf returns a C copy of the n elements of size bytes at p.
+func copyOf(m *cmem.Arena, p unsafe.Pointer, n int, size uintptr) unsafe.Pointer {
+	return m.Copy(p, uintptr(n)*size)
+}
+
+// isDynamic reports whether state is one of the dynamic states of b.
+func (b *GraphicsBuilder) isDynamic(state vk.DynamicState) bool {
+	for _, s := range b.dynamic {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
+// fill sets info, in C memory, to the state of b.
+func (b *GraphicsBuilder) fill(m *cmem.Arena, info *vk.GraphicsPipelineCreateInfo) error {
+	if b.rendering && len(b.attach) != len(b.colorFormats) {
+		return ErrColorAttachments
+	}
+	*info = vk.GraphicsPipelineCreateInfo{
+		SType:              vk.STRUCTURE_TYPE_GRAPHICS_PIPELINE_CREATE_INFO,
+		Flags:              b.flags,
+		Layout:             b.layout,
+		RenderPass:         b.renderPass,
+		Subpass:            b.subpass,
+		BasePipelineHandle: b.base,
+		BasePipelineIndex:  b.baseIndex,
+	}
+
+	if n := len(b.shaders); n > 0 {
+		stages := (*[64]vk.PipelineShaderStageCreateInfo)(m.Alloc(n, unsafe.Sizeof(vk.PipelineShaderStageCreateInfo{})))[:n:n]
+		for i, s := range b.shaders {
+			stages[i] = vk.PipelineShaderStageCreateInfo{
+				SType:  vk.STRUCTURE_TYPE_PIPELINE_SHADER_STAGE_CREATE_INFO,
+				Stage:  s.stage,
+				Module: s.module,
+				PName:  m.String(s.entry),
+			}
+		}
+		info.StageCount, info.PStages = uint32(n), &stages[0]
+	}
+
+	vertex := (*vk.PipelineVertexInputStateCreateInfo)(m.Alloc(1, unsafe.Sizeof(vk.PipelineVertexInputStateCreateInfo{})))
+	vertex.SType = vk.STRUCTURE_TYPE_PIPELINE_VERTEX_INPUT_STATE_CREATE_INFO
+	if n := len(b.bindings); n > 0 {
+		vertex.VertexBindingDescriptionCount = uint32(n)
+		vertex.PVertexBindingDescriptions = (*vk.VertexInputBindingDescription)(copyOf(m, unsafe.Pointer(&b.bindings[0]), n, unsafe.Sizeof(b.bindings[0])))
+	}
+	if n := len(b.attributes); n > 0 {
+		vertex.VertexAttributeDescriptionCount = uint32(n)
+		vertex.PVertexAttributeDescriptions = (*vk.VertexInputAttributeDescription)(copyOf(m, unsafe.Pointer(&b.attributes[0]), n, unsafe.Sizeof(b.attributes[0])))
+	}
+	info.PVertexInputState = vertex
+	info.PInputAssemblyState = (*vk.PipelineInputAssemblyStateCreateInfo)(m.Copy(unsafe.Pointer(&b.assembly), unsafe.Sizeof(b.assembly)))
+
+	if b.patch > 0 {
+		tess := (*vk.PipelineTessellationStateCreateInfo)(m.Alloc(1, unsafe.Sizeof(vk.PipelineTessellationStateCreateInfo{})))
+		tess.SType, tess.PatchControlPoints = vk.STRUCTURE_TYPE_PIPELINE_TESSELLATION_STATE_CREATE_INFO, b.patch
+		info.PTessellationState = tess
+	}
+
+	viewport := (*vk.PipelineViewportStateCreateInfo)(m.Alloc(1, unsafe.Sizeof(vk.PipelineViewportStateCreateInfo{})))
+	viewport.SType = vk.STRUCTURE_TYPE_PIPELINE_VIEWPORT_STATE_CREATE_INFO
+	// the count is that of the static ones, 1 of the dynamic ones, 0 if it
+	// is dynamic too
+	switch n := len(b.viewports); {
+	case b.isDynamic(vk.DYNAMIC_STATE_VIEWPORT_WITH_COUNT):
+	case b.isDynamic(vk.DYNAMIC_STATE_VIEWPORT):
+		viewport.ViewportCount = 1
+	case n > 0:
+		viewport.ViewportCount = uint32(n)
+		viewport.PViewports = (*vk.Viewport)(copyOf(m, unsafe.Pointer(&b.viewports[0]), n, unsafe.Sizeof(b.viewports[0])))
+	default:
+		return ErrViewport
+	}
+	switch n := len(b.scissors); {
+	case b.isDynamic(vk.DYNAMIC_STATE_SCISSOR_WITH_COUNT):
+	case b.isDynamic(vk.DYNAMIC_STATE_SCISSOR):
+		viewport.ScissorCount = 1
+	case n > 0:
+		viewport.ScissorCount = uint32(n)
+		viewport.PScissors = (*vk.Rect2D)(copyOf(m, unsafe.Pointer(&b.scissors[0]), n, unsafe.Sizeof(b.scissors[0])))
+	default:
+		return ErrViewport
+	}
+	info.PViewportState = viewport
+
+	info.PRasterizationState = (*vk.PipelineRasterizationStateCreateInfo)(m.Copy(unsafe.Pointer(&b.raster), unsafe.Sizeof(b.raster)))
+	info.PMultisampleState = (*vk.PipelineMultisampleStateCreateInfo)(m.Copy(unsafe.Pointer(&b.multi), unsafe.Sizeof(b.multi)))
+	info.PDepthStencilState = (*vk.PipelineDepthStencilStateCreateInfo)(m.Copy(unsafe.Pointer(&b.depth), unsafe.Sizeof(b.depth)))
+
+	blend := (*vk.PipelineColorBlendStateCreateInfo)(m.Copy(unsafe.Pointer(&b.blend), unsafe.Sizeof(b.blend)))
+	if n := len(b.attach); n > 0 {
+		blend.AttachmentCount = uint32(n)
+		blend.PAttachments = (*vk.PipelineColorBlendAttachmentState)(copyOf(m, unsafe.Pointer(&b.attach[0]), n, unsafe.Sizeof(b.attach[0])))
+	}
+	info.PColorBlendState = blend
+
+	if n := len(b.dynamic); n > 0 {
+		dynamic := (*vk.PipelineDynamicStateCreateInfo)(m.Alloc(1, unsafe.Sizeof(vk.PipelineDynamicStateCreateInfo{})))
+		dynamic.SType = vk.STRUCTURE_TYPE_PIPELINE_DYNAMIC_STATE_CREATE_INFO
+		dynamic.DynamicStateCount = uint32(n)
+		dynamic.PDynamicStates = (*vk.DynamicState)(copyOf(m, unsafe.Pointer(&b.dynamic[0]), n, unsafe.Sizeof(b.dynamic[0])))
+		info.PDynamicState = dynamic
+	}
+
+	if b.rendering {
+		rendering := (*vk.PipelineRenderingCreateInfo)(m.Alloc(1, unsafe.Sizeof(vk.PipelineRenderingCreateInfo{})))
+		rendering.SType = vk.STRUCTURE_TYPE_PIPELINE_RENDERING_CREATE_INFO
+		rendering.ViewMask = b.renderViewMask
+		if n := len(b.colorFormats); n > 0 {
+			rendering.ColorAttachmentCount = uint32(n)
+			rendering.PColorAttachmentFormats = (*vk.Format)(copyOf(m, unsafe.Pointer(&b.colorFormats[0]), n, unsafe.Sizeof(b.colorFormats[0])))
+		}
+		rendering.DepthAttachmentFormat, rendering.StencilAttachmentFormat = b.depthFormat, b.stencilFormat
+		info.PNext = unsafe.Pointer(rendering)
+	}
+	return nil
+}
+
+func bool32(b bool) vk.Bool32 {
+	if b {
+		return vk.TRUE
+	}
+	return vk.FALSE
+}
@@ -0,0 +1,179 @@
+package pipeline
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"unsafe"
+
+	"github.com/toy80/vk"
+	"github.com/toy80/vk/vkx/internal/cmem"
+)
+
+func TestGraphicsBuilder(t *testing.T) {
+	b := NewGraphics(1, 2, 3).
+		Shader(vk.SHADER_STAGE_VERTEX_BIT, 10, "main").
+		Shader(vk.SHADER_STAGE_FRAGMENT_BIT, 11, "fs").
+		VertexBinding(0, 20, vk.VERTEX_INPUT_RATE_VERTEX).
+		VertexAttribute(0, 0, vk.FORMAT_R32G32B32_SFLOAT, 0).
+		VertexAttribute(1, 0, vk.FORMAT_R32G32_SFLOAT, 12).
+		Dynamic(vk.DYNAMIC_STATE_VIEWPORT, vk.DYNAMIC_STATE_SCISSOR, vk.DYNAMIC_STATE_LINE_WIDTH).
+		Viewports([]vk.Viewport{{Width: 640, Height: 480, MaxDepth: 1}}, []vk.Rect2D{{Extent: vk.Extent2D{Width: 640, Height: 480}}}).
+		ColorAttachments(AlphaBlend, NoBlend).
+		DeriveIndex(0)
+
+	var m cmem.Arena
+	defer m.Free()
+	var info vk.GraphicsPipelineCreateInfo
+	if err := b.fill(&m, &info); err != nil {
+		t.Fatal(err)
+	}
+
+	if info.Layout != 1 || info.RenderPass != 2 || info.Subpass != 3 || info.BasePipelineIndex != 0 || info.Flags != vk.PIPELINE_CREATE_DERIVATIVE_BIT {
+		t.Errorf("info = %+v", info)
+	}
+	stages := (*[2]vk.PipelineShaderStageCreateInfo)(unsafe.Pointer(info.PStages))
+	if info.StageCount != 2 || stages[1].Module != 11 || *(*[3]byte)(unsafe.Pointer(stages[1].PName)) != [3]byte{'f', 's', 0} {
+		t.Errorf("stages = %+v", stages)
+	}
+	attrs := (*[2]vk.VertexInputAttributeDescription)(unsafe.Pointer(info.PVertexInputState.PVertexAttributeDescriptions))
+	if info.PVertexInputState.VertexAttributeDescriptionCount != 2 || attrs[1].Offset != 12 || info.PVertexInputState.PVertexBindingDescriptions.Stride != 20 {
+		t.Errorf("vertex input = %+v", info.PVertexInputState)
+	}
+	if v := info.PViewportState; v.ViewportCount != 1 || v.PViewports.Width != 640 || v.PScissors.Extent.Height != 480 {
+		t.Errorf("viewport state = %+v", v)
+	}
+	if d := info.PDynamicState; d.DynamicStateCount != 1 || *d.PDynamicStates != vk.DYNAMIC_STATE_LINE_WIDTH {
+		t.Errorf("dynamic state = %+v", d)
+	}
+	attach := (*[2]vk.PipelineColorBlendAttachmentState)(unsafe.Pointer(info.PColorBlendState.PAttachments))
+	if info.PColorBlendState.AttachmentCount != 2 || !reflect.DeepEqual(*attach, [2]vk.PipelineColorBlendAttachmentState{AlphaBlend, NoBlend}) {
+		t.Errorf("blend attachments = %+v", attach)
+	}
+	if r := info.PRasterizationState; r.CullMode != vk.CullModeFlags(vk.CULL_MODE_BACK_BIT) || r.LineWidth != 1 {
+		t.Errorf("rasterization state = %+v", r)
+	}
+	if info.PTessellationState != nil || info.PNext != nil {
+		t.Errorf("unexpected tessellation state or pNext")
+	}
+}
+
+func TestViewportCount(t *testing.T) {
+	tests := []struct {
+		b                   *GraphicsBuilder
+		viewports, scissors uint32
+		err                 error
+	}{
+		{NewGraphics(1, 2, 0), 1, 1, nil},
+		{NewGraphics(1, 2, 0).Dynamic(vk.DYNAMIC_STATE_LINE_WIDTH), 0, 0, ErrViewport},
+		{NewGraphics(1, 2, 0).Dynamic(vk.DYNAMIC_STATE_VIEWPORT), 0, 0, ErrViewport},
+		{NewGraphics(1, 2, 0).Dynamic(vk.DYNAMIC_STATE_VIEWPORT_WITH_COUNT, vk.DYNAMIC_STATE_SCISSOR_WITH_COUNT), 0, 0, nil},
+		{NewGraphics(1, 2, 0).Dynamic(vk.DYNAMIC_STATE_VIEWPORT).Viewports(nil, []vk.Rect2D{{}, {}}), 0, 2, ErrViewport},
+		{NewGraphics(1, 2, 0).Viewports(nil, []vk.Rect2D{{}}).Dynamic(vk.DYNAMIC_STATE_VIEWPORT), 1, 1, nil},
+	}
+	for i, test := range tests {
+		var m cmem.Arena
+		var info vk.GraphicsPipelineCreateInfo
+		err := test.b.fill(&m, &info)
+		if err != test.err {
+			t.Errorf("%d: fill() = %v, want %v", i, err, test.err)
+		} else if err == nil {
+			if v := info.PViewportState; v.ViewportCount != test.viewports || v.ScissorCount != test.scissors || v.PViewports != nil {
+				t.Errorf("%d: viewport state = %+v, want %d viewports and %d scissors", i, v, test.viewports, test.scissors)
+			}
+		}
+		m.Free()
+	}
+}
+
+func TestRenderingAttachments(t *testing.T) {
+	var m cmem.Arena
+	defer m.Free()
+	var info vk.GraphicsPipelineCreateInfo
+	depthOnly := NewGraphics(1, 2, 0).Rendering(0, nil, vk.FORMAT_D32_SFLOAT, vk.FORMAT_UNDEFINED)
+	if err := depthOnly.fill(&m, &info); err != nil {
+		t.Fatal(err)
+	}
+	if n := info.PColorBlendState.AttachmentCount; n != 0 || info.RenderPass != 0 {
+		t.Errorf("depth only pipeline of %d color attachments, render pass %v", n, info.RenderPass)
+	}
+
+	// the blend states given first are kept
+	formats := []vk.Format{vk.FORMAT_R8G8B8A8_UNORM, vk.FORMAT_R16G16B16A16_SFLOAT}
+	b := NewGraphics(1, 0, 0).ColorAttachments(AlphaBlend).Rendering(0, formats, 0, 0)
+	if err := b.fill(&m, &info); err != nil {
+		t.Fatal(err)
+	}
+	attach := (*[2]vk.PipelineColorBlendAttachmentState)(unsafe.Pointer(info.PColorBlendState.PAttachments))
+	if info.PColorBlendState.AttachmentCount != 2 || !reflect.DeepEqual(*attach, [2]vk.PipelineColorBlendAttachmentState{AlphaBlend, NoBlend}) {
+		t.Errorf("blend attachments = %+v", attach)
+	}
+	if r := (*vk.PipelineRenderingCreateInfo)(info.PNext); r.ColorAttachmentCount != 2 || r.DepthAttachmentFormat != 0 {
+		t.Errorf("rendering info = %+v", r)
+	}
+	if err := b.ColorAttachments(NoBlend).fill(&m, &info); err != ErrColorAttachments {
+		t.Errorf("fill() with a blend state for 2 formats = %v, want ErrColorAttachments", err)
+	}
+}
+
+func TestHeader(t *testing.T) {
+	props := vk.PhysicalDeviceProperties{VendorID: 0x10de, DeviceID: 0x2204}
+	props.PipelineCacheUUID[0] = 7
+	data := make([]byte, 40)
+	binary.LittleEndian.PutUint32(data, 32)
+	binary.LittleEndian.PutUint32(data[4:], 1)
+	binary.LittleEndian.PutUint32(data[8:], 0x10de)
+	binary.LittleEndian.PutUint32(data[12:], 0x2204)
+	data[16] = 7
+
+	h, err := ParseHeader(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.DeviceID != 0x2204 || h.UUID[0] != 7 {
+		t.Errorf("ParseHeader() = %+v", h)
+	}
+	if err := h.Check(&props); err != nil {
+		t.Errorf("Check() = %v", err)
+	}
+	other := props
+	other.DeviceID++
+	if h.Check(&other) == nil {
+		t.Errorf("Check() of another device = nil")
+	}
+	other = props
+	other.PipelineCacheUUID[15] = 1
+	if h.Check(&other) == nil {
+		t.Errorf("Check() of another driver = nil")
+	}
+	if _, err := ParseHeader(data[:20]); err != ErrHeader {
+		t.Errorf("ParseHeader() of short data = %v", err)
+	}
+	binary.LittleEndian.PutUint32(data, 64)
+	if _, err := ParseHeader(data); err == nil {
+		t.Errorf("ParseHeader() with a header longer than the data = nil")
+	}
+}
+
+func TestWriteFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pipeline")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "cache")
+	for _, s := range []string{"old", "new"} {
+		if err := writeFile(path, []byte(s)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if b, err := ioutil.ReadFile(path); err != nil || string(b) != "new" {
+		t.Errorf("ReadFile() = %q, %v", b, err)
+	}
+	if files, _ := ioutil.ReadDir(dir); len(files) != 1 {
+		t.Errorf("%d files left, want 1", len(files))
+	}
+}
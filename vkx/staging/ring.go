@@ -0,0 +1,58 @@
+package staging
+
+import "github.com/toy80/vk"
+
+// ring hands out the ranges of the staging buffer in order. The ranges of
+// a batch are given back together, when its submission completes, so the
+// used bytes always start at tail and end at head, wrapping at size.
+type ring struct {
+	size       vk.DeviceSize
+	head, tail vk.DeviceSize
+	used       vk.DeviceSize // from tail to head, with the end skipped on wrapping
+}
+
+func alignUp(x, align vk.DeviceSize) vk.DeviceSize {
+	if align <= 1 {
+		return x
+	}
+	return (x + align - 1) / align * align
+}
+
+// alloc returns the offset of n bytes aligned to align, and how many bytes
+// it took in all, the padding and the skipped end of the buffer included.
+func (r *ring) alloc(n, align vk.DeviceSize) (offset, taken vk.DeviceSize, ok bool) {
+	offset = alignUp(r.head, align)
+	switch {
+	case r.used > 0 && r.head <= r.tail:
+		if offset+n > r.tail {
+			return 0, 0, false
+		}
+	case offset+n > r.size:
+		// wrap, the range must end before the oldest one
+		if n > r.tail {
+			return 0, 0, false
+		}
+		offset = 0
+		taken = r.size - r.head
+		r.head = 0
+	}
+	taken += offset + n - r.head
+	r.head = offset + n
+	r.used += taken
+	return offset, taken, true
+}
+
+// release gives back the taken bytes of the oldest batch, which ended at
+// end. A batch that took nothing holds no range, and its end may already be
+// reused by a later batch, so it leaves the ring alone. The ring restarts
+// at 0 only when no batch holds any bytes.
+func (r *ring) release(end, taken vk.DeviceSize) {
+	if taken == 0 {
+		return
+	}
+	r.tail = end
+	r.used -= taken
+	if r.used == 0 {
+		r.head, r.tail = 0, 0
+	}
+}
@@ -0,0 +1,496 @@
+// Package staging uploads data to buffers and images through a ring buffer
+// mapped for its whole life. The copies are recorded into one command
+// buffer and submitted together by Flush, on a transfer queue:
+//
+//   - TransferFamily finds the dedicated transfer queue family if any
+//   - the data comes from a []byte or an io.Reader
+//   - the ownership of the resources is released to the queue family
+//     using them, Token.Acquire records its acquisition there
+//   - a Token tells when an upload has completed
+//
+// An Uploader is safe for concurrent use.
+package staging
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math"
+	"sync"
+	"unsafe"
+
+	"github.com/toy80/vk"
+	"github.com/toy80/vk/vkx"
+	"github.com/toy80/vk/vkx/mem"
+)
+
+// DefaultSize is the size of the ring buffer when Info has none.
+const DefaultSize vk.DeviceSize = 64 << 20
+
+// maxSize is the largest ring buffer, it is addressed as a Go array.
+const maxSize vk.DeviceSize = 1 << 30
+
+// ErrTooLarge is returned for an image upload larger than the ring buffer.
+// The buffer uploads are split.
+var ErrTooLarge = errors.New("staging: upload larger than the ring buffer")
+
+// TransferFamily returns the queue family of the uploads: one with TRANSFER
+// and neither GRAPHICS nor COMPUTE if the device has one, else the first
+// with GRAPHICS or COMPUTE, which can transfer too.
+func TransferFamily(families []vk.QueueFamilyProperties) (family uint32, dedicated bool) {
+	const graphicsCompute = vk.QUEUE_GRAPHICS_BIT | vk.QUEUE_COMPUTE_BIT
+	family = vk.QUEUE_FAMILY_IGNORED
+	for i, f := range families {
+		switch {
+		case f.QueueCount == 0:
+		case f.QueueFlags&vk.QUEUE_TRANSFER_BIT != 0 && f.QueueFlags&graphicsCompute == 0:
+			return uint32(i), true
+		case f.QueueFlags&graphicsCompute != 0 && family == vk.QUEUE_FAMILY_IGNORED:
+			family = uint32(i)
+		}
+	}
+	return family, false
+}
+
+// Info describes an Uploader.
+type Info struct {
+	Queue  vkx.Queue     // where the copies are submitted
+	Family uint32        // the queue family of Queue
+	Size   vk.DeviceSize // of the ring buffer, DefaultSize if zero
+}
+
+// BufferUpload is where an upload to a buffer goes.
+type BufferUpload struct {
+	Buffer vk.Buffer
+	Offset vk.DeviceSize
+	// DstFamily is the queue family using Buffer, its ownership is
+	// transferred when it is not the one of the Uploader. It is
+	// vk.QUEUE_FAMILY_IGNORED for the concurrent buffers.
+	DstFamily uint32
+}
+
+// ImageUpload is where an upload to an image goes.
+type ImageUpload struct {
+	Image     vk.Image
+	Region    vk.BufferImageCopy // BufferOffset is set by the Uploader
+	OldLayout vk.ImageLayout     // of the subresource, UNDEFINED discards its content
+	Layout    vk.ImageLayout     // of the subresource after the upload
+	TexelSize vk.DeviceSize      // of a texel block of the format, the data is aligned to it
+	DstFamily uint32             // as in BufferUpload
+}
+
+// batch is the copies of a submission.
+type batch struct {
+	cmd    vkx.CommandBuffer
+	fence  vk.Fence
+	serial uint64
+	end    vk.DeviceSize // ring head after its data
+	taken  vk.DeviceSize // ring bytes of its data
+}
+
+// Uploader copies data to buffers and images through its ring buffer.
+type Uploader struct {
+	device vkx.Device
+	a      *mem.Allocator
+	queue  vkx.Queue
+	family uint32
+	buffer vk.Buffer
+	alloc  *mem.Allocation
+	pool   vk.CommandPool
+
+	mu      sync.Mutex
+	ring    ring
+	serial  uint64 // of the last batch begun
+	done    uint64 // of the last batch completed
+	current *batch // recording
+	flight  []*batch
+	spare   []*batch
+}
+
+// New creates an Uploader of device, its ring buffer is allocated by a.
+func New(device vkx.Device, a *mem.Allocator, info *Info) (*Uploader, error) {
+	size := info.Size
+	if size == 0 {
+		size = DefaultSize
+	}
+	if size > maxSize {
+		size = maxSize
+	}
+	u := &Uploader{device: device, a: a, queue: info.Queue, family: info.Family, ring: ring{size: size}}
+	var err error
+	u.buffer, u.alloc, err = a.CreateBuffer(&vk.BufferCreateInfo{
+		SType:       vk.STRUCTURE_TYPE_BUFFER_CREATE_INFO,
+		Size:        size,
+		Usage:       vk.BUFFER_USAGE_TRANSFER_SRC_BIT,
+		SharingMode: vk.SHARING_MODE_EXCLUSIVE,
+	}, &mem.AllocationInfo{Preferred: vk.MEMORY_PROPERTY_HOST_COHERENT_BIT, Mapped: true})
+	if err != nil {
+		return nil, err
+	}
+	u.pool, err = device.CreateCommandPool(&vk.CommandPoolCreateInfo{
+		SType:            vk.STRUCTURE_TYPE_COMMAND_POOL_CREATE_INFO,
+		Flags:            vk.COMMAND_POOL_CREATE_TRANSIENT_BIT | vk.COMMAND_POOL_CREATE_RESET_COMMAND_BUFFER_BIT,
+		QueueFamilyIndex: info.Family,
+	})
+	if err != nil {
+		a.DestroyBuffer(u.buffer, u.alloc)
+		return nil, err
+	}
+	return u, nil
+}
+
+// Destroy waits for the uploads and frees the Uploader.
+func (u *Uploader) Destroy() error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	err := u.wait(u.serial, math.MaxUint64)
+	if u.current != nil {
+		u.spare = append(u.spare, u.current) // not submitted after an error
+		u.current = nil
+	}
+	for _, b := range append(u.flight, u.spare...) {
+		u.device.DestroyFence(b.fence)
+	}
+	u.flight, u.spare = nil, nil
+	u.device.DestroyCommandPool(u.pool)
+	u.a.DestroyBuffer(u.buffer, u.alloc)
+	return err
+}
+
+// UploadBuffer copies data to dst.
+func (u *Uploader) UploadBuffer(dst BufferUpload, data []byte) (Token, error) {
+	return u.UploadBufferFrom(dst, bytes.NewReader(data), vk.DeviceSize(len(data)))
+}
+
+// UploadBufferFrom copies size bytes read from r to dst, in parts if they
+// do not fit in the ring buffer. After an error, some parts may have been
+// copied.
+func (u *Uploader) UploadBufferFrom(dst BufferUpload, r io.Reader, size vk.DeviceSize) (Token, error) {
+	if size == 0 {
+		return Token{}, nil
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	var b *batch
+	part := u.ring.size / 2
+	for done := vk.DeviceSize(0); done < size; {
+		n := size - done
+		if n > part {
+			n = part
+		}
+		var offset vk.DeviceSize
+		var err error
+		if b, offset, err = u.reserve(n, 16); err != nil {
+			return Token{}, err
+		}
+		if _, err = io.ReadFull(r, u.bytes(offset, n)); err != nil {
+			return Token{}, err
+		}
+		b.cmd.CmdCopyBuffer(u.buffer, dst.Buffer, []vk.BufferCopy{{SrcOffset: offset, DstOffset: dst.Offset + done, Size: n}})
+		done += n
+	}
+	t := Token{u: u, serial: b.serial}
+	if u.transfers(dst.DstFamily) {
+		barrier := vk.BufferMemoryBarrier{
+			SType:               vk.STRUCTURE_TYPE_BUFFER_MEMORY_BARRIER,
+			SrcAccessMask:       vk.ACCESS_TRANSFER_WRITE_BIT,
+			SrcQueueFamilyIndex: u.family,
+			DstQueueFamilyIndex: dst.DstFamily,
+			Buffer:              dst.Buffer,
+			Offset:              dst.Offset,
+			Size:                size,
+		}
+		b.cmd.CmdPipelineBarrier(vk.PIPELINE_STAGE_TRANSFER_BIT, vk.PIPELINE_STAGE_BOTTOM_OF_PIPE_BIT, 0, nil, []vk.BufferMemoryBarrier{barrier}, nil)
+		barrier.SrcAccessMask = 0
+		t.buffers = []vk.BufferMemoryBarrier{barrier}
+	}
+	return t, nil
+}
+
+// UploadImage copies data to dst, and transitions the subresource to
+// dst.Layout.
+func (u *Uploader) UploadImage(dst ImageUpload, data []byte) (Token, error) {
+	return u.UploadImageFrom(dst, bytes.NewReader(data), vk.DeviceSize(len(data)))
+}
+
+// UploadImageFrom copies size bytes read from r to dst, and transitions
+// the subresource to dst.Layout. It returns ErrTooLarge if they do not fit
+// in the ring buffer.
+func (u *Uploader) UploadImageFrom(dst ImageUpload, r io.Reader, size vk.DeviceSize) (Token, error) {
+	if size > u.ring.size {
+		return Token{}, ErrTooLarge
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	align := vk.DeviceSize(4) // bufferOffset must be a multiple of 4 and of the texel size
+	if dst.TexelSize > 0 {
+		for align%dst.TexelSize != 0 {
+			align += 4
+		}
+	}
+	b, offset, err := u.reserve(size, align)
+	if err != nil {
+		return Token{}, err
+	}
+	if _, err = io.ReadFull(r, u.bytes(offset, size)); err != nil {
+		return Token{}, err
+	}
+	sub := dst.Region.ImageSubresource
+	barrier := vk.ImageMemoryBarrier{
+		SType:               vk.STRUCTURE_TYPE_IMAGE_MEMORY_BARRIER,
+		DstAccessMask:       vk.ACCESS_TRANSFER_WRITE_BIT,
+		OldLayout:           dst.OldLayout,
+		NewLayout:           vk.IMAGE_LAYOUT_TRANSFER_DST_OPTIMAL,
+		SrcQueueFamilyIndex: vk.QUEUE_FAMILY_IGNORED,
+		DstQueueFamilyIndex: vk.QUEUE_FAMILY_IGNORED,
+		Image:               dst.Image,
+		SubresourceRange: vk.ImageSubresourceRange{
+			AspectMask:     sub.AspectMask,
+			BaseMipLevel:   sub.MipLevel,
+			LevelCount:     1,
+			BaseArrayLayer: sub.BaseArrayLayer,
+			LayerCount:     sub.LayerCount,
+		},
+	}
+	b.cmd.CmdPipelineBarrier(vk.PIPELINE_STAGE_TOP_OF_PIPE_BIT, vk.PIPELINE_STAGE_TRANSFER_BIT, 0, nil, nil, []vk.ImageMemoryBarrier{barrier})
+	region := dst.Region
+	region.BufferOffset = offset
+	b.cmd.CmdCopyBufferToImage(u.buffer, dst.Image, vk.IMAGE_LAYOUT_TRANSFER_DST_OPTIMAL, []vk.BufferImageCopy{region})
+
+	t := Token{u: u, serial: b.serial}
+	barrier.SrcAccessMask, barrier.DstAccessMask = vk.ACCESS_TRANSFER_WRITE_BIT, 0
+	barrier.OldLayout, barrier.NewLayout = vk.IMAGE_LAYOUT_TRANSFER_DST_OPTIMAL, dst.Layout
+	if u.transfers(dst.DstFamily) {
+		barrier.SrcQueueFamilyIndex, barrier.DstQueueFamilyIndex = u.family, dst.DstFamily
+	} else if dst.Layout == vk.IMAGE_LAYOUT_TRANSFER_DST_OPTIMAL {
+		return t, nil
+	}
+	b.cmd.CmdPipelineBarrier(vk.PIPELINE_STAGE_TRANSFER_BIT, vk.PIPELINE_STAGE_BOTTOM_OF_PIPE_BIT, 0, nil, nil, []vk.ImageMemoryBarrier{barrier})
+	if u.transfers(dst.DstFamily) {
+		barrier.SrcAccessMask = 0
+		t.images = []vk.ImageMemoryBarrier{barrier}
+	}
+	return t, nil
+}
+
+// transfers reports whether the ownership of a resource used by family
+// is transferred.
+func (u *Uploader) transfers(family uint32) bool {
+	return family != vk.QUEUE_FAMILY_IGNORED && family != u.family
+}
+
+// bytes returns n bytes of the ring buffer from offset.
+func (u *Uploader) bytes(offset, n vk.DeviceSize) []byte {
+	return (*[maxSize]byte)(u.alloc.Mapped)[offset : offset+n : offset+n]
+}
+
+// reserve takes n bytes of the ring buffer for the current batch, waiting
+// for the oldest batches to complete if it is full.
+func (u *Uploader) reserve(n, align vk.DeviceSize) (*batch, vk.DeviceSize, error) {
+	for {
+		b, err := u.begin()
+		if err != nil {
+			return nil, 0, err
+		}
+		if offset, taken, ok := u.ring.alloc(n, align); ok {
+			b.taken += taken
+			b.end = u.ring.head
+			return b, offset, nil
+		}
+		switch {
+		case len(u.flight) > 0:
+			err = u.wait(u.flight[0].serial, math.MaxUint64)
+		case b.taken > 0:
+			err = u.flush(nil)
+		default:
+			return nil, 0, ErrTooLarge
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+}
+
+// begin returns the current batch, beginning one if there is none.
+func (u *Uploader) begin() (*batch, error) {
+	if u.current != nil {
+		return u.current, nil
+	}
+	var b *batch
+	if n := len(u.spare); n > 0 {
+		b, u.spare = u.spare[n-1], u.spare[:n-1]
+	} else {
+		cmds, err := u.device.AllocateCommandBuffers(&vk.CommandBufferAllocateInfo{
+			SType:              vk.STRUCTURE_TYPE_COMMAND_BUFFER_ALLOCATE_INFO,
+			CommandPool:        u.pool,
+			Level:              vk.COMMAND_BUFFER_LEVEL_PRIMARY,
+			CommandBufferCount: 1,
+		})
+		if err != nil {
+			return nil, err
+		}
+		fence, err := u.device.CreateFence(&vk.FenceCreateInfo{SType: vk.STRUCTURE_TYPE_FENCE_CREATE_INFO})
+		if err != nil {
+			u.device.FreeCommandBuffers(u.pool, cmds)
+			return nil, err
+		}
+		b = &batch{cmd: cmds[0], fence: fence}
+	}
+	err := b.cmd.BeginCommandBuffer(&vk.CommandBufferBeginInfo{
+		SType: vk.STRUCTURE_TYPE_COMMAND_BUFFER_BEGIN_INFO,
+		Flags: vk.COMMAND_BUFFER_USAGE_ONE_TIME_SUBMIT_BIT,
+	})
+	if err != nil {
+		u.spare = append(u.spare, b)
+		return nil, err
+	}
+	u.serial++
+	b.serial, b.end, b.taken = u.serial, u.ring.head, 0
+	u.current = b
+	return b, nil
+}
+
+// Flush submits the uploads recorded since the last Flush, their
+// submission signals the semaphores. The semaphores are signaled by an
+// empty submission if there are none.
+func (u *Uploader) Flush(signal ...vk.Semaphore) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return u.flush(signal)
+}
+
+func (u *Uploader) flush(signal []vk.Semaphore) error {
+	if u.current == nil {
+		if len(signal) == 0 {
+			return nil
+		}
+		if _, err := u.begin(); err != nil {
+			return err
+		}
+	}
+	b := u.current
+	if err := b.cmd.EndCommandBuffer(); err != nil {
+		return err
+	}
+	if err := u.a.Flush(u.alloc, 0, vk.WHOLE_SIZE); err != nil {
+		return err
+	}
+	if err := u.submit(b, signal); err != nil {
+		return err
+	}
+	u.current = nil
+	u.flight = append(u.flight, b)
+	return nil
+}
+
+// submit submits the command buffer of b. The SubmitInfo and its arrays
+// are in C memory, as cgo wants.
+func (u *Uploader) submit(b *batch, signal []vk.Semaphore) error {
+	info := vk.NewSubmitInfo()
+	defer info.Free()
+	pCmd := (*vk.CommandBuffer)(vk.MemAlloc(unsafe.Sizeof(b.cmd.CommandBuffer)))
+	defer vk.MemFree(unsafe.Pointer(pCmd))
+	*pCmd = b.cmd.CommandBuffer
+	info.CommandBufferCount, info.PCommandBuffers = 1, pCmd
+	if n := len(signal); n > 0 {
+		p := vk.MemAlloc(uintptr(n) * unsafe.Sizeof(signal[0]))
+		defer vk.MemFree(p)
+		copy((*[1 << 16]vk.Semaphore)(p)[:n:n], signal)
+		info.SignalSemaphoreCount, info.PSignalSemaphores = uint32(n), (*vk.Semaphore)(p)
+	}
+	return u.queue.DeviceTable.QueueSubmit.Call(u.queue.Queue, 1, info, b.fence).Err()
+}
+
+// wait waits for the batches up to serial, submitting the current one if
+// it is one of them.
+func (u *Uploader) wait(serial, timeout uint64) error {
+	if u.current != nil && u.current.serial <= serial {
+		if err := u.flush(nil); err != nil {
+			return err
+		}
+	}
+	for len(u.flight) > 0 && u.flight[0].serial <= serial {
+		if err := u.device.WaitForFences([]vk.Fence{u.flight[0].fence}, vk.TRUE, timeout); err != nil {
+			return err
+		}
+		if err := u.retire(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// poll retires the completed batches.
+func (u *Uploader) poll() error {
+	for len(u.flight) > 0 && u.device.GetFenceStatus(u.flight[0].fence) == nil {
+		if err := u.retire(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// retire gives back the ring bytes of the oldest batch, which completed.
+func (u *Uploader) retire() error {
+	b := u.flight[0]
+	if err := u.device.ResetFences([]vk.Fence{b.fence}); err != nil {
+		return err
+	}
+	u.flight = u.flight[1:]
+	u.spare = append(u.spare, b)
+	u.ring.release(b.end, b.taken)
+	u.done = b.serial
+	return nil
+}
+
+// Token is the completion of an upload. The uploads complete in order, so
+// a Token is the completion of the uploads before it too.
+type Token struct {
+	u       *Uploader
+	serial  uint64
+	buffers []vk.BufferMemoryBarrier
+	images  []vk.ImageMemoryBarrier
+}
+
+// Done reports whether the upload has completed. The zero Token is done.
+func (t Token) Done() bool {
+	if t.u == nil {
+		return true
+	}
+	t.u.mu.Lock()
+	defer t.u.mu.Unlock()
+	t.u.poll()
+	return t.serial <= t.u.done
+}
+
+// Wait flushes the upload if needed and waits for its completion for at
+// most timeout nanoseconds. It returns vk.ErrorResult(vk.TIMEOUT) if the
+// upload is still running. The other uploads wait meanwhile.
+func (t Token) Wait(timeout uint64) error {
+	if t.u == nil {
+		return nil
+	}
+	t.u.mu.Lock()
+	defer t.u.mu.Unlock()
+	return t.u.wait(t.serial, timeout)
+}
+
+// Acquire records into cmd, of the queue family the upload went to, the
+// acquisition of the resource ownership released by the Uploader. The
+// submission of cmd must wait for the upload, with a semaphore signaled by
+// Flush or after Wait. It records nothing if the ownership was not
+// transferred.
+func (t Token) Acquire(cmd vkx.CommandBuffer, dstStage vk.PipelineStageFlags, dstAccess vk.AccessFlags) {
+	if len(t.buffers)+len(t.images) == 0 {
+		return
+	}
+	buffers := append([]vk.BufferMemoryBarrier(nil), t.buffers...)
+	for i := range buffers {
+		buffers[i].DstAccessMask = dstAccess
+	}
+	images := append([]vk.ImageMemoryBarrier(nil), t.images...)
+	for i := range images {
+		images[i].DstAccessMask = dstAccess
+	}
+	cmd.CmdPipelineBarrier(vk.PIPELINE_STAGE_TOP_OF_PIPE_BIT, dstStage, 0, nil, buffers, images)
+}
@@ -0,0 +1,78 @@
+package staging
+
+import (
+	"testing"
+
+	"github.com/toy80/vk"
+)
+
+func TestRing(t *testing.T) {
+	r := ring{size: 100}
+	type step struct {
+		n, align      vk.DeviceSize
+		offset, taken vk.DeviceSize
+		ok            bool
+	}
+	alloc := func(steps ...step) {
+		t.Helper()
+		for _, s := range steps {
+			offset, taken, ok := r.alloc(s.n, s.align)
+			if ok != s.ok || ok && (offset != s.offset || taken != s.taken) {
+				t.Fatalf("alloc(%d, %d) = %d, %d, %v, want %d, %d, %v", s.n, s.align, offset, taken, ok, s.offset, s.taken, s.ok)
+			}
+		}
+	}
+	alloc(step{30, 1, 0, 30, true}, step{30, 16, 32, 32, true}) // batch 1 ends at 62
+	alloc(step{30, 1, 62, 30, true})                            // batch 2 ends at 92
+	alloc(step{10, 1, 0, 0, false})                             // would wrap into batch 1
+	r.release(62, 62)
+	alloc(step{10, 1, 0, 18, true}, step{53, 1, 0, 0, false}, step{52, 1, 10, 52, true})
+	alloc(step{1, 1, 0, 0, false}) // full
+	r.release(92, 30)
+	r.release(62, 70)
+	if r.used != 0 {
+		t.Fatalf("used = %d after releasing everything", r.used)
+	}
+	alloc(step{100, 1, 0, 100, true})
+	r.release(100, 100)
+	alloc(step{101, 1, 0, 0, false})
+
+	// an empty batch, flushed only to signal, ends at 30 and retires after
+	// the ring restarted under a later batch
+	r = ring{size: 100}
+	alloc(step{30, 1, 0, 30, true})
+	emptyEnd := r.head
+	r.release(30, 30)
+	alloc(step{80, 1, 0, 80, true})
+	r.release(emptyEnd, 0)
+	alloc(step{30, 1, 0, 0, false}) // would wrap into the batch at [0,80)
+	r.release(80, 80)
+	alloc(step{30, 1, 0, 30, true})
+}
+
+func TestTransferFamily(t *testing.T) {
+	const (
+		graphics = vk.QUEUE_GRAPHICS_BIT | vk.QUEUE_COMPUTE_BIT | vk.QUEUE_TRANSFER_BIT
+		compute  = vk.QUEUE_COMPUTE_BIT | vk.QUEUE_TRANSFER_BIT
+		transfer = vk.QUEUE_TRANSFER_BIT | vk.QUEUE_SPARSE_BINDING_BIT
+	)
+	tests := []struct {
+		flags     []vk.QueueFlags
+		family    uint32
+		dedicated bool
+	}{
+		{[]vk.QueueFlags{graphics, compute, transfer}, 2, true},
+		{[]vk.QueueFlags{graphics, compute}, 0, false},
+		{[]vk.QueueFlags{vk.QUEUE_SPARSE_BINDING_BIT, compute}, 1, false},
+		{nil, vk.QUEUE_FAMILY_IGNORED, false},
+	}
+	for _, test := range tests {
+		families := make([]vk.QueueFamilyProperties, len(test.flags))
+		for i, f := range test.flags {
+			families[i] = vk.QueueFamilyProperties{QueueFlags: f, QueueCount: 1}
+		}
+		if family, dedicated := TransferFamily(families); family != test.family || dedicated != test.dedicated {
+			t.Errorf("TransferFamily(%v) = %d, %v, want %d, %v", test.flags, family, dedicated, test.family, test.dedicated)
+		}
+	}
+}
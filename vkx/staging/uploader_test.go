@@ -0,0 +1,297 @@
+// +build cgo
+
+package staging
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/toy80/vk"
+	"github.com/toy80/vk/internal/abi"
+	"github.com/toy80/vk/vkx"
+	"github.com/toy80/vk/vkx/mem"
+)
+
+const (
+	family   = 1 // of the Uploader
+	graphics = 0
+	queue    = 0x3000
+)
+
+// newTestUploader returns an Uploader of a ring buffer of 256 bytes on the
+// fake device of package abi, without the calls of New.
+func newTestUploader(t *testing.T) *Uploader {
+	abi.Reset()
+	physical := vkx.PhysicalDevice{PhysicalDevice: vk.PhysicalDevice(0x1000), InstanceTable: &vkx.InstanceTable{
+		GetDeviceProcAddr:                 vk.PfnGetDeviceProcAddr(abi.GetDeviceProcAddr),
+		GetPhysicalDeviceProperties:       vk.PfnGetPhysicalDeviceProperties(abi.Proc("vkGetPhysicalDeviceProperties")),
+		GetPhysicalDeviceMemoryProperties: vk.PfnGetPhysicalDeviceMemoryProperties(abi.Proc("vkGetPhysicalDeviceMemoryProperties")),
+	}}
+	device := physical.NewDevice(vk.Device(0x2000))
+	u, err := New(device, mem.New(physical, device), &Info{
+		Queue:  vkx.Queue{Queue: vk.Queue(queue), DeviceTable: device.DeviceTable},
+		Family: family,
+		Size:   256,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	abi.Calls()
+	return u
+}
+
+func checkCalls(t *testing.T, want []abi.Call) {
+	t.Helper()
+	got := abi.Calls()
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("calls:\n%v\nwant:\n%v", got, want)
+	}
+}
+
+func call(name string, args ...uint64) abi.Call { return abi.Call{Name: name, Args: args} }
+
+// data returns n bytes numbered from first.
+func data(first byte, n int) []byte {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = first + byte(i)
+	}
+	return b
+}
+
+func TestUploadBuffer(t *testing.T) {
+	u := newTestUploader(t)
+	defer u.Destroy()
+	const dst = 0x77
+	token, err := u.UploadBuffer(BufferUpload{Buffer: dst, Offset: 8, DstFamily: graphics}, data(1, 100))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := u.current
+	cmd, fence, ring := uint64(b.cmd.CommandBuffer), uint64(b.fence), uint64(u.buffer)
+	checkCalls(t, []abi.Call{
+		call("vkAllocateCommandBuffers", uint64(u.pool), 1, cmd),
+		call("vkCreateFence", 0, fence),
+		call("vkBeginCommandBuffer", cmd, uint64(vk.COMMAND_BUFFER_USAGE_ONE_TIME_SUBMIT_BIT)),
+		call("vkCmdCopyBuffer", cmd, ring, dst, 1, 0, 8, 100),
+		call("vkCmdPipelineBarrier", cmd, uint64(vk.PIPELINE_STAGE_TRANSFER_BIT), uint64(vk.PIPELINE_STAGE_BOTTOM_OF_PIPE_BIT), 0, 1, 0),
+		call("VkBufferMemoryBarrier", dst, uint64(vk.ACCESS_TRANSFER_WRITE_BIT), 0, family, graphics, 8, 100),
+	})
+	if got := u.bytes(0, 100); !bytes.Equal(got, data(1, 100)) {
+		t.Errorf("ring buffer = %v", got)
+	}
+	if token.Done() {
+		t.Error("upload done before Flush")
+	}
+	checkCalls(t, nil)
+
+	// the release is acquired by the queue family of the buffer
+	token.Acquire(vkx.CommandBuffer{CommandBuffer: 0x9000, DeviceTable: b.cmd.DeviceTable}, vk.PIPELINE_STAGE_VERTEX_INPUT_BIT, vk.ACCESS_VERTEX_ATTRIBUTE_READ_BIT)
+	checkCalls(t, []abi.Call{
+		call("vkCmdPipelineBarrier", 0x9000, uint64(vk.PIPELINE_STAGE_TOP_OF_PIPE_BIT), uint64(vk.PIPELINE_STAGE_VERTEX_INPUT_BIT), 0, 1, 0),
+		call("VkBufferMemoryBarrier", dst, 0, uint64(vk.ACCESS_VERTEX_ATTRIBUTE_READ_BIT), family, graphics, 8, 100),
+	})
+
+	if err := u.Flush(0x55); err != nil {
+		t.Fatal(err)
+	}
+	checkCalls(t, []abi.Call{
+		call("vkEndCommandBuffer", cmd),
+		call("vkQueueSubmit", queue, fence, 1, 1, cmd, 0, 1),
+	})
+	abi.SetResults("vkGetFenceStatus", int32(vk.NOT_READY))
+	if token.Done() {
+		t.Error("upload done before its fence")
+	}
+	if !token.Done() {
+		t.Error("upload not done after its fence")
+	}
+	checkCalls(t, []abi.Call{
+		call("vkGetFenceStatus", fence),
+		call("vkGetFenceStatus", fence),
+		call("vkResetFences", 1, fence),
+	})
+	if u.ring.used != 0 || len(u.flight) != 0 || len(u.spare) != 1 {
+		t.Errorf("ring of %d bytes used, %d batches in flight and %d spare after the upload", u.ring.used, len(u.flight), len(u.spare))
+	}
+
+	// without ownership transfer
+	token, err = u.UploadBuffer(BufferUpload{Buffer: dst, DstFamily: vk.QUEUE_FAMILY_IGNORED}, data(0, 16))
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkCalls(t, []abi.Call{
+		call("vkBeginCommandBuffer", cmd, uint64(vk.COMMAND_BUFFER_USAGE_ONE_TIME_SUBMIT_BIT)),
+		call("vkCmdCopyBuffer", cmd, ring, dst, 1, 0, 0, 16),
+	})
+	token.Acquire(vkx.CommandBuffer{CommandBuffer: 0x9000, DeviceTable: b.cmd.DeviceTable}, vk.PIPELINE_STAGE_VERTEX_INPUT_BIT, vk.ACCESS_VERTEX_ATTRIBUTE_READ_BIT)
+	checkCalls(t, nil)
+	if token, _ := u.UploadBuffer(BufferUpload{Buffer: dst}, nil); token.u != nil || !token.Done() {
+		t.Errorf("token of an empty upload = %+v", token)
+	}
+}
+
+func TestUploadBufferParts(t *testing.T) {
+	u := newTestUploader(t)
+	defer u.Destroy()
+	const dst = 0x77
+	if _, err := u.UploadBuffer(BufferUpload{Buffer: dst, DstFamily: family}, data(0, 300)); err != nil {
+		t.Fatal(err)
+	}
+	calls := abi.Calls()
+	cmd, fence, ring := calls[0].Args[2], calls[1].Args[1], uint64(u.buffer)
+	// the third part waits for the first two, which fill the ring
+	want := []abi.Call{
+		call("vkAllocateCommandBuffers", uint64(u.pool), 1, cmd),
+		call("vkCreateFence", 0, fence),
+		call("vkBeginCommandBuffer", cmd, uint64(vk.COMMAND_BUFFER_USAGE_ONE_TIME_SUBMIT_BIT)),
+		call("vkCmdCopyBuffer", cmd, ring, dst, 1, 0, 0, 128),
+		call("vkCmdCopyBuffer", cmd, ring, dst, 1, 128, 128, 128),
+		call("vkEndCommandBuffer", cmd),
+		call("vkQueueSubmit", queue, fence, 1, 1, cmd, 0, 0),
+		call("vkAllocateCommandBuffers", uint64(u.pool), 1, uint64(u.current.cmd.CommandBuffer)),
+		call("vkCreateFence", 0, uint64(u.current.fence)),
+		call("vkBeginCommandBuffer", uint64(u.current.cmd.CommandBuffer), uint64(vk.COMMAND_BUFFER_USAGE_ONE_TIME_SUBMIT_BIT)),
+		call("vkWaitForFences", 1, fence, ^uint64(0)),
+		call("vkResetFences", 1, fence),
+		call("vkCmdCopyBuffer", uint64(u.current.cmd.CommandBuffer), ring, dst, 1, 0, 256, 44),
+	}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("calls:\n%v\nwant:\n%v", calls, want)
+	}
+	if got := u.bytes(0, 44); !bytes.Equal(got, data(0, 300)[256:]) {
+		t.Errorf("ring buffer = %v, want the last part", got)
+	}
+}
+
+func TestUploadImage(t *testing.T) {
+	u := newTestUploader(t)
+	defer u.Destroy()
+	if _, err := u.UploadBuffer(BufferUpload{Buffer: 0x77, DstFamily: family}, data(0, 10)); err != nil {
+		t.Fatal(err)
+	}
+	abi.Calls()
+	cmd, ring := uint64(u.current.cmd.CommandBuffer), uint64(u.buffer)
+	const image = 0x88
+	region := vk.BufferImageCopy{
+		ImageSubresource: vk.ImageSubresourceLayers{AspectMask: vk.ImageAspectFlags(vk.IMAGE_ASPECT_COLOR_BIT), MipLevel: 2, LayerCount: 1},
+		ImageExtent:      vk.Extent3D{Width: 4, Height: 4, Depth: 1},
+	}
+	token, err := u.UploadImage(ImageUpload{
+		Image:     image,
+		Region:    region,
+		OldLayout: vk.IMAGE_LAYOUT_UNDEFINED,
+		Layout:    vk.IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL,
+		TexelSize: 8,
+		DstFamily: vk.QUEUE_FAMILY_IGNORED,
+	}, data(0, 128))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ignored := uint64(vk.QUEUE_FAMILY_IGNORED)
+	transferDst := uint64(vk.IMAGE_LAYOUT_TRANSFER_DST_OPTIMAL)
+	write := uint64(vk.ACCESS_TRANSFER_WRITE_BIT)
+	checkCalls(t, []abi.Call{
+		call("vkCmdPipelineBarrier", cmd, uint64(vk.PIPELINE_STAGE_TOP_OF_PIPE_BIT), uint64(vk.PIPELINE_STAGE_TRANSFER_BIT), 0, 0, 1),
+		call("VkImageMemoryBarrier", image, 0, write, uint64(vk.IMAGE_LAYOUT_UNDEFINED), transferDst, ignored, ignored),
+		call("vkCmdCopyBufferToImage", cmd, ring, image, transferDst, 1, 16), // aligned to 8 bytes
+		call("vkCmdPipelineBarrier", cmd, uint64(vk.PIPELINE_STAGE_TRANSFER_BIT), uint64(vk.PIPELINE_STAGE_BOTTOM_OF_PIPE_BIT), 0, 0, 1),
+		call("VkImageMemoryBarrier", image, write, 0, transferDst, uint64(vk.IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL), ignored, ignored),
+	})
+	if len(token.images) != 0 {
+		t.Errorf("image barriers to acquire without ownership transfer: %+v", token.images)
+	}
+
+	// the release to the graphics queue family, in TRANSFER_DST_OPTIMAL
+	token, err = u.UploadImage(ImageUpload{
+		Image:     image,
+		Region:    region,
+		OldLayout: vk.IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL,
+		Layout:    vk.IMAGE_LAYOUT_TRANSFER_DST_OPTIMAL,
+		DstFamily: graphics,
+	}, data(0, 64))
+	if err != nil {
+		t.Fatal(err)
+	}
+	read := uint64(vk.IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL)
+	checkCalls(t, []abi.Call{
+		call("vkCmdPipelineBarrier", cmd, uint64(vk.PIPELINE_STAGE_TOP_OF_PIPE_BIT), uint64(vk.PIPELINE_STAGE_TRANSFER_BIT), 0, 0, 1),
+		call("VkImageMemoryBarrier", image, 0, write, read, transferDst, ignored, ignored),
+		call("vkCmdCopyBufferToImage", cmd, ring, image, transferDst, 1, 144),
+		call("vkCmdPipelineBarrier", cmd, uint64(vk.PIPELINE_STAGE_TRANSFER_BIT), uint64(vk.PIPELINE_STAGE_BOTTOM_OF_PIPE_BIT), 0, 0, 1),
+		call("VkImageMemoryBarrier", image, write, 0, transferDst, transferDst, family, graphics),
+	})
+	token.Acquire(vkx.CommandBuffer{CommandBuffer: 0x9000, DeviceTable: u.device.DeviceTable}, vk.PIPELINE_STAGE_FRAGMENT_SHADER_BIT, vk.ACCESS_SHADER_READ_BIT)
+	checkCalls(t, []abi.Call{
+		call("vkCmdPipelineBarrier", 0x9000, uint64(vk.PIPELINE_STAGE_TOP_OF_PIPE_BIT), uint64(vk.PIPELINE_STAGE_FRAGMENT_SHADER_BIT), 0, 0, 1),
+		call("VkImageMemoryBarrier", image, 0, uint64(vk.ACCESS_SHADER_READ_BIT), transferDst, transferDst, family, graphics),
+	})
+
+	if _, err := u.UploadImage(ImageUpload{Image: image}, make([]byte, 257)); err != ErrTooLarge {
+		t.Errorf("upload larger than the ring buffer = %v, want ErrTooLarge", err)
+	}
+}
+
+func TestFlushAndWait(t *testing.T) {
+	u := newTestUploader(t)
+	if err := u.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	checkCalls(t, nil)
+
+	// an empty submission signals the semaphore
+	if err := u.Flush(0x55); err != nil {
+		t.Fatal(err)
+	}
+	calls := abi.Calls()
+	cmd, fence := calls[0].Args[2], calls[1].Args[1]
+	if last := calls[len(calls)-1]; !reflect.DeepEqual(last, call("vkQueueSubmit", queue, fence, 1, 1, cmd, 0, 1)) {
+		t.Errorf("submission = %v", last)
+	}
+	if b := u.flight[0]; b.taken != 0 {
+		t.Errorf("empty batch took %d bytes", b.taken)
+	}
+
+	// Wait submits the upload, and keeps it after a timeout
+	token, err := u.UploadBuffer(BufferUpload{Buffer: 0x77, DstFamily: family}, data(0, 10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	abi.SetResults("vkWaitForFences", int32(vk.TIMEOUT))
+	if err := token.Wait(1000); vk.AsResult(err) != vk.TIMEOUT {
+		t.Fatalf("Wait() = %v, want TIMEOUT", err)
+	}
+	calls = abi.Calls()
+	other := uint64(u.flight[1].fence)
+	if got := calls[len(calls)-1]; !reflect.DeepEqual(got, call("vkWaitForFences", 1, fence, 1000)) {
+		t.Errorf("last call = %v, want the wait of the empty batch", got)
+	}
+	if len(u.flight) != 2 || u.current != nil {
+		t.Fatalf("%d batches in flight after a timeout", len(u.flight))
+	}
+	if err := token.Wait(1000); err != nil {
+		t.Fatal(err)
+	}
+	checkCalls(t, []abi.Call{
+		call("vkWaitForFences", 1, fence, 1000),
+		call("vkResetFences", 1, fence),
+		call("vkWaitForFences", 1, other, 1000),
+		call("vkResetFences", 1, other),
+	})
+	if !token.Done() || u.ring.used != 0 {
+		t.Errorf("upload not done, ring of %d bytes used", u.ring.used)
+	}
+	checkCalls(t, nil)
+
+	pool, ring := uint64(u.pool), uint64(u.buffer)
+	if err := u.Destroy(); err != nil {
+		t.Fatal(err)
+	}
+	// the fences of the spare batches, then the buffer and its memory
+	calls = abi.Calls()
+	if len(calls) < 4 || calls[0].Name != "vkDestroyFence" || calls[1].Name != "vkDestroyFence" ||
+		!reflect.DeepEqual(calls[2], call("vkDestroyCommandPool", pool)) || !reflect.DeepEqual(calls[3], call("vkDestroyBuffer", ring)) {
+		t.Errorf("Destroy() calls %v", calls)
+	}
+}
@@ -0,0 +1,279 @@
+// +build cgo
+
+package swapchain
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/toy80/vk"
+	"github.com/toy80/vk/internal/abi"
+	"github.com/toy80/vk/vkx"
+)
+
+const (
+	surface = 0x5000
+	queue   = 0x3000
+)
+
+// newTestSwapchain returns a Swapchain of 2 frames in flight on the fake
+// device of package abi, for a window of 800 by 600. It returns the
+// swapchain created by New and the fences of the frames.
+func newTestSwapchain(t *testing.T) (*Swapchain, uint64, []uint64) {
+	abi.Reset()
+	physical := vkx.PhysicalDevice{PhysicalDevice: vk.PhysicalDevice(0x1000), InstanceTable: &vkx.InstanceTable{
+		GetDeviceProcAddr:                       vk.PfnGetDeviceProcAddr(abi.GetDeviceProcAddr),
+		GetPhysicalDeviceSurfaceCapabilitiesKHR: vk.PfnGetPhysicalDeviceSurfaceCapabilitiesKHR(abi.Proc("vkGetPhysicalDeviceSurfaceCapabilitiesKHR")),
+		GetPhysicalDeviceSurfaceFormatsKHR:      vk.PfnGetPhysicalDeviceSurfaceFormatsKHR(abi.Proc("vkGetPhysicalDeviceSurfaceFormatsKHR")),
+		GetPhysicalDeviceSurfacePresentModesKHR: vk.PfnGetPhysicalDeviceSurfacePresentModesKHR(abi.Proc("vkGetPhysicalDeviceSurfacePresentModesKHR")),
+	}}
+	device := physical.NewDevice(vk.Device(0x2000))
+	s, err := New(physical, device, vkx.Queue{Queue: vk.Queue(queue), DeviceTable: device.DeviceTable}, Info{Surface: surface}, 800, 600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var swapchain uint64
+	var fences []uint64
+	for _, c := range abi.Calls() {
+		switch c.Name {
+		case "vkCreateSwapchainKHR":
+			swapchain = c.Args[4]
+		case "vkCreateFence":
+			fences = append(fences, c.Args[1])
+		}
+	}
+	return s, swapchain, fences
+}
+
+func call(name string, args ...uint64) abi.Call { return abi.Call{Name: name, Args: args} }
+
+// named returns the calls named name.
+func named(calls []abi.Call, name string) []abi.Call {
+	var found []abi.Call
+	for _, c := range calls {
+		if c.Name == name {
+			found = append(found, c)
+		}
+	}
+	return found
+}
+
+func TestAbandon(t *testing.T) {
+	s, swapchain, fences := newTestSwapchain(t)
+	defer s.Destroy()
+	frame, err := s.Acquire()
+	if err != nil {
+		t.Fatal(err)
+	}
+	abi.Calls()
+	if err := s.Abandon(frame); err != nil {
+		t.Fatal(err)
+	}
+	// the fence reset by Acquire is signaled again, after the acquisition
+	if got, want := abi.Calls(), []abi.Call{call("vkQueueSubmit", queue, fences[0], 1, 0, 0, 1, 0)}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Abandon() calls %v, want %v", got, want)
+	}
+
+	// the next frame is of a new swapchain, which releases the image
+	if frame, err = s.Acquire(); err != nil {
+		t.Fatal(err)
+	}
+	calls := abi.Calls()
+	if waits := named(calls, "vkWaitForFences"); len(waits) == 0 || waits[0].Args[1] != fences[1] {
+		t.Errorf("waits %v, want the fence of the next frame", waits)
+	}
+	if create := named(calls, "vkCreateSwapchainKHR"); len(create) != 1 || create[0].Args[3] != swapchain {
+		t.Errorf("creations %v, want one retiring %#x", create, swapchain)
+	}
+	if !frame.Recreated || frame.Index != 0 {
+		t.Errorf("frame = %+v, want the first image of a new swapchain", frame)
+	}
+}
+
+// present acquires a frame and presents it.
+func present(t *testing.T, s *Swapchain) *Frame {
+	t.Helper()
+	frame, err := s.Acquire()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Present(frame); err != nil {
+		t.Fatal(err)
+	}
+	return frame
+}
+
+// created returns the swapchain created by calls, 0 if none was.
+func created(t *testing.T, calls []abi.Call, old uint64) uint64 {
+	t.Helper()
+	create := named(calls, "vkCreateSwapchainKHR")
+	if len(create) == 0 {
+		return 0
+	}
+	if len(create) > 1 || create[0].Args[3] != old {
+		t.Errorf("creations %v, want one retiring %#x", create, old)
+	}
+	if destroy := named(calls, "vkDestroySwapchainKHR"); len(destroy) != 1 || destroy[0].Args[0] != old {
+		t.Errorf("destructions %v, want %#x", destroy, old)
+	}
+	return create[0].Args[4]
+}
+
+func TestAcquireOutOfDate(t *testing.T) {
+	s, swapchain, _ := newTestSwapchain(t)
+	defer s.Destroy()
+	present(t, s)
+	abi.Calls()
+
+	// the image is acquired again from a new swapchain
+	abi.SetResults("vkAcquireNextImageKHR", int32(vk.ERROR_OUT_OF_DATE_KHR))
+	frame, err := s.Acquire()
+	if err != nil {
+		t.Fatal(err)
+	}
+	calls := abi.Calls()
+	next := created(t, calls, swapchain)
+	acquires := named(calls, "vkAcquireNextImageKHR")
+	if next == 0 || len(acquires) != 2 || acquires[0].Args[0] != swapchain || acquires[1].Args[0] != next {
+		t.Fatalf("acquisitions %v, want one of %#x then one of the new swapchain %#x", acquires, swapchain, next)
+	}
+	if !frame.Recreated || frame.Index != 0 {
+		t.Errorf("frame = %+v, want the first image of a new swapchain", frame)
+	}
+	if err := s.Present(frame); err != nil {
+		t.Fatal(err)
+	}
+	if frame = present(t, s); frame.Recreated {
+		t.Errorf("frame after the recreation = %+v, want it not recreated", frame)
+	}
+}
+
+func TestAcquireSuboptimal(t *testing.T) {
+	s, swapchain, _ := newTestSwapchain(t)
+	defer s.Destroy()
+	present(t, s)
+	abi.Calls()
+
+	// the image acquired is presented, the next frame recreates
+	abi.SetResults("vkAcquireNextImageKHR", int32(vk.SUBOPTIMAL_KHR))
+	frame := present(t, s)
+	calls := abi.Calls()
+	if frame.Recreated || frame.Index != 1 || created(t, calls, swapchain) != 0 {
+		t.Errorf("suboptimal frame = %+v, calls %v", frame, calls)
+	}
+	if presents := named(calls, "vkQueuePresentKHR"); len(presents) != 1 || presents[0].Args[1] != swapchain {
+		t.Errorf("presentations %v, want one of %#x", presents, swapchain)
+	}
+	frame = present(t, s)
+	if next := created(t, abi.Calls(), swapchain); next == 0 || !frame.Recreated {
+		t.Errorf("frame after a suboptimal one = %+v, want a new swapchain", frame)
+	}
+}
+
+func TestPresentOutOfDate(t *testing.T) {
+	for _, result := range []vk.Result{vk.ERROR_OUT_OF_DATE_KHR, vk.SUBOPTIMAL_KHR} {
+		s, swapchain, _ := newTestSwapchain(t)
+		present(t, s)
+		abi.SetResults("vkQueuePresentKHR", int32(result))
+		present(t, s)
+		if created(t, abi.Calls(), swapchain) != 0 {
+			t.Errorf("swapchain recreated before the next frame after %v", result)
+		}
+		if frame := present(t, s); created(t, abi.Calls(), swapchain) == 0 || !frame.Recreated {
+			t.Errorf("frame after %v = %+v, want a new swapchain", result, frame)
+		}
+		s.Destroy()
+	}
+}
+
+func TestResize(t *testing.T) {
+	s, swapchain, _ := newTestSwapchain(t)
+	defer s.Destroy()
+	present(t, s)
+	s.Resize(800, 600)
+	present(t, s)
+	if created(t, abi.Calls(), swapchain) != 0 {
+		t.Error("swapchain recreated for the same size")
+	}
+
+	// the surface takes the size of the window
+	abi.SetSurfaceExtent(undefinedExtent, undefinedExtent)
+	s.Resize(1024, 768)
+	frame := present(t, s)
+	calls := abi.Calls()
+	if created(t, calls, swapchain) == 0 || !frame.Recreated {
+		t.Fatalf("frame after Resize = %+v, want a new swapchain", frame)
+	}
+	if c := named(calls, "vkCreateSwapchainKHR")[0]; c.Args[1] != 1024 || c.Args[2] != 768 {
+		t.Errorf("swapchain of %dx%d, want 1024x768", c.Args[1], c.Args[2])
+	}
+	if e := s.Extent(); e != (vk.Extent2D{Width: 1024, Height: 768}) {
+		t.Errorf("Extent() = %+v", e)
+	}
+	// the waits for the device, the views and semaphores of the 3 images
+	if n := len(named(calls, "vkDeviceWaitIdle")); n != 1 {
+		t.Errorf("%d waits for the device, want 1", n)
+	}
+	if n, m := len(named(calls, "vkDestroyImageView")), len(named(calls, "vkCreateImageView")); n != 3 || m != 3 {
+		t.Errorf("%d views destroyed and %d created, want 3", n, m)
+	}
+}
+
+func TestZeroExtent(t *testing.T) {
+	s, swapchain, _ := newTestSwapchain(t)
+	defer s.Destroy()
+	present(t, s)
+	abi.Calls()
+	abi.SetSurfaceExtent(0, 0)
+	s.Resize(0, 0)
+	for i := 0; i < 2; i++ {
+		if _, err := s.Acquire(); err != ErrZeroExtent {
+			t.Fatalf("Acquire() = %v, want ErrZeroExtent", err)
+		}
+	}
+	calls := abi.Calls()
+	if created(t, calls, swapchain) != 0 || len(named(calls, "vkAcquireNextImageKHR")) != 0 || len(named(calls, "vkResetFences")) != 0 {
+		t.Errorf("calls while minimized %v", calls)
+	}
+
+	// the window is restored
+	abi.SetSurfaceExtent(800, 600)
+	frame, err := s.Acquire()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created(t, abi.Calls(), swapchain) == 0 || !frame.Recreated {
+		t.Errorf("frame after a zero extent = %+v, want a new swapchain", frame)
+	}
+}
+
+func TestImageInFlight(t *testing.T) {
+	s, _, fences := newTestSwapchain(t)
+	defer s.Destroy()
+	// 2 frames in flight render into the 3 images in turn
+	for i := 0; i < 3; i++ {
+		if frame := present(t, s); frame.Index != uint32(i) || frame.Fence != vk.Fence(fences[i%2]) {
+			t.Fatalf("frame %d = %+v", i, frame)
+		}
+	}
+	abi.Calls()
+	frame := present(t, s)
+	if frame.Index != 0 || frame.Fence != vk.Fence(fences[1]) {
+		t.Fatalf("frame = %+v, want image 0 with the fence of frame 1", frame)
+	}
+	// image 0 was rendered by frame 0 and frame 1 waits for it
+	want := []abi.Call{
+		call("vkWaitForFences", 1, fences[1], ^uint64(0)),
+		call("vkWaitForFences", 1, fences[0], ^uint64(0)),
+		call("vkResetFences", 1, fences[1]),
+	}
+	var got []abi.Call
+	for _, c := range abi.Calls() {
+		if c.Name == "vkWaitForFences" || c.Name == "vkResetFences" {
+			got = append(got, c)
+		}
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("fence calls %v, want %v", got, want)
+	}
+}
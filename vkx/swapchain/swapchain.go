@@ -0,0 +1,441 @@
+// Package swapchain presents the frames rendered into the images of a
+// swapchain, which it recreates when they no longer match the surface:
+//
+//   - the format, color space and present mode are chosen from lists of
+//     preferences, among those of the surface
+//   - each of the frames in flight has a fence and the semaphore of the
+//     image acquisition, each image the semaphore of its rendering
+//   - ERROR_OUT_OF_DATE_KHR, SUBOPTIMAL_KHR and Resize make the next
+//     Acquire recreate the swapchain and the views of its images
+//   - a frame acquired but not submitted is given back by Abandon
+//
+// A Swapchain is used by one goroutine.
+package swapchain
+
+import (
+	"errors"
+	"math"
+	"unsafe"
+
+	"github.com/toy80/vk"
+	"github.com/toy80/vk/vkx"
+	"github.com/toy80/vk/vkx/internal/cmem"
+)
+
+// DefaultFramesInFlight is the frames in flight when Info has none.
+const DefaultFramesInFlight = 2
+
+// ErrZeroExtent is returned by Acquire while the surface has no area,
+// e.g. when its window is minimized. No frame can be rendered then.
+var ErrZeroExtent = errors.New("swapchain: surface of zero extent")
+
+// undefinedExtent is the current extent of the surfaces whose extent is
+// that of the swapchain.
+const undefinedExtent = 0xFFFFFFFF
+
+// Info describes a Swapchain.
+type Info struct {
+	Surface vk.SurfaceKHR
+	// Formats are the formats and color spaces wanted, the best first.
+	// The first of the surface is used if it has none of them.
+	Formats []vk.SurfaceFormatKHR
+	// PresentModes are the present modes wanted, the best first. FIFO,
+	// which every surface has, is used if it has none of them.
+	PresentModes   []vk.PresentModeKHR
+	Usage          vk.ImageUsageFlags // of the images, COLOR_ATTACHMENT if zero
+	MinImageCount  uint32             // one more than the surface minimum if zero
+	FramesInFlight int                // DefaultFramesInFlight if zero
+	// Families are the queue families using the images, which are shared
+	// concurrently if there are more than one.
+	Families []uint32
+}
+
+// DefaultFormats are the formats wanted when Info has none.
+var DefaultFormats = []vk.SurfaceFormatKHR{
+	{Format: vk.FORMAT_B8G8R8A8_SRGB, ColorSpace: vk.COLOR_SPACE_SRGB_NONLINEAR_KHR},
+	{Format: vk.FORMAT_R8G8B8A8_SRGB, ColorSpace: vk.COLOR_SPACE_SRGB_NONLINEAR_KHR},
+}
+
+// ChooseFormat returns the first of preferred in available, else the first
+// available. A surface having only UNDEFINED has any format.
+func ChooseFormat(available, preferred []vk.SurfaceFormatKHR) vk.SurfaceFormatKHR {
+	if len(available) == 1 && available[0].Format == vk.FORMAT_UNDEFINED && len(preferred) > 0 {
+		return preferred[0]
+	}
+	for _, p := range preferred {
+		for _, a := range available {
+			if a == p {
+				return a
+			}
+		}
+	}
+	if len(available) == 0 {
+		return vk.SurfaceFormatKHR{}
+	}
+	return available[0]
+}
+
+// ChoosePresentMode returns the first of preferred in available, else FIFO.
+func ChoosePresentMode(available, preferred []vk.PresentModeKHR) vk.PresentModeKHR {
+	for _, p := range preferred {
+		for _, a := range available {
+			if a == p {
+				return a
+			}
+		}
+	}
+	return vk.PRESENT_MODE_FIFO_KHR
+}
+
+// chooseExtent returns the extent of the images, the size of the window if
+// the surface lets the swapchain decide.
+func chooseExtent(caps *vk.SurfaceCapabilitiesKHR, width, height uint32) vk.Extent2D {
+	if caps.CurrentExtent.Width != undefinedExtent {
+		return caps.CurrentExtent
+	}
+	clamp := func(x, min, max uint32) uint32 {
+		if x < min {
+			return min
+		}
+		if x > max {
+			return max
+		}
+		return x
+	}
+	return vk.Extent2D{
+		Width:  clamp(width, caps.MinImageExtent.Width, caps.MaxImageExtent.Width),
+		Height: clamp(height, caps.MinImageExtent.Height, caps.MaxImageExtent.Height),
+	}
+}
+
+// imageCount returns the minimum number of images, want if the surface
+// allows it.
+func imageCount(caps *vk.SurfaceCapabilitiesKHR, want uint32) uint32 {
+	if want == 0 {
+		want = caps.MinImageCount + 1
+	}
+	if want < caps.MinImageCount {
+		want = caps.MinImageCount
+	}
+	if caps.MaxImageCount != 0 && want > caps.MaxImageCount {
+		want = caps.MaxImageCount
+	}
+	return want
+}
+
+// frame is the synchronization of a frame in flight.
+type frame struct {
+	fence    vk.Fence     // signaled when the frame has been rendered
+	acquired vk.Semaphore // signaled when its image has been acquired
+}
+
+// Frame is a frame to render into an image of the swapchain. Its command
+// buffers wait for Acquired at COLOR_ATTACHMENT_OUTPUT, the last one
+// signals Rendered and Fence.
+type Frame struct {
+	Index    uint32 // of the image
+	Image    vk.Image
+	View     vk.ImageView
+	Acquired vk.Semaphore
+	Rendered vk.Semaphore
+	Fence    vk.Fence
+	// Recreated reports whether the images were recreated since the
+	// previous frame, the objects made from them must be too.
+	Recreated bool
+}
+
+// Swapchain is a swapchain and the frames rendered into its images.
+type Swapchain struct {
+	physical vkx.PhysicalDevice
+	device   vkx.Device
+	queue    vkx.Queue // of the presentation
+	info     Info
+
+	swapchain   vk.SwapchainKHR
+	format      vk.SurfaceFormatKHR
+	presentMode vk.PresentModeKHR
+	extent      vk.Extent2D
+	images      []vk.Image
+	views       []vk.ImageView
+	rendered    []vk.Semaphore // by image
+	inFlight    []vk.Fence     // of the frame rendering into each image
+
+	frames    []frame
+	frame     int
+	width     uint32 // of the window
+	height    uint32
+	stale     bool // to recreate before the next frame
+	recreated bool
+}
+
+// New creates the swapchain of info.Surface for a window of width by
+// height, presented on queue.
+func New(physical vkx.PhysicalDevice, device vkx.Device, queue vkx.Queue, info Info, width, height uint32) (*Swapchain, error) {
+	if len(info.Formats) == 0 {
+		info.Formats = DefaultFormats
+	}
+	if info.Usage == 0 {
+		info.Usage = vk.IMAGE_USAGE_COLOR_ATTACHMENT_BIT
+	}
+	if info.FramesInFlight <= 0 {
+		info.FramesInFlight = DefaultFramesInFlight
+	}
+	s := &Swapchain{physical: physical, device: device, queue: queue, info: info, width: width, height: height}
+	for i := 0; i < info.FramesInFlight; i++ {
+		var f frame
+		var err error
+		if f.fence, err = device.CreateFence(&vk.FenceCreateInfo{SType: vk.STRUCTURE_TYPE_FENCE_CREATE_INFO, Flags: vk.FENCE_CREATE_SIGNALED_BIT}); err == nil {
+			if f.acquired, err = s.newSemaphore(); err != nil {
+				device.DestroyFence(f.fence)
+			}
+		}
+		if err != nil {
+			s.Destroy()
+			return nil, err
+		}
+		s.frames = append(s.frames, f)
+	}
+	if err := s.recreate(); err != nil && err != ErrZeroExtent {
+		s.Destroy()
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Swapchain) newSemaphore() (vk.Semaphore, error) {
+	return s.device.CreateSemaphore(&vk.SemaphoreCreateInfo{SType: vk.STRUCTURE_TYPE_SEMAPHORE_CREATE_INFO})
+}
+
+// Format returns the format and color space of the images.
+func (s *Swapchain) Format() vk.SurfaceFormatKHR { return s.format }
+
+// PresentMode returns the present mode.
+func (s *Swapchain) PresentMode() vk.PresentModeKHR { return s.presentMode }
+
+// Extent returns the extent of the images.
+func (s *Swapchain) Extent() vk.Extent2D { return s.extent }
+
+// Images returns the images and their views.
+func (s *Swapchain) Images() ([]vk.Image, []vk.ImageView) { return s.images, s.views }
+
+// Resize says the window is now width by height, the swapchain is
+// recreated before the next frame.
+func (s *Swapchain) Resize(width, height uint32) {
+	if width != s.width || height != s.height {
+		s.width, s.height, s.stale = width, height, true
+	}
+}
+
+// recreate waits for the device to be idle, then replaces the swapchain,
+// the views of its images and their semaphores. It is tried again before
+// the next frame if it fails.
+func (s *Swapchain) recreate() (err error) {
+	defer func() {
+		if err != nil {
+			s.stale = true
+		}
+	}()
+	caps, err := s.physical.GetPhysicalDeviceSurfaceCapabilitiesKHR(s.info.Surface)
+	if err != nil {
+		return err
+	}
+	extent := chooseExtent(&caps, s.width, s.height)
+	if extent.Width == 0 || extent.Height == 0 {
+		return ErrZeroExtent
+	}
+	formats, err := s.physical.GetPhysicalDeviceSurfaceFormatsKHR(s.info.Surface)
+	if err != nil {
+		return err
+	}
+	modes, err := s.physical.GetPhysicalDeviceSurfacePresentModesKHR(s.info.Surface)
+	if err != nil {
+		return err
+	}
+	if err = s.device.DeviceWaitIdle(); err != nil {
+		return err
+	}
+
+	info := vk.NewSwapchainCreateInfoKHR()
+	defer info.Free()
+	info.Surface = s.info.Surface
+	info.MinImageCount = imageCount(&caps, s.info.MinImageCount)
+	format := ChooseFormat(formats, s.info.Formats)
+	info.ImageFormat, info.ImageColorSpace = format.Format, format.ColorSpace
+	info.ImageExtent = extent
+	info.ImageArrayLayers = 1
+	info.ImageUsage = s.info.Usage
+	info.ImageSharingMode = vk.SHARING_MODE_EXCLUSIVE
+	if n := len(s.info.Families); n > 1 {
+		p := vk.MemAlloc(uintptr(n) * unsafe.Sizeof(s.info.Families[0]))
+		defer vk.MemFree(p)
+		copy((*[1 << 16]uint32)(p)[:n:n], s.info.Families)
+		info.ImageSharingMode = vk.SHARING_MODE_CONCURRENT
+		info.QueueFamilyIndexCount, info.PQueueFamilyIndices = uint32(n), (*uint32)(p)
+	}
+	info.PreTransform = caps.CurrentTransform
+	info.CompositeAlpha = vk.COMPOSITE_ALPHA_OPAQUE_BIT_KHR
+	if caps.SupportedCompositeAlpha&info.CompositeAlpha == 0 {
+		// the lowest one supported
+		info.CompositeAlpha = caps.SupportedCompositeAlpha & -caps.SupportedCompositeAlpha
+	}
+	info.PresentMode = ChoosePresentMode(modes, s.info.PresentModes)
+	info.Clipped = vk.TRUE
+	info.OldSwapchain = s.swapchain
+	swapchain, err := s.device.CreateSwapchainKHR(info)
+	if err != nil {
+		s.destroyImages() // the old swapchain is retired anyway
+		return err
+	}
+	s.destroyImages()
+	s.swapchain, s.format, s.presentMode, s.extent = swapchain, format, info.PresentMode, extent
+	s.stale, s.recreated = false, true
+
+	if s.images, err = s.device.GetSwapchainImagesKHR(swapchain); err != nil {
+		return err
+	}
+	view := vk.NewImageViewCreateInfo()
+	defer view.Free()
+	view.ViewType, view.Format = vk.IMAGE_VIEW_TYPE_2D, format.Format
+	view.SubresourceRange = vk.ImageSubresourceRange{AspectMask: vk.ImageAspectFlags(vk.IMAGE_ASPECT_COLOR_BIT), LevelCount: 1, LayerCount: 1}
+	for _, image := range s.images {
+		view.Image = image
+		v, err := s.device.CreateImageView(view)
+		if err != nil {
+			return err
+		}
+		s.views = append(s.views, v)
+		sem, err := s.newSemaphore()
+		if err != nil {
+			return err
+		}
+		s.rendered = append(s.rendered, sem)
+	}
+	s.inFlight = make([]vk.Fence, len(s.images))
+	return nil
+}
+
+// destroyImages destroys the views and semaphores of the images, and the
+// swapchain.
+func (s *Swapchain) destroyImages() {
+	for _, v := range s.views {
+		s.device.DestroyImageView(v)
+	}
+	for _, sem := range s.rendered {
+		s.device.DestroySemaphore(sem)
+	}
+	s.views, s.rendered, s.images, s.inFlight = nil, nil, nil, nil
+	if s.swapchain != 0 {
+		s.device.DestroySwapchainKHR(s.swapchain)
+		s.swapchain = 0
+	}
+}
+
+// Acquire waits for the oldest frame in flight to be rendered, and acquires
+// an image for the next one, recreating the swapchain if needed. It returns
+// ErrZeroExtent while the surface has no area. The Fence of the frame is
+// reset, a frame not submitted must be given to Abandon.
+func (s *Swapchain) Acquire() (*Frame, error) {
+	f := &s.frames[s.frame]
+	if err := s.device.WaitForFences([]vk.Fence{f.fence}, vk.TRUE, math.MaxUint64); err != nil {
+		return nil, err
+	}
+	for {
+		if s.stale || s.swapchain == 0 {
+			if err := s.recreate(); err != nil {
+				return nil, err
+			}
+		}
+		index, err := s.device.AcquireNextImageKHR(s.swapchain, math.MaxUint64, f.acquired, 0)
+		switch vk.AsResult(err) {
+		case vk.SUCCESS:
+		case vk.SUBOPTIMAL_KHR:
+			s.stale = true // the image is acquired, it is presented first
+		case vk.ERROR_OUT_OF_DATE_KHR:
+			s.stale = true
+			continue
+		default:
+			return nil, err
+		}
+
+		// the image may still be rendered by an older frame, with more
+		// images than frames in flight
+		if fence := s.inFlight[index]; fence != 0 && fence != f.fence {
+			if err := s.device.WaitForFences([]vk.Fence{fence}, vk.TRUE, math.MaxUint64); err != nil {
+				return nil, err
+			}
+		}
+		s.inFlight[index] = f.fence
+		if err := s.device.ResetFences([]vk.Fence{f.fence}); err != nil {
+			return nil, err
+		}
+		frame := &Frame{
+			Index:     index,
+			Image:     s.images[index],
+			View:      s.views[index],
+			Acquired:  f.acquired,
+			Rendered:  s.rendered[index],
+			Fence:     f.fence,
+			Recreated: s.recreated,
+		}
+		s.recreated = false
+		return frame, nil
+	}
+}
+
+// Present queues the presentation of the image of frame once Rendered is
+// signaled. The swapchain is recreated before the next frame if it is out
+// of date or suboptimal.
+func (s *Swapchain) Present(frame *Frame) error {
+	var m cmem.Arena
+	defer m.Free()
+	info := (*vk.PresentInfoKHR)(m.Alloc(1, unsafe.Sizeof(vk.PresentInfoKHR{})))
+	info.SType = vk.STRUCTURE_TYPE_PRESENT_INFO_KHR
+	sem := (*vk.Semaphore)(m.Alloc(1, unsafe.Sizeof(frame.Rendered)))
+	swapchain := (*vk.SwapchainKHR)(m.Alloc(1, unsafe.Sizeof(s.swapchain)))
+	index := (*uint32)(m.Alloc(1, unsafe.Sizeof(frame.Index)))
+	*sem, *swapchain, *index = frame.Rendered, s.swapchain, frame.Index
+	info.WaitSemaphoreCount, info.PWaitSemaphores = 1, sem
+	info.SwapchainCount, info.PSwapchains, info.PImageIndices = 1, swapchain, index
+
+	s.frame = (s.frame + 1) % len(s.frames)
+	err := s.queue.QueuePresentKHR(info)
+	switch vk.AsResult(err) {
+	case vk.SUCCESS:
+		return nil
+	case vk.SUBOPTIMAL_KHR, vk.ERROR_OUT_OF_DATE_KHR:
+		s.stale = true
+		return nil
+	}
+	return err
+}
+
+// Abandon gives back frame when it is not submitted, e.g. after an error
+// while recording it: an empty submission waits for Acquired and signals
+// Fence. The image is not presented, the swapchain is recreated before the
+// next frame to release it.
+func (s *Swapchain) Abandon(frame *Frame) error {
+	var m cmem.Arena
+	defer m.Free()
+	info := (*vk.SubmitInfo)(m.Alloc(1, unsafe.Sizeof(vk.SubmitInfo{})))
+	info.SType = vk.STRUCTURE_TYPE_SUBMIT_INFO
+	sem := (*vk.Semaphore)(m.Alloc(1, unsafe.Sizeof(frame.Acquired)))
+	stage := (*vk.PipelineStageFlags)(m.Alloc(1, unsafe.Sizeof(vk.PipelineStageFlags(0))))
+	*sem, *stage = frame.Acquired, vk.PIPELINE_STAGE_ALL_COMMANDS_BIT
+	info.WaitSemaphoreCount, info.PWaitSemaphores, info.PWaitDstStageMask = 1, sem, stage
+
+	s.frame = (s.frame + 1) % len(s.frames)
+	s.stale = true
+	return s.queue.DeviceTable.QueueSubmit.Call(s.queue.Queue, 1, info, frame.Fence).Err()
+}
+
+// Destroy waits for the device to be idle, and destroys the swapchain and
+// the objects of the frames.
+func (s *Swapchain) Destroy() {
+	s.device.DeviceWaitIdle()
+	s.destroyImages()
+	for _, f := range s.frames {
+		s.device.DestroyFence(f.fence)
+		s.device.DestroySemaphore(f.acquired)
+	}
+	s.frames = nil
+}
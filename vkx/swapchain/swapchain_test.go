@@ -0,0 +1,57 @@
+package swapchain
+
+import (
+	"testing"
+
+	"github.com/toy80/vk"
+)
+
+func TestChooseFormat(t *testing.T) {
+	unorm := vk.SurfaceFormatKHR{Format: vk.FORMAT_B8G8R8A8_UNORM, ColorSpace: vk.COLOR_SPACE_SRGB_NONLINEAR_KHR}
+	srgb := DefaultFormats[1]
+	if f := ChooseFormat([]vk.SurfaceFormatKHR{unorm, srgb}, DefaultFormats); f != srgb {
+		t.Errorf("format = %+v, want %+v", f, srgb)
+	}
+	if f := ChooseFormat([]vk.SurfaceFormatKHR{unorm}, DefaultFormats); f != unorm {
+		t.Errorf("format without preferred = %+v", f)
+	}
+	if f := ChooseFormat([]vk.SurfaceFormatKHR{{Format: vk.FORMAT_UNDEFINED}}, DefaultFormats); f != DefaultFormats[0] {
+		t.Errorf("format of any = %+v", f)
+	}
+}
+
+func TestChoosePresentMode(t *testing.T) {
+	available := []vk.PresentModeKHR{vk.PRESENT_MODE_FIFO_KHR, vk.PRESENT_MODE_MAILBOX_KHR}
+	if m := ChoosePresentMode(available, []vk.PresentModeKHR{vk.PRESENT_MODE_IMMEDIATE_KHR, vk.PRESENT_MODE_MAILBOX_KHR}); m != vk.PRESENT_MODE_MAILBOX_KHR {
+		t.Errorf("present mode = %v", m)
+	}
+	if m := ChoosePresentMode(available, []vk.PresentModeKHR{vk.PRESENT_MODE_IMMEDIATE_KHR}); m != vk.PRESENT_MODE_FIFO_KHR {
+		t.Errorf("present mode without preferred = %v", m)
+	}
+}
+
+func TestExtentAndCount(t *testing.T) {
+	caps := vk.SurfaceCapabilitiesKHR{
+		MinImageCount:  2,
+		MaxImageCount:  3,
+		CurrentExtent:  vk.Extent2D{Width: 800, Height: 600},
+		MinImageExtent: vk.Extent2D{Width: 1, Height: 1},
+		MaxImageExtent: vk.Extent2D{Width: 4096, Height: 4096},
+	}
+	if e := chooseExtent(&caps, 1024, 768); e != caps.CurrentExtent {
+		t.Errorf("extent = %+v, want the current one", e)
+	}
+	caps.CurrentExtent = vk.Extent2D{Width: undefinedExtent, Height: undefinedExtent}
+	if e := chooseExtent(&caps, 8192, 768); e != (vk.Extent2D{Width: 4096, Height: 768}) {
+		t.Errorf("extent = %+v, want the window clamped", e)
+	}
+	for _, c := range []struct{ want, got uint32 }{{0, 3}, {1, 2}, {8, 3}} {
+		if n := imageCount(&caps, c.want); n != c.got {
+			t.Errorf("image count for %d = %d, want %d", c.want, n, c.got)
+		}
+	}
+	caps.MaxImageCount = 0
+	if n := imageCount(&caps, 8); n != 8 {
+		t.Errorf("image count without maximum = %d", n)
+	}
+}
@@ -0,0 +1,89 @@
+// Package vkx wraps the dispatchable handles of package vk with the dispatch
+// table they were created from. Its methods call the driver through the
+// table, skipping the loader trampolines, and take Go values:
+//
+//   - a Result is returned as an error, vk.AsResult gets it back
+//   - the output of the last parameter is returned
+//   - the count/array pairs are slices, enumerations are done in two calls
+//   - pAllocator is the Allocator of the table
+//   - the null-terminated strings are Go strings
+//
+// The methods of the commands the instance or the device does not have
+// must not be called, their table fields are zero. The platform commands
+// are called through package vk, with the handle and a Pfn of its own.
+package vkx
+
+import "github.com/toy80/vk"
+
+// Instance is a vk.Instance with its commands.
+type Instance struct {
+	vk.Instance
+	*InstanceTable
+}
+
+// PhysicalDevice is a vk.PhysicalDevice with the commands of its instance.
+type PhysicalDevice struct {
+	vk.PhysicalDevice
+	*InstanceTable
+}
+
+// Device is a vk.Device with its commands.
+type Device struct {
+	vk.Device
+	*DeviceTable
+}
+
+// Queue is a vk.Queue with the commands of its device.
+type Queue struct {
+	vk.Queue
+	*DeviceTable
+}
+
+// CommandBuffer is a vk.CommandBuffer with the commands of its device.
+type CommandBuffer struct {
+	vk.CommandBuffer
+	*DeviceTable
+}
+
+// CreateInstance calls vk.CreateInstance and loads the commands of the
+// instance. pAllocator becomes the Allocator of the instance and of the
+// devices created from it.
+func CreateInstance(pCreateInfo *vk.InstanceCreateInfo, pAllocator *vk.AllocationCallbacks) (Instance, error) {
+	var instance vk.Instance
+	if ret := vk.CreateInstance(pCreateInfo, pAllocator, &instance); ret != vk.SUCCESS {
+		return Instance{}, ret.Err()
+	}
+	return NewInstance(instance, pAllocator), nil
+}
+
+// NewInstance loads the commands of an instance created elsewhere.
+func NewInstance(instance vk.Instance, pAllocator *vk.AllocationCallbacks) Instance {
+	t := &InstanceTable{Allocator: pAllocator}
+	t.load(func(name string) vk.PfnVoidFunction {
+		return vk.GetInstanceProcAddr(instance, name)
+	})
+	return Instance{instance, t}
+}
+
+// NewDevice loads the commands of a device created elsewhere from p.
+func (p PhysicalDevice) NewDevice(device vk.Device) Device {
+	return newDevice(device, p.InstanceTable)
+}
+
+func newDevice(device vk.Device, it *InstanceTable) Device {
+	if device == 0 {
+		return Device{} // vkCreateDevice failed
+	}
+	t := &DeviceTable{Allocator: it.Allocator}
+	t.load(func(name string) vk.PfnVoidFunction {
+		pName, free := vk.CStr(name)
+		defer free()
+		return it.GetDeviceProcAddr.Call(device, pName)
+	})
+	return Device{device, t}
+}
+
+// SetName calls vk.SetNameWith with the commands of the table.
+func (d Device) SetName(h vk.Handle, name string) error {
+	return vk.SetNameWith(d.Device, h, name, d.DeviceTable.SetDebugUtilsObjectNameEXT, d.DeviceTable.DebugMarkerSetObjectNameEXT).Err()
+}
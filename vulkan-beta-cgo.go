@@ -0,0 +1,1800 @@
+//go:build (vkbeta && linux) || (vkbeta && darwin) || (vkbeta && forcecgo && windows)
+// +build vkbeta,linux vkbeta,darwin vkbeta,forcecgo,windows
+
+package vk
+
+// #cgo windows LDFLAGS: -lvulkan-1
+// #cgo linux LDFLAGS: -lvulkan
+// #cgo darwin LDFLAGS: -lMoltenVK
+// #include <stdint.h>
+// #define VK_ENABLE_BETA_EXTENSIONS 1
+// #include "./vulkan/vulkan_core.h"
+// #include "./vulkan/vulkan_beta.h"
+//
+// void bridge_vkCmdEncodeVideoKHR(uintptr_t fp,VkCommandBuffer commandBuffer,const VkVideoEncodeInfoKHR* pEncodeInfo){
+//   return ((PFN_vkCmdEncodeVideoKHR)fp)(commandBuffer,pEncodeInfo);
+// }
+import "C"
+
+import (
+	"fmt"
+	"strings"
+	"unsafe"
+)
+
+/*
+ ** Copyright (c) 2015-2019 The Khronos Group Inc.
+ **
+ ** Licensed under the Apache License, Version 2.0 (the "License");
+ ** you may not use this file except in compliance with the License.
+ ** You may obtain a copy of the License at
+ **
+ **     http://www.apache.org/licenses/LICENSE-2.0
+ **
+ ** Unless required by applicable law or agreed to in writing, software
+ ** distributed under the License is distributed on an "AS IS" BASIS,
+ ** WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ ** See the License for the specific language governing permissions and
+ ** limitations under the License.
+ */
+
+/*
+ ** This file is generated from the Vulkan headers.
+ */
+
+const KHR_portability_subset = 1
+const KHR_PORTABILITY_SUBSET_SPEC_VERSION = 1
+
+var KHR_PORTABILITY_SUBSET_EXTENSION_NAME = "VK_KHR_portability_subset"
+
+// PhysicalDevicePortabilitySubsetFeaturesKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkPhysicalDevicePortabilitySubsetFeaturesKHR.html
+type PhysicalDevicePortabilitySubsetFeaturesKHR struct {
+	SType                                  StructureType
+	PNext                                  unsafe.Pointer
+	ConstantAlphaColorBlendFactors         Bool32
+	Events                                 Bool32
+	ImageViewFormatReinterpretation        Bool32
+	ImageViewFormatSwizzle                 Bool32
+	ImageView2DOn3DImage                   Bool32
+	MultisampleArrayImage                  Bool32
+	MutableComparisonSamplers              Bool32
+	PointPolygons                          Bool32
+	SamplerMipLodBias                      Bool32
+	SeparateStencilMaskRef                 Bool32
+	ShaderSampleRateInterpolationFunctions Bool32
+	TessellationIsolines                   Bool32
+	TessellationPointMode                  Bool32
+	TriangleFans                           Bool32
+	VertexAttributeAccessBeyondStride      Bool32
+}
+
+func NewPhysicalDevicePortabilitySubsetFeaturesKHR() *PhysicalDevicePortabilitySubsetFeaturesKHR {
+	p := (*PhysicalDevicePortabilitySubsetFeaturesKHR)(MemAlloc(unsafe.Sizeof(*(*PhysicalDevicePortabilitySubsetFeaturesKHR)(nil))))
+	p.SType = STRUCTURE_TYPE_PHYSICAL_DEVICE_PORTABILITY_SUBSET_FEATURES_KHR
+	return p
+}
+func (p *PhysicalDevicePortabilitySubsetFeaturesKHR) Free() { MemFree(unsafe.Pointer(p)) }
+
+// PhysicalDevicePortabilitySubsetPropertiesKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkPhysicalDevicePortabilitySubsetPropertiesKHR.html
+type PhysicalDevicePortabilitySubsetPropertiesKHR struct {
+	SType                                StructureType
+	PNext                                unsafe.Pointer
+	MinVertexInputBindingStrideAlignment uint32
+}
+
+func NewPhysicalDevicePortabilitySubsetPropertiesKHR() *PhysicalDevicePortabilitySubsetPropertiesKHR {
+	p := (*PhysicalDevicePortabilitySubsetPropertiesKHR)(MemAlloc(unsafe.Sizeof(*(*PhysicalDevicePortabilitySubsetPropertiesKHR)(nil))))
+	p.SType = STRUCTURE_TYPE_PHYSICAL_DEVICE_PORTABILITY_SUBSET_PROPERTIES_KHR
+	return p
+}
+func (p *PhysicalDevicePortabilitySubsetPropertiesKHR) Free() { MemFree(unsafe.Pointer(p)) }
+
+const KHR_video_encode_queue = 1
+const KHR_VIDEO_ENCODE_QUEUE_SPEC_VERSION = 7
+
+var KHR_VIDEO_ENCODE_QUEUE_EXTENSION_NAME = "VK_KHR_video_encode_queue"
+
+type VideoEncodeFlagsKHR uint32 // reserved
+// VideoEncodeCapabilityFlagsKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeCapabilityFlagsKHR.html
+type VideoEncodeCapabilityFlagsKHR uint32
+
+const (
+	VIDEO_ENCODE_CAPABILITY_PRECEDING_EXTERNALLY_ENCODED_BYTES_BIT_KHR VideoEncodeCapabilityFlagsKHR = 0x00000001
+	VIDEO_ENCODE_CAPABILITY_FLAG_BITS_MAX_ENUM_KHR                     VideoEncodeCapabilityFlagsKHR = 0x7FFFFFFF
+)
+
+func (x VideoEncodeCapabilityFlagsKHR) String() string {
+	var s string
+	for i := uint32(0); i < 32; i++ {
+		if int32(x)&(1<<i) != 0 {
+			switch VideoEncodeCapabilityFlagsKHR(1 << i) {
+			case VIDEO_ENCODE_CAPABILITY_PRECEDING_EXTERNALLY_ENCODED_BYTES_BIT_KHR:
+				s += "VIDEO_ENCODE_CAPABILITY_PRECEDING_EXTERNALLY_ENCODED_BYTES_BIT_KHR|"
+			case VIDEO_ENCODE_CAPABILITY_FLAG_BITS_MAX_ENUM_KHR:
+				s += "VIDEO_ENCODE_CAPABILITY_FLAG_BITS_MAX_ENUM_KHR|"
+			}
+		}
+	}
+	return strings.TrimSuffix(s, `|`)
+}
+
+// VideoEncodeRateControlModeFlagsKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeRateControlModeFlagsKHR.html
+type VideoEncodeRateControlModeFlagsKHR uint32
+
+const (
+	VIDEO_ENCODE_RATE_CONTROL_MODE_NONE_BIT_KHR           VideoEncodeRateControlModeFlagsKHR = 0
+	VIDEO_ENCODE_RATE_CONTROL_MODE_CBR_BIT_KHR            VideoEncodeRateControlModeFlagsKHR = 0x00000001
+	VIDEO_ENCODE_RATE_CONTROL_MODE_VBR_BIT_KHR            VideoEncodeRateControlModeFlagsKHR = 0x00000002
+	VIDEO_ENCODE_RATE_CONTROL_MODE_FLAG_BITS_MAX_ENUM_KHR VideoEncodeRateControlModeFlagsKHR = 0x7FFFFFFF
+)
+
+func (x VideoEncodeRateControlModeFlagsKHR) String() string {
+	var s string
+	for i := uint32(0); i < 32; i++ {
+		if int32(x)&(1<<i) != 0 {
+			switch VideoEncodeRateControlModeFlagsKHR(1 << i) {
+			case VIDEO_ENCODE_RATE_CONTROL_MODE_NONE_BIT_KHR:
+				s += "VIDEO_ENCODE_RATE_CONTROL_MODE_NONE_BIT_KHR|"
+			case VIDEO_ENCODE_RATE_CONTROL_MODE_CBR_BIT_KHR:
+				s += "VIDEO_ENCODE_RATE_CONTROL_MODE_CBR_BIT_KHR|"
+			case VIDEO_ENCODE_RATE_CONTROL_MODE_VBR_BIT_KHR:
+				s += "VIDEO_ENCODE_RATE_CONTROL_MODE_VBR_BIT_KHR|"
+			case VIDEO_ENCODE_RATE_CONTROL_MODE_FLAG_BITS_MAX_ENUM_KHR:
+				s += "VIDEO_ENCODE_RATE_CONTROL_MODE_FLAG_BITS_MAX_ENUM_KHR|"
+			}
+		}
+	}
+	return strings.TrimSuffix(s, `|`)
+}
+
+// VideoEncodeUsageFlagsKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeUsageFlagsKHR.html
+type VideoEncodeUsageFlagsKHR uint32
+
+const (
+	VIDEO_ENCODE_USAGE_DEFAULT_KHR            VideoEncodeUsageFlagsKHR = 0
+	VIDEO_ENCODE_USAGE_TRANSCODING_BIT_KHR    VideoEncodeUsageFlagsKHR = 0x00000001
+	VIDEO_ENCODE_USAGE_STREAMING_BIT_KHR      VideoEncodeUsageFlagsKHR = 0x00000002
+	VIDEO_ENCODE_USAGE_RECORDING_BIT_KHR      VideoEncodeUsageFlagsKHR = 0x00000004
+	VIDEO_ENCODE_USAGE_CONFERENCING_BIT_KHR   VideoEncodeUsageFlagsKHR = 0x00000008
+	VIDEO_ENCODE_USAGE_FLAG_BITS_MAX_ENUM_KHR VideoEncodeUsageFlagsKHR = 0x7FFFFFFF
+)
+
+func (x VideoEncodeUsageFlagsKHR) String() string {
+	var s string
+	for i := uint32(0); i < 32; i++ {
+		if int32(x)&(1<<i) != 0 {
+			switch VideoEncodeUsageFlagsKHR(1 << i) {
+			case VIDEO_ENCODE_USAGE_DEFAULT_KHR:
+				s += "VIDEO_ENCODE_USAGE_DEFAULT_KHR|"
+			case VIDEO_ENCODE_USAGE_TRANSCODING_BIT_KHR:
+				s += "VIDEO_ENCODE_USAGE_TRANSCODING_BIT_KHR|"
+			case VIDEO_ENCODE_USAGE_STREAMING_BIT_KHR:
+				s += "VIDEO_ENCODE_USAGE_STREAMING_BIT_KHR|"
+			case VIDEO_ENCODE_USAGE_RECORDING_BIT_KHR:
+				s += "VIDEO_ENCODE_USAGE_RECORDING_BIT_KHR|"
+			case VIDEO_ENCODE_USAGE_CONFERENCING_BIT_KHR:
+				s += "VIDEO_ENCODE_USAGE_CONFERENCING_BIT_KHR|"
+			case VIDEO_ENCODE_USAGE_FLAG_BITS_MAX_ENUM_KHR:
+				s += "VIDEO_ENCODE_USAGE_FLAG_BITS_MAX_ENUM_KHR|"
+			}
+		}
+	}
+	return strings.TrimSuffix(s, `|`)
+}
+
+// VideoEncodeContentFlagsKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeContentFlagsKHR.html
+type VideoEncodeContentFlagsKHR uint32
+
+const (
+	VIDEO_ENCODE_CONTENT_DEFAULT_KHR            VideoEncodeContentFlagsKHR = 0
+	VIDEO_ENCODE_CONTENT_CAMERA_BIT_KHR         VideoEncodeContentFlagsKHR = 0x00000001
+	VIDEO_ENCODE_CONTENT_DESKTOP_BIT_KHR        VideoEncodeContentFlagsKHR = 0x00000002
+	VIDEO_ENCODE_CONTENT_RENDERED_BIT_KHR       VideoEncodeContentFlagsKHR = 0x00000004
+	VIDEO_ENCODE_CONTENT_FLAG_BITS_MAX_ENUM_KHR VideoEncodeContentFlagsKHR = 0x7FFFFFFF
+)
+
+func (x VideoEncodeContentFlagsKHR) String() string {
+	var s string
+	for i := uint32(0); i < 32; i++ {
+		if int32(x)&(1<<i) != 0 {
+			switch VideoEncodeContentFlagsKHR(1 << i) {
+			case VIDEO_ENCODE_CONTENT_DEFAULT_KHR:
+				s += "VIDEO_ENCODE_CONTENT_DEFAULT_KHR|"
+			case VIDEO_ENCODE_CONTENT_CAMERA_BIT_KHR:
+				s += "VIDEO_ENCODE_CONTENT_CAMERA_BIT_KHR|"
+			case VIDEO_ENCODE_CONTENT_DESKTOP_BIT_KHR:
+				s += "VIDEO_ENCODE_CONTENT_DESKTOP_BIT_KHR|"
+			case VIDEO_ENCODE_CONTENT_RENDERED_BIT_KHR:
+				s += "VIDEO_ENCODE_CONTENT_RENDERED_BIT_KHR|"
+			case VIDEO_ENCODE_CONTENT_FLAG_BITS_MAX_ENUM_KHR:
+				s += "VIDEO_ENCODE_CONTENT_FLAG_BITS_MAX_ENUM_KHR|"
+			}
+		}
+	}
+	return strings.TrimSuffix(s, `|`)
+}
+
+// VideoEncodeTuningModeKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeTuningModeKHR.html
+type VideoEncodeTuningModeKHR int32
+
+const (
+	VIDEO_ENCODE_TUNING_MODE_DEFAULT_KHR           VideoEncodeTuningModeKHR = 0
+	VIDEO_ENCODE_TUNING_MODE_HIGH_QUALITY_KHR      VideoEncodeTuningModeKHR = 1
+	VIDEO_ENCODE_TUNING_MODE_LOW_LATENCY_KHR       VideoEncodeTuningModeKHR = 2
+	VIDEO_ENCODE_TUNING_MODE_ULTRA_LOW_LATENCY_KHR VideoEncodeTuningModeKHR = 3
+	VIDEO_ENCODE_TUNING_MODE_LOSSLESS_KHR          VideoEncodeTuningModeKHR = 4
+	VIDEO_ENCODE_TUNING_MODE_MAX_ENUM_KHR          VideoEncodeTuningModeKHR = 0x7FFFFFFF
+)
+
+func (x VideoEncodeTuningModeKHR) String() string {
+	switch x {
+	case VIDEO_ENCODE_TUNING_MODE_DEFAULT_KHR:
+		return "VIDEO_ENCODE_TUNING_MODE_DEFAULT_KHR"
+	case VIDEO_ENCODE_TUNING_MODE_HIGH_QUALITY_KHR:
+		return "VIDEO_ENCODE_TUNING_MODE_HIGH_QUALITY_KHR"
+	case VIDEO_ENCODE_TUNING_MODE_LOW_LATENCY_KHR:
+		return "VIDEO_ENCODE_TUNING_MODE_LOW_LATENCY_KHR"
+	case VIDEO_ENCODE_TUNING_MODE_ULTRA_LOW_LATENCY_KHR:
+		return "VIDEO_ENCODE_TUNING_MODE_ULTRA_LOW_LATENCY_KHR"
+	case VIDEO_ENCODE_TUNING_MODE_LOSSLESS_KHR:
+		return "VIDEO_ENCODE_TUNING_MODE_LOSSLESS_KHR"
+	case VIDEO_ENCODE_TUNING_MODE_MAX_ENUM_KHR:
+		return "VIDEO_ENCODE_TUNING_MODE_MAX_ENUM_KHR"
+	default:
+		return fmt.Sprint(int32(x))
+	}
+}
+
+type VideoEncodeRateControlFlagsKHR uint32 // reserved
+// VideoEncodeInfoKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeInfoKHR.html
+type VideoEncodeInfoKHR struct {
+	SType                           StructureType
+	PNext                           unsafe.Pointer
+	Flags                           VideoEncodeFlagsKHR
+	QualityLevel                    uint32
+	DstBitstreamBuffer              Buffer
+	DstBitstreamBufferOffset        DeviceSize
+	DstBitstreamBufferMaxRange      DeviceSize
+	SrcPictureResource              VideoPictureResourceInfoKHR
+	PSetupReferenceSlot             *VideoReferenceSlotInfoKHR
+	ReferenceSlotCount              uint32
+	PReferenceSlots                 *VideoReferenceSlotInfoKHR
+	PrecedingExternallyEncodedBytes uint32
+}
+
+func NewVideoEncodeInfoKHR() *VideoEncodeInfoKHR {
+	p := (*VideoEncodeInfoKHR)(MemAlloc(unsafe.Sizeof(*(*VideoEncodeInfoKHR)(nil))))
+	p.SType = STRUCTURE_TYPE_VIDEO_ENCODE_INFO_KHR
+	return p
+}
+func (p *VideoEncodeInfoKHR) Free() { MemFree(unsafe.Pointer(p)) }
+
+// VideoEncodeCapabilitiesKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeCapabilitiesKHR.html
+type VideoEncodeCapabilitiesKHR struct {
+	SType                       StructureType
+	PNext                       unsafe.Pointer
+	Flags                       VideoEncodeCapabilityFlagsKHR
+	RateControlModes            VideoEncodeRateControlModeFlagsKHR
+	RateControlLayerCount       uint8
+	QualityLevelCount           uint8
+	InputImageDataFillAlignment Extent2D
+}
+
+func NewVideoEncodeCapabilitiesKHR() *VideoEncodeCapabilitiesKHR {
+	p := (*VideoEncodeCapabilitiesKHR)(MemAlloc(unsafe.Sizeof(*(*VideoEncodeCapabilitiesKHR)(nil))))
+	p.SType = STRUCTURE_TYPE_VIDEO_ENCODE_CAPABILITIES_KHR
+	return p
+}
+func (p *VideoEncodeCapabilitiesKHR) Free() { MemFree(unsafe.Pointer(p)) }
+
+// VideoEncodeUsageInfoKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeUsageInfoKHR.html
+type VideoEncodeUsageInfoKHR struct {
+	SType             StructureType
+	PNext             unsafe.Pointer
+	VideoUsageHints   VideoEncodeUsageFlagsKHR
+	VideoContentHints VideoEncodeContentFlagsKHR
+	TuningMode        VideoEncodeTuningModeKHR
+}
+
+func NewVideoEncodeUsageInfoKHR() *VideoEncodeUsageInfoKHR {
+	p := (*VideoEncodeUsageInfoKHR)(MemAlloc(unsafe.Sizeof(*(*VideoEncodeUsageInfoKHR)(nil))))
+	p.SType = STRUCTURE_TYPE_VIDEO_ENCODE_USAGE_INFO_KHR
+	return p
+}
+func (p *VideoEncodeUsageInfoKHR) Free() { MemFree(unsafe.Pointer(p)) }
+
+// VideoEncodeRateControlLayerInfoKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeRateControlLayerInfoKHR.html
+type VideoEncodeRateControlLayerInfoKHR struct {
+	SType                        StructureType
+	PNext                        unsafe.Pointer
+	AverageBitrate               uint32
+	MaxBitrate                   uint32
+	FrameRateNumerator           uint32
+	FrameRateDenominator         uint32
+	VirtualBufferSizeInMs        uint32
+	InitialVirtualBufferSizeInMs uint32
+}
+
+func NewVideoEncodeRateControlLayerInfoKHR() *VideoEncodeRateControlLayerInfoKHR {
+	p := (*VideoEncodeRateControlLayerInfoKHR)(MemAlloc(unsafe.Sizeof(*(*VideoEncodeRateControlLayerInfoKHR)(nil))))
+	p.SType = STRUCTURE_TYPE_VIDEO_ENCODE_RATE_CONTROL_LAYER_INFO_KHR
+	return p
+}
+func (p *VideoEncodeRateControlLayerInfoKHR) Free() { MemFree(unsafe.Pointer(p)) }
+
+// VideoEncodeRateControlInfoKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeRateControlInfoKHR.html
+type VideoEncodeRateControlInfoKHR struct {
+	SType           StructureType
+	PNext           unsafe.Pointer
+	Flags           VideoEncodeRateControlFlagsKHR
+	RateControlMode VideoEncodeRateControlModeFlagsKHR
+	LayerCount      uint8
+	PLayerConfigs   *VideoEncodeRateControlLayerInfoKHR
+}
+
+func NewVideoEncodeRateControlInfoKHR() *VideoEncodeRateControlInfoKHR {
+	p := (*VideoEncodeRateControlInfoKHR)(MemAlloc(unsafe.Sizeof(*(*VideoEncodeRateControlInfoKHR)(nil))))
+	p.SType = STRUCTURE_TYPE_VIDEO_ENCODE_RATE_CONTROL_INFO_KHR
+	return p
+}
+func (p *VideoEncodeRateControlInfoKHR) Free() { MemFree(unsafe.Pointer(p)) }
+
+// PfnCmdEncodeVideoKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkCmdEncodeVideoKHR.html
+type PfnCmdEncodeVideoKHR uintptr
+
+func (fn PfnCmdEncodeVideoKHR) Call(commandBuffer CommandBuffer, pEncodeInfo *VideoEncodeInfoKHR) {
+	C.bridge_vkCmdEncodeVideoKHR(C.uintptr_t(fn), (C.VkCommandBuffer)(unsafe.Pointer(uintptr(commandBuffer))), (*C.VkVideoEncodeInfoKHR)(unsafe.Pointer(pEncodeInfo)))
+	debugCheckAndBreak()
+	return
+}
+func (fn PfnCmdEncodeVideoKHR) String() string { return "vkCmdEncodeVideoKHR" }
+
+const EXT_video_encode_h264 = 1
+const STD_VULKAN_VIDEO_CODEC_H264_ENCODE_SPEC_VERSION = 0<<22 | 9<<12 | 8
+
+var STD_VULKAN_VIDEO_CODEC_H264_ENCODE_EXTENSION_NAME = "VK_STD_vulkan_video_codec_h264_encode"
+
+const EXT_VIDEO_ENCODE_H264_SPEC_VERSION = 9
+
+var EXT_VIDEO_ENCODE_H264_EXTENSION_NAME = "VK_EXT_video_encode_h264"
+
+// VideoEncodeH264CapabilityFlagsEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeH264CapabilityFlagsEXT.html
+type VideoEncodeH264CapabilityFlagsEXT uint32
+
+const (
+	VIDEO_ENCODE_H264_CAPABILITY_DIRECT_8X8_INFERENCE_ENABLED_BIT_EXT    VideoEncodeH264CapabilityFlagsEXT = 0x00000001
+	VIDEO_ENCODE_H264_CAPABILITY_DIRECT_8X8_INFERENCE_DISABLED_BIT_EXT   VideoEncodeH264CapabilityFlagsEXT = 0x00000002
+	VIDEO_ENCODE_H264_CAPABILITY_SEPARATE_COLOUR_PLANE_BIT_EXT           VideoEncodeH264CapabilityFlagsEXT = 0x00000004
+	VIDEO_ENCODE_H264_CAPABILITY_QPPRIME_Y_ZERO_TRANSFORM_BYPASS_BIT_EXT VideoEncodeH264CapabilityFlagsEXT = 0x00000008
+	VIDEO_ENCODE_H264_CAPABILITY_SCALING_LISTS_BIT_EXT                   VideoEncodeH264CapabilityFlagsEXT = 0x00000010
+	VIDEO_ENCODE_H264_CAPABILITY_HRD_COMPLIANCE_BIT_EXT                  VideoEncodeH264CapabilityFlagsEXT = 0x00000020
+	VIDEO_ENCODE_H264_CAPABILITY_CHROMA_QP_OFFSET_BIT_EXT                VideoEncodeH264CapabilityFlagsEXT = 0x00000040
+	VIDEO_ENCODE_H264_CAPABILITY_SECOND_CHROMA_QP_OFFSET_BIT_EXT         VideoEncodeH264CapabilityFlagsEXT = 0x00000080
+	VIDEO_ENCODE_H264_CAPABILITY_PIC_INIT_QP_MINUS26_BIT_EXT             VideoEncodeH264CapabilityFlagsEXT = 0x00000100
+	VIDEO_ENCODE_H264_CAPABILITY_WEIGHTED_PRED_BIT_EXT                   VideoEncodeH264CapabilityFlagsEXT = 0x00000200
+	VIDEO_ENCODE_H264_CAPABILITY_WEIGHTED_BIPRED_EXPLICIT_BIT_EXT        VideoEncodeH264CapabilityFlagsEXT = 0x00000400
+	VIDEO_ENCODE_H264_CAPABILITY_WEIGHTED_BIPRED_IMPLICIT_BIT_EXT        VideoEncodeH264CapabilityFlagsEXT = 0x00000800
+	VIDEO_ENCODE_H264_CAPABILITY_WEIGHTED_PRED_NO_TABLE_BIT_EXT          VideoEncodeH264CapabilityFlagsEXT = 0x00001000
+	VIDEO_ENCODE_H264_CAPABILITY_TRANSFORM_8X8_BIT_EXT                   VideoEncodeH264CapabilityFlagsEXT = 0x00002000
+	VIDEO_ENCODE_H264_CAPABILITY_CABAC_BIT_EXT                           VideoEncodeH264CapabilityFlagsEXT = 0x00004000
+	VIDEO_ENCODE_H264_CAPABILITY_CAVLC_BIT_EXT                           VideoEncodeH264CapabilityFlagsEXT = 0x00008000
+	VIDEO_ENCODE_H264_CAPABILITY_DEBLOCKING_FILTER_DISABLED_BIT_EXT      VideoEncodeH264CapabilityFlagsEXT = 0x00010000
+	VIDEO_ENCODE_H264_CAPABILITY_DEBLOCKING_FILTER_ENABLED_BIT_EXT       VideoEncodeH264CapabilityFlagsEXT = 0x00020000
+	VIDEO_ENCODE_H264_CAPABILITY_DEBLOCKING_FILTER_PARTIAL_BIT_EXT       VideoEncodeH264CapabilityFlagsEXT = 0x00040000
+	VIDEO_ENCODE_H264_CAPABILITY_DISABLE_DIRECT_SPATIAL_MV_PRED_BIT_EXT  VideoEncodeH264CapabilityFlagsEXT = 0x00080000
+	VIDEO_ENCODE_H264_CAPABILITY_MULTIPLE_SLICE_PER_FRAME_BIT_EXT        VideoEncodeH264CapabilityFlagsEXT = 0x00100000
+	VIDEO_ENCODE_H264_CAPABILITY_SLICE_MB_COUNT_BIT_EXT                  VideoEncodeH264CapabilityFlagsEXT = 0x00200000
+	VIDEO_ENCODE_H264_CAPABILITY_ROW_UNALIGNED_SLICE_BIT_EXT             VideoEncodeH264CapabilityFlagsEXT = 0x00400000
+	VIDEO_ENCODE_H264_CAPABILITY_DIFFERENT_SLICE_TYPE_BIT_EXT            VideoEncodeH264CapabilityFlagsEXT = 0x00800000
+	VIDEO_ENCODE_H264_CAPABILITY_B_FRAME_IN_L1_LIST_BIT_EXT              VideoEncodeH264CapabilityFlagsEXT = 0x01000000
+	VIDEO_ENCODE_H264_CAPABILITY_FLAG_BITS_MAX_ENUM_EXT                  VideoEncodeH264CapabilityFlagsEXT = 0x7FFFFFFF
+)
+
+func (x VideoEncodeH264CapabilityFlagsEXT) String() string {
+	var s string
+	for i := uint32(0); i < 32; i++ {
+		if int32(x)&(1<<i) != 0 {
+			switch VideoEncodeH264CapabilityFlagsEXT(1 << i) {
+			case VIDEO_ENCODE_H264_CAPABILITY_DIRECT_8X8_INFERENCE_ENABLED_BIT_EXT:
+				s += "VIDEO_ENCODE_H264_CAPABILITY_DIRECT_8X8_INFERENCE_ENABLED_BIT_EXT|"
+			case VIDEO_ENCODE_H264_CAPABILITY_DIRECT_8X8_INFERENCE_DISABLED_BIT_EXT:
+				s += "VIDEO_ENCODE_H264_CAPABILITY_DIRECT_8X8_INFERENCE_DISABLED_BIT_EXT|"
+			case VIDEO_ENCODE_H264_CAPABILITY_SEPARATE_COLOUR_PLANE_BIT_EXT:
+				s += "VIDEO_ENCODE_H264_CAPABILITY_SEPARATE_COLOUR_PLANE_BIT_EXT|"
+			case VIDEO_ENCODE_H264_CAPABILITY_QPPRIME_Y_ZERO_TRANSFORM_BYPASS_BIT_EXT:
+				s += "VIDEO_ENCODE_H264_CAPABILITY_QPPRIME_Y_ZERO_TRANSFORM_BYPASS_BIT_EXT|"
+			case VIDEO_ENCODE_H264_CAPABILITY_SCALING_LISTS_BIT_EXT:
+				s += "VIDEO_ENCODE_H264_CAPABILITY_SCALING_LISTS_BIT_EXT|"
+			case VIDEO_ENCODE_H264_CAPABILITY_HRD_COMPLIANCE_BIT_EXT:
+				s += "VIDEO_ENCODE_H264_CAPABILITY_HRD_COMPLIANCE_BIT_EXT|"
+			case VIDEO_ENCODE_H264_CAPABILITY_CHROMA_QP_OFFSET_BIT_EXT:
+				s += "VIDEO_ENCODE_H264_CAPABILITY_CHROMA_QP_OFFSET_BIT_EXT|"
+			case VIDEO_ENCODE_H264_CAPABILITY_SECOND_CHROMA_QP_OFFSET_BIT_EXT:
+				s += "VIDEO_ENCODE_H264_CAPABILITY_SECOND_CHROMA_QP_OFFSET_BIT_EXT|"
+			case VIDEO_ENCODE_H264_CAPABILITY_PIC_INIT_QP_MINUS26_BIT_EXT:
+				s += "VIDEO_ENCODE_H264_CAPABILITY_PIC_INIT_QP_MINUS26_BIT_EXT|"
+			case VIDEO_ENCODE_H264_CAPABILITY_WEIGHTED_PRED_BIT_EXT:
+				s += "VIDEO_ENCODE_H264_CAPABILITY_WEIGHTED_PRED_BIT_EXT|"
+			case VIDEO_ENCODE_H264_CAPABILITY_WEIGHTED_BIPRED_EXPLICIT_BIT_EXT:
+				s += "VIDEO_ENCODE_H264_CAPABILITY_WEIGHTED_BIPRED_EXPLICIT_BIT_EXT|"
+			case VIDEO_ENCODE_H264_CAPABILITY_WEIGHTED_BIPRED_IMPLICIT_BIT_EXT:
+				s += "VIDEO_ENCODE_H264_CAPABILITY_WEIGHTED_BIPRED_IMPLICIT_BIT_EXT|"
+			case VIDEO_ENCODE_H264_CAPABILITY_WEIGHTED_PRED_NO_TABLE_BIT_EXT:
+				s += "VIDEO_ENCODE_H264_CAPABILITY_WEIGHTED_PRED_NO_TABLE_BIT_EXT|"
+			case VIDEO_ENCODE_H264_CAPABILITY_TRANSFORM_8X8_BIT_EXT:
+				s += "VIDEO_ENCODE_H264_CAPABILITY_TRANSFORM_8X8_BIT_EXT|"
+			case VIDEO_ENCODE_H264_CAPABILITY_CABAC_BIT_EXT:
+				s += "VIDEO_ENCODE_H264_CAPABILITY_CABAC_BIT_EXT|"
+			case VIDEO_ENCODE_H264_CAPABILITY_CAVLC_BIT_EXT:
+				s += "VIDEO_ENCODE_H264_CAPABILITY_CAVLC_BIT_EXT|"
+			case VIDEO_ENCODE_H264_CAPABILITY_DEBLOCKING_FILTER_DISABLED_BIT_EXT:
+				s += "VIDEO_ENCODE_H264_CAPABILITY_DEBLOCKING_FILTER_DISABLED_BIT_EXT|"
+			case VIDEO_ENCODE_H264_CAPABILITY_DEBLOCKING_FILTER_ENABLED_BIT_EXT:
+				s += "VIDEO_ENCODE_H264_CAPABILITY_DEBLOCKING_FILTER_ENABLED_BIT_EXT|"
+			case VIDEO_ENCODE_H264_CAPABILITY_DEBLOCKING_FILTER_PARTIAL_BIT_EXT:
+				s += "VIDEO_ENCODE_H264_CAPABILITY_DEBLOCKING_FILTER_PARTIAL_BIT_EXT|"
+			case VIDEO_ENCODE_H264_CAPABILITY_DISABLE_DIRECT_SPATIAL_MV_PRED_BIT_EXT:
+				s += "VIDEO_ENCODE_H264_CAPABILITY_DISABLE_DIRECT_SPATIAL_MV_PRED_BIT_EXT|"
+			case VIDEO_ENCODE_H264_CAPABILITY_MULTIPLE_SLICE_PER_FRAME_BIT_EXT:
+				s += "VIDEO_ENCODE_H264_CAPABILITY_MULTIPLE_SLICE_PER_FRAME_BIT_EXT|"
+			case VIDEO_ENCODE_H264_CAPABILITY_SLICE_MB_COUNT_BIT_EXT:
+				s += "VIDEO_ENCODE_H264_CAPABILITY_SLICE_MB_COUNT_BIT_EXT|"
+			case VIDEO_ENCODE_H264_CAPABILITY_ROW_UNALIGNED_SLICE_BIT_EXT:
+				s += "VIDEO_ENCODE_H264_CAPABILITY_ROW_UNALIGNED_SLICE_BIT_EXT|"
+			case VIDEO_ENCODE_H264_CAPABILITY_DIFFERENT_SLICE_TYPE_BIT_EXT:
+				s += "VIDEO_ENCODE_H264_CAPABILITY_DIFFERENT_SLICE_TYPE_BIT_EXT|"
+			case VIDEO_ENCODE_H264_CAPABILITY_B_FRAME_IN_L1_LIST_BIT_EXT:
+				s += "VIDEO_ENCODE_H264_CAPABILITY_B_FRAME_IN_L1_LIST_BIT_EXT|"
+			case VIDEO_ENCODE_H264_CAPABILITY_FLAG_BITS_MAX_ENUM_EXT:
+				s += "VIDEO_ENCODE_H264_CAPABILITY_FLAG_BITS_MAX_ENUM_EXT|"
+			}
+		}
+	}
+	return strings.TrimSuffix(s, `|`)
+}
+
+// VideoEncodeH264InputModeFlagsEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeH264InputModeFlagsEXT.html
+type VideoEncodeH264InputModeFlagsEXT uint32
+
+const (
+	VIDEO_ENCODE_H264_INPUT_MODE_FRAME_BIT_EXT          VideoEncodeH264InputModeFlagsEXT = 0x00000001
+	VIDEO_ENCODE_H264_INPUT_MODE_SLICE_BIT_EXT          VideoEncodeH264InputModeFlagsEXT = 0x00000002
+	VIDEO_ENCODE_H264_INPUT_MODE_NON_VCL_BIT_EXT        VideoEncodeH264InputModeFlagsEXT = 0x00000004
+	VIDEO_ENCODE_H264_INPUT_MODE_FLAG_BITS_MAX_ENUM_EXT VideoEncodeH264InputModeFlagsEXT = 0x7FFFFFFF
+)
+
+func (x VideoEncodeH264InputModeFlagsEXT) String() string {
+	var s string
+	for i := uint32(0); i < 32; i++ {
+		if int32(x)&(1<<i) != 0 {
+			switch VideoEncodeH264InputModeFlagsEXT(1 << i) {
+			case VIDEO_ENCODE_H264_INPUT_MODE_FRAME_BIT_EXT:
+				s += "VIDEO_ENCODE_H264_INPUT_MODE_FRAME_BIT_EXT|"
+			case VIDEO_ENCODE_H264_INPUT_MODE_SLICE_BIT_EXT:
+				s += "VIDEO_ENCODE_H264_INPUT_MODE_SLICE_BIT_EXT|"
+			case VIDEO_ENCODE_H264_INPUT_MODE_NON_VCL_BIT_EXT:
+				s += "VIDEO_ENCODE_H264_INPUT_MODE_NON_VCL_BIT_EXT|"
+			case VIDEO_ENCODE_H264_INPUT_MODE_FLAG_BITS_MAX_ENUM_EXT:
+				s += "VIDEO_ENCODE_H264_INPUT_MODE_FLAG_BITS_MAX_ENUM_EXT|"
+			}
+		}
+	}
+	return strings.TrimSuffix(s, `|`)
+}
+
+// VideoEncodeH264OutputModeFlagsEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeH264OutputModeFlagsEXT.html
+type VideoEncodeH264OutputModeFlagsEXT uint32
+
+const (
+	VIDEO_ENCODE_H264_OUTPUT_MODE_FRAME_BIT_EXT          VideoEncodeH264OutputModeFlagsEXT = 0x00000001
+	VIDEO_ENCODE_H264_OUTPUT_MODE_SLICE_BIT_EXT          VideoEncodeH264OutputModeFlagsEXT = 0x00000002
+	VIDEO_ENCODE_H264_OUTPUT_MODE_NON_VCL_BIT_EXT        VideoEncodeH264OutputModeFlagsEXT = 0x00000004
+	VIDEO_ENCODE_H264_OUTPUT_MODE_FLAG_BITS_MAX_ENUM_EXT VideoEncodeH264OutputModeFlagsEXT = 0x7FFFFFFF
+)
+
+func (x VideoEncodeH264OutputModeFlagsEXT) String() string {
+	var s string
+	for i := uint32(0); i < 32; i++ {
+		if int32(x)&(1<<i) != 0 {
+			switch VideoEncodeH264OutputModeFlagsEXT(1 << i) {
+			case VIDEO_ENCODE_H264_OUTPUT_MODE_FRAME_BIT_EXT:
+				s += "VIDEO_ENCODE_H264_OUTPUT_MODE_FRAME_BIT_EXT|"
+			case VIDEO_ENCODE_H264_OUTPUT_MODE_SLICE_BIT_EXT:
+				s += "VIDEO_ENCODE_H264_OUTPUT_MODE_SLICE_BIT_EXT|"
+			case VIDEO_ENCODE_H264_OUTPUT_MODE_NON_VCL_BIT_EXT:
+				s += "VIDEO_ENCODE_H264_OUTPUT_MODE_NON_VCL_BIT_EXT|"
+			case VIDEO_ENCODE_H264_OUTPUT_MODE_FLAG_BITS_MAX_ENUM_EXT:
+				s += "VIDEO_ENCODE_H264_OUTPUT_MODE_FLAG_BITS_MAX_ENUM_EXT|"
+			}
+		}
+	}
+	return strings.TrimSuffix(s, `|`)
+}
+
+// VideoEncodeH264RateControlStructureEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeH264RateControlStructureEXT.html
+type VideoEncodeH264RateControlStructureEXT int32
+
+const (
+	VIDEO_ENCODE_H264_RATE_CONTROL_STRUCTURE_UNKNOWN_EXT  VideoEncodeH264RateControlStructureEXT = 0
+	VIDEO_ENCODE_H264_RATE_CONTROL_STRUCTURE_FLAT_EXT     VideoEncodeH264RateControlStructureEXT = 1
+	VIDEO_ENCODE_H264_RATE_CONTROL_STRUCTURE_DYADIC_EXT   VideoEncodeH264RateControlStructureEXT = 2
+	VIDEO_ENCODE_H264_RATE_CONTROL_STRUCTURE_MAX_ENUM_EXT VideoEncodeH264RateControlStructureEXT = 0x7FFFFFFF
+)
+
+func (x VideoEncodeH264RateControlStructureEXT) String() string {
+	switch x {
+	case VIDEO_ENCODE_H264_RATE_CONTROL_STRUCTURE_UNKNOWN_EXT:
+		return "VIDEO_ENCODE_H264_RATE_CONTROL_STRUCTURE_UNKNOWN_EXT"
+	case VIDEO_ENCODE_H264_RATE_CONTROL_STRUCTURE_FLAT_EXT:
+		return "VIDEO_ENCODE_H264_RATE_CONTROL_STRUCTURE_FLAT_EXT"
+	case VIDEO_ENCODE_H264_RATE_CONTROL_STRUCTURE_DYADIC_EXT:
+		return "VIDEO_ENCODE_H264_RATE_CONTROL_STRUCTURE_DYADIC_EXT"
+	case VIDEO_ENCODE_H264_RATE_CONTROL_STRUCTURE_MAX_ENUM_EXT:
+		return "VIDEO_ENCODE_H264_RATE_CONTROL_STRUCTURE_MAX_ENUM_EXT"
+	default:
+		return fmt.Sprint(int32(x))
+	}
+}
+
+// VideoEncodeH264CapabilitiesEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeH264CapabilitiesEXT.html
+type VideoEncodeH264CapabilitiesEXT struct {
+	SType                              StructureType
+	PNext                              unsafe.Pointer
+	Flags                              VideoEncodeH264CapabilityFlagsEXT
+	InputModeFlags                     VideoEncodeH264InputModeFlagsEXT
+	OutputModeFlags                    VideoEncodeH264OutputModeFlagsEXT
+	MaxPPictureL0ReferenceCount        uint8
+	MaxBPictureL0ReferenceCount        uint8
+	MaxL1ReferenceCount                uint8
+	MotionVectorsOverPicBoundariesFlag Bool32
+	MaxBytesPerPicDenom                uint32
+	MaxBitsPerMbDenom                  uint32
+	Log2MaxMvLengthHorizontal          uint32
+	Log2MaxMvLengthVertical            uint32
+}
+
+func NewVideoEncodeH264CapabilitiesEXT() *VideoEncodeH264CapabilitiesEXT {
+	p := (*VideoEncodeH264CapabilitiesEXT)(MemAlloc(unsafe.Sizeof(*(*VideoEncodeH264CapabilitiesEXT)(nil))))
+	p.SType = STRUCTURE_TYPE_VIDEO_ENCODE_H264_CAPABILITIES_EXT
+	return p
+}
+func (p *VideoEncodeH264CapabilitiesEXT) Free() { MemFree(unsafe.Pointer(p)) }
+
+// VideoEncodeH264SessionParametersAddInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeH264SessionParametersAddInfoEXT.html
+type VideoEncodeH264SessionParametersAddInfoEXT struct {
+	SType       StructureType
+	PNext       unsafe.Pointer
+	StdSPSCount uint32
+	PStdSPSs    *StdVideoH264SequenceParameterSet
+	StdPPSCount uint32
+	PStdPPSs    *StdVideoH264PictureParameterSet
+}
+
+func NewVideoEncodeH264SessionParametersAddInfoEXT() *VideoEncodeH264SessionParametersAddInfoEXT {
+	p := (*VideoEncodeH264SessionParametersAddInfoEXT)(MemAlloc(unsafe.Sizeof(*(*VideoEncodeH264SessionParametersAddInfoEXT)(nil))))
+	p.SType = STRUCTURE_TYPE_VIDEO_ENCODE_H264_SESSION_PARAMETERS_ADD_INFO_EXT
+	return p
+}
+func (p *VideoEncodeH264SessionParametersAddInfoEXT) Free() { MemFree(unsafe.Pointer(p)) }
+
+// VideoEncodeH264SessionParametersCreateInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeH264SessionParametersCreateInfoEXT.html
+type VideoEncodeH264SessionParametersCreateInfoEXT struct {
+	SType              StructureType
+	PNext              unsafe.Pointer
+	MaxStdSPSCount     uint32
+	MaxStdPPSCount     uint32
+	PParametersAddInfo *VideoEncodeH264SessionParametersAddInfoEXT
+}
+
+func NewVideoEncodeH264SessionParametersCreateInfoEXT() *VideoEncodeH264SessionParametersCreateInfoEXT {
+	p := (*VideoEncodeH264SessionParametersCreateInfoEXT)(MemAlloc(unsafe.Sizeof(*(*VideoEncodeH264SessionParametersCreateInfoEXT)(nil))))
+	p.SType = STRUCTURE_TYPE_VIDEO_ENCODE_H264_SESSION_PARAMETERS_CREATE_INFO_EXT
+	return p
+}
+func (p *VideoEncodeH264SessionParametersCreateInfoEXT) Free() { MemFree(unsafe.Pointer(p)) }
+
+// StdVideoEncodeH264WeightTableFlags -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/StdVideoEncodeH264WeightTableFlags.html
+type StdVideoEncodeH264WeightTableFlags struct {
+	LumaWeightL0Flag   uint32
+	ChromaWeightL0Flag uint32
+	LumaWeightL1Flag   uint32
+	ChromaWeightL1Flag uint32
+}
+
+func NewStdVideoEncodeH264WeightTableFlags() *StdVideoEncodeH264WeightTableFlags {
+	return (*StdVideoEncodeH264WeightTableFlags)(MemAlloc(unsafe.Sizeof(*(*StdVideoEncodeH264WeightTableFlags)(nil))))
+}
+func (p *StdVideoEncodeH264WeightTableFlags) Free() { MemFree(unsafe.Pointer(p)) }
+
+// StdVideoEncodeH264WeightTable -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/StdVideoEncodeH264WeightTable.html
+type StdVideoEncodeH264WeightTable struct {
+	Flags                 StdVideoEncodeH264WeightTableFlags
+	LumaLog2WeightDenom   uint8
+	ChromaLog2WeightDenom uint8
+	LumaWeightL0          [STD_VIDEO_H264_MAX_NUM_LIST_REF]int8
+	LumaOffsetL0          [STD_VIDEO_H264_MAX_NUM_LIST_REF]int8
+	ChromaWeightL0        [STD_VIDEO_H264_MAX_NUM_LIST_REF][STD_VIDEO_H264_MAX_CHROMA_PLANES]int8
+	ChromaOffsetL0        [STD_VIDEO_H264_MAX_NUM_LIST_REF][STD_VIDEO_H264_MAX_CHROMA_PLANES]int8
+	LumaWeightL1          [STD_VIDEO_H264_MAX_NUM_LIST_REF]int8
+	LumaOffsetL1          [STD_VIDEO_H264_MAX_NUM_LIST_REF]int8
+	ChromaWeightL1        [STD_VIDEO_H264_MAX_NUM_LIST_REF][STD_VIDEO_H264_MAX_CHROMA_PLANES]int8
+	ChromaOffsetL1        [STD_VIDEO_H264_MAX_NUM_LIST_REF][STD_VIDEO_H264_MAX_CHROMA_PLANES]int8
+}
+
+func NewStdVideoEncodeH264WeightTable() *StdVideoEncodeH264WeightTable {
+	return (*StdVideoEncodeH264WeightTable)(MemAlloc(unsafe.Sizeof(*(*StdVideoEncodeH264WeightTable)(nil))))
+}
+func (p *StdVideoEncodeH264WeightTable) Free() { MemFree(unsafe.Pointer(p)) }
+
+// StdVideoEncodeH264SliceHeaderFlags -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/StdVideoEncodeH264SliceHeaderFlags.html
+type StdVideoEncodeH264SliceHeaderFlags struct {
+	bitfield0 uint32 // direct_spatial_mv_pred_flag:1 num_ref_idx_active_override_flag:1 no_output_of_prior_pics_flag:1 adaptive_ref_pic_marking_mode_flag:1 no_prior_references_available_flag:1
+}
+
+func NewStdVideoEncodeH264SliceHeaderFlags() *StdVideoEncodeH264SliceHeaderFlags {
+	return (*StdVideoEncodeH264SliceHeaderFlags)(MemAlloc(unsafe.Sizeof(*(*StdVideoEncodeH264SliceHeaderFlags)(nil))))
+}
+func (p *StdVideoEncodeH264SliceHeaderFlags) Free() { MemFree(unsafe.Pointer(p)) }
+
+func (p StdVideoEncodeH264SliceHeaderFlags) DirectSpatialMvPredFlag() uint32 {
+	return p.bitfield0 & 0x1
+}
+func (p *StdVideoEncodeH264SliceHeaderFlags) SetDirectSpatialMvPredFlag(x uint32) {
+	p.bitfield0 = p.bitfield0&^0x1 | x&0x1
+}
+
+func (p StdVideoEncodeH264SliceHeaderFlags) NumRefIdxActiveOverrideFlag() uint32 {
+	return p.bitfield0 >> 1 & 0x1
+}
+func (p *StdVideoEncodeH264SliceHeaderFlags) SetNumRefIdxActiveOverrideFlag(x uint32) {
+	p.bitfield0 = p.bitfield0&^0x2 | x&0x1<<1
+}
+
+func (p StdVideoEncodeH264SliceHeaderFlags) NoOutputOfPriorPicsFlag() uint32 {
+	return p.bitfield0 >> 2 & 0x1
+}
+func (p *StdVideoEncodeH264SliceHeaderFlags) SetNoOutputOfPriorPicsFlag(x uint32) {
+	p.bitfield0 = p.bitfield0&^0x4 | x&0x1<<2
+}
+
+func (p StdVideoEncodeH264SliceHeaderFlags) AdaptiveRefPicMarkingModeFlag() uint32 {
+	return p.bitfield0 >> 3 & 0x1
+}
+func (p *StdVideoEncodeH264SliceHeaderFlags) SetAdaptiveRefPicMarkingModeFlag(x uint32) {
+	p.bitfield0 = p.bitfield0&^0x8 | x&0x1<<3
+}
+
+func (p StdVideoEncodeH264SliceHeaderFlags) NoPriorReferencesAvailableFlag() uint32 {
+	return p.bitfield0 >> 4 & 0x1
+}
+func (p *StdVideoEncodeH264SliceHeaderFlags) SetNoPriorReferencesAvailableFlag(x uint32) {
+	p.bitfield0 = p.bitfield0&^0x10 | x&0x1<<4
+}
+
+// StdVideoEncodeH264PictureInfoFlags -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/StdVideoEncodeH264PictureInfoFlags.html
+type StdVideoEncodeH264PictureInfoFlags struct {
+	bitfield0 uint32 // idr_flag:1 is_reference_flag:1 used_for_long_term_reference:1
+}
+
+func NewStdVideoEncodeH264PictureInfoFlags() *StdVideoEncodeH264PictureInfoFlags {
+	return (*StdVideoEncodeH264PictureInfoFlags)(MemAlloc(unsafe.Sizeof(*(*StdVideoEncodeH264PictureInfoFlags)(nil))))
+}
+func (p *StdVideoEncodeH264PictureInfoFlags) Free() { MemFree(unsafe.Pointer(p)) }
+
+func (p StdVideoEncodeH264PictureInfoFlags) IdrFlag() uint32 { return p.bitfield0 & 0x1 }
+func (p *StdVideoEncodeH264PictureInfoFlags) SetIdrFlag(x uint32) {
+	p.bitfield0 = p.bitfield0&^0x1 | x&0x1
+}
+
+func (p StdVideoEncodeH264PictureInfoFlags) IsReferenceFlag() uint32 { return p.bitfield0 >> 1 & 0x1 }
+func (p *StdVideoEncodeH264PictureInfoFlags) SetIsReferenceFlag(x uint32) {
+	p.bitfield0 = p.bitfield0&^0x2 | x&0x1<<1
+}
+
+func (p StdVideoEncodeH264PictureInfoFlags) UsedForLongTermReference() uint32 {
+	return p.bitfield0 >> 2 & 0x1
+}
+func (p *StdVideoEncodeH264PictureInfoFlags) SetUsedForLongTermReference(x uint32) {
+	p.bitfield0 = p.bitfield0&^0x4 | x&0x1<<2
+}
+
+// StdVideoEncodeH264ReferenceInfoFlags -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/StdVideoEncodeH264ReferenceInfoFlags.html
+type StdVideoEncodeH264ReferenceInfoFlags struct {
+	bitfield0 uint32 // used_for_long_term_reference:1
+}
+
+func NewStdVideoEncodeH264ReferenceInfoFlags() *StdVideoEncodeH264ReferenceInfoFlags {
+	return (*StdVideoEncodeH264ReferenceInfoFlags)(MemAlloc(unsafe.Sizeof(*(*StdVideoEncodeH264ReferenceInfoFlags)(nil))))
+}
+func (p *StdVideoEncodeH264ReferenceInfoFlags) Free() { MemFree(unsafe.Pointer(p)) }
+
+func (p StdVideoEncodeH264ReferenceInfoFlags) UsedForLongTermReference() uint32 {
+	return p.bitfield0 & 0x1
+}
+func (p *StdVideoEncodeH264ReferenceInfoFlags) SetUsedForLongTermReference(x uint32) {
+	p.bitfield0 = p.bitfield0&^0x1 | x&0x1
+}
+
+// StdVideoEncodeH264RefMgmtFlags -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/StdVideoEncodeH264RefMgmtFlags.html
+type StdVideoEncodeH264RefMgmtFlags struct {
+	bitfield0 uint32 // ref_pic_list_modification_l0_flag:1 ref_pic_list_modification_l1_flag:1
+}
+
+func NewStdVideoEncodeH264RefMgmtFlags() *StdVideoEncodeH264RefMgmtFlags {
+	return (*StdVideoEncodeH264RefMgmtFlags)(MemAlloc(unsafe.Sizeof(*(*StdVideoEncodeH264RefMgmtFlags)(nil))))
+}
+func (p *StdVideoEncodeH264RefMgmtFlags) Free() { MemFree(unsafe.Pointer(p)) }
+
+func (p StdVideoEncodeH264RefMgmtFlags) RefPicListModificationL0Flag() uint32 {
+	return p.bitfield0 & 0x1
+}
+func (p *StdVideoEncodeH264RefMgmtFlags) SetRefPicListModificationL0Flag(x uint32) {
+	p.bitfield0 = p.bitfield0&^0x1 | x&0x1
+}
+
+func (p StdVideoEncodeH264RefMgmtFlags) RefPicListModificationL1Flag() uint32 {
+	return p.bitfield0 >> 1 & 0x1
+}
+func (p *StdVideoEncodeH264RefMgmtFlags) SetRefPicListModificationL1Flag(x uint32) {
+	p.bitfield0 = p.bitfield0&^0x2 | x&0x1<<1
+}
+
+// StdVideoEncodeH264RefListModEntry -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/StdVideoEncodeH264RefListModEntry.html
+type StdVideoEncodeH264RefListModEntry struct {
+	ModificationOfPicNumsIdc StdVideoH264ModificationOfPicNumsIdc
+	AbsDiffPicNumMinus1      uint16
+	LongTermPicNum           uint16
+}
+
+func NewStdVideoEncodeH264RefListModEntry() *StdVideoEncodeH264RefListModEntry {
+	return (*StdVideoEncodeH264RefListModEntry)(MemAlloc(unsafe.Sizeof(*(*StdVideoEncodeH264RefListModEntry)(nil))))
+}
+func (p *StdVideoEncodeH264RefListModEntry) Free() { MemFree(unsafe.Pointer(p)) }
+
+// StdVideoEncodeH264RefPicMarkingEntry -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/StdVideoEncodeH264RefPicMarkingEntry.html
+type StdVideoEncodeH264RefPicMarkingEntry struct {
+	Operation                 StdVideoH264MemMgmtControlOp
+	DifferenceOfPicNumsMinus1 uint16
+	LongTermPicNum            uint16
+	LongTermFrameIdx          uint16
+	MaxLongTermFrameIdxPlus1  uint16
+}
+
+func NewStdVideoEncodeH264RefPicMarkingEntry() *StdVideoEncodeH264RefPicMarkingEntry {
+	return (*StdVideoEncodeH264RefPicMarkingEntry)(MemAlloc(unsafe.Sizeof(*(*StdVideoEncodeH264RefPicMarkingEntry)(nil))))
+}
+func (p *StdVideoEncodeH264RefPicMarkingEntry) Free() { MemFree(unsafe.Pointer(p)) }
+
+// StdVideoEncodeH264RefMemMgmtCtrlOperations -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/StdVideoEncodeH264RefMemMgmtCtrlOperations.html
+type StdVideoEncodeH264RefMemMgmtCtrlOperations struct {
+	Flags                    StdVideoEncodeH264RefMgmtFlags
+	RefList0ModOpCount       uint8
+	PRefList0ModOperations   *StdVideoEncodeH264RefListModEntry
+	RefList1ModOpCount       uint8
+	PRefList1ModOperations   *StdVideoEncodeH264RefListModEntry
+	RefPicMarkingOpCount     uint8
+	PRefPicMarkingOperations *StdVideoEncodeH264RefPicMarkingEntry
+}
+
+func NewStdVideoEncodeH264RefMemMgmtCtrlOperations() *StdVideoEncodeH264RefMemMgmtCtrlOperations {
+	return (*StdVideoEncodeH264RefMemMgmtCtrlOperations)(MemAlloc(unsafe.Sizeof(*(*StdVideoEncodeH264RefMemMgmtCtrlOperations)(nil))))
+}
+func (p *StdVideoEncodeH264RefMemMgmtCtrlOperations) Free() { MemFree(unsafe.Pointer(p)) }
+
+// StdVideoEncodeH264PictureInfo -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/StdVideoEncodeH264PictureInfo.html
+type StdVideoEncodeH264PictureInfo struct {
+	Flags             StdVideoEncodeH264PictureInfoFlags
+	SeqParameterSetId uint8
+	PicParameterSetId uint8
+	PictureType       StdVideoH264PictureType
+	FrameNum          uint32
+	PicOrderCnt       int32
+}
+
+func NewStdVideoEncodeH264PictureInfo() *StdVideoEncodeH264PictureInfo {
+	return (*StdVideoEncodeH264PictureInfo)(MemAlloc(unsafe.Sizeof(*(*StdVideoEncodeH264PictureInfo)(nil))))
+}
+func (p *StdVideoEncodeH264PictureInfo) Free() { MemFree(unsafe.Pointer(p)) }
+
+// StdVideoEncodeH264ReferenceInfo -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/StdVideoEncodeH264ReferenceInfo.html
+type StdVideoEncodeH264ReferenceInfo struct {
+	Flags            StdVideoEncodeH264ReferenceInfoFlags
+	FrameNum         uint32
+	PicOrderCnt      int32
+	LongTermPicNum   uint16
+	LongTermFrameIdx uint16
+}
+
+func NewStdVideoEncodeH264ReferenceInfo() *StdVideoEncodeH264ReferenceInfo {
+	return (*StdVideoEncodeH264ReferenceInfo)(MemAlloc(unsafe.Sizeof(*(*StdVideoEncodeH264ReferenceInfo)(nil))))
+}
+func (p *StdVideoEncodeH264ReferenceInfo) Free() { MemFree(unsafe.Pointer(p)) }
+
+// StdVideoEncodeH264SliceHeader -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/StdVideoEncodeH264SliceHeader.html
+type StdVideoEncodeH264SliceHeader struct {
+	Flags                      StdVideoEncodeH264SliceHeaderFlags
+	FirstMbInSlice             uint32
+	SliceType                  StdVideoH264SliceType
+	IdrPicId                   uint16
+	NumRefIdxL0ActiveMinus1    uint8
+	NumRefIdxL1ActiveMinus1    uint8
+	CabacInitIdc               StdVideoH264CabacInitIdc
+	DisableDeblockingFilterIdc StdVideoH264DisableDeblockingFilterIdc
+	SliceAlphaC0OffsetDiv2     int8
+	SliceBetaOffsetDiv2        int8
+	PWeightTable               *StdVideoEncodeH264WeightTable
+}
+
+func NewStdVideoEncodeH264SliceHeader() *StdVideoEncodeH264SliceHeader {
+	return (*StdVideoEncodeH264SliceHeader)(MemAlloc(unsafe.Sizeof(*(*StdVideoEncodeH264SliceHeader)(nil))))
+}
+func (p *StdVideoEncodeH264SliceHeader) Free() { MemFree(unsafe.Pointer(p)) }
+
+// VideoEncodeH264DpbSlotInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeH264DpbSlotInfoEXT.html
+type VideoEncodeH264DpbSlotInfoEXT struct {
+	SType             StructureType
+	PNext             unsafe.Pointer
+	SlotIndex         int8
+	PStdReferenceInfo *StdVideoEncodeH264ReferenceInfo
+}
+
+func NewVideoEncodeH264DpbSlotInfoEXT() *VideoEncodeH264DpbSlotInfoEXT {
+	p := (*VideoEncodeH264DpbSlotInfoEXT)(MemAlloc(unsafe.Sizeof(*(*VideoEncodeH264DpbSlotInfoEXT)(nil))))
+	p.SType = STRUCTURE_TYPE_VIDEO_ENCODE_H264_DPB_SLOT_INFO_EXT
+	return p
+}
+func (p *VideoEncodeH264DpbSlotInfoEXT) Free() { MemFree(unsafe.Pointer(p)) }
+
+// VideoEncodeH264ReferenceListsInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeH264ReferenceListsInfoEXT.html
+type VideoEncodeH264ReferenceListsInfoEXT struct {
+	SType                    StructureType
+	PNext                    unsafe.Pointer
+	ReferenceList0EntryCount uint8
+	PReferenceList0Entries   *VideoEncodeH264DpbSlotInfoEXT
+	ReferenceList1EntryCount uint8
+	PReferenceList1Entries   *VideoEncodeH264DpbSlotInfoEXT
+	PMemMgmtCtrlOperations   *StdVideoEncodeH264RefMemMgmtCtrlOperations
+}
+
+func NewVideoEncodeH264ReferenceListsInfoEXT() *VideoEncodeH264ReferenceListsInfoEXT {
+	p := (*VideoEncodeH264ReferenceListsInfoEXT)(MemAlloc(unsafe.Sizeof(*(*VideoEncodeH264ReferenceListsInfoEXT)(nil))))
+	p.SType = STRUCTURE_TYPE_VIDEO_ENCODE_H264_REFERENCE_LISTS_INFO_EXT
+	return p
+}
+func (p *VideoEncodeH264ReferenceListsInfoEXT) Free() { MemFree(unsafe.Pointer(p)) }
+
+// VideoEncodeH264NaluSliceInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeH264NaluSliceInfoEXT.html
+type VideoEncodeH264NaluSliceInfoEXT struct {
+	SType                StructureType
+	PNext                unsafe.Pointer
+	MbCount              uint32
+	PReferenceFinalLists *VideoEncodeH264ReferenceListsInfoEXT
+	PSliceHeaderStd      *StdVideoEncodeH264SliceHeader
+}
+
+func NewVideoEncodeH264NaluSliceInfoEXT() *VideoEncodeH264NaluSliceInfoEXT {
+	p := (*VideoEncodeH264NaluSliceInfoEXT)(MemAlloc(unsafe.Sizeof(*(*VideoEncodeH264NaluSliceInfoEXT)(nil))))
+	p.SType = STRUCTURE_TYPE_VIDEO_ENCODE_H264_NALU_SLICE_INFO_EXT
+	return p
+}
+func (p *VideoEncodeH264NaluSliceInfoEXT) Free() { MemFree(unsafe.Pointer(p)) }
+
+// VideoEncodeH264VclFrameInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeH264VclFrameInfoEXT.html
+type VideoEncodeH264VclFrameInfoEXT struct {
+	SType                StructureType
+	PNext                unsafe.Pointer
+	PReferenceFinalLists *VideoEncodeH264ReferenceListsInfoEXT
+	NaluSliceEntryCount  uint32
+	PNaluSliceEntries    *VideoEncodeH264NaluSliceInfoEXT
+	PCurrentPictureInfo  *StdVideoEncodeH264PictureInfo
+}
+
+func NewVideoEncodeH264VclFrameInfoEXT() *VideoEncodeH264VclFrameInfoEXT {
+	p := (*VideoEncodeH264VclFrameInfoEXT)(MemAlloc(unsafe.Sizeof(*(*VideoEncodeH264VclFrameInfoEXT)(nil))))
+	p.SType = STRUCTURE_TYPE_VIDEO_ENCODE_H264_VCL_FRAME_INFO_EXT
+	return p
+}
+func (p *VideoEncodeH264VclFrameInfoEXT) Free() { MemFree(unsafe.Pointer(p)) }
+
+// VideoEncodeH264EmitPictureParametersInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeH264EmitPictureParametersInfoEXT.html
+type VideoEncodeH264EmitPictureParametersInfoEXT struct {
+	SType           StructureType
+	PNext           unsafe.Pointer
+	SpsId           uint8
+	EmitSpsEnable   Bool32
+	PpsIdEntryCount uint32
+	PpsIdEntries    *uint8
+}
+
+func NewVideoEncodeH264EmitPictureParametersInfoEXT() *VideoEncodeH264EmitPictureParametersInfoEXT {
+	p := (*VideoEncodeH264EmitPictureParametersInfoEXT)(MemAlloc(unsafe.Sizeof(*(*VideoEncodeH264EmitPictureParametersInfoEXT)(nil))))
+	p.SType = STRUCTURE_TYPE_VIDEO_ENCODE_H264_EMIT_PICTURE_PARAMETERS_INFO_EXT
+	return p
+}
+func (p *VideoEncodeH264EmitPictureParametersInfoEXT) Free() { MemFree(unsafe.Pointer(p)) }
+
+// VideoEncodeH264ProfileInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeH264ProfileInfoEXT.html
+type VideoEncodeH264ProfileInfoEXT struct {
+	SType         StructureType
+	PNext         unsafe.Pointer
+	StdProfileIdc StdVideoH264ProfileIdc
+}
+
+func NewVideoEncodeH264ProfileInfoEXT() *VideoEncodeH264ProfileInfoEXT {
+	p := (*VideoEncodeH264ProfileInfoEXT)(MemAlloc(unsafe.Sizeof(*(*VideoEncodeH264ProfileInfoEXT)(nil))))
+	p.SType = STRUCTURE_TYPE_VIDEO_ENCODE_H264_PROFILE_INFO_EXT
+	return p
+}
+func (p *VideoEncodeH264ProfileInfoEXT) Free() { MemFree(unsafe.Pointer(p)) }
+
+// VideoEncodeH264RateControlInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeH264RateControlInfoEXT.html
+type VideoEncodeH264RateControlInfoEXT struct {
+	SType                  StructureType
+	PNext                  unsafe.Pointer
+	GopFrameCount          uint32
+	IdrPeriod              uint32
+	ConsecutiveBFrameCount uint32
+	RateControlStructure   VideoEncodeH264RateControlStructureEXT
+	TemporalLayerCount     uint8
+}
+
+func NewVideoEncodeH264RateControlInfoEXT() *VideoEncodeH264RateControlInfoEXT {
+	p := (*VideoEncodeH264RateControlInfoEXT)(MemAlloc(unsafe.Sizeof(*(*VideoEncodeH264RateControlInfoEXT)(nil))))
+	p.SType = STRUCTURE_TYPE_VIDEO_ENCODE_H264_RATE_CONTROL_INFO_EXT
+	return p
+}
+func (p *VideoEncodeH264RateControlInfoEXT) Free() { MemFree(unsafe.Pointer(p)) }
+
+// VideoEncodeH264QpEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeH264QpEXT.html
+type VideoEncodeH264QpEXT struct {
+	QpI int32
+	QpP int32
+	QpB int32
+}
+
+func NewVideoEncodeH264QpEXT() *VideoEncodeH264QpEXT {
+	return (*VideoEncodeH264QpEXT)(MemAlloc(unsafe.Sizeof(*(*VideoEncodeH264QpEXT)(nil))))
+}
+func (p *VideoEncodeH264QpEXT) Free() { MemFree(unsafe.Pointer(p)) }
+
+// VideoEncodeH264FrameSizeEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeH264FrameSizeEXT.html
+type VideoEncodeH264FrameSizeEXT struct {
+	FrameISize uint32
+	FramePSize uint32
+	FrameBSize uint32
+}
+
+func NewVideoEncodeH264FrameSizeEXT() *VideoEncodeH264FrameSizeEXT {
+	return (*VideoEncodeH264FrameSizeEXT)(MemAlloc(unsafe.Sizeof(*(*VideoEncodeH264FrameSizeEXT)(nil))))
+}
+func (p *VideoEncodeH264FrameSizeEXT) Free() { MemFree(unsafe.Pointer(p)) }
+
+// VideoEncodeH264RateControlLayerInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeH264RateControlLayerInfoEXT.html
+type VideoEncodeH264RateControlLayerInfoEXT struct {
+	SType           StructureType
+	PNext           unsafe.Pointer
+	TemporalLayerId uint8
+	UseInitialRcQp  Bool32
+	InitialRcQp     VideoEncodeH264QpEXT
+	UseMinQp        Bool32
+	MinQp           VideoEncodeH264QpEXT
+	UseMaxQp        Bool32
+	MaxQp           VideoEncodeH264QpEXT
+	UseMaxFrameSize Bool32
+	MaxFrameSize    VideoEncodeH264FrameSizeEXT
+}
+
+func NewVideoEncodeH264RateControlLayerInfoEXT() *VideoEncodeH264RateControlLayerInfoEXT {
+	p := (*VideoEncodeH264RateControlLayerInfoEXT)(MemAlloc(unsafe.Sizeof(*(*VideoEncodeH264RateControlLayerInfoEXT)(nil))))
+	p.SType = STRUCTURE_TYPE_VIDEO_ENCODE_H264_RATE_CONTROL_LAYER_INFO_EXT
+	return p
+}
+func (p *VideoEncodeH264RateControlLayerInfoEXT) Free() { MemFree(unsafe.Pointer(p)) }
+
+const EXT_video_encode_h265 = 1
+const STD_VULKAN_VIDEO_CODEC_H265_ENCODE_SPEC_VERSION = 0<<22 | 9<<12 | 9
+
+var STD_VULKAN_VIDEO_CODEC_H265_ENCODE_EXTENSION_NAME = "VK_STD_vulkan_video_codec_h265_encode"
+
+const EXT_VIDEO_ENCODE_H265_SPEC_VERSION = 9
+
+var EXT_VIDEO_ENCODE_H265_EXTENSION_NAME = "VK_EXT_video_encode_h265"
+
+// VideoEncodeH265CapabilityFlagsEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeH265CapabilityFlagsEXT.html
+type VideoEncodeH265CapabilityFlagsEXT uint32
+
+const (
+	VIDEO_ENCODE_H265_CAPABILITY_SEPARATE_COLOUR_PLANE_BIT_EXT               VideoEncodeH265CapabilityFlagsEXT = 0x00000001
+	VIDEO_ENCODE_H265_CAPABILITY_SCALING_LISTS_BIT_EXT                       VideoEncodeH265CapabilityFlagsEXT = 0x00000002
+	VIDEO_ENCODE_H265_CAPABILITY_SAMPLE_ADAPTIVE_OFFSET_ENABLED_BIT_EXT      VideoEncodeH265CapabilityFlagsEXT = 0x00000004
+	VIDEO_ENCODE_H265_CAPABILITY_PCM_ENABLE_BIT_EXT                          VideoEncodeH265CapabilityFlagsEXT = 0x00000008
+	VIDEO_ENCODE_H265_CAPABILITY_SPS_TEMPORAL_MVP_ENABLED_BIT_EXT            VideoEncodeH265CapabilityFlagsEXT = 0x00000010
+	VIDEO_ENCODE_H265_CAPABILITY_HRD_COMPLIANCE_BIT_EXT                      VideoEncodeH265CapabilityFlagsEXT = 0x00000020
+	VIDEO_ENCODE_H265_CAPABILITY_INIT_QP_MINUS26_BIT_EXT                     VideoEncodeH265CapabilityFlagsEXT = 0x00000040
+	VIDEO_ENCODE_H265_CAPABILITY_LOG2_PARALLEL_MERGE_LEVEL_MINUS2_BIT_EXT    VideoEncodeH265CapabilityFlagsEXT = 0x00000080
+	VIDEO_ENCODE_H265_CAPABILITY_SIGN_DATA_HIDING_ENABLED_BIT_EXT            VideoEncodeH265CapabilityFlagsEXT = 0x00000100
+	VIDEO_ENCODE_H265_CAPABILITY_TRANSFORM_SKIP_ENABLED_BIT_EXT              VideoEncodeH265CapabilityFlagsEXT = 0x00000200
+	VIDEO_ENCODE_H265_CAPABILITY_TRANSFORM_SKIP_DISABLED_BIT_EXT             VideoEncodeH265CapabilityFlagsEXT = 0x00000400
+	VIDEO_ENCODE_H265_CAPABILITY_PPS_SLICE_CHROMA_QP_OFFSETS_PRESENT_BIT_EXT VideoEncodeH265CapabilityFlagsEXT = 0x00000800
+	VIDEO_ENCODE_H265_CAPABILITY_WEIGHTED_PRED_BIT_EXT                       VideoEncodeH265CapabilityFlagsEXT = 0x00001000
+	VIDEO_ENCODE_H265_CAPABILITY_WEIGHTED_BIPRED_BIT_EXT                     VideoEncodeH265CapabilityFlagsEXT = 0x00002000
+	VIDEO_ENCODE_H265_CAPABILITY_WEIGHTED_PRED_NO_TABLE_BIT_EXT              VideoEncodeH265CapabilityFlagsEXT = 0x00004000
+	VIDEO_ENCODE_H265_CAPABILITY_TRANSQUANT_BYPASS_ENABLED_BIT_EXT           VideoEncodeH265CapabilityFlagsEXT = 0x00008000
+	VIDEO_ENCODE_H265_CAPABILITY_ENTROPY_CODING_SYNC_ENABLED_BIT_EXT         VideoEncodeH265CapabilityFlagsEXT = 0x00010000
+	VIDEO_ENCODE_H265_CAPABILITY_DEBLOCKING_FILTER_OVERRIDE_ENABLED_BIT_EXT  VideoEncodeH265CapabilityFlagsEXT = 0x00020000
+	VIDEO_ENCODE_H265_CAPABILITY_MULTIPLE_TILE_PER_FRAME_BIT_EXT             VideoEncodeH265CapabilityFlagsEXT = 0x00040000
+	VIDEO_ENCODE_H265_CAPABILITY_MULTIPLE_SLICE_PER_TILE_BIT_EXT             VideoEncodeH265CapabilityFlagsEXT = 0x00080000
+	VIDEO_ENCODE_H265_CAPABILITY_MULTIPLE_TILE_PER_SLICE_BIT_EXT             VideoEncodeH265CapabilityFlagsEXT = 0x00100000
+	VIDEO_ENCODE_H265_CAPABILITY_SLICE_SEGMENT_CTB_COUNT_BIT_EXT             VideoEncodeH265CapabilityFlagsEXT = 0x00200000
+	VIDEO_ENCODE_H265_CAPABILITY_ROW_UNALIGNED_SLICE_SEGMENT_BIT_EXT         VideoEncodeH265CapabilityFlagsEXT = 0x00400000
+	VIDEO_ENCODE_H265_CAPABILITY_DEPENDENT_SLICE_SEGMENT_BIT_EXT             VideoEncodeH265CapabilityFlagsEXT = 0x00800000
+	VIDEO_ENCODE_H265_CAPABILITY_DIFFERENT_SLICE_TYPE_BIT_EXT                VideoEncodeH265CapabilityFlagsEXT = 0x01000000
+	VIDEO_ENCODE_H265_CAPABILITY_B_FRAME_IN_L1_LIST_BIT_EXT                  VideoEncodeH265CapabilityFlagsEXT = 0x02000000
+	VIDEO_ENCODE_H265_CAPABILITY_FLAG_BITS_MAX_ENUM_EXT                      VideoEncodeH265CapabilityFlagsEXT = 0x7FFFFFFF
+)
+
+func (x VideoEncodeH265CapabilityFlagsEXT) String() string {
+	var s string
+	for i := uint32(0); i < 32; i++ {
+		if int32(x)&(1<<i) != 0 {
+			switch VideoEncodeH265CapabilityFlagsEXT(1 << i) {
+			case VIDEO_ENCODE_H265_CAPABILITY_SEPARATE_COLOUR_PLANE_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_CAPABILITY_SEPARATE_COLOUR_PLANE_BIT_EXT|"
+			case VIDEO_ENCODE_H265_CAPABILITY_SCALING_LISTS_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_CAPABILITY_SCALING_LISTS_BIT_EXT|"
+			case VIDEO_ENCODE_H265_CAPABILITY_SAMPLE_ADAPTIVE_OFFSET_ENABLED_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_CAPABILITY_SAMPLE_ADAPTIVE_OFFSET_ENABLED_BIT_EXT|"
+			case VIDEO_ENCODE_H265_CAPABILITY_PCM_ENABLE_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_CAPABILITY_PCM_ENABLE_BIT_EXT|"
+			case VIDEO_ENCODE_H265_CAPABILITY_SPS_TEMPORAL_MVP_ENABLED_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_CAPABILITY_SPS_TEMPORAL_MVP_ENABLED_BIT_EXT|"
+			case VIDEO_ENCODE_H265_CAPABILITY_HRD_COMPLIANCE_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_CAPABILITY_HRD_COMPLIANCE_BIT_EXT|"
+			case VIDEO_ENCODE_H265_CAPABILITY_INIT_QP_MINUS26_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_CAPABILITY_INIT_QP_MINUS26_BIT_EXT|"
+			case VIDEO_ENCODE_H265_CAPABILITY_LOG2_PARALLEL_MERGE_LEVEL_MINUS2_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_CAPABILITY_LOG2_PARALLEL_MERGE_LEVEL_MINUS2_BIT_EXT|"
+			case VIDEO_ENCODE_H265_CAPABILITY_SIGN_DATA_HIDING_ENABLED_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_CAPABILITY_SIGN_DATA_HIDING_ENABLED_BIT_EXT|"
+			case VIDEO_ENCODE_H265_CAPABILITY_TRANSFORM_SKIP_ENABLED_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_CAPABILITY_TRANSFORM_SKIP_ENABLED_BIT_EXT|"
+			case VIDEO_ENCODE_H265_CAPABILITY_TRANSFORM_SKIP_DISABLED_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_CAPABILITY_TRANSFORM_SKIP_DISABLED_BIT_EXT|"
+			case VIDEO_ENCODE_H265_CAPABILITY_PPS_SLICE_CHROMA_QP_OFFSETS_PRESENT_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_CAPABILITY_PPS_SLICE_CHROMA_QP_OFFSETS_PRESENT_BIT_EXT|"
+			case VIDEO_ENCODE_H265_CAPABILITY_WEIGHTED_PRED_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_CAPABILITY_WEIGHTED_PRED_BIT_EXT|"
+			case VIDEO_ENCODE_H265_CAPABILITY_WEIGHTED_BIPRED_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_CAPABILITY_WEIGHTED_BIPRED_BIT_EXT|"
+			case VIDEO_ENCODE_H265_CAPABILITY_WEIGHTED_PRED_NO_TABLE_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_CAPABILITY_WEIGHTED_PRED_NO_TABLE_BIT_EXT|"
+			case VIDEO_ENCODE_H265_CAPABILITY_TRANSQUANT_BYPASS_ENABLED_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_CAPABILITY_TRANSQUANT_BYPASS_ENABLED_BIT_EXT|"
+			case VIDEO_ENCODE_H265_CAPABILITY_ENTROPY_CODING_SYNC_ENABLED_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_CAPABILITY_ENTROPY_CODING_SYNC_ENABLED_BIT_EXT|"
+			case VIDEO_ENCODE_H265_CAPABILITY_DEBLOCKING_FILTER_OVERRIDE_ENABLED_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_CAPABILITY_DEBLOCKING_FILTER_OVERRIDE_ENABLED_BIT_EXT|"
+			case VIDEO_ENCODE_H265_CAPABILITY_MULTIPLE_TILE_PER_FRAME_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_CAPABILITY_MULTIPLE_TILE_PER_FRAME_BIT_EXT|"
+			case VIDEO_ENCODE_H265_CAPABILITY_MULTIPLE_SLICE_PER_TILE_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_CAPABILITY_MULTIPLE_SLICE_PER_TILE_BIT_EXT|"
+			case VIDEO_ENCODE_H265_CAPABILITY_MULTIPLE_TILE_PER_SLICE_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_CAPABILITY_MULTIPLE_TILE_PER_SLICE_BIT_EXT|"
+			case VIDEO_ENCODE_H265_CAPABILITY_SLICE_SEGMENT_CTB_COUNT_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_CAPABILITY_SLICE_SEGMENT_CTB_COUNT_BIT_EXT|"
+			case VIDEO_ENCODE_H265_CAPABILITY_ROW_UNALIGNED_SLICE_SEGMENT_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_CAPABILITY_ROW_UNALIGNED_SLICE_SEGMENT_BIT_EXT|"
+			case VIDEO_ENCODE_H265_CAPABILITY_DEPENDENT_SLICE_SEGMENT_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_CAPABILITY_DEPENDENT_SLICE_SEGMENT_BIT_EXT|"
+			case VIDEO_ENCODE_H265_CAPABILITY_DIFFERENT_SLICE_TYPE_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_CAPABILITY_DIFFERENT_SLICE_TYPE_BIT_EXT|"
+			case VIDEO_ENCODE_H265_CAPABILITY_B_FRAME_IN_L1_LIST_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_CAPABILITY_B_FRAME_IN_L1_LIST_BIT_EXT|"
+			case VIDEO_ENCODE_H265_CAPABILITY_FLAG_BITS_MAX_ENUM_EXT:
+				s += "VIDEO_ENCODE_H265_CAPABILITY_FLAG_BITS_MAX_ENUM_EXT|"
+			}
+		}
+	}
+	return strings.TrimSuffix(s, `|`)
+}
+
+// VideoEncodeH265InputModeFlagsEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeH265InputModeFlagsEXT.html
+type VideoEncodeH265InputModeFlagsEXT uint32
+
+const (
+	VIDEO_ENCODE_H265_INPUT_MODE_FRAME_BIT_EXT          VideoEncodeH265InputModeFlagsEXT = 0x00000001
+	VIDEO_ENCODE_H265_INPUT_MODE_SLICE_SEGMENT_BIT_EXT  VideoEncodeH265InputModeFlagsEXT = 0x00000002
+	VIDEO_ENCODE_H265_INPUT_MODE_NON_VCL_BIT_EXT        VideoEncodeH265InputModeFlagsEXT = 0x00000004
+	VIDEO_ENCODE_H265_INPUT_MODE_FLAG_BITS_MAX_ENUM_EXT VideoEncodeH265InputModeFlagsEXT = 0x7FFFFFFF
+)
+
+func (x VideoEncodeH265InputModeFlagsEXT) String() string {
+	var s string
+	for i := uint32(0); i < 32; i++ {
+		if int32(x)&(1<<i) != 0 {
+			switch VideoEncodeH265InputModeFlagsEXT(1 << i) {
+			case VIDEO_ENCODE_H265_INPUT_MODE_FRAME_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_INPUT_MODE_FRAME_BIT_EXT|"
+			case VIDEO_ENCODE_H265_INPUT_MODE_SLICE_SEGMENT_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_INPUT_MODE_SLICE_SEGMENT_BIT_EXT|"
+			case VIDEO_ENCODE_H265_INPUT_MODE_NON_VCL_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_INPUT_MODE_NON_VCL_BIT_EXT|"
+			case VIDEO_ENCODE_H265_INPUT_MODE_FLAG_BITS_MAX_ENUM_EXT:
+				s += "VIDEO_ENCODE_H265_INPUT_MODE_FLAG_BITS_MAX_ENUM_EXT|"
+			}
+		}
+	}
+	return strings.TrimSuffix(s, `|`)
+}
+
+// VideoEncodeH265OutputModeFlagsEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeH265OutputModeFlagsEXT.html
+type VideoEncodeH265OutputModeFlagsEXT uint32
+
+const (
+	VIDEO_ENCODE_H265_OUTPUT_MODE_FRAME_BIT_EXT          VideoEncodeH265OutputModeFlagsEXT = 0x00000001
+	VIDEO_ENCODE_H265_OUTPUT_MODE_SLICE_SEGMENT_BIT_EXT  VideoEncodeH265OutputModeFlagsEXT = 0x00000002
+	VIDEO_ENCODE_H265_OUTPUT_MODE_NON_VCL_BIT_EXT        VideoEncodeH265OutputModeFlagsEXT = 0x00000004
+	VIDEO_ENCODE_H265_OUTPUT_MODE_FLAG_BITS_MAX_ENUM_EXT VideoEncodeH265OutputModeFlagsEXT = 0x7FFFFFFF
+)
+
+func (x VideoEncodeH265OutputModeFlagsEXT) String() string {
+	var s string
+	for i := uint32(0); i < 32; i++ {
+		if int32(x)&(1<<i) != 0 {
+			switch VideoEncodeH265OutputModeFlagsEXT(1 << i) {
+			case VIDEO_ENCODE_H265_OUTPUT_MODE_FRAME_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_OUTPUT_MODE_FRAME_BIT_EXT|"
+			case VIDEO_ENCODE_H265_OUTPUT_MODE_SLICE_SEGMENT_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_OUTPUT_MODE_SLICE_SEGMENT_BIT_EXT|"
+			case VIDEO_ENCODE_H265_OUTPUT_MODE_NON_VCL_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_OUTPUT_MODE_NON_VCL_BIT_EXT|"
+			case VIDEO_ENCODE_H265_OUTPUT_MODE_FLAG_BITS_MAX_ENUM_EXT:
+				s += "VIDEO_ENCODE_H265_OUTPUT_MODE_FLAG_BITS_MAX_ENUM_EXT|"
+			}
+		}
+	}
+	return strings.TrimSuffix(s, `|`)
+}
+
+// VideoEncodeH265CtbSizeFlagsEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeH265CtbSizeFlagsEXT.html
+type VideoEncodeH265CtbSizeFlagsEXT uint32
+
+const (
+	VIDEO_ENCODE_H265_CTB_SIZE_16_BIT_EXT             VideoEncodeH265CtbSizeFlagsEXT = 0x00000001
+	VIDEO_ENCODE_H265_CTB_SIZE_32_BIT_EXT             VideoEncodeH265CtbSizeFlagsEXT = 0x00000002
+	VIDEO_ENCODE_H265_CTB_SIZE_64_BIT_EXT             VideoEncodeH265CtbSizeFlagsEXT = 0x00000004
+	VIDEO_ENCODE_H265_CTB_SIZE_FLAG_BITS_MAX_ENUM_EXT VideoEncodeH265CtbSizeFlagsEXT = 0x7FFFFFFF
+)
+
+func (x VideoEncodeH265CtbSizeFlagsEXT) String() string {
+	var s string
+	for i := uint32(0); i < 32; i++ {
+		if int32(x)&(1<<i) != 0 {
+			switch VideoEncodeH265CtbSizeFlagsEXT(1 << i) {
+			case VIDEO_ENCODE_H265_CTB_SIZE_16_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_CTB_SIZE_16_BIT_EXT|"
+			case VIDEO_ENCODE_H265_CTB_SIZE_32_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_CTB_SIZE_32_BIT_EXT|"
+			case VIDEO_ENCODE_H265_CTB_SIZE_64_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_CTB_SIZE_64_BIT_EXT|"
+			case VIDEO_ENCODE_H265_CTB_SIZE_FLAG_BITS_MAX_ENUM_EXT:
+				s += "VIDEO_ENCODE_H265_CTB_SIZE_FLAG_BITS_MAX_ENUM_EXT|"
+			}
+		}
+	}
+	return strings.TrimSuffix(s, `|`)
+}
+
+// VideoEncodeH265TransformBlockSizeFlagsEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeH265TransformBlockSizeFlagsEXT.html
+type VideoEncodeH265TransformBlockSizeFlagsEXT uint32
+
+const (
+	VIDEO_ENCODE_H265_TRANSFORM_BLOCK_SIZE_4_BIT_EXT              VideoEncodeH265TransformBlockSizeFlagsEXT = 0x00000001
+	VIDEO_ENCODE_H265_TRANSFORM_BLOCK_SIZE_8_BIT_EXT              VideoEncodeH265TransformBlockSizeFlagsEXT = 0x00000002
+	VIDEO_ENCODE_H265_TRANSFORM_BLOCK_SIZE_16_BIT_EXT             VideoEncodeH265TransformBlockSizeFlagsEXT = 0x00000004
+	VIDEO_ENCODE_H265_TRANSFORM_BLOCK_SIZE_32_BIT_EXT             VideoEncodeH265TransformBlockSizeFlagsEXT = 0x00000008
+	VIDEO_ENCODE_H265_TRANSFORM_BLOCK_SIZE_FLAG_BITS_MAX_ENUM_EXT VideoEncodeH265TransformBlockSizeFlagsEXT = 0x7FFFFFFF
+)
+
+func (x VideoEncodeH265TransformBlockSizeFlagsEXT) String() string {
+	var s string
+	for i := uint32(0); i < 32; i++ {
+		if int32(x)&(1<<i) != 0 {
+			switch VideoEncodeH265TransformBlockSizeFlagsEXT(1 << i) {
+			case VIDEO_ENCODE_H265_TRANSFORM_BLOCK_SIZE_4_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_TRANSFORM_BLOCK_SIZE_4_BIT_EXT|"
+			case VIDEO_ENCODE_H265_TRANSFORM_BLOCK_SIZE_8_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_TRANSFORM_BLOCK_SIZE_8_BIT_EXT|"
+			case VIDEO_ENCODE_H265_TRANSFORM_BLOCK_SIZE_16_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_TRANSFORM_BLOCK_SIZE_16_BIT_EXT|"
+			case VIDEO_ENCODE_H265_TRANSFORM_BLOCK_SIZE_32_BIT_EXT:
+				s += "VIDEO_ENCODE_H265_TRANSFORM_BLOCK_SIZE_32_BIT_EXT|"
+			case VIDEO_ENCODE_H265_TRANSFORM_BLOCK_SIZE_FLAG_BITS_MAX_ENUM_EXT:
+				s += "VIDEO_ENCODE_H265_TRANSFORM_BLOCK_SIZE_FLAG_BITS_MAX_ENUM_EXT|"
+			}
+		}
+	}
+	return strings.TrimSuffix(s, `|`)
+}
+
+// VideoEncodeH265RateControlStructureEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeH265RateControlStructureEXT.html
+type VideoEncodeH265RateControlStructureEXT int32
+
+const (
+	VIDEO_ENCODE_H265_RATE_CONTROL_STRUCTURE_UNKNOWN_EXT  VideoEncodeH265RateControlStructureEXT = 0
+	VIDEO_ENCODE_H265_RATE_CONTROL_STRUCTURE_FLAT_EXT     VideoEncodeH265RateControlStructureEXT = 1
+	VIDEO_ENCODE_H265_RATE_CONTROL_STRUCTURE_DYADIC_EXT   VideoEncodeH265RateControlStructureEXT = 2
+	VIDEO_ENCODE_H265_RATE_CONTROL_STRUCTURE_MAX_ENUM_EXT VideoEncodeH265RateControlStructureEXT = 0x7FFFFFFF
+)
+
+func (x VideoEncodeH265RateControlStructureEXT) String() string {
+	switch x {
+	case VIDEO_ENCODE_H265_RATE_CONTROL_STRUCTURE_UNKNOWN_EXT:
+		return "VIDEO_ENCODE_H265_RATE_CONTROL_STRUCTURE_UNKNOWN_EXT"
+	case VIDEO_ENCODE_H265_RATE_CONTROL_STRUCTURE_FLAT_EXT:
+		return "VIDEO_ENCODE_H265_RATE_CONTROL_STRUCTURE_FLAT_EXT"
+	case VIDEO_ENCODE_H265_RATE_CONTROL_STRUCTURE_DYADIC_EXT:
+		return "VIDEO_ENCODE_H265_RATE_CONTROL_STRUCTURE_DYADIC_EXT"
+	case VIDEO_ENCODE_H265_RATE_CONTROL_STRUCTURE_MAX_ENUM_EXT:
+		return "VIDEO_ENCODE_H265_RATE_CONTROL_STRUCTURE_MAX_ENUM_EXT"
+	default:
+		return fmt.Sprint(int32(x))
+	}
+}
+
+// VideoEncodeH265CapabilitiesEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeH265CapabilitiesEXT.html
+type VideoEncodeH265CapabilitiesEXT struct {
+	SType                                  StructureType
+	PNext                                  unsafe.Pointer
+	Flags                                  VideoEncodeH265CapabilityFlagsEXT
+	InputModeFlags                         VideoEncodeH265InputModeFlagsEXT
+	OutputModeFlags                        VideoEncodeH265OutputModeFlagsEXT
+	CtbSizes                               VideoEncodeH265CtbSizeFlagsEXT
+	TransformBlockSizes                    VideoEncodeH265TransformBlockSizeFlagsEXT
+	MaxPPictureL0ReferenceCount            uint8
+	MaxBPictureL0ReferenceCount            uint8
+	MaxL1ReferenceCount                    uint8
+	MaxSubLayersCount                      uint8
+	MinLog2MinLumaCodingBlockSizeMinus3    uint8
+	MaxLog2MinLumaCodingBlockSizeMinus3    uint8
+	MinLog2MinLumaTransformBlockSizeMinus2 uint8
+	MaxLog2MinLumaTransformBlockSizeMinus2 uint8
+	MinMaxTransformHierarchyDepthInter     uint8
+	MaxMaxTransformHierarchyDepthInter     uint8
+	MinMaxTransformHierarchyDepthIntra     uint8
+	MaxMaxTransformHierarchyDepthIntra     uint8
+	MaxDiffCuQpDeltaDepth                  uint8
+	MinMaxNumMergeCand                     uint8
+	MaxMaxNumMergeCand                     uint8
+}
+
+func NewVideoEncodeH265CapabilitiesEXT() *VideoEncodeH265CapabilitiesEXT {
+	p := (*VideoEncodeH265CapabilitiesEXT)(MemAlloc(unsafe.Sizeof(*(*VideoEncodeH265CapabilitiesEXT)(nil))))
+	p.SType = STRUCTURE_TYPE_VIDEO_ENCODE_H265_CAPABILITIES_EXT
+	return p
+}
+func (p *VideoEncodeH265CapabilitiesEXT) Free() { MemFree(unsafe.Pointer(p)) }
+
+// VideoEncodeH265SessionParametersAddInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeH265SessionParametersAddInfoEXT.html
+type VideoEncodeH265SessionParametersAddInfoEXT struct {
+	SType       StructureType
+	PNext       unsafe.Pointer
+	StdVPSCount uint32
+	PStdVPSs    *StdVideoH265VideoParameterSet
+	StdSPSCount uint32
+	PStdSPSs    *StdVideoH265SequenceParameterSet
+	StdPPSCount uint32
+	PStdPPSs    *StdVideoH265PictureParameterSet
+}
+
+func NewVideoEncodeH265SessionParametersAddInfoEXT() *VideoEncodeH265SessionParametersAddInfoEXT {
+	p := (*VideoEncodeH265SessionParametersAddInfoEXT)(MemAlloc(unsafe.Sizeof(*(*VideoEncodeH265SessionParametersAddInfoEXT)(nil))))
+	p.SType = STRUCTURE_TYPE_VIDEO_ENCODE_H265_SESSION_PARAMETERS_ADD_INFO_EXT
+	return p
+}
+func (p *VideoEncodeH265SessionParametersAddInfoEXT) Free() { MemFree(unsafe.Pointer(p)) }
+
+// VideoEncodeH265SessionParametersCreateInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeH265SessionParametersCreateInfoEXT.html
+type VideoEncodeH265SessionParametersCreateInfoEXT struct {
+	SType              StructureType
+	PNext              unsafe.Pointer
+	MaxStdVPSCount     uint32
+	MaxStdSPSCount     uint32
+	MaxStdPPSCount     uint32
+	PParametersAddInfo *VideoEncodeH265SessionParametersAddInfoEXT
+}
+
+func NewVideoEncodeH265SessionParametersCreateInfoEXT() *VideoEncodeH265SessionParametersCreateInfoEXT {
+	p := (*VideoEncodeH265SessionParametersCreateInfoEXT)(MemAlloc(unsafe.Sizeof(*(*VideoEncodeH265SessionParametersCreateInfoEXT)(nil))))
+	p.SType = STRUCTURE_TYPE_VIDEO_ENCODE_H265_SESSION_PARAMETERS_CREATE_INFO_EXT
+	return p
+}
+func (p *VideoEncodeH265SessionParametersCreateInfoEXT) Free() { MemFree(unsafe.Pointer(p)) }
+
+// StdVideoEncodeH265WeightTableFlags -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/StdVideoEncodeH265WeightTableFlags.html
+type StdVideoEncodeH265WeightTableFlags struct {
+	LumaWeightL0Flag   uint16
+	ChromaWeightL0Flag uint16
+	LumaWeightL1Flag   uint16
+	ChromaWeightL1Flag uint16
+}
+
+func NewStdVideoEncodeH265WeightTableFlags() *StdVideoEncodeH265WeightTableFlags {
+	return (*StdVideoEncodeH265WeightTableFlags)(MemAlloc(unsafe.Sizeof(*(*StdVideoEncodeH265WeightTableFlags)(nil))))
+}
+func (p *StdVideoEncodeH265WeightTableFlags) Free() { MemFree(unsafe.Pointer(p)) }
+
+// StdVideoEncodeH265WeightTable -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/StdVideoEncodeH265WeightTable.html
+type StdVideoEncodeH265WeightTable struct {
+	Flags                      StdVideoEncodeH265WeightTableFlags
+	LumaLog2WeightDenom        uint8
+	DeltaChromaLog2WeightDenom int8
+	DeltaLumaWeightL0          [STD_VIDEO_H265_MAX_NUM_LIST_REF]int8
+	LumaOffsetL0               [STD_VIDEO_H265_MAX_NUM_LIST_REF]int8
+	DeltaChromaWeightL0        [STD_VIDEO_H265_MAX_NUM_LIST_REF][STD_VIDEO_H265_MAX_CHROMA_PLANES]int8
+	DeltaChromaOffsetL0        [STD_VIDEO_H265_MAX_NUM_LIST_REF][STD_VIDEO_H265_MAX_CHROMA_PLANES]int8
+	DeltaLumaWeightL1          [STD_VIDEO_H265_MAX_NUM_LIST_REF]int8
+	LumaOffsetL1               [STD_VIDEO_H265_MAX_NUM_LIST_REF]int8
+	DeltaChromaWeightL1        [STD_VIDEO_H265_MAX_NUM_LIST_REF][STD_VIDEO_H265_MAX_CHROMA_PLANES]int8
+	DeltaChromaOffsetL1        [STD_VIDEO_H265_MAX_NUM_LIST_REF][STD_VIDEO_H265_MAX_CHROMA_PLANES]int8
+}
+
+func NewStdVideoEncodeH265WeightTable() *StdVideoEncodeH265WeightTable {
+	return (*StdVideoEncodeH265WeightTable)(MemAlloc(unsafe.Sizeof(*(*StdVideoEncodeH265WeightTable)(nil))))
+}
+func (p *StdVideoEncodeH265WeightTable) Free() { MemFree(unsafe.Pointer(p)) }
+
+// StdVideoEncodeH265SliceSegmentHeaderFlags -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/StdVideoEncodeH265SliceSegmentHeaderFlags.html
+type StdVideoEncodeH265SliceSegmentHeaderFlags struct {
+	bitfield0 uint32 // first_slice_segment_in_pic_flag:1 no_output_of_prior_pics_flag:1 dependent_slice_segment_flag:1 pic_output_flag:1 short_term_ref_pic_set_sps_flag:1 slice_temporal_mvp_enable_flag:1 slice_sao_luma_flag:1 slice_sao_chroma_flag:1 num_ref_idx_active_override_flag:1 mvd_l1_zero_flag:1 cabac_init_flag:1 cu_chroma_qp_offset_enabled_flag:1 deblocking_filter_override_flag:1 slice_deblocking_filter_disabled_flag:1 collocated_from_l0_flag:1 slice_loop_filter_across_slices_enabled_flag:1
+}
+
+func NewStdVideoEncodeH265SliceSegmentHeaderFlags() *StdVideoEncodeH265SliceSegmentHeaderFlags {
+	return (*StdVideoEncodeH265SliceSegmentHeaderFlags)(MemAlloc(unsafe.Sizeof(*(*StdVideoEncodeH265SliceSegmentHeaderFlags)(nil))))
+}
+func (p *StdVideoEncodeH265SliceSegmentHeaderFlags) Free() { MemFree(unsafe.Pointer(p)) }
+
+func (p StdVideoEncodeH265SliceSegmentHeaderFlags) FirstSliceSegmentInPicFlag() uint32 {
+	return p.bitfield0 & 0x1
+}
+func (p *StdVideoEncodeH265SliceSegmentHeaderFlags) SetFirstSliceSegmentInPicFlag(x uint32) {
+	p.bitfield0 = p.bitfield0&^0x1 | x&0x1
+}
+
+func (p StdVideoEncodeH265SliceSegmentHeaderFlags) NoOutputOfPriorPicsFlag() uint32 {
+	return p.bitfield0 >> 1 & 0x1
+}
+func (p *StdVideoEncodeH265SliceSegmentHeaderFlags) SetNoOutputOfPriorPicsFlag(x uint32) {
+	p.bitfield0 = p.bitfield0&^0x2 | x&0x1<<1
+}
+
+func (p StdVideoEncodeH265SliceSegmentHeaderFlags) DependentSliceSegmentFlag() uint32 {
+	return p.bitfield0 >> 2 & 0x1
+}
+func (p *StdVideoEncodeH265SliceSegmentHeaderFlags) SetDependentSliceSegmentFlag(x uint32) {
+	p.bitfield0 = p.bitfield0&^0x4 | x&0x1<<2
+}
+
+func (p StdVideoEncodeH265SliceSegmentHeaderFlags) PicOutputFlag() uint32 {
+	return p.bitfield0 >> 3 & 0x1
+}
+func (p *StdVideoEncodeH265SliceSegmentHeaderFlags) SetPicOutputFlag(x uint32) {
+	p.bitfield0 = p.bitfield0&^0x8 | x&0x1<<3
+}
+
+func (p StdVideoEncodeH265SliceSegmentHeaderFlags) ShortTermRefPicSetSpsFlag() uint32 {
+	return p.bitfield0 >> 4 & 0x1
+}
+func (p *StdVideoEncodeH265SliceSegmentHeaderFlags) SetShortTermRefPicSetSpsFlag(x uint32) {
+	p.bitfield0 = p.bitfield0&^0x10 | x&0x1<<4
+}
+
+func (p StdVideoEncodeH265SliceSegmentHeaderFlags) SliceTemporalMvpEnableFlag() uint32 {
+	return p.bitfield0 >> 5 & 0x1
+}
+func (p *StdVideoEncodeH265SliceSegmentHeaderFlags) SetSliceTemporalMvpEnableFlag(x uint32) {
+	p.bitfield0 = p.bitfield0&^0x20 | x&0x1<<5
+}
+
+func (p StdVideoEncodeH265SliceSegmentHeaderFlags) SliceSaoLumaFlag() uint32 {
+	return p.bitfield0 >> 6 & 0x1
+}
+func (p *StdVideoEncodeH265SliceSegmentHeaderFlags) SetSliceSaoLumaFlag(x uint32) {
+	p.bitfield0 = p.bitfield0&^0x40 | x&0x1<<6
+}
+
+func (p StdVideoEncodeH265SliceSegmentHeaderFlags) SliceSaoChromaFlag() uint32 {
+	return p.bitfield0 >> 7 & 0x1
+}
+func (p *StdVideoEncodeH265SliceSegmentHeaderFlags) SetSliceSaoChromaFlag(x uint32) {
+	p.bitfield0 = p.bitfield0&^0x80 | x&0x1<<7
+}
+
+func (p StdVideoEncodeH265SliceSegmentHeaderFlags) NumRefIdxActiveOverrideFlag() uint32 {
+	return p.bitfield0 >> 8 & 0x1
+}
+func (p *StdVideoEncodeH265SliceSegmentHeaderFlags) SetNumRefIdxActiveOverrideFlag(x uint32) {
+	p.bitfield0 = p.bitfield0&^0x100 | x&0x1<<8
+}
+
+func (p StdVideoEncodeH265SliceSegmentHeaderFlags) MvdL1ZeroFlag() uint32 {
+	return p.bitfield0 >> 9 & 0x1
+}
+func (p *StdVideoEncodeH265SliceSegmentHeaderFlags) SetMvdL1ZeroFlag(x uint32) {
+	p.bitfield0 = p.bitfield0&^0x200 | x&0x1<<9
+}
+
+func (p StdVideoEncodeH265SliceSegmentHeaderFlags) CabacInitFlag() uint32 {
+	return p.bitfield0 >> 10 & 0x1
+}
+func (p *StdVideoEncodeH265SliceSegmentHeaderFlags) SetCabacInitFlag(x uint32) {
+	p.bitfield0 = p.bitfield0&^0x400 | x&0x1<<10
+}
+
+func (p StdVideoEncodeH265SliceSegmentHeaderFlags) CuChromaQpOffsetEnabledFlag() uint32 {
+	return p.bitfield0 >> 11 & 0x1
+}
+func (p *StdVideoEncodeH265SliceSegmentHeaderFlags) SetCuChromaQpOffsetEnabledFlag(x uint32) {
+	p.bitfield0 = p.bitfield0&^0x800 | x&0x1<<11
+}
+
+func (p StdVideoEncodeH265SliceSegmentHeaderFlags) DeblockingFilterOverrideFlag() uint32 {
+	return p.bitfield0 >> 12 & 0x1
+}
+func (p *StdVideoEncodeH265SliceSegmentHeaderFlags) SetDeblockingFilterOverrideFlag(x uint32) {
+	p.bitfield0 = p.bitfield0&^0x1000 | x&0x1<<12
+}
+
+func (p StdVideoEncodeH265SliceSegmentHeaderFlags) SliceDeblockingFilterDisabledFlag() uint32 {
+	return p.bitfield0 >> 13 & 0x1
+}
+func (p *StdVideoEncodeH265SliceSegmentHeaderFlags) SetSliceDeblockingFilterDisabledFlag(x uint32) {
+	p.bitfield0 = p.bitfield0&^0x2000 | x&0x1<<13
+}
+
+func (p StdVideoEncodeH265SliceSegmentHeaderFlags) CollocatedFromL0Flag() uint32 {
+	return p.bitfield0 >> 14 & 0x1
+}
+func (p *StdVideoEncodeH265SliceSegmentHeaderFlags) SetCollocatedFromL0Flag(x uint32) {
+	p.bitfield0 = p.bitfield0&^0x4000 | x&0x1<<14
+}
+
+func (p StdVideoEncodeH265SliceSegmentHeaderFlags) SliceLoopFilterAcrossSlicesEnabledFlag() uint32 {
+	return p.bitfield0 >> 15 & 0x1
+}
+func (p *StdVideoEncodeH265SliceSegmentHeaderFlags) SetSliceLoopFilterAcrossSlicesEnabledFlag(x uint32) {
+	p.bitfield0 = p.bitfield0&^0x8000 | x&0x1<<15
+}
+
+// StdVideoEncodeH265SliceSegmentLongTermRefPics -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/StdVideoEncodeH265SliceSegmentLongTermRefPics.html
+type StdVideoEncodeH265SliceSegmentLongTermRefPics struct {
+	NumLongTermSps         uint8
+	NumLongTermPics        uint8
+	LtIdxSps               [STD_VIDEO_H265_MAX_LONG_TERM_REF_PICS_SPS]uint8
+	PocLsbLt               [STD_VIDEO_H265_MAX_LONG_TERM_PICS]uint8
+	UsedByCurrPicLtFlag    uint16
+	DeltaPocMsbPresentFlag [STD_VIDEO_H265_MAX_DELTA_POC]uint8
+	DeltaPocMsbCycleLt     [STD_VIDEO_H265_MAX_DELTA_POC]uint8
+}
+
+func NewStdVideoEncodeH265SliceSegmentLongTermRefPics() *StdVideoEncodeH265SliceSegmentLongTermRefPics {
+	return (*StdVideoEncodeH265SliceSegmentLongTermRefPics)(MemAlloc(unsafe.Sizeof(*(*StdVideoEncodeH265SliceSegmentLongTermRefPics)(nil))))
+}
+func (p *StdVideoEncodeH265SliceSegmentLongTermRefPics) Free() { MemFree(unsafe.Pointer(p)) }
+
+// StdVideoEncodeH265SliceSegmentHeader -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/StdVideoEncodeH265SliceSegmentHeader.html
+type StdVideoEncodeH265SliceSegmentHeader struct {
+	Flags                   StdVideoEncodeH265SliceSegmentHeaderFlags
+	SliceType               StdVideoH265SliceType
+	SliceSegmentAddress     uint32
+	ShortTermRefPicSetIdx   uint8
+	CollocatedRefIdx        uint8
+	NumRefIdxL0ActiveMinus1 uint8
+	NumRefIdxL1ActiveMinus1 uint8
+	MaxNumMergeCand         uint8
+	SliceCbQpOffset         int8
+	SliceCrQpOffset         int8
+	SliceBetaOffsetDiv2     int8
+	SliceTcOffsetDiv2       int8
+	SliceActYQpOffset       int8
+	SliceActCbQpOffset      int8
+	SliceActCrQpOffset      int8
+	PShortTermRefPicSet     *StdVideoH265ShortTermRefPicSet
+	PLongTermRefPics        *StdVideoEncodeH265SliceSegmentLongTermRefPics
+	PWeightTable            *StdVideoEncodeH265WeightTable
+}
+
+func NewStdVideoEncodeH265SliceSegmentHeader() *StdVideoEncodeH265SliceSegmentHeader {
+	return (*StdVideoEncodeH265SliceSegmentHeader)(MemAlloc(unsafe.Sizeof(*(*StdVideoEncodeH265SliceSegmentHeader)(nil))))
+}
+func (p *StdVideoEncodeH265SliceSegmentHeader) Free() { MemFree(unsafe.Pointer(p)) }
+
+// StdVideoEncodeH265ReferenceModificationFlags -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/StdVideoEncodeH265ReferenceModificationFlags.html
+type StdVideoEncodeH265ReferenceModificationFlags struct {
+	bitfield0 uint32 // ref_pic_list_modification_flag_l0:1 ref_pic_list_modification_flag_l1:1
+}
+
+func NewStdVideoEncodeH265ReferenceModificationFlags() *StdVideoEncodeH265ReferenceModificationFlags {
+	return (*StdVideoEncodeH265ReferenceModificationFlags)(MemAlloc(unsafe.Sizeof(*(*StdVideoEncodeH265ReferenceModificationFlags)(nil))))
+}
+func (p *StdVideoEncodeH265ReferenceModificationFlags) Free() { MemFree(unsafe.Pointer(p)) }
+
+func (p StdVideoEncodeH265ReferenceModificationFlags) RefPicListModificationFlagL0() uint32 {
+	return p.bitfield0 & 0x1
+}
+func (p *StdVideoEncodeH265ReferenceModificationFlags) SetRefPicListModificationFlagL0(x uint32) {
+	p.bitfield0 = p.bitfield0&^0x1 | x&0x1
+}
+
+func (p StdVideoEncodeH265ReferenceModificationFlags) RefPicListModificationFlagL1() uint32 {
+	return p.bitfield0 >> 1 & 0x1
+}
+func (p *StdVideoEncodeH265ReferenceModificationFlags) SetRefPicListModificationFlagL1(x uint32) {
+	p.bitfield0 = p.bitfield0&^0x2 | x&0x1<<1
+}
+
+// StdVideoEncodeH265ReferenceModifications -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/StdVideoEncodeH265ReferenceModifications.html
+type StdVideoEncodeH265ReferenceModifications struct {
+	Flags                            StdVideoEncodeH265ReferenceModificationFlags
+	ReferenceList0ModificationsCount uint8
+	PReferenceList0Modifications     *uint8
+	ReferenceList1ModificationsCount uint8
+	PReferenceList1Modifications     *uint8
+}
+
+func NewStdVideoEncodeH265ReferenceModifications() *StdVideoEncodeH265ReferenceModifications {
+	return (*StdVideoEncodeH265ReferenceModifications)(MemAlloc(unsafe.Sizeof(*(*StdVideoEncodeH265ReferenceModifications)(nil))))
+}
+func (p *StdVideoEncodeH265ReferenceModifications) Free() { MemFree(unsafe.Pointer(p)) }
+
+// StdVideoEncodeH265PictureInfoFlags -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/StdVideoEncodeH265PictureInfoFlags.html
+type StdVideoEncodeH265PictureInfoFlags struct {
+	bitfield0 uint32 // is_reference_flag:1 IrapPicFlag:1 long_term_flag:1 discardable_flag:1 cross_layer_bla_flag:1
+}
+
+func NewStdVideoEncodeH265PictureInfoFlags() *StdVideoEncodeH265PictureInfoFlags {
+	return (*StdVideoEncodeH265PictureInfoFlags)(MemAlloc(unsafe.Sizeof(*(*StdVideoEncodeH265PictureInfoFlags)(nil))))
+}
+func (p *StdVideoEncodeH265PictureInfoFlags) Free() { MemFree(unsafe.Pointer(p)) }
+
+func (p StdVideoEncodeH265PictureInfoFlags) IsReferenceFlag() uint32 { return p.bitfield0 & 0x1 }
+func (p *StdVideoEncodeH265PictureInfoFlags) SetIsReferenceFlag(x uint32) {
+	p.bitfield0 = p.bitfield0&^0x1 | x&0x1
+}
+
+func (p StdVideoEncodeH265PictureInfoFlags) IrapPicFlag() uint32 { return p.bitfield0 >> 1 & 0x1 }
+func (p *StdVideoEncodeH265PictureInfoFlags) SetIrapPicFlag(x uint32) {
+	p.bitfield0 = p.bitfield0&^0x2 | x&0x1<<1
+}
+
+func (p StdVideoEncodeH265PictureInfoFlags) LongTermFlag() uint32 { return p.bitfield0 >> 2 & 0x1 }
+func (p *StdVideoEncodeH265PictureInfoFlags) SetLongTermFlag(x uint32) {
+	p.bitfield0 = p.bitfield0&^0x4 | x&0x1<<2
+}
+
+func (p StdVideoEncodeH265PictureInfoFlags) DiscardableFlag() uint32 { return p.bitfield0 >> 3 & 0x1 }
+func (p *StdVideoEncodeH265PictureInfoFlags) SetDiscardableFlag(x uint32) {
+	p.bitfield0 = p.bitfield0&^0x8 | x&0x1<<3
+}
+
+func (p StdVideoEncodeH265PictureInfoFlags) CrossLayerBlaFlag() uint32 {
+	return p.bitfield0 >> 4 & 0x1
+}
+func (p *StdVideoEncodeH265PictureInfoFlags) SetCrossLayerBlaFlag(x uint32) {
+	p.bitfield0 = p.bitfield0&^0x10 | x&0x1<<4
+}
+
+// StdVideoEncodeH265PictureInfo -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/StdVideoEncodeH265PictureInfo.html
+type StdVideoEncodeH265PictureInfo struct {
+	Flags                  StdVideoEncodeH265PictureInfoFlags
+	PictureType            StdVideoH265PictureType
+	SpsVideoParameterSetId uint8
+	PpsSeqParameterSetId   uint8
+	PpsPicParameterSetId   uint8
+	PicOrderCntVal         int32
+	TemporalId             uint8
+}
+
+func NewStdVideoEncodeH265PictureInfo() *StdVideoEncodeH265PictureInfo {
+	return (*StdVideoEncodeH265PictureInfo)(MemAlloc(unsafe.Sizeof(*(*StdVideoEncodeH265PictureInfo)(nil))))
+}
+func (p *StdVideoEncodeH265PictureInfo) Free() { MemFree(unsafe.Pointer(p)) }
+
+// StdVideoEncodeH265ReferenceInfoFlags -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/StdVideoEncodeH265ReferenceInfoFlags.html
+type StdVideoEncodeH265ReferenceInfoFlags struct {
+	bitfield0 uint32 // used_for_long_term_reference:1 unused_for_reference:1
+}
+
+func NewStdVideoEncodeH265ReferenceInfoFlags() *StdVideoEncodeH265ReferenceInfoFlags {
+	return (*StdVideoEncodeH265ReferenceInfoFlags)(MemAlloc(unsafe.Sizeof(*(*StdVideoEncodeH265ReferenceInfoFlags)(nil))))
+}
+func (p *StdVideoEncodeH265ReferenceInfoFlags) Free() { MemFree(unsafe.Pointer(p)) }
+
+func (p StdVideoEncodeH265ReferenceInfoFlags) UsedForLongTermReference() uint32 {
+	return p.bitfield0 & 0x1
+}
+func (p *StdVideoEncodeH265ReferenceInfoFlags) SetUsedForLongTermReference(x uint32) {
+	p.bitfield0 = p.bitfield0&^0x1 | x&0x1
+}
+
+func (p StdVideoEncodeH265ReferenceInfoFlags) UnusedForReference() uint32 {
+	return p.bitfield0 >> 1 & 0x1
+}
+func (p *StdVideoEncodeH265ReferenceInfoFlags) SetUnusedForReference(x uint32) {
+	p.bitfield0 = p.bitfield0&^0x2 | x&0x1<<1
+}
+
+// StdVideoEncodeH265ReferenceInfo -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/StdVideoEncodeH265ReferenceInfo.html
+type StdVideoEncodeH265ReferenceInfo struct {
+	Flags          StdVideoEncodeH265ReferenceInfoFlags
+	PicOrderCntVal int32
+	TemporalId     uint8
+}
+
+func NewStdVideoEncodeH265ReferenceInfo() *StdVideoEncodeH265ReferenceInfo {
+	return (*StdVideoEncodeH265ReferenceInfo)(MemAlloc(unsafe.Sizeof(*(*StdVideoEncodeH265ReferenceInfo)(nil))))
+}
+func (p *StdVideoEncodeH265ReferenceInfo) Free() { MemFree(unsafe.Pointer(p)) }
+
+// VideoEncodeH265DpbSlotInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeH265DpbSlotInfoEXT.html
+type VideoEncodeH265DpbSlotInfoEXT struct {
+	SType             StructureType
+	PNext             unsafe.Pointer
+	SlotIndex         int8
+	PStdReferenceInfo *StdVideoEncodeH265ReferenceInfo
+}
+
+func NewVideoEncodeH265DpbSlotInfoEXT() *VideoEncodeH265DpbSlotInfoEXT {
+	p := (*VideoEncodeH265DpbSlotInfoEXT)(MemAlloc(unsafe.Sizeof(*(*VideoEncodeH265DpbSlotInfoEXT)(nil))))
+	p.SType = STRUCTURE_TYPE_VIDEO_ENCODE_H265_DPB_SLOT_INFO_EXT
+	return p
+}
+func (p *VideoEncodeH265DpbSlotInfoEXT) Free() { MemFree(unsafe.Pointer(p)) }
+
+// VideoEncodeH265ReferenceListsInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeH265ReferenceListsInfoEXT.html
+type VideoEncodeH265ReferenceListsInfoEXT struct {
+	SType                    StructureType
+	PNext                    unsafe.Pointer
+	ReferenceList0EntryCount uint8
+	PReferenceList0Entries   *VideoEncodeH265DpbSlotInfoEXT
+	ReferenceList1EntryCount uint8
+	PReferenceList1Entries   *VideoEncodeH265DpbSlotInfoEXT
+	PReferenceModifications  *StdVideoEncodeH265ReferenceModifications
+}
+
+func NewVideoEncodeH265ReferenceListsInfoEXT() *VideoEncodeH265ReferenceListsInfoEXT {
+	p := (*VideoEncodeH265ReferenceListsInfoEXT)(MemAlloc(unsafe.Sizeof(*(*VideoEncodeH265ReferenceListsInfoEXT)(nil))))
+	p.SType = STRUCTURE_TYPE_VIDEO_ENCODE_H265_REFERENCE_LISTS_INFO_EXT
+	return p
+}
+func (p *VideoEncodeH265ReferenceListsInfoEXT) Free() { MemFree(unsafe.Pointer(p)) }
+
+// VideoEncodeH265NaluSliceSegmentInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeH265NaluSliceSegmentInfoEXT.html
+type VideoEncodeH265NaluSliceSegmentInfoEXT struct {
+	SType                  StructureType
+	PNext                  unsafe.Pointer
+	CtbCount               uint32
+	PReferenceFinalLists   *VideoEncodeH265ReferenceListsInfoEXT
+	PSliceSegmentHeaderStd *StdVideoEncodeH265SliceSegmentHeader
+}
+
+func NewVideoEncodeH265NaluSliceSegmentInfoEXT() *VideoEncodeH265NaluSliceSegmentInfoEXT {
+	p := (*VideoEncodeH265NaluSliceSegmentInfoEXT)(MemAlloc(unsafe.Sizeof(*(*VideoEncodeH265NaluSliceSegmentInfoEXT)(nil))))
+	p.SType = STRUCTURE_TYPE_VIDEO_ENCODE_H265_NALU_SLICE_SEGMENT_INFO_EXT
+	return p
+}
+func (p *VideoEncodeH265NaluSliceSegmentInfoEXT) Free() { MemFree(unsafe.Pointer(p)) }
+
+// VideoEncodeH265VclFrameInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeH265VclFrameInfoEXT.html
+type VideoEncodeH265VclFrameInfoEXT struct {
+	SType                      StructureType
+	PNext                      unsafe.Pointer
+	PReferenceFinalLists       *VideoEncodeH265ReferenceListsInfoEXT
+	NaluSliceSegmentEntryCount uint32
+	PNaluSliceSegmentEntries   *VideoEncodeH265NaluSliceSegmentInfoEXT
+	PCurrentPictureInfo        *StdVideoEncodeH265PictureInfo
+}
+
+func NewVideoEncodeH265VclFrameInfoEXT() *VideoEncodeH265VclFrameInfoEXT {
+	p := (*VideoEncodeH265VclFrameInfoEXT)(MemAlloc(unsafe.Sizeof(*(*VideoEncodeH265VclFrameInfoEXT)(nil))))
+	p.SType = STRUCTURE_TYPE_VIDEO_ENCODE_H265_VCL_FRAME_INFO_EXT
+	return p
+}
+func (p *VideoEncodeH265VclFrameInfoEXT) Free() { MemFree(unsafe.Pointer(p)) }
+
+// VideoEncodeH265EmitPictureParametersInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeH265EmitPictureParametersInfoEXT.html
+type VideoEncodeH265EmitPictureParametersInfoEXT struct {
+	SType           StructureType
+	PNext           unsafe.Pointer
+	VpsId           uint8
+	SpsId           uint8
+	EmitVpsEnable   Bool32
+	EmitSpsEnable   Bool32
+	PpsIdEntryCount uint32
+	PpsIdEntries    *uint8
+}
+
+func NewVideoEncodeH265EmitPictureParametersInfoEXT() *VideoEncodeH265EmitPictureParametersInfoEXT {
+	p := (*VideoEncodeH265EmitPictureParametersInfoEXT)(MemAlloc(unsafe.Sizeof(*(*VideoEncodeH265EmitPictureParametersInfoEXT)(nil))))
+	p.SType = STRUCTURE_TYPE_VIDEO_ENCODE_H265_EMIT_PICTURE_PARAMETERS_INFO_EXT
+	return p
+}
+func (p *VideoEncodeH265EmitPictureParametersInfoEXT) Free() { MemFree(unsafe.Pointer(p)) }
+
+// VideoEncodeH265ProfileInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeH265ProfileInfoEXT.html
+type VideoEncodeH265ProfileInfoEXT struct {
+	SType         StructureType
+	PNext         unsafe.Pointer
+	StdProfileIdc StdVideoH265ProfileIdc
+}
+
+func NewVideoEncodeH265ProfileInfoEXT() *VideoEncodeH265ProfileInfoEXT {
+	p := (*VideoEncodeH265ProfileInfoEXT)(MemAlloc(unsafe.Sizeof(*(*VideoEncodeH265ProfileInfoEXT)(nil))))
+	p.SType = STRUCTURE_TYPE_VIDEO_ENCODE_H265_PROFILE_INFO_EXT
+	return p
+}
+func (p *VideoEncodeH265ProfileInfoEXT) Free() { MemFree(unsafe.Pointer(p)) }
+
+// VideoEncodeH265RateControlInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeH265RateControlInfoEXT.html
+type VideoEncodeH265RateControlInfoEXT struct {
+	SType                  StructureType
+	PNext                  unsafe.Pointer
+	GopFrameCount          uint32
+	IdrPeriod              uint32
+	ConsecutiveBFrameCount uint32
+	RateControlStructure   VideoEncodeH265RateControlStructureEXT
+	SubLayerCount          uint8
+}
+
+func NewVideoEncodeH265RateControlInfoEXT() *VideoEncodeH265RateControlInfoEXT {
+	p := (*VideoEncodeH265RateControlInfoEXT)(MemAlloc(unsafe.Sizeof(*(*VideoEncodeH265RateControlInfoEXT)(nil))))
+	p.SType = STRUCTURE_TYPE_VIDEO_ENCODE_H265_RATE_CONTROL_INFO_EXT
+	return p
+}
+func (p *VideoEncodeH265RateControlInfoEXT) Free() { MemFree(unsafe.Pointer(p)) }
+
+// VideoEncodeH265QpEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeH265QpEXT.html
+type VideoEncodeH265QpEXT struct {
+	QpI int32
+	QpP int32
+	QpB int32
+}
+
+func NewVideoEncodeH265QpEXT() *VideoEncodeH265QpEXT {
+	return (*VideoEncodeH265QpEXT)(MemAlloc(unsafe.Sizeof(*(*VideoEncodeH265QpEXT)(nil))))
+}
+func (p *VideoEncodeH265QpEXT) Free() { MemFree(unsafe.Pointer(p)) }
+
+// VideoEncodeH265FrameSizeEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeH265FrameSizeEXT.html
+type VideoEncodeH265FrameSizeEXT struct {
+	FrameISize uint32
+	FramePSize uint32
+	FrameBSize uint32
+}
+
+func NewVideoEncodeH265FrameSizeEXT() *VideoEncodeH265FrameSizeEXT {
+	return (*VideoEncodeH265FrameSizeEXT)(MemAlloc(unsafe.Sizeof(*(*VideoEncodeH265FrameSizeEXT)(nil))))
+}
+func (p *VideoEncodeH265FrameSizeEXT) Free() { MemFree(unsafe.Pointer(p)) }
+
+// VideoEncodeH265RateControlLayerInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoEncodeH265RateControlLayerInfoEXT.html
+type VideoEncodeH265RateControlLayerInfoEXT struct {
+	SType           StructureType
+	PNext           unsafe.Pointer
+	TemporalId      uint8
+	UseInitialRcQp  Bool32
+	InitialRcQp     VideoEncodeH265QpEXT
+	UseMinQp        Bool32
+	MinQp           VideoEncodeH265QpEXT
+	UseMaxQp        Bool32
+	MaxQp           VideoEncodeH265QpEXT
+	UseMaxFrameSize Bool32
+	MaxFrameSize    VideoEncodeH265FrameSizeEXT
+}
+
+func NewVideoEncodeH265RateControlLayerInfoEXT() *VideoEncodeH265RateControlLayerInfoEXT {
+	p := (*VideoEncodeH265RateControlLayerInfoEXT)(MemAlloc(unsafe.Sizeof(*(*VideoEncodeH265RateControlLayerInfoEXT)(nil))))
+	p.SType = STRUCTURE_TYPE_VIDEO_ENCODE_H265_RATE_CONTROL_LAYER_INFO_EXT
+	return p
+}
+func (p *VideoEncodeH265RateControlLayerInfoEXT) Free() { MemFree(unsafe.Pointer(p)) }
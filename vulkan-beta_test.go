@@ -0,0 +1,39 @@
+//go:build vkbeta
+// +build vkbeta
+
+package vk
+
+import (
+	"testing"
+	"unsafe"
+)
+
+func TestPhysicalDevicePortabilitySubsetFeaturesKHR(t *testing.T) {
+	p := NewPhysicalDevicePortabilitySubsetFeaturesKHR()
+	defer p.Free()
+	if p.SType != STRUCTURE_TYPE_PHYSICAL_DEVICE_PORTABILITY_SUBSET_FEATURES_KHR {
+		t.Errorf("SType = %v, want %v", p.SType, STRUCTURE_TYPE_PHYSICAL_DEVICE_PORTABILITY_SUBSET_FEATURES_KHR)
+	}
+	// sType, pNext and 15 VkBool32, padded to the pointer alignment
+	word := unsafe.Sizeof(uintptr(0))
+	if got, want := unsafe.Sizeof(*p), (2*word+15*4+word-1)/word*word; got != want {
+		t.Errorf("sizeof(VkPhysicalDevicePortabilitySubsetFeaturesKHR) = %d, want %d", got, want)
+	}
+}
+
+func TestBetaEnumNames(t *testing.T) {
+	tests := []struct {
+		v    interface{ String() string }
+		want string
+	}{
+		{STRUCTURE_TYPE_VIDEO_ENCODE_INFO_KHR, "STRUCTURE_TYPE_VIDEO_ENCODE_INFO_KHR"},
+		{IMAGE_LAYOUT_VIDEO_ENCODE_DST_KHR, "IMAGE_LAYOUT_VIDEO_ENCODE_DST_KHR"},
+		{QUERY_TYPE_VIDEO_ENCODE_BITSTREAM_BUFFER_RANGE_KHR, "QUERY_TYPE_VIDEO_ENCODE_BITSTREAM_BUFFER_RANGE_KHR"},
+		{VIDEO_ENCODE_TUNING_MODE_LOW_LATENCY_KHR, "VIDEO_ENCODE_TUNING_MODE_LOW_LATENCY_KHR"},
+	}
+	for _, tt := range tests {
+		if got := tt.v.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
@@ -16,11 +16,7 @@ package vk
  ** limitations under the License.
  */
 
-import (
-	"fmt"
-	"math"
-	"unsafe"
-)
+import "fmt"
 
 type Version uint32
 
@@ -28,18 +24,29 @@ const (
 	API_VERSION_1_0         = 1 << 22
 	API_VERSION_1_1         = 1<<22 | 1<<12
 	API_VERSION_1_2         = 1<<22 | 2<<12
-	HEADER_VERSION_COMPLETE = API_VERSION_1_2 | HEADER_VERSION
+	API_VERSION_1_3         = 1<<22 | 3<<12
+	HEADER_VERSION_COMPLETE = API_VERSION_1_3 | HEADER_VERSION // MakeApiVersion(0, 1, 3, HEADER_VERSION)
 )
 
 func MakeVersion(major, minor, patch uint32) Version {
 	return Version(((major) << 22) | ((minor) << 12) | (patch))
 }
 
-func (ver Version) Major() uint32 { return ((uint32)(ver) >> 22) }
-func (ver Version) Minor() uint32 { return (((uint32)(ver) >> 12) & 0x3ff) }
-func (ver Version) Patch() uint32 { return ((uint32)(ver) & 0xfff) }
+// MakeApiVersion is VK_MAKE_API_VERSION, the top 3 bits hold the variant,
+// which is 0 for Vulkan itself.
+func MakeApiVersion(variant, major, minor, patch uint32) Version {
+	return Version(((variant) << 29) | ((major) << 22) | ((minor) << 12) | (patch))
+}
+
+func (ver Version) Variant() uint32 { return ((uint32)(ver) >> 29) }
+func (ver Version) Major() uint32   { return (((uint32)(ver) >> 22) & 0x7f) }
+func (ver Version) Minor() uint32   { return (((uint32)(ver) >> 12) & 0x3ff) }
+func (ver Version) Patch() uint32   { return ((uint32)(ver) & 0xfff) }
 
 func (ver Version) String() string {
+	if v := ver.Variant(); v != 0 {
+		return fmt.Sprintf("%d:%d.%d.%d", v, ver.Major(), ver.Minor(), ver.Patch())
+	}
 	return fmt.Sprintf("%d.%d.%d", ver.Major(), ver.Minor(), ver.Patch())
 }
 
@@ -60,7 +67,7 @@ const (
 	ATTACHMENT_UNUSED      uint32 = 0xFFFFFFFF         // ^0
 	QUEUE_FAMILY_IGNORED   uint32 = 0xFFFFFFFF         // ^0
 	SUBPASS_EXTERNAL       uint32 = 0xFFFFFFFF         // ^0
-	SHADER_UNUSED_NV       uint32 = 0xFFFFFFFF         // ^0
+	SHADER_UNUSED_KHR      uint32 = 0xFFFFFFFF         // ^0
 )
 
 type ErrorResult Result
@@ -86,234 +93,80 @@ func AsResult(err error) Result {
 	return RESULT_MAX_ENUM
 }
 
-func CreateInstance(pCreateInfo *InstanceCreateInfo, pAllocator *AllocationCallbacks, pInstance *Instance) Result {
-	fp := PfnCreateInstance(GetInstanceProcAddr(0, "vkCreateInstance"))
-	if fp == 0 {
-		return ERROR_UNKNOWN
-	}
-	return fp.Call(pCreateInfo, pAllocator, pInstance)
-}
+// ClearColorValue and ClearValue used to be [16]byte, they are structs now
+// to get the alignment of the C unions. Bytes and SetBytes stand in for the
+// conversions to and from the array.
 
-func EnumerateInstanceVersion(pApiVersion *Version) Result {
-	fp := PfnEnumerateInstanceVersion(GetInstanceProcAddr(0, "vkEnumerateInstanceVersion"))
-	if fp == 0 {
-		return ERROR_UNKNOWN
-	}
-	return fp.Call((*uint32)(pApiVersion))
-}
+// Bytes returns the 16 bytes of the union.
+func (p *ClearColorValue) Bytes() [16]byte { return p.data }
 
-// union VkClearColorValue {
-// 	float       float32[4];
-// 	int32_t     int32[4];
-// 	uint32_t    uint32[4];
-// };
-// ClearColorValue -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkClearColorValue.html
-type ClearColorValue [16]byte
+// SetBytes sets the 16 bytes of the union.
+func (p *ClearColorValue) SetBytes(x [16]byte) { p.data = x }
 
-func NewClearColorValue() *ClearColorValue {
-	return (*ClearColorValue)(MemAlloc(unsafe.Sizeof(*(*ClearColorValue)(nil))))
-}
-func (p *ClearColorValue) Free() { MemFree(unsafe.Pointer(p)) }
+// Bytes returns the 16 bytes of the union.
+func (p *ClearValue) Bytes() [16]byte { return p.data }
 
-func (v *ClearColorValue) SetFloat32(x [4]float32) {
-	*v = *((*[16]byte)(unsafe.Pointer(&x)))
-}
-func (v *ClearColorValue) Float32Color() [4]float32 {
-	return *((*[4]float32)(unsafe.Pointer(v)))
-}
-func (v *ClearColorValue) SetInt32(x [4]int32) {
-	*v = *((*[16]byte)(unsafe.Pointer(&x)))
-}
-func (v *ClearColorValue) Int32Color() [4]int32 {
-	return *((*[4]int32)(unsafe.Pointer(v)))
-}
-func (v *ClearColorValue) SetUint32(x [4]uint32) {
-	*v = *((*[16]byte)(unsafe.Pointer(&x)))
-}
-func (v *ClearColorValue) Uint32Color() [4]uint32 {
-	return *((*[4]uint32)(unsafe.Pointer(v)))
-}
+// SetBytes sets the 16 bytes of the union.
+func (p *ClearValue) SetBytes(x [16]byte) { p.data = x }
 
-// union VkClearValue {
-// 	VkClearColorValue           color;
-// 	VkClearDepthStencilValue    depthStencil;
-// };
-// ClearValue -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkClearValue.html
-type ClearValue [16]byte
+// Deprecated: use Float32.
+func (p *ClearColorValue) Float32Color() [4]float32 { return p.Float32() }
 
-func NewClearValue() *ClearValue {
-	return (*ClearValue)(MemAlloc(unsafe.Sizeof(*(*ClearValue)(nil))))
-}
-func (p *ClearValue) Free() { MemFree(unsafe.Pointer(p)) }
+// Deprecated: use Int32.
+func (p *ClearColorValue) Int32Color() [4]int32 { return p.Int32() }
 
-func (v *ClearValue) SetClearColor(x ClearColorValue) {
-	*v = *((*[16]byte)(unsafe.Pointer(&x)))
-}
-func (v *ClearValue) ClearColor() ClearColorValue {
-	return *((*ClearColorValue)(unsafe.Pointer(v)))
-}
+// Deprecated: use Uint32.
+func (p *ClearColorValue) Uint32Color() [4]uint32 { return p.Uint32() }
 
-func (v *ClearValue) SetFloat32Color(x [4]float32) {
-	*v = *((*[16]byte)(unsafe.Pointer(&x)))
-}
-func (v *ClearValue) Float32Color() [4]float32 {
-	return *((*[4]float32)(unsafe.Pointer(v)))
-}
-func (v *ClearValue) SetInt32Color(x [4]int32) {
-	*v = *((*[16]byte)(unsafe.Pointer(&x)))
-}
-func (v *ClearValue) Int32Color() [4]int32 {
-	return *((*[4]int32)(unsafe.Pointer(v)))
-}
-func (v *ClearValue) SetUint32Color(x [4]uint32) {
-	*v = *((*[16]byte)(unsafe.Pointer(&x)))
-}
-func (v *ClearValue) Uint32Color() [4]uint32 {
-	return *((*[4]uint32)(unsafe.Pointer(v)))
-}
+// Deprecated: use SetColor.
+func (p *ClearValue) SetClearColor(x ClearColorValue) { p.SetColor(x) }
 
-func (v *ClearValue) SetDepthStencil(x ClearDepthStencilValue) {
-	*((*[8]byte)(unsafe.Pointer(v))) = *((*[8]byte)(unsafe.Pointer(&x)))
-}
-func (v *ClearValue) DepthStencil() ClearDepthStencilValue {
-	return *((*ClearDepthStencilValue)(unsafe.Pointer(v)))
-}
+// Deprecated: use Color.
+func (p *ClearValue) ClearColor() ClearColorValue { return p.Color() }
 
-func (v *ClearValue) SetDepth(x float32) {
-	*((*[4]byte)(unsafe.Pointer(v))) = *((*[4]byte)(unsafe.Pointer(&x)))
+func (p *ClearValue) SetFloat32Color(x [4]float32) {
+	var c ClearColorValue
+	c.SetFloat32(x)
+	p.SetColor(c)
 }
-func (v *ClearValue) Depth() float32 {
-	return *((*float32)(unsafe.Pointer(v)))
+func (p *ClearValue) Float32Color() [4]float32 {
+	c := p.Color()
+	return c.Float32()
 }
-func (v *ClearValue) SetStencil(x int32) {
-	// unsafe.Offsetof(ClearDepthStencilValue.Stencil) = 4
-	*((*[4]byte)(unsafe.Pointer(&v[4]))) = *((*[4]byte)(unsafe.Pointer(&x)))
+func (p *ClearValue) SetInt32Color(x [4]int32) {
+	var c ClearColorValue
+	c.SetInt32(x)
+	p.SetColor(c)
 }
-func (v *ClearValue) Stencil() int32 {
-	// unsafe.Offsetof(ClearDepthStencilValue.Stencil) = 4
-	return *((*int32)(unsafe.Pointer(&v[4])))
-}
-
-// union VkPerformanceValueDataINTEL {
-// 	uint32_t       value32;
-// 	uint64_t       value64;
-// 	float          valueFloat;
-// 	VkBool32       valueBool;
-// 	const char*    valueString;
-// };
-type PerformanceValueDataINTEL uint64
-
-func (v *PerformanceValueDataINTEL) SetValue32(x uint32) {
-	*v = PerformanceValueDataINTEL(uint64(x))
+func (p *ClearValue) Int32Color() [4]int32 {
+	c := p.Color()
+	return c.Int32()
 }
-func (v *PerformanceValueDataINTEL) SetValue64(x uint64) {
-	*v = PerformanceValueDataINTEL(x)
+func (p *ClearValue) SetUint32Color(x [4]uint32) {
+	var c ClearColorValue
+	c.SetUint32(x)
+	p.SetColor(c)
 }
-func (v *PerformanceValueDataINTEL) SetValueFloat(x float32) {
-	*v = PerformanceValueDataINTEL(uint64(math.Float32bits(x)))
-}
-func (v *PerformanceValueDataINTEL) SetValueBool(x Bool32) {
-	*v = PerformanceValueDataINTEL(uint64(x))
-}
-func (v *PerformanceValueDataINTEL) SetValueCString(x *int8) {
-	*v = PerformanceValueDataINTEL(uint64(uintptr(unsafe.Pointer(x))))
-}
-
-// union VkPipelineExecutableStatisticValueKHR {
-// 	VkBool32    b32;
-// 	int64_t     i64;
-// 	uint64_t    u64;
-// 	double      f64;
-// } VkPipelineExecutableStatisticValueKHR;
-
-type PipelineExecutableStatisticValueKHR uint64
-
-// TODO:
-
-// ypedef struct VkAccelerationStructureInstanceKHR {
-//     VkTransformMatrixKHR          transform;
-//     uint32_t                      instanceCustomIndex:24;
-//     uint32_t                      mask:8;
-//     uint32_t                      instanceShaderBindingTableRecordOffset:24;
-//     VkGeometryInstanceFlagsKHR    flags:8;
-//     uint64_t                      accelerationStructureReference;
-// } VkAccelerationStructureInstanceKHR;
-
-type AccelerationStructureInstanceKHR struct {
-	Transform                      TransformMatrixKHR
-	bitfield0                      uint32 // instanceCustomIndex:24 mask:8
-	bitfield1                      uint32 // instanceShaderBindingTableRecordOffset:24 flags:8
-	AccelerationStructureReference uint64
-}
-
-func (a *AccelerationStructureInstanceKHR) SetInstanceCustomIndex(x uint32) {
-	a.bitfield0 = (a.bitfield0 & 0xFF000000) | (x & 0x00FFFFFF)
-}
-
-func (a AccelerationStructureInstanceKHR) InstanceCustomIndex() uint32 {
-	return a.bitfield0 & 0x00FFFFFF
-}
-
-func (a *AccelerationStructureInstanceKHR) SetMask(x uint8) {
-	a.bitfield0 = (a.bitfield0 & 0x00FFFFFF) | (uint32(x) << 24)
+func (p *ClearValue) Uint32Color() [4]uint32 {
+	c := p.Color()
+	return c.Uint32()
 }
 
-func (a AccelerationStructureInstanceKHR) Mask() uint8 {
-	return uint8((a.bitfield0 & 0xFF000000) >> 24)
+// SetDepth keeps the stencil value.
+func (p *ClearValue) SetDepth(x float32) {
+	ds := p.DepthStencil()
+	ds.Depth = x
+	p.SetDepthStencil(ds)
 }
+func (p *ClearValue) Depth() float32 { return p.DepthStencil().Depth }
 
-func (a *AccelerationStructureInstanceKHR) SetInstanceShaderBindingTableRecordOffset(x uint32) {
-	a.bitfield1 = (a.bitfield1 & 0xFF000000) | (x & 0x00FFFFFF)
+// SetStencil keeps the depth value.
+func (p *ClearValue) SetStencil(x int32) {
+	ds := p.DepthStencil()
+	ds.Stencil = uint32(x)
+	p.SetDepthStencil(ds)
 }
+func (p *ClearValue) Stencil() int32 { return int32(p.DepthStencil().Stencil) }
 
-func (a AccelerationStructureInstanceKHR) InstanceShaderBindingTableRecordOffset() uint32 {
-	return a.bitfield1 & 0x00FFFFFF
-}
-
-func (a *AccelerationStructureInstanceKHR) SetFlags(x uint8) {
-	a.bitfield1 = (a.bitfield1 & 0x00FFFFFF) | (uint32(x) << 24)
-}
-
-func (a AccelerationStructureInstanceKHR) Flags() uint8 {
-	return uint8((a.bitfield1 & 0xFF000000) >> 24)
-}
-
-type DeviceOrHostAddressKHR uint64
-
-type DeviceOrHostAddressConstKHR uint64
-
-// typedef union VkAccelerationStructureGeometryDataKHR {
-//     VkAccelerationStructureGeometryTrianglesDataKHR    triangles;
-//     VkAccelerationStructureGeometryAabbsDataKHR        aabbs;
-//     VkAccelerationStructureGeometryInstancesDataKHR    instances;
-// } VkAccelerationStructureGeometryDataKHR;
-/*
-type AccelerationStructureGeometryTrianglesDataKHR struct {
-	SType         StructureType   32
-	PNext         unsafe.Pointer  64
-	VertexFormat  Format          32
-	VertexData    DeviceOrHostAddressConstKHR 64
-	VertexStride  DeviceSize 64
-	MaxVertex     uint32   32
-	IndexType     IndexType 32
-	IndexData     DeviceOrHostAddressConstKHR  64
-	TransformData DeviceOrHostAddressConstKHR  64
-}
-
-// AccelerationStructureGeometryAabbsDataKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkAccelerationStructureGeometryAabbsDataKHR.html
-type AccelerationStructureGeometryAabbsDataKHR struct {
-	SType  StructureType
-	PNext  unsafe.Pointer
-	Data   DeviceOrHostAddressConstKHR
-	Stride DeviceSize
-}
-
-// AccelerationStructureGeometryInstancesDataKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkAccelerationStructureGeometryInstancesDataKHR.html
-type AccelerationStructureGeometryInstancesDataKHR struct {
-	SType           StructureType
-	PNext           unsafe.Pointer
-	ArrayOfPointers Bool32
-	Data            DeviceOrHostAddressConstKHR
-}
-*/
-type AccelerationStructureGeometryDataKHR [448]byte // TODO: 验证其尺寸
+// Deprecated: use SetValueString.
+func (p *PerformanceValueDataINTEL) SetValueCString(x *int8) { p.SetValueString(x) }
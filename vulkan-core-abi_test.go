@@ -0,0 +1,6122 @@
+//go:build cgo
+// +build cgo
+
+// This file is generated by vkgen.
+
+package vk
+
+import (
+	"runtime"
+	"testing"
+	"unsafe"
+
+	"github.com/toy80/vk/internal/abi"
+)
+
+type structLayout struct {
+	name    string
+	size    uintptr
+	align   uintptr
+	members []memberOffset // nil for the unions, all at 0
+}
+
+type memberOffset struct {
+	name   string
+	offset uintptr
+}
+
+// TestStructLayouts compares every generated struct with the C declaration
+// it mirrors. A mismatch means the driver reads garbage.
+func TestStructLayouts(t *testing.T) {
+	for _, s := range structLayouts {
+		c, ok := abi.Layouts[s.name]
+		if !ok || len(c) != 2+len(s.members) {
+			t.Errorf("%s: C layout has %d values, want %d", s.name, len(c), 2+len(s.members))
+			continue
+		}
+		if s.size != c[0] {
+			t.Errorf("%s: sizeof is %d, C has %d", s.name, s.size, c[0])
+		}
+		// Go aligns nothing to 8 on arm, the padding of the structs keeps
+		// their members and their size where C has them
+		if s.align != c[1] && !(runtime.GOARCH == "arm" && s.align == 4 && c[1] == 8) {
+			t.Errorf("%s: alignof is %d, C has %d", s.name, s.align, c[1])
+		}
+		for i, m := range s.members {
+			if m.offset != c[2+i] {
+				t.Errorf("%s.%s: offsetof is %d, C has %d", s.name, m.name, m.offset, c[2+i])
+			}
+		}
+	}
+}
+
+var structLayouts = []structLayout{
+	{"VkExtent2D", unsafe.Sizeof(*(*Extent2D)(nil)), unsafe.Alignof(*(*Extent2D)(nil)), []memberOffset{
+		{"width", unsafe.Offsetof((*Extent2D)(nil).Width)},
+		{"height", unsafe.Offsetof((*Extent2D)(nil).Height)},
+	}},
+	{"VkExtent3D", unsafe.Sizeof(*(*Extent3D)(nil)), unsafe.Alignof(*(*Extent3D)(nil)), []memberOffset{
+		{"width", unsafe.Offsetof((*Extent3D)(nil).Width)},
+		{"height", unsafe.Offsetof((*Extent3D)(nil).Height)},
+		{"depth", unsafe.Offsetof((*Extent3D)(nil).Depth)},
+	}},
+	{"VkOffset2D", unsafe.Sizeof(*(*Offset2D)(nil)), unsafe.Alignof(*(*Offset2D)(nil)), []memberOffset{
+		{"x", unsafe.Offsetof((*Offset2D)(nil).X)},
+		{"y", unsafe.Offsetof((*Offset2D)(nil).Y)},
+	}},
+	{"VkOffset3D", unsafe.Sizeof(*(*Offset3D)(nil)), unsafe.Alignof(*(*Offset3D)(nil)), []memberOffset{
+		{"x", unsafe.Offsetof((*Offset3D)(nil).X)},
+		{"y", unsafe.Offsetof((*Offset3D)(nil).Y)},
+		{"z", unsafe.Offsetof((*Offset3D)(nil).Z)},
+	}},
+	{"VkRect2D", unsafe.Sizeof(*(*Rect2D)(nil)), unsafe.Alignof(*(*Rect2D)(nil)), []memberOffset{
+		{"offset", unsafe.Offsetof((*Rect2D)(nil).Offset)},
+		{"extent", unsafe.Offsetof((*Rect2D)(nil).Extent)},
+	}},
+	{"VkBaseInStructure", unsafe.Sizeof(*(*BaseInStructure)(nil)), unsafe.Alignof(*(*BaseInStructure)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*BaseInStructure)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*BaseInStructure)(nil).PNext)},
+	}},
+	{"VkBaseOutStructure", unsafe.Sizeof(*(*BaseOutStructure)(nil)), unsafe.Alignof(*(*BaseOutStructure)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*BaseOutStructure)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*BaseOutStructure)(nil).PNext)},
+	}},
+	{"VkBufferMemoryBarrier", unsafe.Sizeof(*(*BufferMemoryBarrier)(nil)), unsafe.Alignof(*(*BufferMemoryBarrier)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*BufferMemoryBarrier)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*BufferMemoryBarrier)(nil).PNext)},
+		{"srcAccessMask", unsafe.Offsetof((*BufferMemoryBarrier)(nil).SrcAccessMask)},
+		{"dstAccessMask", unsafe.Offsetof((*BufferMemoryBarrier)(nil).DstAccessMask)},
+		{"srcQueueFamilyIndex", unsafe.Offsetof((*BufferMemoryBarrier)(nil).SrcQueueFamilyIndex)},
+		{"dstQueueFamilyIndex", unsafe.Offsetof((*BufferMemoryBarrier)(nil).DstQueueFamilyIndex)},
+		{"buffer", unsafe.Offsetof((*BufferMemoryBarrier)(nil).Buffer)},
+		{"offset", unsafe.Offsetof((*BufferMemoryBarrier)(nil).Offset)},
+		{"size", unsafe.Offsetof((*BufferMemoryBarrier)(nil).Size)},
+	}},
+	{"VkDispatchIndirectCommand", unsafe.Sizeof(*(*DispatchIndirectCommand)(nil)), unsafe.Alignof(*(*DispatchIndirectCommand)(nil)), []memberOffset{
+		{"x", unsafe.Offsetof((*DispatchIndirectCommand)(nil).X)},
+		{"y", unsafe.Offsetof((*DispatchIndirectCommand)(nil).Y)},
+		{"z", unsafe.Offsetof((*DispatchIndirectCommand)(nil).Z)},
+	}},
+	{"VkDrawIndexedIndirectCommand", unsafe.Sizeof(*(*DrawIndexedIndirectCommand)(nil)), unsafe.Alignof(*(*DrawIndexedIndirectCommand)(nil)), []memberOffset{
+		{"indexCount", unsafe.Offsetof((*DrawIndexedIndirectCommand)(nil).IndexCount)},
+		{"instanceCount", unsafe.Offsetof((*DrawIndexedIndirectCommand)(nil).InstanceCount)},
+		{"firstIndex", unsafe.Offsetof((*DrawIndexedIndirectCommand)(nil).FirstIndex)},
+		{"vertexOffset", unsafe.Offsetof((*DrawIndexedIndirectCommand)(nil).VertexOffset)},
+		{"firstInstance", unsafe.Offsetof((*DrawIndexedIndirectCommand)(nil).FirstInstance)},
+	}},
+	{"VkDrawIndirectCommand", unsafe.Sizeof(*(*DrawIndirectCommand)(nil)), unsafe.Alignof(*(*DrawIndirectCommand)(nil)), []memberOffset{
+		{"vertexCount", unsafe.Offsetof((*DrawIndirectCommand)(nil).VertexCount)},
+		{"instanceCount", unsafe.Offsetof((*DrawIndirectCommand)(nil).InstanceCount)},
+		{"firstVertex", unsafe.Offsetof((*DrawIndirectCommand)(nil).FirstVertex)},
+		{"firstInstance", unsafe.Offsetof((*DrawIndirectCommand)(nil).FirstInstance)},
+	}},
+	{"VkImageSubresourceRange", unsafe.Sizeof(*(*ImageSubresourceRange)(nil)), unsafe.Alignof(*(*ImageSubresourceRange)(nil)), []memberOffset{
+		{"aspectMask", unsafe.Offsetof((*ImageSubresourceRange)(nil).AspectMask)},
+		{"baseMipLevel", unsafe.Offsetof((*ImageSubresourceRange)(nil).BaseMipLevel)},
+		{"levelCount", unsafe.Offsetof((*ImageSubresourceRange)(nil).LevelCount)},
+		{"baseArrayLayer", unsafe.Offsetof((*ImageSubresourceRange)(nil).BaseArrayLayer)},
+		{"layerCount", unsafe.Offsetof((*ImageSubresourceRange)(nil).LayerCount)},
+	}},
+	{"VkImageMemoryBarrier", unsafe.Sizeof(*(*ImageMemoryBarrier)(nil)), unsafe.Alignof(*(*ImageMemoryBarrier)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ImageMemoryBarrier)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ImageMemoryBarrier)(nil).PNext)},
+		{"srcAccessMask", unsafe.Offsetof((*ImageMemoryBarrier)(nil).SrcAccessMask)},
+		{"dstAccessMask", unsafe.Offsetof((*ImageMemoryBarrier)(nil).DstAccessMask)},
+		{"oldLayout", unsafe.Offsetof((*ImageMemoryBarrier)(nil).OldLayout)},
+		{"newLayout", unsafe.Offsetof((*ImageMemoryBarrier)(nil).NewLayout)},
+		{"srcQueueFamilyIndex", unsafe.Offsetof((*ImageMemoryBarrier)(nil).SrcQueueFamilyIndex)},
+		{"dstQueueFamilyIndex", unsafe.Offsetof((*ImageMemoryBarrier)(nil).DstQueueFamilyIndex)},
+		{"image", unsafe.Offsetof((*ImageMemoryBarrier)(nil).Image)},
+		{"subresourceRange", unsafe.Offsetof((*ImageMemoryBarrier)(nil).SubresourceRange)},
+	}},
+	{"VkMemoryBarrier", unsafe.Sizeof(*(*MemoryBarrier)(nil)), unsafe.Alignof(*(*MemoryBarrier)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*MemoryBarrier)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*MemoryBarrier)(nil).PNext)},
+		{"srcAccessMask", unsafe.Offsetof((*MemoryBarrier)(nil).SrcAccessMask)},
+		{"dstAccessMask", unsafe.Offsetof((*MemoryBarrier)(nil).DstAccessMask)},
+	}},
+	{"VkPipelineCacheHeaderVersionOne", unsafe.Sizeof(*(*PipelineCacheHeaderVersionOne)(nil)), unsafe.Alignof(*(*PipelineCacheHeaderVersionOne)(nil)), []memberOffset{
+		{"headerSize", unsafe.Offsetof((*PipelineCacheHeaderVersionOne)(nil).HeaderSize)},
+		{"headerVersion", unsafe.Offsetof((*PipelineCacheHeaderVersionOne)(nil).HeaderVersion)},
+		{"vendorID", unsafe.Offsetof((*PipelineCacheHeaderVersionOne)(nil).VendorID)},
+		{"deviceID", unsafe.Offsetof((*PipelineCacheHeaderVersionOne)(nil).DeviceID)},
+		{"pipelineCacheUUID", unsafe.Offsetof((*PipelineCacheHeaderVersionOne)(nil).PipelineCacheUUID)},
+	}},
+	{"VkAllocationCallbacks", unsafe.Sizeof(*(*AllocationCallbacks)(nil)), unsafe.Alignof(*(*AllocationCallbacks)(nil)), []memberOffset{
+		{"pUserData", unsafe.Offsetof((*AllocationCallbacks)(nil).PUserData)},
+		{"pfnAllocation", unsafe.Offsetof((*AllocationCallbacks)(nil).PfnAllocation)},
+		{"pfnReallocation", unsafe.Offsetof((*AllocationCallbacks)(nil).PfnReallocation)},
+		{"pfnFree", unsafe.Offsetof((*AllocationCallbacks)(nil).PfnFree)},
+		{"pfnInternalAllocation", unsafe.Offsetof((*AllocationCallbacks)(nil).PfnInternalAllocation)},
+		{"pfnInternalFree", unsafe.Offsetof((*AllocationCallbacks)(nil).PfnInternalFree)},
+	}},
+	{"VkApplicationInfo", unsafe.Sizeof(*(*ApplicationInfo)(nil)), unsafe.Alignof(*(*ApplicationInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ApplicationInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ApplicationInfo)(nil).PNext)},
+		{"pApplicationName", unsafe.Offsetof((*ApplicationInfo)(nil).PApplicationName)},
+		{"applicationVersion", unsafe.Offsetof((*ApplicationInfo)(nil).ApplicationVersion)},
+		{"pEngineName", unsafe.Offsetof((*ApplicationInfo)(nil).PEngineName)},
+		{"engineVersion", unsafe.Offsetof((*ApplicationInfo)(nil).EngineVersion)},
+		{"apiVersion", unsafe.Offsetof((*ApplicationInfo)(nil).ApiVersion)},
+	}},
+	{"VkFormatProperties", unsafe.Sizeof(*(*FormatProperties)(nil)), unsafe.Alignof(*(*FormatProperties)(nil)), []memberOffset{
+		{"linearTilingFeatures", unsafe.Offsetof((*FormatProperties)(nil).LinearTilingFeatures)},
+		{"optimalTilingFeatures", unsafe.Offsetof((*FormatProperties)(nil).OptimalTilingFeatures)},
+		{"bufferFeatures", unsafe.Offsetof((*FormatProperties)(nil).BufferFeatures)},
+	}},
+	{"VkImageFormatProperties", unsafe.Sizeof(*(*ImageFormatProperties)(nil)), unsafe.Alignof(*(*ImageFormatProperties)(nil)), []memberOffset{
+		{"maxExtent", unsafe.Offsetof((*ImageFormatProperties)(nil).MaxExtent)},
+		{"maxMipLevels", unsafe.Offsetof((*ImageFormatProperties)(nil).MaxMipLevels)},
+		{"maxArrayLayers", unsafe.Offsetof((*ImageFormatProperties)(nil).MaxArrayLayers)},
+		{"sampleCounts", unsafe.Offsetof((*ImageFormatProperties)(nil).SampleCounts)},
+		{"maxResourceSize", unsafe.Offsetof((*ImageFormatProperties)(nil).MaxResourceSize)},
+	}},
+	{"VkInstanceCreateInfo", unsafe.Sizeof(*(*InstanceCreateInfo)(nil)), unsafe.Alignof(*(*InstanceCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*InstanceCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*InstanceCreateInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*InstanceCreateInfo)(nil).Flags)},
+		{"pApplicationInfo", unsafe.Offsetof((*InstanceCreateInfo)(nil).PApplicationInfo)},
+		{"enabledLayerCount", unsafe.Offsetof((*InstanceCreateInfo)(nil).EnabledLayerCount)},
+		{"ppEnabledLayerNames", unsafe.Offsetof((*InstanceCreateInfo)(nil).PpEnabledLayerNames)},
+		{"enabledExtensionCount", unsafe.Offsetof((*InstanceCreateInfo)(nil).EnabledExtensionCount)},
+		{"ppEnabledExtensionNames", unsafe.Offsetof((*InstanceCreateInfo)(nil).PpEnabledExtensionNames)},
+	}},
+	{"VkMemoryHeap", unsafe.Sizeof(*(*MemoryHeap)(nil)), unsafe.Alignof(*(*MemoryHeap)(nil)), []memberOffset{
+		{"size", unsafe.Offsetof((*MemoryHeap)(nil).Size)},
+		{"flags", unsafe.Offsetof((*MemoryHeap)(nil).Flags)},
+	}},
+	{"VkMemoryType", unsafe.Sizeof(*(*MemoryType)(nil)), unsafe.Alignof(*(*MemoryType)(nil)), []memberOffset{
+		{"propertyFlags", unsafe.Offsetof((*MemoryType)(nil).PropertyFlags)},
+		{"heapIndex", unsafe.Offsetof((*MemoryType)(nil).HeapIndex)},
+	}},
+	{"VkPhysicalDeviceFeatures", unsafe.Sizeof(*(*PhysicalDeviceFeatures)(nil)), unsafe.Alignof(*(*PhysicalDeviceFeatures)(nil)), []memberOffset{
+		{"robustBufferAccess", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).RobustBufferAccess)},
+		{"fullDrawIndexUint32", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).FullDrawIndexUint32)},
+		{"imageCubeArray", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).ImageCubeArray)},
+		{"independentBlend", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).IndependentBlend)},
+		{"geometryShader", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).GeometryShader)},
+		{"tessellationShader", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).TessellationShader)},
+		{"sampleRateShading", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).SampleRateShading)},
+		{"dualSrcBlend", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).DualSrcBlend)},
+		{"logicOp", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).LogicOp)},
+		{"multiDrawIndirect", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).MultiDrawIndirect)},
+		{"drawIndirectFirstInstance", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).DrawIndirectFirstInstance)},
+		{"depthClamp", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).DepthClamp)},
+		{"depthBiasClamp", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).DepthBiasClamp)},
+		{"fillModeNonSolid", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).FillModeNonSolid)},
+		{"depthBounds", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).DepthBounds)},
+		{"wideLines", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).WideLines)},
+		{"largePoints", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).LargePoints)},
+		{"alphaToOne", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).AlphaToOne)},
+		{"multiViewport", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).MultiViewport)},
+		{"samplerAnisotropy", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).SamplerAnisotropy)},
+		{"textureCompressionETC2", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).TextureCompressionETC2)},
+		{"textureCompressionASTC_LDR", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).TextureCompressionASTCLDR)},
+		{"textureCompressionBC", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).TextureCompressionBC)},
+		{"occlusionQueryPrecise", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).OcclusionQueryPrecise)},
+		{"pipelineStatisticsQuery", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).PipelineStatisticsQuery)},
+		{"vertexPipelineStoresAndAtomics", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).VertexPipelineStoresAndAtomics)},
+		{"fragmentStoresAndAtomics", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).FragmentStoresAndAtomics)},
+		{"shaderTessellationAndGeometryPointSize", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).ShaderTessellationAndGeometryPointSize)},
+		{"shaderImageGatherExtended", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).ShaderImageGatherExtended)},
+		{"shaderStorageImageExtendedFormats", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).ShaderStorageImageExtendedFormats)},
+		{"shaderStorageImageMultisample", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).ShaderStorageImageMultisample)},
+		{"shaderStorageImageReadWithoutFormat", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).ShaderStorageImageReadWithoutFormat)},
+		{"shaderStorageImageWriteWithoutFormat", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).ShaderStorageImageWriteWithoutFormat)},
+		{"shaderUniformBufferArrayDynamicIndexing", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).ShaderUniformBufferArrayDynamicIndexing)},
+		{"shaderSampledImageArrayDynamicIndexing", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).ShaderSampledImageArrayDynamicIndexing)},
+		{"shaderStorageBufferArrayDynamicIndexing", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).ShaderStorageBufferArrayDynamicIndexing)},
+		{"shaderStorageImageArrayDynamicIndexing", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).ShaderStorageImageArrayDynamicIndexing)},
+		{"shaderClipDistance", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).ShaderClipDistance)},
+		{"shaderCullDistance", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).ShaderCullDistance)},
+		{"shaderFloat64", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).ShaderFloat64)},
+		{"shaderInt64", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).ShaderInt64)},
+		{"shaderInt16", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).ShaderInt16)},
+		{"shaderResourceResidency", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).ShaderResourceResidency)},
+		{"shaderResourceMinLod", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).ShaderResourceMinLod)},
+		{"sparseBinding", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).SparseBinding)},
+		{"sparseResidencyBuffer", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).SparseResidencyBuffer)},
+		{"sparseResidencyImage2D", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).SparseResidencyImage2D)},
+		{"sparseResidencyImage3D", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).SparseResidencyImage3D)},
+		{"sparseResidency2Samples", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).SparseResidency2Samples)},
+		{"sparseResidency4Samples", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).SparseResidency4Samples)},
+		{"sparseResidency8Samples", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).SparseResidency8Samples)},
+		{"sparseResidency16Samples", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).SparseResidency16Samples)},
+		{"sparseResidencyAliased", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).SparseResidencyAliased)},
+		{"variableMultisampleRate", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).VariableMultisampleRate)},
+		{"inheritedQueries", unsafe.Offsetof((*PhysicalDeviceFeatures)(nil).InheritedQueries)},
+	}},
+	{"VkPhysicalDeviceLimits", unsafe.Sizeof(*(*PhysicalDeviceLimits)(nil)), unsafe.Alignof(*(*PhysicalDeviceLimits)(nil)), []memberOffset{
+		{"maxImageDimension1D", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxImageDimension1D)},
+		{"maxImageDimension2D", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxImageDimension2D)},
+		{"maxImageDimension3D", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxImageDimension3D)},
+		{"maxImageDimensionCube", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxImageDimensionCube)},
+		{"maxImageArrayLayers", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxImageArrayLayers)},
+		{"maxTexelBufferElements", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxTexelBufferElements)},
+		{"maxUniformBufferRange", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxUniformBufferRange)},
+		{"maxStorageBufferRange", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxStorageBufferRange)},
+		{"maxPushConstantsSize", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxPushConstantsSize)},
+		{"maxMemoryAllocationCount", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxMemoryAllocationCount)},
+		{"maxSamplerAllocationCount", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxSamplerAllocationCount)},
+		{"bufferImageGranularity", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).BufferImageGranularity)},
+		{"sparseAddressSpaceSize", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).SparseAddressSpaceSize)},
+		{"maxBoundDescriptorSets", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxBoundDescriptorSets)},
+		{"maxPerStageDescriptorSamplers", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxPerStageDescriptorSamplers)},
+		{"maxPerStageDescriptorUniformBuffers", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxPerStageDescriptorUniformBuffers)},
+		{"maxPerStageDescriptorStorageBuffers", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxPerStageDescriptorStorageBuffers)},
+		{"maxPerStageDescriptorSampledImages", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxPerStageDescriptorSampledImages)},
+		{"maxPerStageDescriptorStorageImages", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxPerStageDescriptorStorageImages)},
+		{"maxPerStageDescriptorInputAttachments", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxPerStageDescriptorInputAttachments)},
+		{"maxPerStageResources", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxPerStageResources)},
+		{"maxDescriptorSetSamplers", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxDescriptorSetSamplers)},
+		{"maxDescriptorSetUniformBuffers", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxDescriptorSetUniformBuffers)},
+		{"maxDescriptorSetUniformBuffersDynamic", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxDescriptorSetUniformBuffersDynamic)},
+		{"maxDescriptorSetStorageBuffers", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxDescriptorSetStorageBuffers)},
+		{"maxDescriptorSetStorageBuffersDynamic", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxDescriptorSetStorageBuffersDynamic)},
+		{"maxDescriptorSetSampledImages", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxDescriptorSetSampledImages)},
+		{"maxDescriptorSetStorageImages", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxDescriptorSetStorageImages)},
+		{"maxDescriptorSetInputAttachments", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxDescriptorSetInputAttachments)},
+		{"maxVertexInputAttributes", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxVertexInputAttributes)},
+		{"maxVertexInputBindings", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxVertexInputBindings)},
+		{"maxVertexInputAttributeOffset", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxVertexInputAttributeOffset)},
+		{"maxVertexInputBindingStride", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxVertexInputBindingStride)},
+		{"maxVertexOutputComponents", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxVertexOutputComponents)},
+		{"maxTessellationGenerationLevel", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxTessellationGenerationLevel)},
+		{"maxTessellationPatchSize", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxTessellationPatchSize)},
+		{"maxTessellationControlPerVertexInputComponents", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxTessellationControlPerVertexInputComponents)},
+		{"maxTessellationControlPerVertexOutputComponents", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxTessellationControlPerVertexOutputComponents)},
+		{"maxTessellationControlPerPatchOutputComponents", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxTessellationControlPerPatchOutputComponents)},
+		{"maxTessellationControlTotalOutputComponents", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxTessellationControlTotalOutputComponents)},
+		{"maxTessellationEvaluationInputComponents", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxTessellationEvaluationInputComponents)},
+		{"maxTessellationEvaluationOutputComponents", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxTessellationEvaluationOutputComponents)},
+		{"maxGeometryShaderInvocations", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxGeometryShaderInvocations)},
+		{"maxGeometryInputComponents", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxGeometryInputComponents)},
+		{"maxGeometryOutputComponents", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxGeometryOutputComponents)},
+		{"maxGeometryOutputVertices", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxGeometryOutputVertices)},
+		{"maxGeometryTotalOutputComponents", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxGeometryTotalOutputComponents)},
+		{"maxFragmentInputComponents", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxFragmentInputComponents)},
+		{"maxFragmentOutputAttachments", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxFragmentOutputAttachments)},
+		{"maxFragmentDualSrcAttachments", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxFragmentDualSrcAttachments)},
+		{"maxFragmentCombinedOutputResources", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxFragmentCombinedOutputResources)},
+		{"maxComputeSharedMemorySize", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxComputeSharedMemorySize)},
+		{"maxComputeWorkGroupCount", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxComputeWorkGroupCount)},
+		{"maxComputeWorkGroupInvocations", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxComputeWorkGroupInvocations)},
+		{"maxComputeWorkGroupSize", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxComputeWorkGroupSize)},
+		{"subPixelPrecisionBits", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).SubPixelPrecisionBits)},
+		{"subTexelPrecisionBits", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).SubTexelPrecisionBits)},
+		{"mipmapPrecisionBits", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MipmapPrecisionBits)},
+		{"maxDrawIndexedIndexValue", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxDrawIndexedIndexValue)},
+		{"maxDrawIndirectCount", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxDrawIndirectCount)},
+		{"maxSamplerLodBias", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxSamplerLodBias)},
+		{"maxSamplerAnisotropy", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxSamplerAnisotropy)},
+		{"maxViewports", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxViewports)},
+		{"maxViewportDimensions", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxViewportDimensions)},
+		{"viewportBoundsRange", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).ViewportBoundsRange)},
+		{"viewportSubPixelBits", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).ViewportSubPixelBits)},
+		{"minMemoryMapAlignment", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MinMemoryMapAlignment)},
+		{"minTexelBufferOffsetAlignment", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MinTexelBufferOffsetAlignment)},
+		{"minUniformBufferOffsetAlignment", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MinUniformBufferOffsetAlignment)},
+		{"minStorageBufferOffsetAlignment", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MinStorageBufferOffsetAlignment)},
+		{"minTexelOffset", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MinTexelOffset)},
+		{"maxTexelOffset", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxTexelOffset)},
+		{"minTexelGatherOffset", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MinTexelGatherOffset)},
+		{"maxTexelGatherOffset", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxTexelGatherOffset)},
+		{"minInterpolationOffset", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MinInterpolationOffset)},
+		{"maxInterpolationOffset", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxInterpolationOffset)},
+		{"subPixelInterpolationOffsetBits", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).SubPixelInterpolationOffsetBits)},
+		{"maxFramebufferWidth", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxFramebufferWidth)},
+		{"maxFramebufferHeight", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxFramebufferHeight)},
+		{"maxFramebufferLayers", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxFramebufferLayers)},
+		{"framebufferColorSampleCounts", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).FramebufferColorSampleCounts)},
+		{"framebufferDepthSampleCounts", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).FramebufferDepthSampleCounts)},
+		{"framebufferStencilSampleCounts", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).FramebufferStencilSampleCounts)},
+		{"framebufferNoAttachmentsSampleCounts", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).FramebufferNoAttachmentsSampleCounts)},
+		{"maxColorAttachments", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxColorAttachments)},
+		{"sampledImageColorSampleCounts", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).SampledImageColorSampleCounts)},
+		{"sampledImageIntegerSampleCounts", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).SampledImageIntegerSampleCounts)},
+		{"sampledImageDepthSampleCounts", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).SampledImageDepthSampleCounts)},
+		{"sampledImageStencilSampleCounts", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).SampledImageStencilSampleCounts)},
+		{"storageImageSampleCounts", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).StorageImageSampleCounts)},
+		{"maxSampleMaskWords", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxSampleMaskWords)},
+		{"timestampComputeAndGraphics", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).TimestampComputeAndGraphics)},
+		{"timestampPeriod", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).TimestampPeriod)},
+		{"maxClipDistances", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxClipDistances)},
+		{"maxCullDistances", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxCullDistances)},
+		{"maxCombinedClipAndCullDistances", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).MaxCombinedClipAndCullDistances)},
+		{"discreteQueuePriorities", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).DiscreteQueuePriorities)},
+		{"pointSizeRange", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).PointSizeRange)},
+		{"lineWidthRange", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).LineWidthRange)},
+		{"pointSizeGranularity", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).PointSizeGranularity)},
+		{"lineWidthGranularity", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).LineWidthGranularity)},
+		{"strictLines", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).StrictLines)},
+		{"standardSampleLocations", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).StandardSampleLocations)},
+		{"optimalBufferCopyOffsetAlignment", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).OptimalBufferCopyOffsetAlignment)},
+		{"optimalBufferCopyRowPitchAlignment", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).OptimalBufferCopyRowPitchAlignment)},
+		{"nonCoherentAtomSize", unsafe.Offsetof((*PhysicalDeviceLimits)(nil).NonCoherentAtomSize)},
+	}},
+	{"VkPhysicalDeviceMemoryProperties", unsafe.Sizeof(*(*PhysicalDeviceMemoryProperties)(nil)), unsafe.Alignof(*(*PhysicalDeviceMemoryProperties)(nil)), []memberOffset{
+		{"memoryTypeCount", unsafe.Offsetof((*PhysicalDeviceMemoryProperties)(nil).MemoryTypeCount)},
+		{"memoryTypes", unsafe.Offsetof((*PhysicalDeviceMemoryProperties)(nil).MemoryTypes)},
+		{"memoryHeapCount", unsafe.Offsetof((*PhysicalDeviceMemoryProperties)(nil).MemoryHeapCount)},
+		{"memoryHeaps", unsafe.Offsetof((*PhysicalDeviceMemoryProperties)(nil).MemoryHeaps)},
+	}},
+	{"VkPhysicalDeviceSparseProperties", unsafe.Sizeof(*(*PhysicalDeviceSparseProperties)(nil)), unsafe.Alignof(*(*PhysicalDeviceSparseProperties)(nil)), []memberOffset{
+		{"residencyStandard2DBlockShape", unsafe.Offsetof((*PhysicalDeviceSparseProperties)(nil).ResidencyStandard2DBlockShape)},
+		{"residencyStandard2DMultisampleBlockShape", unsafe.Offsetof((*PhysicalDeviceSparseProperties)(nil).ResidencyStandard2DMultisampleBlockShape)},
+		{"residencyStandard3DBlockShape", unsafe.Offsetof((*PhysicalDeviceSparseProperties)(nil).ResidencyStandard3DBlockShape)},
+		{"residencyAlignedMipSize", unsafe.Offsetof((*PhysicalDeviceSparseProperties)(nil).ResidencyAlignedMipSize)},
+		{"residencyNonResidentStrict", unsafe.Offsetof((*PhysicalDeviceSparseProperties)(nil).ResidencyNonResidentStrict)},
+	}},
+	{"VkPhysicalDeviceProperties", unsafe.Sizeof(*(*PhysicalDeviceProperties)(nil)), unsafe.Alignof(*(*PhysicalDeviceProperties)(nil)), []memberOffset{
+		{"apiVersion", unsafe.Offsetof((*PhysicalDeviceProperties)(nil).ApiVersion)},
+		{"driverVersion", unsafe.Offsetof((*PhysicalDeviceProperties)(nil).DriverVersion)},
+		{"vendorID", unsafe.Offsetof((*PhysicalDeviceProperties)(nil).VendorID)},
+		{"deviceID", unsafe.Offsetof((*PhysicalDeviceProperties)(nil).DeviceID)},
+		{"deviceType", unsafe.Offsetof((*PhysicalDeviceProperties)(nil).DeviceType)},
+		{"deviceName", unsafe.Offsetof((*PhysicalDeviceProperties)(nil).DeviceName)},
+		{"pipelineCacheUUID", unsafe.Offsetof((*PhysicalDeviceProperties)(nil).PipelineCacheUUID)},
+		{"limits", unsafe.Offsetof((*PhysicalDeviceProperties)(nil).Limits)},
+		{"sparseProperties", unsafe.Offsetof((*PhysicalDeviceProperties)(nil).SparseProperties)},
+	}},
+	{"VkQueueFamilyProperties", unsafe.Sizeof(*(*QueueFamilyProperties)(nil)), unsafe.Alignof(*(*QueueFamilyProperties)(nil)), []memberOffset{
+		{"queueFlags", unsafe.Offsetof((*QueueFamilyProperties)(nil).QueueFlags)},
+		{"queueCount", unsafe.Offsetof((*QueueFamilyProperties)(nil).QueueCount)},
+		{"timestampValidBits", unsafe.Offsetof((*QueueFamilyProperties)(nil).TimestampValidBits)},
+		{"minImageTransferGranularity", unsafe.Offsetof((*QueueFamilyProperties)(nil).MinImageTransferGranularity)},
+	}},
+	{"VkDeviceQueueCreateInfo", unsafe.Sizeof(*(*DeviceQueueCreateInfo)(nil)), unsafe.Alignof(*(*DeviceQueueCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DeviceQueueCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DeviceQueueCreateInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*DeviceQueueCreateInfo)(nil).Flags)},
+		{"queueFamilyIndex", unsafe.Offsetof((*DeviceQueueCreateInfo)(nil).QueueFamilyIndex)},
+		{"queueCount", unsafe.Offsetof((*DeviceQueueCreateInfo)(nil).QueueCount)},
+		{"pQueuePriorities", unsafe.Offsetof((*DeviceQueueCreateInfo)(nil).PQueuePriorities)},
+	}},
+	{"VkDeviceCreateInfo", unsafe.Sizeof(*(*DeviceCreateInfo)(nil)), unsafe.Alignof(*(*DeviceCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DeviceCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DeviceCreateInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*DeviceCreateInfo)(nil).Flags)},
+		{"queueCreateInfoCount", unsafe.Offsetof((*DeviceCreateInfo)(nil).QueueCreateInfoCount)},
+		{"pQueueCreateInfos", unsafe.Offsetof((*DeviceCreateInfo)(nil).PQueueCreateInfos)},
+		{"enabledLayerCount", unsafe.Offsetof((*DeviceCreateInfo)(nil).EnabledLayerCount)},
+		{"ppEnabledLayerNames", unsafe.Offsetof((*DeviceCreateInfo)(nil).PpEnabledLayerNames)},
+		{"enabledExtensionCount", unsafe.Offsetof((*DeviceCreateInfo)(nil).EnabledExtensionCount)},
+		{"ppEnabledExtensionNames", unsafe.Offsetof((*DeviceCreateInfo)(nil).PpEnabledExtensionNames)},
+		{"pEnabledFeatures", unsafe.Offsetof((*DeviceCreateInfo)(nil).PEnabledFeatures)},
+	}},
+	{"VkExtensionProperties", unsafe.Sizeof(*(*ExtensionProperties)(nil)), unsafe.Alignof(*(*ExtensionProperties)(nil)), []memberOffset{
+		{"extensionName", unsafe.Offsetof((*ExtensionProperties)(nil).ExtensionName)},
+		{"specVersion", unsafe.Offsetof((*ExtensionProperties)(nil).SpecVersion)},
+	}},
+	{"VkLayerProperties", unsafe.Sizeof(*(*LayerProperties)(nil)), unsafe.Alignof(*(*LayerProperties)(nil)), []memberOffset{
+		{"layerName", unsafe.Offsetof((*LayerProperties)(nil).LayerName)},
+		{"specVersion", unsafe.Offsetof((*LayerProperties)(nil).SpecVersion)},
+		{"implementationVersion", unsafe.Offsetof((*LayerProperties)(nil).ImplementationVersion)},
+		{"description", unsafe.Offsetof((*LayerProperties)(nil).Description)},
+	}},
+	{"VkSubmitInfo", unsafe.Sizeof(*(*SubmitInfo)(nil)), unsafe.Alignof(*(*SubmitInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SubmitInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SubmitInfo)(nil).PNext)},
+		{"waitSemaphoreCount", unsafe.Offsetof((*SubmitInfo)(nil).WaitSemaphoreCount)},
+		{"pWaitSemaphores", unsafe.Offsetof((*SubmitInfo)(nil).PWaitSemaphores)},
+		{"pWaitDstStageMask", unsafe.Offsetof((*SubmitInfo)(nil).PWaitDstStageMask)},
+		{"commandBufferCount", unsafe.Offsetof((*SubmitInfo)(nil).CommandBufferCount)},
+		{"pCommandBuffers", unsafe.Offsetof((*SubmitInfo)(nil).PCommandBuffers)},
+		{"signalSemaphoreCount", unsafe.Offsetof((*SubmitInfo)(nil).SignalSemaphoreCount)},
+		{"pSignalSemaphores", unsafe.Offsetof((*SubmitInfo)(nil).PSignalSemaphores)},
+	}},
+	{"VkMappedMemoryRange", unsafe.Sizeof(*(*MappedMemoryRange)(nil)), unsafe.Alignof(*(*MappedMemoryRange)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*MappedMemoryRange)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*MappedMemoryRange)(nil).PNext)},
+		{"memory", unsafe.Offsetof((*MappedMemoryRange)(nil).Memory)},
+		{"offset", unsafe.Offsetof((*MappedMemoryRange)(nil).Offset)},
+		{"size", unsafe.Offsetof((*MappedMemoryRange)(nil).Size)},
+	}},
+	{"VkMemoryAllocateInfo", unsafe.Sizeof(*(*MemoryAllocateInfo)(nil)), unsafe.Alignof(*(*MemoryAllocateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*MemoryAllocateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*MemoryAllocateInfo)(nil).PNext)},
+		{"allocationSize", unsafe.Offsetof((*MemoryAllocateInfo)(nil).AllocationSize)},
+		{"memoryTypeIndex", unsafe.Offsetof((*MemoryAllocateInfo)(nil).MemoryTypeIndex)},
+	}},
+	{"VkMemoryRequirements", unsafe.Sizeof(*(*MemoryRequirements)(nil)), unsafe.Alignof(*(*MemoryRequirements)(nil)), []memberOffset{
+		{"size", unsafe.Offsetof((*MemoryRequirements)(nil).Size)},
+		{"alignment", unsafe.Offsetof((*MemoryRequirements)(nil).Alignment)},
+		{"memoryTypeBits", unsafe.Offsetof((*MemoryRequirements)(nil).MemoryTypeBits)},
+	}},
+	{"VkSparseMemoryBind", unsafe.Sizeof(*(*SparseMemoryBind)(nil)), unsafe.Alignof(*(*SparseMemoryBind)(nil)), []memberOffset{
+		{"resourceOffset", unsafe.Offsetof((*SparseMemoryBind)(nil).ResourceOffset)},
+		{"size", unsafe.Offsetof((*SparseMemoryBind)(nil).Size)},
+		{"memory", unsafe.Offsetof((*SparseMemoryBind)(nil).Memory)},
+		{"memoryOffset", unsafe.Offsetof((*SparseMemoryBind)(nil).MemoryOffset)},
+		{"flags", unsafe.Offsetof((*SparseMemoryBind)(nil).Flags)},
+	}},
+	{"VkSparseBufferMemoryBindInfo", unsafe.Sizeof(*(*SparseBufferMemoryBindInfo)(nil)), unsafe.Alignof(*(*SparseBufferMemoryBindInfo)(nil)), []memberOffset{
+		{"buffer", unsafe.Offsetof((*SparseBufferMemoryBindInfo)(nil).Buffer)},
+		{"bindCount", unsafe.Offsetof((*SparseBufferMemoryBindInfo)(nil).BindCount)},
+		{"pBinds", unsafe.Offsetof((*SparseBufferMemoryBindInfo)(nil).PBinds)},
+	}},
+	{"VkSparseImageOpaqueMemoryBindInfo", unsafe.Sizeof(*(*SparseImageOpaqueMemoryBindInfo)(nil)), unsafe.Alignof(*(*SparseImageOpaqueMemoryBindInfo)(nil)), []memberOffset{
+		{"image", unsafe.Offsetof((*SparseImageOpaqueMemoryBindInfo)(nil).Image)},
+		{"bindCount", unsafe.Offsetof((*SparseImageOpaqueMemoryBindInfo)(nil).BindCount)},
+		{"pBinds", unsafe.Offsetof((*SparseImageOpaqueMemoryBindInfo)(nil).PBinds)},
+	}},
+	{"VkImageSubresource", unsafe.Sizeof(*(*ImageSubresource)(nil)), unsafe.Alignof(*(*ImageSubresource)(nil)), []memberOffset{
+		{"aspectMask", unsafe.Offsetof((*ImageSubresource)(nil).AspectMask)},
+		{"mipLevel", unsafe.Offsetof((*ImageSubresource)(nil).MipLevel)},
+		{"arrayLayer", unsafe.Offsetof((*ImageSubresource)(nil).ArrayLayer)},
+	}},
+	{"VkSparseImageMemoryBind", unsafe.Sizeof(*(*SparseImageMemoryBind)(nil)), unsafe.Alignof(*(*SparseImageMemoryBind)(nil)), []memberOffset{
+		{"subresource", unsafe.Offsetof((*SparseImageMemoryBind)(nil).Subresource)},
+		{"offset", unsafe.Offsetof((*SparseImageMemoryBind)(nil).Offset)},
+		{"extent", unsafe.Offsetof((*SparseImageMemoryBind)(nil).Extent)},
+		{"memory", unsafe.Offsetof((*SparseImageMemoryBind)(nil).Memory)},
+		{"memoryOffset", unsafe.Offsetof((*SparseImageMemoryBind)(nil).MemoryOffset)},
+		{"flags", unsafe.Offsetof((*SparseImageMemoryBind)(nil).Flags)},
+	}},
+	{"VkSparseImageMemoryBindInfo", unsafe.Sizeof(*(*SparseImageMemoryBindInfo)(nil)), unsafe.Alignof(*(*SparseImageMemoryBindInfo)(nil)), []memberOffset{
+		{"image", unsafe.Offsetof((*SparseImageMemoryBindInfo)(nil).Image)},
+		{"bindCount", unsafe.Offsetof((*SparseImageMemoryBindInfo)(nil).BindCount)},
+		{"pBinds", unsafe.Offsetof((*SparseImageMemoryBindInfo)(nil).PBinds)},
+	}},
+	{"VkBindSparseInfo", unsafe.Sizeof(*(*BindSparseInfo)(nil)), unsafe.Alignof(*(*BindSparseInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*BindSparseInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*BindSparseInfo)(nil).PNext)},
+		{"waitSemaphoreCount", unsafe.Offsetof((*BindSparseInfo)(nil).WaitSemaphoreCount)},
+		{"pWaitSemaphores", unsafe.Offsetof((*BindSparseInfo)(nil).PWaitSemaphores)},
+		{"bufferBindCount", unsafe.Offsetof((*BindSparseInfo)(nil).BufferBindCount)},
+		{"pBufferBinds", unsafe.Offsetof((*BindSparseInfo)(nil).PBufferBinds)},
+		{"imageOpaqueBindCount", unsafe.Offsetof((*BindSparseInfo)(nil).ImageOpaqueBindCount)},
+		{"pImageOpaqueBinds", unsafe.Offsetof((*BindSparseInfo)(nil).PImageOpaqueBinds)},
+		{"imageBindCount", unsafe.Offsetof((*BindSparseInfo)(nil).ImageBindCount)},
+		{"pImageBinds", unsafe.Offsetof((*BindSparseInfo)(nil).PImageBinds)},
+		{"signalSemaphoreCount", unsafe.Offsetof((*BindSparseInfo)(nil).SignalSemaphoreCount)},
+		{"pSignalSemaphores", unsafe.Offsetof((*BindSparseInfo)(nil).PSignalSemaphores)},
+	}},
+	{"VkSparseImageFormatProperties", unsafe.Sizeof(*(*SparseImageFormatProperties)(nil)), unsafe.Alignof(*(*SparseImageFormatProperties)(nil)), []memberOffset{
+		{"aspectMask", unsafe.Offsetof((*SparseImageFormatProperties)(nil).AspectMask)},
+		{"imageGranularity", unsafe.Offsetof((*SparseImageFormatProperties)(nil).ImageGranularity)},
+		{"flags", unsafe.Offsetof((*SparseImageFormatProperties)(nil).Flags)},
+	}},
+	{"VkSparseImageMemoryRequirements", unsafe.Sizeof(*(*SparseImageMemoryRequirements)(nil)), unsafe.Alignof(*(*SparseImageMemoryRequirements)(nil)), []memberOffset{
+		{"formatProperties", unsafe.Offsetof((*SparseImageMemoryRequirements)(nil).FormatProperties)},
+		{"imageMipTailFirstLod", unsafe.Offsetof((*SparseImageMemoryRequirements)(nil).ImageMipTailFirstLod)},
+		{"imageMipTailSize", unsafe.Offsetof((*SparseImageMemoryRequirements)(nil).ImageMipTailSize)},
+		{"imageMipTailOffset", unsafe.Offsetof((*SparseImageMemoryRequirements)(nil).ImageMipTailOffset)},
+		{"imageMipTailStride", unsafe.Offsetof((*SparseImageMemoryRequirements)(nil).ImageMipTailStride)},
+	}},
+	{"VkFenceCreateInfo", unsafe.Sizeof(*(*FenceCreateInfo)(nil)), unsafe.Alignof(*(*FenceCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*FenceCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*FenceCreateInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*FenceCreateInfo)(nil).Flags)},
+	}},
+	{"VkSemaphoreCreateInfo", unsafe.Sizeof(*(*SemaphoreCreateInfo)(nil)), unsafe.Alignof(*(*SemaphoreCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SemaphoreCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SemaphoreCreateInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*SemaphoreCreateInfo)(nil).Flags)},
+	}},
+	{"VkEventCreateInfo", unsafe.Sizeof(*(*EventCreateInfo)(nil)), unsafe.Alignof(*(*EventCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*EventCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*EventCreateInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*EventCreateInfo)(nil).Flags)},
+	}},
+	{"VkQueryPoolCreateInfo", unsafe.Sizeof(*(*QueryPoolCreateInfo)(nil)), unsafe.Alignof(*(*QueryPoolCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*QueryPoolCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*QueryPoolCreateInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*QueryPoolCreateInfo)(nil).Flags)},
+		{"queryType", unsafe.Offsetof((*QueryPoolCreateInfo)(nil).QueryType)},
+		{"queryCount", unsafe.Offsetof((*QueryPoolCreateInfo)(nil).QueryCount)},
+		{"pipelineStatistics", unsafe.Offsetof((*QueryPoolCreateInfo)(nil).PipelineStatistics)},
+	}},
+	{"VkBufferCreateInfo", unsafe.Sizeof(*(*BufferCreateInfo)(nil)), unsafe.Alignof(*(*BufferCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*BufferCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*BufferCreateInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*BufferCreateInfo)(nil).Flags)},
+		{"size", unsafe.Offsetof((*BufferCreateInfo)(nil).Size)},
+		{"usage", unsafe.Offsetof((*BufferCreateInfo)(nil).Usage)},
+		{"sharingMode", unsafe.Offsetof((*BufferCreateInfo)(nil).SharingMode)},
+		{"queueFamilyIndexCount", unsafe.Offsetof((*BufferCreateInfo)(nil).QueueFamilyIndexCount)},
+		{"pQueueFamilyIndices", unsafe.Offsetof((*BufferCreateInfo)(nil).PQueueFamilyIndices)},
+	}},
+	{"VkBufferViewCreateInfo", unsafe.Sizeof(*(*BufferViewCreateInfo)(nil)), unsafe.Alignof(*(*BufferViewCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*BufferViewCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*BufferViewCreateInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*BufferViewCreateInfo)(nil).Flags)},
+		{"buffer", unsafe.Offsetof((*BufferViewCreateInfo)(nil).Buffer)},
+		{"format", unsafe.Offsetof((*BufferViewCreateInfo)(nil).Format)},
+		{"offset", unsafe.Offsetof((*BufferViewCreateInfo)(nil).Offset)},
+		{"range", unsafe.Offsetof((*BufferViewCreateInfo)(nil).Range)},
+	}},
+	{"VkImageCreateInfo", unsafe.Sizeof(*(*ImageCreateInfo)(nil)), unsafe.Alignof(*(*ImageCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ImageCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ImageCreateInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*ImageCreateInfo)(nil).Flags)},
+		{"imageType", unsafe.Offsetof((*ImageCreateInfo)(nil).ImageType)},
+		{"format", unsafe.Offsetof((*ImageCreateInfo)(nil).Format)},
+		{"extent", unsafe.Offsetof((*ImageCreateInfo)(nil).Extent)},
+		{"mipLevels", unsafe.Offsetof((*ImageCreateInfo)(nil).MipLevels)},
+		{"arrayLayers", unsafe.Offsetof((*ImageCreateInfo)(nil).ArrayLayers)},
+		{"samples", unsafe.Offsetof((*ImageCreateInfo)(nil).Samples)},
+		{"tiling", unsafe.Offsetof((*ImageCreateInfo)(nil).Tiling)},
+		{"usage", unsafe.Offsetof((*ImageCreateInfo)(nil).Usage)},
+		{"sharingMode", unsafe.Offsetof((*ImageCreateInfo)(nil).SharingMode)},
+		{"queueFamilyIndexCount", unsafe.Offsetof((*ImageCreateInfo)(nil).QueueFamilyIndexCount)},
+		{"pQueueFamilyIndices", unsafe.Offsetof((*ImageCreateInfo)(nil).PQueueFamilyIndices)},
+		{"initialLayout", unsafe.Offsetof((*ImageCreateInfo)(nil).InitialLayout)},
+	}},
+	{"VkSubresourceLayout", unsafe.Sizeof(*(*SubresourceLayout)(nil)), unsafe.Alignof(*(*SubresourceLayout)(nil)), []memberOffset{
+		{"offset", unsafe.Offsetof((*SubresourceLayout)(nil).Offset)},
+		{"size", unsafe.Offsetof((*SubresourceLayout)(nil).Size)},
+		{"rowPitch", unsafe.Offsetof((*SubresourceLayout)(nil).RowPitch)},
+		{"arrayPitch", unsafe.Offsetof((*SubresourceLayout)(nil).ArrayPitch)},
+		{"depthPitch", unsafe.Offsetof((*SubresourceLayout)(nil).DepthPitch)},
+	}},
+	{"VkComponentMapping", unsafe.Sizeof(*(*ComponentMapping)(nil)), unsafe.Alignof(*(*ComponentMapping)(nil)), []memberOffset{
+		{"r", unsafe.Offsetof((*ComponentMapping)(nil).R)},
+		{"g", unsafe.Offsetof((*ComponentMapping)(nil).G)},
+		{"b", unsafe.Offsetof((*ComponentMapping)(nil).B)},
+		{"a", unsafe.Offsetof((*ComponentMapping)(nil).A)},
+	}},
+	{"VkImageViewCreateInfo", unsafe.Sizeof(*(*ImageViewCreateInfo)(nil)), unsafe.Alignof(*(*ImageViewCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ImageViewCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ImageViewCreateInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*ImageViewCreateInfo)(nil).Flags)},
+		{"image", unsafe.Offsetof((*ImageViewCreateInfo)(nil).Image)},
+		{"viewType", unsafe.Offsetof((*ImageViewCreateInfo)(nil).ViewType)},
+		{"format", unsafe.Offsetof((*ImageViewCreateInfo)(nil).Format)},
+		{"components", unsafe.Offsetof((*ImageViewCreateInfo)(nil).Components)},
+		{"subresourceRange", unsafe.Offsetof((*ImageViewCreateInfo)(nil).SubresourceRange)},
+	}},
+	{"VkShaderModuleCreateInfo", unsafe.Sizeof(*(*ShaderModuleCreateInfo)(nil)), unsafe.Alignof(*(*ShaderModuleCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ShaderModuleCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ShaderModuleCreateInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*ShaderModuleCreateInfo)(nil).Flags)},
+		{"codeSize", unsafe.Offsetof((*ShaderModuleCreateInfo)(nil).CodeSize)},
+		{"pCode", unsafe.Offsetof((*ShaderModuleCreateInfo)(nil).PCode)},
+	}},
+	{"VkPipelineCacheCreateInfo", unsafe.Sizeof(*(*PipelineCacheCreateInfo)(nil)), unsafe.Alignof(*(*PipelineCacheCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineCacheCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineCacheCreateInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*PipelineCacheCreateInfo)(nil).Flags)},
+		{"initialDataSize", unsafe.Offsetof((*PipelineCacheCreateInfo)(nil).InitialDataSize)},
+		{"pInitialData", unsafe.Offsetof((*PipelineCacheCreateInfo)(nil).PInitialData)},
+	}},
+	{"VkSpecializationMapEntry", unsafe.Sizeof(*(*SpecializationMapEntry)(nil)), unsafe.Alignof(*(*SpecializationMapEntry)(nil)), []memberOffset{
+		{"constantID", unsafe.Offsetof((*SpecializationMapEntry)(nil).ConstantID)},
+		{"offset", unsafe.Offsetof((*SpecializationMapEntry)(nil).Offset)},
+		{"size", unsafe.Offsetof((*SpecializationMapEntry)(nil).Size)},
+	}},
+	{"VkSpecializationInfo", unsafe.Sizeof(*(*SpecializationInfo)(nil)), unsafe.Alignof(*(*SpecializationInfo)(nil)), []memberOffset{
+		{"mapEntryCount", unsafe.Offsetof((*SpecializationInfo)(nil).MapEntryCount)},
+		{"pMapEntries", unsafe.Offsetof((*SpecializationInfo)(nil).PMapEntries)},
+		{"dataSize", unsafe.Offsetof((*SpecializationInfo)(nil).DataSize)},
+		{"pData", unsafe.Offsetof((*SpecializationInfo)(nil).PData)},
+	}},
+	{"VkPipelineShaderStageCreateInfo", unsafe.Sizeof(*(*PipelineShaderStageCreateInfo)(nil)), unsafe.Alignof(*(*PipelineShaderStageCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineShaderStageCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineShaderStageCreateInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*PipelineShaderStageCreateInfo)(nil).Flags)},
+		{"stage", unsafe.Offsetof((*PipelineShaderStageCreateInfo)(nil).Stage)},
+		{"module", unsafe.Offsetof((*PipelineShaderStageCreateInfo)(nil).Module)},
+		{"pName", unsafe.Offsetof((*PipelineShaderStageCreateInfo)(nil).PName)},
+		{"pSpecializationInfo", unsafe.Offsetof((*PipelineShaderStageCreateInfo)(nil).PSpecializationInfo)},
+	}},
+	{"VkComputePipelineCreateInfo", unsafe.Sizeof(*(*ComputePipelineCreateInfo)(nil)), unsafe.Alignof(*(*ComputePipelineCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ComputePipelineCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ComputePipelineCreateInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*ComputePipelineCreateInfo)(nil).Flags)},
+		{"stage", unsafe.Offsetof((*ComputePipelineCreateInfo)(nil).Stage)},
+		{"layout", unsafe.Offsetof((*ComputePipelineCreateInfo)(nil).Layout)},
+		{"basePipelineHandle", unsafe.Offsetof((*ComputePipelineCreateInfo)(nil).BasePipelineHandle)},
+		{"basePipelineIndex", unsafe.Offsetof((*ComputePipelineCreateInfo)(nil).BasePipelineIndex)},
+	}},
+	{"VkVertexInputBindingDescription", unsafe.Sizeof(*(*VertexInputBindingDescription)(nil)), unsafe.Alignof(*(*VertexInputBindingDescription)(nil)), []memberOffset{
+		{"binding", unsafe.Offsetof((*VertexInputBindingDescription)(nil).Binding)},
+		{"stride", unsafe.Offsetof((*VertexInputBindingDescription)(nil).Stride)},
+		{"inputRate", unsafe.Offsetof((*VertexInputBindingDescription)(nil).InputRate)},
+	}},
+	{"VkVertexInputAttributeDescription", unsafe.Sizeof(*(*VertexInputAttributeDescription)(nil)), unsafe.Alignof(*(*VertexInputAttributeDescription)(nil)), []memberOffset{
+		{"location", unsafe.Offsetof((*VertexInputAttributeDescription)(nil).Location)},
+		{"binding", unsafe.Offsetof((*VertexInputAttributeDescription)(nil).Binding)},
+		{"format", unsafe.Offsetof((*VertexInputAttributeDescription)(nil).Format)},
+		{"offset", unsafe.Offsetof((*VertexInputAttributeDescription)(nil).Offset)},
+	}},
+	{"VkPipelineVertexInputStateCreateInfo", unsafe.Sizeof(*(*PipelineVertexInputStateCreateInfo)(nil)), unsafe.Alignof(*(*PipelineVertexInputStateCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineVertexInputStateCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineVertexInputStateCreateInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*PipelineVertexInputStateCreateInfo)(nil).Flags)},
+		{"vertexBindingDescriptionCount", unsafe.Offsetof((*PipelineVertexInputStateCreateInfo)(nil).VertexBindingDescriptionCount)},
+		{"pVertexBindingDescriptions", unsafe.Offsetof((*PipelineVertexInputStateCreateInfo)(nil).PVertexBindingDescriptions)},
+		{"vertexAttributeDescriptionCount", unsafe.Offsetof((*PipelineVertexInputStateCreateInfo)(nil).VertexAttributeDescriptionCount)},
+		{"pVertexAttributeDescriptions", unsafe.Offsetof((*PipelineVertexInputStateCreateInfo)(nil).PVertexAttributeDescriptions)},
+	}},
+	{"VkPipelineInputAssemblyStateCreateInfo", unsafe.Sizeof(*(*PipelineInputAssemblyStateCreateInfo)(nil)), unsafe.Alignof(*(*PipelineInputAssemblyStateCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineInputAssemblyStateCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineInputAssemblyStateCreateInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*PipelineInputAssemblyStateCreateInfo)(nil).Flags)},
+		{"topology", unsafe.Offsetof((*PipelineInputAssemblyStateCreateInfo)(nil).Topology)},
+		{"primitiveRestartEnable", unsafe.Offsetof((*PipelineInputAssemblyStateCreateInfo)(nil).PrimitiveRestartEnable)},
+	}},
+	{"VkPipelineTessellationStateCreateInfo", unsafe.Sizeof(*(*PipelineTessellationStateCreateInfo)(nil)), unsafe.Alignof(*(*PipelineTessellationStateCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineTessellationStateCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineTessellationStateCreateInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*PipelineTessellationStateCreateInfo)(nil).Flags)},
+		{"patchControlPoints", unsafe.Offsetof((*PipelineTessellationStateCreateInfo)(nil).PatchControlPoints)},
+	}},
+	{"VkViewport", unsafe.Sizeof(*(*Viewport)(nil)), unsafe.Alignof(*(*Viewport)(nil)), []memberOffset{
+		{"x", unsafe.Offsetof((*Viewport)(nil).X)},
+		{"y", unsafe.Offsetof((*Viewport)(nil).Y)},
+		{"width", unsafe.Offsetof((*Viewport)(nil).Width)},
+		{"height", unsafe.Offsetof((*Viewport)(nil).Height)},
+		{"minDepth", unsafe.Offsetof((*Viewport)(nil).MinDepth)},
+		{"maxDepth", unsafe.Offsetof((*Viewport)(nil).MaxDepth)},
+	}},
+	{"VkPipelineViewportStateCreateInfo", unsafe.Sizeof(*(*PipelineViewportStateCreateInfo)(nil)), unsafe.Alignof(*(*PipelineViewportStateCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineViewportStateCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineViewportStateCreateInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*PipelineViewportStateCreateInfo)(nil).Flags)},
+		{"viewportCount", unsafe.Offsetof((*PipelineViewportStateCreateInfo)(nil).ViewportCount)},
+		{"pViewports", unsafe.Offsetof((*PipelineViewportStateCreateInfo)(nil).PViewports)},
+		{"scissorCount", unsafe.Offsetof((*PipelineViewportStateCreateInfo)(nil).ScissorCount)},
+		{"pScissors", unsafe.Offsetof((*PipelineViewportStateCreateInfo)(nil).PScissors)},
+	}},
+	{"VkPipelineRasterizationStateCreateInfo", unsafe.Sizeof(*(*PipelineRasterizationStateCreateInfo)(nil)), unsafe.Alignof(*(*PipelineRasterizationStateCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineRasterizationStateCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineRasterizationStateCreateInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*PipelineRasterizationStateCreateInfo)(nil).Flags)},
+		{"depthClampEnable", unsafe.Offsetof((*PipelineRasterizationStateCreateInfo)(nil).DepthClampEnable)},
+		{"rasterizerDiscardEnable", unsafe.Offsetof((*PipelineRasterizationStateCreateInfo)(nil).RasterizerDiscardEnable)},
+		{"polygonMode", unsafe.Offsetof((*PipelineRasterizationStateCreateInfo)(nil).PolygonMode)},
+		{"cullMode", unsafe.Offsetof((*PipelineRasterizationStateCreateInfo)(nil).CullMode)},
+		{"frontFace", unsafe.Offsetof((*PipelineRasterizationStateCreateInfo)(nil).FrontFace)},
+		{"depthBiasEnable", unsafe.Offsetof((*PipelineRasterizationStateCreateInfo)(nil).DepthBiasEnable)},
+		{"depthBiasConstantFactor", unsafe.Offsetof((*PipelineRasterizationStateCreateInfo)(nil).DepthBiasConstantFactor)},
+		{"depthBiasClamp", unsafe.Offsetof((*PipelineRasterizationStateCreateInfo)(nil).DepthBiasClamp)},
+		{"depthBiasSlopeFactor", unsafe.Offsetof((*PipelineRasterizationStateCreateInfo)(nil).DepthBiasSlopeFactor)},
+		{"lineWidth", unsafe.Offsetof((*PipelineRasterizationStateCreateInfo)(nil).LineWidth)},
+	}},
+	{"VkPipelineMultisampleStateCreateInfo", unsafe.Sizeof(*(*PipelineMultisampleStateCreateInfo)(nil)), unsafe.Alignof(*(*PipelineMultisampleStateCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineMultisampleStateCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineMultisampleStateCreateInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*PipelineMultisampleStateCreateInfo)(nil).Flags)},
+		{"rasterizationSamples", unsafe.Offsetof((*PipelineMultisampleStateCreateInfo)(nil).RasterizationSamples)},
+		{"sampleShadingEnable", unsafe.Offsetof((*PipelineMultisampleStateCreateInfo)(nil).SampleShadingEnable)},
+		{"minSampleShading", unsafe.Offsetof((*PipelineMultisampleStateCreateInfo)(nil).MinSampleShading)},
+		{"pSampleMask", unsafe.Offsetof((*PipelineMultisampleStateCreateInfo)(nil).PSampleMask)},
+		{"alphaToCoverageEnable", unsafe.Offsetof((*PipelineMultisampleStateCreateInfo)(nil).AlphaToCoverageEnable)},
+		{"alphaToOneEnable", unsafe.Offsetof((*PipelineMultisampleStateCreateInfo)(nil).AlphaToOneEnable)},
+	}},
+	{"VkStencilOpState", unsafe.Sizeof(*(*StencilOpState)(nil)), unsafe.Alignof(*(*StencilOpState)(nil)), []memberOffset{
+		{"failOp", unsafe.Offsetof((*StencilOpState)(nil).FailOp)},
+		{"passOp", unsafe.Offsetof((*StencilOpState)(nil).PassOp)},
+		{"depthFailOp", unsafe.Offsetof((*StencilOpState)(nil).DepthFailOp)},
+		{"compareOp", unsafe.Offsetof((*StencilOpState)(nil).CompareOp)},
+		{"compareMask", unsafe.Offsetof((*StencilOpState)(nil).CompareMask)},
+		{"writeMask", unsafe.Offsetof((*StencilOpState)(nil).WriteMask)},
+		{"reference", unsafe.Offsetof((*StencilOpState)(nil).Reference)},
+	}},
+	{"VkPipelineDepthStencilStateCreateInfo", unsafe.Sizeof(*(*PipelineDepthStencilStateCreateInfo)(nil)), unsafe.Alignof(*(*PipelineDepthStencilStateCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineDepthStencilStateCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineDepthStencilStateCreateInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*PipelineDepthStencilStateCreateInfo)(nil).Flags)},
+		{"depthTestEnable", unsafe.Offsetof((*PipelineDepthStencilStateCreateInfo)(nil).DepthTestEnable)},
+		{"depthWriteEnable", unsafe.Offsetof((*PipelineDepthStencilStateCreateInfo)(nil).DepthWriteEnable)},
+		{"depthCompareOp", unsafe.Offsetof((*PipelineDepthStencilStateCreateInfo)(nil).DepthCompareOp)},
+		{"depthBoundsTestEnable", unsafe.Offsetof((*PipelineDepthStencilStateCreateInfo)(nil).DepthBoundsTestEnable)},
+		{"stencilTestEnable", unsafe.Offsetof((*PipelineDepthStencilStateCreateInfo)(nil).StencilTestEnable)},
+		{"front", unsafe.Offsetof((*PipelineDepthStencilStateCreateInfo)(nil).Front)},
+		{"back", unsafe.Offsetof((*PipelineDepthStencilStateCreateInfo)(nil).Back)},
+		{"minDepthBounds", unsafe.Offsetof((*PipelineDepthStencilStateCreateInfo)(nil).MinDepthBounds)},
+		{"maxDepthBounds", unsafe.Offsetof((*PipelineDepthStencilStateCreateInfo)(nil).MaxDepthBounds)},
+	}},
+	{"VkPipelineColorBlendAttachmentState", unsafe.Sizeof(*(*PipelineColorBlendAttachmentState)(nil)), unsafe.Alignof(*(*PipelineColorBlendAttachmentState)(nil)), []memberOffset{
+		{"blendEnable", unsafe.Offsetof((*PipelineColorBlendAttachmentState)(nil).BlendEnable)},
+		{"srcColorBlendFactor", unsafe.Offsetof((*PipelineColorBlendAttachmentState)(nil).SrcColorBlendFactor)},
+		{"dstColorBlendFactor", unsafe.Offsetof((*PipelineColorBlendAttachmentState)(nil).DstColorBlendFactor)},
+		{"colorBlendOp", unsafe.Offsetof((*PipelineColorBlendAttachmentState)(nil).ColorBlendOp)},
+		{"srcAlphaBlendFactor", unsafe.Offsetof((*PipelineColorBlendAttachmentState)(nil).SrcAlphaBlendFactor)},
+		{"dstAlphaBlendFactor", unsafe.Offsetof((*PipelineColorBlendAttachmentState)(nil).DstAlphaBlendFactor)},
+		{"alphaBlendOp", unsafe.Offsetof((*PipelineColorBlendAttachmentState)(nil).AlphaBlendOp)},
+		{"colorWriteMask", unsafe.Offsetof((*PipelineColorBlendAttachmentState)(nil).ColorWriteMask)},
+	}},
+	{"VkPipelineColorBlendStateCreateInfo", unsafe.Sizeof(*(*PipelineColorBlendStateCreateInfo)(nil)), unsafe.Alignof(*(*PipelineColorBlendStateCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineColorBlendStateCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineColorBlendStateCreateInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*PipelineColorBlendStateCreateInfo)(nil).Flags)},
+		{"logicOpEnable", unsafe.Offsetof((*PipelineColorBlendStateCreateInfo)(nil).LogicOpEnable)},
+		{"logicOp", unsafe.Offsetof((*PipelineColorBlendStateCreateInfo)(nil).LogicOp)},
+		{"attachmentCount", unsafe.Offsetof((*PipelineColorBlendStateCreateInfo)(nil).AttachmentCount)},
+		{"pAttachments", unsafe.Offsetof((*PipelineColorBlendStateCreateInfo)(nil).PAttachments)},
+		{"blendConstants", unsafe.Offsetof((*PipelineColorBlendStateCreateInfo)(nil).BlendConstants)},
+	}},
+	{"VkPipelineDynamicStateCreateInfo", unsafe.Sizeof(*(*PipelineDynamicStateCreateInfo)(nil)), unsafe.Alignof(*(*PipelineDynamicStateCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineDynamicStateCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineDynamicStateCreateInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*PipelineDynamicStateCreateInfo)(nil).Flags)},
+		{"dynamicStateCount", unsafe.Offsetof((*PipelineDynamicStateCreateInfo)(nil).DynamicStateCount)},
+		{"pDynamicStates", unsafe.Offsetof((*PipelineDynamicStateCreateInfo)(nil).PDynamicStates)},
+	}},
+	{"VkGraphicsPipelineCreateInfo", unsafe.Sizeof(*(*GraphicsPipelineCreateInfo)(nil)), unsafe.Alignof(*(*GraphicsPipelineCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*GraphicsPipelineCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*GraphicsPipelineCreateInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*GraphicsPipelineCreateInfo)(nil).Flags)},
+		{"stageCount", unsafe.Offsetof((*GraphicsPipelineCreateInfo)(nil).StageCount)},
+		{"pStages", unsafe.Offsetof((*GraphicsPipelineCreateInfo)(nil).PStages)},
+		{"pVertexInputState", unsafe.Offsetof((*GraphicsPipelineCreateInfo)(nil).PVertexInputState)},
+		{"pInputAssemblyState", unsafe.Offsetof((*GraphicsPipelineCreateInfo)(nil).PInputAssemblyState)},
+		{"pTessellationState", unsafe.Offsetof((*GraphicsPipelineCreateInfo)(nil).PTessellationState)},
+		{"pViewportState", unsafe.Offsetof((*GraphicsPipelineCreateInfo)(nil).PViewportState)},
+		{"pRasterizationState", unsafe.Offsetof((*GraphicsPipelineCreateInfo)(nil).PRasterizationState)},
+		{"pMultisampleState", unsafe.Offsetof((*GraphicsPipelineCreateInfo)(nil).PMultisampleState)},
+		{"pDepthStencilState", unsafe.Offsetof((*GraphicsPipelineCreateInfo)(nil).PDepthStencilState)},
+		{"pColorBlendState", unsafe.Offsetof((*GraphicsPipelineCreateInfo)(nil).PColorBlendState)},
+		{"pDynamicState", unsafe.Offsetof((*GraphicsPipelineCreateInfo)(nil).PDynamicState)},
+		{"layout", unsafe.Offsetof((*GraphicsPipelineCreateInfo)(nil).Layout)},
+		{"renderPass", unsafe.Offsetof((*GraphicsPipelineCreateInfo)(nil).RenderPass)},
+		{"subpass", unsafe.Offsetof((*GraphicsPipelineCreateInfo)(nil).Subpass)},
+		{"basePipelineHandle", unsafe.Offsetof((*GraphicsPipelineCreateInfo)(nil).BasePipelineHandle)},
+		{"basePipelineIndex", unsafe.Offsetof((*GraphicsPipelineCreateInfo)(nil).BasePipelineIndex)},
+	}},
+	{"VkPushConstantRange", unsafe.Sizeof(*(*PushConstantRange)(nil)), unsafe.Alignof(*(*PushConstantRange)(nil)), []memberOffset{
+		{"stageFlags", unsafe.Offsetof((*PushConstantRange)(nil).StageFlags)},
+		{"offset", unsafe.Offsetof((*PushConstantRange)(nil).Offset)},
+		{"size", unsafe.Offsetof((*PushConstantRange)(nil).Size)},
+	}},
+	{"VkPipelineLayoutCreateInfo", unsafe.Sizeof(*(*PipelineLayoutCreateInfo)(nil)), unsafe.Alignof(*(*PipelineLayoutCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineLayoutCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineLayoutCreateInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*PipelineLayoutCreateInfo)(nil).Flags)},
+		{"setLayoutCount", unsafe.Offsetof((*PipelineLayoutCreateInfo)(nil).SetLayoutCount)},
+		{"pSetLayouts", unsafe.Offsetof((*PipelineLayoutCreateInfo)(nil).PSetLayouts)},
+		{"pushConstantRangeCount", unsafe.Offsetof((*PipelineLayoutCreateInfo)(nil).PushConstantRangeCount)},
+		{"pPushConstantRanges", unsafe.Offsetof((*PipelineLayoutCreateInfo)(nil).PPushConstantRanges)},
+	}},
+	{"VkSamplerCreateInfo", unsafe.Sizeof(*(*SamplerCreateInfo)(nil)), unsafe.Alignof(*(*SamplerCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SamplerCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SamplerCreateInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*SamplerCreateInfo)(nil).Flags)},
+		{"magFilter", unsafe.Offsetof((*SamplerCreateInfo)(nil).MagFilter)},
+		{"minFilter", unsafe.Offsetof((*SamplerCreateInfo)(nil).MinFilter)},
+		{"mipmapMode", unsafe.Offsetof((*SamplerCreateInfo)(nil).MipmapMode)},
+		{"addressModeU", unsafe.Offsetof((*SamplerCreateInfo)(nil).AddressModeU)},
+		{"addressModeV", unsafe.Offsetof((*SamplerCreateInfo)(nil).AddressModeV)},
+		{"addressModeW", unsafe.Offsetof((*SamplerCreateInfo)(nil).AddressModeW)},
+		{"mipLodBias", unsafe.Offsetof((*SamplerCreateInfo)(nil).MipLodBias)},
+		{"anisotropyEnable", unsafe.Offsetof((*SamplerCreateInfo)(nil).AnisotropyEnable)},
+		{"maxAnisotropy", unsafe.Offsetof((*SamplerCreateInfo)(nil).MaxAnisotropy)},
+		{"compareEnable", unsafe.Offsetof((*SamplerCreateInfo)(nil).CompareEnable)},
+		{"compareOp", unsafe.Offsetof((*SamplerCreateInfo)(nil).CompareOp)},
+		{"minLod", unsafe.Offsetof((*SamplerCreateInfo)(nil).MinLod)},
+		{"maxLod", unsafe.Offsetof((*SamplerCreateInfo)(nil).MaxLod)},
+		{"borderColor", unsafe.Offsetof((*SamplerCreateInfo)(nil).BorderColor)},
+		{"unnormalizedCoordinates", unsafe.Offsetof((*SamplerCreateInfo)(nil).UnnormalizedCoordinates)},
+	}},
+	{"VkCopyDescriptorSet", unsafe.Sizeof(*(*CopyDescriptorSet)(nil)), unsafe.Alignof(*(*CopyDescriptorSet)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*CopyDescriptorSet)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*CopyDescriptorSet)(nil).PNext)},
+		{"srcSet", unsafe.Offsetof((*CopyDescriptorSet)(nil).SrcSet)},
+		{"srcBinding", unsafe.Offsetof((*CopyDescriptorSet)(nil).SrcBinding)},
+		{"srcArrayElement", unsafe.Offsetof((*CopyDescriptorSet)(nil).SrcArrayElement)},
+		{"dstSet", unsafe.Offsetof((*CopyDescriptorSet)(nil).DstSet)},
+		{"dstBinding", unsafe.Offsetof((*CopyDescriptorSet)(nil).DstBinding)},
+		{"dstArrayElement", unsafe.Offsetof((*CopyDescriptorSet)(nil).DstArrayElement)},
+		{"descriptorCount", unsafe.Offsetof((*CopyDescriptorSet)(nil).DescriptorCount)},
+	}},
+	{"VkDescriptorBufferInfo", unsafe.Sizeof(*(*DescriptorBufferInfo)(nil)), unsafe.Alignof(*(*DescriptorBufferInfo)(nil)), []memberOffset{
+		{"buffer", unsafe.Offsetof((*DescriptorBufferInfo)(nil).Buffer)},
+		{"offset", unsafe.Offsetof((*DescriptorBufferInfo)(nil).Offset)},
+		{"range", unsafe.Offsetof((*DescriptorBufferInfo)(nil).Range)},
+	}},
+	{"VkDescriptorImageInfo", unsafe.Sizeof(*(*DescriptorImageInfo)(nil)), unsafe.Alignof(*(*DescriptorImageInfo)(nil)), []memberOffset{
+		{"sampler", unsafe.Offsetof((*DescriptorImageInfo)(nil).Sampler)},
+		{"imageView", unsafe.Offsetof((*DescriptorImageInfo)(nil).ImageView)},
+		{"imageLayout", unsafe.Offsetof((*DescriptorImageInfo)(nil).ImageLayout)},
+	}},
+	{"VkDescriptorPoolSize", unsafe.Sizeof(*(*DescriptorPoolSize)(nil)), unsafe.Alignof(*(*DescriptorPoolSize)(nil)), []memberOffset{
+		{"type", unsafe.Offsetof((*DescriptorPoolSize)(nil).Type)},
+		{"descriptorCount", unsafe.Offsetof((*DescriptorPoolSize)(nil).DescriptorCount)},
+	}},
+	{"VkDescriptorPoolCreateInfo", unsafe.Sizeof(*(*DescriptorPoolCreateInfo)(nil)), unsafe.Alignof(*(*DescriptorPoolCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DescriptorPoolCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DescriptorPoolCreateInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*DescriptorPoolCreateInfo)(nil).Flags)},
+		{"maxSets", unsafe.Offsetof((*DescriptorPoolCreateInfo)(nil).MaxSets)},
+		{"poolSizeCount", unsafe.Offsetof((*DescriptorPoolCreateInfo)(nil).PoolSizeCount)},
+		{"pPoolSizes", unsafe.Offsetof((*DescriptorPoolCreateInfo)(nil).PPoolSizes)},
+	}},
+	{"VkDescriptorSetAllocateInfo", unsafe.Sizeof(*(*DescriptorSetAllocateInfo)(nil)), unsafe.Alignof(*(*DescriptorSetAllocateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DescriptorSetAllocateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DescriptorSetAllocateInfo)(nil).PNext)},
+		{"descriptorPool", unsafe.Offsetof((*DescriptorSetAllocateInfo)(nil).DescriptorPool)},
+		{"descriptorSetCount", unsafe.Offsetof((*DescriptorSetAllocateInfo)(nil).DescriptorSetCount)},
+		{"pSetLayouts", unsafe.Offsetof((*DescriptorSetAllocateInfo)(nil).PSetLayouts)},
+	}},
+	{"VkDescriptorSetLayoutBinding", unsafe.Sizeof(*(*DescriptorSetLayoutBinding)(nil)), unsafe.Alignof(*(*DescriptorSetLayoutBinding)(nil)), []memberOffset{
+		{"binding", unsafe.Offsetof((*DescriptorSetLayoutBinding)(nil).Binding)},
+		{"descriptorType", unsafe.Offsetof((*DescriptorSetLayoutBinding)(nil).DescriptorType)},
+		{"descriptorCount", unsafe.Offsetof((*DescriptorSetLayoutBinding)(nil).DescriptorCount)},
+		{"stageFlags", unsafe.Offsetof((*DescriptorSetLayoutBinding)(nil).StageFlags)},
+		{"pImmutableSamplers", unsafe.Offsetof((*DescriptorSetLayoutBinding)(nil).PImmutableSamplers)},
+	}},
+	{"VkDescriptorSetLayoutCreateInfo", unsafe.Sizeof(*(*DescriptorSetLayoutCreateInfo)(nil)), unsafe.Alignof(*(*DescriptorSetLayoutCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DescriptorSetLayoutCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DescriptorSetLayoutCreateInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*DescriptorSetLayoutCreateInfo)(nil).Flags)},
+		{"bindingCount", unsafe.Offsetof((*DescriptorSetLayoutCreateInfo)(nil).BindingCount)},
+		{"pBindings", unsafe.Offsetof((*DescriptorSetLayoutCreateInfo)(nil).PBindings)},
+	}},
+	{"VkWriteDescriptorSet", unsafe.Sizeof(*(*WriteDescriptorSet)(nil)), unsafe.Alignof(*(*WriteDescriptorSet)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*WriteDescriptorSet)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*WriteDescriptorSet)(nil).PNext)},
+		{"dstSet", unsafe.Offsetof((*WriteDescriptorSet)(nil).DstSet)},
+		{"dstBinding", unsafe.Offsetof((*WriteDescriptorSet)(nil).DstBinding)},
+		{"dstArrayElement", unsafe.Offsetof((*WriteDescriptorSet)(nil).DstArrayElement)},
+		{"descriptorCount", unsafe.Offsetof((*WriteDescriptorSet)(nil).DescriptorCount)},
+		{"descriptorType", unsafe.Offsetof((*WriteDescriptorSet)(nil).DescriptorType)},
+		{"pImageInfo", unsafe.Offsetof((*WriteDescriptorSet)(nil).PImageInfo)},
+		{"pBufferInfo", unsafe.Offsetof((*WriteDescriptorSet)(nil).PBufferInfo)},
+		{"pTexelBufferView", unsafe.Offsetof((*WriteDescriptorSet)(nil).PTexelBufferView)},
+	}},
+	{"VkAttachmentDescription", unsafe.Sizeof(*(*AttachmentDescription)(nil)), unsafe.Alignof(*(*AttachmentDescription)(nil)), []memberOffset{
+		{"flags", unsafe.Offsetof((*AttachmentDescription)(nil).Flags)},
+		{"format", unsafe.Offsetof((*AttachmentDescription)(nil).Format)},
+		{"samples", unsafe.Offsetof((*AttachmentDescription)(nil).Samples)},
+		{"loadOp", unsafe.Offsetof((*AttachmentDescription)(nil).LoadOp)},
+		{"storeOp", unsafe.Offsetof((*AttachmentDescription)(nil).StoreOp)},
+		{"stencilLoadOp", unsafe.Offsetof((*AttachmentDescription)(nil).StencilLoadOp)},
+		{"stencilStoreOp", unsafe.Offsetof((*AttachmentDescription)(nil).StencilStoreOp)},
+		{"initialLayout", unsafe.Offsetof((*AttachmentDescription)(nil).InitialLayout)},
+		{"finalLayout", unsafe.Offsetof((*AttachmentDescription)(nil).FinalLayout)},
+	}},
+	{"VkAttachmentReference", unsafe.Sizeof(*(*AttachmentReference)(nil)), unsafe.Alignof(*(*AttachmentReference)(nil)), []memberOffset{
+		{"attachment", unsafe.Offsetof((*AttachmentReference)(nil).Attachment)},
+		{"layout", unsafe.Offsetof((*AttachmentReference)(nil).Layout)},
+	}},
+	{"VkFramebufferCreateInfo", unsafe.Sizeof(*(*FramebufferCreateInfo)(nil)), unsafe.Alignof(*(*FramebufferCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*FramebufferCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*FramebufferCreateInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*FramebufferCreateInfo)(nil).Flags)},
+		{"renderPass", unsafe.Offsetof((*FramebufferCreateInfo)(nil).RenderPass)},
+		{"attachmentCount", unsafe.Offsetof((*FramebufferCreateInfo)(nil).AttachmentCount)},
+		{"pAttachments", unsafe.Offsetof((*FramebufferCreateInfo)(nil).PAttachments)},
+		{"width", unsafe.Offsetof((*FramebufferCreateInfo)(nil).Width)},
+		{"height", unsafe.Offsetof((*FramebufferCreateInfo)(nil).Height)},
+		{"layers", unsafe.Offsetof((*FramebufferCreateInfo)(nil).Layers)},
+	}},
+	{"VkSubpassDescription", unsafe.Sizeof(*(*SubpassDescription)(nil)), unsafe.Alignof(*(*SubpassDescription)(nil)), []memberOffset{
+		{"flags", unsafe.Offsetof((*SubpassDescription)(nil).Flags)},
+		{"pipelineBindPoint", unsafe.Offsetof((*SubpassDescription)(nil).PipelineBindPoint)},
+		{"inputAttachmentCount", unsafe.Offsetof((*SubpassDescription)(nil).InputAttachmentCount)},
+		{"pInputAttachments", unsafe.Offsetof((*SubpassDescription)(nil).PInputAttachments)},
+		{"colorAttachmentCount", unsafe.Offsetof((*SubpassDescription)(nil).ColorAttachmentCount)},
+		{"pColorAttachments", unsafe.Offsetof((*SubpassDescription)(nil).PColorAttachments)},
+		{"pResolveAttachments", unsafe.Offsetof((*SubpassDescription)(nil).PResolveAttachments)},
+		{"pDepthStencilAttachment", unsafe.Offsetof((*SubpassDescription)(nil).PDepthStencilAttachment)},
+		{"preserveAttachmentCount", unsafe.Offsetof((*SubpassDescription)(nil).PreserveAttachmentCount)},
+		{"pPreserveAttachments", unsafe.Offsetof((*SubpassDescription)(nil).PPreserveAttachments)},
+	}},
+	{"VkSubpassDependency", unsafe.Sizeof(*(*SubpassDependency)(nil)), unsafe.Alignof(*(*SubpassDependency)(nil)), []memberOffset{
+		{"srcSubpass", unsafe.Offsetof((*SubpassDependency)(nil).SrcSubpass)},
+		{"dstSubpass", unsafe.Offsetof((*SubpassDependency)(nil).DstSubpass)},
+		{"srcStageMask", unsafe.Offsetof((*SubpassDependency)(nil).SrcStageMask)},
+		{"dstStageMask", unsafe.Offsetof((*SubpassDependency)(nil).DstStageMask)},
+		{"srcAccessMask", unsafe.Offsetof((*SubpassDependency)(nil).SrcAccessMask)},
+		{"dstAccessMask", unsafe.Offsetof((*SubpassDependency)(nil).DstAccessMask)},
+		{"dependencyFlags", unsafe.Offsetof((*SubpassDependency)(nil).DependencyFlags)},
+	}},
+	{"VkRenderPassCreateInfo", unsafe.Sizeof(*(*RenderPassCreateInfo)(nil)), unsafe.Alignof(*(*RenderPassCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*RenderPassCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*RenderPassCreateInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*RenderPassCreateInfo)(nil).Flags)},
+		{"attachmentCount", unsafe.Offsetof((*RenderPassCreateInfo)(nil).AttachmentCount)},
+		{"pAttachments", unsafe.Offsetof((*RenderPassCreateInfo)(nil).PAttachments)},
+		{"subpassCount", unsafe.Offsetof((*RenderPassCreateInfo)(nil).SubpassCount)},
+		{"pSubpasses", unsafe.Offsetof((*RenderPassCreateInfo)(nil).PSubpasses)},
+		{"dependencyCount", unsafe.Offsetof((*RenderPassCreateInfo)(nil).DependencyCount)},
+		{"pDependencies", unsafe.Offsetof((*RenderPassCreateInfo)(nil).PDependencies)},
+	}},
+	{"VkCommandPoolCreateInfo", unsafe.Sizeof(*(*CommandPoolCreateInfo)(nil)), unsafe.Alignof(*(*CommandPoolCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*CommandPoolCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*CommandPoolCreateInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*CommandPoolCreateInfo)(nil).Flags)},
+		{"queueFamilyIndex", unsafe.Offsetof((*CommandPoolCreateInfo)(nil).QueueFamilyIndex)},
+	}},
+	{"VkCommandBufferAllocateInfo", unsafe.Sizeof(*(*CommandBufferAllocateInfo)(nil)), unsafe.Alignof(*(*CommandBufferAllocateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*CommandBufferAllocateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*CommandBufferAllocateInfo)(nil).PNext)},
+		{"commandPool", unsafe.Offsetof((*CommandBufferAllocateInfo)(nil).CommandPool)},
+		{"level", unsafe.Offsetof((*CommandBufferAllocateInfo)(nil).Level)},
+		{"commandBufferCount", unsafe.Offsetof((*CommandBufferAllocateInfo)(nil).CommandBufferCount)},
+	}},
+	{"VkCommandBufferInheritanceInfo", unsafe.Sizeof(*(*CommandBufferInheritanceInfo)(nil)), unsafe.Alignof(*(*CommandBufferInheritanceInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*CommandBufferInheritanceInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*CommandBufferInheritanceInfo)(nil).PNext)},
+		{"renderPass", unsafe.Offsetof((*CommandBufferInheritanceInfo)(nil).RenderPass)},
+		{"subpass", unsafe.Offsetof((*CommandBufferInheritanceInfo)(nil).Subpass)},
+		{"framebuffer", unsafe.Offsetof((*CommandBufferInheritanceInfo)(nil).Framebuffer)},
+		{"occlusionQueryEnable", unsafe.Offsetof((*CommandBufferInheritanceInfo)(nil).OcclusionQueryEnable)},
+		{"queryFlags", unsafe.Offsetof((*CommandBufferInheritanceInfo)(nil).QueryFlags)},
+		{"pipelineStatistics", unsafe.Offsetof((*CommandBufferInheritanceInfo)(nil).PipelineStatistics)},
+	}},
+	{"VkCommandBufferBeginInfo", unsafe.Sizeof(*(*CommandBufferBeginInfo)(nil)), unsafe.Alignof(*(*CommandBufferBeginInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*CommandBufferBeginInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*CommandBufferBeginInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*CommandBufferBeginInfo)(nil).Flags)},
+		{"pInheritanceInfo", unsafe.Offsetof((*CommandBufferBeginInfo)(nil).PInheritanceInfo)},
+	}},
+	{"VkBufferCopy", unsafe.Sizeof(*(*BufferCopy)(nil)), unsafe.Alignof(*(*BufferCopy)(nil)), []memberOffset{
+		{"srcOffset", unsafe.Offsetof((*BufferCopy)(nil).SrcOffset)},
+		{"dstOffset", unsafe.Offsetof((*BufferCopy)(nil).DstOffset)},
+		{"size", unsafe.Offsetof((*BufferCopy)(nil).Size)},
+	}},
+	{"VkImageSubresourceLayers", unsafe.Sizeof(*(*ImageSubresourceLayers)(nil)), unsafe.Alignof(*(*ImageSubresourceLayers)(nil)), []memberOffset{
+		{"aspectMask", unsafe.Offsetof((*ImageSubresourceLayers)(nil).AspectMask)},
+		{"mipLevel", unsafe.Offsetof((*ImageSubresourceLayers)(nil).MipLevel)},
+		{"baseArrayLayer", unsafe.Offsetof((*ImageSubresourceLayers)(nil).BaseArrayLayer)},
+		{"layerCount", unsafe.Offsetof((*ImageSubresourceLayers)(nil).LayerCount)},
+	}},
+	{"VkBufferImageCopy", unsafe.Sizeof(*(*BufferImageCopy)(nil)), unsafe.Alignof(*(*BufferImageCopy)(nil)), []memberOffset{
+		{"bufferOffset", unsafe.Offsetof((*BufferImageCopy)(nil).BufferOffset)},
+		{"bufferRowLength", unsafe.Offsetof((*BufferImageCopy)(nil).BufferRowLength)},
+		{"bufferImageHeight", unsafe.Offsetof((*BufferImageCopy)(nil).BufferImageHeight)},
+		{"imageSubresource", unsafe.Offsetof((*BufferImageCopy)(nil).ImageSubresource)},
+		{"imageOffset", unsafe.Offsetof((*BufferImageCopy)(nil).ImageOffset)},
+		{"imageExtent", unsafe.Offsetof((*BufferImageCopy)(nil).ImageExtent)},
+	}},
+	{"VkClearColorValue", unsafe.Sizeof(*(*ClearColorValue)(nil)), unsafe.Alignof(*(*ClearColorValue)(nil)), nil},
+	{"VkClearDepthStencilValue", unsafe.Sizeof(*(*ClearDepthStencilValue)(nil)), unsafe.Alignof(*(*ClearDepthStencilValue)(nil)), []memberOffset{
+		{"depth", unsafe.Offsetof((*ClearDepthStencilValue)(nil).Depth)},
+		{"stencil", unsafe.Offsetof((*ClearDepthStencilValue)(nil).Stencil)},
+	}},
+	{"VkClearValue", unsafe.Sizeof(*(*ClearValue)(nil)), unsafe.Alignof(*(*ClearValue)(nil)), nil},
+	{"VkClearAttachment", unsafe.Sizeof(*(*ClearAttachment)(nil)), unsafe.Alignof(*(*ClearAttachment)(nil)), []memberOffset{
+		{"aspectMask", unsafe.Offsetof((*ClearAttachment)(nil).AspectMask)},
+		{"colorAttachment", unsafe.Offsetof((*ClearAttachment)(nil).ColorAttachment)},
+		{"clearValue", unsafe.Offsetof((*ClearAttachment)(nil).ClearValue)},
+	}},
+	{"VkClearRect", unsafe.Sizeof(*(*ClearRect)(nil)), unsafe.Alignof(*(*ClearRect)(nil)), []memberOffset{
+		{"rect", unsafe.Offsetof((*ClearRect)(nil).Rect)},
+		{"baseArrayLayer", unsafe.Offsetof((*ClearRect)(nil).BaseArrayLayer)},
+		{"layerCount", unsafe.Offsetof((*ClearRect)(nil).LayerCount)},
+	}},
+	{"VkImageBlit", unsafe.Sizeof(*(*ImageBlit)(nil)), unsafe.Alignof(*(*ImageBlit)(nil)), []memberOffset{
+		{"srcSubresource", unsafe.Offsetof((*ImageBlit)(nil).SrcSubresource)},
+		{"srcOffsets", unsafe.Offsetof((*ImageBlit)(nil).SrcOffsets)},
+		{"dstSubresource", unsafe.Offsetof((*ImageBlit)(nil).DstSubresource)},
+		{"dstOffsets", unsafe.Offsetof((*ImageBlit)(nil).DstOffsets)},
+	}},
+	{"VkImageCopy", unsafe.Sizeof(*(*ImageCopy)(nil)), unsafe.Alignof(*(*ImageCopy)(nil)), []memberOffset{
+		{"srcSubresource", unsafe.Offsetof((*ImageCopy)(nil).SrcSubresource)},
+		{"srcOffset", unsafe.Offsetof((*ImageCopy)(nil).SrcOffset)},
+		{"dstSubresource", unsafe.Offsetof((*ImageCopy)(nil).DstSubresource)},
+		{"dstOffset", unsafe.Offsetof((*ImageCopy)(nil).DstOffset)},
+		{"extent", unsafe.Offsetof((*ImageCopy)(nil).Extent)},
+	}},
+	{"VkImageResolve", unsafe.Sizeof(*(*ImageResolve)(nil)), unsafe.Alignof(*(*ImageResolve)(nil)), []memberOffset{
+		{"srcSubresource", unsafe.Offsetof((*ImageResolve)(nil).SrcSubresource)},
+		{"srcOffset", unsafe.Offsetof((*ImageResolve)(nil).SrcOffset)},
+		{"dstSubresource", unsafe.Offsetof((*ImageResolve)(nil).DstSubresource)},
+		{"dstOffset", unsafe.Offsetof((*ImageResolve)(nil).DstOffset)},
+		{"extent", unsafe.Offsetof((*ImageResolve)(nil).Extent)},
+	}},
+	{"VkRenderPassBeginInfo", unsafe.Sizeof(*(*RenderPassBeginInfo)(nil)), unsafe.Alignof(*(*RenderPassBeginInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*RenderPassBeginInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*RenderPassBeginInfo)(nil).PNext)},
+		{"renderPass", unsafe.Offsetof((*RenderPassBeginInfo)(nil).RenderPass)},
+		{"framebuffer", unsafe.Offsetof((*RenderPassBeginInfo)(nil).Framebuffer)},
+		{"renderArea", unsafe.Offsetof((*RenderPassBeginInfo)(nil).RenderArea)},
+		{"clearValueCount", unsafe.Offsetof((*RenderPassBeginInfo)(nil).ClearValueCount)},
+		{"pClearValues", unsafe.Offsetof((*RenderPassBeginInfo)(nil).PClearValues)},
+	}},
+	{"VkPhysicalDeviceSubgroupProperties", unsafe.Sizeof(*(*PhysicalDeviceSubgroupProperties)(nil)), unsafe.Alignof(*(*PhysicalDeviceSubgroupProperties)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceSubgroupProperties)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceSubgroupProperties)(nil).PNext)},
+		{"subgroupSize", unsafe.Offsetof((*PhysicalDeviceSubgroupProperties)(nil).SubgroupSize)},
+		{"supportedStages", unsafe.Offsetof((*PhysicalDeviceSubgroupProperties)(nil).SupportedStages)},
+		{"supportedOperations", unsafe.Offsetof((*PhysicalDeviceSubgroupProperties)(nil).SupportedOperations)},
+		{"quadOperationsInAllStages", unsafe.Offsetof((*PhysicalDeviceSubgroupProperties)(nil).QuadOperationsInAllStages)},
+	}},
+	{"VkBindBufferMemoryInfo", unsafe.Sizeof(*(*BindBufferMemoryInfo)(nil)), unsafe.Alignof(*(*BindBufferMemoryInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*BindBufferMemoryInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*BindBufferMemoryInfo)(nil).PNext)},
+		{"buffer", unsafe.Offsetof((*BindBufferMemoryInfo)(nil).Buffer)},
+		{"memory", unsafe.Offsetof((*BindBufferMemoryInfo)(nil).Memory)},
+		{"memoryOffset", unsafe.Offsetof((*BindBufferMemoryInfo)(nil).MemoryOffset)},
+	}},
+	{"VkBindImageMemoryInfo", unsafe.Sizeof(*(*BindImageMemoryInfo)(nil)), unsafe.Alignof(*(*BindImageMemoryInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*BindImageMemoryInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*BindImageMemoryInfo)(nil).PNext)},
+		{"image", unsafe.Offsetof((*BindImageMemoryInfo)(nil).Image)},
+		{"memory", unsafe.Offsetof((*BindImageMemoryInfo)(nil).Memory)},
+		{"memoryOffset", unsafe.Offsetof((*BindImageMemoryInfo)(nil).MemoryOffset)},
+	}},
+	{"VkPhysicalDevice16BitStorageFeatures", unsafe.Sizeof(*(*PhysicalDevice16BitStorageFeatures)(nil)), unsafe.Alignof(*(*PhysicalDevice16BitStorageFeatures)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDevice16BitStorageFeatures)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDevice16BitStorageFeatures)(nil).PNext)},
+		{"storageBuffer16BitAccess", unsafe.Offsetof((*PhysicalDevice16BitStorageFeatures)(nil).StorageBuffer16BitAccess)},
+		{"uniformAndStorageBuffer16BitAccess", unsafe.Offsetof((*PhysicalDevice16BitStorageFeatures)(nil).UniformAndStorageBuffer16BitAccess)},
+		{"storagePushConstant16", unsafe.Offsetof((*PhysicalDevice16BitStorageFeatures)(nil).StoragePushConstant16)},
+		{"storageInputOutput16", unsafe.Offsetof((*PhysicalDevice16BitStorageFeatures)(nil).StorageInputOutput16)},
+	}},
+	{"VkMemoryDedicatedRequirements", unsafe.Sizeof(*(*MemoryDedicatedRequirements)(nil)), unsafe.Alignof(*(*MemoryDedicatedRequirements)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*MemoryDedicatedRequirements)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*MemoryDedicatedRequirements)(nil).PNext)},
+		{"prefersDedicatedAllocation", unsafe.Offsetof((*MemoryDedicatedRequirements)(nil).PrefersDedicatedAllocation)},
+		{"requiresDedicatedAllocation", unsafe.Offsetof((*MemoryDedicatedRequirements)(nil).RequiresDedicatedAllocation)},
+	}},
+	{"VkMemoryDedicatedAllocateInfo", unsafe.Sizeof(*(*MemoryDedicatedAllocateInfo)(nil)), unsafe.Alignof(*(*MemoryDedicatedAllocateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*MemoryDedicatedAllocateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*MemoryDedicatedAllocateInfo)(nil).PNext)},
+		{"image", unsafe.Offsetof((*MemoryDedicatedAllocateInfo)(nil).Image)},
+		{"buffer", unsafe.Offsetof((*MemoryDedicatedAllocateInfo)(nil).Buffer)},
+	}},
+	{"VkMemoryAllocateFlagsInfo", unsafe.Sizeof(*(*MemoryAllocateFlagsInfo)(nil)), unsafe.Alignof(*(*MemoryAllocateFlagsInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*MemoryAllocateFlagsInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*MemoryAllocateFlagsInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*MemoryAllocateFlagsInfo)(nil).Flags)},
+		{"deviceMask", unsafe.Offsetof((*MemoryAllocateFlagsInfo)(nil).DeviceMask)},
+	}},
+	{"VkDeviceGroupRenderPassBeginInfo", unsafe.Sizeof(*(*DeviceGroupRenderPassBeginInfo)(nil)), unsafe.Alignof(*(*DeviceGroupRenderPassBeginInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DeviceGroupRenderPassBeginInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DeviceGroupRenderPassBeginInfo)(nil).PNext)},
+		{"deviceMask", unsafe.Offsetof((*DeviceGroupRenderPassBeginInfo)(nil).DeviceMask)},
+		{"deviceRenderAreaCount", unsafe.Offsetof((*DeviceGroupRenderPassBeginInfo)(nil).DeviceRenderAreaCount)},
+		{"pDeviceRenderAreas", unsafe.Offsetof((*DeviceGroupRenderPassBeginInfo)(nil).PDeviceRenderAreas)},
+	}},
+	{"VkDeviceGroupCommandBufferBeginInfo", unsafe.Sizeof(*(*DeviceGroupCommandBufferBeginInfo)(nil)), unsafe.Alignof(*(*DeviceGroupCommandBufferBeginInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DeviceGroupCommandBufferBeginInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DeviceGroupCommandBufferBeginInfo)(nil).PNext)},
+		{"deviceMask", unsafe.Offsetof((*DeviceGroupCommandBufferBeginInfo)(nil).DeviceMask)},
+	}},
+	{"VkDeviceGroupSubmitInfo", unsafe.Sizeof(*(*DeviceGroupSubmitInfo)(nil)), unsafe.Alignof(*(*DeviceGroupSubmitInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DeviceGroupSubmitInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DeviceGroupSubmitInfo)(nil).PNext)},
+		{"waitSemaphoreCount", unsafe.Offsetof((*DeviceGroupSubmitInfo)(nil).WaitSemaphoreCount)},
+		{"pWaitSemaphoreDeviceIndices", unsafe.Offsetof((*DeviceGroupSubmitInfo)(nil).PWaitSemaphoreDeviceIndices)},
+		{"commandBufferCount", unsafe.Offsetof((*DeviceGroupSubmitInfo)(nil).CommandBufferCount)},
+		{"pCommandBufferDeviceMasks", unsafe.Offsetof((*DeviceGroupSubmitInfo)(nil).PCommandBufferDeviceMasks)},
+		{"signalSemaphoreCount", unsafe.Offsetof((*DeviceGroupSubmitInfo)(nil).SignalSemaphoreCount)},
+		{"pSignalSemaphoreDeviceIndices", unsafe.Offsetof((*DeviceGroupSubmitInfo)(nil).PSignalSemaphoreDeviceIndices)},
+	}},
+	{"VkDeviceGroupBindSparseInfo", unsafe.Sizeof(*(*DeviceGroupBindSparseInfo)(nil)), unsafe.Alignof(*(*DeviceGroupBindSparseInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DeviceGroupBindSparseInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DeviceGroupBindSparseInfo)(nil).PNext)},
+		{"resourceDeviceIndex", unsafe.Offsetof((*DeviceGroupBindSparseInfo)(nil).ResourceDeviceIndex)},
+		{"memoryDeviceIndex", unsafe.Offsetof((*DeviceGroupBindSparseInfo)(nil).MemoryDeviceIndex)},
+	}},
+	{"VkBindBufferMemoryDeviceGroupInfo", unsafe.Sizeof(*(*BindBufferMemoryDeviceGroupInfo)(nil)), unsafe.Alignof(*(*BindBufferMemoryDeviceGroupInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*BindBufferMemoryDeviceGroupInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*BindBufferMemoryDeviceGroupInfo)(nil).PNext)},
+		{"deviceIndexCount", unsafe.Offsetof((*BindBufferMemoryDeviceGroupInfo)(nil).DeviceIndexCount)},
+		{"pDeviceIndices", unsafe.Offsetof((*BindBufferMemoryDeviceGroupInfo)(nil).PDeviceIndices)},
+	}},
+	{"VkBindImageMemoryDeviceGroupInfo", unsafe.Sizeof(*(*BindImageMemoryDeviceGroupInfo)(nil)), unsafe.Alignof(*(*BindImageMemoryDeviceGroupInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*BindImageMemoryDeviceGroupInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*BindImageMemoryDeviceGroupInfo)(nil).PNext)},
+		{"deviceIndexCount", unsafe.Offsetof((*BindImageMemoryDeviceGroupInfo)(nil).DeviceIndexCount)},
+		{"pDeviceIndices", unsafe.Offsetof((*BindImageMemoryDeviceGroupInfo)(nil).PDeviceIndices)},
+		{"splitInstanceBindRegionCount", unsafe.Offsetof((*BindImageMemoryDeviceGroupInfo)(nil).SplitInstanceBindRegionCount)},
+		{"pSplitInstanceBindRegions", unsafe.Offsetof((*BindImageMemoryDeviceGroupInfo)(nil).PSplitInstanceBindRegions)},
+	}},
+	{"VkPhysicalDeviceGroupProperties", unsafe.Sizeof(*(*PhysicalDeviceGroupProperties)(nil)), unsafe.Alignof(*(*PhysicalDeviceGroupProperties)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceGroupProperties)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceGroupProperties)(nil).PNext)},
+		{"physicalDeviceCount", unsafe.Offsetof((*PhysicalDeviceGroupProperties)(nil).PhysicalDeviceCount)},
+		{"physicalDevices", unsafe.Offsetof((*PhysicalDeviceGroupProperties)(nil).PhysicalDevices)},
+		{"subsetAllocation", unsafe.Offsetof((*PhysicalDeviceGroupProperties)(nil).SubsetAllocation)},
+	}},
+	{"VkDeviceGroupDeviceCreateInfo", unsafe.Sizeof(*(*DeviceGroupDeviceCreateInfo)(nil)), unsafe.Alignof(*(*DeviceGroupDeviceCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DeviceGroupDeviceCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DeviceGroupDeviceCreateInfo)(nil).PNext)},
+		{"physicalDeviceCount", unsafe.Offsetof((*DeviceGroupDeviceCreateInfo)(nil).PhysicalDeviceCount)},
+		{"pPhysicalDevices", unsafe.Offsetof((*DeviceGroupDeviceCreateInfo)(nil).PPhysicalDevices)},
+	}},
+	{"VkBufferMemoryRequirementsInfo2", unsafe.Sizeof(*(*BufferMemoryRequirementsInfo2)(nil)), unsafe.Alignof(*(*BufferMemoryRequirementsInfo2)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*BufferMemoryRequirementsInfo2)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*BufferMemoryRequirementsInfo2)(nil).PNext)},
+		{"buffer", unsafe.Offsetof((*BufferMemoryRequirementsInfo2)(nil).Buffer)},
+	}},
+	{"VkImageMemoryRequirementsInfo2", unsafe.Sizeof(*(*ImageMemoryRequirementsInfo2)(nil)), unsafe.Alignof(*(*ImageMemoryRequirementsInfo2)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ImageMemoryRequirementsInfo2)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ImageMemoryRequirementsInfo2)(nil).PNext)},
+		{"image", unsafe.Offsetof((*ImageMemoryRequirementsInfo2)(nil).Image)},
+	}},
+	{"VkImageSparseMemoryRequirementsInfo2", unsafe.Sizeof(*(*ImageSparseMemoryRequirementsInfo2)(nil)), unsafe.Alignof(*(*ImageSparseMemoryRequirementsInfo2)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ImageSparseMemoryRequirementsInfo2)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ImageSparseMemoryRequirementsInfo2)(nil).PNext)},
+		{"image", unsafe.Offsetof((*ImageSparseMemoryRequirementsInfo2)(nil).Image)},
+	}},
+	{"VkMemoryRequirements2", unsafe.Sizeof(*(*MemoryRequirements2)(nil)), unsafe.Alignof(*(*MemoryRequirements2)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*MemoryRequirements2)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*MemoryRequirements2)(nil).PNext)},
+		{"memoryRequirements", unsafe.Offsetof((*MemoryRequirements2)(nil).MemoryRequirements)},
+	}},
+	{"VkSparseImageMemoryRequirements2", unsafe.Sizeof(*(*SparseImageMemoryRequirements2)(nil)), unsafe.Alignof(*(*SparseImageMemoryRequirements2)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SparseImageMemoryRequirements2)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SparseImageMemoryRequirements2)(nil).PNext)},
+		{"memoryRequirements", unsafe.Offsetof((*SparseImageMemoryRequirements2)(nil).MemoryRequirements)},
+	}},
+	{"VkPhysicalDeviceFeatures2", unsafe.Sizeof(*(*PhysicalDeviceFeatures2)(nil)), unsafe.Alignof(*(*PhysicalDeviceFeatures2)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceFeatures2)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceFeatures2)(nil).PNext)},
+		{"features", unsafe.Offsetof((*PhysicalDeviceFeatures2)(nil).Features)},
+	}},
+	{"VkPhysicalDeviceProperties2", unsafe.Sizeof(*(*PhysicalDeviceProperties2)(nil)), unsafe.Alignof(*(*PhysicalDeviceProperties2)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceProperties2)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceProperties2)(nil).PNext)},
+		{"properties", unsafe.Offsetof((*PhysicalDeviceProperties2)(nil).Properties)},
+	}},
+	{"VkFormatProperties2", unsafe.Sizeof(*(*FormatProperties2)(nil)), unsafe.Alignof(*(*FormatProperties2)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*FormatProperties2)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*FormatProperties2)(nil).PNext)},
+		{"formatProperties", unsafe.Offsetof((*FormatProperties2)(nil).FormatProperties)},
+	}},
+	{"VkImageFormatProperties2", unsafe.Sizeof(*(*ImageFormatProperties2)(nil)), unsafe.Alignof(*(*ImageFormatProperties2)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ImageFormatProperties2)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ImageFormatProperties2)(nil).PNext)},
+		{"imageFormatProperties", unsafe.Offsetof((*ImageFormatProperties2)(nil).ImageFormatProperties)},
+	}},
+	{"VkPhysicalDeviceImageFormatInfo2", unsafe.Sizeof(*(*PhysicalDeviceImageFormatInfo2)(nil)), unsafe.Alignof(*(*PhysicalDeviceImageFormatInfo2)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceImageFormatInfo2)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceImageFormatInfo2)(nil).PNext)},
+		{"format", unsafe.Offsetof((*PhysicalDeviceImageFormatInfo2)(nil).Format)},
+		{"type", unsafe.Offsetof((*PhysicalDeviceImageFormatInfo2)(nil).Type)},
+		{"tiling", unsafe.Offsetof((*PhysicalDeviceImageFormatInfo2)(nil).Tiling)},
+		{"usage", unsafe.Offsetof((*PhysicalDeviceImageFormatInfo2)(nil).Usage)},
+		{"flags", unsafe.Offsetof((*PhysicalDeviceImageFormatInfo2)(nil).Flags)},
+	}},
+	{"VkQueueFamilyProperties2", unsafe.Sizeof(*(*QueueFamilyProperties2)(nil)), unsafe.Alignof(*(*QueueFamilyProperties2)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*QueueFamilyProperties2)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*QueueFamilyProperties2)(nil).PNext)},
+		{"queueFamilyProperties", unsafe.Offsetof((*QueueFamilyProperties2)(nil).QueueFamilyProperties)},
+	}},
+	{"VkPhysicalDeviceMemoryProperties2", unsafe.Sizeof(*(*PhysicalDeviceMemoryProperties2)(nil)), unsafe.Alignof(*(*PhysicalDeviceMemoryProperties2)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceMemoryProperties2)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceMemoryProperties2)(nil).PNext)},
+		{"memoryProperties", unsafe.Offsetof((*PhysicalDeviceMemoryProperties2)(nil).MemoryProperties)},
+	}},
+	{"VkSparseImageFormatProperties2", unsafe.Sizeof(*(*SparseImageFormatProperties2)(nil)), unsafe.Alignof(*(*SparseImageFormatProperties2)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SparseImageFormatProperties2)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SparseImageFormatProperties2)(nil).PNext)},
+		{"properties", unsafe.Offsetof((*SparseImageFormatProperties2)(nil).Properties)},
+	}},
+	{"VkPhysicalDeviceSparseImageFormatInfo2", unsafe.Sizeof(*(*PhysicalDeviceSparseImageFormatInfo2)(nil)), unsafe.Alignof(*(*PhysicalDeviceSparseImageFormatInfo2)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceSparseImageFormatInfo2)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceSparseImageFormatInfo2)(nil).PNext)},
+		{"format", unsafe.Offsetof((*PhysicalDeviceSparseImageFormatInfo2)(nil).Format)},
+		{"type", unsafe.Offsetof((*PhysicalDeviceSparseImageFormatInfo2)(nil).Type)},
+		{"samples", unsafe.Offsetof((*PhysicalDeviceSparseImageFormatInfo2)(nil).Samples)},
+		{"usage", unsafe.Offsetof((*PhysicalDeviceSparseImageFormatInfo2)(nil).Usage)},
+		{"tiling", unsafe.Offsetof((*PhysicalDeviceSparseImageFormatInfo2)(nil).Tiling)},
+	}},
+	{"VkPhysicalDevicePointClippingProperties", unsafe.Sizeof(*(*PhysicalDevicePointClippingProperties)(nil)), unsafe.Alignof(*(*PhysicalDevicePointClippingProperties)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDevicePointClippingProperties)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDevicePointClippingProperties)(nil).PNext)},
+		{"pointClippingBehavior", unsafe.Offsetof((*PhysicalDevicePointClippingProperties)(nil).PointClippingBehavior)},
+	}},
+	{"VkInputAttachmentAspectReference", unsafe.Sizeof(*(*InputAttachmentAspectReference)(nil)), unsafe.Alignof(*(*InputAttachmentAspectReference)(nil)), []memberOffset{
+		{"subpass", unsafe.Offsetof((*InputAttachmentAspectReference)(nil).Subpass)},
+		{"inputAttachmentIndex", unsafe.Offsetof((*InputAttachmentAspectReference)(nil).InputAttachmentIndex)},
+		{"aspectMask", unsafe.Offsetof((*InputAttachmentAspectReference)(nil).AspectMask)},
+	}},
+	{"VkRenderPassInputAttachmentAspectCreateInfo", unsafe.Sizeof(*(*RenderPassInputAttachmentAspectCreateInfo)(nil)), unsafe.Alignof(*(*RenderPassInputAttachmentAspectCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*RenderPassInputAttachmentAspectCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*RenderPassInputAttachmentAspectCreateInfo)(nil).PNext)},
+		{"aspectReferenceCount", unsafe.Offsetof((*RenderPassInputAttachmentAspectCreateInfo)(nil).AspectReferenceCount)},
+		{"pAspectReferences", unsafe.Offsetof((*RenderPassInputAttachmentAspectCreateInfo)(nil).PAspectReferences)},
+	}},
+	{"VkImageViewUsageCreateInfo", unsafe.Sizeof(*(*ImageViewUsageCreateInfo)(nil)), unsafe.Alignof(*(*ImageViewUsageCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ImageViewUsageCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ImageViewUsageCreateInfo)(nil).PNext)},
+		{"usage", unsafe.Offsetof((*ImageViewUsageCreateInfo)(nil).Usage)},
+	}},
+	{"VkPipelineTessellationDomainOriginStateCreateInfo", unsafe.Sizeof(*(*PipelineTessellationDomainOriginStateCreateInfo)(nil)), unsafe.Alignof(*(*PipelineTessellationDomainOriginStateCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineTessellationDomainOriginStateCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineTessellationDomainOriginStateCreateInfo)(nil).PNext)},
+		{"domainOrigin", unsafe.Offsetof((*PipelineTessellationDomainOriginStateCreateInfo)(nil).DomainOrigin)},
+	}},
+	{"VkRenderPassMultiviewCreateInfo", unsafe.Sizeof(*(*RenderPassMultiviewCreateInfo)(nil)), unsafe.Alignof(*(*RenderPassMultiviewCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*RenderPassMultiviewCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*RenderPassMultiviewCreateInfo)(nil).PNext)},
+		{"subpassCount", unsafe.Offsetof((*RenderPassMultiviewCreateInfo)(nil).SubpassCount)},
+		{"pViewMasks", unsafe.Offsetof((*RenderPassMultiviewCreateInfo)(nil).PViewMasks)},
+		{"dependencyCount", unsafe.Offsetof((*RenderPassMultiviewCreateInfo)(nil).DependencyCount)},
+		{"pViewOffsets", unsafe.Offsetof((*RenderPassMultiviewCreateInfo)(nil).PViewOffsets)},
+		{"correlationMaskCount", unsafe.Offsetof((*RenderPassMultiviewCreateInfo)(nil).CorrelationMaskCount)},
+		{"pCorrelationMasks", unsafe.Offsetof((*RenderPassMultiviewCreateInfo)(nil).PCorrelationMasks)},
+	}},
+	{"VkPhysicalDeviceMultiviewFeatures", unsafe.Sizeof(*(*PhysicalDeviceMultiviewFeatures)(nil)), unsafe.Alignof(*(*PhysicalDeviceMultiviewFeatures)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceMultiviewFeatures)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceMultiviewFeatures)(nil).PNext)},
+		{"multiview", unsafe.Offsetof((*PhysicalDeviceMultiviewFeatures)(nil).Multiview)},
+		{"multiviewGeometryShader", unsafe.Offsetof((*PhysicalDeviceMultiviewFeatures)(nil).MultiviewGeometryShader)},
+		{"multiviewTessellationShader", unsafe.Offsetof((*PhysicalDeviceMultiviewFeatures)(nil).MultiviewTessellationShader)},
+	}},
+	{"VkPhysicalDeviceMultiviewProperties", unsafe.Sizeof(*(*PhysicalDeviceMultiviewProperties)(nil)), unsafe.Alignof(*(*PhysicalDeviceMultiviewProperties)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceMultiviewProperties)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceMultiviewProperties)(nil).PNext)},
+		{"maxMultiviewViewCount", unsafe.Offsetof((*PhysicalDeviceMultiviewProperties)(nil).MaxMultiviewViewCount)},
+		{"maxMultiviewInstanceIndex", unsafe.Offsetof((*PhysicalDeviceMultiviewProperties)(nil).MaxMultiviewInstanceIndex)},
+	}},
+	{"VkPhysicalDeviceVariablePointersFeatures", unsafe.Sizeof(*(*PhysicalDeviceVariablePointersFeatures)(nil)), unsafe.Alignof(*(*PhysicalDeviceVariablePointersFeatures)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceVariablePointersFeatures)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceVariablePointersFeatures)(nil).PNext)},
+		{"variablePointersStorageBuffer", unsafe.Offsetof((*PhysicalDeviceVariablePointersFeatures)(nil).VariablePointersStorageBuffer)},
+		{"variablePointers", unsafe.Offsetof((*PhysicalDeviceVariablePointersFeatures)(nil).VariablePointers)},
+	}},
+	{"VkPhysicalDeviceProtectedMemoryFeatures", unsafe.Sizeof(*(*PhysicalDeviceProtectedMemoryFeatures)(nil)), unsafe.Alignof(*(*PhysicalDeviceProtectedMemoryFeatures)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceProtectedMemoryFeatures)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceProtectedMemoryFeatures)(nil).PNext)},
+		{"protectedMemory", unsafe.Offsetof((*PhysicalDeviceProtectedMemoryFeatures)(nil).ProtectedMemory)},
+	}},
+	{"VkPhysicalDeviceProtectedMemoryProperties", unsafe.Sizeof(*(*PhysicalDeviceProtectedMemoryProperties)(nil)), unsafe.Alignof(*(*PhysicalDeviceProtectedMemoryProperties)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceProtectedMemoryProperties)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceProtectedMemoryProperties)(nil).PNext)},
+		{"protectedNoFault", unsafe.Offsetof((*PhysicalDeviceProtectedMemoryProperties)(nil).ProtectedNoFault)},
+	}},
+	{"VkDeviceQueueInfo2", unsafe.Sizeof(*(*DeviceQueueInfo2)(nil)), unsafe.Alignof(*(*DeviceQueueInfo2)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DeviceQueueInfo2)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DeviceQueueInfo2)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*DeviceQueueInfo2)(nil).Flags)},
+		{"queueFamilyIndex", unsafe.Offsetof((*DeviceQueueInfo2)(nil).QueueFamilyIndex)},
+		{"queueIndex", unsafe.Offsetof((*DeviceQueueInfo2)(nil).QueueIndex)},
+	}},
+	{"VkProtectedSubmitInfo", unsafe.Sizeof(*(*ProtectedSubmitInfo)(nil)), unsafe.Alignof(*(*ProtectedSubmitInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ProtectedSubmitInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ProtectedSubmitInfo)(nil).PNext)},
+		{"protectedSubmit", unsafe.Offsetof((*ProtectedSubmitInfo)(nil).ProtectedSubmit)},
+	}},
+	{"VkSamplerYcbcrConversionCreateInfo", unsafe.Sizeof(*(*SamplerYcbcrConversionCreateInfo)(nil)), unsafe.Alignof(*(*SamplerYcbcrConversionCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SamplerYcbcrConversionCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SamplerYcbcrConversionCreateInfo)(nil).PNext)},
+		{"format", unsafe.Offsetof((*SamplerYcbcrConversionCreateInfo)(nil).Format)},
+		{"ycbcrModel", unsafe.Offsetof((*SamplerYcbcrConversionCreateInfo)(nil).YcbcrModel)},
+		{"ycbcrRange", unsafe.Offsetof((*SamplerYcbcrConversionCreateInfo)(nil).YcbcrRange)},
+		{"components", unsafe.Offsetof((*SamplerYcbcrConversionCreateInfo)(nil).Components)},
+		{"xChromaOffset", unsafe.Offsetof((*SamplerYcbcrConversionCreateInfo)(nil).XChromaOffset)},
+		{"yChromaOffset", unsafe.Offsetof((*SamplerYcbcrConversionCreateInfo)(nil).YChromaOffset)},
+		{"chromaFilter", unsafe.Offsetof((*SamplerYcbcrConversionCreateInfo)(nil).ChromaFilter)},
+		{"forceExplicitReconstruction", unsafe.Offsetof((*SamplerYcbcrConversionCreateInfo)(nil).ForceExplicitReconstruction)},
+	}},
+	{"VkSamplerYcbcrConversionInfo", unsafe.Sizeof(*(*SamplerYcbcrConversionInfo)(nil)), unsafe.Alignof(*(*SamplerYcbcrConversionInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SamplerYcbcrConversionInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SamplerYcbcrConversionInfo)(nil).PNext)},
+		{"conversion", unsafe.Offsetof((*SamplerYcbcrConversionInfo)(nil).Conversion)},
+	}},
+	{"VkBindImagePlaneMemoryInfo", unsafe.Sizeof(*(*BindImagePlaneMemoryInfo)(nil)), unsafe.Alignof(*(*BindImagePlaneMemoryInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*BindImagePlaneMemoryInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*BindImagePlaneMemoryInfo)(nil).PNext)},
+		{"planeAspect", unsafe.Offsetof((*BindImagePlaneMemoryInfo)(nil).PlaneAspect)},
+	}},
+	{"VkImagePlaneMemoryRequirementsInfo", unsafe.Sizeof(*(*ImagePlaneMemoryRequirementsInfo)(nil)), unsafe.Alignof(*(*ImagePlaneMemoryRequirementsInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ImagePlaneMemoryRequirementsInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ImagePlaneMemoryRequirementsInfo)(nil).PNext)},
+		{"planeAspect", unsafe.Offsetof((*ImagePlaneMemoryRequirementsInfo)(nil).PlaneAspect)},
+	}},
+	{"VkPhysicalDeviceSamplerYcbcrConversionFeatures", unsafe.Sizeof(*(*PhysicalDeviceSamplerYcbcrConversionFeatures)(nil)), unsafe.Alignof(*(*PhysicalDeviceSamplerYcbcrConversionFeatures)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceSamplerYcbcrConversionFeatures)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceSamplerYcbcrConversionFeatures)(nil).PNext)},
+		{"samplerYcbcrConversion", unsafe.Offsetof((*PhysicalDeviceSamplerYcbcrConversionFeatures)(nil).SamplerYcbcrConversion)},
+	}},
+	{"VkSamplerYcbcrConversionImageFormatProperties", unsafe.Sizeof(*(*SamplerYcbcrConversionImageFormatProperties)(nil)), unsafe.Alignof(*(*SamplerYcbcrConversionImageFormatProperties)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SamplerYcbcrConversionImageFormatProperties)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SamplerYcbcrConversionImageFormatProperties)(nil).PNext)},
+		{"combinedImageSamplerDescriptorCount", unsafe.Offsetof((*SamplerYcbcrConversionImageFormatProperties)(nil).CombinedImageSamplerDescriptorCount)},
+	}},
+	{"VkDescriptorUpdateTemplateEntry", unsafe.Sizeof(*(*DescriptorUpdateTemplateEntry)(nil)), unsafe.Alignof(*(*DescriptorUpdateTemplateEntry)(nil)), []memberOffset{
+		{"dstBinding", unsafe.Offsetof((*DescriptorUpdateTemplateEntry)(nil).DstBinding)},
+		{"dstArrayElement", unsafe.Offsetof((*DescriptorUpdateTemplateEntry)(nil).DstArrayElement)},
+		{"descriptorCount", unsafe.Offsetof((*DescriptorUpdateTemplateEntry)(nil).DescriptorCount)},
+		{"descriptorType", unsafe.Offsetof((*DescriptorUpdateTemplateEntry)(nil).DescriptorType)},
+		{"offset", unsafe.Offsetof((*DescriptorUpdateTemplateEntry)(nil).Offset)},
+		{"stride", unsafe.Offsetof((*DescriptorUpdateTemplateEntry)(nil).Stride)},
+	}},
+	{"VkDescriptorUpdateTemplateCreateInfo", unsafe.Sizeof(*(*DescriptorUpdateTemplateCreateInfo)(nil)), unsafe.Alignof(*(*DescriptorUpdateTemplateCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DescriptorUpdateTemplateCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DescriptorUpdateTemplateCreateInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*DescriptorUpdateTemplateCreateInfo)(nil).Flags)},
+		{"descriptorUpdateEntryCount", unsafe.Offsetof((*DescriptorUpdateTemplateCreateInfo)(nil).DescriptorUpdateEntryCount)},
+		{"pDescriptorUpdateEntries", unsafe.Offsetof((*DescriptorUpdateTemplateCreateInfo)(nil).PDescriptorUpdateEntries)},
+		{"templateType", unsafe.Offsetof((*DescriptorUpdateTemplateCreateInfo)(nil).TemplateType)},
+		{"descriptorSetLayout", unsafe.Offsetof((*DescriptorUpdateTemplateCreateInfo)(nil).DescriptorSetLayout)},
+		{"pipelineBindPoint", unsafe.Offsetof((*DescriptorUpdateTemplateCreateInfo)(nil).PipelineBindPoint)},
+		{"pipelineLayout", unsafe.Offsetof((*DescriptorUpdateTemplateCreateInfo)(nil).PipelineLayout)},
+		{"set", unsafe.Offsetof((*DescriptorUpdateTemplateCreateInfo)(nil).Set)},
+	}},
+	{"VkExternalMemoryProperties", unsafe.Sizeof(*(*ExternalMemoryProperties)(nil)), unsafe.Alignof(*(*ExternalMemoryProperties)(nil)), []memberOffset{
+		{"externalMemoryFeatures", unsafe.Offsetof((*ExternalMemoryProperties)(nil).ExternalMemoryFeatures)},
+		{"exportFromImportedHandleTypes", unsafe.Offsetof((*ExternalMemoryProperties)(nil).ExportFromImportedHandleTypes)},
+		{"compatibleHandleTypes", unsafe.Offsetof((*ExternalMemoryProperties)(nil).CompatibleHandleTypes)},
+	}},
+	{"VkPhysicalDeviceExternalImageFormatInfo", unsafe.Sizeof(*(*PhysicalDeviceExternalImageFormatInfo)(nil)), unsafe.Alignof(*(*PhysicalDeviceExternalImageFormatInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceExternalImageFormatInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceExternalImageFormatInfo)(nil).PNext)},
+		{"handleType", unsafe.Offsetof((*PhysicalDeviceExternalImageFormatInfo)(nil).HandleType)},
+	}},
+	{"VkExternalImageFormatProperties", unsafe.Sizeof(*(*ExternalImageFormatProperties)(nil)), unsafe.Alignof(*(*ExternalImageFormatProperties)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ExternalImageFormatProperties)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ExternalImageFormatProperties)(nil).PNext)},
+		{"externalMemoryProperties", unsafe.Offsetof((*ExternalImageFormatProperties)(nil).ExternalMemoryProperties)},
+	}},
+	{"VkPhysicalDeviceExternalBufferInfo", unsafe.Sizeof(*(*PhysicalDeviceExternalBufferInfo)(nil)), unsafe.Alignof(*(*PhysicalDeviceExternalBufferInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceExternalBufferInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceExternalBufferInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*PhysicalDeviceExternalBufferInfo)(nil).Flags)},
+		{"usage", unsafe.Offsetof((*PhysicalDeviceExternalBufferInfo)(nil).Usage)},
+		{"handleType", unsafe.Offsetof((*PhysicalDeviceExternalBufferInfo)(nil).HandleType)},
+	}},
+	{"VkExternalBufferProperties", unsafe.Sizeof(*(*ExternalBufferProperties)(nil)), unsafe.Alignof(*(*ExternalBufferProperties)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ExternalBufferProperties)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ExternalBufferProperties)(nil).PNext)},
+		{"externalMemoryProperties", unsafe.Offsetof((*ExternalBufferProperties)(nil).ExternalMemoryProperties)},
+	}},
+	{"VkPhysicalDeviceIDProperties", unsafe.Sizeof(*(*PhysicalDeviceIDProperties)(nil)), unsafe.Alignof(*(*PhysicalDeviceIDProperties)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceIDProperties)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceIDProperties)(nil).PNext)},
+		{"deviceUUID", unsafe.Offsetof((*PhysicalDeviceIDProperties)(nil).DeviceUUID)},
+		{"driverUUID", unsafe.Offsetof((*PhysicalDeviceIDProperties)(nil).DriverUUID)},
+		{"deviceLUID", unsafe.Offsetof((*PhysicalDeviceIDProperties)(nil).DeviceLUID)},
+		{"deviceNodeMask", unsafe.Offsetof((*PhysicalDeviceIDProperties)(nil).DeviceNodeMask)},
+		{"deviceLUIDValid", unsafe.Offsetof((*PhysicalDeviceIDProperties)(nil).DeviceLUIDValid)},
+	}},
+	{"VkExternalMemoryImageCreateInfo", unsafe.Sizeof(*(*ExternalMemoryImageCreateInfo)(nil)), unsafe.Alignof(*(*ExternalMemoryImageCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ExternalMemoryImageCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ExternalMemoryImageCreateInfo)(nil).PNext)},
+		{"handleTypes", unsafe.Offsetof((*ExternalMemoryImageCreateInfo)(nil).HandleTypes)},
+	}},
+	{"VkExternalMemoryBufferCreateInfo", unsafe.Sizeof(*(*ExternalMemoryBufferCreateInfo)(nil)), unsafe.Alignof(*(*ExternalMemoryBufferCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ExternalMemoryBufferCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ExternalMemoryBufferCreateInfo)(nil).PNext)},
+		{"handleTypes", unsafe.Offsetof((*ExternalMemoryBufferCreateInfo)(nil).HandleTypes)},
+	}},
+	{"VkExportMemoryAllocateInfo", unsafe.Sizeof(*(*ExportMemoryAllocateInfo)(nil)), unsafe.Alignof(*(*ExportMemoryAllocateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ExportMemoryAllocateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ExportMemoryAllocateInfo)(nil).PNext)},
+		{"handleTypes", unsafe.Offsetof((*ExportMemoryAllocateInfo)(nil).HandleTypes)},
+	}},
+	{"VkPhysicalDeviceExternalFenceInfo", unsafe.Sizeof(*(*PhysicalDeviceExternalFenceInfo)(nil)), unsafe.Alignof(*(*PhysicalDeviceExternalFenceInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceExternalFenceInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceExternalFenceInfo)(nil).PNext)},
+		{"handleType", unsafe.Offsetof((*PhysicalDeviceExternalFenceInfo)(nil).HandleType)},
+	}},
+	{"VkExternalFenceProperties", unsafe.Sizeof(*(*ExternalFenceProperties)(nil)), unsafe.Alignof(*(*ExternalFenceProperties)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ExternalFenceProperties)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ExternalFenceProperties)(nil).PNext)},
+		{"exportFromImportedHandleTypes", unsafe.Offsetof((*ExternalFenceProperties)(nil).ExportFromImportedHandleTypes)},
+		{"compatibleHandleTypes", unsafe.Offsetof((*ExternalFenceProperties)(nil).CompatibleHandleTypes)},
+		{"externalFenceFeatures", unsafe.Offsetof((*ExternalFenceProperties)(nil).ExternalFenceFeatures)},
+	}},
+	{"VkExportFenceCreateInfo", unsafe.Sizeof(*(*ExportFenceCreateInfo)(nil)), unsafe.Alignof(*(*ExportFenceCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ExportFenceCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ExportFenceCreateInfo)(nil).PNext)},
+		{"handleTypes", unsafe.Offsetof((*ExportFenceCreateInfo)(nil).HandleTypes)},
+	}},
+	{"VkExportSemaphoreCreateInfo", unsafe.Sizeof(*(*ExportSemaphoreCreateInfo)(nil)), unsafe.Alignof(*(*ExportSemaphoreCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ExportSemaphoreCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ExportSemaphoreCreateInfo)(nil).PNext)},
+		{"handleTypes", unsafe.Offsetof((*ExportSemaphoreCreateInfo)(nil).HandleTypes)},
+	}},
+	{"VkPhysicalDeviceExternalSemaphoreInfo", unsafe.Sizeof(*(*PhysicalDeviceExternalSemaphoreInfo)(nil)), unsafe.Alignof(*(*PhysicalDeviceExternalSemaphoreInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceExternalSemaphoreInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceExternalSemaphoreInfo)(nil).PNext)},
+		{"handleType", unsafe.Offsetof((*PhysicalDeviceExternalSemaphoreInfo)(nil).HandleType)},
+	}},
+	{"VkExternalSemaphoreProperties", unsafe.Sizeof(*(*ExternalSemaphoreProperties)(nil)), unsafe.Alignof(*(*ExternalSemaphoreProperties)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ExternalSemaphoreProperties)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ExternalSemaphoreProperties)(nil).PNext)},
+		{"exportFromImportedHandleTypes", unsafe.Offsetof((*ExternalSemaphoreProperties)(nil).ExportFromImportedHandleTypes)},
+		{"compatibleHandleTypes", unsafe.Offsetof((*ExternalSemaphoreProperties)(nil).CompatibleHandleTypes)},
+		{"externalSemaphoreFeatures", unsafe.Offsetof((*ExternalSemaphoreProperties)(nil).ExternalSemaphoreFeatures)},
+	}},
+	{"VkPhysicalDeviceMaintenance3Properties", unsafe.Sizeof(*(*PhysicalDeviceMaintenance3Properties)(nil)), unsafe.Alignof(*(*PhysicalDeviceMaintenance3Properties)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceMaintenance3Properties)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceMaintenance3Properties)(nil).PNext)},
+		{"maxPerSetDescriptors", unsafe.Offsetof((*PhysicalDeviceMaintenance3Properties)(nil).MaxPerSetDescriptors)},
+		{"maxMemoryAllocationSize", unsafe.Offsetof((*PhysicalDeviceMaintenance3Properties)(nil).MaxMemoryAllocationSize)},
+	}},
+	{"VkDescriptorSetLayoutSupport", unsafe.Sizeof(*(*DescriptorSetLayoutSupport)(nil)), unsafe.Alignof(*(*DescriptorSetLayoutSupport)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DescriptorSetLayoutSupport)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DescriptorSetLayoutSupport)(nil).PNext)},
+		{"supported", unsafe.Offsetof((*DescriptorSetLayoutSupport)(nil).Supported)},
+	}},
+	{"VkPhysicalDeviceShaderDrawParametersFeatures", unsafe.Sizeof(*(*PhysicalDeviceShaderDrawParametersFeatures)(nil)), unsafe.Alignof(*(*PhysicalDeviceShaderDrawParametersFeatures)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceShaderDrawParametersFeatures)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceShaderDrawParametersFeatures)(nil).PNext)},
+		{"shaderDrawParameters", unsafe.Offsetof((*PhysicalDeviceShaderDrawParametersFeatures)(nil).ShaderDrawParameters)},
+	}},
+	{"VkPhysicalDeviceVulkan11Features", unsafe.Sizeof(*(*PhysicalDeviceVulkan11Features)(nil)), unsafe.Alignof(*(*PhysicalDeviceVulkan11Features)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceVulkan11Features)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceVulkan11Features)(nil).PNext)},
+		{"storageBuffer16BitAccess", unsafe.Offsetof((*PhysicalDeviceVulkan11Features)(nil).StorageBuffer16BitAccess)},
+		{"uniformAndStorageBuffer16BitAccess", unsafe.Offsetof((*PhysicalDeviceVulkan11Features)(nil).UniformAndStorageBuffer16BitAccess)},
+		{"storagePushConstant16", unsafe.Offsetof((*PhysicalDeviceVulkan11Features)(nil).StoragePushConstant16)},
+		{"storageInputOutput16", unsafe.Offsetof((*PhysicalDeviceVulkan11Features)(nil).StorageInputOutput16)},
+		{"multiview", unsafe.Offsetof((*PhysicalDeviceVulkan11Features)(nil).Multiview)},
+		{"multiviewGeometryShader", unsafe.Offsetof((*PhysicalDeviceVulkan11Features)(nil).MultiviewGeometryShader)},
+		{"multiviewTessellationShader", unsafe.Offsetof((*PhysicalDeviceVulkan11Features)(nil).MultiviewTessellationShader)},
+		{"variablePointersStorageBuffer", unsafe.Offsetof((*PhysicalDeviceVulkan11Features)(nil).VariablePointersStorageBuffer)},
+		{"variablePointers", unsafe.Offsetof((*PhysicalDeviceVulkan11Features)(nil).VariablePointers)},
+		{"protectedMemory", unsafe.Offsetof((*PhysicalDeviceVulkan11Features)(nil).ProtectedMemory)},
+		{"samplerYcbcrConversion", unsafe.Offsetof((*PhysicalDeviceVulkan11Features)(nil).SamplerYcbcrConversion)},
+		{"shaderDrawParameters", unsafe.Offsetof((*PhysicalDeviceVulkan11Features)(nil).ShaderDrawParameters)},
+	}},
+	{"VkPhysicalDeviceVulkan11Properties", unsafe.Sizeof(*(*PhysicalDeviceVulkan11Properties)(nil)), unsafe.Alignof(*(*PhysicalDeviceVulkan11Properties)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceVulkan11Properties)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceVulkan11Properties)(nil).PNext)},
+		{"deviceUUID", unsafe.Offsetof((*PhysicalDeviceVulkan11Properties)(nil).DeviceUUID)},
+		{"driverUUID", unsafe.Offsetof((*PhysicalDeviceVulkan11Properties)(nil).DriverUUID)},
+		{"deviceLUID", unsafe.Offsetof((*PhysicalDeviceVulkan11Properties)(nil).DeviceLUID)},
+		{"deviceNodeMask", unsafe.Offsetof((*PhysicalDeviceVulkan11Properties)(nil).DeviceNodeMask)},
+		{"deviceLUIDValid", unsafe.Offsetof((*PhysicalDeviceVulkan11Properties)(nil).DeviceLUIDValid)},
+		{"subgroupSize", unsafe.Offsetof((*PhysicalDeviceVulkan11Properties)(nil).SubgroupSize)},
+		{"subgroupSupportedStages", unsafe.Offsetof((*PhysicalDeviceVulkan11Properties)(nil).SubgroupSupportedStages)},
+		{"subgroupSupportedOperations", unsafe.Offsetof((*PhysicalDeviceVulkan11Properties)(nil).SubgroupSupportedOperations)},
+		{"subgroupQuadOperationsInAllStages", unsafe.Offsetof((*PhysicalDeviceVulkan11Properties)(nil).SubgroupQuadOperationsInAllStages)},
+		{"pointClippingBehavior", unsafe.Offsetof((*PhysicalDeviceVulkan11Properties)(nil).PointClippingBehavior)},
+		{"maxMultiviewViewCount", unsafe.Offsetof((*PhysicalDeviceVulkan11Properties)(nil).MaxMultiviewViewCount)},
+		{"maxMultiviewInstanceIndex", unsafe.Offsetof((*PhysicalDeviceVulkan11Properties)(nil).MaxMultiviewInstanceIndex)},
+		{"protectedNoFault", unsafe.Offsetof((*PhysicalDeviceVulkan11Properties)(nil).ProtectedNoFault)},
+		{"maxPerSetDescriptors", unsafe.Offsetof((*PhysicalDeviceVulkan11Properties)(nil).MaxPerSetDescriptors)},
+		{"maxMemoryAllocationSize", unsafe.Offsetof((*PhysicalDeviceVulkan11Properties)(nil).MaxMemoryAllocationSize)},
+	}},
+	{"VkPhysicalDeviceVulkan12Features", unsafe.Sizeof(*(*PhysicalDeviceVulkan12Features)(nil)), unsafe.Alignof(*(*PhysicalDeviceVulkan12Features)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).PNext)},
+		{"samplerMirrorClampToEdge", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).SamplerMirrorClampToEdge)},
+		{"drawIndirectCount", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).DrawIndirectCount)},
+		{"storageBuffer8BitAccess", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).StorageBuffer8BitAccess)},
+		{"uniformAndStorageBuffer8BitAccess", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).UniformAndStorageBuffer8BitAccess)},
+		{"storagePushConstant8", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).StoragePushConstant8)},
+		{"shaderBufferInt64Atomics", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).ShaderBufferInt64Atomics)},
+		{"shaderSharedInt64Atomics", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).ShaderSharedInt64Atomics)},
+		{"shaderFloat16", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).ShaderFloat16)},
+		{"shaderInt8", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).ShaderInt8)},
+		{"descriptorIndexing", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).DescriptorIndexing)},
+		{"shaderInputAttachmentArrayDynamicIndexing", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).ShaderInputAttachmentArrayDynamicIndexing)},
+		{"shaderUniformTexelBufferArrayDynamicIndexing", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).ShaderUniformTexelBufferArrayDynamicIndexing)},
+		{"shaderStorageTexelBufferArrayDynamicIndexing", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).ShaderStorageTexelBufferArrayDynamicIndexing)},
+		{"shaderUniformBufferArrayNonUniformIndexing", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).ShaderUniformBufferArrayNonUniformIndexing)},
+		{"shaderSampledImageArrayNonUniformIndexing", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).ShaderSampledImageArrayNonUniformIndexing)},
+		{"shaderStorageBufferArrayNonUniformIndexing", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).ShaderStorageBufferArrayNonUniformIndexing)},
+		{"shaderStorageImageArrayNonUniformIndexing", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).ShaderStorageImageArrayNonUniformIndexing)},
+		{"shaderInputAttachmentArrayNonUniformIndexing", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).ShaderInputAttachmentArrayNonUniformIndexing)},
+		{"shaderUniformTexelBufferArrayNonUniformIndexing", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).ShaderUniformTexelBufferArrayNonUniformIndexing)},
+		{"shaderStorageTexelBufferArrayNonUniformIndexing", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).ShaderStorageTexelBufferArrayNonUniformIndexing)},
+		{"descriptorBindingUniformBufferUpdateAfterBind", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).DescriptorBindingUniformBufferUpdateAfterBind)},
+		{"descriptorBindingSampledImageUpdateAfterBind", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).DescriptorBindingSampledImageUpdateAfterBind)},
+		{"descriptorBindingStorageImageUpdateAfterBind", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).DescriptorBindingStorageImageUpdateAfterBind)},
+		{"descriptorBindingStorageBufferUpdateAfterBind", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).DescriptorBindingStorageBufferUpdateAfterBind)},
+		{"descriptorBindingUniformTexelBufferUpdateAfterBind", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).DescriptorBindingUniformTexelBufferUpdateAfterBind)},
+		{"descriptorBindingStorageTexelBufferUpdateAfterBind", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).DescriptorBindingStorageTexelBufferUpdateAfterBind)},
+		{"descriptorBindingUpdateUnusedWhilePending", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).DescriptorBindingUpdateUnusedWhilePending)},
+		{"descriptorBindingPartiallyBound", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).DescriptorBindingPartiallyBound)},
+		{"descriptorBindingVariableDescriptorCount", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).DescriptorBindingVariableDescriptorCount)},
+		{"runtimeDescriptorArray", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).RuntimeDescriptorArray)},
+		{"samplerFilterMinmax", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).SamplerFilterMinmax)},
+		{"scalarBlockLayout", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).ScalarBlockLayout)},
+		{"imagelessFramebuffer", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).ImagelessFramebuffer)},
+		{"uniformBufferStandardLayout", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).UniformBufferStandardLayout)},
+		{"shaderSubgroupExtendedTypes", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).ShaderSubgroupExtendedTypes)},
+		{"separateDepthStencilLayouts", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).SeparateDepthStencilLayouts)},
+		{"hostQueryReset", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).HostQueryReset)},
+		{"timelineSemaphore", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).TimelineSemaphore)},
+		{"bufferDeviceAddress", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).BufferDeviceAddress)},
+		{"bufferDeviceAddressCaptureReplay", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).BufferDeviceAddressCaptureReplay)},
+		{"bufferDeviceAddressMultiDevice", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).BufferDeviceAddressMultiDevice)},
+		{"vulkanMemoryModel", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).VulkanMemoryModel)},
+		{"vulkanMemoryModelDeviceScope", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).VulkanMemoryModelDeviceScope)},
+		{"vulkanMemoryModelAvailabilityVisibilityChains", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).VulkanMemoryModelAvailabilityVisibilityChains)},
+		{"shaderOutputViewportIndex", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).ShaderOutputViewportIndex)},
+		{"shaderOutputLayer", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).ShaderOutputLayer)},
+		{"subgroupBroadcastDynamicId", unsafe.Offsetof((*PhysicalDeviceVulkan12Features)(nil).SubgroupBroadcastDynamicId)},
+	}},
+	{"VkConformanceVersion", unsafe.Sizeof(*(*ConformanceVersion)(nil)), unsafe.Alignof(*(*ConformanceVersion)(nil)), []memberOffset{
+		{"major", unsafe.Offsetof((*ConformanceVersion)(nil).Major)},
+		{"minor", unsafe.Offsetof((*ConformanceVersion)(nil).Minor)},
+		{"subminor", unsafe.Offsetof((*ConformanceVersion)(nil).Subminor)},
+		{"patch", unsafe.Offsetof((*ConformanceVersion)(nil).Patch)},
+	}},
+	{"VkPhysicalDeviceVulkan12Properties", unsafe.Sizeof(*(*PhysicalDeviceVulkan12Properties)(nil)), unsafe.Alignof(*(*PhysicalDeviceVulkan12Properties)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).PNext)},
+		{"driverID", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).DriverID)},
+		{"driverName", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).DriverName)},
+		{"driverInfo", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).DriverInfo)},
+		{"conformanceVersion", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).ConformanceVersion)},
+		{"denormBehaviorIndependence", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).DenormBehaviorIndependence)},
+		{"roundingModeIndependence", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).RoundingModeIndependence)},
+		{"shaderSignedZeroInfNanPreserveFloat16", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).ShaderSignedZeroInfNanPreserveFloat16)},
+		{"shaderSignedZeroInfNanPreserveFloat32", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).ShaderSignedZeroInfNanPreserveFloat32)},
+		{"shaderSignedZeroInfNanPreserveFloat64", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).ShaderSignedZeroInfNanPreserveFloat64)},
+		{"shaderDenormPreserveFloat16", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).ShaderDenormPreserveFloat16)},
+		{"shaderDenormPreserveFloat32", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).ShaderDenormPreserveFloat32)},
+		{"shaderDenormPreserveFloat64", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).ShaderDenormPreserveFloat64)},
+		{"shaderDenormFlushToZeroFloat16", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).ShaderDenormFlushToZeroFloat16)},
+		{"shaderDenormFlushToZeroFloat32", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).ShaderDenormFlushToZeroFloat32)},
+		{"shaderDenormFlushToZeroFloat64", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).ShaderDenormFlushToZeroFloat64)},
+		{"shaderRoundingModeRTEFloat16", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).ShaderRoundingModeRTEFloat16)},
+		{"shaderRoundingModeRTEFloat32", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).ShaderRoundingModeRTEFloat32)},
+		{"shaderRoundingModeRTEFloat64", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).ShaderRoundingModeRTEFloat64)},
+		{"shaderRoundingModeRTZFloat16", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).ShaderRoundingModeRTZFloat16)},
+		{"shaderRoundingModeRTZFloat32", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).ShaderRoundingModeRTZFloat32)},
+		{"shaderRoundingModeRTZFloat64", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).ShaderRoundingModeRTZFloat64)},
+		{"maxUpdateAfterBindDescriptorsInAllPools", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).MaxUpdateAfterBindDescriptorsInAllPools)},
+		{"shaderUniformBufferArrayNonUniformIndexingNative", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).ShaderUniformBufferArrayNonUniformIndexingNative)},
+		{"shaderSampledImageArrayNonUniformIndexingNative", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).ShaderSampledImageArrayNonUniformIndexingNative)},
+		{"shaderStorageBufferArrayNonUniformIndexingNative", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).ShaderStorageBufferArrayNonUniformIndexingNative)},
+		{"shaderStorageImageArrayNonUniformIndexingNative", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).ShaderStorageImageArrayNonUniformIndexingNative)},
+		{"shaderInputAttachmentArrayNonUniformIndexingNative", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).ShaderInputAttachmentArrayNonUniformIndexingNative)},
+		{"robustBufferAccessUpdateAfterBind", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).RobustBufferAccessUpdateAfterBind)},
+		{"quadDivergentImplicitLod", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).QuadDivergentImplicitLod)},
+		{"maxPerStageDescriptorUpdateAfterBindSamplers", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).MaxPerStageDescriptorUpdateAfterBindSamplers)},
+		{"maxPerStageDescriptorUpdateAfterBindUniformBuffers", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).MaxPerStageDescriptorUpdateAfterBindUniformBuffers)},
+		{"maxPerStageDescriptorUpdateAfterBindStorageBuffers", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).MaxPerStageDescriptorUpdateAfterBindStorageBuffers)},
+		{"maxPerStageDescriptorUpdateAfterBindSampledImages", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).MaxPerStageDescriptorUpdateAfterBindSampledImages)},
+		{"maxPerStageDescriptorUpdateAfterBindStorageImages", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).MaxPerStageDescriptorUpdateAfterBindStorageImages)},
+		{"maxPerStageDescriptorUpdateAfterBindInputAttachments", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).MaxPerStageDescriptorUpdateAfterBindInputAttachments)},
+		{"maxPerStageUpdateAfterBindResources", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).MaxPerStageUpdateAfterBindResources)},
+		{"maxDescriptorSetUpdateAfterBindSamplers", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).MaxDescriptorSetUpdateAfterBindSamplers)},
+		{"maxDescriptorSetUpdateAfterBindUniformBuffers", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).MaxDescriptorSetUpdateAfterBindUniformBuffers)},
+		{"maxDescriptorSetUpdateAfterBindUniformBuffersDynamic", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).MaxDescriptorSetUpdateAfterBindUniformBuffersDynamic)},
+		{"maxDescriptorSetUpdateAfterBindStorageBuffers", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).MaxDescriptorSetUpdateAfterBindStorageBuffers)},
+		{"maxDescriptorSetUpdateAfterBindStorageBuffersDynamic", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).MaxDescriptorSetUpdateAfterBindStorageBuffersDynamic)},
+		{"maxDescriptorSetUpdateAfterBindSampledImages", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).MaxDescriptorSetUpdateAfterBindSampledImages)},
+		{"maxDescriptorSetUpdateAfterBindStorageImages", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).MaxDescriptorSetUpdateAfterBindStorageImages)},
+		{"maxDescriptorSetUpdateAfterBindInputAttachments", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).MaxDescriptorSetUpdateAfterBindInputAttachments)},
+		{"supportedDepthResolveModes", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).SupportedDepthResolveModes)},
+		{"supportedStencilResolveModes", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).SupportedStencilResolveModes)},
+		{"independentResolveNone", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).IndependentResolveNone)},
+		{"independentResolve", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).IndependentResolve)},
+		{"filterMinmaxSingleComponentFormats", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).FilterMinmaxSingleComponentFormats)},
+		{"filterMinmaxImageComponentMapping", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).FilterMinmaxImageComponentMapping)},
+		{"maxTimelineSemaphoreValueDifference", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).MaxTimelineSemaphoreValueDifference)},
+		{"framebufferIntegerColorSampleCounts", unsafe.Offsetof((*PhysicalDeviceVulkan12Properties)(nil).FramebufferIntegerColorSampleCounts)},
+	}},
+	{"VkImageFormatListCreateInfo", unsafe.Sizeof(*(*ImageFormatListCreateInfo)(nil)), unsafe.Alignof(*(*ImageFormatListCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ImageFormatListCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ImageFormatListCreateInfo)(nil).PNext)},
+		{"viewFormatCount", unsafe.Offsetof((*ImageFormatListCreateInfo)(nil).ViewFormatCount)},
+		{"pViewFormats", unsafe.Offsetof((*ImageFormatListCreateInfo)(nil).PViewFormats)},
+	}},
+	{"VkAttachmentDescription2", unsafe.Sizeof(*(*AttachmentDescription2)(nil)), unsafe.Alignof(*(*AttachmentDescription2)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*AttachmentDescription2)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*AttachmentDescription2)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*AttachmentDescription2)(nil).Flags)},
+		{"format", unsafe.Offsetof((*AttachmentDescription2)(nil).Format)},
+		{"samples", unsafe.Offsetof((*AttachmentDescription2)(nil).Samples)},
+		{"loadOp", unsafe.Offsetof((*AttachmentDescription2)(nil).LoadOp)},
+		{"storeOp", unsafe.Offsetof((*AttachmentDescription2)(nil).StoreOp)},
+		{"stencilLoadOp", unsafe.Offsetof((*AttachmentDescription2)(nil).StencilLoadOp)},
+		{"stencilStoreOp", unsafe.Offsetof((*AttachmentDescription2)(nil).StencilStoreOp)},
+		{"initialLayout", unsafe.Offsetof((*AttachmentDescription2)(nil).InitialLayout)},
+		{"finalLayout", unsafe.Offsetof((*AttachmentDescription2)(nil).FinalLayout)},
+	}},
+	{"VkAttachmentReference2", unsafe.Sizeof(*(*AttachmentReference2)(nil)), unsafe.Alignof(*(*AttachmentReference2)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*AttachmentReference2)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*AttachmentReference2)(nil).PNext)},
+		{"attachment", unsafe.Offsetof((*AttachmentReference2)(nil).Attachment)},
+		{"layout", unsafe.Offsetof((*AttachmentReference2)(nil).Layout)},
+		{"aspectMask", unsafe.Offsetof((*AttachmentReference2)(nil).AspectMask)},
+	}},
+	{"VkSubpassDescription2", unsafe.Sizeof(*(*SubpassDescription2)(nil)), unsafe.Alignof(*(*SubpassDescription2)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SubpassDescription2)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SubpassDescription2)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*SubpassDescription2)(nil).Flags)},
+		{"pipelineBindPoint", unsafe.Offsetof((*SubpassDescription2)(nil).PipelineBindPoint)},
+		{"viewMask", unsafe.Offsetof((*SubpassDescription2)(nil).ViewMask)},
+		{"inputAttachmentCount", unsafe.Offsetof((*SubpassDescription2)(nil).InputAttachmentCount)},
+		{"pInputAttachments", unsafe.Offsetof((*SubpassDescription2)(nil).PInputAttachments)},
+		{"colorAttachmentCount", unsafe.Offsetof((*SubpassDescription2)(nil).ColorAttachmentCount)},
+		{"pColorAttachments", unsafe.Offsetof((*SubpassDescription2)(nil).PColorAttachments)},
+		{"pResolveAttachments", unsafe.Offsetof((*SubpassDescription2)(nil).PResolveAttachments)},
+		{"pDepthStencilAttachment", unsafe.Offsetof((*SubpassDescription2)(nil).PDepthStencilAttachment)},
+		{"preserveAttachmentCount", unsafe.Offsetof((*SubpassDescription2)(nil).PreserveAttachmentCount)},
+		{"pPreserveAttachments", unsafe.Offsetof((*SubpassDescription2)(nil).PPreserveAttachments)},
+	}},
+	{"VkSubpassDependency2", unsafe.Sizeof(*(*SubpassDependency2)(nil)), unsafe.Alignof(*(*SubpassDependency2)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SubpassDependency2)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SubpassDependency2)(nil).PNext)},
+		{"srcSubpass", unsafe.Offsetof((*SubpassDependency2)(nil).SrcSubpass)},
+		{"dstSubpass", unsafe.Offsetof((*SubpassDependency2)(nil).DstSubpass)},
+		{"srcStageMask", unsafe.Offsetof((*SubpassDependency2)(nil).SrcStageMask)},
+		{"dstStageMask", unsafe.Offsetof((*SubpassDependency2)(nil).DstStageMask)},
+		{"srcAccessMask", unsafe.Offsetof((*SubpassDependency2)(nil).SrcAccessMask)},
+		{"dstAccessMask", unsafe.Offsetof((*SubpassDependency2)(nil).DstAccessMask)},
+		{"dependencyFlags", unsafe.Offsetof((*SubpassDependency2)(nil).DependencyFlags)},
+		{"viewOffset", unsafe.Offsetof((*SubpassDependency2)(nil).ViewOffset)},
+	}},
+	{"VkRenderPassCreateInfo2", unsafe.Sizeof(*(*RenderPassCreateInfo2)(nil)), unsafe.Alignof(*(*RenderPassCreateInfo2)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*RenderPassCreateInfo2)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*RenderPassCreateInfo2)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*RenderPassCreateInfo2)(nil).Flags)},
+		{"attachmentCount", unsafe.Offsetof((*RenderPassCreateInfo2)(nil).AttachmentCount)},
+		{"pAttachments", unsafe.Offsetof((*RenderPassCreateInfo2)(nil).PAttachments)},
+		{"subpassCount", unsafe.Offsetof((*RenderPassCreateInfo2)(nil).SubpassCount)},
+		{"pSubpasses", unsafe.Offsetof((*RenderPassCreateInfo2)(nil).PSubpasses)},
+		{"dependencyCount", unsafe.Offsetof((*RenderPassCreateInfo2)(nil).DependencyCount)},
+		{"pDependencies", unsafe.Offsetof((*RenderPassCreateInfo2)(nil).PDependencies)},
+		{"correlatedViewMaskCount", unsafe.Offsetof((*RenderPassCreateInfo2)(nil).CorrelatedViewMaskCount)},
+		{"pCorrelatedViewMasks", unsafe.Offsetof((*RenderPassCreateInfo2)(nil).PCorrelatedViewMasks)},
+	}},
+	{"VkSubpassBeginInfo", unsafe.Sizeof(*(*SubpassBeginInfo)(nil)), unsafe.Alignof(*(*SubpassBeginInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SubpassBeginInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SubpassBeginInfo)(nil).PNext)},
+		{"contents", unsafe.Offsetof((*SubpassBeginInfo)(nil).Contents)},
+	}},
+	{"VkSubpassEndInfo", unsafe.Sizeof(*(*SubpassEndInfo)(nil)), unsafe.Alignof(*(*SubpassEndInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SubpassEndInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SubpassEndInfo)(nil).PNext)},
+	}},
+	{"VkPhysicalDevice8BitStorageFeatures", unsafe.Sizeof(*(*PhysicalDevice8BitStorageFeatures)(nil)), unsafe.Alignof(*(*PhysicalDevice8BitStorageFeatures)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDevice8BitStorageFeatures)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDevice8BitStorageFeatures)(nil).PNext)},
+		{"storageBuffer8BitAccess", unsafe.Offsetof((*PhysicalDevice8BitStorageFeatures)(nil).StorageBuffer8BitAccess)},
+		{"uniformAndStorageBuffer8BitAccess", unsafe.Offsetof((*PhysicalDevice8BitStorageFeatures)(nil).UniformAndStorageBuffer8BitAccess)},
+		{"storagePushConstant8", unsafe.Offsetof((*PhysicalDevice8BitStorageFeatures)(nil).StoragePushConstant8)},
+	}},
+	{"VkPhysicalDeviceDriverProperties", unsafe.Sizeof(*(*PhysicalDeviceDriverProperties)(nil)), unsafe.Alignof(*(*PhysicalDeviceDriverProperties)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceDriverProperties)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceDriverProperties)(nil).PNext)},
+		{"driverID", unsafe.Offsetof((*PhysicalDeviceDriverProperties)(nil).DriverID)},
+		{"driverName", unsafe.Offsetof((*PhysicalDeviceDriverProperties)(nil).DriverName)},
+		{"driverInfo", unsafe.Offsetof((*PhysicalDeviceDriverProperties)(nil).DriverInfo)},
+		{"conformanceVersion", unsafe.Offsetof((*PhysicalDeviceDriverProperties)(nil).ConformanceVersion)},
+	}},
+	{"VkPhysicalDeviceShaderAtomicInt64Features", unsafe.Sizeof(*(*PhysicalDeviceShaderAtomicInt64Features)(nil)), unsafe.Alignof(*(*PhysicalDeviceShaderAtomicInt64Features)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceShaderAtomicInt64Features)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceShaderAtomicInt64Features)(nil).PNext)},
+		{"shaderBufferInt64Atomics", unsafe.Offsetof((*PhysicalDeviceShaderAtomicInt64Features)(nil).ShaderBufferInt64Atomics)},
+		{"shaderSharedInt64Atomics", unsafe.Offsetof((*PhysicalDeviceShaderAtomicInt64Features)(nil).ShaderSharedInt64Atomics)},
+	}},
+	{"VkPhysicalDeviceShaderFloat16Int8Features", unsafe.Sizeof(*(*PhysicalDeviceShaderFloat16Int8Features)(nil)), unsafe.Alignof(*(*PhysicalDeviceShaderFloat16Int8Features)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceShaderFloat16Int8Features)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceShaderFloat16Int8Features)(nil).PNext)},
+		{"shaderFloat16", unsafe.Offsetof((*PhysicalDeviceShaderFloat16Int8Features)(nil).ShaderFloat16)},
+		{"shaderInt8", unsafe.Offsetof((*PhysicalDeviceShaderFloat16Int8Features)(nil).ShaderInt8)},
+	}},
+	{"VkPhysicalDeviceFloatControlsProperties", unsafe.Sizeof(*(*PhysicalDeviceFloatControlsProperties)(nil)), unsafe.Alignof(*(*PhysicalDeviceFloatControlsProperties)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceFloatControlsProperties)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceFloatControlsProperties)(nil).PNext)},
+		{"denormBehaviorIndependence", unsafe.Offsetof((*PhysicalDeviceFloatControlsProperties)(nil).DenormBehaviorIndependence)},
+		{"roundingModeIndependence", unsafe.Offsetof((*PhysicalDeviceFloatControlsProperties)(nil).RoundingModeIndependence)},
+		{"shaderSignedZeroInfNanPreserveFloat16", unsafe.Offsetof((*PhysicalDeviceFloatControlsProperties)(nil).ShaderSignedZeroInfNanPreserveFloat16)},
+		{"shaderSignedZeroInfNanPreserveFloat32", unsafe.Offsetof((*PhysicalDeviceFloatControlsProperties)(nil).ShaderSignedZeroInfNanPreserveFloat32)},
+		{"shaderSignedZeroInfNanPreserveFloat64", unsafe.Offsetof((*PhysicalDeviceFloatControlsProperties)(nil).ShaderSignedZeroInfNanPreserveFloat64)},
+		{"shaderDenormPreserveFloat16", unsafe.Offsetof((*PhysicalDeviceFloatControlsProperties)(nil).ShaderDenormPreserveFloat16)},
+		{"shaderDenormPreserveFloat32", unsafe.Offsetof((*PhysicalDeviceFloatControlsProperties)(nil).ShaderDenormPreserveFloat32)},
+		{"shaderDenormPreserveFloat64", unsafe.Offsetof((*PhysicalDeviceFloatControlsProperties)(nil).ShaderDenormPreserveFloat64)},
+		{"shaderDenormFlushToZeroFloat16", unsafe.Offsetof((*PhysicalDeviceFloatControlsProperties)(nil).ShaderDenormFlushToZeroFloat16)},
+		{"shaderDenormFlushToZeroFloat32", unsafe.Offsetof((*PhysicalDeviceFloatControlsProperties)(nil).ShaderDenormFlushToZeroFloat32)},
+		{"shaderDenormFlushToZeroFloat64", unsafe.Offsetof((*PhysicalDeviceFloatControlsProperties)(nil).ShaderDenormFlushToZeroFloat64)},
+		{"shaderRoundingModeRTEFloat16", unsafe.Offsetof((*PhysicalDeviceFloatControlsProperties)(nil).ShaderRoundingModeRTEFloat16)},
+		{"shaderRoundingModeRTEFloat32", unsafe.Offsetof((*PhysicalDeviceFloatControlsProperties)(nil).ShaderRoundingModeRTEFloat32)},
+		{"shaderRoundingModeRTEFloat64", unsafe.Offsetof((*PhysicalDeviceFloatControlsProperties)(nil).ShaderRoundingModeRTEFloat64)},
+		{"shaderRoundingModeRTZFloat16", unsafe.Offsetof((*PhysicalDeviceFloatControlsProperties)(nil).ShaderRoundingModeRTZFloat16)},
+		{"shaderRoundingModeRTZFloat32", unsafe.Offsetof((*PhysicalDeviceFloatControlsProperties)(nil).ShaderRoundingModeRTZFloat32)},
+		{"shaderRoundingModeRTZFloat64", unsafe.Offsetof((*PhysicalDeviceFloatControlsProperties)(nil).ShaderRoundingModeRTZFloat64)},
+	}},
+	{"VkDescriptorSetLayoutBindingFlagsCreateInfo", unsafe.Sizeof(*(*DescriptorSetLayoutBindingFlagsCreateInfo)(nil)), unsafe.Alignof(*(*DescriptorSetLayoutBindingFlagsCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DescriptorSetLayoutBindingFlagsCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DescriptorSetLayoutBindingFlagsCreateInfo)(nil).PNext)},
+		{"bindingCount", unsafe.Offsetof((*DescriptorSetLayoutBindingFlagsCreateInfo)(nil).BindingCount)},
+		{"pBindingFlags", unsafe.Offsetof((*DescriptorSetLayoutBindingFlagsCreateInfo)(nil).PBindingFlags)},
+	}},
+	{"VkPhysicalDeviceDescriptorIndexingFeatures", unsafe.Sizeof(*(*PhysicalDeviceDescriptorIndexingFeatures)(nil)), unsafe.Alignof(*(*PhysicalDeviceDescriptorIndexingFeatures)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingFeatures)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingFeatures)(nil).PNext)},
+		{"shaderInputAttachmentArrayDynamicIndexing", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingFeatures)(nil).ShaderInputAttachmentArrayDynamicIndexing)},
+		{"shaderUniformTexelBufferArrayDynamicIndexing", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingFeatures)(nil).ShaderUniformTexelBufferArrayDynamicIndexing)},
+		{"shaderStorageTexelBufferArrayDynamicIndexing", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingFeatures)(nil).ShaderStorageTexelBufferArrayDynamicIndexing)},
+		{"shaderUniformBufferArrayNonUniformIndexing", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingFeatures)(nil).ShaderUniformBufferArrayNonUniformIndexing)},
+		{"shaderSampledImageArrayNonUniformIndexing", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingFeatures)(nil).ShaderSampledImageArrayNonUniformIndexing)},
+		{"shaderStorageBufferArrayNonUniformIndexing", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingFeatures)(nil).ShaderStorageBufferArrayNonUniformIndexing)},
+		{"shaderStorageImageArrayNonUniformIndexing", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingFeatures)(nil).ShaderStorageImageArrayNonUniformIndexing)},
+		{"shaderInputAttachmentArrayNonUniformIndexing", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingFeatures)(nil).ShaderInputAttachmentArrayNonUniformIndexing)},
+		{"shaderUniformTexelBufferArrayNonUniformIndexing", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingFeatures)(nil).ShaderUniformTexelBufferArrayNonUniformIndexing)},
+		{"shaderStorageTexelBufferArrayNonUniformIndexing", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingFeatures)(nil).ShaderStorageTexelBufferArrayNonUniformIndexing)},
+		{"descriptorBindingUniformBufferUpdateAfterBind", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingFeatures)(nil).DescriptorBindingUniformBufferUpdateAfterBind)},
+		{"descriptorBindingSampledImageUpdateAfterBind", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingFeatures)(nil).DescriptorBindingSampledImageUpdateAfterBind)},
+		{"descriptorBindingStorageImageUpdateAfterBind", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingFeatures)(nil).DescriptorBindingStorageImageUpdateAfterBind)},
+		{"descriptorBindingStorageBufferUpdateAfterBind", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingFeatures)(nil).DescriptorBindingStorageBufferUpdateAfterBind)},
+		{"descriptorBindingUniformTexelBufferUpdateAfterBind", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingFeatures)(nil).DescriptorBindingUniformTexelBufferUpdateAfterBind)},
+		{"descriptorBindingStorageTexelBufferUpdateAfterBind", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingFeatures)(nil).DescriptorBindingStorageTexelBufferUpdateAfterBind)},
+		{"descriptorBindingUpdateUnusedWhilePending", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingFeatures)(nil).DescriptorBindingUpdateUnusedWhilePending)},
+		{"descriptorBindingPartiallyBound", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingFeatures)(nil).DescriptorBindingPartiallyBound)},
+		{"descriptorBindingVariableDescriptorCount", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingFeatures)(nil).DescriptorBindingVariableDescriptorCount)},
+		{"runtimeDescriptorArray", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingFeatures)(nil).RuntimeDescriptorArray)},
+	}},
+	{"VkPhysicalDeviceDescriptorIndexingProperties", unsafe.Sizeof(*(*PhysicalDeviceDescriptorIndexingProperties)(nil)), unsafe.Alignof(*(*PhysicalDeviceDescriptorIndexingProperties)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingProperties)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingProperties)(nil).PNext)},
+		{"maxUpdateAfterBindDescriptorsInAllPools", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingProperties)(nil).MaxUpdateAfterBindDescriptorsInAllPools)},
+		{"shaderUniformBufferArrayNonUniformIndexingNative", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingProperties)(nil).ShaderUniformBufferArrayNonUniformIndexingNative)},
+		{"shaderSampledImageArrayNonUniformIndexingNative", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingProperties)(nil).ShaderSampledImageArrayNonUniformIndexingNative)},
+		{"shaderStorageBufferArrayNonUniformIndexingNative", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingProperties)(nil).ShaderStorageBufferArrayNonUniformIndexingNative)},
+		{"shaderStorageImageArrayNonUniformIndexingNative", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingProperties)(nil).ShaderStorageImageArrayNonUniformIndexingNative)},
+		{"shaderInputAttachmentArrayNonUniformIndexingNative", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingProperties)(nil).ShaderInputAttachmentArrayNonUniformIndexingNative)},
+		{"robustBufferAccessUpdateAfterBind", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingProperties)(nil).RobustBufferAccessUpdateAfterBind)},
+		{"quadDivergentImplicitLod", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingProperties)(nil).QuadDivergentImplicitLod)},
+		{"maxPerStageDescriptorUpdateAfterBindSamplers", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingProperties)(nil).MaxPerStageDescriptorUpdateAfterBindSamplers)},
+		{"maxPerStageDescriptorUpdateAfterBindUniformBuffers", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingProperties)(nil).MaxPerStageDescriptorUpdateAfterBindUniformBuffers)},
+		{"maxPerStageDescriptorUpdateAfterBindStorageBuffers", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingProperties)(nil).MaxPerStageDescriptorUpdateAfterBindStorageBuffers)},
+		{"maxPerStageDescriptorUpdateAfterBindSampledImages", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingProperties)(nil).MaxPerStageDescriptorUpdateAfterBindSampledImages)},
+		{"maxPerStageDescriptorUpdateAfterBindStorageImages", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingProperties)(nil).MaxPerStageDescriptorUpdateAfterBindStorageImages)},
+		{"maxPerStageDescriptorUpdateAfterBindInputAttachments", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingProperties)(nil).MaxPerStageDescriptorUpdateAfterBindInputAttachments)},
+		{"maxPerStageUpdateAfterBindResources", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingProperties)(nil).MaxPerStageUpdateAfterBindResources)},
+		{"maxDescriptorSetUpdateAfterBindSamplers", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingProperties)(nil).MaxDescriptorSetUpdateAfterBindSamplers)},
+		{"maxDescriptorSetUpdateAfterBindUniformBuffers", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingProperties)(nil).MaxDescriptorSetUpdateAfterBindUniformBuffers)},
+		{"maxDescriptorSetUpdateAfterBindUniformBuffersDynamic", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingProperties)(nil).MaxDescriptorSetUpdateAfterBindUniformBuffersDynamic)},
+		{"maxDescriptorSetUpdateAfterBindStorageBuffers", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingProperties)(nil).MaxDescriptorSetUpdateAfterBindStorageBuffers)},
+		{"maxDescriptorSetUpdateAfterBindStorageBuffersDynamic", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingProperties)(nil).MaxDescriptorSetUpdateAfterBindStorageBuffersDynamic)},
+		{"maxDescriptorSetUpdateAfterBindSampledImages", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingProperties)(nil).MaxDescriptorSetUpdateAfterBindSampledImages)},
+		{"maxDescriptorSetUpdateAfterBindStorageImages", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingProperties)(nil).MaxDescriptorSetUpdateAfterBindStorageImages)},
+		{"maxDescriptorSetUpdateAfterBindInputAttachments", unsafe.Offsetof((*PhysicalDeviceDescriptorIndexingProperties)(nil).MaxDescriptorSetUpdateAfterBindInputAttachments)},
+	}},
+	{"VkDescriptorSetVariableDescriptorCountAllocateInfo", unsafe.Sizeof(*(*DescriptorSetVariableDescriptorCountAllocateInfo)(nil)), unsafe.Alignof(*(*DescriptorSetVariableDescriptorCountAllocateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DescriptorSetVariableDescriptorCountAllocateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DescriptorSetVariableDescriptorCountAllocateInfo)(nil).PNext)},
+		{"descriptorSetCount", unsafe.Offsetof((*DescriptorSetVariableDescriptorCountAllocateInfo)(nil).DescriptorSetCount)},
+		{"pDescriptorCounts", unsafe.Offsetof((*DescriptorSetVariableDescriptorCountAllocateInfo)(nil).PDescriptorCounts)},
+	}},
+	{"VkDescriptorSetVariableDescriptorCountLayoutSupport", unsafe.Sizeof(*(*DescriptorSetVariableDescriptorCountLayoutSupport)(nil)), unsafe.Alignof(*(*DescriptorSetVariableDescriptorCountLayoutSupport)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DescriptorSetVariableDescriptorCountLayoutSupport)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DescriptorSetVariableDescriptorCountLayoutSupport)(nil).PNext)},
+		{"maxVariableDescriptorCount", unsafe.Offsetof((*DescriptorSetVariableDescriptorCountLayoutSupport)(nil).MaxVariableDescriptorCount)},
+	}},
+	{"VkSubpassDescriptionDepthStencilResolve", unsafe.Sizeof(*(*SubpassDescriptionDepthStencilResolve)(nil)), unsafe.Alignof(*(*SubpassDescriptionDepthStencilResolve)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SubpassDescriptionDepthStencilResolve)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SubpassDescriptionDepthStencilResolve)(nil).PNext)},
+		{"depthResolveMode", unsafe.Offsetof((*SubpassDescriptionDepthStencilResolve)(nil).DepthResolveMode)},
+		{"stencilResolveMode", unsafe.Offsetof((*SubpassDescriptionDepthStencilResolve)(nil).StencilResolveMode)},
+		{"pDepthStencilResolveAttachment", unsafe.Offsetof((*SubpassDescriptionDepthStencilResolve)(nil).PDepthStencilResolveAttachment)},
+	}},
+	{"VkPhysicalDeviceDepthStencilResolveProperties", unsafe.Sizeof(*(*PhysicalDeviceDepthStencilResolveProperties)(nil)), unsafe.Alignof(*(*PhysicalDeviceDepthStencilResolveProperties)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceDepthStencilResolveProperties)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceDepthStencilResolveProperties)(nil).PNext)},
+		{"supportedDepthResolveModes", unsafe.Offsetof((*PhysicalDeviceDepthStencilResolveProperties)(nil).SupportedDepthResolveModes)},
+		{"supportedStencilResolveModes", unsafe.Offsetof((*PhysicalDeviceDepthStencilResolveProperties)(nil).SupportedStencilResolveModes)},
+		{"independentResolveNone", unsafe.Offsetof((*PhysicalDeviceDepthStencilResolveProperties)(nil).IndependentResolveNone)},
+		{"independentResolve", unsafe.Offsetof((*PhysicalDeviceDepthStencilResolveProperties)(nil).IndependentResolve)},
+	}},
+	{"VkPhysicalDeviceScalarBlockLayoutFeatures", unsafe.Sizeof(*(*PhysicalDeviceScalarBlockLayoutFeatures)(nil)), unsafe.Alignof(*(*PhysicalDeviceScalarBlockLayoutFeatures)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceScalarBlockLayoutFeatures)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceScalarBlockLayoutFeatures)(nil).PNext)},
+		{"scalarBlockLayout", unsafe.Offsetof((*PhysicalDeviceScalarBlockLayoutFeatures)(nil).ScalarBlockLayout)},
+	}},
+	{"VkImageStencilUsageCreateInfo", unsafe.Sizeof(*(*ImageStencilUsageCreateInfo)(nil)), unsafe.Alignof(*(*ImageStencilUsageCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ImageStencilUsageCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ImageStencilUsageCreateInfo)(nil).PNext)},
+		{"stencilUsage", unsafe.Offsetof((*ImageStencilUsageCreateInfo)(nil).StencilUsage)},
+	}},
+	{"VkSamplerReductionModeCreateInfo", unsafe.Sizeof(*(*SamplerReductionModeCreateInfo)(nil)), unsafe.Alignof(*(*SamplerReductionModeCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SamplerReductionModeCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SamplerReductionModeCreateInfo)(nil).PNext)},
+		{"reductionMode", unsafe.Offsetof((*SamplerReductionModeCreateInfo)(nil).ReductionMode)},
+	}},
+	{"VkPhysicalDeviceSamplerFilterMinmaxProperties", unsafe.Sizeof(*(*PhysicalDeviceSamplerFilterMinmaxProperties)(nil)), unsafe.Alignof(*(*PhysicalDeviceSamplerFilterMinmaxProperties)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceSamplerFilterMinmaxProperties)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceSamplerFilterMinmaxProperties)(nil).PNext)},
+		{"filterMinmaxSingleComponentFormats", unsafe.Offsetof((*PhysicalDeviceSamplerFilterMinmaxProperties)(nil).FilterMinmaxSingleComponentFormats)},
+		{"filterMinmaxImageComponentMapping", unsafe.Offsetof((*PhysicalDeviceSamplerFilterMinmaxProperties)(nil).FilterMinmaxImageComponentMapping)},
+	}},
+	{"VkPhysicalDeviceVulkanMemoryModelFeatures", unsafe.Sizeof(*(*PhysicalDeviceVulkanMemoryModelFeatures)(nil)), unsafe.Alignof(*(*PhysicalDeviceVulkanMemoryModelFeatures)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceVulkanMemoryModelFeatures)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceVulkanMemoryModelFeatures)(nil).PNext)},
+		{"vulkanMemoryModel", unsafe.Offsetof((*PhysicalDeviceVulkanMemoryModelFeatures)(nil).VulkanMemoryModel)},
+		{"vulkanMemoryModelDeviceScope", unsafe.Offsetof((*PhysicalDeviceVulkanMemoryModelFeatures)(nil).VulkanMemoryModelDeviceScope)},
+		{"vulkanMemoryModelAvailabilityVisibilityChains", unsafe.Offsetof((*PhysicalDeviceVulkanMemoryModelFeatures)(nil).VulkanMemoryModelAvailabilityVisibilityChains)},
+	}},
+	{"VkPhysicalDeviceImagelessFramebufferFeatures", unsafe.Sizeof(*(*PhysicalDeviceImagelessFramebufferFeatures)(nil)), unsafe.Alignof(*(*PhysicalDeviceImagelessFramebufferFeatures)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceImagelessFramebufferFeatures)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceImagelessFramebufferFeatures)(nil).PNext)},
+		{"imagelessFramebuffer", unsafe.Offsetof((*PhysicalDeviceImagelessFramebufferFeatures)(nil).ImagelessFramebuffer)},
+	}},
+	{"VkFramebufferAttachmentImageInfo", unsafe.Sizeof(*(*FramebufferAttachmentImageInfo)(nil)), unsafe.Alignof(*(*FramebufferAttachmentImageInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*FramebufferAttachmentImageInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*FramebufferAttachmentImageInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*FramebufferAttachmentImageInfo)(nil).Flags)},
+		{"usage", unsafe.Offsetof((*FramebufferAttachmentImageInfo)(nil).Usage)},
+		{"width", unsafe.Offsetof((*FramebufferAttachmentImageInfo)(nil).Width)},
+		{"height", unsafe.Offsetof((*FramebufferAttachmentImageInfo)(nil).Height)},
+		{"layerCount", unsafe.Offsetof((*FramebufferAttachmentImageInfo)(nil).LayerCount)},
+		{"viewFormatCount", unsafe.Offsetof((*FramebufferAttachmentImageInfo)(nil).ViewFormatCount)},
+		{"pViewFormats", unsafe.Offsetof((*FramebufferAttachmentImageInfo)(nil).PViewFormats)},
+	}},
+	{"VkFramebufferAttachmentsCreateInfo", unsafe.Sizeof(*(*FramebufferAttachmentsCreateInfo)(nil)), unsafe.Alignof(*(*FramebufferAttachmentsCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*FramebufferAttachmentsCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*FramebufferAttachmentsCreateInfo)(nil).PNext)},
+		{"attachmentImageInfoCount", unsafe.Offsetof((*FramebufferAttachmentsCreateInfo)(nil).AttachmentImageInfoCount)},
+		{"pAttachmentImageInfos", unsafe.Offsetof((*FramebufferAttachmentsCreateInfo)(nil).PAttachmentImageInfos)},
+	}},
+	{"VkRenderPassAttachmentBeginInfo", unsafe.Sizeof(*(*RenderPassAttachmentBeginInfo)(nil)), unsafe.Alignof(*(*RenderPassAttachmentBeginInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*RenderPassAttachmentBeginInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*RenderPassAttachmentBeginInfo)(nil).PNext)},
+		{"attachmentCount", unsafe.Offsetof((*RenderPassAttachmentBeginInfo)(nil).AttachmentCount)},
+		{"pAttachments", unsafe.Offsetof((*RenderPassAttachmentBeginInfo)(nil).PAttachments)},
+	}},
+	{"VkPhysicalDeviceUniformBufferStandardLayoutFeatures", unsafe.Sizeof(*(*PhysicalDeviceUniformBufferStandardLayoutFeatures)(nil)), unsafe.Alignof(*(*PhysicalDeviceUniformBufferStandardLayoutFeatures)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceUniformBufferStandardLayoutFeatures)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceUniformBufferStandardLayoutFeatures)(nil).PNext)},
+		{"uniformBufferStandardLayout", unsafe.Offsetof((*PhysicalDeviceUniformBufferStandardLayoutFeatures)(nil).UniformBufferStandardLayout)},
+	}},
+	{"VkPhysicalDeviceShaderSubgroupExtendedTypesFeatures", unsafe.Sizeof(*(*PhysicalDeviceShaderSubgroupExtendedTypesFeatures)(nil)), unsafe.Alignof(*(*PhysicalDeviceShaderSubgroupExtendedTypesFeatures)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceShaderSubgroupExtendedTypesFeatures)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceShaderSubgroupExtendedTypesFeatures)(nil).PNext)},
+		{"shaderSubgroupExtendedTypes", unsafe.Offsetof((*PhysicalDeviceShaderSubgroupExtendedTypesFeatures)(nil).ShaderSubgroupExtendedTypes)},
+	}},
+	{"VkPhysicalDeviceSeparateDepthStencilLayoutsFeatures", unsafe.Sizeof(*(*PhysicalDeviceSeparateDepthStencilLayoutsFeatures)(nil)), unsafe.Alignof(*(*PhysicalDeviceSeparateDepthStencilLayoutsFeatures)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceSeparateDepthStencilLayoutsFeatures)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceSeparateDepthStencilLayoutsFeatures)(nil).PNext)},
+		{"separateDepthStencilLayouts", unsafe.Offsetof((*PhysicalDeviceSeparateDepthStencilLayoutsFeatures)(nil).SeparateDepthStencilLayouts)},
+	}},
+	{"VkAttachmentReferenceStencilLayout", unsafe.Sizeof(*(*AttachmentReferenceStencilLayout)(nil)), unsafe.Alignof(*(*AttachmentReferenceStencilLayout)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*AttachmentReferenceStencilLayout)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*AttachmentReferenceStencilLayout)(nil).PNext)},
+		{"stencilLayout", unsafe.Offsetof((*AttachmentReferenceStencilLayout)(nil).StencilLayout)},
+	}},
+	{"VkAttachmentDescriptionStencilLayout", unsafe.Sizeof(*(*AttachmentDescriptionStencilLayout)(nil)), unsafe.Alignof(*(*AttachmentDescriptionStencilLayout)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*AttachmentDescriptionStencilLayout)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*AttachmentDescriptionStencilLayout)(nil).PNext)},
+		{"stencilInitialLayout", unsafe.Offsetof((*AttachmentDescriptionStencilLayout)(nil).StencilInitialLayout)},
+		{"stencilFinalLayout", unsafe.Offsetof((*AttachmentDescriptionStencilLayout)(nil).StencilFinalLayout)},
+	}},
+	{"VkPhysicalDeviceHostQueryResetFeatures", unsafe.Sizeof(*(*PhysicalDeviceHostQueryResetFeatures)(nil)), unsafe.Alignof(*(*PhysicalDeviceHostQueryResetFeatures)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceHostQueryResetFeatures)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceHostQueryResetFeatures)(nil).PNext)},
+		{"hostQueryReset", unsafe.Offsetof((*PhysicalDeviceHostQueryResetFeatures)(nil).HostQueryReset)},
+	}},
+	{"VkPhysicalDeviceTimelineSemaphoreFeatures", unsafe.Sizeof(*(*PhysicalDeviceTimelineSemaphoreFeatures)(nil)), unsafe.Alignof(*(*PhysicalDeviceTimelineSemaphoreFeatures)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceTimelineSemaphoreFeatures)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceTimelineSemaphoreFeatures)(nil).PNext)},
+		{"timelineSemaphore", unsafe.Offsetof((*PhysicalDeviceTimelineSemaphoreFeatures)(nil).TimelineSemaphore)},
+	}},
+	{"VkPhysicalDeviceTimelineSemaphoreProperties", unsafe.Sizeof(*(*PhysicalDeviceTimelineSemaphoreProperties)(nil)), unsafe.Alignof(*(*PhysicalDeviceTimelineSemaphoreProperties)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceTimelineSemaphoreProperties)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceTimelineSemaphoreProperties)(nil).PNext)},
+		{"maxTimelineSemaphoreValueDifference", unsafe.Offsetof((*PhysicalDeviceTimelineSemaphoreProperties)(nil).MaxTimelineSemaphoreValueDifference)},
+	}},
+	{"VkSemaphoreTypeCreateInfo", unsafe.Sizeof(*(*SemaphoreTypeCreateInfo)(nil)), unsafe.Alignof(*(*SemaphoreTypeCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SemaphoreTypeCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SemaphoreTypeCreateInfo)(nil).PNext)},
+		{"semaphoreType", unsafe.Offsetof((*SemaphoreTypeCreateInfo)(nil).SemaphoreType)},
+		{"initialValue", unsafe.Offsetof((*SemaphoreTypeCreateInfo)(nil).InitialValue)},
+	}},
+	{"VkTimelineSemaphoreSubmitInfo", unsafe.Sizeof(*(*TimelineSemaphoreSubmitInfo)(nil)), unsafe.Alignof(*(*TimelineSemaphoreSubmitInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*TimelineSemaphoreSubmitInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*TimelineSemaphoreSubmitInfo)(nil).PNext)},
+		{"waitSemaphoreValueCount", unsafe.Offsetof((*TimelineSemaphoreSubmitInfo)(nil).WaitSemaphoreValueCount)},
+		{"pWaitSemaphoreValues", unsafe.Offsetof((*TimelineSemaphoreSubmitInfo)(nil).PWaitSemaphoreValues)},
+		{"signalSemaphoreValueCount", unsafe.Offsetof((*TimelineSemaphoreSubmitInfo)(nil).SignalSemaphoreValueCount)},
+		{"pSignalSemaphoreValues", unsafe.Offsetof((*TimelineSemaphoreSubmitInfo)(nil).PSignalSemaphoreValues)},
+	}},
+	{"VkSemaphoreWaitInfo", unsafe.Sizeof(*(*SemaphoreWaitInfo)(nil)), unsafe.Alignof(*(*SemaphoreWaitInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SemaphoreWaitInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SemaphoreWaitInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*SemaphoreWaitInfo)(nil).Flags)},
+		{"semaphoreCount", unsafe.Offsetof((*SemaphoreWaitInfo)(nil).SemaphoreCount)},
+		{"pSemaphores", unsafe.Offsetof((*SemaphoreWaitInfo)(nil).PSemaphores)},
+		{"pValues", unsafe.Offsetof((*SemaphoreWaitInfo)(nil).PValues)},
+	}},
+	{"VkSemaphoreSignalInfo", unsafe.Sizeof(*(*SemaphoreSignalInfo)(nil)), unsafe.Alignof(*(*SemaphoreSignalInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SemaphoreSignalInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SemaphoreSignalInfo)(nil).PNext)},
+		{"semaphore", unsafe.Offsetof((*SemaphoreSignalInfo)(nil).Semaphore)},
+		{"value", unsafe.Offsetof((*SemaphoreSignalInfo)(nil).Value)},
+	}},
+	{"VkPhysicalDeviceBufferDeviceAddressFeatures", unsafe.Sizeof(*(*PhysicalDeviceBufferDeviceAddressFeatures)(nil)), unsafe.Alignof(*(*PhysicalDeviceBufferDeviceAddressFeatures)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceBufferDeviceAddressFeatures)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceBufferDeviceAddressFeatures)(nil).PNext)},
+		{"bufferDeviceAddress", unsafe.Offsetof((*PhysicalDeviceBufferDeviceAddressFeatures)(nil).BufferDeviceAddress)},
+		{"bufferDeviceAddressCaptureReplay", unsafe.Offsetof((*PhysicalDeviceBufferDeviceAddressFeatures)(nil).BufferDeviceAddressCaptureReplay)},
+		{"bufferDeviceAddressMultiDevice", unsafe.Offsetof((*PhysicalDeviceBufferDeviceAddressFeatures)(nil).BufferDeviceAddressMultiDevice)},
+	}},
+	{"VkBufferDeviceAddressInfo", unsafe.Sizeof(*(*BufferDeviceAddressInfo)(nil)), unsafe.Alignof(*(*BufferDeviceAddressInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*BufferDeviceAddressInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*BufferDeviceAddressInfo)(nil).PNext)},
+		{"buffer", unsafe.Offsetof((*BufferDeviceAddressInfo)(nil).Buffer)},
+	}},
+	{"VkBufferOpaqueCaptureAddressCreateInfo", unsafe.Sizeof(*(*BufferOpaqueCaptureAddressCreateInfo)(nil)), unsafe.Alignof(*(*BufferOpaqueCaptureAddressCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*BufferOpaqueCaptureAddressCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*BufferOpaqueCaptureAddressCreateInfo)(nil).PNext)},
+		{"opaqueCaptureAddress", unsafe.Offsetof((*BufferOpaqueCaptureAddressCreateInfo)(nil).OpaqueCaptureAddress)},
+	}},
+	{"VkMemoryOpaqueCaptureAddressAllocateInfo", unsafe.Sizeof(*(*MemoryOpaqueCaptureAddressAllocateInfo)(nil)), unsafe.Alignof(*(*MemoryOpaqueCaptureAddressAllocateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*MemoryOpaqueCaptureAddressAllocateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*MemoryOpaqueCaptureAddressAllocateInfo)(nil).PNext)},
+		{"opaqueCaptureAddress", unsafe.Offsetof((*MemoryOpaqueCaptureAddressAllocateInfo)(nil).OpaqueCaptureAddress)},
+	}},
+	{"VkDeviceMemoryOpaqueCaptureAddressInfo", unsafe.Sizeof(*(*DeviceMemoryOpaqueCaptureAddressInfo)(nil)), unsafe.Alignof(*(*DeviceMemoryOpaqueCaptureAddressInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DeviceMemoryOpaqueCaptureAddressInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DeviceMemoryOpaqueCaptureAddressInfo)(nil).PNext)},
+		{"memory", unsafe.Offsetof((*DeviceMemoryOpaqueCaptureAddressInfo)(nil).Memory)},
+	}},
+	{"VkPhysicalDeviceVulkan13Features", unsafe.Sizeof(*(*PhysicalDeviceVulkan13Features)(nil)), unsafe.Alignof(*(*PhysicalDeviceVulkan13Features)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceVulkan13Features)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceVulkan13Features)(nil).PNext)},
+		{"robustImageAccess", unsafe.Offsetof((*PhysicalDeviceVulkan13Features)(nil).RobustImageAccess)},
+		{"inlineUniformBlock", unsafe.Offsetof((*PhysicalDeviceVulkan13Features)(nil).InlineUniformBlock)},
+		{"descriptorBindingInlineUniformBlockUpdateAfterBind", unsafe.Offsetof((*PhysicalDeviceVulkan13Features)(nil).DescriptorBindingInlineUniformBlockUpdateAfterBind)},
+		{"pipelineCreationCacheControl", unsafe.Offsetof((*PhysicalDeviceVulkan13Features)(nil).PipelineCreationCacheControl)},
+		{"privateData", unsafe.Offsetof((*PhysicalDeviceVulkan13Features)(nil).PrivateData)},
+		{"shaderDemoteToHelperInvocation", unsafe.Offsetof((*PhysicalDeviceVulkan13Features)(nil).ShaderDemoteToHelperInvocation)},
+		{"shaderTerminateInvocation", unsafe.Offsetof((*PhysicalDeviceVulkan13Features)(nil).ShaderTerminateInvocation)},
+		{"subgroupSizeControl", unsafe.Offsetof((*PhysicalDeviceVulkan13Features)(nil).SubgroupSizeControl)},
+		{"computeFullSubgroups", unsafe.Offsetof((*PhysicalDeviceVulkan13Features)(nil).ComputeFullSubgroups)},
+		{"synchronization2", unsafe.Offsetof((*PhysicalDeviceVulkan13Features)(nil).Synchronization2)},
+		{"textureCompressionASTC_HDR", unsafe.Offsetof((*PhysicalDeviceVulkan13Features)(nil).TextureCompressionASTCHDR)},
+		{"shaderZeroInitializeWorkgroupMemory", unsafe.Offsetof((*PhysicalDeviceVulkan13Features)(nil).ShaderZeroInitializeWorkgroupMemory)},
+		{"dynamicRendering", unsafe.Offsetof((*PhysicalDeviceVulkan13Features)(nil).DynamicRendering)},
+		{"shaderIntegerDotProduct", unsafe.Offsetof((*PhysicalDeviceVulkan13Features)(nil).ShaderIntegerDotProduct)},
+		{"maintenance4", unsafe.Offsetof((*PhysicalDeviceVulkan13Features)(nil).Maintenance4)},
+	}},
+	{"VkPhysicalDeviceVulkan13Properties", unsafe.Sizeof(*(*PhysicalDeviceVulkan13Properties)(nil)), unsafe.Alignof(*(*PhysicalDeviceVulkan13Properties)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).PNext)},
+		{"minSubgroupSize", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).MinSubgroupSize)},
+		{"maxSubgroupSize", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).MaxSubgroupSize)},
+		{"maxComputeWorkgroupSubgroups", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).MaxComputeWorkgroupSubgroups)},
+		{"requiredSubgroupSizeStages", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).RequiredSubgroupSizeStages)},
+		{"maxInlineUniformBlockSize", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).MaxInlineUniformBlockSize)},
+		{"maxPerStageDescriptorInlineUniformBlocks", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).MaxPerStageDescriptorInlineUniformBlocks)},
+		{"maxPerStageDescriptorUpdateAfterBindInlineUniformBlocks", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).MaxPerStageDescriptorUpdateAfterBindInlineUniformBlocks)},
+		{"maxDescriptorSetInlineUniformBlocks", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).MaxDescriptorSetInlineUniformBlocks)},
+		{"maxDescriptorSetUpdateAfterBindInlineUniformBlocks", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).MaxDescriptorSetUpdateAfterBindInlineUniformBlocks)},
+		{"maxInlineUniformTotalSize", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).MaxInlineUniformTotalSize)},
+		{"integerDotProduct8BitUnsignedAccelerated", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).IntegerDotProduct8BitUnsignedAccelerated)},
+		{"integerDotProduct8BitSignedAccelerated", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).IntegerDotProduct8BitSignedAccelerated)},
+		{"integerDotProduct8BitMixedSignednessAccelerated", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).IntegerDotProduct8BitMixedSignednessAccelerated)},
+		{"integerDotProduct4x8BitPackedUnsignedAccelerated", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).IntegerDotProduct4x8BitPackedUnsignedAccelerated)},
+		{"integerDotProduct4x8BitPackedSignedAccelerated", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).IntegerDotProduct4x8BitPackedSignedAccelerated)},
+		{"integerDotProduct4x8BitPackedMixedSignednessAccelerated", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).IntegerDotProduct4x8BitPackedMixedSignednessAccelerated)},
+		{"integerDotProduct16BitUnsignedAccelerated", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).IntegerDotProduct16BitUnsignedAccelerated)},
+		{"integerDotProduct16BitSignedAccelerated", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).IntegerDotProduct16BitSignedAccelerated)},
+		{"integerDotProduct16BitMixedSignednessAccelerated", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).IntegerDotProduct16BitMixedSignednessAccelerated)},
+		{"integerDotProduct32BitUnsignedAccelerated", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).IntegerDotProduct32BitUnsignedAccelerated)},
+		{"integerDotProduct32BitSignedAccelerated", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).IntegerDotProduct32BitSignedAccelerated)},
+		{"integerDotProduct32BitMixedSignednessAccelerated", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).IntegerDotProduct32BitMixedSignednessAccelerated)},
+		{"integerDotProduct64BitUnsignedAccelerated", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).IntegerDotProduct64BitUnsignedAccelerated)},
+		{"integerDotProduct64BitSignedAccelerated", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).IntegerDotProduct64BitSignedAccelerated)},
+		{"integerDotProduct64BitMixedSignednessAccelerated", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).IntegerDotProduct64BitMixedSignednessAccelerated)},
+		{"integerDotProductAccumulatingSaturating8BitUnsignedAccelerated", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).IntegerDotProductAccumulatingSaturating8BitUnsignedAccelerated)},
+		{"integerDotProductAccumulatingSaturating8BitSignedAccelerated", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).IntegerDotProductAccumulatingSaturating8BitSignedAccelerated)},
+		{"integerDotProductAccumulatingSaturating8BitMixedSignednessAccelerated", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).IntegerDotProductAccumulatingSaturating8BitMixedSignednessAccelerated)},
+		{"integerDotProductAccumulatingSaturating4x8BitPackedUnsignedAccelerated", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).IntegerDotProductAccumulatingSaturating4x8BitPackedUnsignedAccelerated)},
+		{"integerDotProductAccumulatingSaturating4x8BitPackedSignedAccelerated", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).IntegerDotProductAccumulatingSaturating4x8BitPackedSignedAccelerated)},
+		{"integerDotProductAccumulatingSaturating4x8BitPackedMixedSignednessAccelerated", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).IntegerDotProductAccumulatingSaturating4x8BitPackedMixedSignednessAccelerated)},
+		{"integerDotProductAccumulatingSaturating16BitUnsignedAccelerated", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).IntegerDotProductAccumulatingSaturating16BitUnsignedAccelerated)},
+		{"integerDotProductAccumulatingSaturating16BitSignedAccelerated", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).IntegerDotProductAccumulatingSaturating16BitSignedAccelerated)},
+		{"integerDotProductAccumulatingSaturating16BitMixedSignednessAccelerated", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).IntegerDotProductAccumulatingSaturating16BitMixedSignednessAccelerated)},
+		{"integerDotProductAccumulatingSaturating32BitUnsignedAccelerated", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).IntegerDotProductAccumulatingSaturating32BitUnsignedAccelerated)},
+		{"integerDotProductAccumulatingSaturating32BitSignedAccelerated", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).IntegerDotProductAccumulatingSaturating32BitSignedAccelerated)},
+		{"integerDotProductAccumulatingSaturating32BitMixedSignednessAccelerated", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).IntegerDotProductAccumulatingSaturating32BitMixedSignednessAccelerated)},
+		{"integerDotProductAccumulatingSaturating64BitUnsignedAccelerated", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).IntegerDotProductAccumulatingSaturating64BitUnsignedAccelerated)},
+		{"integerDotProductAccumulatingSaturating64BitSignedAccelerated", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).IntegerDotProductAccumulatingSaturating64BitSignedAccelerated)},
+		{"integerDotProductAccumulatingSaturating64BitMixedSignednessAccelerated", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).IntegerDotProductAccumulatingSaturating64BitMixedSignednessAccelerated)},
+		{"storageTexelBufferOffsetAlignmentBytes", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).StorageTexelBufferOffsetAlignmentBytes)},
+		{"storageTexelBufferOffsetSingleTexelAlignment", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).StorageTexelBufferOffsetSingleTexelAlignment)},
+		{"uniformTexelBufferOffsetAlignmentBytes", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).UniformTexelBufferOffsetAlignmentBytes)},
+		{"uniformTexelBufferOffsetSingleTexelAlignment", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).UniformTexelBufferOffsetSingleTexelAlignment)},
+		{"maxBufferSize", unsafe.Offsetof((*PhysicalDeviceVulkan13Properties)(nil).MaxBufferSize)},
+	}},
+	{"VkPipelineCreationFeedback", unsafe.Sizeof(*(*PipelineCreationFeedback)(nil)), unsafe.Alignof(*(*PipelineCreationFeedback)(nil)), []memberOffset{
+		{"flags", unsafe.Offsetof((*PipelineCreationFeedback)(nil).Flags)},
+		{"duration", unsafe.Offsetof((*PipelineCreationFeedback)(nil).Duration)},
+	}},
+	{"VkPipelineCreationFeedbackCreateInfo", unsafe.Sizeof(*(*PipelineCreationFeedbackCreateInfo)(nil)), unsafe.Alignof(*(*PipelineCreationFeedbackCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineCreationFeedbackCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineCreationFeedbackCreateInfo)(nil).PNext)},
+		{"pPipelineCreationFeedback", unsafe.Offsetof((*PipelineCreationFeedbackCreateInfo)(nil).PPipelineCreationFeedback)},
+		{"pipelineStageCreationFeedbackCount", unsafe.Offsetof((*PipelineCreationFeedbackCreateInfo)(nil).PipelineStageCreationFeedbackCount)},
+		{"pPipelineStageCreationFeedbacks", unsafe.Offsetof((*PipelineCreationFeedbackCreateInfo)(nil).PPipelineStageCreationFeedbacks)},
+	}},
+	{"VkPhysicalDeviceShaderTerminateInvocationFeatures", unsafe.Sizeof(*(*PhysicalDeviceShaderTerminateInvocationFeatures)(nil)), unsafe.Alignof(*(*PhysicalDeviceShaderTerminateInvocationFeatures)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceShaderTerminateInvocationFeatures)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceShaderTerminateInvocationFeatures)(nil).PNext)},
+		{"shaderTerminateInvocation", unsafe.Offsetof((*PhysicalDeviceShaderTerminateInvocationFeatures)(nil).ShaderTerminateInvocation)},
+	}},
+	{"VkPhysicalDeviceToolProperties", unsafe.Sizeof(*(*PhysicalDeviceToolProperties)(nil)), unsafe.Alignof(*(*PhysicalDeviceToolProperties)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceToolProperties)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceToolProperties)(nil).PNext)},
+		{"name", unsafe.Offsetof((*PhysicalDeviceToolProperties)(nil).Name)},
+		{"version", unsafe.Offsetof((*PhysicalDeviceToolProperties)(nil).Version)},
+		{"purposes", unsafe.Offsetof((*PhysicalDeviceToolProperties)(nil).Purposes)},
+		{"description", unsafe.Offsetof((*PhysicalDeviceToolProperties)(nil).Description)},
+		{"layer", unsafe.Offsetof((*PhysicalDeviceToolProperties)(nil).Layer)},
+	}},
+	{"VkPhysicalDeviceShaderDemoteToHelperInvocationFeatures", unsafe.Sizeof(*(*PhysicalDeviceShaderDemoteToHelperInvocationFeatures)(nil)), unsafe.Alignof(*(*PhysicalDeviceShaderDemoteToHelperInvocationFeatures)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceShaderDemoteToHelperInvocationFeatures)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceShaderDemoteToHelperInvocationFeatures)(nil).PNext)},
+		{"shaderDemoteToHelperInvocation", unsafe.Offsetof((*PhysicalDeviceShaderDemoteToHelperInvocationFeatures)(nil).ShaderDemoteToHelperInvocation)},
+	}},
+	{"VkPhysicalDevicePrivateDataFeatures", unsafe.Sizeof(*(*PhysicalDevicePrivateDataFeatures)(nil)), unsafe.Alignof(*(*PhysicalDevicePrivateDataFeatures)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDevicePrivateDataFeatures)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDevicePrivateDataFeatures)(nil).PNext)},
+		{"privateData", unsafe.Offsetof((*PhysicalDevicePrivateDataFeatures)(nil).PrivateData)},
+	}},
+	{"VkDevicePrivateDataCreateInfo", unsafe.Sizeof(*(*DevicePrivateDataCreateInfo)(nil)), unsafe.Alignof(*(*DevicePrivateDataCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DevicePrivateDataCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DevicePrivateDataCreateInfo)(nil).PNext)},
+		{"privateDataSlotRequestCount", unsafe.Offsetof((*DevicePrivateDataCreateInfo)(nil).PrivateDataSlotRequestCount)},
+	}},
+	{"VkPrivateDataSlotCreateInfo", unsafe.Sizeof(*(*PrivateDataSlotCreateInfo)(nil)), unsafe.Alignof(*(*PrivateDataSlotCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PrivateDataSlotCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PrivateDataSlotCreateInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*PrivateDataSlotCreateInfo)(nil).Flags)},
+	}},
+	{"VkPhysicalDevicePipelineCreationCacheControlFeatures", unsafe.Sizeof(*(*PhysicalDevicePipelineCreationCacheControlFeatures)(nil)), unsafe.Alignof(*(*PhysicalDevicePipelineCreationCacheControlFeatures)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDevicePipelineCreationCacheControlFeatures)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDevicePipelineCreationCacheControlFeatures)(nil).PNext)},
+		{"pipelineCreationCacheControl", unsafe.Offsetof((*PhysicalDevicePipelineCreationCacheControlFeatures)(nil).PipelineCreationCacheControl)},
+	}},
+	{"VkMemoryBarrier2", unsafe.Sizeof(*(*MemoryBarrier2)(nil)), unsafe.Alignof(*(*MemoryBarrier2)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*MemoryBarrier2)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*MemoryBarrier2)(nil).PNext)},
+		{"srcStageMask", unsafe.Offsetof((*MemoryBarrier2)(nil).SrcStageMask)},
+		{"srcAccessMask", unsafe.Offsetof((*MemoryBarrier2)(nil).SrcAccessMask)},
+		{"dstStageMask", unsafe.Offsetof((*MemoryBarrier2)(nil).DstStageMask)},
+		{"dstAccessMask", unsafe.Offsetof((*MemoryBarrier2)(nil).DstAccessMask)},
+	}},
+	{"VkBufferMemoryBarrier2", unsafe.Sizeof(*(*BufferMemoryBarrier2)(nil)), unsafe.Alignof(*(*BufferMemoryBarrier2)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*BufferMemoryBarrier2)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*BufferMemoryBarrier2)(nil).PNext)},
+		{"srcStageMask", unsafe.Offsetof((*BufferMemoryBarrier2)(nil).SrcStageMask)},
+		{"srcAccessMask", unsafe.Offsetof((*BufferMemoryBarrier2)(nil).SrcAccessMask)},
+		{"dstStageMask", unsafe.Offsetof((*BufferMemoryBarrier2)(nil).DstStageMask)},
+		{"dstAccessMask", unsafe.Offsetof((*BufferMemoryBarrier2)(nil).DstAccessMask)},
+		{"srcQueueFamilyIndex", unsafe.Offsetof((*BufferMemoryBarrier2)(nil).SrcQueueFamilyIndex)},
+		{"dstQueueFamilyIndex", unsafe.Offsetof((*BufferMemoryBarrier2)(nil).DstQueueFamilyIndex)},
+		{"buffer", unsafe.Offsetof((*BufferMemoryBarrier2)(nil).Buffer)},
+		{"offset", unsafe.Offsetof((*BufferMemoryBarrier2)(nil).Offset)},
+		{"size", unsafe.Offsetof((*BufferMemoryBarrier2)(nil).Size)},
+	}},
+	{"VkImageMemoryBarrier2", unsafe.Sizeof(*(*ImageMemoryBarrier2)(nil)), unsafe.Alignof(*(*ImageMemoryBarrier2)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ImageMemoryBarrier2)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ImageMemoryBarrier2)(nil).PNext)},
+		{"srcStageMask", unsafe.Offsetof((*ImageMemoryBarrier2)(nil).SrcStageMask)},
+		{"srcAccessMask", unsafe.Offsetof((*ImageMemoryBarrier2)(nil).SrcAccessMask)},
+		{"dstStageMask", unsafe.Offsetof((*ImageMemoryBarrier2)(nil).DstStageMask)},
+		{"dstAccessMask", unsafe.Offsetof((*ImageMemoryBarrier2)(nil).DstAccessMask)},
+		{"oldLayout", unsafe.Offsetof((*ImageMemoryBarrier2)(nil).OldLayout)},
+		{"newLayout", unsafe.Offsetof((*ImageMemoryBarrier2)(nil).NewLayout)},
+		{"srcQueueFamilyIndex", unsafe.Offsetof((*ImageMemoryBarrier2)(nil).SrcQueueFamilyIndex)},
+		{"dstQueueFamilyIndex", unsafe.Offsetof((*ImageMemoryBarrier2)(nil).DstQueueFamilyIndex)},
+		{"image", unsafe.Offsetof((*ImageMemoryBarrier2)(nil).Image)},
+		{"subresourceRange", unsafe.Offsetof((*ImageMemoryBarrier2)(nil).SubresourceRange)},
+	}},
+	{"VkDependencyInfo", unsafe.Sizeof(*(*DependencyInfo)(nil)), unsafe.Alignof(*(*DependencyInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DependencyInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DependencyInfo)(nil).PNext)},
+		{"dependencyFlags", unsafe.Offsetof((*DependencyInfo)(nil).DependencyFlags)},
+		{"memoryBarrierCount", unsafe.Offsetof((*DependencyInfo)(nil).MemoryBarrierCount)},
+		{"pMemoryBarriers", unsafe.Offsetof((*DependencyInfo)(nil).PMemoryBarriers)},
+		{"bufferMemoryBarrierCount", unsafe.Offsetof((*DependencyInfo)(nil).BufferMemoryBarrierCount)},
+		{"pBufferMemoryBarriers", unsafe.Offsetof((*DependencyInfo)(nil).PBufferMemoryBarriers)},
+		{"imageMemoryBarrierCount", unsafe.Offsetof((*DependencyInfo)(nil).ImageMemoryBarrierCount)},
+		{"pImageMemoryBarriers", unsafe.Offsetof((*DependencyInfo)(nil).PImageMemoryBarriers)},
+	}},
+	{"VkSemaphoreSubmitInfo", unsafe.Sizeof(*(*SemaphoreSubmitInfo)(nil)), unsafe.Alignof(*(*SemaphoreSubmitInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SemaphoreSubmitInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SemaphoreSubmitInfo)(nil).PNext)},
+		{"semaphore", unsafe.Offsetof((*SemaphoreSubmitInfo)(nil).Semaphore)},
+		{"value", unsafe.Offsetof((*SemaphoreSubmitInfo)(nil).Value)},
+		{"stageMask", unsafe.Offsetof((*SemaphoreSubmitInfo)(nil).StageMask)},
+		{"deviceIndex", unsafe.Offsetof((*SemaphoreSubmitInfo)(nil).DeviceIndex)},
+	}},
+	{"VkCommandBufferSubmitInfo", unsafe.Sizeof(*(*CommandBufferSubmitInfo)(nil)), unsafe.Alignof(*(*CommandBufferSubmitInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*CommandBufferSubmitInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*CommandBufferSubmitInfo)(nil).PNext)},
+		{"commandBuffer", unsafe.Offsetof((*CommandBufferSubmitInfo)(nil).CommandBuffer)},
+		{"deviceMask", unsafe.Offsetof((*CommandBufferSubmitInfo)(nil).DeviceMask)},
+	}},
+	{"VkSubmitInfo2", unsafe.Sizeof(*(*SubmitInfo2)(nil)), unsafe.Alignof(*(*SubmitInfo2)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SubmitInfo2)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SubmitInfo2)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*SubmitInfo2)(nil).Flags)},
+		{"waitSemaphoreInfoCount", unsafe.Offsetof((*SubmitInfo2)(nil).WaitSemaphoreInfoCount)},
+		{"pWaitSemaphoreInfos", unsafe.Offsetof((*SubmitInfo2)(nil).PWaitSemaphoreInfos)},
+		{"commandBufferInfoCount", unsafe.Offsetof((*SubmitInfo2)(nil).CommandBufferInfoCount)},
+		{"pCommandBufferInfos", unsafe.Offsetof((*SubmitInfo2)(nil).PCommandBufferInfos)},
+		{"signalSemaphoreInfoCount", unsafe.Offsetof((*SubmitInfo2)(nil).SignalSemaphoreInfoCount)},
+		{"pSignalSemaphoreInfos", unsafe.Offsetof((*SubmitInfo2)(nil).PSignalSemaphoreInfos)},
+	}},
+	{"VkPhysicalDeviceSynchronization2Features", unsafe.Sizeof(*(*PhysicalDeviceSynchronization2Features)(nil)), unsafe.Alignof(*(*PhysicalDeviceSynchronization2Features)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceSynchronization2Features)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceSynchronization2Features)(nil).PNext)},
+		{"synchronization2", unsafe.Offsetof((*PhysicalDeviceSynchronization2Features)(nil).Synchronization2)},
+	}},
+	{"VkPhysicalDeviceZeroInitializeWorkgroupMemoryFeatures", unsafe.Sizeof(*(*PhysicalDeviceZeroInitializeWorkgroupMemoryFeatures)(nil)), unsafe.Alignof(*(*PhysicalDeviceZeroInitializeWorkgroupMemoryFeatures)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceZeroInitializeWorkgroupMemoryFeatures)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceZeroInitializeWorkgroupMemoryFeatures)(nil).PNext)},
+		{"shaderZeroInitializeWorkgroupMemory", unsafe.Offsetof((*PhysicalDeviceZeroInitializeWorkgroupMemoryFeatures)(nil).ShaderZeroInitializeWorkgroupMemory)},
+	}},
+	{"VkPhysicalDeviceImageRobustnessFeatures", unsafe.Sizeof(*(*PhysicalDeviceImageRobustnessFeatures)(nil)), unsafe.Alignof(*(*PhysicalDeviceImageRobustnessFeatures)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceImageRobustnessFeatures)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceImageRobustnessFeatures)(nil).PNext)},
+		{"robustImageAccess", unsafe.Offsetof((*PhysicalDeviceImageRobustnessFeatures)(nil).RobustImageAccess)},
+	}},
+	{"VkBufferCopy2", unsafe.Sizeof(*(*BufferCopy2)(nil)), unsafe.Alignof(*(*BufferCopy2)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*BufferCopy2)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*BufferCopy2)(nil).PNext)},
+		{"srcOffset", unsafe.Offsetof((*BufferCopy2)(nil).SrcOffset)},
+		{"dstOffset", unsafe.Offsetof((*BufferCopy2)(nil).DstOffset)},
+		{"size", unsafe.Offsetof((*BufferCopy2)(nil).Size)},
+	}},
+	{"VkCopyBufferInfo2", unsafe.Sizeof(*(*CopyBufferInfo2)(nil)), unsafe.Alignof(*(*CopyBufferInfo2)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*CopyBufferInfo2)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*CopyBufferInfo2)(nil).PNext)},
+		{"srcBuffer", unsafe.Offsetof((*CopyBufferInfo2)(nil).SrcBuffer)},
+		{"dstBuffer", unsafe.Offsetof((*CopyBufferInfo2)(nil).DstBuffer)},
+		{"regionCount", unsafe.Offsetof((*CopyBufferInfo2)(nil).RegionCount)},
+		{"pRegions", unsafe.Offsetof((*CopyBufferInfo2)(nil).PRegions)},
+	}},
+	{"VkImageCopy2", unsafe.Sizeof(*(*ImageCopy2)(nil)), unsafe.Alignof(*(*ImageCopy2)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ImageCopy2)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ImageCopy2)(nil).PNext)},
+		{"srcSubresource", unsafe.Offsetof((*ImageCopy2)(nil).SrcSubresource)},
+		{"srcOffset", unsafe.Offsetof((*ImageCopy2)(nil).SrcOffset)},
+		{"dstSubresource", unsafe.Offsetof((*ImageCopy2)(nil).DstSubresource)},
+		{"dstOffset", unsafe.Offsetof((*ImageCopy2)(nil).DstOffset)},
+		{"extent", unsafe.Offsetof((*ImageCopy2)(nil).Extent)},
+	}},
+	{"VkCopyImageInfo2", unsafe.Sizeof(*(*CopyImageInfo2)(nil)), unsafe.Alignof(*(*CopyImageInfo2)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*CopyImageInfo2)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*CopyImageInfo2)(nil).PNext)},
+		{"srcImage", unsafe.Offsetof((*CopyImageInfo2)(nil).SrcImage)},
+		{"srcImageLayout", unsafe.Offsetof((*CopyImageInfo2)(nil).SrcImageLayout)},
+		{"dstImage", unsafe.Offsetof((*CopyImageInfo2)(nil).DstImage)},
+		{"dstImageLayout", unsafe.Offsetof((*CopyImageInfo2)(nil).DstImageLayout)},
+		{"regionCount", unsafe.Offsetof((*CopyImageInfo2)(nil).RegionCount)},
+		{"pRegions", unsafe.Offsetof((*CopyImageInfo2)(nil).PRegions)},
+	}},
+	{"VkBufferImageCopy2", unsafe.Sizeof(*(*BufferImageCopy2)(nil)), unsafe.Alignof(*(*BufferImageCopy2)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*BufferImageCopy2)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*BufferImageCopy2)(nil).PNext)},
+		{"bufferOffset", unsafe.Offsetof((*BufferImageCopy2)(nil).BufferOffset)},
+		{"bufferRowLength", unsafe.Offsetof((*BufferImageCopy2)(nil).BufferRowLength)},
+		{"bufferImageHeight", unsafe.Offsetof((*BufferImageCopy2)(nil).BufferImageHeight)},
+		{"imageSubresource", unsafe.Offsetof((*BufferImageCopy2)(nil).ImageSubresource)},
+		{"imageOffset", unsafe.Offsetof((*BufferImageCopy2)(nil).ImageOffset)},
+		{"imageExtent", unsafe.Offsetof((*BufferImageCopy2)(nil).ImageExtent)},
+	}},
+	{"VkCopyBufferToImageInfo2", unsafe.Sizeof(*(*CopyBufferToImageInfo2)(nil)), unsafe.Alignof(*(*CopyBufferToImageInfo2)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*CopyBufferToImageInfo2)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*CopyBufferToImageInfo2)(nil).PNext)},
+		{"srcBuffer", unsafe.Offsetof((*CopyBufferToImageInfo2)(nil).SrcBuffer)},
+		{"dstImage", unsafe.Offsetof((*CopyBufferToImageInfo2)(nil).DstImage)},
+		{"dstImageLayout", unsafe.Offsetof((*CopyBufferToImageInfo2)(nil).DstImageLayout)},
+		{"regionCount", unsafe.Offsetof((*CopyBufferToImageInfo2)(nil).RegionCount)},
+		{"pRegions", unsafe.Offsetof((*CopyBufferToImageInfo2)(nil).PRegions)},
+	}},
+	{"VkCopyImageToBufferInfo2", unsafe.Sizeof(*(*CopyImageToBufferInfo2)(nil)), unsafe.Alignof(*(*CopyImageToBufferInfo2)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*CopyImageToBufferInfo2)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*CopyImageToBufferInfo2)(nil).PNext)},
+		{"srcImage", unsafe.Offsetof((*CopyImageToBufferInfo2)(nil).SrcImage)},
+		{"srcImageLayout", unsafe.Offsetof((*CopyImageToBufferInfo2)(nil).SrcImageLayout)},
+		{"dstBuffer", unsafe.Offsetof((*CopyImageToBufferInfo2)(nil).DstBuffer)},
+		{"regionCount", unsafe.Offsetof((*CopyImageToBufferInfo2)(nil).RegionCount)},
+		{"pRegions", unsafe.Offsetof((*CopyImageToBufferInfo2)(nil).PRegions)},
+	}},
+	{"VkImageBlit2", unsafe.Sizeof(*(*ImageBlit2)(nil)), unsafe.Alignof(*(*ImageBlit2)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ImageBlit2)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ImageBlit2)(nil).PNext)},
+		{"srcSubresource", unsafe.Offsetof((*ImageBlit2)(nil).SrcSubresource)},
+		{"srcOffsets", unsafe.Offsetof((*ImageBlit2)(nil).SrcOffsets)},
+		{"dstSubresource", unsafe.Offsetof((*ImageBlit2)(nil).DstSubresource)},
+		{"dstOffsets", unsafe.Offsetof((*ImageBlit2)(nil).DstOffsets)},
+	}},
+	{"VkBlitImageInfo2", unsafe.Sizeof(*(*BlitImageInfo2)(nil)), unsafe.Alignof(*(*BlitImageInfo2)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*BlitImageInfo2)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*BlitImageInfo2)(nil).PNext)},
+		{"srcImage", unsafe.Offsetof((*BlitImageInfo2)(nil).SrcImage)},
+		{"srcImageLayout", unsafe.Offsetof((*BlitImageInfo2)(nil).SrcImageLayout)},
+		{"dstImage", unsafe.Offsetof((*BlitImageInfo2)(nil).DstImage)},
+		{"dstImageLayout", unsafe.Offsetof((*BlitImageInfo2)(nil).DstImageLayout)},
+		{"regionCount", unsafe.Offsetof((*BlitImageInfo2)(nil).RegionCount)},
+		{"pRegions", unsafe.Offsetof((*BlitImageInfo2)(nil).PRegions)},
+		{"filter", unsafe.Offsetof((*BlitImageInfo2)(nil).Filter)},
+	}},
+	{"VkImageResolve2", unsafe.Sizeof(*(*ImageResolve2)(nil)), unsafe.Alignof(*(*ImageResolve2)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ImageResolve2)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ImageResolve2)(nil).PNext)},
+		{"srcSubresource", unsafe.Offsetof((*ImageResolve2)(nil).SrcSubresource)},
+		{"srcOffset", unsafe.Offsetof((*ImageResolve2)(nil).SrcOffset)},
+		{"dstSubresource", unsafe.Offsetof((*ImageResolve2)(nil).DstSubresource)},
+		{"dstOffset", unsafe.Offsetof((*ImageResolve2)(nil).DstOffset)},
+		{"extent", unsafe.Offsetof((*ImageResolve2)(nil).Extent)},
+	}},
+	{"VkResolveImageInfo2", unsafe.Sizeof(*(*ResolveImageInfo2)(nil)), unsafe.Alignof(*(*ResolveImageInfo2)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ResolveImageInfo2)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ResolveImageInfo2)(nil).PNext)},
+		{"srcImage", unsafe.Offsetof((*ResolveImageInfo2)(nil).SrcImage)},
+		{"srcImageLayout", unsafe.Offsetof((*ResolveImageInfo2)(nil).SrcImageLayout)},
+		{"dstImage", unsafe.Offsetof((*ResolveImageInfo2)(nil).DstImage)},
+		{"dstImageLayout", unsafe.Offsetof((*ResolveImageInfo2)(nil).DstImageLayout)},
+		{"regionCount", unsafe.Offsetof((*ResolveImageInfo2)(nil).RegionCount)},
+		{"pRegions", unsafe.Offsetof((*ResolveImageInfo2)(nil).PRegions)},
+	}},
+	{"VkPhysicalDeviceSubgroupSizeControlFeatures", unsafe.Sizeof(*(*PhysicalDeviceSubgroupSizeControlFeatures)(nil)), unsafe.Alignof(*(*PhysicalDeviceSubgroupSizeControlFeatures)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceSubgroupSizeControlFeatures)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceSubgroupSizeControlFeatures)(nil).PNext)},
+		{"subgroupSizeControl", unsafe.Offsetof((*PhysicalDeviceSubgroupSizeControlFeatures)(nil).SubgroupSizeControl)},
+		{"computeFullSubgroups", unsafe.Offsetof((*PhysicalDeviceSubgroupSizeControlFeatures)(nil).ComputeFullSubgroups)},
+	}},
+	{"VkPhysicalDeviceSubgroupSizeControlProperties", unsafe.Sizeof(*(*PhysicalDeviceSubgroupSizeControlProperties)(nil)), unsafe.Alignof(*(*PhysicalDeviceSubgroupSizeControlProperties)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceSubgroupSizeControlProperties)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceSubgroupSizeControlProperties)(nil).PNext)},
+		{"minSubgroupSize", unsafe.Offsetof((*PhysicalDeviceSubgroupSizeControlProperties)(nil).MinSubgroupSize)},
+		{"maxSubgroupSize", unsafe.Offsetof((*PhysicalDeviceSubgroupSizeControlProperties)(nil).MaxSubgroupSize)},
+		{"maxComputeWorkgroupSubgroups", unsafe.Offsetof((*PhysicalDeviceSubgroupSizeControlProperties)(nil).MaxComputeWorkgroupSubgroups)},
+		{"requiredSubgroupSizeStages", unsafe.Offsetof((*PhysicalDeviceSubgroupSizeControlProperties)(nil).RequiredSubgroupSizeStages)},
+	}},
+	{"VkPipelineShaderStageRequiredSubgroupSizeCreateInfo", unsafe.Sizeof(*(*PipelineShaderStageRequiredSubgroupSizeCreateInfo)(nil)), unsafe.Alignof(*(*PipelineShaderStageRequiredSubgroupSizeCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineShaderStageRequiredSubgroupSizeCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineShaderStageRequiredSubgroupSizeCreateInfo)(nil).PNext)},
+		{"requiredSubgroupSize", unsafe.Offsetof((*PipelineShaderStageRequiredSubgroupSizeCreateInfo)(nil).RequiredSubgroupSize)},
+	}},
+	{"VkPhysicalDeviceInlineUniformBlockFeatures", unsafe.Sizeof(*(*PhysicalDeviceInlineUniformBlockFeatures)(nil)), unsafe.Alignof(*(*PhysicalDeviceInlineUniformBlockFeatures)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceInlineUniformBlockFeatures)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceInlineUniformBlockFeatures)(nil).PNext)},
+		{"inlineUniformBlock", unsafe.Offsetof((*PhysicalDeviceInlineUniformBlockFeatures)(nil).InlineUniformBlock)},
+		{"descriptorBindingInlineUniformBlockUpdateAfterBind", unsafe.Offsetof((*PhysicalDeviceInlineUniformBlockFeatures)(nil).DescriptorBindingInlineUniformBlockUpdateAfterBind)},
+	}},
+	{"VkPhysicalDeviceInlineUniformBlockProperties", unsafe.Sizeof(*(*PhysicalDeviceInlineUniformBlockProperties)(nil)), unsafe.Alignof(*(*PhysicalDeviceInlineUniformBlockProperties)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceInlineUniformBlockProperties)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceInlineUniformBlockProperties)(nil).PNext)},
+		{"maxInlineUniformBlockSize", unsafe.Offsetof((*PhysicalDeviceInlineUniformBlockProperties)(nil).MaxInlineUniformBlockSize)},
+		{"maxPerStageDescriptorInlineUniformBlocks", unsafe.Offsetof((*PhysicalDeviceInlineUniformBlockProperties)(nil).MaxPerStageDescriptorInlineUniformBlocks)},
+		{"maxPerStageDescriptorUpdateAfterBindInlineUniformBlocks", unsafe.Offsetof((*PhysicalDeviceInlineUniformBlockProperties)(nil).MaxPerStageDescriptorUpdateAfterBindInlineUniformBlocks)},
+		{"maxDescriptorSetInlineUniformBlocks", unsafe.Offsetof((*PhysicalDeviceInlineUniformBlockProperties)(nil).MaxDescriptorSetInlineUniformBlocks)},
+		{"maxDescriptorSetUpdateAfterBindInlineUniformBlocks", unsafe.Offsetof((*PhysicalDeviceInlineUniformBlockProperties)(nil).MaxDescriptorSetUpdateAfterBindInlineUniformBlocks)},
+	}},
+	{"VkWriteDescriptorSetInlineUniformBlock", unsafe.Sizeof(*(*WriteDescriptorSetInlineUniformBlock)(nil)), unsafe.Alignof(*(*WriteDescriptorSetInlineUniformBlock)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*WriteDescriptorSetInlineUniformBlock)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*WriteDescriptorSetInlineUniformBlock)(nil).PNext)},
+		{"dataSize", unsafe.Offsetof((*WriteDescriptorSetInlineUniformBlock)(nil).DataSize)},
+		{"pData", unsafe.Offsetof((*WriteDescriptorSetInlineUniformBlock)(nil).PData)},
+	}},
+	{"VkDescriptorPoolInlineUniformBlockCreateInfo", unsafe.Sizeof(*(*DescriptorPoolInlineUniformBlockCreateInfo)(nil)), unsafe.Alignof(*(*DescriptorPoolInlineUniformBlockCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DescriptorPoolInlineUniformBlockCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DescriptorPoolInlineUniformBlockCreateInfo)(nil).PNext)},
+		{"maxInlineUniformBlockBindings", unsafe.Offsetof((*DescriptorPoolInlineUniformBlockCreateInfo)(nil).MaxInlineUniformBlockBindings)},
+	}},
+	{"VkPhysicalDeviceTextureCompressionASTCHDRFeatures", unsafe.Sizeof(*(*PhysicalDeviceTextureCompressionASTCHDRFeatures)(nil)), unsafe.Alignof(*(*PhysicalDeviceTextureCompressionASTCHDRFeatures)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceTextureCompressionASTCHDRFeatures)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceTextureCompressionASTCHDRFeatures)(nil).PNext)},
+		{"textureCompressionASTC_HDR", unsafe.Offsetof((*PhysicalDeviceTextureCompressionASTCHDRFeatures)(nil).TextureCompressionASTCHDR)},
+	}},
+	{"VkRenderingAttachmentInfo", unsafe.Sizeof(*(*RenderingAttachmentInfo)(nil)), unsafe.Alignof(*(*RenderingAttachmentInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*RenderingAttachmentInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*RenderingAttachmentInfo)(nil).PNext)},
+		{"imageView", unsafe.Offsetof((*RenderingAttachmentInfo)(nil).ImageView)},
+		{"imageLayout", unsafe.Offsetof((*RenderingAttachmentInfo)(nil).ImageLayout)},
+		{"resolveMode", unsafe.Offsetof((*RenderingAttachmentInfo)(nil).ResolveMode)},
+		{"resolveImageView", unsafe.Offsetof((*RenderingAttachmentInfo)(nil).ResolveImageView)},
+		{"resolveImageLayout", unsafe.Offsetof((*RenderingAttachmentInfo)(nil).ResolveImageLayout)},
+		{"loadOp", unsafe.Offsetof((*RenderingAttachmentInfo)(nil).LoadOp)},
+		{"storeOp", unsafe.Offsetof((*RenderingAttachmentInfo)(nil).StoreOp)},
+		{"clearValue", unsafe.Offsetof((*RenderingAttachmentInfo)(nil).ClearValue)},
+	}},
+	{"VkRenderingInfo", unsafe.Sizeof(*(*RenderingInfo)(nil)), unsafe.Alignof(*(*RenderingInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*RenderingInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*RenderingInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*RenderingInfo)(nil).Flags)},
+		{"renderArea", unsafe.Offsetof((*RenderingInfo)(nil).RenderArea)},
+		{"layerCount", unsafe.Offsetof((*RenderingInfo)(nil).LayerCount)},
+		{"viewMask", unsafe.Offsetof((*RenderingInfo)(nil).ViewMask)},
+		{"colorAttachmentCount", unsafe.Offsetof((*RenderingInfo)(nil).ColorAttachmentCount)},
+		{"pColorAttachments", unsafe.Offsetof((*RenderingInfo)(nil).PColorAttachments)},
+		{"pDepthAttachment", unsafe.Offsetof((*RenderingInfo)(nil).PDepthAttachment)},
+		{"pStencilAttachment", unsafe.Offsetof((*RenderingInfo)(nil).PStencilAttachment)},
+	}},
+	{"VkPipelineRenderingCreateInfo", unsafe.Sizeof(*(*PipelineRenderingCreateInfo)(nil)), unsafe.Alignof(*(*PipelineRenderingCreateInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineRenderingCreateInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineRenderingCreateInfo)(nil).PNext)},
+		{"viewMask", unsafe.Offsetof((*PipelineRenderingCreateInfo)(nil).ViewMask)},
+		{"colorAttachmentCount", unsafe.Offsetof((*PipelineRenderingCreateInfo)(nil).ColorAttachmentCount)},
+		{"pColorAttachmentFormats", unsafe.Offsetof((*PipelineRenderingCreateInfo)(nil).PColorAttachmentFormats)},
+		{"depthAttachmentFormat", unsafe.Offsetof((*PipelineRenderingCreateInfo)(nil).DepthAttachmentFormat)},
+		{"stencilAttachmentFormat", unsafe.Offsetof((*PipelineRenderingCreateInfo)(nil).StencilAttachmentFormat)},
+	}},
+	{"VkPhysicalDeviceDynamicRenderingFeatures", unsafe.Sizeof(*(*PhysicalDeviceDynamicRenderingFeatures)(nil)), unsafe.Alignof(*(*PhysicalDeviceDynamicRenderingFeatures)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceDynamicRenderingFeatures)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceDynamicRenderingFeatures)(nil).PNext)},
+		{"dynamicRendering", unsafe.Offsetof((*PhysicalDeviceDynamicRenderingFeatures)(nil).DynamicRendering)},
+	}},
+	{"VkCommandBufferInheritanceRenderingInfo", unsafe.Sizeof(*(*CommandBufferInheritanceRenderingInfo)(nil)), unsafe.Alignof(*(*CommandBufferInheritanceRenderingInfo)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*CommandBufferInheritanceRenderingInfo)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*CommandBufferInheritanceRenderingInfo)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*CommandBufferInheritanceRenderingInfo)(nil).Flags)},
+		{"viewMask", unsafe.Offsetof((*CommandBufferInheritanceRenderingInfo)(nil).ViewMask)},
+		{"colorAttachmentCount", unsafe.Offsetof((*CommandBufferInheritanceRenderingInfo)(nil).ColorAttachmentCount)},
+		{"pColorAttachmentFormats", unsafe.Offsetof((*CommandBufferInheritanceRenderingInfo)(nil).PColorAttachmentFormats)},
+		{"depthAttachmentFormat", unsafe.Offsetof((*CommandBufferInheritanceRenderingInfo)(nil).DepthAttachmentFormat)},
+		{"stencilAttachmentFormat", unsafe.Offsetof((*CommandBufferInheritanceRenderingInfo)(nil).StencilAttachmentFormat)},
+		{"rasterizationSamples", unsafe.Offsetof((*CommandBufferInheritanceRenderingInfo)(nil).RasterizationSamples)},
+	}},
+	{"VkPhysicalDeviceShaderIntegerDotProductFeatures", unsafe.Sizeof(*(*PhysicalDeviceShaderIntegerDotProductFeatures)(nil)), unsafe.Alignof(*(*PhysicalDeviceShaderIntegerDotProductFeatures)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceShaderIntegerDotProductFeatures)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceShaderIntegerDotProductFeatures)(nil).PNext)},
+		{"shaderIntegerDotProduct", unsafe.Offsetof((*PhysicalDeviceShaderIntegerDotProductFeatures)(nil).ShaderIntegerDotProduct)},
+	}},
+	{"VkPhysicalDeviceShaderIntegerDotProductProperties", unsafe.Sizeof(*(*PhysicalDeviceShaderIntegerDotProductProperties)(nil)), unsafe.Alignof(*(*PhysicalDeviceShaderIntegerDotProductProperties)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceShaderIntegerDotProductProperties)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceShaderIntegerDotProductProperties)(nil).PNext)},
+		{"integerDotProduct8BitUnsignedAccelerated", unsafe.Offsetof((*PhysicalDeviceShaderIntegerDotProductProperties)(nil).IntegerDotProduct8BitUnsignedAccelerated)},
+		{"integerDotProduct8BitSignedAccelerated", unsafe.Offsetof((*PhysicalDeviceShaderIntegerDotProductProperties)(nil).IntegerDotProduct8BitSignedAccelerated)},
+		{"integerDotProduct8BitMixedSignednessAccelerated", unsafe.Offsetof((*PhysicalDeviceShaderIntegerDotProductProperties)(nil).IntegerDotProduct8BitMixedSignednessAccelerated)},
+		{"integerDotProduct4x8BitPackedUnsignedAccelerated", unsafe.Offsetof((*PhysicalDeviceShaderIntegerDotProductProperties)(nil).IntegerDotProduct4x8BitPackedUnsignedAccelerated)},
+		{"integerDotProduct4x8BitPackedSignedAccelerated", unsafe.Offsetof((*PhysicalDeviceShaderIntegerDotProductProperties)(nil).IntegerDotProduct4x8BitPackedSignedAccelerated)},
+		{"integerDotProduct4x8BitPackedMixedSignednessAccelerated", unsafe.Offsetof((*PhysicalDeviceShaderIntegerDotProductProperties)(nil).IntegerDotProduct4x8BitPackedMixedSignednessAccelerated)},
+		{"integerDotProduct16BitUnsignedAccelerated", unsafe.Offsetof((*PhysicalDeviceShaderIntegerDotProductProperties)(nil).IntegerDotProduct16BitUnsignedAccelerated)},
+		{"integerDotProduct16BitSignedAccelerated", unsafe.Offsetof((*PhysicalDeviceShaderIntegerDotProductProperties)(nil).IntegerDotProduct16BitSignedAccelerated)},
+		{"integerDotProduct16BitMixedSignednessAccelerated", unsafe.Offsetof((*PhysicalDeviceShaderIntegerDotProductProperties)(nil).IntegerDotProduct16BitMixedSignednessAccelerated)},
+		{"integerDotProduct32BitUnsignedAccelerated", unsafe.Offsetof((*PhysicalDeviceShaderIntegerDotProductProperties)(nil).IntegerDotProduct32BitUnsignedAccelerated)},
+		{"integerDotProduct32BitSignedAccelerated", unsafe.Offsetof((*PhysicalDeviceShaderIntegerDotProductProperties)(nil).IntegerDotProduct32BitSignedAccelerated)},
+		{"integerDotProduct32BitMixedSignednessAccelerated", unsafe.Offsetof((*PhysicalDeviceShaderIntegerDotProductProperties)(nil).IntegerDotProduct32BitMixedSignednessAccelerated)},
+		{"integerDotProduct64BitUnsignedAccelerated", unsafe.Offsetof((*PhysicalDeviceShaderIntegerDotProductProperties)(nil).IntegerDotProduct64BitUnsignedAccelerated)},
+		{"integerDotProduct64BitSignedAccelerated", unsafe.Offsetof((*PhysicalDeviceShaderIntegerDotProductProperties)(nil).IntegerDotProduct64BitSignedAccelerated)},
+		{"integerDotProduct64BitMixedSignednessAccelerated", unsafe.Offsetof((*PhysicalDeviceShaderIntegerDotProductProperties)(nil).IntegerDotProduct64BitMixedSignednessAccelerated)},
+		{"integerDotProductAccumulatingSaturating8BitUnsignedAccelerated", unsafe.Offsetof((*PhysicalDeviceShaderIntegerDotProductProperties)(nil).IntegerDotProductAccumulatingSaturating8BitUnsignedAccelerated)},
+		{"integerDotProductAccumulatingSaturating8BitSignedAccelerated", unsafe.Offsetof((*PhysicalDeviceShaderIntegerDotProductProperties)(nil).IntegerDotProductAccumulatingSaturating8BitSignedAccelerated)},
+		{"integerDotProductAccumulatingSaturating8BitMixedSignednessAccelerated", unsafe.Offsetof((*PhysicalDeviceShaderIntegerDotProductProperties)(nil).IntegerDotProductAccumulatingSaturating8BitMixedSignednessAccelerated)},
+		{"integerDotProductAccumulatingSaturating4x8BitPackedUnsignedAccelerated", unsafe.Offsetof((*PhysicalDeviceShaderIntegerDotProductProperties)(nil).IntegerDotProductAccumulatingSaturating4x8BitPackedUnsignedAccelerated)},
+		{"integerDotProductAccumulatingSaturating4x8BitPackedSignedAccelerated", unsafe.Offsetof((*PhysicalDeviceShaderIntegerDotProductProperties)(nil).IntegerDotProductAccumulatingSaturating4x8BitPackedSignedAccelerated)},
+		{"integerDotProductAccumulatingSaturating4x8BitPackedMixedSignednessAccelerated", unsafe.Offsetof((*PhysicalDeviceShaderIntegerDotProductProperties)(nil).IntegerDotProductAccumulatingSaturating4x8BitPackedMixedSignednessAccelerated)},
+		{"integerDotProductAccumulatingSaturating16BitUnsignedAccelerated", unsafe.Offsetof((*PhysicalDeviceShaderIntegerDotProductProperties)(nil).IntegerDotProductAccumulatingSaturating16BitUnsignedAccelerated)},
+		{"integerDotProductAccumulatingSaturating16BitSignedAccelerated", unsafe.Offsetof((*PhysicalDeviceShaderIntegerDotProductProperties)(nil).IntegerDotProductAccumulatingSaturating16BitSignedAccelerated)},
+		{"integerDotProductAccumulatingSaturating16BitMixedSignednessAccelerated", unsafe.Offsetof((*PhysicalDeviceShaderIntegerDotProductProperties)(nil).IntegerDotProductAccumulatingSaturating16BitMixedSignednessAccelerated)},
+		{"integerDotProductAccumulatingSaturating32BitUnsignedAccelerated", unsafe.Offsetof((*PhysicalDeviceShaderIntegerDotProductProperties)(nil).IntegerDotProductAccumulatingSaturating32BitUnsignedAccelerated)},
+		{"integerDotProductAccumulatingSaturating32BitSignedAccelerated", unsafe.Offsetof((*PhysicalDeviceShaderIntegerDotProductProperties)(nil).IntegerDotProductAccumulatingSaturating32BitSignedAccelerated)},
+		{"integerDotProductAccumulatingSaturating32BitMixedSignednessAccelerated", unsafe.Offsetof((*PhysicalDeviceShaderIntegerDotProductProperties)(nil).IntegerDotProductAccumulatingSaturating32BitMixedSignednessAccelerated)},
+		{"integerDotProductAccumulatingSaturating64BitUnsignedAccelerated", unsafe.Offsetof((*PhysicalDeviceShaderIntegerDotProductProperties)(nil).IntegerDotProductAccumulatingSaturating64BitUnsignedAccelerated)},
+		{"integerDotProductAccumulatingSaturating64BitSignedAccelerated", unsafe.Offsetof((*PhysicalDeviceShaderIntegerDotProductProperties)(nil).IntegerDotProductAccumulatingSaturating64BitSignedAccelerated)},
+		{"integerDotProductAccumulatingSaturating64BitMixedSignednessAccelerated", unsafe.Offsetof((*PhysicalDeviceShaderIntegerDotProductProperties)(nil).IntegerDotProductAccumulatingSaturating64BitMixedSignednessAccelerated)},
+	}},
+	{"VkPhysicalDeviceTexelBufferAlignmentProperties", unsafe.Sizeof(*(*PhysicalDeviceTexelBufferAlignmentProperties)(nil)), unsafe.Alignof(*(*PhysicalDeviceTexelBufferAlignmentProperties)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceTexelBufferAlignmentProperties)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceTexelBufferAlignmentProperties)(nil).PNext)},
+		{"storageTexelBufferOffsetAlignmentBytes", unsafe.Offsetof((*PhysicalDeviceTexelBufferAlignmentProperties)(nil).StorageTexelBufferOffsetAlignmentBytes)},
+		{"storageTexelBufferOffsetSingleTexelAlignment", unsafe.Offsetof((*PhysicalDeviceTexelBufferAlignmentProperties)(nil).StorageTexelBufferOffsetSingleTexelAlignment)},
+		{"uniformTexelBufferOffsetAlignmentBytes", unsafe.Offsetof((*PhysicalDeviceTexelBufferAlignmentProperties)(nil).UniformTexelBufferOffsetAlignmentBytes)},
+		{"uniformTexelBufferOffsetSingleTexelAlignment", unsafe.Offsetof((*PhysicalDeviceTexelBufferAlignmentProperties)(nil).UniformTexelBufferOffsetSingleTexelAlignment)},
+	}},
+	{"VkFormatProperties3", unsafe.Sizeof(*(*FormatProperties3)(nil)), unsafe.Alignof(*(*FormatProperties3)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*FormatProperties3)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*FormatProperties3)(nil).PNext)},
+		{"linearTilingFeatures", unsafe.Offsetof((*FormatProperties3)(nil).LinearTilingFeatures)},
+		{"optimalTilingFeatures", unsafe.Offsetof((*FormatProperties3)(nil).OptimalTilingFeatures)},
+		{"bufferFeatures", unsafe.Offsetof((*FormatProperties3)(nil).BufferFeatures)},
+	}},
+	{"VkPhysicalDeviceMaintenance4Features", unsafe.Sizeof(*(*PhysicalDeviceMaintenance4Features)(nil)), unsafe.Alignof(*(*PhysicalDeviceMaintenance4Features)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceMaintenance4Features)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceMaintenance4Features)(nil).PNext)},
+		{"maintenance4", unsafe.Offsetof((*PhysicalDeviceMaintenance4Features)(nil).Maintenance4)},
+	}},
+	{"VkPhysicalDeviceMaintenance4Properties", unsafe.Sizeof(*(*PhysicalDeviceMaintenance4Properties)(nil)), unsafe.Alignof(*(*PhysicalDeviceMaintenance4Properties)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceMaintenance4Properties)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceMaintenance4Properties)(nil).PNext)},
+		{"maxBufferSize", unsafe.Offsetof((*PhysicalDeviceMaintenance4Properties)(nil).MaxBufferSize)},
+	}},
+	{"VkDeviceBufferMemoryRequirements", unsafe.Sizeof(*(*DeviceBufferMemoryRequirements)(nil)), unsafe.Alignof(*(*DeviceBufferMemoryRequirements)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DeviceBufferMemoryRequirements)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DeviceBufferMemoryRequirements)(nil).PNext)},
+		{"pCreateInfo", unsafe.Offsetof((*DeviceBufferMemoryRequirements)(nil).PCreateInfo)},
+	}},
+	{"VkDeviceImageMemoryRequirements", unsafe.Sizeof(*(*DeviceImageMemoryRequirements)(nil)), unsafe.Alignof(*(*DeviceImageMemoryRequirements)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DeviceImageMemoryRequirements)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DeviceImageMemoryRequirements)(nil).PNext)},
+		{"pCreateInfo", unsafe.Offsetof((*DeviceImageMemoryRequirements)(nil).PCreateInfo)},
+		{"planeAspect", unsafe.Offsetof((*DeviceImageMemoryRequirements)(nil).PlaneAspect)},
+	}},
+	{"VkSurfaceCapabilitiesKHR", unsafe.Sizeof(*(*SurfaceCapabilitiesKHR)(nil)), unsafe.Alignof(*(*SurfaceCapabilitiesKHR)(nil)), []memberOffset{
+		{"minImageCount", unsafe.Offsetof((*SurfaceCapabilitiesKHR)(nil).MinImageCount)},
+		{"maxImageCount", unsafe.Offsetof((*SurfaceCapabilitiesKHR)(nil).MaxImageCount)},
+		{"currentExtent", unsafe.Offsetof((*SurfaceCapabilitiesKHR)(nil).CurrentExtent)},
+		{"minImageExtent", unsafe.Offsetof((*SurfaceCapabilitiesKHR)(nil).MinImageExtent)},
+		{"maxImageExtent", unsafe.Offsetof((*SurfaceCapabilitiesKHR)(nil).MaxImageExtent)},
+		{"maxImageArrayLayers", unsafe.Offsetof((*SurfaceCapabilitiesKHR)(nil).MaxImageArrayLayers)},
+		{"supportedTransforms", unsafe.Offsetof((*SurfaceCapabilitiesKHR)(nil).SupportedTransforms)},
+		{"currentTransform", unsafe.Offsetof((*SurfaceCapabilitiesKHR)(nil).CurrentTransform)},
+		{"supportedCompositeAlpha", unsafe.Offsetof((*SurfaceCapabilitiesKHR)(nil).SupportedCompositeAlpha)},
+		{"supportedUsageFlags", unsafe.Offsetof((*SurfaceCapabilitiesKHR)(nil).SupportedUsageFlags)},
+	}},
+	{"VkSurfaceFormatKHR", unsafe.Sizeof(*(*SurfaceFormatKHR)(nil)), unsafe.Alignof(*(*SurfaceFormatKHR)(nil)), []memberOffset{
+		{"format", unsafe.Offsetof((*SurfaceFormatKHR)(nil).Format)},
+		{"colorSpace", unsafe.Offsetof((*SurfaceFormatKHR)(nil).ColorSpace)},
+	}},
+	{"VkSwapchainCreateInfoKHR", unsafe.Sizeof(*(*SwapchainCreateInfoKHR)(nil)), unsafe.Alignof(*(*SwapchainCreateInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SwapchainCreateInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SwapchainCreateInfoKHR)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*SwapchainCreateInfoKHR)(nil).Flags)},
+		{"surface", unsafe.Offsetof((*SwapchainCreateInfoKHR)(nil).Surface)},
+		{"minImageCount", unsafe.Offsetof((*SwapchainCreateInfoKHR)(nil).MinImageCount)},
+		{"imageFormat", unsafe.Offsetof((*SwapchainCreateInfoKHR)(nil).ImageFormat)},
+		{"imageColorSpace", unsafe.Offsetof((*SwapchainCreateInfoKHR)(nil).ImageColorSpace)},
+		{"imageExtent", unsafe.Offsetof((*SwapchainCreateInfoKHR)(nil).ImageExtent)},
+		{"imageArrayLayers", unsafe.Offsetof((*SwapchainCreateInfoKHR)(nil).ImageArrayLayers)},
+		{"imageUsage", unsafe.Offsetof((*SwapchainCreateInfoKHR)(nil).ImageUsage)},
+		{"imageSharingMode", unsafe.Offsetof((*SwapchainCreateInfoKHR)(nil).ImageSharingMode)},
+		{"queueFamilyIndexCount", unsafe.Offsetof((*SwapchainCreateInfoKHR)(nil).QueueFamilyIndexCount)},
+		{"pQueueFamilyIndices", unsafe.Offsetof((*SwapchainCreateInfoKHR)(nil).PQueueFamilyIndices)},
+		{"preTransform", unsafe.Offsetof((*SwapchainCreateInfoKHR)(nil).PreTransform)},
+		{"compositeAlpha", unsafe.Offsetof((*SwapchainCreateInfoKHR)(nil).CompositeAlpha)},
+		{"presentMode", unsafe.Offsetof((*SwapchainCreateInfoKHR)(nil).PresentMode)},
+		{"clipped", unsafe.Offsetof((*SwapchainCreateInfoKHR)(nil).Clipped)},
+		{"oldSwapchain", unsafe.Offsetof((*SwapchainCreateInfoKHR)(nil).OldSwapchain)},
+	}},
+	{"VkPresentInfoKHR", unsafe.Sizeof(*(*PresentInfoKHR)(nil)), unsafe.Alignof(*(*PresentInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PresentInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PresentInfoKHR)(nil).PNext)},
+		{"waitSemaphoreCount", unsafe.Offsetof((*PresentInfoKHR)(nil).WaitSemaphoreCount)},
+		{"pWaitSemaphores", unsafe.Offsetof((*PresentInfoKHR)(nil).PWaitSemaphores)},
+		{"swapchainCount", unsafe.Offsetof((*PresentInfoKHR)(nil).SwapchainCount)},
+		{"pSwapchains", unsafe.Offsetof((*PresentInfoKHR)(nil).PSwapchains)},
+		{"pImageIndices", unsafe.Offsetof((*PresentInfoKHR)(nil).PImageIndices)},
+		{"pResults", unsafe.Offsetof((*PresentInfoKHR)(nil).PResults)},
+	}},
+	{"VkImageSwapchainCreateInfoKHR", unsafe.Sizeof(*(*ImageSwapchainCreateInfoKHR)(nil)), unsafe.Alignof(*(*ImageSwapchainCreateInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ImageSwapchainCreateInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ImageSwapchainCreateInfoKHR)(nil).PNext)},
+		{"swapchain", unsafe.Offsetof((*ImageSwapchainCreateInfoKHR)(nil).Swapchain)},
+	}},
+	{"VkBindImageMemorySwapchainInfoKHR", unsafe.Sizeof(*(*BindImageMemorySwapchainInfoKHR)(nil)), unsafe.Alignof(*(*BindImageMemorySwapchainInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*BindImageMemorySwapchainInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*BindImageMemorySwapchainInfoKHR)(nil).PNext)},
+		{"swapchain", unsafe.Offsetof((*BindImageMemorySwapchainInfoKHR)(nil).Swapchain)},
+		{"imageIndex", unsafe.Offsetof((*BindImageMemorySwapchainInfoKHR)(nil).ImageIndex)},
+	}},
+	{"VkAcquireNextImageInfoKHR", unsafe.Sizeof(*(*AcquireNextImageInfoKHR)(nil)), unsafe.Alignof(*(*AcquireNextImageInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*AcquireNextImageInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*AcquireNextImageInfoKHR)(nil).PNext)},
+		{"swapchain", unsafe.Offsetof((*AcquireNextImageInfoKHR)(nil).Swapchain)},
+		{"timeout", unsafe.Offsetof((*AcquireNextImageInfoKHR)(nil).Timeout)},
+		{"semaphore", unsafe.Offsetof((*AcquireNextImageInfoKHR)(nil).Semaphore)},
+		{"fence", unsafe.Offsetof((*AcquireNextImageInfoKHR)(nil).Fence)},
+		{"deviceMask", unsafe.Offsetof((*AcquireNextImageInfoKHR)(nil).DeviceMask)},
+	}},
+	{"VkDeviceGroupPresentCapabilitiesKHR", unsafe.Sizeof(*(*DeviceGroupPresentCapabilitiesKHR)(nil)), unsafe.Alignof(*(*DeviceGroupPresentCapabilitiesKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DeviceGroupPresentCapabilitiesKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DeviceGroupPresentCapabilitiesKHR)(nil).PNext)},
+		{"presentMask", unsafe.Offsetof((*DeviceGroupPresentCapabilitiesKHR)(nil).PresentMask)},
+		{"modes", unsafe.Offsetof((*DeviceGroupPresentCapabilitiesKHR)(nil).Modes)},
+	}},
+	{"VkDeviceGroupPresentInfoKHR", unsafe.Sizeof(*(*DeviceGroupPresentInfoKHR)(nil)), unsafe.Alignof(*(*DeviceGroupPresentInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DeviceGroupPresentInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DeviceGroupPresentInfoKHR)(nil).PNext)},
+		{"swapchainCount", unsafe.Offsetof((*DeviceGroupPresentInfoKHR)(nil).SwapchainCount)},
+		{"pDeviceMasks", unsafe.Offsetof((*DeviceGroupPresentInfoKHR)(nil).PDeviceMasks)},
+		{"mode", unsafe.Offsetof((*DeviceGroupPresentInfoKHR)(nil).Mode)},
+	}},
+	{"VkDeviceGroupSwapchainCreateInfoKHR", unsafe.Sizeof(*(*DeviceGroupSwapchainCreateInfoKHR)(nil)), unsafe.Alignof(*(*DeviceGroupSwapchainCreateInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DeviceGroupSwapchainCreateInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DeviceGroupSwapchainCreateInfoKHR)(nil).PNext)},
+		{"modes", unsafe.Offsetof((*DeviceGroupSwapchainCreateInfoKHR)(nil).Modes)},
+	}},
+	{"VkDisplayModeParametersKHR", unsafe.Sizeof(*(*DisplayModeParametersKHR)(nil)), unsafe.Alignof(*(*DisplayModeParametersKHR)(nil)), []memberOffset{
+		{"visibleRegion", unsafe.Offsetof((*DisplayModeParametersKHR)(nil).VisibleRegion)},
+		{"refreshRate", unsafe.Offsetof((*DisplayModeParametersKHR)(nil).RefreshRate)},
+	}},
+	{"VkDisplayModeCreateInfoKHR", unsafe.Sizeof(*(*DisplayModeCreateInfoKHR)(nil)), unsafe.Alignof(*(*DisplayModeCreateInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DisplayModeCreateInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DisplayModeCreateInfoKHR)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*DisplayModeCreateInfoKHR)(nil).Flags)},
+		{"parameters", unsafe.Offsetof((*DisplayModeCreateInfoKHR)(nil).Parameters)},
+	}},
+	{"VkDisplayModePropertiesKHR", unsafe.Sizeof(*(*DisplayModePropertiesKHR)(nil)), unsafe.Alignof(*(*DisplayModePropertiesKHR)(nil)), []memberOffset{
+		{"displayMode", unsafe.Offsetof((*DisplayModePropertiesKHR)(nil).DisplayMode)},
+		{"parameters", unsafe.Offsetof((*DisplayModePropertiesKHR)(nil).Parameters)},
+	}},
+	{"VkDisplayPlaneCapabilitiesKHR", unsafe.Sizeof(*(*DisplayPlaneCapabilitiesKHR)(nil)), unsafe.Alignof(*(*DisplayPlaneCapabilitiesKHR)(nil)), []memberOffset{
+		{"supportedAlpha", unsafe.Offsetof((*DisplayPlaneCapabilitiesKHR)(nil).SupportedAlpha)},
+		{"minSrcPosition", unsafe.Offsetof((*DisplayPlaneCapabilitiesKHR)(nil).MinSrcPosition)},
+		{"maxSrcPosition", unsafe.Offsetof((*DisplayPlaneCapabilitiesKHR)(nil).MaxSrcPosition)},
+		{"minSrcExtent", unsafe.Offsetof((*DisplayPlaneCapabilitiesKHR)(nil).MinSrcExtent)},
+		{"maxSrcExtent", unsafe.Offsetof((*DisplayPlaneCapabilitiesKHR)(nil).MaxSrcExtent)},
+		{"minDstPosition", unsafe.Offsetof((*DisplayPlaneCapabilitiesKHR)(nil).MinDstPosition)},
+		{"maxDstPosition", unsafe.Offsetof((*DisplayPlaneCapabilitiesKHR)(nil).MaxDstPosition)},
+		{"minDstExtent", unsafe.Offsetof((*DisplayPlaneCapabilitiesKHR)(nil).MinDstExtent)},
+		{"maxDstExtent", unsafe.Offsetof((*DisplayPlaneCapabilitiesKHR)(nil).MaxDstExtent)},
+	}},
+	{"VkDisplayPlanePropertiesKHR", unsafe.Sizeof(*(*DisplayPlanePropertiesKHR)(nil)), unsafe.Alignof(*(*DisplayPlanePropertiesKHR)(nil)), []memberOffset{
+		{"currentDisplay", unsafe.Offsetof((*DisplayPlanePropertiesKHR)(nil).CurrentDisplay)},
+		{"currentStackIndex", unsafe.Offsetof((*DisplayPlanePropertiesKHR)(nil).CurrentStackIndex)},
+	}},
+	{"VkDisplayPropertiesKHR", unsafe.Sizeof(*(*DisplayPropertiesKHR)(nil)), unsafe.Alignof(*(*DisplayPropertiesKHR)(nil)), []memberOffset{
+		{"display", unsafe.Offsetof((*DisplayPropertiesKHR)(nil).Display)},
+		{"displayName", unsafe.Offsetof((*DisplayPropertiesKHR)(nil).DisplayName)},
+		{"physicalDimensions", unsafe.Offsetof((*DisplayPropertiesKHR)(nil).PhysicalDimensions)},
+		{"physicalResolution", unsafe.Offsetof((*DisplayPropertiesKHR)(nil).PhysicalResolution)},
+		{"supportedTransforms", unsafe.Offsetof((*DisplayPropertiesKHR)(nil).SupportedTransforms)},
+		{"planeReorderPossible", unsafe.Offsetof((*DisplayPropertiesKHR)(nil).PlaneReorderPossible)},
+		{"persistentContent", unsafe.Offsetof((*DisplayPropertiesKHR)(nil).PersistentContent)},
+	}},
+	{"VkDisplaySurfaceCreateInfoKHR", unsafe.Sizeof(*(*DisplaySurfaceCreateInfoKHR)(nil)), unsafe.Alignof(*(*DisplaySurfaceCreateInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DisplaySurfaceCreateInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DisplaySurfaceCreateInfoKHR)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*DisplaySurfaceCreateInfoKHR)(nil).Flags)},
+		{"displayMode", unsafe.Offsetof((*DisplaySurfaceCreateInfoKHR)(nil).DisplayMode)},
+		{"planeIndex", unsafe.Offsetof((*DisplaySurfaceCreateInfoKHR)(nil).PlaneIndex)},
+		{"planeStackIndex", unsafe.Offsetof((*DisplaySurfaceCreateInfoKHR)(nil).PlaneStackIndex)},
+		{"transform", unsafe.Offsetof((*DisplaySurfaceCreateInfoKHR)(nil).Transform)},
+		{"globalAlpha", unsafe.Offsetof((*DisplaySurfaceCreateInfoKHR)(nil).GlobalAlpha)},
+		{"alphaMode", unsafe.Offsetof((*DisplaySurfaceCreateInfoKHR)(nil).AlphaMode)},
+		{"imageExtent", unsafe.Offsetof((*DisplaySurfaceCreateInfoKHR)(nil).ImageExtent)},
+	}},
+	{"VkDisplayPresentInfoKHR", unsafe.Sizeof(*(*DisplayPresentInfoKHR)(nil)), unsafe.Alignof(*(*DisplayPresentInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DisplayPresentInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DisplayPresentInfoKHR)(nil).PNext)},
+		{"srcRect", unsafe.Offsetof((*DisplayPresentInfoKHR)(nil).SrcRect)},
+		{"dstRect", unsafe.Offsetof((*DisplayPresentInfoKHR)(nil).DstRect)},
+		{"persistent", unsafe.Offsetof((*DisplayPresentInfoKHR)(nil).Persistent)},
+	}},
+	{"VkQueueFamilyQueryResultStatusPropertiesKHR", unsafe.Sizeof(*(*QueueFamilyQueryResultStatusPropertiesKHR)(nil)), unsafe.Alignof(*(*QueueFamilyQueryResultStatusPropertiesKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*QueueFamilyQueryResultStatusPropertiesKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*QueueFamilyQueryResultStatusPropertiesKHR)(nil).PNext)},
+		{"queryResultStatusSupport", unsafe.Offsetof((*QueueFamilyQueryResultStatusPropertiesKHR)(nil).QueryResultStatusSupport)},
+	}},
+	{"VkQueueFamilyVideoPropertiesKHR", unsafe.Sizeof(*(*QueueFamilyVideoPropertiesKHR)(nil)), unsafe.Alignof(*(*QueueFamilyVideoPropertiesKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*QueueFamilyVideoPropertiesKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*QueueFamilyVideoPropertiesKHR)(nil).PNext)},
+		{"videoCodecOperations", unsafe.Offsetof((*QueueFamilyVideoPropertiesKHR)(nil).VideoCodecOperations)},
+	}},
+	{"VkVideoProfileInfoKHR", unsafe.Sizeof(*(*VideoProfileInfoKHR)(nil)), unsafe.Alignof(*(*VideoProfileInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*VideoProfileInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*VideoProfileInfoKHR)(nil).PNext)},
+		{"videoCodecOperation", unsafe.Offsetof((*VideoProfileInfoKHR)(nil).VideoCodecOperation)},
+		{"chromaSubsampling", unsafe.Offsetof((*VideoProfileInfoKHR)(nil).ChromaSubsampling)},
+		{"lumaBitDepth", unsafe.Offsetof((*VideoProfileInfoKHR)(nil).LumaBitDepth)},
+		{"chromaBitDepth", unsafe.Offsetof((*VideoProfileInfoKHR)(nil).ChromaBitDepth)},
+	}},
+	{"VkVideoProfileListInfoKHR", unsafe.Sizeof(*(*VideoProfileListInfoKHR)(nil)), unsafe.Alignof(*(*VideoProfileListInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*VideoProfileListInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*VideoProfileListInfoKHR)(nil).PNext)},
+		{"profileCount", unsafe.Offsetof((*VideoProfileListInfoKHR)(nil).ProfileCount)},
+		{"pProfiles", unsafe.Offsetof((*VideoProfileListInfoKHR)(nil).PProfiles)},
+	}},
+	{"VkVideoCapabilitiesKHR", unsafe.Sizeof(*(*VideoCapabilitiesKHR)(nil)), unsafe.Alignof(*(*VideoCapabilitiesKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*VideoCapabilitiesKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*VideoCapabilitiesKHR)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*VideoCapabilitiesKHR)(nil).Flags)},
+		{"minBitstreamBufferOffsetAlignment", unsafe.Offsetof((*VideoCapabilitiesKHR)(nil).MinBitstreamBufferOffsetAlignment)},
+		{"minBitstreamBufferSizeAlignment", unsafe.Offsetof((*VideoCapabilitiesKHR)(nil).MinBitstreamBufferSizeAlignment)},
+		{"pictureAccessGranularity", unsafe.Offsetof((*VideoCapabilitiesKHR)(nil).PictureAccessGranularity)},
+		{"minCodedExtent", unsafe.Offsetof((*VideoCapabilitiesKHR)(nil).MinCodedExtent)},
+		{"maxCodedExtent", unsafe.Offsetof((*VideoCapabilitiesKHR)(nil).MaxCodedExtent)},
+		{"maxDpbSlots", unsafe.Offsetof((*VideoCapabilitiesKHR)(nil).MaxDpbSlots)},
+		{"maxActiveReferencePictures", unsafe.Offsetof((*VideoCapabilitiesKHR)(nil).MaxActiveReferencePictures)},
+		{"stdHeaderVersion", unsafe.Offsetof((*VideoCapabilitiesKHR)(nil).StdHeaderVersion)},
+	}},
+	{"VkPhysicalDeviceVideoFormatInfoKHR", unsafe.Sizeof(*(*PhysicalDeviceVideoFormatInfoKHR)(nil)), unsafe.Alignof(*(*PhysicalDeviceVideoFormatInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceVideoFormatInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceVideoFormatInfoKHR)(nil).PNext)},
+		{"imageUsage", unsafe.Offsetof((*PhysicalDeviceVideoFormatInfoKHR)(nil).ImageUsage)},
+	}},
+	{"VkVideoFormatPropertiesKHR", unsafe.Sizeof(*(*VideoFormatPropertiesKHR)(nil)), unsafe.Alignof(*(*VideoFormatPropertiesKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*VideoFormatPropertiesKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*VideoFormatPropertiesKHR)(nil).PNext)},
+		{"format", unsafe.Offsetof((*VideoFormatPropertiesKHR)(nil).Format)},
+		{"componentMapping", unsafe.Offsetof((*VideoFormatPropertiesKHR)(nil).ComponentMapping)},
+		{"imageCreateFlags", unsafe.Offsetof((*VideoFormatPropertiesKHR)(nil).ImageCreateFlags)},
+		{"imageType", unsafe.Offsetof((*VideoFormatPropertiesKHR)(nil).ImageType)},
+		{"imageTiling", unsafe.Offsetof((*VideoFormatPropertiesKHR)(nil).ImageTiling)},
+		{"imageUsageFlags", unsafe.Offsetof((*VideoFormatPropertiesKHR)(nil).ImageUsageFlags)},
+	}},
+	{"VkVideoPictureResourceInfoKHR", unsafe.Sizeof(*(*VideoPictureResourceInfoKHR)(nil)), unsafe.Alignof(*(*VideoPictureResourceInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*VideoPictureResourceInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*VideoPictureResourceInfoKHR)(nil).PNext)},
+		{"codedOffset", unsafe.Offsetof((*VideoPictureResourceInfoKHR)(nil).CodedOffset)},
+		{"codedExtent", unsafe.Offsetof((*VideoPictureResourceInfoKHR)(nil).CodedExtent)},
+		{"baseArrayLayer", unsafe.Offsetof((*VideoPictureResourceInfoKHR)(nil).BaseArrayLayer)},
+		{"imageViewBinding", unsafe.Offsetof((*VideoPictureResourceInfoKHR)(nil).ImageViewBinding)},
+	}},
+	{"VkVideoReferenceSlotInfoKHR", unsafe.Sizeof(*(*VideoReferenceSlotInfoKHR)(nil)), unsafe.Alignof(*(*VideoReferenceSlotInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*VideoReferenceSlotInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*VideoReferenceSlotInfoKHR)(nil).PNext)},
+		{"slotIndex", unsafe.Offsetof((*VideoReferenceSlotInfoKHR)(nil).SlotIndex)},
+		{"pPictureResource", unsafe.Offsetof((*VideoReferenceSlotInfoKHR)(nil).PPictureResource)},
+	}},
+	{"VkVideoSessionMemoryRequirementsKHR", unsafe.Sizeof(*(*VideoSessionMemoryRequirementsKHR)(nil)), unsafe.Alignof(*(*VideoSessionMemoryRequirementsKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*VideoSessionMemoryRequirementsKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*VideoSessionMemoryRequirementsKHR)(nil).PNext)},
+		{"memoryBindIndex", unsafe.Offsetof((*VideoSessionMemoryRequirementsKHR)(nil).MemoryBindIndex)},
+		{"memoryRequirements", unsafe.Offsetof((*VideoSessionMemoryRequirementsKHR)(nil).MemoryRequirements)},
+	}},
+	{"VkBindVideoSessionMemoryInfoKHR", unsafe.Sizeof(*(*BindVideoSessionMemoryInfoKHR)(nil)), unsafe.Alignof(*(*BindVideoSessionMemoryInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*BindVideoSessionMemoryInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*BindVideoSessionMemoryInfoKHR)(nil).PNext)},
+		{"memoryBindIndex", unsafe.Offsetof((*BindVideoSessionMemoryInfoKHR)(nil).MemoryBindIndex)},
+		{"memory", unsafe.Offsetof((*BindVideoSessionMemoryInfoKHR)(nil).Memory)},
+		{"memoryOffset", unsafe.Offsetof((*BindVideoSessionMemoryInfoKHR)(nil).MemoryOffset)},
+		{"memorySize", unsafe.Offsetof((*BindVideoSessionMemoryInfoKHR)(nil).MemorySize)},
+	}},
+	{"VkVideoSessionCreateInfoKHR", unsafe.Sizeof(*(*VideoSessionCreateInfoKHR)(nil)), unsafe.Alignof(*(*VideoSessionCreateInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*VideoSessionCreateInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*VideoSessionCreateInfoKHR)(nil).PNext)},
+		{"queueFamilyIndex", unsafe.Offsetof((*VideoSessionCreateInfoKHR)(nil).QueueFamilyIndex)},
+		{"flags", unsafe.Offsetof((*VideoSessionCreateInfoKHR)(nil).Flags)},
+		{"pVideoProfile", unsafe.Offsetof((*VideoSessionCreateInfoKHR)(nil).PVideoProfile)},
+		{"pictureFormat", unsafe.Offsetof((*VideoSessionCreateInfoKHR)(nil).PictureFormat)},
+		{"maxCodedExtent", unsafe.Offsetof((*VideoSessionCreateInfoKHR)(nil).MaxCodedExtent)},
+		{"referencePictureFormat", unsafe.Offsetof((*VideoSessionCreateInfoKHR)(nil).ReferencePictureFormat)},
+		{"maxDpbSlots", unsafe.Offsetof((*VideoSessionCreateInfoKHR)(nil).MaxDpbSlots)},
+		{"maxActiveReferencePictures", unsafe.Offsetof((*VideoSessionCreateInfoKHR)(nil).MaxActiveReferencePictures)},
+		{"pStdHeaderVersion", unsafe.Offsetof((*VideoSessionCreateInfoKHR)(nil).PStdHeaderVersion)},
+	}},
+	{"VkVideoSessionParametersCreateInfoKHR", unsafe.Sizeof(*(*VideoSessionParametersCreateInfoKHR)(nil)), unsafe.Alignof(*(*VideoSessionParametersCreateInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*VideoSessionParametersCreateInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*VideoSessionParametersCreateInfoKHR)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*VideoSessionParametersCreateInfoKHR)(nil).Flags)},
+		{"videoSessionParametersTemplate", unsafe.Offsetof((*VideoSessionParametersCreateInfoKHR)(nil).VideoSessionParametersTemplate)},
+		{"videoSession", unsafe.Offsetof((*VideoSessionParametersCreateInfoKHR)(nil).VideoSession)},
+	}},
+	{"VkVideoSessionParametersUpdateInfoKHR", unsafe.Sizeof(*(*VideoSessionParametersUpdateInfoKHR)(nil)), unsafe.Alignof(*(*VideoSessionParametersUpdateInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*VideoSessionParametersUpdateInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*VideoSessionParametersUpdateInfoKHR)(nil).PNext)},
+		{"updateSequenceCount", unsafe.Offsetof((*VideoSessionParametersUpdateInfoKHR)(nil).UpdateSequenceCount)},
+	}},
+	{"VkVideoBeginCodingInfoKHR", unsafe.Sizeof(*(*VideoBeginCodingInfoKHR)(nil)), unsafe.Alignof(*(*VideoBeginCodingInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*VideoBeginCodingInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*VideoBeginCodingInfoKHR)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*VideoBeginCodingInfoKHR)(nil).Flags)},
+		{"videoSession", unsafe.Offsetof((*VideoBeginCodingInfoKHR)(nil).VideoSession)},
+		{"videoSessionParameters", unsafe.Offsetof((*VideoBeginCodingInfoKHR)(nil).VideoSessionParameters)},
+		{"referenceSlotCount", unsafe.Offsetof((*VideoBeginCodingInfoKHR)(nil).ReferenceSlotCount)},
+		{"pReferenceSlots", unsafe.Offsetof((*VideoBeginCodingInfoKHR)(nil).PReferenceSlots)},
+	}},
+	{"VkVideoEndCodingInfoKHR", unsafe.Sizeof(*(*VideoEndCodingInfoKHR)(nil)), unsafe.Alignof(*(*VideoEndCodingInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*VideoEndCodingInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*VideoEndCodingInfoKHR)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*VideoEndCodingInfoKHR)(nil).Flags)},
+	}},
+	{"VkVideoCodingControlInfoKHR", unsafe.Sizeof(*(*VideoCodingControlInfoKHR)(nil)), unsafe.Alignof(*(*VideoCodingControlInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*VideoCodingControlInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*VideoCodingControlInfoKHR)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*VideoCodingControlInfoKHR)(nil).Flags)},
+	}},
+	{"VkVideoDecodeCapabilitiesKHR", unsafe.Sizeof(*(*VideoDecodeCapabilitiesKHR)(nil)), unsafe.Alignof(*(*VideoDecodeCapabilitiesKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*VideoDecodeCapabilitiesKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*VideoDecodeCapabilitiesKHR)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*VideoDecodeCapabilitiesKHR)(nil).Flags)},
+	}},
+	{"VkVideoDecodeUsageInfoKHR", unsafe.Sizeof(*(*VideoDecodeUsageInfoKHR)(nil)), unsafe.Alignof(*(*VideoDecodeUsageInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*VideoDecodeUsageInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*VideoDecodeUsageInfoKHR)(nil).PNext)},
+		{"videoUsageHints", unsafe.Offsetof((*VideoDecodeUsageInfoKHR)(nil).VideoUsageHints)},
+	}},
+	{"VkVideoDecodeInfoKHR", unsafe.Sizeof(*(*VideoDecodeInfoKHR)(nil)), unsafe.Alignof(*(*VideoDecodeInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*VideoDecodeInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*VideoDecodeInfoKHR)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*VideoDecodeInfoKHR)(nil).Flags)},
+		{"srcBuffer", unsafe.Offsetof((*VideoDecodeInfoKHR)(nil).SrcBuffer)},
+		{"srcBufferOffset", unsafe.Offsetof((*VideoDecodeInfoKHR)(nil).SrcBufferOffset)},
+		{"srcBufferRange", unsafe.Offsetof((*VideoDecodeInfoKHR)(nil).SrcBufferRange)},
+		{"dstPictureResource", unsafe.Offsetof((*VideoDecodeInfoKHR)(nil).DstPictureResource)},
+		{"pSetupReferenceSlot", unsafe.Offsetof((*VideoDecodeInfoKHR)(nil).PSetupReferenceSlot)},
+		{"referenceSlotCount", unsafe.Offsetof((*VideoDecodeInfoKHR)(nil).ReferenceSlotCount)},
+		{"pReferenceSlots", unsafe.Offsetof((*VideoDecodeInfoKHR)(nil).PReferenceSlots)},
+	}},
+	{"StdVideoH264SpsVuiFlags", unsafe.Sizeof(*(*StdVideoH264SpsVuiFlags)(nil)), unsafe.Alignof(*(*StdVideoH264SpsVuiFlags)(nil)), nil},
+	{"StdVideoH264HrdParameters", unsafe.Sizeof(*(*StdVideoH264HrdParameters)(nil)), unsafe.Alignof(*(*StdVideoH264HrdParameters)(nil)), []memberOffset{
+		{"cpb_cnt_minus1", unsafe.Offsetof((*StdVideoH264HrdParameters)(nil).CpbCntMinus1)},
+		{"bit_rate_scale", unsafe.Offsetof((*StdVideoH264HrdParameters)(nil).BitRateScale)},
+		{"cpb_size_scale", unsafe.Offsetof((*StdVideoH264HrdParameters)(nil).CpbSizeScale)},
+		{"reserved1", unsafe.Offsetof((*StdVideoH264HrdParameters)(nil).Reserved1)},
+		{"bit_rate_value_minus1", unsafe.Offsetof((*StdVideoH264HrdParameters)(nil).BitRateValueMinus1)},
+		{"cpb_size_value_minus1", unsafe.Offsetof((*StdVideoH264HrdParameters)(nil).CpbSizeValueMinus1)},
+		{"cbr_flag", unsafe.Offsetof((*StdVideoH264HrdParameters)(nil).CbrFlag)},
+		{"initial_cpb_removal_delay_length_minus1", unsafe.Offsetof((*StdVideoH264HrdParameters)(nil).InitialCpbRemovalDelayLengthMinus1)},
+		{"cpb_removal_delay_length_minus1", unsafe.Offsetof((*StdVideoH264HrdParameters)(nil).CpbRemovalDelayLengthMinus1)},
+		{"dpb_output_delay_length_minus1", unsafe.Offsetof((*StdVideoH264HrdParameters)(nil).DpbOutputDelayLengthMinus1)},
+		{"time_offset_length", unsafe.Offsetof((*StdVideoH264HrdParameters)(nil).TimeOffsetLength)},
+	}},
+	{"StdVideoH264SequenceParameterSetVui", unsafe.Sizeof(*(*StdVideoH264SequenceParameterSetVui)(nil)), unsafe.Alignof(*(*StdVideoH264SequenceParameterSetVui)(nil)), []memberOffset{
+		{"flags", unsafe.Offsetof((*StdVideoH264SequenceParameterSetVui)(nil).Flags)},
+		{"aspect_ratio_idc", unsafe.Offsetof((*StdVideoH264SequenceParameterSetVui)(nil).AspectRatioIdc)},
+		{"sar_width", unsafe.Offsetof((*StdVideoH264SequenceParameterSetVui)(nil).SarWidth)},
+		{"sar_height", unsafe.Offsetof((*StdVideoH264SequenceParameterSetVui)(nil).SarHeight)},
+		{"video_format", unsafe.Offsetof((*StdVideoH264SequenceParameterSetVui)(nil).VideoFormat)},
+		{"colour_primaries", unsafe.Offsetof((*StdVideoH264SequenceParameterSetVui)(nil).ColourPrimaries)},
+		{"transfer_characteristics", unsafe.Offsetof((*StdVideoH264SequenceParameterSetVui)(nil).TransferCharacteristics)},
+		{"matrix_coefficients", unsafe.Offsetof((*StdVideoH264SequenceParameterSetVui)(nil).MatrixCoefficients)},
+		{"num_units_in_tick", unsafe.Offsetof((*StdVideoH264SequenceParameterSetVui)(nil).NumUnitsInTick)},
+		{"time_scale", unsafe.Offsetof((*StdVideoH264SequenceParameterSetVui)(nil).TimeScale)},
+		{"max_num_reorder_frames", unsafe.Offsetof((*StdVideoH264SequenceParameterSetVui)(nil).MaxNumReorderFrames)},
+		{"max_dec_frame_buffering", unsafe.Offsetof((*StdVideoH264SequenceParameterSetVui)(nil).MaxDecFrameBuffering)},
+		{"chroma_sample_loc_type_top_field", unsafe.Offsetof((*StdVideoH264SequenceParameterSetVui)(nil).ChromaSampleLocTypeTopField)},
+		{"chroma_sample_loc_type_bottom_field", unsafe.Offsetof((*StdVideoH264SequenceParameterSetVui)(nil).ChromaSampleLocTypeBottomField)},
+		{"reserved1", unsafe.Offsetof((*StdVideoH264SequenceParameterSetVui)(nil).Reserved1)},
+		{"pHrdParameters", unsafe.Offsetof((*StdVideoH264SequenceParameterSetVui)(nil).PHrdParameters)},
+	}},
+	{"StdVideoH264SpsFlags", unsafe.Sizeof(*(*StdVideoH264SpsFlags)(nil)), unsafe.Alignof(*(*StdVideoH264SpsFlags)(nil)), nil},
+	{"StdVideoH264ScalingLists", unsafe.Sizeof(*(*StdVideoH264ScalingLists)(nil)), unsafe.Alignof(*(*StdVideoH264ScalingLists)(nil)), []memberOffset{
+		{"scaling_list_present_mask", unsafe.Offsetof((*StdVideoH264ScalingLists)(nil).ScalingListPresentMask)},
+		{"use_default_scaling_matrix_mask", unsafe.Offsetof((*StdVideoH264ScalingLists)(nil).UseDefaultScalingMatrixMask)},
+		{"ScalingList4x4", unsafe.Offsetof((*StdVideoH264ScalingLists)(nil).ScalingList4x4)},
+		{"ScalingList8x8", unsafe.Offsetof((*StdVideoH264ScalingLists)(nil).ScalingList8x8)},
+	}},
+	{"StdVideoH264SequenceParameterSet", unsafe.Sizeof(*(*StdVideoH264SequenceParameterSet)(nil)), unsafe.Alignof(*(*StdVideoH264SequenceParameterSet)(nil)), []memberOffset{
+		{"flags", unsafe.Offsetof((*StdVideoH264SequenceParameterSet)(nil).Flags)},
+		{"profile_idc", unsafe.Offsetof((*StdVideoH264SequenceParameterSet)(nil).ProfileIdc)},
+		{"level_idc", unsafe.Offsetof((*StdVideoH264SequenceParameterSet)(nil).LevelIdc)},
+		{"chroma_format_idc", unsafe.Offsetof((*StdVideoH264SequenceParameterSet)(nil).ChromaFormatIdc)},
+		{"seq_parameter_set_id", unsafe.Offsetof((*StdVideoH264SequenceParameterSet)(nil).SeqParameterSetId)},
+		{"bit_depth_luma_minus8", unsafe.Offsetof((*StdVideoH264SequenceParameterSet)(nil).BitDepthLumaMinus8)},
+		{"bit_depth_chroma_minus8", unsafe.Offsetof((*StdVideoH264SequenceParameterSet)(nil).BitDepthChromaMinus8)},
+		{"log2_max_frame_num_minus4", unsafe.Offsetof((*StdVideoH264SequenceParameterSet)(nil).Log2MaxFrameNumMinus4)},
+		{"pic_order_cnt_type", unsafe.Offsetof((*StdVideoH264SequenceParameterSet)(nil).PicOrderCntType)},
+		{"offset_for_non_ref_pic", unsafe.Offsetof((*StdVideoH264SequenceParameterSet)(nil).OffsetForNonRefPic)},
+		{"offset_for_top_to_bottom_field", unsafe.Offsetof((*StdVideoH264SequenceParameterSet)(nil).OffsetForTopToBottomField)},
+		{"log2_max_pic_order_cnt_lsb_minus4", unsafe.Offsetof((*StdVideoH264SequenceParameterSet)(nil).Log2MaxPicOrderCntLsbMinus4)},
+		{"num_ref_frames_in_pic_order_cnt_cycle", unsafe.Offsetof((*StdVideoH264SequenceParameterSet)(nil).NumRefFramesInPicOrderCntCycle)},
+		{"max_num_ref_frames", unsafe.Offsetof((*StdVideoH264SequenceParameterSet)(nil).MaxNumRefFrames)},
+		{"reserved1", unsafe.Offsetof((*StdVideoH264SequenceParameterSet)(nil).Reserved1)},
+		{"pic_width_in_mbs_minus1", unsafe.Offsetof((*StdVideoH264SequenceParameterSet)(nil).PicWidthInMbsMinus1)},
+		{"pic_height_in_map_units_minus1", unsafe.Offsetof((*StdVideoH264SequenceParameterSet)(nil).PicHeightInMapUnitsMinus1)},
+		{"frame_crop_left_offset", unsafe.Offsetof((*StdVideoH264SequenceParameterSet)(nil).FrameCropLeftOffset)},
+		{"frame_crop_right_offset", unsafe.Offsetof((*StdVideoH264SequenceParameterSet)(nil).FrameCropRightOffset)},
+		{"frame_crop_top_offset", unsafe.Offsetof((*StdVideoH264SequenceParameterSet)(nil).FrameCropTopOffset)},
+		{"frame_crop_bottom_offset", unsafe.Offsetof((*StdVideoH264SequenceParameterSet)(nil).FrameCropBottomOffset)},
+		{"reserved2", unsafe.Offsetof((*StdVideoH264SequenceParameterSet)(nil).Reserved2)},
+		{"pOffsetForRefFrame", unsafe.Offsetof((*StdVideoH264SequenceParameterSet)(nil).POffsetForRefFrame)},
+		{"pScalingLists", unsafe.Offsetof((*StdVideoH264SequenceParameterSet)(nil).PScalingLists)},
+		{"pSequenceParameterSetVui", unsafe.Offsetof((*StdVideoH264SequenceParameterSet)(nil).PSequenceParameterSetVui)},
+	}},
+	{"StdVideoH264PpsFlags", unsafe.Sizeof(*(*StdVideoH264PpsFlags)(nil)), unsafe.Alignof(*(*StdVideoH264PpsFlags)(nil)), nil},
+	{"StdVideoH264PictureParameterSet", unsafe.Sizeof(*(*StdVideoH264PictureParameterSet)(nil)), unsafe.Alignof(*(*StdVideoH264PictureParameterSet)(nil)), []memberOffset{
+		{"flags", unsafe.Offsetof((*StdVideoH264PictureParameterSet)(nil).Flags)},
+		{"seq_parameter_set_id", unsafe.Offsetof((*StdVideoH264PictureParameterSet)(nil).SeqParameterSetId)},
+		{"pic_parameter_set_id", unsafe.Offsetof((*StdVideoH264PictureParameterSet)(nil).PicParameterSetId)},
+		{"num_ref_idx_l0_default_active_minus1", unsafe.Offsetof((*StdVideoH264PictureParameterSet)(nil).NumRefIdxL0DefaultActiveMinus1)},
+		{"num_ref_idx_l1_default_active_minus1", unsafe.Offsetof((*StdVideoH264PictureParameterSet)(nil).NumRefIdxL1DefaultActiveMinus1)},
+		{"weighted_bipred_idc", unsafe.Offsetof((*StdVideoH264PictureParameterSet)(nil).WeightedBipredIdc)},
+		{"pic_init_qp_minus26", unsafe.Offsetof((*StdVideoH264PictureParameterSet)(nil).PicInitQpMinus26)},
+		{"pic_init_qs_minus26", unsafe.Offsetof((*StdVideoH264PictureParameterSet)(nil).PicInitQsMinus26)},
+		{"chroma_qp_index_offset", unsafe.Offsetof((*StdVideoH264PictureParameterSet)(nil).ChromaQpIndexOffset)},
+		{"second_chroma_qp_index_offset", unsafe.Offsetof((*StdVideoH264PictureParameterSet)(nil).SecondChromaQpIndexOffset)},
+		{"pScalingLists", unsafe.Offsetof((*StdVideoH264PictureParameterSet)(nil).PScalingLists)},
+	}},
+	{"VkVideoDecodeH264ProfileInfoKHR", unsafe.Sizeof(*(*VideoDecodeH264ProfileInfoKHR)(nil)), unsafe.Alignof(*(*VideoDecodeH264ProfileInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*VideoDecodeH264ProfileInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*VideoDecodeH264ProfileInfoKHR)(nil).PNext)},
+		{"stdProfileIdc", unsafe.Offsetof((*VideoDecodeH264ProfileInfoKHR)(nil).StdProfileIdc)},
+		{"pictureLayout", unsafe.Offsetof((*VideoDecodeH264ProfileInfoKHR)(nil).PictureLayout)},
+	}},
+	{"VkVideoDecodeH264CapabilitiesKHR", unsafe.Sizeof(*(*VideoDecodeH264CapabilitiesKHR)(nil)), unsafe.Alignof(*(*VideoDecodeH264CapabilitiesKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*VideoDecodeH264CapabilitiesKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*VideoDecodeH264CapabilitiesKHR)(nil).PNext)},
+		{"maxLevelIdc", unsafe.Offsetof((*VideoDecodeH264CapabilitiesKHR)(nil).MaxLevelIdc)},
+		{"fieldOffsetGranularity", unsafe.Offsetof((*VideoDecodeH264CapabilitiesKHR)(nil).FieldOffsetGranularity)},
+	}},
+	{"VkVideoDecodeH264SessionParametersAddInfoKHR", unsafe.Sizeof(*(*VideoDecodeH264SessionParametersAddInfoKHR)(nil)), unsafe.Alignof(*(*VideoDecodeH264SessionParametersAddInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*VideoDecodeH264SessionParametersAddInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*VideoDecodeH264SessionParametersAddInfoKHR)(nil).PNext)},
+		{"stdSPSCount", unsafe.Offsetof((*VideoDecodeH264SessionParametersAddInfoKHR)(nil).StdSPSCount)},
+		{"pStdSPSs", unsafe.Offsetof((*VideoDecodeH264SessionParametersAddInfoKHR)(nil).PStdSPSs)},
+		{"stdPPSCount", unsafe.Offsetof((*VideoDecodeH264SessionParametersAddInfoKHR)(nil).StdPPSCount)},
+		{"pStdPPSs", unsafe.Offsetof((*VideoDecodeH264SessionParametersAddInfoKHR)(nil).PStdPPSs)},
+	}},
+	{"VkVideoDecodeH264SessionParametersCreateInfoKHR", unsafe.Sizeof(*(*VideoDecodeH264SessionParametersCreateInfoKHR)(nil)), unsafe.Alignof(*(*VideoDecodeH264SessionParametersCreateInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*VideoDecodeH264SessionParametersCreateInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*VideoDecodeH264SessionParametersCreateInfoKHR)(nil).PNext)},
+		{"maxStdSPSCount", unsafe.Offsetof((*VideoDecodeH264SessionParametersCreateInfoKHR)(nil).MaxStdSPSCount)},
+		{"maxStdPPSCount", unsafe.Offsetof((*VideoDecodeH264SessionParametersCreateInfoKHR)(nil).MaxStdPPSCount)},
+		{"pParametersAddInfo", unsafe.Offsetof((*VideoDecodeH264SessionParametersCreateInfoKHR)(nil).PParametersAddInfo)},
+	}},
+	{"StdVideoDecodeH264PictureInfoFlags", unsafe.Sizeof(*(*StdVideoDecodeH264PictureInfoFlags)(nil)), unsafe.Alignof(*(*StdVideoDecodeH264PictureInfoFlags)(nil)), nil},
+	{"StdVideoDecodeH264PictureInfo", unsafe.Sizeof(*(*StdVideoDecodeH264PictureInfo)(nil)), unsafe.Alignof(*(*StdVideoDecodeH264PictureInfo)(nil)), []memberOffset{
+		{"flags", unsafe.Offsetof((*StdVideoDecodeH264PictureInfo)(nil).Flags)},
+		{"seq_parameter_set_id", unsafe.Offsetof((*StdVideoDecodeH264PictureInfo)(nil).SeqParameterSetId)},
+		{"pic_parameter_set_id", unsafe.Offsetof((*StdVideoDecodeH264PictureInfo)(nil).PicParameterSetId)},
+		{"reserved1", unsafe.Offsetof((*StdVideoDecodeH264PictureInfo)(nil).Reserved1)},
+		{"reserved2", unsafe.Offsetof((*StdVideoDecodeH264PictureInfo)(nil).Reserved2)},
+		{"frame_num", unsafe.Offsetof((*StdVideoDecodeH264PictureInfo)(nil).FrameNum)},
+		{"idr_pic_id", unsafe.Offsetof((*StdVideoDecodeH264PictureInfo)(nil).IdrPicId)},
+		{"PicOrderCnt", unsafe.Offsetof((*StdVideoDecodeH264PictureInfo)(nil).PicOrderCnt)},
+	}},
+	{"StdVideoDecodeH264ReferenceInfoFlags", unsafe.Sizeof(*(*StdVideoDecodeH264ReferenceInfoFlags)(nil)), unsafe.Alignof(*(*StdVideoDecodeH264ReferenceInfoFlags)(nil)), nil},
+	{"StdVideoDecodeH264ReferenceInfo", unsafe.Sizeof(*(*StdVideoDecodeH264ReferenceInfo)(nil)), unsafe.Alignof(*(*StdVideoDecodeH264ReferenceInfo)(nil)), []memberOffset{
+		{"flags", unsafe.Offsetof((*StdVideoDecodeH264ReferenceInfo)(nil).Flags)},
+		{"FrameNum", unsafe.Offsetof((*StdVideoDecodeH264ReferenceInfo)(nil).FrameNum)},
+		{"reserved", unsafe.Offsetof((*StdVideoDecodeH264ReferenceInfo)(nil).Reserved)},
+		{"PicOrderCnt", unsafe.Offsetof((*StdVideoDecodeH264ReferenceInfo)(nil).PicOrderCnt)},
+	}},
+	{"VkVideoDecodeH264PictureInfoKHR", unsafe.Sizeof(*(*VideoDecodeH264PictureInfoKHR)(nil)), unsafe.Alignof(*(*VideoDecodeH264PictureInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*VideoDecodeH264PictureInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*VideoDecodeH264PictureInfoKHR)(nil).PNext)},
+		{"pStdPictureInfo", unsafe.Offsetof((*VideoDecodeH264PictureInfoKHR)(nil).PStdPictureInfo)},
+		{"sliceCount", unsafe.Offsetof((*VideoDecodeH264PictureInfoKHR)(nil).SliceCount)},
+		{"pSliceOffsets", unsafe.Offsetof((*VideoDecodeH264PictureInfoKHR)(nil).PSliceOffsets)},
+	}},
+	{"VkVideoDecodeH264DpbSlotInfoKHR", unsafe.Sizeof(*(*VideoDecodeH264DpbSlotInfoKHR)(nil)), unsafe.Alignof(*(*VideoDecodeH264DpbSlotInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*VideoDecodeH264DpbSlotInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*VideoDecodeH264DpbSlotInfoKHR)(nil).PNext)},
+		{"pStdReferenceInfo", unsafe.Offsetof((*VideoDecodeH264DpbSlotInfoKHR)(nil).PStdReferenceInfo)},
+	}},
+	{"VkRenderingFragmentShadingRateAttachmentInfoKHR", unsafe.Sizeof(*(*RenderingFragmentShadingRateAttachmentInfoKHR)(nil)), unsafe.Alignof(*(*RenderingFragmentShadingRateAttachmentInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*RenderingFragmentShadingRateAttachmentInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*RenderingFragmentShadingRateAttachmentInfoKHR)(nil).PNext)},
+		{"imageView", unsafe.Offsetof((*RenderingFragmentShadingRateAttachmentInfoKHR)(nil).ImageView)},
+		{"imageLayout", unsafe.Offsetof((*RenderingFragmentShadingRateAttachmentInfoKHR)(nil).ImageLayout)},
+		{"shadingRateAttachmentTexelSize", unsafe.Offsetof((*RenderingFragmentShadingRateAttachmentInfoKHR)(nil).ShadingRateAttachmentTexelSize)},
+	}},
+	{"VkRenderingFragmentDensityMapAttachmentInfoEXT", unsafe.Sizeof(*(*RenderingFragmentDensityMapAttachmentInfoEXT)(nil)), unsafe.Alignof(*(*RenderingFragmentDensityMapAttachmentInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*RenderingFragmentDensityMapAttachmentInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*RenderingFragmentDensityMapAttachmentInfoEXT)(nil).PNext)},
+		{"imageView", unsafe.Offsetof((*RenderingFragmentDensityMapAttachmentInfoEXT)(nil).ImageView)},
+		{"imageLayout", unsafe.Offsetof((*RenderingFragmentDensityMapAttachmentInfoEXT)(nil).ImageLayout)},
+	}},
+	{"VkAttachmentSampleCountInfoAMD", unsafe.Sizeof(*(*AttachmentSampleCountInfoAMD)(nil)), unsafe.Alignof(*(*AttachmentSampleCountInfoAMD)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*AttachmentSampleCountInfoAMD)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*AttachmentSampleCountInfoAMD)(nil).PNext)},
+		{"colorAttachmentCount", unsafe.Offsetof((*AttachmentSampleCountInfoAMD)(nil).ColorAttachmentCount)},
+		{"pColorAttachmentSamples", unsafe.Offsetof((*AttachmentSampleCountInfoAMD)(nil).PColorAttachmentSamples)},
+		{"depthStencilAttachmentSamples", unsafe.Offsetof((*AttachmentSampleCountInfoAMD)(nil).DepthStencilAttachmentSamples)},
+	}},
+	{"VkMultiviewPerViewAttributesInfoNVX", unsafe.Sizeof(*(*MultiviewPerViewAttributesInfoNVX)(nil)), unsafe.Alignof(*(*MultiviewPerViewAttributesInfoNVX)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*MultiviewPerViewAttributesInfoNVX)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*MultiviewPerViewAttributesInfoNVX)(nil).PNext)},
+		{"perViewAttributes", unsafe.Offsetof((*MultiviewPerViewAttributesInfoNVX)(nil).PerViewAttributes)},
+		{"perViewAttributesPositionXOnly", unsafe.Offsetof((*MultiviewPerViewAttributesInfoNVX)(nil).PerViewAttributesPositionXOnly)},
+	}},
+	{"VkImportMemoryFdInfoKHR", unsafe.Sizeof(*(*ImportMemoryFdInfoKHR)(nil)), unsafe.Alignof(*(*ImportMemoryFdInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ImportMemoryFdInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ImportMemoryFdInfoKHR)(nil).PNext)},
+		{"handleType", unsafe.Offsetof((*ImportMemoryFdInfoKHR)(nil).HandleType)},
+		{"fd", unsafe.Offsetof((*ImportMemoryFdInfoKHR)(nil).Fd)},
+	}},
+	{"VkMemoryFdPropertiesKHR", unsafe.Sizeof(*(*MemoryFdPropertiesKHR)(nil)), unsafe.Alignof(*(*MemoryFdPropertiesKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*MemoryFdPropertiesKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*MemoryFdPropertiesKHR)(nil).PNext)},
+		{"memoryTypeBits", unsafe.Offsetof((*MemoryFdPropertiesKHR)(nil).MemoryTypeBits)},
+	}},
+	{"VkMemoryGetFdInfoKHR", unsafe.Sizeof(*(*MemoryGetFdInfoKHR)(nil)), unsafe.Alignof(*(*MemoryGetFdInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*MemoryGetFdInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*MemoryGetFdInfoKHR)(nil).PNext)},
+		{"memory", unsafe.Offsetof((*MemoryGetFdInfoKHR)(nil).Memory)},
+		{"handleType", unsafe.Offsetof((*MemoryGetFdInfoKHR)(nil).HandleType)},
+	}},
+	{"VkImportSemaphoreFdInfoKHR", unsafe.Sizeof(*(*ImportSemaphoreFdInfoKHR)(nil)), unsafe.Alignof(*(*ImportSemaphoreFdInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ImportSemaphoreFdInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ImportSemaphoreFdInfoKHR)(nil).PNext)},
+		{"semaphore", unsafe.Offsetof((*ImportSemaphoreFdInfoKHR)(nil).Semaphore)},
+		{"flags", unsafe.Offsetof((*ImportSemaphoreFdInfoKHR)(nil).Flags)},
+		{"handleType", unsafe.Offsetof((*ImportSemaphoreFdInfoKHR)(nil).HandleType)},
+		{"fd", unsafe.Offsetof((*ImportSemaphoreFdInfoKHR)(nil).Fd)},
+	}},
+	{"VkSemaphoreGetFdInfoKHR", unsafe.Sizeof(*(*SemaphoreGetFdInfoKHR)(nil)), unsafe.Alignof(*(*SemaphoreGetFdInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SemaphoreGetFdInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SemaphoreGetFdInfoKHR)(nil).PNext)},
+		{"semaphore", unsafe.Offsetof((*SemaphoreGetFdInfoKHR)(nil).Semaphore)},
+		{"handleType", unsafe.Offsetof((*SemaphoreGetFdInfoKHR)(nil).HandleType)},
+	}},
+	{"VkPhysicalDevicePushDescriptorPropertiesKHR", unsafe.Sizeof(*(*PhysicalDevicePushDescriptorPropertiesKHR)(nil)), unsafe.Alignof(*(*PhysicalDevicePushDescriptorPropertiesKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDevicePushDescriptorPropertiesKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDevicePushDescriptorPropertiesKHR)(nil).PNext)},
+		{"maxPushDescriptors", unsafe.Offsetof((*PhysicalDevicePushDescriptorPropertiesKHR)(nil).MaxPushDescriptors)},
+	}},
+	{"VkRectLayerKHR", unsafe.Sizeof(*(*RectLayerKHR)(nil)), unsafe.Alignof(*(*RectLayerKHR)(nil)), []memberOffset{
+		{"offset", unsafe.Offsetof((*RectLayerKHR)(nil).Offset)},
+		{"extent", unsafe.Offsetof((*RectLayerKHR)(nil).Extent)},
+		{"layer", unsafe.Offsetof((*RectLayerKHR)(nil).Layer)},
+	}},
+	{"VkPresentRegionKHR", unsafe.Sizeof(*(*PresentRegionKHR)(nil)), unsafe.Alignof(*(*PresentRegionKHR)(nil)), []memberOffset{
+		{"rectangleCount", unsafe.Offsetof((*PresentRegionKHR)(nil).RectangleCount)},
+		{"pRectangles", unsafe.Offsetof((*PresentRegionKHR)(nil).PRectangles)},
+	}},
+	{"VkPresentRegionsKHR", unsafe.Sizeof(*(*PresentRegionsKHR)(nil)), unsafe.Alignof(*(*PresentRegionsKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PresentRegionsKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PresentRegionsKHR)(nil).PNext)},
+		{"swapchainCount", unsafe.Offsetof((*PresentRegionsKHR)(nil).SwapchainCount)},
+		{"pRegions", unsafe.Offsetof((*PresentRegionsKHR)(nil).PRegions)},
+	}},
+	{"VkSharedPresentSurfaceCapabilitiesKHR", unsafe.Sizeof(*(*SharedPresentSurfaceCapabilitiesKHR)(nil)), unsafe.Alignof(*(*SharedPresentSurfaceCapabilitiesKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SharedPresentSurfaceCapabilitiesKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SharedPresentSurfaceCapabilitiesKHR)(nil).PNext)},
+		{"sharedPresentSupportedUsageFlags", unsafe.Offsetof((*SharedPresentSurfaceCapabilitiesKHR)(nil).SharedPresentSupportedUsageFlags)},
+	}},
+	{"VkImportFenceFdInfoKHR", unsafe.Sizeof(*(*ImportFenceFdInfoKHR)(nil)), unsafe.Alignof(*(*ImportFenceFdInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ImportFenceFdInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ImportFenceFdInfoKHR)(nil).PNext)},
+		{"fence", unsafe.Offsetof((*ImportFenceFdInfoKHR)(nil).Fence)},
+		{"flags", unsafe.Offsetof((*ImportFenceFdInfoKHR)(nil).Flags)},
+		{"handleType", unsafe.Offsetof((*ImportFenceFdInfoKHR)(nil).HandleType)},
+		{"fd", unsafe.Offsetof((*ImportFenceFdInfoKHR)(nil).Fd)},
+	}},
+	{"VkFenceGetFdInfoKHR", unsafe.Sizeof(*(*FenceGetFdInfoKHR)(nil)), unsafe.Alignof(*(*FenceGetFdInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*FenceGetFdInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*FenceGetFdInfoKHR)(nil).PNext)},
+		{"fence", unsafe.Offsetof((*FenceGetFdInfoKHR)(nil).Fence)},
+		{"handleType", unsafe.Offsetof((*FenceGetFdInfoKHR)(nil).HandleType)},
+	}},
+	{"VkPhysicalDevicePerformanceQueryFeaturesKHR", unsafe.Sizeof(*(*PhysicalDevicePerformanceQueryFeaturesKHR)(nil)), unsafe.Alignof(*(*PhysicalDevicePerformanceQueryFeaturesKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDevicePerformanceQueryFeaturesKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDevicePerformanceQueryFeaturesKHR)(nil).PNext)},
+		{"performanceCounterQueryPools", unsafe.Offsetof((*PhysicalDevicePerformanceQueryFeaturesKHR)(nil).PerformanceCounterQueryPools)},
+		{"performanceCounterMultipleQueryPools", unsafe.Offsetof((*PhysicalDevicePerformanceQueryFeaturesKHR)(nil).PerformanceCounterMultipleQueryPools)},
+	}},
+	{"VkPhysicalDevicePerformanceQueryPropertiesKHR", unsafe.Sizeof(*(*PhysicalDevicePerformanceQueryPropertiesKHR)(nil)), unsafe.Alignof(*(*PhysicalDevicePerformanceQueryPropertiesKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDevicePerformanceQueryPropertiesKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDevicePerformanceQueryPropertiesKHR)(nil).PNext)},
+		{"allowCommandBufferQueryCopies", unsafe.Offsetof((*PhysicalDevicePerformanceQueryPropertiesKHR)(nil).AllowCommandBufferQueryCopies)},
+	}},
+	{"VkPerformanceCounterKHR", unsafe.Sizeof(*(*PerformanceCounterKHR)(nil)), unsafe.Alignof(*(*PerformanceCounterKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PerformanceCounterKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PerformanceCounterKHR)(nil).PNext)},
+		{"unit", unsafe.Offsetof((*PerformanceCounterKHR)(nil).Unit)},
+		{"scope", unsafe.Offsetof((*PerformanceCounterKHR)(nil).Scope)},
+		{"storage", unsafe.Offsetof((*PerformanceCounterKHR)(nil).Storage)},
+		{"uuid", unsafe.Offsetof((*PerformanceCounterKHR)(nil).Uuid)},
+	}},
+	{"VkPerformanceCounterDescriptionKHR", unsafe.Sizeof(*(*PerformanceCounterDescriptionKHR)(nil)), unsafe.Alignof(*(*PerformanceCounterDescriptionKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PerformanceCounterDescriptionKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PerformanceCounterDescriptionKHR)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*PerformanceCounterDescriptionKHR)(nil).Flags)},
+		{"name", unsafe.Offsetof((*PerformanceCounterDescriptionKHR)(nil).Name)},
+		{"category", unsafe.Offsetof((*PerformanceCounterDescriptionKHR)(nil).Category)},
+		{"description", unsafe.Offsetof((*PerformanceCounterDescriptionKHR)(nil).Description)},
+	}},
+	{"VkQueryPoolPerformanceCreateInfoKHR", unsafe.Sizeof(*(*QueryPoolPerformanceCreateInfoKHR)(nil)), unsafe.Alignof(*(*QueryPoolPerformanceCreateInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*QueryPoolPerformanceCreateInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*QueryPoolPerformanceCreateInfoKHR)(nil).PNext)},
+		{"queueFamilyIndex", unsafe.Offsetof((*QueryPoolPerformanceCreateInfoKHR)(nil).QueueFamilyIndex)},
+		{"counterIndexCount", unsafe.Offsetof((*QueryPoolPerformanceCreateInfoKHR)(nil).CounterIndexCount)},
+		{"pCounterIndices", unsafe.Offsetof((*QueryPoolPerformanceCreateInfoKHR)(nil).PCounterIndices)},
+	}},
+	{"VkPerformanceCounterResultKHR", unsafe.Sizeof(*(*PerformanceCounterResultKHR)(nil)), unsafe.Alignof(*(*PerformanceCounterResultKHR)(nil)), nil},
+	{"VkAcquireProfilingLockInfoKHR", unsafe.Sizeof(*(*AcquireProfilingLockInfoKHR)(nil)), unsafe.Alignof(*(*AcquireProfilingLockInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*AcquireProfilingLockInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*AcquireProfilingLockInfoKHR)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*AcquireProfilingLockInfoKHR)(nil).Flags)},
+		{"timeout", unsafe.Offsetof((*AcquireProfilingLockInfoKHR)(nil).Timeout)},
+	}},
+	{"VkPerformanceQuerySubmitInfoKHR", unsafe.Sizeof(*(*PerformanceQuerySubmitInfoKHR)(nil)), unsafe.Alignof(*(*PerformanceQuerySubmitInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PerformanceQuerySubmitInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PerformanceQuerySubmitInfoKHR)(nil).PNext)},
+		{"counterPassIndex", unsafe.Offsetof((*PerformanceQuerySubmitInfoKHR)(nil).CounterPassIndex)},
+	}},
+	{"VkPhysicalDeviceSurfaceInfo2KHR", unsafe.Sizeof(*(*PhysicalDeviceSurfaceInfo2KHR)(nil)), unsafe.Alignof(*(*PhysicalDeviceSurfaceInfo2KHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceSurfaceInfo2KHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceSurfaceInfo2KHR)(nil).PNext)},
+		{"surface", unsafe.Offsetof((*PhysicalDeviceSurfaceInfo2KHR)(nil).Surface)},
+	}},
+	{"VkSurfaceCapabilities2KHR", unsafe.Sizeof(*(*SurfaceCapabilities2KHR)(nil)), unsafe.Alignof(*(*SurfaceCapabilities2KHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SurfaceCapabilities2KHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SurfaceCapabilities2KHR)(nil).PNext)},
+		{"surfaceCapabilities", unsafe.Offsetof((*SurfaceCapabilities2KHR)(nil).SurfaceCapabilities)},
+	}},
+	{"VkSurfaceFormat2KHR", unsafe.Sizeof(*(*SurfaceFormat2KHR)(nil)), unsafe.Alignof(*(*SurfaceFormat2KHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SurfaceFormat2KHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SurfaceFormat2KHR)(nil).PNext)},
+		{"surfaceFormat", unsafe.Offsetof((*SurfaceFormat2KHR)(nil).SurfaceFormat)},
+	}},
+	{"VkDisplayProperties2KHR", unsafe.Sizeof(*(*DisplayProperties2KHR)(nil)), unsafe.Alignof(*(*DisplayProperties2KHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DisplayProperties2KHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DisplayProperties2KHR)(nil).PNext)},
+		{"displayProperties", unsafe.Offsetof((*DisplayProperties2KHR)(nil).DisplayProperties)},
+	}},
+	{"VkDisplayPlaneProperties2KHR", unsafe.Sizeof(*(*DisplayPlaneProperties2KHR)(nil)), unsafe.Alignof(*(*DisplayPlaneProperties2KHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DisplayPlaneProperties2KHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DisplayPlaneProperties2KHR)(nil).PNext)},
+		{"displayPlaneProperties", unsafe.Offsetof((*DisplayPlaneProperties2KHR)(nil).DisplayPlaneProperties)},
+	}},
+	{"VkDisplayModeProperties2KHR", unsafe.Sizeof(*(*DisplayModeProperties2KHR)(nil)), unsafe.Alignof(*(*DisplayModeProperties2KHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DisplayModeProperties2KHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DisplayModeProperties2KHR)(nil).PNext)},
+		{"displayModeProperties", unsafe.Offsetof((*DisplayModeProperties2KHR)(nil).DisplayModeProperties)},
+	}},
+	{"VkDisplayPlaneInfo2KHR", unsafe.Sizeof(*(*DisplayPlaneInfo2KHR)(nil)), unsafe.Alignof(*(*DisplayPlaneInfo2KHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DisplayPlaneInfo2KHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DisplayPlaneInfo2KHR)(nil).PNext)},
+		{"mode", unsafe.Offsetof((*DisplayPlaneInfo2KHR)(nil).Mode)},
+		{"planeIndex", unsafe.Offsetof((*DisplayPlaneInfo2KHR)(nil).PlaneIndex)},
+	}},
+	{"VkDisplayPlaneCapabilities2KHR", unsafe.Sizeof(*(*DisplayPlaneCapabilities2KHR)(nil)), unsafe.Alignof(*(*DisplayPlaneCapabilities2KHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DisplayPlaneCapabilities2KHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DisplayPlaneCapabilities2KHR)(nil).PNext)},
+		{"capabilities", unsafe.Offsetof((*DisplayPlaneCapabilities2KHR)(nil).Capabilities)},
+	}},
+	{"VkDeviceOrHostAddressKHR", unsafe.Sizeof(*(*DeviceOrHostAddressKHR)(nil)), unsafe.Alignof(*(*DeviceOrHostAddressKHR)(nil)), nil},
+	{"VkDeviceOrHostAddressConstKHR", unsafe.Sizeof(*(*DeviceOrHostAddressConstKHR)(nil)), unsafe.Alignof(*(*DeviceOrHostAddressConstKHR)(nil)), nil},
+	{"VkAccelerationStructureBuildRangeInfoKHR", unsafe.Sizeof(*(*AccelerationStructureBuildRangeInfoKHR)(nil)), unsafe.Alignof(*(*AccelerationStructureBuildRangeInfoKHR)(nil)), []memberOffset{
+		{"primitiveCount", unsafe.Offsetof((*AccelerationStructureBuildRangeInfoKHR)(nil).PrimitiveCount)},
+		{"primitiveOffset", unsafe.Offsetof((*AccelerationStructureBuildRangeInfoKHR)(nil).PrimitiveOffset)},
+		{"firstVertex", unsafe.Offsetof((*AccelerationStructureBuildRangeInfoKHR)(nil).FirstVertex)},
+		{"transformOffset", unsafe.Offsetof((*AccelerationStructureBuildRangeInfoKHR)(nil).TransformOffset)},
+	}},
+	{"VkAabbPositionsKHR", unsafe.Sizeof(*(*AabbPositionsKHR)(nil)), unsafe.Alignof(*(*AabbPositionsKHR)(nil)), []memberOffset{
+		{"minX", unsafe.Offsetof((*AabbPositionsKHR)(nil).MinX)},
+		{"minY", unsafe.Offsetof((*AabbPositionsKHR)(nil).MinY)},
+		{"minZ", unsafe.Offsetof((*AabbPositionsKHR)(nil).MinZ)},
+		{"maxX", unsafe.Offsetof((*AabbPositionsKHR)(nil).MaxX)},
+		{"maxY", unsafe.Offsetof((*AabbPositionsKHR)(nil).MaxY)},
+		{"maxZ", unsafe.Offsetof((*AabbPositionsKHR)(nil).MaxZ)},
+	}},
+	{"VkAccelerationStructureGeometryTrianglesDataKHR", unsafe.Sizeof(*(*AccelerationStructureGeometryTrianglesDataKHR)(nil)), unsafe.Alignof(*(*AccelerationStructureGeometryTrianglesDataKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*AccelerationStructureGeometryTrianglesDataKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*AccelerationStructureGeometryTrianglesDataKHR)(nil).PNext)},
+		{"vertexFormat", unsafe.Offsetof((*AccelerationStructureGeometryTrianglesDataKHR)(nil).VertexFormat)},
+		{"vertexData", unsafe.Offsetof((*AccelerationStructureGeometryTrianglesDataKHR)(nil).VertexData)},
+		{"vertexStride", unsafe.Offsetof((*AccelerationStructureGeometryTrianglesDataKHR)(nil).VertexStride)},
+		{"maxVertex", unsafe.Offsetof((*AccelerationStructureGeometryTrianglesDataKHR)(nil).MaxVertex)},
+		{"indexType", unsafe.Offsetof((*AccelerationStructureGeometryTrianglesDataKHR)(nil).IndexType)},
+		{"indexData", unsafe.Offsetof((*AccelerationStructureGeometryTrianglesDataKHR)(nil).IndexData)},
+		{"transformData", unsafe.Offsetof((*AccelerationStructureGeometryTrianglesDataKHR)(nil).TransformData)},
+	}},
+	{"VkTransformMatrixKHR", unsafe.Sizeof(*(*TransformMatrixKHR)(nil)), unsafe.Alignof(*(*TransformMatrixKHR)(nil)), []memberOffset{
+		{"matrix", unsafe.Offsetof((*TransformMatrixKHR)(nil).Matrix)},
+	}},
+	{"VkAccelerationStructureGeometryAabbsDataKHR", unsafe.Sizeof(*(*AccelerationStructureGeometryAabbsDataKHR)(nil)), unsafe.Alignof(*(*AccelerationStructureGeometryAabbsDataKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*AccelerationStructureGeometryAabbsDataKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*AccelerationStructureGeometryAabbsDataKHR)(nil).PNext)},
+		{"data", unsafe.Offsetof((*AccelerationStructureGeometryAabbsDataKHR)(nil).Data)},
+		{"stride", unsafe.Offsetof((*AccelerationStructureGeometryAabbsDataKHR)(nil).Stride)},
+	}},
+	{"VkAccelerationStructureGeometryInstancesDataKHR", unsafe.Sizeof(*(*AccelerationStructureGeometryInstancesDataKHR)(nil)), unsafe.Alignof(*(*AccelerationStructureGeometryInstancesDataKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*AccelerationStructureGeometryInstancesDataKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*AccelerationStructureGeometryInstancesDataKHR)(nil).PNext)},
+		{"arrayOfPointers", unsafe.Offsetof((*AccelerationStructureGeometryInstancesDataKHR)(nil).ArrayOfPointers)},
+		{"data", unsafe.Offsetof((*AccelerationStructureGeometryInstancesDataKHR)(nil).Data)},
+	}},
+	{"VkAccelerationStructureGeometryDataKHR", unsafe.Sizeof(*(*AccelerationStructureGeometryDataKHR)(nil)), unsafe.Alignof(*(*AccelerationStructureGeometryDataKHR)(nil)), nil},
+	{"VkAccelerationStructureGeometryKHR", unsafe.Sizeof(*(*AccelerationStructureGeometryKHR)(nil)), unsafe.Alignof(*(*AccelerationStructureGeometryKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*AccelerationStructureGeometryKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*AccelerationStructureGeometryKHR)(nil).PNext)},
+		{"geometryType", unsafe.Offsetof((*AccelerationStructureGeometryKHR)(nil).GeometryType)},
+		{"geometry", unsafe.Offsetof((*AccelerationStructureGeometryKHR)(nil).Geometry)},
+		{"flags", unsafe.Offsetof((*AccelerationStructureGeometryKHR)(nil).Flags)},
+	}},
+	{"VkAccelerationStructureBuildGeometryInfoKHR", unsafe.Sizeof(*(*AccelerationStructureBuildGeometryInfoKHR)(nil)), unsafe.Alignof(*(*AccelerationStructureBuildGeometryInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*AccelerationStructureBuildGeometryInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*AccelerationStructureBuildGeometryInfoKHR)(nil).PNext)},
+		{"type", unsafe.Offsetof((*AccelerationStructureBuildGeometryInfoKHR)(nil).Type)},
+		{"flags", unsafe.Offsetof((*AccelerationStructureBuildGeometryInfoKHR)(nil).Flags)},
+		{"mode", unsafe.Offsetof((*AccelerationStructureBuildGeometryInfoKHR)(nil).Mode)},
+		{"srcAccelerationStructure", unsafe.Offsetof((*AccelerationStructureBuildGeometryInfoKHR)(nil).SrcAccelerationStructure)},
+		{"dstAccelerationStructure", unsafe.Offsetof((*AccelerationStructureBuildGeometryInfoKHR)(nil).DstAccelerationStructure)},
+		{"geometryCount", unsafe.Offsetof((*AccelerationStructureBuildGeometryInfoKHR)(nil).GeometryCount)},
+		{"pGeometries", unsafe.Offsetof((*AccelerationStructureBuildGeometryInfoKHR)(nil).PGeometries)},
+		{"ppGeometries", unsafe.Offsetof((*AccelerationStructureBuildGeometryInfoKHR)(nil).PpGeometries)},
+		{"scratchData", unsafe.Offsetof((*AccelerationStructureBuildGeometryInfoKHR)(nil).ScratchData)},
+	}},
+	{"VkAccelerationStructureInstanceKHR", unsafe.Sizeof(*(*AccelerationStructureInstanceKHR)(nil)), unsafe.Alignof(*(*AccelerationStructureInstanceKHR)(nil)), []memberOffset{
+		{"transform", unsafe.Offsetof((*AccelerationStructureInstanceKHR)(nil).Transform)},
+		{"accelerationStructureReference", unsafe.Offsetof((*AccelerationStructureInstanceKHR)(nil).AccelerationStructureReference)},
+	}},
+	{"VkAccelerationStructureCreateInfoKHR", unsafe.Sizeof(*(*AccelerationStructureCreateInfoKHR)(nil)), unsafe.Alignof(*(*AccelerationStructureCreateInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*AccelerationStructureCreateInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*AccelerationStructureCreateInfoKHR)(nil).PNext)},
+		{"createFlags", unsafe.Offsetof((*AccelerationStructureCreateInfoKHR)(nil).CreateFlags)},
+		{"buffer", unsafe.Offsetof((*AccelerationStructureCreateInfoKHR)(nil).Buffer)},
+		{"offset", unsafe.Offsetof((*AccelerationStructureCreateInfoKHR)(nil).Offset)},
+		{"size", unsafe.Offsetof((*AccelerationStructureCreateInfoKHR)(nil).Size)},
+		{"type", unsafe.Offsetof((*AccelerationStructureCreateInfoKHR)(nil).Type)},
+		{"deviceAddress", unsafe.Offsetof((*AccelerationStructureCreateInfoKHR)(nil).DeviceAddress)},
+	}},
+	{"VkWriteDescriptorSetAccelerationStructureKHR", unsafe.Sizeof(*(*WriteDescriptorSetAccelerationStructureKHR)(nil)), unsafe.Alignof(*(*WriteDescriptorSetAccelerationStructureKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*WriteDescriptorSetAccelerationStructureKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*WriteDescriptorSetAccelerationStructureKHR)(nil).PNext)},
+		{"accelerationStructureCount", unsafe.Offsetof((*WriteDescriptorSetAccelerationStructureKHR)(nil).AccelerationStructureCount)},
+		{"pAccelerationStructures", unsafe.Offsetof((*WriteDescriptorSetAccelerationStructureKHR)(nil).PAccelerationStructures)},
+	}},
+	{"VkPhysicalDeviceAccelerationStructureFeaturesKHR", unsafe.Sizeof(*(*PhysicalDeviceAccelerationStructureFeaturesKHR)(nil)), unsafe.Alignof(*(*PhysicalDeviceAccelerationStructureFeaturesKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceAccelerationStructureFeaturesKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceAccelerationStructureFeaturesKHR)(nil).PNext)},
+		{"accelerationStructure", unsafe.Offsetof((*PhysicalDeviceAccelerationStructureFeaturesKHR)(nil).AccelerationStructure)},
+		{"accelerationStructureCaptureReplay", unsafe.Offsetof((*PhysicalDeviceAccelerationStructureFeaturesKHR)(nil).AccelerationStructureCaptureReplay)},
+		{"accelerationStructureIndirectBuild", unsafe.Offsetof((*PhysicalDeviceAccelerationStructureFeaturesKHR)(nil).AccelerationStructureIndirectBuild)},
+		{"accelerationStructureHostCommands", unsafe.Offsetof((*PhysicalDeviceAccelerationStructureFeaturesKHR)(nil).AccelerationStructureHostCommands)},
+		{"descriptorBindingAccelerationStructureUpdateAfterBind", unsafe.Offsetof((*PhysicalDeviceAccelerationStructureFeaturesKHR)(nil).DescriptorBindingAccelerationStructureUpdateAfterBind)},
+	}},
+	{"VkPhysicalDeviceAccelerationStructurePropertiesKHR", unsafe.Sizeof(*(*PhysicalDeviceAccelerationStructurePropertiesKHR)(nil)), unsafe.Alignof(*(*PhysicalDeviceAccelerationStructurePropertiesKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceAccelerationStructurePropertiesKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceAccelerationStructurePropertiesKHR)(nil).PNext)},
+		{"maxGeometryCount", unsafe.Offsetof((*PhysicalDeviceAccelerationStructurePropertiesKHR)(nil).MaxGeometryCount)},
+		{"maxInstanceCount", unsafe.Offsetof((*PhysicalDeviceAccelerationStructurePropertiesKHR)(nil).MaxInstanceCount)},
+		{"maxPrimitiveCount", unsafe.Offsetof((*PhysicalDeviceAccelerationStructurePropertiesKHR)(nil).MaxPrimitiveCount)},
+		{"maxPerStageDescriptorAccelerationStructures", unsafe.Offsetof((*PhysicalDeviceAccelerationStructurePropertiesKHR)(nil).MaxPerStageDescriptorAccelerationStructures)},
+		{"maxPerStageDescriptorUpdateAfterBindAccelerationStructures", unsafe.Offsetof((*PhysicalDeviceAccelerationStructurePropertiesKHR)(nil).MaxPerStageDescriptorUpdateAfterBindAccelerationStructures)},
+		{"maxDescriptorSetAccelerationStructures", unsafe.Offsetof((*PhysicalDeviceAccelerationStructurePropertiesKHR)(nil).MaxDescriptorSetAccelerationStructures)},
+		{"maxDescriptorSetUpdateAfterBindAccelerationStructures", unsafe.Offsetof((*PhysicalDeviceAccelerationStructurePropertiesKHR)(nil).MaxDescriptorSetUpdateAfterBindAccelerationStructures)},
+		{"minAccelerationStructureScratchOffsetAlignment", unsafe.Offsetof((*PhysicalDeviceAccelerationStructurePropertiesKHR)(nil).MinAccelerationStructureScratchOffsetAlignment)},
+	}},
+	{"VkAccelerationStructureDeviceAddressInfoKHR", unsafe.Sizeof(*(*AccelerationStructureDeviceAddressInfoKHR)(nil)), unsafe.Alignof(*(*AccelerationStructureDeviceAddressInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*AccelerationStructureDeviceAddressInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*AccelerationStructureDeviceAddressInfoKHR)(nil).PNext)},
+		{"accelerationStructure", unsafe.Offsetof((*AccelerationStructureDeviceAddressInfoKHR)(nil).AccelerationStructure)},
+	}},
+	{"VkAccelerationStructureVersionInfoKHR", unsafe.Sizeof(*(*AccelerationStructureVersionInfoKHR)(nil)), unsafe.Alignof(*(*AccelerationStructureVersionInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*AccelerationStructureVersionInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*AccelerationStructureVersionInfoKHR)(nil).PNext)},
+		{"pVersionData", unsafe.Offsetof((*AccelerationStructureVersionInfoKHR)(nil).PVersionData)},
+	}},
+	{"VkCopyAccelerationStructureToMemoryInfoKHR", unsafe.Sizeof(*(*CopyAccelerationStructureToMemoryInfoKHR)(nil)), unsafe.Alignof(*(*CopyAccelerationStructureToMemoryInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*CopyAccelerationStructureToMemoryInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*CopyAccelerationStructureToMemoryInfoKHR)(nil).PNext)},
+		{"src", unsafe.Offsetof((*CopyAccelerationStructureToMemoryInfoKHR)(nil).Src)},
+		{"dst", unsafe.Offsetof((*CopyAccelerationStructureToMemoryInfoKHR)(nil).Dst)},
+		{"mode", unsafe.Offsetof((*CopyAccelerationStructureToMemoryInfoKHR)(nil).Mode)},
+	}},
+	{"VkCopyMemoryToAccelerationStructureInfoKHR", unsafe.Sizeof(*(*CopyMemoryToAccelerationStructureInfoKHR)(nil)), unsafe.Alignof(*(*CopyMemoryToAccelerationStructureInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*CopyMemoryToAccelerationStructureInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*CopyMemoryToAccelerationStructureInfoKHR)(nil).PNext)},
+		{"src", unsafe.Offsetof((*CopyMemoryToAccelerationStructureInfoKHR)(nil).Src)},
+		{"dst", unsafe.Offsetof((*CopyMemoryToAccelerationStructureInfoKHR)(nil).Dst)},
+		{"mode", unsafe.Offsetof((*CopyMemoryToAccelerationStructureInfoKHR)(nil).Mode)},
+	}},
+	{"VkCopyAccelerationStructureInfoKHR", unsafe.Sizeof(*(*CopyAccelerationStructureInfoKHR)(nil)), unsafe.Alignof(*(*CopyAccelerationStructureInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*CopyAccelerationStructureInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*CopyAccelerationStructureInfoKHR)(nil).PNext)},
+		{"src", unsafe.Offsetof((*CopyAccelerationStructureInfoKHR)(nil).Src)},
+		{"dst", unsafe.Offsetof((*CopyAccelerationStructureInfoKHR)(nil).Dst)},
+		{"mode", unsafe.Offsetof((*CopyAccelerationStructureInfoKHR)(nil).Mode)},
+	}},
+	{"VkAccelerationStructureBuildSizesInfoKHR", unsafe.Sizeof(*(*AccelerationStructureBuildSizesInfoKHR)(nil)), unsafe.Alignof(*(*AccelerationStructureBuildSizesInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*AccelerationStructureBuildSizesInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*AccelerationStructureBuildSizesInfoKHR)(nil).PNext)},
+		{"accelerationStructureSize", unsafe.Offsetof((*AccelerationStructureBuildSizesInfoKHR)(nil).AccelerationStructureSize)},
+		{"updateScratchSize", unsafe.Offsetof((*AccelerationStructureBuildSizesInfoKHR)(nil).UpdateScratchSize)},
+		{"buildScratchSize", unsafe.Offsetof((*AccelerationStructureBuildSizesInfoKHR)(nil).BuildScratchSize)},
+	}},
+	{"VkPhysicalDeviceShaderClockFeaturesKHR", unsafe.Sizeof(*(*PhysicalDeviceShaderClockFeaturesKHR)(nil)), unsafe.Alignof(*(*PhysicalDeviceShaderClockFeaturesKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceShaderClockFeaturesKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceShaderClockFeaturesKHR)(nil).PNext)},
+		{"shaderSubgroupClock", unsafe.Offsetof((*PhysicalDeviceShaderClockFeaturesKHR)(nil).ShaderSubgroupClock)},
+		{"shaderDeviceClock", unsafe.Offsetof((*PhysicalDeviceShaderClockFeaturesKHR)(nil).ShaderDeviceClock)},
+	}},
+	{"StdVideoH265DecPicBufMgr", unsafe.Sizeof(*(*StdVideoH265DecPicBufMgr)(nil)), unsafe.Alignof(*(*StdVideoH265DecPicBufMgr)(nil)), []memberOffset{
+		{"max_latency_increase_plus1", unsafe.Offsetof((*StdVideoH265DecPicBufMgr)(nil).MaxLatencyIncreasePlus1)},
+		{"max_dec_pic_buffering_minus1", unsafe.Offsetof((*StdVideoH265DecPicBufMgr)(nil).MaxDecPicBufferingMinus1)},
+		{"max_num_reorder_pics", unsafe.Offsetof((*StdVideoH265DecPicBufMgr)(nil).MaxNumReorderPics)},
+	}},
+	{"StdVideoH265SubLayerHrdParameters", unsafe.Sizeof(*(*StdVideoH265SubLayerHrdParameters)(nil)), unsafe.Alignof(*(*StdVideoH265SubLayerHrdParameters)(nil)), []memberOffset{
+		{"bit_rate_value_minus1", unsafe.Offsetof((*StdVideoH265SubLayerHrdParameters)(nil).BitRateValueMinus1)},
+		{"cpb_size_value_minus1", unsafe.Offsetof((*StdVideoH265SubLayerHrdParameters)(nil).CpbSizeValueMinus1)},
+		{"cpb_size_du_value_minus1", unsafe.Offsetof((*StdVideoH265SubLayerHrdParameters)(nil).CpbSizeDuValueMinus1)},
+		{"bit_rate_du_value_minus1", unsafe.Offsetof((*StdVideoH265SubLayerHrdParameters)(nil).BitRateDuValueMinus1)},
+		{"cbr_flag", unsafe.Offsetof((*StdVideoH265SubLayerHrdParameters)(nil).CbrFlag)},
+	}},
+	{"StdVideoH265HrdFlags", unsafe.Sizeof(*(*StdVideoH265HrdFlags)(nil)), unsafe.Alignof(*(*StdVideoH265HrdFlags)(nil)), nil},
+	{"StdVideoH265HrdParameters", unsafe.Sizeof(*(*StdVideoH265HrdParameters)(nil)), unsafe.Alignof(*(*StdVideoH265HrdParameters)(nil)), []memberOffset{
+		{"flags", unsafe.Offsetof((*StdVideoH265HrdParameters)(nil).Flags)},
+		{"tick_divisor_minus2", unsafe.Offsetof((*StdVideoH265HrdParameters)(nil).TickDivisorMinus2)},
+		{"du_cpb_removal_delay_increment_length_minus1", unsafe.Offsetof((*StdVideoH265HrdParameters)(nil).DuCpbRemovalDelayIncrementLengthMinus1)},
+		{"dpb_output_delay_du_length_minus1", unsafe.Offsetof((*StdVideoH265HrdParameters)(nil).DpbOutputDelayDuLengthMinus1)},
+		{"bit_rate_scale", unsafe.Offsetof((*StdVideoH265HrdParameters)(nil).BitRateScale)},
+		{"cpb_size_scale", unsafe.Offsetof((*StdVideoH265HrdParameters)(nil).CpbSizeScale)},
+		{"cpb_size_du_scale", unsafe.Offsetof((*StdVideoH265HrdParameters)(nil).CpbSizeDuScale)},
+		{"initial_cpb_removal_delay_length_minus1", unsafe.Offsetof((*StdVideoH265HrdParameters)(nil).InitialCpbRemovalDelayLengthMinus1)},
+		{"au_cpb_removal_delay_length_minus1", unsafe.Offsetof((*StdVideoH265HrdParameters)(nil).AuCpbRemovalDelayLengthMinus1)},
+		{"dpb_output_delay_length_minus1", unsafe.Offsetof((*StdVideoH265HrdParameters)(nil).DpbOutputDelayLengthMinus1)},
+		{"cpb_cnt_minus1", unsafe.Offsetof((*StdVideoH265HrdParameters)(nil).CpbCntMinus1)},
+		{"elemental_duration_in_tc_minus1", unsafe.Offsetof((*StdVideoH265HrdParameters)(nil).ElementalDurationInTcMinus1)},
+		{"reserved", unsafe.Offsetof((*StdVideoH265HrdParameters)(nil).Reserved)},
+		{"pSubLayerHrdParametersNal", unsafe.Offsetof((*StdVideoH265HrdParameters)(nil).PSubLayerHrdParametersNal)},
+		{"pSubLayerHrdParametersVcl", unsafe.Offsetof((*StdVideoH265HrdParameters)(nil).PSubLayerHrdParametersVcl)},
+	}},
+	{"StdVideoH265VpsFlags", unsafe.Sizeof(*(*StdVideoH265VpsFlags)(nil)), unsafe.Alignof(*(*StdVideoH265VpsFlags)(nil)), nil},
+	{"StdVideoH265ProfileTierLevelFlags", unsafe.Sizeof(*(*StdVideoH265ProfileTierLevelFlags)(nil)), unsafe.Alignof(*(*StdVideoH265ProfileTierLevelFlags)(nil)), nil},
+	{"StdVideoH265ProfileTierLevel", unsafe.Sizeof(*(*StdVideoH265ProfileTierLevel)(nil)), unsafe.Alignof(*(*StdVideoH265ProfileTierLevel)(nil)), []memberOffset{
+		{"flags", unsafe.Offsetof((*StdVideoH265ProfileTierLevel)(nil).Flags)},
+		{"general_profile_idc", unsafe.Offsetof((*StdVideoH265ProfileTierLevel)(nil).GeneralProfileIdc)},
+		{"general_level_idc", unsafe.Offsetof((*StdVideoH265ProfileTierLevel)(nil).GeneralLevelIdc)},
+	}},
+	{"StdVideoH265VideoParameterSet", unsafe.Sizeof(*(*StdVideoH265VideoParameterSet)(nil)), unsafe.Alignof(*(*StdVideoH265VideoParameterSet)(nil)), []memberOffset{
+		{"flags", unsafe.Offsetof((*StdVideoH265VideoParameterSet)(nil).Flags)},
+		{"vps_video_parameter_set_id", unsafe.Offsetof((*StdVideoH265VideoParameterSet)(nil).VpsVideoParameterSetId)},
+		{"vps_max_sub_layers_minus1", unsafe.Offsetof((*StdVideoH265VideoParameterSet)(nil).VpsMaxSubLayersMinus1)},
+		{"reserved1", unsafe.Offsetof((*StdVideoH265VideoParameterSet)(nil).Reserved1)},
+		{"reserved2", unsafe.Offsetof((*StdVideoH265VideoParameterSet)(nil).Reserved2)},
+		{"vps_num_units_in_tick", unsafe.Offsetof((*StdVideoH265VideoParameterSet)(nil).VpsNumUnitsInTick)},
+		{"vps_time_scale", unsafe.Offsetof((*StdVideoH265VideoParameterSet)(nil).VpsTimeScale)},
+		{"vps_num_ticks_poc_diff_one_minus1", unsafe.Offsetof((*StdVideoH265VideoParameterSet)(nil).VpsNumTicksPocDiffOneMinus1)},
+		{"reserved3", unsafe.Offsetof((*StdVideoH265VideoParameterSet)(nil).Reserved3)},
+		{"pDecPicBufMgr", unsafe.Offsetof((*StdVideoH265VideoParameterSet)(nil).PDecPicBufMgr)},
+		{"pHrdParameters", unsafe.Offsetof((*StdVideoH265VideoParameterSet)(nil).PHrdParameters)},
+		{"pProfileTierLevel", unsafe.Offsetof((*StdVideoH265VideoParameterSet)(nil).PProfileTierLevel)},
+	}},
+	{"StdVideoH265ScalingLists", unsafe.Sizeof(*(*StdVideoH265ScalingLists)(nil)), unsafe.Alignof(*(*StdVideoH265ScalingLists)(nil)), []memberOffset{
+		{"ScalingList4x4", unsafe.Offsetof((*StdVideoH265ScalingLists)(nil).ScalingList4x4)},
+		{"ScalingList8x8", unsafe.Offsetof((*StdVideoH265ScalingLists)(nil).ScalingList8x8)},
+		{"ScalingList16x16", unsafe.Offsetof((*StdVideoH265ScalingLists)(nil).ScalingList16x16)},
+		{"ScalingList32x32", unsafe.Offsetof((*StdVideoH265ScalingLists)(nil).ScalingList32x32)},
+		{"ScalingListDCCoef16x16", unsafe.Offsetof((*StdVideoH265ScalingLists)(nil).ScalingListDCCoef16x16)},
+		{"ScalingListDCCoef32x32", unsafe.Offsetof((*StdVideoH265ScalingLists)(nil).ScalingListDCCoef32x32)},
+	}},
+	{"StdVideoH265SpsVuiFlags", unsafe.Sizeof(*(*StdVideoH265SpsVuiFlags)(nil)), unsafe.Alignof(*(*StdVideoH265SpsVuiFlags)(nil)), nil},
+	{"StdVideoH265SequenceParameterSetVui", unsafe.Sizeof(*(*StdVideoH265SequenceParameterSetVui)(nil)), unsafe.Alignof(*(*StdVideoH265SequenceParameterSetVui)(nil)), []memberOffset{
+		{"flags", unsafe.Offsetof((*StdVideoH265SequenceParameterSetVui)(nil).Flags)},
+		{"aspect_ratio_idc", unsafe.Offsetof((*StdVideoH265SequenceParameterSetVui)(nil).AspectRatioIdc)},
+		{"sar_width", unsafe.Offsetof((*StdVideoH265SequenceParameterSetVui)(nil).SarWidth)},
+		{"sar_height", unsafe.Offsetof((*StdVideoH265SequenceParameterSetVui)(nil).SarHeight)},
+		{"video_format", unsafe.Offsetof((*StdVideoH265SequenceParameterSetVui)(nil).VideoFormat)},
+		{"colour_primaries", unsafe.Offsetof((*StdVideoH265SequenceParameterSetVui)(nil).ColourPrimaries)},
+		{"transfer_characteristics", unsafe.Offsetof((*StdVideoH265SequenceParameterSetVui)(nil).TransferCharacteristics)},
+		{"matrix_coeffs", unsafe.Offsetof((*StdVideoH265SequenceParameterSetVui)(nil).MatrixCoeffs)},
+		{"chroma_sample_loc_type_top_field", unsafe.Offsetof((*StdVideoH265SequenceParameterSetVui)(nil).ChromaSampleLocTypeTopField)},
+		{"chroma_sample_loc_type_bottom_field", unsafe.Offsetof((*StdVideoH265SequenceParameterSetVui)(nil).ChromaSampleLocTypeBottomField)},
+		{"reserved1", unsafe.Offsetof((*StdVideoH265SequenceParameterSetVui)(nil).Reserved1)},
+		{"reserved2", unsafe.Offsetof((*StdVideoH265SequenceParameterSetVui)(nil).Reserved2)},
+		{"def_disp_win_left_offset", unsafe.Offsetof((*StdVideoH265SequenceParameterSetVui)(nil).DefDispWinLeftOffset)},
+		{"def_disp_win_right_offset", unsafe.Offsetof((*StdVideoH265SequenceParameterSetVui)(nil).DefDispWinRightOffset)},
+		{"def_disp_win_top_offset", unsafe.Offsetof((*StdVideoH265SequenceParameterSetVui)(nil).DefDispWinTopOffset)},
+		{"def_disp_win_bottom_offset", unsafe.Offsetof((*StdVideoH265SequenceParameterSetVui)(nil).DefDispWinBottomOffset)},
+		{"vui_num_units_in_tick", unsafe.Offsetof((*StdVideoH265SequenceParameterSetVui)(nil).VuiNumUnitsInTick)},
+		{"vui_time_scale", unsafe.Offsetof((*StdVideoH265SequenceParameterSetVui)(nil).VuiTimeScale)},
+		{"vui_num_ticks_poc_diff_one_minus1", unsafe.Offsetof((*StdVideoH265SequenceParameterSetVui)(nil).VuiNumTicksPocDiffOneMinus1)},
+		{"min_spatial_segmentation_idc", unsafe.Offsetof((*StdVideoH265SequenceParameterSetVui)(nil).MinSpatialSegmentationIdc)},
+		{"reserved3", unsafe.Offsetof((*StdVideoH265SequenceParameterSetVui)(nil).Reserved3)},
+		{"max_bytes_per_pic_denom", unsafe.Offsetof((*StdVideoH265SequenceParameterSetVui)(nil).MaxBytesPerPicDenom)},
+		{"max_bits_per_min_cu_denom", unsafe.Offsetof((*StdVideoH265SequenceParameterSetVui)(nil).MaxBitsPerMinCuDenom)},
+		{"log2_max_mv_length_horizontal", unsafe.Offsetof((*StdVideoH265SequenceParameterSetVui)(nil).Log2MaxMvLengthHorizontal)},
+		{"log2_max_mv_length_vertical", unsafe.Offsetof((*StdVideoH265SequenceParameterSetVui)(nil).Log2MaxMvLengthVertical)},
+		{"pHrdParameters", unsafe.Offsetof((*StdVideoH265SequenceParameterSetVui)(nil).PHrdParameters)},
+	}},
+	{"StdVideoH265PredictorPaletteEntries", unsafe.Sizeof(*(*StdVideoH265PredictorPaletteEntries)(nil)), unsafe.Alignof(*(*StdVideoH265PredictorPaletteEntries)(nil)), []memberOffset{
+		{"PredictorPaletteEntries", unsafe.Offsetof((*StdVideoH265PredictorPaletteEntries)(nil).PredictorPaletteEntries)},
+	}},
+	{"StdVideoH265SpsFlags", unsafe.Sizeof(*(*StdVideoH265SpsFlags)(nil)), unsafe.Alignof(*(*StdVideoH265SpsFlags)(nil)), nil},
+	{"StdVideoH265ShortTermRefPicSetFlags", unsafe.Sizeof(*(*StdVideoH265ShortTermRefPicSetFlags)(nil)), unsafe.Alignof(*(*StdVideoH265ShortTermRefPicSetFlags)(nil)), nil},
+	{"StdVideoH265ShortTermRefPicSet", unsafe.Sizeof(*(*StdVideoH265ShortTermRefPicSet)(nil)), unsafe.Alignof(*(*StdVideoH265ShortTermRefPicSet)(nil)), []memberOffset{
+		{"flags", unsafe.Offsetof((*StdVideoH265ShortTermRefPicSet)(nil).Flags)},
+		{"delta_idx_minus1", unsafe.Offsetof((*StdVideoH265ShortTermRefPicSet)(nil).DeltaIdxMinus1)},
+		{"use_delta_flag", unsafe.Offsetof((*StdVideoH265ShortTermRefPicSet)(nil).UseDeltaFlag)},
+		{"abs_delta_rps_minus1", unsafe.Offsetof((*StdVideoH265ShortTermRefPicSet)(nil).AbsDeltaRpsMinus1)},
+		{"used_by_curr_pic_flag", unsafe.Offsetof((*StdVideoH265ShortTermRefPicSet)(nil).UsedByCurrPicFlag)},
+		{"used_by_curr_pic_s0_flag", unsafe.Offsetof((*StdVideoH265ShortTermRefPicSet)(nil).UsedByCurrPicS0Flag)},
+		{"used_by_curr_pic_s1_flag", unsafe.Offsetof((*StdVideoH265ShortTermRefPicSet)(nil).UsedByCurrPicS1Flag)},
+		{"reserved1", unsafe.Offsetof((*StdVideoH265ShortTermRefPicSet)(nil).Reserved1)},
+		{"reserved2", unsafe.Offsetof((*StdVideoH265ShortTermRefPicSet)(nil).Reserved2)},
+		{"reserved3", unsafe.Offsetof((*StdVideoH265ShortTermRefPicSet)(nil).Reserved3)},
+		{"num_negative_pics", unsafe.Offsetof((*StdVideoH265ShortTermRefPicSet)(nil).NumNegativePics)},
+		{"num_positive_pics", unsafe.Offsetof((*StdVideoH265ShortTermRefPicSet)(nil).NumPositivePics)},
+		{"delta_poc_s0_minus1", unsafe.Offsetof((*StdVideoH265ShortTermRefPicSet)(nil).DeltaPocS0Minus1)},
+		{"delta_poc_s1_minus1", unsafe.Offsetof((*StdVideoH265ShortTermRefPicSet)(nil).DeltaPocS1Minus1)},
+	}},
+	{"StdVideoH265LongTermRefPicsSps", unsafe.Sizeof(*(*StdVideoH265LongTermRefPicsSps)(nil)), unsafe.Alignof(*(*StdVideoH265LongTermRefPicsSps)(nil)), []memberOffset{
+		{"used_by_curr_pic_lt_sps_flag", unsafe.Offsetof((*StdVideoH265LongTermRefPicsSps)(nil).UsedByCurrPicLtSpsFlag)},
+		{"lt_ref_pic_poc_lsb_sps", unsafe.Offsetof((*StdVideoH265LongTermRefPicsSps)(nil).LtRefPicPocLsbSps)},
+	}},
+	{"StdVideoH265SequenceParameterSet", unsafe.Sizeof(*(*StdVideoH265SequenceParameterSet)(nil)), unsafe.Alignof(*(*StdVideoH265SequenceParameterSet)(nil)), []memberOffset{
+		{"flags", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).Flags)},
+		{"chroma_format_idc", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).ChromaFormatIdc)},
+		{"pic_width_in_luma_samples", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).PicWidthInLumaSamples)},
+		{"pic_height_in_luma_samples", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).PicHeightInLumaSamples)},
+		{"sps_video_parameter_set_id", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).SpsVideoParameterSetId)},
+		{"sps_max_sub_layers_minus1", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).SpsMaxSubLayersMinus1)},
+		{"sps_seq_parameter_set_id", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).SpsSeqParameterSetId)},
+		{"bit_depth_luma_minus8", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).BitDepthLumaMinus8)},
+		{"bit_depth_chroma_minus8", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).BitDepthChromaMinus8)},
+		{"log2_max_pic_order_cnt_lsb_minus4", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).Log2MaxPicOrderCntLsbMinus4)},
+		{"log2_min_luma_coding_block_size_minus3", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).Log2MinLumaCodingBlockSizeMinus3)},
+		{"log2_diff_max_min_luma_coding_block_size", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).Log2DiffMaxMinLumaCodingBlockSize)},
+		{"log2_min_luma_transform_block_size_minus2", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).Log2MinLumaTransformBlockSizeMinus2)},
+		{"log2_diff_max_min_luma_transform_block_size", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).Log2DiffMaxMinLumaTransformBlockSize)},
+		{"max_transform_hierarchy_depth_inter", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).MaxTransformHierarchyDepthInter)},
+		{"max_transform_hierarchy_depth_intra", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).MaxTransformHierarchyDepthIntra)},
+		{"num_short_term_ref_pic_sets", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).NumShortTermRefPicSets)},
+		{"num_long_term_ref_pics_sps", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).NumLongTermRefPicsSps)},
+		{"pcm_sample_bit_depth_luma_minus1", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).PcmSampleBitDepthLumaMinus1)},
+		{"pcm_sample_bit_depth_chroma_minus1", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).PcmSampleBitDepthChromaMinus1)},
+		{"log2_min_pcm_luma_coding_block_size_minus3", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).Log2MinPcmLumaCodingBlockSizeMinus3)},
+		{"log2_diff_max_min_pcm_luma_coding_block_size", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).Log2DiffMaxMinPcmLumaCodingBlockSize)},
+		{"reserved1", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).Reserved1)},
+		{"reserved2", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).Reserved2)},
+		{"palette_max_size", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).PaletteMaxSize)},
+		{"delta_palette_max_predictor_size", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).DeltaPaletteMaxPredictorSize)},
+		{"motion_vector_resolution_control_idc", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).MotionVectorResolutionControlIdc)},
+		{"sps_num_palette_predictor_initializers_minus1", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).SpsNumPalettePredictorInitializersMinus1)},
+		{"conf_win_left_offset", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).ConfWinLeftOffset)},
+		{"conf_win_right_offset", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).ConfWinRightOffset)},
+		{"conf_win_top_offset", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).ConfWinTopOffset)},
+		{"conf_win_bottom_offset", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).ConfWinBottomOffset)},
+		{"pProfileTierLevel", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).PProfileTierLevel)},
+		{"pDecPicBufMgr", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).PDecPicBufMgr)},
+		{"pScalingLists", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).PScalingLists)},
+		{"pShortTermRefPicSet", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).PShortTermRefPicSet)},
+		{"pLongTermRefPicsSps", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).PLongTermRefPicsSps)},
+		{"pSequenceParameterSetVui", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).PSequenceParameterSetVui)},
+		{"pPredictorPaletteEntries", unsafe.Offsetof((*StdVideoH265SequenceParameterSet)(nil).PPredictorPaletteEntries)},
+	}},
+	{"StdVideoH265PpsFlags", unsafe.Sizeof(*(*StdVideoH265PpsFlags)(nil)), unsafe.Alignof(*(*StdVideoH265PpsFlags)(nil)), nil},
+	{"StdVideoH265PictureParameterSet", unsafe.Sizeof(*(*StdVideoH265PictureParameterSet)(nil)), unsafe.Alignof(*(*StdVideoH265PictureParameterSet)(nil)), []memberOffset{
+		{"flags", unsafe.Offsetof((*StdVideoH265PictureParameterSet)(nil).Flags)},
+		{"pps_pic_parameter_set_id", unsafe.Offsetof((*StdVideoH265PictureParameterSet)(nil).PpsPicParameterSetId)},
+		{"pps_seq_parameter_set_id", unsafe.Offsetof((*StdVideoH265PictureParameterSet)(nil).PpsSeqParameterSetId)},
+		{"sps_video_parameter_set_id", unsafe.Offsetof((*StdVideoH265PictureParameterSet)(nil).SpsVideoParameterSetId)},
+		{"num_extra_slice_header_bits", unsafe.Offsetof((*StdVideoH265PictureParameterSet)(nil).NumExtraSliceHeaderBits)},
+		{"num_ref_idx_l0_default_active_minus1", unsafe.Offsetof((*StdVideoH265PictureParameterSet)(nil).NumRefIdxL0DefaultActiveMinus1)},
+		{"num_ref_idx_l1_default_active_minus1", unsafe.Offsetof((*StdVideoH265PictureParameterSet)(nil).NumRefIdxL1DefaultActiveMinus1)},
+		{"init_qp_minus26", unsafe.Offsetof((*StdVideoH265PictureParameterSet)(nil).InitQpMinus26)},
+		{"diff_cu_qp_delta_depth", unsafe.Offsetof((*StdVideoH265PictureParameterSet)(nil).DiffCuQpDeltaDepth)},
+		{"pps_cb_qp_offset", unsafe.Offsetof((*StdVideoH265PictureParameterSet)(nil).PpsCbQpOffset)},
+		{"pps_cr_qp_offset", unsafe.Offsetof((*StdVideoH265PictureParameterSet)(nil).PpsCrQpOffset)},
+		{"pps_beta_offset_div2", unsafe.Offsetof((*StdVideoH265PictureParameterSet)(nil).PpsBetaOffsetDiv2)},
+		{"pps_tc_offset_div2", unsafe.Offsetof((*StdVideoH265PictureParameterSet)(nil).PpsTcOffsetDiv2)},
+		{"log2_parallel_merge_level_minus2", unsafe.Offsetof((*StdVideoH265PictureParameterSet)(nil).Log2ParallelMergeLevelMinus2)},
+		{"log2_max_transform_skip_block_size_minus2", unsafe.Offsetof((*StdVideoH265PictureParameterSet)(nil).Log2MaxTransformSkipBlockSizeMinus2)},
+		{"diff_cu_chroma_qp_offset_depth", unsafe.Offsetof((*StdVideoH265PictureParameterSet)(nil).DiffCuChromaQpOffsetDepth)},
+		{"chroma_qp_offset_list_len_minus1", unsafe.Offsetof((*StdVideoH265PictureParameterSet)(nil).ChromaQpOffsetListLenMinus1)},
+		{"cb_qp_offset_list", unsafe.Offsetof((*StdVideoH265PictureParameterSet)(nil).CbQpOffsetList)},
+		{"cr_qp_offset_list", unsafe.Offsetof((*StdVideoH265PictureParameterSet)(nil).CrQpOffsetList)},
+		{"log2_sao_offset_scale_luma", unsafe.Offsetof((*StdVideoH265PictureParameterSet)(nil).Log2SaoOffsetScaleLuma)},
+		{"log2_sao_offset_scale_chroma", unsafe.Offsetof((*StdVideoH265PictureParameterSet)(nil).Log2SaoOffsetScaleChroma)},
+		{"pps_act_y_qp_offset_plus5", unsafe.Offsetof((*StdVideoH265PictureParameterSet)(nil).PpsActYQpOffsetPlus5)},
+		{"pps_act_cb_qp_offset_plus5", unsafe.Offsetof((*StdVideoH265PictureParameterSet)(nil).PpsActCbQpOffsetPlus5)},
+		{"pps_act_cr_qp_offset_plus3", unsafe.Offsetof((*StdVideoH265PictureParameterSet)(nil).PpsActCrQpOffsetPlus3)},
+		{"pps_num_palette_predictor_initializers", unsafe.Offsetof((*StdVideoH265PictureParameterSet)(nil).PpsNumPalettePredictorInitializers)},
+		{"luma_bit_depth_entry_minus8", unsafe.Offsetof((*StdVideoH265PictureParameterSet)(nil).LumaBitDepthEntryMinus8)},
+		{"chroma_bit_depth_entry_minus8", unsafe.Offsetof((*StdVideoH265PictureParameterSet)(nil).ChromaBitDepthEntryMinus8)},
+		{"num_tile_columns_minus1", unsafe.Offsetof((*StdVideoH265PictureParameterSet)(nil).NumTileColumnsMinus1)},
+		{"num_tile_rows_minus1", unsafe.Offsetof((*StdVideoH265PictureParameterSet)(nil).NumTileRowsMinus1)},
+		{"reserved1", unsafe.Offsetof((*StdVideoH265PictureParameterSet)(nil).Reserved1)},
+		{"reserved2", unsafe.Offsetof((*StdVideoH265PictureParameterSet)(nil).Reserved2)},
+		{"column_width_minus1", unsafe.Offsetof((*StdVideoH265PictureParameterSet)(nil).ColumnWidthMinus1)},
+		{"row_height_minus1", unsafe.Offsetof((*StdVideoH265PictureParameterSet)(nil).RowHeightMinus1)},
+		{"reserved3", unsafe.Offsetof((*StdVideoH265PictureParameterSet)(nil).Reserved3)},
+		{"pScalingLists", unsafe.Offsetof((*StdVideoH265PictureParameterSet)(nil).PScalingLists)},
+		{"pPredictorPaletteEntries", unsafe.Offsetof((*StdVideoH265PictureParameterSet)(nil).PPredictorPaletteEntries)},
+	}},
+	{"VkVideoDecodeH265ProfileInfoKHR", unsafe.Sizeof(*(*VideoDecodeH265ProfileInfoKHR)(nil)), unsafe.Alignof(*(*VideoDecodeH265ProfileInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*VideoDecodeH265ProfileInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*VideoDecodeH265ProfileInfoKHR)(nil).PNext)},
+		{"stdProfileIdc", unsafe.Offsetof((*VideoDecodeH265ProfileInfoKHR)(nil).StdProfileIdc)},
+	}},
+	{"VkVideoDecodeH265CapabilitiesKHR", unsafe.Sizeof(*(*VideoDecodeH265CapabilitiesKHR)(nil)), unsafe.Alignof(*(*VideoDecodeH265CapabilitiesKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*VideoDecodeH265CapabilitiesKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*VideoDecodeH265CapabilitiesKHR)(nil).PNext)},
+		{"maxLevelIdc", unsafe.Offsetof((*VideoDecodeH265CapabilitiesKHR)(nil).MaxLevelIdc)},
+	}},
+	{"VkVideoDecodeH265SessionParametersAddInfoKHR", unsafe.Sizeof(*(*VideoDecodeH265SessionParametersAddInfoKHR)(nil)), unsafe.Alignof(*(*VideoDecodeH265SessionParametersAddInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*VideoDecodeH265SessionParametersAddInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*VideoDecodeH265SessionParametersAddInfoKHR)(nil).PNext)},
+		{"stdVPSCount", unsafe.Offsetof((*VideoDecodeH265SessionParametersAddInfoKHR)(nil).StdVPSCount)},
+		{"pStdVPSs", unsafe.Offsetof((*VideoDecodeH265SessionParametersAddInfoKHR)(nil).PStdVPSs)},
+		{"stdSPSCount", unsafe.Offsetof((*VideoDecodeH265SessionParametersAddInfoKHR)(nil).StdSPSCount)},
+		{"pStdSPSs", unsafe.Offsetof((*VideoDecodeH265SessionParametersAddInfoKHR)(nil).PStdSPSs)},
+		{"stdPPSCount", unsafe.Offsetof((*VideoDecodeH265SessionParametersAddInfoKHR)(nil).StdPPSCount)},
+		{"pStdPPSs", unsafe.Offsetof((*VideoDecodeH265SessionParametersAddInfoKHR)(nil).PStdPPSs)},
+	}},
+	{"VkVideoDecodeH265SessionParametersCreateInfoKHR", unsafe.Sizeof(*(*VideoDecodeH265SessionParametersCreateInfoKHR)(nil)), unsafe.Alignof(*(*VideoDecodeH265SessionParametersCreateInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*VideoDecodeH265SessionParametersCreateInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*VideoDecodeH265SessionParametersCreateInfoKHR)(nil).PNext)},
+		{"maxStdVPSCount", unsafe.Offsetof((*VideoDecodeH265SessionParametersCreateInfoKHR)(nil).MaxStdVPSCount)},
+		{"maxStdSPSCount", unsafe.Offsetof((*VideoDecodeH265SessionParametersCreateInfoKHR)(nil).MaxStdSPSCount)},
+		{"maxStdPPSCount", unsafe.Offsetof((*VideoDecodeH265SessionParametersCreateInfoKHR)(nil).MaxStdPPSCount)},
+		{"pParametersAddInfo", unsafe.Offsetof((*VideoDecodeH265SessionParametersCreateInfoKHR)(nil).PParametersAddInfo)},
+	}},
+	{"StdVideoDecodeH265PictureInfoFlags", unsafe.Sizeof(*(*StdVideoDecodeH265PictureInfoFlags)(nil)), unsafe.Alignof(*(*StdVideoDecodeH265PictureInfoFlags)(nil)), nil},
+	{"StdVideoDecodeH265PictureInfo", unsafe.Sizeof(*(*StdVideoDecodeH265PictureInfo)(nil)), unsafe.Alignof(*(*StdVideoDecodeH265PictureInfo)(nil)), []memberOffset{
+		{"flags", unsafe.Offsetof((*StdVideoDecodeH265PictureInfo)(nil).Flags)},
+		{"sps_video_parameter_set_id", unsafe.Offsetof((*StdVideoDecodeH265PictureInfo)(nil).SpsVideoParameterSetId)},
+		{"pps_seq_parameter_set_id", unsafe.Offsetof((*StdVideoDecodeH265PictureInfo)(nil).PpsSeqParameterSetId)},
+		{"pps_pic_parameter_set_id", unsafe.Offsetof((*StdVideoDecodeH265PictureInfo)(nil).PpsPicParameterSetId)},
+		{"NumDeltaPocsOfRefRpsIdx", unsafe.Offsetof((*StdVideoDecodeH265PictureInfo)(nil).NumDeltaPocsOfRefRpsIdx)},
+		{"PicOrderCntVal", unsafe.Offsetof((*StdVideoDecodeH265PictureInfo)(nil).PicOrderCntVal)},
+		{"NumBitsForSTRefPicSetInSlice", unsafe.Offsetof((*StdVideoDecodeH265PictureInfo)(nil).NumBitsForSTRefPicSetInSlice)},
+		{"reserved", unsafe.Offsetof((*StdVideoDecodeH265PictureInfo)(nil).Reserved)},
+		{"RefPicSetStCurrBefore", unsafe.Offsetof((*StdVideoDecodeH265PictureInfo)(nil).RefPicSetStCurrBefore)},
+		{"RefPicSetStCurrAfter", unsafe.Offsetof((*StdVideoDecodeH265PictureInfo)(nil).RefPicSetStCurrAfter)},
+		{"RefPicSetLtCurr", unsafe.Offsetof((*StdVideoDecodeH265PictureInfo)(nil).RefPicSetLtCurr)},
+	}},
+	{"StdVideoDecodeH265ReferenceInfoFlags", unsafe.Sizeof(*(*StdVideoDecodeH265ReferenceInfoFlags)(nil)), unsafe.Alignof(*(*StdVideoDecodeH265ReferenceInfoFlags)(nil)), nil},
+	{"StdVideoDecodeH265ReferenceInfo", unsafe.Sizeof(*(*StdVideoDecodeH265ReferenceInfo)(nil)), unsafe.Alignof(*(*StdVideoDecodeH265ReferenceInfo)(nil)), []memberOffset{
+		{"flags", unsafe.Offsetof((*StdVideoDecodeH265ReferenceInfo)(nil).Flags)},
+		{"PicOrderCntVal", unsafe.Offsetof((*StdVideoDecodeH265ReferenceInfo)(nil).PicOrderCntVal)},
+	}},
+	{"VkVideoDecodeH265PictureInfoKHR", unsafe.Sizeof(*(*VideoDecodeH265PictureInfoKHR)(nil)), unsafe.Alignof(*(*VideoDecodeH265PictureInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*VideoDecodeH265PictureInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*VideoDecodeH265PictureInfoKHR)(nil).PNext)},
+		{"pStdPictureInfo", unsafe.Offsetof((*VideoDecodeH265PictureInfoKHR)(nil).PStdPictureInfo)},
+		{"sliceSegmentCount", unsafe.Offsetof((*VideoDecodeH265PictureInfoKHR)(nil).SliceSegmentCount)},
+		{"pSliceSegmentOffsets", unsafe.Offsetof((*VideoDecodeH265PictureInfoKHR)(nil).PSliceSegmentOffsets)},
+	}},
+	{"VkVideoDecodeH265DpbSlotInfoKHR", unsafe.Sizeof(*(*VideoDecodeH265DpbSlotInfoKHR)(nil)), unsafe.Alignof(*(*VideoDecodeH265DpbSlotInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*VideoDecodeH265DpbSlotInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*VideoDecodeH265DpbSlotInfoKHR)(nil).PNext)},
+		{"pStdReferenceInfo", unsafe.Offsetof((*VideoDecodeH265DpbSlotInfoKHR)(nil).PStdReferenceInfo)},
+	}},
+	{"VkDeviceQueueGlobalPriorityCreateInfoKHR", unsafe.Sizeof(*(*DeviceQueueGlobalPriorityCreateInfoKHR)(nil)), unsafe.Alignof(*(*DeviceQueueGlobalPriorityCreateInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DeviceQueueGlobalPriorityCreateInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DeviceQueueGlobalPriorityCreateInfoKHR)(nil).PNext)},
+		{"globalPriority", unsafe.Offsetof((*DeviceQueueGlobalPriorityCreateInfoKHR)(nil).GlobalPriority)},
+	}},
+	{"VkPhysicalDeviceGlobalPriorityQueryFeaturesKHR", unsafe.Sizeof(*(*PhysicalDeviceGlobalPriorityQueryFeaturesKHR)(nil)), unsafe.Alignof(*(*PhysicalDeviceGlobalPriorityQueryFeaturesKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceGlobalPriorityQueryFeaturesKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceGlobalPriorityQueryFeaturesKHR)(nil).PNext)},
+		{"globalPriorityQuery", unsafe.Offsetof((*PhysicalDeviceGlobalPriorityQueryFeaturesKHR)(nil).GlobalPriorityQuery)},
+	}},
+	{"VkQueueFamilyGlobalPriorityPropertiesKHR", unsafe.Sizeof(*(*QueueFamilyGlobalPriorityPropertiesKHR)(nil)), unsafe.Alignof(*(*QueueFamilyGlobalPriorityPropertiesKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*QueueFamilyGlobalPriorityPropertiesKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*QueueFamilyGlobalPriorityPropertiesKHR)(nil).PNext)},
+		{"priorityCount", unsafe.Offsetof((*QueueFamilyGlobalPriorityPropertiesKHR)(nil).PriorityCount)},
+		{"priorities", unsafe.Offsetof((*QueueFamilyGlobalPriorityPropertiesKHR)(nil).Priorities)},
+	}},
+	{"VkFragmentShadingRateAttachmentInfoKHR", unsafe.Sizeof(*(*FragmentShadingRateAttachmentInfoKHR)(nil)), unsafe.Alignof(*(*FragmentShadingRateAttachmentInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*FragmentShadingRateAttachmentInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*FragmentShadingRateAttachmentInfoKHR)(nil).PNext)},
+		{"pFragmentShadingRateAttachment", unsafe.Offsetof((*FragmentShadingRateAttachmentInfoKHR)(nil).PFragmentShadingRateAttachment)},
+		{"shadingRateAttachmentTexelSize", unsafe.Offsetof((*FragmentShadingRateAttachmentInfoKHR)(nil).ShadingRateAttachmentTexelSize)},
+	}},
+	{"VkPipelineFragmentShadingRateStateCreateInfoKHR", unsafe.Sizeof(*(*PipelineFragmentShadingRateStateCreateInfoKHR)(nil)), unsafe.Alignof(*(*PipelineFragmentShadingRateStateCreateInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineFragmentShadingRateStateCreateInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineFragmentShadingRateStateCreateInfoKHR)(nil).PNext)},
+		{"fragmentSize", unsafe.Offsetof((*PipelineFragmentShadingRateStateCreateInfoKHR)(nil).FragmentSize)},
+		{"combinerOps", unsafe.Offsetof((*PipelineFragmentShadingRateStateCreateInfoKHR)(nil).CombinerOps)},
+	}},
+	{"VkPhysicalDeviceFragmentShadingRateFeaturesKHR", unsafe.Sizeof(*(*PhysicalDeviceFragmentShadingRateFeaturesKHR)(nil)), unsafe.Alignof(*(*PhysicalDeviceFragmentShadingRateFeaturesKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceFragmentShadingRateFeaturesKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceFragmentShadingRateFeaturesKHR)(nil).PNext)},
+		{"pipelineFragmentShadingRate", unsafe.Offsetof((*PhysicalDeviceFragmentShadingRateFeaturesKHR)(nil).PipelineFragmentShadingRate)},
+		{"primitiveFragmentShadingRate", unsafe.Offsetof((*PhysicalDeviceFragmentShadingRateFeaturesKHR)(nil).PrimitiveFragmentShadingRate)},
+		{"attachmentFragmentShadingRate", unsafe.Offsetof((*PhysicalDeviceFragmentShadingRateFeaturesKHR)(nil).AttachmentFragmentShadingRate)},
+	}},
+	{"VkPhysicalDeviceFragmentShadingRatePropertiesKHR", unsafe.Sizeof(*(*PhysicalDeviceFragmentShadingRatePropertiesKHR)(nil)), unsafe.Alignof(*(*PhysicalDeviceFragmentShadingRatePropertiesKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceFragmentShadingRatePropertiesKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceFragmentShadingRatePropertiesKHR)(nil).PNext)},
+		{"minFragmentShadingRateAttachmentTexelSize", unsafe.Offsetof((*PhysicalDeviceFragmentShadingRatePropertiesKHR)(nil).MinFragmentShadingRateAttachmentTexelSize)},
+		{"maxFragmentShadingRateAttachmentTexelSize", unsafe.Offsetof((*PhysicalDeviceFragmentShadingRatePropertiesKHR)(nil).MaxFragmentShadingRateAttachmentTexelSize)},
+		{"maxFragmentShadingRateAttachmentTexelSizeAspectRatio", unsafe.Offsetof((*PhysicalDeviceFragmentShadingRatePropertiesKHR)(nil).MaxFragmentShadingRateAttachmentTexelSizeAspectRatio)},
+		{"primitiveFragmentShadingRateWithMultipleViewports", unsafe.Offsetof((*PhysicalDeviceFragmentShadingRatePropertiesKHR)(nil).PrimitiveFragmentShadingRateWithMultipleViewports)},
+		{"layeredShadingRateAttachments", unsafe.Offsetof((*PhysicalDeviceFragmentShadingRatePropertiesKHR)(nil).LayeredShadingRateAttachments)},
+		{"fragmentShadingRateNonTrivialCombinerOps", unsafe.Offsetof((*PhysicalDeviceFragmentShadingRatePropertiesKHR)(nil).FragmentShadingRateNonTrivialCombinerOps)},
+		{"maxFragmentSize", unsafe.Offsetof((*PhysicalDeviceFragmentShadingRatePropertiesKHR)(nil).MaxFragmentSize)},
+		{"maxFragmentSizeAspectRatio", unsafe.Offsetof((*PhysicalDeviceFragmentShadingRatePropertiesKHR)(nil).MaxFragmentSizeAspectRatio)},
+		{"maxFragmentShadingRateCoverageSamples", unsafe.Offsetof((*PhysicalDeviceFragmentShadingRatePropertiesKHR)(nil).MaxFragmentShadingRateCoverageSamples)},
+		{"maxFragmentShadingRateRasterizationSamples", unsafe.Offsetof((*PhysicalDeviceFragmentShadingRatePropertiesKHR)(nil).MaxFragmentShadingRateRasterizationSamples)},
+		{"fragmentShadingRateWithShaderDepthStencilWrites", unsafe.Offsetof((*PhysicalDeviceFragmentShadingRatePropertiesKHR)(nil).FragmentShadingRateWithShaderDepthStencilWrites)},
+		{"fragmentShadingRateWithSampleMask", unsafe.Offsetof((*PhysicalDeviceFragmentShadingRatePropertiesKHR)(nil).FragmentShadingRateWithSampleMask)},
+		{"fragmentShadingRateWithShaderSampleMask", unsafe.Offsetof((*PhysicalDeviceFragmentShadingRatePropertiesKHR)(nil).FragmentShadingRateWithShaderSampleMask)},
+		{"fragmentShadingRateWithConservativeRasterization", unsafe.Offsetof((*PhysicalDeviceFragmentShadingRatePropertiesKHR)(nil).FragmentShadingRateWithConservativeRasterization)},
+		{"fragmentShadingRateWithFragmentShaderInterlock", unsafe.Offsetof((*PhysicalDeviceFragmentShadingRatePropertiesKHR)(nil).FragmentShadingRateWithFragmentShaderInterlock)},
+		{"fragmentShadingRateWithCustomSampleLocations", unsafe.Offsetof((*PhysicalDeviceFragmentShadingRatePropertiesKHR)(nil).FragmentShadingRateWithCustomSampleLocations)},
+		{"fragmentShadingRateStrictMultiplyCombiner", unsafe.Offsetof((*PhysicalDeviceFragmentShadingRatePropertiesKHR)(nil).FragmentShadingRateStrictMultiplyCombiner)},
+	}},
+	{"VkPhysicalDeviceFragmentShadingRateKHR", unsafe.Sizeof(*(*PhysicalDeviceFragmentShadingRateKHR)(nil)), unsafe.Alignof(*(*PhysicalDeviceFragmentShadingRateKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceFragmentShadingRateKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceFragmentShadingRateKHR)(nil).PNext)},
+		{"sampleCounts", unsafe.Offsetof((*PhysicalDeviceFragmentShadingRateKHR)(nil).SampleCounts)},
+		{"fragmentSize", unsafe.Offsetof((*PhysicalDeviceFragmentShadingRateKHR)(nil).FragmentSize)},
+	}},
+	{"VkSurfaceProtectedCapabilitiesKHR", unsafe.Sizeof(*(*SurfaceProtectedCapabilitiesKHR)(nil)), unsafe.Alignof(*(*SurfaceProtectedCapabilitiesKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SurfaceProtectedCapabilitiesKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SurfaceProtectedCapabilitiesKHR)(nil).PNext)},
+		{"supportsProtected", unsafe.Offsetof((*SurfaceProtectedCapabilitiesKHR)(nil).SupportsProtected)},
+	}},
+	{"VkPhysicalDevicePresentWaitFeaturesKHR", unsafe.Sizeof(*(*PhysicalDevicePresentWaitFeaturesKHR)(nil)), unsafe.Alignof(*(*PhysicalDevicePresentWaitFeaturesKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDevicePresentWaitFeaturesKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDevicePresentWaitFeaturesKHR)(nil).PNext)},
+		{"presentWait", unsafe.Offsetof((*PhysicalDevicePresentWaitFeaturesKHR)(nil).PresentWait)},
+	}},
+	{"VkPhysicalDevicePipelineExecutablePropertiesFeaturesKHR", unsafe.Sizeof(*(*PhysicalDevicePipelineExecutablePropertiesFeaturesKHR)(nil)), unsafe.Alignof(*(*PhysicalDevicePipelineExecutablePropertiesFeaturesKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDevicePipelineExecutablePropertiesFeaturesKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDevicePipelineExecutablePropertiesFeaturesKHR)(nil).PNext)},
+		{"pipelineExecutableInfo", unsafe.Offsetof((*PhysicalDevicePipelineExecutablePropertiesFeaturesKHR)(nil).PipelineExecutableInfo)},
+	}},
+	{"VkPipelineInfoKHR", unsafe.Sizeof(*(*PipelineInfoKHR)(nil)), unsafe.Alignof(*(*PipelineInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineInfoKHR)(nil).PNext)},
+		{"pipeline", unsafe.Offsetof((*PipelineInfoKHR)(nil).Pipeline)},
+	}},
+	{"VkPipelineExecutablePropertiesKHR", unsafe.Sizeof(*(*PipelineExecutablePropertiesKHR)(nil)), unsafe.Alignof(*(*PipelineExecutablePropertiesKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineExecutablePropertiesKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineExecutablePropertiesKHR)(nil).PNext)},
+		{"stages", unsafe.Offsetof((*PipelineExecutablePropertiesKHR)(nil).Stages)},
+		{"name", unsafe.Offsetof((*PipelineExecutablePropertiesKHR)(nil).Name)},
+		{"description", unsafe.Offsetof((*PipelineExecutablePropertiesKHR)(nil).Description)},
+		{"subgroupSize", unsafe.Offsetof((*PipelineExecutablePropertiesKHR)(nil).SubgroupSize)},
+	}},
+	{"VkPipelineExecutableInfoKHR", unsafe.Sizeof(*(*PipelineExecutableInfoKHR)(nil)), unsafe.Alignof(*(*PipelineExecutableInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineExecutableInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineExecutableInfoKHR)(nil).PNext)},
+		{"pipeline", unsafe.Offsetof((*PipelineExecutableInfoKHR)(nil).Pipeline)},
+		{"executableIndex", unsafe.Offsetof((*PipelineExecutableInfoKHR)(nil).ExecutableIndex)},
+	}},
+	{"VkPipelineExecutableStatisticValueKHR", unsafe.Sizeof(*(*PipelineExecutableStatisticValueKHR)(nil)), unsafe.Alignof(*(*PipelineExecutableStatisticValueKHR)(nil)), nil},
+	{"VkPipelineExecutableStatisticKHR", unsafe.Sizeof(*(*PipelineExecutableStatisticKHR)(nil)), unsafe.Alignof(*(*PipelineExecutableStatisticKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineExecutableStatisticKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineExecutableStatisticKHR)(nil).PNext)},
+		{"name", unsafe.Offsetof((*PipelineExecutableStatisticKHR)(nil).Name)},
+		{"description", unsafe.Offsetof((*PipelineExecutableStatisticKHR)(nil).Description)},
+		{"format", unsafe.Offsetof((*PipelineExecutableStatisticKHR)(nil).Format)},
+		{"value", unsafe.Offsetof((*PipelineExecutableStatisticKHR)(nil).Value)},
+	}},
+	{"VkPipelineExecutableInternalRepresentationKHR", unsafe.Sizeof(*(*PipelineExecutableInternalRepresentationKHR)(nil)), unsafe.Alignof(*(*PipelineExecutableInternalRepresentationKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineExecutableInternalRepresentationKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineExecutableInternalRepresentationKHR)(nil).PNext)},
+		{"name", unsafe.Offsetof((*PipelineExecutableInternalRepresentationKHR)(nil).Name)},
+		{"description", unsafe.Offsetof((*PipelineExecutableInternalRepresentationKHR)(nil).Description)},
+		{"isText", unsafe.Offsetof((*PipelineExecutableInternalRepresentationKHR)(nil).IsText)},
+		{"dataSize", unsafe.Offsetof((*PipelineExecutableInternalRepresentationKHR)(nil).DataSize)},
+		{"pData", unsafe.Offsetof((*PipelineExecutableInternalRepresentationKHR)(nil).PData)},
+	}},
+	{"VkPipelineLibraryCreateInfoKHR", unsafe.Sizeof(*(*PipelineLibraryCreateInfoKHR)(nil)), unsafe.Alignof(*(*PipelineLibraryCreateInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineLibraryCreateInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineLibraryCreateInfoKHR)(nil).PNext)},
+		{"libraryCount", unsafe.Offsetof((*PipelineLibraryCreateInfoKHR)(nil).LibraryCount)},
+		{"pLibraries", unsafe.Offsetof((*PipelineLibraryCreateInfoKHR)(nil).PLibraries)},
+	}},
+	{"VkPresentIdKHR", unsafe.Sizeof(*(*PresentIdKHR)(nil)), unsafe.Alignof(*(*PresentIdKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PresentIdKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PresentIdKHR)(nil).PNext)},
+		{"swapchainCount", unsafe.Offsetof((*PresentIdKHR)(nil).SwapchainCount)},
+		{"pPresentIds", unsafe.Offsetof((*PresentIdKHR)(nil).PPresentIds)},
+	}},
+	{"VkPhysicalDevicePresentIdFeaturesKHR", unsafe.Sizeof(*(*PhysicalDevicePresentIdFeaturesKHR)(nil)), unsafe.Alignof(*(*PhysicalDevicePresentIdFeaturesKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDevicePresentIdFeaturesKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDevicePresentIdFeaturesKHR)(nil).PNext)},
+		{"presentId", unsafe.Offsetof((*PhysicalDevicePresentIdFeaturesKHR)(nil).PresentId)},
+	}},
+	{"VkQueueFamilyCheckpointProperties2NV", unsafe.Sizeof(*(*QueueFamilyCheckpointProperties2NV)(nil)), unsafe.Alignof(*(*QueueFamilyCheckpointProperties2NV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*QueueFamilyCheckpointProperties2NV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*QueueFamilyCheckpointProperties2NV)(nil).PNext)},
+		{"checkpointExecutionStageMask", unsafe.Offsetof((*QueueFamilyCheckpointProperties2NV)(nil).CheckpointExecutionStageMask)},
+	}},
+	{"VkCheckpointData2NV", unsafe.Sizeof(*(*CheckpointData2NV)(nil)), unsafe.Alignof(*(*CheckpointData2NV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*CheckpointData2NV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*CheckpointData2NV)(nil).PNext)},
+		{"stage", unsafe.Offsetof((*CheckpointData2NV)(nil).Stage)},
+		{"pCheckpointMarker", unsafe.Offsetof((*CheckpointData2NV)(nil).PCheckpointMarker)},
+	}},
+	{"VkPhysicalDeviceFragmentShaderBarycentricFeaturesKHR", unsafe.Sizeof(*(*PhysicalDeviceFragmentShaderBarycentricFeaturesKHR)(nil)), unsafe.Alignof(*(*PhysicalDeviceFragmentShaderBarycentricFeaturesKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceFragmentShaderBarycentricFeaturesKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceFragmentShaderBarycentricFeaturesKHR)(nil).PNext)},
+		{"fragmentShaderBarycentric", unsafe.Offsetof((*PhysicalDeviceFragmentShaderBarycentricFeaturesKHR)(nil).FragmentShaderBarycentric)},
+	}},
+	{"VkPhysicalDeviceFragmentShaderBarycentricPropertiesKHR", unsafe.Sizeof(*(*PhysicalDeviceFragmentShaderBarycentricPropertiesKHR)(nil)), unsafe.Alignof(*(*PhysicalDeviceFragmentShaderBarycentricPropertiesKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceFragmentShaderBarycentricPropertiesKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceFragmentShaderBarycentricPropertiesKHR)(nil).PNext)},
+		{"triStripVertexOrderIndependentOfProvokingVertex", unsafe.Offsetof((*PhysicalDeviceFragmentShaderBarycentricPropertiesKHR)(nil).TriStripVertexOrderIndependentOfProvokingVertex)},
+	}},
+	{"VkPhysicalDeviceShaderSubgroupUniformControlFlowFeaturesKHR", unsafe.Sizeof(*(*PhysicalDeviceShaderSubgroupUniformControlFlowFeaturesKHR)(nil)), unsafe.Alignof(*(*PhysicalDeviceShaderSubgroupUniformControlFlowFeaturesKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceShaderSubgroupUniformControlFlowFeaturesKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceShaderSubgroupUniformControlFlowFeaturesKHR)(nil).PNext)},
+		{"shaderSubgroupUniformControlFlow", unsafe.Offsetof((*PhysicalDeviceShaderSubgroupUniformControlFlowFeaturesKHR)(nil).ShaderSubgroupUniformControlFlow)},
+	}},
+	{"VkPhysicalDeviceWorkgroupMemoryExplicitLayoutFeaturesKHR", unsafe.Sizeof(*(*PhysicalDeviceWorkgroupMemoryExplicitLayoutFeaturesKHR)(nil)), unsafe.Alignof(*(*PhysicalDeviceWorkgroupMemoryExplicitLayoutFeaturesKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceWorkgroupMemoryExplicitLayoutFeaturesKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceWorkgroupMemoryExplicitLayoutFeaturesKHR)(nil).PNext)},
+		{"workgroupMemoryExplicitLayout", unsafe.Offsetof((*PhysicalDeviceWorkgroupMemoryExplicitLayoutFeaturesKHR)(nil).WorkgroupMemoryExplicitLayout)},
+		{"workgroupMemoryExplicitLayoutScalarBlockLayout", unsafe.Offsetof((*PhysicalDeviceWorkgroupMemoryExplicitLayoutFeaturesKHR)(nil).WorkgroupMemoryExplicitLayoutScalarBlockLayout)},
+		{"workgroupMemoryExplicitLayout8BitAccess", unsafe.Offsetof((*PhysicalDeviceWorkgroupMemoryExplicitLayoutFeaturesKHR)(nil).WorkgroupMemoryExplicitLayout8BitAccess)},
+		{"workgroupMemoryExplicitLayout16BitAccess", unsafe.Offsetof((*PhysicalDeviceWorkgroupMemoryExplicitLayoutFeaturesKHR)(nil).WorkgroupMemoryExplicitLayout16BitAccess)},
+	}},
+	{"VkRayTracingShaderGroupCreateInfoKHR", unsafe.Sizeof(*(*RayTracingShaderGroupCreateInfoKHR)(nil)), unsafe.Alignof(*(*RayTracingShaderGroupCreateInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*RayTracingShaderGroupCreateInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*RayTracingShaderGroupCreateInfoKHR)(nil).PNext)},
+		{"type", unsafe.Offsetof((*RayTracingShaderGroupCreateInfoKHR)(nil).Type)},
+		{"generalShader", unsafe.Offsetof((*RayTracingShaderGroupCreateInfoKHR)(nil).GeneralShader)},
+		{"closestHitShader", unsafe.Offsetof((*RayTracingShaderGroupCreateInfoKHR)(nil).ClosestHitShader)},
+		{"anyHitShader", unsafe.Offsetof((*RayTracingShaderGroupCreateInfoKHR)(nil).AnyHitShader)},
+		{"intersectionShader", unsafe.Offsetof((*RayTracingShaderGroupCreateInfoKHR)(nil).IntersectionShader)},
+		{"pShaderGroupCaptureReplayHandle", unsafe.Offsetof((*RayTracingShaderGroupCreateInfoKHR)(nil).PShaderGroupCaptureReplayHandle)},
+	}},
+	{"VkRayTracingPipelineInterfaceCreateInfoKHR", unsafe.Sizeof(*(*RayTracingPipelineInterfaceCreateInfoKHR)(nil)), unsafe.Alignof(*(*RayTracingPipelineInterfaceCreateInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*RayTracingPipelineInterfaceCreateInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*RayTracingPipelineInterfaceCreateInfoKHR)(nil).PNext)},
+		{"maxPipelineRayPayloadSize", unsafe.Offsetof((*RayTracingPipelineInterfaceCreateInfoKHR)(nil).MaxPipelineRayPayloadSize)},
+		{"maxPipelineRayHitAttributeSize", unsafe.Offsetof((*RayTracingPipelineInterfaceCreateInfoKHR)(nil).MaxPipelineRayHitAttributeSize)},
+	}},
+	{"VkRayTracingPipelineCreateInfoKHR", unsafe.Sizeof(*(*RayTracingPipelineCreateInfoKHR)(nil)), unsafe.Alignof(*(*RayTracingPipelineCreateInfoKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*RayTracingPipelineCreateInfoKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*RayTracingPipelineCreateInfoKHR)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*RayTracingPipelineCreateInfoKHR)(nil).Flags)},
+		{"stageCount", unsafe.Offsetof((*RayTracingPipelineCreateInfoKHR)(nil).StageCount)},
+		{"pStages", unsafe.Offsetof((*RayTracingPipelineCreateInfoKHR)(nil).PStages)},
+		{"groupCount", unsafe.Offsetof((*RayTracingPipelineCreateInfoKHR)(nil).GroupCount)},
+		{"pGroups", unsafe.Offsetof((*RayTracingPipelineCreateInfoKHR)(nil).PGroups)},
+		{"maxPipelineRayRecursionDepth", unsafe.Offsetof((*RayTracingPipelineCreateInfoKHR)(nil).MaxPipelineRayRecursionDepth)},
+		{"pLibraryInfo", unsafe.Offsetof((*RayTracingPipelineCreateInfoKHR)(nil).PLibraryInfo)},
+		{"pLibraryInterface", unsafe.Offsetof((*RayTracingPipelineCreateInfoKHR)(nil).PLibraryInterface)},
+		{"pDynamicState", unsafe.Offsetof((*RayTracingPipelineCreateInfoKHR)(nil).PDynamicState)},
+		{"layout", unsafe.Offsetof((*RayTracingPipelineCreateInfoKHR)(nil).Layout)},
+		{"basePipelineHandle", unsafe.Offsetof((*RayTracingPipelineCreateInfoKHR)(nil).BasePipelineHandle)},
+		{"basePipelineIndex", unsafe.Offsetof((*RayTracingPipelineCreateInfoKHR)(nil).BasePipelineIndex)},
+	}},
+	{"VkPhysicalDeviceRayTracingPipelineFeaturesKHR", unsafe.Sizeof(*(*PhysicalDeviceRayTracingPipelineFeaturesKHR)(nil)), unsafe.Alignof(*(*PhysicalDeviceRayTracingPipelineFeaturesKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceRayTracingPipelineFeaturesKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceRayTracingPipelineFeaturesKHR)(nil).PNext)},
+		{"rayTracingPipeline", unsafe.Offsetof((*PhysicalDeviceRayTracingPipelineFeaturesKHR)(nil).RayTracingPipeline)},
+		{"rayTracingPipelineShaderGroupHandleCaptureReplay", unsafe.Offsetof((*PhysicalDeviceRayTracingPipelineFeaturesKHR)(nil).RayTracingPipelineShaderGroupHandleCaptureReplay)},
+		{"rayTracingPipelineShaderGroupHandleCaptureReplayMixed", unsafe.Offsetof((*PhysicalDeviceRayTracingPipelineFeaturesKHR)(nil).RayTracingPipelineShaderGroupHandleCaptureReplayMixed)},
+		{"rayTracingPipelineTraceRaysIndirect", unsafe.Offsetof((*PhysicalDeviceRayTracingPipelineFeaturesKHR)(nil).RayTracingPipelineTraceRaysIndirect)},
+		{"rayTraversalPrimitiveCulling", unsafe.Offsetof((*PhysicalDeviceRayTracingPipelineFeaturesKHR)(nil).RayTraversalPrimitiveCulling)},
+	}},
+	{"VkPhysicalDeviceRayTracingPipelinePropertiesKHR", unsafe.Sizeof(*(*PhysicalDeviceRayTracingPipelinePropertiesKHR)(nil)), unsafe.Alignof(*(*PhysicalDeviceRayTracingPipelinePropertiesKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceRayTracingPipelinePropertiesKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceRayTracingPipelinePropertiesKHR)(nil).PNext)},
+		{"shaderGroupHandleSize", unsafe.Offsetof((*PhysicalDeviceRayTracingPipelinePropertiesKHR)(nil).ShaderGroupHandleSize)},
+		{"maxRayRecursionDepth", unsafe.Offsetof((*PhysicalDeviceRayTracingPipelinePropertiesKHR)(nil).MaxRayRecursionDepth)},
+		{"maxShaderGroupStride", unsafe.Offsetof((*PhysicalDeviceRayTracingPipelinePropertiesKHR)(nil).MaxShaderGroupStride)},
+		{"shaderGroupBaseAlignment", unsafe.Offsetof((*PhysicalDeviceRayTracingPipelinePropertiesKHR)(nil).ShaderGroupBaseAlignment)},
+		{"shaderGroupHandleCaptureReplaySize", unsafe.Offsetof((*PhysicalDeviceRayTracingPipelinePropertiesKHR)(nil).ShaderGroupHandleCaptureReplaySize)},
+		{"maxRayDispatchInvocationCount", unsafe.Offsetof((*PhysicalDeviceRayTracingPipelinePropertiesKHR)(nil).MaxRayDispatchInvocationCount)},
+		{"shaderGroupHandleAlignment", unsafe.Offsetof((*PhysicalDeviceRayTracingPipelinePropertiesKHR)(nil).ShaderGroupHandleAlignment)},
+		{"maxRayHitAttributeSize", unsafe.Offsetof((*PhysicalDeviceRayTracingPipelinePropertiesKHR)(nil).MaxRayHitAttributeSize)},
+	}},
+	{"VkStridedDeviceAddressRegionKHR", unsafe.Sizeof(*(*StridedDeviceAddressRegionKHR)(nil)), unsafe.Alignof(*(*StridedDeviceAddressRegionKHR)(nil)), []memberOffset{
+		{"deviceAddress", unsafe.Offsetof((*StridedDeviceAddressRegionKHR)(nil).DeviceAddress)},
+		{"stride", unsafe.Offsetof((*StridedDeviceAddressRegionKHR)(nil).Stride)},
+		{"size", unsafe.Offsetof((*StridedDeviceAddressRegionKHR)(nil).Size)},
+	}},
+	{"VkTraceRaysIndirectCommandKHR", unsafe.Sizeof(*(*TraceRaysIndirectCommandKHR)(nil)), unsafe.Alignof(*(*TraceRaysIndirectCommandKHR)(nil)), []memberOffset{
+		{"width", unsafe.Offsetof((*TraceRaysIndirectCommandKHR)(nil).Width)},
+		{"height", unsafe.Offsetof((*TraceRaysIndirectCommandKHR)(nil).Height)},
+		{"depth", unsafe.Offsetof((*TraceRaysIndirectCommandKHR)(nil).Depth)},
+	}},
+	{"VkPhysicalDeviceRayQueryFeaturesKHR", unsafe.Sizeof(*(*PhysicalDeviceRayQueryFeaturesKHR)(nil)), unsafe.Alignof(*(*PhysicalDeviceRayQueryFeaturesKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceRayQueryFeaturesKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceRayQueryFeaturesKHR)(nil).PNext)},
+		{"rayQuery", unsafe.Offsetof((*PhysicalDeviceRayQueryFeaturesKHR)(nil).RayQuery)},
+	}},
+	{"VkPhysicalDeviceRayTracingMaintenance1FeaturesKHR", unsafe.Sizeof(*(*PhysicalDeviceRayTracingMaintenance1FeaturesKHR)(nil)), unsafe.Alignof(*(*PhysicalDeviceRayTracingMaintenance1FeaturesKHR)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceRayTracingMaintenance1FeaturesKHR)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceRayTracingMaintenance1FeaturesKHR)(nil).PNext)},
+		{"rayTracingMaintenance1", unsafe.Offsetof((*PhysicalDeviceRayTracingMaintenance1FeaturesKHR)(nil).RayTracingMaintenance1)},
+		{"rayTracingPipelineTraceRaysIndirect2", unsafe.Offsetof((*PhysicalDeviceRayTracingMaintenance1FeaturesKHR)(nil).RayTracingPipelineTraceRaysIndirect2)},
+	}},
+	{"VkTraceRaysIndirectCommand2KHR", unsafe.Sizeof(*(*TraceRaysIndirectCommand2KHR)(nil)), unsafe.Alignof(*(*TraceRaysIndirectCommand2KHR)(nil)), []memberOffset{
+		{"raygenShaderRecordAddress", unsafe.Offsetof((*TraceRaysIndirectCommand2KHR)(nil).RaygenShaderRecordAddress)},
+		{"raygenShaderRecordSize", unsafe.Offsetof((*TraceRaysIndirectCommand2KHR)(nil).RaygenShaderRecordSize)},
+		{"missShaderBindingTableAddress", unsafe.Offsetof((*TraceRaysIndirectCommand2KHR)(nil).MissShaderBindingTableAddress)},
+		{"missShaderBindingTableSize", unsafe.Offsetof((*TraceRaysIndirectCommand2KHR)(nil).MissShaderBindingTableSize)},
+		{"missShaderBindingTableStride", unsafe.Offsetof((*TraceRaysIndirectCommand2KHR)(nil).MissShaderBindingTableStride)},
+		{"hitShaderBindingTableAddress", unsafe.Offsetof((*TraceRaysIndirectCommand2KHR)(nil).HitShaderBindingTableAddress)},
+		{"hitShaderBindingTableSize", unsafe.Offsetof((*TraceRaysIndirectCommand2KHR)(nil).HitShaderBindingTableSize)},
+		{"hitShaderBindingTableStride", unsafe.Offsetof((*TraceRaysIndirectCommand2KHR)(nil).HitShaderBindingTableStride)},
+		{"callableShaderBindingTableAddress", unsafe.Offsetof((*TraceRaysIndirectCommand2KHR)(nil).CallableShaderBindingTableAddress)},
+		{"callableShaderBindingTableSize", unsafe.Offsetof((*TraceRaysIndirectCommand2KHR)(nil).CallableShaderBindingTableSize)},
+		{"callableShaderBindingTableStride", unsafe.Offsetof((*TraceRaysIndirectCommand2KHR)(nil).CallableShaderBindingTableStride)},
+		{"width", unsafe.Offsetof((*TraceRaysIndirectCommand2KHR)(nil).Width)},
+		{"height", unsafe.Offsetof((*TraceRaysIndirectCommand2KHR)(nil).Height)},
+		{"depth", unsafe.Offsetof((*TraceRaysIndirectCommand2KHR)(nil).Depth)},
+	}},
+	{"VkDebugReportCallbackCreateInfoEXT", unsafe.Sizeof(*(*DebugReportCallbackCreateInfoEXT)(nil)), unsafe.Alignof(*(*DebugReportCallbackCreateInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DebugReportCallbackCreateInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DebugReportCallbackCreateInfoEXT)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*DebugReportCallbackCreateInfoEXT)(nil).Flags)},
+		{"pfnCallback", unsafe.Offsetof((*DebugReportCallbackCreateInfoEXT)(nil).PfnCallback)},
+		{"pUserData", unsafe.Offsetof((*DebugReportCallbackCreateInfoEXT)(nil).PUserData)},
+	}},
+	{"VkPipelineRasterizationStateRasterizationOrderAMD", unsafe.Sizeof(*(*PipelineRasterizationStateRasterizationOrderAMD)(nil)), unsafe.Alignof(*(*PipelineRasterizationStateRasterizationOrderAMD)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineRasterizationStateRasterizationOrderAMD)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineRasterizationStateRasterizationOrderAMD)(nil).PNext)},
+		{"rasterizationOrder", unsafe.Offsetof((*PipelineRasterizationStateRasterizationOrderAMD)(nil).RasterizationOrder)},
+	}},
+	{"VkDebugMarkerObjectNameInfoEXT", unsafe.Sizeof(*(*DebugMarkerObjectNameInfoEXT)(nil)), unsafe.Alignof(*(*DebugMarkerObjectNameInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DebugMarkerObjectNameInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DebugMarkerObjectNameInfoEXT)(nil).PNext)},
+		{"objectType", unsafe.Offsetof((*DebugMarkerObjectNameInfoEXT)(nil).ObjectType)},
+		{"object", unsafe.Offsetof((*DebugMarkerObjectNameInfoEXT)(nil).Object)},
+		{"pObjectName", unsafe.Offsetof((*DebugMarkerObjectNameInfoEXT)(nil).PObjectName)},
+	}},
+	{"VkDebugMarkerObjectTagInfoEXT", unsafe.Sizeof(*(*DebugMarkerObjectTagInfoEXT)(nil)), unsafe.Alignof(*(*DebugMarkerObjectTagInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DebugMarkerObjectTagInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DebugMarkerObjectTagInfoEXT)(nil).PNext)},
+		{"objectType", unsafe.Offsetof((*DebugMarkerObjectTagInfoEXT)(nil).ObjectType)},
+		{"object", unsafe.Offsetof((*DebugMarkerObjectTagInfoEXT)(nil).Object)},
+		{"tagName", unsafe.Offsetof((*DebugMarkerObjectTagInfoEXT)(nil).TagName)},
+		{"tagSize", unsafe.Offsetof((*DebugMarkerObjectTagInfoEXT)(nil).TagSize)},
+		{"pTag", unsafe.Offsetof((*DebugMarkerObjectTagInfoEXT)(nil).PTag)},
+	}},
+	{"VkDebugMarkerMarkerInfoEXT", unsafe.Sizeof(*(*DebugMarkerMarkerInfoEXT)(nil)), unsafe.Alignof(*(*DebugMarkerMarkerInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DebugMarkerMarkerInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DebugMarkerMarkerInfoEXT)(nil).PNext)},
+		{"pMarkerName", unsafe.Offsetof((*DebugMarkerMarkerInfoEXT)(nil).PMarkerName)},
+		{"color", unsafe.Offsetof((*DebugMarkerMarkerInfoEXT)(nil).Color)},
+	}},
+	{"VkDedicatedAllocationImageCreateInfoNV", unsafe.Sizeof(*(*DedicatedAllocationImageCreateInfoNV)(nil)), unsafe.Alignof(*(*DedicatedAllocationImageCreateInfoNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DedicatedAllocationImageCreateInfoNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DedicatedAllocationImageCreateInfoNV)(nil).PNext)},
+		{"dedicatedAllocation", unsafe.Offsetof((*DedicatedAllocationImageCreateInfoNV)(nil).DedicatedAllocation)},
+	}},
+	{"VkDedicatedAllocationBufferCreateInfoNV", unsafe.Sizeof(*(*DedicatedAllocationBufferCreateInfoNV)(nil)), unsafe.Alignof(*(*DedicatedAllocationBufferCreateInfoNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DedicatedAllocationBufferCreateInfoNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DedicatedAllocationBufferCreateInfoNV)(nil).PNext)},
+		{"dedicatedAllocation", unsafe.Offsetof((*DedicatedAllocationBufferCreateInfoNV)(nil).DedicatedAllocation)},
+	}},
+	{"VkDedicatedAllocationMemoryAllocateInfoNV", unsafe.Sizeof(*(*DedicatedAllocationMemoryAllocateInfoNV)(nil)), unsafe.Alignof(*(*DedicatedAllocationMemoryAllocateInfoNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DedicatedAllocationMemoryAllocateInfoNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DedicatedAllocationMemoryAllocateInfoNV)(nil).PNext)},
+		{"image", unsafe.Offsetof((*DedicatedAllocationMemoryAllocateInfoNV)(nil).Image)},
+		{"buffer", unsafe.Offsetof((*DedicatedAllocationMemoryAllocateInfoNV)(nil).Buffer)},
+	}},
+	{"VkPhysicalDeviceTransformFeedbackFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceTransformFeedbackFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceTransformFeedbackFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceTransformFeedbackFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceTransformFeedbackFeaturesEXT)(nil).PNext)},
+		{"transformFeedback", unsafe.Offsetof((*PhysicalDeviceTransformFeedbackFeaturesEXT)(nil).TransformFeedback)},
+		{"geometryStreams", unsafe.Offsetof((*PhysicalDeviceTransformFeedbackFeaturesEXT)(nil).GeometryStreams)},
+	}},
+	{"VkPhysicalDeviceTransformFeedbackPropertiesEXT", unsafe.Sizeof(*(*PhysicalDeviceTransformFeedbackPropertiesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceTransformFeedbackPropertiesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceTransformFeedbackPropertiesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceTransformFeedbackPropertiesEXT)(nil).PNext)},
+		{"maxTransformFeedbackStreams", unsafe.Offsetof((*PhysicalDeviceTransformFeedbackPropertiesEXT)(nil).MaxTransformFeedbackStreams)},
+		{"maxTransformFeedbackBuffers", unsafe.Offsetof((*PhysicalDeviceTransformFeedbackPropertiesEXT)(nil).MaxTransformFeedbackBuffers)},
+		{"maxTransformFeedbackBufferSize", unsafe.Offsetof((*PhysicalDeviceTransformFeedbackPropertiesEXT)(nil).MaxTransformFeedbackBufferSize)},
+		{"maxTransformFeedbackStreamDataSize", unsafe.Offsetof((*PhysicalDeviceTransformFeedbackPropertiesEXT)(nil).MaxTransformFeedbackStreamDataSize)},
+		{"maxTransformFeedbackBufferDataSize", unsafe.Offsetof((*PhysicalDeviceTransformFeedbackPropertiesEXT)(nil).MaxTransformFeedbackBufferDataSize)},
+		{"maxTransformFeedbackBufferDataStride", unsafe.Offsetof((*PhysicalDeviceTransformFeedbackPropertiesEXT)(nil).MaxTransformFeedbackBufferDataStride)},
+		{"transformFeedbackQueries", unsafe.Offsetof((*PhysicalDeviceTransformFeedbackPropertiesEXT)(nil).TransformFeedbackQueries)},
+		{"transformFeedbackStreamsLinesTriangles", unsafe.Offsetof((*PhysicalDeviceTransformFeedbackPropertiesEXT)(nil).TransformFeedbackStreamsLinesTriangles)},
+		{"transformFeedbackRasterizationStreamSelect", unsafe.Offsetof((*PhysicalDeviceTransformFeedbackPropertiesEXT)(nil).TransformFeedbackRasterizationStreamSelect)},
+		{"transformFeedbackDraw", unsafe.Offsetof((*PhysicalDeviceTransformFeedbackPropertiesEXT)(nil).TransformFeedbackDraw)},
+	}},
+	{"VkPipelineRasterizationStateStreamCreateInfoEXT", unsafe.Sizeof(*(*PipelineRasterizationStateStreamCreateInfoEXT)(nil)), unsafe.Alignof(*(*PipelineRasterizationStateStreamCreateInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineRasterizationStateStreamCreateInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineRasterizationStateStreamCreateInfoEXT)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*PipelineRasterizationStateStreamCreateInfoEXT)(nil).Flags)},
+		{"rasterizationStream", unsafe.Offsetof((*PipelineRasterizationStateStreamCreateInfoEXT)(nil).RasterizationStream)},
+	}},
+	{"VkCuModuleCreateInfoNVX", unsafe.Sizeof(*(*CuModuleCreateInfoNVX)(nil)), unsafe.Alignof(*(*CuModuleCreateInfoNVX)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*CuModuleCreateInfoNVX)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*CuModuleCreateInfoNVX)(nil).PNext)},
+		{"dataSize", unsafe.Offsetof((*CuModuleCreateInfoNVX)(nil).DataSize)},
+		{"pData", unsafe.Offsetof((*CuModuleCreateInfoNVX)(nil).PData)},
+	}},
+	{"VkCuFunctionCreateInfoNVX", unsafe.Sizeof(*(*CuFunctionCreateInfoNVX)(nil)), unsafe.Alignof(*(*CuFunctionCreateInfoNVX)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*CuFunctionCreateInfoNVX)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*CuFunctionCreateInfoNVX)(nil).PNext)},
+		{"module", unsafe.Offsetof((*CuFunctionCreateInfoNVX)(nil).Module)},
+		{"pName", unsafe.Offsetof((*CuFunctionCreateInfoNVX)(nil).PName)},
+	}},
+	{"VkCuLaunchInfoNVX", unsafe.Sizeof(*(*CuLaunchInfoNVX)(nil)), unsafe.Alignof(*(*CuLaunchInfoNVX)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*CuLaunchInfoNVX)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*CuLaunchInfoNVX)(nil).PNext)},
+		{"function", unsafe.Offsetof((*CuLaunchInfoNVX)(nil).Function)},
+		{"gridDimX", unsafe.Offsetof((*CuLaunchInfoNVX)(nil).GridDimX)},
+		{"gridDimY", unsafe.Offsetof((*CuLaunchInfoNVX)(nil).GridDimY)},
+		{"gridDimZ", unsafe.Offsetof((*CuLaunchInfoNVX)(nil).GridDimZ)},
+		{"blockDimX", unsafe.Offsetof((*CuLaunchInfoNVX)(nil).BlockDimX)},
+		{"blockDimY", unsafe.Offsetof((*CuLaunchInfoNVX)(nil).BlockDimY)},
+		{"blockDimZ", unsafe.Offsetof((*CuLaunchInfoNVX)(nil).BlockDimZ)},
+		{"sharedMemBytes", unsafe.Offsetof((*CuLaunchInfoNVX)(nil).SharedMemBytes)},
+		{"paramCount", unsafe.Offsetof((*CuLaunchInfoNVX)(nil).ParamCount)},
+		{"pParams", unsafe.Offsetof((*CuLaunchInfoNVX)(nil).PParams)},
+		{"extraCount", unsafe.Offsetof((*CuLaunchInfoNVX)(nil).ExtraCount)},
+		{"pExtras", unsafe.Offsetof((*CuLaunchInfoNVX)(nil).PExtras)},
+	}},
+	{"VkImageViewHandleInfoNVX", unsafe.Sizeof(*(*ImageViewHandleInfoNVX)(nil)), unsafe.Alignof(*(*ImageViewHandleInfoNVX)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ImageViewHandleInfoNVX)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ImageViewHandleInfoNVX)(nil).PNext)},
+		{"imageView", unsafe.Offsetof((*ImageViewHandleInfoNVX)(nil).ImageView)},
+		{"descriptorType", unsafe.Offsetof((*ImageViewHandleInfoNVX)(nil).DescriptorType)},
+		{"sampler", unsafe.Offsetof((*ImageViewHandleInfoNVX)(nil).Sampler)},
+	}},
+	{"VkImageViewAddressPropertiesNVX", unsafe.Sizeof(*(*ImageViewAddressPropertiesNVX)(nil)), unsafe.Alignof(*(*ImageViewAddressPropertiesNVX)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ImageViewAddressPropertiesNVX)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ImageViewAddressPropertiesNVX)(nil).PNext)},
+		{"deviceAddress", unsafe.Offsetof((*ImageViewAddressPropertiesNVX)(nil).DeviceAddress)},
+		{"size", unsafe.Offsetof((*ImageViewAddressPropertiesNVX)(nil).Size)},
+	}},
+	{"VkTextureLODGatherFormatPropertiesAMD", unsafe.Sizeof(*(*TextureLODGatherFormatPropertiesAMD)(nil)), unsafe.Alignof(*(*TextureLODGatherFormatPropertiesAMD)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*TextureLODGatherFormatPropertiesAMD)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*TextureLODGatherFormatPropertiesAMD)(nil).PNext)},
+		{"supportsTextureGatherLODBiasAMD", unsafe.Offsetof((*TextureLODGatherFormatPropertiesAMD)(nil).SupportsTextureGatherLODBiasAMD)},
+	}},
+	{"VkShaderResourceUsageAMD", unsafe.Sizeof(*(*ShaderResourceUsageAMD)(nil)), unsafe.Alignof(*(*ShaderResourceUsageAMD)(nil)), []memberOffset{
+		{"numUsedVgprs", unsafe.Offsetof((*ShaderResourceUsageAMD)(nil).NumUsedVgprs)},
+		{"numUsedSgprs", unsafe.Offsetof((*ShaderResourceUsageAMD)(nil).NumUsedSgprs)},
+		{"ldsSizePerLocalWorkGroup", unsafe.Offsetof((*ShaderResourceUsageAMD)(nil).LdsSizePerLocalWorkGroup)},
+		{"ldsUsageSizeInBytes", unsafe.Offsetof((*ShaderResourceUsageAMD)(nil).LdsUsageSizeInBytes)},
+		{"scratchMemUsageInBytes", unsafe.Offsetof((*ShaderResourceUsageAMD)(nil).ScratchMemUsageInBytes)},
+	}},
+	{"VkShaderStatisticsInfoAMD", unsafe.Sizeof(*(*ShaderStatisticsInfoAMD)(nil)), unsafe.Alignof(*(*ShaderStatisticsInfoAMD)(nil)), []memberOffset{
+		{"shaderStageMask", unsafe.Offsetof((*ShaderStatisticsInfoAMD)(nil).ShaderStageMask)},
+		{"resourceUsage", unsafe.Offsetof((*ShaderStatisticsInfoAMD)(nil).ResourceUsage)},
+		{"numPhysicalVgprs", unsafe.Offsetof((*ShaderStatisticsInfoAMD)(nil).NumPhysicalVgprs)},
+		{"numPhysicalSgprs", unsafe.Offsetof((*ShaderStatisticsInfoAMD)(nil).NumPhysicalSgprs)},
+		{"numAvailableVgprs", unsafe.Offsetof((*ShaderStatisticsInfoAMD)(nil).NumAvailableVgprs)},
+		{"numAvailableSgprs", unsafe.Offsetof((*ShaderStatisticsInfoAMD)(nil).NumAvailableSgprs)},
+		{"computeWorkGroupSize", unsafe.Offsetof((*ShaderStatisticsInfoAMD)(nil).ComputeWorkGroupSize)},
+	}},
+	{"VkPhysicalDeviceCornerSampledImageFeaturesNV", unsafe.Sizeof(*(*PhysicalDeviceCornerSampledImageFeaturesNV)(nil)), unsafe.Alignof(*(*PhysicalDeviceCornerSampledImageFeaturesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceCornerSampledImageFeaturesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceCornerSampledImageFeaturesNV)(nil).PNext)},
+		{"cornerSampledImage", unsafe.Offsetof((*PhysicalDeviceCornerSampledImageFeaturesNV)(nil).CornerSampledImage)},
+	}},
+	{"VkExternalImageFormatPropertiesNV", unsafe.Sizeof(*(*ExternalImageFormatPropertiesNV)(nil)), unsafe.Alignof(*(*ExternalImageFormatPropertiesNV)(nil)), []memberOffset{
+		{"imageFormatProperties", unsafe.Offsetof((*ExternalImageFormatPropertiesNV)(nil).ImageFormatProperties)},
+		{"externalMemoryFeatures", unsafe.Offsetof((*ExternalImageFormatPropertiesNV)(nil).ExternalMemoryFeatures)},
+		{"exportFromImportedHandleTypes", unsafe.Offsetof((*ExternalImageFormatPropertiesNV)(nil).ExportFromImportedHandleTypes)},
+		{"compatibleHandleTypes", unsafe.Offsetof((*ExternalImageFormatPropertiesNV)(nil).CompatibleHandleTypes)},
+	}},
+	{"VkExternalMemoryImageCreateInfoNV", unsafe.Sizeof(*(*ExternalMemoryImageCreateInfoNV)(nil)), unsafe.Alignof(*(*ExternalMemoryImageCreateInfoNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ExternalMemoryImageCreateInfoNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ExternalMemoryImageCreateInfoNV)(nil).PNext)},
+		{"handleTypes", unsafe.Offsetof((*ExternalMemoryImageCreateInfoNV)(nil).HandleTypes)},
+	}},
+	{"VkExportMemoryAllocateInfoNV", unsafe.Sizeof(*(*ExportMemoryAllocateInfoNV)(nil)), unsafe.Alignof(*(*ExportMemoryAllocateInfoNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ExportMemoryAllocateInfoNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ExportMemoryAllocateInfoNV)(nil).PNext)},
+		{"handleTypes", unsafe.Offsetof((*ExportMemoryAllocateInfoNV)(nil).HandleTypes)},
+	}},
+	{"VkValidationFlagsEXT", unsafe.Sizeof(*(*ValidationFlagsEXT)(nil)), unsafe.Alignof(*(*ValidationFlagsEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ValidationFlagsEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ValidationFlagsEXT)(nil).PNext)},
+		{"disabledValidationCheckCount", unsafe.Offsetof((*ValidationFlagsEXT)(nil).DisabledValidationCheckCount)},
+		{"pDisabledValidationChecks", unsafe.Offsetof((*ValidationFlagsEXT)(nil).PDisabledValidationChecks)},
+	}},
+	{"VkImageViewASTCDecodeModeEXT", unsafe.Sizeof(*(*ImageViewASTCDecodeModeEXT)(nil)), unsafe.Alignof(*(*ImageViewASTCDecodeModeEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ImageViewASTCDecodeModeEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ImageViewASTCDecodeModeEXT)(nil).PNext)},
+		{"decodeMode", unsafe.Offsetof((*ImageViewASTCDecodeModeEXT)(nil).DecodeMode)},
+	}},
+	{"VkPhysicalDeviceASTCDecodeFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceASTCDecodeFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceASTCDecodeFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceASTCDecodeFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceASTCDecodeFeaturesEXT)(nil).PNext)},
+		{"decodeModeSharedExponent", unsafe.Offsetof((*PhysicalDeviceASTCDecodeFeaturesEXT)(nil).DecodeModeSharedExponent)},
+	}},
+	{"VkPhysicalDevicePipelineRobustnessFeaturesEXT", unsafe.Sizeof(*(*PhysicalDevicePipelineRobustnessFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDevicePipelineRobustnessFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDevicePipelineRobustnessFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDevicePipelineRobustnessFeaturesEXT)(nil).PNext)},
+		{"pipelineRobustness", unsafe.Offsetof((*PhysicalDevicePipelineRobustnessFeaturesEXT)(nil).PipelineRobustness)},
+	}},
+	{"VkPhysicalDevicePipelineRobustnessPropertiesEXT", unsafe.Sizeof(*(*PhysicalDevicePipelineRobustnessPropertiesEXT)(nil)), unsafe.Alignof(*(*PhysicalDevicePipelineRobustnessPropertiesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDevicePipelineRobustnessPropertiesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDevicePipelineRobustnessPropertiesEXT)(nil).PNext)},
+		{"defaultRobustnessStorageBuffers", unsafe.Offsetof((*PhysicalDevicePipelineRobustnessPropertiesEXT)(nil).DefaultRobustnessStorageBuffers)},
+		{"defaultRobustnessUniformBuffers", unsafe.Offsetof((*PhysicalDevicePipelineRobustnessPropertiesEXT)(nil).DefaultRobustnessUniformBuffers)},
+		{"defaultRobustnessVertexInputs", unsafe.Offsetof((*PhysicalDevicePipelineRobustnessPropertiesEXT)(nil).DefaultRobustnessVertexInputs)},
+		{"defaultRobustnessImages", unsafe.Offsetof((*PhysicalDevicePipelineRobustnessPropertiesEXT)(nil).DefaultRobustnessImages)},
+	}},
+	{"VkPipelineRobustnessCreateInfoEXT", unsafe.Sizeof(*(*PipelineRobustnessCreateInfoEXT)(nil)), unsafe.Alignof(*(*PipelineRobustnessCreateInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineRobustnessCreateInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineRobustnessCreateInfoEXT)(nil).PNext)},
+		{"storageBuffers", unsafe.Offsetof((*PipelineRobustnessCreateInfoEXT)(nil).StorageBuffers)},
+		{"uniformBuffers", unsafe.Offsetof((*PipelineRobustnessCreateInfoEXT)(nil).UniformBuffers)},
+		{"vertexInputs", unsafe.Offsetof((*PipelineRobustnessCreateInfoEXT)(nil).VertexInputs)},
+		{"images", unsafe.Offsetof((*PipelineRobustnessCreateInfoEXT)(nil).Images)},
+	}},
+	{"VkConditionalRenderingBeginInfoEXT", unsafe.Sizeof(*(*ConditionalRenderingBeginInfoEXT)(nil)), unsafe.Alignof(*(*ConditionalRenderingBeginInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ConditionalRenderingBeginInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ConditionalRenderingBeginInfoEXT)(nil).PNext)},
+		{"buffer", unsafe.Offsetof((*ConditionalRenderingBeginInfoEXT)(nil).Buffer)},
+		{"offset", unsafe.Offsetof((*ConditionalRenderingBeginInfoEXT)(nil).Offset)},
+		{"flags", unsafe.Offsetof((*ConditionalRenderingBeginInfoEXT)(nil).Flags)},
+	}},
+	{"VkPhysicalDeviceConditionalRenderingFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceConditionalRenderingFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceConditionalRenderingFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceConditionalRenderingFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceConditionalRenderingFeaturesEXT)(nil).PNext)},
+		{"conditionalRendering", unsafe.Offsetof((*PhysicalDeviceConditionalRenderingFeaturesEXT)(nil).ConditionalRendering)},
+		{"inheritedConditionalRendering", unsafe.Offsetof((*PhysicalDeviceConditionalRenderingFeaturesEXT)(nil).InheritedConditionalRendering)},
+	}},
+	{"VkCommandBufferInheritanceConditionalRenderingInfoEXT", unsafe.Sizeof(*(*CommandBufferInheritanceConditionalRenderingInfoEXT)(nil)), unsafe.Alignof(*(*CommandBufferInheritanceConditionalRenderingInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*CommandBufferInheritanceConditionalRenderingInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*CommandBufferInheritanceConditionalRenderingInfoEXT)(nil).PNext)},
+		{"conditionalRenderingEnable", unsafe.Offsetof((*CommandBufferInheritanceConditionalRenderingInfoEXT)(nil).ConditionalRenderingEnable)},
+	}},
+	{"VkViewportWScalingNV", unsafe.Sizeof(*(*ViewportWScalingNV)(nil)), unsafe.Alignof(*(*ViewportWScalingNV)(nil)), []memberOffset{
+		{"xcoeff", unsafe.Offsetof((*ViewportWScalingNV)(nil).Xcoeff)},
+		{"ycoeff", unsafe.Offsetof((*ViewportWScalingNV)(nil).Ycoeff)},
+	}},
+	{"VkPipelineViewportWScalingStateCreateInfoNV", unsafe.Sizeof(*(*PipelineViewportWScalingStateCreateInfoNV)(nil)), unsafe.Alignof(*(*PipelineViewportWScalingStateCreateInfoNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineViewportWScalingStateCreateInfoNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineViewportWScalingStateCreateInfoNV)(nil).PNext)},
+		{"viewportWScalingEnable", unsafe.Offsetof((*PipelineViewportWScalingStateCreateInfoNV)(nil).ViewportWScalingEnable)},
+		{"viewportCount", unsafe.Offsetof((*PipelineViewportWScalingStateCreateInfoNV)(nil).ViewportCount)},
+		{"pViewportWScalings", unsafe.Offsetof((*PipelineViewportWScalingStateCreateInfoNV)(nil).PViewportWScalings)},
+	}},
+	{"VkSurfaceCapabilities2EXT", unsafe.Sizeof(*(*SurfaceCapabilities2EXT)(nil)), unsafe.Alignof(*(*SurfaceCapabilities2EXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SurfaceCapabilities2EXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SurfaceCapabilities2EXT)(nil).PNext)},
+		{"minImageCount", unsafe.Offsetof((*SurfaceCapabilities2EXT)(nil).MinImageCount)},
+		{"maxImageCount", unsafe.Offsetof((*SurfaceCapabilities2EXT)(nil).MaxImageCount)},
+		{"currentExtent", unsafe.Offsetof((*SurfaceCapabilities2EXT)(nil).CurrentExtent)},
+		{"minImageExtent", unsafe.Offsetof((*SurfaceCapabilities2EXT)(nil).MinImageExtent)},
+		{"maxImageExtent", unsafe.Offsetof((*SurfaceCapabilities2EXT)(nil).MaxImageExtent)},
+		{"maxImageArrayLayers", unsafe.Offsetof((*SurfaceCapabilities2EXT)(nil).MaxImageArrayLayers)},
+		{"supportedTransforms", unsafe.Offsetof((*SurfaceCapabilities2EXT)(nil).SupportedTransforms)},
+		{"currentTransform", unsafe.Offsetof((*SurfaceCapabilities2EXT)(nil).CurrentTransform)},
+		{"supportedCompositeAlpha", unsafe.Offsetof((*SurfaceCapabilities2EXT)(nil).SupportedCompositeAlpha)},
+		{"supportedUsageFlags", unsafe.Offsetof((*SurfaceCapabilities2EXT)(nil).SupportedUsageFlags)},
+		{"supportedSurfaceCounters", unsafe.Offsetof((*SurfaceCapabilities2EXT)(nil).SupportedSurfaceCounters)},
+	}},
+	{"VkDisplayPowerInfoEXT", unsafe.Sizeof(*(*DisplayPowerInfoEXT)(nil)), unsafe.Alignof(*(*DisplayPowerInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DisplayPowerInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DisplayPowerInfoEXT)(nil).PNext)},
+		{"powerState", unsafe.Offsetof((*DisplayPowerInfoEXT)(nil).PowerState)},
+	}},
+	{"VkDeviceEventInfoEXT", unsafe.Sizeof(*(*DeviceEventInfoEXT)(nil)), unsafe.Alignof(*(*DeviceEventInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DeviceEventInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DeviceEventInfoEXT)(nil).PNext)},
+		{"deviceEvent", unsafe.Offsetof((*DeviceEventInfoEXT)(nil).DeviceEvent)},
+	}},
+	{"VkDisplayEventInfoEXT", unsafe.Sizeof(*(*DisplayEventInfoEXT)(nil)), unsafe.Alignof(*(*DisplayEventInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DisplayEventInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DisplayEventInfoEXT)(nil).PNext)},
+		{"displayEvent", unsafe.Offsetof((*DisplayEventInfoEXT)(nil).DisplayEvent)},
+	}},
+	{"VkSwapchainCounterCreateInfoEXT", unsafe.Sizeof(*(*SwapchainCounterCreateInfoEXT)(nil)), unsafe.Alignof(*(*SwapchainCounterCreateInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SwapchainCounterCreateInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SwapchainCounterCreateInfoEXT)(nil).PNext)},
+		{"surfaceCounters", unsafe.Offsetof((*SwapchainCounterCreateInfoEXT)(nil).SurfaceCounters)},
+	}},
+	{"VkRefreshCycleDurationGOOGLE", unsafe.Sizeof(*(*RefreshCycleDurationGOOGLE)(nil)), unsafe.Alignof(*(*RefreshCycleDurationGOOGLE)(nil)), []memberOffset{
+		{"refreshDuration", unsafe.Offsetof((*RefreshCycleDurationGOOGLE)(nil).RefreshDuration)},
+	}},
+	{"VkPastPresentationTimingGOOGLE", unsafe.Sizeof(*(*PastPresentationTimingGOOGLE)(nil)), unsafe.Alignof(*(*PastPresentationTimingGOOGLE)(nil)), []memberOffset{
+		{"presentID", unsafe.Offsetof((*PastPresentationTimingGOOGLE)(nil).PresentID)},
+		{"desiredPresentTime", unsafe.Offsetof((*PastPresentationTimingGOOGLE)(nil).DesiredPresentTime)},
+		{"actualPresentTime", unsafe.Offsetof((*PastPresentationTimingGOOGLE)(nil).ActualPresentTime)},
+		{"earliestPresentTime", unsafe.Offsetof((*PastPresentationTimingGOOGLE)(nil).EarliestPresentTime)},
+		{"presentMargin", unsafe.Offsetof((*PastPresentationTimingGOOGLE)(nil).PresentMargin)},
+	}},
+	{"VkPresentTimeGOOGLE", unsafe.Sizeof(*(*PresentTimeGOOGLE)(nil)), unsafe.Alignof(*(*PresentTimeGOOGLE)(nil)), []memberOffset{
+		{"presentID", unsafe.Offsetof((*PresentTimeGOOGLE)(nil).PresentID)},
+		{"desiredPresentTime", unsafe.Offsetof((*PresentTimeGOOGLE)(nil).DesiredPresentTime)},
+	}},
+	{"VkPresentTimesInfoGOOGLE", unsafe.Sizeof(*(*PresentTimesInfoGOOGLE)(nil)), unsafe.Alignof(*(*PresentTimesInfoGOOGLE)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PresentTimesInfoGOOGLE)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PresentTimesInfoGOOGLE)(nil).PNext)},
+		{"swapchainCount", unsafe.Offsetof((*PresentTimesInfoGOOGLE)(nil).SwapchainCount)},
+		{"pTimes", unsafe.Offsetof((*PresentTimesInfoGOOGLE)(nil).PTimes)},
+	}},
+	{"VkPhysicalDeviceMultiviewPerViewAttributesPropertiesNVX", unsafe.Sizeof(*(*PhysicalDeviceMultiviewPerViewAttributesPropertiesNVX)(nil)), unsafe.Alignof(*(*PhysicalDeviceMultiviewPerViewAttributesPropertiesNVX)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceMultiviewPerViewAttributesPropertiesNVX)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceMultiviewPerViewAttributesPropertiesNVX)(nil).PNext)},
+		{"perViewPositionAllComponents", unsafe.Offsetof((*PhysicalDeviceMultiviewPerViewAttributesPropertiesNVX)(nil).PerViewPositionAllComponents)},
+	}},
+	{"VkViewportSwizzleNV", unsafe.Sizeof(*(*ViewportSwizzleNV)(nil)), unsafe.Alignof(*(*ViewportSwizzleNV)(nil)), []memberOffset{
+		{"x", unsafe.Offsetof((*ViewportSwizzleNV)(nil).X)},
+		{"y", unsafe.Offsetof((*ViewportSwizzleNV)(nil).Y)},
+		{"z", unsafe.Offsetof((*ViewportSwizzleNV)(nil).Z)},
+		{"w", unsafe.Offsetof((*ViewportSwizzleNV)(nil).W)},
+	}},
+	{"VkPipelineViewportSwizzleStateCreateInfoNV", unsafe.Sizeof(*(*PipelineViewportSwizzleStateCreateInfoNV)(nil)), unsafe.Alignof(*(*PipelineViewportSwizzleStateCreateInfoNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineViewportSwizzleStateCreateInfoNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineViewportSwizzleStateCreateInfoNV)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*PipelineViewportSwizzleStateCreateInfoNV)(nil).Flags)},
+		{"viewportCount", unsafe.Offsetof((*PipelineViewportSwizzleStateCreateInfoNV)(nil).ViewportCount)},
+		{"pViewportSwizzles", unsafe.Offsetof((*PipelineViewportSwizzleStateCreateInfoNV)(nil).PViewportSwizzles)},
+	}},
+	{"VkPhysicalDeviceDiscardRectanglePropertiesEXT", unsafe.Sizeof(*(*PhysicalDeviceDiscardRectanglePropertiesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceDiscardRectanglePropertiesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceDiscardRectanglePropertiesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceDiscardRectanglePropertiesEXT)(nil).PNext)},
+		{"maxDiscardRectangles", unsafe.Offsetof((*PhysicalDeviceDiscardRectanglePropertiesEXT)(nil).MaxDiscardRectangles)},
+	}},
+	{"VkPipelineDiscardRectangleStateCreateInfoEXT", unsafe.Sizeof(*(*PipelineDiscardRectangleStateCreateInfoEXT)(nil)), unsafe.Alignof(*(*PipelineDiscardRectangleStateCreateInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineDiscardRectangleStateCreateInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineDiscardRectangleStateCreateInfoEXT)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*PipelineDiscardRectangleStateCreateInfoEXT)(nil).Flags)},
+		{"discardRectangleMode", unsafe.Offsetof((*PipelineDiscardRectangleStateCreateInfoEXT)(nil).DiscardRectangleMode)},
+		{"discardRectangleCount", unsafe.Offsetof((*PipelineDiscardRectangleStateCreateInfoEXT)(nil).DiscardRectangleCount)},
+		{"pDiscardRectangles", unsafe.Offsetof((*PipelineDiscardRectangleStateCreateInfoEXT)(nil).PDiscardRectangles)},
+	}},
+	{"VkPhysicalDeviceConservativeRasterizationPropertiesEXT", unsafe.Sizeof(*(*PhysicalDeviceConservativeRasterizationPropertiesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceConservativeRasterizationPropertiesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceConservativeRasterizationPropertiesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceConservativeRasterizationPropertiesEXT)(nil).PNext)},
+		{"primitiveOverestimationSize", unsafe.Offsetof((*PhysicalDeviceConservativeRasterizationPropertiesEXT)(nil).PrimitiveOverestimationSize)},
+		{"maxExtraPrimitiveOverestimationSize", unsafe.Offsetof((*PhysicalDeviceConservativeRasterizationPropertiesEXT)(nil).MaxExtraPrimitiveOverestimationSize)},
+		{"extraPrimitiveOverestimationSizeGranularity", unsafe.Offsetof((*PhysicalDeviceConservativeRasterizationPropertiesEXT)(nil).ExtraPrimitiveOverestimationSizeGranularity)},
+		{"primitiveUnderestimation", unsafe.Offsetof((*PhysicalDeviceConservativeRasterizationPropertiesEXT)(nil).PrimitiveUnderestimation)},
+		{"conservativePointAndLineRasterization", unsafe.Offsetof((*PhysicalDeviceConservativeRasterizationPropertiesEXT)(nil).ConservativePointAndLineRasterization)},
+		{"degenerateTrianglesRasterized", unsafe.Offsetof((*PhysicalDeviceConservativeRasterizationPropertiesEXT)(nil).DegenerateTrianglesRasterized)},
+		{"degenerateLinesRasterized", unsafe.Offsetof((*PhysicalDeviceConservativeRasterizationPropertiesEXT)(nil).DegenerateLinesRasterized)},
+		{"fullyCoveredFragmentShaderInputVariable", unsafe.Offsetof((*PhysicalDeviceConservativeRasterizationPropertiesEXT)(nil).FullyCoveredFragmentShaderInputVariable)},
+		{"conservativeRasterizationPostDepthCoverage", unsafe.Offsetof((*PhysicalDeviceConservativeRasterizationPropertiesEXT)(nil).ConservativeRasterizationPostDepthCoverage)},
+	}},
+	{"VkPipelineRasterizationConservativeStateCreateInfoEXT", unsafe.Sizeof(*(*PipelineRasterizationConservativeStateCreateInfoEXT)(nil)), unsafe.Alignof(*(*PipelineRasterizationConservativeStateCreateInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineRasterizationConservativeStateCreateInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineRasterizationConservativeStateCreateInfoEXT)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*PipelineRasterizationConservativeStateCreateInfoEXT)(nil).Flags)},
+		{"conservativeRasterizationMode", unsafe.Offsetof((*PipelineRasterizationConservativeStateCreateInfoEXT)(nil).ConservativeRasterizationMode)},
+		{"extraPrimitiveOverestimationSize", unsafe.Offsetof((*PipelineRasterizationConservativeStateCreateInfoEXT)(nil).ExtraPrimitiveOverestimationSize)},
+	}},
+	{"VkPhysicalDeviceDepthClipEnableFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceDepthClipEnableFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceDepthClipEnableFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceDepthClipEnableFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceDepthClipEnableFeaturesEXT)(nil).PNext)},
+		{"depthClipEnable", unsafe.Offsetof((*PhysicalDeviceDepthClipEnableFeaturesEXT)(nil).DepthClipEnable)},
+	}},
+	{"VkPipelineRasterizationDepthClipStateCreateInfoEXT", unsafe.Sizeof(*(*PipelineRasterizationDepthClipStateCreateInfoEXT)(nil)), unsafe.Alignof(*(*PipelineRasterizationDepthClipStateCreateInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineRasterizationDepthClipStateCreateInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineRasterizationDepthClipStateCreateInfoEXT)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*PipelineRasterizationDepthClipStateCreateInfoEXT)(nil).Flags)},
+		{"depthClipEnable", unsafe.Offsetof((*PipelineRasterizationDepthClipStateCreateInfoEXT)(nil).DepthClipEnable)},
+	}},
+	{"VkXYColorEXT", unsafe.Sizeof(*(*XYColorEXT)(nil)), unsafe.Alignof(*(*XYColorEXT)(nil)), []memberOffset{
+		{"x", unsafe.Offsetof((*XYColorEXT)(nil).X)},
+		{"y", unsafe.Offsetof((*XYColorEXT)(nil).Y)},
+	}},
+	{"VkHdrMetadataEXT", unsafe.Sizeof(*(*HdrMetadataEXT)(nil)), unsafe.Alignof(*(*HdrMetadataEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*HdrMetadataEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*HdrMetadataEXT)(nil).PNext)},
+		{"displayPrimaryRed", unsafe.Offsetof((*HdrMetadataEXT)(nil).DisplayPrimaryRed)},
+		{"displayPrimaryGreen", unsafe.Offsetof((*HdrMetadataEXT)(nil).DisplayPrimaryGreen)},
+		{"displayPrimaryBlue", unsafe.Offsetof((*HdrMetadataEXT)(nil).DisplayPrimaryBlue)},
+		{"whitePoint", unsafe.Offsetof((*HdrMetadataEXT)(nil).WhitePoint)},
+		{"maxLuminance", unsafe.Offsetof((*HdrMetadataEXT)(nil).MaxLuminance)},
+		{"minLuminance", unsafe.Offsetof((*HdrMetadataEXT)(nil).MinLuminance)},
+		{"maxContentLightLevel", unsafe.Offsetof((*HdrMetadataEXT)(nil).MaxContentLightLevel)},
+		{"maxFrameAverageLightLevel", unsafe.Offsetof((*HdrMetadataEXT)(nil).MaxFrameAverageLightLevel)},
+	}},
+	{"VkDebugUtilsLabelEXT", unsafe.Sizeof(*(*DebugUtilsLabelEXT)(nil)), unsafe.Alignof(*(*DebugUtilsLabelEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DebugUtilsLabelEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DebugUtilsLabelEXT)(nil).PNext)},
+		{"pLabelName", unsafe.Offsetof((*DebugUtilsLabelEXT)(nil).PLabelName)},
+		{"color", unsafe.Offsetof((*DebugUtilsLabelEXT)(nil).Color)},
+	}},
+	{"VkDebugUtilsObjectNameInfoEXT", unsafe.Sizeof(*(*DebugUtilsObjectNameInfoEXT)(nil)), unsafe.Alignof(*(*DebugUtilsObjectNameInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DebugUtilsObjectNameInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DebugUtilsObjectNameInfoEXT)(nil).PNext)},
+		{"objectType", unsafe.Offsetof((*DebugUtilsObjectNameInfoEXT)(nil).ObjectType)},
+		{"objectHandle", unsafe.Offsetof((*DebugUtilsObjectNameInfoEXT)(nil).ObjectHandle)},
+		{"pObjectName", unsafe.Offsetof((*DebugUtilsObjectNameInfoEXT)(nil).PObjectName)},
+	}},
+	{"VkDebugUtilsMessengerCallbackDataEXT", unsafe.Sizeof(*(*DebugUtilsMessengerCallbackDataEXT)(nil)), unsafe.Alignof(*(*DebugUtilsMessengerCallbackDataEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DebugUtilsMessengerCallbackDataEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DebugUtilsMessengerCallbackDataEXT)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*DebugUtilsMessengerCallbackDataEXT)(nil).Flags)},
+		{"pMessageIdName", unsafe.Offsetof((*DebugUtilsMessengerCallbackDataEXT)(nil).PMessageIdName)},
+		{"messageIdNumber", unsafe.Offsetof((*DebugUtilsMessengerCallbackDataEXT)(nil).MessageIdNumber)},
+		{"pMessage", unsafe.Offsetof((*DebugUtilsMessengerCallbackDataEXT)(nil).PMessage)},
+		{"queueLabelCount", unsafe.Offsetof((*DebugUtilsMessengerCallbackDataEXT)(nil).QueueLabelCount)},
+		{"pQueueLabels", unsafe.Offsetof((*DebugUtilsMessengerCallbackDataEXT)(nil).PQueueLabels)},
+		{"cmdBufLabelCount", unsafe.Offsetof((*DebugUtilsMessengerCallbackDataEXT)(nil).CmdBufLabelCount)},
+		{"pCmdBufLabels", unsafe.Offsetof((*DebugUtilsMessengerCallbackDataEXT)(nil).PCmdBufLabels)},
+		{"objectCount", unsafe.Offsetof((*DebugUtilsMessengerCallbackDataEXT)(nil).ObjectCount)},
+		{"pObjects", unsafe.Offsetof((*DebugUtilsMessengerCallbackDataEXT)(nil).PObjects)},
+	}},
+	{"VkDebugUtilsMessengerCreateInfoEXT", unsafe.Sizeof(*(*DebugUtilsMessengerCreateInfoEXT)(nil)), unsafe.Alignof(*(*DebugUtilsMessengerCreateInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DebugUtilsMessengerCreateInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DebugUtilsMessengerCreateInfoEXT)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*DebugUtilsMessengerCreateInfoEXT)(nil).Flags)},
+		{"messageSeverity", unsafe.Offsetof((*DebugUtilsMessengerCreateInfoEXT)(nil).MessageSeverity)},
+		{"messageType", unsafe.Offsetof((*DebugUtilsMessengerCreateInfoEXT)(nil).MessageType)},
+		{"pfnUserCallback", unsafe.Offsetof((*DebugUtilsMessengerCreateInfoEXT)(nil).PfnUserCallback)},
+		{"pUserData", unsafe.Offsetof((*DebugUtilsMessengerCreateInfoEXT)(nil).PUserData)},
+	}},
+	{"VkDebugUtilsObjectTagInfoEXT", unsafe.Sizeof(*(*DebugUtilsObjectTagInfoEXT)(nil)), unsafe.Alignof(*(*DebugUtilsObjectTagInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DebugUtilsObjectTagInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DebugUtilsObjectTagInfoEXT)(nil).PNext)},
+		{"objectType", unsafe.Offsetof((*DebugUtilsObjectTagInfoEXT)(nil).ObjectType)},
+		{"objectHandle", unsafe.Offsetof((*DebugUtilsObjectTagInfoEXT)(nil).ObjectHandle)},
+		{"tagName", unsafe.Offsetof((*DebugUtilsObjectTagInfoEXT)(nil).TagName)},
+		{"tagSize", unsafe.Offsetof((*DebugUtilsObjectTagInfoEXT)(nil).TagSize)},
+		{"pTag", unsafe.Offsetof((*DebugUtilsObjectTagInfoEXT)(nil).PTag)},
+	}},
+	{"VkSampleLocationEXT", unsafe.Sizeof(*(*SampleLocationEXT)(nil)), unsafe.Alignof(*(*SampleLocationEXT)(nil)), []memberOffset{
+		{"x", unsafe.Offsetof((*SampleLocationEXT)(nil).X)},
+		{"y", unsafe.Offsetof((*SampleLocationEXT)(nil).Y)},
+	}},
+	{"VkSampleLocationsInfoEXT", unsafe.Sizeof(*(*SampleLocationsInfoEXT)(nil)), unsafe.Alignof(*(*SampleLocationsInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SampleLocationsInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SampleLocationsInfoEXT)(nil).PNext)},
+		{"sampleLocationsPerPixel", unsafe.Offsetof((*SampleLocationsInfoEXT)(nil).SampleLocationsPerPixel)},
+		{"sampleLocationGridSize", unsafe.Offsetof((*SampleLocationsInfoEXT)(nil).SampleLocationGridSize)},
+		{"sampleLocationsCount", unsafe.Offsetof((*SampleLocationsInfoEXT)(nil).SampleLocationsCount)},
+		{"pSampleLocations", unsafe.Offsetof((*SampleLocationsInfoEXT)(nil).PSampleLocations)},
+	}},
+	{"VkAttachmentSampleLocationsEXT", unsafe.Sizeof(*(*AttachmentSampleLocationsEXT)(nil)), unsafe.Alignof(*(*AttachmentSampleLocationsEXT)(nil)), []memberOffset{
+		{"attachmentIndex", unsafe.Offsetof((*AttachmentSampleLocationsEXT)(nil).AttachmentIndex)},
+		{"sampleLocationsInfo", unsafe.Offsetof((*AttachmentSampleLocationsEXT)(nil).SampleLocationsInfo)},
+	}},
+	{"VkSubpassSampleLocationsEXT", unsafe.Sizeof(*(*SubpassSampleLocationsEXT)(nil)), unsafe.Alignof(*(*SubpassSampleLocationsEXT)(nil)), []memberOffset{
+		{"subpassIndex", unsafe.Offsetof((*SubpassSampleLocationsEXT)(nil).SubpassIndex)},
+		{"sampleLocationsInfo", unsafe.Offsetof((*SubpassSampleLocationsEXT)(nil).SampleLocationsInfo)},
+	}},
+	{"VkRenderPassSampleLocationsBeginInfoEXT", unsafe.Sizeof(*(*RenderPassSampleLocationsBeginInfoEXT)(nil)), unsafe.Alignof(*(*RenderPassSampleLocationsBeginInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*RenderPassSampleLocationsBeginInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*RenderPassSampleLocationsBeginInfoEXT)(nil).PNext)},
+		{"attachmentInitialSampleLocationsCount", unsafe.Offsetof((*RenderPassSampleLocationsBeginInfoEXT)(nil).AttachmentInitialSampleLocationsCount)},
+		{"pAttachmentInitialSampleLocations", unsafe.Offsetof((*RenderPassSampleLocationsBeginInfoEXT)(nil).PAttachmentInitialSampleLocations)},
+		{"postSubpassSampleLocationsCount", unsafe.Offsetof((*RenderPassSampleLocationsBeginInfoEXT)(nil).PostSubpassSampleLocationsCount)},
+		{"pPostSubpassSampleLocations", unsafe.Offsetof((*RenderPassSampleLocationsBeginInfoEXT)(nil).PPostSubpassSampleLocations)},
+	}},
+	{"VkPipelineSampleLocationsStateCreateInfoEXT", unsafe.Sizeof(*(*PipelineSampleLocationsStateCreateInfoEXT)(nil)), unsafe.Alignof(*(*PipelineSampleLocationsStateCreateInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineSampleLocationsStateCreateInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineSampleLocationsStateCreateInfoEXT)(nil).PNext)},
+		{"sampleLocationsEnable", unsafe.Offsetof((*PipelineSampleLocationsStateCreateInfoEXT)(nil).SampleLocationsEnable)},
+		{"sampleLocationsInfo", unsafe.Offsetof((*PipelineSampleLocationsStateCreateInfoEXT)(nil).SampleLocationsInfo)},
+	}},
+	{"VkPhysicalDeviceSampleLocationsPropertiesEXT", unsafe.Sizeof(*(*PhysicalDeviceSampleLocationsPropertiesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceSampleLocationsPropertiesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceSampleLocationsPropertiesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceSampleLocationsPropertiesEXT)(nil).PNext)},
+		{"sampleLocationSampleCounts", unsafe.Offsetof((*PhysicalDeviceSampleLocationsPropertiesEXT)(nil).SampleLocationSampleCounts)},
+		{"maxSampleLocationGridSize", unsafe.Offsetof((*PhysicalDeviceSampleLocationsPropertiesEXT)(nil).MaxSampleLocationGridSize)},
+		{"sampleLocationCoordinateRange", unsafe.Offsetof((*PhysicalDeviceSampleLocationsPropertiesEXT)(nil).SampleLocationCoordinateRange)},
+		{"sampleLocationSubPixelBits", unsafe.Offsetof((*PhysicalDeviceSampleLocationsPropertiesEXT)(nil).SampleLocationSubPixelBits)},
+		{"variableSampleLocations", unsafe.Offsetof((*PhysicalDeviceSampleLocationsPropertiesEXT)(nil).VariableSampleLocations)},
+	}},
+	{"VkMultisamplePropertiesEXT", unsafe.Sizeof(*(*MultisamplePropertiesEXT)(nil)), unsafe.Alignof(*(*MultisamplePropertiesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*MultisamplePropertiesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*MultisamplePropertiesEXT)(nil).PNext)},
+		{"maxSampleLocationGridSize", unsafe.Offsetof((*MultisamplePropertiesEXT)(nil).MaxSampleLocationGridSize)},
+	}},
+	{"VkPhysicalDeviceBlendOperationAdvancedFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceBlendOperationAdvancedFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceBlendOperationAdvancedFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceBlendOperationAdvancedFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceBlendOperationAdvancedFeaturesEXT)(nil).PNext)},
+		{"advancedBlendCoherentOperations", unsafe.Offsetof((*PhysicalDeviceBlendOperationAdvancedFeaturesEXT)(nil).AdvancedBlendCoherentOperations)},
+	}},
+	{"VkPhysicalDeviceBlendOperationAdvancedPropertiesEXT", unsafe.Sizeof(*(*PhysicalDeviceBlendOperationAdvancedPropertiesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceBlendOperationAdvancedPropertiesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceBlendOperationAdvancedPropertiesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceBlendOperationAdvancedPropertiesEXT)(nil).PNext)},
+		{"advancedBlendMaxColorAttachments", unsafe.Offsetof((*PhysicalDeviceBlendOperationAdvancedPropertiesEXT)(nil).AdvancedBlendMaxColorAttachments)},
+		{"advancedBlendIndependentBlend", unsafe.Offsetof((*PhysicalDeviceBlendOperationAdvancedPropertiesEXT)(nil).AdvancedBlendIndependentBlend)},
+		{"advancedBlendNonPremultipliedSrcColor", unsafe.Offsetof((*PhysicalDeviceBlendOperationAdvancedPropertiesEXT)(nil).AdvancedBlendNonPremultipliedSrcColor)},
+		{"advancedBlendNonPremultipliedDstColor", unsafe.Offsetof((*PhysicalDeviceBlendOperationAdvancedPropertiesEXT)(nil).AdvancedBlendNonPremultipliedDstColor)},
+		{"advancedBlendCorrelatedOverlap", unsafe.Offsetof((*PhysicalDeviceBlendOperationAdvancedPropertiesEXT)(nil).AdvancedBlendCorrelatedOverlap)},
+		{"advancedBlendAllOperations", unsafe.Offsetof((*PhysicalDeviceBlendOperationAdvancedPropertiesEXT)(nil).AdvancedBlendAllOperations)},
+	}},
+	{"VkPipelineColorBlendAdvancedStateCreateInfoEXT", unsafe.Sizeof(*(*PipelineColorBlendAdvancedStateCreateInfoEXT)(nil)), unsafe.Alignof(*(*PipelineColorBlendAdvancedStateCreateInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineColorBlendAdvancedStateCreateInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineColorBlendAdvancedStateCreateInfoEXT)(nil).PNext)},
+		{"srcPremultiplied", unsafe.Offsetof((*PipelineColorBlendAdvancedStateCreateInfoEXT)(nil).SrcPremultiplied)},
+		{"dstPremultiplied", unsafe.Offsetof((*PipelineColorBlendAdvancedStateCreateInfoEXT)(nil).DstPremultiplied)},
+		{"blendOverlap", unsafe.Offsetof((*PipelineColorBlendAdvancedStateCreateInfoEXT)(nil).BlendOverlap)},
+	}},
+	{"VkPipelineCoverageToColorStateCreateInfoNV", unsafe.Sizeof(*(*PipelineCoverageToColorStateCreateInfoNV)(nil)), unsafe.Alignof(*(*PipelineCoverageToColorStateCreateInfoNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineCoverageToColorStateCreateInfoNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineCoverageToColorStateCreateInfoNV)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*PipelineCoverageToColorStateCreateInfoNV)(nil).Flags)},
+		{"coverageToColorEnable", unsafe.Offsetof((*PipelineCoverageToColorStateCreateInfoNV)(nil).CoverageToColorEnable)},
+		{"coverageToColorLocation", unsafe.Offsetof((*PipelineCoverageToColorStateCreateInfoNV)(nil).CoverageToColorLocation)},
+	}},
+	{"VkPipelineCoverageModulationStateCreateInfoNV", unsafe.Sizeof(*(*PipelineCoverageModulationStateCreateInfoNV)(nil)), unsafe.Alignof(*(*PipelineCoverageModulationStateCreateInfoNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineCoverageModulationStateCreateInfoNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineCoverageModulationStateCreateInfoNV)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*PipelineCoverageModulationStateCreateInfoNV)(nil).Flags)},
+		{"coverageModulationMode", unsafe.Offsetof((*PipelineCoverageModulationStateCreateInfoNV)(nil).CoverageModulationMode)},
+		{"coverageModulationTableEnable", unsafe.Offsetof((*PipelineCoverageModulationStateCreateInfoNV)(nil).CoverageModulationTableEnable)},
+		{"coverageModulationTableCount", unsafe.Offsetof((*PipelineCoverageModulationStateCreateInfoNV)(nil).CoverageModulationTableCount)},
+		{"pCoverageModulationTable", unsafe.Offsetof((*PipelineCoverageModulationStateCreateInfoNV)(nil).PCoverageModulationTable)},
+	}},
+	{"VkPhysicalDeviceShaderSMBuiltinsPropertiesNV", unsafe.Sizeof(*(*PhysicalDeviceShaderSMBuiltinsPropertiesNV)(nil)), unsafe.Alignof(*(*PhysicalDeviceShaderSMBuiltinsPropertiesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceShaderSMBuiltinsPropertiesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceShaderSMBuiltinsPropertiesNV)(nil).PNext)},
+		{"shaderSMCount", unsafe.Offsetof((*PhysicalDeviceShaderSMBuiltinsPropertiesNV)(nil).ShaderSMCount)},
+		{"shaderWarpsPerSM", unsafe.Offsetof((*PhysicalDeviceShaderSMBuiltinsPropertiesNV)(nil).ShaderWarpsPerSM)},
+	}},
+	{"VkPhysicalDeviceShaderSMBuiltinsFeaturesNV", unsafe.Sizeof(*(*PhysicalDeviceShaderSMBuiltinsFeaturesNV)(nil)), unsafe.Alignof(*(*PhysicalDeviceShaderSMBuiltinsFeaturesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceShaderSMBuiltinsFeaturesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceShaderSMBuiltinsFeaturesNV)(nil).PNext)},
+		{"shaderSMBuiltins", unsafe.Offsetof((*PhysicalDeviceShaderSMBuiltinsFeaturesNV)(nil).ShaderSMBuiltins)},
+	}},
+	{"VkDrmFormatModifierPropertiesEXT", unsafe.Sizeof(*(*DrmFormatModifierPropertiesEXT)(nil)), unsafe.Alignof(*(*DrmFormatModifierPropertiesEXT)(nil)), []memberOffset{
+		{"drmFormatModifier", unsafe.Offsetof((*DrmFormatModifierPropertiesEXT)(nil).DrmFormatModifier)},
+		{"drmFormatModifierPlaneCount", unsafe.Offsetof((*DrmFormatModifierPropertiesEXT)(nil).DrmFormatModifierPlaneCount)},
+		{"drmFormatModifierTilingFeatures", unsafe.Offsetof((*DrmFormatModifierPropertiesEXT)(nil).DrmFormatModifierTilingFeatures)},
+	}},
+	{"VkDrmFormatModifierPropertiesListEXT", unsafe.Sizeof(*(*DrmFormatModifierPropertiesListEXT)(nil)), unsafe.Alignof(*(*DrmFormatModifierPropertiesListEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DrmFormatModifierPropertiesListEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DrmFormatModifierPropertiesListEXT)(nil).PNext)},
+		{"drmFormatModifierCount", unsafe.Offsetof((*DrmFormatModifierPropertiesListEXT)(nil).DrmFormatModifierCount)},
+		{"pDrmFormatModifierProperties", unsafe.Offsetof((*DrmFormatModifierPropertiesListEXT)(nil).PDrmFormatModifierProperties)},
+	}},
+	{"VkPhysicalDeviceImageDrmFormatModifierInfoEXT", unsafe.Sizeof(*(*PhysicalDeviceImageDrmFormatModifierInfoEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceImageDrmFormatModifierInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceImageDrmFormatModifierInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceImageDrmFormatModifierInfoEXT)(nil).PNext)},
+		{"drmFormatModifier", unsafe.Offsetof((*PhysicalDeviceImageDrmFormatModifierInfoEXT)(nil).DrmFormatModifier)},
+		{"sharingMode", unsafe.Offsetof((*PhysicalDeviceImageDrmFormatModifierInfoEXT)(nil).SharingMode)},
+		{"queueFamilyIndexCount", unsafe.Offsetof((*PhysicalDeviceImageDrmFormatModifierInfoEXT)(nil).QueueFamilyIndexCount)},
+		{"pQueueFamilyIndices", unsafe.Offsetof((*PhysicalDeviceImageDrmFormatModifierInfoEXT)(nil).PQueueFamilyIndices)},
+	}},
+	{"VkImageDrmFormatModifierListCreateInfoEXT", unsafe.Sizeof(*(*ImageDrmFormatModifierListCreateInfoEXT)(nil)), unsafe.Alignof(*(*ImageDrmFormatModifierListCreateInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ImageDrmFormatModifierListCreateInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ImageDrmFormatModifierListCreateInfoEXT)(nil).PNext)},
+		{"drmFormatModifierCount", unsafe.Offsetof((*ImageDrmFormatModifierListCreateInfoEXT)(nil).DrmFormatModifierCount)},
+		{"pDrmFormatModifiers", unsafe.Offsetof((*ImageDrmFormatModifierListCreateInfoEXT)(nil).PDrmFormatModifiers)},
+	}},
+	{"VkImageDrmFormatModifierExplicitCreateInfoEXT", unsafe.Sizeof(*(*ImageDrmFormatModifierExplicitCreateInfoEXT)(nil)), unsafe.Alignof(*(*ImageDrmFormatModifierExplicitCreateInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ImageDrmFormatModifierExplicitCreateInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ImageDrmFormatModifierExplicitCreateInfoEXT)(nil).PNext)},
+		{"drmFormatModifier", unsafe.Offsetof((*ImageDrmFormatModifierExplicitCreateInfoEXT)(nil).DrmFormatModifier)},
+		{"drmFormatModifierPlaneCount", unsafe.Offsetof((*ImageDrmFormatModifierExplicitCreateInfoEXT)(nil).DrmFormatModifierPlaneCount)},
+		{"pPlaneLayouts", unsafe.Offsetof((*ImageDrmFormatModifierExplicitCreateInfoEXT)(nil).PPlaneLayouts)},
+	}},
+	{"VkImageDrmFormatModifierPropertiesEXT", unsafe.Sizeof(*(*ImageDrmFormatModifierPropertiesEXT)(nil)), unsafe.Alignof(*(*ImageDrmFormatModifierPropertiesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ImageDrmFormatModifierPropertiesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ImageDrmFormatModifierPropertiesEXT)(nil).PNext)},
+		{"drmFormatModifier", unsafe.Offsetof((*ImageDrmFormatModifierPropertiesEXT)(nil).DrmFormatModifier)},
+	}},
+	{"VkDrmFormatModifierProperties2EXT", unsafe.Sizeof(*(*DrmFormatModifierProperties2EXT)(nil)), unsafe.Alignof(*(*DrmFormatModifierProperties2EXT)(nil)), []memberOffset{
+		{"drmFormatModifier", unsafe.Offsetof((*DrmFormatModifierProperties2EXT)(nil).DrmFormatModifier)},
+		{"drmFormatModifierPlaneCount", unsafe.Offsetof((*DrmFormatModifierProperties2EXT)(nil).DrmFormatModifierPlaneCount)},
+		{"drmFormatModifierTilingFeatures", unsafe.Offsetof((*DrmFormatModifierProperties2EXT)(nil).DrmFormatModifierTilingFeatures)},
+	}},
+	{"VkDrmFormatModifierPropertiesList2EXT", unsafe.Sizeof(*(*DrmFormatModifierPropertiesList2EXT)(nil)), unsafe.Alignof(*(*DrmFormatModifierPropertiesList2EXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DrmFormatModifierPropertiesList2EXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DrmFormatModifierPropertiesList2EXT)(nil).PNext)},
+		{"drmFormatModifierCount", unsafe.Offsetof((*DrmFormatModifierPropertiesList2EXT)(nil).DrmFormatModifierCount)},
+		{"pDrmFormatModifierProperties", unsafe.Offsetof((*DrmFormatModifierPropertiesList2EXT)(nil).PDrmFormatModifierProperties)},
+	}},
+	{"VkValidationCacheCreateInfoEXT", unsafe.Sizeof(*(*ValidationCacheCreateInfoEXT)(nil)), unsafe.Alignof(*(*ValidationCacheCreateInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ValidationCacheCreateInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ValidationCacheCreateInfoEXT)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*ValidationCacheCreateInfoEXT)(nil).Flags)},
+		{"initialDataSize", unsafe.Offsetof((*ValidationCacheCreateInfoEXT)(nil).InitialDataSize)},
+		{"pInitialData", unsafe.Offsetof((*ValidationCacheCreateInfoEXT)(nil).PInitialData)},
+	}},
+	{"VkShaderModuleValidationCacheCreateInfoEXT", unsafe.Sizeof(*(*ShaderModuleValidationCacheCreateInfoEXT)(nil)), unsafe.Alignof(*(*ShaderModuleValidationCacheCreateInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ShaderModuleValidationCacheCreateInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ShaderModuleValidationCacheCreateInfoEXT)(nil).PNext)},
+		{"validationCache", unsafe.Offsetof((*ShaderModuleValidationCacheCreateInfoEXT)(nil).ValidationCache)},
+	}},
+	{"VkShadingRatePaletteNV", unsafe.Sizeof(*(*ShadingRatePaletteNV)(nil)), unsafe.Alignof(*(*ShadingRatePaletteNV)(nil)), []memberOffset{
+		{"shadingRatePaletteEntryCount", unsafe.Offsetof((*ShadingRatePaletteNV)(nil).ShadingRatePaletteEntryCount)},
+		{"pShadingRatePaletteEntries", unsafe.Offsetof((*ShadingRatePaletteNV)(nil).PShadingRatePaletteEntries)},
+	}},
+	{"VkPipelineViewportShadingRateImageStateCreateInfoNV", unsafe.Sizeof(*(*PipelineViewportShadingRateImageStateCreateInfoNV)(nil)), unsafe.Alignof(*(*PipelineViewportShadingRateImageStateCreateInfoNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineViewportShadingRateImageStateCreateInfoNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineViewportShadingRateImageStateCreateInfoNV)(nil).PNext)},
+		{"shadingRateImageEnable", unsafe.Offsetof((*PipelineViewportShadingRateImageStateCreateInfoNV)(nil).ShadingRateImageEnable)},
+		{"viewportCount", unsafe.Offsetof((*PipelineViewportShadingRateImageStateCreateInfoNV)(nil).ViewportCount)},
+		{"pShadingRatePalettes", unsafe.Offsetof((*PipelineViewportShadingRateImageStateCreateInfoNV)(nil).PShadingRatePalettes)},
+	}},
+	{"VkPhysicalDeviceShadingRateImageFeaturesNV", unsafe.Sizeof(*(*PhysicalDeviceShadingRateImageFeaturesNV)(nil)), unsafe.Alignof(*(*PhysicalDeviceShadingRateImageFeaturesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceShadingRateImageFeaturesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceShadingRateImageFeaturesNV)(nil).PNext)},
+		{"shadingRateImage", unsafe.Offsetof((*PhysicalDeviceShadingRateImageFeaturesNV)(nil).ShadingRateImage)},
+		{"shadingRateCoarseSampleOrder", unsafe.Offsetof((*PhysicalDeviceShadingRateImageFeaturesNV)(nil).ShadingRateCoarseSampleOrder)},
+	}},
+	{"VkPhysicalDeviceShadingRateImagePropertiesNV", unsafe.Sizeof(*(*PhysicalDeviceShadingRateImagePropertiesNV)(nil)), unsafe.Alignof(*(*PhysicalDeviceShadingRateImagePropertiesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceShadingRateImagePropertiesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceShadingRateImagePropertiesNV)(nil).PNext)},
+		{"shadingRateTexelSize", unsafe.Offsetof((*PhysicalDeviceShadingRateImagePropertiesNV)(nil).ShadingRateTexelSize)},
+		{"shadingRatePaletteSize", unsafe.Offsetof((*PhysicalDeviceShadingRateImagePropertiesNV)(nil).ShadingRatePaletteSize)},
+		{"shadingRateMaxCoarseSamples", unsafe.Offsetof((*PhysicalDeviceShadingRateImagePropertiesNV)(nil).ShadingRateMaxCoarseSamples)},
+	}},
+	{"VkCoarseSampleLocationNV", unsafe.Sizeof(*(*CoarseSampleLocationNV)(nil)), unsafe.Alignof(*(*CoarseSampleLocationNV)(nil)), []memberOffset{
+		{"pixelX", unsafe.Offsetof((*CoarseSampleLocationNV)(nil).PixelX)},
+		{"pixelY", unsafe.Offsetof((*CoarseSampleLocationNV)(nil).PixelY)},
+		{"sample", unsafe.Offsetof((*CoarseSampleLocationNV)(nil).Sample)},
+	}},
+	{"VkCoarseSampleOrderCustomNV", unsafe.Sizeof(*(*CoarseSampleOrderCustomNV)(nil)), unsafe.Alignof(*(*CoarseSampleOrderCustomNV)(nil)), []memberOffset{
+		{"shadingRate", unsafe.Offsetof((*CoarseSampleOrderCustomNV)(nil).ShadingRate)},
+		{"sampleCount", unsafe.Offsetof((*CoarseSampleOrderCustomNV)(nil).SampleCount)},
+		{"sampleLocationCount", unsafe.Offsetof((*CoarseSampleOrderCustomNV)(nil).SampleLocationCount)},
+		{"pSampleLocations", unsafe.Offsetof((*CoarseSampleOrderCustomNV)(nil).PSampleLocations)},
+	}},
+	{"VkPipelineViewportCoarseSampleOrderStateCreateInfoNV", unsafe.Sizeof(*(*PipelineViewportCoarseSampleOrderStateCreateInfoNV)(nil)), unsafe.Alignof(*(*PipelineViewportCoarseSampleOrderStateCreateInfoNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineViewportCoarseSampleOrderStateCreateInfoNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineViewportCoarseSampleOrderStateCreateInfoNV)(nil).PNext)},
+		{"sampleOrderType", unsafe.Offsetof((*PipelineViewportCoarseSampleOrderStateCreateInfoNV)(nil).SampleOrderType)},
+		{"customSampleOrderCount", unsafe.Offsetof((*PipelineViewportCoarseSampleOrderStateCreateInfoNV)(nil).CustomSampleOrderCount)},
+		{"pCustomSampleOrders", unsafe.Offsetof((*PipelineViewportCoarseSampleOrderStateCreateInfoNV)(nil).PCustomSampleOrders)},
+	}},
+	{"VkRayTracingShaderGroupCreateInfoNV", unsafe.Sizeof(*(*RayTracingShaderGroupCreateInfoNV)(nil)), unsafe.Alignof(*(*RayTracingShaderGroupCreateInfoNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*RayTracingShaderGroupCreateInfoNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*RayTracingShaderGroupCreateInfoNV)(nil).PNext)},
+		{"type", unsafe.Offsetof((*RayTracingShaderGroupCreateInfoNV)(nil).Type)},
+		{"generalShader", unsafe.Offsetof((*RayTracingShaderGroupCreateInfoNV)(nil).GeneralShader)},
+		{"closestHitShader", unsafe.Offsetof((*RayTracingShaderGroupCreateInfoNV)(nil).ClosestHitShader)},
+		{"anyHitShader", unsafe.Offsetof((*RayTracingShaderGroupCreateInfoNV)(nil).AnyHitShader)},
+		{"intersectionShader", unsafe.Offsetof((*RayTracingShaderGroupCreateInfoNV)(nil).IntersectionShader)},
+	}},
+	{"VkRayTracingPipelineCreateInfoNV", unsafe.Sizeof(*(*RayTracingPipelineCreateInfoNV)(nil)), unsafe.Alignof(*(*RayTracingPipelineCreateInfoNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*RayTracingPipelineCreateInfoNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*RayTracingPipelineCreateInfoNV)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*RayTracingPipelineCreateInfoNV)(nil).Flags)},
+		{"stageCount", unsafe.Offsetof((*RayTracingPipelineCreateInfoNV)(nil).StageCount)},
+		{"pStages", unsafe.Offsetof((*RayTracingPipelineCreateInfoNV)(nil).PStages)},
+		{"groupCount", unsafe.Offsetof((*RayTracingPipelineCreateInfoNV)(nil).GroupCount)},
+		{"pGroups", unsafe.Offsetof((*RayTracingPipelineCreateInfoNV)(nil).PGroups)},
+		{"maxRecursionDepth", unsafe.Offsetof((*RayTracingPipelineCreateInfoNV)(nil).MaxRecursionDepth)},
+		{"layout", unsafe.Offsetof((*RayTracingPipelineCreateInfoNV)(nil).Layout)},
+		{"basePipelineHandle", unsafe.Offsetof((*RayTracingPipelineCreateInfoNV)(nil).BasePipelineHandle)},
+		{"basePipelineIndex", unsafe.Offsetof((*RayTracingPipelineCreateInfoNV)(nil).BasePipelineIndex)},
+	}},
+	{"VkGeometryTrianglesNV", unsafe.Sizeof(*(*GeometryTrianglesNV)(nil)), unsafe.Alignof(*(*GeometryTrianglesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*GeometryTrianglesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*GeometryTrianglesNV)(nil).PNext)},
+		{"vertexData", unsafe.Offsetof((*GeometryTrianglesNV)(nil).VertexData)},
+		{"vertexOffset", unsafe.Offsetof((*GeometryTrianglesNV)(nil).VertexOffset)},
+		{"vertexCount", unsafe.Offsetof((*GeometryTrianglesNV)(nil).VertexCount)},
+		{"vertexStride", unsafe.Offsetof((*GeometryTrianglesNV)(nil).VertexStride)},
+		{"vertexFormat", unsafe.Offsetof((*GeometryTrianglesNV)(nil).VertexFormat)},
+		{"indexData", unsafe.Offsetof((*GeometryTrianglesNV)(nil).IndexData)},
+		{"indexOffset", unsafe.Offsetof((*GeometryTrianglesNV)(nil).IndexOffset)},
+		{"indexCount", unsafe.Offsetof((*GeometryTrianglesNV)(nil).IndexCount)},
+		{"indexType", unsafe.Offsetof((*GeometryTrianglesNV)(nil).IndexType)},
+		{"transformData", unsafe.Offsetof((*GeometryTrianglesNV)(nil).TransformData)},
+		{"transformOffset", unsafe.Offsetof((*GeometryTrianglesNV)(nil).TransformOffset)},
+	}},
+	{"VkGeometryAABBNV", unsafe.Sizeof(*(*GeometryAABBNV)(nil)), unsafe.Alignof(*(*GeometryAABBNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*GeometryAABBNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*GeometryAABBNV)(nil).PNext)},
+		{"aabbData", unsafe.Offsetof((*GeometryAABBNV)(nil).AabbData)},
+		{"numAABBs", unsafe.Offsetof((*GeometryAABBNV)(nil).NumAABBs)},
+		{"stride", unsafe.Offsetof((*GeometryAABBNV)(nil).Stride)},
+		{"offset", unsafe.Offsetof((*GeometryAABBNV)(nil).Offset)},
+	}},
+	{"VkGeometryDataNV", unsafe.Sizeof(*(*GeometryDataNV)(nil)), unsafe.Alignof(*(*GeometryDataNV)(nil)), []memberOffset{
+		{"triangles", unsafe.Offsetof((*GeometryDataNV)(nil).Triangles)},
+		{"aabbs", unsafe.Offsetof((*GeometryDataNV)(nil).Aabbs)},
+	}},
+	{"VkGeometryNV", unsafe.Sizeof(*(*GeometryNV)(nil)), unsafe.Alignof(*(*GeometryNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*GeometryNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*GeometryNV)(nil).PNext)},
+		{"geometryType", unsafe.Offsetof((*GeometryNV)(nil).GeometryType)},
+		{"geometry", unsafe.Offsetof((*GeometryNV)(nil).Geometry)},
+		{"flags", unsafe.Offsetof((*GeometryNV)(nil).Flags)},
+	}},
+	{"VkAccelerationStructureInfoNV", unsafe.Sizeof(*(*AccelerationStructureInfoNV)(nil)), unsafe.Alignof(*(*AccelerationStructureInfoNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*AccelerationStructureInfoNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*AccelerationStructureInfoNV)(nil).PNext)},
+		{"type", unsafe.Offsetof((*AccelerationStructureInfoNV)(nil).Type)},
+		{"flags", unsafe.Offsetof((*AccelerationStructureInfoNV)(nil).Flags)},
+		{"instanceCount", unsafe.Offsetof((*AccelerationStructureInfoNV)(nil).InstanceCount)},
+		{"geometryCount", unsafe.Offsetof((*AccelerationStructureInfoNV)(nil).GeometryCount)},
+		{"pGeometries", unsafe.Offsetof((*AccelerationStructureInfoNV)(nil).PGeometries)},
+	}},
+	{"VkAccelerationStructureCreateInfoNV", unsafe.Sizeof(*(*AccelerationStructureCreateInfoNV)(nil)), unsafe.Alignof(*(*AccelerationStructureCreateInfoNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*AccelerationStructureCreateInfoNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*AccelerationStructureCreateInfoNV)(nil).PNext)},
+		{"compactedSize", unsafe.Offsetof((*AccelerationStructureCreateInfoNV)(nil).CompactedSize)},
+		{"info", unsafe.Offsetof((*AccelerationStructureCreateInfoNV)(nil).Info)},
+	}},
+	{"VkBindAccelerationStructureMemoryInfoNV", unsafe.Sizeof(*(*BindAccelerationStructureMemoryInfoNV)(nil)), unsafe.Alignof(*(*BindAccelerationStructureMemoryInfoNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*BindAccelerationStructureMemoryInfoNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*BindAccelerationStructureMemoryInfoNV)(nil).PNext)},
+		{"accelerationStructure", unsafe.Offsetof((*BindAccelerationStructureMemoryInfoNV)(nil).AccelerationStructure)},
+		{"memory", unsafe.Offsetof((*BindAccelerationStructureMemoryInfoNV)(nil).Memory)},
+		{"memoryOffset", unsafe.Offsetof((*BindAccelerationStructureMemoryInfoNV)(nil).MemoryOffset)},
+		{"deviceIndexCount", unsafe.Offsetof((*BindAccelerationStructureMemoryInfoNV)(nil).DeviceIndexCount)},
+		{"pDeviceIndices", unsafe.Offsetof((*BindAccelerationStructureMemoryInfoNV)(nil).PDeviceIndices)},
+	}},
+	{"VkWriteDescriptorSetAccelerationStructureNV", unsafe.Sizeof(*(*WriteDescriptorSetAccelerationStructureNV)(nil)), unsafe.Alignof(*(*WriteDescriptorSetAccelerationStructureNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*WriteDescriptorSetAccelerationStructureNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*WriteDescriptorSetAccelerationStructureNV)(nil).PNext)},
+		{"accelerationStructureCount", unsafe.Offsetof((*WriteDescriptorSetAccelerationStructureNV)(nil).AccelerationStructureCount)},
+		{"pAccelerationStructures", unsafe.Offsetof((*WriteDescriptorSetAccelerationStructureNV)(nil).PAccelerationStructures)},
+	}},
+	{"VkAccelerationStructureMemoryRequirementsInfoNV", unsafe.Sizeof(*(*AccelerationStructureMemoryRequirementsInfoNV)(nil)), unsafe.Alignof(*(*AccelerationStructureMemoryRequirementsInfoNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*AccelerationStructureMemoryRequirementsInfoNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*AccelerationStructureMemoryRequirementsInfoNV)(nil).PNext)},
+		{"type", unsafe.Offsetof((*AccelerationStructureMemoryRequirementsInfoNV)(nil).Type)},
+		{"accelerationStructure", unsafe.Offsetof((*AccelerationStructureMemoryRequirementsInfoNV)(nil).AccelerationStructure)},
+	}},
+	{"VkPhysicalDeviceRayTracingPropertiesNV", unsafe.Sizeof(*(*PhysicalDeviceRayTracingPropertiesNV)(nil)), unsafe.Alignof(*(*PhysicalDeviceRayTracingPropertiesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceRayTracingPropertiesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceRayTracingPropertiesNV)(nil).PNext)},
+		{"shaderGroupHandleSize", unsafe.Offsetof((*PhysicalDeviceRayTracingPropertiesNV)(nil).ShaderGroupHandleSize)},
+		{"maxRecursionDepth", unsafe.Offsetof((*PhysicalDeviceRayTracingPropertiesNV)(nil).MaxRecursionDepth)},
+		{"maxShaderGroupStride", unsafe.Offsetof((*PhysicalDeviceRayTracingPropertiesNV)(nil).MaxShaderGroupStride)},
+		{"shaderGroupBaseAlignment", unsafe.Offsetof((*PhysicalDeviceRayTracingPropertiesNV)(nil).ShaderGroupBaseAlignment)},
+		{"maxGeometryCount", unsafe.Offsetof((*PhysicalDeviceRayTracingPropertiesNV)(nil).MaxGeometryCount)},
+		{"maxInstanceCount", unsafe.Offsetof((*PhysicalDeviceRayTracingPropertiesNV)(nil).MaxInstanceCount)},
+		{"maxTriangleCount", unsafe.Offsetof((*PhysicalDeviceRayTracingPropertiesNV)(nil).MaxTriangleCount)},
+		{"maxDescriptorSetAccelerationStructures", unsafe.Offsetof((*PhysicalDeviceRayTracingPropertiesNV)(nil).MaxDescriptorSetAccelerationStructures)},
+	}},
+	{"VkPhysicalDeviceRepresentativeFragmentTestFeaturesNV", unsafe.Sizeof(*(*PhysicalDeviceRepresentativeFragmentTestFeaturesNV)(nil)), unsafe.Alignof(*(*PhysicalDeviceRepresentativeFragmentTestFeaturesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceRepresentativeFragmentTestFeaturesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceRepresentativeFragmentTestFeaturesNV)(nil).PNext)},
+		{"representativeFragmentTest", unsafe.Offsetof((*PhysicalDeviceRepresentativeFragmentTestFeaturesNV)(nil).RepresentativeFragmentTest)},
+	}},
+	{"VkPipelineRepresentativeFragmentTestStateCreateInfoNV", unsafe.Sizeof(*(*PipelineRepresentativeFragmentTestStateCreateInfoNV)(nil)), unsafe.Alignof(*(*PipelineRepresentativeFragmentTestStateCreateInfoNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineRepresentativeFragmentTestStateCreateInfoNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineRepresentativeFragmentTestStateCreateInfoNV)(nil).PNext)},
+		{"representativeFragmentTestEnable", unsafe.Offsetof((*PipelineRepresentativeFragmentTestStateCreateInfoNV)(nil).RepresentativeFragmentTestEnable)},
+	}},
+	{"VkPhysicalDeviceImageViewImageFormatInfoEXT", unsafe.Sizeof(*(*PhysicalDeviceImageViewImageFormatInfoEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceImageViewImageFormatInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceImageViewImageFormatInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceImageViewImageFormatInfoEXT)(nil).PNext)},
+		{"imageViewType", unsafe.Offsetof((*PhysicalDeviceImageViewImageFormatInfoEXT)(nil).ImageViewType)},
+	}},
+	{"VkFilterCubicImageViewImageFormatPropertiesEXT", unsafe.Sizeof(*(*FilterCubicImageViewImageFormatPropertiesEXT)(nil)), unsafe.Alignof(*(*FilterCubicImageViewImageFormatPropertiesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*FilterCubicImageViewImageFormatPropertiesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*FilterCubicImageViewImageFormatPropertiesEXT)(nil).PNext)},
+		{"filterCubic", unsafe.Offsetof((*FilterCubicImageViewImageFormatPropertiesEXT)(nil).FilterCubic)},
+		{"filterCubicMinmax", unsafe.Offsetof((*FilterCubicImageViewImageFormatPropertiesEXT)(nil).FilterCubicMinmax)},
+	}},
+	{"VkImportMemoryHostPointerInfoEXT", unsafe.Sizeof(*(*ImportMemoryHostPointerInfoEXT)(nil)), unsafe.Alignof(*(*ImportMemoryHostPointerInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ImportMemoryHostPointerInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ImportMemoryHostPointerInfoEXT)(nil).PNext)},
+		{"handleType", unsafe.Offsetof((*ImportMemoryHostPointerInfoEXT)(nil).HandleType)},
+		{"pHostPointer", unsafe.Offsetof((*ImportMemoryHostPointerInfoEXT)(nil).PHostPointer)},
+	}},
+	{"VkMemoryHostPointerPropertiesEXT", unsafe.Sizeof(*(*MemoryHostPointerPropertiesEXT)(nil)), unsafe.Alignof(*(*MemoryHostPointerPropertiesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*MemoryHostPointerPropertiesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*MemoryHostPointerPropertiesEXT)(nil).PNext)},
+		{"memoryTypeBits", unsafe.Offsetof((*MemoryHostPointerPropertiesEXT)(nil).MemoryTypeBits)},
+	}},
+	{"VkPhysicalDeviceExternalMemoryHostPropertiesEXT", unsafe.Sizeof(*(*PhysicalDeviceExternalMemoryHostPropertiesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceExternalMemoryHostPropertiesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceExternalMemoryHostPropertiesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceExternalMemoryHostPropertiesEXT)(nil).PNext)},
+		{"minImportedHostPointerAlignment", unsafe.Offsetof((*PhysicalDeviceExternalMemoryHostPropertiesEXT)(nil).MinImportedHostPointerAlignment)},
+	}},
+	{"VkPipelineCompilerControlCreateInfoAMD", unsafe.Sizeof(*(*PipelineCompilerControlCreateInfoAMD)(nil)), unsafe.Alignof(*(*PipelineCompilerControlCreateInfoAMD)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineCompilerControlCreateInfoAMD)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineCompilerControlCreateInfoAMD)(nil).PNext)},
+		{"compilerControlFlags", unsafe.Offsetof((*PipelineCompilerControlCreateInfoAMD)(nil).CompilerControlFlags)},
+	}},
+	{"VkCalibratedTimestampInfoEXT", unsafe.Sizeof(*(*CalibratedTimestampInfoEXT)(nil)), unsafe.Alignof(*(*CalibratedTimestampInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*CalibratedTimestampInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*CalibratedTimestampInfoEXT)(nil).PNext)},
+		{"timeDomain", unsafe.Offsetof((*CalibratedTimestampInfoEXT)(nil).TimeDomain)},
+	}},
+	{"VkPhysicalDeviceShaderCorePropertiesAMD", unsafe.Sizeof(*(*PhysicalDeviceShaderCorePropertiesAMD)(nil)), unsafe.Alignof(*(*PhysicalDeviceShaderCorePropertiesAMD)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceShaderCorePropertiesAMD)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceShaderCorePropertiesAMD)(nil).PNext)},
+		{"shaderEngineCount", unsafe.Offsetof((*PhysicalDeviceShaderCorePropertiesAMD)(nil).ShaderEngineCount)},
+		{"shaderArraysPerEngineCount", unsafe.Offsetof((*PhysicalDeviceShaderCorePropertiesAMD)(nil).ShaderArraysPerEngineCount)},
+		{"computeUnitsPerShaderArray", unsafe.Offsetof((*PhysicalDeviceShaderCorePropertiesAMD)(nil).ComputeUnitsPerShaderArray)},
+		{"simdPerComputeUnit", unsafe.Offsetof((*PhysicalDeviceShaderCorePropertiesAMD)(nil).SimdPerComputeUnit)},
+		{"wavefrontsPerSimd", unsafe.Offsetof((*PhysicalDeviceShaderCorePropertiesAMD)(nil).WavefrontsPerSimd)},
+		{"wavefrontSize", unsafe.Offsetof((*PhysicalDeviceShaderCorePropertiesAMD)(nil).WavefrontSize)},
+		{"sgprsPerSimd", unsafe.Offsetof((*PhysicalDeviceShaderCorePropertiesAMD)(nil).SgprsPerSimd)},
+		{"minSgprAllocation", unsafe.Offsetof((*PhysicalDeviceShaderCorePropertiesAMD)(nil).MinSgprAllocation)},
+		{"maxSgprAllocation", unsafe.Offsetof((*PhysicalDeviceShaderCorePropertiesAMD)(nil).MaxSgprAllocation)},
+		{"sgprAllocationGranularity", unsafe.Offsetof((*PhysicalDeviceShaderCorePropertiesAMD)(nil).SgprAllocationGranularity)},
+		{"vgprsPerSimd", unsafe.Offsetof((*PhysicalDeviceShaderCorePropertiesAMD)(nil).VgprsPerSimd)},
+		{"minVgprAllocation", unsafe.Offsetof((*PhysicalDeviceShaderCorePropertiesAMD)(nil).MinVgprAllocation)},
+		{"maxVgprAllocation", unsafe.Offsetof((*PhysicalDeviceShaderCorePropertiesAMD)(nil).MaxVgprAllocation)},
+		{"vgprAllocationGranularity", unsafe.Offsetof((*PhysicalDeviceShaderCorePropertiesAMD)(nil).VgprAllocationGranularity)},
+	}},
+	{"VkDeviceMemoryOverallocationCreateInfoAMD", unsafe.Sizeof(*(*DeviceMemoryOverallocationCreateInfoAMD)(nil)), unsafe.Alignof(*(*DeviceMemoryOverallocationCreateInfoAMD)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DeviceMemoryOverallocationCreateInfoAMD)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DeviceMemoryOverallocationCreateInfoAMD)(nil).PNext)},
+		{"overallocationBehavior", unsafe.Offsetof((*DeviceMemoryOverallocationCreateInfoAMD)(nil).OverallocationBehavior)},
+	}},
+	{"VkPhysicalDeviceVertexAttributeDivisorPropertiesEXT", unsafe.Sizeof(*(*PhysicalDeviceVertexAttributeDivisorPropertiesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceVertexAttributeDivisorPropertiesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceVertexAttributeDivisorPropertiesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceVertexAttributeDivisorPropertiesEXT)(nil).PNext)},
+		{"maxVertexAttribDivisor", unsafe.Offsetof((*PhysicalDeviceVertexAttributeDivisorPropertiesEXT)(nil).MaxVertexAttribDivisor)},
+	}},
+	{"VkVertexInputBindingDivisorDescriptionEXT", unsafe.Sizeof(*(*VertexInputBindingDivisorDescriptionEXT)(nil)), unsafe.Alignof(*(*VertexInputBindingDivisorDescriptionEXT)(nil)), []memberOffset{
+		{"binding", unsafe.Offsetof((*VertexInputBindingDivisorDescriptionEXT)(nil).Binding)},
+		{"divisor", unsafe.Offsetof((*VertexInputBindingDivisorDescriptionEXT)(nil).Divisor)},
+	}},
+	{"VkPipelineVertexInputDivisorStateCreateInfoEXT", unsafe.Sizeof(*(*PipelineVertexInputDivisorStateCreateInfoEXT)(nil)), unsafe.Alignof(*(*PipelineVertexInputDivisorStateCreateInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineVertexInputDivisorStateCreateInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineVertexInputDivisorStateCreateInfoEXT)(nil).PNext)},
+		{"vertexBindingDivisorCount", unsafe.Offsetof((*PipelineVertexInputDivisorStateCreateInfoEXT)(nil).VertexBindingDivisorCount)},
+		{"pVertexBindingDivisors", unsafe.Offsetof((*PipelineVertexInputDivisorStateCreateInfoEXT)(nil).PVertexBindingDivisors)},
+	}},
+	{"VkPhysicalDeviceVertexAttributeDivisorFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceVertexAttributeDivisorFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceVertexAttributeDivisorFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceVertexAttributeDivisorFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceVertexAttributeDivisorFeaturesEXT)(nil).PNext)},
+		{"vertexAttributeInstanceRateDivisor", unsafe.Offsetof((*PhysicalDeviceVertexAttributeDivisorFeaturesEXT)(nil).VertexAttributeInstanceRateDivisor)},
+		{"vertexAttributeInstanceRateZeroDivisor", unsafe.Offsetof((*PhysicalDeviceVertexAttributeDivisorFeaturesEXT)(nil).VertexAttributeInstanceRateZeroDivisor)},
+	}},
+	{"VkPhysicalDeviceComputeShaderDerivativesFeaturesNV", unsafe.Sizeof(*(*PhysicalDeviceComputeShaderDerivativesFeaturesNV)(nil)), unsafe.Alignof(*(*PhysicalDeviceComputeShaderDerivativesFeaturesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceComputeShaderDerivativesFeaturesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceComputeShaderDerivativesFeaturesNV)(nil).PNext)},
+		{"computeDerivativeGroupQuads", unsafe.Offsetof((*PhysicalDeviceComputeShaderDerivativesFeaturesNV)(nil).ComputeDerivativeGroupQuads)},
+		{"computeDerivativeGroupLinear", unsafe.Offsetof((*PhysicalDeviceComputeShaderDerivativesFeaturesNV)(nil).ComputeDerivativeGroupLinear)},
+	}},
+	{"VkPhysicalDeviceMeshShaderFeaturesNV", unsafe.Sizeof(*(*PhysicalDeviceMeshShaderFeaturesNV)(nil)), unsafe.Alignof(*(*PhysicalDeviceMeshShaderFeaturesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceMeshShaderFeaturesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceMeshShaderFeaturesNV)(nil).PNext)},
+		{"taskShader", unsafe.Offsetof((*PhysicalDeviceMeshShaderFeaturesNV)(nil).TaskShader)},
+		{"meshShader", unsafe.Offsetof((*PhysicalDeviceMeshShaderFeaturesNV)(nil).MeshShader)},
+	}},
+	{"VkPhysicalDeviceMeshShaderPropertiesNV", unsafe.Sizeof(*(*PhysicalDeviceMeshShaderPropertiesNV)(nil)), unsafe.Alignof(*(*PhysicalDeviceMeshShaderPropertiesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesNV)(nil).PNext)},
+		{"maxDrawMeshTasksCount", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesNV)(nil).MaxDrawMeshTasksCount)},
+		{"maxTaskWorkGroupInvocations", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesNV)(nil).MaxTaskWorkGroupInvocations)},
+		{"maxTaskWorkGroupSize", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesNV)(nil).MaxTaskWorkGroupSize)},
+		{"maxTaskTotalMemorySize", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesNV)(nil).MaxTaskTotalMemorySize)},
+		{"maxTaskOutputCount", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesNV)(nil).MaxTaskOutputCount)},
+		{"maxMeshWorkGroupInvocations", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesNV)(nil).MaxMeshWorkGroupInvocations)},
+		{"maxMeshWorkGroupSize", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesNV)(nil).MaxMeshWorkGroupSize)},
+		{"maxMeshTotalMemorySize", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesNV)(nil).MaxMeshTotalMemorySize)},
+		{"maxMeshOutputVertices", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesNV)(nil).MaxMeshOutputVertices)},
+		{"maxMeshOutputPrimitives", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesNV)(nil).MaxMeshOutputPrimitives)},
+		{"maxMeshMultiviewViewCount", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesNV)(nil).MaxMeshMultiviewViewCount)},
+		{"meshOutputPerVertexGranularity", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesNV)(nil).MeshOutputPerVertexGranularity)},
+		{"meshOutputPerPrimitiveGranularity", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesNV)(nil).MeshOutputPerPrimitiveGranularity)},
+	}},
+	{"VkDrawMeshTasksIndirectCommandNV", unsafe.Sizeof(*(*DrawMeshTasksIndirectCommandNV)(nil)), unsafe.Alignof(*(*DrawMeshTasksIndirectCommandNV)(nil)), []memberOffset{
+		{"taskCount", unsafe.Offsetof((*DrawMeshTasksIndirectCommandNV)(nil).TaskCount)},
+		{"firstTask", unsafe.Offsetof((*DrawMeshTasksIndirectCommandNV)(nil).FirstTask)},
+	}},
+	{"VkPhysicalDeviceShaderImageFootprintFeaturesNV", unsafe.Sizeof(*(*PhysicalDeviceShaderImageFootprintFeaturesNV)(nil)), unsafe.Alignof(*(*PhysicalDeviceShaderImageFootprintFeaturesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceShaderImageFootprintFeaturesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceShaderImageFootprintFeaturesNV)(nil).PNext)},
+		{"imageFootprint", unsafe.Offsetof((*PhysicalDeviceShaderImageFootprintFeaturesNV)(nil).ImageFootprint)},
+	}},
+	{"VkPipelineViewportExclusiveScissorStateCreateInfoNV", unsafe.Sizeof(*(*PipelineViewportExclusiveScissorStateCreateInfoNV)(nil)), unsafe.Alignof(*(*PipelineViewportExclusiveScissorStateCreateInfoNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineViewportExclusiveScissorStateCreateInfoNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineViewportExclusiveScissorStateCreateInfoNV)(nil).PNext)},
+		{"exclusiveScissorCount", unsafe.Offsetof((*PipelineViewportExclusiveScissorStateCreateInfoNV)(nil).ExclusiveScissorCount)},
+		{"pExclusiveScissors", unsafe.Offsetof((*PipelineViewportExclusiveScissorStateCreateInfoNV)(nil).PExclusiveScissors)},
+	}},
+	{"VkPhysicalDeviceExclusiveScissorFeaturesNV", unsafe.Sizeof(*(*PhysicalDeviceExclusiveScissorFeaturesNV)(nil)), unsafe.Alignof(*(*PhysicalDeviceExclusiveScissorFeaturesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceExclusiveScissorFeaturesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceExclusiveScissorFeaturesNV)(nil).PNext)},
+		{"exclusiveScissor", unsafe.Offsetof((*PhysicalDeviceExclusiveScissorFeaturesNV)(nil).ExclusiveScissor)},
+	}},
+	{"VkQueueFamilyCheckpointPropertiesNV", unsafe.Sizeof(*(*QueueFamilyCheckpointPropertiesNV)(nil)), unsafe.Alignof(*(*QueueFamilyCheckpointPropertiesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*QueueFamilyCheckpointPropertiesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*QueueFamilyCheckpointPropertiesNV)(nil).PNext)},
+		{"checkpointExecutionStageMask", unsafe.Offsetof((*QueueFamilyCheckpointPropertiesNV)(nil).CheckpointExecutionStageMask)},
+	}},
+	{"VkCheckpointDataNV", unsafe.Sizeof(*(*CheckpointDataNV)(nil)), unsafe.Alignof(*(*CheckpointDataNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*CheckpointDataNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*CheckpointDataNV)(nil).PNext)},
+		{"stage", unsafe.Offsetof((*CheckpointDataNV)(nil).Stage)},
+		{"pCheckpointMarker", unsafe.Offsetof((*CheckpointDataNV)(nil).PCheckpointMarker)},
+	}},
+	{"VkPhysicalDeviceShaderIntegerFunctions2FeaturesINTEL", unsafe.Sizeof(*(*PhysicalDeviceShaderIntegerFunctions2FeaturesINTEL)(nil)), unsafe.Alignof(*(*PhysicalDeviceShaderIntegerFunctions2FeaturesINTEL)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceShaderIntegerFunctions2FeaturesINTEL)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceShaderIntegerFunctions2FeaturesINTEL)(nil).PNext)},
+		{"shaderIntegerFunctions2", unsafe.Offsetof((*PhysicalDeviceShaderIntegerFunctions2FeaturesINTEL)(nil).ShaderIntegerFunctions2)},
+	}},
+	{"VkPerformanceValueDataINTEL", unsafe.Sizeof(*(*PerformanceValueDataINTEL)(nil)), unsafe.Alignof(*(*PerformanceValueDataINTEL)(nil)), nil},
+	{"VkPerformanceValueINTEL", unsafe.Sizeof(*(*PerformanceValueINTEL)(nil)), unsafe.Alignof(*(*PerformanceValueINTEL)(nil)), []memberOffset{
+		{"type", unsafe.Offsetof((*PerformanceValueINTEL)(nil).Type)},
+		{"data", unsafe.Offsetof((*PerformanceValueINTEL)(nil).Data)},
+	}},
+	{"VkInitializePerformanceApiInfoINTEL", unsafe.Sizeof(*(*InitializePerformanceApiInfoINTEL)(nil)), unsafe.Alignof(*(*InitializePerformanceApiInfoINTEL)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*InitializePerformanceApiInfoINTEL)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*InitializePerformanceApiInfoINTEL)(nil).PNext)},
+		{"pUserData", unsafe.Offsetof((*InitializePerformanceApiInfoINTEL)(nil).PUserData)},
+	}},
+	{"VkQueryPoolPerformanceQueryCreateInfoINTEL", unsafe.Sizeof(*(*QueryPoolPerformanceQueryCreateInfoINTEL)(nil)), unsafe.Alignof(*(*QueryPoolPerformanceQueryCreateInfoINTEL)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*QueryPoolPerformanceQueryCreateInfoINTEL)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*QueryPoolPerformanceQueryCreateInfoINTEL)(nil).PNext)},
+		{"performanceCountersSampling", unsafe.Offsetof((*QueryPoolPerformanceQueryCreateInfoINTEL)(nil).PerformanceCountersSampling)},
+	}},
+	{"VkPerformanceMarkerInfoINTEL", unsafe.Sizeof(*(*PerformanceMarkerInfoINTEL)(nil)), unsafe.Alignof(*(*PerformanceMarkerInfoINTEL)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PerformanceMarkerInfoINTEL)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PerformanceMarkerInfoINTEL)(nil).PNext)},
+		{"marker", unsafe.Offsetof((*PerformanceMarkerInfoINTEL)(nil).Marker)},
+	}},
+	{"VkPerformanceStreamMarkerInfoINTEL", unsafe.Sizeof(*(*PerformanceStreamMarkerInfoINTEL)(nil)), unsafe.Alignof(*(*PerformanceStreamMarkerInfoINTEL)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PerformanceStreamMarkerInfoINTEL)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PerformanceStreamMarkerInfoINTEL)(nil).PNext)},
+		{"marker", unsafe.Offsetof((*PerformanceStreamMarkerInfoINTEL)(nil).Marker)},
+	}},
+	{"VkPerformanceOverrideInfoINTEL", unsafe.Sizeof(*(*PerformanceOverrideInfoINTEL)(nil)), unsafe.Alignof(*(*PerformanceOverrideInfoINTEL)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PerformanceOverrideInfoINTEL)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PerformanceOverrideInfoINTEL)(nil).PNext)},
+		{"type", unsafe.Offsetof((*PerformanceOverrideInfoINTEL)(nil).Type)},
+		{"enable", unsafe.Offsetof((*PerformanceOverrideInfoINTEL)(nil).Enable)},
+		{"parameter", unsafe.Offsetof((*PerformanceOverrideInfoINTEL)(nil).Parameter)},
+	}},
+	{"VkPerformanceConfigurationAcquireInfoINTEL", unsafe.Sizeof(*(*PerformanceConfigurationAcquireInfoINTEL)(nil)), unsafe.Alignof(*(*PerformanceConfigurationAcquireInfoINTEL)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PerformanceConfigurationAcquireInfoINTEL)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PerformanceConfigurationAcquireInfoINTEL)(nil).PNext)},
+		{"type", unsafe.Offsetof((*PerformanceConfigurationAcquireInfoINTEL)(nil).Type)},
+	}},
+	{"VkPhysicalDevicePCIBusInfoPropertiesEXT", unsafe.Sizeof(*(*PhysicalDevicePCIBusInfoPropertiesEXT)(nil)), unsafe.Alignof(*(*PhysicalDevicePCIBusInfoPropertiesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDevicePCIBusInfoPropertiesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDevicePCIBusInfoPropertiesEXT)(nil).PNext)},
+		{"pciDomain", unsafe.Offsetof((*PhysicalDevicePCIBusInfoPropertiesEXT)(nil).PciDomain)},
+		{"pciBus", unsafe.Offsetof((*PhysicalDevicePCIBusInfoPropertiesEXT)(nil).PciBus)},
+		{"pciDevice", unsafe.Offsetof((*PhysicalDevicePCIBusInfoPropertiesEXT)(nil).PciDevice)},
+		{"pciFunction", unsafe.Offsetof((*PhysicalDevicePCIBusInfoPropertiesEXT)(nil).PciFunction)},
+	}},
+	{"VkDisplayNativeHdrSurfaceCapabilitiesAMD", unsafe.Sizeof(*(*DisplayNativeHdrSurfaceCapabilitiesAMD)(nil)), unsafe.Alignof(*(*DisplayNativeHdrSurfaceCapabilitiesAMD)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DisplayNativeHdrSurfaceCapabilitiesAMD)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DisplayNativeHdrSurfaceCapabilitiesAMD)(nil).PNext)},
+		{"localDimmingSupport", unsafe.Offsetof((*DisplayNativeHdrSurfaceCapabilitiesAMD)(nil).LocalDimmingSupport)},
+	}},
+	{"VkSwapchainDisplayNativeHdrCreateInfoAMD", unsafe.Sizeof(*(*SwapchainDisplayNativeHdrCreateInfoAMD)(nil)), unsafe.Alignof(*(*SwapchainDisplayNativeHdrCreateInfoAMD)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SwapchainDisplayNativeHdrCreateInfoAMD)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SwapchainDisplayNativeHdrCreateInfoAMD)(nil).PNext)},
+		{"localDimmingEnable", unsafe.Offsetof((*SwapchainDisplayNativeHdrCreateInfoAMD)(nil).LocalDimmingEnable)},
+	}},
+	{"VkPhysicalDeviceFragmentDensityMapFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceFragmentDensityMapFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceFragmentDensityMapFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceFragmentDensityMapFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceFragmentDensityMapFeaturesEXT)(nil).PNext)},
+		{"fragmentDensityMap", unsafe.Offsetof((*PhysicalDeviceFragmentDensityMapFeaturesEXT)(nil).FragmentDensityMap)},
+		{"fragmentDensityMapDynamic", unsafe.Offsetof((*PhysicalDeviceFragmentDensityMapFeaturesEXT)(nil).FragmentDensityMapDynamic)},
+		{"fragmentDensityMapNonSubsampledImages", unsafe.Offsetof((*PhysicalDeviceFragmentDensityMapFeaturesEXT)(nil).FragmentDensityMapNonSubsampledImages)},
+	}},
+	{"VkPhysicalDeviceFragmentDensityMapPropertiesEXT", unsafe.Sizeof(*(*PhysicalDeviceFragmentDensityMapPropertiesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceFragmentDensityMapPropertiesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceFragmentDensityMapPropertiesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceFragmentDensityMapPropertiesEXT)(nil).PNext)},
+		{"minFragmentDensityTexelSize", unsafe.Offsetof((*PhysicalDeviceFragmentDensityMapPropertiesEXT)(nil).MinFragmentDensityTexelSize)},
+		{"maxFragmentDensityTexelSize", unsafe.Offsetof((*PhysicalDeviceFragmentDensityMapPropertiesEXT)(nil).MaxFragmentDensityTexelSize)},
+		{"fragmentDensityInvocations", unsafe.Offsetof((*PhysicalDeviceFragmentDensityMapPropertiesEXT)(nil).FragmentDensityInvocations)},
+	}},
+	{"VkRenderPassFragmentDensityMapCreateInfoEXT", unsafe.Sizeof(*(*RenderPassFragmentDensityMapCreateInfoEXT)(nil)), unsafe.Alignof(*(*RenderPassFragmentDensityMapCreateInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*RenderPassFragmentDensityMapCreateInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*RenderPassFragmentDensityMapCreateInfoEXT)(nil).PNext)},
+		{"fragmentDensityMapAttachment", unsafe.Offsetof((*RenderPassFragmentDensityMapCreateInfoEXT)(nil).FragmentDensityMapAttachment)},
+	}},
+	{"VkPhysicalDeviceShaderCoreProperties2AMD", unsafe.Sizeof(*(*PhysicalDeviceShaderCoreProperties2AMD)(nil)), unsafe.Alignof(*(*PhysicalDeviceShaderCoreProperties2AMD)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceShaderCoreProperties2AMD)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceShaderCoreProperties2AMD)(nil).PNext)},
+		{"shaderCoreFeatures", unsafe.Offsetof((*PhysicalDeviceShaderCoreProperties2AMD)(nil).ShaderCoreFeatures)},
+		{"activeComputeUnitCount", unsafe.Offsetof((*PhysicalDeviceShaderCoreProperties2AMD)(nil).ActiveComputeUnitCount)},
+	}},
+	{"VkPhysicalDeviceCoherentMemoryFeaturesAMD", unsafe.Sizeof(*(*PhysicalDeviceCoherentMemoryFeaturesAMD)(nil)), unsafe.Alignof(*(*PhysicalDeviceCoherentMemoryFeaturesAMD)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceCoherentMemoryFeaturesAMD)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceCoherentMemoryFeaturesAMD)(nil).PNext)},
+		{"deviceCoherentMemory", unsafe.Offsetof((*PhysicalDeviceCoherentMemoryFeaturesAMD)(nil).DeviceCoherentMemory)},
+	}},
+	{"VkPhysicalDeviceShaderImageAtomicInt64FeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceShaderImageAtomicInt64FeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceShaderImageAtomicInt64FeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceShaderImageAtomicInt64FeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceShaderImageAtomicInt64FeaturesEXT)(nil).PNext)},
+		{"shaderImageInt64Atomics", unsafe.Offsetof((*PhysicalDeviceShaderImageAtomicInt64FeaturesEXT)(nil).ShaderImageInt64Atomics)},
+		{"sparseImageInt64Atomics", unsafe.Offsetof((*PhysicalDeviceShaderImageAtomicInt64FeaturesEXT)(nil).SparseImageInt64Atomics)},
+	}},
+	{"VkPhysicalDeviceMemoryBudgetPropertiesEXT", unsafe.Sizeof(*(*PhysicalDeviceMemoryBudgetPropertiesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceMemoryBudgetPropertiesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceMemoryBudgetPropertiesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceMemoryBudgetPropertiesEXT)(nil).PNext)},
+		{"heapBudget", unsafe.Offsetof((*PhysicalDeviceMemoryBudgetPropertiesEXT)(nil).HeapBudget)},
+		{"heapUsage", unsafe.Offsetof((*PhysicalDeviceMemoryBudgetPropertiesEXT)(nil).HeapUsage)},
+	}},
+	{"VkPhysicalDeviceMemoryPriorityFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceMemoryPriorityFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceMemoryPriorityFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceMemoryPriorityFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceMemoryPriorityFeaturesEXT)(nil).PNext)},
+		{"memoryPriority", unsafe.Offsetof((*PhysicalDeviceMemoryPriorityFeaturesEXT)(nil).MemoryPriority)},
+	}},
+	{"VkMemoryPriorityAllocateInfoEXT", unsafe.Sizeof(*(*MemoryPriorityAllocateInfoEXT)(nil)), unsafe.Alignof(*(*MemoryPriorityAllocateInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*MemoryPriorityAllocateInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*MemoryPriorityAllocateInfoEXT)(nil).PNext)},
+		{"priority", unsafe.Offsetof((*MemoryPriorityAllocateInfoEXT)(nil).Priority)},
+	}},
+	{"VkPhysicalDeviceDedicatedAllocationImageAliasingFeaturesNV", unsafe.Sizeof(*(*PhysicalDeviceDedicatedAllocationImageAliasingFeaturesNV)(nil)), unsafe.Alignof(*(*PhysicalDeviceDedicatedAllocationImageAliasingFeaturesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceDedicatedAllocationImageAliasingFeaturesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceDedicatedAllocationImageAliasingFeaturesNV)(nil).PNext)},
+		{"dedicatedAllocationImageAliasing", unsafe.Offsetof((*PhysicalDeviceDedicatedAllocationImageAliasingFeaturesNV)(nil).DedicatedAllocationImageAliasing)},
+	}},
+	{"VkPhysicalDeviceBufferDeviceAddressFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceBufferDeviceAddressFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceBufferDeviceAddressFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceBufferDeviceAddressFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceBufferDeviceAddressFeaturesEXT)(nil).PNext)},
+		{"bufferDeviceAddress", unsafe.Offsetof((*PhysicalDeviceBufferDeviceAddressFeaturesEXT)(nil).BufferDeviceAddress)},
+		{"bufferDeviceAddressCaptureReplay", unsafe.Offsetof((*PhysicalDeviceBufferDeviceAddressFeaturesEXT)(nil).BufferDeviceAddressCaptureReplay)},
+		{"bufferDeviceAddressMultiDevice", unsafe.Offsetof((*PhysicalDeviceBufferDeviceAddressFeaturesEXT)(nil).BufferDeviceAddressMultiDevice)},
+	}},
+	{"VkBufferDeviceAddressCreateInfoEXT", unsafe.Sizeof(*(*BufferDeviceAddressCreateInfoEXT)(nil)), unsafe.Alignof(*(*BufferDeviceAddressCreateInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*BufferDeviceAddressCreateInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*BufferDeviceAddressCreateInfoEXT)(nil).PNext)},
+		{"deviceAddress", unsafe.Offsetof((*BufferDeviceAddressCreateInfoEXT)(nil).DeviceAddress)},
+	}},
+	{"VkValidationFeaturesEXT", unsafe.Sizeof(*(*ValidationFeaturesEXT)(nil)), unsafe.Alignof(*(*ValidationFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ValidationFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ValidationFeaturesEXT)(nil).PNext)},
+		{"enabledValidationFeatureCount", unsafe.Offsetof((*ValidationFeaturesEXT)(nil).EnabledValidationFeatureCount)},
+		{"pEnabledValidationFeatures", unsafe.Offsetof((*ValidationFeaturesEXT)(nil).PEnabledValidationFeatures)},
+		{"disabledValidationFeatureCount", unsafe.Offsetof((*ValidationFeaturesEXT)(nil).DisabledValidationFeatureCount)},
+		{"pDisabledValidationFeatures", unsafe.Offsetof((*ValidationFeaturesEXT)(nil).PDisabledValidationFeatures)},
+	}},
+	{"VkCooperativeMatrixPropertiesNV", unsafe.Sizeof(*(*CooperativeMatrixPropertiesNV)(nil)), unsafe.Alignof(*(*CooperativeMatrixPropertiesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*CooperativeMatrixPropertiesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*CooperativeMatrixPropertiesNV)(nil).PNext)},
+		{"MSize", unsafe.Offsetof((*CooperativeMatrixPropertiesNV)(nil).MSize)},
+		{"NSize", unsafe.Offsetof((*CooperativeMatrixPropertiesNV)(nil).NSize)},
+		{"KSize", unsafe.Offsetof((*CooperativeMatrixPropertiesNV)(nil).KSize)},
+		{"AType", unsafe.Offsetof((*CooperativeMatrixPropertiesNV)(nil).AType)},
+		{"BType", unsafe.Offsetof((*CooperativeMatrixPropertiesNV)(nil).BType)},
+		{"CType", unsafe.Offsetof((*CooperativeMatrixPropertiesNV)(nil).CType)},
+		{"DType", unsafe.Offsetof((*CooperativeMatrixPropertiesNV)(nil).DType)},
+		{"scope", unsafe.Offsetof((*CooperativeMatrixPropertiesNV)(nil).Scope)},
+	}},
+	{"VkPhysicalDeviceCooperativeMatrixFeaturesNV", unsafe.Sizeof(*(*PhysicalDeviceCooperativeMatrixFeaturesNV)(nil)), unsafe.Alignof(*(*PhysicalDeviceCooperativeMatrixFeaturesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceCooperativeMatrixFeaturesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceCooperativeMatrixFeaturesNV)(nil).PNext)},
+		{"cooperativeMatrix", unsafe.Offsetof((*PhysicalDeviceCooperativeMatrixFeaturesNV)(nil).CooperativeMatrix)},
+		{"cooperativeMatrixRobustBufferAccess", unsafe.Offsetof((*PhysicalDeviceCooperativeMatrixFeaturesNV)(nil).CooperativeMatrixRobustBufferAccess)},
+	}},
+	{"VkPhysicalDeviceCooperativeMatrixPropertiesNV", unsafe.Sizeof(*(*PhysicalDeviceCooperativeMatrixPropertiesNV)(nil)), unsafe.Alignof(*(*PhysicalDeviceCooperativeMatrixPropertiesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceCooperativeMatrixPropertiesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceCooperativeMatrixPropertiesNV)(nil).PNext)},
+		{"cooperativeMatrixSupportedStages", unsafe.Offsetof((*PhysicalDeviceCooperativeMatrixPropertiesNV)(nil).CooperativeMatrixSupportedStages)},
+	}},
+	{"VkPhysicalDeviceCoverageReductionModeFeaturesNV", unsafe.Sizeof(*(*PhysicalDeviceCoverageReductionModeFeaturesNV)(nil)), unsafe.Alignof(*(*PhysicalDeviceCoverageReductionModeFeaturesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceCoverageReductionModeFeaturesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceCoverageReductionModeFeaturesNV)(nil).PNext)},
+		{"coverageReductionMode", unsafe.Offsetof((*PhysicalDeviceCoverageReductionModeFeaturesNV)(nil).CoverageReductionMode)},
+	}},
+	{"VkPipelineCoverageReductionStateCreateInfoNV", unsafe.Sizeof(*(*PipelineCoverageReductionStateCreateInfoNV)(nil)), unsafe.Alignof(*(*PipelineCoverageReductionStateCreateInfoNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineCoverageReductionStateCreateInfoNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineCoverageReductionStateCreateInfoNV)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*PipelineCoverageReductionStateCreateInfoNV)(nil).Flags)},
+		{"coverageReductionMode", unsafe.Offsetof((*PipelineCoverageReductionStateCreateInfoNV)(nil).CoverageReductionMode)},
+	}},
+	{"VkFramebufferMixedSamplesCombinationNV", unsafe.Sizeof(*(*FramebufferMixedSamplesCombinationNV)(nil)), unsafe.Alignof(*(*FramebufferMixedSamplesCombinationNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*FramebufferMixedSamplesCombinationNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*FramebufferMixedSamplesCombinationNV)(nil).PNext)},
+		{"coverageReductionMode", unsafe.Offsetof((*FramebufferMixedSamplesCombinationNV)(nil).CoverageReductionMode)},
+		{"rasterizationSamples", unsafe.Offsetof((*FramebufferMixedSamplesCombinationNV)(nil).RasterizationSamples)},
+		{"depthStencilSamples", unsafe.Offsetof((*FramebufferMixedSamplesCombinationNV)(nil).DepthStencilSamples)},
+		{"colorSamples", unsafe.Offsetof((*FramebufferMixedSamplesCombinationNV)(nil).ColorSamples)},
+	}},
+	{"VkPhysicalDeviceFragmentShaderInterlockFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceFragmentShaderInterlockFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceFragmentShaderInterlockFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceFragmentShaderInterlockFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceFragmentShaderInterlockFeaturesEXT)(nil).PNext)},
+		{"fragmentShaderSampleInterlock", unsafe.Offsetof((*PhysicalDeviceFragmentShaderInterlockFeaturesEXT)(nil).FragmentShaderSampleInterlock)},
+		{"fragmentShaderPixelInterlock", unsafe.Offsetof((*PhysicalDeviceFragmentShaderInterlockFeaturesEXT)(nil).FragmentShaderPixelInterlock)},
+		{"fragmentShaderShadingRateInterlock", unsafe.Offsetof((*PhysicalDeviceFragmentShaderInterlockFeaturesEXT)(nil).FragmentShaderShadingRateInterlock)},
+	}},
+	{"VkPhysicalDeviceYcbcrImageArraysFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceYcbcrImageArraysFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceYcbcrImageArraysFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceYcbcrImageArraysFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceYcbcrImageArraysFeaturesEXT)(nil).PNext)},
+		{"ycbcrImageArrays", unsafe.Offsetof((*PhysicalDeviceYcbcrImageArraysFeaturesEXT)(nil).YcbcrImageArrays)},
+	}},
+	{"VkPhysicalDeviceProvokingVertexFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceProvokingVertexFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceProvokingVertexFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceProvokingVertexFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceProvokingVertexFeaturesEXT)(nil).PNext)},
+		{"provokingVertexLast", unsafe.Offsetof((*PhysicalDeviceProvokingVertexFeaturesEXT)(nil).ProvokingVertexLast)},
+		{"transformFeedbackPreservesProvokingVertex", unsafe.Offsetof((*PhysicalDeviceProvokingVertexFeaturesEXT)(nil).TransformFeedbackPreservesProvokingVertex)},
+	}},
+	{"VkPhysicalDeviceProvokingVertexPropertiesEXT", unsafe.Sizeof(*(*PhysicalDeviceProvokingVertexPropertiesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceProvokingVertexPropertiesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceProvokingVertexPropertiesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceProvokingVertexPropertiesEXT)(nil).PNext)},
+		{"provokingVertexModePerPipeline", unsafe.Offsetof((*PhysicalDeviceProvokingVertexPropertiesEXT)(nil).ProvokingVertexModePerPipeline)},
+		{"transformFeedbackPreservesTriangleFanProvokingVertex", unsafe.Offsetof((*PhysicalDeviceProvokingVertexPropertiesEXT)(nil).TransformFeedbackPreservesTriangleFanProvokingVertex)},
+	}},
+	{"VkPipelineRasterizationProvokingVertexStateCreateInfoEXT", unsafe.Sizeof(*(*PipelineRasterizationProvokingVertexStateCreateInfoEXT)(nil)), unsafe.Alignof(*(*PipelineRasterizationProvokingVertexStateCreateInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineRasterizationProvokingVertexStateCreateInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineRasterizationProvokingVertexStateCreateInfoEXT)(nil).PNext)},
+		{"provokingVertexMode", unsafe.Offsetof((*PipelineRasterizationProvokingVertexStateCreateInfoEXT)(nil).ProvokingVertexMode)},
+	}},
+	{"VkHeadlessSurfaceCreateInfoEXT", unsafe.Sizeof(*(*HeadlessSurfaceCreateInfoEXT)(nil)), unsafe.Alignof(*(*HeadlessSurfaceCreateInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*HeadlessSurfaceCreateInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*HeadlessSurfaceCreateInfoEXT)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*HeadlessSurfaceCreateInfoEXT)(nil).Flags)},
+	}},
+	{"VkPhysicalDeviceLineRasterizationFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceLineRasterizationFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceLineRasterizationFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceLineRasterizationFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceLineRasterizationFeaturesEXT)(nil).PNext)},
+		{"rectangularLines", unsafe.Offsetof((*PhysicalDeviceLineRasterizationFeaturesEXT)(nil).RectangularLines)},
+		{"bresenhamLines", unsafe.Offsetof((*PhysicalDeviceLineRasterizationFeaturesEXT)(nil).BresenhamLines)},
+		{"smoothLines", unsafe.Offsetof((*PhysicalDeviceLineRasterizationFeaturesEXT)(nil).SmoothLines)},
+		{"stippledRectangularLines", unsafe.Offsetof((*PhysicalDeviceLineRasterizationFeaturesEXT)(nil).StippledRectangularLines)},
+		{"stippledBresenhamLines", unsafe.Offsetof((*PhysicalDeviceLineRasterizationFeaturesEXT)(nil).StippledBresenhamLines)},
+		{"stippledSmoothLines", unsafe.Offsetof((*PhysicalDeviceLineRasterizationFeaturesEXT)(nil).StippledSmoothLines)},
+	}},
+	{"VkPhysicalDeviceLineRasterizationPropertiesEXT", unsafe.Sizeof(*(*PhysicalDeviceLineRasterizationPropertiesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceLineRasterizationPropertiesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceLineRasterizationPropertiesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceLineRasterizationPropertiesEXT)(nil).PNext)},
+		{"lineSubPixelPrecisionBits", unsafe.Offsetof((*PhysicalDeviceLineRasterizationPropertiesEXT)(nil).LineSubPixelPrecisionBits)},
+	}},
+	{"VkPipelineRasterizationLineStateCreateInfoEXT", unsafe.Sizeof(*(*PipelineRasterizationLineStateCreateInfoEXT)(nil)), unsafe.Alignof(*(*PipelineRasterizationLineStateCreateInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineRasterizationLineStateCreateInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineRasterizationLineStateCreateInfoEXT)(nil).PNext)},
+		{"lineRasterizationMode", unsafe.Offsetof((*PipelineRasterizationLineStateCreateInfoEXT)(nil).LineRasterizationMode)},
+		{"stippledLineEnable", unsafe.Offsetof((*PipelineRasterizationLineStateCreateInfoEXT)(nil).StippledLineEnable)},
+		{"lineStippleFactor", unsafe.Offsetof((*PipelineRasterizationLineStateCreateInfoEXT)(nil).LineStippleFactor)},
+		{"lineStipplePattern", unsafe.Offsetof((*PipelineRasterizationLineStateCreateInfoEXT)(nil).LineStipplePattern)},
+	}},
+	{"VkPhysicalDeviceShaderAtomicFloatFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceShaderAtomicFloatFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceShaderAtomicFloatFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceShaderAtomicFloatFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceShaderAtomicFloatFeaturesEXT)(nil).PNext)},
+		{"shaderBufferFloat32Atomics", unsafe.Offsetof((*PhysicalDeviceShaderAtomicFloatFeaturesEXT)(nil).ShaderBufferFloat32Atomics)},
+		{"shaderBufferFloat32AtomicAdd", unsafe.Offsetof((*PhysicalDeviceShaderAtomicFloatFeaturesEXT)(nil).ShaderBufferFloat32AtomicAdd)},
+		{"shaderBufferFloat64Atomics", unsafe.Offsetof((*PhysicalDeviceShaderAtomicFloatFeaturesEXT)(nil).ShaderBufferFloat64Atomics)},
+		{"shaderBufferFloat64AtomicAdd", unsafe.Offsetof((*PhysicalDeviceShaderAtomicFloatFeaturesEXT)(nil).ShaderBufferFloat64AtomicAdd)},
+		{"shaderSharedFloat32Atomics", unsafe.Offsetof((*PhysicalDeviceShaderAtomicFloatFeaturesEXT)(nil).ShaderSharedFloat32Atomics)},
+		{"shaderSharedFloat32AtomicAdd", unsafe.Offsetof((*PhysicalDeviceShaderAtomicFloatFeaturesEXT)(nil).ShaderSharedFloat32AtomicAdd)},
+		{"shaderSharedFloat64Atomics", unsafe.Offsetof((*PhysicalDeviceShaderAtomicFloatFeaturesEXT)(nil).ShaderSharedFloat64Atomics)},
+		{"shaderSharedFloat64AtomicAdd", unsafe.Offsetof((*PhysicalDeviceShaderAtomicFloatFeaturesEXT)(nil).ShaderSharedFloat64AtomicAdd)},
+		{"shaderImageFloat32Atomics", unsafe.Offsetof((*PhysicalDeviceShaderAtomicFloatFeaturesEXT)(nil).ShaderImageFloat32Atomics)},
+		{"shaderImageFloat32AtomicAdd", unsafe.Offsetof((*PhysicalDeviceShaderAtomicFloatFeaturesEXT)(nil).ShaderImageFloat32AtomicAdd)},
+		{"sparseImageFloat32Atomics", unsafe.Offsetof((*PhysicalDeviceShaderAtomicFloatFeaturesEXT)(nil).SparseImageFloat32Atomics)},
+		{"sparseImageFloat32AtomicAdd", unsafe.Offsetof((*PhysicalDeviceShaderAtomicFloatFeaturesEXT)(nil).SparseImageFloat32AtomicAdd)},
+	}},
+	{"VkPhysicalDeviceIndexTypeUint8FeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceIndexTypeUint8FeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceIndexTypeUint8FeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceIndexTypeUint8FeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceIndexTypeUint8FeaturesEXT)(nil).PNext)},
+		{"indexTypeUint8", unsafe.Offsetof((*PhysicalDeviceIndexTypeUint8FeaturesEXT)(nil).IndexTypeUint8)},
+	}},
+	{"VkPhysicalDeviceExtendedDynamicStateFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceExtendedDynamicStateFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceExtendedDynamicStateFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicStateFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicStateFeaturesEXT)(nil).PNext)},
+		{"extendedDynamicState", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicStateFeaturesEXT)(nil).ExtendedDynamicState)},
+	}},
+	{"VkPhysicalDeviceShaderAtomicFloat2FeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceShaderAtomicFloat2FeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceShaderAtomicFloat2FeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceShaderAtomicFloat2FeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceShaderAtomicFloat2FeaturesEXT)(nil).PNext)},
+		{"shaderBufferFloat16Atomics", unsafe.Offsetof((*PhysicalDeviceShaderAtomicFloat2FeaturesEXT)(nil).ShaderBufferFloat16Atomics)},
+		{"shaderBufferFloat16AtomicAdd", unsafe.Offsetof((*PhysicalDeviceShaderAtomicFloat2FeaturesEXT)(nil).ShaderBufferFloat16AtomicAdd)},
+		{"shaderBufferFloat16AtomicMinMax", unsafe.Offsetof((*PhysicalDeviceShaderAtomicFloat2FeaturesEXT)(nil).ShaderBufferFloat16AtomicMinMax)},
+		{"shaderBufferFloat32AtomicMinMax", unsafe.Offsetof((*PhysicalDeviceShaderAtomicFloat2FeaturesEXT)(nil).ShaderBufferFloat32AtomicMinMax)},
+		{"shaderBufferFloat64AtomicMinMax", unsafe.Offsetof((*PhysicalDeviceShaderAtomicFloat2FeaturesEXT)(nil).ShaderBufferFloat64AtomicMinMax)},
+		{"shaderSharedFloat16Atomics", unsafe.Offsetof((*PhysicalDeviceShaderAtomicFloat2FeaturesEXT)(nil).ShaderSharedFloat16Atomics)},
+		{"shaderSharedFloat16AtomicAdd", unsafe.Offsetof((*PhysicalDeviceShaderAtomicFloat2FeaturesEXT)(nil).ShaderSharedFloat16AtomicAdd)},
+		{"shaderSharedFloat16AtomicMinMax", unsafe.Offsetof((*PhysicalDeviceShaderAtomicFloat2FeaturesEXT)(nil).ShaderSharedFloat16AtomicMinMax)},
+		{"shaderSharedFloat32AtomicMinMax", unsafe.Offsetof((*PhysicalDeviceShaderAtomicFloat2FeaturesEXT)(nil).ShaderSharedFloat32AtomicMinMax)},
+		{"shaderSharedFloat64AtomicMinMax", unsafe.Offsetof((*PhysicalDeviceShaderAtomicFloat2FeaturesEXT)(nil).ShaderSharedFloat64AtomicMinMax)},
+		{"shaderImageFloat32AtomicMinMax", unsafe.Offsetof((*PhysicalDeviceShaderAtomicFloat2FeaturesEXT)(nil).ShaderImageFloat32AtomicMinMax)},
+		{"sparseImageFloat32AtomicMinMax", unsafe.Offsetof((*PhysicalDeviceShaderAtomicFloat2FeaturesEXT)(nil).SparseImageFloat32AtomicMinMax)},
+	}},
+	{"VkSurfacePresentModeEXT", unsafe.Sizeof(*(*SurfacePresentModeEXT)(nil)), unsafe.Alignof(*(*SurfacePresentModeEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SurfacePresentModeEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SurfacePresentModeEXT)(nil).PNext)},
+		{"presentMode", unsafe.Offsetof((*SurfacePresentModeEXT)(nil).PresentMode)},
+	}},
+	{"VkSurfacePresentScalingCapabilitiesEXT", unsafe.Sizeof(*(*SurfacePresentScalingCapabilitiesEXT)(nil)), unsafe.Alignof(*(*SurfacePresentScalingCapabilitiesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SurfacePresentScalingCapabilitiesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SurfacePresentScalingCapabilitiesEXT)(nil).PNext)},
+		{"supportedPresentScaling", unsafe.Offsetof((*SurfacePresentScalingCapabilitiesEXT)(nil).SupportedPresentScaling)},
+		{"supportedPresentGravityX", unsafe.Offsetof((*SurfacePresentScalingCapabilitiesEXT)(nil).SupportedPresentGravityX)},
+		{"supportedPresentGravityY", unsafe.Offsetof((*SurfacePresentScalingCapabilitiesEXT)(nil).SupportedPresentGravityY)},
+		{"minScaledImageExtent", unsafe.Offsetof((*SurfacePresentScalingCapabilitiesEXT)(nil).MinScaledImageExtent)},
+		{"maxScaledImageExtent", unsafe.Offsetof((*SurfacePresentScalingCapabilitiesEXT)(nil).MaxScaledImageExtent)},
+	}},
+	{"VkSurfacePresentModeCompatibilityEXT", unsafe.Sizeof(*(*SurfacePresentModeCompatibilityEXT)(nil)), unsafe.Alignof(*(*SurfacePresentModeCompatibilityEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SurfacePresentModeCompatibilityEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SurfacePresentModeCompatibilityEXT)(nil).PNext)},
+		{"presentModeCount", unsafe.Offsetof((*SurfacePresentModeCompatibilityEXT)(nil).PresentModeCount)},
+		{"pPresentModes", unsafe.Offsetof((*SurfacePresentModeCompatibilityEXT)(nil).PPresentModes)},
+	}},
+	{"VkPhysicalDeviceSwapchainMaintenance1FeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceSwapchainMaintenance1FeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceSwapchainMaintenance1FeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceSwapchainMaintenance1FeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceSwapchainMaintenance1FeaturesEXT)(nil).PNext)},
+		{"swapchainMaintenance1", unsafe.Offsetof((*PhysicalDeviceSwapchainMaintenance1FeaturesEXT)(nil).SwapchainMaintenance1)},
+	}},
+	{"VkSwapchainPresentFenceInfoEXT", unsafe.Sizeof(*(*SwapchainPresentFenceInfoEXT)(nil)), unsafe.Alignof(*(*SwapchainPresentFenceInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SwapchainPresentFenceInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SwapchainPresentFenceInfoEXT)(nil).PNext)},
+		{"swapchainCount", unsafe.Offsetof((*SwapchainPresentFenceInfoEXT)(nil).SwapchainCount)},
+		{"pFences", unsafe.Offsetof((*SwapchainPresentFenceInfoEXT)(nil).PFences)},
+	}},
+	{"VkSwapchainPresentModesCreateInfoEXT", unsafe.Sizeof(*(*SwapchainPresentModesCreateInfoEXT)(nil)), unsafe.Alignof(*(*SwapchainPresentModesCreateInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SwapchainPresentModesCreateInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SwapchainPresentModesCreateInfoEXT)(nil).PNext)},
+		{"presentModeCount", unsafe.Offsetof((*SwapchainPresentModesCreateInfoEXT)(nil).PresentModeCount)},
+		{"pPresentModes", unsafe.Offsetof((*SwapchainPresentModesCreateInfoEXT)(nil).PPresentModes)},
+	}},
+	{"VkSwapchainPresentModeInfoEXT", unsafe.Sizeof(*(*SwapchainPresentModeInfoEXT)(nil)), unsafe.Alignof(*(*SwapchainPresentModeInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SwapchainPresentModeInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SwapchainPresentModeInfoEXT)(nil).PNext)},
+		{"swapchainCount", unsafe.Offsetof((*SwapchainPresentModeInfoEXT)(nil).SwapchainCount)},
+		{"pPresentModes", unsafe.Offsetof((*SwapchainPresentModeInfoEXT)(nil).PPresentModes)},
+	}},
+	{"VkSwapchainPresentScalingCreateInfoEXT", unsafe.Sizeof(*(*SwapchainPresentScalingCreateInfoEXT)(nil)), unsafe.Alignof(*(*SwapchainPresentScalingCreateInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SwapchainPresentScalingCreateInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SwapchainPresentScalingCreateInfoEXT)(nil).PNext)},
+		{"scalingBehavior", unsafe.Offsetof((*SwapchainPresentScalingCreateInfoEXT)(nil).ScalingBehavior)},
+		{"presentGravityX", unsafe.Offsetof((*SwapchainPresentScalingCreateInfoEXT)(nil).PresentGravityX)},
+		{"presentGravityY", unsafe.Offsetof((*SwapchainPresentScalingCreateInfoEXT)(nil).PresentGravityY)},
+	}},
+	{"VkReleaseSwapchainImagesInfoEXT", unsafe.Sizeof(*(*ReleaseSwapchainImagesInfoEXT)(nil)), unsafe.Alignof(*(*ReleaseSwapchainImagesInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ReleaseSwapchainImagesInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ReleaseSwapchainImagesInfoEXT)(nil).PNext)},
+		{"swapchain", unsafe.Offsetof((*ReleaseSwapchainImagesInfoEXT)(nil).Swapchain)},
+		{"imageIndexCount", unsafe.Offsetof((*ReleaseSwapchainImagesInfoEXT)(nil).ImageIndexCount)},
+		{"pImageIndices", unsafe.Offsetof((*ReleaseSwapchainImagesInfoEXT)(nil).PImageIndices)},
+	}},
+	{"VkPhysicalDeviceDeviceGeneratedCommandsPropertiesNV", unsafe.Sizeof(*(*PhysicalDeviceDeviceGeneratedCommandsPropertiesNV)(nil)), unsafe.Alignof(*(*PhysicalDeviceDeviceGeneratedCommandsPropertiesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceDeviceGeneratedCommandsPropertiesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceDeviceGeneratedCommandsPropertiesNV)(nil).PNext)},
+		{"maxGraphicsShaderGroupCount", unsafe.Offsetof((*PhysicalDeviceDeviceGeneratedCommandsPropertiesNV)(nil).MaxGraphicsShaderGroupCount)},
+		{"maxIndirectSequenceCount", unsafe.Offsetof((*PhysicalDeviceDeviceGeneratedCommandsPropertiesNV)(nil).MaxIndirectSequenceCount)},
+		{"maxIndirectCommandsTokenCount", unsafe.Offsetof((*PhysicalDeviceDeviceGeneratedCommandsPropertiesNV)(nil).MaxIndirectCommandsTokenCount)},
+		{"maxIndirectCommandsStreamCount", unsafe.Offsetof((*PhysicalDeviceDeviceGeneratedCommandsPropertiesNV)(nil).MaxIndirectCommandsStreamCount)},
+		{"maxIndirectCommandsTokenOffset", unsafe.Offsetof((*PhysicalDeviceDeviceGeneratedCommandsPropertiesNV)(nil).MaxIndirectCommandsTokenOffset)},
+		{"maxIndirectCommandsStreamStride", unsafe.Offsetof((*PhysicalDeviceDeviceGeneratedCommandsPropertiesNV)(nil).MaxIndirectCommandsStreamStride)},
+		{"minSequencesCountBufferOffsetAlignment", unsafe.Offsetof((*PhysicalDeviceDeviceGeneratedCommandsPropertiesNV)(nil).MinSequencesCountBufferOffsetAlignment)},
+		{"minSequencesIndexBufferOffsetAlignment", unsafe.Offsetof((*PhysicalDeviceDeviceGeneratedCommandsPropertiesNV)(nil).MinSequencesIndexBufferOffsetAlignment)},
+		{"minIndirectCommandsBufferOffsetAlignment", unsafe.Offsetof((*PhysicalDeviceDeviceGeneratedCommandsPropertiesNV)(nil).MinIndirectCommandsBufferOffsetAlignment)},
+	}},
+	{"VkPhysicalDeviceDeviceGeneratedCommandsFeaturesNV", unsafe.Sizeof(*(*PhysicalDeviceDeviceGeneratedCommandsFeaturesNV)(nil)), unsafe.Alignof(*(*PhysicalDeviceDeviceGeneratedCommandsFeaturesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceDeviceGeneratedCommandsFeaturesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceDeviceGeneratedCommandsFeaturesNV)(nil).PNext)},
+		{"deviceGeneratedCommands", unsafe.Offsetof((*PhysicalDeviceDeviceGeneratedCommandsFeaturesNV)(nil).DeviceGeneratedCommands)},
+	}},
+	{"VkGraphicsShaderGroupCreateInfoNV", unsafe.Sizeof(*(*GraphicsShaderGroupCreateInfoNV)(nil)), unsafe.Alignof(*(*GraphicsShaderGroupCreateInfoNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*GraphicsShaderGroupCreateInfoNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*GraphicsShaderGroupCreateInfoNV)(nil).PNext)},
+		{"stageCount", unsafe.Offsetof((*GraphicsShaderGroupCreateInfoNV)(nil).StageCount)},
+		{"pStages", unsafe.Offsetof((*GraphicsShaderGroupCreateInfoNV)(nil).PStages)},
+		{"pVertexInputState", unsafe.Offsetof((*GraphicsShaderGroupCreateInfoNV)(nil).PVertexInputState)},
+		{"pTessellationState", unsafe.Offsetof((*GraphicsShaderGroupCreateInfoNV)(nil).PTessellationState)},
+	}},
+	{"VkGraphicsPipelineShaderGroupsCreateInfoNV", unsafe.Sizeof(*(*GraphicsPipelineShaderGroupsCreateInfoNV)(nil)), unsafe.Alignof(*(*GraphicsPipelineShaderGroupsCreateInfoNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*GraphicsPipelineShaderGroupsCreateInfoNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*GraphicsPipelineShaderGroupsCreateInfoNV)(nil).PNext)},
+		{"groupCount", unsafe.Offsetof((*GraphicsPipelineShaderGroupsCreateInfoNV)(nil).GroupCount)},
+		{"pGroups", unsafe.Offsetof((*GraphicsPipelineShaderGroupsCreateInfoNV)(nil).PGroups)},
+		{"pipelineCount", unsafe.Offsetof((*GraphicsPipelineShaderGroupsCreateInfoNV)(nil).PipelineCount)},
+		{"pPipelines", unsafe.Offsetof((*GraphicsPipelineShaderGroupsCreateInfoNV)(nil).PPipelines)},
+	}},
+	{"VkBindShaderGroupIndirectCommandNV", unsafe.Sizeof(*(*BindShaderGroupIndirectCommandNV)(nil)), unsafe.Alignof(*(*BindShaderGroupIndirectCommandNV)(nil)), []memberOffset{
+		{"groupIndex", unsafe.Offsetof((*BindShaderGroupIndirectCommandNV)(nil).GroupIndex)},
+	}},
+	{"VkBindIndexBufferIndirectCommandNV", unsafe.Sizeof(*(*BindIndexBufferIndirectCommandNV)(nil)), unsafe.Alignof(*(*BindIndexBufferIndirectCommandNV)(nil)), []memberOffset{
+		{"bufferAddress", unsafe.Offsetof((*BindIndexBufferIndirectCommandNV)(nil).BufferAddress)},
+		{"size", unsafe.Offsetof((*BindIndexBufferIndirectCommandNV)(nil).Size)},
+		{"indexType", unsafe.Offsetof((*BindIndexBufferIndirectCommandNV)(nil).IndexType)},
+	}},
+	{"VkBindVertexBufferIndirectCommandNV", unsafe.Sizeof(*(*BindVertexBufferIndirectCommandNV)(nil)), unsafe.Alignof(*(*BindVertexBufferIndirectCommandNV)(nil)), []memberOffset{
+		{"bufferAddress", unsafe.Offsetof((*BindVertexBufferIndirectCommandNV)(nil).BufferAddress)},
+		{"size", unsafe.Offsetof((*BindVertexBufferIndirectCommandNV)(nil).Size)},
+		{"stride", unsafe.Offsetof((*BindVertexBufferIndirectCommandNV)(nil).Stride)},
+	}},
+	{"VkSetStateFlagsIndirectCommandNV", unsafe.Sizeof(*(*SetStateFlagsIndirectCommandNV)(nil)), unsafe.Alignof(*(*SetStateFlagsIndirectCommandNV)(nil)), []memberOffset{
+		{"data", unsafe.Offsetof((*SetStateFlagsIndirectCommandNV)(nil).Data)},
+	}},
+	{"VkIndirectCommandsStreamNV", unsafe.Sizeof(*(*IndirectCommandsStreamNV)(nil)), unsafe.Alignof(*(*IndirectCommandsStreamNV)(nil)), []memberOffset{
+		{"buffer", unsafe.Offsetof((*IndirectCommandsStreamNV)(nil).Buffer)},
+		{"offset", unsafe.Offsetof((*IndirectCommandsStreamNV)(nil).Offset)},
+	}},
+	{"VkIndirectCommandsLayoutTokenNV", unsafe.Sizeof(*(*IndirectCommandsLayoutTokenNV)(nil)), unsafe.Alignof(*(*IndirectCommandsLayoutTokenNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*IndirectCommandsLayoutTokenNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*IndirectCommandsLayoutTokenNV)(nil).PNext)},
+		{"tokenType", unsafe.Offsetof((*IndirectCommandsLayoutTokenNV)(nil).TokenType)},
+		{"stream", unsafe.Offsetof((*IndirectCommandsLayoutTokenNV)(nil).Stream)},
+		{"offset", unsafe.Offsetof((*IndirectCommandsLayoutTokenNV)(nil).Offset)},
+		{"vertexBindingUnit", unsafe.Offsetof((*IndirectCommandsLayoutTokenNV)(nil).VertexBindingUnit)},
+		{"vertexDynamicStride", unsafe.Offsetof((*IndirectCommandsLayoutTokenNV)(nil).VertexDynamicStride)},
+		{"pushconstantPipelineLayout", unsafe.Offsetof((*IndirectCommandsLayoutTokenNV)(nil).PushconstantPipelineLayout)},
+		{"pushconstantShaderStageFlags", unsafe.Offsetof((*IndirectCommandsLayoutTokenNV)(nil).PushconstantShaderStageFlags)},
+		{"pushconstantOffset", unsafe.Offsetof((*IndirectCommandsLayoutTokenNV)(nil).PushconstantOffset)},
+		{"pushconstantSize", unsafe.Offsetof((*IndirectCommandsLayoutTokenNV)(nil).PushconstantSize)},
+		{"indirectStateFlags", unsafe.Offsetof((*IndirectCommandsLayoutTokenNV)(nil).IndirectStateFlags)},
+		{"indexTypeCount", unsafe.Offsetof((*IndirectCommandsLayoutTokenNV)(nil).IndexTypeCount)},
+		{"pIndexTypes", unsafe.Offsetof((*IndirectCommandsLayoutTokenNV)(nil).PIndexTypes)},
+		{"pIndexTypeValues", unsafe.Offsetof((*IndirectCommandsLayoutTokenNV)(nil).PIndexTypeValues)},
+	}},
+	{"VkIndirectCommandsLayoutCreateInfoNV", unsafe.Sizeof(*(*IndirectCommandsLayoutCreateInfoNV)(nil)), unsafe.Alignof(*(*IndirectCommandsLayoutCreateInfoNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*IndirectCommandsLayoutCreateInfoNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*IndirectCommandsLayoutCreateInfoNV)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*IndirectCommandsLayoutCreateInfoNV)(nil).Flags)},
+		{"pipelineBindPoint", unsafe.Offsetof((*IndirectCommandsLayoutCreateInfoNV)(nil).PipelineBindPoint)},
+		{"tokenCount", unsafe.Offsetof((*IndirectCommandsLayoutCreateInfoNV)(nil).TokenCount)},
+		{"pTokens", unsafe.Offsetof((*IndirectCommandsLayoutCreateInfoNV)(nil).PTokens)},
+		{"streamCount", unsafe.Offsetof((*IndirectCommandsLayoutCreateInfoNV)(nil).StreamCount)},
+		{"pStreamStrides", unsafe.Offsetof((*IndirectCommandsLayoutCreateInfoNV)(nil).PStreamStrides)},
+	}},
+	{"VkGeneratedCommandsInfoNV", unsafe.Sizeof(*(*GeneratedCommandsInfoNV)(nil)), unsafe.Alignof(*(*GeneratedCommandsInfoNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*GeneratedCommandsInfoNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*GeneratedCommandsInfoNV)(nil).PNext)},
+		{"pipelineBindPoint", unsafe.Offsetof((*GeneratedCommandsInfoNV)(nil).PipelineBindPoint)},
+		{"pipeline", unsafe.Offsetof((*GeneratedCommandsInfoNV)(nil).Pipeline)},
+		{"indirectCommandsLayout", unsafe.Offsetof((*GeneratedCommandsInfoNV)(nil).IndirectCommandsLayout)},
+		{"streamCount", unsafe.Offsetof((*GeneratedCommandsInfoNV)(nil).StreamCount)},
+		{"pStreams", unsafe.Offsetof((*GeneratedCommandsInfoNV)(nil).PStreams)},
+		{"sequencesCount", unsafe.Offsetof((*GeneratedCommandsInfoNV)(nil).SequencesCount)},
+		{"preprocessBuffer", unsafe.Offsetof((*GeneratedCommandsInfoNV)(nil).PreprocessBuffer)},
+		{"preprocessOffset", unsafe.Offsetof((*GeneratedCommandsInfoNV)(nil).PreprocessOffset)},
+		{"preprocessSize", unsafe.Offsetof((*GeneratedCommandsInfoNV)(nil).PreprocessSize)},
+		{"sequencesCountBuffer", unsafe.Offsetof((*GeneratedCommandsInfoNV)(nil).SequencesCountBuffer)},
+		{"sequencesCountOffset", unsafe.Offsetof((*GeneratedCommandsInfoNV)(nil).SequencesCountOffset)},
+		{"sequencesIndexBuffer", unsafe.Offsetof((*GeneratedCommandsInfoNV)(nil).SequencesIndexBuffer)},
+		{"sequencesIndexOffset", unsafe.Offsetof((*GeneratedCommandsInfoNV)(nil).SequencesIndexOffset)},
+	}},
+	{"VkGeneratedCommandsMemoryRequirementsInfoNV", unsafe.Sizeof(*(*GeneratedCommandsMemoryRequirementsInfoNV)(nil)), unsafe.Alignof(*(*GeneratedCommandsMemoryRequirementsInfoNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*GeneratedCommandsMemoryRequirementsInfoNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*GeneratedCommandsMemoryRequirementsInfoNV)(nil).PNext)},
+		{"pipelineBindPoint", unsafe.Offsetof((*GeneratedCommandsMemoryRequirementsInfoNV)(nil).PipelineBindPoint)},
+		{"pipeline", unsafe.Offsetof((*GeneratedCommandsMemoryRequirementsInfoNV)(nil).Pipeline)},
+		{"indirectCommandsLayout", unsafe.Offsetof((*GeneratedCommandsMemoryRequirementsInfoNV)(nil).IndirectCommandsLayout)},
+		{"maxSequencesCount", unsafe.Offsetof((*GeneratedCommandsMemoryRequirementsInfoNV)(nil).MaxSequencesCount)},
+	}},
+	{"VkPhysicalDeviceInheritedViewportScissorFeaturesNV", unsafe.Sizeof(*(*PhysicalDeviceInheritedViewportScissorFeaturesNV)(nil)), unsafe.Alignof(*(*PhysicalDeviceInheritedViewportScissorFeaturesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceInheritedViewportScissorFeaturesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceInheritedViewportScissorFeaturesNV)(nil).PNext)},
+		{"inheritedViewportScissor2D", unsafe.Offsetof((*PhysicalDeviceInheritedViewportScissorFeaturesNV)(nil).InheritedViewportScissor2D)},
+	}},
+	{"VkCommandBufferInheritanceViewportScissorInfoNV", unsafe.Sizeof(*(*CommandBufferInheritanceViewportScissorInfoNV)(nil)), unsafe.Alignof(*(*CommandBufferInheritanceViewportScissorInfoNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*CommandBufferInheritanceViewportScissorInfoNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*CommandBufferInheritanceViewportScissorInfoNV)(nil).PNext)},
+		{"viewportScissor2D", unsafe.Offsetof((*CommandBufferInheritanceViewportScissorInfoNV)(nil).ViewportScissor2D)},
+		{"viewportDepthCount", unsafe.Offsetof((*CommandBufferInheritanceViewportScissorInfoNV)(nil).ViewportDepthCount)},
+		{"pViewportDepths", unsafe.Offsetof((*CommandBufferInheritanceViewportScissorInfoNV)(nil).PViewportDepths)},
+	}},
+	{"VkPhysicalDeviceTexelBufferAlignmentFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceTexelBufferAlignmentFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceTexelBufferAlignmentFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceTexelBufferAlignmentFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceTexelBufferAlignmentFeaturesEXT)(nil).PNext)},
+		{"texelBufferAlignment", unsafe.Offsetof((*PhysicalDeviceTexelBufferAlignmentFeaturesEXT)(nil).TexelBufferAlignment)},
+	}},
+	{"VkRenderPassTransformBeginInfoQCOM", unsafe.Sizeof(*(*RenderPassTransformBeginInfoQCOM)(nil)), unsafe.Alignof(*(*RenderPassTransformBeginInfoQCOM)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*RenderPassTransformBeginInfoQCOM)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*RenderPassTransformBeginInfoQCOM)(nil).PNext)},
+		{"transform", unsafe.Offsetof((*RenderPassTransformBeginInfoQCOM)(nil).Transform)},
+	}},
+	{"VkCommandBufferInheritanceRenderPassTransformInfoQCOM", unsafe.Sizeof(*(*CommandBufferInheritanceRenderPassTransformInfoQCOM)(nil)), unsafe.Alignof(*(*CommandBufferInheritanceRenderPassTransformInfoQCOM)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*CommandBufferInheritanceRenderPassTransformInfoQCOM)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*CommandBufferInheritanceRenderPassTransformInfoQCOM)(nil).PNext)},
+		{"transform", unsafe.Offsetof((*CommandBufferInheritanceRenderPassTransformInfoQCOM)(nil).Transform)},
+		{"renderArea", unsafe.Offsetof((*CommandBufferInheritanceRenderPassTransformInfoQCOM)(nil).RenderArea)},
+	}},
+	{"VkPhysicalDeviceDeviceMemoryReportFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceDeviceMemoryReportFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceDeviceMemoryReportFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceDeviceMemoryReportFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceDeviceMemoryReportFeaturesEXT)(nil).PNext)},
+		{"deviceMemoryReport", unsafe.Offsetof((*PhysicalDeviceDeviceMemoryReportFeaturesEXT)(nil).DeviceMemoryReport)},
+	}},
+	{"VkDeviceMemoryReportCallbackDataEXT", unsafe.Sizeof(*(*DeviceMemoryReportCallbackDataEXT)(nil)), unsafe.Alignof(*(*DeviceMemoryReportCallbackDataEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DeviceMemoryReportCallbackDataEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DeviceMemoryReportCallbackDataEXT)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*DeviceMemoryReportCallbackDataEXT)(nil).Flags)},
+		{"type", unsafe.Offsetof((*DeviceMemoryReportCallbackDataEXT)(nil).Type)},
+		{"memoryObjectId", unsafe.Offsetof((*DeviceMemoryReportCallbackDataEXT)(nil).MemoryObjectId)},
+		{"size", unsafe.Offsetof((*DeviceMemoryReportCallbackDataEXT)(nil).Size)},
+		{"objectType", unsafe.Offsetof((*DeviceMemoryReportCallbackDataEXT)(nil).ObjectType)},
+		{"objectHandle", unsafe.Offsetof((*DeviceMemoryReportCallbackDataEXT)(nil).ObjectHandle)},
+		{"heapIndex", unsafe.Offsetof((*DeviceMemoryReportCallbackDataEXT)(nil).HeapIndex)},
+	}},
+	{"VkDeviceDeviceMemoryReportCreateInfoEXT", unsafe.Sizeof(*(*DeviceDeviceMemoryReportCreateInfoEXT)(nil)), unsafe.Alignof(*(*DeviceDeviceMemoryReportCreateInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DeviceDeviceMemoryReportCreateInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DeviceDeviceMemoryReportCreateInfoEXT)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*DeviceDeviceMemoryReportCreateInfoEXT)(nil).Flags)},
+		{"pfnUserCallback", unsafe.Offsetof((*DeviceDeviceMemoryReportCreateInfoEXT)(nil).PfnUserCallback)},
+		{"pUserData", unsafe.Offsetof((*DeviceDeviceMemoryReportCreateInfoEXT)(nil).PUserData)},
+	}},
+	{"VkPhysicalDeviceRobustness2FeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceRobustness2FeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceRobustness2FeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceRobustness2FeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceRobustness2FeaturesEXT)(nil).PNext)},
+		{"robustBufferAccess2", unsafe.Offsetof((*PhysicalDeviceRobustness2FeaturesEXT)(nil).RobustBufferAccess2)},
+		{"robustImageAccess2", unsafe.Offsetof((*PhysicalDeviceRobustness2FeaturesEXT)(nil).RobustImageAccess2)},
+		{"nullDescriptor", unsafe.Offsetof((*PhysicalDeviceRobustness2FeaturesEXT)(nil).NullDescriptor)},
+	}},
+	{"VkPhysicalDeviceRobustness2PropertiesEXT", unsafe.Sizeof(*(*PhysicalDeviceRobustness2PropertiesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceRobustness2PropertiesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceRobustness2PropertiesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceRobustness2PropertiesEXT)(nil).PNext)},
+		{"robustStorageBufferAccessSizeAlignment", unsafe.Offsetof((*PhysicalDeviceRobustness2PropertiesEXT)(nil).RobustStorageBufferAccessSizeAlignment)},
+		{"robustUniformBufferAccessSizeAlignment", unsafe.Offsetof((*PhysicalDeviceRobustness2PropertiesEXT)(nil).RobustUniformBufferAccessSizeAlignment)},
+	}},
+	{"VkSamplerCustomBorderColorCreateInfoEXT", unsafe.Sizeof(*(*SamplerCustomBorderColorCreateInfoEXT)(nil)), unsafe.Alignof(*(*SamplerCustomBorderColorCreateInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SamplerCustomBorderColorCreateInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SamplerCustomBorderColorCreateInfoEXT)(nil).PNext)},
+		{"customBorderColor", unsafe.Offsetof((*SamplerCustomBorderColorCreateInfoEXT)(nil).CustomBorderColor)},
+		{"format", unsafe.Offsetof((*SamplerCustomBorderColorCreateInfoEXT)(nil).Format)},
+	}},
+	{"VkPhysicalDeviceCustomBorderColorPropertiesEXT", unsafe.Sizeof(*(*PhysicalDeviceCustomBorderColorPropertiesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceCustomBorderColorPropertiesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceCustomBorderColorPropertiesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceCustomBorderColorPropertiesEXT)(nil).PNext)},
+		{"maxCustomBorderColorSamplers", unsafe.Offsetof((*PhysicalDeviceCustomBorderColorPropertiesEXT)(nil).MaxCustomBorderColorSamplers)},
+	}},
+	{"VkPhysicalDeviceCustomBorderColorFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceCustomBorderColorFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceCustomBorderColorFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceCustomBorderColorFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceCustomBorderColorFeaturesEXT)(nil).PNext)},
+		{"customBorderColors", unsafe.Offsetof((*PhysicalDeviceCustomBorderColorFeaturesEXT)(nil).CustomBorderColors)},
+		{"customBorderColorWithoutFormat", unsafe.Offsetof((*PhysicalDeviceCustomBorderColorFeaturesEXT)(nil).CustomBorderColorWithoutFormat)},
+	}},
+	{"VkPhysicalDevicePresentBarrierFeaturesNV", unsafe.Sizeof(*(*PhysicalDevicePresentBarrierFeaturesNV)(nil)), unsafe.Alignof(*(*PhysicalDevicePresentBarrierFeaturesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDevicePresentBarrierFeaturesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDevicePresentBarrierFeaturesNV)(nil).PNext)},
+		{"presentBarrier", unsafe.Offsetof((*PhysicalDevicePresentBarrierFeaturesNV)(nil).PresentBarrier)},
+	}},
+	{"VkSurfaceCapabilitiesPresentBarrierNV", unsafe.Sizeof(*(*SurfaceCapabilitiesPresentBarrierNV)(nil)), unsafe.Alignof(*(*SurfaceCapabilitiesPresentBarrierNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SurfaceCapabilitiesPresentBarrierNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SurfaceCapabilitiesPresentBarrierNV)(nil).PNext)},
+		{"presentBarrierSupported", unsafe.Offsetof((*SurfaceCapabilitiesPresentBarrierNV)(nil).PresentBarrierSupported)},
+	}},
+	{"VkSwapchainPresentBarrierCreateInfoNV", unsafe.Sizeof(*(*SwapchainPresentBarrierCreateInfoNV)(nil)), unsafe.Alignof(*(*SwapchainPresentBarrierCreateInfoNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SwapchainPresentBarrierCreateInfoNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SwapchainPresentBarrierCreateInfoNV)(nil).PNext)},
+		{"presentBarrierEnable", unsafe.Offsetof((*SwapchainPresentBarrierCreateInfoNV)(nil).PresentBarrierEnable)},
+	}},
+	{"VkPhysicalDeviceDiagnosticsConfigFeaturesNV", unsafe.Sizeof(*(*PhysicalDeviceDiagnosticsConfigFeaturesNV)(nil)), unsafe.Alignof(*(*PhysicalDeviceDiagnosticsConfigFeaturesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceDiagnosticsConfigFeaturesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceDiagnosticsConfigFeaturesNV)(nil).PNext)},
+		{"diagnosticsConfig", unsafe.Offsetof((*PhysicalDeviceDiagnosticsConfigFeaturesNV)(nil).DiagnosticsConfig)},
+	}},
+	{"VkDeviceDiagnosticsConfigCreateInfoNV", unsafe.Sizeof(*(*DeviceDiagnosticsConfigCreateInfoNV)(nil)), unsafe.Alignof(*(*DeviceDiagnosticsConfigCreateInfoNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DeviceDiagnosticsConfigCreateInfoNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DeviceDiagnosticsConfigCreateInfoNV)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*DeviceDiagnosticsConfigCreateInfoNV)(nil).Flags)},
+	}},
+	{"VkPhysicalDeviceDescriptorBufferPropertiesEXT", unsafe.Sizeof(*(*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil).PNext)},
+		{"combinedImageSamplerDescriptorSingleArray", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil).CombinedImageSamplerDescriptorSingleArray)},
+		{"bufferlessPushDescriptors", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil).BufferlessPushDescriptors)},
+		{"allowSamplerImageViewPostSubmitCreation", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil).AllowSamplerImageViewPostSubmitCreation)},
+		{"descriptorBufferOffsetAlignment", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil).DescriptorBufferOffsetAlignment)},
+		{"maxDescriptorBufferBindings", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil).MaxDescriptorBufferBindings)},
+		{"maxResourceDescriptorBufferBindings", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil).MaxResourceDescriptorBufferBindings)},
+		{"maxSamplerDescriptorBufferBindings", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil).MaxSamplerDescriptorBufferBindings)},
+		{"maxEmbeddedImmutableSamplerBindings", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil).MaxEmbeddedImmutableSamplerBindings)},
+		{"maxEmbeddedImmutableSamplers", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil).MaxEmbeddedImmutableSamplers)},
+		{"bufferCaptureReplayDescriptorDataSize", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil).BufferCaptureReplayDescriptorDataSize)},
+		{"imageCaptureReplayDescriptorDataSize", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil).ImageCaptureReplayDescriptorDataSize)},
+		{"imageViewCaptureReplayDescriptorDataSize", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil).ImageViewCaptureReplayDescriptorDataSize)},
+		{"samplerCaptureReplayDescriptorDataSize", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil).SamplerCaptureReplayDescriptorDataSize)},
+		{"accelerationStructureCaptureReplayDescriptorDataSize", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil).AccelerationStructureCaptureReplayDescriptorDataSize)},
+		{"samplerDescriptorSize", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil).SamplerDescriptorSize)},
+		{"combinedImageSamplerDescriptorSize", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil).CombinedImageSamplerDescriptorSize)},
+		{"sampledImageDescriptorSize", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil).SampledImageDescriptorSize)},
+		{"storageImageDescriptorSize", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil).StorageImageDescriptorSize)},
+		{"uniformTexelBufferDescriptorSize", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil).UniformTexelBufferDescriptorSize)},
+		{"robustUniformTexelBufferDescriptorSize", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil).RobustUniformTexelBufferDescriptorSize)},
+		{"storageTexelBufferDescriptorSize", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil).StorageTexelBufferDescriptorSize)},
+		{"robustStorageTexelBufferDescriptorSize", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil).RobustStorageTexelBufferDescriptorSize)},
+		{"uniformBufferDescriptorSize", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil).UniformBufferDescriptorSize)},
+		{"robustUniformBufferDescriptorSize", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil).RobustUniformBufferDescriptorSize)},
+		{"storageBufferDescriptorSize", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil).StorageBufferDescriptorSize)},
+		{"robustStorageBufferDescriptorSize", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil).RobustStorageBufferDescriptorSize)},
+		{"inputAttachmentDescriptorSize", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil).InputAttachmentDescriptorSize)},
+		{"accelerationStructureDescriptorSize", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil).AccelerationStructureDescriptorSize)},
+		{"maxSamplerDescriptorBufferRange", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil).MaxSamplerDescriptorBufferRange)},
+		{"maxResourceDescriptorBufferRange", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil).MaxResourceDescriptorBufferRange)},
+		{"samplerDescriptorBufferAddressSpaceSize", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil).SamplerDescriptorBufferAddressSpaceSize)},
+		{"resourceDescriptorBufferAddressSpaceSize", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil).ResourceDescriptorBufferAddressSpaceSize)},
+		{"descriptorBufferAddressSpaceSize", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferPropertiesEXT)(nil).DescriptorBufferAddressSpaceSize)},
+	}},
+	{"VkPhysicalDeviceDescriptorBufferDensityMapPropertiesEXT", unsafe.Sizeof(*(*PhysicalDeviceDescriptorBufferDensityMapPropertiesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceDescriptorBufferDensityMapPropertiesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferDensityMapPropertiesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferDensityMapPropertiesEXT)(nil).PNext)},
+		{"combinedImageSamplerDensityMapDescriptorSize", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferDensityMapPropertiesEXT)(nil).CombinedImageSamplerDensityMapDescriptorSize)},
+	}},
+	{"VkPhysicalDeviceDescriptorBufferFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceDescriptorBufferFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceDescriptorBufferFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferFeaturesEXT)(nil).PNext)},
+		{"descriptorBuffer", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferFeaturesEXT)(nil).DescriptorBuffer)},
+		{"descriptorBufferCaptureReplay", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferFeaturesEXT)(nil).DescriptorBufferCaptureReplay)},
+		{"descriptorBufferImageLayoutIgnored", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferFeaturesEXT)(nil).DescriptorBufferImageLayoutIgnored)},
+		{"descriptorBufferPushDescriptors", unsafe.Offsetof((*PhysicalDeviceDescriptorBufferFeaturesEXT)(nil).DescriptorBufferPushDescriptors)},
+	}},
+	{"VkDescriptorAddressInfoEXT", unsafe.Sizeof(*(*DescriptorAddressInfoEXT)(nil)), unsafe.Alignof(*(*DescriptorAddressInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DescriptorAddressInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DescriptorAddressInfoEXT)(nil).PNext)},
+		{"address", unsafe.Offsetof((*DescriptorAddressInfoEXT)(nil).Address)},
+		{"range", unsafe.Offsetof((*DescriptorAddressInfoEXT)(nil).Range)},
+		{"format", unsafe.Offsetof((*DescriptorAddressInfoEXT)(nil).Format)},
+	}},
+	{"VkDescriptorBufferBindingInfoEXT", unsafe.Sizeof(*(*DescriptorBufferBindingInfoEXT)(nil)), unsafe.Alignof(*(*DescriptorBufferBindingInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DescriptorBufferBindingInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DescriptorBufferBindingInfoEXT)(nil).PNext)},
+		{"address", unsafe.Offsetof((*DescriptorBufferBindingInfoEXT)(nil).Address)},
+		{"usage", unsafe.Offsetof((*DescriptorBufferBindingInfoEXT)(nil).Usage)},
+	}},
+	{"VkDescriptorBufferBindingPushDescriptorBufferHandleEXT", unsafe.Sizeof(*(*DescriptorBufferBindingPushDescriptorBufferHandleEXT)(nil)), unsafe.Alignof(*(*DescriptorBufferBindingPushDescriptorBufferHandleEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DescriptorBufferBindingPushDescriptorBufferHandleEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DescriptorBufferBindingPushDescriptorBufferHandleEXT)(nil).PNext)},
+		{"buffer", unsafe.Offsetof((*DescriptorBufferBindingPushDescriptorBufferHandleEXT)(nil).Buffer)},
+	}},
+	{"VkDescriptorDataEXT", unsafe.Sizeof(*(*DescriptorDataEXT)(nil)), unsafe.Alignof(*(*DescriptorDataEXT)(nil)), nil},
+	{"VkDescriptorGetInfoEXT", unsafe.Sizeof(*(*DescriptorGetInfoEXT)(nil)), unsafe.Alignof(*(*DescriptorGetInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DescriptorGetInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DescriptorGetInfoEXT)(nil).PNext)},
+		{"type", unsafe.Offsetof((*DescriptorGetInfoEXT)(nil).Type)},
+		{"data", unsafe.Offsetof((*DescriptorGetInfoEXT)(nil).Data)},
+	}},
+	{"VkBufferCaptureDescriptorDataInfoEXT", unsafe.Sizeof(*(*BufferCaptureDescriptorDataInfoEXT)(nil)), unsafe.Alignof(*(*BufferCaptureDescriptorDataInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*BufferCaptureDescriptorDataInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*BufferCaptureDescriptorDataInfoEXT)(nil).PNext)},
+		{"buffer", unsafe.Offsetof((*BufferCaptureDescriptorDataInfoEXT)(nil).Buffer)},
+	}},
+	{"VkImageCaptureDescriptorDataInfoEXT", unsafe.Sizeof(*(*ImageCaptureDescriptorDataInfoEXT)(nil)), unsafe.Alignof(*(*ImageCaptureDescriptorDataInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ImageCaptureDescriptorDataInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ImageCaptureDescriptorDataInfoEXT)(nil).PNext)},
+		{"image", unsafe.Offsetof((*ImageCaptureDescriptorDataInfoEXT)(nil).Image)},
+	}},
+	{"VkImageViewCaptureDescriptorDataInfoEXT", unsafe.Sizeof(*(*ImageViewCaptureDescriptorDataInfoEXT)(nil)), unsafe.Alignof(*(*ImageViewCaptureDescriptorDataInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ImageViewCaptureDescriptorDataInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ImageViewCaptureDescriptorDataInfoEXT)(nil).PNext)},
+		{"imageView", unsafe.Offsetof((*ImageViewCaptureDescriptorDataInfoEXT)(nil).ImageView)},
+	}},
+	{"VkSamplerCaptureDescriptorDataInfoEXT", unsafe.Sizeof(*(*SamplerCaptureDescriptorDataInfoEXT)(nil)), unsafe.Alignof(*(*SamplerCaptureDescriptorDataInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SamplerCaptureDescriptorDataInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SamplerCaptureDescriptorDataInfoEXT)(nil).PNext)},
+		{"sampler", unsafe.Offsetof((*SamplerCaptureDescriptorDataInfoEXT)(nil).Sampler)},
+	}},
+	{"VkOpaqueCaptureDescriptorDataCreateInfoEXT", unsafe.Sizeof(*(*OpaqueCaptureDescriptorDataCreateInfoEXT)(nil)), unsafe.Alignof(*(*OpaqueCaptureDescriptorDataCreateInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*OpaqueCaptureDescriptorDataCreateInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*OpaqueCaptureDescriptorDataCreateInfoEXT)(nil).PNext)},
+		{"opaqueCaptureDescriptorData", unsafe.Offsetof((*OpaqueCaptureDescriptorDataCreateInfoEXT)(nil).OpaqueCaptureDescriptorData)},
+	}},
+	{"VkAccelerationStructureCaptureDescriptorDataInfoEXT", unsafe.Sizeof(*(*AccelerationStructureCaptureDescriptorDataInfoEXT)(nil)), unsafe.Alignof(*(*AccelerationStructureCaptureDescriptorDataInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*AccelerationStructureCaptureDescriptorDataInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*AccelerationStructureCaptureDescriptorDataInfoEXT)(nil).PNext)},
+		{"accelerationStructure", unsafe.Offsetof((*AccelerationStructureCaptureDescriptorDataInfoEXT)(nil).AccelerationStructure)},
+		{"accelerationStructureNV", unsafe.Offsetof((*AccelerationStructureCaptureDescriptorDataInfoEXT)(nil).AccelerationStructureNV)},
+	}},
+	{"VkPhysicalDeviceGraphicsPipelineLibraryFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceGraphicsPipelineLibraryFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceGraphicsPipelineLibraryFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceGraphicsPipelineLibraryFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceGraphicsPipelineLibraryFeaturesEXT)(nil).PNext)},
+		{"graphicsPipelineLibrary", unsafe.Offsetof((*PhysicalDeviceGraphicsPipelineLibraryFeaturesEXT)(nil).GraphicsPipelineLibrary)},
+	}},
+	{"VkPhysicalDeviceGraphicsPipelineLibraryPropertiesEXT", unsafe.Sizeof(*(*PhysicalDeviceGraphicsPipelineLibraryPropertiesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceGraphicsPipelineLibraryPropertiesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceGraphicsPipelineLibraryPropertiesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceGraphicsPipelineLibraryPropertiesEXT)(nil).PNext)},
+		{"graphicsPipelineLibraryFastLinking", unsafe.Offsetof((*PhysicalDeviceGraphicsPipelineLibraryPropertiesEXT)(nil).GraphicsPipelineLibraryFastLinking)},
+		{"graphicsPipelineLibraryIndependentInterpolationDecoration", unsafe.Offsetof((*PhysicalDeviceGraphicsPipelineLibraryPropertiesEXT)(nil).GraphicsPipelineLibraryIndependentInterpolationDecoration)},
+	}},
+	{"VkGraphicsPipelineLibraryCreateInfoEXT", unsafe.Sizeof(*(*GraphicsPipelineLibraryCreateInfoEXT)(nil)), unsafe.Alignof(*(*GraphicsPipelineLibraryCreateInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*GraphicsPipelineLibraryCreateInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*GraphicsPipelineLibraryCreateInfoEXT)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*GraphicsPipelineLibraryCreateInfoEXT)(nil).Flags)},
+	}},
+	{"VkPhysicalDeviceShaderEarlyAndLateFragmentTestsFeaturesAMD", unsafe.Sizeof(*(*PhysicalDeviceShaderEarlyAndLateFragmentTestsFeaturesAMD)(nil)), unsafe.Alignof(*(*PhysicalDeviceShaderEarlyAndLateFragmentTestsFeaturesAMD)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceShaderEarlyAndLateFragmentTestsFeaturesAMD)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceShaderEarlyAndLateFragmentTestsFeaturesAMD)(nil).PNext)},
+		{"shaderEarlyAndLateFragmentTests", unsafe.Offsetof((*PhysicalDeviceShaderEarlyAndLateFragmentTestsFeaturesAMD)(nil).ShaderEarlyAndLateFragmentTests)},
+	}},
+	{"VkPhysicalDeviceFragmentShadingRateEnumsFeaturesNV", unsafe.Sizeof(*(*PhysicalDeviceFragmentShadingRateEnumsFeaturesNV)(nil)), unsafe.Alignof(*(*PhysicalDeviceFragmentShadingRateEnumsFeaturesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceFragmentShadingRateEnumsFeaturesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceFragmentShadingRateEnumsFeaturesNV)(nil).PNext)},
+		{"fragmentShadingRateEnums", unsafe.Offsetof((*PhysicalDeviceFragmentShadingRateEnumsFeaturesNV)(nil).FragmentShadingRateEnums)},
+		{"supersampleFragmentShadingRates", unsafe.Offsetof((*PhysicalDeviceFragmentShadingRateEnumsFeaturesNV)(nil).SupersampleFragmentShadingRates)},
+		{"noInvocationFragmentShadingRates", unsafe.Offsetof((*PhysicalDeviceFragmentShadingRateEnumsFeaturesNV)(nil).NoInvocationFragmentShadingRates)},
+	}},
+	{"VkPhysicalDeviceFragmentShadingRateEnumsPropertiesNV", unsafe.Sizeof(*(*PhysicalDeviceFragmentShadingRateEnumsPropertiesNV)(nil)), unsafe.Alignof(*(*PhysicalDeviceFragmentShadingRateEnumsPropertiesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceFragmentShadingRateEnumsPropertiesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceFragmentShadingRateEnumsPropertiesNV)(nil).PNext)},
+		{"maxFragmentShadingRateInvocationCount", unsafe.Offsetof((*PhysicalDeviceFragmentShadingRateEnumsPropertiesNV)(nil).MaxFragmentShadingRateInvocationCount)},
+	}},
+	{"VkPipelineFragmentShadingRateEnumStateCreateInfoNV", unsafe.Sizeof(*(*PipelineFragmentShadingRateEnumStateCreateInfoNV)(nil)), unsafe.Alignof(*(*PipelineFragmentShadingRateEnumStateCreateInfoNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineFragmentShadingRateEnumStateCreateInfoNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineFragmentShadingRateEnumStateCreateInfoNV)(nil).PNext)},
+		{"shadingRateType", unsafe.Offsetof((*PipelineFragmentShadingRateEnumStateCreateInfoNV)(nil).ShadingRateType)},
+		{"shadingRate", unsafe.Offsetof((*PipelineFragmentShadingRateEnumStateCreateInfoNV)(nil).ShadingRate)},
+		{"combinerOps", unsafe.Offsetof((*PipelineFragmentShadingRateEnumStateCreateInfoNV)(nil).CombinerOps)},
+	}},
+	{"VkAccelerationStructureGeometryMotionTrianglesDataNV", unsafe.Sizeof(*(*AccelerationStructureGeometryMotionTrianglesDataNV)(nil)), unsafe.Alignof(*(*AccelerationStructureGeometryMotionTrianglesDataNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*AccelerationStructureGeometryMotionTrianglesDataNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*AccelerationStructureGeometryMotionTrianglesDataNV)(nil).PNext)},
+		{"vertexData", unsafe.Offsetof((*AccelerationStructureGeometryMotionTrianglesDataNV)(nil).VertexData)},
+	}},
+	{"VkAccelerationStructureMotionInfoNV", unsafe.Sizeof(*(*AccelerationStructureMotionInfoNV)(nil)), unsafe.Alignof(*(*AccelerationStructureMotionInfoNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*AccelerationStructureMotionInfoNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*AccelerationStructureMotionInfoNV)(nil).PNext)},
+		{"maxInstances", unsafe.Offsetof((*AccelerationStructureMotionInfoNV)(nil).MaxInstances)},
+		{"flags", unsafe.Offsetof((*AccelerationStructureMotionInfoNV)(nil).Flags)},
+	}},
+	{"VkAccelerationStructureMatrixMotionInstanceNV", unsafe.Sizeof(*(*AccelerationStructureMatrixMotionInstanceNV)(nil)), unsafe.Alignof(*(*AccelerationStructureMatrixMotionInstanceNV)(nil)), []memberOffset{
+		{"transformT0", unsafe.Offsetof((*AccelerationStructureMatrixMotionInstanceNV)(nil).TransformT0)},
+		{"transformT1", unsafe.Offsetof((*AccelerationStructureMatrixMotionInstanceNV)(nil).TransformT1)},
+		{"accelerationStructureReference", unsafe.Offsetof((*AccelerationStructureMatrixMotionInstanceNV)(nil).AccelerationStructureReference)},
+	}},
+	{"VkSRTDataNV", unsafe.Sizeof(*(*SRTDataNV)(nil)), unsafe.Alignof(*(*SRTDataNV)(nil)), []memberOffset{
+		{"sx", unsafe.Offsetof((*SRTDataNV)(nil).Sx)},
+		{"a", unsafe.Offsetof((*SRTDataNV)(nil).A)},
+		{"b", unsafe.Offsetof((*SRTDataNV)(nil).B)},
+		{"pvx", unsafe.Offsetof((*SRTDataNV)(nil).Pvx)},
+		{"sy", unsafe.Offsetof((*SRTDataNV)(nil).Sy)},
+		{"c", unsafe.Offsetof((*SRTDataNV)(nil).C)},
+		{"pvy", unsafe.Offsetof((*SRTDataNV)(nil).Pvy)},
+		{"sz", unsafe.Offsetof((*SRTDataNV)(nil).Sz)},
+		{"pvz", unsafe.Offsetof((*SRTDataNV)(nil).Pvz)},
+		{"qx", unsafe.Offsetof((*SRTDataNV)(nil).Qx)},
+		{"qy", unsafe.Offsetof((*SRTDataNV)(nil).Qy)},
+		{"qz", unsafe.Offsetof((*SRTDataNV)(nil).Qz)},
+		{"qw", unsafe.Offsetof((*SRTDataNV)(nil).Qw)},
+		{"tx", unsafe.Offsetof((*SRTDataNV)(nil).Tx)},
+		{"ty", unsafe.Offsetof((*SRTDataNV)(nil).Ty)},
+		{"tz", unsafe.Offsetof((*SRTDataNV)(nil).Tz)},
+	}},
+	{"VkAccelerationStructureSRTMotionInstanceNV", unsafe.Sizeof(*(*AccelerationStructureSRTMotionInstanceNV)(nil)), unsafe.Alignof(*(*AccelerationStructureSRTMotionInstanceNV)(nil)), []memberOffset{
+		{"transformT0", unsafe.Offsetof((*AccelerationStructureSRTMotionInstanceNV)(nil).TransformT0)},
+		{"transformT1", unsafe.Offsetof((*AccelerationStructureSRTMotionInstanceNV)(nil).TransformT1)},
+		{"accelerationStructureReference", unsafe.Offsetof((*AccelerationStructureSRTMotionInstanceNV)(nil).AccelerationStructureReference)},
+	}},
+	{"VkAccelerationStructureMotionInstanceDataNV", unsafe.Sizeof(*(*AccelerationStructureMotionInstanceDataNV)(nil)), unsafe.Alignof(*(*AccelerationStructureMotionInstanceDataNV)(nil)), nil},
+	{"VkAccelerationStructureMotionInstanceNV", unsafe.Sizeof(*(*AccelerationStructureMotionInstanceNV)(nil)), unsafe.Alignof(*(*AccelerationStructureMotionInstanceNV)(nil)), []memberOffset{
+		{"type", unsafe.Offsetof((*AccelerationStructureMotionInstanceNV)(nil).Type)},
+		{"flags", unsafe.Offsetof((*AccelerationStructureMotionInstanceNV)(nil).Flags)},
+		{"data", unsafe.Offsetof((*AccelerationStructureMotionInstanceNV)(nil).Data)},
+	}},
+	{"VkPhysicalDeviceRayTracingMotionBlurFeaturesNV", unsafe.Sizeof(*(*PhysicalDeviceRayTracingMotionBlurFeaturesNV)(nil)), unsafe.Alignof(*(*PhysicalDeviceRayTracingMotionBlurFeaturesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceRayTracingMotionBlurFeaturesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceRayTracingMotionBlurFeaturesNV)(nil).PNext)},
+		{"rayTracingMotionBlur", unsafe.Offsetof((*PhysicalDeviceRayTracingMotionBlurFeaturesNV)(nil).RayTracingMotionBlur)},
+		{"rayTracingMotionBlurPipelineTraceRaysIndirect", unsafe.Offsetof((*PhysicalDeviceRayTracingMotionBlurFeaturesNV)(nil).RayTracingMotionBlurPipelineTraceRaysIndirect)},
+	}},
+	{"VkPhysicalDeviceMeshShaderFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceMeshShaderFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceMeshShaderFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceMeshShaderFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceMeshShaderFeaturesEXT)(nil).PNext)},
+		{"taskShader", unsafe.Offsetof((*PhysicalDeviceMeshShaderFeaturesEXT)(nil).TaskShader)},
+		{"meshShader", unsafe.Offsetof((*PhysicalDeviceMeshShaderFeaturesEXT)(nil).MeshShader)},
+		{"multiviewMeshShader", unsafe.Offsetof((*PhysicalDeviceMeshShaderFeaturesEXT)(nil).MultiviewMeshShader)},
+		{"primitiveFragmentShadingRateMeshShader", unsafe.Offsetof((*PhysicalDeviceMeshShaderFeaturesEXT)(nil).PrimitiveFragmentShadingRateMeshShader)},
+		{"meshShaderQueries", unsafe.Offsetof((*PhysicalDeviceMeshShaderFeaturesEXT)(nil).MeshShaderQueries)},
+	}},
+	{"VkPhysicalDeviceMeshShaderPropertiesEXT", unsafe.Sizeof(*(*PhysicalDeviceMeshShaderPropertiesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceMeshShaderPropertiesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesEXT)(nil).PNext)},
+		{"maxTaskWorkGroupTotalCount", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesEXT)(nil).MaxTaskWorkGroupTotalCount)},
+		{"maxTaskWorkGroupCount", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesEXT)(nil).MaxTaskWorkGroupCount)},
+		{"maxTaskWorkGroupInvocations", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesEXT)(nil).MaxTaskWorkGroupInvocations)},
+		{"maxTaskWorkGroupSize", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesEXT)(nil).MaxTaskWorkGroupSize)},
+		{"maxTaskPayloadSize", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesEXT)(nil).MaxTaskPayloadSize)},
+		{"maxTaskSharedMemorySize", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesEXT)(nil).MaxTaskSharedMemorySize)},
+		{"maxTaskPayloadAndSharedMemorySize", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesEXT)(nil).MaxTaskPayloadAndSharedMemorySize)},
+		{"maxMeshWorkGroupTotalCount", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesEXT)(nil).MaxMeshWorkGroupTotalCount)},
+		{"maxMeshWorkGroupCount", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesEXT)(nil).MaxMeshWorkGroupCount)},
+		{"maxMeshWorkGroupInvocations", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesEXT)(nil).MaxMeshWorkGroupInvocations)},
+		{"maxMeshWorkGroupSize", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesEXT)(nil).MaxMeshWorkGroupSize)},
+		{"maxMeshSharedMemorySize", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesEXT)(nil).MaxMeshSharedMemorySize)},
+		{"maxMeshPayloadAndSharedMemorySize", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesEXT)(nil).MaxMeshPayloadAndSharedMemorySize)},
+		{"maxMeshOutputMemorySize", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesEXT)(nil).MaxMeshOutputMemorySize)},
+		{"maxMeshPayloadAndOutputMemorySize", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesEXT)(nil).MaxMeshPayloadAndOutputMemorySize)},
+		{"maxMeshOutputComponents", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesEXT)(nil).MaxMeshOutputComponents)},
+		{"maxMeshOutputVertices", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesEXT)(nil).MaxMeshOutputVertices)},
+		{"maxMeshOutputPrimitives", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesEXT)(nil).MaxMeshOutputPrimitives)},
+		{"maxMeshOutputLayers", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesEXT)(nil).MaxMeshOutputLayers)},
+		{"maxMeshMultiviewViewCount", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesEXT)(nil).MaxMeshMultiviewViewCount)},
+		{"meshOutputPerVertexGranularity", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesEXT)(nil).MeshOutputPerVertexGranularity)},
+		{"meshOutputPerPrimitiveGranularity", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesEXT)(nil).MeshOutputPerPrimitiveGranularity)},
+		{"maxPreferredTaskWorkGroupInvocations", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesEXT)(nil).MaxPreferredTaskWorkGroupInvocations)},
+		{"maxPreferredMeshWorkGroupInvocations", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesEXT)(nil).MaxPreferredMeshWorkGroupInvocations)},
+		{"prefersLocalInvocationVertexOutput", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesEXT)(nil).PrefersLocalInvocationVertexOutput)},
+		{"prefersLocalInvocationPrimitiveOutput", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesEXT)(nil).PrefersLocalInvocationPrimitiveOutput)},
+		{"prefersCompactVertexOutput", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesEXT)(nil).PrefersCompactVertexOutput)},
+		{"prefersCompactPrimitiveOutput", unsafe.Offsetof((*PhysicalDeviceMeshShaderPropertiesEXT)(nil).PrefersCompactPrimitiveOutput)},
+	}},
+	{"VkDrawMeshTasksIndirectCommandEXT", unsafe.Sizeof(*(*DrawMeshTasksIndirectCommandEXT)(nil)), unsafe.Alignof(*(*DrawMeshTasksIndirectCommandEXT)(nil)), []memberOffset{
+		{"groupCountX", unsafe.Offsetof((*DrawMeshTasksIndirectCommandEXT)(nil).GroupCountX)},
+		{"groupCountY", unsafe.Offsetof((*DrawMeshTasksIndirectCommandEXT)(nil).GroupCountY)},
+		{"groupCountZ", unsafe.Offsetof((*DrawMeshTasksIndirectCommandEXT)(nil).GroupCountZ)},
+	}},
+	{"VkPhysicalDeviceYcbcr2Plane444FormatsFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceYcbcr2Plane444FormatsFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceYcbcr2Plane444FormatsFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceYcbcr2Plane444FormatsFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceYcbcr2Plane444FormatsFeaturesEXT)(nil).PNext)},
+		{"ycbcr2plane444Formats", unsafe.Offsetof((*PhysicalDeviceYcbcr2Plane444FormatsFeaturesEXT)(nil).Ycbcr2plane444Formats)},
+	}},
+	{"VkPhysicalDeviceFragmentDensityMap2FeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceFragmentDensityMap2FeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceFragmentDensityMap2FeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceFragmentDensityMap2FeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceFragmentDensityMap2FeaturesEXT)(nil).PNext)},
+		{"fragmentDensityMapDeferred", unsafe.Offsetof((*PhysicalDeviceFragmentDensityMap2FeaturesEXT)(nil).FragmentDensityMapDeferred)},
+	}},
+	{"VkPhysicalDeviceFragmentDensityMap2PropertiesEXT", unsafe.Sizeof(*(*PhysicalDeviceFragmentDensityMap2PropertiesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceFragmentDensityMap2PropertiesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceFragmentDensityMap2PropertiesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceFragmentDensityMap2PropertiesEXT)(nil).PNext)},
+		{"subsampledLoads", unsafe.Offsetof((*PhysicalDeviceFragmentDensityMap2PropertiesEXT)(nil).SubsampledLoads)},
+		{"subsampledCoarseReconstructionEarlyAccess", unsafe.Offsetof((*PhysicalDeviceFragmentDensityMap2PropertiesEXT)(nil).SubsampledCoarseReconstructionEarlyAccess)},
+		{"maxSubsampledArrayLayers", unsafe.Offsetof((*PhysicalDeviceFragmentDensityMap2PropertiesEXT)(nil).MaxSubsampledArrayLayers)},
+		{"maxDescriptorSetSubsampledSamplers", unsafe.Offsetof((*PhysicalDeviceFragmentDensityMap2PropertiesEXT)(nil).MaxDescriptorSetSubsampledSamplers)},
+	}},
+	{"VkCopyCommandTransformInfoQCOM", unsafe.Sizeof(*(*CopyCommandTransformInfoQCOM)(nil)), unsafe.Alignof(*(*CopyCommandTransformInfoQCOM)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*CopyCommandTransformInfoQCOM)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*CopyCommandTransformInfoQCOM)(nil).PNext)},
+		{"transform", unsafe.Offsetof((*CopyCommandTransformInfoQCOM)(nil).Transform)},
+	}},
+	{"VkPhysicalDeviceImageCompressionControlFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceImageCompressionControlFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceImageCompressionControlFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceImageCompressionControlFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceImageCompressionControlFeaturesEXT)(nil).PNext)},
+		{"imageCompressionControl", unsafe.Offsetof((*PhysicalDeviceImageCompressionControlFeaturesEXT)(nil).ImageCompressionControl)},
+	}},
+	{"VkImageCompressionControlEXT", unsafe.Sizeof(*(*ImageCompressionControlEXT)(nil)), unsafe.Alignof(*(*ImageCompressionControlEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ImageCompressionControlEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ImageCompressionControlEXT)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*ImageCompressionControlEXT)(nil).Flags)},
+		{"compressionControlPlaneCount", unsafe.Offsetof((*ImageCompressionControlEXT)(nil).CompressionControlPlaneCount)},
+		{"pFixedRateFlags", unsafe.Offsetof((*ImageCompressionControlEXT)(nil).PFixedRateFlags)},
+	}},
+	{"VkSubresourceLayout2EXT", unsafe.Sizeof(*(*SubresourceLayout2EXT)(nil)), unsafe.Alignof(*(*SubresourceLayout2EXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SubresourceLayout2EXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SubresourceLayout2EXT)(nil).PNext)},
+		{"subresourceLayout", unsafe.Offsetof((*SubresourceLayout2EXT)(nil).SubresourceLayout)},
+	}},
+	{"VkImageSubresource2EXT", unsafe.Sizeof(*(*ImageSubresource2EXT)(nil)), unsafe.Alignof(*(*ImageSubresource2EXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ImageSubresource2EXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ImageSubresource2EXT)(nil).PNext)},
+		{"imageSubresource", unsafe.Offsetof((*ImageSubresource2EXT)(nil).ImageSubresource)},
+	}},
+	{"VkImageCompressionPropertiesEXT", unsafe.Sizeof(*(*ImageCompressionPropertiesEXT)(nil)), unsafe.Alignof(*(*ImageCompressionPropertiesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ImageCompressionPropertiesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ImageCompressionPropertiesEXT)(nil).PNext)},
+		{"imageCompressionFlags", unsafe.Offsetof((*ImageCompressionPropertiesEXT)(nil).ImageCompressionFlags)},
+		{"imageCompressionFixedRateFlags", unsafe.Offsetof((*ImageCompressionPropertiesEXT)(nil).ImageCompressionFixedRateFlags)},
+	}},
+	{"VkPhysicalDeviceAttachmentFeedbackLoopLayoutFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceAttachmentFeedbackLoopLayoutFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceAttachmentFeedbackLoopLayoutFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceAttachmentFeedbackLoopLayoutFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceAttachmentFeedbackLoopLayoutFeaturesEXT)(nil).PNext)},
+		{"attachmentFeedbackLoopLayout", unsafe.Offsetof((*PhysicalDeviceAttachmentFeedbackLoopLayoutFeaturesEXT)(nil).AttachmentFeedbackLoopLayout)},
+	}},
+	{"VkPhysicalDevice4444FormatsFeaturesEXT", unsafe.Sizeof(*(*PhysicalDevice4444FormatsFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDevice4444FormatsFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDevice4444FormatsFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDevice4444FormatsFeaturesEXT)(nil).PNext)},
+		{"formatA4R4G4B4", unsafe.Offsetof((*PhysicalDevice4444FormatsFeaturesEXT)(nil).FormatA4R4G4B4)},
+		{"formatA4B4G4R4", unsafe.Offsetof((*PhysicalDevice4444FormatsFeaturesEXT)(nil).FormatA4B4G4R4)},
+	}},
+	{"VkPhysicalDeviceFaultFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceFaultFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceFaultFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceFaultFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceFaultFeaturesEXT)(nil).PNext)},
+		{"deviceFault", unsafe.Offsetof((*PhysicalDeviceFaultFeaturesEXT)(nil).DeviceFault)},
+		{"deviceFaultVendorBinary", unsafe.Offsetof((*PhysicalDeviceFaultFeaturesEXT)(nil).DeviceFaultVendorBinary)},
+	}},
+	{"VkDeviceFaultCountsEXT", unsafe.Sizeof(*(*DeviceFaultCountsEXT)(nil)), unsafe.Alignof(*(*DeviceFaultCountsEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DeviceFaultCountsEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DeviceFaultCountsEXT)(nil).PNext)},
+		{"addressInfoCount", unsafe.Offsetof((*DeviceFaultCountsEXT)(nil).AddressInfoCount)},
+		{"vendorInfoCount", unsafe.Offsetof((*DeviceFaultCountsEXT)(nil).VendorInfoCount)},
+		{"vendorBinarySize", unsafe.Offsetof((*DeviceFaultCountsEXT)(nil).VendorBinarySize)},
+	}},
+	{"VkDeviceFaultAddressInfoEXT", unsafe.Sizeof(*(*DeviceFaultAddressInfoEXT)(nil)), unsafe.Alignof(*(*DeviceFaultAddressInfoEXT)(nil)), []memberOffset{
+		{"addressType", unsafe.Offsetof((*DeviceFaultAddressInfoEXT)(nil).AddressType)},
+		{"reportedAddress", unsafe.Offsetof((*DeviceFaultAddressInfoEXT)(nil).ReportedAddress)},
+		{"addressPrecision", unsafe.Offsetof((*DeviceFaultAddressInfoEXT)(nil).AddressPrecision)},
+	}},
+	{"VkDeviceFaultVendorInfoEXT", unsafe.Sizeof(*(*DeviceFaultVendorInfoEXT)(nil)), unsafe.Alignof(*(*DeviceFaultVendorInfoEXT)(nil)), []memberOffset{
+		{"description", unsafe.Offsetof((*DeviceFaultVendorInfoEXT)(nil).Description)},
+		{"vendorFaultCode", unsafe.Offsetof((*DeviceFaultVendorInfoEXT)(nil).VendorFaultCode)},
+		{"vendorFaultData", unsafe.Offsetof((*DeviceFaultVendorInfoEXT)(nil).VendorFaultData)},
+	}},
+	{"VkDeviceFaultInfoEXT", unsafe.Sizeof(*(*DeviceFaultInfoEXT)(nil)), unsafe.Alignof(*(*DeviceFaultInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DeviceFaultInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DeviceFaultInfoEXT)(nil).PNext)},
+		{"description", unsafe.Offsetof((*DeviceFaultInfoEXT)(nil).Description)},
+		{"pAddressInfos", unsafe.Offsetof((*DeviceFaultInfoEXT)(nil).PAddressInfos)},
+		{"pVendorInfos", unsafe.Offsetof((*DeviceFaultInfoEXT)(nil).PVendorInfos)},
+		{"pVendorBinaryData", unsafe.Offsetof((*DeviceFaultInfoEXT)(nil).PVendorBinaryData)},
+	}},
+	{"VkDeviceFaultVendorBinaryHeaderVersionOneEXT", unsafe.Sizeof(*(*DeviceFaultVendorBinaryHeaderVersionOneEXT)(nil)), unsafe.Alignof(*(*DeviceFaultVendorBinaryHeaderVersionOneEXT)(nil)), []memberOffset{
+		{"headerSize", unsafe.Offsetof((*DeviceFaultVendorBinaryHeaderVersionOneEXT)(nil).HeaderSize)},
+		{"headerVersion", unsafe.Offsetof((*DeviceFaultVendorBinaryHeaderVersionOneEXT)(nil).HeaderVersion)},
+		{"vendorID", unsafe.Offsetof((*DeviceFaultVendorBinaryHeaderVersionOneEXT)(nil).VendorID)},
+		{"deviceID", unsafe.Offsetof((*DeviceFaultVendorBinaryHeaderVersionOneEXT)(nil).DeviceID)},
+		{"driverVersion", unsafe.Offsetof((*DeviceFaultVendorBinaryHeaderVersionOneEXT)(nil).DriverVersion)},
+		{"pipelineCacheUUID", unsafe.Offsetof((*DeviceFaultVendorBinaryHeaderVersionOneEXT)(nil).PipelineCacheUUID)},
+		{"applicationNameOffset", unsafe.Offsetof((*DeviceFaultVendorBinaryHeaderVersionOneEXT)(nil).ApplicationNameOffset)},
+		{"applicationVersion", unsafe.Offsetof((*DeviceFaultVendorBinaryHeaderVersionOneEXT)(nil).ApplicationVersion)},
+		{"engineNameOffset", unsafe.Offsetof((*DeviceFaultVendorBinaryHeaderVersionOneEXT)(nil).EngineNameOffset)},
+	}},
+	{"VkPhysicalDeviceRasterizationOrderAttachmentAccessFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceRasterizationOrderAttachmentAccessFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceRasterizationOrderAttachmentAccessFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceRasterizationOrderAttachmentAccessFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceRasterizationOrderAttachmentAccessFeaturesEXT)(nil).PNext)},
+		{"rasterizationOrderColorAttachmentAccess", unsafe.Offsetof((*PhysicalDeviceRasterizationOrderAttachmentAccessFeaturesEXT)(nil).RasterizationOrderColorAttachmentAccess)},
+		{"rasterizationOrderDepthAttachmentAccess", unsafe.Offsetof((*PhysicalDeviceRasterizationOrderAttachmentAccessFeaturesEXT)(nil).RasterizationOrderDepthAttachmentAccess)},
+		{"rasterizationOrderStencilAttachmentAccess", unsafe.Offsetof((*PhysicalDeviceRasterizationOrderAttachmentAccessFeaturesEXT)(nil).RasterizationOrderStencilAttachmentAccess)},
+	}},
+	{"VkPhysicalDeviceRGBA10X6FormatsFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceRGBA10X6FormatsFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceRGBA10X6FormatsFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceRGBA10X6FormatsFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceRGBA10X6FormatsFeaturesEXT)(nil).PNext)},
+		{"formatRgba10x6WithoutYCbCrSampler", unsafe.Offsetof((*PhysicalDeviceRGBA10X6FormatsFeaturesEXT)(nil).FormatRgba10x6WithoutYCbCrSampler)},
+	}},
+	{"VkPhysicalDeviceMutableDescriptorTypeFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceMutableDescriptorTypeFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceMutableDescriptorTypeFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceMutableDescriptorTypeFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceMutableDescriptorTypeFeaturesEXT)(nil).PNext)},
+		{"mutableDescriptorType", unsafe.Offsetof((*PhysicalDeviceMutableDescriptorTypeFeaturesEXT)(nil).MutableDescriptorType)},
+	}},
+	{"VkMutableDescriptorTypeListEXT", unsafe.Sizeof(*(*MutableDescriptorTypeListEXT)(nil)), unsafe.Alignof(*(*MutableDescriptorTypeListEXT)(nil)), []memberOffset{
+		{"descriptorTypeCount", unsafe.Offsetof((*MutableDescriptorTypeListEXT)(nil).DescriptorTypeCount)},
+		{"pDescriptorTypes", unsafe.Offsetof((*MutableDescriptorTypeListEXT)(nil).PDescriptorTypes)},
+	}},
+	{"VkMutableDescriptorTypeCreateInfoEXT", unsafe.Sizeof(*(*MutableDescriptorTypeCreateInfoEXT)(nil)), unsafe.Alignof(*(*MutableDescriptorTypeCreateInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*MutableDescriptorTypeCreateInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*MutableDescriptorTypeCreateInfoEXT)(nil).PNext)},
+		{"mutableDescriptorTypeListCount", unsafe.Offsetof((*MutableDescriptorTypeCreateInfoEXT)(nil).MutableDescriptorTypeListCount)},
+		{"pMutableDescriptorTypeLists", unsafe.Offsetof((*MutableDescriptorTypeCreateInfoEXT)(nil).PMutableDescriptorTypeLists)},
+	}},
+	{"VkPhysicalDeviceVertexInputDynamicStateFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceVertexInputDynamicStateFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceVertexInputDynamicStateFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceVertexInputDynamicStateFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceVertexInputDynamicStateFeaturesEXT)(nil).PNext)},
+		{"vertexInputDynamicState", unsafe.Offsetof((*PhysicalDeviceVertexInputDynamicStateFeaturesEXT)(nil).VertexInputDynamicState)},
+	}},
+	{"VkVertexInputBindingDescription2EXT", unsafe.Sizeof(*(*VertexInputBindingDescription2EXT)(nil)), unsafe.Alignof(*(*VertexInputBindingDescription2EXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*VertexInputBindingDescription2EXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*VertexInputBindingDescription2EXT)(nil).PNext)},
+		{"binding", unsafe.Offsetof((*VertexInputBindingDescription2EXT)(nil).Binding)},
+		{"stride", unsafe.Offsetof((*VertexInputBindingDescription2EXT)(nil).Stride)},
+		{"inputRate", unsafe.Offsetof((*VertexInputBindingDescription2EXT)(nil).InputRate)},
+		{"divisor", unsafe.Offsetof((*VertexInputBindingDescription2EXT)(nil).Divisor)},
+	}},
+	{"VkVertexInputAttributeDescription2EXT", unsafe.Sizeof(*(*VertexInputAttributeDescription2EXT)(nil)), unsafe.Alignof(*(*VertexInputAttributeDescription2EXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*VertexInputAttributeDescription2EXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*VertexInputAttributeDescription2EXT)(nil).PNext)},
+		{"location", unsafe.Offsetof((*VertexInputAttributeDescription2EXT)(nil).Location)},
+		{"binding", unsafe.Offsetof((*VertexInputAttributeDescription2EXT)(nil).Binding)},
+		{"format", unsafe.Offsetof((*VertexInputAttributeDescription2EXT)(nil).Format)},
+		{"offset", unsafe.Offsetof((*VertexInputAttributeDescription2EXT)(nil).Offset)},
+	}},
+	{"VkPhysicalDeviceDrmPropertiesEXT", unsafe.Sizeof(*(*PhysicalDeviceDrmPropertiesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceDrmPropertiesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceDrmPropertiesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceDrmPropertiesEXT)(nil).PNext)},
+		{"hasPrimary", unsafe.Offsetof((*PhysicalDeviceDrmPropertiesEXT)(nil).HasPrimary)},
+		{"hasRender", unsafe.Offsetof((*PhysicalDeviceDrmPropertiesEXT)(nil).HasRender)},
+		{"primaryMajor", unsafe.Offsetof((*PhysicalDeviceDrmPropertiesEXT)(nil).PrimaryMajor)},
+		{"primaryMinor", unsafe.Offsetof((*PhysicalDeviceDrmPropertiesEXT)(nil).PrimaryMinor)},
+		{"renderMajor", unsafe.Offsetof((*PhysicalDeviceDrmPropertiesEXT)(nil).RenderMajor)},
+		{"renderMinor", unsafe.Offsetof((*PhysicalDeviceDrmPropertiesEXT)(nil).RenderMinor)},
+	}},
+	{"VkPhysicalDeviceAddressBindingReportFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceAddressBindingReportFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceAddressBindingReportFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceAddressBindingReportFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceAddressBindingReportFeaturesEXT)(nil).PNext)},
+		{"reportAddressBinding", unsafe.Offsetof((*PhysicalDeviceAddressBindingReportFeaturesEXT)(nil).ReportAddressBinding)},
+	}},
+	{"VkDeviceAddressBindingCallbackDataEXT", unsafe.Sizeof(*(*DeviceAddressBindingCallbackDataEXT)(nil)), unsafe.Alignof(*(*DeviceAddressBindingCallbackDataEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DeviceAddressBindingCallbackDataEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DeviceAddressBindingCallbackDataEXT)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*DeviceAddressBindingCallbackDataEXT)(nil).Flags)},
+		{"baseAddress", unsafe.Offsetof((*DeviceAddressBindingCallbackDataEXT)(nil).BaseAddress)},
+		{"size", unsafe.Offsetof((*DeviceAddressBindingCallbackDataEXT)(nil).Size)},
+		{"bindingType", unsafe.Offsetof((*DeviceAddressBindingCallbackDataEXT)(nil).BindingType)},
+	}},
+	{"VkPhysicalDeviceDepthClipControlFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceDepthClipControlFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceDepthClipControlFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceDepthClipControlFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceDepthClipControlFeaturesEXT)(nil).PNext)},
+		{"depthClipControl", unsafe.Offsetof((*PhysicalDeviceDepthClipControlFeaturesEXT)(nil).DepthClipControl)},
+	}},
+	{"VkPipelineViewportDepthClipControlCreateInfoEXT", unsafe.Sizeof(*(*PipelineViewportDepthClipControlCreateInfoEXT)(nil)), unsafe.Alignof(*(*PipelineViewportDepthClipControlCreateInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineViewportDepthClipControlCreateInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineViewportDepthClipControlCreateInfoEXT)(nil).PNext)},
+		{"negativeOneToOne", unsafe.Offsetof((*PipelineViewportDepthClipControlCreateInfoEXT)(nil).NegativeOneToOne)},
+	}},
+	{"VkPhysicalDevicePrimitiveTopologyListRestartFeaturesEXT", unsafe.Sizeof(*(*PhysicalDevicePrimitiveTopologyListRestartFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDevicePrimitiveTopologyListRestartFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDevicePrimitiveTopologyListRestartFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDevicePrimitiveTopologyListRestartFeaturesEXT)(nil).PNext)},
+		{"primitiveTopologyListRestart", unsafe.Offsetof((*PhysicalDevicePrimitiveTopologyListRestartFeaturesEXT)(nil).PrimitiveTopologyListRestart)},
+		{"primitiveTopologyPatchListRestart", unsafe.Offsetof((*PhysicalDevicePrimitiveTopologyListRestartFeaturesEXT)(nil).PrimitiveTopologyPatchListRestart)},
+	}},
+	{"VkSubpassShadingPipelineCreateInfoHUAWEI", unsafe.Sizeof(*(*SubpassShadingPipelineCreateInfoHUAWEI)(nil)), unsafe.Alignof(*(*SubpassShadingPipelineCreateInfoHUAWEI)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SubpassShadingPipelineCreateInfoHUAWEI)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SubpassShadingPipelineCreateInfoHUAWEI)(nil).PNext)},
+		{"renderPass", unsafe.Offsetof((*SubpassShadingPipelineCreateInfoHUAWEI)(nil).RenderPass)},
+		{"subpass", unsafe.Offsetof((*SubpassShadingPipelineCreateInfoHUAWEI)(nil).Subpass)},
+	}},
+	{"VkPhysicalDeviceSubpassShadingFeaturesHUAWEI", unsafe.Sizeof(*(*PhysicalDeviceSubpassShadingFeaturesHUAWEI)(nil)), unsafe.Alignof(*(*PhysicalDeviceSubpassShadingFeaturesHUAWEI)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceSubpassShadingFeaturesHUAWEI)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceSubpassShadingFeaturesHUAWEI)(nil).PNext)},
+		{"subpassShading", unsafe.Offsetof((*PhysicalDeviceSubpassShadingFeaturesHUAWEI)(nil).SubpassShading)},
+	}},
+	{"VkPhysicalDeviceSubpassShadingPropertiesHUAWEI", unsafe.Sizeof(*(*PhysicalDeviceSubpassShadingPropertiesHUAWEI)(nil)), unsafe.Alignof(*(*PhysicalDeviceSubpassShadingPropertiesHUAWEI)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceSubpassShadingPropertiesHUAWEI)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceSubpassShadingPropertiesHUAWEI)(nil).PNext)},
+		{"maxSubpassShadingWorkgroupSizeAspectRatio", unsafe.Offsetof((*PhysicalDeviceSubpassShadingPropertiesHUAWEI)(nil).MaxSubpassShadingWorkgroupSizeAspectRatio)},
+	}},
+	{"VkPhysicalDeviceInvocationMaskFeaturesHUAWEI", unsafe.Sizeof(*(*PhysicalDeviceInvocationMaskFeaturesHUAWEI)(nil)), unsafe.Alignof(*(*PhysicalDeviceInvocationMaskFeaturesHUAWEI)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceInvocationMaskFeaturesHUAWEI)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceInvocationMaskFeaturesHUAWEI)(nil).PNext)},
+		{"invocationMask", unsafe.Offsetof((*PhysicalDeviceInvocationMaskFeaturesHUAWEI)(nil).InvocationMask)},
+	}},
+	{"VkMemoryGetRemoteAddressInfoNV", unsafe.Sizeof(*(*MemoryGetRemoteAddressInfoNV)(nil)), unsafe.Alignof(*(*MemoryGetRemoteAddressInfoNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*MemoryGetRemoteAddressInfoNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*MemoryGetRemoteAddressInfoNV)(nil).PNext)},
+		{"memory", unsafe.Offsetof((*MemoryGetRemoteAddressInfoNV)(nil).Memory)},
+		{"handleType", unsafe.Offsetof((*MemoryGetRemoteAddressInfoNV)(nil).HandleType)},
+	}},
+	{"VkPhysicalDeviceExternalMemoryRDMAFeaturesNV", unsafe.Sizeof(*(*PhysicalDeviceExternalMemoryRDMAFeaturesNV)(nil)), unsafe.Alignof(*(*PhysicalDeviceExternalMemoryRDMAFeaturesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceExternalMemoryRDMAFeaturesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceExternalMemoryRDMAFeaturesNV)(nil).PNext)},
+		{"externalMemoryRDMA", unsafe.Offsetof((*PhysicalDeviceExternalMemoryRDMAFeaturesNV)(nil).ExternalMemoryRDMA)},
+	}},
+	{"VkPipelinePropertiesIdentifierEXT", unsafe.Sizeof(*(*PipelinePropertiesIdentifierEXT)(nil)), unsafe.Alignof(*(*PipelinePropertiesIdentifierEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelinePropertiesIdentifierEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelinePropertiesIdentifierEXT)(nil).PNext)},
+		{"pipelineIdentifier", unsafe.Offsetof((*PipelinePropertiesIdentifierEXT)(nil).PipelineIdentifier)},
+	}},
+	{"VkPhysicalDevicePipelinePropertiesFeaturesEXT", unsafe.Sizeof(*(*PhysicalDevicePipelinePropertiesFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDevicePipelinePropertiesFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDevicePipelinePropertiesFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDevicePipelinePropertiesFeaturesEXT)(nil).PNext)},
+		{"pipelinePropertiesIdentifier", unsafe.Offsetof((*PhysicalDevicePipelinePropertiesFeaturesEXT)(nil).PipelinePropertiesIdentifier)},
+	}},
+	{"VkPhysicalDeviceMultisampledRenderToSingleSampledFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceMultisampledRenderToSingleSampledFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceMultisampledRenderToSingleSampledFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceMultisampledRenderToSingleSampledFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceMultisampledRenderToSingleSampledFeaturesEXT)(nil).PNext)},
+		{"multisampledRenderToSingleSampled", unsafe.Offsetof((*PhysicalDeviceMultisampledRenderToSingleSampledFeaturesEXT)(nil).MultisampledRenderToSingleSampled)},
+	}},
+	{"VkSubpassResolvePerformanceQueryEXT", unsafe.Sizeof(*(*SubpassResolvePerformanceQueryEXT)(nil)), unsafe.Alignof(*(*SubpassResolvePerformanceQueryEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SubpassResolvePerformanceQueryEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SubpassResolvePerformanceQueryEXT)(nil).PNext)},
+		{"optimal", unsafe.Offsetof((*SubpassResolvePerformanceQueryEXT)(nil).Optimal)},
+	}},
+	{"VkMultisampledRenderToSingleSampledInfoEXT", unsafe.Sizeof(*(*MultisampledRenderToSingleSampledInfoEXT)(nil)), unsafe.Alignof(*(*MultisampledRenderToSingleSampledInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*MultisampledRenderToSingleSampledInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*MultisampledRenderToSingleSampledInfoEXT)(nil).PNext)},
+		{"multisampledRenderToSingleSampledEnable", unsafe.Offsetof((*MultisampledRenderToSingleSampledInfoEXT)(nil).MultisampledRenderToSingleSampledEnable)},
+		{"rasterizationSamples", unsafe.Offsetof((*MultisampledRenderToSingleSampledInfoEXT)(nil).RasterizationSamples)},
+	}},
+	{"VkPhysicalDeviceExtendedDynamicState2FeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceExtendedDynamicState2FeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceExtendedDynamicState2FeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState2FeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState2FeaturesEXT)(nil).PNext)},
+		{"extendedDynamicState2", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState2FeaturesEXT)(nil).ExtendedDynamicState2)},
+		{"extendedDynamicState2LogicOp", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState2FeaturesEXT)(nil).ExtendedDynamicState2LogicOp)},
+		{"extendedDynamicState2PatchControlPoints", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState2FeaturesEXT)(nil).ExtendedDynamicState2PatchControlPoints)},
+	}},
+	{"VkPhysicalDeviceColorWriteEnableFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceColorWriteEnableFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceColorWriteEnableFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceColorWriteEnableFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceColorWriteEnableFeaturesEXT)(nil).PNext)},
+		{"colorWriteEnable", unsafe.Offsetof((*PhysicalDeviceColorWriteEnableFeaturesEXT)(nil).ColorWriteEnable)},
+	}},
+	{"VkPipelineColorWriteCreateInfoEXT", unsafe.Sizeof(*(*PipelineColorWriteCreateInfoEXT)(nil)), unsafe.Alignof(*(*PipelineColorWriteCreateInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineColorWriteCreateInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineColorWriteCreateInfoEXT)(nil).PNext)},
+		{"attachmentCount", unsafe.Offsetof((*PipelineColorWriteCreateInfoEXT)(nil).AttachmentCount)},
+		{"pColorWriteEnables", unsafe.Offsetof((*PipelineColorWriteCreateInfoEXT)(nil).PColorWriteEnables)},
+	}},
+	{"VkPhysicalDevicePrimitivesGeneratedQueryFeaturesEXT", unsafe.Sizeof(*(*PhysicalDevicePrimitivesGeneratedQueryFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDevicePrimitivesGeneratedQueryFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDevicePrimitivesGeneratedQueryFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDevicePrimitivesGeneratedQueryFeaturesEXT)(nil).PNext)},
+		{"primitivesGeneratedQuery", unsafe.Offsetof((*PhysicalDevicePrimitivesGeneratedQueryFeaturesEXT)(nil).PrimitivesGeneratedQuery)},
+		{"primitivesGeneratedQueryWithRasterizerDiscard", unsafe.Offsetof((*PhysicalDevicePrimitivesGeneratedQueryFeaturesEXT)(nil).PrimitivesGeneratedQueryWithRasterizerDiscard)},
+		{"primitivesGeneratedQueryWithNonZeroStreams", unsafe.Offsetof((*PhysicalDevicePrimitivesGeneratedQueryFeaturesEXT)(nil).PrimitivesGeneratedQueryWithNonZeroStreams)},
+	}},
+	{"VkPhysicalDeviceImageViewMinLodFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceImageViewMinLodFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceImageViewMinLodFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceImageViewMinLodFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceImageViewMinLodFeaturesEXT)(nil).PNext)},
+		{"minLod", unsafe.Offsetof((*PhysicalDeviceImageViewMinLodFeaturesEXT)(nil).MinLod)},
+	}},
+	{"VkImageViewMinLodCreateInfoEXT", unsafe.Sizeof(*(*ImageViewMinLodCreateInfoEXT)(nil)), unsafe.Alignof(*(*ImageViewMinLodCreateInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ImageViewMinLodCreateInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ImageViewMinLodCreateInfoEXT)(nil).PNext)},
+		{"minLod", unsafe.Offsetof((*ImageViewMinLodCreateInfoEXT)(nil).MinLod)},
+	}},
+	{"VkPhysicalDeviceMultiDrawFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceMultiDrawFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceMultiDrawFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceMultiDrawFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceMultiDrawFeaturesEXT)(nil).PNext)},
+		{"multiDraw", unsafe.Offsetof((*PhysicalDeviceMultiDrawFeaturesEXT)(nil).MultiDraw)},
+	}},
+	{"VkPhysicalDeviceMultiDrawPropertiesEXT", unsafe.Sizeof(*(*PhysicalDeviceMultiDrawPropertiesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceMultiDrawPropertiesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceMultiDrawPropertiesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceMultiDrawPropertiesEXT)(nil).PNext)},
+		{"maxMultiDrawCount", unsafe.Offsetof((*PhysicalDeviceMultiDrawPropertiesEXT)(nil).MaxMultiDrawCount)},
+	}},
+	{"VkMultiDrawInfoEXT", unsafe.Sizeof(*(*MultiDrawInfoEXT)(nil)), unsafe.Alignof(*(*MultiDrawInfoEXT)(nil)), []memberOffset{
+		{"firstVertex", unsafe.Offsetof((*MultiDrawInfoEXT)(nil).FirstVertex)},
+		{"vertexCount", unsafe.Offsetof((*MultiDrawInfoEXT)(nil).VertexCount)},
+	}},
+	{"VkMultiDrawIndexedInfoEXT", unsafe.Sizeof(*(*MultiDrawIndexedInfoEXT)(nil)), unsafe.Alignof(*(*MultiDrawIndexedInfoEXT)(nil)), []memberOffset{
+		{"firstIndex", unsafe.Offsetof((*MultiDrawIndexedInfoEXT)(nil).FirstIndex)},
+		{"indexCount", unsafe.Offsetof((*MultiDrawIndexedInfoEXT)(nil).IndexCount)},
+		{"vertexOffset", unsafe.Offsetof((*MultiDrawIndexedInfoEXT)(nil).VertexOffset)},
+	}},
+	{"VkPhysicalDeviceImage2DViewOf3DFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceImage2DViewOf3DFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceImage2DViewOf3DFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceImage2DViewOf3DFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceImage2DViewOf3DFeaturesEXT)(nil).PNext)},
+		{"image2DViewOf3D", unsafe.Offsetof((*PhysicalDeviceImage2DViewOf3DFeaturesEXT)(nil).Image2DViewOf3D)},
+		{"sampler2DViewOf3D", unsafe.Offsetof((*PhysicalDeviceImage2DViewOf3DFeaturesEXT)(nil).Sampler2DViewOf3D)},
+	}},
+	{"VkMicromapUsageEXT", unsafe.Sizeof(*(*MicromapUsageEXT)(nil)), unsafe.Alignof(*(*MicromapUsageEXT)(nil)), []memberOffset{
+		{"count", unsafe.Offsetof((*MicromapUsageEXT)(nil).Count)},
+		{"subdivisionLevel", unsafe.Offsetof((*MicromapUsageEXT)(nil).SubdivisionLevel)},
+		{"format", unsafe.Offsetof((*MicromapUsageEXT)(nil).Format)},
+	}},
+	{"VkMicromapBuildInfoEXT", unsafe.Sizeof(*(*MicromapBuildInfoEXT)(nil)), unsafe.Alignof(*(*MicromapBuildInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*MicromapBuildInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*MicromapBuildInfoEXT)(nil).PNext)},
+		{"type", unsafe.Offsetof((*MicromapBuildInfoEXT)(nil).Type)},
+		{"flags", unsafe.Offsetof((*MicromapBuildInfoEXT)(nil).Flags)},
+		{"mode", unsafe.Offsetof((*MicromapBuildInfoEXT)(nil).Mode)},
+		{"dstMicromap", unsafe.Offsetof((*MicromapBuildInfoEXT)(nil).DstMicromap)},
+		{"usageCountsCount", unsafe.Offsetof((*MicromapBuildInfoEXT)(nil).UsageCountsCount)},
+		{"pUsageCounts", unsafe.Offsetof((*MicromapBuildInfoEXT)(nil).PUsageCounts)},
+		{"ppUsageCounts", unsafe.Offsetof((*MicromapBuildInfoEXT)(nil).PpUsageCounts)},
+		{"data", unsafe.Offsetof((*MicromapBuildInfoEXT)(nil).Data)},
+		{"scratchData", unsafe.Offsetof((*MicromapBuildInfoEXT)(nil).ScratchData)},
+		{"triangleArray", unsafe.Offsetof((*MicromapBuildInfoEXT)(nil).TriangleArray)},
+		{"triangleArrayStride", unsafe.Offsetof((*MicromapBuildInfoEXT)(nil).TriangleArrayStride)},
+	}},
+	{"VkMicromapCreateInfoEXT", unsafe.Sizeof(*(*MicromapCreateInfoEXT)(nil)), unsafe.Alignof(*(*MicromapCreateInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*MicromapCreateInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*MicromapCreateInfoEXT)(nil).PNext)},
+		{"createFlags", unsafe.Offsetof((*MicromapCreateInfoEXT)(nil).CreateFlags)},
+		{"buffer", unsafe.Offsetof((*MicromapCreateInfoEXT)(nil).Buffer)},
+		{"offset", unsafe.Offsetof((*MicromapCreateInfoEXT)(nil).Offset)},
+		{"size", unsafe.Offsetof((*MicromapCreateInfoEXT)(nil).Size)},
+		{"type", unsafe.Offsetof((*MicromapCreateInfoEXT)(nil).Type)},
+		{"deviceAddress", unsafe.Offsetof((*MicromapCreateInfoEXT)(nil).DeviceAddress)},
+	}},
+	{"VkPhysicalDeviceOpacityMicromapFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceOpacityMicromapFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceOpacityMicromapFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceOpacityMicromapFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceOpacityMicromapFeaturesEXT)(nil).PNext)},
+		{"micromap", unsafe.Offsetof((*PhysicalDeviceOpacityMicromapFeaturesEXT)(nil).Micromap)},
+		{"micromapCaptureReplay", unsafe.Offsetof((*PhysicalDeviceOpacityMicromapFeaturesEXT)(nil).MicromapCaptureReplay)},
+		{"micromapHostCommands", unsafe.Offsetof((*PhysicalDeviceOpacityMicromapFeaturesEXT)(nil).MicromapHostCommands)},
+	}},
+	{"VkPhysicalDeviceOpacityMicromapPropertiesEXT", unsafe.Sizeof(*(*PhysicalDeviceOpacityMicromapPropertiesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceOpacityMicromapPropertiesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceOpacityMicromapPropertiesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceOpacityMicromapPropertiesEXT)(nil).PNext)},
+		{"maxOpacity2StateSubdivisionLevel", unsafe.Offsetof((*PhysicalDeviceOpacityMicromapPropertiesEXT)(nil).MaxOpacity2StateSubdivisionLevel)},
+		{"maxOpacity4StateSubdivisionLevel", unsafe.Offsetof((*PhysicalDeviceOpacityMicromapPropertiesEXT)(nil).MaxOpacity4StateSubdivisionLevel)},
+	}},
+	{"VkMicromapVersionInfoEXT", unsafe.Sizeof(*(*MicromapVersionInfoEXT)(nil)), unsafe.Alignof(*(*MicromapVersionInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*MicromapVersionInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*MicromapVersionInfoEXT)(nil).PNext)},
+		{"pVersionData", unsafe.Offsetof((*MicromapVersionInfoEXT)(nil).PVersionData)},
+	}},
+	{"VkCopyMicromapToMemoryInfoEXT", unsafe.Sizeof(*(*CopyMicromapToMemoryInfoEXT)(nil)), unsafe.Alignof(*(*CopyMicromapToMemoryInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*CopyMicromapToMemoryInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*CopyMicromapToMemoryInfoEXT)(nil).PNext)},
+		{"src", unsafe.Offsetof((*CopyMicromapToMemoryInfoEXT)(nil).Src)},
+		{"dst", unsafe.Offsetof((*CopyMicromapToMemoryInfoEXT)(nil).Dst)},
+		{"mode", unsafe.Offsetof((*CopyMicromapToMemoryInfoEXT)(nil).Mode)},
+	}},
+	{"VkCopyMemoryToMicromapInfoEXT", unsafe.Sizeof(*(*CopyMemoryToMicromapInfoEXT)(nil)), unsafe.Alignof(*(*CopyMemoryToMicromapInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*CopyMemoryToMicromapInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*CopyMemoryToMicromapInfoEXT)(nil).PNext)},
+		{"src", unsafe.Offsetof((*CopyMemoryToMicromapInfoEXT)(nil).Src)},
+		{"dst", unsafe.Offsetof((*CopyMemoryToMicromapInfoEXT)(nil).Dst)},
+		{"mode", unsafe.Offsetof((*CopyMemoryToMicromapInfoEXT)(nil).Mode)},
+	}},
+	{"VkCopyMicromapInfoEXT", unsafe.Sizeof(*(*CopyMicromapInfoEXT)(nil)), unsafe.Alignof(*(*CopyMicromapInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*CopyMicromapInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*CopyMicromapInfoEXT)(nil).PNext)},
+		{"src", unsafe.Offsetof((*CopyMicromapInfoEXT)(nil).Src)},
+		{"dst", unsafe.Offsetof((*CopyMicromapInfoEXT)(nil).Dst)},
+		{"mode", unsafe.Offsetof((*CopyMicromapInfoEXT)(nil).Mode)},
+	}},
+	{"VkMicromapBuildSizesInfoEXT", unsafe.Sizeof(*(*MicromapBuildSizesInfoEXT)(nil)), unsafe.Alignof(*(*MicromapBuildSizesInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*MicromapBuildSizesInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*MicromapBuildSizesInfoEXT)(nil).PNext)},
+		{"micromapSize", unsafe.Offsetof((*MicromapBuildSizesInfoEXT)(nil).MicromapSize)},
+		{"buildScratchSize", unsafe.Offsetof((*MicromapBuildSizesInfoEXT)(nil).BuildScratchSize)},
+		{"discardable", unsafe.Offsetof((*MicromapBuildSizesInfoEXT)(nil).Discardable)},
+	}},
+	{"VkAccelerationStructureTrianglesOpacityMicromapEXT", unsafe.Sizeof(*(*AccelerationStructureTrianglesOpacityMicromapEXT)(nil)), unsafe.Alignof(*(*AccelerationStructureTrianglesOpacityMicromapEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*AccelerationStructureTrianglesOpacityMicromapEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*AccelerationStructureTrianglesOpacityMicromapEXT)(nil).PNext)},
+		{"indexType", unsafe.Offsetof((*AccelerationStructureTrianglesOpacityMicromapEXT)(nil).IndexType)},
+		{"indexBuffer", unsafe.Offsetof((*AccelerationStructureTrianglesOpacityMicromapEXT)(nil).IndexBuffer)},
+		{"indexStride", unsafe.Offsetof((*AccelerationStructureTrianglesOpacityMicromapEXT)(nil).IndexStride)},
+		{"baseTriangle", unsafe.Offsetof((*AccelerationStructureTrianglesOpacityMicromapEXT)(nil).BaseTriangle)},
+		{"usageCountsCount", unsafe.Offsetof((*AccelerationStructureTrianglesOpacityMicromapEXT)(nil).UsageCountsCount)},
+		{"pUsageCounts", unsafe.Offsetof((*AccelerationStructureTrianglesOpacityMicromapEXT)(nil).PUsageCounts)},
+		{"ppUsageCounts", unsafe.Offsetof((*AccelerationStructureTrianglesOpacityMicromapEXT)(nil).PpUsageCounts)},
+		{"micromap", unsafe.Offsetof((*AccelerationStructureTrianglesOpacityMicromapEXT)(nil).Micromap)},
+	}},
+	{"VkMicromapTriangleEXT", unsafe.Sizeof(*(*MicromapTriangleEXT)(nil)), unsafe.Alignof(*(*MicromapTriangleEXT)(nil)), []memberOffset{
+		{"dataOffset", unsafe.Offsetof((*MicromapTriangleEXT)(nil).DataOffset)},
+		{"subdivisionLevel", unsafe.Offsetof((*MicromapTriangleEXT)(nil).SubdivisionLevel)},
+		{"format", unsafe.Offsetof((*MicromapTriangleEXT)(nil).Format)},
+	}},
+	{"VkPhysicalDeviceClusterCullingShaderFeaturesHUAWEI", unsafe.Sizeof(*(*PhysicalDeviceClusterCullingShaderFeaturesHUAWEI)(nil)), unsafe.Alignof(*(*PhysicalDeviceClusterCullingShaderFeaturesHUAWEI)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceClusterCullingShaderFeaturesHUAWEI)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceClusterCullingShaderFeaturesHUAWEI)(nil).PNext)},
+		{"clustercullingShader", unsafe.Offsetof((*PhysicalDeviceClusterCullingShaderFeaturesHUAWEI)(nil).ClustercullingShader)},
+		{"multiviewClusterCullingShader", unsafe.Offsetof((*PhysicalDeviceClusterCullingShaderFeaturesHUAWEI)(nil).MultiviewClusterCullingShader)},
+	}},
+	{"VkPhysicalDeviceClusterCullingShaderPropertiesHUAWEI", unsafe.Sizeof(*(*PhysicalDeviceClusterCullingShaderPropertiesHUAWEI)(nil)), unsafe.Alignof(*(*PhysicalDeviceClusterCullingShaderPropertiesHUAWEI)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceClusterCullingShaderPropertiesHUAWEI)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceClusterCullingShaderPropertiesHUAWEI)(nil).PNext)},
+		{"maxWorkGroupCount", unsafe.Offsetof((*PhysicalDeviceClusterCullingShaderPropertiesHUAWEI)(nil).MaxWorkGroupCount)},
+		{"maxWorkGroupSize", unsafe.Offsetof((*PhysicalDeviceClusterCullingShaderPropertiesHUAWEI)(nil).MaxWorkGroupSize)},
+		{"maxOutputClusterCount", unsafe.Offsetof((*PhysicalDeviceClusterCullingShaderPropertiesHUAWEI)(nil).MaxOutputClusterCount)},
+	}},
+	{"VkPhysicalDeviceBorderColorSwizzleFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceBorderColorSwizzleFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceBorderColorSwizzleFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceBorderColorSwizzleFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceBorderColorSwizzleFeaturesEXT)(nil).PNext)},
+		{"borderColorSwizzle", unsafe.Offsetof((*PhysicalDeviceBorderColorSwizzleFeaturesEXT)(nil).BorderColorSwizzle)},
+		{"borderColorSwizzleFromImage", unsafe.Offsetof((*PhysicalDeviceBorderColorSwizzleFeaturesEXT)(nil).BorderColorSwizzleFromImage)},
+	}},
+	{"VkSamplerBorderColorComponentMappingCreateInfoEXT", unsafe.Sizeof(*(*SamplerBorderColorComponentMappingCreateInfoEXT)(nil)), unsafe.Alignof(*(*SamplerBorderColorComponentMappingCreateInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SamplerBorderColorComponentMappingCreateInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SamplerBorderColorComponentMappingCreateInfoEXT)(nil).PNext)},
+		{"components", unsafe.Offsetof((*SamplerBorderColorComponentMappingCreateInfoEXT)(nil).Components)},
+		{"srgb", unsafe.Offsetof((*SamplerBorderColorComponentMappingCreateInfoEXT)(nil).Srgb)},
+	}},
+	{"VkPhysicalDevicePageableDeviceLocalMemoryFeaturesEXT", unsafe.Sizeof(*(*PhysicalDevicePageableDeviceLocalMemoryFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDevicePageableDeviceLocalMemoryFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDevicePageableDeviceLocalMemoryFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDevicePageableDeviceLocalMemoryFeaturesEXT)(nil).PNext)},
+		{"pageableDeviceLocalMemory", unsafe.Offsetof((*PhysicalDevicePageableDeviceLocalMemoryFeaturesEXT)(nil).PageableDeviceLocalMemory)},
+	}},
+	{"VkPhysicalDeviceDescriptorSetHostMappingFeaturesVALVE", unsafe.Sizeof(*(*PhysicalDeviceDescriptorSetHostMappingFeaturesVALVE)(nil)), unsafe.Alignof(*(*PhysicalDeviceDescriptorSetHostMappingFeaturesVALVE)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceDescriptorSetHostMappingFeaturesVALVE)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceDescriptorSetHostMappingFeaturesVALVE)(nil).PNext)},
+		{"descriptorSetHostMapping", unsafe.Offsetof((*PhysicalDeviceDescriptorSetHostMappingFeaturesVALVE)(nil).DescriptorSetHostMapping)},
+	}},
+	{"VkDescriptorSetBindingReferenceVALVE", unsafe.Sizeof(*(*DescriptorSetBindingReferenceVALVE)(nil)), unsafe.Alignof(*(*DescriptorSetBindingReferenceVALVE)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DescriptorSetBindingReferenceVALVE)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DescriptorSetBindingReferenceVALVE)(nil).PNext)},
+		{"descriptorSetLayout", unsafe.Offsetof((*DescriptorSetBindingReferenceVALVE)(nil).DescriptorSetLayout)},
+		{"binding", unsafe.Offsetof((*DescriptorSetBindingReferenceVALVE)(nil).Binding)},
+	}},
+	{"VkDescriptorSetLayoutHostMappingInfoVALVE", unsafe.Sizeof(*(*DescriptorSetLayoutHostMappingInfoVALVE)(nil)), unsafe.Alignof(*(*DescriptorSetLayoutHostMappingInfoVALVE)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DescriptorSetLayoutHostMappingInfoVALVE)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DescriptorSetLayoutHostMappingInfoVALVE)(nil).PNext)},
+		{"descriptorOffset", unsafe.Offsetof((*DescriptorSetLayoutHostMappingInfoVALVE)(nil).DescriptorOffset)},
+		{"descriptorSize", unsafe.Offsetof((*DescriptorSetLayoutHostMappingInfoVALVE)(nil).DescriptorSize)},
+	}},
+	{"VkPhysicalDeviceDepthClampZeroOneFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceDepthClampZeroOneFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceDepthClampZeroOneFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceDepthClampZeroOneFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceDepthClampZeroOneFeaturesEXT)(nil).PNext)},
+		{"depthClampZeroOne", unsafe.Offsetof((*PhysicalDeviceDepthClampZeroOneFeaturesEXT)(nil).DepthClampZeroOne)},
+	}},
+	{"VkPhysicalDeviceNonSeamlessCubeMapFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceNonSeamlessCubeMapFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceNonSeamlessCubeMapFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceNonSeamlessCubeMapFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceNonSeamlessCubeMapFeaturesEXT)(nil).PNext)},
+		{"nonSeamlessCubeMap", unsafe.Offsetof((*PhysicalDeviceNonSeamlessCubeMapFeaturesEXT)(nil).NonSeamlessCubeMap)},
+	}},
+	{"VkPhysicalDeviceFragmentDensityMapOffsetFeaturesQCOM", unsafe.Sizeof(*(*PhysicalDeviceFragmentDensityMapOffsetFeaturesQCOM)(nil)), unsafe.Alignof(*(*PhysicalDeviceFragmentDensityMapOffsetFeaturesQCOM)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceFragmentDensityMapOffsetFeaturesQCOM)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceFragmentDensityMapOffsetFeaturesQCOM)(nil).PNext)},
+		{"fragmentDensityMapOffset", unsafe.Offsetof((*PhysicalDeviceFragmentDensityMapOffsetFeaturesQCOM)(nil).FragmentDensityMapOffset)},
+	}},
+	{"VkPhysicalDeviceFragmentDensityMapOffsetPropertiesQCOM", unsafe.Sizeof(*(*PhysicalDeviceFragmentDensityMapOffsetPropertiesQCOM)(nil)), unsafe.Alignof(*(*PhysicalDeviceFragmentDensityMapOffsetPropertiesQCOM)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceFragmentDensityMapOffsetPropertiesQCOM)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceFragmentDensityMapOffsetPropertiesQCOM)(nil).PNext)},
+		{"fragmentDensityOffsetGranularity", unsafe.Offsetof((*PhysicalDeviceFragmentDensityMapOffsetPropertiesQCOM)(nil).FragmentDensityOffsetGranularity)},
+	}},
+	{"VkSubpassFragmentDensityMapOffsetEndInfoQCOM", unsafe.Sizeof(*(*SubpassFragmentDensityMapOffsetEndInfoQCOM)(nil)), unsafe.Alignof(*(*SubpassFragmentDensityMapOffsetEndInfoQCOM)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*SubpassFragmentDensityMapOffsetEndInfoQCOM)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*SubpassFragmentDensityMapOffsetEndInfoQCOM)(nil).PNext)},
+		{"fragmentDensityOffsetCount", unsafe.Offsetof((*SubpassFragmentDensityMapOffsetEndInfoQCOM)(nil).FragmentDensityOffsetCount)},
+		{"pFragmentDensityOffsets", unsafe.Offsetof((*SubpassFragmentDensityMapOffsetEndInfoQCOM)(nil).PFragmentDensityOffsets)},
+	}},
+	{"VkCopyMemoryIndirectCommandNV", unsafe.Sizeof(*(*CopyMemoryIndirectCommandNV)(nil)), unsafe.Alignof(*(*CopyMemoryIndirectCommandNV)(nil)), []memberOffset{
+		{"srcAddress", unsafe.Offsetof((*CopyMemoryIndirectCommandNV)(nil).SrcAddress)},
+		{"dstAddress", unsafe.Offsetof((*CopyMemoryIndirectCommandNV)(nil).DstAddress)},
+		{"size", unsafe.Offsetof((*CopyMemoryIndirectCommandNV)(nil).Size)},
+	}},
+	{"VkCopyMemoryToImageIndirectCommandNV", unsafe.Sizeof(*(*CopyMemoryToImageIndirectCommandNV)(nil)), unsafe.Alignof(*(*CopyMemoryToImageIndirectCommandNV)(nil)), []memberOffset{
+		{"srcAddress", unsafe.Offsetof((*CopyMemoryToImageIndirectCommandNV)(nil).SrcAddress)},
+		{"bufferRowLength", unsafe.Offsetof((*CopyMemoryToImageIndirectCommandNV)(nil).BufferRowLength)},
+		{"bufferImageHeight", unsafe.Offsetof((*CopyMemoryToImageIndirectCommandNV)(nil).BufferImageHeight)},
+		{"imageSubresource", unsafe.Offsetof((*CopyMemoryToImageIndirectCommandNV)(nil).ImageSubresource)},
+		{"imageOffset", unsafe.Offsetof((*CopyMemoryToImageIndirectCommandNV)(nil).ImageOffset)},
+		{"imageExtent", unsafe.Offsetof((*CopyMemoryToImageIndirectCommandNV)(nil).ImageExtent)},
+	}},
+	{"VkPhysicalDeviceCopyMemoryIndirectFeaturesNV", unsafe.Sizeof(*(*PhysicalDeviceCopyMemoryIndirectFeaturesNV)(nil)), unsafe.Alignof(*(*PhysicalDeviceCopyMemoryIndirectFeaturesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceCopyMemoryIndirectFeaturesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceCopyMemoryIndirectFeaturesNV)(nil).PNext)},
+		{"indirectCopy", unsafe.Offsetof((*PhysicalDeviceCopyMemoryIndirectFeaturesNV)(nil).IndirectCopy)},
+	}},
+	{"VkPhysicalDeviceCopyMemoryIndirectPropertiesNV", unsafe.Sizeof(*(*PhysicalDeviceCopyMemoryIndirectPropertiesNV)(nil)), unsafe.Alignof(*(*PhysicalDeviceCopyMemoryIndirectPropertiesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceCopyMemoryIndirectPropertiesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceCopyMemoryIndirectPropertiesNV)(nil).PNext)},
+		{"supportedQueues", unsafe.Offsetof((*PhysicalDeviceCopyMemoryIndirectPropertiesNV)(nil).SupportedQueues)},
+	}},
+	{"VkDecompressMemoryRegionNV", unsafe.Sizeof(*(*DecompressMemoryRegionNV)(nil)), unsafe.Alignof(*(*DecompressMemoryRegionNV)(nil)), []memberOffset{
+		{"srcAddress", unsafe.Offsetof((*DecompressMemoryRegionNV)(nil).SrcAddress)},
+		{"dstAddress", unsafe.Offsetof((*DecompressMemoryRegionNV)(nil).DstAddress)},
+		{"compressedSize", unsafe.Offsetof((*DecompressMemoryRegionNV)(nil).CompressedSize)},
+		{"decompressedSize", unsafe.Offsetof((*DecompressMemoryRegionNV)(nil).DecompressedSize)},
+		{"decompressionMethod", unsafe.Offsetof((*DecompressMemoryRegionNV)(nil).DecompressionMethod)},
+	}},
+	{"VkPhysicalDeviceMemoryDecompressionFeaturesNV", unsafe.Sizeof(*(*PhysicalDeviceMemoryDecompressionFeaturesNV)(nil)), unsafe.Alignof(*(*PhysicalDeviceMemoryDecompressionFeaturesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceMemoryDecompressionFeaturesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceMemoryDecompressionFeaturesNV)(nil).PNext)},
+		{"memoryDecompression", unsafe.Offsetof((*PhysicalDeviceMemoryDecompressionFeaturesNV)(nil).MemoryDecompression)},
+	}},
+	{"VkPhysicalDeviceMemoryDecompressionPropertiesNV", unsafe.Sizeof(*(*PhysicalDeviceMemoryDecompressionPropertiesNV)(nil)), unsafe.Alignof(*(*PhysicalDeviceMemoryDecompressionPropertiesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceMemoryDecompressionPropertiesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceMemoryDecompressionPropertiesNV)(nil).PNext)},
+		{"decompressionMethods", unsafe.Offsetof((*PhysicalDeviceMemoryDecompressionPropertiesNV)(nil).DecompressionMethods)},
+		{"maxDecompressionIndirectCount", unsafe.Offsetof((*PhysicalDeviceMemoryDecompressionPropertiesNV)(nil).MaxDecompressionIndirectCount)},
+	}},
+	{"VkPhysicalDeviceLinearColorAttachmentFeaturesNV", unsafe.Sizeof(*(*PhysicalDeviceLinearColorAttachmentFeaturesNV)(nil)), unsafe.Alignof(*(*PhysicalDeviceLinearColorAttachmentFeaturesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceLinearColorAttachmentFeaturesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceLinearColorAttachmentFeaturesNV)(nil).PNext)},
+		{"linearColorAttachment", unsafe.Offsetof((*PhysicalDeviceLinearColorAttachmentFeaturesNV)(nil).LinearColorAttachment)},
+	}},
+	{"VkPhysicalDeviceImageCompressionControlSwapchainFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceImageCompressionControlSwapchainFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceImageCompressionControlSwapchainFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceImageCompressionControlSwapchainFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceImageCompressionControlSwapchainFeaturesEXT)(nil).PNext)},
+		{"imageCompressionControlSwapchain", unsafe.Offsetof((*PhysicalDeviceImageCompressionControlSwapchainFeaturesEXT)(nil).ImageCompressionControlSwapchain)},
+	}},
+	{"VkImageViewSampleWeightCreateInfoQCOM", unsafe.Sizeof(*(*ImageViewSampleWeightCreateInfoQCOM)(nil)), unsafe.Alignof(*(*ImageViewSampleWeightCreateInfoQCOM)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ImageViewSampleWeightCreateInfoQCOM)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ImageViewSampleWeightCreateInfoQCOM)(nil).PNext)},
+		{"filterCenter", unsafe.Offsetof((*ImageViewSampleWeightCreateInfoQCOM)(nil).FilterCenter)},
+		{"filterSize", unsafe.Offsetof((*ImageViewSampleWeightCreateInfoQCOM)(nil).FilterSize)},
+		{"numPhases", unsafe.Offsetof((*ImageViewSampleWeightCreateInfoQCOM)(nil).NumPhases)},
+	}},
+	{"VkPhysicalDeviceImageProcessingFeaturesQCOM", unsafe.Sizeof(*(*PhysicalDeviceImageProcessingFeaturesQCOM)(nil)), unsafe.Alignof(*(*PhysicalDeviceImageProcessingFeaturesQCOM)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceImageProcessingFeaturesQCOM)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceImageProcessingFeaturesQCOM)(nil).PNext)},
+		{"textureSampleWeighted", unsafe.Offsetof((*PhysicalDeviceImageProcessingFeaturesQCOM)(nil).TextureSampleWeighted)},
+		{"textureBoxFilter", unsafe.Offsetof((*PhysicalDeviceImageProcessingFeaturesQCOM)(nil).TextureBoxFilter)},
+		{"textureBlockMatch", unsafe.Offsetof((*PhysicalDeviceImageProcessingFeaturesQCOM)(nil).TextureBlockMatch)},
+	}},
+	{"VkPhysicalDeviceImageProcessingPropertiesQCOM", unsafe.Sizeof(*(*PhysicalDeviceImageProcessingPropertiesQCOM)(nil)), unsafe.Alignof(*(*PhysicalDeviceImageProcessingPropertiesQCOM)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceImageProcessingPropertiesQCOM)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceImageProcessingPropertiesQCOM)(nil).PNext)},
+		{"maxWeightFilterPhases", unsafe.Offsetof((*PhysicalDeviceImageProcessingPropertiesQCOM)(nil).MaxWeightFilterPhases)},
+		{"maxWeightFilterDimension", unsafe.Offsetof((*PhysicalDeviceImageProcessingPropertiesQCOM)(nil).MaxWeightFilterDimension)},
+		{"maxBlockMatchRegion", unsafe.Offsetof((*PhysicalDeviceImageProcessingPropertiesQCOM)(nil).MaxBlockMatchRegion)},
+		{"maxBoxFilterBlockSize", unsafe.Offsetof((*PhysicalDeviceImageProcessingPropertiesQCOM)(nil).MaxBoxFilterBlockSize)},
+	}},
+	{"VkPhysicalDeviceExtendedDynamicState3FeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceExtendedDynamicState3FeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceExtendedDynamicState3FeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState3FeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState3FeaturesEXT)(nil).PNext)},
+		{"extendedDynamicState3TessellationDomainOrigin", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState3FeaturesEXT)(nil).ExtendedDynamicState3TessellationDomainOrigin)},
+		{"extendedDynamicState3DepthClampEnable", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState3FeaturesEXT)(nil).ExtendedDynamicState3DepthClampEnable)},
+		{"extendedDynamicState3PolygonMode", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState3FeaturesEXT)(nil).ExtendedDynamicState3PolygonMode)},
+		{"extendedDynamicState3RasterizationSamples", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState3FeaturesEXT)(nil).ExtendedDynamicState3RasterizationSamples)},
+		{"extendedDynamicState3SampleMask", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState3FeaturesEXT)(nil).ExtendedDynamicState3SampleMask)},
+		{"extendedDynamicState3AlphaToCoverageEnable", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState3FeaturesEXT)(nil).ExtendedDynamicState3AlphaToCoverageEnable)},
+		{"extendedDynamicState3AlphaToOneEnable", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState3FeaturesEXT)(nil).ExtendedDynamicState3AlphaToOneEnable)},
+		{"extendedDynamicState3LogicOpEnable", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState3FeaturesEXT)(nil).ExtendedDynamicState3LogicOpEnable)},
+		{"extendedDynamicState3ColorBlendEnable", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState3FeaturesEXT)(nil).ExtendedDynamicState3ColorBlendEnable)},
+		{"extendedDynamicState3ColorBlendEquation", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState3FeaturesEXT)(nil).ExtendedDynamicState3ColorBlendEquation)},
+		{"extendedDynamicState3ColorWriteMask", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState3FeaturesEXT)(nil).ExtendedDynamicState3ColorWriteMask)},
+		{"extendedDynamicState3RasterizationStream", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState3FeaturesEXT)(nil).ExtendedDynamicState3RasterizationStream)},
+		{"extendedDynamicState3ConservativeRasterizationMode", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState3FeaturesEXT)(nil).ExtendedDynamicState3ConservativeRasterizationMode)},
+		{"extendedDynamicState3ExtraPrimitiveOverestimationSize", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState3FeaturesEXT)(nil).ExtendedDynamicState3ExtraPrimitiveOverestimationSize)},
+		{"extendedDynamicState3DepthClipEnable", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState3FeaturesEXT)(nil).ExtendedDynamicState3DepthClipEnable)},
+		{"extendedDynamicState3SampleLocationsEnable", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState3FeaturesEXT)(nil).ExtendedDynamicState3SampleLocationsEnable)},
+		{"extendedDynamicState3ColorBlendAdvanced", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState3FeaturesEXT)(nil).ExtendedDynamicState3ColorBlendAdvanced)},
+		{"extendedDynamicState3ProvokingVertexMode", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState3FeaturesEXT)(nil).ExtendedDynamicState3ProvokingVertexMode)},
+		{"extendedDynamicState3LineRasterizationMode", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState3FeaturesEXT)(nil).ExtendedDynamicState3LineRasterizationMode)},
+		{"extendedDynamicState3LineStippleEnable", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState3FeaturesEXT)(nil).ExtendedDynamicState3LineStippleEnable)},
+		{"extendedDynamicState3DepthClipNegativeOneToOne", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState3FeaturesEXT)(nil).ExtendedDynamicState3DepthClipNegativeOneToOne)},
+		{"extendedDynamicState3ViewportWScalingEnable", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState3FeaturesEXT)(nil).ExtendedDynamicState3ViewportWScalingEnable)},
+		{"extendedDynamicState3ViewportSwizzle", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState3FeaturesEXT)(nil).ExtendedDynamicState3ViewportSwizzle)},
+		{"extendedDynamicState3CoverageToColorEnable", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState3FeaturesEXT)(nil).ExtendedDynamicState3CoverageToColorEnable)},
+		{"extendedDynamicState3CoverageToColorLocation", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState3FeaturesEXT)(nil).ExtendedDynamicState3CoverageToColorLocation)},
+		{"extendedDynamicState3CoverageModulationMode", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState3FeaturesEXT)(nil).ExtendedDynamicState3CoverageModulationMode)},
+		{"extendedDynamicState3CoverageModulationTableEnable", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState3FeaturesEXT)(nil).ExtendedDynamicState3CoverageModulationTableEnable)},
+		{"extendedDynamicState3CoverageModulationTable", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState3FeaturesEXT)(nil).ExtendedDynamicState3CoverageModulationTable)},
+		{"extendedDynamicState3CoverageReductionMode", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState3FeaturesEXT)(nil).ExtendedDynamicState3CoverageReductionMode)},
+		{"extendedDynamicState3RepresentativeFragmentTestEnable", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState3FeaturesEXT)(nil).ExtendedDynamicState3RepresentativeFragmentTestEnable)},
+		{"extendedDynamicState3ShadingRateImageEnable", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState3FeaturesEXT)(nil).ExtendedDynamicState3ShadingRateImageEnable)},
+	}},
+	{"VkPhysicalDeviceExtendedDynamicState3PropertiesEXT", unsafe.Sizeof(*(*PhysicalDeviceExtendedDynamicState3PropertiesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceExtendedDynamicState3PropertiesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState3PropertiesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState3PropertiesEXT)(nil).PNext)},
+		{"dynamicPrimitiveTopologyUnrestricted", unsafe.Offsetof((*PhysicalDeviceExtendedDynamicState3PropertiesEXT)(nil).DynamicPrimitiveTopologyUnrestricted)},
+	}},
+	{"VkColorBlendEquationEXT", unsafe.Sizeof(*(*ColorBlendEquationEXT)(nil)), unsafe.Alignof(*(*ColorBlendEquationEXT)(nil)), []memberOffset{
+		{"srcColorBlendFactor", unsafe.Offsetof((*ColorBlendEquationEXT)(nil).SrcColorBlendFactor)},
+		{"dstColorBlendFactor", unsafe.Offsetof((*ColorBlendEquationEXT)(nil).DstColorBlendFactor)},
+		{"colorBlendOp", unsafe.Offsetof((*ColorBlendEquationEXT)(nil).ColorBlendOp)},
+		{"srcAlphaBlendFactor", unsafe.Offsetof((*ColorBlendEquationEXT)(nil).SrcAlphaBlendFactor)},
+		{"dstAlphaBlendFactor", unsafe.Offsetof((*ColorBlendEquationEXT)(nil).DstAlphaBlendFactor)},
+		{"alphaBlendOp", unsafe.Offsetof((*ColorBlendEquationEXT)(nil).AlphaBlendOp)},
+	}},
+	{"VkColorBlendAdvancedEXT", unsafe.Sizeof(*(*ColorBlendAdvancedEXT)(nil)), unsafe.Alignof(*(*ColorBlendAdvancedEXT)(nil)), []memberOffset{
+		{"advancedBlendOp", unsafe.Offsetof((*ColorBlendAdvancedEXT)(nil).AdvancedBlendOp)},
+		{"srcPremultiplied", unsafe.Offsetof((*ColorBlendAdvancedEXT)(nil).SrcPremultiplied)},
+		{"dstPremultiplied", unsafe.Offsetof((*ColorBlendAdvancedEXT)(nil).DstPremultiplied)},
+		{"blendOverlap", unsafe.Offsetof((*ColorBlendAdvancedEXT)(nil).BlendOverlap)},
+		{"clampResults", unsafe.Offsetof((*ColorBlendAdvancedEXT)(nil).ClampResults)},
+	}},
+	{"VkPhysicalDeviceSubpassMergeFeedbackFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceSubpassMergeFeedbackFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceSubpassMergeFeedbackFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceSubpassMergeFeedbackFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceSubpassMergeFeedbackFeaturesEXT)(nil).PNext)},
+		{"subpassMergeFeedback", unsafe.Offsetof((*PhysicalDeviceSubpassMergeFeedbackFeaturesEXT)(nil).SubpassMergeFeedback)},
+	}},
+	{"VkRenderPassCreationControlEXT", unsafe.Sizeof(*(*RenderPassCreationControlEXT)(nil)), unsafe.Alignof(*(*RenderPassCreationControlEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*RenderPassCreationControlEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*RenderPassCreationControlEXT)(nil).PNext)},
+		{"disallowMerging", unsafe.Offsetof((*RenderPassCreationControlEXT)(nil).DisallowMerging)},
+	}},
+	{"VkRenderPassCreationFeedbackInfoEXT", unsafe.Sizeof(*(*RenderPassCreationFeedbackInfoEXT)(nil)), unsafe.Alignof(*(*RenderPassCreationFeedbackInfoEXT)(nil)), []memberOffset{
+		{"postMergeSubpassCount", unsafe.Offsetof((*RenderPassCreationFeedbackInfoEXT)(nil).PostMergeSubpassCount)},
+	}},
+	{"VkRenderPassCreationFeedbackCreateInfoEXT", unsafe.Sizeof(*(*RenderPassCreationFeedbackCreateInfoEXT)(nil)), unsafe.Alignof(*(*RenderPassCreationFeedbackCreateInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*RenderPassCreationFeedbackCreateInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*RenderPassCreationFeedbackCreateInfoEXT)(nil).PNext)},
+		{"pRenderPassFeedback", unsafe.Offsetof((*RenderPassCreationFeedbackCreateInfoEXT)(nil).PRenderPassFeedback)},
+	}},
+	{"VkRenderPassSubpassFeedbackInfoEXT", unsafe.Sizeof(*(*RenderPassSubpassFeedbackInfoEXT)(nil)), unsafe.Alignof(*(*RenderPassSubpassFeedbackInfoEXT)(nil)), []memberOffset{
+		{"subpassMergeStatus", unsafe.Offsetof((*RenderPassSubpassFeedbackInfoEXT)(nil).SubpassMergeStatus)},
+		{"description", unsafe.Offsetof((*RenderPassSubpassFeedbackInfoEXT)(nil).Description)},
+		{"postMergeIndex", unsafe.Offsetof((*RenderPassSubpassFeedbackInfoEXT)(nil).PostMergeIndex)},
+	}},
+	{"VkRenderPassSubpassFeedbackCreateInfoEXT", unsafe.Sizeof(*(*RenderPassSubpassFeedbackCreateInfoEXT)(nil)), unsafe.Alignof(*(*RenderPassSubpassFeedbackCreateInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*RenderPassSubpassFeedbackCreateInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*RenderPassSubpassFeedbackCreateInfoEXT)(nil).PNext)},
+		{"pSubpassFeedback", unsafe.Offsetof((*RenderPassSubpassFeedbackCreateInfoEXT)(nil).PSubpassFeedback)},
+	}},
+	{"VkDirectDriverLoadingInfoLUNARG", unsafe.Sizeof(*(*DirectDriverLoadingInfoLUNARG)(nil)), unsafe.Alignof(*(*DirectDriverLoadingInfoLUNARG)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DirectDriverLoadingInfoLUNARG)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DirectDriverLoadingInfoLUNARG)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*DirectDriverLoadingInfoLUNARG)(nil).Flags)},
+		{"pfnGetInstanceProcAddr", unsafe.Offsetof((*DirectDriverLoadingInfoLUNARG)(nil).PfnGetInstanceProcAddr)},
+	}},
+	{"VkDirectDriverLoadingListLUNARG", unsafe.Sizeof(*(*DirectDriverLoadingListLUNARG)(nil)), unsafe.Alignof(*(*DirectDriverLoadingListLUNARG)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*DirectDriverLoadingListLUNARG)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*DirectDriverLoadingListLUNARG)(nil).PNext)},
+		{"mode", unsafe.Offsetof((*DirectDriverLoadingListLUNARG)(nil).Mode)},
+		{"driverCount", unsafe.Offsetof((*DirectDriverLoadingListLUNARG)(nil).DriverCount)},
+		{"pDrivers", unsafe.Offsetof((*DirectDriverLoadingListLUNARG)(nil).PDrivers)},
+	}},
+	{"VkPhysicalDeviceShaderModuleIdentifierFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceShaderModuleIdentifierFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceShaderModuleIdentifierFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceShaderModuleIdentifierFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceShaderModuleIdentifierFeaturesEXT)(nil).PNext)},
+		{"shaderModuleIdentifier", unsafe.Offsetof((*PhysicalDeviceShaderModuleIdentifierFeaturesEXT)(nil).ShaderModuleIdentifier)},
+	}},
+	{"VkPhysicalDeviceShaderModuleIdentifierPropertiesEXT", unsafe.Sizeof(*(*PhysicalDeviceShaderModuleIdentifierPropertiesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceShaderModuleIdentifierPropertiesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceShaderModuleIdentifierPropertiesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceShaderModuleIdentifierPropertiesEXT)(nil).PNext)},
+		{"shaderModuleIdentifierAlgorithmUUID", unsafe.Offsetof((*PhysicalDeviceShaderModuleIdentifierPropertiesEXT)(nil).ShaderModuleIdentifierAlgorithmUUID)},
+	}},
+	{"VkPipelineShaderStageModuleIdentifierCreateInfoEXT", unsafe.Sizeof(*(*PipelineShaderStageModuleIdentifierCreateInfoEXT)(nil)), unsafe.Alignof(*(*PipelineShaderStageModuleIdentifierCreateInfoEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PipelineShaderStageModuleIdentifierCreateInfoEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PipelineShaderStageModuleIdentifierCreateInfoEXT)(nil).PNext)},
+		{"identifierSize", unsafe.Offsetof((*PipelineShaderStageModuleIdentifierCreateInfoEXT)(nil).IdentifierSize)},
+		{"pIdentifier", unsafe.Offsetof((*PipelineShaderStageModuleIdentifierCreateInfoEXT)(nil).PIdentifier)},
+	}},
+	{"VkShaderModuleIdentifierEXT", unsafe.Sizeof(*(*ShaderModuleIdentifierEXT)(nil)), unsafe.Alignof(*(*ShaderModuleIdentifierEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*ShaderModuleIdentifierEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*ShaderModuleIdentifierEXT)(nil).PNext)},
+		{"identifierSize", unsafe.Offsetof((*ShaderModuleIdentifierEXT)(nil).IdentifierSize)},
+		{"identifier", unsafe.Offsetof((*ShaderModuleIdentifierEXT)(nil).Identifier)},
+	}},
+	{"VkPhysicalDeviceOpticalFlowFeaturesNV", unsafe.Sizeof(*(*PhysicalDeviceOpticalFlowFeaturesNV)(nil)), unsafe.Alignof(*(*PhysicalDeviceOpticalFlowFeaturesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceOpticalFlowFeaturesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceOpticalFlowFeaturesNV)(nil).PNext)},
+		{"opticalFlow", unsafe.Offsetof((*PhysicalDeviceOpticalFlowFeaturesNV)(nil).OpticalFlow)},
+	}},
+	{"VkPhysicalDeviceOpticalFlowPropertiesNV", unsafe.Sizeof(*(*PhysicalDeviceOpticalFlowPropertiesNV)(nil)), unsafe.Alignof(*(*PhysicalDeviceOpticalFlowPropertiesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceOpticalFlowPropertiesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceOpticalFlowPropertiesNV)(nil).PNext)},
+		{"supportedOutputGridSizes", unsafe.Offsetof((*PhysicalDeviceOpticalFlowPropertiesNV)(nil).SupportedOutputGridSizes)},
+		{"supportedHintGridSizes", unsafe.Offsetof((*PhysicalDeviceOpticalFlowPropertiesNV)(nil).SupportedHintGridSizes)},
+		{"hintSupported", unsafe.Offsetof((*PhysicalDeviceOpticalFlowPropertiesNV)(nil).HintSupported)},
+		{"costSupported", unsafe.Offsetof((*PhysicalDeviceOpticalFlowPropertiesNV)(nil).CostSupported)},
+		{"bidirectionalFlowSupported", unsafe.Offsetof((*PhysicalDeviceOpticalFlowPropertiesNV)(nil).BidirectionalFlowSupported)},
+		{"globalFlowSupported", unsafe.Offsetof((*PhysicalDeviceOpticalFlowPropertiesNV)(nil).GlobalFlowSupported)},
+		{"minWidth", unsafe.Offsetof((*PhysicalDeviceOpticalFlowPropertiesNV)(nil).MinWidth)},
+		{"minHeight", unsafe.Offsetof((*PhysicalDeviceOpticalFlowPropertiesNV)(nil).MinHeight)},
+		{"maxWidth", unsafe.Offsetof((*PhysicalDeviceOpticalFlowPropertiesNV)(nil).MaxWidth)},
+		{"maxHeight", unsafe.Offsetof((*PhysicalDeviceOpticalFlowPropertiesNV)(nil).MaxHeight)},
+		{"maxNumRegionsOfInterest", unsafe.Offsetof((*PhysicalDeviceOpticalFlowPropertiesNV)(nil).MaxNumRegionsOfInterest)},
+	}},
+	{"VkOpticalFlowImageFormatInfoNV", unsafe.Sizeof(*(*OpticalFlowImageFormatInfoNV)(nil)), unsafe.Alignof(*(*OpticalFlowImageFormatInfoNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*OpticalFlowImageFormatInfoNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*OpticalFlowImageFormatInfoNV)(nil).PNext)},
+		{"usage", unsafe.Offsetof((*OpticalFlowImageFormatInfoNV)(nil).Usage)},
+	}},
+	{"VkOpticalFlowImageFormatPropertiesNV", unsafe.Sizeof(*(*OpticalFlowImageFormatPropertiesNV)(nil)), unsafe.Alignof(*(*OpticalFlowImageFormatPropertiesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*OpticalFlowImageFormatPropertiesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*OpticalFlowImageFormatPropertiesNV)(nil).PNext)},
+		{"format", unsafe.Offsetof((*OpticalFlowImageFormatPropertiesNV)(nil).Format)},
+	}},
+	{"VkOpticalFlowSessionCreateInfoNV", unsafe.Sizeof(*(*OpticalFlowSessionCreateInfoNV)(nil)), unsafe.Alignof(*(*OpticalFlowSessionCreateInfoNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*OpticalFlowSessionCreateInfoNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*OpticalFlowSessionCreateInfoNV)(nil).PNext)},
+		{"width", unsafe.Offsetof((*OpticalFlowSessionCreateInfoNV)(nil).Width)},
+		{"height", unsafe.Offsetof((*OpticalFlowSessionCreateInfoNV)(nil).Height)},
+		{"imageFormat", unsafe.Offsetof((*OpticalFlowSessionCreateInfoNV)(nil).ImageFormat)},
+		{"flowVectorFormat", unsafe.Offsetof((*OpticalFlowSessionCreateInfoNV)(nil).FlowVectorFormat)},
+		{"costFormat", unsafe.Offsetof((*OpticalFlowSessionCreateInfoNV)(nil).CostFormat)},
+		{"outputGridSize", unsafe.Offsetof((*OpticalFlowSessionCreateInfoNV)(nil).OutputGridSize)},
+		{"hintGridSize", unsafe.Offsetof((*OpticalFlowSessionCreateInfoNV)(nil).HintGridSize)},
+		{"performanceLevel", unsafe.Offsetof((*OpticalFlowSessionCreateInfoNV)(nil).PerformanceLevel)},
+		{"flags", unsafe.Offsetof((*OpticalFlowSessionCreateInfoNV)(nil).Flags)},
+	}},
+	{"VkOpticalFlowSessionCreatePrivateDataInfoNV", unsafe.Sizeof(*(*OpticalFlowSessionCreatePrivateDataInfoNV)(nil)), unsafe.Alignof(*(*OpticalFlowSessionCreatePrivateDataInfoNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*OpticalFlowSessionCreatePrivateDataInfoNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*OpticalFlowSessionCreatePrivateDataInfoNV)(nil).PNext)},
+		{"id", unsafe.Offsetof((*OpticalFlowSessionCreatePrivateDataInfoNV)(nil).Id)},
+		{"size", unsafe.Offsetof((*OpticalFlowSessionCreatePrivateDataInfoNV)(nil).Size)},
+		{"pPrivateData", unsafe.Offsetof((*OpticalFlowSessionCreatePrivateDataInfoNV)(nil).PPrivateData)},
+	}},
+	{"VkOpticalFlowExecuteInfoNV", unsafe.Sizeof(*(*OpticalFlowExecuteInfoNV)(nil)), unsafe.Alignof(*(*OpticalFlowExecuteInfoNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*OpticalFlowExecuteInfoNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*OpticalFlowExecuteInfoNV)(nil).PNext)},
+		{"flags", unsafe.Offsetof((*OpticalFlowExecuteInfoNV)(nil).Flags)},
+		{"regionCount", unsafe.Offsetof((*OpticalFlowExecuteInfoNV)(nil).RegionCount)},
+		{"pRegions", unsafe.Offsetof((*OpticalFlowExecuteInfoNV)(nil).PRegions)},
+	}},
+	{"VkPhysicalDeviceLegacyDitheringFeaturesEXT", unsafe.Sizeof(*(*PhysicalDeviceLegacyDitheringFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDeviceLegacyDitheringFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceLegacyDitheringFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceLegacyDitheringFeaturesEXT)(nil).PNext)},
+		{"legacyDithering", unsafe.Offsetof((*PhysicalDeviceLegacyDitheringFeaturesEXT)(nil).LegacyDithering)},
+	}},
+	{"VkPhysicalDevicePipelineProtectedAccessFeaturesEXT", unsafe.Sizeof(*(*PhysicalDevicePipelineProtectedAccessFeaturesEXT)(nil)), unsafe.Alignof(*(*PhysicalDevicePipelineProtectedAccessFeaturesEXT)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDevicePipelineProtectedAccessFeaturesEXT)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDevicePipelineProtectedAccessFeaturesEXT)(nil).PNext)},
+		{"pipelineProtectedAccess", unsafe.Offsetof((*PhysicalDevicePipelineProtectedAccessFeaturesEXT)(nil).PipelineProtectedAccess)},
+	}},
+	{"VkPhysicalDeviceTilePropertiesFeaturesQCOM", unsafe.Sizeof(*(*PhysicalDeviceTilePropertiesFeaturesQCOM)(nil)), unsafe.Alignof(*(*PhysicalDeviceTilePropertiesFeaturesQCOM)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceTilePropertiesFeaturesQCOM)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceTilePropertiesFeaturesQCOM)(nil).PNext)},
+		{"tileProperties", unsafe.Offsetof((*PhysicalDeviceTilePropertiesFeaturesQCOM)(nil).TileProperties)},
+	}},
+	{"VkTilePropertiesQCOM", unsafe.Sizeof(*(*TilePropertiesQCOM)(nil)), unsafe.Alignof(*(*TilePropertiesQCOM)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*TilePropertiesQCOM)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*TilePropertiesQCOM)(nil).PNext)},
+		{"tileSize", unsafe.Offsetof((*TilePropertiesQCOM)(nil).TileSize)},
+		{"apronSize", unsafe.Offsetof((*TilePropertiesQCOM)(nil).ApronSize)},
+		{"origin", unsafe.Offsetof((*TilePropertiesQCOM)(nil).Origin)},
+	}},
+	{"VkPhysicalDeviceAmigoProfilingFeaturesSEC", unsafe.Sizeof(*(*PhysicalDeviceAmigoProfilingFeaturesSEC)(nil)), unsafe.Alignof(*(*PhysicalDeviceAmigoProfilingFeaturesSEC)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceAmigoProfilingFeaturesSEC)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceAmigoProfilingFeaturesSEC)(nil).PNext)},
+		{"amigoProfiling", unsafe.Offsetof((*PhysicalDeviceAmigoProfilingFeaturesSEC)(nil).AmigoProfiling)},
+	}},
+	{"VkAmigoProfilingSubmitInfoSEC", unsafe.Sizeof(*(*AmigoProfilingSubmitInfoSEC)(nil)), unsafe.Alignof(*(*AmigoProfilingSubmitInfoSEC)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*AmigoProfilingSubmitInfoSEC)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*AmigoProfilingSubmitInfoSEC)(nil).PNext)},
+		{"firstDrawTimestamp", unsafe.Offsetof((*AmigoProfilingSubmitInfoSEC)(nil).FirstDrawTimestamp)},
+		{"swapBufferTimestamp", unsafe.Offsetof((*AmigoProfilingSubmitInfoSEC)(nil).SwapBufferTimestamp)},
+	}},
+	{"VkPhysicalDeviceMultiviewPerViewViewportsFeaturesQCOM", unsafe.Sizeof(*(*PhysicalDeviceMultiviewPerViewViewportsFeaturesQCOM)(nil)), unsafe.Alignof(*(*PhysicalDeviceMultiviewPerViewViewportsFeaturesQCOM)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceMultiviewPerViewViewportsFeaturesQCOM)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceMultiviewPerViewViewportsFeaturesQCOM)(nil).PNext)},
+		{"multiviewPerViewViewports", unsafe.Offsetof((*PhysicalDeviceMultiviewPerViewViewportsFeaturesQCOM)(nil).MultiviewPerViewViewports)},
+	}},
+	{"VkPhysicalDeviceRayTracingInvocationReorderPropertiesNV", unsafe.Sizeof(*(*PhysicalDeviceRayTracingInvocationReorderPropertiesNV)(nil)), unsafe.Alignof(*(*PhysicalDeviceRayTracingInvocationReorderPropertiesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceRayTracingInvocationReorderPropertiesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceRayTracingInvocationReorderPropertiesNV)(nil).PNext)},
+		{"rayTracingInvocationReorderReorderingHint", unsafe.Offsetof((*PhysicalDeviceRayTracingInvocationReorderPropertiesNV)(nil).RayTracingInvocationReorderReorderingHint)},
+	}},
+	{"VkPhysicalDeviceRayTracingInvocationReorderFeaturesNV", unsafe.Sizeof(*(*PhysicalDeviceRayTracingInvocationReorderFeaturesNV)(nil)), unsafe.Alignof(*(*PhysicalDeviceRayTracingInvocationReorderFeaturesNV)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceRayTracingInvocationReorderFeaturesNV)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceRayTracingInvocationReorderFeaturesNV)(nil).PNext)},
+		{"rayTracingInvocationReorder", unsafe.Offsetof((*PhysicalDeviceRayTracingInvocationReorderFeaturesNV)(nil).RayTracingInvocationReorder)},
+	}},
+	{"VkPhysicalDeviceShaderCoreBuiltinsFeaturesARM", unsafe.Sizeof(*(*PhysicalDeviceShaderCoreBuiltinsFeaturesARM)(nil)), unsafe.Alignof(*(*PhysicalDeviceShaderCoreBuiltinsFeaturesARM)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceShaderCoreBuiltinsFeaturesARM)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceShaderCoreBuiltinsFeaturesARM)(nil).PNext)},
+		{"shaderCoreBuiltins", unsafe.Offsetof((*PhysicalDeviceShaderCoreBuiltinsFeaturesARM)(nil).ShaderCoreBuiltins)},
+	}},
+	{"VkPhysicalDeviceShaderCoreBuiltinsPropertiesARM", unsafe.Sizeof(*(*PhysicalDeviceShaderCoreBuiltinsPropertiesARM)(nil)), unsafe.Alignof(*(*PhysicalDeviceShaderCoreBuiltinsPropertiesARM)(nil)), []memberOffset{
+		{"sType", unsafe.Offsetof((*PhysicalDeviceShaderCoreBuiltinsPropertiesARM)(nil).SType)},
+		{"pNext", unsafe.Offsetof((*PhysicalDeviceShaderCoreBuiltinsPropertiesARM)(nil).PNext)},
+		{"shaderCoreMask", unsafe.Offsetof((*PhysicalDeviceShaderCoreBuiltinsPropertiesARM)(nil).ShaderCoreMask)},
+		{"shaderCoreCount", unsafe.Offsetof((*PhysicalDeviceShaderCoreBuiltinsPropertiesARM)(nil).ShaderCoreCount)},
+		{"shaderWarpsPerCore", unsafe.Offsetof((*PhysicalDeviceShaderCoreBuiltinsPropertiesARM)(nil).ShaderWarpsPerCore)},
+	}},
+}
@@ -0,0 +1,54 @@
+// +build cgo
+
+package vk
+
+import (
+	"reflect"
+	"testing"
+	"unsafe"
+
+	"github.com/toy80/vk/internal/abi"
+)
+
+// TestCallArgs64 calls C functions that record their arguments. The
+// non-dispatchable handles and the DeviceSize values must arrive whole on
+// the 32-bit targets as well, and the arguments after them in place.
+func TestCallArgs64(t *testing.T) {
+	const (
+		device = Device(0x1234)
+		buffer = Buffer(0x8877665544332211)
+		memory = DeviceMemory(0xF0E0D0C0B0A09080)
+		offset = DeviceSize(0x0000000100000002)
+		size   = DeviceSize(0xFFFFFFFF00000003)
+	)
+	var data unsafe.Pointer
+	tests := []struct {
+		name string
+		call func()
+		want []uint64
+	}{
+		{"DestroyBuffer", func() {
+			PfnDestroyBuffer(abi.DestroyBuffer).Call(device, buffer, nil)
+		}, []uint64{uint64(device), uint64(buffer), 0}},
+		{"MapMemory", func() {
+			if ret := PfnMapMemory(abi.MapMemory).Call(device, memory, offset, size, 0x5, &data); ret != ERROR_MEMORY_MAP_FAILED {
+				t.Errorf("MapMemory returned %v", ret)
+			}
+		}, []uint64{uint64(device), uint64(memory), offset, size, 0x5, uint64(uintptr(unsafe.Pointer(&data)))}},
+		{"CmdFillBuffer", func() {
+			PfnCmdFillBuffer(abi.CmdFillBuffer).Call(CommandBuffer(0x5678), buffer, offset, size, 0xDEADBEEF)
+		}, []uint64{0x5678, uint64(buffer), offset, size, 0xDEADBEEF}},
+		{"GetBufferOpaqueCaptureAddress", func() {
+			info := BufferDeviceAddressInfo{SType: STRUCTURE_TYPE_BUFFER_DEVICE_ADDRESS_INFO, Buffer: buffer}
+			if ret := PfnGetBufferOpaqueCaptureAddress(abi.GetBufferOpaqueCaptureAddress).Call(device, &info); ret != ^uint64(buffer) {
+				t.Errorf("GetBufferOpaqueCaptureAddress returned %#x, want %#x", ret, ^uint64(buffer))
+			}
+		}, []uint64{uint64(device), uint64(buffer)}},
+	}
+	for _, tt := range tests {
+		tt.call()
+		if got := abi.Args(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: C got %#x, want %#x", tt.name, got, tt.want)
+		}
+	}
+}
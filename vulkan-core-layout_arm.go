@@ -0,0 +1,1596 @@
+//go:build linux || darwin || (forcecgo && windows)
+// +build linux darwin forcecgo,windows
+
+package vk
+
+import "unsafe"
+
+/*
+ ** Copyright (c) 2015-2019 The Khronos Group Inc.
+ **
+ ** Licensed under the Apache License, Version 2.0 (the "License");
+ ** you may not use this file except in compliance with the License.
+ ** You may obtain a copy of the License at
+ **
+ **     http://www.apache.org/licenses/LICENSE-2.0
+ **
+ ** Unless required by applicable law or agreed to in writing, software
+ ** distributed under the License is distributed on an "AS IS" BASIS,
+ ** WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ ** See the License for the specific language governing permissions and
+ ** limitations under the License.
+ */
+
+/*
+ ** This file is generated from the Vulkan headers.
+ */
+
+// The structs of vulkan-core-cgo.go that C lays out differently on linux/arm,
+// where the EABI aligns the 64-bit scalars to 8 bytes and Go to 4. The
+// fields are padded to the C layout, vulkan-core-layout.go has them unpadded.
+
+// ImageMemoryBarrier -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkImageMemoryBarrier.html
+type ImageMemoryBarrier struct {
+	SType               StructureType
+	PNext               unsafe.Pointer
+	SrcAccessMask       AccessFlags
+	DstAccessMask       AccessFlags
+	OldLayout           ImageLayout
+	NewLayout           ImageLayout
+	SrcQueueFamilyIndex uint32
+	DstQueueFamilyIndex uint32
+	Image               Image
+	SubresourceRange    ImageSubresourceRange
+	_                   [4]byte
+}
+
+// MemoryHeap -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkMemoryHeap.html
+type MemoryHeap struct {
+	Size  DeviceSize
+	Flags MemoryHeapFlags
+	_     [4]byte
+}
+
+// PhysicalDeviceLimits -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkPhysicalDeviceLimits.html
+type PhysicalDeviceLimits struct {
+	MaxImageDimension1D                             uint32
+	MaxImageDimension2D                             uint32
+	MaxImageDimension3D                             uint32
+	MaxImageDimensionCube                           uint32
+	MaxImageArrayLayers                             uint32
+	MaxTexelBufferElements                          uint32
+	MaxUniformBufferRange                           uint32
+	MaxStorageBufferRange                           uint32
+	MaxPushConstantsSize                            uint32
+	MaxMemoryAllocationCount                        uint32
+	MaxSamplerAllocationCount                       uint32
+	_                                               [4]byte
+	BufferImageGranularity                          DeviceSize
+	SparseAddressSpaceSize                          DeviceSize
+	MaxBoundDescriptorSets                          uint32
+	MaxPerStageDescriptorSamplers                   uint32
+	MaxPerStageDescriptorUniformBuffers             uint32
+	MaxPerStageDescriptorStorageBuffers             uint32
+	MaxPerStageDescriptorSampledImages              uint32
+	MaxPerStageDescriptorStorageImages              uint32
+	MaxPerStageDescriptorInputAttachments           uint32
+	MaxPerStageResources                            uint32
+	MaxDescriptorSetSamplers                        uint32
+	MaxDescriptorSetUniformBuffers                  uint32
+	MaxDescriptorSetUniformBuffersDynamic           uint32
+	MaxDescriptorSetStorageBuffers                  uint32
+	MaxDescriptorSetStorageBuffersDynamic           uint32
+	MaxDescriptorSetSampledImages                   uint32
+	MaxDescriptorSetStorageImages                   uint32
+	MaxDescriptorSetInputAttachments                uint32
+	MaxVertexInputAttributes                        uint32
+	MaxVertexInputBindings                          uint32
+	MaxVertexInputAttributeOffset                   uint32
+	MaxVertexInputBindingStride                     uint32
+	MaxVertexOutputComponents                       uint32
+	MaxTessellationGenerationLevel                  uint32
+	MaxTessellationPatchSize                        uint32
+	MaxTessellationControlPerVertexInputComponents  uint32
+	MaxTessellationControlPerVertexOutputComponents uint32
+	MaxTessellationControlPerPatchOutputComponents  uint32
+	MaxTessellationControlTotalOutputComponents     uint32
+	MaxTessellationEvaluationInputComponents        uint32
+	MaxTessellationEvaluationOutputComponents       uint32
+	MaxGeometryShaderInvocations                    uint32
+	MaxGeometryInputComponents                      uint32
+	MaxGeometryOutputComponents                     uint32
+	MaxGeometryOutputVertices                       uint32
+	MaxGeometryTotalOutputComponents                uint32
+	MaxFragmentInputComponents                      uint32
+	MaxFragmentOutputAttachments                    uint32
+	MaxFragmentDualSrcAttachments                   uint32
+	MaxFragmentCombinedOutputResources              uint32
+	MaxComputeSharedMemorySize                      uint32
+	MaxComputeWorkGroupCount                        [3]uint32
+	MaxComputeWorkGroupInvocations                  uint32
+	MaxComputeWorkGroupSize                         [3]uint32
+	SubPixelPrecisionBits                           uint32
+	SubTexelPrecisionBits                           uint32
+	MipmapPrecisionBits                             uint32
+	MaxDrawIndexedIndexValue                        uint32
+	MaxDrawIndirectCount                            uint32
+	MaxSamplerLodBias                               float32
+	MaxSamplerAnisotropy                            float32
+	MaxViewports                                    uint32
+	MaxViewportDimensions                           [2]uint32
+	ViewportBoundsRange                             [2]float32
+	ViewportSubPixelBits                            uint32
+	MinMemoryMapAlignment                           uintptr
+	MinTexelBufferOffsetAlignment                   DeviceSize
+	MinUniformBufferOffsetAlignment                 DeviceSize
+	MinStorageBufferOffsetAlignment                 DeviceSize
+	MinTexelOffset                                  int32
+	MaxTexelOffset                                  uint32
+	MinTexelGatherOffset                            int32
+	MaxTexelGatherOffset                            uint32
+	MinInterpolationOffset                          float32
+	MaxInterpolationOffset                          float32
+	SubPixelInterpolationOffsetBits                 uint32
+	MaxFramebufferWidth                             uint32
+	MaxFramebufferHeight                            uint32
+	MaxFramebufferLayers                            uint32
+	FramebufferColorSampleCounts                    SampleCountFlags
+	FramebufferDepthSampleCounts                    SampleCountFlags
+	FramebufferStencilSampleCounts                  SampleCountFlags
+	FramebufferNoAttachmentsSampleCounts            SampleCountFlags
+	MaxColorAttachments                             uint32
+	SampledImageColorSampleCounts                   SampleCountFlags
+	SampledImageIntegerSampleCounts                 SampleCountFlags
+	SampledImageDepthSampleCounts                   SampleCountFlags
+	SampledImageStencilSampleCounts                 SampleCountFlags
+	StorageImageSampleCounts                        SampleCountFlags
+	MaxSampleMaskWords                              uint32
+	TimestampComputeAndGraphics                     Bool32
+	TimestampPeriod                                 float32
+	MaxClipDistances                                uint32
+	MaxCullDistances                                uint32
+	MaxCombinedClipAndCullDistances                 uint32
+	DiscreteQueuePriorities                         uint32
+	PointSizeRange                                  [2]float32
+	LineWidthRange                                  [2]float32
+	PointSizeGranularity                            float32
+	LineWidthGranularity                            float32
+	StrictLines                                     Bool32
+	StandardSampleLocations                         Bool32
+	_                                               [4]byte
+	OptimalBufferCopyOffsetAlignment                DeviceSize
+	OptimalBufferCopyRowPitchAlignment              DeviceSize
+	NonCoherentAtomSize                             DeviceSize
+}
+
+// PhysicalDeviceProperties -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkPhysicalDeviceProperties.html
+type PhysicalDeviceProperties struct {
+	ApiVersion        Version
+	DriverVersion     Version
+	VendorID          uint32
+	DeviceID          uint32
+	DeviceType        PhysicalDeviceType
+	DeviceName        [MAX_PHYSICAL_DEVICE_NAME_SIZE]int8
+	PipelineCacheUUID [UUID_SIZE]uint8
+	_                 [4]byte
+	Limits            PhysicalDeviceLimits
+	SparseProperties  PhysicalDeviceSparseProperties
+	_                 [4]byte
+}
+
+// MemoryAllocateInfo -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkMemoryAllocateInfo.html
+type MemoryAllocateInfo struct {
+	SType           StructureType
+	PNext           unsafe.Pointer
+	AllocationSize  DeviceSize
+	MemoryTypeIndex uint32
+	_               [4]byte
+}
+
+// MemoryRequirements -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkMemoryRequirements.html
+type MemoryRequirements struct {
+	Size           DeviceSize
+	Alignment      DeviceSize
+	MemoryTypeBits uint32
+	_              [4]byte
+}
+
+// SparseMemoryBind -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkSparseMemoryBind.html
+type SparseMemoryBind struct {
+	ResourceOffset DeviceSize
+	Size           DeviceSize
+	Memory         DeviceMemory
+	MemoryOffset   DeviceSize
+	Flags          SparseMemoryBindFlags
+	_              [4]byte
+}
+
+// SparseImageMemoryBind -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkSparseImageMemoryBind.html
+type SparseImageMemoryBind struct {
+	Subresource  ImageSubresource
+	Offset       Offset3D
+	Extent       Extent3D
+	_            [4]byte
+	Memory       DeviceMemory
+	MemoryOffset DeviceSize
+	Flags        SparseMemoryBindFlags
+	_            [4]byte
+}
+
+// BufferCreateInfo -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkBufferCreateInfo.html
+type BufferCreateInfo struct {
+	SType                 StructureType
+	PNext                 unsafe.Pointer
+	Flags                 BufferCreateFlags
+	_                     [4]byte
+	Size                  DeviceSize
+	Usage                 BufferUsageFlags
+	SharingMode           SharingMode
+	QueueFamilyIndexCount uint32
+	PQueueFamilyIndices   *uint32
+}
+
+// BufferViewCreateInfo -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkBufferViewCreateInfo.html
+type BufferViewCreateInfo struct {
+	SType  StructureType
+	PNext  unsafe.Pointer
+	Flags  BufferViewCreateFlags
+	_      [4]byte
+	Buffer Buffer
+	Format Format
+	_      [4]byte
+	Offset DeviceSize
+	Range  DeviceSize
+}
+
+// ImageViewCreateInfo -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkImageViewCreateInfo.html
+type ImageViewCreateInfo struct {
+	SType            StructureType
+	PNext            unsafe.Pointer
+	Flags            ImageViewCreateFlags
+	_                [4]byte
+	Image            Image
+	ViewType         ImageViewType
+	Format           Format
+	Components       ComponentMapping
+	SubresourceRange ImageSubresourceRange
+	_                [4]byte
+}
+
+// ComputePipelineCreateInfo -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkComputePipelineCreateInfo.html
+type ComputePipelineCreateInfo struct {
+	SType              StructureType
+	PNext              unsafe.Pointer
+	Flags              PipelineCreateFlags
+	_                  [4]byte
+	Stage              PipelineShaderStageCreateInfo
+	Layout             PipelineLayout
+	BasePipelineHandle Pipeline
+	BasePipelineIndex  int32
+	_                  [4]byte
+}
+
+// GraphicsPipelineCreateInfo -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkGraphicsPipelineCreateInfo.html
+type GraphicsPipelineCreateInfo struct {
+	SType               StructureType
+	PNext               unsafe.Pointer
+	Flags               PipelineCreateFlags
+	StageCount          uint32
+	PStages             *PipelineShaderStageCreateInfo
+	PVertexInputState   *PipelineVertexInputStateCreateInfo
+	PInputAssemblyState *PipelineInputAssemblyStateCreateInfo
+	PTessellationState  *PipelineTessellationStateCreateInfo
+	PViewportState      *PipelineViewportStateCreateInfo
+	PRasterizationState *PipelineRasterizationStateCreateInfo
+	PMultisampleState   *PipelineMultisampleStateCreateInfo
+	PDepthStencilState  *PipelineDepthStencilStateCreateInfo
+	PColorBlendState    *PipelineColorBlendStateCreateInfo
+	PDynamicState       *PipelineDynamicStateCreateInfo
+	Layout              PipelineLayout
+	RenderPass          RenderPass
+	Subpass             uint32
+	_                   [4]byte
+	BasePipelineHandle  Pipeline
+	BasePipelineIndex   int32
+	_                   [4]byte
+}
+
+// CopyDescriptorSet -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkCopyDescriptorSet.html
+type CopyDescriptorSet struct {
+	SType           StructureType
+	PNext           unsafe.Pointer
+	SrcSet          DescriptorSet
+	SrcBinding      uint32
+	SrcArrayElement uint32
+	DstSet          DescriptorSet
+	DstBinding      uint32
+	DstArrayElement uint32
+	DescriptorCount uint32
+	_               [4]byte
+}
+
+// DescriptorImageInfo -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkDescriptorImageInfo.html
+type DescriptorImageInfo struct {
+	Sampler     Sampler
+	ImageView   ImageView
+	ImageLayout ImageLayout
+	_           [4]byte
+}
+
+// WriteDescriptorSet -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkWriteDescriptorSet.html
+type WriteDescriptorSet struct {
+	SType            StructureType
+	PNext            unsafe.Pointer
+	DstSet           DescriptorSet
+	DstBinding       uint32
+	DstArrayElement  uint32
+	DescriptorCount  uint32
+	DescriptorType   DescriptorType
+	PImageInfo       *DescriptorImageInfo
+	PBufferInfo      *DescriptorBufferInfo
+	PTexelBufferView *BufferView
+	_                [4]byte
+}
+
+// FramebufferCreateInfo -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkFramebufferCreateInfo.html
+type FramebufferCreateInfo struct {
+	SType           StructureType
+	PNext           unsafe.Pointer
+	Flags           FramebufferCreateFlags
+	_               [4]byte
+	RenderPass      RenderPass
+	AttachmentCount uint32
+	PAttachments    *ImageView
+	Width           uint32
+	Height          uint32
+	Layers          uint32
+	_               [4]byte
+}
+
+// CommandBufferInheritanceInfo -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkCommandBufferInheritanceInfo.html
+type CommandBufferInheritanceInfo struct {
+	SType                StructureType
+	PNext                unsafe.Pointer
+	RenderPass           RenderPass
+	Subpass              uint32
+	_                    [4]byte
+	Framebuffer          Framebuffer
+	OcclusionQueryEnable Bool32
+	QueryFlags           QueryControlFlags
+	PipelineStatistics   QueryPipelineStatisticFlags
+	_                    [4]byte
+}
+
+// DescriptorUpdateTemplateCreateInfo -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkDescriptorUpdateTemplateCreateInfo.html
+type DescriptorUpdateTemplateCreateInfo struct {
+	SType                      StructureType
+	PNext                      unsafe.Pointer
+	Flags                      DescriptorUpdateTemplateCreateFlags
+	DescriptorUpdateEntryCount uint32
+	PDescriptorUpdateEntries   *DescriptorUpdateTemplateEntry
+	TemplateType               DescriptorUpdateTemplateType
+	DescriptorSetLayout        DescriptorSetLayout
+	PipelineBindPoint          PipelineBindPoint
+	_                          [4]byte
+	PipelineLayout             PipelineLayout
+	Set                        uint32
+	_                          [4]byte
+}
+
+// PhysicalDeviceMaintenance3Properties -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkPhysicalDeviceMaintenance3Properties.html
+type PhysicalDeviceMaintenance3Properties struct {
+	SType                   StructureType
+	PNext                   unsafe.Pointer
+	MaxPerSetDescriptors    uint32
+	_                       [4]byte
+	MaxMemoryAllocationSize DeviceSize
+}
+
+// PhysicalDeviceVulkan11Properties -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkPhysicalDeviceVulkan11Properties.html
+type PhysicalDeviceVulkan11Properties struct {
+	SType                             StructureType
+	PNext                             unsafe.Pointer
+	DeviceUUID                        [UUID_SIZE]uint8
+	DriverUUID                        [UUID_SIZE]uint8
+	DeviceLUID                        [LUID_SIZE]uint8
+	DeviceNodeMask                    uint32
+	DeviceLUIDValid                   Bool32
+	SubgroupSize                      uint32
+	SubgroupSupportedStages           ShaderStageFlags
+	SubgroupSupportedOperations       SubgroupFeatureFlags
+	SubgroupQuadOperationsInAllStages Bool32
+	PointClippingBehavior             PointClippingBehavior
+	MaxMultiviewViewCount             uint32
+	MaxMultiviewInstanceIndex         uint32
+	ProtectedNoFault                  Bool32
+	MaxPerSetDescriptors              uint32
+	_                                 [4]byte
+	MaxMemoryAllocationSize           DeviceSize
+}
+
+// PhysicalDeviceVulkan12Properties -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkPhysicalDeviceVulkan12Properties.html
+type PhysicalDeviceVulkan12Properties struct {
+	SType                                                StructureType
+	PNext                                                unsafe.Pointer
+	DriverID                                             DriverId
+	DriverName                                           [MAX_DRIVER_NAME_SIZE]int8
+	DriverInfo                                           [MAX_DRIVER_INFO_SIZE]int8
+	ConformanceVersion                                   ConformanceVersion
+	DenormBehaviorIndependence                           ShaderFloatControlsIndependence
+	RoundingModeIndependence                             ShaderFloatControlsIndependence
+	ShaderSignedZeroInfNanPreserveFloat16                Bool32
+	ShaderSignedZeroInfNanPreserveFloat32                Bool32
+	ShaderSignedZeroInfNanPreserveFloat64                Bool32
+	ShaderDenormPreserveFloat16                          Bool32
+	ShaderDenormPreserveFloat32                          Bool32
+	ShaderDenormPreserveFloat64                          Bool32
+	ShaderDenormFlushToZeroFloat16                       Bool32
+	ShaderDenormFlushToZeroFloat32                       Bool32
+	ShaderDenormFlushToZeroFloat64                       Bool32
+	ShaderRoundingModeRTEFloat16                         Bool32
+	ShaderRoundingModeRTEFloat32                         Bool32
+	ShaderRoundingModeRTEFloat64                         Bool32
+	ShaderRoundingModeRTZFloat16                         Bool32
+	ShaderRoundingModeRTZFloat32                         Bool32
+	ShaderRoundingModeRTZFloat64                         Bool32
+	MaxUpdateAfterBindDescriptorsInAllPools              uint32
+	ShaderUniformBufferArrayNonUniformIndexingNative     Bool32
+	ShaderSampledImageArrayNonUniformIndexingNative      Bool32
+	ShaderStorageBufferArrayNonUniformIndexingNative     Bool32
+	ShaderStorageImageArrayNonUniformIndexingNative      Bool32
+	ShaderInputAttachmentArrayNonUniformIndexingNative   Bool32
+	RobustBufferAccessUpdateAfterBind                    Bool32
+	QuadDivergentImplicitLod                             Bool32
+	MaxPerStageDescriptorUpdateAfterBindSamplers         uint32
+	MaxPerStageDescriptorUpdateAfterBindUniformBuffers   uint32
+	MaxPerStageDescriptorUpdateAfterBindStorageBuffers   uint32
+	MaxPerStageDescriptorUpdateAfterBindSampledImages    uint32
+	MaxPerStageDescriptorUpdateAfterBindStorageImages    uint32
+	MaxPerStageDescriptorUpdateAfterBindInputAttachments uint32
+	MaxPerStageUpdateAfterBindResources                  uint32
+	MaxDescriptorSetUpdateAfterBindSamplers              uint32
+	MaxDescriptorSetUpdateAfterBindUniformBuffers        uint32
+	MaxDescriptorSetUpdateAfterBindUniformBuffersDynamic uint32
+	MaxDescriptorSetUpdateAfterBindStorageBuffers        uint32
+	MaxDescriptorSetUpdateAfterBindStorageBuffersDynamic uint32
+	MaxDescriptorSetUpdateAfterBindSampledImages         uint32
+	MaxDescriptorSetUpdateAfterBindStorageImages         uint32
+	MaxDescriptorSetUpdateAfterBindInputAttachments      uint32
+	SupportedDepthResolveModes                           ResolveModeFlags
+	SupportedStencilResolveModes                         ResolveModeFlags
+	IndependentResolveNone                               Bool32
+	IndependentResolve                                   Bool32
+	FilterMinmaxSingleComponentFormats                   Bool32
+	FilterMinmaxImageComponentMapping                    Bool32
+	MaxTimelineSemaphoreValueDifference                  uint64
+	FramebufferIntegerColorSampleCounts                  SampleCountFlags
+	_                                                    [4]byte
+}
+
+// SemaphoreTypeCreateInfo -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkSemaphoreTypeCreateInfo.html
+type SemaphoreTypeCreateInfo struct {
+	SType         StructureType
+	PNext         unsafe.Pointer
+	SemaphoreType SemaphoreType
+	_             [4]byte
+	InitialValue  uint64
+}
+
+// PhysicalDeviceVulkan13Properties -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkPhysicalDeviceVulkan13Properties.html
+type PhysicalDeviceVulkan13Properties struct {
+	SType                                                                         StructureType
+	PNext                                                                         unsafe.Pointer
+	MinSubgroupSize                                                               uint32
+	MaxSubgroupSize                                                               uint32
+	MaxComputeWorkgroupSubgroups                                                  uint32
+	RequiredSubgroupSizeStages                                                    ShaderStageFlags
+	MaxInlineUniformBlockSize                                                     uint32
+	MaxPerStageDescriptorInlineUniformBlocks                                      uint32
+	MaxPerStageDescriptorUpdateAfterBindInlineUniformBlocks                       uint32
+	MaxDescriptorSetInlineUniformBlocks                                           uint32
+	MaxDescriptorSetUpdateAfterBindInlineUniformBlocks                            uint32
+	MaxInlineUniformTotalSize                                                     uint32
+	IntegerDotProduct8BitUnsignedAccelerated                                      Bool32
+	IntegerDotProduct8BitSignedAccelerated                                        Bool32
+	IntegerDotProduct8BitMixedSignednessAccelerated                               Bool32
+	IntegerDotProduct4x8BitPackedUnsignedAccelerated                              Bool32
+	IntegerDotProduct4x8BitPackedSignedAccelerated                                Bool32
+	IntegerDotProduct4x8BitPackedMixedSignednessAccelerated                       Bool32
+	IntegerDotProduct16BitUnsignedAccelerated                                     Bool32
+	IntegerDotProduct16BitSignedAccelerated                                       Bool32
+	IntegerDotProduct16BitMixedSignednessAccelerated                              Bool32
+	IntegerDotProduct32BitUnsignedAccelerated                                     Bool32
+	IntegerDotProduct32BitSignedAccelerated                                       Bool32
+	IntegerDotProduct32BitMixedSignednessAccelerated                              Bool32
+	IntegerDotProduct64BitUnsignedAccelerated                                     Bool32
+	IntegerDotProduct64BitSignedAccelerated                                       Bool32
+	IntegerDotProduct64BitMixedSignednessAccelerated                              Bool32
+	IntegerDotProductAccumulatingSaturating8BitUnsignedAccelerated                Bool32
+	IntegerDotProductAccumulatingSaturating8BitSignedAccelerated                  Bool32
+	IntegerDotProductAccumulatingSaturating8BitMixedSignednessAccelerated         Bool32
+	IntegerDotProductAccumulatingSaturating4x8BitPackedUnsignedAccelerated        Bool32
+	IntegerDotProductAccumulatingSaturating4x8BitPackedSignedAccelerated          Bool32
+	IntegerDotProductAccumulatingSaturating4x8BitPackedMixedSignednessAccelerated Bool32
+	IntegerDotProductAccumulatingSaturating16BitUnsignedAccelerated               Bool32
+	IntegerDotProductAccumulatingSaturating16BitSignedAccelerated                 Bool32
+	IntegerDotProductAccumulatingSaturating16BitMixedSignednessAccelerated        Bool32
+	IntegerDotProductAccumulatingSaturating32BitUnsignedAccelerated               Bool32
+	IntegerDotProductAccumulatingSaturating32BitSignedAccelerated                 Bool32
+	IntegerDotProductAccumulatingSaturating32BitMixedSignednessAccelerated        Bool32
+	IntegerDotProductAccumulatingSaturating64BitUnsignedAccelerated               Bool32
+	IntegerDotProductAccumulatingSaturating64BitSignedAccelerated                 Bool32
+	IntegerDotProductAccumulatingSaturating64BitMixedSignednessAccelerated        Bool32
+	StorageTexelBufferOffsetAlignmentBytes                                        DeviceSize
+	StorageTexelBufferOffsetSingleTexelAlignment                                  Bool32
+	_                                                                             [4]byte
+	UniformTexelBufferOffsetAlignmentBytes                                        DeviceSize
+	UniformTexelBufferOffsetSingleTexelAlignment                                  Bool32
+	_                                                                             [4]byte
+	MaxBufferSize                                                                 DeviceSize
+}
+
+// PipelineCreationFeedback -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkPipelineCreationFeedback.html
+type PipelineCreationFeedback struct {
+	Flags    PipelineCreationFeedbackFlags
+	_        [4]byte
+	Duration uint64
+}
+
+// ImageMemoryBarrier2 -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkImageMemoryBarrier2.html
+type ImageMemoryBarrier2 struct {
+	SType               StructureType
+	PNext               unsafe.Pointer
+	SrcStageMask        PipelineStageFlags2
+	SrcAccessMask       AccessFlags2
+	DstStageMask        PipelineStageFlags2
+	DstAccessMask       AccessFlags2
+	OldLayout           ImageLayout
+	NewLayout           ImageLayout
+	SrcQueueFamilyIndex uint32
+	DstQueueFamilyIndex uint32
+	Image               Image
+	SubresourceRange    ImageSubresourceRange
+	_                   [4]byte
+}
+
+// SemaphoreSubmitInfo -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkSemaphoreSubmitInfo.html
+type SemaphoreSubmitInfo struct {
+	SType       StructureType
+	PNext       unsafe.Pointer
+	Semaphore   Semaphore
+	Value       uint64
+	StageMask   PipelineStageFlags2
+	DeviceIndex uint32
+	_           [4]byte
+}
+
+// CopyImageInfo2 -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkCopyImageInfo2.html
+type CopyImageInfo2 struct {
+	SType          StructureType
+	PNext          unsafe.Pointer
+	SrcImage       Image
+	SrcImageLayout ImageLayout
+	_              [4]byte
+	DstImage       Image
+	DstImageLayout ImageLayout
+	RegionCount    uint32
+	PRegions       *ImageCopy2
+	_              [4]byte
+}
+
+// CopyBufferToImageInfo2 -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkCopyBufferToImageInfo2.html
+type CopyBufferToImageInfo2 struct {
+	SType          StructureType
+	PNext          unsafe.Pointer
+	SrcBuffer      Buffer
+	DstImage       Image
+	DstImageLayout ImageLayout
+	RegionCount    uint32
+	PRegions       *BufferImageCopy2
+	_              [4]byte
+}
+
+// CopyImageToBufferInfo2 -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkCopyImageToBufferInfo2.html
+type CopyImageToBufferInfo2 struct {
+	SType          StructureType
+	PNext          unsafe.Pointer
+	SrcImage       Image
+	SrcImageLayout ImageLayout
+	_              [4]byte
+	DstBuffer      Buffer
+	RegionCount    uint32
+	PRegions       *BufferImageCopy2
+}
+
+// BlitImageInfo2 -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkBlitImageInfo2.html
+type BlitImageInfo2 struct {
+	SType          StructureType
+	PNext          unsafe.Pointer
+	SrcImage       Image
+	SrcImageLayout ImageLayout
+	_              [4]byte
+	DstImage       Image
+	DstImageLayout ImageLayout
+	RegionCount    uint32
+	PRegions       *ImageBlit2
+	Filter         Filter
+}
+
+// ResolveImageInfo2 -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkResolveImageInfo2.html
+type ResolveImageInfo2 struct {
+	SType          StructureType
+	PNext          unsafe.Pointer
+	SrcImage       Image
+	SrcImageLayout ImageLayout
+	_              [4]byte
+	DstImage       Image
+	DstImageLayout ImageLayout
+	RegionCount    uint32
+	PRegions       *ImageResolve2
+	_              [4]byte
+}
+
+// RenderingAttachmentInfo -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkRenderingAttachmentInfo.html
+type RenderingAttachmentInfo struct {
+	SType              StructureType
+	PNext              unsafe.Pointer
+	ImageView          ImageView
+	ImageLayout        ImageLayout
+	ResolveMode        ResolveModeFlags
+	ResolveImageView   ImageView
+	ResolveImageLayout ImageLayout
+	LoadOp             AttachmentLoadOp
+	StoreOp            AttachmentStoreOp
+	ClearValue         ClearValue
+	_                  [4]byte
+}
+
+// PhysicalDeviceTexelBufferAlignmentProperties -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkPhysicalDeviceTexelBufferAlignmentProperties.html
+type PhysicalDeviceTexelBufferAlignmentProperties struct {
+	SType                                        StructureType
+	PNext                                        unsafe.Pointer
+	StorageTexelBufferOffsetAlignmentBytes       DeviceSize
+	StorageTexelBufferOffsetSingleTexelAlignment Bool32
+	_                                            [4]byte
+	UniformTexelBufferOffsetAlignmentBytes       DeviceSize
+	UniformTexelBufferOffsetSingleTexelAlignment Bool32
+	_                                            [4]byte
+}
+
+// SwapchainCreateInfoKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkSwapchainCreateInfoKHR.html
+type SwapchainCreateInfoKHR struct {
+	SType                 StructureType
+	PNext                 unsafe.Pointer
+	Flags                 SwapchainCreateFlagsKHR
+	_                     [4]byte
+	Surface               SurfaceKHR
+	MinImageCount         uint32
+	ImageFormat           Format
+	ImageColorSpace       ColorSpaceKHR
+	ImageExtent           Extent2D
+	ImageArrayLayers      uint32
+	ImageUsage            ImageUsageFlags
+	ImageSharingMode      SharingMode
+	QueueFamilyIndexCount uint32
+	PQueueFamilyIndices   *uint32
+	PreTransform          SurfaceTransformFlagsKHR
+	CompositeAlpha        CompositeAlphaFlagsKHR
+	PresentMode           PresentModeKHR
+	Clipped               Bool32
+	OldSwapchain          SwapchainKHR
+}
+
+// BindImageMemorySwapchainInfoKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkBindImageMemorySwapchainInfoKHR.html
+type BindImageMemorySwapchainInfoKHR struct {
+	SType      StructureType
+	PNext      unsafe.Pointer
+	Swapchain  SwapchainKHR
+	ImageIndex uint32
+	_          [4]byte
+}
+
+// AcquireNextImageInfoKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkAcquireNextImageInfoKHR.html
+type AcquireNextImageInfoKHR struct {
+	SType      StructureType
+	PNext      unsafe.Pointer
+	Swapchain  SwapchainKHR
+	Timeout    uint64
+	Semaphore  Semaphore
+	Fence      Fence
+	DeviceMask uint32
+	_          [4]byte
+}
+
+// DisplayModePropertiesKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkDisplayModePropertiesKHR.html
+type DisplayModePropertiesKHR struct {
+	DisplayMode DisplayModeKHR
+	Parameters  DisplayModeParametersKHR
+	_           [4]byte
+}
+
+// DisplayPlanePropertiesKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkDisplayPlanePropertiesKHR.html
+type DisplayPlanePropertiesKHR struct {
+	CurrentDisplay    DisplayKHR
+	CurrentStackIndex uint32
+	_                 [4]byte
+}
+
+// DisplaySurfaceCreateInfoKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkDisplaySurfaceCreateInfoKHR.html
+type DisplaySurfaceCreateInfoKHR struct {
+	SType           StructureType
+	PNext           unsafe.Pointer
+	Flags           DisplaySurfaceCreateFlagsKHR
+	_               [4]byte
+	DisplayMode     DisplayModeKHR
+	PlaneIndex      uint32
+	PlaneStackIndex uint32
+	Transform       SurfaceTransformFlagsKHR
+	GlobalAlpha     float32
+	AlphaMode       DisplayPlaneAlphaFlagsKHR
+	ImageExtent     Extent2D
+	_               [4]byte
+}
+
+// VideoCapabilitiesKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoCapabilitiesKHR.html
+type VideoCapabilitiesKHR struct {
+	SType                             StructureType
+	PNext                             unsafe.Pointer
+	Flags                             VideoCapabilityFlagsKHR
+	_                                 [4]byte
+	MinBitstreamBufferOffsetAlignment DeviceSize
+	MinBitstreamBufferSizeAlignment   DeviceSize
+	PictureAccessGranularity          Extent2D
+	MinCodedExtent                    Extent2D
+	MaxCodedExtent                    Extent2D
+	MaxDpbSlots                       uint32
+	MaxActiveReferencePictures        uint32
+	StdHeaderVersion                  ExtensionProperties
+	_                                 [4]byte
+}
+
+// VideoPictureResourceInfoKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoPictureResourceInfoKHR.html
+type VideoPictureResourceInfoKHR struct {
+	SType            StructureType
+	PNext            unsafe.Pointer
+	CodedOffset      Offset2D
+	CodedExtent      Extent2D
+	BaseArrayLayer   uint32
+	_                [4]byte
+	ImageViewBinding ImageView
+}
+
+// VideoSessionMemoryRequirementsKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoSessionMemoryRequirementsKHR.html
+type VideoSessionMemoryRequirementsKHR struct {
+	SType              StructureType
+	PNext              unsafe.Pointer
+	MemoryBindIndex    uint32
+	_                  [4]byte
+	MemoryRequirements MemoryRequirements
+}
+
+// BindVideoSessionMemoryInfoKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkBindVideoSessionMemoryInfoKHR.html
+type BindVideoSessionMemoryInfoKHR struct {
+	SType           StructureType
+	PNext           unsafe.Pointer
+	MemoryBindIndex uint32
+	_               [4]byte
+	Memory          DeviceMemory
+	MemoryOffset    DeviceSize
+	MemorySize      DeviceSize
+}
+
+// VideoSessionParametersCreateInfoKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoSessionParametersCreateInfoKHR.html
+type VideoSessionParametersCreateInfoKHR struct {
+	SType                          StructureType
+	PNext                          unsafe.Pointer
+	Flags                          VideoSessionParametersCreateFlagsKHR
+	_                              [4]byte
+	VideoSessionParametersTemplate VideoSessionParametersKHR
+	VideoSession                   VideoSessionKHR
+}
+
+// VideoBeginCodingInfoKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoBeginCodingInfoKHR.html
+type VideoBeginCodingInfoKHR struct {
+	SType                  StructureType
+	PNext                  unsafe.Pointer
+	Flags                  VideoBeginCodingFlagsKHR
+	_                      [4]byte
+	VideoSession           VideoSessionKHR
+	VideoSessionParameters VideoSessionParametersKHR
+	ReferenceSlotCount     uint32
+	PReferenceSlots        *VideoReferenceSlotInfoKHR
+}
+
+// VideoDecodeInfoKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkVideoDecodeInfoKHR.html
+type VideoDecodeInfoKHR struct {
+	SType               StructureType
+	PNext               unsafe.Pointer
+	Flags               VideoDecodeFlagsKHR
+	_                   [4]byte
+	SrcBuffer           Buffer
+	SrcBufferOffset     DeviceSize
+	SrcBufferRange      DeviceSize
+	DstPictureResource  VideoPictureResourceInfoKHR
+	PSetupReferenceSlot *VideoReferenceSlotInfoKHR
+	ReferenceSlotCount  uint32
+	PReferenceSlots     *VideoReferenceSlotInfoKHR
+	_                   [4]byte
+}
+
+// RenderingFragmentShadingRateAttachmentInfoKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkRenderingFragmentShadingRateAttachmentInfoKHR.html
+type RenderingFragmentShadingRateAttachmentInfoKHR struct {
+	SType                          StructureType
+	PNext                          unsafe.Pointer
+	ImageView                      ImageView
+	ImageLayout                    ImageLayout
+	ShadingRateAttachmentTexelSize Extent2D
+	_                              [4]byte
+}
+
+// RenderingFragmentDensityMapAttachmentInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkRenderingFragmentDensityMapAttachmentInfoEXT.html
+type RenderingFragmentDensityMapAttachmentInfoEXT struct {
+	SType       StructureType
+	PNext       unsafe.Pointer
+	ImageView   ImageView
+	ImageLayout ImageLayout
+	_           [4]byte
+}
+
+// MemoryGetFdInfoKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkMemoryGetFdInfoKHR.html
+type MemoryGetFdInfoKHR struct {
+	SType      StructureType
+	PNext      unsafe.Pointer
+	Memory     DeviceMemory
+	HandleType ExternalMemoryHandleTypeFlags
+	_          [4]byte
+}
+
+// ImportSemaphoreFdInfoKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkImportSemaphoreFdInfoKHR.html
+type ImportSemaphoreFdInfoKHR struct {
+	SType      StructureType
+	PNext      unsafe.Pointer
+	Semaphore  Semaphore
+	Flags      SemaphoreImportFlags
+	HandleType ExternalSemaphoreHandleTypeFlags
+	Fd         int32
+	_          [4]byte
+}
+
+// SemaphoreGetFdInfoKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkSemaphoreGetFdInfoKHR.html
+type SemaphoreGetFdInfoKHR struct {
+	SType      StructureType
+	PNext      unsafe.Pointer
+	Semaphore  Semaphore
+	HandleType ExternalSemaphoreHandleTypeFlags
+	_          [4]byte
+}
+
+// ImportFenceFdInfoKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkImportFenceFdInfoKHR.html
+type ImportFenceFdInfoKHR struct {
+	SType      StructureType
+	PNext      unsafe.Pointer
+	Fence      Fence
+	Flags      FenceImportFlags
+	HandleType ExternalFenceHandleTypeFlags
+	Fd         int32
+	_          [4]byte
+}
+
+// FenceGetFdInfoKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkFenceGetFdInfoKHR.html
+type FenceGetFdInfoKHR struct {
+	SType      StructureType
+	PNext      unsafe.Pointer
+	Fence      Fence
+	HandleType ExternalFenceHandleTypeFlags
+	_          [4]byte
+}
+
+// AcquireProfilingLockInfoKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkAcquireProfilingLockInfoKHR.html
+type AcquireProfilingLockInfoKHR struct {
+	SType   StructureType
+	PNext   unsafe.Pointer
+	Flags   AcquireProfilingLockFlagsKHR
+	_       [4]byte
+	Timeout uint64
+}
+
+// DisplayPlaneInfo2KHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkDisplayPlaneInfo2KHR.html
+type DisplayPlaneInfo2KHR struct {
+	SType      StructureType
+	PNext      unsafe.Pointer
+	Mode       DisplayModeKHR
+	PlaneIndex uint32
+	_          [4]byte
+}
+
+// AccelerationStructureGeometryTrianglesDataKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkAccelerationStructureGeometryTrianglesDataKHR.html
+type AccelerationStructureGeometryTrianglesDataKHR struct {
+	SType         StructureType
+	PNext         unsafe.Pointer
+	VertexFormat  Format
+	_             [4]byte
+	VertexData    DeviceOrHostAddressConstKHR
+	VertexStride  DeviceSize
+	MaxVertex     uint32
+	IndexType     IndexType
+	IndexData     DeviceOrHostAddressConstKHR
+	TransformData DeviceOrHostAddressConstKHR
+}
+
+// AccelerationStructureGeometryInstancesDataKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkAccelerationStructureGeometryInstancesDataKHR.html
+type AccelerationStructureGeometryInstancesDataKHR struct {
+	SType           StructureType
+	PNext           unsafe.Pointer
+	ArrayOfPointers Bool32
+	_               [4]byte
+	Data            DeviceOrHostAddressConstKHR
+}
+
+// AccelerationStructureGeometryKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkAccelerationStructureGeometryKHR.html
+type AccelerationStructureGeometryKHR struct {
+	SType        StructureType
+	PNext        unsafe.Pointer
+	GeometryType GeometryTypeKHR
+	_            [4]byte
+	Geometry     AccelerationStructureGeometryDataKHR
+	Flags        GeometryFlagsKHR
+	_            [4]byte
+}
+
+// AccelerationStructureBuildGeometryInfoKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkAccelerationStructureBuildGeometryInfoKHR.html
+type AccelerationStructureBuildGeometryInfoKHR struct {
+	SType                    StructureType
+	PNext                    unsafe.Pointer
+	Type                     AccelerationStructureTypeKHR
+	Flags                    BuildAccelerationStructureFlagsKHR
+	Mode                     BuildAccelerationStructureModeKHR
+	_                        [4]byte
+	SrcAccelerationStructure AccelerationStructureKHR
+	DstAccelerationStructure AccelerationStructureKHR
+	GeometryCount            uint32
+	PGeometries              *AccelerationStructureGeometryKHR
+	PpGeometries             **AccelerationStructureGeometryKHR
+	_                        [4]byte
+	ScratchData              DeviceOrHostAddressKHR
+}
+
+// AccelerationStructureCreateInfoKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkAccelerationStructureCreateInfoKHR.html
+type AccelerationStructureCreateInfoKHR struct {
+	SType         StructureType
+	PNext         unsafe.Pointer
+	CreateFlags   AccelerationStructureCreateFlagsKHR
+	_             [4]byte
+	Buffer        Buffer
+	Offset        DeviceSize
+	Size          DeviceSize
+	Type          AccelerationStructureTypeKHR
+	_             [4]byte
+	DeviceAddress DeviceAddress
+}
+
+// PhysicalDeviceAccelerationStructurePropertiesKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkPhysicalDeviceAccelerationStructurePropertiesKHR.html
+type PhysicalDeviceAccelerationStructurePropertiesKHR struct {
+	SType                                                      StructureType
+	PNext                                                      unsafe.Pointer
+	MaxGeometryCount                                           uint64
+	MaxInstanceCount                                           uint64
+	MaxPrimitiveCount                                          uint64
+	MaxPerStageDescriptorAccelerationStructures                uint32
+	MaxPerStageDescriptorUpdateAfterBindAccelerationStructures uint32
+	MaxDescriptorSetAccelerationStructures                     uint32
+	MaxDescriptorSetUpdateAfterBindAccelerationStructures      uint32
+	MinAccelerationStructureScratchOffsetAlignment             uint32
+	_                                                          [4]byte
+}
+
+// CopyAccelerationStructureToMemoryInfoKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkCopyAccelerationStructureToMemoryInfoKHR.html
+type CopyAccelerationStructureToMemoryInfoKHR struct {
+	SType StructureType
+	PNext unsafe.Pointer
+	Src   AccelerationStructureKHR
+	Dst   DeviceOrHostAddressKHR
+	Mode  CopyAccelerationStructureModeKHR
+	_     [4]byte
+}
+
+// CopyMemoryToAccelerationStructureInfoKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkCopyMemoryToAccelerationStructureInfoKHR.html
+type CopyMemoryToAccelerationStructureInfoKHR struct {
+	SType StructureType
+	PNext unsafe.Pointer
+	Src   DeviceOrHostAddressConstKHR
+	Dst   AccelerationStructureKHR
+	Mode  CopyAccelerationStructureModeKHR
+	_     [4]byte
+}
+
+// CopyAccelerationStructureInfoKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkCopyAccelerationStructureInfoKHR.html
+type CopyAccelerationStructureInfoKHR struct {
+	SType StructureType
+	PNext unsafe.Pointer
+	Src   AccelerationStructureKHR
+	Dst   AccelerationStructureKHR
+	Mode  CopyAccelerationStructureModeKHR
+	_     [4]byte
+}
+
+// PipelineExecutableInfoKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkPipelineExecutableInfoKHR.html
+type PipelineExecutableInfoKHR struct {
+	SType           StructureType
+	PNext           unsafe.Pointer
+	Pipeline        Pipeline
+	ExecutableIndex uint32
+	_               [4]byte
+}
+
+// PipelineExecutableStatisticKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkPipelineExecutableStatisticKHR.html
+type PipelineExecutableStatisticKHR struct {
+	SType       StructureType
+	PNext       unsafe.Pointer
+	Name        [MAX_DESCRIPTION_SIZE]int8
+	Description [MAX_DESCRIPTION_SIZE]int8
+	Format      PipelineExecutableStatisticFormatKHR
+	_           [4]byte
+	Value       PipelineExecutableStatisticValueKHR
+}
+
+// CheckpointData2NV -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkCheckpointData2NV.html
+type CheckpointData2NV struct {
+	SType             StructureType
+	PNext             unsafe.Pointer
+	Stage             PipelineStageFlags2
+	PCheckpointMarker unsafe.Pointer
+	_                 [4]byte
+}
+
+// RayTracingPipelineCreateInfoKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkRayTracingPipelineCreateInfoKHR.html
+type RayTracingPipelineCreateInfoKHR struct {
+	SType                        StructureType
+	PNext                        unsafe.Pointer
+	Flags                        PipelineCreateFlags
+	StageCount                   uint32
+	PStages                      *PipelineShaderStageCreateInfo
+	GroupCount                   uint32
+	PGroups                      *RayTracingShaderGroupCreateInfoKHR
+	MaxPipelineRayRecursionDepth uint32
+	PLibraryInfo                 *PipelineLibraryCreateInfoKHR
+	PLibraryInterface            *RayTracingPipelineInterfaceCreateInfoKHR
+	PDynamicState                *PipelineDynamicStateCreateInfo
+	_                            [4]byte
+	Layout                       PipelineLayout
+	BasePipelineHandle           Pipeline
+	BasePipelineIndex            int32
+	_                            [4]byte
+}
+
+// TraceRaysIndirectCommand2KHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkTraceRaysIndirectCommand2KHR.html
+type TraceRaysIndirectCommand2KHR struct {
+	RaygenShaderRecordAddress         DeviceAddress
+	RaygenShaderRecordSize            DeviceSize
+	MissShaderBindingTableAddress     DeviceAddress
+	MissShaderBindingTableSize        DeviceSize
+	MissShaderBindingTableStride      DeviceSize
+	HitShaderBindingTableAddress      DeviceAddress
+	HitShaderBindingTableSize         DeviceSize
+	HitShaderBindingTableStride       DeviceSize
+	CallableShaderBindingTableAddress DeviceAddress
+	CallableShaderBindingTableSize    DeviceSize
+	CallableShaderBindingTableStride  DeviceSize
+	Width                             uint32
+	Height                            uint32
+	Depth                             uint32
+	_                                 [4]byte
+}
+
+// DebugMarkerObjectNameInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkDebugMarkerObjectNameInfoEXT.html
+type DebugMarkerObjectNameInfoEXT struct {
+	SType       StructureType
+	PNext       unsafe.Pointer
+	ObjectType  DebugReportObjectTypeEXT
+	_           [4]byte
+	Object      uint64
+	PObjectName *int8
+	_           [4]byte
+}
+
+// DebugMarkerObjectTagInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkDebugMarkerObjectTagInfoEXT.html
+type DebugMarkerObjectTagInfoEXT struct {
+	SType      StructureType
+	PNext      unsafe.Pointer
+	ObjectType DebugReportObjectTypeEXT
+	_          [4]byte
+	Object     uint64
+	TagName    uint64
+	TagSize    uintptr
+	PTag       unsafe.Pointer
+}
+
+// PhysicalDeviceTransformFeedbackPropertiesEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkPhysicalDeviceTransformFeedbackPropertiesEXT.html
+type PhysicalDeviceTransformFeedbackPropertiesEXT struct {
+	SType                                      StructureType
+	PNext                                      unsafe.Pointer
+	MaxTransformFeedbackStreams                uint32
+	MaxTransformFeedbackBuffers                uint32
+	MaxTransformFeedbackBufferSize             DeviceSize
+	MaxTransformFeedbackStreamDataSize         uint32
+	MaxTransformFeedbackBufferDataSize         uint32
+	MaxTransformFeedbackBufferDataStride       uint32
+	TransformFeedbackQueries                   Bool32
+	TransformFeedbackStreamsLinesTriangles     Bool32
+	TransformFeedbackRasterizationStreamSelect Bool32
+	TransformFeedbackDraw                      Bool32
+	_                                          [4]byte
+}
+
+// CuFunctionCreateInfoNVX -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkCuFunctionCreateInfoNVX.html
+type CuFunctionCreateInfoNVX struct {
+	SType  StructureType
+	PNext  unsafe.Pointer
+	Module CuModuleNVX
+	PName  *int8
+	_      [4]byte
+}
+
+// CuLaunchInfoNVX -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkCuLaunchInfoNVX.html
+type CuLaunchInfoNVX struct {
+	SType          StructureType
+	PNext          unsafe.Pointer
+	Function       CuFunctionNVX
+	GridDimX       uint32
+	GridDimY       uint32
+	GridDimZ       uint32
+	BlockDimX      uint32
+	BlockDimY      uint32
+	BlockDimZ      uint32
+	SharedMemBytes uint32
+	ParamCount     uintptr
+	PParams        *unsafe.Pointer
+	ExtraCount     uintptr
+	PExtras        *unsafe.Pointer
+	_              [4]byte
+}
+
+// ImageViewHandleInfoNVX -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkImageViewHandleInfoNVX.html
+type ImageViewHandleInfoNVX struct {
+	SType          StructureType
+	PNext          unsafe.Pointer
+	ImageView      ImageView
+	DescriptorType DescriptorType
+	_              [4]byte
+	Sampler        Sampler
+}
+
+// ExternalImageFormatPropertiesNV -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkExternalImageFormatPropertiesNV.html
+type ExternalImageFormatPropertiesNV struct {
+	ImageFormatProperties         ImageFormatProperties
+	ExternalMemoryFeatures        ExternalMemoryFeatureFlagsNV
+	ExportFromImportedHandleTypes ExternalMemoryHandleTypeFlagsNV
+	CompatibleHandleTypes         ExternalMemoryHandleTypeFlagsNV
+	_                             [4]byte
+}
+
+// ConditionalRenderingBeginInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkConditionalRenderingBeginInfoEXT.html
+type ConditionalRenderingBeginInfoEXT struct {
+	SType  StructureType
+	PNext  unsafe.Pointer
+	Buffer Buffer
+	Offset DeviceSize
+	Flags  ConditionalRenderingFlagsEXT
+	_      [4]byte
+}
+
+// PastPresentationTimingGOOGLE -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkPastPresentationTimingGOOGLE.html
+type PastPresentationTimingGOOGLE struct {
+	PresentID           uint32
+	_                   [4]byte
+	DesiredPresentTime  uint64
+	ActualPresentTime   uint64
+	EarliestPresentTime uint64
+	PresentMargin       uint64
+}
+
+// PresentTimeGOOGLE -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkPresentTimeGOOGLE.html
+type PresentTimeGOOGLE struct {
+	PresentID          uint32
+	_                  [4]byte
+	DesiredPresentTime uint64
+}
+
+// DebugUtilsObjectNameInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkDebugUtilsObjectNameInfoEXT.html
+type DebugUtilsObjectNameInfoEXT struct {
+	SType        StructureType
+	PNext        unsafe.Pointer
+	ObjectType   ObjectType
+	_            [4]byte
+	ObjectHandle uint64
+	PObjectName  *int8
+	_            [4]byte
+}
+
+// DebugUtilsObjectTagInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkDebugUtilsObjectTagInfoEXT.html
+type DebugUtilsObjectTagInfoEXT struct {
+	SType        StructureType
+	PNext        unsafe.Pointer
+	ObjectType   ObjectType
+	_            [4]byte
+	ObjectHandle uint64
+	TagName      uint64
+	TagSize      uintptr
+	PTag         unsafe.Pointer
+}
+
+// PhysicalDeviceImageDrmFormatModifierInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkPhysicalDeviceImageDrmFormatModifierInfoEXT.html
+type PhysicalDeviceImageDrmFormatModifierInfoEXT struct {
+	SType                 StructureType
+	PNext                 unsafe.Pointer
+	DrmFormatModifier     uint64
+	SharingMode           SharingMode
+	QueueFamilyIndexCount uint32
+	PQueueFamilyIndices   *uint32
+	_                     [4]byte
+}
+
+// DrmFormatModifierProperties2EXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkDrmFormatModifierProperties2EXT.html
+type DrmFormatModifierProperties2EXT struct {
+	DrmFormatModifier               uint64
+	DrmFormatModifierPlaneCount     uint32
+	_                               [4]byte
+	DrmFormatModifierTilingFeatures FormatFeatureFlags2
+}
+
+// RayTracingPipelineCreateInfoNV -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkRayTracingPipelineCreateInfoNV.html
+type RayTracingPipelineCreateInfoNV struct {
+	SType              StructureType
+	PNext              unsafe.Pointer
+	Flags              PipelineCreateFlags
+	StageCount         uint32
+	PStages            *PipelineShaderStageCreateInfo
+	GroupCount         uint32
+	PGroups            *RayTracingShaderGroupCreateInfoNV
+	MaxRecursionDepth  uint32
+	Layout             PipelineLayout
+	BasePipelineHandle Pipeline
+	BasePipelineIndex  int32
+	_                  [4]byte
+}
+
+// GeometryTrianglesNV -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkGeometryTrianglesNV.html
+type GeometryTrianglesNV struct {
+	SType           StructureType
+	PNext           unsafe.Pointer
+	VertexData      Buffer
+	VertexOffset    DeviceSize
+	VertexCount     uint32
+	_               [4]byte
+	VertexStride    DeviceSize
+	VertexFormat    Format
+	_               [4]byte
+	IndexData       Buffer
+	IndexOffset     DeviceSize
+	IndexCount      uint32
+	IndexType       IndexType
+	TransformData   Buffer
+	TransformOffset DeviceSize
+}
+
+// GeometryNV -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkGeometryNV.html
+type GeometryNV struct {
+	SType        StructureType
+	PNext        unsafe.Pointer
+	GeometryType GeometryTypeKHR
+	_            [4]byte
+	Geometry     GeometryDataNV
+	Flags        GeometryFlagsKHR
+	_            [4]byte
+}
+
+// AccelerationStructureCreateInfoNV -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkAccelerationStructureCreateInfoNV.html
+type AccelerationStructureCreateInfoNV struct {
+	SType         StructureType
+	PNext         unsafe.Pointer
+	CompactedSize DeviceSize
+	Info          AccelerationStructureInfoNV
+	_             [4]byte
+}
+
+// AccelerationStructureMemoryRequirementsInfoNV -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkAccelerationStructureMemoryRequirementsInfoNV.html
+type AccelerationStructureMemoryRequirementsInfoNV struct {
+	SType                 StructureType
+	PNext                 unsafe.Pointer
+	Type                  AccelerationStructureMemoryRequirementsTypeNV
+	_                     [4]byte
+	AccelerationStructure AccelerationStructureNV
+}
+
+// PhysicalDeviceRayTracingPropertiesNV -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkPhysicalDeviceRayTracingPropertiesNV.html
+type PhysicalDeviceRayTracingPropertiesNV struct {
+	SType                                  StructureType
+	PNext                                  unsafe.Pointer
+	ShaderGroupHandleSize                  uint32
+	MaxRecursionDepth                      uint32
+	MaxShaderGroupStride                   uint32
+	ShaderGroupBaseAlignment               uint32
+	MaxGeometryCount                       uint64
+	MaxInstanceCount                       uint64
+	MaxTriangleCount                       uint64
+	MaxDescriptorSetAccelerationStructures uint32
+	_                                      [4]byte
+}
+
+// PerformanceValueINTEL -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkPerformanceValueINTEL.html
+type PerformanceValueINTEL struct {
+	Type PerformanceValueTypeINTEL
+	_    [4]byte
+	Data PerformanceValueDataINTEL
+}
+
+// IndirectCommandsLayoutTokenNV -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkIndirectCommandsLayoutTokenNV.html
+type IndirectCommandsLayoutTokenNV struct {
+	SType                        StructureType
+	PNext                        unsafe.Pointer
+	TokenType                    IndirectCommandsTokenTypeNV
+	Stream                       uint32
+	Offset                       uint32
+	VertexBindingUnit            uint32
+	VertexDynamicStride          Bool32
+	_                            [4]byte
+	PushconstantPipelineLayout   PipelineLayout
+	PushconstantShaderStageFlags ShaderStageFlags
+	PushconstantOffset           uint32
+	PushconstantSize             uint32
+	IndirectStateFlags           IndirectStateFlagsNV
+	IndexTypeCount               uint32
+	PIndexTypes                  *IndexType
+	PIndexTypeValues             *uint32
+	_                            [4]byte
+}
+
+// GeneratedCommandsInfoNV -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkGeneratedCommandsInfoNV.html
+type GeneratedCommandsInfoNV struct {
+	SType                  StructureType
+	PNext                  unsafe.Pointer
+	PipelineBindPoint      PipelineBindPoint
+	_                      [4]byte
+	Pipeline               Pipeline
+	IndirectCommandsLayout IndirectCommandsLayoutNV
+	StreamCount            uint32
+	PStreams               *IndirectCommandsStreamNV
+	SequencesCount         uint32
+	_                      [4]byte
+	PreprocessBuffer       Buffer
+	PreprocessOffset       DeviceSize
+	PreprocessSize         DeviceSize
+	SequencesCountBuffer   Buffer
+	SequencesCountOffset   DeviceSize
+	SequencesIndexBuffer   Buffer
+	SequencesIndexOffset   DeviceSize
+}
+
+// GeneratedCommandsMemoryRequirementsInfoNV -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkGeneratedCommandsMemoryRequirementsInfoNV.html
+type GeneratedCommandsMemoryRequirementsInfoNV struct {
+	SType                  StructureType
+	PNext                  unsafe.Pointer
+	PipelineBindPoint      PipelineBindPoint
+	_                      [4]byte
+	Pipeline               Pipeline
+	IndirectCommandsLayout IndirectCommandsLayoutNV
+	MaxSequencesCount      uint32
+	_                      [4]byte
+}
+
+// DeviceMemoryReportCallbackDataEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkDeviceMemoryReportCallbackDataEXT.html
+type DeviceMemoryReportCallbackDataEXT struct {
+	SType          StructureType
+	PNext          unsafe.Pointer
+	Flags          DeviceMemoryReportFlagsEXT
+	Type           DeviceMemoryReportEventTypeEXT
+	MemoryObjectId uint64
+	Size           DeviceSize
+	ObjectType     ObjectType
+	_              [4]byte
+	ObjectHandle   uint64
+	HeapIndex      uint32
+	_              [4]byte
+}
+
+// PhysicalDeviceDescriptorBufferPropertiesEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkPhysicalDeviceDescriptorBufferPropertiesEXT.html
+type PhysicalDeviceDescriptorBufferPropertiesEXT struct {
+	SType                                                StructureType
+	PNext                                                unsafe.Pointer
+	CombinedImageSamplerDescriptorSingleArray            Bool32
+	BufferlessPushDescriptors                            Bool32
+	AllowSamplerImageViewPostSubmitCreation              Bool32
+	_                                                    [4]byte
+	DescriptorBufferOffsetAlignment                      DeviceSize
+	MaxDescriptorBufferBindings                          uint32
+	MaxResourceDescriptorBufferBindings                  uint32
+	MaxSamplerDescriptorBufferBindings                   uint32
+	MaxEmbeddedImmutableSamplerBindings                  uint32
+	MaxEmbeddedImmutableSamplers                         uint32
+	BufferCaptureReplayDescriptorDataSize                uintptr
+	ImageCaptureReplayDescriptorDataSize                 uintptr
+	ImageViewCaptureReplayDescriptorDataSize             uintptr
+	SamplerCaptureReplayDescriptorDataSize               uintptr
+	AccelerationStructureCaptureReplayDescriptorDataSize uintptr
+	SamplerDescriptorSize                                uintptr
+	CombinedImageSamplerDescriptorSize                   uintptr
+	SampledImageDescriptorSize                           uintptr
+	StorageImageDescriptorSize                           uintptr
+	UniformTexelBufferDescriptorSize                     uintptr
+	RobustUniformTexelBufferDescriptorSize               uintptr
+	StorageTexelBufferDescriptorSize                     uintptr
+	RobustStorageTexelBufferDescriptorSize               uintptr
+	UniformBufferDescriptorSize                          uintptr
+	RobustUniformBufferDescriptorSize                    uintptr
+	StorageBufferDescriptorSize                          uintptr
+	RobustStorageBufferDescriptorSize                    uintptr
+	InputAttachmentDescriptorSize                        uintptr
+	AccelerationStructureDescriptorSize                  uintptr
+	MaxSamplerDescriptorBufferRange                      DeviceSize
+	MaxResourceDescriptorBufferRange                     DeviceSize
+	SamplerDescriptorBufferAddressSpaceSize              DeviceSize
+	ResourceDescriptorBufferAddressSpaceSize             DeviceSize
+	DescriptorBufferAddressSpaceSize                     DeviceSize
+}
+
+// DescriptorAddressInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkDescriptorAddressInfoEXT.html
+type DescriptorAddressInfoEXT struct {
+	SType   StructureType
+	PNext   unsafe.Pointer
+	Address DeviceAddress
+	Range   DeviceSize
+	Format  Format
+	_       [4]byte
+}
+
+// DescriptorBufferBindingInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkDescriptorBufferBindingInfoEXT.html
+type DescriptorBufferBindingInfoEXT struct {
+	SType   StructureType
+	PNext   unsafe.Pointer
+	Address DeviceAddress
+	Usage   BufferUsageFlags
+	_       [4]byte
+}
+
+// DescriptorGetInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkDescriptorGetInfoEXT.html
+type DescriptorGetInfoEXT struct {
+	SType StructureType
+	PNext unsafe.Pointer
+	Type  DescriptorType
+	_     [4]byte
+	Data  DescriptorDataEXT
+}
+
+// DeviceFaultAddressInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkDeviceFaultAddressInfoEXT.html
+type DeviceFaultAddressInfoEXT struct {
+	AddressType      DeviceFaultAddressTypeEXT
+	_                [4]byte
+	ReportedAddress  DeviceAddress
+	AddressPrecision DeviceSize
+}
+
+// DeviceAddressBindingCallbackDataEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkDeviceAddressBindingCallbackDataEXT.html
+type DeviceAddressBindingCallbackDataEXT struct {
+	SType       StructureType
+	PNext       unsafe.Pointer
+	Flags       DeviceAddressBindingFlagsEXT
+	_           [4]byte
+	BaseAddress DeviceAddress
+	Size        DeviceSize
+	BindingType DeviceAddressBindingTypeEXT
+	_           [4]byte
+}
+
+// SubpassShadingPipelineCreateInfoHUAWEI -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkSubpassShadingPipelineCreateInfoHUAWEI.html
+type SubpassShadingPipelineCreateInfoHUAWEI struct {
+	SType      StructureType
+	PNext      unsafe.Pointer
+	RenderPass RenderPass
+	Subpass    uint32
+	_          [4]byte
+}
+
+// MemoryGetRemoteAddressInfoNV -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkMemoryGetRemoteAddressInfoNV.html
+type MemoryGetRemoteAddressInfoNV struct {
+	SType      StructureType
+	PNext      unsafe.Pointer
+	Memory     DeviceMemory
+	HandleType ExternalMemoryHandleTypeFlags
+	_          [4]byte
+}
+
+// MicromapBuildInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkMicromapBuildInfoEXT.html
+type MicromapBuildInfoEXT struct {
+	SType               StructureType
+	PNext               unsafe.Pointer
+	Type                MicromapTypeEXT
+	Flags               BuildMicromapFlagsEXT
+	Mode                BuildMicromapModeEXT
+	_                   [4]byte
+	DstMicromap         MicromapEXT
+	UsageCountsCount    uint32
+	PUsageCounts        *MicromapUsageEXT
+	PpUsageCounts       **MicromapUsageEXT
+	_                   [4]byte
+	Data                DeviceOrHostAddressConstKHR
+	ScratchData         DeviceOrHostAddressKHR
+	TriangleArray       DeviceOrHostAddressConstKHR
+	TriangleArrayStride DeviceSize
+}
+
+// MicromapCreateInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkMicromapCreateInfoEXT.html
+type MicromapCreateInfoEXT struct {
+	SType         StructureType
+	PNext         unsafe.Pointer
+	CreateFlags   MicromapCreateFlagsEXT
+	_             [4]byte
+	Buffer        Buffer
+	Offset        DeviceSize
+	Size          DeviceSize
+	Type          MicromapTypeEXT
+	_             [4]byte
+	DeviceAddress DeviceAddress
+}
+
+// CopyMicromapToMemoryInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkCopyMicromapToMemoryInfoEXT.html
+type CopyMicromapToMemoryInfoEXT struct {
+	SType StructureType
+	PNext unsafe.Pointer
+	Src   MicromapEXT
+	Dst   DeviceOrHostAddressKHR
+	Mode  CopyMicromapModeEXT
+	_     [4]byte
+}
+
+// CopyMemoryToMicromapInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkCopyMemoryToMicromapInfoEXT.html
+type CopyMemoryToMicromapInfoEXT struct {
+	SType StructureType
+	PNext unsafe.Pointer
+	Src   DeviceOrHostAddressConstKHR
+	Dst   MicromapEXT
+	Mode  CopyMicromapModeEXT
+	_     [4]byte
+}
+
+// CopyMicromapInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkCopyMicromapInfoEXT.html
+type CopyMicromapInfoEXT struct {
+	SType StructureType
+	PNext unsafe.Pointer
+	Src   MicromapEXT
+	Dst   MicromapEXT
+	Mode  CopyMicromapModeEXT
+	_     [4]byte
+}
+
+// MicromapBuildSizesInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkMicromapBuildSizesInfoEXT.html
+type MicromapBuildSizesInfoEXT struct {
+	SType            StructureType
+	PNext            unsafe.Pointer
+	MicromapSize     DeviceSize
+	BuildScratchSize DeviceSize
+	Discardable      Bool32
+	_                [4]byte
+}
+
+// AccelerationStructureTrianglesOpacityMicromapEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkAccelerationStructureTrianglesOpacityMicromapEXT.html
+type AccelerationStructureTrianglesOpacityMicromapEXT struct {
+	SType            StructureType
+	PNext            unsafe.Pointer
+	IndexType        IndexType
+	_                [4]byte
+	IndexBuffer      DeviceOrHostAddressConstKHR
+	IndexStride      DeviceSize
+	BaseTriangle     uint32
+	UsageCountsCount uint32
+	PUsageCounts     *MicromapUsageEXT
+	PpUsageCounts    **MicromapUsageEXT
+	Micromap         MicromapEXT
+}
+
+// DescriptorSetBindingReferenceVALVE -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkDescriptorSetBindingReferenceVALVE.html
+type DescriptorSetBindingReferenceVALVE struct {
+	SType               StructureType
+	PNext               unsafe.Pointer
+	DescriptorSetLayout DescriptorSetLayout
+	Binding             uint32
+	_                   [4]byte
+}
@@ -0,0 +1,95 @@
+//go:build directfb
+// +build directfb
+
+package vk
+
+// #cgo linux LDFLAGS: -lvulkan
+// #include <stdint.h>
+// typedef struct _IDirectFB IDirectFB;
+// typedef struct _IDirectFBSurface IDirectFBSurface;
+// #include "./vulkan/vulkan.h"
+// #include "./vulkan/vulkan_directfb.h"
+//
+// VkResult bridge_vkCreateDirectFBSurfaceEXT(uintptr_t fp,VkInstance instance,const VkDirectFBSurfaceCreateInfoEXT* pCreateInfo,const VkAllocationCallbacks* pAllocator,VkSurfaceKHR* pSurface){
+//   return ((PFN_vkCreateDirectFBSurfaceEXT)fp)(instance,pCreateInfo,pAllocator,pSurface);
+// }
+// VkBool32 bridge_vkGetPhysicalDeviceDirectFBPresentationSupportEXT(uintptr_t fp,VkPhysicalDevice physicalDevice,uint32_t queueFamilyIndex,IDirectFB* dfb){
+//   return ((PFN_vkGetPhysicalDeviceDirectFBPresentationSupportEXT)fp)(physicalDevice,queueFamilyIndex,dfb);
+// }
+import "C"
+
+import (
+	"unsafe"
+)
+
+/*
+ ** Copyright (c) 2015-2019 The Khronos Group Inc.
+ **
+ ** Licensed under the Apache License, Version 2.0 (the "License");
+ ** you may not use this file except in compliance with the License.
+ ** You may obtain a copy of the License at
+ **
+ **     http://www.apache.org/licenses/LICENSE-2.0
+ **
+ ** Unless required by applicable law or agreed to in writing, software
+ ** distributed under the License is distributed on an "AS IS" BASIS,
+ ** WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ ** See the License for the specific language governing permissions and
+ ** limitations under the License.
+ */
+
+/*
+ ** This file is generated from the Vulkan headers.
+ */
+
+type (
+	IDirectFB        = C.IDirectFB
+	IDirectFBSurface = C.IDirectFBSurface
+)
+
+const EXT_directfb_surface = 1
+const EXT_DIRECTFB_SURFACE_SPEC_VERSION = 1
+
+var EXT_DIRECTFB_SURFACE_EXTENSION_NAME = "VK_EXT_directfb_surface"
+
+type DirectFBSurfaceCreateFlagsEXT uint32 // reserved
+// DirectFBSurfaceCreateInfoEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkDirectFBSurfaceCreateInfoEXT.html
+type DirectFBSurfaceCreateInfoEXT struct {
+	SType   StructureType
+	PNext   unsafe.Pointer
+	Flags   DirectFBSurfaceCreateFlagsEXT
+	Dfb     *IDirectFB
+	Surface *IDirectFBSurface
+}
+
+func NewDirectFBSurfaceCreateInfoEXT() *DirectFBSurfaceCreateInfoEXT {
+	p := (*DirectFBSurfaceCreateInfoEXT)(MemAlloc(unsafe.Sizeof(*(*DirectFBSurfaceCreateInfoEXT)(nil))))
+	p.SType = STRUCTURE_TYPE_DIRECTFB_SURFACE_CREATE_INFO_EXT
+	return p
+}
+func (p *DirectFBSurfaceCreateInfoEXT) Free() { MemFree(unsafe.Pointer(p)) }
+
+// PfnCreateDirectFBSurfaceEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkCreateDirectFBSurfaceEXT.html
+type PfnCreateDirectFBSurfaceEXT uintptr
+
+func (fn PfnCreateDirectFBSurfaceEXT) Call(instance Instance, pCreateInfo *DirectFBSurfaceCreateInfoEXT, pAllocator *AllocationCallbacks, pSurface *SurfaceKHR) Result {
+	ret := C.bridge_vkCreateDirectFBSurfaceEXT(C.uintptr_t(fn), (C.VkInstance)(unsafe.Pointer(uintptr(instance))), (*C.VkDirectFBSurfaceCreateInfoEXT)(unsafe.Pointer(pCreateInfo)), (*C.VkAllocationCallbacks)(unsafe.Pointer(pAllocator)), (*C.VkSurfaceKHR)(unsafe.Pointer(pSurface)))
+	debugCheckAndBreak()
+	if trackObjects {
+		trackCreated(Result(ret), OBJECT_TYPE_SURFACE_KHR, uint64(instance), unsafe.Pointer(pSurface), 1, unsafe.Sizeof(*pSurface), true)
+	}
+	return Result(ret)
+}
+func (fn PfnCreateDirectFBSurfaceEXT) String() string { return "vkCreateDirectFBSurfaceEXT" }
+
+// PfnGetPhysicalDeviceDirectFBPresentationSupportEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetPhysicalDeviceDirectFBPresentationSupportEXT.html
+type PfnGetPhysicalDeviceDirectFBPresentationSupportEXT uintptr
+
+func (fn PfnGetPhysicalDeviceDirectFBPresentationSupportEXT) Call(physicalDevice PhysicalDevice, queueFamilyIndex uint32, dfb *IDirectFB) Bool32 {
+	ret := C.bridge_vkGetPhysicalDeviceDirectFBPresentationSupportEXT(C.uintptr_t(fn), (C.VkPhysicalDevice)(unsafe.Pointer(uintptr(physicalDevice))), (C.uint32_t)(queueFamilyIndex), (*C.IDirectFB)(unsafe.Pointer(dfb)))
+	debugCheckAndBreak()
+	return Bool32(ret)
+}
+func (fn PfnGetPhysicalDeviceDirectFBPresentationSupportEXT) String() string {
+	return "vkGetPhysicalDeviceDirectFBPresentationSupportEXT"
+}
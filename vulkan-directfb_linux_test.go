@@ -0,0 +1,24 @@
+//go:build directfb
+// +build directfb
+
+package vk
+
+import (
+	"testing"
+	"unsafe"
+)
+
+func TestDirectFBSurfaceCreateInfoEXT(t *testing.T) {
+	p := NewDirectFBSurfaceCreateInfoEXT()
+	defer p.Free()
+	if p.SType != STRUCTURE_TYPE_DIRECTFB_SURFACE_CREATE_INFO_EXT {
+		t.Errorf("SType = %v, want %v", p.SType, STRUCTURE_TYPE_DIRECTFB_SURFACE_CREATE_INFO_EXT)
+	}
+	word := unsafe.Sizeof(uintptr(0))
+	if got, want := unsafe.Sizeof(*p), 5*word; got != want {
+		t.Errorf("sizeof(VkDirectFBSurfaceCreateInfoEXT) = %d, want %d", got, want)
+	}
+	if got, want := unsafe.Offsetof(p.Surface), 4*word; got != want {
+		t.Errorf("offsetof(surface) = %d, want %d", got, want)
+	}
+}
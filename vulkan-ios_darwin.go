@@ -1,3 +1,4 @@
+//go:build ios
 // +build ios
 
 package vk
@@ -8,8 +9,8 @@ package vk
 // #include "./vulkan/vulkan.h"
 // #include "./vulkan/vulkan_ios.h"
 //
-// VkResult bridge_vkCreateMacOSSurfaceMVK(uintptr_t fp,VkInstance instance,const VkMacOSSurfaceCreateInfoMVK* pCreateInfo,const VkAllocationCallbacks* pAllocator,VkSurfaceKHR* pSurface){
-//   return ((PFN_vkCreateMacOSSurfaceMVK)fp)(instance,pCreateInfo,pAllocator,pSurface);
+// VkResult bridge_vkCreateIOSSurfaceMVK(uintptr_t fp,VkInstance instance,const VkIOSSurfaceCreateInfoMVK* pCreateInfo,const VkAllocationCallbacks* pAllocator,VkSurfaceKHR* pSurface){
+//   return ((PFN_vkCreateIOSSurfaceMVK)fp)(instance,pCreateInfo,pAllocator,pSurface);
 // }
 import "C"
 
@@ -37,33 +38,36 @@ import (
  ** This file is generated from the Vulkan headers.
  */
 
-const MVK_macos_surface = 1
-const MVK_MACOS_SURFACE_SPEC_VERSION = 3
+const MVK_ios_surface = 1
+const MVK_IOS_SURFACE_SPEC_VERSION = 3
 
-var MVK_MACOS_SURFACE_EXTENSION_NAME = "VK_MVK_macos_surface"
+var MVK_IOS_SURFACE_EXTENSION_NAME = "VK_MVK_ios_surface"
 
-type MacOSSurfaceCreateFlagsMVK uint32 // reserved
-// MacOSSurfaceCreateInfoMVK -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkMacOSSurfaceCreateInfoMVK.html
-type MacOSSurfaceCreateInfoMVK struct {
+type IOSSurfaceCreateFlagsMVK uint32 // reserved
+// IOSSurfaceCreateInfoMVK -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkIOSSurfaceCreateInfoMVK.html
+type IOSSurfaceCreateInfoMVK struct {
 	SType StructureType
 	PNext unsafe.Pointer
-	Flags MacOSSurfaceCreateFlagsMVK
+	Flags IOSSurfaceCreateFlagsMVK
 	PView unsafe.Pointer
 }
 
-func NewMacOSSurfaceCreateInfoMVK() *MacOSSurfaceCreateInfoMVK {
-	p := (*MacOSSurfaceCreateInfoMVK)(MemAlloc(unsafe.Sizeof(*(*MacOSSurfaceCreateInfoMVK)(nil))))
-	p.SType = STRUCTURE_TYPE_MACOS_SURFACE_CREATE_INFO_MVK
+func NewIOSSurfaceCreateInfoMVK() *IOSSurfaceCreateInfoMVK {
+	p := (*IOSSurfaceCreateInfoMVK)(MemAlloc(unsafe.Sizeof(*(*IOSSurfaceCreateInfoMVK)(nil))))
+	p.SType = STRUCTURE_TYPE_IOS_SURFACE_CREATE_INFO_MVK
 	return p
 }
-func (p *MacOSSurfaceCreateInfoMVK) Free() { MemFree(unsafe.Pointer(p)) }
+func (p *IOSSurfaceCreateInfoMVK) Free() { MemFree(unsafe.Pointer(p)) }
 
-//  PfnCreateMacOSSurfaceMVK -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkCreateMacOSSurfaceMVK.html
-type PfnCreateMacOSSurfaceMVK uintptr
+// PfnCreateIOSSurfaceMVK -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkCreateIOSSurfaceMVK.html
+type PfnCreateIOSSurfaceMVK uintptr
 
-func (fn PfnCreateMacOSSurfaceMVK) Call(instance Instance, pCreateInfo *MacOSSurfaceCreateInfoMVK, pAllocator *AllocationCallbacks, pSurface *SurfaceKHR) Result {
-	ret := C.bridge_vkCreateMacOSSurfaceMVK(C.uintptr_t(fn), (C.VkInstance)(unsafe.Pointer(uintptr(instance))), (*C.VkMacOSSurfaceCreateInfoMVK)(unsafe.Pointer(pCreateInfo)), (*C.VkAllocationCallbacks)(unsafe.Pointer(pAllocator)), (*C.VkSurfaceKHR)(unsafe.Pointer(pSurface)))
+func (fn PfnCreateIOSSurfaceMVK) Call(instance Instance, pCreateInfo *IOSSurfaceCreateInfoMVK, pAllocator *AllocationCallbacks, pSurface *SurfaceKHR) Result {
+	ret := C.bridge_vkCreateIOSSurfaceMVK(C.uintptr_t(fn), (C.VkInstance)(unsafe.Pointer(uintptr(instance))), (*C.VkIOSSurfaceCreateInfoMVK)(unsafe.Pointer(pCreateInfo)), (*C.VkAllocationCallbacks)(unsafe.Pointer(pAllocator)), (*C.VkSurfaceKHR)(unsafe.Pointer(pSurface)))
 	debugCheckAndBreak()
+	if trackObjects {
+		trackCreated(Result(ret), OBJECT_TYPE_SURFACE_KHR, uint64(instance), unsafe.Pointer(pSurface), 1, unsafe.Sizeof(*pSurface), true)
+	}
 	return Result(ret)
 }
-func (fn PfnCreateMacOSSurfaceMVK) String() string { return "vkCreateMacOSSurfaceMVK" }
+func (fn PfnCreateIOSSurfaceMVK) String() string { return "vkCreateIOSSurfaceMVK" }
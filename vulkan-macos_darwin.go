@@ -1,3 +1,4 @@
+//go:build !ios
 // +build !ios
 
 package vk
@@ -58,12 +59,15 @@ func NewMacOSSurfaceCreateInfoMVK() *MacOSSurfaceCreateInfoMVK {
 }
 func (p *MacOSSurfaceCreateInfoMVK) Free() { MemFree(unsafe.Pointer(p)) }
 
-//  PfnCreateMacOSSurfaceMVK -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkCreateMacOSSurfaceMVK.html
+// PfnCreateMacOSSurfaceMVK -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkCreateMacOSSurfaceMVK.html
 type PfnCreateMacOSSurfaceMVK uintptr
 
 func (fn PfnCreateMacOSSurfaceMVK) Call(instance Instance, pCreateInfo *MacOSSurfaceCreateInfoMVK, pAllocator *AllocationCallbacks, pSurface *SurfaceKHR) Result {
 	ret := C.bridge_vkCreateMacOSSurfaceMVK(C.uintptr_t(fn), (C.VkInstance)(unsafe.Pointer(uintptr(instance))), (*C.VkMacOSSurfaceCreateInfoMVK)(unsafe.Pointer(pCreateInfo)), (*C.VkAllocationCallbacks)(unsafe.Pointer(pAllocator)), (*C.VkSurfaceKHR)(unsafe.Pointer(pSurface)))
 	debugCheckAndBreak()
+	if trackObjects {
+		trackCreated(Result(ret), OBJECT_TYPE_SURFACE_KHR, uint64(instance), unsafe.Pointer(pSurface), 1, unsafe.Sizeof(*pSurface), true)
+	}
 	return Result(ret)
 }
 func (fn PfnCreateMacOSSurfaceMVK) String() string { return "vkCreateMacOSSurfaceMVK" }
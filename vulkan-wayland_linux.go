@@ -0,0 +1,95 @@
+//go:build wayland
+// +build wayland
+
+package vk
+
+// #cgo linux LDFLAGS: -lvulkan
+// #include <stdint.h>
+// typedef struct wl_display wl_display;
+// typedef struct wl_surface wl_surface;
+// #include "./vulkan/vulkan.h"
+// #include "./vulkan/vulkan_wayland.h"
+//
+// VkResult bridge_vkCreateWaylandSurfaceKHR(uintptr_t fp,VkInstance instance,const VkWaylandSurfaceCreateInfoKHR* pCreateInfo,const VkAllocationCallbacks* pAllocator,VkSurfaceKHR* pSurface){
+//   return ((PFN_vkCreateWaylandSurfaceKHR)fp)(instance,pCreateInfo,pAllocator,pSurface);
+// }
+// VkBool32 bridge_vkGetPhysicalDeviceWaylandPresentationSupportKHR(uintptr_t fp,VkPhysicalDevice physicalDevice,uint32_t queueFamilyIndex,struct wl_display* display){
+//   return ((PFN_vkGetPhysicalDeviceWaylandPresentationSupportKHR)fp)(physicalDevice,queueFamilyIndex,display);
+// }
+import "C"
+
+import (
+	"unsafe"
+)
+
+/*
+ ** Copyright (c) 2015-2019 The Khronos Group Inc.
+ **
+ ** Licensed under the Apache License, Version 2.0 (the "License");
+ ** you may not use this file except in compliance with the License.
+ ** You may obtain a copy of the License at
+ **
+ **     http://www.apache.org/licenses/LICENSE-2.0
+ **
+ ** Unless required by applicable law or agreed to in writing, software
+ ** distributed under the License is distributed on an "AS IS" BASIS,
+ ** WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ ** See the License for the specific language governing permissions and
+ ** limitations under the License.
+ */
+
+/*
+ ** This file is generated from the Vulkan headers.
+ */
+
+type (
+	WlDisplay = C.wl_display
+	WlSurface = C.wl_surface
+)
+
+const KHR_wayland_surface = 1
+const KHR_WAYLAND_SURFACE_SPEC_VERSION = 6
+
+var KHR_WAYLAND_SURFACE_EXTENSION_NAME = "VK_KHR_wayland_surface"
+
+type WaylandSurfaceCreateFlagsKHR uint32 // reserved
+// WaylandSurfaceCreateInfoKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/VkWaylandSurfaceCreateInfoKHR.html
+type WaylandSurfaceCreateInfoKHR struct {
+	SType   StructureType
+	PNext   unsafe.Pointer
+	Flags   WaylandSurfaceCreateFlagsKHR
+	Display *WlDisplay
+	Surface *WlSurface
+}
+
+func NewWaylandSurfaceCreateInfoKHR() *WaylandSurfaceCreateInfoKHR {
+	p := (*WaylandSurfaceCreateInfoKHR)(MemAlloc(unsafe.Sizeof(*(*WaylandSurfaceCreateInfoKHR)(nil))))
+	p.SType = STRUCTURE_TYPE_WAYLAND_SURFACE_CREATE_INFO_KHR
+	return p
+}
+func (p *WaylandSurfaceCreateInfoKHR) Free() { MemFree(unsafe.Pointer(p)) }
+
+// PfnCreateWaylandSurfaceKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkCreateWaylandSurfaceKHR.html
+type PfnCreateWaylandSurfaceKHR uintptr
+
+func (fn PfnCreateWaylandSurfaceKHR) Call(instance Instance, pCreateInfo *WaylandSurfaceCreateInfoKHR, pAllocator *AllocationCallbacks, pSurface *SurfaceKHR) Result {
+	ret := C.bridge_vkCreateWaylandSurfaceKHR(C.uintptr_t(fn), (C.VkInstance)(unsafe.Pointer(uintptr(instance))), (*C.VkWaylandSurfaceCreateInfoKHR)(unsafe.Pointer(pCreateInfo)), (*C.VkAllocationCallbacks)(unsafe.Pointer(pAllocator)), (*C.VkSurfaceKHR)(unsafe.Pointer(pSurface)))
+	debugCheckAndBreak()
+	if trackObjects {
+		trackCreated(Result(ret), OBJECT_TYPE_SURFACE_KHR, uint64(instance), unsafe.Pointer(pSurface), 1, unsafe.Sizeof(*pSurface), true)
+	}
+	return Result(ret)
+}
+func (fn PfnCreateWaylandSurfaceKHR) String() string { return "vkCreateWaylandSurfaceKHR" }
+
+// PfnGetPhysicalDeviceWaylandPresentationSupportKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetPhysicalDeviceWaylandPresentationSupportKHR.html
+type PfnGetPhysicalDeviceWaylandPresentationSupportKHR uintptr
+
+func (fn PfnGetPhysicalDeviceWaylandPresentationSupportKHR) Call(physicalDevice PhysicalDevice, queueFamilyIndex uint32, display *WlDisplay) Bool32 {
+	ret := C.bridge_vkGetPhysicalDeviceWaylandPresentationSupportKHR(C.uintptr_t(fn), (C.VkPhysicalDevice)(unsafe.Pointer(uintptr(physicalDevice))), (C.uint32_t)(queueFamilyIndex), (*C.wl_display)(unsafe.Pointer(display)))
+	debugCheckAndBreak()
+	return Bool32(ret)
+}
+func (fn PfnGetPhysicalDeviceWaylandPresentationSupportKHR) String() string {
+	return "vkGetPhysicalDeviceWaylandPresentationSupportKHR"
+}
@@ -0,0 +1,40 @@
+//go:build wayland
+// +build wayland
+
+package vk
+
+import (
+	"testing"
+	"unsafe"
+)
+
+func TestWaylandSurfaceCreateInfoKHR(t *testing.T) {
+	p := NewWaylandSurfaceCreateInfoKHR()
+	defer p.Free()
+	if p.SType != STRUCTURE_TYPE_WAYLAND_SURFACE_CREATE_INFO_KHR {
+		t.Errorf("SType = %v, want %v", p.SType, STRUCTURE_TYPE_WAYLAND_SURFACE_CREATE_INFO_KHR)
+	}
+	// sType and flags are padded to pointer size on 64-bit targets
+	word := unsafe.Sizeof(uintptr(0))
+	if got, want := unsafe.Sizeof(*p), 5*word; got != want {
+		t.Errorf("sizeof(VkWaylandSurfaceCreateInfoKHR) = %d, want %d", got, want)
+	}
+	if got, want := unsafe.Offsetof(p.Surface), 4*word; got != want {
+		t.Errorf("offsetof(surface) = %d, want %d", got, want)
+	}
+}
+
+func TestWaylandPfnNames(t *testing.T) {
+	tests := []struct {
+		fn   interface{ String() string }
+		want string
+	}{
+		{PfnCreateWaylandSurfaceKHR(0), "vkCreateWaylandSurfaceKHR"},
+		{PfnGetPhysicalDeviceWaylandPresentationSupportKHR(0), "vkGetPhysicalDeviceWaylandPresentationSupportKHR"},
+	}
+	for _, tt := range tests {
+		if got := tt.fn.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
@@ -1,3 +1,4 @@
+//go:build forcecgo
 // +build forcecgo
 
 package vk
@@ -32,21 +33,27 @@ package vk
 // VkResult bridge_vkGetFenceWin32HandleKHR(uintptr_t fp,VkDevice device,const VkFenceGetWin32HandleInfoKHR* pGetWin32HandleInfo,HANDLE* pHandle){
 //   return ((PFN_vkGetFenceWin32HandleKHR)fp)(device,pGetWin32HandleInfo,pHandle);
 // }
-// VkResult bridge_vkGetMemoryWin32HandleNV(uintptr_t fp,VkDevice device,VkDeviceMemory memory,VkExternalMemoryHandleTypeFlagsNV handleType,HANDLE* pHandle){
-//   return ((PFN_vkGetMemoryWin32HandleNV)fp)(device,memory,handleType,pHandle);
+// VkResult bridge_vkGetMemoryWin32HandleNV(uintptr_t fp,VkDevice device,uint64_t memory,VkExternalMemoryHandleTypeFlagsNV handleType,HANDLE* pHandle){
+//   return ((PFN_vkGetMemoryWin32HandleNV)fp)(device,(VkDeviceMemory)memory,handleType,pHandle);
 // }
 // VkResult bridge_vkGetPhysicalDeviceSurfacePresentModes2EXT(uintptr_t fp,VkPhysicalDevice physicalDevice,const VkPhysicalDeviceSurfaceInfo2KHR* pSurfaceInfo,uint32_t* pPresentModeCount,VkPresentModeKHR* pPresentModes){
 //   return ((PFN_vkGetPhysicalDeviceSurfacePresentModes2EXT)fp)(physicalDevice,pSurfaceInfo,pPresentModeCount,pPresentModes);
 // }
-// VkResult bridge_vkAcquireFullScreenExclusiveModeEXT(uintptr_t fp,VkDevice device,VkSwapchainKHR swapchain){
-//   return ((PFN_vkAcquireFullScreenExclusiveModeEXT)fp)(device,swapchain);
+// VkResult bridge_vkAcquireFullScreenExclusiveModeEXT(uintptr_t fp,VkDevice device,uint64_t swapchain){
+//   return ((PFN_vkAcquireFullScreenExclusiveModeEXT)fp)(device,(VkSwapchainKHR)swapchain);
 // }
-// VkResult bridge_vkReleaseFullScreenExclusiveModeEXT(uintptr_t fp,VkDevice device,VkSwapchainKHR swapchain){
-//   return ((PFN_vkReleaseFullScreenExclusiveModeEXT)fp)(device,swapchain);
+// VkResult bridge_vkReleaseFullScreenExclusiveModeEXT(uintptr_t fp,VkDevice device,uint64_t swapchain){
+//   return ((PFN_vkReleaseFullScreenExclusiveModeEXT)fp)(device,(VkSwapchainKHR)swapchain);
 // }
 // VkResult bridge_vkGetDeviceGroupSurfacePresentModes2EXT(uintptr_t fp,VkDevice device,const VkPhysicalDeviceSurfaceInfo2KHR* pSurfaceInfo,VkDeviceGroupPresentModeFlagsKHR* pModes){
 //   return ((PFN_vkGetDeviceGroupSurfacePresentModes2EXT)fp)(device,pSurfaceInfo,pModes);
 // }
+// VkResult bridge_vkAcquireWinrtDisplayNV(uintptr_t fp,VkPhysicalDevice physicalDevice,uint64_t display){
+//   return ((PFN_vkAcquireWinrtDisplayNV)fp)(physicalDevice,(VkDisplayKHR)display);
+// }
+// VkResult bridge_vkGetWinrtDisplayNV(uintptr_t fp,VkPhysicalDevice physicalDevice,uint32_t deviceRelativeId,VkDisplayKHR* pDisplay){
+//   return ((PFN_vkGetWinrtDisplayNV)fp)(physicalDevice,deviceRelativeId,pDisplay);
+// }
 import "C"
 
 import (
@@ -112,17 +119,20 @@ func NewWin32SurfaceCreateInfoKHR() *Win32SurfaceCreateInfoKHR {
 }
 func (p *Win32SurfaceCreateInfoKHR) Free() { MemFree(unsafe.Pointer(p)) }
 
-//  PfnCreateWin32SurfaceKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkCreateWin32SurfaceKHR.html
+// PfnCreateWin32SurfaceKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkCreateWin32SurfaceKHR.html
 type PfnCreateWin32SurfaceKHR uintptr
 
 func (fn PfnCreateWin32SurfaceKHR) Call(instance Instance, pCreateInfo *Win32SurfaceCreateInfoKHR, pAllocator *AllocationCallbacks, pSurface *SurfaceKHR) Result {
 	ret := C.bridge_vkCreateWin32SurfaceKHR(C.uintptr_t(fn), (C.VkInstance)(unsafe.Pointer(uintptr(instance))), (*C.VkWin32SurfaceCreateInfoKHR)(unsafe.Pointer(pCreateInfo)), (*C.VkAllocationCallbacks)(unsafe.Pointer(pAllocator)), (*C.VkSurfaceKHR)(unsafe.Pointer(pSurface)))
 	debugCheckAndBreak()
+	if trackObjects {
+		trackCreated(Result(ret), OBJECT_TYPE_SURFACE_KHR, uint64(instance), unsafe.Pointer(pSurface), 1, unsafe.Sizeof(*pSurface), true)
+	}
 	return Result(ret)
 }
 func (fn PfnCreateWin32SurfaceKHR) String() string { return "vkCreateWin32SurfaceKHR" }
 
-//  PfnGetPhysicalDeviceWin32PresentationSupportKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetPhysicalDeviceWin32PresentationSupportKHR.html
+// PfnGetPhysicalDeviceWin32PresentationSupportKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetPhysicalDeviceWin32PresentationSupportKHR.html
 type PfnGetPhysicalDeviceWin32PresentationSupportKHR uintptr
 
 func (fn PfnGetPhysicalDeviceWin32PresentationSupportKHR) Call(physicalDevice PhysicalDevice, queueFamilyIndex uint32) Bool32 {
@@ -150,7 +160,7 @@ type ImportMemoryWin32HandleInfoKHR struct {
 
 func NewImportMemoryWin32HandleInfoKHR() *ImportMemoryWin32HandleInfoKHR {
 	p := (*ImportMemoryWin32HandleInfoKHR)(MemAlloc(unsafe.Sizeof(*(*ImportMemoryWin32HandleInfoKHR)(nil))))
-	p.SType = STRUCTURE_TYPE_IMPORT_MEMORY_WIN32_HANDLE_INFO_NV
+	p.SType = STRUCTURE_TYPE_IMPORT_MEMORY_WIN32_HANDLE_INFO_KHR
 	return p
 }
 func (p *ImportMemoryWin32HandleInfoKHR) Free() { MemFree(unsafe.Pointer(p)) }
@@ -166,7 +176,7 @@ type ExportMemoryWin32HandleInfoKHR struct {
 
 func NewExportMemoryWin32HandleInfoKHR() *ExportMemoryWin32HandleInfoKHR {
 	p := (*ExportMemoryWin32HandleInfoKHR)(MemAlloc(unsafe.Sizeof(*(*ExportMemoryWin32HandleInfoKHR)(nil))))
-	p.SType = STRUCTURE_TYPE_EXPORT_MEMORY_WIN32_HANDLE_INFO_NV
+	p.SType = STRUCTURE_TYPE_EXPORT_MEMORY_WIN32_HANDLE_INFO_KHR
 	return p
 }
 func (p *ExportMemoryWin32HandleInfoKHR) Free() { MemFree(unsafe.Pointer(p)) }
@@ -200,7 +210,7 @@ func NewMemoryGetWin32HandleInfoKHR() *MemoryGetWin32HandleInfoKHR {
 }
 func (p *MemoryGetWin32HandleInfoKHR) Free() { MemFree(unsafe.Pointer(p)) }
 
-//  PfnGetMemoryWin32HandleKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetMemoryWin32HandleKHR.html
+// PfnGetMemoryWin32HandleKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetMemoryWin32HandleKHR.html
 type PfnGetMemoryWin32HandleKHR uintptr
 
 func (fn PfnGetMemoryWin32HandleKHR) Call(device Device, pGetWin32HandleInfo *MemoryGetWin32HandleInfoKHR, pHandle *HANDLE) Result {
@@ -210,7 +220,7 @@ func (fn PfnGetMemoryWin32HandleKHR) Call(device Device, pGetWin32HandleInfo *Me
 }
 func (fn PfnGetMemoryWin32HandleKHR) String() string { return "vkGetMemoryWin32HandleKHR" }
 
-//  PfnGetMemoryWin32HandlePropertiesKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetMemoryWin32HandlePropertiesKHR.html
+// PfnGetMemoryWin32HandlePropertiesKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetMemoryWin32HandlePropertiesKHR.html
 type PfnGetMemoryWin32HandlePropertiesKHR uintptr
 
 func (fn PfnGetMemoryWin32HandlePropertiesKHR) Call(device Device, handleType ExternalMemoryHandleTypeFlags, handle HANDLE, pMemoryWin32HandleProperties *MemoryWin32HandlePropertiesKHR) Result {
@@ -318,7 +328,7 @@ func NewSemaphoreGetWin32HandleInfoKHR() *SemaphoreGetWin32HandleInfoKHR {
 }
 func (p *SemaphoreGetWin32HandleInfoKHR) Free() { MemFree(unsafe.Pointer(p)) }
 
-//  PfnImportSemaphoreWin32HandleKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkImportSemaphoreWin32HandleKHR.html
+// PfnImportSemaphoreWin32HandleKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkImportSemaphoreWin32HandleKHR.html
 type PfnImportSemaphoreWin32HandleKHR uintptr
 
 func (fn PfnImportSemaphoreWin32HandleKHR) Call(device Device, pImportSemaphoreWin32HandleInfo *ImportSemaphoreWin32HandleInfoKHR) Result {
@@ -328,7 +338,7 @@ func (fn PfnImportSemaphoreWin32HandleKHR) Call(device Device, pImportSemaphoreW
 }
 func (fn PfnImportSemaphoreWin32HandleKHR) String() string { return "vkImportSemaphoreWin32HandleKHR" }
 
-//  PfnGetSemaphoreWin32HandleKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetSemaphoreWin32HandleKHR.html
+// PfnGetSemaphoreWin32HandleKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetSemaphoreWin32HandleKHR.html
 type PfnGetSemaphoreWin32HandleKHR uintptr
 
 func (fn PfnGetSemaphoreWin32HandleKHR) Call(device Device, pGetWin32HandleInfo *SemaphoreGetWin32HandleInfoKHR, pHandle *HANDLE) Result {
@@ -392,7 +402,7 @@ func NewFenceGetWin32HandleInfoKHR() *FenceGetWin32HandleInfoKHR {
 }
 func (p *FenceGetWin32HandleInfoKHR) Free() { MemFree(unsafe.Pointer(p)) }
 
-//  PfnImportFenceWin32HandleKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkImportFenceWin32HandleKHR.html
+// PfnImportFenceWin32HandleKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkImportFenceWin32HandleKHR.html
 type PfnImportFenceWin32HandleKHR uintptr
 
 func (fn PfnImportFenceWin32HandleKHR) Call(device Device, pImportFenceWin32HandleInfo *ImportFenceWin32HandleInfoKHR) Result {
@@ -402,7 +412,7 @@ func (fn PfnImportFenceWin32HandleKHR) Call(device Device, pImportFenceWin32Hand
 }
 func (fn PfnImportFenceWin32HandleKHR) String() string { return "vkImportFenceWin32HandleKHR" }
 
-//  PfnGetFenceWin32HandleKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetFenceWin32HandleKHR.html
+// PfnGetFenceWin32HandleKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetFenceWin32HandleKHR.html
 type PfnGetFenceWin32HandleKHR uintptr
 
 func (fn PfnGetFenceWin32HandleKHR) Call(device Device, pGetWin32HandleInfo *FenceGetWin32HandleInfoKHR, pHandle *HANDLE) Result {
@@ -447,11 +457,11 @@ func NewExportMemoryWin32HandleInfoNV() *ExportMemoryWin32HandleInfoNV {
 }
 func (p *ExportMemoryWin32HandleInfoNV) Free() { MemFree(unsafe.Pointer(p)) }
 
-//  PfnGetMemoryWin32HandleNV -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetMemoryWin32HandleNV.html
+// PfnGetMemoryWin32HandleNV -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetMemoryWin32HandleNV.html
 type PfnGetMemoryWin32HandleNV uintptr
 
 func (fn PfnGetMemoryWin32HandleNV) Call(device Device, memory DeviceMemory, handleType ExternalMemoryHandleTypeFlagsNV, pHandle *HANDLE) Result {
-	ret := C.bridge_vkGetMemoryWin32HandleNV(C.uintptr_t(fn), (C.VkDevice)(unsafe.Pointer(uintptr(device))), (C.VkDeviceMemory)(unsafe.Pointer(uintptr(memory))), (C.VkExternalMemoryHandleTypeFlagsNV)(uint32(handleType)), (*C.HANDLE)(unsafe.Pointer(pHandle)))
+	ret := C.bridge_vkGetMemoryWin32HandleNV(C.uintptr_t(fn), (C.VkDevice)(unsafe.Pointer(uintptr(device))), C.uint64_t(memory), (C.VkExternalMemoryHandleTypeFlagsNV)(uint32(handleType)), (*C.HANDLE)(unsafe.Pointer(pHandle)))
 	debugCheckAndBreak()
 	return Result(ret)
 }
@@ -557,7 +567,7 @@ func NewSurfaceFullScreenExclusiveWin32InfoEXT() *SurfaceFullScreenExclusiveWin3
 }
 func (p *SurfaceFullScreenExclusiveWin32InfoEXT) Free() { MemFree(unsafe.Pointer(p)) }
 
-//  PfnGetPhysicalDeviceSurfacePresentModes2EXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetPhysicalDeviceSurfacePresentModes2EXT.html
+// PfnGetPhysicalDeviceSurfacePresentModes2EXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetPhysicalDeviceSurfacePresentModes2EXT.html
 type PfnGetPhysicalDeviceSurfacePresentModes2EXT uintptr
 
 func (fn PfnGetPhysicalDeviceSurfacePresentModes2EXT) Call(physicalDevice PhysicalDevice, pSurfaceInfo *PhysicalDeviceSurfaceInfo2KHR, pPresentModeCount *uint32, pPresentModes *PresentModeKHR) Result {
@@ -569,11 +579,11 @@ func (fn PfnGetPhysicalDeviceSurfacePresentModes2EXT) String() string {
 	return "vkGetPhysicalDeviceSurfacePresentModes2EXT"
 }
 
-//  PfnAcquireFullScreenExclusiveModeEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkAcquireFullScreenExclusiveModeEXT.html
+// PfnAcquireFullScreenExclusiveModeEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkAcquireFullScreenExclusiveModeEXT.html
 type PfnAcquireFullScreenExclusiveModeEXT uintptr
 
 func (fn PfnAcquireFullScreenExclusiveModeEXT) Call(device Device, swapchain SwapchainKHR) Result {
-	ret := C.bridge_vkAcquireFullScreenExclusiveModeEXT(C.uintptr_t(fn), (C.VkDevice)(unsafe.Pointer(uintptr(device))), (C.VkSwapchainKHR)(unsafe.Pointer(uintptr(swapchain))))
+	ret := C.bridge_vkAcquireFullScreenExclusiveModeEXT(C.uintptr_t(fn), (C.VkDevice)(unsafe.Pointer(uintptr(device))), C.uint64_t(swapchain))
 	debugCheckAndBreak()
 	return Result(ret)
 }
@@ -581,11 +591,11 @@ func (fn PfnAcquireFullScreenExclusiveModeEXT) String() string {
 	return "vkAcquireFullScreenExclusiveModeEXT"
 }
 
-//  PfnReleaseFullScreenExclusiveModeEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkReleaseFullScreenExclusiveModeEXT.html
+// PfnReleaseFullScreenExclusiveModeEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkReleaseFullScreenExclusiveModeEXT.html
 type PfnReleaseFullScreenExclusiveModeEXT uintptr
 
 func (fn PfnReleaseFullScreenExclusiveModeEXT) Call(device Device, swapchain SwapchainKHR) Result {
-	ret := C.bridge_vkReleaseFullScreenExclusiveModeEXT(C.uintptr_t(fn), (C.VkDevice)(unsafe.Pointer(uintptr(device))), (C.VkSwapchainKHR)(unsafe.Pointer(uintptr(swapchain))))
+	ret := C.bridge_vkReleaseFullScreenExclusiveModeEXT(C.uintptr_t(fn), (C.VkDevice)(unsafe.Pointer(uintptr(device))), C.uint64_t(swapchain))
 	debugCheckAndBreak()
 	return Result(ret)
 }
@@ -593,7 +603,7 @@ func (fn PfnReleaseFullScreenExclusiveModeEXT) String() string {
 	return "vkReleaseFullScreenExclusiveModeEXT"
 }
 
-//  PfnGetDeviceGroupSurfacePresentModes2EXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetDeviceGroupSurfacePresentModes2EXT.html
+// PfnGetDeviceGroupSurfacePresentModes2EXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetDeviceGroupSurfacePresentModes2EXT.html
 type PfnGetDeviceGroupSurfacePresentModes2EXT uintptr
 
 func (fn PfnGetDeviceGroupSurfacePresentModes2EXT) Call(device Device, pSurfaceInfo *PhysicalDeviceSurfaceInfo2KHR, pModes *DeviceGroupPresentModeFlagsKHR) Result {
@@ -604,3 +614,28 @@ func (fn PfnGetDeviceGroupSurfacePresentModes2EXT) Call(device Device, pSurfaceI
 func (fn PfnGetDeviceGroupSurfacePresentModes2EXT) String() string {
 	return "vkGetDeviceGroupSurfacePresentModes2EXT"
 }
+
+const NV_acquire_winrt_display = 1
+const NV_ACQUIRE_WINRT_DISPLAY_SPEC_VERSION = 1
+
+var NV_ACQUIRE_WINRT_DISPLAY_EXTENSION_NAME = "VK_NV_acquire_winrt_display"
+
+// PfnAcquireWinrtDisplayNV -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkAcquireWinrtDisplayNV.html
+type PfnAcquireWinrtDisplayNV uintptr
+
+func (fn PfnAcquireWinrtDisplayNV) Call(physicalDevice PhysicalDevice, display DisplayKHR) Result {
+	ret := C.bridge_vkAcquireWinrtDisplayNV(C.uintptr_t(fn), (C.VkPhysicalDevice)(unsafe.Pointer(uintptr(physicalDevice))), C.uint64_t(display))
+	debugCheckAndBreak()
+	return Result(ret)
+}
+func (fn PfnAcquireWinrtDisplayNV) String() string { return "vkAcquireWinrtDisplayNV" }
+
+// PfnGetWinrtDisplayNV -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetWinrtDisplayNV.html
+type PfnGetWinrtDisplayNV uintptr
+
+func (fn PfnGetWinrtDisplayNV) Call(physicalDevice PhysicalDevice, deviceRelativeId uint32, pDisplay *DisplayKHR) Result {
+	ret := C.bridge_vkGetWinrtDisplayNV(C.uintptr_t(fn), (C.VkPhysicalDevice)(unsafe.Pointer(uintptr(physicalDevice))), (C.uint32_t)(deviceRelativeId), (*C.VkDisplayKHR)(unsafe.Pointer(pDisplay)))
+	debugCheckAndBreak()
+	return Result(ret)
+}
+func (fn PfnGetWinrtDisplayNV) String() string { return "vkGetWinrtDisplayNV" }
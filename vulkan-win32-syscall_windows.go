@@ -1,3 +1,4 @@
+//go:build !forcecgo
 // +build !forcecgo
 
 package vk
@@ -49,17 +50,20 @@ func NewWin32SurfaceCreateInfoKHR() *Win32SurfaceCreateInfoKHR {
 }
 func (p *Win32SurfaceCreateInfoKHR) Free() { MemFree(unsafe.Pointer(p)) }
 
-//  PfnCreateWin32SurfaceKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkCreateWin32SurfaceKHR.html
+// PfnCreateWin32SurfaceKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkCreateWin32SurfaceKHR.html
 type PfnCreateWin32SurfaceKHR uintptr
 
 func (fn PfnCreateWin32SurfaceKHR) Call(instance Instance, pCreateInfo *Win32SurfaceCreateInfoKHR, pAllocator *AllocationCallbacks, pSurface *SurfaceKHR) Result {
 	ret, _, _ := call(uintptr(fn), uintptr(instance), uintptr(unsafe.Pointer(pCreateInfo)), uintptr(unsafe.Pointer(pAllocator)), uintptr(unsafe.Pointer(pSurface)))
 	debugCheckAndBreak()
+	if trackObjects {
+		trackCreated(Result(ret), OBJECT_TYPE_SURFACE_KHR, uint64(instance), unsafe.Pointer(pSurface), 1, unsafe.Sizeof(*pSurface), true)
+	}
 	return Result(ret)
 }
 func (fn PfnCreateWin32SurfaceKHR) String() string { return "vkCreateWin32SurfaceKHR" }
 
-//  PfnGetPhysicalDeviceWin32PresentationSupportKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetPhysicalDeviceWin32PresentationSupportKHR.html
+// PfnGetPhysicalDeviceWin32PresentationSupportKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetPhysicalDeviceWin32PresentationSupportKHR.html
 type PfnGetPhysicalDeviceWin32PresentationSupportKHR uintptr
 
 func (fn PfnGetPhysicalDeviceWin32PresentationSupportKHR) Call(physicalDevice PhysicalDevice, queueFamilyIndex uint32) Bool32 {
@@ -87,7 +91,7 @@ type ImportMemoryWin32HandleInfoKHR struct {
 
 func NewImportMemoryWin32HandleInfoKHR() *ImportMemoryWin32HandleInfoKHR {
 	p := (*ImportMemoryWin32HandleInfoKHR)(MemAlloc(unsafe.Sizeof(*(*ImportMemoryWin32HandleInfoKHR)(nil))))
-	p.SType = STRUCTURE_TYPE_IMPORT_MEMORY_WIN32_HANDLE_INFO_NV
+	p.SType = STRUCTURE_TYPE_IMPORT_MEMORY_WIN32_HANDLE_INFO_KHR
 	return p
 }
 func (p *ImportMemoryWin32HandleInfoKHR) Free() { MemFree(unsafe.Pointer(p)) }
@@ -103,7 +107,7 @@ type ExportMemoryWin32HandleInfoKHR struct {
 
 func NewExportMemoryWin32HandleInfoKHR() *ExportMemoryWin32HandleInfoKHR {
 	p := (*ExportMemoryWin32HandleInfoKHR)(MemAlloc(unsafe.Sizeof(*(*ExportMemoryWin32HandleInfoKHR)(nil))))
-	p.SType = STRUCTURE_TYPE_EXPORT_MEMORY_WIN32_HANDLE_INFO_NV
+	p.SType = STRUCTURE_TYPE_EXPORT_MEMORY_WIN32_HANDLE_INFO_KHR
 	return p
 }
 func (p *ExportMemoryWin32HandleInfoKHR) Free() { MemFree(unsafe.Pointer(p)) }
@@ -137,7 +141,7 @@ func NewMemoryGetWin32HandleInfoKHR() *MemoryGetWin32HandleInfoKHR {
 }
 func (p *MemoryGetWin32HandleInfoKHR) Free() { MemFree(unsafe.Pointer(p)) }
 
-//  PfnGetMemoryWin32HandleKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetMemoryWin32HandleKHR.html
+// PfnGetMemoryWin32HandleKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetMemoryWin32HandleKHR.html
 type PfnGetMemoryWin32HandleKHR uintptr
 
 func (fn PfnGetMemoryWin32HandleKHR) Call(device Device, pGetWin32HandleInfo *MemoryGetWin32HandleInfoKHR, pHandle *HANDLE) Result {
@@ -147,7 +151,7 @@ func (fn PfnGetMemoryWin32HandleKHR) Call(device Device, pGetWin32HandleInfo *Me
 }
 func (fn PfnGetMemoryWin32HandleKHR) String() string { return "vkGetMemoryWin32HandleKHR" }
 
-//  PfnGetMemoryWin32HandlePropertiesKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetMemoryWin32HandlePropertiesKHR.html
+// PfnGetMemoryWin32HandlePropertiesKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetMemoryWin32HandlePropertiesKHR.html
 type PfnGetMemoryWin32HandlePropertiesKHR uintptr
 
 func (fn PfnGetMemoryWin32HandlePropertiesKHR) Call(device Device, handleType ExternalMemoryHandleTypeFlags, handle HANDLE, pMemoryWin32HandleProperties *MemoryWin32HandlePropertiesKHR) Result {
@@ -255,7 +259,7 @@ func NewSemaphoreGetWin32HandleInfoKHR() *SemaphoreGetWin32HandleInfoKHR {
 }
 func (p *SemaphoreGetWin32HandleInfoKHR) Free() { MemFree(unsafe.Pointer(p)) }
 
-//  PfnImportSemaphoreWin32HandleKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkImportSemaphoreWin32HandleKHR.html
+// PfnImportSemaphoreWin32HandleKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkImportSemaphoreWin32HandleKHR.html
 type PfnImportSemaphoreWin32HandleKHR uintptr
 
 func (fn PfnImportSemaphoreWin32HandleKHR) Call(device Device, pImportSemaphoreWin32HandleInfo *ImportSemaphoreWin32HandleInfoKHR) Result {
@@ -265,7 +269,7 @@ func (fn PfnImportSemaphoreWin32HandleKHR) Call(device Device, pImportSemaphoreW
 }
 func (fn PfnImportSemaphoreWin32HandleKHR) String() string { return "vkImportSemaphoreWin32HandleKHR" }
 
-//  PfnGetSemaphoreWin32HandleKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetSemaphoreWin32HandleKHR.html
+// PfnGetSemaphoreWin32HandleKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetSemaphoreWin32HandleKHR.html
 type PfnGetSemaphoreWin32HandleKHR uintptr
 
 func (fn PfnGetSemaphoreWin32HandleKHR) Call(device Device, pGetWin32HandleInfo *SemaphoreGetWin32HandleInfoKHR, pHandle *HANDLE) Result {
@@ -329,7 +333,7 @@ func NewFenceGetWin32HandleInfoKHR() *FenceGetWin32HandleInfoKHR {
 }
 func (p *FenceGetWin32HandleInfoKHR) Free() { MemFree(unsafe.Pointer(p)) }
 
-//  PfnImportFenceWin32HandleKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkImportFenceWin32HandleKHR.html
+// PfnImportFenceWin32HandleKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkImportFenceWin32HandleKHR.html
 type PfnImportFenceWin32HandleKHR uintptr
 
 func (fn PfnImportFenceWin32HandleKHR) Call(device Device, pImportFenceWin32HandleInfo *ImportFenceWin32HandleInfoKHR) Result {
@@ -339,7 +343,7 @@ func (fn PfnImportFenceWin32HandleKHR) Call(device Device, pImportFenceWin32Hand
 }
 func (fn PfnImportFenceWin32HandleKHR) String() string { return "vkImportFenceWin32HandleKHR" }
 
-//  PfnGetFenceWin32HandleKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetFenceWin32HandleKHR.html
+// PfnGetFenceWin32HandleKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetFenceWin32HandleKHR.html
 type PfnGetFenceWin32HandleKHR uintptr
 
 func (fn PfnGetFenceWin32HandleKHR) Call(device Device, pGetWin32HandleInfo *FenceGetWin32HandleInfoKHR, pHandle *HANDLE) Result {
@@ -384,11 +388,16 @@ func NewExportMemoryWin32HandleInfoNV() *ExportMemoryWin32HandleInfoNV {
 }
 func (p *ExportMemoryWin32HandleInfoNV) Free() { MemFree(unsafe.Pointer(p)) }
 
-//  PfnGetMemoryWin32HandleNV -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetMemoryWin32HandleNV.html
+// PfnGetMemoryWin32HandleNV -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetMemoryWin32HandleNV.html
 type PfnGetMemoryWin32HandleNV uintptr
 
 func (fn PfnGetMemoryWin32HandleNV) Call(device Device, memory DeviceMemory, handleType ExternalMemoryHandleTypeFlagsNV, pHandle *HANDLE) Result {
-	ret, _, _ := call(uintptr(fn), uintptr(device), uintptr(memory), uintptr(handleType), uintptr(unsafe.Pointer(pHandle)))
+	var ret uintptr
+	if is32bit {
+		ret, _, _ = call(uintptr(fn), uintptr(device), uintptr(memory), uintptr(memory>>32), uintptr(handleType), uintptr(unsafe.Pointer(pHandle)))
+	} else {
+		ret, _, _ = call(uintptr(fn), uintptr(device), uintptr(memory), uintptr(handleType), uintptr(unsafe.Pointer(pHandle)))
+	}
 	debugCheckAndBreak()
 	return Result(ret)
 }
@@ -494,7 +503,7 @@ func NewSurfaceFullScreenExclusiveWin32InfoEXT() *SurfaceFullScreenExclusiveWin3
 }
 func (p *SurfaceFullScreenExclusiveWin32InfoEXT) Free() { MemFree(unsafe.Pointer(p)) }
 
-//  PfnGetPhysicalDeviceSurfacePresentModes2EXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetPhysicalDeviceSurfacePresentModes2EXT.html
+// PfnGetPhysicalDeviceSurfacePresentModes2EXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetPhysicalDeviceSurfacePresentModes2EXT.html
 type PfnGetPhysicalDeviceSurfacePresentModes2EXT uintptr
 
 func (fn PfnGetPhysicalDeviceSurfacePresentModes2EXT) Call(physicalDevice PhysicalDevice, pSurfaceInfo *PhysicalDeviceSurfaceInfo2KHR, pPresentModeCount *uint32, pPresentModes *PresentModeKHR) Result {
@@ -506,11 +515,16 @@ func (fn PfnGetPhysicalDeviceSurfacePresentModes2EXT) String() string {
 	return "vkGetPhysicalDeviceSurfacePresentModes2EXT"
 }
 
-//  PfnAcquireFullScreenExclusiveModeEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkAcquireFullScreenExclusiveModeEXT.html
+// PfnAcquireFullScreenExclusiveModeEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkAcquireFullScreenExclusiveModeEXT.html
 type PfnAcquireFullScreenExclusiveModeEXT uintptr
 
 func (fn PfnAcquireFullScreenExclusiveModeEXT) Call(device Device, swapchain SwapchainKHR) Result {
-	ret, _, _ := call(uintptr(fn), uintptr(device), uintptr(swapchain))
+	var ret uintptr
+	if is32bit {
+		ret, _, _ = call(uintptr(fn), uintptr(device), uintptr(swapchain), uintptr(swapchain>>32))
+	} else {
+		ret, _, _ = call(uintptr(fn), uintptr(device), uintptr(swapchain))
+	}
 	debugCheckAndBreak()
 	return Result(ret)
 }
@@ -518,11 +532,16 @@ func (fn PfnAcquireFullScreenExclusiveModeEXT) String() string {
 	return "vkAcquireFullScreenExclusiveModeEXT"
 }
 
-//  PfnReleaseFullScreenExclusiveModeEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkReleaseFullScreenExclusiveModeEXT.html
+// PfnReleaseFullScreenExclusiveModeEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkReleaseFullScreenExclusiveModeEXT.html
 type PfnReleaseFullScreenExclusiveModeEXT uintptr
 
 func (fn PfnReleaseFullScreenExclusiveModeEXT) Call(device Device, swapchain SwapchainKHR) Result {
-	ret, _, _ := call(uintptr(fn), uintptr(device), uintptr(swapchain))
+	var ret uintptr
+	if is32bit {
+		ret, _, _ = call(uintptr(fn), uintptr(device), uintptr(swapchain), uintptr(swapchain>>32))
+	} else {
+		ret, _, _ = call(uintptr(fn), uintptr(device), uintptr(swapchain))
+	}
 	debugCheckAndBreak()
 	return Result(ret)
 }
@@ -530,7 +549,7 @@ func (fn PfnReleaseFullScreenExclusiveModeEXT) String() string {
 	return "vkReleaseFullScreenExclusiveModeEXT"
 }
 
-//  PfnGetDeviceGroupSurfacePresentModes2EXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetDeviceGroupSurfacePresentModes2EXT.html
+// PfnGetDeviceGroupSurfacePresentModes2EXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetDeviceGroupSurfacePresentModes2EXT.html
 type PfnGetDeviceGroupSurfacePresentModes2EXT uintptr
 
 func (fn PfnGetDeviceGroupSurfacePresentModes2EXT) Call(device Device, pSurfaceInfo *PhysicalDeviceSurfaceInfo2KHR, pModes *DeviceGroupPresentModeFlagsKHR) Result {
@@ -541,3 +560,33 @@ func (fn PfnGetDeviceGroupSurfacePresentModes2EXT) Call(device Device, pSurfaceI
 func (fn PfnGetDeviceGroupSurfacePresentModes2EXT) String() string {
 	return "vkGetDeviceGroupSurfacePresentModes2EXT"
 }
+
+const NV_acquire_winrt_display = 1
+const NV_ACQUIRE_WINRT_DISPLAY_SPEC_VERSION = 1
+
+var NV_ACQUIRE_WINRT_DISPLAY_EXTENSION_NAME = "VK_NV_acquire_winrt_display"
+
+// PfnAcquireWinrtDisplayNV -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkAcquireWinrtDisplayNV.html
+type PfnAcquireWinrtDisplayNV uintptr
+
+func (fn PfnAcquireWinrtDisplayNV) Call(physicalDevice PhysicalDevice, display DisplayKHR) Result {
+	var ret uintptr
+	if is32bit {
+		ret, _, _ = call(uintptr(fn), uintptr(physicalDevice), uintptr(display), uintptr(display>>32))
+	} else {
+		ret, _, _ = call(uintptr(fn), uintptr(physicalDevice), uintptr(display))
+	}
+	debugCheckAndBreak()
+	return Result(ret)
+}
+func (fn PfnAcquireWinrtDisplayNV) String() string { return "vkAcquireWinrtDisplayNV" }
+
+// PfnGetWinrtDisplayNV -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetWinrtDisplayNV.html
+type PfnGetWinrtDisplayNV uintptr
+
+func (fn PfnGetWinrtDisplayNV) Call(physicalDevice PhysicalDevice, deviceRelativeId uint32, pDisplay *DisplayKHR) Result {
+	ret, _, _ := call(uintptr(fn), uintptr(physicalDevice), uintptr(deviceRelativeId), uintptr(unsafe.Pointer(pDisplay)))
+	debugCheckAndBreak()
+	return Result(ret)
+}
+func (fn PfnGetWinrtDisplayNV) String() string { return "vkGetWinrtDisplayNV" }
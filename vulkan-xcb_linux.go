@@ -1,4 +1,5 @@
-// +build !xlib
+//go:build !xlib && !xlib_xrandr
+// +build !xlib,!xlib_xrandr
 
 package vk
 
@@ -68,17 +69,20 @@ func NewXcbSurfaceCreateInfoKHR() *XcbSurfaceCreateInfoKHR {
 }
 func (p *XcbSurfaceCreateInfoKHR) Free() { MemFree(unsafe.Pointer(p)) }
 
-//  PfnCreateXcbSurfaceKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkCreateXcbSurfaceKHR.html
+// PfnCreateXcbSurfaceKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkCreateXcbSurfaceKHR.html
 type PfnCreateXcbSurfaceKHR uintptr
 
 func (fn PfnCreateXcbSurfaceKHR) Call(instance Instance, pCreateInfo *XcbSurfaceCreateInfoKHR, pAllocator *AllocationCallbacks, pSurface *SurfaceKHR) Result {
 	ret := C.bridge_vkCreateXcbSurfaceKHR(C.uintptr_t(fn), (C.VkInstance)(unsafe.Pointer(uintptr(instance))), (*C.VkXcbSurfaceCreateInfoKHR)(unsafe.Pointer(pCreateInfo)), (*C.VkAllocationCallbacks)(unsafe.Pointer(pAllocator)), (*C.VkSurfaceKHR)(unsafe.Pointer(pSurface)))
 	debugCheckAndBreak()
+	if trackObjects {
+		trackCreated(Result(ret), OBJECT_TYPE_SURFACE_KHR, uint64(instance), unsafe.Pointer(pSurface), 1, unsafe.Sizeof(*pSurface), true)
+	}
 	return Result(ret)
 }
 func (fn PfnCreateXcbSurfaceKHR) String() string { return "vkCreateXcbSurfaceKHR" }
 
-//  PfnGetPhysicalDeviceXcbPresentationSupportKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetPhysicalDeviceXcbPresentationSupportKHR.html
+// PfnGetPhysicalDeviceXcbPresentationSupportKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetPhysicalDeviceXcbPresentationSupportKHR.html
 type PfnGetPhysicalDeviceXcbPresentationSupportKHR uintptr
 
 func (fn PfnGetPhysicalDeviceXcbPresentationSupportKHR) Call(physicalDevice PhysicalDevice, queueFamilyIndex uint32, connection *XcbConnection, visual_id XcbVisualID) Bool32 {
@@ -1,4 +1,5 @@
-// +build xlib
+//go:build xlib || xlib_xrandr
+// +build xlib xlib_xrandr
 
 package vk
 
@@ -68,17 +69,20 @@ func NewXlibSurfaceCreateInfoKHR() *XlibSurfaceCreateInfoKHR {
 }
 func (p *XlibSurfaceCreateInfoKHR) Free() { MemFree(unsafe.Pointer(p)) }
 
-//  PfnCreateXlibSurfaceKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkCreateXlibSurfaceKHR.html
+// PfnCreateXlibSurfaceKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkCreateXlibSurfaceKHR.html
 type PfnCreateXlibSurfaceKHR uintptr
 
 func (fn PfnCreateXlibSurfaceKHR) Call(instance Instance, pCreateInfo *XlibSurfaceCreateInfoKHR, pAllocator *AllocationCallbacks, pSurface *SurfaceKHR) Result {
 	ret := C.bridge_vkCreateXlibSurfaceKHR(C.uintptr_t(fn), (C.VkInstance)(unsafe.Pointer(uintptr(instance))), (*C.VkXlibSurfaceCreateInfoKHR)(unsafe.Pointer(pCreateInfo)), (*C.VkAllocationCallbacks)(unsafe.Pointer(pAllocator)), (*C.VkSurfaceKHR)(unsafe.Pointer(pSurface)))
 	debugCheckAndBreak()
+	if trackObjects {
+		trackCreated(Result(ret), OBJECT_TYPE_SURFACE_KHR, uint64(instance), unsafe.Pointer(pSurface), 1, unsafe.Sizeof(*pSurface), true)
+	}
 	return Result(ret)
 }
 func (fn PfnCreateXlibSurfaceKHR) String() string { return "vkCreateXlibSurfaceKHR" }
 
-//  PfnGetPhysicalDeviceXlibPresentationSupportKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetPhysicalDeviceXlibPresentationSupportKHR.html
+// PfnGetPhysicalDeviceXlibPresentationSupportKHR -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetPhysicalDeviceXlibPresentationSupportKHR.html
 type PfnGetPhysicalDeviceXlibPresentationSupportKHR uintptr
 
 func (fn PfnGetPhysicalDeviceXlibPresentationSupportKHR) Call(physicalDevice PhysicalDevice, queueFamilyIndex uint32, dpy *Display, visualID VisualID) Bool32 {
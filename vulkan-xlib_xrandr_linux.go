@@ -0,0 +1,70 @@
+//go:build xlib_xrandr
+// +build xlib_xrandr
+
+package vk
+
+// #cgo linux LDFLAGS: -lvulkan
+// #include <stdint.h>
+// #include <X11/Xlib.h>
+// typedef XID RROutput;
+// #include "./vulkan/vulkan.h"
+// #include "./vulkan/vulkan_xlib_xrandr.h"
+//
+// VkResult bridge_vkAcquireXlibDisplayEXT(uintptr_t fp,VkPhysicalDevice physicalDevice,Display* dpy,uint64_t display){
+//   return ((PFN_vkAcquireXlibDisplayEXT)fp)(physicalDevice,dpy,(VkDisplayKHR)display);
+// }
+// VkResult bridge_vkGetRandROutputDisplayEXT(uintptr_t fp,VkPhysicalDevice physicalDevice,Display* dpy,RROutput rrOutput,VkDisplayKHR* pDisplay){
+//   return ((PFN_vkGetRandROutputDisplayEXT)fp)(physicalDevice,dpy,rrOutput,pDisplay);
+// }
+import "C"
+
+import (
+	"unsafe"
+)
+
+/*
+ ** Copyright (c) 2015-2019 The Khronos Group Inc.
+ **
+ ** Licensed under the Apache License, Version 2.0 (the "License");
+ ** you may not use this file except in compliance with the License.
+ ** You may obtain a copy of the License at
+ **
+ **     http://www.apache.org/licenses/LICENSE-2.0
+ **
+ ** Unless required by applicable law or agreed to in writing, software
+ ** distributed under the License is distributed on an "AS IS" BASIS,
+ ** WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ ** See the License for the specific language governing permissions and
+ ** limitations under the License.
+ */
+
+/*
+ ** This file is generated from the Vulkan headers.
+ */
+
+type RROutput = C.RROutput
+
+const EXT_acquire_xlib_display = 1
+const EXT_ACQUIRE_XLIB_DISPLAY_SPEC_VERSION = 1
+
+var EXT_ACQUIRE_XLIB_DISPLAY_EXTENSION_NAME = "VK_EXT_acquire_xlib_display"
+
+// PfnAcquireXlibDisplayEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkAcquireXlibDisplayEXT.html
+type PfnAcquireXlibDisplayEXT uintptr
+
+func (fn PfnAcquireXlibDisplayEXT) Call(physicalDevice PhysicalDevice, dpy *Display, display DisplayKHR) Result {
+	ret := C.bridge_vkAcquireXlibDisplayEXT(C.uintptr_t(fn), (C.VkPhysicalDevice)(unsafe.Pointer(uintptr(physicalDevice))), (*C.Display)(unsafe.Pointer(dpy)), C.uint64_t(display))
+	debugCheckAndBreak()
+	return Result(ret)
+}
+func (fn PfnAcquireXlibDisplayEXT) String() string { return "vkAcquireXlibDisplayEXT" }
+
+// PfnGetRandROutputDisplayEXT -- https://www.khronos.org/registry/vulkan/specs/1.1-extensions/man/html/vkGetRandROutputDisplayEXT.html
+type PfnGetRandROutputDisplayEXT uintptr
+
+func (fn PfnGetRandROutputDisplayEXT) Call(physicalDevice PhysicalDevice, dpy *Display, rrOutput RROutput, pDisplay *DisplayKHR) Result {
+	ret := C.bridge_vkGetRandROutputDisplayEXT(C.uintptr_t(fn), (C.VkPhysicalDevice)(unsafe.Pointer(uintptr(physicalDevice))), (*C.Display)(unsafe.Pointer(dpy)), (C.RROutput)(rrOutput), (*C.VkDisplayKHR)(unsafe.Pointer(pDisplay)))
+	debugCheckAndBreak()
+	return Result(ret)
+}
+func (fn PfnGetRandROutputDisplayEXT) String() string { return "vkGetRandROutputDisplayEXT" }
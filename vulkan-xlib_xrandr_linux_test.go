@@ -0,0 +1,35 @@
+//go:build xlib_xrandr
+// +build xlib_xrandr
+
+package vk
+
+import (
+	"testing"
+	"unsafe"
+)
+
+func TestRROutput(t *testing.T) {
+	// an XID, an unsigned long
+	var o RROutput
+	if got, want := unsafe.Sizeof(o), unsafe.Sizeof(uintptr(0)); got != want {
+		t.Errorf("sizeof(RROutput) = %d, want %d", got, want)
+	}
+	if EXT_ACQUIRE_XLIB_DISPLAY_EXTENSION_NAME != "VK_EXT_acquire_xlib_display" {
+		t.Errorf("extension name = %q", EXT_ACQUIRE_XLIB_DISPLAY_EXTENSION_NAME)
+	}
+}
+
+func TestXlibXRandRPfnNames(t *testing.T) {
+	tests := []struct {
+		fn   interface{ String() string }
+		want string
+	}{
+		{PfnAcquireXlibDisplayEXT(0), "vkAcquireXlibDisplayEXT"},
+		{PfnGetRandROutputDisplayEXT(0), "vkGetRandROutputDisplayEXT"},
+	}
+	for _, tt := range tests {
+		if got := tt.fn.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
@@ -2,9 +2,9 @@
 // File: vk_sdk_platform.h
 //
 /*
- * Copyright (c) 2015-2016 The Khronos Group Inc.
- * Copyright (c) 2015-2016 Valve Corporation
- * Copyright (c) 2015-2016 LunarG, Inc.
+ * Copyright (c) 2015-2023 LunarG, Inc.
+ * Copyright (c) 2015-2023 The Khronos Group Inc.
+ * Copyright (c) 2015-2023 Valve Corporation
  *
  * Licensed under the Apache License, Version 2.0 (the "License");
  * you may not use this file except in compliance with the License.
@@ -18,12 +18,26 @@
  * See the License for the specific language governing permissions and
  * limitations under the License.
  */
+#pragma once
 
-#ifndef VK_SDK_PLATFORM_H
-#define VK_SDK_PLATFORM_H
+// Allow users to suppress warnings generated by this header file by defining VK_SDK_PLATFORM_SUPRRESS_DEPRECATION_WARNING
+#ifndef VK_SDK_PLATFORM_SUPRRESS_DEPRECATION_WARNING
+
+#if defined(__GNUC__) && __GNUC__ >= 4
+#warning "vk_sdk_platform.h is deprecated and will be removed in future release! Use VK_SDK_PLATFORM_SUPRRESS_DEPRECATION_WARNING to suppress warning!"
+#endif
+
+// MSVC doesn't support warning directive
+#if defined(_MSC_VER)
+#pragma message("vk_sdk_platform.h is deprecated and will be removed in future release! Use VK_SDK_PLATFORM_SUPRRESS_DEPRECATION_WARNING to suppress warning!")
+#endif
+
+#endif
 
 #if defined(_WIN32)
+#ifndef NOMINMAX
 #define NOMINMAX
+#endif
 #ifndef __cplusplus
 #undef inline
 #define inline __inline
@@ -65,5 +79,3 @@
 #define NOEXCEPT
 #endif
 #endif
-
-#endif  // VK_SDK_PLATFORM_H